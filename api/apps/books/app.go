@@ -12,7 +12,6 @@ import (
 	"tools.xdoubleu.com/apps/books/internal/repositories"
 	"tools.xdoubleu.com/apps/books/internal/services"
 	"tools.xdoubleu.com/apps/books/pkg/hardcover"
-	"tools.xdoubleu.com/apps/books/pkg/objectstore"
 	"tools.xdoubleu.com/apps/books/pkg/unicat"
 	"tools.xdoubleu.com/apps/books/pkg/webfetch"
 	"tools.xdoubleu.com/internal/app"
@@ -38,6 +37,8 @@ type Books struct {
 	jobQueue       *jobqueue.JobQueue
 	resyncBooksJob *jobs.ResyncMetadataJob
 	storageScanJob *jobs.StorageScanJob
+	textIndexJob   *jobs.TextIndexJob
+	feedDigestJob  *jobs.FeedDigestJob
 }
 
 func New(
@@ -46,14 +47,6 @@ func New(
 	cfg config.Config,
 	db postgres.DB,
 ) *Books {
-	if cfg.R2AccountID == "" || cfg.R2AccessKeyID == "" ||
-		cfg.R2SecretKey == "" || cfg.R2Bucket == "" {
-		logger.Warn(
-			"R2 object store is not fully configured — book file uploads will fail;" +
-				" set R2_ACCOUNT_ID, R2_ACCESS_KEY_ID, R2_SECRET_ACCESS_KEY, R2_BUCKET",
-		)
-	}
-
 	// Hardcover requires a token to work at all, so leave the client nil when
 	// unset — the resync orchestration nil-checks every optional provider.
 	var hardcoverClient hardcover.Client
@@ -65,21 +58,16 @@ func New(
 		hardcoverClient = hardcover.New(logger, cfg.HardcoverAPIKey)
 	}
 
-	endpoint := "https://" + cfg.R2AccountID + ".r2.cloudflarestorage.com"
 	webFetchClient := webfetch.New(logger, cfg.Env != config.ProdEnv)
 
 	clients := Clients{
-		UniCat:    unicat.New(logger),
-		Hardcover: hardcoverClient,
-		ObjectStore: objectstore.NewR2(
-			endpoint,
-			cfg.R2AccessKeyID,
-			cfg.R2SecretKey,
-			cfg.R2Bucket,
-		),
-		WebFetch:         webFetchClient,
-		KoboStoreBaseURL: "https://storeapi.kobo.com",
-		PublicAPIBaseURL: cfg.APIURL,
+		UniCat:                 unicat.New(logger),
+		Hardcover:              hardcoverClient,
+		ObjectStore:            newObjectStore(logger, cfg),
+		WebFetch:               webFetchClient,
+		KoboStoreBaseURL:       "https://storeapi.kobo.com",
+		KoboReadingServicesURL: "https://readingservices.kobo.com",
+		PublicAPIBaseURL:       cfg.APIURL,
 	}
 
 	return NewInner(authService, logger, cfg, db, clients)
@@ -131,6 +119,8 @@ func NewInner(
 		a.Repositories.BookFiles,
 		sharedrepos.NewStorageSnapshotsRepository(db),
 	)
+	a.textIndexJob = jobs.NewTextIndexJob(a.Services.Text)
+	a.feedDigestJob = jobs.NewFeedDigestJob(a.Services.Digest)
 
 	return a
 }
@@ -151,6 +141,20 @@ func (a *Books) Start() error {
 		return err
 	}
 
+	if err := a.jobQueue.AddJob(
+		observability.NewTrackedJob(a.textIndexJob, a.db),
+		noop,
+	); err != nil {
+		return err
+	}
+
+	if err := a.jobQueue.AddJob(
+		observability.NewTrackedJob(a.feedDigestJob, a.db),
+		noop,
+	); err != nil {
+		return err
+	}
+
 	a.Services.WebSocket.RegisterTopics(a.jobQueue.FetchJobIDs())
 	return nil
 }
@@ -30,12 +30,13 @@ func TestStart_RegistersJobs(t *testing.T) {
 		testCfg,
 		testDB,
 		books.Clients{
-			UniCat:           nil,
-			WebFetch:         nil,
-			Hardcover:        nil,
-			ObjectStore:      objectstore.NewFake(),
-			KoboStoreBaseURL: "",
-			PublicAPIBaseURL: "",
+			UniCat:                 nil,
+			WebFetch:               nil,
+			Hardcover:              nil,
+			ObjectStore:            objectstore.NewFake(),
+			KoboStoreBaseURL:       "",
+			KoboReadingServicesURL: "",
+			PublicAPIBaseURL:       "",
 		},
 	)
 	require.NotNil(t, bl)
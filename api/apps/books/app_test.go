@@ -59,12 +59,13 @@ func TestMain(m *testing.M) {
 	fakeStore = objectstore.NewFake()
 	mockWebFetch = mocks.NewMockWebFetchClient()
 	clients := books.Clients{
-		UniCat:           nil,
-		Hardcover:        mocks.NewMockHardcoverClient(),
-		ObjectStore:      fakeStore,
-		WebFetch:         mockWebFetch,
-		KoboStoreBaseURL: "",
-		PublicAPIBaseURL: "",
+		UniCat:                 nil,
+		Hardcover:              mocks.NewMockHardcoverClient(),
+		ObjectStore:            fakeStore,
+		WebFetch:               mockWebFetch,
+		KoboStoreBaseURL:       "",
+		KoboReadingServicesURL: "",
+		PublicAPIBaseURL:       "",
 	}
 
 	testApp = books.NewInner(
@@ -115,17 +116,19 @@ func getRoutes() http.Handler {
 }
 
 // getRoutesWithKoboUpstream creates a Backlog instance identical to testApp
-// but with a custom KoboStoreBaseURL (for proxy/merge tests).
+// but with upstreamURL as both the Kobo store and the Kobo reading services
+// (for proxy/merge tests).
 // It shares the same DB so tokens generated via testApp are recognised.
 func getRoutesWithKoboUpstream(t *testing.T, upstreamURL string) http.Handler {
 	t.Helper()
 	clients := books.Clients{
-		UniCat:           nil,
-		Hardcover:        mocks.NewMockHardcoverClient(),
-		ObjectStore:      objectstore.NewFake(),
-		WebFetch:         nil,
-		KoboStoreBaseURL: upstreamURL,
-		PublicAPIBaseURL: "",
+		UniCat:                 nil,
+		Hardcover:              mocks.NewMockHardcoverClient(),
+		ObjectStore:            objectstore.NewFake(),
+		WebFetch:               nil,
+		KoboStoreBaseURL:       upstreamURL,
+		KoboReadingServicesURL: upstreamURL,
+		PublicAPIBaseURL:       "",
 	}
 	app := books.NewInner(
 		sharedmocks.NewMockedAuthService(userID),
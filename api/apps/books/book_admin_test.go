@@ -50,12 +50,13 @@ func newAdminBooksTestClient(t *testing.T) booksTestClient {
 		testCfg,
 		testDB,
 		books.Clients{
-			UniCat:           nil,
-			WebFetch:         nil,
-			Hardcover:        nil,
-			ObjectStore:      objectstore.NewFake(),
-			PublicAPIBaseURL: "",
-			KoboStoreBaseURL: "",
+			UniCat:                 nil,
+			WebFetch:               nil,
+			Hardcover:              nil,
+			ObjectStore:            objectstore.NewFake(),
+			PublicAPIBaseURL:       "",
+			KoboStoreBaseURL:       "",
+			KoboReadingServicesURL: "",
 		},
 	)
 	ts := httptest.NewServer(testhelper.BuildMux(adminApp))
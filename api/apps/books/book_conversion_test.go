@@ -1,6 +1,7 @@
 package books_test
 
 import (
+	"archive/zip"
 	"bytes"
 	"context"
 	"errors"
@@ -46,6 +47,7 @@ func newTestConversionService(
 		store,
 		converter,
 		convertPDF,
+		nil,
 	)
 	return svc, store
 }
@@ -283,6 +285,46 @@ func TestEnsureKEPUB_PDFOnly_ConvertSuccess(t *testing.T) {
 	assert.Equal(t, kepubBytes, stored)
 }
 
+func TestEnsureKEPUB_CBZOnly_RendersComic(t *testing.T) {
+	book := addUniqueBook(t)
+	converter := &countingConverter{out: []byte("comic kepub")}
+	conv, store := newTestConversionService(converter, nil)
+	seedPDFFile(t, store, book.ID)
+
+	cbzData := buildCBZBytes("Seed Comic", "Seed Writer", "")
+	key := fmt.Sprintf("users/%s/books/%s/seed.cbz", userID, book.ID)
+	require.NoError(t, store.Put(
+		context.Background(), key,
+		bytes.NewReader(cbzData), int64(len(cbzData)), "application/vnd.comicbook+zip",
+	))
+	cbzFile, err := testApp.Repositories.BookFiles.Insert(
+		context.Background(),
+		models.BookFile{ //nolint:exhaustruct //optional nullable fields omitted
+			BookID:     book.ID,
+			UserID:     userID,
+			Format:     models.FileFormatCBZ,
+			StorageKey: key,
+			SizeBytes:  int64(len(cbzData)),
+			Status:     models.FileStatusReady,
+		},
+	)
+	require.NoError(t, err)
+
+	result, err := conv.EnsureKEPUB(context.Background(), userID, book.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusReady, result.Status)
+	// The CBZ wins over the PDF, and kepubify gets the rendered comic.
+	require.NotNil(t, result.SourceFileID)
+	assert.Equal(t, cbzFile.ID, *result.SourceFileID)
+	assert.Equal(t, 1, converter.calls)
+	epub, err := zip.NewReader(
+		bytes.NewReader(converter.last), int64(len(converter.last)),
+	)
+	require.NoError(t, err)
+	_, err = epub.Open("OEBPS/images/page-0001.jpg")
+	assert.NoError(t, err)
+}
+
 func TestEnsureKEPUB_PDFConvertError_MarksFailedStatus(t *testing.T) {
 	book := addUniqueBook(t)
 	conv, store := newTestConversionService(
@@ -399,12 +441,14 @@ var user2ID = "5001e9cf-3fbe-4b09-863f-bd1654cfbf76"
 // Convert is called. Used to assert that cross-user dedup skips conversion.
 type countingConverter struct {
 	calls int
+	last  []byte
 	out   []byte
 	err   error
 }
 
-func (c *countingConverter) Convert(_ context.Context, _ []byte) ([]byte, error) {
+func (c *countingConverter) Convert(_ context.Context, in []byte) ([]byte, error) {
 	c.calls++
+	c.last = in
 	return c.out, c.err
 }
 
@@ -469,9 +513,9 @@ func TestEnsureKEPUB_CanonicalKey_WhenSourceHasChecksum(t *testing.T) {
 
 	assert.Equal(
 		t,
-		"books/"+book.ID.String()+"/"+checksum+".kepub",
+		"blobs/"+checksum+".kepub",
 		result.StorageKey,
-		"KEPUB must be stored at per-book canonical key, not a per-user path",
+		"KEPUB must be stored at its content-addressed key, not a per-user path",
 	)
 	assert.Equal(t, models.FileStatusReady, result.Status)
 }
@@ -500,7 +544,7 @@ func TestEnsureKEPUB_CrossUserDedup_SkipsConversion(t *testing.T) {
 	result1, err := conv.EnsureKEPUB(context.Background(), userID, book.ID)
 	require.NoError(t, err)
 	assert.Equal(t, 1, counter.calls, "converter must run exactly once for user 1")
-	assert.Equal(t, "books/"+book.ID.String()+"/"+checksum+".kepub", result1.StorageKey)
+	assert.Equal(t, "blobs/"+checksum+".kepub", result1.StorageKey)
 
 	// User 2: warm path — canonical blob already exists; converter must NOT run.
 	result2, err := conv.EnsureKEPUB(context.Background(), user2ID, book.ID)
@@ -592,6 +636,7 @@ func TestEnsureKEPUB_StorePutFails_MarksFailedStatus(t *testing.T) {
 		store,
 		&fakeEPUBConverter{out: []byte("kepub"), err: nil},
 		nil,
+		nil,
 	)
 
 	_, err = conv.EnsureKEPUB(context.Background(), userID, book.ID)
@@ -648,6 +693,7 @@ func TestEnsureKEPUB_StoreGetFails_MarksFailedStatus(t *testing.T) {
 		store,
 		&fakeEPUBConverter{out: []byte("kepub"), err: nil},
 		nil,
+		nil,
 	)
 
 	_, err = conv.EnsureKEPUB(context.Background(), userID, book.ID)
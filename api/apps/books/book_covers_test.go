@@ -38,12 +38,13 @@ func buildCoverApp(t *testing.T) (*books.Books, *objectstore.FakeClient) {
 	t.Helper()
 	store := objectstore.NewFake()
 	clients := books.Clients{
-		UniCat:           nil,
-		WebFetch:         nil,
-		Hardcover:        nil,
-		ObjectStore:      store,
-		KoboStoreBaseURL: "",
-		PublicAPIBaseURL: "http://api.test",
+		UniCat:                 nil,
+		WebFetch:               nil,
+		Hardcover:              nil,
+		ObjectStore:            store,
+		KoboStoreBaseURL:       "",
+		KoboReadingServicesURL: "",
+		PublicAPIBaseURL:       "http://api.test",
 	}
 	app := books.NewInner(
 		sharedmocks.NewMockedAuthService(userID),
@@ -205,12 +206,13 @@ func TestCoverHandler_HangingObjectStoreFailsFast(t *testing.T) {
 	ub := addTestBook(t, "CoverHandlerHangingStoreBook")
 
 	clients := books.Clients{
-		UniCat:           nil,
-		WebFetch:         nil,
-		Hardcover:        nil,
-		ObjectStore:      hangingObjectStore{Client: objectstore.NewFake()},
-		KoboStoreBaseURL: "",
-		PublicAPIBaseURL: "http://api.test",
+		UniCat:                 nil,
+		WebFetch:               nil,
+		Hardcover:              nil,
+		ObjectStore:            hangingObjectStore{Client: objectstore.NewFake()},
+		KoboStoreBaseURL:       "",
+		KoboReadingServicesURL: "",
+		PublicAPIBaseURL:       "http://api.test",
 	}
 	app := books.NewInner(
 		sharedmocks.NewMockedAuthService(userID),
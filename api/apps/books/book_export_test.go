@@ -0,0 +1,199 @@
+package books_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/services"
+	"tools.xdoubleu.com/apps/books/pkg/books"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+)
+
+//nolint:lll // CSV rows are inherently long
+const exportRoundTripCSV = `Book Id,Title,Author,Additional Authors,ISBN13,My Rating,Number of Pages,Exclusive Shelf,Bookshelves with positions,Date Read,Date Added
+1,Export Round Trip A,Ann Author,Co Writer,,4,320,read,"read (#2), own-physical, favourite (#1)",2023/06/15,2023/01/02
+2,Export Round Trip B,Ben Author,,,0,,to-read,"to-read (#1)",,2024/03/04
+3,Export Round Trip C,Cat Author,,,0,,dnf-maybe-later,"dnf-maybe-later, own-digital",,2024/05/06
+`
+
+func TestExportLibrary_CSVRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	source := "export-src-" + uuid.NewString()
+	target := "export-dst-" + uuid.NewString()
+
+	_, err := testApp.Services.Books.ImportFromCSV(
+		ctx, source, bytes.NewBufferString(exportRoundTripCSV),
+	)
+	require.NoError(t, err)
+
+	exported, err := testApp.Services.Export.ExportLibrary(
+		ctx, source, services.LibraryExportCSV, now,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "library-2024-06-01.csv", exported.Filename)
+	assert.Equal(t, "text/csv; charset=utf-8", exported.ContentType)
+
+	count, err := testApp.Services.Books.ImportFromCSV(
+		ctx, target, bytes.NewReader(exported.Data),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	reExported, err := testApp.Services.Export.ExportLibrary(
+		ctx, target, services.LibraryExportCSV, now,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, string(exported.Data), string(reExported.Data))
+
+	sourceEntries, err := books.ParseCSV(bytes.NewReader(exported.Data))
+	require.NoError(t, err)
+	targetEntries, err := books.ParseCSV(bytes.NewReader(reExported.Data))
+	require.NoError(t, err)
+	assert.Equal(t, sourceEntries, targetEntries)
+
+	first := sourceEntries[0]
+	assert.Equal(t, []string{"Ann Author", "Co Writer"}, first.Book.Authors)
+	assert.EqualValues(t, 4, *first.UserBook.Rating)
+	assert.Equal(t, 320, *first.Book.PageCount)
+	assert.Equal(t, 2, first.UserBook.ShelfPositions[models.StatusRead])
+	assert.Equal(t, "dnf-maybe-later", sourceEntries[2].UserBook.Status)
+}
+
+func TestExportLibrary_JSONIncludesReadingHistory(t *testing.T) {
+	ctx := context.Background()
+	owner := "export-json-" + uuid.NewString()
+	_, bookID := uploadFileForOwner(t, owner, models.FileFormatEPUB)
+
+	require.NoError(t, testApp.Services.Books.UpdateReadingProgress(
+		ctx, owner, bookID, models.ReadingSourceWeb, 10, nil,
+	))
+	require.NoError(t, testApp.Services.Books.UpdateReadingProgress(
+		ctx, owner, bookID, models.ReadingSourceWeb, 30, nil,
+	))
+
+	exported, err := testApp.Services.Export.ExportLibrary(
+		ctx, owner, services.LibraryExportJSON, time.Now(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "application/json", exported.ContentType)
+
+	library, err := books.ParseJSON(bytes.NewReader(exported.Data))
+	require.NoError(t, err)
+	require.Len(t, library.Books, 1)
+	b := library.Books[0]
+	assert.Equal(t, models.StatusToRead, b.Status)
+	require.Len(t, b.ReadingStates, 1)
+	assert.Equal(t, models.ReadingSourceWeb, b.ReadingStates[0].Source)
+	assert.Equal(t, 30, b.ReadingStates[0].Percent)
+	require.Len(t, b.Sessions, 1)
+	assert.Equal(t, 10, *b.Sessions[0].StartPercent)
+	assert.Equal(t, 30, *b.Sessions[0].EndPercent)
+}
+
+func TestConnectExportLibrary(t *testing.T) {
+	ctx := context.Background()
+	client := newBooksTestClient(t)
+
+	req := connect.NewRequest(&booksv1.ExportLibraryRequest{})
+	req.Header().Set("Cookie", accessToken.String())
+	resp, err := client.ExportLibrary(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "text/csv; charset=utf-8", resp.Msg.ContentType)
+	_, err = books.ParseCSV(bytes.NewReader(resp.Msg.Data))
+	require.NoError(t, err)
+
+	req = connect.NewRequest(&booksv1.ExportLibraryRequest{Format: "xml"})
+	req.Header().Set("Cookie", accessToken.String())
+	_, err = client.ExportLibrary(ctx, req)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = client.ExportLibrary(
+		ctx, connect.NewRequest(&booksv1.ExportLibraryRequest{}),
+	)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
+
+func TestExportLibrary_JSONBackupRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	source := "backup-src-" + uuid.NewString()
+	target := "backup-dst-" + uuid.NewString()
+
+	ub := seedBookInLibrary(t, source, "Backup-"+uuid.NewString(), "Author", "")
+	ub.Status = models.StatusRead
+	ub.FinishedAt = []time.Time{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}
+	require.NoError(t, testApp.Repositories.Books.UpsertUserBook(ctx, *ub))
+	require.NoError(t, testApp.Repositories.Books.UpdateProgress(
+		ctx, source, ub.BookID, models.ProgressModePages, 42, 0,
+	))
+	require.NoError(t, testApp.Services.Books.UpdateReadingProgress(
+		ctx, source, ub.BookID, models.ReadingSourceWeb, 10, nil,
+	))
+	require.NoError(t, testApp.Services.Books.UpdateReadingProgress(
+		ctx, source, ub.BookID, models.ReadingSourceWeb, 30, nil,
+	))
+	//nolint:exhaustruct //optional annotation fields
+	require.NoError(t, testApp.Repositories.Annotations.Upsert(ctx, nil, models.Annotation{
+		ID:              uuid.New(),
+		UserID:          source,
+		BookID:          ub.BookID,
+		Type:            models.AnnotationTypeHighlight,
+		HighlightedText: "a line worth keeping",
+		NoteText:        "and a note on it",
+		Source:          models.ReadingSourceKobo,
+	}))
+	rebuildProgress(t, source)
+
+	backup := exportJSON(t, source)
+	require.Len(t, backup.Books, 1)
+	require.Len(t, backup.Books[0].Annotations, 1)
+	require.NotEmpty(t, backup.Progress)
+	data, err := json.Marshal(backup)
+	require.NoError(t, err)
+
+	for range 2 {
+		_, err = testApp.Services.Books.ImportLibrary(
+			ctx, target, data, books.FormatBackup, false,
+		)
+		require.NoError(t, err)
+	}
+	rebuildProgress(t, target)
+
+	restored := exportJSON(t, target)
+	// Another account can't reuse the source's annotation IDs.
+	for _, lib := range []*books.LibraryExport{&backup, &restored} {
+		for i := range lib.Books[0].Annotations {
+			lib.Books[0].Annotations[i].ID = nil
+		}
+	}
+	assert.Equal(t, backup.Books, restored.Books)
+	assert.Equal(t, backup.Progress, restored.Progress)
+}
+
+func exportJSON(t *testing.T, userID string) books.LibraryExport {
+	t.Helper()
+	exported, err := testApp.Services.Export.ExportLibrary(
+		context.Background(), userID, services.LibraryExportJSON, time.Now(),
+	)
+	require.NoError(t, err)
+	library, err := books.ParseJSON(bytes.NewReader(exported.Data))
+	require.NoError(t, err)
+	return library
+}
+
+func rebuildProgress(t *testing.T, userID string) {
+	t.Helper()
+	ctx := context.Background()
+	labels, values, err := testApp.Services.Books.BuildReadProgress(ctx, userID)
+	require.NoError(t, err)
+	require.NoError(t, testApp.Services.Progress.Save(ctx, userID, labels, values))
+}
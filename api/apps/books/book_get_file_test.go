@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"connectrpc.com/connect"
@@ -12,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
 	booksv1 "tools.xdoubleu.com/gen/books/v1"
 	"tools.xdoubleu.com/internal/database"
 )
@@ -102,6 +104,65 @@ func TestGetBookFile_ByFormat_EPUB_Found(t *testing.T) {
 	assert.False(t, result.ExpiresAt.IsZero())
 }
 
+// servedContent returns the fake-store object a GetBookFile URL points at.
+func servedContent(t *testing.T, url string) (string, []byte) {
+	t.Helper()
+	key := strings.TrimPrefix(url, "https://fake.example.com/")
+	key, _, _ = strings.Cut(key, "?")
+	data, ok := fakeStore.GetContent(key)
+	require.True(t, ok, "no object at %s", key)
+	return key, data
+}
+
+func TestGetBookFile_EPUB_ServesCatalogMetadata(t *testing.T) {
+	ctx := context.Background()
+	_, bookID := uploadFileForOwner(t, userID, models.FileFormatEPUB)
+
+	book, err := testApp.Repositories.Books.GetBookByID(ctx, bookID)
+	require.NoError(t, err)
+	book.Title = "Corrected Title " + uuid.NewString()
+	book.Authors = []string{"Corrected Author"}
+	_, err = testApp.Services.Books.UpdateBook(ctx, bookID, *book, "")
+	require.NoError(t, err)
+
+	result, err := testApp.Services.Books.GetBookFile(
+		ctx, userID, bookID, models.FileFormatEPUB,
+	)
+	require.NoError(t, err)
+	key, data := servedContent(t, result.URL)
+	assert.Contains(t, key, "/rendered/")
+	meta, err := ebookmeta.Extract(
+		ebookmeta.FormatEPUB, bytes.NewReader(data), int64(len(data)),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, book.Title, meta.Title)
+	assert.Equal(t, []string{"Corrected Author"}, meta.Authors)
+
+	// Unchanged catalog: the cached copy is served again.
+	again, err := testApp.Services.Books.GetBookFile(
+		ctx, userID, bookID, models.FileFormatEPUB,
+	)
+	require.NoError(t, err)
+	againKey, _ := servedContent(t, again.URL)
+	assert.Equal(t, key, againKey)
+
+	// A later edit renders a new copy.
+	book.Title += " (2nd ed.)"
+	_, err = testApp.Services.Books.UpdateBook(ctx, bookID, *book, "")
+	require.NoError(t, err)
+	edited, err := testApp.Services.Books.GetBookFile(
+		ctx, userID, bookID, models.FileFormatEPUB,
+	)
+	require.NoError(t, err)
+	editedKey, data := servedContent(t, edited.URL)
+	assert.NotEqual(t, key, editedKey)
+	meta, err = ebookmeta.Extract(
+		ebookmeta.FormatEPUB, bytes.NewReader(data), int64(len(data)),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, book.Title, meta.Title)
+}
+
 func TestGetBookFile_ByFormat_PDF_Found(t *testing.T) {
 	bf, bookID := uploadFileForOwner(t, userID, models.FileFormatPDF)
 	require.Equal(t, models.FileFormatPDF, bf.Format)
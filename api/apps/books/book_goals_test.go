@@ -0,0 +1,123 @@
+package books_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+)
+
+// goalTestYear is long past, so every goal in it is measured over the whole
+// year and no other test finishes books in it.
+const goalTestYear = 2011
+
+func setGoal(
+	t *testing.T,
+	client booksTestClient,
+	goal *booksv1.ReadingGoal,
+) (*connect.Response[booksv1.SetReadingGoalResponse], error) {
+	t.Helper()
+	req := connect.NewRequest(&booksv1.SetReadingGoalRequest{Goal: goal})
+	req.Header().Set("Cookie", accessToken.String())
+	return client.SetReadingGoal(context.Background(), req)
+}
+
+func TestConnectReadingGoals(t *testing.T) {
+	ctx := context.Background()
+	client := newBooksTestClient(t)
+
+	_, bookID := uploadFileForOwner(t, userID, models.FileFormatEPUB)
+	finished := time.Date(goalTestYear, 3, 4, 0, 0, 0, 0, time.UTC)
+	_, err := testDB.Exec(ctx,
+		`UPDATE books.user_books SET status = $1, finished_at = $2
+		 WHERE user_id = $3 AND book_id = $4`,
+		models.StatusRead, []time.Time{finished}, userID, bookID)
+	require.NoError(t, err)
+	_, err = testDB.Exec(ctx,
+		`UPDATE books.books SET page_count = 300 WHERE id = $1`, bookID)
+	require.NoError(t, err)
+
+	_, err = setGoal(t, client, &booksv1.ReadingGoal{
+		Year: goalTestYear, Kind: models.GoalKindBooks, Target: 1, Public: true,
+	})
+	require.NoError(t, err)
+	// Setting the same kind again replaces the target.
+	resp, err := setGoal(t, client, &booksv1.ReadingGoal{
+		Year: goalTestYear, Kind: models.GoalKindPages, Target: 100,
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, resp.Msg.Goal.Target)
+	resp, err = setGoal(t, client, &booksv1.ReadingGoal{
+		Year: goalTestYear, Kind: models.GoalKindPages, Target: 600,
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 600, resp.Msg.Goal.Target)
+
+	req := connect.NewRequest(&booksv1.GetReadingGoalsRequest{Year: goalTestYear})
+	req.Header().Set("Cookie", accessToken.String())
+	goals, err := client.GetReadingGoals(ctx, req)
+	require.NoError(t, err)
+	assert.EqualValues(t, goalTestYear, goals.Msg.Year)
+	require.Len(t, goals.Msg.Goals, 2)
+
+	byBooks := goals.Msg.Goals[0]
+	assert.Equal(t, models.GoalKindBooks, byBooks.Goal.Kind)
+	assert.True(t, byBooks.Goal.Public)
+	assert.EqualValues(t, 1, byBooks.Current)
+	assert.Equal(t, models.GoalPaceOnTrack, byBooks.Pace)
+
+	byPages := goals.Msg.Goals[1]
+	assert.Equal(t, models.GoalKindPages, byPages.Goal.Kind)
+	assert.EqualValues(t, 300, byPages.Current)
+	assert.InDelta(t, 600, byPages.Expected, 1e-9)
+	assert.Equal(t, models.GoalPaceBehind, byPages.Pace)
+
+	shared, err := testApp.BuildSharedReadingGoals(ctx, userID, goalTestYear)
+	require.NoError(t, err)
+	require.Len(t, shared, 1)
+	assert.Equal(t, models.GoalKindBooks, shared[0].Goal.Kind)
+
+	for _, kind := range []string{models.GoalKindBooks, models.GoalKindPages} {
+		del := connect.NewRequest(&booksv1.DeleteReadingGoalRequest{
+			Year: goalTestYear, Kind: kind,
+		})
+		del.Header().Set("Cookie", accessToken.String())
+		_, err = client.DeleteReadingGoal(ctx, del)
+		require.NoError(t, err)
+	}
+
+	del := connect.NewRequest(&booksv1.DeleteReadingGoalRequest{
+		Year: goalTestYear, Kind: models.GoalKindBooks,
+	})
+	del.Header().Set("Cookie", accessToken.String())
+	_, err = client.DeleteReadingGoal(ctx, del)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestConnectSetReadingGoal_Invalid(t *testing.T) {
+	client := newBooksTestClient(t)
+
+	_, err := setGoal(t, client, &booksv1.ReadingGoal{
+		Year: goalTestYear, Kind: "chapters", Target: 10,
+	})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = setGoal(t, client, nil)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestConnectGetReadingGoals_Unauthenticated(t *testing.T) {
+	client := newBooksTestClient(t)
+
+	_, err := client.GetReadingGoals(
+		context.Background(),
+		connect.NewRequest(&booksv1.GetReadingGoalsRequest{}),
+	)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
@@ -0,0 +1,122 @@
+package books_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/services"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+)
+
+//nolint:lll // CSV rows are inherently long
+const importSeedCSV = `Book Id,Title,Author,ISBN13,My Rating,Exclusive Shelf,Bookshelves with positions,Date Read
+1,Import Merge Target,Mia Author,,0,to-read,"to-read (#1)",
+`
+
+// The first row matches the seeded book by title and author; the last two
+// are the same new book twice, and the second copy carries more tags.
+//
+//nolint:lll // CSV rows are inherently long
+const importStoryGraphCSV = `Title,Authors,ISBN/UID,Format,Read Status,Date Added,Last Date Read,Dates Read,Read Count,Star Rating,Tags,Owned?
+Import Merge Target,Mia Author,,paperback,read,2023/01/01,2023/02/01,2023/01/10-2023/02/01,1,4.0,,Yes
+Import Fresh Book,Fay Author,,digital,to-read,2023/03/01,,,0,,,No
+Import Fresh Book,Fay Author,,digital,to-read,2023/03/02,,,0,,keepers,Yes
+`
+
+func TestImportLibrary_DryRunThenApply(t *testing.T) {
+	ctx := context.Background()
+	owner := "import-" + uuid.NewString()
+
+	_, err := testApp.Services.Books.ImportFromCSV(
+		ctx, owner, bytes.NewBufferString(importSeedCSV),
+	)
+	require.NoError(t, err)
+
+	preview, err := testApp.Services.Books.ImportLibrary(
+		ctx, owner, []byte(importStoryGraphCSV), "", true,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "storygraph", preview.Format)
+	assert.Equal(t, 1, preview.Created)
+	assert.Equal(t, 1, preview.Merged)
+	assert.Equal(t, 1, preview.Skipped)
+	assert.Equal(t, "title+author", preview.Items[0].Reason)
+
+	lib, err := testApp.Services.Books.GetLibrary(ctx, owner)
+	require.NoError(t, err)
+	require.Len(t, lib, 1, "dry run writes nothing")
+	assert.Equal(t, models.StatusToRead, lib[0].Status)
+
+	applied, err := testApp.Services.Books.ImportLibrary(
+		ctx, owner, []byte(importStoryGraphCSV), "storygraph", false,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, preview.Created, applied.Created)
+	assert.Equal(t, preview.Merged, applied.Merged)
+
+	lib, err = testApp.Services.Books.GetLibrary(ctx, owner)
+	require.NoError(t, err)
+	require.Len(t, lib, 2)
+	byTitle := map[string]models.UserBook{}
+	for _, ub := range lib {
+		byTitle[ub.Book.Title] = ub
+	}
+	merged := byTitle["Import Merge Target"]
+	assert.Equal(t, models.StatusRead, merged.Status)
+	assert.Len(t, merged.FinishedAt, 1)
+	assert.Contains(t, merged.Tags, models.TagOwnPhysical)
+	assert.ElementsMatch(t,
+		[]string{"keepers", models.TagOwnDigital},
+		byTitle["Import Fresh Book"].Tags)
+
+	again, err := testApp.Services.Books.ImportLibrary(
+		ctx, owner, []byte(importStoryGraphCSV), "", true,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 0, again.Created)
+	assert.Equal(t, 0, again.Merged)
+	assert.Equal(t, 3, again.Skipped)
+}
+
+func TestImportLibrary_RejectsUnknownFormat(t *testing.T) {
+	_, err := testApp.Services.Books.ImportLibrary(
+		context.Background(), "import-"+uuid.NewString(),
+		[]byte("not, a, library\n"), "", true,
+	)
+	require.ErrorIs(t, err, services.ErrInvalidImport)
+}
+
+func TestConnectImportBooks_DryRun(t *testing.T) {
+	ctx := context.Background()
+	client := newBooksTestClient(t)
+
+	req := connect.NewRequest(&booksv1.ImportBooksRequest{
+		Data:   []byte(importStoryGraphCSV),
+		DryRun: true,
+	})
+	req.Header().Set("Cookie", accessToken.String())
+	resp, err := client.ImportBooks(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "storygraph", resp.Msg.Format)
+	assert.Zero(t, resp.Msg.ImportedCount)
+	require.Len(t, resp.Msg.Items, 3)
+	// The tagged copy of the duplicate has the richer entry and is kept.
+	assert.Equal(t, services.ImportActionSkip, resp.Msg.Items[1].Action)
+	assert.Equal(t, "duplicate in file", resp.Msg.Items[1].Reason)
+	assert.Equal(t, services.ImportActionCreate, resp.Msg.Items[2].Action)
+
+	req = connect.NewRequest(&booksv1.ImportBooksRequest{
+		Data:   []byte(importStoryGraphCSV),
+		Format: "delicious",
+	})
+	req.Header().Set("Cookie", accessToken.String())
+	_, err = client.ImportBooks(ctx, req)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
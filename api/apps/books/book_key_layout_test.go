@@ -1,17 +1,20 @@
 package books_test
 
 import (
+	"bytes"
+	"context"
 	"strings"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-// TestUploadUsesPerBookKey verifies that a new upload is stored under the
-// per-book folder (books/<bookID>/<checksum><ext>) rather than the old flat
-// scheme (books/<checksum><ext>).
-func TestUploadUsesPerBookKey(t *testing.T) {
+// TestUploadUsesContentAddressedKey verifies that a new upload is stored
+// under the content-addressed blobs/<checksum><ext> key, not under the
+// book's folder.
+func TestUploadUsesContentAddressedKey(t *testing.T) {
 	ub := seedBookInLibrary(
 		t, userID, "PerBookKeyBook", "Homer", "9780140447934",
 	)
@@ -26,17 +29,59 @@ func TestUploadUsesPerBookKey(t *testing.T) {
 	)
 	require.NoError(t, err)
 	require.NotNil(t, result)
+	assert.Equal(t, ub.BookID, result.BookFile.BookID)
 
-	// The storage key must be under the book's folder.
-	expectedPrefix := "books/" + ub.BookID.String() + "/"
-	assert.True(
+	require.NotNil(t, result.BookFile.Checksum)
+	assert.Equal(
 		t,
-		strings.HasPrefix(result.BookFile.StorageKey, expectedPrefix),
-		"storage key %q should start with %q",
+		"blobs/"+*result.BookFile.Checksum+".epub",
 		result.BookFile.StorageKey,
-		expectedPrefix,
 	)
-	// Exactly two slashes: "books/<bookID>/<checksum><ext>"
-	slashes := strings.Count(result.BookFile.StorageKey, "/")
-	assert.Equal(t, 2, slashes, "storage key should have exactly 2 '/' separators")
+}
+
+// TestUpload_SameContentOnTwoBooks_SharesOneBlob uploads the same bytes,
+// without a client checksum, to two different books: the second upload
+// links to the first one's blob instead of storing a copy, and the blob
+// survives until the last book referencing it is removed.
+func TestUpload_SameContentOnTwoBooks_SharesOneBlob(t *testing.T) {
+	ctx := context.Background()
+	suffix := uuid.NewString()
+	first := seedBookInLibrary(t, userID, "Shared Blob A "+suffix, "Blob Author", "")
+	second := seedBookInLibrary(t, userID, "Shared Blob B "+suffix, "Blob Author", "")
+	data := buildEPUBBytes("Shared Blob "+suffix, "Blob Author", "")
+
+	upload := func(title string) string {
+		uploadID, _, _, err := testApp.Services.Books.CreateUpload(
+			ctx, userID, "shared.epub", "application/epub+zip", int64(len(data)), "",
+		)
+		require.NoError(t, err)
+		require.NoError(t, fakeStore.Put(
+			ctx, uploadID, bytes.NewReader(data), int64(len(data)), "application/epub+zip",
+		))
+		result, err := testApp.Services.Books.FinalizeUpload(
+			ctx, userID, uploadID, "shared.epub", "application/epub+zip", "",
+			title, "Blob Author",
+		)
+		require.NoError(t, err)
+		_, leftover := fakeStore.GetContent(uploadID)
+		assert.False(t, leftover, "the temp upload must be deleted")
+		return result.BookFile.StorageKey
+	}
+
+	keyA := upload("Shared Blob A " + suffix)
+	keyB := upload("Shared Blob B " + suffix)
+	assert.True(t, strings.HasPrefix(keyA, "blobs/"), keyA)
+	assert.Equal(t, keyA, keyB, "both books must reference one blob")
+
+	refs, err := testApp.Repositories.BookFiles.CountByStorageKey(ctx, keyA)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), refs)
+
+	require.NoError(t, testApp.Services.Books.RemoveFromLibrary(ctx, userID, first.BookID))
+	_, exists := fakeStore.GetContent(keyA)
+	assert.True(t, exists, "the blob is still referenced by the other book")
+
+	require.NoError(t, testApp.Services.Books.RemoveFromLibrary(ctx, userID, second.BookID))
+	_, exists = fakeStore.GetContent(keyA)
+	assert.False(t, exists, "the last reference is gone, so is the blob")
 }
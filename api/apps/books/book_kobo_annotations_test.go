@@ -0,0 +1,427 @@
+package books_test
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+)
+
+// koboFixtureAnnotationIDs are the annotation IDs used in the recorded
+// testdata/kobo/annotations_*.json requests.
+//
+//nolint:gochecknoglobals //needed for tests
+var koboFixtureAnnotationIDs = []string{
+	"0b6f3a5e-2c1d-4e8a-9f47-3d2c8b1a7e01",
+	"0b6f3a5e-2c1d-4e8a-9f47-3d2c8b1a7e02",
+	"0b6f3a5e-2c1d-4e8a-9f47-3d2c8b1a7e03",
+}
+
+// loadKoboAnnotationFixture reads a recorded Kobo request body and rewrites
+// its annotation IDs through ids, so every test gets fresh primary keys while
+// the same fixture ID maps to the same new ID across the fixtures it loads.
+func loadKoboAnnotationFixture(
+	t *testing.T,
+	name string,
+	ids map[string]string,
+) []byte {
+	t.Helper()
+	raw, err := os.ReadFile("testdata/kobo/" + name)
+	require.NoError(t, err)
+
+	body := string(raw)
+	for _, fixtureID := range koboFixtureAnnotationIDs {
+		if _, ok := ids[fixtureID]; !ok {
+			ids[fixtureID] = uuid.NewString()
+		}
+		body = strings.ReplaceAll(body, fixtureID, ids[fixtureID])
+	}
+	return []byte(body)
+}
+
+func koboAnnotationsURL(ts *httptest.Server, rawToken string, bookID uuid.UUID) string {
+	return koboURL(ts, rawToken, "/api/v3/content/"+bookID.String()+"/annotations")
+}
+
+func patchKoboAnnotations(
+	t *testing.T,
+	ts *httptest.Server,
+	rawToken string,
+	bookID uuid.UUID,
+	body []byte,
+) {
+	t.Helper()
+	resp, err := http.DefaultClient.Do(koboReq(
+		t, http.MethodPatch, koboAnnotationsURL(ts, rawToken, bookID), body,
+	))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func getKoboAnnotations(
+	t *testing.T,
+	ts *httptest.Server,
+	rawToken string,
+	bookID uuid.UUID,
+) ([]map[string]any, string) {
+	t.Helper()
+	resp, err := http.DefaultClient.Do(koboReq(
+		t, http.MethodGet, koboAnnotationsURL(ts, rawToken, bookID), nil,
+	))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Annotations []map[string]any `json:"annotations"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return body.Annotations, resp.Header.Get("ETag")
+}
+
+func TestKoboAnnotations_PatchThenGetRoundTrip(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "kobo-annotations-roundtrip-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+
+	ids := map[string]string{}
+	patchKoboAnnotations(t, ts, rawToken, bookID,
+		loadKoboAnnotationFixture(t, "annotations_patch.json", ids))
+
+	annotations, etag := getKoboAnnotations(t, ts, rawToken, bookID)
+	require.Len(t, annotations, 3)
+	assert.NotEmpty(t, etag)
+
+	// Reading order follows chapterProgress, matching the fixture order.
+	first := annotations[0]
+	assert.Equal(t, ids[koboFixtureAnnotationIDs[0]], first["id"])
+	assert.Equal(t, "highlight", first["type"])
+	assert.Equal(t,
+		"It was a bright cold day in April, and the clocks were striking thirteen.",
+		first["highlightedText"],
+	)
+	assert.Nil(t, first["noteText"])
+	assert.Equal(t, "2025-03-14T20:41:07Z", first["clientLastModifiedUtc"])
+
+	// The device's location blob is echoed back untouched.
+	loc, ok := first["location"].(map[string]any)
+	require.True(t, ok)
+	span, ok := loc["span"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "OEBPS/chapter001.xhtml", span["chapterFilename"])
+	assert.Equal(t, `span#kobo\.1\.1`, span["startPath"])
+	assert.InDelta(t, 73.0, span["endChar"], 0.01)
+
+	assert.Equal(t, "note", annotations[1]["type"])
+	assert.Equal(t,
+		"Party slogan - compare with the ending.", annotations[1]["noteText"],
+	)
+	assert.Equal(t, "dogear", annotations[2]["type"])
+	assert.Nil(t, annotations[2]["highlightedText"])
+}
+
+func TestKoboAnnotations_IfNoneMatchReturns304(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "kobo-annotations-etag-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+	patchKoboAnnotations(t, ts, rawToken, bookID,
+		loadKoboAnnotationFixture(t, "annotations_patch.json", map[string]string{}))
+
+	_, etag := getKoboAnnotations(t, ts, rawToken, bookID)
+
+	req := koboReq(t, http.MethodGet, koboAnnotationsURL(ts, rawToken, bookID), nil)
+	req.Header.Set("If-None-Match", etag)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+}
+
+func TestKoboAnnotations_PatchUpdatesAndDeletes(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "kobo-annotations-delete-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+
+	ids := map[string]string{}
+	patchKoboAnnotations(t, ts, rawToken, bookID,
+		loadKoboAnnotationFixture(t, "annotations_patch.json", ids))
+	_, etagBefore := getKoboAnnotations(t, ts, rawToken, bookID)
+
+	patchKoboAnnotations(t, ts, rawToken, bookID,
+		loadKoboAnnotationFixture(t, "annotations_patch_delete.json", ids))
+
+	annotations, etagAfter := getKoboAnnotations(t, ts, rawToken, bookID)
+	require.Len(t, annotations, 2)
+	assert.NotEqual(t, etagBefore, etagAfter)
+	assert.Equal(t, ids[koboFixtureAnnotationIDs[1]], annotations[1]["id"])
+	assert.Equal(t, "Party slogan - echoes in Part Three.", annotations[1]["noteText"])
+}
+
+// TestAnnotationSync_RollsBackAFailedBatch checks a batch is applied as a
+// whole: when one upsert fails, the earlier upserts and the deletes are
+// undone too.
+func TestAnnotationSync_RollsBackAFailedBatch(t *testing.T) {
+	ctx := context.Background()
+	owner := "kobo-annotations-tx-" + uuid.NewString()
+	_, bookID := uploadFileForOwner(t, owner, models.FileFormatEPUB)
+
+	//nolint:exhaustruct //optional annotation fields
+	kept := models.Annotation{
+		ID:              uuid.New(),
+		Type:            models.AnnotationTypeHighlight,
+		HighlightedText: "before",
+	}
+	require.NoError(t, testApp.Services.Annotation.Sync(
+		ctx, owner, bookID, []models.Annotation{kept}, nil,
+	))
+
+	edited := kept
+	edited.HighlightedText = "after"
+	//nolint:exhaustruct //optional annotation fields
+	bad := models.Annotation{ID: uuid.New(), Type: "bogus"}
+	require.Error(t, testApp.Services.Annotation.Sync(
+		ctx, owner, bookID,
+		[]models.Annotation{edited, bad}, []uuid.UUID{kept.ID},
+	))
+
+	annotations, err := testApp.Services.Annotation.ListForBook(ctx, owner, bookID)
+	require.NoError(t, err)
+	require.Len(t, annotations, 1)
+	assert.Equal(t, "before", annotations[0].HighlightedText)
+}
+
+func TestKoboAnnotations_UnknownContentProxied(t *testing.T) {
+	var upstreamPath string
+	upstream := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			upstreamPath = r.URL.Path
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"annotations":[],"nextPageOffsetToken":null}`))
+		}),
+	)
+	t.Cleanup(upstream.Close)
+
+	ts := httptest.NewServer(getRoutesWithKoboUpstream(t, upstream.URL))
+	t.Cleanup(ts.Close)
+
+	rawToken := registerTestDevice(t, "kobo-annotations-proxy-"+uuid.NewString())
+	storeBookID := uuid.New()
+
+	resp, err := http.DefaultClient.Do(koboReq(
+		t, http.MethodGet, koboAnnotationsURL(ts, rawToken, storeBookID), nil,
+	))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t,
+		"/api/v3/content/"+storeBookID.String()+"/annotations", upstreamPath,
+	)
+}
+
+func TestKoboAnnotations_UserBCannotPatchUserABook(t *testing.T) {
+	upstream := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}),
+	)
+	t.Cleanup(upstream.Close)
+
+	ts := httptest.NewServer(getRoutesWithKoboUpstream(t, upstream.URL))
+	t.Cleanup(ts.Close)
+
+	ownerA := "kobo-annotations-usera-" + uuid.NewString()
+	ownerB := "kobo-annotations-userb-" + uuid.NewString()
+	rawTokenA, bookID := setupKoboSyncBook(t, ownerA)
+	rawTokenB := registerTestDevice(t, ownerB)
+
+	resp, err := http.DefaultClient.Do(koboReq(
+		t, http.MethodPatch, koboAnnotationsURL(ts, rawTokenB, bookID),
+		loadKoboAnnotationFixture(t, "annotations_patch.json", map[string]string{}),
+	))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	annotations, _ := getKoboAnnotations(t, ts, rawTokenA, bookID)
+	assert.Empty(t, annotations)
+}
+
+func TestKoboAnnotations_PatchBadJSON(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	rawToken, bookID := setupKoboSyncBook(t, "kobo-annotations-bad-"+uuid.NewString())
+
+	resp, err := http.DefaultClient.Do(koboReq(
+		t, http.MethodPatch, koboAnnotationsURL(ts, rawToken, bookID),
+		[]byte(`{"updatedAnnotations":[{"id":"not-a-uuid","type":"highlight"}]}`),
+	))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestKoboAnnotations_CheckForChanges(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "kobo-annotations-changes-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+	patchKoboAnnotations(t, ts, rawToken, bookID,
+		loadKoboAnnotationFixture(t, "annotations_patch.json", map[string]string{}))
+	_, etag := getKoboAnnotations(t, ts, rawToken, bookID)
+
+	check := func(seenETag string) []string {
+		body, err := json.Marshal([]map[string]string{
+			{"contentId": bookID.String(), "etag": seenETag},
+			{"contentId": uuid.NewString(), "etag": ""},
+		})
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(koboReq(t, http.MethodPost,
+			koboURL(ts, rawToken, "/api/v3/content/checkforchanges"), body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var changed []string
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&changed))
+		return changed
+	}
+
+	assert.Equal(t, []string{bookID.String()}, check(`"stale"`))
+	assert.Empty(t, check(etag))
+}
+
+// TestKoboAnnotations_CheckForChangesAsksUpstreamForStoreBooks checks entries
+// for books that aren't ours are answered by the upstream reading services,
+// and only those are sent there.
+func TestKoboAnnotations_CheckForChangesAsksUpstreamForStoreBooks(t *testing.T) {
+	storeBookID := uuid.NewString()
+	var forwarded []map[string]string
+	upstream := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v3/content/checkforchanges", r.URL.Path)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&forwarded))
+			// Compress like the real store does: the device's client asked
+			// for gzip, and forwarding that would leave us a body we can't
+			// decode.
+			w.Header().Set("Content-Encoding", "gzip")
+			w.WriteHeader(http.StatusOK)
+			gz := gzip.NewWriter(w)
+			_ = json.NewEncoder(gz).Encode([]string{storeBookID})
+			_ = gz.Close()
+		}),
+	)
+	t.Cleanup(upstream.Close)
+
+	ts := httptest.NewServer(getRoutesWithKoboUpstream(t, upstream.URL))
+	t.Cleanup(ts.Close)
+
+	rawToken, bookID := setupKoboSyncBook(
+		t, "kobo-annotations-upstream-"+uuid.NewString(),
+	)
+	body, err := json.Marshal([]map[string]string{
+		{"contentId": bookID.String(), "etag": `"stale"`},
+		{"contentId": storeBookID, "etag": `"store"`},
+	})
+	require.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(koboReq(t, http.MethodPost,
+		koboURL(ts, rawToken, "/api/v3/content/checkforchanges"), body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var changed []string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&changed))
+	assert.Equal(t, []string{bookID.String(), storeBookID}, changed)
+	assert.Equal(t,
+		[]map[string]string{{"contentId": storeBookID, "etag": `"store"`}},
+		forwarded,
+	)
+}
+
+func TestConnectAnnotations_ListSearchExport(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	book := addTestBook(t, "Nineteen Eighty-Four Annotated")
+	rawToken := registerTestDevice(t, userID)
+	patchKoboAnnotations(t, ts, rawToken, book.BookID,
+		loadKoboAnnotationFixture(t, "annotations_patch.json", map[string]string{}))
+
+	client := newBooksTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	listReq := connect.NewRequest(&booksv1.ListAnnotationsRequest{
+		BookId: book.BookID.String(),
+	})
+	listReq.Header().Set("Cookie", accessToken.String())
+	listResp, err := client.ListAnnotations(ctx, listReq)
+	require.NoError(t, err)
+	require.Len(t, listResp.Msg.Annotations, 3)
+	assert.Equal(t, "Chapter 1", listResp.Msg.Annotations[0].ChapterTitle)
+	assert.Equal(t, "kobo", listResp.Msg.Annotations[0].Source)
+	assert.InDelta(t, 0.41, listResp.Msg.Annotations[1].ChapterProgress, 0.001)
+
+	searchReq := connect.NewRequest(&booksv1.SearchAnnotationsRequest{
+		Query: "slogan ending",
+	})
+	searchReq.Header().Set("Cookie", accessToken.String())
+	searchResp, err := client.SearchAnnotations(ctx, searchReq)
+	require.NoError(t, err)
+	require.NotEmpty(t, searchResp.Msg.Annotations)
+	assert.Equal(t, "note", searchResp.Msg.Annotations[0].Type)
+	assert.Equal(t, book.BookID.String(), searchResp.Msg.Annotations[0].BookId)
+
+	exportReq := connect.NewRequest(&booksv1.ExportAnnotationsRequest{
+		BookId: book.BookID.String(),
+		Format: "markdown",
+	})
+	exportReq.Header().Set("Cookie", accessToken.String())
+	exportResp, err := client.ExportAnnotations(ctx, exportReq)
+	require.NoError(t, err)
+	assert.Equal(t, "nineteen-eighty-four-annotated.md", exportResp.Msg.Filename)
+	md := string(exportResp.Msg.Data)
+	assert.Contains(t, md, "# Nineteen Eighty-Four Annotated")
+	assert.Contains(t, md, "## Chapter 3")
+	assert.Contains(t, md, "> It was a bright cold day in April")
+}
+
+func TestConnectAnnotations_InvalidInput(t *testing.T) {
+	client := newBooksTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	listReq := connect.NewRequest(&booksv1.ListAnnotationsRequest{BookId: "nope"})
+	listReq.Header().Set("Cookie", accessToken.String())
+	_, err := client.ListAnnotations(ctx, listReq)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	exportReq := connect.NewRequest(&booksv1.ExportAnnotationsRequest{
+		Format: "pdf",
+	})
+	exportReq.Header().Set("Cookie", accessToken.String())
+	_, err = client.ExportAnnotations(ctx, exportReq)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
@@ -0,0 +1,235 @@
+package books_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+)
+
+// koboSyncEntitlements runs a library sync and returns the IDs of our books
+// entitled on the device and of those it was told to remove.
+func koboSyncEntitlements(
+	t *testing.T,
+	ts *httptest.Server,
+	rawToken string,
+) ([]string, []string) {
+	t.Helper()
+	resp, err := http.DefaultClient.Do(
+		koboReq(t, http.MethodGet, koboURL(ts, rawToken, "/v1/library/sync"), nil),
+	)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	type entitlement struct {
+		BookEntitlement struct {
+			ID        string `json:"Id"`
+			IsRemoved bool   `json:"IsRemoved"`
+		} `json:"BookEntitlement"`
+	}
+	var entries []struct {
+		NewEntitlement     *entitlement `json:"NewEntitlement"`
+		ChangedEntitlement *entitlement `json:"ChangedEntitlement"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&entries))
+
+	var active, removed []string
+	for _, e := range entries {
+		switch {
+		case e.NewEntitlement != nil:
+			active = append(active, e.NewEntitlement.BookEntitlement.ID)
+		case e.ChangedEntitlement != nil:
+			assert.True(t, e.ChangedEntitlement.BookEntitlement.IsRemoved)
+			removed = append(removed, e.ChangedEntitlement.BookEntitlement.ID)
+		}
+	}
+	return active, removed
+}
+
+// addKoboSyncBook adds another kobo-sync book with a ready KEPUB to owner's
+// library, without registering a device.
+func addKoboSyncBook(t *testing.T, owner string) uuid.UUID {
+	t.Helper()
+	ctx := context.Background()
+	_, bookID := uploadFileForOwner(t, owner, models.FileFormatEPUB)
+	_, err := testApp.Services.Conversion.EnsureKEPUB(ctx, owner, bookID)
+	require.NoError(t, err)
+	require.NoError(t, testApp.Services.Books.EnableKoboSync(ctx, owner, bookID))
+	return bookID
+}
+
+func koboDeviceIDForToken(t *testing.T, rawToken string) uuid.UUID {
+	t.Helper()
+	_, deviceID, err := testApp.Services.Kobo.GetKoboAuthByTokenHash(
+		context.Background(), tokenHash(rawToken),
+	)
+	require.NoError(t, err)
+	return uuid.MustParse(deviceID)
+}
+
+func TestKoboFilter_ShelfFilterArchivesOtherBooks(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+	ctx := context.Background()
+
+	owner := "kobo-filter-shelf-" + uuid.NewString()
+	rawToken, reading := setupKoboSyncBook(t, owner)
+	other := addKoboSyncBook(t, owner)
+	moveToShelf(t, owner, reading, models.StatusReading)
+
+	// Unfiltered, the device gets both books.
+	active, removed := koboSyncEntitlements(t, ts, rawToken)
+	assert.ElementsMatch(t, []string{reading.String(), other.String()}, active)
+	assert.Empty(t, removed)
+
+	_, err := testApp.Services.Kobo.SetKoboDeviceFilter(
+		ctx, owner, koboDeviceIDForToken(t, rawToken),
+		models.KoboSyncFilter{Shelves: []string{models.StatusReading}, Tags: nil},
+	)
+	require.NoError(t, err)
+
+	// The other book is archived, and stays archived on later syncs.
+	for range 2 {
+		active, removed = koboSyncEntitlements(t, ts, rawToken)
+		assert.Equal(t, []string{reading.String()}, active)
+		assert.Equal(t, []string{other.String()}, removed)
+	}
+}
+
+func TestKoboFilter_TagFilterAndOtherDevicesUnaffected(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+	ctx := context.Background()
+
+	owner := "kobo-filter-tag-" + uuid.NewString()
+	rawToken, tagged := setupKoboSyncBook(t, owner)
+	other := addKoboSyncBook(t, owner)
+	require.NoError(t, testApp.Repositories.Books.UpdateTags(
+		ctx, owner, tagged, []string{models.TagKoboSync, "commute"}, true,
+	))
+	otherDevice := registerTestDevice(t, owner)
+
+	_, err := testApp.Services.Kobo.SetKoboDeviceFilter(
+		ctx, owner, koboDeviceIDForToken(t, rawToken),
+		models.KoboSyncFilter{Shelves: nil, Tags: []string{" commute ", "commute"}},
+	)
+	require.NoError(t, err)
+
+	active, removed := koboSyncEntitlements(t, ts, rawToken)
+	assert.Equal(t, []string{tagged.String()}, active)
+	// Never sent to this device, so there is nothing to archive.
+	assert.Empty(t, removed)
+
+	active, removed = koboSyncEntitlements(t, ts, otherDevice)
+	assert.ElementsMatch(t, []string{tagged.String(), other.String()}, active)
+	assert.Empty(t, removed)
+}
+
+func TestKoboFilter_BookComesBackWhenFilterCleared(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+	ctx := context.Background()
+
+	owner := "kobo-filter-clear-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+	deviceID := koboDeviceIDForToken(t, rawToken)
+	koboSyncEntitlements(t, ts, rawToken)
+
+	_, err := testApp.Services.Kobo.SetKoboDeviceFilter(
+		ctx, owner, deviceID,
+		models.KoboSyncFilter{Shelves: []string{"Nothing Here"}, Tags: nil},
+	)
+	require.NoError(t, err)
+	active, removed := koboSyncEntitlements(t, ts, rawToken)
+	assert.Empty(t, active)
+	assert.Equal(t, []string{bookID.String()}, removed)
+
+	_, err = testApp.Services.Kobo.SetKoboDeviceFilter(
+		ctx, owner, deviceID, models.KoboSyncFilter{Shelves: nil, Tags: nil},
+	)
+	require.NoError(t, err)
+	active, removed = koboSyncEntitlements(t, ts, rawToken)
+	assert.Equal(t, []string{bookID.String()}, active)
+	assert.Empty(t, removed)
+}
+
+func TestConnectRegisterKoboDevice_WithFilter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req := connect.NewRequest(&booksv1.RegisterKoboDeviceRequest{
+		Name:        "Filtered Kobo",
+		SyncShelves: []string{models.StatusReading},
+		SyncTags:    []string{"commute"},
+	})
+	req.Header().Set("Cookie", accessToken.String())
+	resp, err := newKoboTestClient(t).RegisterKoboDevice(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{models.StatusReading}, resp.Msg.Device.SyncShelves)
+	assert.Equal(t, []string{"commute"}, resp.Msg.Device.SyncTags)
+}
+
+func TestConnectSetKoboDeviceFilter_ShowsInList(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newKoboTestClient(t)
+
+	regReq := connect.NewRequest(&booksv1.RegisterKoboDeviceRequest{Name: "Kobo F"})
+	regReq.Header().Set("Cookie", accessToken.String())
+	regResp, err := client.RegisterKoboDevice(ctx, regReq)
+	require.NoError(t, err)
+	deviceID := regResp.Msg.Device.Id
+	assert.Empty(t, regResp.Msg.Device.SyncShelves)
+
+	setReq := connect.NewRequest(&booksv1.SetKoboDeviceFilterRequest{
+		Id:          deviceID,
+		SyncShelves: []string{"Favourites", "", "Favourites"},
+	})
+	setReq.Header().Set("Cookie", accessToken.String())
+	setResp, err := client.SetKoboDeviceFilter(ctx, setReq)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Favourites"}, setResp.Msg.Device.SyncShelves)
+
+	listReq := connect.NewRequest(&booksv1.ListKoboDevicesRequest{})
+	listReq.Header().Set("Cookie", accessToken.String())
+	listResp, err := client.ListKoboDevices(ctx, listReq)
+	require.NoError(t, err)
+	var found *booksv1.KoboDevice
+	for _, d := range listResp.Msg.Devices {
+		if d.Id == deviceID {
+			found = d
+		}
+	}
+	require.NotNil(t, found)
+	assert.Equal(t, []string{"Favourites"}, found.SyncShelves)
+	assert.Empty(t, found.SyncTags)
+}
+
+func TestConnectSetKoboDeviceFilter_Errors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newKoboTestClient(t)
+
+	for id, code := range map[string]connect.Code{
+		"not-a-uuid":     connect.CodeInvalidArgument,
+		uuid.NewString(): connect.CodeNotFound,
+	} {
+		req := connect.NewRequest(&booksv1.SetKoboDeviceFilterRequest{Id: id})
+		req.Header().Set("Cookie", accessToken.String())
+		_, err := client.SetKoboDeviceFilter(ctx, req)
+		require.Error(t, err)
+		var connectErr *connect.Error
+		require.ErrorAs(t, err, &connectErr)
+		assert.Equal(t, code, connectErr.Code())
+	}
+}
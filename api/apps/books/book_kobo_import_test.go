@@ -0,0 +1,236 @@
+package books_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+type koboImportResult struct {
+	Books      int `json:"books"`
+	Matched    int `json:"matched"`
+	Created    int `json:"created"`
+	Skipped    int `json:"skipped"`
+	MarkedRead int `json:"markedRead"`
+	Highlights int `json:"highlights"`
+	Sessions   int `json:"sessions"`
+
+	SkippedHighlights int `json:"skippedHighlights"`
+}
+
+func postKoboImport(
+	t *testing.T,
+	ts *httptest.Server,
+	rawToken string,
+	payload any,
+) koboImportResult {
+	t.Helper()
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(koboReq(
+		t, http.MethodPost, koboURL(ts, rawToken, "/gateway/import"), body,
+	))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var result koboImportResult
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	return result
+}
+
+// koboImportPayload is what kobo-gateway uploads for one synced book (content
+// ID = our UUID) with a highlight, two analytics sessions and more device
+// reading time than those sessions cover, plus one sideloaded book we have
+// never seen that the device marks finished.
+func koboImportPayload(syncedBookID uuid.UUID, sideloadedTitle string) map[string]any {
+	return map[string]any{
+		"serial": "N418170000001",
+		"books": []map[string]any{
+			{
+				"contentId":               syncedBookID.String(),
+				"title":                   "Synced Book",
+				"authors":                 []string{"Test Author"},
+				"readStatus":              "reading",
+				"percentRead":             37,
+				"firstOpenedAt":           "2025-01-02T18:00:00Z",
+				"lastReadAt":              "2025-01-05T21:30:00Z",
+				"timeSpentReadingSeconds": 5400,
+				"highlights": []map[string]any{{
+					"id":              uuid.NewString(),
+					"type":            "highlight",
+					"text":            "A sentence worth keeping.",
+					"chapterTitle":    "Chapter 2",
+					"chapterFilename": "OEBPS/ch02.xhtml",
+					"chapterProgress": 0.25,
+					"startPath":       `span#kobo\.4\.1`,
+					"startOffset":     0,
+					"endPath":         `span#kobo\.4\.1`,
+					"endOffset":       25,
+					"createdAt":       "2025-01-04T20:01:00Z",
+				}},
+				"sessions": []map[string]any{
+					{
+						"startedAt":   "2025-01-04T20:00:00Z",
+						"endedAt":     "2025-01-04T20:30:00Z",
+						"seconds":     1800,
+						"pagesTurned": 22,
+					},
+					{
+						"startedAt": "2025-01-05T21:00:00Z",
+						"endedAt":   "2025-01-05T21:30:00Z",
+						"seconds":   1800,
+					},
+				},
+			},
+			{
+				"contentId":               "file:///mnt/onboard/Books/sideloaded.epub",
+				"title":                   sideloadedTitle,
+				"authors":                 []string{"Side Loader"},
+				"readStatus":              "finished",
+				"percentRead":             100,
+				"lastReadAt":              "2024-11-20T22:00:00Z",
+				"finishedAt":              "2024-11-20T22:00:00Z",
+				"timeSpentReadingSeconds": 0,
+			},
+			{
+				"contentId": "file:///mnt/onboard/Books/never-opened.epub",
+				"title":     "Never Opened " + uuid.NewString(),
+				"authors":   []string{"Nobody"},
+			},
+		},
+	}
+}
+
+func TestKoboImport_SyncedAndSideloadedBooks(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "kobo-import-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+	sideloaded := "Sideloaded Novel " + uuid.NewString()
+
+	result := postKoboImport(t, ts, rawToken, koboImportPayload(bookID, sideloaded))
+	assert.Equal(t, koboImportResult{
+		Books:      3,
+		Matched:    1,
+		Created:    1,
+		Skipped:    1,
+		MarkedRead: 1,
+		Highlights: 1,
+		Sessions:   2,
+	}, result)
+
+	ctx := context.Background()
+
+	annotations, err := testApp.Services.Annotation.ListForBook(ctx, owner, bookID)
+	require.NoError(t, err)
+	require.Len(t, annotations, 1)
+	assert.Equal(t, "A sentence worth keeping.", annotations[0].HighlightedText)
+	assert.Equal(t, "Chapter 2", annotations[0].ChapterTitle)
+
+	// Two real sessions plus one estimated row covering the remaining 30 min
+	// of device reading time.
+	sessions, err := testApp.Repositories.Sessions.ListByBook(ctx, owner, bookID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+	total := 0
+	estimated := 0
+	for _, s := range sessions {
+		total += s.Seconds
+		if s.Estimated {
+			estimated = s.Seconds
+		}
+	}
+	assert.Equal(t, 5400, total)
+	assert.Equal(t, 1800, estimated)
+
+	state, err := testApp.Services.Books.GetReadingState(ctx, owner, bookID)
+	require.NoError(t, err)
+	assert.Equal(t, 37, state.Percent)
+
+	lib, err := testApp.Services.Books.GetLibrary(ctx, owner)
+	require.NoError(t, err)
+	var created *models.UserBook
+	for i := range lib {
+		if lib[i].Book != nil && lib[i].Book.Title == sideloaded {
+			created = &lib[i]
+		}
+	}
+	require.NotNil(t, created)
+	assert.Equal(t, models.StatusRead, created.Status)
+	require.Len(t, created.FinishedAt, 1)
+	assert.Equal(t, "2024-11-20", created.FinishedAt[0].UTC().Format("2006-01-02"))
+}
+
+func TestKoboImport_ReimportIsIdempotent(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "kobo-import-idempotent-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+	payload := koboImportPayload(bookID, "Sideloaded Again "+uuid.NewString())
+
+	postKoboImport(t, ts, rawToken, payload)
+	second := postKoboImport(t, ts, rawToken, payload)
+
+	assert.Equal(t, 2, second.Matched)
+	assert.Equal(t, 0, second.Created)
+	assert.Equal(t, 0, second.Sessions)
+	assert.Equal(t, 0, second.MarkedRead)
+
+	sessions, err := testApp.Repositories.Sessions.ListByBook(
+		context.Background(), owner, bookID,
+	)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 3)
+}
+
+func TestKoboImport_InvalidToken_Returns401(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	resp, err := http.DefaultClient.Do(koboReq(
+		t, http.MethodPost, koboURL(ts, "not-a-token", "/gateway/import"),
+		[]byte(`{"books":[]}`),
+	))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestKoboImport_BadHighlightID_SkippedAndCounted(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "kobo-import-bad-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+	payload := koboImportPayload(bookID, "Sideloaded "+uuid.NewString())
+	books, ok := payload["books"].([]map[string]any)
+	require.True(t, ok)
+	highlights, ok := books[0]["highlights"].([]map[string]any)
+	require.True(t, ok)
+	books[0]["highlights"] = append(highlights,
+		map[string]any{"id": "nope", "text": "Firmware without UUIDs."})
+
+	result := postKoboImport(t, ts, rawToken, payload)
+	assert.Equal(t, 1, result.Highlights)
+	assert.Equal(t, 1, result.SkippedHighlights)
+	assert.Equal(t, 2, result.Sessions)
+
+	annotations, err := testApp.Services.Annotation.ListForBook(
+		context.Background(), owner, bookID,
+	)
+	require.NoError(t, err)
+	require.Len(t, annotations, 1)
+	assert.Equal(t, "A sentence worth keeping.", annotations[0].HighlightedText)
+}
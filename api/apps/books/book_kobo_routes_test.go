@@ -447,6 +447,79 @@ func TestKoboInit_ValidToken_ReturnsInitData(t *testing.T) {
 	assert.Contains(t, body, "Settings")
 }
 
+// TestKoboInit_PointsResourcesAtThisAPI checks the init handshake hands the
+// device the upstream resources with the reading services and store URLs
+// rewritten to this API, so annotation sync reaches our handlers.
+func TestKoboInit_PointsResourcesAtThisAPI(t *testing.T) {
+	var upstreamURL string
+	upstream := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/initialization", r.URL.Path)
+			w.Header().Set("x-kobo-apitoken", "e30=")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"Resources": map[string]any{
+					"reading_services_host":   upstreamURL,
+					"user_profile":            upstreamURL + "/v1/user/profile",
+					"image_host":              "https://cdn.kobo.com/book-images/",
+					"library_sync":            "https://elsewhere.example/v1/library/sync",
+					"kobo_audiobooks_enabled": "True",
+				},
+			})
+		}),
+	)
+	t.Cleanup(upstream.Close)
+	upstreamURL = upstream.URL
+
+	ts := httptest.NewServer(getRoutesWithKoboUpstream(t, upstream.URL))
+	t.Cleanup(ts.Close)
+
+	rawToken := registerTestDevice(t, "kobo-init-resources-"+uuid.NewString())
+	base := "https://" + ts.Listener.Addr().String() + "/books/kobo/" + rawToken
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		res, hdrs := koboInitResources(t, ts, method, rawToken)
+		assert.Equal(t, "e30=", hdrs.Get("x-kobo-apitoken"))
+		assert.Equal(t, base, res["reading_services_host"])
+		assert.Equal(t, base+"/v1/user/profile", res["user_profile"])
+		assert.Equal(t, base+"/v1/library/sync", res["library_sync"])
+		assert.Equal(t, base+"/v1/library/{Ids}/state", res["reading_state"])
+		assert.Equal(t, "https://cdn.kobo.com/book-images/", res["image_host"])
+		assert.Equal(t, "True", res["kobo_audiobooks_enabled"])
+	}
+}
+
+func TestKoboInit_UpstreamDown_StillPointsAtThisAPI(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	rawToken := registerTestDevice(t, "kobo-init-offline-"+uuid.NewString())
+	base := "https://" + ts.Listener.Addr().String() + "/books/kobo/" + rawToken
+
+	res, _ := koboInitResources(t, ts, http.MethodGet, rawToken)
+	assert.Equal(t, base, res["reading_services_host"])
+	assert.Equal(t, base+"/v1/library/sync", res["library_sync"])
+}
+
+func koboInitResources(
+	t *testing.T,
+	ts *httptest.Server,
+	method, rawToken string,
+) (map[string]any, http.Header) {
+	t.Helper()
+	resp, err := http.DefaultClient.Do(
+		koboReq(t, method, koboURL(ts, rawToken, "/v1/initialization"), nil),
+	)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Resources map[string]any `json:"Resources"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return body.Resources, resp.Header
+}
+
 // --- Library sync ---
 
 func TestKoboLibrarySync_EmptyLibrary(t *testing.T) {
@@ -0,0 +1,305 @@
+package books_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+type koboSyncedTag struct {
+	ID    string
+	Name  string
+	Items []string
+}
+
+// koboSyncTags runs a library sync and returns the live collections by
+// name and the IDs of deleted ones.
+func koboSyncTags(
+	t *testing.T,
+	ts *httptest.Server,
+	rawToken string,
+) (map[string]koboSyncedTag, []string) {
+	t.Helper()
+	resp, err := http.DefaultClient.Do(
+		koboReq(t, http.MethodGet, koboURL(ts, rawToken, "/v1/library/sync"), nil),
+	)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var entries []struct {
+		NewTag *struct {
+			Tag struct {
+				ID    string `json:"Id"`
+				Name  string `json:"Name"`
+				Type  string `json:"Type"`
+				Items []struct {
+					RevisionID string `json:"RevisionId"`
+					Type       string `json:"Type"`
+				} `json:"Items"`
+			} `json:"Tag"`
+		} `json:"NewTag"`
+		DeletedTag *struct {
+			Tag struct {
+				ID string `json:"Id"`
+			} `json:"Tag"`
+		} `json:"DeletedTag"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&entries))
+
+	live := map[string]koboSyncedTag{}
+	var deleted []string
+	for _, e := range entries {
+		switch {
+		case e.NewTag != nil:
+			tag := koboSyncedTag{ID: e.NewTag.Tag.ID, Name: e.NewTag.Tag.Name}
+			assert.Equal(t, "UserTag", e.NewTag.Tag.Type)
+			for _, item := range e.NewTag.Tag.Items {
+				assert.Equal(t, "ProductRevisionTag", item.Type)
+				tag.Items = append(tag.Items, item.RevisionID)
+			}
+			live[tag.Name] = tag
+		case e.DeletedTag != nil:
+			deleted = append(deleted, e.DeletedTag.Tag.ID)
+		}
+	}
+	return live, deleted
+}
+
+func koboTagCall(
+	t *testing.T,
+	ts *httptest.Server,
+	rawToken, method, path, body string,
+) *http.Response {
+	t.Helper()
+	resp, err := http.DefaultClient.Do(
+		koboReq(t, method, koboURL(ts, rawToken, path), []byte(body)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func moveToShelf(t *testing.T, owner string, bookID uuid.UUID, shelf string) {
+	t.Helper()
+	ctx := context.Background()
+	ub, err := testApp.Services.Books.GetUserBook(ctx, owner, bookID)
+	require.NoError(t, err)
+	ub.Status = shelf
+	require.NoError(t, testApp.Services.Books.UpdateStatus(ctx, owner, *ub))
+}
+
+func shelfOf(t *testing.T, owner string, bookID uuid.UUID) string {
+	t.Helper()
+	ub, err := testApp.Services.Books.GetUserBook(context.Background(), owner, bookID)
+	require.NoError(t, err)
+	return ub.Status
+}
+
+func TestKoboTags_SyncSendsCustomShelves(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "kobo-tags-sync-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+	moveToShelf(t, owner, bookID, "Favourites")
+	require.NoError(t, testApp.Services.Books.CreateShelf(
+		context.Background(), owner, "Empty Shelf",
+	))
+
+	tags, deleted := koboSyncTags(t, ts, rawToken)
+	assert.Empty(t, deleted)
+	require.Contains(t, tags, "Favourites")
+	assert.Equal(t, []string{bookID.String()}, tags["Favourites"].Items)
+	require.Contains(t, tags, "Empty Shelf")
+	assert.Empty(t, tags["Empty Shelf"].Items)
+	// Built-in statuses are not sent; the device has its own views for them.
+	assert.NotContains(t, tags, models.StatusToRead)
+
+	// IDs are stable across syncs.
+	again, _ := koboSyncTags(t, ts, rawToken)
+	assert.Equal(t, tags["Favourites"].ID, again["Favourites"].ID)
+}
+
+func TestKoboTags_WebRenameKeepsIDAndDeleteTombstones(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+	ctx := context.Background()
+
+	owner := "kobo-tags-web-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+	moveToShelf(t, owner, bookID, "Sci-Fi")
+	before, _ := koboSyncTags(t, ts, rawToken)
+	require.Contains(t, before, "Sci-Fi")
+
+	_, err := testApp.Services.Books.RenameShelf(
+		ctx, owner, "Sci-Fi", "Science Fiction",
+	)
+	require.NoError(t, err)
+	renamed, deleted := koboSyncTags(t, ts, rawToken)
+	assert.NotContains(t, renamed, "Sci-Fi")
+	require.Contains(t, renamed, "Science Fiction")
+	assert.Equal(t, before["Sci-Fi"].ID, renamed["Science Fiction"].ID)
+	assert.Empty(t, deleted)
+
+	_, err = testApp.Services.Books.DeleteShelf(
+		ctx, owner, "Science Fiction", models.StatusToRead,
+	)
+	require.NoError(t, err)
+	after, deleted := koboSyncTags(t, ts, rawToken)
+	assert.NotContains(t, after, "Science Fiction")
+	assert.Equal(t, []string{before["Sci-Fi"].ID}, deleted)
+}
+
+func TestKoboTags_DeviceCreateBecomesShelf(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "kobo-tags-create-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+
+	body := `{"Name":"Holiday","Items":[{"RevisionId":"` + bookID.String() +
+		`","Type":"ProductRevisionTag"},` +
+		`{"RevisionId":"store-book","Type":"ProductRevisionTag"}]}`
+	resp := koboTagCall(t, ts, rawToken, http.MethodPost, "/v1/library/tags", body)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var tagID string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&tagID))
+	_, err := uuid.Parse(tagID)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Holiday", shelfOf(t, owner, bookID))
+	shelves, err := testApp.Services.Books.ListShelves(context.Background(), owner)
+	require.NoError(t, err)
+	assert.Contains(t, shelves, "Holiday")
+
+	tags, _ := koboSyncTags(t, ts, rawToken)
+	require.Contains(t, tags, "Holiday")
+	assert.Equal(t, tagID, tags["Holiday"].ID)
+	assert.Equal(t, []string{bookID.String()}, tags["Holiday"].Items)
+
+	// Creating the same name again joins the existing collection.
+	resp = koboTagCall(t, ts, rawToken, http.MethodPost, "/v1/library/tags",
+		`{"Name":"Holiday","Items":[]}`)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var second string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&second))
+	assert.Equal(t, tagID, second)
+}
+
+func TestKoboTags_DeviceRenameAddRemoveDelete(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "kobo-tags-edit-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+	resp := koboTagCall(t, ts, rawToken, http.MethodPost, "/v1/library/tags",
+		`{"Name":"Loans","Items":[]}`)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var tagID string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&tagID))
+	tagPath := "/v1/library/tags/" + tagID
+	items := `{"Items":[{"RevisionId":"` + bookID.String() +
+		`","Type":"ProductRevisionTag"}]}`
+
+	resp = koboTagCall(t, ts, rawToken, http.MethodPost, tagPath+"/items", items)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "Loans", shelfOf(t, owner, bookID))
+
+	resp = koboTagCall(t, ts, rawToken, http.MethodPut, tagPath, `{"Name":"Borrowed"}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "Borrowed", shelfOf(t, owner, bookID))
+	tags, _ := koboSyncTags(t, ts, rawToken)
+	require.Contains(t, tags, "Borrowed")
+	assert.Equal(t, tagID, tags["Borrowed"].ID)
+
+	resp = koboTagCall(t, ts, rawToken, http.MethodPost, tagPath+"/items/delete", items)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, models.StatusToRead, shelfOf(t, owner, bookID))
+
+	resp = koboTagCall(t, ts, rawToken, http.MethodDelete, tagPath, "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	shelves, err := testApp.Services.Books.ListShelves(context.Background(), owner)
+	require.NoError(t, err)
+	assert.NotContains(t, shelves, "Borrowed")
+	_, deleted := koboSyncTags(t, ts, rawToken)
+	assert.Contains(t, deleted, tagID)
+}
+
+func TestKoboTags_BuiltInCollectionOnlyMovesBooks(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "kobo-tags-builtin-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+	body := `{"Name":"read","Items":[{"RevisionId":"` + bookID.String() +
+		`","Type":"ProductRevisionTag"}]}`
+	resp := koboTagCall(t, ts, rawToken, http.MethodPost, "/v1/library/tags", body)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var tagID string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&tagID))
+
+	ub, err := testApp.Services.Books.GetUserBook(context.Background(), owner, bookID)
+	require.NoError(t, err)
+	assert.Equal(t, models.StatusRead, ub.Status)
+	assert.Len(t, ub.FinishedAt, 1)
+
+	resp = koboTagCall(t, ts, rawToken, http.MethodPut,
+		"/v1/library/tags/"+tagID, `{"Name":"Done"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = koboTagCall(
+		t, ts, rawToken, http.MethodDelete, "/v1/library/tags/"+tagID, "",
+	)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, models.StatusRead, shelfOf(t, owner, bookID))
+}
+
+func TestKoboTags_UnknownTagIsProxied(t *testing.T) {
+	var proxied []string
+	upstream := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied = append(proxied, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+	t.Cleanup(upstream.Close)
+	ts := httptest.NewServer(getRoutesWithKoboUpstream(t, upstream.URL))
+	t.Cleanup(ts.Close)
+
+	rawToken := registerTestDevice(t, "kobo-tags-proxy-"+uuid.NewString())
+	storeTag := uuid.NewString()
+
+	koboTagCall(t, ts, rawToken, http.MethodPut,
+		"/v1/library/tags/"+storeTag, `{"Name":"Store"}`)
+	koboTagCall(t, ts, rawToken, http.MethodDelete, "/v1/library/tags/"+storeTag, "")
+	koboTagCall(t, ts, rawToken, http.MethodPost,
+		"/v1/library/tags/"+storeTag+"/items", `{"Items":[]}`)
+
+	assert.Equal(t, []string{
+		"PUT /v1/library/tags/" + storeTag,
+		"DELETE /v1/library/tags/" + storeTag,
+		"POST /v1/library/tags/" + storeTag + "/items",
+	}, proxied)
+}
+
+func TestKoboTags_InvalidItemType_Returns400(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	rawToken := registerTestDevice(t, "kobo-tags-bad-"+uuid.NewString())
+	resp := koboTagCall(t, ts, rawToken, http.MethodPost, "/v1/library/tags",
+		`{"Name":"X","Items":[{"RevisionId":"a","Type":"Unknown"}]}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = koboTagCall(t, ts, rawToken, http.MethodPost, "/v1/library/tags", `{}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
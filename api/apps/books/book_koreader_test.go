@@ -0,0 +1,289 @@
+package books_test
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // KOReader's digests are MD5
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/services"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+)
+
+// koreaderDocument computes KOReader's partial MD5 of a file: 1 KiB samples
+// at offset 0 and at 1024·4^i, the same way KOReader's util.partialMD5 does.
+func koreaderDocument(data []byte) string {
+	h := md5.New() //nolint:gosec // see import
+	for i := -1; i <= 10; i++ {
+		offset := 0
+		if i >= 0 {
+			offset = 1024 << (2 * i)
+		}
+		if offset >= len(data) {
+			break
+		}
+		h.Write(data[offset:min(offset+1024, len(data))])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type kosyncClient struct {
+	ts       *httptest.Server
+	username string
+	key      string
+}
+
+// newKOSyncClient registers KOReader credentials for owner and returns a
+// client that sends them the way KOReader does.
+func newKOSyncClient(t *testing.T, ts *httptest.Server, owner string) kosyncClient {
+	t.Helper()
+	device, password, err := testApp.Services.KOReader.RegisterDevice(
+		context.Background(), owner, "reader-"+owner,
+	)
+	require.NoError(t, err)
+	return kosyncClient{
+		ts:       ts,
+		username: device.Username,
+		key:      services.KOReaderKey(password),
+	}
+}
+
+func (c kosyncClient) do(
+	t *testing.T,
+	method, path string,
+	body any,
+) (*http.Response, map[string]any) {
+	t.Helper()
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		require.NoError(t, err)
+	}
+	req := koboReq(t, method, c.ts.URL+"/books/kosync"+path, payload)
+	req.Header.Set("Accept", "application/vnd.koreader.v1+json")
+	req.Header.Set("x-auth-user", c.username)
+	req.Header.Set("x-auth-key", c.key)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var decoded map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+	return resp, decoded
+}
+
+func TestKOSync_Auth(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	client := newKOSyncClient(t, ts, "kosync-auth-"+uuid.NewString())
+
+	resp, body := client.do(t, http.MethodGet, "/users/auth", nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "OK", body["authorized"])
+
+	for _, bad := range []kosyncClient{
+		{ts: ts, username: client.username, key: services.KOReaderKey("wrong")},
+		{ts: ts, username: "someone-else", key: client.key},
+		{ts: ts, username: "", key: ""},
+	} {
+		resp, body = bad.do(t, http.MethodGet, "/users/auth", nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		assert.InDelta(t, 2001, body["code"], 0)
+	}
+
+	// Registration happens in the web app, not from KOReader.
+	resp, body = client.do(t, http.MethodPost, "/users/create", map[string]string{
+		"username": "new", "password": "x",
+	})
+	assert.Equal(t, http.StatusPaymentRequired, resp.StatusCode)
+	assert.InDelta(t, 2005, body["code"], 0)
+}
+
+func TestKOSync_RequiresHTTPS(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	client := newKOSyncClient(t, ts, "kosync-https-"+uuid.NewString())
+	req := koboReq(t, http.MethodGet, ts.URL+"/books/kosync/users/auth", nil)
+	req.Header.Del("X-Forwarded-Proto")
+	req.Header.Set("x-auth-user", client.username)
+	req.Header.Set("x-auth-key", client.key)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestKOSync_PushMatchedDocumentUpdatesReadingState(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+	ctx := context.Background()
+
+	owner := "kosync-push-" + uuid.NewString()
+	file, bookID := uploadFileForOwner(t, owner, models.FileFormatEPUB)
+	data, ok := fakeStore.GetContent(file.StorageKey)
+	require.True(t, ok)
+	document := koreaderDocument(data)
+	client := newKOSyncClient(t, ts, owner)
+
+	xpointer := "/body/DocFragment[3]/body/p[12]/text().0"
+	resp, body := client.do(t, http.MethodPut, "/syncs/progress", map[string]any{
+		"document":   document,
+		"progress":   xpointer,
+		"percentage": 0.42,
+		"device":     "Kindle",
+		"device_id":  "ABC123",
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, document, body["document"])
+	assert.NotZero(t, body["timestamp"])
+
+	state, err := testApp.Services.Books.GetReadingState(ctx, owner, bookID)
+	require.NoError(t, err)
+	assert.Equal(t, models.ReadingSourceKOReader, state.Source)
+	assert.Equal(t, 42, state.Percent)
+	require.NotNil(t, state.Location)
+	assert.Equal(t, xpointer, *state.Location)
+
+	resp, body = client.do(t, http.MethodGet, "/syncs/progress/"+document, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, xpointer, body["progress"])
+	assert.InDelta(t, 0.42, body["percentage"], 1e-9)
+	assert.Equal(t, "Kindle", body["device"])
+	assert.Equal(t, "ABC123", body["device_id"])
+}
+
+func TestKOSync_UnknownDocumentRoundTrips(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	client := newKOSyncClient(t, ts, "kosync-unknown-"+uuid.NewString())
+
+	resp, body := client.do(t, http.MethodGet, "/syncs/progress/nothing-yet", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, body)
+
+	resp, _ = client.do(t, http.MethodPut, "/syncs/progress", map[string]any{
+		"document":   "0123456789abcdef0123456789abcdef",
+		"progress":   "17",
+		"percentage": 0.1,
+		"device":     "Kobo",
+		"device_id":  "K1",
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, body = client.do(
+		t, http.MethodGet, "/syncs/progress/0123456789abcdef0123456789abcdef", nil,
+	)
+	assert.Equal(t, "17", body["progress"])
+}
+
+func TestKOSync_PutValidation(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	client := newKOSyncClient(t, ts, "kosync-validate-"+uuid.NewString())
+
+	resp, body := client.do(t, http.MethodPut, "/syncs/progress", map[string]any{
+		"progress": "1", "percentage": 0.5,
+	})
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.InDelta(t, 2004, body["code"], 0)
+
+	resp, body = client.do(t, http.MethodPut, "/syncs/progress", map[string]any{
+		"document": "abc", "progress": "1", "percentage": 4.2,
+	})
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.InDelta(t, 2003, body["code"], 0)
+}
+
+func TestConnectKOReaderDevices(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newBooksTestClient(t)
+
+	regReq := connect.NewRequest(&booksv1.RegisterKOReaderDeviceRequest{
+		Username: " kindle ",
+	})
+	regReq.Header().Set("Cookie", accessToken.String())
+	regResp, err := client.RegisterKOReaderDevice(ctx, regReq)
+	require.NoError(t, err)
+	assert.Equal(t, "kindle", regResp.Msg.Device.Username)
+	assert.Len(t, regResp.Msg.Password, 16)
+	deviceID := regResp.Msg.Device.Id
+
+	userID, err := testApp.Services.KOReader.Authenticate(
+		ctx, "kindle", services.KOReaderKey(regResp.Msg.Password),
+	)
+	require.NoError(t, err)
+	assert.NotEmpty(t, userID)
+
+	listReq := connect.NewRequest(&booksv1.ListKOReaderDevicesRequest{})
+	listReq.Header().Set("Cookie", accessToken.String())
+	listResp, err := client.ListKOReaderDevices(ctx, listReq)
+	require.NoError(t, err)
+	var ids []string
+	for _, d := range listResp.Msg.Devices {
+		ids = append(ids, d.Id)
+	}
+	assert.Contains(t, ids, deviceID)
+
+	discReq := connect.NewRequest(&booksv1.DisconnectKOReaderDeviceRequest{
+		Id: deviceID,
+	})
+	discReq.Header().Set("Cookie", accessToken.String())
+	_, err = client.DisconnectKOReaderDevice(ctx, discReq)
+	require.NoError(t, err)
+
+	_, err = client.DisconnectKOReaderDevice(ctx, discReq)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestConnectKOReaderDevices_Errors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newBooksTestClient(t)
+
+	regReq := connect.NewRequest(&booksv1.RegisterKOReaderDeviceRequest{})
+	regReq.Header().Set("Cookie", accessToken.String())
+	_, err := client.RegisterKOReaderDevice(ctx, regReq)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	discReq := connect.NewRequest(&booksv1.DisconnectKOReaderDeviceRequest{Id: "x"})
+	discReq.Header().Set("Cookie", accessToken.String())
+	_, err = client.DisconnectKOReaderDevice(ctx, discReq)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestKOSync_RenderedCopyKeepsDigest(t *testing.T) {
+	ctx := context.Background()
+	owner := "kosync-render-" + uuid.NewString()
+	file, bookID := uploadFileForOwner(t, owner, models.FileFormatEPUB)
+	_, err := testApp.Services.Books.GetBookFile(ctx, owner, bookID, "")
+	require.NoError(t, err)
+
+	var renderedKey, digest *string
+	err = testDB.QueryRow(
+		ctx,
+		`SELECT rendered_storage_key, koreader_digest
+		 FROM books.book_files WHERE id = $1`,
+		file.ID,
+	).Scan(&renderedKey, &digest)
+	require.NoError(t, err)
+	require.NotNil(t, renderedKey, "EPUBs are served as a rendered copy")
+	rendered, ok := fakeStore.GetContent(*renderedKey)
+	require.True(t, ok)
+	require.NotNil(t, digest, "rendering must not clear the KOReader digest")
+	assert.Equal(t, koreaderDocument(rendered), *digest)
+}
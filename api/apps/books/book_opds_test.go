@@ -0,0 +1,325 @@
+package books_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+)
+
+type opdsTestFeed struct {
+	Links   []opdsTestLink `xml:"link"`
+	Entries []struct {
+		ID    string         `xml:"id"`
+		Title string         `xml:"title"`
+		Links []opdsTestLink `xml:"link"`
+	} `xml:"entry"`
+}
+
+type opdsTestLink struct {
+	Rel  string `xml:"rel,attr"`
+	Href string `xml:"href,attr"`
+	Type string `xml:"type,attr"`
+}
+
+// setupOPDSBook uploads an EPUB for owner, moves it to shelf and returns a
+// fresh OPDS token together with the book ID.
+func setupOPDSBook(t *testing.T, owner, shelf string) (string, uuid.UUID) {
+	t.Helper()
+	_, bookID := uploadFileForOwner(t, owner, models.FileFormatEPUB)
+	moveToShelf(t, owner, bookID, shelf)
+	_, rawToken, err := testApp.Services.OPDS.CreateOPDSToken(
+		context.Background(), owner, "Reader",
+	)
+	require.NoError(t, err)
+	return rawToken, bookID
+}
+
+// opdsPublicBase is the catalog URL the server advertises for rawToken: it
+// derives absolute links from the Host header and always uses https.
+func opdsPublicBase(ts *httptest.Server, rawToken string) string {
+	host := strings.TrimPrefix(ts.URL, "http://")
+	return "https://" + host + "/books/opds/" + rawToken
+}
+
+func opdsGet(t *testing.T, ts *httptest.Server, rawToken, path string) *http.Response {
+	t.Helper()
+	req := koboReq(t, http.MethodGet, ts.URL+"/books/opds/"+rawToken+path, nil)
+	client := &http.Client{ //nolint:exhaustruct //defaults
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func opdsGetFeed(
+	t *testing.T,
+	ts *httptest.Server,
+	rawToken, path string,
+) opdsTestFeed {
+	t.Helper()
+	resp := opdsGet(t, ts, rawToken, path)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "application/atom+xml")
+	var feed opdsTestFeed
+	require.NoError(t, xml.NewDecoder(resp.Body).Decode(&feed))
+	return feed
+}
+
+func TestOPDS_Auth(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "opds-auth-" + uuid.NewString()
+	rawToken, _ := setupOPDSBook(t, owner, models.StatusReading)
+
+	// Plain HTTP is refused even with a valid token.
+	req := koboReq(t, http.MethodGet, ts.URL+"/books/opds/"+rawToken+"/v1.2", nil)
+	req.Header.Del("X-Forwarded-Proto")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp = opdsGet(t, ts, "not-a-token", "/v1.2")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp = opdsGet(t, ts, rawToken, "/v1.2")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestOPDS_RevokedTokenIsRejected(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+	ctx := context.Background()
+
+	owner := "opds-revoke-" + uuid.NewString()
+	token, rawToken, err := testApp.Services.OPDS.CreateOPDSToken(ctx, owner, "Old")
+	require.NoError(t, err)
+	require.NoError(t, testApp.Services.OPDS.RevokeOPDSToken(
+		ctx, owner, uuid.MustParse(token.ID),
+	))
+
+	resp := opdsGet(t, ts, rawToken, "/v2")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestOPDS_RootNavigation(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "opds-root-" + uuid.NewString()
+	rawToken, _ := setupOPDSBook(t, owner, models.StatusReading)
+
+	feed := opdsGetFeed(t, ts, rawToken, "/v1.2")
+	var hrefs []string
+	for _, e := range feed.Entries {
+		require.NotEmpty(t, e.Links)
+		hrefs = append(hrefs, e.Links[0].Href)
+	}
+	base := opdsPublicBase(ts, rawToken) + "/v1.2"
+	assert.Equal(t, []string{
+		base + "/recent", base + "/statuses", base + "/shelves", base + "/tags",
+	}, hrefs)
+
+	var search string
+	for _, l := range feed.Links {
+		if l.Rel == "search" {
+			search = l.Href
+		}
+	}
+	assert.Equal(t, base+"/opensearch.xml", search)
+}
+
+func TestOPDS_ShelfFeedHasAcquisitionLinks(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "opds-shelf-" + uuid.NewString()
+	rawToken, bookID := setupOPDSBook(t, owner, "Sci Fi")
+
+	shelves := opdsGetFeed(t, ts, rawToken, "/v1.2/shelves")
+	require.Len(t, shelves.Entries, 1)
+	assert.Equal(t, "Sci Fi", shelves.Entries[0].Title)
+	assert.True(t, strings.HasSuffix(shelves.Entries[0].Links[0].Href, "/Sci%20Fi"))
+
+	feed := opdsGetFeed(t, ts, rawToken, "/v1.2/shelves/"+url.PathEscape("Sci Fi"))
+	require.Len(t, feed.Entries, 1)
+	entry := feed.Entries[0]
+	assert.Equal(t, "urn:uuid:"+bookID.String(), entry.ID)
+
+	var acquisition []opdsTestLink
+	for _, l := range entry.Links {
+		if l.Rel == "http://opds-spec.org/acquisition" {
+			acquisition = append(acquisition, l)
+		}
+	}
+	require.Len(t, acquisition, 1)
+	assert.Equal(t, "application/epub+zip", acquisition[0].Type)
+	assert.Equal(t,
+		opdsPublicBase(ts, rawToken)+"/download/"+bookID.String()+"/epub",
+		acquisition[0].Href,
+	)
+
+	// Other shelves of the same user don't list the book.
+	feed = opdsGetFeed(t, ts, rawToken, "/v1.2/shelves/"+models.StatusRead)
+	assert.Empty(t, feed.Entries)
+}
+
+func TestOPDS_V2Publications(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "opds-v2-" + uuid.NewString()
+	rawToken, bookID := setupOPDSBook(t, owner, models.StatusReading)
+
+	resp := opdsGet(t, ts, rawToken, "/v2/recent")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/opds+json", resp.Header.Get("Content-Type"))
+
+	var feed struct {
+		Publications []struct {
+			Metadata struct {
+				Identifier string `json:"identifier"`
+			} `json:"metadata"`
+			Links []struct {
+				Href string `json:"href"`
+				Rel  string `json:"rel"`
+			} `json:"links"`
+		} `json:"publications"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&feed))
+	require.Len(t, feed.Publications, 1)
+	pub := feed.Publications[0]
+	assert.Equal(t, "urn:uuid:"+bookID.String(), pub.Metadata.Identifier)
+	require.Len(t, pub.Links, 1)
+	assert.True(t, strings.HasSuffix(pub.Links[0].Href, "/"+bookID.String()+"/epub"))
+}
+
+func TestOPDS_Search(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "opds-search-" + uuid.NewString()
+	rawToken, bookID := setupOPDSBook(t, owner, models.StatusReading)
+	ub, err := testApp.Services.Books.GetUserBook(context.Background(), owner, bookID)
+	require.NoError(t, err)
+
+	feed := opdsGetFeed(
+		t, ts, rawToken, "/v1.2/search?q="+url.QueryEscape(ub.Book.Title),
+	)
+	require.Len(t, feed.Entries, 1)
+	assert.Equal(t, "urn:uuid:"+bookID.String(), feed.Entries[0].ID)
+
+	feed = opdsGetFeed(t, ts, rawToken, "/v1.2/search?q=no-such-book-anywhere")
+	assert.Empty(t, feed.Entries)
+
+	resp := opdsGet(t, ts, rawToken, "/v1.2/opensearch.xml")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var desc struct {
+		URL struct {
+			Template string `xml:"template,attr"`
+		} `xml:"Url"`
+	}
+	require.NoError(t, xml.NewDecoder(resp.Body).Decode(&desc))
+	assert.Equal(t,
+		opdsPublicBase(ts, rawToken)+"/v1.2/search?q={searchTerms}",
+		desc.URL.Template,
+	)
+}
+
+func TestOPDS_DownloadRedirectsToPresignedURL(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+
+	owner := "opds-download-" + uuid.NewString()
+	rawToken, bookID := setupOPDSBook(t, owner, models.StatusReading)
+
+	resp := opdsGet(t, ts, rawToken, "/download/"+bookID.String()+"/epub")
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.NotEmpty(t, resp.Header.Get("Location"))
+
+	resp = opdsGet(t, ts, rawToken, "/download/"+bookID.String()+"/pdf")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp = opdsGet(t, ts, rawToken, "/download/"+bookID.String()+"/exe")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// Another user's token can't download the book.
+	_, otherToken, err := testApp.Services.OPDS.CreateOPDSToken(
+		context.Background(), "opds-download-other-"+uuid.NewString(), "Other",
+	)
+	require.NoError(t, err)
+	resp = opdsGet(t, ts, otherToken, "/download/"+bookID.String()+"/epub")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestConnectOPDSTokens(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newBooksTestClient(t)
+
+	createReq := connect.NewRequest(&booksv1.CreateOPDSTokenRequest{Name: " KOReader "})
+	createReq.Header().Set("Cookie", accessToken.String())
+	createResp, err := client.CreateOPDSToken(ctx, createReq)
+	require.NoError(t, err)
+	assert.Equal(t, "KOReader", createResp.Msg.Token.Name)
+	assert.NotEmpty(t, createResp.Msg.RawToken)
+	assert.Empty(t, createResp.Msg.Token.LastUsedAt)
+	tokenID := createResp.Msg.Token.Id
+
+	listReq := connect.NewRequest(&booksv1.ListOPDSTokensRequest{})
+	listReq.Header().Set("Cookie", accessToken.String())
+	listResp, err := client.ListOPDSTokens(ctx, listReq)
+	require.NoError(t, err)
+	var ids []string
+	for _, tok := range listResp.Msg.Tokens {
+		ids = append(ids, tok.Id)
+	}
+	assert.Contains(t, ids, tokenID)
+
+	revokeReq := connect.NewRequest(&booksv1.RevokeOPDSTokenRequest{Id: tokenID})
+	revokeReq.Header().Set("Cookie", accessToken.String())
+	_, err = client.RevokeOPDSToken(ctx, revokeReq)
+	require.NoError(t, err)
+
+	_, err = client.RevokeOPDSToken(ctx, revokeReq)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestConnectOPDSTokens_Errors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newBooksTestClient(t)
+
+	createReq := connect.NewRequest(&booksv1.CreateOPDSTokenRequest{Name: "  "})
+	createReq.Header().Set("Cookie", accessToken.String())
+	_, err := client.CreateOPDSToken(ctx, createReq)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	revokeReq := connect.NewRequest(&booksv1.RevokeOPDSTokenRequest{Id: "nope"})
+	revokeReq.Header().Set("Cookie", accessToken.String())
+	_, err = client.RevokeOPDSToken(ctx, revokeReq)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = client.ListOPDSTokens(
+		ctx, connect.NewRequest(&booksv1.ListOPDSTokensRequest{}),
+	)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
@@ -0,0 +1,327 @@
+package books_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/services"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+	"tools.xdoubleu.com/internal/database"
+)
+
+// seedShelvedBook adds a fresh catalog book to owner's library on status.
+func seedShelvedBook(t *testing.T, owner, status string) uuid.UUID {
+	t.Helper()
+	book := addUniqueBook(t)
+	require.NoError(t, testApp.Repositories.Books.UpsertUserBook(
+		context.Background(),
+		models.UserBook{ //nolint:exhaustruct //optional fields
+			UserID:         owner,
+			BookID:         book.ID,
+			Status:         status,
+			Tags:           []string{},
+			ShelfPositions: map[string]int{},
+		},
+	))
+	return book.ID
+}
+
+// newQueueOwner returns a user of its own, so queue settings set by a test
+// can't change what other tests sync to Kobo or start reading.
+func newQueueOwner() string {
+	return "queue-user-" + uuid.NewString()
+}
+
+func setStatus(t *testing.T, owner string, bookID uuid.UUID, status string) {
+	t.Helper()
+	ub, err := testApp.Services.Books.GetUserBook(context.Background(), owner, bookID)
+	require.NoError(t, err)
+	ub.Status = status
+	require.NoError(t, testApp.Services.Books.UpdateStatus(
+		context.Background(), owner, *ub,
+	))
+}
+
+func TestReadingQueue_QueueReorderDequeue(t *testing.T) {
+	ctx := context.Background()
+	owner := newQueueOwner()
+	b1 := seedShelvedBook(t, owner, models.StatusToRead)
+	b2 := seedShelvedBook(t, owner, models.StatusToRead)
+	b3 := seedShelvedBook(t, owner, models.StatusToRead)
+	read := seedShelvedBook(t, owner, models.StatusRead)
+
+	for _, id := range []uuid.UUID{b1, b2, b3, b1} {
+		require.NoError(t, testApp.Services.Books.QueueBook(ctx, owner, id))
+	}
+	queued, err := testApp.Repositories.Queue.ListQueued(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{b1, b2, b3}, queued)
+
+	err = testApp.Services.Books.QueueBook(ctx, owner, read)
+	require.ErrorIs(t, err, database.ErrResourceNotFound)
+
+	// Listed books move to the front; the rest keep their order behind.
+	require.NoError(t, testApp.Services.Books.ReorderQueue(
+		ctx, owner, []uuid.UUID{b3, b1},
+	))
+	queued, err = testApp.Repositories.Queue.ListQueued(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{b3, b1, b2}, queued)
+
+	require.NoError(t, testApp.Services.Books.DequeueBook(ctx, owner, b1))
+	queued, err = testApp.Repositories.Queue.ListQueued(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{b3, b2}, queued)
+
+	// Leaving to-read takes a book out of the queue for good.
+	setStatus(t, owner, b3, models.StatusReading)
+	setStatus(t, owner, b3, models.StatusToRead)
+	queued, err = testApp.Repositories.Queue.ListQueued(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{b2}, queued)
+}
+
+func TestReadingQueue_AutoAdvance(t *testing.T) {
+	ctx := context.Background()
+	owner := newQueueOwner()
+	reading := seedShelvedBook(t, owner, models.StatusReading)
+	next := seedShelvedBook(t, owner, models.StatusToRead)
+	after := seedShelvedBook(t, owner, models.StatusToRead)
+	require.NoError(t, testApp.Services.Books.ReorderQueue(
+		ctx, owner, []uuid.UUID{next, after},
+	))
+
+	// Off by default.
+	setStatus(t, owner, reading, models.StatusRead)
+	ub, err := testApp.Services.Books.GetUserBook(ctx, owner, next)
+	require.NoError(t, err)
+	assert.Equal(t, models.StatusToRead, ub.Status)
+
+	require.NoError(t, testApp.Services.Books.UpdateQueueSettings(
+		ctx, owner, models.QueueSettings{AutoAdvance: true, KoboSyncCount: 1},
+	))
+
+	// Finishing a book that wasn't being read leaves the queue alone.
+	wishlist := seedShelvedBook(t, owner, models.StatusToRead)
+	setStatus(t, owner, wishlist, models.StatusRead)
+	ub, err = testApp.Services.Books.GetUserBook(ctx, owner, next)
+	require.NoError(t, err)
+	assert.Equal(t, models.StatusToRead, ub.Status)
+
+	reading = seedShelvedBook(t, owner, models.StatusReading)
+	setStatus(t, owner, reading, models.StatusRead)
+
+	ub, err = testApp.Services.Books.GetUserBook(ctx, owner, next)
+	require.NoError(t, err)
+	assert.Equal(t, models.StatusReading, ub.Status)
+	assert.Nil(t, ub.QueuePosition)
+	// It was on the Kobo through the queue and stays there.
+	assert.True(t, ub.HasTag(models.TagKoboSync))
+
+	queued, err := testApp.Repositories.Queue.ListQueued(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{after}, queued)
+}
+
+func TestReadingQueue_TopSyncsToKobo(t *testing.T) {
+	ctx := context.Background()
+	owner := newQueueOwner()
+	first := seedShelvedBook(t, owner, models.StatusToRead)
+	second := seedShelvedBook(t, owner, models.StatusToRead)
+	tagged := seedShelvedBook(t, owner, models.StatusReading)
+	for _, id := range []uuid.UUID{first, second, tagged} {
+		insertKEPUBRow(t, id, owner)
+	}
+	require.NoError(t, testApp.Services.Books.EnableKoboSync(ctx, owner, tagged))
+	require.NoError(t, testApp.Services.Books.ReorderQueue(
+		ctx, owner, []uuid.UUID{second, first},
+	))
+
+	books, err := testApp.Services.Books.ListKoboSyncBooks(ctx, owner)
+	require.NoError(t, err)
+	require.Len(t, books, 1)
+	assert.Equal(t, tagged, books[0].BookID)
+
+	require.NoError(t, testApp.Services.Books.UpdateQueueSettings(
+		ctx, owner, models.QueueSettings{AutoAdvance: false, KoboSyncCount: 1},
+	))
+
+	books, err = testApp.Services.Books.ListKoboSyncBooks(ctx, owner)
+	require.NoError(t, err)
+	require.Len(t, books, 2)
+	// Queued books sync ahead of the rest.
+	assert.Equal(t, second, books[0].BookID)
+	assert.Equal(t, tagged, books[1].BookID)
+
+	_, err = testApp.Services.Books.GetKoboSyncBook(ctx, owner, second)
+	require.NoError(t, err)
+	_, err = testApp.Services.Books.GetKoboSyncBook(ctx, owner, first)
+	require.ErrorIs(t, err, database.ErrResourceNotFound)
+}
+
+// koboRemovals returns the books tombstoned for removal from owner's Kobo.
+func koboRemovals(t *testing.T, owner string) []uuid.UUID {
+	t.Helper()
+	removals, err := testApp.Repositories.Books.ListKoboRemovals(
+		context.Background(), owner,
+	)
+	require.NoError(t, err)
+	ids := make([]uuid.UUID, 0, len(removals))
+	for _, r := range removals {
+		ids = append(ids, r.BookID)
+	}
+	return ids
+}
+
+func TestReadingQueue_ReorderOutOfKoboTombstones(t *testing.T) {
+	ctx := context.Background()
+	owner := newQueueOwner()
+	first := seedShelvedBook(t, owner, models.StatusToRead)
+	second := seedShelvedBook(t, owner, models.StatusToRead)
+	require.NoError(t, testApp.Services.Books.UpdateQueueSettings(
+		ctx, owner, models.QueueSettings{AutoAdvance: false, KoboSyncCount: 1},
+	))
+	require.NoError(t, testApp.Services.Books.ReorderQueue(
+		ctx, owner, []uuid.UUID{first, second},
+	))
+	assert.Empty(t, koboRemovals(t, owner))
+
+	// Reordered below the synced count: off the device.
+	require.NoError(t, testApp.Services.Books.ReorderQueue(
+		ctx, owner, []uuid.UUID{second, first},
+	))
+	assert.Equal(t, []uuid.UUID{first}, koboRemovals(t, owner))
+
+	// Dequeued: off the device, and the book moving up comes back.
+	require.NoError(t, testApp.Services.Books.DequeueBook(ctx, owner, second))
+	assert.Equal(t, []uuid.UUID{second}, koboRemovals(t, owner))
+
+	// Removed from the library while syncing through the queue.
+	require.NoError(t, testApp.Services.Books.RemoveFromLibrary(ctx, owner, first))
+	assert.ElementsMatch(t, []uuid.UUID{first, second}, koboRemovals(t, owner))
+
+	// Fewer books synced from the queue.
+	third := seedShelvedBook(t, owner, models.StatusToRead)
+	require.NoError(t, testApp.Services.Books.QueueBook(ctx, owner, third))
+	require.NoError(t, testApp.Services.Books.UpdateQueueSettings(
+		ctx, owner, models.QueueSettings{AutoAdvance: false, KoboSyncCount: 0},
+	))
+	assert.ElementsMatch(t, []uuid.UUID{first, second, third}, koboRemovals(t, owner))
+}
+
+func TestReadingQueue_ManualStartKeepsBookOnKobo(t *testing.T) {
+	ctx := context.Background()
+	owner := newQueueOwner()
+	first := seedShelvedBook(t, owner, models.StatusToRead)
+	second := seedShelvedBook(t, owner, models.StatusToRead)
+	require.NoError(t, testApp.Services.Books.UpdateQueueSettings(
+		ctx, owner, models.QueueSettings{AutoAdvance: false, KoboSyncCount: 1},
+	))
+	require.NoError(t, testApp.Services.Books.ReorderQueue(
+		ctx, owner, []uuid.UUID{first, second},
+	))
+
+	setStatus(t, owner, first, models.StatusReading)
+	ub, err := testApp.Services.Books.GetUserBook(ctx, owner, first)
+	require.NoError(t, err)
+	assert.True(t, ub.HasTag(models.TagKoboSync),
+		"a book started by hand stays on the device it synced to")
+	assert.Empty(t, koboRemovals(t, owner))
+
+	// Marked read straight from the queue: nothing keeps it on the device.
+	setStatus(t, owner, second, models.StatusRead)
+	assert.Equal(t, []uuid.UUID{second}, koboRemovals(t, owner))
+}
+
+func TestUpdateQueueSettings_RejectsOutOfRangeCount(t *testing.T) {
+	ctx := context.Background()
+	owner := newQueueOwner()
+	for _, count := range []int{-1, models.MaxQueueKoboSyncCount + 1} {
+		err := testApp.Services.Books.UpdateQueueSettings(
+			ctx, owner, models.QueueSettings{AutoAdvance: false, KoboSyncCount: count},
+		)
+		require.ErrorIs(t, err, services.ErrInvalidQueueSettings)
+	}
+
+	settings, err := testApp.Services.Books.GetQueueSettings(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, models.QueueSettings{AutoAdvance: false, KoboSyncCount: 0}, settings)
+}
+
+func TestConnectReadingQueue(t *testing.T) {
+	ctx := context.Background()
+	client := newBooksTestClient(t)
+	first := addTestBookNoISBN(t, "QueueFirst-"+uuid.NewString())
+	second := addTestBookNoISBN(t, "QueueSecond-"+uuid.NewString())
+
+	for _, ub := range []*models.UserBook{first, second} {
+		req := connect.NewRequest(&booksv1.QueueBookRequest{BookId: ub.BookID.String()})
+		req.Header().Set("Cookie", accessToken.String())
+		_, err := client.QueueBook(ctx, req)
+		require.NoError(t, err)
+	}
+	reorderReq := connect.NewRequest(&booksv1.ReorderQueueRequest{
+		BookIds: []string{second.BookID.String(), first.BookID.String()},
+	})
+	reorderReq.Header().Set("Cookie", accessToken.String())
+	_, err := client.ReorderQueue(ctx, reorderReq)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		for _, ub := range []*models.UserBook{first, second} {
+			_ = testApp.Services.Books.DequeueBook(ctx, userID, ub.BookID)
+		}
+	})
+
+	libReq := connect.NewRequest(&booksv1.GetLibraryRequest{})
+	libReq.Header().Set("Cookie", accessToken.String())
+	resp, err := client.GetLibrary(ctx, libReq)
+	require.NoError(t, err)
+	lib := resp.Msg.Library
+
+	upNext := make([]string, 0, len(lib.UpNext))
+	for _, ub := range lib.UpNext {
+		upNext = append(upNext, ub.BookId)
+	}
+	secondIdx := slices.Index(upNext, second.BookID.String())
+	firstIdx := slices.Index(upNext, first.BookID.String())
+	require.NotEqual(t, -1, secondIdx)
+	assert.Equal(t, secondIdx+1, firstIdx)
+	require.NotNil(t, lib.UpNext[secondIdx].QueuePosition)
+
+	// The wishlist lists the queue first.
+	require.GreaterOrEqual(t, len(lib.Wishlist), len(lib.UpNext))
+	for i, ub := range lib.UpNext {
+		assert.Equal(t, ub.Id, lib.Wishlist[i].Id)
+	}
+	require.NotNil(t, lib.QueueSettings)
+	assert.False(t, lib.QueueSettings.AutoAdvance)
+
+	finished := addTestBookNoISBN(t, "QueueFinished-"+uuid.NewString())
+	statusReq := connect.NewRequest(&booksv1.UpdateBookStatusRequest{
+		BookId: finished.BookID.String(), Status: models.StatusRead,
+	})
+	statusReq.Header().Set("Cookie", accessToken.String())
+	_, err = client.UpdateBookStatus(ctx, statusReq)
+	require.NoError(t, err)
+
+	queueReq := connect.NewRequest(&booksv1.QueueBookRequest{
+		BookId: finished.BookID.String(),
+	})
+	queueReq.Header().Set("Cookie", accessToken.String())
+	_, err = client.QueueBook(ctx, queueReq)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	settingsReq := connect.NewRequest(&booksv1.UpdateQueueSettingsRequest{
+		Settings: &booksv1.QueueSettings{KoboSyncCount: -1},
+	})
+	settingsReq.Header().Set("Cookie", accessToken.String())
+	_, err = client.UpdateQueueSettings(ctx, settingsReq)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
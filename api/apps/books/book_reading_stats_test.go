@@ -0,0 +1,200 @@
+package books_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+)
+
+func TestReadingSessions_FromProgressUpdates(t *testing.T) {
+	ctx := context.Background()
+	owner := "sessions-progress-" + uuid.NewString()
+	_, bookID := uploadFileForOwner(t, owner, models.FileFormatEPUB)
+
+	books := testApp.Services.Books
+	require.NoError(t, books.UpdateReadingProgress(
+		ctx, owner, bookID, models.ReadingSourceWeb, 10, nil,
+	))
+	require.NoError(t, books.UpdateReadingProgress(
+		ctx, owner, bookID, models.ReadingSourceWeb, 25, nil,
+	))
+	// Hand-entered progress isn't reading, so it doesn't start a session.
+	require.NoError(t, books.UpdateReadingProgress(
+		ctx, owner, bookID, models.ReadingSourceManual, 30, nil,
+	))
+
+	sessions, err := testApp.Repositories.Sessions.ListByBook(ctx, owner, bookID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	s := sessions[0]
+	assert.Equal(t, models.ReadingSourceWeb, s.Source)
+	require.NotNil(t, s.StartPercent)
+	require.NotNil(t, s.EndPercent)
+	assert.Equal(t, 10, *s.StartPercent)
+	assert.Equal(t, 25, *s.EndPercent)
+	assert.False(t, s.EndedAt.Before(s.StartedAt))
+
+	// A different reader gets its own session, starting where the book was.
+	require.NoError(t, books.UpdateReadingProgress(
+		ctx, owner, bookID, models.ReadingSourceKOReader, 40, nil,
+	))
+	sessions, err = testApp.Repositories.Sessions.ListByBook(ctx, owner, bookID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, models.ReadingSourceKOReader, sessions[1].Source)
+	assert.Equal(t, 30, *sessions[1].StartPercent)
+	assert.Equal(t, 40, *sessions[1].EndPercent)
+}
+
+func TestKoboAnalytics_RecordsLeaveContentAndProxies(t *testing.T) {
+	ctx := context.Background()
+	var upstreamBodies []string
+	upstream := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/analytics/event", r.URL.Path)
+			body, _ := io.ReadAll(r.Body)
+			upstreamBodies = append(upstreamBodies, string(body))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"Result":"Success"}`))
+		},
+	))
+	t.Cleanup(upstream.Close)
+	ts := httptest.NewServer(getRoutesWithKoboUpstream(t, upstream.URL))
+	t.Cleanup(ts.Close)
+
+	owner := "sessions-kobo-" + uuid.NewString()
+	rawToken, bookID := setupKoboSyncBook(t, owner)
+
+	ended := time.Date(2024, 5, 1, 21, 30, 0, 0, time.UTC)
+	payload, err := json.Marshal(map[string]any{
+		"Events": []map[string]any{
+			{
+				"EventType":  "LeaveContent",
+				"Timestamp":  ended.Format(time.RFC3339),
+				"Attributes": map[string]any{"volumeid": bookID.String()},
+				"Metrics":    map[string]any{"SecondsRead": 600, "PagesTurned": "12"},
+			},
+			{
+				// A store book: not ours, so only the store hears about it.
+				"EventType":  "LeaveContent",
+				"Timestamp":  ended.Format(time.RFC3339),
+				"Attributes": map[string]any{"volumeid": uuid.NewString()},
+				"Metrics":    map[string]any{"SecondsRead": 300},
+			},
+			{
+				"EventType":  "OpenContent",
+				"Timestamp":  ended.Format(time.RFC3339),
+				"Attributes": map[string]any{"volumeid": bookID.String()},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	url := ts.URL + "/books/kobo/" + rawToken + "/v1/analytics/event"
+	for range 2 {
+		req := koboReq(t, http.MethodPost, url, payload)
+		resp, doErr := http.DefaultClient.Do(req)
+		require.NoError(t, doErr)
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.JSONEq(t, `{"Result":"Success"}`, string(body))
+	}
+
+	require.Len(t, upstreamBodies, 2)
+	assert.JSONEq(t, string(payload), upstreamBodies[0])
+
+	// Re-sending the same upload doesn't duplicate the session.
+	sessions, err := testApp.Repositories.Sessions.ListByBook(ctx, owner, bookID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, models.ReadingSourceKobo, sessions[0].Source)
+	assert.Equal(t, 600, sessions[0].Seconds)
+	assert.Equal(t, 12, sessions[0].PagesTurned)
+	assert.True(t, ended.Equal(sessions[0].EndedAt))
+	assert.True(t, ended.Add(-10*time.Minute).Equal(sessions[0].StartedAt))
+}
+
+func TestConnectGetReadingStats(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newBooksTestClient(t)
+
+	_, bookID := uploadFileForOwner(t, userID, models.FileFormatEPUB)
+	require.NoError(t, testApp.Repositories.Books.UpdateLibraryProgress(
+		ctx, userID, bookID, 30,
+	))
+
+	started := time.Now().UTC().AddDate(0, 0, -2).Truncate(time.Hour)
+	start, end := 10, 30
+	_, err := testApp.Repositories.Sessions.Insert(ctx,
+		models.ReadingSession{ //nolint:exhaustruct //ID/CreatedAt set by DB
+			UserID:       userID,
+			BookID:       bookID,
+			Source:       models.ReadingSourceKOReader,
+			StartedAt:    started,
+			EndedAt:      started.Add(time.Hour),
+			Seconds:      3600,
+			StartPercent: &start,
+			EndPercent:   &end,
+		})
+	require.NoError(t, err)
+
+	req := connect.NewRequest(&booksv1.GetReadingStatsRequest{
+		DateStart: time.Now().UTC().AddDate(0, 0, -3).Format(models.ProgressDateFormat),
+		DateEnd:   time.Now().UTC().Format(models.ProgressDateFormat),
+	})
+	req.Header().Set("Cookie", accessToken.String())
+	resp, err := client.GetReadingStats(ctx, req)
+	require.NoError(t, err)
+
+	var day *booksv1.ReadingDay
+	for _, d := range resp.Msg.Days {
+		if d.BookId == bookID.String() {
+			day = d
+		}
+	}
+	require.NotNil(t, day)
+	assert.Equal(t, started.Format(models.ProgressDateFormat), day.Date)
+	assert.EqualValues(t, 3600, day.Seconds)
+	assert.EqualValues(t, 1, day.Sessions)
+
+	var stats *booksv1.BookReadingStats
+	for _, b := range resp.Msg.Books {
+		if b.BookId == bookID.String() {
+			stats = b
+		}
+	}
+	require.NotNil(t, stats)
+	assert.Equal(t, models.StatusReading, stats.Status)
+	assert.InDelta(t, 20, stats.PercentPerHour, 1e-9)
+	// 70% left at 20%/h is 3.5h; at an hour per fortnight that's 49 days.
+	assert.EqualValues(t, 12600, stats.RemainingSeconds)
+	assert.Equal(t,
+		time.Now().UTC().AddDate(0, 0, 49).Format(models.ProgressDateFormat),
+		stats.EstimatedFinish,
+	)
+}
+
+func TestConnectGetReadingStats_Unauthenticated(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newBooksTestClient(t)
+
+	_, err := client.GetReadingStats(
+		ctx, connect.NewRequest(&booksv1.GetReadingStatsRequest{}),
+	)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
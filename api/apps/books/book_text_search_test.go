@@ -0,0 +1,140 @@
+package books_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+	"tools.xdoubleu.com/internal/logging"
+)
+
+// buildTextEPUBBytes builds an EPUB with metadata plus one chapter document,
+// for tests that need text to index.
+func buildTextEPUBBytes(title, author, chapterHTML string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	writeZipEntry(zw, "META-INF/container.xml",
+		`<?xml version="1.0"?>`+
+			`<container xmlns="urn:oasis:names:tc:opendocument:xmlns:container"`+
+			` version="1.0"><rootfiles><rootfile full-path="OEBPS/content.opf"`+
+			` media-type="application/oebps-package+xml"/></rootfiles></container>`,
+	)
+	writeZipEntry(zw, "OEBPS/content.opf",
+		`<?xml version="1.0"?>`+
+			`<package xmlns="http://www.idpf.org/2007/opf"`+
+			` xmlns:dc="http://purl.org/dc/elements/1.1/" version="2.0">`+
+			`<metadata><dc:title>`+title+`</dc:title>`+
+			`<dc:creator>`+author+`</dc:creator></metadata>`+
+			`<manifest><item id="ch1" href="text/ch1.xhtml"`+
+			` media-type="application/xhtml+xml"/></manifest>`+
+			`<spine><itemref idref="ch1"/></spine></package>`,
+	)
+	writeZipEntry(zw, "OEBPS/text/ch1.xhtml", chapterHTML)
+	_ = zw.Close()
+	return buf.Bytes()
+}
+
+// uniqueWord returns a search term no other test's text contains.
+func uniqueWord(prefix string) string {
+	return prefix + strings.ReplaceAll(uuid.NewString(), "-", "")
+}
+
+// indexAllPendingText runs the text index until nothing is left to index.
+func indexAllPendingText(t *testing.T) {
+	t.Helper()
+	ctx := context.Background()
+	for range 100 {
+		require.NoError(t, testApp.Services.Text.IndexPending(ctx, logging.NewNopLogger()))
+		files, err := testApp.Repositories.Text.ListPendingFiles(ctx, 1)
+		require.NoError(t, err)
+		articles, err := testApp.Repositories.Text.ListPendingArticles(ctx, 1)
+		require.NoError(t, err)
+		if len(files) == 0 && len(articles) == 0 {
+			return
+		}
+	}
+	t.Fatal("text index did not drain")
+}
+
+func TestSearchBookText_UploadedEPUB(t *testing.T) {
+	owner := "text-search-epub-" + uuid.NewString()
+	word := uniqueWord("lighthouse")
+	title := "TextSearchBook-" + uuid.NewString()
+	seedBookInLibrary(t, owner, title, "Author", "")
+	result, err := uploadViaTestApp(t, owner, "book.epub", "application/epub+zip",
+		buildTextEPUBBytes(title, "Author",
+			`<html><body><h1>The Keeper</h1>`+
+				`<p>Every night the `+word+` turned its lamp toward the sea.</p>`+
+				`</body></html>`,
+		),
+	)
+	require.NoError(t, err)
+
+	indexAllPendingText(t)
+
+	hits, hasMore, err := testApp.Services.Text.Search(
+		context.Background(), owner, word, 10, 0,
+	)
+	require.NoError(t, err)
+	assert.False(t, hasMore)
+	require.Len(t, hits, 1)
+	hit := hits[0]
+	assert.Equal(t, result.UserBook.ID, hit.UserBookID)
+	assert.Equal(t, result.UserBook.BookID, hit.BookID)
+	assert.Equal(t, models.FileFormatEPUB, hit.Format)
+	assert.Equal(t, "text/ch1.xhtml", hit.Location)
+	assert.Equal(t, "The Keeper", hit.Chapter)
+	assert.Contains(t, hit.Snippet, "<mark>"+word+"</mark>")
+
+	// Another user's library doesn't include the file.
+	hits, _, err = testApp.Services.Text.Search(
+		context.Background(), "text-search-other-"+uuid.NewString(), word, 10, 0,
+	)
+	require.NoError(t, err)
+	assert.Empty(t, hits)
+}
+
+func TestSearchBookText_ArticleReindexedOnNewContent(t *testing.T) {
+	ctx := context.Background()
+	book := seedContentBookInLibrary(t, userID)
+	oldWord, newWord := uniqueWord("harbour"), uniqueWord("glacier")
+	require.NoError(t, testApp.Repositories.Books.SetBookContentHTML(
+		ctx, book.ID,
+		`<article><h2>Findings</h2><p>The `+oldWord+` was quiet.</p></article>`,
+	))
+	indexAllPendingText(t)
+
+	client := newBooksTestClient(t)
+	search := func(query string) *booksv1.SearchBookTextResponse {
+		req := connect.NewRequest(&booksv1.SearchBookTextRequest{Query: query})
+		req.Header().Set("Cookie", accessToken.String())
+		resp, searchErr := client.SearchBookText(ctx, req)
+		require.NoError(t, searchErr)
+		return resp.Msg
+	}
+
+	resp := search(oldWord)
+	require.Len(t, resp.Hits, 1)
+	assert.Equal(t, book.ID.String(), resp.Hits[0].BookId)
+	assert.Equal(t, models.TextHitFormatArticle, resp.Hits[0].Format)
+	assert.Empty(t, resp.Hits[0].Location)
+	assert.Equal(t, "Findings", resp.Hits[0].Chapter)
+
+	require.NoError(t, testApp.Repositories.Books.SetBookContentHTML(
+		ctx, book.ID, `<p>A `+newWord+` moved.</p>`,
+	))
+	indexAllPendingText(t)
+
+	assert.Empty(t, search(oldWord).Hits)
+	assert.Len(t, search(newWord).Hits, 1)
+	assert.Empty(t, search("   ").Hits)
+}
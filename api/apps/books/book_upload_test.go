@@ -5,6 +5,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"image"
+	"image/png"
 	"net/http/httptest"
 	"strings"
 	"testing"
@@ -68,6 +70,24 @@ func minimalPDFData() []byte {
 	return []byte("%PDF-1.4\n1 0 obj<</Type/Catalog>>endobj\n%%EOF")
 }
 
+// buildCBZBytes returns a one-page comic archive whose ComicInfo.xml carries
+// the given series, writer and GTIN.
+func buildCBZBytes(series, writer, gtin string) []byte {
+	var page bytes.Buffer
+	_ = png.Encode(&page, image.NewGray(image.Rect(0, 0, 8, 12)))
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	writeZipEntry(zw, "ComicInfo.xml", fmt.Sprintf(
+		`<ComicInfo><Series>%s</Series><Writer>%s</Writer>`+
+			`<GTIN>%s</GTIN></ComicInfo>`,
+		series, writer, gtin,
+	))
+	writeZipEntry(zw, "001.png", page.String())
+	_ = zw.Close()
+	return buf.Bytes()
+}
+
 // simulateUpload is the two-phase test helper:
 //  1. Call CreateUpload to get a presigned key (with empty checksum so no
 //     dedup shortcut is applied — ensures bytes always go through R2).
@@ -252,6 +272,49 @@ func TestUploadFile_EPUB_MatchByISBN(t *testing.T) {
 	assert.Equal(t, models.FileFormatEPUB, result.BookFile.Format)
 }
 
+func TestUploadFile_CBZ_DetectedFromContents(t *testing.T) {
+	fakeStore := fakeStore
+	const isbn = "9781607066019"
+	ub := addTestBookWithISBN(t, "CBZMatchBook", isbn)
+
+	// The name and content type say zip; the pages inside say comic.
+	data := buildCBZBytes("CBZMatchBook", "Comic Author", isbn)
+	result, err := simulateUpload(
+		context.Background(), t, userID,
+		"issue-1.zip", "application/zip", data, fakeStore,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.True(t, result.MatchedExisting)
+	assert.Equal(t, ub.BookID, result.UserBook.BookID)
+	assert.Equal(t, models.FileFormatCBZ, result.BookFile.Format)
+	assert.True(t, strings.HasSuffix(result.BookFile.StorageKey, ".cbz"))
+}
+
+func TestUploadFile_FB2_StoredAsEPUB(t *testing.T) {
+	fakeStore := fakeStore
+	const isbn = "9781613743416"
+	ub := addTestBookWithISBN(t, "FB2MatchBook", isbn)
+
+	data := []byte(`<?xml version="1.0" encoding="utf-8"?>` +
+		`<FictionBook><description><title-info>` +
+		`<author><first-name>Arkady</first-name>` +
+		`<last-name>Strugatsky</last-name></author>` +
+		`<book-title>FB2MatchBook</book-title></title-info>` +
+		`<publish-info><isbn>` + isbn + `</isbn></publish-info></description>` +
+		`<body><section><p>The Zone.</p></section></body></FictionBook>`)
+	result, err := simulateUpload(
+		context.Background(), t, userID,
+		"picnic.fb2", "application/x-fictionbook+xml", data, fakeStore,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.True(t, result.MatchedExisting)
+	assert.Equal(t, ub.BookID, result.UserBook.BookID)
+	assert.Equal(t, models.FileFormatEPUB, result.BookFile.Format)
+	assert.True(t, strings.HasSuffix(result.BookFile.StorageKey, ".epub"))
+}
+
 func TestUploadFile_EPUB_MatchByTitleAndAuthor(t *testing.T) {
 	fakeStore := fakeStore
 	const title = "TitleAuthorMatchUpload"
@@ -329,8 +392,8 @@ func TestUploadFile_CanonicalStorageKey(t *testing.T) {
 	require.NotNil(t, result)
 	assert.True(
 		t,
-		strings.HasPrefix(result.BookFile.StorageKey, "books/"),
-		"storage key must use canonical books/ prefix, got %s",
+		strings.HasPrefix(result.BookFile.StorageKey, "blobs/"),
+		"storage key must use canonical blobs/ prefix, got %s",
 		result.BookFile.StorageKey,
 	)
 	assert.NotContains(t, result.BookFile.StorageKey, "uploads/")
@@ -400,7 +463,7 @@ func TestUploadFile_GlobalDedup_CrossUser(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, r1)
 	canonicalKey := r1.BookFile.StorageKey
-	require.True(t, strings.HasPrefix(canonicalKey, "books/"),
+	require.True(t, strings.HasPrefix(canonicalKey, "blobs/"),
 		"user A's blob must be at canonical key")
 
 	// User B finalizes with the same checksum, no upload.
@@ -578,12 +641,13 @@ func TestUploadFile_EPUB_ExternalSearchFallback(t *testing.T) {
 		testCfg,
 		testDB,
 		books.Clients{
-			UniCat:           nil,
-			WebFetch:         nil,
-			Hardcover:        mocks.NewMockHardcoverClient(),
-			ObjectStore:      fakeStore,
-			KoboStoreBaseURL: "",
-			PublicAPIBaseURL: "",
+			UniCat:                 nil,
+			WebFetch:               nil,
+			Hardcover:              mocks.NewMockHardcoverClient(),
+			ObjectStore:            fakeStore,
+			KoboStoreBaseURL:       "",
+			KoboReadingServicesURL: "",
+			PublicAPIBaseURL:       "",
 		},
 	)
 	t.Cleanup(func() {
@@ -704,8 +768,8 @@ func TestUploadFile_OwnershipFromContext(t *testing.T) {
 	// a per-user prefix — that is expected and correct.
 	assert.True(
 		t,
-		strings.HasPrefix(result.BookFile.StorageKey, "books/"),
-		"canonical key must start with books/, got %s",
+		strings.HasPrefix(result.BookFile.StorageKey, "blobs/"),
+		"canonical key must start with blobs/, got %s",
 		result.BookFile.StorageKey,
 	)
 }
@@ -927,12 +991,13 @@ func noExternalMatchApp(t *testing.T, isolatedUser string) *books.Books {
 		testCfg,
 		testDB,
 		books.Clients{
-			UniCat:           nil,
-			WebFetch:         nil,
-			Hardcover:        mocks.NewMockEmptyHardcoverClient(),
-			ObjectStore:      fakeStore,
-			KoboStoreBaseURL: "",
-			PublicAPIBaseURL: "",
+			UniCat:                 nil,
+			WebFetch:               nil,
+			Hardcover:              mocks.NewMockEmptyHardcoverClient(),
+			ObjectStore:            fakeStore,
+			KoboStoreBaseURL:       "",
+			KoboReadingServicesURL: "",
+			PublicAPIBaseURL:       "",
 		},
 	)
 }
@@ -17,6 +17,10 @@ type Clients struct {
 	// KoboStoreBaseURL is the upstream Kobo store base URL used for proxy/merge.
 	// Override in tests to point at a stub upstream.
 	KoboStoreBaseURL string
+	// KoboReadingServicesURL is the upstream Kobo reading services base URL
+	// (annotations and other /api/… paths). Devices are pointed at this API
+	// for it at initialization, so whatever we don't answer is proxied here.
+	KoboReadingServicesURL string
 	// PublicAPIBaseURL is the externally reachable base URL of this API server
 	// (e.g. "https://tools.xdoubleu.com/api"). It is prepended to the library
 	// path when building Kobo file download URLs so the device can reach the
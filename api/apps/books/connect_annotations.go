@@ -0,0 +1,140 @@
+package books
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/services"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+	"tools.xdoubleu.com/internal/constants"
+	"tools.xdoubleu.com/internal/contexttools"
+	sharedmodels "tools.xdoubleu.com/internal/models"
+)
+
+func (h *booksConnectHandler) ListAnnotations(
+	ctx context.Context,
+	req *connect.Request[booksv1.ListAnnotationsRequest],
+) (*connect.Response[booksv1.ListAnnotationsResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	bookID, err := uuid.Parse(req.Msg.BookId)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("invalid book ID"),
+		)
+	}
+	annotations, err := h.app.Services.Annotation.ListForBook(ctx, user.ID, bookID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.ListAnnotationsResponse{
+		Annotations: protoAnnotations(annotations),
+	}), nil
+}
+
+func (h *booksConnectHandler) SearchAnnotations(
+	ctx context.Context,
+	req *connect.Request[booksv1.SearchAnnotationsRequest],
+) (*connect.Response[booksv1.SearchAnnotationsResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	if req.Msg.Query == "" {
+		return connect.NewResponse(&booksv1.SearchAnnotationsResponse{
+			Annotations: []*booksv1.Annotation{},
+		}), nil
+	}
+	annotations, hasMore, err := h.app.Services.Annotation.Search(
+		ctx,
+		user.ID,
+		req.Msg.Query,
+		req.Msg.Limit,
+		req.Msg.Offset,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.SearchAnnotationsResponse{
+		Annotations: protoAnnotations(annotations),
+		HasMore:     hasMore,
+	}), nil
+}
+
+func (h *booksConnectHandler) ExportAnnotations(
+	ctx context.Context,
+	req *connect.Request[booksv1.ExportAnnotationsRequest],
+) (*connect.Response[booksv1.ExportAnnotationsResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	var bookID *uuid.UUID
+	if req.Msg.BookId != "" {
+		id, err := uuid.Parse(req.Msg.BookId)
+		if err != nil {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New("invalid book ID"),
+			)
+		}
+		bookID = &id
+	}
+	switch req.Msg.Format {
+	case "", services.AnnotationExportMarkdown, services.AnnotationExportJSON:
+	default:
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("invalid export format"),
+		)
+	}
+	export, err := h.app.Services.Annotation.Export(
+		ctx, user.ID, bookID, req.Msg.Format,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.ExportAnnotationsResponse{
+		Data:        export.Data,
+		ContentType: export.ContentType,
+		Filename:    export.Filename,
+	}), nil
+}
+
+func protoAnnotations(annotations []models.Annotation) []*booksv1.Annotation {
+	out := make([]*booksv1.Annotation, len(annotations))
+	for i, a := range annotations {
+		out[i] = &booksv1.Annotation{
+			Id:              a.ID.String(),
+			BookId:          a.BookID.String(),
+			BookTitle:       a.BookTitle,
+			Type:            a.Type,
+			HighlightedText: a.HighlightedText,
+			NoteText:        a.NoteText,
+			Color:           a.Color,
+			ChapterTitle:    a.ChapterTitle,
+			ChapterProgress: a.ChapterProgress,
+			Source:          a.Source,
+			CreatedAt:       a.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:       a.UpdatedAt.Format(time.RFC3339),
+		}
+	}
+	return out
+}
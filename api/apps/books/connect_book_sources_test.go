@@ -40,12 +40,13 @@ func newAdminBooksTestClientWithMockSources(
 		testCfg,
 		testDB,
 		books.Clients{
-			UniCat:           mocks.NewMockEmptyUniCatClient(),
-			WebFetch:         nil,
-			Hardcover:        mocks.NewMockHardcoverClient(),
-			ObjectStore:      objectstore.NewFake(),
-			PublicAPIBaseURL: "",
-			KoboStoreBaseURL: "",
+			UniCat:                 mocks.NewMockEmptyUniCatClient(),
+			WebFetch:               nil,
+			Hardcover:              mocks.NewMockHardcoverClient(),
+			ObjectStore:            objectstore.NewFake(),
+			PublicAPIBaseURL:       "",
+			KoboStoreBaseURL:       "",
+			KoboReadingServicesURL: "",
 		},
 	)
 	ts := httptest.NewServer(testhelper.BuildMux(adminApp))
@@ -67,12 +68,13 @@ func newAdminBooksTestClientWithTwoSources(
 		testCfg,
 		testDB,
 		books.Clients{
-			UniCat:           mocks.NewMockUniCatClient(),
-			WebFetch:         nil,
-			Hardcover:        mocks.NewMockHardcoverClient(),
-			ObjectStore:      objectstore.NewFake(),
-			PublicAPIBaseURL: "",
-			KoboStoreBaseURL: "",
+			UniCat:                 mocks.NewMockUniCatClient(),
+			WebFetch:               nil,
+			Hardcover:              mocks.NewMockHardcoverClient(),
+			ObjectStore:            objectstore.NewFake(),
+			PublicAPIBaseURL:       "",
+			KoboStoreBaseURL:       "",
+			KoboReadingServicesURL: "",
 		},
 	)
 	ts := httptest.NewServer(testhelper.BuildMux(adminApp))
@@ -0,0 +1,58 @@
+package books
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+	"tools.xdoubleu.com/internal/constants"
+	"tools.xdoubleu.com/internal/contexttools"
+	sharedmodels "tools.xdoubleu.com/internal/models"
+)
+
+func (h *booksConnectHandler) SearchBookText(
+	ctx context.Context,
+	req *connect.Request[booksv1.SearchBookTextRequest],
+) (*connect.Response[booksv1.SearchBookTextResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	hits, hasMore, err := h.app.Services.Text.Search(
+		ctx,
+		user.ID,
+		req.Msg.Query,
+		req.Msg.Limit,
+		req.Msg.Offset,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.SearchBookTextResponse{
+		Hits:    protoTextHits(hits),
+		HasMore: hasMore,
+	}), nil
+}
+
+func protoTextHits(hits []models.TextHit) []*booksv1.TextHit {
+	out := make([]*booksv1.TextHit, len(hits))
+	for i, hit := range hits {
+		out[i] = &booksv1.TextHit{
+			UserBookId: hit.UserBookID.String(),
+			BookId:     hit.BookID.String(),
+			Title:      hit.Title,
+			Authors:    hit.Authors,
+			Chapter:    hit.Chapter,
+			Location:   hit.Location,
+			Format:     hit.Format,
+			Snippet:    hit.Snippet,
+		}
+	}
+	return out
+}
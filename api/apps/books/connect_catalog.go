@@ -1,10 +1,10 @@
 package books
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
@@ -30,16 +30,81 @@ func (h *booksConnectHandler) ImportBooks(
 		)
 	}
 	importCtx := context.WithoutCancel(ctx)
-	reader := bytes.NewReader(req.Msg.CsvData)
-	count, err := h.app.Services.Books.ImportFromCSV(importCtx, user.ID, reader)
+	result, err := h.app.Services.Books.ImportLibrary(
+		importCtx, user.ID, req.Msg.Data, req.Msg.Format, req.Msg.DryRun,
+	)
+	if errors.Is(err, services.ErrInvalidImport) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+
+	resp := &booksv1.ImportBooksResponse{
+		ImportedCount: 0,
+		Format:        result.Format,
+		CreatedCount:  int32FromInt(result.Created),
+		MergedCount:   int32FromInt(result.Merged),
+		SkippedCount:  int32FromInt(result.Skipped),
+		Items:         importPlanItemProtos(result.Items),
+	}
+	if req.Msg.DryRun {
+		return connect.NewResponse(resp), nil
+	}
 	if err = h.app.rebuildReadProgress(importCtx, user.ID); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	return connect.NewResponse(&booksv1.ImportBooksResponse{
-		ImportedCount: int32(count), //nolint:gosec // int32 safe for domain values
+	resp.ImportedCount = int32FromInt(result.Created + result.Merged)
+	return connect.NewResponse(resp), nil
+}
+
+func importPlanItemProtos(items []services.ImportPlanItem) []*booksv1.ImportPlanItem {
+	out := make([]*booksv1.ImportPlanItem, 0, len(items))
+	for _, item := range items {
+		existingID := ""
+		if item.Existing != nil {
+			existingID = item.Existing.BookID.String()
+		}
+		out = append(out, &booksv1.ImportPlanItem{
+			Title:          item.Entry.Book.Title,
+			Authors:        item.Entry.Book.Authors,
+			Action:         item.Action,
+			Reason:         item.Reason,
+			ExistingBookId: existingID,
+		})
+	}
+	return out
+}
+
+func (h *booksConnectHandler) ExportLibrary(
+	ctx context.Context,
+	req *connect.Request[booksv1.ExportLibraryRequest],
+) (*connect.Response[booksv1.ExportLibraryResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	switch req.Msg.Format {
+	case "", services.LibraryExportCSV, services.LibraryExportJSON:
+	default:
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("invalid export format"),
+		)
+	}
+	export, err := h.app.Services.Export.ExportLibrary(
+		ctx, user.ID, req.Msg.Format, time.Now(),
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.ExportLibraryResponse{
+		Data:        export.Data,
+		ContentType: export.ContentType,
+		Filename:    export.Filename,
 	}), nil
 }
 
@@ -42,15 +42,18 @@ func normalizeAndValidateISBN13(raw string) (string, error) {
 // protoSourceProposal maps a services.SourceProposal to its proto view.
 func protoSourceProposal(p services.SourceProposal) *booksv1.SourceBook {
 	return &booksv1.SourceBook{
-		Source:      p.Source,
-		CoverUrl:    p.CoverURL,
-		Description: p.Description,
-		PageCount:   int32FromInt(p.PageCount),
-		Isbn13:      p.ISBN13,
-		Title:       p.Title,
-		Authors:     p.Authors,
-		Differs:     p.Differs,
-		Index:       int32FromInt(p.Index),
+		Source:         p.Source,
+		CoverUrl:       p.CoverURL,
+		Description:    p.Description,
+		PageCount:      int32FromInt(p.PageCount),
+		Isbn13:         p.ISBN13,
+		Title:          p.Title,
+		Authors:        p.Authors,
+		Differs:        p.Differs,
+		Index:          int32FromInt(p.Index),
+		Series:         p.Series,
+		SeriesPosition: p.SeriesPosition,
+		SeriesTotal:    int32FromInt(p.SeriesTotal),
 	}
 }
 
@@ -101,6 +104,14 @@ func protoBookToModel(pb *booksv1.Book) *models.Book {
 		pc := int(pb.PageCount)
 		m.PageCount = &pc
 	}
+	if pb.Series != "" {
+		m.Series = &pb.Series
+		m.SeriesPosition = pb.SeriesPosition
+		if pb.SeriesTotal != 0 {
+			st := int(pb.SeriesTotal)
+			m.SeriesTotal = &st
+		}
+	}
 
 	return m
 }
@@ -16,7 +16,7 @@ func TestConnectImportBooks(t *testing.T) {
 	defer cancel()
 
 	req := connect.NewRequest(&booksv1.ImportBooksRequest{
-		CsvData: []byte(goodreadsCSVForImport),
+		Data: []byte(goodreadsCSVForImport),
 	})
 	req.Header().Set("Cookie", accessToken.String())
 
@@ -53,7 +53,7 @@ func (h *booksConnectHandler) GetBookFile(
 
 // maybeStartKEPUBConversion checks whether a background KEPUB conversion should
 // be started for the given book and user. If no KEPUB row exists yet and a
-// convertible source (EPUB or PDF) is available it launches EnsureKEPUB in a
+// convertible source (EPUB, CBZ or PDF) is available it launches EnsureKEPUB in a
 // detached goroutine and returns models.FileStatusConverting; otherwise it
 // returns the current kepubStatus unchanged.
 //
@@ -68,7 +68,7 @@ func (h *booksConnectHandler) maybeStartKEPUBConversion(
 	whenKEPUBOnly bool,
 ) (string, error) {
 	kepubStatus := statusResult.KepubStatus
-	hasSource := statusResult.HasEPUB || statusResult.HasPDF
+	hasSource := statusResult.HasEPUB || statusResult.HasCBZ || statusResult.HasPDF
 	if kepubStatus != "" || !hasSource {
 		return kepubStatus, nil
 	}
@@ -150,6 +150,7 @@ func (h *booksConnectHandler) GetKEPUBStatus(
 	return connect.NewResponse(&booksv1.GetKEPUBStatusResponse{
 		HasEpub:     result.HasEPUB,
 		HasPdf:      result.HasPDF,
+		HasCbz:      result.HasCBZ,
 		KepubStatus: result.KepubStatus,
 	}), nil
 }
@@ -0,0 +1,141 @@
+package books
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/services"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+	"tools.xdoubleu.com/internal/constants"
+	"tools.xdoubleu.com/internal/contexttools"
+	"tools.xdoubleu.com/internal/database"
+	sharedmodels "tools.xdoubleu.com/internal/models"
+)
+
+func (h *booksConnectHandler) SetReadingGoal(
+	ctx context.Context,
+	req *connect.Request[booksv1.SetReadingGoalRequest],
+) (*connect.Response[booksv1.SetReadingGoalResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	g := req.Msg.GetGoal()
+	if g == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			services.ErrInvalidGoal,
+		)
+	}
+
+	goal, err := h.app.Services.Goals.SetGoal(
+		ctx,
+		models.ReadingGoal{ //nolint:exhaustruct //timestamps set by DB
+			UserID: user.ID,
+			Year:   int(g.Year),
+			Kind:   g.Kind,
+			Target: int(g.Target),
+			Public: g.Public,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidGoal) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.SetReadingGoalResponse{
+		Goal: readingGoalProto(goal),
+	}), nil
+}
+
+func (h *booksConnectHandler) DeleteReadingGoal(
+	ctx context.Context,
+	req *connect.Request[booksv1.DeleteReadingGoalRequest],
+) (*connect.Response[booksv1.DeleteReadingGoalResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	err := h.app.Services.Goals.DeleteGoal(
+		ctx, user.ID, int(req.Msg.Year), req.Msg.Kind,
+	)
+	if err != nil {
+		if errors.Is(err, database.ErrResourceNotFound) {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				errors.New("goal not found"),
+			)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.DeleteReadingGoalResponse{}), nil
+}
+
+func (h *booksConnectHandler) GetReadingGoals(
+	ctx context.Context,
+	req *connect.Request[booksv1.GetReadingGoalsRequest],
+) (*connect.Response[booksv1.GetReadingGoalsResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	year := goalYear(req.Msg.Year)
+	progress, err := h.app.Services.Goals.GetProgress(
+		ctx, user.ID, year, false, time.Now(),
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.GetReadingGoalsResponse{
+		Year:  int32FromInt(year),
+		Goals: readingGoalProgressProtos(progress),
+	}), nil
+}
+
+// readingGoalProgressProtos converts goal progress for the wire.
+func readingGoalProgressProtos(
+	progress []models.ReadingGoalProgress,
+) []*booksv1.ReadingGoalProgress {
+	out := make([]*booksv1.ReadingGoalProgress, 0, len(progress))
+	for _, p := range progress {
+		out = append(out, &booksv1.ReadingGoalProgress{
+			Goal:      readingGoalProto(p.Goal),
+			Current:   int32FromInt(p.Current),
+			Expected:  p.Expected,
+			Projected: p.Projected,
+			Pace:      p.Pace,
+		})
+	}
+	return out
+}
+
+// goalYear defaults an unset year to the current one.
+func goalYear(year int32) int {
+	if year <= 0 {
+		return time.Now().Year()
+	}
+	return int(year)
+}
+
+func readingGoalProto(g models.ReadingGoal) *booksv1.ReadingGoal {
+	return &booksv1.ReadingGoal{
+		Year:   int32FromInt(g.Year),
+		Kind:   g.Kind,
+		Target: int32FromInt(g.Target),
+		Public: g.Public,
+	}
+}
@@ -69,6 +69,19 @@ func (h *booksConnectHandler) RegisterKoboDevice(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	filter := models.KoboSyncFilter{
+		Shelves: req.Msg.SyncShelves,
+		Tags:    req.Msg.SyncTags,
+	}
+	if !filter.IsEmpty() {
+		deviceID, _ := uuid.Parse(device.ID) // fresh from a UUID column
+		device, err = h.app.Services.Kobo.SetKoboDeviceFilter(
+			ctx, user.ID, deviceID, filter,
+		)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
 	return connect.NewResponse(&booksv1.RegisterKoboDeviceResponse{
 		Device:   koboDeviceProto(device),
 		RawToken: rawToken,
@@ -132,6 +145,45 @@ func (h *booksConnectHandler) DisconnectKoboDevice(
 	return connect.NewResponse(&booksv1.DisconnectKoboDeviceResponse{}), nil
 }
 
+func (h *booksConnectHandler) SetKoboDeviceFilter(
+	ctx context.Context,
+	req *connect.Request[booksv1.SetKoboDeviceFilterRequest],
+) (*connect.Response[booksv1.SetKoboDeviceFilterResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	deviceID, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("invalid device ID"),
+		)
+	}
+	device, err := h.app.Services.Kobo.SetKoboDeviceFilter(
+		ctx, user.ID, deviceID, models.KoboSyncFilter{
+			Shelves: req.Msg.SyncShelves,
+			Tags:    req.Msg.SyncTags,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, database.ErrResourceNotFound) {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				errors.New("device not found"),
+			)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	device.LoggingEnabled = h.app.Services.KoboLog.IsEnabled(device.ID)
+	return connect.NewResponse(&booksv1.SetKoboDeviceFilterResponse{
+		Device: koboDeviceProto(device),
+	}), nil
+}
+
 func koboDeviceProto(d models.KoboDevice) *booksv1.KoboDevice {
 	lastSeen := ""
 	if d.LastSeenAt != nil {
@@ -144,5 +196,7 @@ func koboDeviceProto(d models.KoboDevice) *booksv1.KoboDevice {
 		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
 		LastSeenAt:     lastSeen,
 		LoggingEnabled: d.LoggingEnabled,
+		SyncShelves:    d.Filter.Shelves,
+		SyncTags:       d.Filter.Tags,
 	}
 }
@@ -0,0 +1,112 @@
+package books
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/services"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+	"tools.xdoubleu.com/internal/constants"
+	"tools.xdoubleu.com/internal/contexttools"
+	"tools.xdoubleu.com/internal/database"
+	sharedmodels "tools.xdoubleu.com/internal/models"
+)
+
+func (h *booksConnectHandler) RegisterKOReaderDevice(
+	ctx context.Context,
+	req *connect.Request[booksv1.RegisterKOReaderDeviceRequest],
+) (*connect.Response[booksv1.RegisterKOReaderDeviceResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	device, password, err := h.app.Services.KOReader.RegisterDevice(
+		ctx, user.ID, req.Msg.Username,
+	)
+	if err != nil {
+		if errors.Is(err, services.ErrKOReaderUsername) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.RegisterKOReaderDeviceResponse{
+		Device:   koreaderDeviceProto(device),
+		Password: password,
+	}), nil
+}
+
+func (h *booksConnectHandler) ListKOReaderDevices(
+	ctx context.Context,
+	_ *connect.Request[booksv1.ListKOReaderDevicesRequest],
+) (*connect.Response[booksv1.ListKOReaderDevicesResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	devices, err := h.app.Services.KOReader.ListDevices(ctx, user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	resp := &booksv1.ListKOReaderDevicesResponse{
+		Devices: make([]*booksv1.KOReaderDevice, len(devices)),
+	}
+	for i, d := range devices {
+		resp.Devices[i] = koreaderDeviceProto(d)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *booksConnectHandler) DisconnectKOReaderDevice(
+	ctx context.Context,
+	req *connect.Request[booksv1.DisconnectKOReaderDeviceRequest],
+) (*connect.Response[booksv1.DisconnectKOReaderDeviceResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	deviceID, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("invalid device ID"),
+		)
+	}
+	err = h.app.Services.KOReader.DisconnectDevice(ctx, user.ID, deviceID)
+	if err != nil {
+		if errors.Is(err, database.ErrResourceNotFound) {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				errors.New("device not found"),
+			)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.DisconnectKOReaderDeviceResponse{}), nil
+}
+
+func koreaderDeviceProto(d models.KOReaderDevice) *booksv1.KOReaderDevice {
+	lastUsed := ""
+	if d.LastUsedAt != nil {
+		lastUsed = d.LastUsedAt.Format(time.RFC3339)
+	}
+	return &booksv1.KOReaderDevice{
+		Id:         d.ID,
+		Username:   d.Username,
+		CreatedAt:  d.CreatedAt.Format(time.RFC3339),
+		LastUsedAt: lastUsed,
+	}
+}
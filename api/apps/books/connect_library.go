@@ -32,13 +32,20 @@ func (h *booksConnectHandler) GetLibrary(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	queueSettings, err := h.app.Services.Books.GetQueueSettings(ctx, user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 	base := h.app.clients.PublicAPIBaseURL
 	return connect.NewResponse(&booksv1.GetLibraryResponse{
 		Library: &booksv1.LibraryResponse{
-			Reading:  protoUserBooks(data.Reading, base),
-			Wishlist: protoUserBooks(data.Wishlist, base),
-			Finished: protoUserBooks(data.Finished, base),
-			Shelves:  protoBookshelves(data.Shelves, base),
+			Reading:       protoUserBooks(data.Reading, base),
+			Wishlist:      protoUserBooks(data.Wishlist, base),
+			Finished:      protoUserBooks(data.Finished, base),
+			Shelves:       protoBookshelves(data.Shelves, base),
+			NextInSeries:  protoUserBooks(data.NextInSeries, base),
+			UpNext:        protoUserBooks(data.UpNext, base),
+			QueueSettings: protoQueueSettings(queueSettings),
 		},
 	}), nil
 }
@@ -36,15 +36,18 @@ func protoBook(book *models.Book, coverBaseURL string) *booksv1.Book {
 	}
 
 	return &booksv1.Book{
-		Id:          book.ID.String(),
-		Title:       book.Title,
-		Authors:     book.Authors,
-		Isbn13:      stringPtr(book.ISBN13),
-		CoverUrl:    proxyURL,
-		Description: stringPtr(book.Description),
-		PageCount:   int32FromIntPtr(book.PageCount),
-		SourceUrl:   stringPtr(book.SourceURL),
-		HasContent:  book.HasContent,
+		Id:             book.ID.String(),
+		Title:          book.Title,
+		Authors:        book.Authors,
+		Isbn13:         stringPtr(book.ISBN13),
+		CoverUrl:       proxyURL,
+		Description:    stringPtr(book.Description),
+		PageCount:      int32FromIntPtr(book.PageCount),
+		SourceUrl:      stringPtr(book.SourceURL),
+		HasContent:     book.HasContent,
+		Series:         stringPtr(book.Series),
+		SeriesPosition: book.SeriesPosition,
+		SeriesTotal:    int32FromIntPtr(book.SeriesTotal),
 	}
 }
 
@@ -74,6 +77,17 @@ func protoUserBook(ub models.UserBook, coverBaseURL string) *booksv1.UserBook {
 		finishedAt[i] = t.Format(time.RFC3339)
 	}
 
+	feedItemID := ""
+	if ub.FeedItemID != nil {
+		feedItemID = ub.FeedItemID.String()
+	}
+
+	var queuePosition *int32
+	if ub.QueuePosition != nil {
+		p := int32FromInt(*ub.QueuePosition)
+		queuePosition = &p
+	}
+
 	return &booksv1.UserBook{
 		Id:              ub.ID.String(),
 		UserId:          ub.UserID,
@@ -89,6 +103,8 @@ func protoUserBook(ub models.UserBook, coverBaseURL string) *booksv1.UserBook {
 		ProgressPercent: int32FromInt(ub.ProgressPercent),
 		AddedAt:         ub.AddedAt.Format(time.RFC3339),
 		UpdatedAt:       ub.UpdatedAt.Format(time.RFC3339),
+		FeedItemId:      feedItemID,
+		QueuePosition:   queuePosition,
 	}
 }
 
@@ -23,13 +23,16 @@ func newBooksTestClient(t *testing.T) booksTestClient {
 	return newBooksClientFor(ts.URL, connect.WithHTTPGet())
 }
 
-// booksTestClient bundles all four books service clients so tests can call
+// booksTestClient bundles all books service clients so tests can call
 // any RPC through one value, mirroring the pre-split single-service client.
 type booksTestClient struct {
 	booksv1connect.LibraryServiceClient
 	booksv1connect.BookFilesServiceClient
 	booksv1connect.KoboServiceClient
 	booksv1connect.CatalogServiceClient
+	booksv1connect.OPDSServiceClient
+	booksv1connect.KOReaderServiceClient
+	booksv1connect.GoalsServiceClient
 }
 
 // newBooksClientFor builds a composite client against the given base URL.
@@ -47,6 +50,15 @@ func newBooksClientFor(url string, opts ...connect.ClientOption) booksTestClient
 		CatalogServiceClient: booksv1connect.NewCatalogServiceClient(
 			http.DefaultClient, url, opts...,
 		),
+		OPDSServiceClient: booksv1connect.NewOPDSServiceClient(
+			http.DefaultClient, url, opts...,
+		),
+		KOReaderServiceClient: booksv1connect.NewKOReaderServiceClient(
+			http.DefaultClient, url, opts...,
+		),
+		GoalsServiceClient: booksv1connect.NewGoalsServiceClient(
+			http.DefaultClient, url, opts...,
+		),
 	}
 }
 
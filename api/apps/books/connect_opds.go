@@ -0,0 +1,112 @@
+package books
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/services"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+	"tools.xdoubleu.com/internal/constants"
+	"tools.xdoubleu.com/internal/contexttools"
+	"tools.xdoubleu.com/internal/database"
+	sharedmodels "tools.xdoubleu.com/internal/models"
+)
+
+func (h *booksConnectHandler) CreateOPDSToken(
+	ctx context.Context,
+	req *connect.Request[booksv1.CreateOPDSTokenRequest],
+) (*connect.Response[booksv1.CreateOPDSTokenResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	token, rawToken, err := h.app.Services.OPDS.CreateOPDSToken(
+		ctx, user.ID, req.Msg.Name,
+	)
+	if err != nil {
+		if errors.Is(err, services.ErrOPDSTokenName) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.CreateOPDSTokenResponse{
+		Token:    opdsTokenProto(token),
+		RawToken: rawToken,
+	}), nil
+}
+
+func (h *booksConnectHandler) ListOPDSTokens(
+	ctx context.Context,
+	_ *connect.Request[booksv1.ListOPDSTokensRequest],
+) (*connect.Response[booksv1.ListOPDSTokensResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	tokens, err := h.app.Services.OPDS.ListOPDSTokens(ctx, user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	resp := &booksv1.ListOPDSTokensResponse{
+		Tokens: make([]*booksv1.OPDSToken, len(tokens)),
+	}
+	for i, t := range tokens {
+		resp.Tokens[i] = opdsTokenProto(t)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *booksConnectHandler) RevokeOPDSToken(
+	ctx context.Context,
+	req *connect.Request[booksv1.RevokeOPDSTokenRequest],
+) (*connect.Response[booksv1.RevokeOPDSTokenResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	tokenID, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("invalid token ID"),
+		)
+	}
+	err = h.app.Services.OPDS.RevokeOPDSToken(ctx, user.ID, tokenID)
+	if err != nil {
+		if errors.Is(err, database.ErrResourceNotFound) {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				errors.New("token not found"),
+			)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.RevokeOPDSTokenResponse{}), nil
+}
+
+func opdsTokenProto(t models.OPDSToken) *booksv1.OPDSToken {
+	lastUsed := ""
+	if t.LastUsedAt != nil {
+		lastUsed = t.LastUsedAt.Format(time.RFC3339)
+	}
+	return &booksv1.OPDSToken{
+		Id:         t.ID,
+		Name:       t.Name,
+		CreatedAt:  t.CreatedAt.Format(time.RFC3339),
+		LastUsedAt: lastUsed,
+	}
+}
@@ -0,0 +1,167 @@
+package books
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/services"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+	"tools.xdoubleu.com/internal/constants"
+	"tools.xdoubleu.com/internal/contexttools"
+	"tools.xdoubleu.com/internal/database"
+	sharedmodels "tools.xdoubleu.com/internal/models"
+)
+
+func (h *booksConnectHandler) QueueBook(
+	ctx context.Context,
+	req *connect.Request[booksv1.QueueBookRequest],
+) (*connect.Response[booksv1.QueueBookResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	bookID, err := uuid.Parse(req.Msg.BookId)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("invalid book ID"),
+		)
+	}
+	if err = h.app.Services.Books.QueueBook(ctx, user.ID, bookID); err != nil {
+		if errors.Is(err, database.ErrResourceNotFound) {
+			return nil, connect.NewError(
+				connect.CodeFailedPrecondition,
+				errors.New("only books on to-read can be queued"),
+			)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err = h.convertQueuedForKobo(ctx, user.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.QueueBookResponse{}), nil
+}
+
+func (h *booksConnectHandler) DequeueBook(
+	ctx context.Context,
+	req *connect.Request[booksv1.DequeueBookRequest],
+) (*connect.Response[booksv1.DequeueBookResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	bookID, err := uuid.Parse(req.Msg.BookId)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("invalid book ID"),
+		)
+	}
+	if err = h.app.Services.Books.DequeueBook(ctx, user.ID, bookID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// A book moves up into the Kobo-synced part of the queue.
+	if err = h.convertQueuedForKobo(ctx, user.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.DequeueBookResponse{}), nil
+}
+
+func (h *booksConnectHandler) ReorderQueue(
+	ctx context.Context,
+	req *connect.Request[booksv1.ReorderQueueRequest],
+) (*connect.Response[booksv1.ReorderQueueResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	ids := make([]uuid.UUID, 0, len(req.Msg.BookIds))
+	for _, s := range req.Msg.BookIds {
+		if id, err := uuid.Parse(s); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	if err := h.app.Services.Books.ReorderQueue(ctx, user.ID, ids); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := h.convertQueuedForKobo(ctx, user.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.ReorderQueueResponse{}), nil
+}
+
+func (h *booksConnectHandler) UpdateQueueSettings(
+	ctx context.Context,
+	req *connect.Request[booksv1.UpdateQueueSettingsRequest],
+) (*connect.Response[booksv1.UpdateQueueSettingsResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+	settings := models.QueueSettings{
+		AutoAdvance:   req.Msg.GetSettings().GetAutoAdvance(),
+		KoboSyncCount: int(req.Msg.GetSettings().GetKoboSyncCount()),
+	}
+	err := h.app.Services.Books.UpdateQueueSettings(ctx, user.ID, settings)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidQueueSettings) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err = h.convertQueuedForKobo(ctx, user.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&booksv1.UpdateQueueSettingsResponse{}), nil
+}
+
+// convertQueuedForKobo starts KEPUB conversion for the queued books that
+// sync to Kobo on the queue's account, as EnableKoboSync does for a book
+// given the kobo-sync tag: without a ready KEPUB a book isn't synced.
+func (h *booksConnectHandler) convertQueuedForKobo(
+	ctx context.Context,
+	userID string,
+) error {
+	bookIDs, err := h.app.Services.Books.QueuedForKobo(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, bookID := range bookIDs {
+		statusResult, statusErr := h.app.Services.Books.GetKEPUBStatus(
+			ctx, userID, bookID,
+		)
+		if statusErr != nil {
+			return statusErr
+		}
+		_, convErr := h.maybeStartKEPUBConversion(
+			ctx, userID, bookID, statusResult, true,
+		)
+		if convErr != nil {
+			return convErr
+		}
+	}
+	return nil
+}
+
+func protoQueueSettings(s models.QueueSettings) *booksv1.QueueSettings {
+	return &booksv1.QueueSettings{
+		AutoAdvance:   s.AutoAdvance,
+		KoboSyncCount: int32FromInt(s.KoboSyncCount),
+	}
+}
@@ -0,0 +1,82 @@
+package books
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	booksv1 "tools.xdoubleu.com/gen/books/v1"
+	"tools.xdoubleu.com/internal/constants"
+	"tools.xdoubleu.com/internal/contexttools"
+	sharedmodels "tools.xdoubleu.com/internal/models"
+)
+
+func (h *booksConnectHandler) GetReadingStats(
+	ctx context.Context,
+	req *connect.Request[booksv1.GetReadingStatsRequest],
+) (*connect.Response[booksv1.GetReadingStatsResponse], error) {
+	user := contexttools.GetValue[sharedmodels.User](ctx, constants.UserContextKey)
+	if user == nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthorized"),
+		)
+	}
+
+	// date_end is inclusive; sessions are selected up to the next midnight.
+	dateStart, dateEnd := parseDateRangeFromStrings(req.Msg.DateStart, req.Msg.DateEnd)
+	stats, err := h.app.Services.Sessions.GetStats(
+		ctx, user.ID,
+		dateStart.Truncate(24*time.Hour),
+		dateEnd.Truncate(24*time.Hour).AddDate(0, 0, 1),
+		time.Now(),
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &booksv1.GetReadingStatsResponse{
+		Days:      make([]*booksv1.ReadingDay, 0, len(stats.Days)),
+		Books:     make([]*booksv1.BookReadingStats, 0, len(stats.Books)),
+		DateStart: dateStart.Format(models.ProgressDateFormat),
+		DateEnd:   dateEnd.Format(models.ProgressDateFormat),
+	}
+	for _, d := range stats.Days {
+		resp.Days = append(resp.Days, &booksv1.ReadingDay{
+			Date:        d.Date.Format(models.ProgressDateFormat),
+			BookId:      d.BookID.String(),
+			Seconds:     int32FromInt(d.Seconds),
+			Sessions:    int32FromInt(d.Sessions),
+			PagesTurned: int32FromInt(d.PagesTurned),
+		})
+	}
+	for _, b := range stats.Books {
+		resp.Books = append(resp.Books, bookReadingStatsProto(b))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func bookReadingStatsProto(b models.BookReadingStats) *booksv1.BookReadingStats {
+	out := &booksv1.BookReadingStats{
+		BookId:           b.BookID.String(),
+		Title:            b.Title,
+		Authors:          b.Authors,
+		Status:           b.Status,
+		Percent:          int32FromInt(b.Percent),
+		Seconds:          int32FromInt(b.Seconds),
+		Sessions:         int32FromInt(b.Sessions),
+		PagesTurned:      int32FromInt(b.PagesTurned),
+		PercentPerHour:   b.PercentPerHour,
+		PagesPerHour:     b.PagesPerHour,
+		RemainingSeconds: int32FromInt(b.RemainingSeconds),
+		EstimatedFinish:  "",
+		LastReadAt:       b.LastReadAt.UTC().Format(time.RFC3339),
+	}
+	if b.EstimatedFinish != nil {
+		out.EstimatedFinish = b.EstimatedFinish.Format(models.ProgressDateFormat)
+	}
+	return out
+}
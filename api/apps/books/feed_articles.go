@@ -0,0 +1,62 @@
+package books
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// FeedArticle is a feed item sent to the library as an article: its stored
+// body, with the title, link and feed the entry is catalogued by.
+type FeedArticle struct {
+	ItemID      uuid.UUID
+	FeedTitle   string
+	Title       string
+	SourceURL   string
+	ContentHTML string
+	PublishedAt time.Time
+}
+
+// FeedProgressSink is what articles sent from feeds need from the feeds
+// app to pass reading progress back to their items. The composition root
+// (cmd/api) adapts *feeds.Feeds to it, so the books app never imports
+// apps/feeds or writes its schema.
+type FeedProgressSink interface {
+	SetItemProgress(
+		ctx context.Context, userID string, itemID uuid.UUID, percent int,
+	) error
+}
+
+// SetFeedProgressSink connects articles sent from feeds to the feeds app.
+// Until it is called, progress on them stays in the library.
+func (a *Books) SetFeedProgressSink(sink FeedProgressSink) {
+	a.Services.FeedArticle.SetSink(sink)
+}
+
+// AddFeedArticle adds a feed item to userID's library as an article, or
+// finds the entry an earlier send created, and returns its book id. It is
+// the only entry point the feeds app's "send to library" reaches books
+// through.
+func (a *Books) AddFeedArticle(
+	ctx context.Context,
+	userID string,
+	article FeedArticle,
+) (uuid.UUID, error) {
+	return a.Services.FeedArticle.Add(ctx, userID, models.FeedArticle(article))
+}
+
+// SetFeedArticleProgress records scroll progress from the feeds reader as
+// userID's reading progress on bookID, an article sent from feeds.
+func (a *Books) SetFeedArticleProgress(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	percent int,
+) error {
+	return a.Services.Books.UpdateReadingProgress(
+		ctx, userID, bookID, models.ReadingSourceFeeds, percent, nil,
+	)
+}
@@ -0,0 +1,78 @@
+package books_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books"
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// fakeFeedProgressSink stands in for the feeds app.
+type fakeFeedProgressSink struct {
+	progress map[uuid.UUID]int
+}
+
+func (f *fakeFeedProgressSink) SetItemProgress(
+	_ context.Context,
+	_ string,
+	itemID uuid.UUID,
+	percent int,
+) error {
+	f.progress[itemID] = percent
+	return nil
+}
+
+func TestFeedArticle_AddsEntryAndSyncsProgressBack(t *testing.T) {
+	ctx := context.Background()
+	owner := "feed-article-" + uuid.NewString()
+	itemID := uuid.New()
+	sink := &fakeFeedProgressSink{progress: map[uuid.UUID]int{}}
+	testApp.SetFeedProgressSink(sink)
+	t.Cleanup(func() { testApp.Services.FeedArticle.SetSink(nil) })
+
+	article := books.FeedArticle{
+		ItemID:      itemID,
+		FeedTitle:   "Alpha",
+		Title:       "Long Read",
+		SourceURL:   "https://a.example/long-read-" + itemID.String(),
+		ContentHTML: "<p>" + strings.Repeat("word ", 300) + "</p>",
+		PublishedAt: time.Now().Add(-time.Hour),
+	}
+	bookID, err := testApp.AddFeedArticle(ctx, owner, article)
+	require.NoError(t, err)
+
+	ub, err := testApp.Repositories.Books.GetUserBook(ctx, owner, bookID)
+	require.NoError(t, err)
+	require.NotNil(t, ub.Book)
+	assert.Equal(t, "Long Read", ub.Book.Title)
+	require.NotNil(t, ub.FeedItemID)
+	assert.Equal(t, itemID, *ub.FeedItemID)
+
+	epub, err := testApp.Repositories.BookFiles.GetByBookAndFormat(
+		ctx, owner, bookID, models.FileFormatEPUB,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusReady, epub.Status)
+
+	// Sending the same item again finds the entry instead of adding one.
+	again, err := testApp.AddFeedArticle(ctx, owner, article)
+	require.NoError(t, err)
+	assert.Equal(t, bookID, again)
+
+	// Progress read elsewhere reaches the feed item...
+	require.NoError(t, testApp.Services.Books.UpdateReadingProgress(
+		ctx, owner, bookID, models.ReadingSourceKobo, 40, nil,
+	))
+	assert.Equal(t, 40, sink.progress[itemID])
+
+	// ...but progress that came from feeds isn't echoed back to it.
+	require.NoError(t, testApp.SetFeedArticleProgress(ctx, owner, bookID, 70))
+	assert.Equal(t, 40, sink.progress[itemID])
+}
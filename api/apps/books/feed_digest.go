@@ -0,0 +1,86 @@
+package books
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// FeedDigestItem is a feed item offered for a user's daily feeds digest:
+// unread or bookmarked, without its article body.
+type FeedDigestItem struct {
+	ID          uuid.UUID
+	UserID      string
+	FeedID      uuid.UUID
+	FeedTitle   string
+	Title       string
+	SourceURL   string
+	PublishedAt time.Time
+}
+
+// FeedDigestSource is what the daily feeds digest needs from the feeds app.
+// The composition root (cmd/api) adapts *feeds.Feeds to it, so the books
+// app never imports apps/feeds or queries its schema.
+type FeedDigestSource interface {
+	ListDigestCandidates(ctx context.Context, since time.Time) ([]FeedDigestItem, error)
+	ItemContent(
+		ctx context.Context, userID string, itemIDs []uuid.UUID,
+	) (map[uuid.UUID]string, error)
+	MarkItemsRead(ctx context.Context, userID string, itemIDs []uuid.UUID) error
+}
+
+// SetFeedDigestSource connects the daily feeds digest to the feeds app.
+// Until it is called, the digest job builds nothing. It must be called
+// before Start.
+func (a *Books) SetFeedDigestSource(source FeedDigestSource) {
+	a.Services.Digest.SetSource(feedDigestSourceAdapter{source: source})
+}
+
+// feedDigestSourceAdapter adapts a FeedDigestSource to
+// services.FeedItemSource, whose items are the internal model type.
+type feedDigestSourceAdapter struct {
+	source FeedDigestSource
+}
+
+func (a feedDigestSourceAdapter) ListDigestCandidates(
+	ctx context.Context,
+	since time.Time,
+) ([]models.FeedDigestItem, error) {
+	items, err := a.source.ListDigestCandidates(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]models.FeedDigestItem, len(items))
+	for i, item := range items {
+		out[i] = models.FeedDigestItem{
+			ID:          item.ID,
+			UserID:      item.UserID,
+			FeedID:      item.FeedID,
+			FeedTitle:   item.FeedTitle,
+			Title:       item.Title,
+			SourceURL:   item.SourceURL,
+			PublishedAt: item.PublishedAt,
+		}
+	}
+	return out, nil
+}
+
+func (a feedDigestSourceAdapter) ItemContent(
+	ctx context.Context,
+	userID string,
+	itemIDs []uuid.UUID,
+) (map[uuid.UUID]string, error) {
+	return a.source.ItemContent(ctx, userID, itemIDs)
+}
+
+func (a feedDigestSourceAdapter) MarkItemsRead(
+	ctx context.Context,
+	userID string,
+	itemIDs []uuid.UUID,
+) error {
+	return a.source.MarkItemsRead(ctx, userID, itemIDs)
+}
@@ -0,0 +1,182 @@
+package books_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books"
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/logging"
+)
+
+// fakeFeedDigestSource stands in for the feeds app.
+type fakeFeedDigestSource struct {
+	items   []books.FeedDigestItem
+	content map[uuid.UUID]string
+	read    []uuid.UUID
+}
+
+func (f *fakeFeedDigestSource) ListDigestCandidates(
+	_ context.Context,
+	_ time.Time,
+) ([]books.FeedDigestItem, error) {
+	return f.items, nil
+}
+
+func (f *fakeFeedDigestSource) ItemContent(
+	_ context.Context,
+	_ string,
+	itemIDs []uuid.UUID,
+) (map[uuid.UUID]string, error) {
+	out := map[uuid.UUID]string{}
+	for _, id := range itemIDs {
+		out[id] = f.content[id]
+	}
+	return out, nil
+}
+
+func (f *fakeFeedDigestSource) MarkItemsRead(
+	_ context.Context,
+	_ string,
+	itemIDs []uuid.UUID,
+) error {
+	f.read = append(f.read, itemIDs...)
+	return nil
+}
+
+func TestFeedDigest_BuildsKoboSyncedDigestAndMarksItemsRead(t *testing.T) {
+	ctx := context.Background()
+	owner := "feed-digest-" + uuid.NewString()
+	feedA, feedB := uuid.New(), uuid.New()
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+	published := time.Now().Add(-time.Hour)
+	source := &fakeFeedDigestSource{
+		items: []books.FeedDigestItem{
+			{
+				ID: first, UserID: owner, FeedID: feedA, FeedTitle: "Alpha",
+				Title: "First", SourceURL: "https://a.example/1", PublishedAt: published,
+			},
+			{
+				ID: second, UserID: owner, FeedID: feedB, FeedTitle: "Beta",
+				Title: "Second", SourceURL: "https://b.example/2", PublishedAt: published,
+			},
+			{
+				ID: third, UserID: owner, FeedID: feedB, FeedTitle: "Beta",
+				Title: "No body", SourceURL: "https://b.example/3", PublishedAt: published,
+			},
+		},
+		content: map[uuid.UUID]string{
+			first:  "<p>" + strings.Repeat("alpha ", 200) + "</p>",
+			second: "<p>" + strings.Repeat("beta ", 200) + "</p>",
+		},
+		read: nil,
+	}
+	testApp.SetFeedDigestSource(source)
+	t.Cleanup(func() { testApp.Services.Digest.SetSource(nil) })
+
+	require.NoError(t, testApp.Services.Digest.BuildDaily(ctx, logging.NewNopLogger()))
+
+	var bookID uuid.UUID
+	require.NoError(t, testDB.QueryRow(ctx,
+		`SELECT book_id FROM books.feed_digests WHERE user_id = $1`, owner,
+	).Scan(&bookID))
+
+	ub, err := testApp.Repositories.Books.GetUserBook(ctx, owner, bookID)
+	require.NoError(t, err)
+	require.NotNil(t, ub.Book)
+	assert.Contains(t, ub.Book.Title, "Feeds digest ")
+	assert.Contains(t, ub.Tags, models.TagKoboSync)
+
+	epub, err := testApp.Repositories.BookFiles.GetByBookAndFormat(
+		ctx, owner, bookID, models.FileFormatEPUB,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusReady, epub.Status)
+	kepub, err := testApp.Repositories.BookFiles.GetByBookAndFormat(
+		ctx, owner, bookID, models.FileFormatKEPUB,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, models.FileStatusReady, kepub.Status)
+
+	// A second run the same day builds nothing new.
+	require.NoError(t, testApp.Services.Digest.BuildDaily(ctx, logging.NewNopLogger()))
+	var digests int
+	require.NoError(t, testDB.QueryRow(ctx,
+		`SELECT count(*) FROM books.feed_digests WHERE user_id = $1`, owner,
+	).Scan(&digests))
+	assert.Equal(t, 1, digests)
+
+	// The contents page comes first, so the start of the book reaches no
+	// article; the end reaches both.
+	require.NoError(t, testApp.Services.Books.UpdateReadingProgress(
+		ctx, owner, bookID, models.ReadingSourceKobo, 0, nil,
+	))
+	assert.Empty(t, source.read)
+
+	require.NoError(t, testApp.Services.Books.UpdateReadingProgress(
+		ctx, owner, bookID, models.ReadingSourceKobo, 100, nil,
+	))
+	assert.ElementsMatch(t, []uuid.UUID{first, second}, source.read)
+
+	// Items already marked aren't marked again.
+	require.NoError(t, testApp.Services.Books.UpdateReadingProgress(
+		ctx, owner, bookID, models.ReadingSourceKobo, 100, nil,
+	))
+	assert.Len(t, source.read, 2)
+}
+
+// TestFeedDigest_RetryAfterUnrecordedBuildReusesTheBook covers a build that
+// added the digest to the library but failed before recording it: the next
+// run must finish that digest, not add a second one.
+func TestFeedDigest_RetryAfterUnrecordedBuildReusesTheBook(t *testing.T) {
+	ctx := context.Background()
+	owner := "feed-digest-retry-" + uuid.NewString()
+	item := uuid.New()
+	source := &fakeFeedDigestSource{
+		items: []books.FeedDigestItem{{
+			ID: item, UserID: owner, FeedID: uuid.New(), FeedTitle: "Alpha",
+			Title: "Only", SourceURL: "https://a.example/only",
+			PublishedAt: time.Now().Add(-time.Hour),
+		}},
+		content: map[uuid.UUID]string{item: "<p>" + strings.Repeat("alpha ", 200) + "</p>"},
+		read:    nil,
+	}
+	testApp.SetFeedDigestSource(source)
+	t.Cleanup(func() { testApp.Services.Digest.SetSource(nil) })
+
+	require.NoError(t, testApp.Services.Digest.BuildDaily(ctx, logging.NewNopLogger()))
+	var bookID uuid.UUID
+	require.NoError(t, testDB.QueryRow(ctx,
+		`SELECT book_id FROM books.feed_digests WHERE user_id = $1`, owner,
+	).Scan(&bookID))
+
+	// As if recording the digest had failed.
+	_, err := testDB.Exec(ctx,
+		`DELETE FROM books.feed_digests WHERE user_id = $1`, owner)
+	require.NoError(t, err)
+
+	require.NoError(t, testApp.Services.Digest.BuildDaily(ctx, logging.NewNopLogger()))
+
+	var rebuilt uuid.UUID
+	require.NoError(t, testDB.QueryRow(ctx,
+		`SELECT book_id FROM books.feed_digests WHERE user_id = $1`, owner,
+	).Scan(&rebuilt))
+	assert.Equal(t, bookID, rebuilt)
+
+	var libraryBooks, epubs int
+	require.NoError(t, testDB.QueryRow(ctx,
+		`SELECT count(*) FROM books.user_books WHERE user_id = $1`, owner,
+	).Scan(&libraryBooks))
+	assert.Equal(t, 1, libraryBooks)
+	require.NoError(t, testDB.QueryRow(ctx, `
+		SELECT count(*) FROM books.book_files
+		WHERE user_id = $1 AND book_id = $2 AND format = $3
+	`, owner, bookID, models.FileFormatEPUB).Scan(&epubs))
+	assert.Equal(t, 1, epubs)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/services"
 	booksv1 "tools.xdoubleu.com/gen/books/v1"
 )
 
@@ -16,10 +17,12 @@ type bookShelf struct {
 }
 
 type booksPageData struct {
-	Reading  []models.UserBook
-	Wishlist []models.UserBook
-	Finished []models.UserBook
-	Shelves  []bookShelf
+	Reading      []models.UserBook
+	Wishlist     []models.UserBook
+	Finished     []models.UserBook
+	Shelves      []bookShelf
+	NextInSeries []models.UserBook
+	UpNext       []models.UserBook
 }
 
 func groupByStatus(
@@ -104,6 +107,8 @@ func (app *Books) buildLibraryData(
 		}
 	}
 
+	upNext := queuedFirst(wishlist)
+
 	registeredShelves, err := app.Services.Books.ListShelves(ctx, userID)
 	if err != nil {
 		return booksPageData{}, err
@@ -121,13 +126,36 @@ func (app *Books) buildLibraryData(
 	})
 
 	return booksPageData{
-		Reading:  reading,
-		Wishlist: wishlist,
-		Finished: finished,
-		Shelves:  shelves,
+		Reading:      reading,
+		Wishlist:     wishlist,
+		Finished:     finished,
+		Shelves:      shelves,
+		NextInSeries: services.NextInSeries(library),
+		UpNext:       upNext,
 	}, nil
 }
 
+// queuedFirst stably moves wishlist's queued books to its front in queue
+// order and returns them, the "up next" queue.
+func queuedFirst(wishlist []models.UserBook) []models.UserBook {
+	slices.SortStableFunc(wishlist, func(a, b models.UserBook) int {
+		switch {
+		case a.QueuePosition == nil && b.QueuePosition == nil:
+			return 0
+		case a.QueuePosition == nil:
+			return 1
+		case b.QueuePosition == nil:
+			return -1
+		}
+		return *a.QueuePosition - *b.QueuePosition
+	})
+	queued := 0
+	for queued < len(wishlist) && wishlist[queued].QueuePosition != nil {
+		queued++
+	}
+	return wishlist[:queued:queued]
+}
+
 // BuildSharedLibrary assembles the reading dashboard's library payload for a
 // user, plus their most recent Kobo device sync time (empty string when the
 // owner has no Kobo devices — Kobo sync is the books equivalent of a
@@ -154,10 +182,12 @@ func (app *Books) BuildSharedLibrary(
 
 	base := app.clients.PublicAPIBaseURL
 	return &booksv1.LibraryResponse{
-		Reading:  protoUserBooks(data.Reading, base),
-		Wishlist: protoUserBooks(data.Wishlist, base),
-		Finished: protoUserBooks(data.Finished, base),
-		Shelves:  protoBookshelves(data.Shelves, base),
+		Reading:      protoUserBooks(data.Reading, base),
+		Wishlist:     protoUserBooks(data.Wishlist, base),
+		Finished:     protoUserBooks(data.Finished, base),
+		Shelves:      protoBookshelves(data.Shelves, base),
+		NextInSeries: protoUserBooks(data.NextInSeries, base),
+		UpNext:       protoUserBooks(data.UpNext, base),
 	}, lastSyncedAt, nil
 }
 
@@ -186,6 +216,22 @@ func (app *Books) BuildSharedProgress(
 	}, nil
 }
 
+// BuildSharedReadingGoals reports progress on the goals a user has chosen
+// to show on their public reading dashboard. A zero year means this year.
+func (app *Books) BuildSharedReadingGoals(
+	ctx context.Context,
+	userID string,
+	year int32,
+) ([]*booksv1.ReadingGoalProgress, error) {
+	progress, err := app.Services.Goals.GetProgress(
+		ctx, userID, goalYear(year), true, time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return readingGoalProgressProtos(progress), nil
+}
+
 func (app *Books) rebuildReadProgress(ctx context.Context, userID string) error {
 	labels, values, err := app.Services.Books.BuildReadProgress(ctx, userID)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"tools.xdoubleu.com/apps/books/internal/models"
 )
@@ -123,3 +124,27 @@ func TestGroupByStatus_CustomStatusBecomesShelf(t *testing.T) {
 	assert.Equal(t, "favorites", shelves[2].Name)
 	assert.Len(t, shelves[2].Books, 2)
 }
+
+func TestQueuedFirst(t *testing.T) {
+	pos := func(p int) *int { return &p }
+	wishlist := []models.UserBook{
+		{ID: uuid.New(), Status: models.StatusToRead},                        //nolint:exhaustruct //test
+		{ID: uuid.New(), Status: models.StatusToRead, QueuePosition: pos(4)}, //nolint:exhaustruct //test
+		{ID: uuid.New(), Status: models.StatusToRead},                        //nolint:exhaustruct //test
+		{ID: uuid.New(), Status: models.StatusToRead, QueuePosition: pos(1)}, //nolint:exhaustruct //test
+	}
+	ids := []uuid.UUID{wishlist[0].ID, wishlist[1].ID, wishlist[2].ID, wishlist[3].ID}
+
+	upNext := queuedFirst(wishlist)
+
+	require.Len(t, upNext, 2)
+	assert.Equal(t, []uuid.UUID{ids[3], ids[1]}, []uuid.UUID{upNext[0].ID, upNext[1].ID})
+	// The unqueued rest keeps its order behind the queue.
+	assert.Equal(
+		t,
+		[]uuid.UUID{ids[3], ids[1], ids[0], ids[2]},
+		[]uuid.UUID{wishlist[0].ID, wishlist[1].ID, wishlist[2].ID, wishlist[3].ID},
+	)
+
+	assert.Empty(t, queuedFirst(nil))
+}
@@ -0,0 +1,34 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// feedDigestInterval is how often the feeds digest is built. A user gets at
+// most one digest per (UTC) day however often it runs.
+const feedDigestInterval = 24 * time.Hour
+
+// feedDigestBuilder is the slice of services.DigestService the job needs.
+type feedDigestBuilder interface {
+	BuildDaily(ctx context.Context, logger *slog.Logger) error
+}
+
+// FeedDigestJob bundles each user's new unread and bookmarked feed items
+// into a digest EPUB synced to their Kobo.
+type FeedDigestJob struct {
+	builder feedDigestBuilder
+}
+
+func NewFeedDigestJob(builder feedDigestBuilder) *FeedDigestJob {
+	return &FeedDigestJob{builder: builder}
+}
+
+func (j *FeedDigestJob) ID() string { return "books-feed-digest" }
+
+func (j *FeedDigestJob) RunEvery() time.Duration { return feedDigestInterval }
+
+func (j *FeedDigestJob) Run(ctx context.Context, logger *slog.Logger) error {
+	return j.builder.BuildDaily(ctx, logger)
+}
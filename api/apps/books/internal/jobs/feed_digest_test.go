@@ -0,0 +1,36 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tools.xdoubleu.com/apps/books/internal/jobs"
+)
+
+type fakeFeedDigestBuilder struct {
+	runs int
+	err  error
+}
+
+func (f *fakeFeedDigestBuilder) BuildDaily(_ context.Context, _ *slog.Logger) error {
+	f.runs++
+	return f.err
+}
+
+func TestFeedDigestJob(t *testing.T) {
+	builder := &fakeFeedDigestBuilder{runs: 0, err: nil}
+	job := jobs.NewFeedDigestJob(builder)
+	assert.Equal(t, "books-feed-digest", job.ID())
+	assert.Equal(t, 24*time.Hour, job.RunEvery())
+
+	assert.NoError(t, job.Run(context.Background(), slog.Default()))
+	assert.Equal(t, 1, builder.runs)
+
+	builder.err = errors.New("feeds unavailable")
+	assert.ErrorIs(t, job.Run(context.Background(), slog.Default()), builder.err)
+}
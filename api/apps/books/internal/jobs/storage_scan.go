@@ -16,20 +16,26 @@ const (
 	// staleUploadAge is how old a temp upload must be before it counts as
 	// leaked (the upload flow normally finalizes within minutes).
 	staleUploadAge = 7 * 24 * time.Hour
+	// orphanGraceAge is how old an unreferenced file object must be before
+	// it is deleted: uploads and conversions store the object a moment
+	// before inserting the row that references it.
+	orphanGraceAge = 24 * time.Hour
 	booksPrefix    = "books/"
+	blobsPrefix    = "blobs/"
 	uploadsMarker  = "/uploads/"
 	coverSuffix    = "/cover.jpg"
 	coverMissing   = "/cover.missing"
 )
 
-// objectLister is the slice of objectstore.Client the scan needs.
-type objectLister interface {
+// objectStore is the slice of objectstore.Client the scan needs.
+type objectStore interface {
 	List(ctx context.Context, prefix string) ([]objectstore.ObjectInfo, error)
+	Delete(ctx context.Context, key string) error
 }
 
-// storageKeyLister returns the R2 keys referenced by book files.
-type storageKeyLister interface {
-	AllStorageKeys(ctx context.Context) ([]string, error)
+// storageKeyCounter returns how many book files reference each R2 key.
+type storageKeyCounter interface {
+	StorageKeyRefs(ctx context.Context) (map[string]int64, error)
 }
 
 // snapshotStore persists a completed scan.
@@ -38,18 +44,20 @@ type snapshotStore interface {
 }
 
 // StorageScanJob walks the whole object-store bucket once a day and records a
-// snapshot (total size, per-prefix breakdown, and — by diffing against the
-// book_files table — orphaned objects and stale temp uploads) so the admin
-// dashboard can flag when manual cleanup is worthwhile.
+// snapshot (total size, per-prefix breakdown, the space content-addressed
+// blobs save by being shared, and — by diffing against the book_files
+// table — orphaned objects and stale temp uploads). Orphans past
+// orphanGraceAge and stale uploads are then deleted, and the snapshot
+// records what that reclaimed.
 type StorageScanJob struct {
-	store     objectLister
-	bookFiles storageKeyLister
+	store     objectStore
+	bookFiles storageKeyCounter
 	snapshots snapshotStore
 }
 
 func NewStorageScanJob(
-	store objectLister,
-	bookFiles storageKeyLister,
+	store objectStore,
+	bookFiles storageKeyCounter,
 	snapshots snapshotStore,
 ) *StorageScanJob {
 	return &StorageScanJob{
@@ -69,31 +77,56 @@ func (j *StorageScanJob) Run(ctx context.Context, logger *slog.Logger) error {
 		return err
 	}
 
-	keys, err := j.bookFiles.AllStorageKeys(ctx)
+	refs, err := j.bookFiles.StorageKeyRefs(ctx)
 	if err != nil {
 		return err
 	}
-	referenced := make(map[string]bool, len(keys))
-	for _, k := range keys {
-		referenced[k] = true
-	}
 
-	snap := buildSnapshot(objects, referenced, time.Now())
+	now := time.Now()
+	snap := buildSnapshot(objects, refs, now)
+	j.collectGarbage(ctx, logger, objects, refs, now, &snap)
 
 	logger.InfoContext(ctx, "storage scan complete",
 		slog.Int64("objects", snap.ObjectCount),
 		slog.Int64("orphans", snap.OrphanCount),
 		slog.Int64("stale_uploads", snap.StaleUploadCount),
+		slog.Int64("dedup_saved_bytes", snap.DedupSavedBytes),
+		slog.Int64("reclaimed", snap.ReclaimedCount),
 	)
 
 	return j.snapshots.Insert(ctx, snap)
 }
 
+// collectGarbage deletes the orphans and stale uploads in objects, adding
+// what it freed to snap. A failed delete is logged and retried on the next
+// scan.
+func (j *StorageScanJob) collectGarbage(
+	ctx context.Context,
+	logger *slog.Logger,
+	objects []objectstore.ObjectInfo,
+	refs map[string]int64,
+	now time.Time,
+	snap *models.StorageSnapshot,
+) {
+	for _, obj := range objects {
+		if !isCollectable(obj, refs, now) {
+			continue
+		}
+		if err := j.store.Delete(ctx, obj.Key); err != nil {
+			logger.WarnContext(ctx, "failed to delete unreferenced object",
+				slog.String("key", obj.Key), slog.Any("err", err))
+			continue
+		}
+		snap.ReclaimedSizeBytes += obj.Size
+		snap.ReclaimedCount++
+	}
+}
+
 // buildSnapshot aggregates a bucket listing into a StorageSnapshot. It is pure
 // so the classification logic can be unit-tested without a live bucket.
 func buildSnapshot(
 	objects []objectstore.ObjectInfo,
-	referenced map[string]bool,
+	refs map[string]int64,
 	now time.Time,
 ) models.StorageSnapshot {
 	prefixes := map[string]*models.PrefixStat{}
@@ -105,6 +138,9 @@ func buildSnapshot(
 		OrphanCount:          0,
 		StaleUploadSizeBytes: 0,
 		StaleUploadCount:     0,
+		DedupSavedBytes:      0,
+		ReclaimedSizeBytes:   0,
+		ReclaimedCount:       0,
 		PrefixBreakdown:      nil,
 	}
 
@@ -121,7 +157,12 @@ func buildSnapshot(
 		stat.SizeBytes += obj.Size
 		stat.Count++
 
-		if isOrphan(obj.Key, referenced) {
+		// Every reference past the first would have been a copy.
+		if n := refs[obj.Key]; n > 1 {
+			snap.DedupSavedBytes += (n - 1) * obj.Size
+		}
+
+		if isOrphan(obj.Key, refs) {
 			snap.OrphanSizeBytes += obj.Size
 			snap.OrphanCount++
 		}
@@ -138,17 +179,30 @@ func buildSnapshot(
 	return snap
 }
 
-// isOrphan reports whether a books/<id>/… object is no longer referenced by
-// any book file. Cover caches and negative-cache markers are legitimately
-// unreferenced, so they never count as orphans.
-func isOrphan(key string, referenced map[string]bool) bool {
-	if !strings.HasPrefix(key, booksPrefix) {
+// isOrphan reports whether a blobs/… or books/<id>/… object is no longer
+// referenced by any book file. Cover caches and negative-cache markers are
+// legitimately unreferenced, so they never count as orphans.
+func isOrphan(key string, refs map[string]int64) bool {
+	if !strings.HasPrefix(key, booksPrefix) && !strings.HasPrefix(key, blobsPrefix) {
 		return false
 	}
 	if strings.HasSuffix(key, coverSuffix) || strings.HasSuffix(key, coverMissing) {
 		return false
 	}
-	return !referenced[key]
+	return refs[key] == 0
+}
+
+// isCollectable reports whether the scan may delete obj: a stale upload,
+// or an orphan old enough that no in-flight write can still reference it.
+func isCollectable(
+	obj objectstore.ObjectInfo,
+	refs map[string]int64,
+	now time.Time,
+) bool {
+	if isStaleUpload(obj, now) {
+		return true
+	}
+	return isOrphan(obj.Key, refs) && now.Sub(obj.LastModified) > orphanGraceAge
 }
 
 func isStaleUpload(obj objectstore.ObjectInfo, now time.Time) bool {
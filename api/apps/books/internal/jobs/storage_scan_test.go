@@ -15,13 +15,13 @@ import (
 	"tools.xdoubleu.com/internal/models"
 )
 
-type fakeKeyLister struct {
-	keys []string
+type fakeKeyCounter struct {
+	refs map[string]int64
 	err  error
 }
 
-func (f fakeKeyLister) AllStorageKeys(_ context.Context) ([]string, error) {
-	return f.keys, f.err
+func (f fakeKeyCounter) StorageKeyRefs(_ context.Context) (map[string]int64, error) {
+	return f.refs, f.err
 }
 
 type fakeSnapshotStore struct {
@@ -69,7 +69,7 @@ func TestStorageScanClassifiesObjects(t *testing.T) {
 	snapStore := &fakeSnapshotStore{saved: nil, err: nil}
 	job := jobs.NewStorageScanJob(
 		store,
-		fakeKeyLister{keys: []string{"books/b1/abc.epub"}, err: nil},
+		fakeKeyCounter{refs: map[string]int64{"books/b1/abc.epub": 1}, err: nil},
 		snapStore,
 	)
 
@@ -85,6 +85,14 @@ func TestStorageScanClassifiesObjects(t *testing.T) {
 	// Only the stale upload counts.
 	assert.Equal(t, int64(1), snap.StaleUploadCount)
 	assert.Equal(t, int64(len("staledata")), snap.StaleUploadSizeBytes)
+	// The stale upload is collected; the orphan is still within its grace
+	// period and stays.
+	assert.Equal(t, int64(1), snap.ReclaimedCount)
+	assert.Equal(t, int64(len("staledata")), snap.ReclaimedSizeBytes)
+	_, ok := store.GetContent("users/u1/uploads/stale.epub")
+	assert.False(t, ok)
+	_, ok = store.GetContent("books/b3/orphan.epub")
+	assert.True(t, ok)
 
 	// Prefix breakdown covers both top-level prefixes.
 	prefixes := map[string]int64{}
@@ -98,7 +106,7 @@ func TestStorageScanClassifiesObjects(t *testing.T) {
 func TestStorageScanIDAndSchedule(t *testing.T) {
 	job := jobs.NewStorageScanJob(
 		objectstore.NewFake(),
-		fakeKeyLister{keys: nil, err: nil},
+		fakeKeyCounter{refs: nil, err: nil},
 		&fakeSnapshotStore{saved: nil, err: nil},
 	)
 	assert.Equal(t, "books-storage-scan", job.ID())
@@ -109,7 +117,7 @@ func TestStorageScanEmptyBucket(t *testing.T) {
 	snapStore := &fakeSnapshotStore{saved: nil, err: nil}
 	job := jobs.NewStorageScanJob(
 		objectstore.NewFake(),
-		fakeKeyLister{keys: nil, err: nil},
+		fakeKeyCounter{refs: nil, err: nil},
 		snapStore,
 	)
 
@@ -118,3 +126,34 @@ func TestStorageScanEmptyBucket(t *testing.T) {
 	assert.Equal(t, int64(0), snapStore.saved.ObjectCount)
 	assert.Empty(t, snapStore.saved.PrefixBreakdown)
 }
+
+func TestStorageScanCollectsOldOrphansOnly(t *testing.T) {
+	store := objectstore.NewFake()
+	old := time.Now().Add(-48 * time.Hour)
+	store.PutAt("blobs/shared.epub", []byte("shared"), old)
+	store.PutAt("blobs/unreferenced.epub", []byte("gone"), old)
+	store.PutAt("books/b1/cover.jpg", []byte("img"), old)
+
+	snapStore := &fakeSnapshotStore{saved: nil, err: nil}
+	job := jobs.NewStorageScanJob(
+		store,
+		fakeKeyCounter{refs: map[string]int64{"blobs/shared.epub": 3}, err: nil},
+		snapStore,
+	)
+	require.NoError(t, job.Run(t.Context(), logging.NewNopLogger()))
+
+	snap := snapStore.saved
+	require.NotNil(t, snap)
+	assert.Equal(t, int64(1), snap.OrphanCount)
+	assert.Equal(t, int64(1), snap.ReclaimedCount)
+	assert.Equal(t, int64(len("gone")), snap.ReclaimedSizeBytes)
+	// Three rows share one copy, saving two.
+	assert.Equal(t, int64(2*len("shared")), snap.DedupSavedBytes)
+
+	_, ok := store.GetContent("blobs/unreferenced.epub")
+	assert.False(t, ok)
+	_, ok = store.GetContent("blobs/shared.epub")
+	assert.True(t, ok)
+	_, ok = store.GetContent("books/b1/cover.jpg")
+	assert.True(t, ok)
+}
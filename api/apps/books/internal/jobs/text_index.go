@@ -0,0 +1,36 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// textIndexInterval is how often new uploads and articles are picked up for
+// full-text search. Each run indexes a bounded batch (see
+// services.TextIndexService.IndexPending), so a fresh library is worked
+// through over several runs.
+const textIndexInterval = 10 * time.Minute
+
+// textIndexer is the slice of services.TextIndexService the job needs.
+type textIndexer interface {
+	IndexPending(ctx context.Context, logger *slog.Logger) error
+}
+
+// TextIndexJob extracts and indexes the text of book files and articles that
+// were added or changed since the last run.
+type TextIndexJob struct {
+	indexer textIndexer
+}
+
+func NewTextIndexJob(indexer textIndexer) *TextIndexJob {
+	return &TextIndexJob{indexer: indexer}
+}
+
+func (j *TextIndexJob) ID() string { return "books-text-index" }
+
+func (j *TextIndexJob) RunEvery() time.Duration { return textIndexInterval }
+
+func (j *TextIndexJob) Run(ctx context.Context, logger *slog.Logger) error {
+	return j.indexer.IndexPending(ctx, logger)
+}
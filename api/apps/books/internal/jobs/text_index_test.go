@@ -0,0 +1,36 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tools.xdoubleu.com/apps/books/internal/jobs"
+)
+
+type fakeTextIndexer struct {
+	runs int
+	err  error
+}
+
+func (f *fakeTextIndexer) IndexPending(_ context.Context, _ *slog.Logger) error {
+	f.runs++
+	return f.err
+}
+
+func TestTextIndexJob(t *testing.T) {
+	indexer := &fakeTextIndexer{runs: 0, err: nil}
+	job := jobs.NewTextIndexJob(indexer)
+	assert.Equal(t, "books-text-index", job.ID())
+	assert.Equal(t, 10*time.Minute, job.RunEvery())
+
+	assert.NoError(t, job.Run(context.Background(), slog.Default()))
+	assert.Equal(t, 1, indexer.runs)
+
+	indexer.err = errors.New("db down")
+	assert.ErrorIs(t, job.Run(context.Background(), slog.Default()), indexer.err)
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AnnotationTypeHighlight = "highlight"
+	AnnotationTypeNote      = "note"
+	AnnotationTypeDogear    = "dogear"
+)
+
+// Annotation is a highlight, note or bookmark a user made inside a book on a
+// reading device. Location holds the device's raw position payload (for Kobo,
+// the JSON "location" object) so it can be echoed back verbatim.
+type Annotation struct {
+	ID               uuid.UUID
+	UserID           string
+	BookID           uuid.UUID
+	BookTitle        string // filled by list/search queries joining books.books
+	BookAuthors      []string
+	Type             string
+	HighlightedText  string
+	NoteText         string
+	Color            string
+	ChapterTitle     string
+	ChapterProgress  float64
+	Location         []byte
+	Source           string
+	ClientModifiedAt *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
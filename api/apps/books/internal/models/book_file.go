@@ -10,6 +10,7 @@ const (
 	FileFormatPDF   = "pdf"
 	FileFormatEPUB  = "epub"
 	FileFormatKEPUB = "kepub"
+	FileFormatCBZ   = "cbz"
 )
 
 const (
@@ -25,10 +26,16 @@ type KoboSyncBook struct {
 	BookID            uuid.UUID
 	Title             string
 	Authors           []string
+	Series            *string
+	SeriesPosition    *float64
 	Format            string
 	StorageKey        string
 	Size              int64
 	KoboSyncEnabledAt time.Time
+	// Status and Tags are the user's shelf and tags for the book, used to
+	// apply per-device sync filters.
+	Status string
+	Tags   []string
 }
 
 type BookFile struct {
@@ -43,6 +50,21 @@ type BookFile struct {
 	Status           string
 	SourceFileID     *uuid.UUID
 	ConverterVersion int16
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
+	// RenderedStorageKey is the copy served to readers, with metadata and
+	// cover rewritten from the catalog; RenderedMetaHash identifies the
+	// catalog state it was rendered from. Both are nil until the first
+	// download of an EPUB or KEPUB.
+	RenderedStorageKey *string
+	RenderedMetaHash   *string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// ServedStorageKey returns the key of the bytes readers download: the
+// rendered copy when there is one, the stored file otherwise.
+func (f BookFile) ServedStorageKey() string {
+	if f.RenderedStorageKey != nil {
+		return *f.RenderedStorageKey
+	}
+	return f.StorageKey
 }
@@ -7,9 +7,13 @@ import (
 )
 
 const (
-	ReadingSourceWeb    = "web"
-	ReadingSourceKobo   = "kobo"
-	ReadingSourceManual = "manual"
+	ReadingSourceWeb      = "web"
+	ReadingSourceKobo     = "kobo"
+	ReadingSourceManual   = "manual"
+	ReadingSourceKOReader = "koreader"
+	// ReadingSourceFeeds is scroll progress from the feeds reader, on an
+	// article sent to the library from a feed item.
+	ReadingSourceFeeds = "feeds"
 )
 
 type BookReadingState struct {
@@ -0,0 +1,40 @@
+package models
+
+import "github.com/google/uuid"
+
+// TextHitFormatArticle is the TextHit.Format of a match in an ingested
+// article's stored content, read in the in-app article reader rather than
+// from a file.
+const TextHitFormatArticle = "article"
+
+// TextChunk is one indexed piece of a book's text. Location is the content
+// document it came from (an EPUB manifest href), empty for articles.
+type TextChunk struct {
+	Seq      int
+	Chapter  string
+	Location string
+	Body     string
+}
+
+// PendingArticle is an ingested article whose content_html the text index
+// hasn't caught up with yet.
+type PendingArticle struct {
+	BookID uuid.UUID
+	HTML   string
+}
+
+// TextHit is a full-text search match inside a book in the user's library.
+// Format is what to open to read it — FileFormatEPUB, FileFormatKEPUB (a
+// PDF's text lives in its KEPUB conversion) or TextHitFormatArticle — and
+// Location where in it. Snippet is the text around the match with matched
+// words wrapped in <mark></mark>.
+type TextHit struct {
+	UserBookID uuid.UUID
+	BookID     uuid.UUID
+	Title      string
+	Authors    []string
+	Chapter    string
+	Location   string
+	Format     string
+	Snippet    string
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"math"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -46,6 +47,14 @@ type Book struct {
 	// SourceURL is the canonical origin URL for URL-ingested items (dedup
 	// key — unique where set). Nil for books.
 	SourceURL *string
+	// Series is the name of the series the book belongs to, nil when it is a
+	// standalone (or nobody knows better yet). SeriesPosition is its place in
+	// the series — fractional for novellas, 0 for prequels — and SeriesTotal
+	// the number of books in it, each nil when unknown.
+	Series         *string
+	SeriesPosition *float64
+	SeriesTotal    *int
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
@@ -65,6 +74,15 @@ type Book struct {
 	MetadataSource *string
 }
 
+// SeriesIndex formats SeriesPosition the way ebook metadata writes it: "2",
+// "2.5" or "" when the position is unknown.
+func (b Book) SeriesIndex() string {
+	if b.SeriesPosition == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*b.SeriesPosition, 'f', -1, 64)
+}
+
 // HasTag reports whether the user_book has the given tag.
 func (ub UserBook) HasTag(tag string) bool {
 	for _, t := range ub.Tags {
@@ -102,6 +120,12 @@ type UserBook struct {
 	ProgressPercent int
 	AddedAt         time.Time
 	UpdatedAt       time.Time
+	// FeedItemID is the feeds-app item the entry was sent from as an
+	// article, nil for anything else.
+	FeedItemID *uuid.UUID
+	// QueuePosition is the book's place in the "up next" reading queue,
+	// from 0; nil when it isn't queued. Only to-read books are queued.
+	QueuePosition *int
 }
 
 // DisplayProgressPercent returns the reading progress as a 0-100 percentage. In
@@ -140,3 +140,37 @@ func TestUserBook_DisplayProgressPercent(t *testing.T) {
 		})
 	}
 }
+
+func TestKoboSyncFilter_EmptyMatchesEverything(t *testing.T) {
+	f := models.KoboSyncFilter{} //nolint:exhaustruct //zero value is the point
+	assert.True(t, f.IsEmpty())
+	assert.True(t, f.Matches(models.StatusToRead, nil))
+}
+
+func TestKoboSyncFilter_MatchesShelfOrTag(t *testing.T) {
+	f := models.KoboSyncFilter{
+		Shelves: []string{models.StatusReading},
+		Tags:    []string{"commute"},
+	}
+	assert.False(t, f.IsEmpty())
+	assert.True(t, f.Matches(models.StatusReading, nil))
+	assert.True(t, f.Matches(models.StatusToRead, []string{"kobo-sync", "commute"}))
+	assert.False(t, f.Matches(models.StatusToRead, []string{"kobo-sync"}))
+}
+
+func TestBook_SeriesIndex(t *testing.T) {
+	pos := func(f float64) *float64 { return &f }
+	for _, tc := range []struct {
+		pos  *float64
+		want string
+	}{
+		{nil, ""},
+		{pos(2), "2"},
+		{pos(2.5), "2.5"},
+		{pos(0), "0"},
+	} {
+		//nolint:exhaustruct // only the position matters
+		book := models.Book{SeriesPosition: tc.pos}
+		assert.Equal(t, tc.want, book.SeriesIndex())
+	}
+}
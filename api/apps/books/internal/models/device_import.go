@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+const (
+	DeviceReadStatusUnread   = "unread"
+	DeviceReadStatusReading  = "reading"
+	DeviceReadStatusFinished = "finished"
+)
+
+// DeviceImport is a snapshot of reading history read straight off an
+// e-reader's own database, e.g. KoboReader.sqlite via kobo-gateway.
+type DeviceImport struct {
+	Serial string
+	Books  []DeviceImportBook
+	// SkippedHighlights counts device highlights dropped while parsing the
+	// upload, carried through to the result.
+	SkippedHighlights int
+}
+
+// DeviceImportBook is one book's history on the device. ContentID is the
+// device's identifier: our book UUID for books we synced, a file path for
+// sideloaded ones.
+type DeviceImportBook struct {
+	ContentID        string
+	Title            string
+	Authors          []string
+	ISBN             string
+	ReadStatus       string
+	PercentRead      int
+	FirstOpenedAt    *time.Time
+	LastReadAt       *time.Time
+	FinishedAt       *time.Time
+	TimeSpentSeconds int
+	Highlights       []Annotation
+	Sessions         []ReadingSession
+}
+
+// HasHistory reports whether the device recorded any reading activity for
+// the book, as opposed to it merely sitting in the device library.
+func (b DeviceImportBook) HasHistory() bool {
+	return len(b.Highlights) > 0 ||
+		len(b.Sessions) > 0 ||
+		b.TimeSpentSeconds > 0 ||
+		b.PercentRead > 0 ||
+		b.ReadStatus == DeviceReadStatusReading ||
+		b.ReadStatus == DeviceReadStatusFinished
+}
+
+// DeviceImportResult summarises what an import changed.
+type DeviceImportResult struct {
+	Books      int
+	Matched    int
+	Created    int
+	Skipped    int
+	MarkedRead int
+	Highlights int
+	Sessions   int
+
+	SkippedHighlights int
+}
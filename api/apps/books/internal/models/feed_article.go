@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FeedArticle is a feed item sent to the library: its stored article body,
+// with the title, link and feed the library entry is catalogued by.
+type FeedArticle struct {
+	ItemID      uuid.UUID
+	FeedTitle   string
+	Title       string
+	SourceURL   string
+	ContentHTML string
+	PublishedAt time.Time
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FeedDigestItem is a feed item the feeds app offers for a user's daily
+// digest. It carries no article body — the digest asks for the bodies of
+// the items it ends up including separately.
+type FeedDigestItem struct {
+	ID          uuid.UUID
+	UserID      string
+	FeedID      uuid.UUID
+	FeedTitle   string
+	Title       string
+	SourceURL   string
+	PublishedAt time.Time
+}
+
+// FeedDigestEntry is where one feed item sits in a digest EPUB: the
+// content document holding it, and the whole percentage of the book at
+// which that document starts.
+type FeedDigestEntry struct {
+	ItemID       uuid.UUID
+	Href         string
+	StartPercent int
+}
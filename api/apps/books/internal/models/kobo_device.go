@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 // KoboDevice represents a registered Kobo device for a user.
 type KoboDevice struct {
@@ -10,7 +13,35 @@ type KoboDevice struct {
 	Serial     string // may be empty if not known
 	CreatedAt  time.Time
 	LastSeenAt *time.Time // nil if the device has never synced
+	Filter     KoboSyncFilter
 	// LoggingEnabled reflects the in-memory debug-logging switch; it is filled
 	// by the service/handler layer from KoboLogStore, not persisted in the DB.
 	LoggingEnabled bool
 }
+
+// KoboSyncFilter narrows the kobo-sync books a device receives. A book
+// matches when its shelf (status) is one of Shelves or it carries any of
+// Tags; an empty filter matches every book.
+type KoboSyncFilter struct {
+	Shelves []string
+	Tags    []string
+}
+
+// IsEmpty reports whether the filter lets the whole library through.
+func (f KoboSyncFilter) IsEmpty() bool {
+	return len(f.Shelves) == 0 && len(f.Tags) == 0
+}
+
+// Matches reports whether a book on shelf status with the given tags is
+// synced to the device.
+func (f KoboSyncFilter) Matches(status string, tags []string) bool {
+	if f.IsEmpty() || slices.Contains(f.Shelves, status) {
+		return true
+	}
+	for _, t := range tags {
+		if slices.Contains(f.Tags, t) {
+			return true
+		}
+	}
+	return false
+}
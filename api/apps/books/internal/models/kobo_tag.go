@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// KoboTag is a shelf as the Kobo device sees it: a collection with a stable
+// ID. Shelf is the backing shelf (= user_books status) and Items the
+// kobo-sync books currently on it. DeletedAt is set once the shelf or the
+// collection is gone, leaving a tombstone for the next sync.
+type KoboTag struct {
+	ID        uuid.UUID
+	Shelf     string
+	Items     []uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
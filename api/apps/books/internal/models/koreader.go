@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// KOReaderDevice is a set of kosync credentials for one KOReader install.
+// Only a hash of the key KOReader derives from the password is stored.
+type KOReaderDevice struct {
+	ID         string
+	UserID     string
+	Username   string
+	CreatedAt  time.Time
+	LastUsedAt *time.Time // nil if the device has never synced
+}
+
+// KOSyncProgress is a reading position as exchanged with KOReader. Document
+// is KOReader's partial MD5 of the book file and Progress its own location
+// format (an XPointer, or a page number for fixed-layout documents), stored
+// verbatim so devices get back exactly what they sent. BookID is set when
+// the document matched one of the user's book files.
+type KOSyncProgress struct {
+	UserID     string
+	Document   string
+	Progress   string
+	Percentage float64
+	Device     string
+	DeviceID   string
+	BookID     *uuid.UUID
+	UpdatedAt  time.Time
+}
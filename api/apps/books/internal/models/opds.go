@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// OPDSToken is a user's access token for the OPDS catalog, one per reader
+// app. The raw token is never stored, only its hash.
+type OPDSToken struct {
+	ID         string
+	UserID     string
+	Name       string
+	CreatedAt  time.Time
+	LastUsedAt *time.Time // nil if the token has never been used
+}
+
+// OPDSFilter selects the books of an OPDS acquisition feed. Only books with
+// at least one ready file are ever listed. Empty fields don't filter; Recent
+// orders by date added, newest first, instead of by title.
+type OPDSFilter struct {
+	Shelf  string
+	Tag    string
+	Query  string
+	Recent bool
+}
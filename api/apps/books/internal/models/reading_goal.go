@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// Reading goal kinds: a goal counts either finished books or the pages of
+// those books.
+const (
+	GoalKindBooks = "books"
+	GoalKindPages = "pages"
+)
+
+// Where a goal's progress stands against an even pace through the year.
+const (
+	GoalPaceAhead   = "ahead"
+	GoalPaceOnTrack = "on-track"
+	GoalPaceBehind  = "behind"
+)
+
+// ReadingGoal is a target of books or pages to finish in a calendar year.
+// Public goals are shown on the owner's shared reading dashboard.
+type ReadingGoal struct {
+	UserID    string
+	Year      int
+	Kind      string
+	Target    int
+	Public    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ReadingGoalProgress is how far a goal has come. Expected is where an even
+// pace through the year would be by now, and Projected is the year-end total
+// at the current pace; both are fractional.
+type ReadingGoalProgress struct {
+	Goal      ReadingGoal
+	Current   int
+	Expected  float64
+	Projected float64
+	Pace      string
+}
@@ -0,0 +1,14 @@
+package models
+
+// MaxQueueKoboSyncCount caps how many books from the top of the reading
+// queue sync to Kobo on the queue's account.
+const MaxQueueKoboSyncCount = 50
+
+// QueueSettings are a user's reading queue rules. AutoAdvance moves the
+// first queued book to currently-reading when a book being read is
+// finished; KoboSyncCount is how many books from the top of the queue sync
+// to Kobo as if they had the kobo-sync tag (0 for none).
+type QueueSettings struct {
+	AutoAdvance   bool
+	KoboSyncCount int
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReadingSession is one continuous stretch of reading a book. Estimated
+// sessions are synthesised to account for reading time the source reported
+// only as a total, without individual sessions. StartPercent and EndPercent
+// are nil when the source didn't report how far the session got.
+type ReadingSession struct {
+	ID           uuid.UUID
+	UserID       string
+	BookID       uuid.UUID
+	Source       string
+	StartedAt    time.Time
+	EndedAt      time.Time
+	Seconds      int
+	PagesTurned  int
+	StartPercent *int
+	EndPercent   *int
+	Estimated    bool
+	CreatedAt    time.Time
+}
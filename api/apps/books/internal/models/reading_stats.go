@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReadingDay is the reading time spent on one book on one (UTC) day.
+type ReadingDay struct {
+	Date        time.Time
+	BookID      uuid.UUID
+	Seconds     int
+	Sessions    int
+	PagesTurned int
+}
+
+// BookSessionTotals aggregates every recorded session of one book, along
+// with the book's library entry so in-progress books can be told apart.
+//
+// PercentRead only counts sessions that reported where they started and
+// ended, and PercentSeconds is the reading time of exactly those sessions,
+// so the two can be divided into a reading speed. RecentSeconds is the
+// reading time since the cutoff passed to the query.
+type BookSessionTotals struct {
+	BookID         uuid.UUID
+	Title          string
+	Authors        []string
+	Status         string
+	Percent        int
+	Seconds        int
+	Sessions       int
+	PagesTurned    int
+	PercentRead    int
+	PercentSeconds int
+	RecentSeconds  int
+	LastReadAt     time.Time
+}
+
+// BookReadingStats is a book's reading time and speed, plus an estimate of
+// when it will be finished for books still being read.
+type BookReadingStats struct {
+	BookSessionTotals
+	PercentPerHour   float64
+	PagesPerHour     float64
+	RemainingSeconds int
+	EstimatedFinish  *time.Time
+}
+
+// ReadingStats is the reading-time report for a date range.
+type ReadingStats struct {
+	Days  []ReadingDay
+	Books []BookReadingStats
+}
@@ -0,0 +1,297 @@
+package repositories
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/database/postgres"
+	"tools.xdoubleu.com/internal/pagination"
+)
+
+const annotationColumns = `a.id, a.user_id, a.book_id, b.title, b.authors, a.type,
+	a.highlighted_text, a.note_text, a.highlight_color, a.chapter_title,
+	a.chapter_progress, a.location, a.source, a.client_modified_at,
+	a.created_at, a.updated_at`
+
+type AnnotationsRepository struct {
+	db postgres.DB
+}
+
+// WithTx runs fn inside a single transaction, committing on success and rolling
+// back on any error so a device's annotation batch applies atomically.
+func (r *AnnotationsRepository) WithTx(
+	ctx context.Context,
+	fn func(tx pgx.Tx) error,
+) error {
+	//nolint:exhaustruct //default tx options
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+
+	return nil
+}
+
+// Upsert inserts or replaces an annotation by its device-assigned ID. The
+// user_id guard on the update keeps one account from overwriting another's
+// row should two devices ever send a colliding ID. Pass a nil Querier to use
+// the repository's own connection.
+func (r *AnnotationsRepository) Upsert(
+	ctx context.Context,
+	q Querier,
+	a models.Annotation,
+) error {
+	if q == nil {
+		q = r.db
+	}
+
+	query := `
+		INSERT INTO books.annotations
+		    (id, user_id, book_id, type, highlighted_text, note_text,
+		     highlight_color, chapter_title, chapter_progress, location,
+		     source, client_modified_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO UPDATE
+		    SET type = EXCLUDED.type,
+		        highlighted_text = EXCLUDED.highlighted_text,
+		        note_text = EXCLUDED.note_text,
+		        highlight_color = EXCLUDED.highlight_color,
+		        chapter_title = EXCLUDED.chapter_title,
+		        chapter_progress = EXCLUDED.chapter_progress,
+		        location = EXCLUDED.location,
+		        client_modified_at = EXCLUDED.client_modified_at
+		    WHERE books.annotations.user_id = EXCLUDED.user_id
+	`
+
+	_, err := q.Exec(ctx, query,
+		a.ID,
+		a.UserID,
+		a.BookID,
+		a.Type,
+		a.HighlightedText,
+		a.NoteText,
+		a.Color,
+		a.ChapterTitle,
+		a.ChapterProgress,
+		a.Location,
+		a.Source,
+		a.ClientModifiedAt,
+	)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// Restore writes an annotation read back from a backup, keeping its
+// creation time. An annotation the user already has under the same ID is
+// left alone: the device copy is at least as current as the backup. When
+// the ID belongs to another user, as when a backup is restored into a
+// second account, the annotation is stored under an ID derived from both.
+func (r *AnnotationsRepository) Restore(
+	ctx context.Context,
+	a models.Annotation,
+) error {
+	inserted, err := r.restore(ctx, a)
+	if err != nil || inserted {
+		return err
+	}
+
+	var owner string
+	err = r.db.QueryRow(ctx,
+		`SELECT user_id FROM books.annotations WHERE id = $1`, a.ID,
+	).Scan(&owner)
+	if err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+	if owner == a.UserID {
+		return nil
+	}
+	a.ID = uuid.NewSHA1(a.ID, []byte(a.UserID))
+	_, err = r.restore(ctx, a)
+	return err
+}
+
+func (r *AnnotationsRepository) restore(
+	ctx context.Context,
+	a models.Annotation,
+) (bool, error) {
+	query := `
+		INSERT INTO books.annotations
+		    (id, user_id, book_id, type, highlighted_text, note_text,
+		     highlight_color, chapter_title, chapter_progress, location,
+		     source, client_modified_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (id) DO NOTHING
+	`
+
+	tag, err := r.db.Exec(ctx, query,
+		a.ID,
+		a.UserID,
+		a.BookID,
+		a.Type,
+		a.HighlightedText,
+		a.NoteText,
+		a.Color,
+		a.ChapterTitle,
+		a.ChapterProgress,
+		a.Location,
+		a.Source,
+		a.ClientModifiedAt,
+		a.CreatedAt,
+	)
+	if err != nil {
+		return false, postgres.PgxErrorToHTTPError(err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Delete removes the given annotations from one of the user's books. IDs that
+// don't exist (already deleted, or never synced) are ignored.
+func (r *AnnotationsRepository) Delete(
+	ctx context.Context,
+	q Querier,
+	userID string,
+	bookID uuid.UUID,
+	ids []uuid.UUID,
+) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if q == nil {
+		q = r.db
+	}
+	query := `
+		DELETE FROM books.annotations
+		WHERE user_id = $1 AND book_id = $2 AND id = ANY($3)
+	`
+	_, err := q.Exec(ctx, query, userID, bookID, ids)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// ListByBook returns a book's annotations in reading order.
+func (r *AnnotationsRepository) ListByBook(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+) ([]models.Annotation, error) {
+	query := `
+		SELECT ` + annotationColumns + `
+		FROM books.annotations a
+		JOIN books.books b ON b.id = a.book_id
+		WHERE a.user_id = $1 AND a.book_id = $2
+		ORDER BY a.chapter_progress, a.created_at, a.id
+	`
+	return r.query(ctx, query, userID, bookID)
+}
+
+// ListByUser returns every annotation the user has, grouped by book title and
+// then in reading order within each book.
+func (r *AnnotationsRepository) ListByUser(
+	ctx context.Context,
+	userID string,
+) ([]models.Annotation, error) {
+	query := `
+		SELECT ` + annotationColumns + `
+		FROM books.annotations a
+		JOIN books.books b ON b.id = a.book_id
+		WHERE a.user_id = $1
+		ORDER BY b.title, a.book_id, a.chapter_progress, a.created_at, a.id
+	`
+	return r.query(ctx, query, userID)
+}
+
+// Search matches annotations whose highlighted text, note, or book title
+// contain every whitespace-separated word of query, newest first.
+func (r *AnnotationsRepository) Search(
+	ctx context.Context,
+	userID string,
+	query string,
+	limit int32,
+	offset int32,
+) ([]models.Annotation, bool, error) {
+	safeLimit, sqlLimit := pagination.Clamp(limit)
+	tokens := strings.Fields(query)
+
+	q := `
+		SELECT ` + annotationColumns + `
+		FROM books.annotations a
+		JOIN books.books b ON b.id = a.book_id
+		WHERE a.user_id = $1
+		  AND (
+		        SELECT bool_and(
+		            a.highlighted_text ILIKE '%' || t || '%'
+		            OR a.note_text ILIKE '%' || t || '%'
+		            OR b.title ILIKE '%' || t || '%'
+		        )
+		        FROM UNNEST($2::text[]) AS t
+		  )
+		ORDER BY a.updated_at DESC, a.id
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.query(ctx, q, userID, tokens, sqlLimit, offset)
+	if err != nil {
+		return nil, false, err
+	}
+
+	page, hasMore := pagination.Split(rows, safeLimit)
+	return page, hasMore, nil
+}
+
+func (r *AnnotationsRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]models.Annotation, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var annotations []models.Annotation
+	for rows.Next() {
+		a, scanErr := scanAnnotation(rows)
+		if scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		annotations = append(annotations, a)
+	}
+	return annotations, rows.Err()
+}
+
+func scanAnnotation(row pgx.Row) (models.Annotation, error) {
+	var a models.Annotation
+	err := row.Scan(
+		&a.ID,
+		&a.UserID,
+		&a.BookID,
+		&a.BookTitle,
+		&a.BookAuthors,
+		&a.Type,
+		&a.HighlightedText,
+		&a.NoteText,
+		&a.Color,
+		&a.ChapterTitle,
+		&a.ChapterProgress,
+		&a.Location,
+		&a.Source,
+		&a.ClientModifiedAt,
+		&a.CreatedAt,
+		&a.UpdatedAt,
+	)
+	return a, err
+}
@@ -14,7 +14,7 @@ import (
 
 const bookFileColumns = `id, book_id, user_id, format, storage_key, size_bytes,
 	checksum, original_filename, status, source_file_id, converter_version,
-	created_at, updated_at`
+	rendered_storage_key, rendered_meta_hash, created_at, updated_at`
 
 type BookFilesRepository struct {
 	db postgres.DB
@@ -153,34 +153,42 @@ func (r *BookFilesRepository) Delete(
 	return postgres.PgxErrorToHTTPError(err)
 }
 
-// AllStorageKeys returns every distinct storage key referenced by a book
-// file, across all users — the set of R2 objects that are NOT orphaned.
-func (r *BookFilesRepository) AllStorageKeys(
+// StorageKeyRefs returns how many book files reference each storage key,
+// as their stored file or their rendered copy, across all users — the R2
+// objects that are NOT orphaned, and how widely each blob is shared.
+func (r *BookFilesRepository) StorageKeyRefs(
 	ctx context.Context,
-) ([]string, error) {
+) (map[string]int64, error) {
 	rows, err := r.db.Query(
 		ctx,
-		`SELECT DISTINCT storage_key FROM books.book_files`,
+		`SELECT key, count(*) FROM (
+			SELECT storage_key AS key FROM books.book_files
+			UNION ALL
+			SELECT rendered_storage_key FROM books.book_files
+			WHERE rendered_storage_key IS NOT NULL
+		 ) refs
+		 GROUP BY key`,
 	)
 	if err != nil {
 		return nil, postgres.PgxErrorToHTTPError(err)
 	}
 	defer rows.Close()
 
-	var keys []string
+	refs := map[string]int64{}
 	for rows.Next() {
 		var key string
-		if scanErr := rows.Scan(&key); scanErr != nil {
+		var n int64
+		if scanErr := rows.Scan(&key, &n); scanErr != nil {
 			return nil, postgres.PgxErrorToHTTPError(scanErr)
 		}
-		keys = append(keys, key)
+		refs[key] = n
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, postgres.PgxErrorToHTTPError(err)
 	}
 
-	return keys, nil
+	return refs, nil
 }
 
 // DeleteByUserBook removes a user's files for a single book.
@@ -207,7 +215,7 @@ func (r *BookFilesRepository) UpdateAfterConversion(
 	query := `
 		UPDATE books.book_files
 		SET storage_key = $2, size_bytes = $3, status = $4,
-		    converter_version = $5, updated_at = now()
+		    converter_version = $5, koreader_digest = NULL, updated_at = now()
 		WHERE id = $1
 	`
 	_, err := r.db.Exec(
@@ -216,6 +224,25 @@ func (r *BookFilesRepository) UpdateAfterConversion(
 	return postgres.PgxErrorToHTTPError(err)
 }
 
+// SetRendered records the rendered copy of a file served to readers, along
+// with the KOReader digest of that copy.
+func (r *BookFilesRepository) SetRendered(
+	ctx context.Context,
+	id uuid.UUID,
+	renderedKey string,
+	metaHash string,
+	koreaderDigest string,
+) error {
+	query := `
+		UPDATE books.book_files
+		SET rendered_storage_key = $2, rendered_meta_hash = $3,
+		    koreader_digest = $4
+		WHERE id = $1
+	`
+	_, err := r.db.Exec(ctx, query, id, renderedKey, metaHash, koreaderDigest)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
 // FindByChecksumGlobal returns any book_files row with the given checksum,
 // regardless of user or book. Used for global content-addressed deduplication.
 // Returns database.ErrResourceNotFound when no row matches.
@@ -248,6 +275,34 @@ func (r *BookFilesRepository) FindByChecksumGlobal(
 	return f, nil
 }
 
+// FindReadyBlob returns any ready book_files row, of any user or book,
+// holding content with the given checksum and format — its storage key is
+// the blob a new file with that content can share. Returns
+// database.ErrResourceNotFound when no row matches.
+func (r *BookFilesRepository) FindReadyBlob(
+	ctx context.Context,
+	checksum string,
+	format string,
+) (*models.BookFile, error) {
+	query := `
+		SELECT ` + bookFileColumns + `
+		FROM books.book_files
+		WHERE checksum = $1 AND format = $2 AND status = 'ready'
+		ORDER BY created_at
+		LIMIT 1
+	`
+
+	f, err := scanBookFile(r.db.QueryRow(ctx, query, checksum, format))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, database.ErrResourceNotFound
+		}
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+
+	return f, nil
+}
+
 // FindByStorageKeyGlobal returns any ready book_files row with the given
 // storage key, regardless of user or book. Used for cross-user KEPUB
 // deduplication: if another user already converted the same source, their
@@ -337,7 +392,7 @@ func (r *BookFilesRepository) FindByChecksum(
 }
 
 // FormatsByUser returns a map of book ID → sorted list of ready file formats
-// (pdf, epub only — kepub is excluded) for all of a user's books in one query.
+// (pdf, epub, cbz only — kepub is excluded) for all of a user's books in one query.
 func (r *BookFilesRepository) FormatsByUser(
 	ctx context.Context,
 	userID string,
@@ -347,7 +402,7 @@ func (r *BookFilesRepository) FormatsByUser(
 		FROM books.book_files
 		WHERE user_id = $1
 		  AND status = 'ready'
-		  AND format IN ('pdf', 'epub')
+		  AND format IN ('pdf', 'epub', 'cbz')
 		GROUP BY book_id
 	`
 
@@ -374,6 +429,114 @@ func (r *BookFilesRepository) FormatsByUser(
 	return result, nil
 }
 
+// ReadyFormatsByBooks returns a map of book ID → sorted list of ready file
+// formats, kepub included, for the given books of a user.
+func (r *BookFilesRepository) ReadyFormatsByBooks(
+	ctx context.Context,
+	userID string,
+	bookIDs []uuid.UUID,
+) (map[uuid.UUID][]string, error) {
+	query := `
+		SELECT book_id, array_agg(DISTINCT format ORDER BY format)
+		FROM books.book_files
+		WHERE user_id = $1 AND book_id = ANY($2) AND status = 'ready'
+		GROUP BY book_id
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, bookIDs)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	result := make(map[uuid.UUID][]string, len(bookIDs))
+	for rows.Next() {
+		var bookID uuid.UUID
+		var formats []string
+		if scanErr := rows.Scan(&bookID, &formats); scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		result[bookID] = formats
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+
+	return result, nil
+}
+
+// FindBookByKOReaderDigest returns the book whose ready file has the given
+// KOReader document digest. Returns database.ErrResourceNotFound when no file
+// of the user matches.
+func (r *BookFilesRepository) FindBookByKOReaderDigest(
+	ctx context.Context,
+	userID, digest string,
+) (uuid.UUID, error) {
+	query := `
+		SELECT book_id
+		FROM books.book_files
+		WHERE user_id = $1 AND koreader_digest = $2 AND status = 'ready'
+		LIMIT 1
+	`
+
+	var bookID uuid.UUID
+	err := r.db.QueryRow(ctx, query, userID, digest).Scan(&bookID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, database.ErrResourceNotFound
+		}
+		return uuid.Nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return bookID, nil
+}
+
+// ListMissingKOReaderDigests returns the user's ready files whose KOReader
+// digest has not been computed yet.
+func (r *BookFilesRepository) ListMissingKOReaderDigests(
+	ctx context.Context,
+	userID string,
+) ([]models.BookFile, error) {
+	query := `
+		SELECT ` + bookFileColumns + `
+		FROM books.book_files
+		WHERE user_id = $1 AND status = 'ready' AND koreader_digest IS NULL
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var files []models.BookFile
+	for rows.Next() {
+		f, scanErr := scanBookFile(rows)
+		if scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		files = append(files, *f)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return files, nil
+}
+
+// SetKOReaderDigest records the KOReader document digest of a file.
+func (r *BookFilesRepository) SetKOReaderDigest(
+	ctx context.Context,
+	id uuid.UUID,
+	digest string,
+) error {
+	query := `
+		UPDATE books.book_files SET koreader_digest = $2 WHERE id = $1
+	`
+	_, err := r.db.Exec(ctx, query, id, digest)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
 // RepointAndDedup moves all of fromBookID's files to toBookID for a given
 // user. Files whose (format, checksum) pair already exists on toBookID are
 // deleted instead of repointed (they are exact duplicates). The storage_keys
@@ -449,6 +612,8 @@ func scanBookFile(row pgx.Row) (*models.BookFile, error) {
 		&f.Status,
 		&f.SourceFileID,
 		&f.ConverterVersion,
+		&f.RenderedStorageKey,
+		&f.RenderedMetaHash,
 		&f.CreatedAt,
 		&f.UpdatedAt,
 	)
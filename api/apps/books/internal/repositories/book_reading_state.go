@@ -43,6 +43,36 @@ func (r *BookReadingStateRepository) Upsert(
 	return postgres.PgxErrorToHTTPError(err)
 }
 
+// Restore writes a reading state read back from a backup, keeping its
+// timestamp. A state already on the book is only replaced when the backup's
+// is newer.
+func (r *BookReadingStateRepository) Restore(
+	ctx context.Context,
+	state models.BookReadingState,
+) error {
+	query := `
+		INSERT INTO books.book_reading_state
+		    (user_id, book_id, source, percent, location, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id, book_id) DO UPDATE
+		    SET source = EXCLUDED.source,
+		        percent = EXCLUDED.percent,
+		        location = EXCLUDED.location,
+		        updated_at = EXCLUDED.updated_at
+		    WHERE books.book_reading_state.updated_at < EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		state.UserID,
+		state.BookID,
+		state.Source,
+		state.Percent,
+		state.Location,
+		state.UpdatedAt,
+	)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
 func (r *BookReadingStateRepository) Get(
 	ctx context.Context,
 	userID string,
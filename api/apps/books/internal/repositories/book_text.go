@@ -0,0 +1,225 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/database/postgres"
+	"tools.xdoubleu.com/internal/pagination"
+)
+
+// textHeadlineOptions shapes the ts_headline snippet of a hit: one fragment
+// of a sentence or so around the match, matched words wrapped in <mark>.
+const textHeadlineOptions = `StartSel=<mark>, StopSel=</mark>, ` +
+	`MinWords=12, MaxWords=30, MaxFragments=1`
+
+type BookTextRepository struct {
+	db postgres.DB
+}
+
+// ListPendingFiles returns up to limit EPUB and PDF files whose text hasn't
+// been indexed yet, oldest first.
+func (r *BookTextRepository) ListPendingFiles(
+	ctx context.Context,
+	limit int,
+) ([]models.BookFile, error) {
+	query := `
+		SELECT ` + bookFileColumns + `
+		FROM books.book_files
+		WHERE text_indexed_at IS NULL
+		  AND status = $1
+		  AND format IN ($2, $3)
+		ORDER BY created_at
+		LIMIT $4
+	`
+
+	rows, err := r.db.Query(ctx, query,
+		models.FileStatusReady, models.FileFormatEPUB, models.FileFormatPDF, limit,
+	)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var files []models.BookFile
+	for rows.Next() {
+		f, scanErr := scanBookFile(rows)
+		if scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		files = append(files, *f)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return files, nil
+}
+
+// ListPendingArticles returns up to limit books with stored article content
+// that hasn't been indexed yet, oldest first.
+func (r *BookTextRepository) ListPendingArticles(
+	ctx context.Context,
+	limit int,
+) ([]models.PendingArticle, error) {
+	query := `
+		SELECT id, content_html
+		FROM books.books
+		WHERE text_indexed_at IS NULL
+		  AND content_html IS NOT NULL AND content_html <> ''
+		ORDER BY created_at
+		LIMIT $1
+	`
+
+	rows, err := r.db.Query(ctx, query, limit)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var out []models.PendingArticle
+	for rows.Next() {
+		var a models.PendingArticle
+		if scanErr := rows.Scan(&a.BookID, &a.HTML); scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		out = append(out, a)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return out, nil
+}
+
+// ReplaceFileChunks swaps a book file's indexed text for chunks and marks
+// the file indexed. An empty chunks still marks it, so a file without text
+// isn't retried on every run.
+func (r *BookTextRepository) ReplaceFileChunks(
+	ctx context.Context,
+	file models.BookFile,
+	chunks []models.TextChunk,
+) error {
+	//nolint:exhaustruct //QueuedQueries populated via Queue()
+	batch := &pgx.Batch{}
+	batch.Queue(
+		`DELETE FROM books.book_text_chunks WHERE book_file_id = $1`, file.ID,
+	)
+	queueChunkInserts(batch, file.BookID, &file.ID, chunks)
+	batch.Queue(
+		`UPDATE books.book_files SET text_indexed_at = now() WHERE id = $1`,
+		file.ID,
+	)
+
+	err := r.db.SendBatch(ctx, batch).Close()
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// ReplaceArticleChunks swaps an article's indexed text for chunks and marks
+// the article indexed.
+func (r *BookTextRepository) ReplaceArticleChunks(
+	ctx context.Context,
+	bookID uuid.UUID,
+	chunks []models.TextChunk,
+) error {
+	//nolint:exhaustruct //QueuedQueries populated via Queue()
+	batch := &pgx.Batch{}
+	batch.Queue(`
+		DELETE FROM books.book_text_chunks
+		WHERE book_id = $1 AND book_file_id IS NULL
+	`, bookID)
+	queueChunkInserts(batch, bookID, nil, chunks)
+	batch.Queue(
+		`UPDATE books.books SET text_indexed_at = now() WHERE id = $1`, bookID,
+	)
+
+	err := r.db.SendBatch(ctx, batch).Close()
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+func queueChunkInserts(
+	batch *pgx.Batch,
+	bookID uuid.UUID,
+	bookFileID *uuid.UUID,
+	chunks []models.TextChunk,
+) {
+	for _, c := range chunks {
+		batch.Queue(`
+			INSERT INTO books.book_text_chunks
+			    (book_id, book_file_id, seq, chapter, location, body)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, bookID, bookFileID, c.Seq, c.Chapter, c.Location, c.Body)
+	}
+}
+
+// Search matches query (web search syntax: quoted phrases, OR, -word)
+// against the text of the books in the user's library: their own files and
+// the articles in it. A PDF's text is left out when the user has an indexed
+// EPUB of the same book, which reads better and would match the same
+// passages. Hits are ranked best first.
+func (r *BookTextRepository) Search(
+	ctx context.Context,
+	userID string,
+	query string,
+	limit int32,
+	offset int32,
+) ([]models.TextHit, bool, error) {
+	safeLimit, sqlLimit := pagination.Clamp(limit)
+
+	q := `
+		WITH q AS (SELECT websearch_to_tsquery('simple', $2) AS query)
+		SELECT ub.id, b.id, b.title, b.authors, c.chapter, c.location,
+		       CASE
+		           WHEN c.book_file_id IS NULL THEN $5
+		           WHEN bf.format = $6 THEN $7
+		           ELSE bf.format
+		       END,
+		       ts_headline('simple', c.body, q.query, $8)
+		FROM books.book_text_chunks c
+		CROSS JOIN q
+		JOIN books.books b ON b.id = c.book_id
+		JOIN books.user_books ub ON ub.book_id = c.book_id AND ub.user_id = $1
+		LEFT JOIN books.book_files bf ON bf.id = c.book_file_id
+		WHERE c.tsv @@ q.query
+		  AND (c.book_file_id IS NULL OR bf.user_id = $1)
+		  AND NOT (
+		        bf.format IS NOT DISTINCT FROM $6
+		        AND EXISTS (
+		            SELECT 1 FROM books.book_files e
+		            WHERE e.user_id = $1 AND e.book_id = c.book_id
+		              AND e.format = $9 AND e.text_indexed_at IS NOT NULL
+		        )
+		  )
+		ORDER BY ts_rank(c.tsv, q.query) DESC, b.title, c.seq
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Query(ctx, q,
+		userID, query, sqlLimit, offset,
+		models.TextHitFormatArticle, models.FileFormatPDF, models.FileFormatKEPUB,
+		textHeadlineOptions, models.FileFormatEPUB,
+	)
+	if err != nil {
+		return nil, false, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var hits []models.TextHit
+	for rows.Next() {
+		var h models.TextHit
+		if scanErr := rows.Scan(
+			&h.UserBookID, &h.BookID, &h.Title, &h.Authors,
+			&h.Chapter, &h.Location, &h.Format, &h.Snippet,
+		); scanErr != nil {
+			return nil, false, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		hits = append(hits, h)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, false, postgres.PgxErrorToHTTPError(err)
+	}
+
+	page, hasMore := pagination.Split(hits, safeLimit)
+	return page, hasMore, nil
+}
@@ -29,8 +29,9 @@ func (repo *BooksRepository) UpsertBook(
 	query := `
 		INSERT INTO books.books
 		    (title, authors, isbn13, cover_url, description, page_count,
-		     metadata_source, source_url)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		     metadata_source, source_url,
+		     series, series_position, series_total)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		ON CONFLICT (isbn13) WHERE isbn13 IS NOT NULL
 		DO UPDATE SET
 		    title         = EXCLUDED.title,
@@ -41,6 +42,15 @@ func (repo *BooksRepository) UpsertBook(
 		    metadata_source = COALESCE(
 		        EXCLUDED.metadata_source, books.books.metadata_source
 		    ),
+		    series          = COALESCE(EXCLUDED.series, books.books.series),
+		    series_position = CASE
+		        WHEN EXCLUDED.series IS NULL THEN books.books.series_position
+		        ELSE EXCLUDED.series_position
+		    END,
+		    series_total    = CASE
+		        WHEN EXCLUDED.series IS NULL THEN books.books.series_total
+		        ELSE EXCLUDED.series_total
+		    END,
 		    updated_at    = now()
 		RETURNING ` + bookColumns
 
@@ -53,6 +63,9 @@ func (repo *BooksRepository) UpsertBook(
 		book.PageCount,
 		book.MetadataSource,
 		book.SourceURL,
+		book.Series,
+		book.SeriesPosition,
+		book.SeriesTotal,
 	)
 
 	return scanBook(row)
@@ -139,6 +152,9 @@ func (repo *BooksRepository) UpdateBookByID(
 		    description   = $6,
 		    page_count    = $7,
 		    source_url    = COALESCE($8, source_url),
+		    series          = $9,
+		    series_position = $10,
+		    series_total    = $11,
 		    updated_at    = now()
 		WHERE id = $1
 	`
@@ -152,6 +168,9 @@ func (repo *BooksRepository) UpdateBookByID(
 		book.Description,
 		book.PageCount,
 		book.SourceURL,
+		book.Series,
+		book.SeriesPosition,
+		book.SeriesTotal,
 	)
 
 	return postgres.PgxErrorToHTTPError(err)
@@ -199,6 +218,8 @@ func (repo *BooksRepository) UpsertUserBook(
 		    shelf_positions = EXCLUDED.shelf_positions,
 		    rating          = COALESCE(EXCLUDED.rating, books.user_books.rating),
 		    finished_at     = EXCLUDED.finished_at,
+		    queue_position  = CASE WHEN EXCLUDED.status = 'to-read'
+		        THEN books.user_books.queue_position END,
 		    updated_at      = now()
 	`
 
@@ -346,8 +367,9 @@ func (repo *BooksRepository) BatchUpsert(
 	// ---------------------------
 	upsertBookQuery := `
 		INSERT INTO books.books
-		    (title, authors, isbn13, cover_url, description, page_count)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		    (title, authors, isbn13, cover_url, description, page_count,
+		     series, series_position, series_total)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		ON CONFLICT (isbn13) WHERE isbn13 IS NOT NULL
 		DO UPDATE SET
 		    title         = EXCLUDED.title,
@@ -355,6 +377,15 @@ func (repo *BooksRepository) BatchUpsert(
 		    cover_url     = COALESCE(EXCLUDED.cover_url, books.books.cover_url),
 		    description   = COALESCE(EXCLUDED.description, books.books.description),
 		    page_count    = COALESCE(EXCLUDED.page_count, books.books.page_count),
+		    series          = COALESCE(books.books.series, EXCLUDED.series),
+		    series_position = CASE
+		        WHEN books.books.series IS NULL THEN EXCLUDED.series_position
+		        ELSE books.books.series_position
+		    END,
+		    series_total    = CASE
+		        WHEN books.books.series IS NULL THEN EXCLUDED.series_total
+		        ELSE books.books.series_total
+		    END,
 		    updated_at    = now()
 		RETURNING id
 	`
@@ -372,6 +403,9 @@ func (repo *BooksRepository) BatchUpsert(
 			book.CoverURL,
 			book.Description,
 			book.PageCount,
+			book.Series,
+			book.SeriesPosition,
+			book.SeriesTotal,
 		)
 	}
 
@@ -648,15 +682,18 @@ func (repo *BooksRepository) UpdateFinishedAt(
 }
 
 // ListKoboSyncBooks returns all books for a user that have the kobo-sync tag
+// or are near enough the top of the reading queue (see koboQueueEligible),
 // and a ready file to serve to the Kobo device. The file format is chosen
 // per-book: "pdf" when the kobo-format-pdf tag is present, "kepub" otherwise.
+// Queued books come first, in queue order, then the rest by title.
 func (repo *BooksRepository) ListKoboSyncBooks(
 	ctx context.Context,
 	userID string,
 ) ([]models.KoboSyncBook, error) {
 	query := `
-		SELECT b.id, b.title, b.authors, bf.format, bf.storage_key, bf.size_bytes,
-		       COALESCE(ub.kobo_sync_enabled_at, ub.added_at)
+		SELECT b.id, b.title, b.authors, b.series, b.series_position,
+		       bf.format, bf.storage_key, bf.size_bytes,
+		       COALESCE(ub.kobo_sync_enabled_at, ub.added_at), ub.status, ub.tags
 		FROM books.user_books ub
 		JOIN books.books b ON b.id = ub.book_id
 		JOIN books.book_files bf
@@ -667,8 +704,11 @@ func (repo *BooksRepository) ListKoboSyncBooks(
 		        WHEN 'kobo-format-pdf' = ANY(ub.tags) THEN 'pdf'
 		        ELSE 'kepub'
 		    END
-		WHERE ub.user_id = $1 AND 'kobo-sync' = ANY(ub.tags)
-		ORDER BY b.title
+		WHERE ub.user_id = $1
+		  AND ('kobo-sync' = ANY(ub.tags) OR ` + koboQueueEligible + `)
+		ORDER BY CASE WHEN ub.status = 'to-read' THEN ub.queue_position END
+		         NULLS LAST,
+		         b.title
 	`
 
 	rows, err := repo.db.Query(ctx, query, userID)
@@ -681,8 +721,9 @@ func (repo *BooksRepository) ListKoboSyncBooks(
 	for rows.Next() {
 		var b models.KoboSyncBook
 		if scanErr := rows.Scan(
-			&b.BookID, &b.Title, &b.Authors, &b.Format, &b.StorageKey, &b.Size,
-			&b.KoboSyncEnabledAt,
+			&b.BookID, &b.Title, &b.Authors, &b.Series, &b.SeriesPosition,
+			&b.Format, &b.StorageKey, &b.Size,
+			&b.KoboSyncEnabledAt, &b.Status, &b.Tags,
 		); scanErr != nil {
 			return nil, postgres.PgxErrorToHTTPError(scanErr)
 		}
@@ -796,6 +837,10 @@ func (repo *BooksRepository) ListBooksWithISBN13(
 // that ISBN (the NOT EXISTS guard) — preventing a unique-constraint error
 // from a fuzzy title/author match attaching the wrong ISBN. An empty isbn13,
 // or one that collides, leaves the column untouched.
+//
+// series is never blanked either: few sources know a book's series, so one
+// that doesn't shouldn't wipe what title parsing or an uploaded file found.
+// A non-empty series replaces the name, position and total together.
 func (repo *BooksRepository) RefreshBookExternalData(
 	ctx context.Context,
 	bookID uuid.UUID,
@@ -805,6 +850,9 @@ func (repo *BooksRepository) RefreshBookExternalData(
 	isbn13 string,
 	title string,
 	authors []string,
+	series string,
+	seriesPosition *float64,
+	seriesTotal *int,
 	metadataSource string,
 ) error {
 	query := `
@@ -823,18 +871,38 @@ func (repo *BooksRepository) RefreshBookExternalData(
 		                  END,
 		    title       = $6,
 		    authors     = $7,
-		    metadata_source = NULLIF($8, ''),
+		    series      = COALESCE(NULLIF($8, ''), series),
+		    series_position = CASE WHEN $8 <> '' THEN $9 ELSE series_position END,
+		    series_total    = CASE WHEN $8 <> '' THEN $10 ELSE series_total END,
+		    metadata_source = NULLIF($11, ''),
 		    updated_at  = now()
 		WHERE id = $1
 	`
 	_, err := repo.db.Exec(
 		ctx, query,
 		bookID, coverURL, description, pageCount, isbn13,
-		title, authors, metadataSource,
+		title, authors, series, seriesPosition, seriesTotal, metadataSource,
 	)
 	return postgres.PgxErrorToHTTPError(err)
 }
 
+// FillBookSeries sets a book's series when it has none yet, so metadata read
+// from an uploaded file never overrides a series a source or an admin set.
+func (repo *BooksRepository) FillBookSeries(
+	ctx context.Context,
+	bookID uuid.UUID,
+	series string,
+	position *float64,
+) error {
+	query := `
+		UPDATE books.books
+		SET series = $2, series_position = $3, updated_at = now()
+		WHERE id = $1 AND series IS NULL
+	`
+	_, err := repo.db.Exec(ctx, query, bookID, series, position)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
 // UpdateResyncScanStatus records one scan pass's per-source found flags. A
 // nil flag means the source wasn't resolved this pass — not configured, not
 // attempted, skipped because already known, or its call errored — and must
@@ -1009,7 +1077,9 @@ func (repo *BooksRepository) GetCatalogWithUserOverlay(
 		    COALESCE(ub.added_at, b.created_at),
 		    COALESCE(ub.updated_at, b.updated_at),
 		    b.id, b.title, b.authors, b.isbn13, b.cover_url, b.description,
-		    b.page_count, b.source_url, b.created_at, b.updated_at,
+		    b.page_count, b.source_url,
+		    b.series, b.series_position, b.series_total,
+		    b.created_at, b.updated_at,
 		    b.content_html IS NOT NULL AND b.content_html <> ''
 		FROM books.books b
 		LEFT JOIN books.user_books ub
@@ -1154,8 +1224,8 @@ func (repo *BooksRepository) EnsureShelf(
 }
 
 // RenameShelf updates the status of every user_book with status == oldName to
-// newName, and moves the shelf's registry entry along with it. Returns the
-// number of user_books rows affected.
+// newName, and moves the shelf's registry entry and Kobo collection along
+// with it. Returns the number of user_books rows affected.
 // The caller is responsible for rejecting built-in status values.
 func (repo *BooksRepository) RenameShelf(
 	ctx context.Context,
@@ -1190,13 +1260,17 @@ func (repo *BooksRepository) RenameShelf(
 	if _, err = repo.db.Exec(ctx, registryQuery, userID, oldName, newName); err != nil {
 		return 0, postgres.PgxErrorToHTTPError(err)
 	}
+	if err = repo.renameKoboTag(ctx, userID, oldName, newName); err != nil {
+		return 0, err
+	}
 
 	//nolint:gosec // row count is safe for domain values
 	return uint32(tag.RowsAffected()), nil
 }
 
-// DeleteShelf reassigns every book on shelf oldName to targetName and removes
-// oldName from the shelf registry. Returns the number of rows moved.
+// DeleteShelf reassigns every book on shelf oldName to targetName, removes
+// oldName from the shelf registry and tombstones its Kobo collection.
+// Returns the number of rows moved.
 // The caller is responsible for rejecting built-in status values.
 func (repo *BooksRepository) DeleteShelf(
 	ctx context.Context,
@@ -1218,6 +1292,9 @@ func (repo *BooksRepository) DeleteShelf(
 	if _, err = repo.db.Exec(ctx, deleteQuery, userID, oldName); err != nil {
 		return 0, postgres.PgxErrorToHTTPError(err)
 	}
+	if err = repo.deleteKoboTagForShelf(ctx, userID, oldName); err != nil {
+		return 0, err
+	}
 
 	//nolint:gosec // row count is safe for domain values
 	return uint32(tag.RowsAffected()), nil
@@ -1266,7 +1343,8 @@ func (repo *BooksRepository) DeleteTag(
 
 // GetKoboSyncBook returns the single kobo-sync book matching bookID for the
 // user. It uses the same eligibility criteria as ListKoboSyncBooks: the book
-// must have the kobo-sync tag and a ready file. Returns
+// must have the kobo-sync tag or a place near the top of the reading queue,
+// and a ready file. Returns
 // database.ErrResourceNotFound when no matching row exists.
 func (repo *BooksRepository) GetKoboSyncBook(
 	ctx context.Context,
@@ -1274,7 +1352,8 @@ func (repo *BooksRepository) GetKoboSyncBook(
 	bookID uuid.UUID,
 ) (models.KoboSyncBook, error) {
 	query := `
-		SELECT b.id, b.title, b.authors, bf.format, bf.storage_key, bf.size_bytes
+		SELECT b.id, b.title, b.authors, b.series, b.series_position,
+		       bf.format, bf.storage_key, bf.size_bytes
 		FROM books.user_books ub
 		JOIN books.books b ON b.id = ub.book_id
 		JOIN books.book_files bf
@@ -1285,12 +1364,14 @@ func (repo *BooksRepository) GetKoboSyncBook(
 		        WHEN 'kobo-format-pdf' = ANY(ub.tags) THEN 'pdf'
 		        ELSE 'kepub'
 		    END
-		WHERE ub.user_id = $1 AND ub.book_id = $2 AND 'kobo-sync' = ANY(ub.tags)
+		WHERE ub.user_id = $1 AND ub.book_id = $2
+		  AND ('kobo-sync' = ANY(ub.tags) OR ` + koboQueueEligible + `)
 	`
 
 	var b models.KoboSyncBook
 	err := repo.db.QueryRow(ctx, query, userID, bookID).Scan(
-		&b.BookID, &b.Title, &b.Authors, &b.Format, &b.StorageKey, &b.Size,
+		&b.BookID, &b.Title, &b.Authors, &b.Series, &b.SeriesPosition,
+		&b.Format, &b.StorageKey, &b.Size,
 	)
 	if err != nil {
 		return models.KoboSyncBook{}, postgres.PgxErrorToHTTPError(err)
@@ -70,13 +70,18 @@ func (repo *BooksRepository) GetBookBySourceURL(
 }
 
 // SetBookContentHTML stores the readability-extracted article body for an
-// in-app read, independent of any EPUB file that may or may not exist.
+// in-app read, independent of any EPUB file that may or may not exist, and
+// queues it for (re-)indexing by the text search.
 func (repo *BooksRepository) SetBookContentHTML(
 	ctx context.Context,
 	bookID uuid.UUID,
 	html string,
 ) error {
-	query := `UPDATE books.books SET content_html = $2 WHERE id = $1`
+	query := `
+		UPDATE books.books
+		SET content_html = $2, text_indexed_at = NULL
+		WHERE id = $1
+	`
 	_, err := repo.db.Exec(ctx, query, bookID, html)
 	return postgres.PgxErrorToHTTPError(err)
 }
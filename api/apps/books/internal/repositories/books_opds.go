@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"strings"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/database/postgres"
+	"tools.xdoubleu.com/internal/pagination"
+)
+
+// opdsHasFile restricts a user_books query to books with a file to serve.
+const opdsHasFile = `EXISTS (
+	SELECT 1 FROM books.book_files bf
+	WHERE bf.user_id = ub.user_id AND bf.book_id = ub.book_id
+	  AND bf.status = 'ready'
+)`
+
+// ListOPDSBooks returns one page of the user's books that have a ready file,
+// narrowed by filter. The query is matched like SearchLibrary: every word
+// must appear in the title or an author.
+func (repo *BooksRepository) ListOPDSBooks(
+	ctx context.Context,
+	userID string,
+	filter models.OPDSFilter,
+	limit int32,
+	offset int32,
+) ([]models.UserBook, bool, error) {
+	safeLimit, sqlLimit := pagination.Clamp(limit)
+
+	order := `b.title, b.id`
+	if filter.Recent {
+		order = `ub.added_at DESC, b.id`
+	}
+
+	q := `
+		SELECT ` + userBookColumns + `
+		FROM books.user_books ub
+		JOIN books.books b ON b.id = ub.book_id
+		WHERE ub.user_id = $1
+		  AND ` + opdsHasFile + `
+		  AND ($2 = '' OR ub.status = $2)
+		  AND ($3 = '' OR $3 = ANY(ub.tags))
+		  AND (
+		        SELECT COALESCE(bool_and(
+		            b.title ILIKE '%' || t || '%'
+		            OR EXISTS (
+		                SELECT 1 FROM UNNEST(b.authors) a WHERE a ILIKE '%' || t || '%'
+		            )
+		        ), true)
+		        FROM UNNEST($4::text[]) AS t
+		  )
+		ORDER BY ` + order + `
+		LIMIT $5 OFFSET $6
+	`
+
+	rows, err := repo.queryUserBooks(
+		ctx, q, userID, filter.Shelf, filter.Tag, strings.Fields(filter.Query),
+		sqlLimit, offset,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	page, hasMore := pagination.Split(rows, safeLimit)
+	return page, hasMore, nil
+}
+
+// ListOPDSTags returns the distinct tags on the user's books that have a
+// ready file, alphabetically.
+func (repo *BooksRepository) ListOPDSTags(
+	ctx context.Context,
+	userID string,
+) ([]string, error) {
+	query := `
+		SELECT DISTINCT t
+		FROM books.user_books ub, UNNEST(ub.tags) AS t
+		WHERE ub.user_id = $1 AND ` + opdsHasFile + `
+		ORDER BY t
+	`
+	rows, err := repo.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var tags []string
+	for rows.Next() {
+		var tag string
+		if scanErr := rows.Scan(&tag); scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		tags = append(tags, tag)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return tags, nil
+}
@@ -12,19 +12,26 @@ import (
 // bookColumns is the standalone column list for books.books selects. The order
 // must match scanBook.
 const bookColumns = `id, title, authors, isbn13, cover_url, description,
-	page_count, source_url, created_at, updated_at,
+	page_count, source_url, series, series_position, series_total,
+	created_at, updated_at,
 	unicat_found, hardcover_found,
 	last_resync_at, metadata_source,
 	content_html IS NOT NULL AND content_html <> ''`
 
 // userBookColumns is the joined column list for user_book selects. The order must
-// match scanUserBookWithBook (user_book columns first, then the joined book).
+// match scanUserBookWithBook (user_book columns first, then the joined book,
+// then the feed item the entry was sent from, if any).
 const userBookColumns = `ub.id, ub.user_id, ub.book_id, ub.status, ub.tags,
 	ub.shelf_positions, ub.rating, ub.finished_at, ub.progress_mode,
 	ub.current_page, ub.progress_percent, ub.added_at, ub.updated_at,
+	CASE WHEN ub.status = 'to-read' THEN ub.queue_position END,
 	b.id, b.title, b.authors, b.isbn13, b.cover_url, b.description,
-	b.page_count, b.source_url, b.created_at, b.updated_at,
-	b.content_html IS NOT NULL AND b.content_html <> ''`
+	b.page_count, b.source_url, b.series, b.series_position, b.series_total,
+	b.created_at, b.updated_at,
+	b.content_html IS NOT NULL AND b.content_html <> '',
+	(SELECT fi.item_id FROM books.feed_item_books fi
+	 WHERE fi.user_id = ub.user_id AND fi.book_id = ub.book_id
+	 ORDER BY fi.created_at LIMIT 1)`
 
 func nullTime(t time.Time) *time.Time {
 	if t.IsZero() {
@@ -45,6 +52,9 @@ func scanBook(row pgx.Row) (*models.Book, error) {
 		&book.Description,
 		&book.PageCount,
 		&book.SourceURL,
+		&book.Series,
+		&book.SeriesPosition,
+		&book.SeriesTotal,
 		&book.CreatedAt,
 		&book.UpdatedAt,
 		&book.UniCatFound,
@@ -79,6 +89,7 @@ func scanUserBookWithBook(rows pgx.Rows) (models.UserBook, error) {
 		&ub.ProgressPercent,
 		&ub.AddedAt,
 		&ub.UpdatedAt,
+		&ub.QueuePosition,
 		&book.ID,
 		&book.Title,
 		&book.Authors,
@@ -87,9 +98,13 @@ func scanUserBookWithBook(rows pgx.Rows) (models.UserBook, error) {
 		&book.Description,
 		&book.PageCount,
 		&book.SourceURL,
+		&book.Series,
+		&book.SeriesPosition,
+		&book.SeriesTotal,
 		&book.CreatedAt,
 		&book.UpdatedAt,
 		&book.HasContent,
+		&ub.FeedItemID,
 	)
 	if err != nil {
 		return models.UserBook{}, err
@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/database/postgres"
+)
+
+type FeedDigestsRepository struct {
+	db postgres.DB
+}
+
+// ExistsForDate reports whether the user already has a digest for date.
+func (r *FeedDigestsRepository) ExistsForDate(
+	ctx context.Context,
+	userID string,
+	date time.Time,
+) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, `
+		SELECT EXISTS (
+		    SELECT 1 FROM books.feed_digests
+		    WHERE user_id = $1 AND digest_date = $2::date
+		)
+	`, userID, date).Scan(&exists)
+	if err != nil {
+		return false, postgres.PgxErrorToHTTPError(err)
+	}
+	return exists, nil
+}
+
+// FilterUndigested returns the subset of itemIDs that no earlier digest of
+// the user's carries, preserving input order.
+func (r *FeedDigestsRepository) FilterUndigested(
+	ctx context.Context,
+	userID string,
+	itemIDs []uuid.UUID,
+) ([]uuid.UUID, error) {
+	if len(itemIDs) == 0 {
+		return nil, nil
+	}
+	query := `
+		SELECT i.id
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS i (id, ord)
+		WHERE NOT EXISTS (
+		    SELECT 1 FROM books.feed_digest_items d
+		    WHERE d.user_id = $1 AND d.item_id = i.id
+		)
+		ORDER BY i.ord
+	`
+	rows, err := r.db.Query(ctx, query, userID, itemIDs)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var out []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if scanErr := rows.Scan(&id); scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		out = append(out, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return out, nil
+}
+
+// Insert records bookID as the user's digest for date, carrying entries.
+func (r *FeedDigestsRepository) Insert(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	date time.Time,
+	entries []models.FeedDigestEntry,
+) error {
+	//nolint:exhaustruct //QueuedQueries populated via Queue()
+	batch := &pgx.Batch{}
+	batch.Queue(`
+		INSERT INTO books.feed_digests (book_id, user_id, digest_date)
+		VALUES ($1, $2, $3::date)
+	`, bookID, userID, date)
+	for _, e := range entries {
+		batch.Queue(`
+			INSERT INTO books.feed_digest_items
+			    (book_id, user_id, item_id, href, start_percent)
+			VALUES ($1, $2, $3, $4, $5)
+		`, bookID, userID, e.ItemID, e.Href, e.StartPercent)
+	}
+
+	err := r.db.SendBatch(ctx, batch).Close()
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// ListReached returns the items of the user's digest bookID whose chapter
+// starts at or before percent and that haven't been marked read yet. It
+// returns nothing when bookID isn't a digest.
+func (r *FeedDigestsRepository) ListReached(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	percent int,
+) ([]uuid.UUID, error) {
+	query := `
+		SELECT item_id
+		FROM books.feed_digest_items
+		WHERE user_id = $1 AND book_id = $2
+		  AND start_percent <= $3 AND marked_read_at IS NULL
+		ORDER BY start_percent
+	`
+	rows, err := r.db.Query(ctx, query, userID, bookID, percent)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var out []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if scanErr := rows.Scan(&id); scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		out = append(out, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return out, nil
+}
+
+// SetMarkedRead records that itemIDs of digest bookID have been marked read
+// in feeds.
+func (r *FeedDigestsRepository) SetMarkedRead(
+	ctx context.Context,
+	bookID uuid.UUID,
+	itemIDs []uuid.UUID,
+) error {
+	_, err := r.db.Exec(ctx, `
+		UPDATE books.feed_digest_items
+		SET marked_read_at = now()
+		WHERE book_id = $1 AND item_id = ANY($2)
+	`, bookID, itemIDs)
+	return postgres.PgxErrorToHTTPError(err)
+}
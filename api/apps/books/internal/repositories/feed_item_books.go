@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/internal/database/postgres"
+)
+
+type FeedItemBooksRepository struct {
+	db postgres.DB
+}
+
+// Link records that the user's feed item was sent to the library as
+// bookID, replacing any earlier entry it was sent as.
+func (r *FeedItemBooksRepository) Link(
+	ctx context.Context,
+	userID string,
+	itemID, bookID uuid.UUID,
+) error {
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO books.feed_item_books (user_id, item_id, book_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, item_id) DO UPDATE SET book_id = EXCLUDED.book_id
+	`, userID, itemID, bookID)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// ListItems returns the user's feed items sent to the library as bookID,
+// which is none for books that didn't come from a feed.
+func (r *FeedItemBooksRepository) ListItems(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+) ([]uuid.UUID, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT item_id
+		FROM books.feed_item_books
+		WHERE user_id = $1 AND book_id = $2
+		ORDER BY created_at
+	`, userID, bookID)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var out []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if scanErr := rows.Scan(&id); scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		out = append(out, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return out, nil
+}
@@ -17,20 +17,20 @@ type KoboDevicesRepository struct {
 	db postgres.DB
 }
 
+const koboDeviceColumns = `id, user_id, name, COALESCE(serial, ''), created_at,
+	last_seen_at, sync_shelves, sync_tags`
+
 // CreateKoboDevice inserts a new device record and returns the persisted model.
 func (r *KoboDevicesRepository) CreateKoboDevice(
 	ctx context.Context,
 	userID, name, serial, tokenHash string,
 ) (models.KoboDevice, error) {
-	var d models.KoboDevice
-	err := r.db.QueryRow(ctx, `
+	return scanKoboDevice(r.db.QueryRow(ctx, `
 		INSERT INTO books.kobo_devices (user_id, name, serial, token_hash)
 		VALUES ($1, $2, NULLIF($3, ''), $4)
-		RETURNING id, user_id, name, COALESCE(serial, ''), created_at, last_seen_at
-	`, userID, name, serial, tokenHash).Scan(
-		&d.ID, &d.UserID, &d.Name, &d.Serial, &d.CreatedAt, &d.LastSeenAt,
-	)
-	return d, err
+		RETURNING `+koboDeviceColumns,
+		userID, name, serial, tokenHash,
+	))
 }
 
 // ListKoboDevices returns all devices for a user, oldest first.
@@ -39,7 +39,7 @@ func (r *KoboDevicesRepository) ListKoboDevices(
 	userID string,
 ) ([]models.KoboDevice, error) {
 	rows, err := r.db.Query(ctx, `
-		SELECT id, user_id, name, COALESCE(serial, ''), created_at, last_seen_at
+		SELECT `+koboDeviceColumns+`
 		FROM books.kobo_devices
 		WHERE user_id = $1
 		ORDER BY created_at ASC
@@ -51,11 +51,9 @@ func (r *KoboDevicesRepository) ListKoboDevices(
 
 	var devices []models.KoboDevice
 	for rows.Next() {
-		var d models.KoboDevice
-		if err = rows.Scan(
-			&d.ID, &d.UserID, &d.Name, &d.Serial, &d.CreatedAt, &d.LastSeenAt,
-		); err != nil {
-			return nil, err
+		d, scanErr := scanKoboDevice(rows)
+		if scanErr != nil {
+			return nil, scanErr
 		}
 		devices = append(devices, d)
 	}
@@ -69,14 +67,11 @@ func (r *KoboDevicesRepository) GetKoboDevice(
 	userID string,
 	deviceID uuid.UUID,
 ) (models.KoboDevice, error) {
-	var d models.KoboDevice
-	err := r.db.QueryRow(ctx, `
-		SELECT id, user_id, name, COALESCE(serial, ''), created_at, last_seen_at
+	d, err := scanKoboDevice(r.db.QueryRow(ctx, `
+		SELECT `+koboDeviceColumns+`
 		FROM books.kobo_devices
 		WHERE id = $1 AND user_id = $2
-	`, deviceID, userID).Scan(
-		&d.ID, &d.UserID, &d.Name, &d.Serial, &d.CreatedAt, &d.LastSeenAt,
-	)
+	`, deviceID, userID))
 	if errors.Is(err, pgx.ErrNoRows) {
 		return models.KoboDevice{}, database.ErrResourceNotFound
 	}
@@ -135,3 +130,78 @@ func (r *KoboDevicesRepository) GetLastSeenAt(
 	}
 	return lastSeen, nil
 }
+
+// SetKoboDeviceFilter replaces a device's sync filter and returns the updated
+// device. Returns database.ErrResourceNotFound when no matching row exists.
+func (r *KoboDevicesRepository) SetKoboDeviceFilter(
+	ctx context.Context,
+	userID string,
+	deviceID uuid.UUID,
+	filter models.KoboSyncFilter,
+) (models.KoboDevice, error) {
+	d, err := scanKoboDevice(r.db.QueryRow(ctx, `
+		UPDATE books.kobo_devices
+		SET sync_shelves = $3, sync_tags = $4
+		WHERE id = $1 AND user_id = $2
+		RETURNING `+koboDeviceColumns,
+		deviceID, userID, filter.Shelves, filter.Tags,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.KoboDevice{}, database.ErrResourceNotFound
+	}
+	return d, err
+}
+
+// RecordKoboDeviceBooks records bookIDs as the books now on the device and
+// archives every other book it was sent before. It returns the device's
+// archived books, each with the time it was archived.
+func (r *KoboDevicesRepository) RecordKoboDeviceBooks(
+	ctx context.Context,
+	deviceID uuid.UUID,
+	bookIDs []uuid.UUID,
+) ([]models.KoboRemoval, error) {
+	// A nil slice is sent as NULL, and NOT (book_id = ANY(NULL)) would then
+	// archive nothing.
+	if bookIDs == nil {
+		bookIDs = []uuid.UUID{}
+	}
+	if _, err := r.db.Exec(ctx, `
+		INSERT INTO books.kobo_device_books (device_id, book_id)
+		SELECT $1, unnest($2::uuid[])
+		ON CONFLICT (device_id, book_id) DO UPDATE
+		SET archived_at = NULL
+		WHERE books.kobo_device_books.archived_at IS NOT NULL
+	`, deviceID, bookIDs); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, `
+		UPDATE books.kobo_device_books
+		SET archived_at = COALESCE(archived_at, now())
+		WHERE device_id = $1 AND NOT (book_id = ANY($2))
+		RETURNING book_id, archived_at
+	`, deviceID, bookIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var archived []models.KoboRemoval
+	for rows.Next() {
+		var rm models.KoboRemoval
+		if err = rows.Scan(&rm.BookID, &rm.RemovedAt); err != nil {
+			return nil, err
+		}
+		archived = append(archived, rm)
+	}
+	return archived, rows.Err()
+}
+
+func scanKoboDevice(row pgx.Row) (models.KoboDevice, error) {
+	var d models.KoboDevice
+	err := row.Scan(
+		&d.ID, &d.UserID, &d.Name, &d.Serial, &d.CreatedAt, &d.LastSeenAt,
+		&d.Filter.Shelves, &d.Filter.Tags,
+	)
+	return d, err
+}
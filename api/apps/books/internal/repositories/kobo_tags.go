@@ -0,0 +1,225 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/database"
+	"tools.xdoubleu.com/internal/database/postgres"
+)
+
+const koboTagColumns = `id, shelf, items, created_at, updated_at, deleted_at`
+
+// RefreshKoboTags brings the user's Kobo collections in line with their
+// shelves before a sync: every registered shelf not in skip gets a
+// collection, collections whose shelf has disappeared are tombstoned, and
+// each live collection's items are recomputed from the kobo-sync books on
+// its shelf (bumping updated_at only when they changed).
+func (repo *BooksRepository) RefreshKoboTags(
+	ctx context.Context,
+	userID string,
+	skip []string,
+) error {
+	insertQuery := `
+		INSERT INTO books.kobo_tags (user_id, shelf)
+		SELECT user_id, name
+		FROM books.shelves
+		WHERE user_id = $1 AND NOT (name = ANY($2))
+		ON CONFLICT (user_id, shelf) WHERE deleted_at IS NULL DO NOTHING
+	`
+	if _, err := repo.db.Exec(ctx, insertQuery, userID, skip); err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+
+	// Collections backed by a skipped (built-in) status are only ever
+	// created from the device and have no registry entry to check.
+	orphanQuery := `
+		UPDATE books.kobo_tags t
+		SET deleted_at = now(), updated_at = now()
+		WHERE t.user_id = $1
+		  AND t.deleted_at IS NULL
+		  AND NOT (t.shelf = ANY($2))
+		  AND NOT EXISTS (
+		      SELECT 1 FROM books.shelves s
+		      WHERE s.user_id = t.user_id AND s.name = t.shelf
+		  )
+	`
+	if _, err := repo.db.Exec(ctx, orphanQuery, userID, skip); err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+
+	itemsQuery := `
+		WITH current AS (
+		    SELECT t.id, ARRAY(
+		        SELECT ub.book_id
+		        FROM books.user_books ub
+		        WHERE ub.user_id = t.user_id
+		          AND ub.status = t.shelf
+		          AND 'kobo-sync' = ANY(ub.tags)
+		        ORDER BY ub.book_id
+		    ) AS items
+		    FROM books.kobo_tags t
+		    WHERE t.user_id = $1 AND t.deleted_at IS NULL
+		)
+		UPDATE books.kobo_tags t
+		SET items = current.items, updated_at = now()
+		FROM current
+		WHERE t.id = current.id AND t.items IS DISTINCT FROM current.items
+	`
+	if _, err := repo.db.Exec(ctx, itemsQuery, userID); err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+	return nil
+}
+
+// ListKoboTags returns every Kobo collection of the user, tombstones
+// included, oldest first.
+func (repo *BooksRepository) ListKoboTags(
+	ctx context.Context,
+	userID string,
+) ([]models.KoboTag, error) {
+	query := `
+		SELECT ` + koboTagColumns + `
+		FROM books.kobo_tags
+		WHERE user_id = $1
+		ORDER BY created_at, id
+	`
+	rows, err := repo.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var tags []models.KoboTag
+	for rows.Next() {
+		tag, scanErr := scanKoboTag(rows)
+		if scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		tags = append(tags, tag)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return tags, nil
+}
+
+// GetKoboTag returns a live (not tombstoned) collection by ID.
+func (repo *BooksRepository) GetKoboTag(
+	ctx context.Context,
+	userID string,
+	id uuid.UUID,
+) (*models.KoboTag, error) {
+	query := `
+		SELECT ` + koboTagColumns + `
+		FROM books.kobo_tags
+		WHERE user_id = $1 AND id = $2 AND deleted_at IS NULL
+	`
+	tag, err := scanKoboTag(repo.db.QueryRow(ctx, query, userID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, database.ErrResourceNotFound
+		}
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return &tag, nil
+}
+
+// EnsureKoboTag returns the live collection for shelf, creating it if
+// needed, so a collection the device creates under an existing shelf's name
+// merges into that shelf's collection.
+func (repo *BooksRepository) EnsureKoboTag(
+	ctx context.Context,
+	userID string,
+	shelf string,
+) (models.KoboTag, error) {
+	query := `
+		INSERT INTO books.kobo_tags (user_id, shelf)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id, shelf) WHERE deleted_at IS NULL
+		DO UPDATE SET shelf = EXCLUDED.shelf
+		RETURNING ` + koboTagColumns
+	tag, err := scanKoboTag(repo.db.QueryRow(ctx, query, userID, shelf))
+	if err != nil {
+		return models.KoboTag{}, postgres.PgxErrorToHTTPError(err)
+	}
+	return tag, nil
+}
+
+// DeleteKoboTag tombstones a collection without touching its shelf.
+func (repo *BooksRepository) DeleteKoboTag(
+	ctx context.Context,
+	userID string,
+	id uuid.UUID,
+) error {
+	query := `
+		UPDATE books.kobo_tags
+		SET deleted_at = now(), updated_at = now()
+		WHERE user_id = $1 AND id = $2 AND deleted_at IS NULL
+	`
+	_, err := repo.db.Exec(ctx, query, userID, id)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// renameKoboTag carries a shelf rename over to its collection, keeping the
+// collection ID. When newName already has a collection the two shelves have
+// merged, so the old collection is tombstoned instead.
+func (repo *BooksRepository) renameKoboTag(
+	ctx context.Context,
+	userID string,
+	oldName string,
+	newName string,
+) error {
+	mergeQuery := `
+		UPDATE books.kobo_tags
+		SET deleted_at = now(), updated_at = now()
+		WHERE user_id = $1 AND shelf = $2 AND deleted_at IS NULL
+		  AND EXISTS (
+		      SELECT 1 FROM books.kobo_tags
+		      WHERE user_id = $1 AND shelf = $3 AND deleted_at IS NULL
+		  )
+	`
+	if _, err := repo.db.Exec(ctx, mergeQuery, userID, oldName, newName); err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+
+	renameQuery := `
+		UPDATE books.kobo_tags
+		SET shelf = $3, updated_at = now()
+		WHERE user_id = $1 AND shelf = $2 AND deleted_at IS NULL
+	`
+	_, err := repo.db.Exec(ctx, renameQuery, userID, oldName, newName)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// deleteKoboTagForShelf tombstones the collection backing a deleted shelf.
+func (repo *BooksRepository) deleteKoboTagForShelf(
+	ctx context.Context,
+	userID string,
+	name string,
+) error {
+	query := `
+		UPDATE books.kobo_tags
+		SET deleted_at = now(), updated_at = now()
+		WHERE user_id = $1 AND shelf = $2 AND deleted_at IS NULL
+	`
+	_, err := repo.db.Exec(ctx, query, userID, name)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+func scanKoboTag(row pgx.Row) (models.KoboTag, error) {
+	var tag models.KoboTag
+	err := row.Scan(
+		&tag.ID,
+		&tag.Shelf,
+		&tag.Items,
+		&tag.CreatedAt,
+		&tag.UpdatedAt,
+		&tag.DeletedAt,
+	)
+	return tag, err
+}
@@ -0,0 +1,175 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/database"
+	"tools.xdoubleu.com/internal/database/postgres"
+)
+
+const kosyncProgressColumns = `user_id, document, progress, percentage, device,
+	device_id, book_id, updated_at`
+
+type KOReaderRepository struct {
+	db postgres.DB
+}
+
+// CreateDevice inserts a new set of KOReader credentials and returns the
+// persisted model.
+func (r *KOReaderRepository) CreateDevice(
+	ctx context.Context,
+	userID, username, keyHash string,
+) (models.KOReaderDevice, error) {
+	var d models.KOReaderDevice
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO books.koreader_devices (user_id, username, key_hash)
+		VALUES ($1, $2, $3)
+		RETURNING id, user_id, username, created_at, last_used_at
+	`, userID, username, keyHash).Scan(
+		&d.ID, &d.UserID, &d.Username, &d.CreatedAt, &d.LastUsedAt,
+	)
+	return d, err
+}
+
+// ListDevices returns all KOReader devices for a user, oldest first.
+func (r *KOReaderRepository) ListDevices(
+	ctx context.Context,
+	userID string,
+) ([]models.KOReaderDevice, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, user_id, username, created_at, last_used_at
+		FROM books.koreader_devices
+		WHERE user_id = $1
+		ORDER BY created_at ASC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var devices []models.KOReaderDevice
+	for rows.Next() {
+		var d models.KOReaderDevice
+		if err = rows.Scan(
+			&d.ID, &d.UserID, &d.Username, &d.CreatedAt, &d.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+		devices = append(devices, d)
+	}
+	return devices, rows.Err()
+}
+
+// DeleteDevice removes a device by ID, scoped to the owning user.
+func (r *KOReaderRepository) DeleteDevice(
+	ctx context.Context,
+	userID string,
+	deviceID uuid.UUID,
+) error {
+	tag, err := r.db.Exec(ctx, `
+		DELETE FROM books.koreader_devices WHERE id = $1 AND user_id = $2
+	`, deviceID, userID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return database.ErrResourceNotFound
+	}
+	return nil
+}
+
+// GetDeviceByKeyHash looks up a device by the hash of its key and records
+// the current time as last_used_at in one atomic statement.
+func (r *KOReaderRepository) GetDeviceByKeyHash(
+	ctx context.Context,
+	hash string,
+) (models.KOReaderDevice, error) {
+	var d models.KOReaderDevice
+	err := r.db.QueryRow(ctx, `
+		UPDATE books.koreader_devices
+		SET last_used_at = now()
+		WHERE key_hash = $1
+		RETURNING id, user_id, username, created_at, last_used_at
+	`, hash).Scan(&d.ID, &d.UserID, &d.Username, &d.CreatedAt, &d.LastUsedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.KOReaderDevice{}, database.ErrResourceNotFound
+	}
+	return d, err
+}
+
+// UpsertProgress stores the latest position for a document and returns it
+// with its server timestamp.
+func (r *KOReaderRepository) UpsertProgress(
+	ctx context.Context,
+	p models.KOSyncProgress,
+) (*models.KOSyncProgress, error) {
+	query := `
+		INSERT INTO books.kosync_progress
+		    (user_id, document, progress, percentage, device, device_id, book_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id, document) DO UPDATE
+		    SET progress = EXCLUDED.progress,
+		        percentage = EXCLUDED.percentage,
+		        device = EXCLUDED.device,
+		        device_id = EXCLUDED.device_id,
+		        book_id = EXCLUDED.book_id,
+		        updated_at = now()
+		RETURNING ` + kosyncProgressColumns
+
+	row := r.db.QueryRow(ctx, query,
+		p.UserID, p.Document, p.Progress, p.Percentage, p.Device, p.DeviceID,
+		p.BookID,
+	)
+	saved, err := scanKOSyncProgress(row)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return saved, nil
+}
+
+// GetProgress returns the stored position for a document. Returns
+// database.ErrResourceNotFound when none was ever pushed.
+func (r *KOReaderRepository) GetProgress(
+	ctx context.Context,
+	userID, document string,
+) (*models.KOSyncProgress, error) {
+	query := `
+		SELECT ` + kosyncProgressColumns + `
+		FROM books.kosync_progress
+		WHERE user_id = $1 AND document = $2
+	`
+
+	p, err := scanKOSyncProgress(r.db.QueryRow(ctx, query, userID, document))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, database.ErrResourceNotFound
+		}
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return p, nil
+}
+
+func scanKOSyncProgress(row pgx.Row) (*models.KOSyncProgress, error) {
+	var p models.KOSyncProgress
+
+	err := row.Scan(
+		&p.UserID,
+		&p.Document,
+		&p.Progress,
+		&p.Percentage,
+		&p.Device,
+		&p.DeviceID,
+		&p.BookID,
+		&p.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
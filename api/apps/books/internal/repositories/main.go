@@ -25,6 +25,15 @@ type Repositories struct {
 	ReadingState *BookReadingStateRepository
 	Progress     *ProgressRepository
 	KoboDevices  *KoboDevicesRepository
+	Annotations  *AnnotationsRepository
+	Sessions     *ReadingSessionsRepository
+	OPDSTokens   *OPDSTokensRepository
+	KOReader     *KOReaderRepository
+	Goals        *ReadingGoalsRepository
+	Text         *BookTextRepository
+	FeedDigests  *FeedDigestsRepository
+	FeedItems    *FeedItemBooksRepository
+	Queue        *ReadingQueueRepository
 }
 
 func New(db postgres.DB) *Repositories {
@@ -34,5 +43,14 @@ func New(db postgres.DB) *Repositories {
 		ReadingState: &BookReadingStateRepository{db: db},
 		Progress:     &ProgressRepository{db: db},
 		KoboDevices:  &KoboDevicesRepository{db: db},
+		Annotations:  &AnnotationsRepository{db: db},
+		Sessions:     &ReadingSessionsRepository{db: db},
+		OPDSTokens:   &OPDSTokensRepository{db: db},
+		KOReader:     &KOReaderRepository{db: db},
+		Goals:        &ReadingGoalsRepository{db: db},
+		Text:         &BookTextRepository{db: db},
+		FeedDigests:  &FeedDigestsRepository{db: db},
+		FeedItems:    &FeedItemBooksRepository{db: db},
+		Queue:        &ReadingQueueRepository{db: db},
 	}
 }
@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/database"
+	"tools.xdoubleu.com/internal/database/postgres"
+)
+
+type OPDSTokensRepository struct {
+	db postgres.DB
+}
+
+// CreateOPDSToken inserts a new token record and returns the persisted model.
+func (r *OPDSTokensRepository) CreateOPDSToken(
+	ctx context.Context,
+	userID, name, tokenHash string,
+) (models.OPDSToken, error) {
+	var t models.OPDSToken
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO books.opds_tokens (user_id, name, token_hash)
+		VALUES ($1, $2, $3)
+		RETURNING id, user_id, name, created_at, last_used_at
+	`, userID, name, tokenHash).Scan(
+		&t.ID, &t.UserID, &t.Name, &t.CreatedAt, &t.LastUsedAt,
+	)
+	return t, err
+}
+
+// ListOPDSTokens returns all tokens for a user, oldest first.
+func (r *OPDSTokensRepository) ListOPDSTokens(
+	ctx context.Context,
+	userID string,
+) ([]models.OPDSToken, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, user_id, name, created_at, last_used_at
+		FROM books.opds_tokens
+		WHERE user_id = $1
+		ORDER BY created_at ASC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tokens []models.OPDSToken
+	for rows.Next() {
+		var t models.OPDSToken
+		if err = rows.Scan(
+			&t.ID, &t.UserID, &t.Name, &t.CreatedAt, &t.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t)
+	}
+	return tokens, rows.Err()
+}
+
+// DeleteOPDSToken removes a token by ID, scoped to the owning user.
+func (r *OPDSTokensRepository) DeleteOPDSToken(
+	ctx context.Context,
+	userID string,
+	tokenID uuid.UUID,
+) error {
+	tag, err := r.db.Exec(ctx, `
+		DELETE FROM books.opds_tokens WHERE id = $1 AND user_id = $2
+	`, tokenID, userID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return database.ErrResourceNotFound
+	}
+	return nil
+}
+
+// GetOPDSUserByTokenHash looks up the owning user by token hash and records
+// the current time as last_used_at in one atomic statement.
+func (r *OPDSTokensRepository) GetOPDSUserByTokenHash(
+	ctx context.Context,
+	hash string,
+) (string, error) {
+	var userID string
+	err := r.db.QueryRow(ctx, `
+		UPDATE books.opds_tokens
+		SET last_used_at = now()
+		WHERE token_hash = $1
+		RETURNING user_id
+	`, hash).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", database.ErrResourceNotFound
+	}
+	return userID, err
+}
@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/database"
+	"tools.xdoubleu.com/internal/database/postgres"
+)
+
+const readingGoalColumns = `user_id, year, kind, target, public, created_at,
+	updated_at`
+
+type ReadingGoalsRepository struct {
+	db postgres.DB
+}
+
+// Upsert creates or replaces the user's goal of g.Kind for g.Year and
+// returns the stored goal.
+func (r *ReadingGoalsRepository) Upsert(
+	ctx context.Context,
+	g models.ReadingGoal,
+) (models.ReadingGoal, error) {
+	query := `
+		INSERT INTO books.reading_goals (user_id, year, kind, target, public)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, year, kind) DO UPDATE SET
+		    target = EXCLUDED.target,
+		    public = EXCLUDED.public,
+		    updated_at = now()
+		RETURNING ` + readingGoalColumns
+
+	stored, err := scanReadingGoal(r.db.QueryRow(ctx, query,
+		g.UserID, g.Year, g.Kind, g.Target, g.Public,
+	))
+	if err != nil {
+		return models.ReadingGoal{}, postgres.PgxErrorToHTTPError(err)
+	}
+	return stored, nil
+}
+
+// Delete removes one goal. Returns database.ErrResourceNotFound when the
+// user has no such goal.
+func (r *ReadingGoalsRepository) Delete(
+	ctx context.Context,
+	userID string,
+	year int,
+	kind string,
+) error {
+	tag, err := r.db.Exec(ctx, `
+		DELETE FROM books.reading_goals
+		WHERE user_id = $1 AND year = $2 AND kind = $3
+	`, userID, year, kind)
+	if err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return database.ErrResourceNotFound
+	}
+	return nil
+}
+
+// ListByYear returns the user's goals for a year, books before pages. With
+// publicOnly set, goals the owner hasn't shared are left out.
+func (r *ReadingGoalsRepository) ListByYear(
+	ctx context.Context,
+	userID string,
+	year int,
+	publicOnly bool,
+) ([]models.ReadingGoal, error) {
+	query := `
+		SELECT ` + readingGoalColumns + `
+		FROM books.reading_goals
+		WHERE user_id = $1 AND year = $2 AND (public OR NOT $3)
+		ORDER BY kind
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, year, publicOnly)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var goals []models.ReadingGoal
+	for rows.Next() {
+		g, scanErr := scanReadingGoal(rows)
+		if scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		goals = append(goals, g)
+	}
+	return goals, rows.Err()
+}
+
+// GetFinishedTotals counts the books the user finished in [start, end) and
+// sums their page counts. A re-read counts again; books without a known
+// page count add no pages.
+func (r *ReadingGoalsRepository) GetFinishedTotals(
+	ctx context.Context,
+	userID string,
+	start, end time.Time,
+) (int, int, error) {
+	query := `
+		SELECT count(*), COALESCE(sum(b.page_count), 0)
+		FROM books.user_books ub
+		JOIN books.books b ON b.id = ub.book_id
+		CROSS JOIN LATERAL unnest(ub.finished_at) AS f(finished)
+		WHERE ub.user_id = $1 AND ub.status = $2
+		  AND f.finished >= $3 AND f.finished < $4
+	`
+
+	var books, pages int
+	err := r.db.QueryRow(
+		ctx, query, userID, models.StatusRead, start, end,
+	).Scan(&books, &pages)
+	if err != nil {
+		return 0, 0, postgres.PgxErrorToHTTPError(err)
+	}
+	return books, pages, nil
+}
+
+func scanReadingGoal(row pgx.Row) (models.ReadingGoal, error) {
+	var g models.ReadingGoal
+	err := row.Scan(
+		&g.UserID,
+		&g.Year,
+		&g.Kind,
+		&g.Target,
+		&g.Public,
+		&g.CreatedAt,
+		&g.UpdatedAt,
+	)
+	return g, err
+}
@@ -0,0 +1,196 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/database"
+	"tools.xdoubleu.com/internal/database/postgres"
+)
+
+// koboQueueEligible matches the user_books row ub when it is among the
+// first kobo_sync_count books of the user's reading queue ($1 is the user),
+// so they sync to Kobo without the kobo-sync tag.
+const koboQueueEligible = `ub.id IN (
+	SELECT q.id FROM (
+		SELECT id, row_number() OVER (ORDER BY queue_position, id) AS rank
+		FROM books.user_books
+		WHERE user_id = $1 AND status = 'to-read'
+		  AND queue_position IS NOT NULL
+	) q
+	WHERE q.rank <= (
+		SELECT COALESCE(max(kobo_sync_count), 0)
+		FROM books.reading_queue_settings WHERE user_id = $1
+	)
+)`
+
+type ReadingQueueRepository struct {
+	db postgres.DB
+}
+
+// Enqueue appends the user's to-read book to the end of their queue. A
+// book that is already queued keeps its place. Returns
+// database.ErrResourceNotFound when the book isn't on the user's to-read
+// shelf.
+func (r *ReadingQueueRepository) Enqueue(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+) error {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE books.user_books
+		SET queue_position = COALESCE(queue_position, (
+		    SELECT COALESCE(max(queue_position) + 1, 0)
+		    FROM books.user_books
+		    WHERE user_id = $1 AND status = 'to-read'
+		))
+		WHERE user_id = $1 AND book_id = $2 AND status = 'to-read'
+	`, userID, bookID)
+	if err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return database.ErrResourceNotFound
+	}
+	return nil
+}
+
+// Dequeue takes the book out of the user's queue. The books behind it keep
+// their positions; the gap is harmless as only the order matters. A book
+// that isn't queued is left as it is.
+func (r *ReadingQueueRepository) Dequeue(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+) error {
+	_, err := r.db.Exec(ctx, `
+		UPDATE books.user_books SET queue_position = NULL
+		WHERE user_id = $1 AND book_id = $2
+	`, userID, bookID)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// Reorder sets queue positions 0,1,2,... for the given book IDs in order,
+// queueing any to-read book among them that wasn't yet. Queued books left
+// out of bookIDs keep their relative order behind the listed ones. IDs that
+// aren't on the user's to-read shelf are ignored.
+func (r *ReadingQueueRepository) Reorder(
+	ctx context.Context,
+	userID string,
+	bookIDs []uuid.UUID,
+) error {
+	_, err := r.db.Exec(ctx, `
+		WITH listed AS (
+		    SELECT book_id, n
+		    FROM unnest($2::uuid[]) WITH ORDINALITY AS l(book_id, n)
+		), ranked AS (
+		    SELECT ub.id,
+		           row_number() OVER (
+		               ORDER BY min(l.n) NULLS LAST, ub.queue_position, ub.id
+		           ) - 1 AS position
+		    FROM books.user_books ub
+		    LEFT JOIN listed l ON l.book_id = ub.book_id
+		    WHERE ub.user_id = $1 AND ub.status = 'to-read'
+		      AND (l.n IS NOT NULL OR ub.queue_position IS NOT NULL)
+		    GROUP BY ub.id, ub.queue_position
+		)
+		UPDATE books.user_books ub SET queue_position = ranked.position
+		FROM ranked
+		WHERE ub.id = ranked.id
+	`, userID, bookIDs)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// ListQueued returns the IDs of the user's queued books, first to last.
+func (r *ReadingQueueRepository) ListQueued(
+	ctx context.Context,
+	userID string,
+) ([]uuid.UUID, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT book_id FROM books.user_books
+		WHERE user_id = $1 AND status = 'to-read'
+		  AND queue_position IS NOT NULL
+		ORDER BY queue_position, id
+	`, userID)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			return nil, postgres.PgxErrorToHTTPError(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// StartNext moves the first book of the user's queue to currently-reading,
+// taking it out of the queue, and returns its ID. Returns
+// database.ErrResourceNotFound when the queue is empty.
+func (r *ReadingQueueRepository) StartNext(
+	ctx context.Context,
+	userID string,
+) (uuid.UUID, error) {
+	var bookID uuid.UUID
+	err := r.db.QueryRow(ctx, `
+		UPDATE books.user_books
+		SET status = 'currently-reading', queue_position = NULL
+		WHERE id = (
+		    SELECT id FROM books.user_books
+		    WHERE user_id = $1 AND status = 'to-read'
+		      AND queue_position IS NOT NULL
+		    ORDER BY queue_position, id
+		    LIMIT 1
+		    FOR UPDATE SKIP LOCKED
+		)
+		RETURNING book_id
+	`, userID).Scan(&bookID)
+	if err != nil {
+		return uuid.Nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return bookID, nil
+}
+
+// GetSettings returns the user's queue rules, both off when they never set
+// any.
+func (r *ReadingQueueRepository) GetSettings(
+	ctx context.Context,
+	userID string,
+) (models.QueueSettings, error) {
+	var settings models.QueueSettings
+	err := r.db.QueryRow(ctx, `
+		SELECT auto_advance, kobo_sync_count
+		FROM books.reading_queue_settings
+		WHERE user_id = $1
+	`, userID).Scan(&settings.AutoAdvance, &settings.KoboSyncCount)
+	err = postgres.PgxErrorToHTTPError(err)
+	if errors.Is(err, database.ErrResourceNotFound) {
+		return models.QueueSettings{AutoAdvance: false, KoboSyncCount: 0}, nil
+	}
+	return settings, err
+}
+
+// UpsertSettings stores the user's queue rules.
+func (r *ReadingQueueRepository) UpsertSettings(
+	ctx context.Context,
+	userID string,
+	settings models.QueueSettings,
+) error {
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO books.reading_queue_settings
+		    (user_id, auto_advance, kobo_sync_count)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET
+		    auto_advance = EXCLUDED.auto_advance,
+		    kobo_sync_count = EXCLUDED.kobo_sync_count,
+		    updated_at = now()
+	`, userID, settings.AutoAdvance, settings.KoboSyncCount)
+	return postgres.PgxErrorToHTTPError(err)
+}
@@ -0,0 +1,346 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/database/postgres"
+)
+
+const readingSessionColumns = `id, user_id, book_id, source, started_at, ended_at,
+	seconds, pages_turned, start_percent, end_percent, estimated, created_at`
+
+type ReadingSessionsRepository struct {
+	db postgres.DB
+}
+
+// Insert stores a session unless one from the same source already starts at
+// the same instant, and reports whether a row was written.
+func (r *ReadingSessionsRepository) Insert(
+	ctx context.Context,
+	s models.ReadingSession,
+) (bool, error) {
+	query := `
+		INSERT INTO books.reading_sessions
+		    (user_id, book_id, source, started_at, ended_at, seconds,
+		     pages_turned, start_percent, end_percent, estimated)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (user_id, book_id, source, started_at) DO NOTHING
+	`
+
+	tag, err := r.db.Exec(ctx, query,
+		s.UserID,
+		s.BookID,
+		s.Source,
+		s.StartedAt,
+		s.EndedAt,
+		s.Seconds,
+		s.PagesTurned,
+		s.StartPercent,
+		s.EndPercent,
+		s.Estimated,
+	)
+	if err != nil {
+		return false, postgres.PgxErrorToHTTPError(err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// GetLatest returns the book's most recent non-estimated session from one
+// source that ended at or after since. Returns database.ErrResourceNotFound
+// when there is none.
+func (r *ReadingSessionsRepository) GetLatest(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	source string,
+	since time.Time,
+) (models.ReadingSession, error) {
+	query := `
+		SELECT ` + readingSessionColumns + `
+		FROM books.reading_sessions
+		WHERE user_id = $1 AND book_id = $2 AND source = $3
+		  AND NOT estimated AND ended_at >= $4
+		ORDER BY ended_at DESC
+		LIMIT 1
+	`
+
+	s, err := scanReadingSession(
+		r.db.QueryRow(ctx, query, userID, bookID, source, since),
+	)
+	if err != nil {
+		return models.ReadingSession{}, postgres.PgxErrorToHTTPError(err)
+	}
+	return s, nil
+}
+
+// Update overwrites a session's span, reading time, page turns and
+// percentages.
+func (r *ReadingSessionsRepository) Update(
+	ctx context.Context,
+	s models.ReadingSession,
+) error {
+	query := `
+		UPDATE books.reading_sessions
+		SET started_at = $2,
+		    ended_at = $3,
+		    seconds = $4,
+		    pages_turned = $5,
+		    start_percent = $6,
+		    end_percent = $7
+		WHERE id = $1
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		s.ID,
+		s.StartedAt,
+		s.EndedAt,
+		s.Seconds,
+		s.PagesTurned,
+		s.StartPercent,
+		s.EndPercent,
+	)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// ListDays totals reading time per UTC day and book for sessions that
+// started in [start, end).
+func (r *ReadingSessionsRepository) ListDays(
+	ctx context.Context,
+	userID string,
+	start, end time.Time,
+) ([]models.ReadingDay, error) {
+	query := `
+		SELECT (started_at AT TIME ZONE 'UTC')::date AS day, book_id,
+		       sum(seconds), count(*), sum(pages_turned)
+		FROM books.reading_sessions
+		WHERE user_id = $1 AND started_at >= $2 AND started_at < $3
+		GROUP BY day, book_id
+		ORDER BY day, book_id
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, start, end)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var days []models.ReadingDay
+	for rows.Next() {
+		var d models.ReadingDay
+		if err = rows.Scan(
+			&d.Date, &d.BookID, &d.Seconds, &d.Sessions, &d.PagesTurned,
+		); err != nil {
+			return nil, postgres.PgxErrorToHTTPError(err)
+		}
+		days = append(days, d)
+	}
+	return days, rows.Err()
+}
+
+// ListBookTotals aggregates all sessions per book for books with a session
+// that started in [start, end), joined with the book and its library entry.
+// Only timed sessions with both percentages count towards PercentRead and
+// PercentSeconds; RecentSeconds sums the reading time since recentSince.
+func (r *ReadingSessionsRepository) ListBookTotals(
+	ctx context.Context,
+	userID string,
+	start, end, recentSince time.Time,
+) ([]models.BookSessionTotals, error) {
+	query := `
+		WITH totals AS (
+		    SELECT book_id,
+		           sum(seconds) AS seconds,
+		           count(*) AS sessions,
+		           sum(pages_turned) AS pages_turned,
+		           COALESCE(sum(GREATEST(end_percent - start_percent, 0))
+		               FILTER (WHERE timed), 0) AS percent_read,
+		           COALESCE(sum(seconds) FILTER (WHERE timed), 0)
+		               AS percent_seconds,
+		           COALESCE(sum(seconds) FILTER (WHERE started_at >= $4), 0)
+		               AS recent_seconds,
+		           max(ended_at) AS last_read_at,
+		           bool_or(started_at >= $2 AND started_at < $3) AS in_range
+		    FROM (
+		        SELECT *, start_percent IS NOT NULL AND end_percent IS NOT NULL
+		                  AND seconds > 0 AS timed
+		        FROM books.reading_sessions
+		        WHERE user_id = $1
+		    ) s
+		    GROUP BY book_id
+		)
+		SELECT t.book_id, b.title, b.authors,
+		       COALESCE(ub.status, ''), COALESCE(ub.progress_percent, 0),
+		       t.seconds, t.sessions, t.pages_turned, t.percent_read,
+		       t.percent_seconds, t.recent_seconds, t.last_read_at
+		FROM totals t
+		JOIN books.books b ON b.id = t.book_id
+		LEFT JOIN books.user_books ub
+		    ON ub.book_id = t.book_id AND ub.user_id = $1
+		WHERE t.in_range
+		ORDER BY t.last_read_at DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, start, end, recentSince)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var totals []models.BookSessionTotals
+	for rows.Next() {
+		var t models.BookSessionTotals
+		if err = rows.Scan(
+			&t.BookID,
+			&t.Title,
+			&t.Authors,
+			&t.Status,
+			&t.Percent,
+			&t.Seconds,
+			&t.Sessions,
+			&t.PagesTurned,
+			&t.PercentRead,
+			&t.PercentSeconds,
+			&t.RecentSeconds,
+			&t.LastReadAt,
+		); err != nil {
+			return nil, postgres.PgxErrorToHTTPError(err)
+		}
+		totals = append(totals, t)
+	}
+	return totals, rows.Err()
+}
+
+// SumSeconds totals the non-estimated reading time recorded for a book from
+// one source.
+func (r *ReadingSessionsRepository) SumSeconds(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	source string,
+) (int, error) {
+	query := `
+		SELECT COALESCE(sum(seconds), 0)
+		FROM books.reading_sessions
+		WHERE user_id = $1 AND book_id = $2 AND source = $3 AND NOT estimated
+	`
+
+	var total int
+	err := r.db.QueryRow(ctx, query, userID, bookID, source).Scan(&total)
+	if err != nil {
+		return 0, postgres.PgxErrorToHTTPError(err)
+	}
+	return total, nil
+}
+
+// ReplaceEstimated swaps the book's estimated session for this source with s,
+// or just removes it when s is nil. There is at most one estimated session
+// per (user, book, source).
+func (r *ReadingSessionsRepository) ReplaceEstimated(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	source string,
+	s *models.ReadingSession,
+) error {
+	//nolint:exhaustruct //fields are optional
+	batch := &pgx.Batch{}
+	batch.Queue(`
+		DELETE FROM books.reading_sessions
+		WHERE user_id = $1 AND book_id = $2 AND source = $3 AND estimated
+	`, userID, bookID, source)
+	if s != nil {
+		batch.Queue(`
+			INSERT INTO books.reading_sessions
+			    (user_id, book_id, source, started_at, ended_at, seconds,
+			     pages_turned, estimated)
+			VALUES ($1, $2, $3, $4, $5, $6, 0, TRUE)
+			ON CONFLICT (user_id, book_id, source, started_at) DO NOTHING
+		`, userID, bookID, source, s.StartedAt, s.EndedAt, s.Seconds)
+	}
+
+	err := r.db.SendBatch(ctx, batch).Close()
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// ListByBook returns a book's sessions, oldest first.
+func (r *ReadingSessionsRepository) ListByBook(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+) ([]models.ReadingSession, error) {
+	query := `
+		SELECT ` + readingSessionColumns + `
+		FROM books.reading_sessions
+		WHERE user_id = $1 AND book_id = $2
+		ORDER BY started_at
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, bookID)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var sessions []models.ReadingSession
+	for rows.Next() {
+		s, scanErr := scanReadingSession(rows)
+		if scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, rows.Err()
+}
+
+// ListByUser returns every session the user has, per book and oldest first.
+func (r *ReadingSessionsRepository) ListByUser(
+	ctx context.Context,
+	userID string,
+) ([]models.ReadingSession, error) {
+	query := `
+		SELECT ` + readingSessionColumns + `
+		FROM books.reading_sessions
+		WHERE user_id = $1
+		ORDER BY book_id, started_at
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var sessions []models.ReadingSession
+	for rows.Next() {
+		s, scanErr := scanReadingSession(rows)
+		if scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, rows.Err()
+}
+
+func scanReadingSession(row pgx.Row) (models.ReadingSession, error) {
+	var s models.ReadingSession
+	err := row.Scan(
+		&s.ID,
+		&s.UserID,
+		&s.BookID,
+		&s.Source,
+		&s.StartedAt,
+		&s.EndedAt,
+		&s.Seconds,
+		&s.PagesTurned,
+		&s.StartPercent,
+		&s.EndPercent,
+		&s.Estimated,
+		&s.CreatedAt,
+	)
+	return s, err
+}
@@ -24,6 +24,7 @@ type ResyncProposalRow struct {
 // stored proposals blob.
 const resyncProposalColumns = `b.id, b.title, b.authors, b.isbn13, b.cover_url,
 	b.description, b.page_count, b.source_url,
+	b.series, b.series_position, b.series_total,
 	b.created_at, b.updated_at,
 	b.unicat_found, b.hardcover_found,
 	b.last_resync_at, b.metadata_source,
@@ -42,6 +43,9 @@ func scanResyncProposalRow(row pgx.Row) (*ResyncProposalRow, error) {
 		&book.Description,
 		&book.PageCount,
 		&book.SourceURL,
+		&book.Series,
+		&book.SeriesPosition,
+		&book.SeriesTotal,
 		&book.CreatedAt,
 		&book.UpdatedAt,
 		&book.UniCatFound,
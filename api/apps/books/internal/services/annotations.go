@@ -0,0 +1,276 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/repositories"
+	"tools.xdoubleu.com/internal/database"
+)
+
+const (
+	AnnotationExportMarkdown = "markdown"
+	AnnotationExportJSON     = "json"
+)
+
+// AnnotationService stores device highlights/notes and renders them for
+// search and export.
+type AnnotationService struct {
+	annotations *repositories.AnnotationsRepository
+	books       *repositories.BooksRepository
+}
+
+// AnnotationExport is a rendered export ready to be served as a download.
+type AnnotationExport struct {
+	Data        []byte
+	ContentType string
+	Filename    string
+}
+
+// OwnsBook reports whether bookID is in the user's library. Kobo annotation
+// handlers use it to tell our own sideloaded books apart from store purchases,
+// which are left to the upstream reading services.
+func (s *AnnotationService) OwnsBook(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+) (bool, error) {
+	_, err := s.books.GetUserBook(ctx, userID, bookID)
+	if errors.Is(err, database.ErrResourceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Sync applies one device batch in a single transaction: every annotation in
+// updated is upserted against bookID and every ID in deleted is removed.
+func (s *AnnotationService) Sync(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	updated []models.Annotation,
+	deleted []uuid.UUID,
+) error {
+	return s.annotations.WithTx(ctx, func(tx pgx.Tx) error {
+		for _, a := range updated {
+			a.UserID = userID
+			a.BookID = bookID
+			if a.Source == "" {
+				a.Source = models.ReadingSourceKobo
+			}
+			if err := s.annotations.Upsert(ctx, tx, a); err != nil {
+				return err
+			}
+		}
+		return s.annotations.Delete(ctx, tx, userID, bookID, deleted)
+	})
+}
+
+// ListForBook returns a book's annotations in reading order.
+func (s *AnnotationService) ListForBook(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+) ([]models.Annotation, error) {
+	return s.annotations.ListByBook(ctx, userID, bookID)
+}
+
+// Search finds annotations by highlighted text, note, or book title.
+func (s *AnnotationService) Search(
+	ctx context.Context,
+	userID string,
+	query string,
+	limit int32,
+	offset int32,
+) ([]models.Annotation, bool, error) {
+	return s.annotations.Search(ctx, userID, query, limit, offset)
+}
+
+// Export renders the annotations of one book, or of the whole library when
+// bookID is nil, in the requested format (markdown when empty).
+func (s *AnnotationService) Export(
+	ctx context.Context,
+	userID string,
+	bookID *uuid.UUID,
+	format string,
+) (*AnnotationExport, error) {
+	var (
+		annotations []models.Annotation
+		err         error
+	)
+	if bookID != nil {
+		annotations, err = s.annotations.ListByBook(ctx, userID, *bookID)
+	} else {
+		annotations, err = s.annotations.ListByUser(ctx, userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return RenderAnnotationExport(annotations, format)
+}
+
+// RenderAnnotationExport formats annotations, which must already be grouped
+// by book in reading order (as the repository returns them).
+func RenderAnnotationExport(
+	annotations []models.Annotation,
+	format string,
+) (*AnnotationExport, error) {
+	filename := "highlights"
+	if len(annotations) > 0 && allSameBook(annotations) {
+		filename = slugify(annotations[0].BookTitle)
+	}
+
+	switch format {
+	case "", AnnotationExportMarkdown:
+		return &AnnotationExport{
+			Data:        []byte(renderAnnotationsMarkdown(annotations)),
+			ContentType: "text/markdown; charset=utf-8",
+			Filename:    filename + ".md",
+		}, nil
+	case AnnotationExportJSON:
+		data, err := renderAnnotationsJSON(annotations)
+		if err != nil {
+			return nil, err
+		}
+		return &AnnotationExport{
+			Data:        data,
+			ContentType: "application/json",
+			Filename:    filename + ".json",
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+func renderAnnotationsMarkdown(annotations []models.Annotation) string {
+	var sb strings.Builder
+	var lastBook uuid.UUID
+	lastChapter := ""
+
+	for _, a := range annotations {
+		if a.BookID != lastBook {
+			if lastBook != uuid.Nil {
+				sb.WriteString("\n")
+			}
+			fmt.Fprintf(&sb, "# %s\n\n", a.BookTitle)
+			if len(a.BookAuthors) > 0 {
+				fmt.Fprintf(&sb, "_%s_\n\n", strings.Join(a.BookAuthors, ", "))
+			}
+			lastBook = a.BookID
+			lastChapter = ""
+		}
+		if a.ChapterTitle != "" && a.ChapterTitle != lastChapter {
+			fmt.Fprintf(&sb, "## %s\n\n", a.ChapterTitle)
+			lastChapter = a.ChapterTitle
+		}
+
+		switch {
+		case a.HighlightedText != "":
+			text := strings.TrimSpace(a.HighlightedText)
+			for line := range strings.SplitSeq(text, "\n") {
+				fmt.Fprintf(&sb, "> %s\n", line)
+			}
+			sb.WriteString("\n")
+		case a.Type == models.AnnotationTypeDogear:
+			fmt.Fprintf(&sb, "- Bookmark in chapter at %d%%\n\n",
+				int(a.ChapterProgress*models.MaxProgressPercent))
+		}
+		if a.NoteText != "" {
+			fmt.Fprintf(&sb, "%s\n\n", strings.TrimSpace(a.NoteText))
+		}
+	}
+
+	return sb.String()
+}
+
+type annotationExportBook struct {
+	BookID      string                 `json:"bookId"`
+	Title       string                 `json:"title"`
+	Authors     []string               `json:"authors"`
+	Annotations []annotationExportItem `json:"annotations"`
+}
+
+type annotationExportItem struct {
+	ID              string    `json:"id"`
+	Type            string    `json:"type"`
+	HighlightedText string    `json:"highlightedText,omitempty"`
+	NoteText        string    `json:"noteText,omitempty"`
+	Color           string    `json:"color,omitempty"`
+	ChapterTitle    string    `json:"chapterTitle,omitempty"`
+	ChapterProgress float64   `json:"chapterProgress"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+func renderAnnotationsJSON(annotations []models.Annotation) ([]byte, error) {
+	books := []annotationExportBook{}
+	for _, a := range annotations {
+		if len(books) == 0 || books[len(books)-1].BookID != a.BookID.String() {
+			authors := a.BookAuthors
+			if authors == nil {
+				authors = []string{}
+			}
+			books = append(books, annotationExportBook{
+				BookID:      a.BookID.String(),
+				Title:       a.BookTitle,
+				Authors:     authors,
+				Annotations: []annotationExportItem{},
+			})
+		}
+		last := &books[len(books)-1]
+		last.Annotations = append(last.Annotations, annotationExportItem{
+			ID:              a.ID.String(),
+			Type:            a.Type,
+			HighlightedText: a.HighlightedText,
+			NoteText:        a.NoteText,
+			Color:           a.Color,
+			ChapterTitle:    a.ChapterTitle,
+			ChapterProgress: a.ChapterProgress,
+			CreatedAt:       a.CreatedAt.UTC(),
+			UpdatedAt:       a.UpdatedAt.UTC(),
+		})
+	}
+	return json.MarshalIndent(books, "", "  ")
+}
+
+func allSameBook(annotations []models.Annotation) bool {
+	for _, a := range annotations[1:] {
+		if a.BookID != annotations[0].BookID {
+			return false
+		}
+	}
+	return true
+}
+
+// slugify turns a book title into a filesystem-friendly file name stem.
+func slugify(title string) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			sb.WriteRune(r)
+			dash = false
+		case !dash && sb.Len() > 0:
+			sb.WriteByte('-')
+			dash = true
+		}
+	}
+	slug := strings.TrimSuffix(sb.String(), "-")
+	if slug == "" {
+		return "highlights"
+	}
+	return slug
+}
@@ -0,0 +1,106 @@
+//nolint:testpackage // testing unexported export renderers
+package services
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+func testAnnotation(
+	bookID uuid.UUID,
+	title, chapter, text, note string,
+) models.Annotation {
+	return models.Annotation{
+		ID:               uuid.New(),
+		UserID:           "user",
+		BookID:           bookID,
+		BookTitle:        title,
+		BookAuthors:      []string{"George Orwell"},
+		Type:             models.AnnotationTypeHighlight,
+		HighlightedText:  text,
+		NoteText:         note,
+		Color:            "",
+		ChapterTitle:     chapter,
+		ChapterProgress:  0,
+		Location:         nil,
+		Source:           models.ReadingSourceKobo,
+		ClientModifiedAt: nil,
+		CreatedAt:        time.Date(2025, 3, 14, 20, 0, 0, 0, time.UTC),
+		UpdatedAt:        time.Date(2025, 3, 14, 20, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestRenderAnnotationExport_MarkdownGroupsByBookAndChapter(t *testing.T) {
+	book1, book2 := uuid.New(), uuid.New()
+	annotations := []models.Annotation{
+		testAnnotation(book1, "1984", "Chapter 1", "The clocks\nwere striking", ""),
+		testAnnotation(book1, "1984", "Chapter 1", "Big Brother", "Key motif"),
+		testAnnotation(book1, "1984", "Chapter 2", "Thoughtcrime", ""),
+		testAnnotation(book2, "Animal Farm", "", "All animals are equal", ""),
+	}
+
+	export, err := RenderAnnotationExport(annotations, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "highlights.md", export.Filename)
+	assert.Equal(t, "text/markdown; charset=utf-8", export.ContentType)
+	assert.Equal(t, `# 1984
+
+_George Orwell_
+
+## Chapter 1
+
+> The clocks
+> were striking
+
+> Big Brother
+
+Key motif
+
+## Chapter 2
+
+> Thoughtcrime
+
+
+# Animal Farm
+
+_George Orwell_
+
+> All animals are equal
+
+`, string(export.Data))
+}
+
+func TestRenderAnnotationExport_JSONSingleBook(t *testing.T) {
+	book := uuid.New()
+	annotations := []models.Annotation{
+		testAnnotation(book, "Nineteen Eighty-Four!", "Chapter 1", "Big Brother", ""),
+	}
+
+	export, err := RenderAnnotationExport(annotations, AnnotationExportJSON)
+	require.NoError(t, err)
+	assert.Equal(t, "nineteen-eighty-four.json", export.Filename)
+
+	var books []annotationExportBook
+	require.NoError(t, json.Unmarshal(export.Data, &books))
+	require.Len(t, books, 1)
+	assert.Equal(t, book.String(), books[0].BookID)
+	require.Len(t, books[0].Annotations, 1)
+	assert.Equal(t, "Big Brother", books[0].Annotations[0].HighlightedText)
+}
+
+func TestRenderAnnotationExport_EmptyAndUnknownFormat(t *testing.T) {
+	export, err := RenderAnnotationExport(nil, AnnotationExportJSON)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[]`, string(export.Data))
+
+	_, err = RenderAnnotationExport(nil, "pdf")
+	assert.Error(t, err)
+}
@@ -20,7 +20,8 @@ import (
 )
 
 // ErrInvalidFormat is returned when the uploaded file's magic bytes do not
-// match any supported format (epub, pdf).
+// match any supported format (epub, pdf, cbz, mobi/azw3, fb2), or when a
+// mobi/fb2 upload cannot be converted to EPUB (e.g. it is DRM-protected).
 var ErrInvalidFormat = errors.New("unsupported or unrecognized file format")
 
 // ErrFileTooLarge is returned when the declared file size exceeds MaxUploadBytes.
@@ -48,22 +49,28 @@ const MaxUploadBytes = 250 * 1024 * 1024
 // Generous to handle large files on slow connections.
 const uploadPresignTTL = 60 * time.Minute
 
-// magicBytesLen is the number of leading bytes needed to detect file format.
-const magicBytesLen = 4
-
 // maxFilenameBytes caps the stored original_filename to avoid overly long values.
 const maxFilenameBytes = 255
 
-// booksFolderPrefix is the R2 prefix under which per-book asset folders live.
-// Every book's files (epub/pdf/kepub/cover) are stored under
-// books/<bookID>/<name>.
+// booksFolderPrefix is the R2 prefix under which per-book asset folders live:
+// covers and rendered copies, which carry the book's own catalog data, are
+// stored under books/<bookID>/<name>. Files stored before blobs/ existed
+// are there too.
 const booksFolderPrefix = "books/"
 
-// bookFileKey returns the canonical R2 key for a book file:
+// blobsPrefix is the R2 prefix of content-addressed book file blobs. One
+// blob is shared by every book_files row with the same content, across
+// users and books; it is deleted once CountByStorageKey drops to zero.
+const blobsPrefix = "blobs/"
+
+// blobKey returns the content-addressed R2 key for a book file:
 //
-//	books/<bookID>/<checksum><ext>
-func bookFileKey(bookID fmt.Stringer, checksum, ext string) string {
-	return booksFolderPrefix + bookID.String() + "/" + checksum + ext
+//	blobs/<checksum><ext>
+//
+// A KEPUB is keyed by its source file's checksum, so every book file
+// converted from the same source shares one conversion.
+func blobKey(checksum, ext string) string {
+	return blobsPrefix + checksum + ext
 }
 
 // bookCoverKey returns the R2 key used to cache a book's cover image.
@@ -80,6 +87,16 @@ func bookCoverMissingKey(bookID fmt.Stringer) string {
 const extEPUB = ".epub"
 const extPDF = ".pdf"
 const extKEPUB = ".kepub"
+const extCBZ = ".cbz"
+
+// Upload-only extensions: these formats are converted to EPUB on upload, so
+// they only ever appear on temp upload keys.
+const extMOBI = ".mobi"
+const extAZW3 = ".azw3"
+const extFB2 = ".fb2"
+const extFB2Zip = ".fb2.zip"
+
+const contentTypeCBZ = "application/vnd.comicbook+zip"
 
 // UploadFileResult holds the outcome of a successful FinalizeUpload call.
 type UploadFileResult struct {
@@ -253,6 +270,9 @@ type uploadedFile struct {
 	format   string
 	meta     ebookmeta.Metadata
 	checksum string
+	// converted is set once tmp has been replaced by an EPUB built from the
+	// upload, which then no longer matches the bytes at the upload key.
+	converted bool
 }
 
 // loadUploadedFile streams the R2 object at uploadID to a temp file, validates
@@ -280,13 +300,7 @@ func (s *BookService) loadUploadedFile(
 		return nil, fmt.Errorf("stream upload to disk: %w", err)
 	}
 
-	magic := make([]byte, magicBytesLen)
-	if _, readErr := tmp.ReadAt(magic, 0); readErr != nil {
-		_ = tmp.Close()
-		_ = os.Remove(tmp.Name())
-		return nil, ErrInvalidFormat
-	}
-	format := ebookmeta.DetectFormatFromMagic(magic)
+	format := ebookmeta.DetectFormatFromFile(tmp, size)
 	if format == "" {
 		_ = tmp.Close()
 		_ = os.Remove(tmp.Name())
@@ -302,17 +316,20 @@ func (s *BookService) loadUploadedFile(
 	}
 
 	return &uploadedFile{
-		tmp:      tmp,
-		size:     size,
-		format:   format,
-		meta:     meta,
-		checksum: checksum,
+		tmp:       tmp,
+		size:      size,
+		format:    format,
+		meta:      meta,
+		checksum:  checksum,
+		converted: false,
 	}, nil
 }
 
-// finalizeNew handles an upload where the content is genuinely new: validates
-// the bytes, extracts metadata, copies the blob to its canonical content-
-// addressed key, and inserts the book_files row.
+// finalizeNew handles an upload whose checksum the client didn't send or that
+// matched no file: validates the bytes, extracts metadata, links to the blob
+// already holding the same content (the server-side checksum may still
+// match one) or stores a new content-addressed one, and inserts the
+// book_files row.
 func (s *BookService) finalizeNew(
 	ctx context.Context,
 	userID string,
@@ -359,7 +376,18 @@ func (s *BookService) finalizeNew(
 		meta.Authors = []string{authorOverride}
 	}
 
-	// 7. Match existing user_book or upsert a new one.
+	// 7. Convert formats the library does not serve as-is (mobi/azw3, fb2)
+	// to EPUB, using the resolved title/author for the EPUB's own metadata.
+	if needsEPUBConversion(uf.format) {
+		if convErr := convertUploadToEPUB(ctx, uf, meta); convErr != nil {
+			if errors.Is(convErr, ErrInvalidFormat) {
+				_ = s.objectStore.Delete(context.WithoutCancel(ctx), uploadID)
+			}
+			return nil, convErr
+		}
+	}
+
+	// 8. Match existing user_book or upsert a new one.
 	ub, matchedExisting, err := s.recognizeBook(ctx, userID, meta)
 	if err != nil {
 		if errors.Is(err, ErrUnrecognizedBook) {
@@ -368,12 +396,15 @@ func (s *BookService) finalizeNew(
 		return nil, err
 	}
 
-	// 8. Ensure own-digital tag.
+	// 9. Ensure own-digital tag.
 	if tagErr := s.ensureTag(ctx, userID, ub.BookID, models.TagOwnDigital); tagErr != nil {
 		return nil, tagErr
 	}
 
-	// 9. Dedup within (user, book, format) — handles a concurrent finalizeNew.
+	// 9b. Record the file's series on a book that has none yet.
+	s.fillSeriesFromFile(ctx, ub, meta)
+
+	// 10. Dedup within (user, book, format) — handles a concurrent finalizeNew.
 	dupe, dupeErr := s.bookFiles.FindByChecksum(
 		ctx, userID, ub.BookID, uf.format, uf.checksum,
 	)
@@ -389,22 +420,23 @@ func (s *BookService) finalizeNew(
 		return nil, dupeErr
 	}
 
-	// 10. Copy to per-book canonical key; delete the temp upload.
-	canonicalKey := bookFileKey(ub.BookID, uf.checksum, extForFormat(uf.format))
+	// 11. Link to the blob already holding this content, or store it under
+	// its content-addressed key; delete the temp upload.
 	bgCtx := context.WithoutCancel(ctx)
-	if copyErr := s.objectStore.Copy(bgCtx, uploadID, canonicalKey); copyErr != nil {
-		return nil, fmt.Errorf("copy to canonical key: %w", copyErr)
+	storageKey, storeErr := s.storeUploadedBlob(bgCtx, uf, uploadID)
+	if storeErr != nil {
+		return nil, storeErr
 	}
 	_ = s.objectStore.Delete(bgCtx, uploadID)
 
-	// 11. Insert book_files row at the canonical key.
+	// 12. Insert book_files row at the blob's key.
 	bf, err := s.bookFiles.Insert(
 		ctx,
 		models.BookFile{ //nolint:exhaustruct //optional fields
 			BookID:           ub.BookID,
 			UserID:           userID,
 			Format:           uf.format,
-			StorageKey:       canonicalKey,
+			StorageKey:       storageKey,
 			SizeBytes:        uf.size,
 			Checksum:         &uf.checksum,
 			OriginalFilename: &filename,
@@ -422,6 +454,83 @@ func (s *BookService) finalizeNew(
 	}, nil
 }
 
+// fillSeriesFromFile records the series an uploaded file declares, or its
+// title's series annotation, on a book that has no series yet. Failing to do
+// so is logged rather than failing the upload.
+func (s *BookService) fillSeriesFromFile(
+	ctx context.Context,
+	ub *models.UserBook,
+	meta ebookmeta.Metadata,
+) {
+	if ub.Book != nil && ub.Book.Series != nil {
+		return
+	}
+	series, position := meta.Series, parseSeriesIndex(meta.SeriesIndex)
+	if series == "" {
+		series, position = seriesFromTitle(meta.Title)
+	}
+	if series == "" {
+		return
+	}
+	if err := s.books.FillBookSeries(ctx, ub.BookID, series, position); err != nil {
+		s.logger.WarnContext(ctx, "failed to record book series",
+			"book_id", ub.BookID, "err", err)
+	}
+}
+
+// storeUploadedBlob returns the key of the blob uf is stored in. When
+// another book file, of any user or book, already stores the same content,
+// its blob is reused and nothing is copied; otherwise uf is stored at its
+// blobKey.
+func (s *BookService) storeUploadedBlob(
+	ctx context.Context,
+	uf *uploadedFile,
+	uploadID string,
+) (string, error) {
+	existing, err := s.bookFiles.FindReadyBlob(ctx, uf.checksum, uf.format)
+	if err == nil {
+		// A removal racing this upload may have dropped the blob's last
+		// reference, and the object with it; only link to one still there.
+		exists, existsErr := s.objectStore.Exists(ctx, existing.StorageKey)
+		if existsErr == nil && exists {
+			return existing.StorageKey, nil
+		}
+	} else if !errors.Is(err, database.ErrResourceNotFound) {
+		return "", err
+	}
+
+	key := blobKey(uf.checksum, extForFormat(uf.format))
+	if storeErr := s.storeUploadedFile(ctx, uf, uploadID, key); storeErr != nil {
+		return "", storeErr
+	}
+	return key, nil
+}
+
+// storeUploadedFile places uf at canonicalKey. Unconverted uploads are
+// copied server-side from uploadID; converted ones are uploaded from tmp.
+func (s *BookService) storeUploadedFile(
+	ctx context.Context,
+	uf *uploadedFile,
+	uploadID string,
+	canonicalKey string,
+) error {
+	if !uf.converted {
+		if err := s.objectStore.Copy(ctx, uploadID, canonicalKey); err != nil {
+			return fmt.Errorf("copy to canonical key: %w", err)
+		}
+		return nil
+	}
+	if _, err := uf.tmp.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("seek converted epub: %w", err)
+	}
+	if err := s.objectStore.Put(
+		ctx, canonicalKey, uf.tmp, uf.size, "application/epub+zip",
+	); err != nil {
+		return fmt.Errorf("store converted epub: %w", err)
+	}
+	return nil
+}
+
 // titleFromFilename derives a best-effort title from an uploaded file's
 // original filename, for use when the file's own metadata has none (e.g. a
 // PDF with no /Info dictionary Title set). Strips the extension and turns
@@ -465,6 +574,14 @@ func extForContentType(contentType, filename string) string {
 		return extEPUB
 	case contentTypePDF:
 		return extPDF
+	case contentTypeCBZ:
+		return extCBZ
+	case "application/x-mobipocket-ebook":
+		return extMOBI
+	case "application/vnd.amazon.mobi8-ebook":
+		return extAZW3
+	case "application/x-fictionbook+xml":
+		return extFB2
 	}
 	lower := strings.ToLower(filename)
 	switch {
@@ -472,6 +589,16 @@ func extForContentType(contentType, filename string) string {
 		return extEPUB
 	case strings.HasSuffix(lower, extPDF):
 		return extPDF
+	case strings.HasSuffix(lower, extCBZ):
+		return extCBZ
+	case strings.HasSuffix(lower, extMOBI):
+		return extMOBI
+	case strings.HasSuffix(lower, extAZW3):
+		return extAZW3
+	case strings.HasSuffix(lower, extFB2):
+		return extFB2
+	case strings.HasSuffix(lower, extFB2Zip):
+		return extFB2Zip
 	}
 	return ""
 }
@@ -485,6 +612,8 @@ func extForFormat(format string) string {
 		return extKEPUB
 	case models.FileFormatPDF:
 		return extPDF
+	case models.FileFormatCBZ:
+		return extCBZ
 	}
 	return ""
 }
@@ -605,10 +734,11 @@ func (s *BookService) ensureTag(
 type KEPUBStatusResult struct {
 	HasEPUB     bool
 	HasPDF      bool
+	HasCBZ      bool
 	KepubStatus string // "", "converting", "ready", or "failed"
 }
 
-// GetKEPUBStatus reports whether the book has an EPUB or PDF file and the
+// GetKEPUBStatus reports whether the book has an EPUB, PDF or CBZ file and the
 // status of its derived KEPUB. Used by the Kobo-sync toggle to gate the UI
 // and poll conversion progress.
 func (s *BookService) GetKEPUBStatus(
@@ -642,6 +772,18 @@ func (s *BookService) GetKEPUBStatus(
 		return nil, pdfErr
 	}
 
+	_, cbzErr := s.bookFiles.GetByBookAndFormat(
+		ctx,
+		userID,
+		bookID,
+		models.FileFormatCBZ,
+	)
+	if cbzErr == nil {
+		result.HasCBZ = true
+	} else if !errors.Is(cbzErr, database.ErrResourceNotFound) {
+		return nil, cbzErr
+	}
+
 	kepub, kepubErr := s.bookFiles.GetByBookAndFormat(
 		ctx,
 		userID,
@@ -700,8 +842,10 @@ type GetBookFileResult struct {
 	Format    string
 }
 
-// GetBookFile returns a short-lived presigned URL for the book's stored file.
-// format is optional; when empty the first ready pdf/epub is returned.
+// GetBookFile returns a short-lived presigned URL for the book's stored file
+// (for EPUB and KEPUB, a copy carrying the catalog's metadata and cover; see
+// servedStorageKey). format is optional; when empty the first ready
+// pdf/epub/cbz is returned.
 // Returns database.ErrResourceNotFound when no matching file exists (including
 // when the file belongs to a different user — callers must not distinguish).
 func (s *BookService) GetBookFile(
@@ -715,7 +859,8 @@ func (s *BookService) GetBookFile(
 		return nil, err
 	}
 
-	url, presignErr := s.objectStore.PresignGet(ctx, file.StorageKey, presignTTL)
+	key := s.servedStorageKey(ctx, file)
+	url, presignErr := s.objectStore.PresignGet(ctx, key, presignTTL)
 	if presignErr != nil {
 		return nil, fmt.Errorf("presign: %w", presignErr)
 	}
@@ -750,8 +895,8 @@ func (s *BookService) resolveBookFile(
 	return nil, database.ErrResourceNotFound
 }
 
-// FormatsByUser returns a map of book ID → ready file formats (pdf/epub) for a
-// user's entire library in a single query.
+// FormatsByUser returns a map of book ID → ready file formats (pdf/epub/cbz)
+// for a user's entire library in a single query.
 func (s *BookService) FormatsByUser(
 	ctx context.Context,
 	userID string,
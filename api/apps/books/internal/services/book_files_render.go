@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+)
+
+// currentMetadataWriterVersion identifies how served EPUB/KEPUB files are
+// rewritten from the catalog (ebookmeta.RewriteEPUB plus catalogOverrides).
+// It is folded into every rendered copy's hash, so bumping it by hand after
+// a change that alters their output re-renders each file on its next
+// download, much as currentKEPUBConverterVersion retires stale KEPUBs.
+const currentMetadataWriterVersion = 1
+
+// bookRenderedKey returns the R2 key of a rendered copy. The hash covers
+// the source file as well as the catalog state, so users sharing a source
+// blob share its rendered copy too:
+//
+//	books/<bookID>/rendered/<hash><ext>
+func bookRenderedKey(bookID fmt.Stringer, hash, ext string) string {
+	return booksFolderPrefix + bookID.String() + "/rendered/" + hash + ext
+}
+
+// servedStorageKey returns the key to hand a reader for file. EPUBs and
+// KEPUBs are served as a copy whose metadata and cover match the catalog,
+// rendered on first use and again whenever the catalog changes. A failed
+// render is logged and falls back to the stored file, which is still a
+// perfectly readable book.
+func (s *BookService) servedStorageKey(
+	ctx context.Context,
+	file *models.BookFile,
+) string {
+	switch file.Format {
+	case models.FileFormatEPUB, models.FileFormatKEPUB:
+	default:
+		return file.StorageKey
+	}
+	key, err := s.renderedStorageKey(ctx, file)
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to render book file metadata",
+			"file_id", file.ID, "book_id", file.BookID, "err", err)
+		return file.StorageKey
+	}
+	return key
+}
+
+func (s *BookService) renderedStorageKey(
+	ctx context.Context,
+	file *models.BookFile,
+) (string, error) {
+	book, err := s.books.GetBookByID(ctx, file.BookID)
+	if err != nil {
+		return "", err
+	}
+	hasCover, err := s.objectStore.Exists(ctx, bookCoverKey(file.BookID))
+	if err != nil {
+		return "", fmt.Errorf("check cover cache: %w", err)
+	}
+
+	hash := renderHash(file, book, hasCover)
+	if file.RenderedStorageKey != nil && file.RenderedMetaHash != nil &&
+		*file.RenderedMetaHash == hash {
+		return *file.RenderedStorageKey, nil
+	}
+
+	key := bookRenderedKey(file.BookID, hash, extForFormat(file.Format))
+	exists, err := s.objectStore.Exists(ctx, key)
+	if err != nil {
+		return "", fmt.Errorf("check rendered copy: %w", err)
+	}
+	if !exists {
+		if err = s.renderBookFile(ctx, file, book, hasCover, key); err != nil {
+			return "", err
+		}
+	}
+
+	// KOReader identifies the copy it downloaded by its digest, so the new
+	// copy's digest replaces the old one in the same update.
+	digest, err := objectKOReaderDigest(ctx, s.objectStore, key)
+	if err != nil {
+		return "", fmt.Errorf("digest rendered copy: %w", err)
+	}
+	if err = s.bookFiles.SetRendered(ctx, file.ID, key, hash, digest); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// renderHash identifies everything a rendered copy of file is built from.
+func renderHash(file *models.BookFile, book *models.Book, hasCover bool) string {
+	h := sha256.New()
+	for _, part := range []string{
+		strconv.Itoa(currentMetadataWriterVersion),
+		file.StorageKey,
+		strconv.Itoa(int(file.ConverterVersion)),
+		book.Title,
+		strings.Join(book.Authors, "\x1f"),
+		derefStr(book.ISBN13),
+		derefStr(book.Description),
+		derefStr(book.CoverURL),
+		derefStr(book.Series),
+		book.SeriesIndex(),
+		strconv.FormatBool(hasCover),
+	} {
+		_, _ = io.WriteString(h, part)
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// catalogOverrides maps a catalog book onto the fields written into its
+// files. Empty catalog fields leave the file's own values alone.
+func catalogOverrides(book *models.Book, cover []byte) ebookmeta.Overrides {
+	o := ebookmeta.Overrides{
+		Title:       book.Title,
+		Authors:     book.Authors,
+		ISBN13:      book.ISBN13,
+		Description: book.Description,
+		Cover:       cover,
+		Series:      derefStr(book.Series),
+		SeriesIndex: book.SeriesIndex(),
+	}
+	if o.Description != nil && *o.Description == "" {
+		o.Description = nil
+	}
+	return o
+}
+
+// renderBookFile writes a copy of file with book's metadata (and cached
+// cover, when hasCover) to key.
+func (s *BookService) renderBookFile(
+	ctx context.Context,
+	file *models.BookFile,
+	book *models.Book,
+	hasCover bool,
+	key string,
+) error {
+	var cover []byte
+	if hasCover {
+		var err error
+		cover, err = s.downloadObject(ctx, bookCoverKey(book.ID), maxCoverBytes)
+		if err != nil {
+			return fmt.Errorf("download cover: %w", err)
+		}
+	}
+
+	src, err := s.downloadToTemp(ctx, file.StorageKey)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = src.Close()
+		_ = os.Remove(src.Name())
+	}()
+	info, err := src.Stat()
+	if err != nil {
+		return fmt.Errorf("stat book file: %w", err)
+	}
+
+	out, err := os.CreateTemp("", "bookrender-*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer func() {
+		_ = out.Close()
+		_ = os.Remove(out.Name())
+	}()
+
+	overrides := catalogOverrides(book, cover)
+	if err = ebookmeta.RewriteEPUB(out, src, info.Size(), overrides); err != nil {
+		return err
+	}
+	size, err := out.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("size rendered file: %w", err)
+	}
+	if _, err = out.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("rewind rendered file: %w", err)
+	}
+	err = s.objectStore.Put(ctx, key, out, size, "application/epub+zip")
+	if err != nil {
+		return fmt.Errorf("store rendered file: %w", err)
+	}
+	return nil
+}
+
+// downloadToTemp streams the object at key into a temp file the caller must
+// close and remove.
+func (s *BookService) downloadToTemp(
+	ctx context.Context,
+	key string,
+) (*os.File, error) {
+	rc, err := s.objectStore.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("download book file: %w", err)
+	}
+	defer rc.Close()
+
+	tmp, err := os.CreateTemp("", "bookfile-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temp file: %w", err)
+	}
+	if _, err = io.Copy(tmp, rc); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return nil, fmt.Errorf("stream book file to disk: %w", err)
+	}
+	return tmp, nil
+}
+
+// downloadObject reads the object at key into memory, failing when it is
+// larger than limit.
+func (s *BookService) downloadObject(
+	ctx context.Context,
+	key string,
+	limit int64,
+) ([]byte, error) {
+	rc, err := s.objectStore.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("%s exceeds %d bytes", key, limit)
+	}
+	return data, nil
+}
@@ -0,0 +1,83 @@
+//nolint:testpackage // testing unexported service helpers
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+func TestRenderHash_ChangesWithInputs(t *testing.T) {
+	desc := "A blurb"
+	file := &models.BookFile{ //nolint:exhaustruct // only hashed fields matter
+		StorageKey:       "books/x/abc.epub",
+		ConverterVersion: 0,
+	}
+	book := &models.Book{ //nolint:exhaustruct // only hashed fields matter
+		Title:       "Title",
+		Authors:     []string{"A", "B"},
+		Description: &desc,
+	}
+	base := renderHash(file, book, true)
+	assert.Equal(t, base, renderHash(file, book, true))
+	assert.Len(t, base, 64)
+
+	assert.NotEqual(t, base, renderHash(file, book, false))
+
+	retitled := *book
+	retitled.Title = "Other"
+	assert.NotEqual(t, base, renderHash(file, &retitled, true))
+
+	// Field boundaries are kept: moving text between authors changes it.
+	regrouped := *book
+	regrouped.Authors = []string{"AB"}
+	assert.NotEqual(t, base, renderHash(file, &regrouped, true))
+
+	series := "Saga"
+	serialized := *book
+	serialized.Series = &series
+	assert.NotEqual(t, base, renderHash(file, &serialized, true))
+
+	reconverted := *file
+	reconverted.ConverterVersion = 1
+	assert.NotEqual(t, base, renderHash(&reconverted, book, true))
+}
+
+func TestCatalogOverrides_BlankDescriptionKeepsFiles(t *testing.T) {
+	blank := ""
+	book := &models.Book{ //nolint:exhaustruct // only mapped fields matter
+		ID:          uuid.New(),
+		Title:       "Title",
+		Authors:     []string{"A"},
+		Description: &blank,
+	}
+	o := catalogOverrides(book, nil)
+	assert.Equal(t, "Title", o.Title)
+	assert.Equal(t, []string{"A"}, o.Authors)
+	assert.Nil(t, o.Description)
+	assert.Nil(t, o.Cover)
+	assert.Empty(t, o.Series)
+	assert.Empty(t, o.SeriesIndex)
+}
+
+func TestCatalogOverrides_Series(t *testing.T) {
+	series, pos := "Saga", 2.5
+	book := &models.Book{ //nolint:exhaustruct // only mapped fields matter
+		Title:          "Title",
+		Series:         &series,
+		SeriesPosition: &pos,
+	}
+	o := catalogOverrides(book, nil)
+	assert.Equal(t, "Saga", o.Series)
+	assert.Equal(t, "2.5", o.SeriesIndex)
+}
+
+func TestBookRenderedKey(t *testing.T) {
+	id := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	assert.Equal(t,
+		"books/00000000-0000-0000-0000-000000000001/rendered/h.kepub",
+		bookRenderedKey(id, "h", extKEPUB))
+}
@@ -32,7 +32,8 @@ var parentheticalRe = regexp.MustCompile(`[(\[][^)\]]*[)\]]`)
 // volumeNumberRe matches a volume/edition/part marker plus its number, e.g.
 // "Volume 2", "Vol. 2", "Book 3", "Part 1", "Edition 4" — case-insensitive.
 // Deliberately narrower than "any number": a Goodreads shelf marker like
-// "(Series, #1)" has no such keyword and must stay stripped as noise.
+// "(Series, #1)" has no such keyword and must stay stripped as noise for
+// matching (seriesFromTitle records it as the book's series instead).
 var volumeNumberRe = regexp.MustCompile(
 	`(?i)\b(?:volume|vol|book|part|edition|ed)\.?\s*#?\s*(\d+)`,
 )
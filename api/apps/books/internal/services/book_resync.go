@@ -38,6 +38,9 @@ type booksResyncSource interface {
 		isbn13 string,
 		title string,
 		authors []string,
+		series string,
+		seriesPosition *float64,
+		seriesTotal *int,
 		metadataSource string,
 	) error
 	UpdateResyncScanStatus(
@@ -81,6 +84,11 @@ type SourceProposal struct {
 	ISBN13      string   `json:"isbn13,omitempty"`
 	Title       string   `json:"title,omitempty"`
 	Authors     []string `json:"authors,omitempty"`
+	// Series is the series name; SeriesPosition and SeriesTotal are only
+	// meaningful alongside it.
+	Series         string   `json:"series,omitempty"`
+	SeriesPosition *float64 `json:"series_position,omitempty"`
+	SeriesTotal    int      `json:"series_total,omitempty"`
 	// Index is this candidate's ordinal position (0-based) among other
 	// SourceProposals sharing the same Source. Always 0 except for the manual
 	// override search, which can return up to 5 candidates per source (see
@@ -342,6 +350,9 @@ func bookFieldCount(book models.Book) int {
 	if book.CoverURL != nil && *book.CoverURL != "" {
 		count++
 	}
+	if book.Series != nil && *book.Series != "" {
+		count++
+	}
 	return count
 }
 
@@ -367,6 +378,9 @@ func proposalFieldCount(p SourceProposal) int {
 	if p.CoverURL != "" {
 		count++
 	}
+	if p.Series != "" {
+		count++
+	}
 	return count
 }
 
@@ -536,13 +550,7 @@ func (s *BookService) fetchUniCatByISBN(
 
 	p := newSourceProposalFromCandidate(
 		"unicat",
-		titleOnlyCandidate{ //nolint:exhaustruct // UniCat has no cover images
-			title:       ucDetail.Title,
-			authors:     ucDetail.Authors,
-			isbn13:      ucDetail.ISBN13,
-			description: ucDetail.Description,
-			pageCount:   ucDetail.PageCount,
-		},
+		ucCandidate(*ucDetail),
 	)
 	return &p, false
 }
@@ -609,11 +617,9 @@ func (s *BookService) fetchHardcoverByISBN(
 		return s.fetchHardcoverBySearchFallback(ctx, logger, book)
 	}
 
-	p := newSourceProposalFromCandidate("hardcover", titleOnlyCandidate{
-		title: hcDetail.Title, authors: hcDetail.Authors, isbn13: hcDetail.ISBN13,
-		coverURL: hcDetail.CoverURL, description: hcDetail.Description,
-		pageCount: hcDetail.PageCount,
-	})
+	p := newSourceProposalFromCandidate(
+		"hardcover", hcCandidate(*hcDetail),
+	)
 	return &p, false
 }
 
@@ -859,14 +865,20 @@ func matchSearchResult(
 func ucCandidates(results []unicat.ExternalBook) []titleOnlyCandidate {
 	out := make([]titleOnlyCandidate, len(results))
 	for i, r := range results {
-		out[i] = titleOnlyCandidate{ //nolint:exhaustruct // UniCat has no cover images
-			title: r.Title, authors: r.Authors, isbn13: r.ISBN13,
-			description: r.Description, pageCount: r.PageCount,
-		}
+		out[i] = ucCandidate(r)
 	}
 	return out
 }
 
+func ucCandidate(r unicat.ExternalBook) titleOnlyCandidate {
+	//nolint:exhaustruct // UniCat has no cover images or series totals
+	return titleOnlyCandidate{
+		title: r.Title, authors: r.Authors, isbn13: r.ISBN13,
+		description: r.Description, pageCount: r.PageCount,
+		series: r.Series, seriesPosition: r.SeriesPosition,
+	}
+}
+
 // filterByAuthor keeps the candidates that share a normalised author last
 // name with one of authors (the same author semantics titleAuthorMatch uses,
 // so diacritics fold and "Last, First" forms match). With no authors to
@@ -901,14 +913,20 @@ func filterByAuthor(
 func hcCandidates(results []hardcover.ExternalBook) []titleOnlyCandidate {
 	out := make([]titleOnlyCandidate, len(results))
 	for i, r := range results {
-		out[i] = titleOnlyCandidate{
-			title: r.Title, authors: r.Authors, isbn13: r.ISBN13,
-			coverURL: r.CoverURL, description: r.Description, pageCount: r.PageCount,
-		}
+		out[i] = hcCandidate(r)
 	}
 	return out
 }
 
+func hcCandidate(r hardcover.ExternalBook) titleOnlyCandidate {
+	return titleOnlyCandidate{
+		title: r.Title, authors: r.Authors, isbn13: r.ISBN13,
+		coverURL: r.CoverURL, description: r.Description, pageCount: r.PageCount,
+		series: r.Series, seriesPosition: r.SeriesPosition,
+		seriesTotal: r.SeriesTotal,
+	}
+}
+
 // appendPicked converts one provider's picked candidates into SourceProposals
 // and appends them to out.
 func appendPicked(
@@ -956,6 +974,13 @@ func newSourceProposalFromCandidate(
 	if c.pageCount != nil {
 		p.PageCount = *c.pageCount
 	}
+	if c.series != "" {
+		p.Series = c.series
+		p.SeriesPosition = c.seriesPosition
+		if c.seriesTotal != nil {
+			p.SeriesTotal = *c.seriesTotal
+		}
+	}
 	return p
 }
 
@@ -963,7 +988,8 @@ func newSourceProposalFromCandidate(
 // A field only counts as a difference when the source actually supplied a
 // value: cover/isbn only flag when the library is missing that field (a
 // source's cover/ISBN can't be judged "better" than an existing one), while
-// title/authors/description/page_count flag on any non-empty mismatch —
+// title/authors/description/page_count flag on any non-empty mismatch, and
+// series on a different name or position —
 // // ponytail: cover flagged only when library lacks one, no "better cover" guess.
 func computeDifferences(book models.Book, p SourceProposal) []string {
 	var diffs []string
@@ -987,10 +1013,21 @@ func computeDifferences(book models.Book, p SourceProposal) []string {
 	if p.CoverURL != "" && (book.CoverURL == nil || *book.CoverURL == "") {
 		diffs = append(diffs, "cover_url")
 	}
+	if p.Series != "" &&
+		(normalizeString(p.Series) != normalizeString(derefStr(book.Series)) ||
+			!samePosition(p.SeriesPosition, book.SeriesPosition)) {
+		diffs = append(diffs, "series")
+	}
 
 	return diffs
 }
 
+// samePosition reports whether two optional series positions are equal. An
+// unknown proposed position never counts as a difference.
+func samePosition(proposed, current *float64) bool {
+	return proposed == nil || (current != nil && *proposed == *current)
+}
+
 func sameAuthorSet(a, b []string) bool {
 	if len(a) != len(b) {
 		return false
@@ -1033,6 +1070,13 @@ func libraryProposal(book models.Book) SourceProposal {
 	if book.PageCount != nil {
 		p.PageCount = *book.PageCount
 	}
+	if book.Series != nil {
+		p.Series = *book.Series
+		p.SeriesPosition = book.SeriesPosition
+	}
+	if book.SeriesTotal != nil {
+		p.SeriesTotal = *book.SeriesTotal
+	}
 	return p
 }
 
@@ -1155,11 +1199,7 @@ func (s *BookService) applySelectedSource(
 		return ErrProposalNotFound
 	}
 
-	return s.writeResyncResult(
-		ctx, logger, book,
-		chosen.CoverURL, chosen.Description, chosen.PageCount, chosen.ISBN13,
-		chosen.Title, chosen.Authors, chosen.Source,
-	)
+	return s.writeResyncResult(ctx, logger, book, *chosen)
 }
 
 // GetBookSources fetches every configured provider's live view of one book
@@ -1238,35 +1278,38 @@ func (s *BookService) SyncBookSource(
 	return nil
 }
 
-// writeResyncResult persists the chosen fields and refreshes the R2 cover
-// cache when the cover URL actually changes — including when the new source
-// blanks a cover the book previously had.
+// writeResyncResult persists the chosen source's fields and refreshes the R2
+// cover cache when the cover URL actually changes — including when the new
+// source blanks a cover the book previously had.
 func (s *BookService) writeResyncResult(
 	ctx context.Context,
 	logger *slog.Logger,
 	book models.Book,
-	coverURL string,
-	description string,
-	pageCount int,
-	isbn13 string,
-	title string,
-	authors []string,
-	metadataSource string,
+	chosen SourceProposal,
 ) error {
+	var seriesTotal *int
+	if chosen.SeriesTotal > 0 {
+		seriesTotal = &chosen.SeriesTotal
+	}
 	if dbErr := s.resyncRepo().RefreshBookExternalData(
 		ctx,
 		book.ID,
-		coverURL,
-		description,
-		pageCount,
-		isbn13,
-		title,
-		authors,
-		metadataSource,
+		chosen.CoverURL,
+		chosen.Description,
+		chosen.PageCount,
+		chosen.ISBN13,
+		chosen.Title,
+		chosen.Authors,
+		chosen.Series,
+		chosen.SeriesPosition,
+		seriesTotal,
+		chosen.Source,
 	); dbErr != nil {
 		return dbErr
 	}
 
+	coverURL := chosen.CoverURL
+
 	if coverURL == derefStr(book.CoverURL) {
 		return nil
 	}
@@ -1360,12 +1403,15 @@ func titleAuthorMatch(
 // used by selectTitleOnlyMatch. All three external providers expose the same
 // set of fields; this common type avoids duplicating the helper per provider.
 type titleOnlyCandidate struct {
-	title       string
-	authors     []string
-	isbn13      *string
-	coverURL    *string
-	description *string
-	pageCount   *int
+	title          string
+	authors        []string
+	isbn13         *string
+	coverURL       *string
+	description    *string
+	pageCount      *int
+	series         string
+	seriesPosition *float64
+	seriesTotal    *int
 }
 
 // selectTitleOnlyMatch filters candidates to those whose normalised title
@@ -33,6 +33,9 @@ type refreshCall struct {
 	isbn13         string
 	title          string
 	authors        []string
+	series         string
+	seriesPosition *float64
+	seriesTotal    *int
 	metadataSource string
 }
 
@@ -96,12 +99,16 @@ func (f *fakeBooksResync) RefreshBookExternalData(
 	isbn13 string,
 	title string,
 	authors []string,
+	series string,
+	seriesPosition *float64,
+	seriesTotal *int,
 	metadataSource string,
 ) error {
 	f.mu.Lock()
 	f.refreshCalls = append(f.refreshCalls, refreshCall{
 		bookID: bookID, coverURL: coverURL, description: description,
 		pageCount: pageCount, isbn13: isbn13, title: title, authors: authors,
+		series: series, seriesPosition: seriesPosition, seriesTotal: seriesTotal,
 		metadataSource: metadataSource,
 	})
 	f.mu.Unlock()
@@ -752,6 +759,34 @@ func TestComputeDifferences_Rules(t *testing.T) {
 	assert.Contains(t, diffs, "isbn13")
 }
 
+func TestComputeDifferences_Series(t *testing.T) {
+	pos := func(f float64) *float64 { return &f }
+	series := "Dune"
+	book := models.Book{ //nolint:exhaustruct // partial
+		Title: "Dune Messiah", Series: &series, SeriesPosition: pos(2),
+	}
+
+	//nolint:exhaustruct // partial
+	same := SourceProposal{Series: "dune", SeriesPosition: pos(2)}
+	assert.NotContains(t, computeDifferences(book, same), "series")
+
+	// A source that knows the series but not the position agrees.
+	unnumbered := SourceProposal{Series: "Dune"} //nolint:exhaustruct // partial
+	assert.NotContains(t, computeDifferences(book, unnumbered), "series")
+
+	//nolint:exhaustruct // partial
+	moved := SourceProposal{Series: "Dune", SeriesPosition: pos(3)}
+	assert.Contains(t, computeDifferences(book, moved), "series")
+
+	//nolint:exhaustruct // partial
+	renamed := SourceProposal{Series: "Dune Chronicles", SeriesPosition: pos(2)}
+	assert.Contains(t, computeDifferences(book, renamed), "series")
+
+	// A source without a series never flags one.
+	seriesless := SourceProposal{Title: "Dune Messiah"} //nolint:exhaustruct // partial
+	assert.NotContains(t, computeDifferences(book, seriesless), "series")
+}
+
 // ---------------------------------------------------------------------------
 // BuildResyncProposals
 // ---------------------------------------------------------------------------
@@ -1035,6 +1070,7 @@ func TestApplyResyncChoice_ChosenSource_WritesFields(t *testing.T) {
 		{ //nolint:exhaustruct // partial
 			Source: "hardcover", Title: "New Title", Description: "New desc",
 			PageCount: 42, CoverURL: "https://example.com/c.jpg",
+			Series: "Saga", SeriesTotal: 5,
 		},
 	})
 	require.NoError(t, err)
@@ -1059,6 +1095,10 @@ func TestApplyResyncChoice_ChosenSource_WritesFields(t *testing.T) {
 	assert.Equal(t, "New Title", rc.title)
 	assert.Equal(t, "New desc", rc.description)
 	assert.Equal(t, 42, rc.pageCount)
+	assert.Equal(t, "Saga", rc.series)
+	assert.Nil(t, rc.seriesPosition)
+	require.NotNil(t, rc.seriesTotal)
+	assert.Equal(t, 5, *rc.seriesTotal)
 	assert.Equal(t, "hardcover", rc.metadataSource,
 		"applying a source must record it as the book's metadata source")
 	assert.Equal(t, []uuid.UUID{bookID}, repo.deletedIDs)
@@ -1311,7 +1351,8 @@ func TestWriteResyncResult_ClearCoverCacheErrors_NonFatal(t *testing.T) {
 
 	err := svc.writeResyncResult(
 		context.Background(), logging.NewNopLogger(), book,
-		"", "", 0, "", "New Title", nil, "hardcover",
+		//nolint:exhaustruct // partial
+		SourceProposal{Source: "hardcover", Title: "New Title"},
 	)
 	require.NoError(t, err, "a cover-cache-clear failure must not fail the apply")
 }
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+)
+
+const (
+	// textChunkChars is the target size of an indexed text chunk: small
+	// enough that a hit points at a passage rather than a whole chapter,
+	// large enough that a phrase rarely straddles two chunks.
+	textChunkChars = 1500
+	// textIndexBatch caps how many files and articles one IndexPending run
+	// takes on, so a large backlog is worked through over several runs.
+	textIndexBatch = 20
+)
+
+// bookTextRepo is the slice of repositories.BookTextRepository the text
+// index needs.
+type bookTextRepo interface {
+	ListPendingFiles(ctx context.Context, limit int) ([]models.BookFile, error)
+	ListPendingArticles(
+		ctx context.Context,
+		limit int,
+	) ([]models.PendingArticle, error)
+	ReplaceFileChunks(
+		ctx context.Context,
+		file models.BookFile,
+		chunks []models.TextChunk,
+	) error
+	ReplaceArticleChunks(
+		ctx context.Context,
+		bookID uuid.UUID,
+		chunks []models.TextChunk,
+	) error
+	Search(
+		ctx context.Context,
+		userID string,
+		query string,
+		limit int32,
+		offset int32,
+	) ([]models.TextHit, bool, error)
+}
+
+// TextIndexService keeps the full-text index of uploaded books and ingested
+// articles up to date and searches it. EPUBs are indexed as-is; PDFs go
+// through the same extraction pipeline as their KEPUB conversion, so the
+// locations of their hits line up with the converted file.
+type TextIndexService struct {
+	repo       bookTextRepo
+	conversion *ConversionService
+}
+
+func NewTextIndexService(
+	repo bookTextRepo,
+	conversion *ConversionService,
+) *TextIndexService {
+	return &TextIndexService{
+		repo:       repo,
+		conversion: conversion,
+	}
+}
+
+// IndexPending indexes a batch of files and articles that are new or changed
+// since they were last indexed. A file whose text can't be extracted is
+// logged and indexed as empty rather than retried forever; a failure to
+// store the result aborts the run.
+func (s *TextIndexService) IndexPending(
+	ctx context.Context,
+	logger *slog.Logger,
+) error {
+	files, err := s.repo.ListPendingFiles(ctx, textIndexBatch)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		chunks, extractErr := s.fileChunks(ctx, f)
+		if extractErr != nil {
+			logger.WarnContext(ctx, "text index: extract failed",
+				"book_file_id", f.ID, "format", f.Format, "error", extractErr)
+		}
+		if err = s.repo.ReplaceFileChunks(ctx, f, chunks); err != nil {
+			return err
+		}
+	}
+
+	articles, err := s.repo.ListPendingArticles(ctx, textIndexBatch)
+	if err != nil {
+		return err
+	}
+	for _, a := range articles {
+		chunks := chunkSections(ebookmeta.HTMLText([]byte(a.HTML), ""))
+		if err = s.repo.ReplaceArticleChunks(ctx, a.BookID, chunks); err != nil {
+			return err
+		}
+	}
+
+	if n := len(files) + len(articles); n > 0 {
+		logger.InfoContext(ctx, "text index: indexed",
+			"files", len(files), "articles", len(articles))
+	}
+	return nil
+}
+
+func (s *TextIndexService) fileChunks(
+	ctx context.Context,
+	f models.BookFile,
+) ([]models.TextChunk, error) {
+	epubData, err := s.conversion.getEPUBBytes(ctx, f.StorageKey, f.Format)
+	if err != nil {
+		return nil, err
+	}
+	sections, err := ebookmeta.ReadText(
+		bytes.NewReader(epubData), int64(len(epubData)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return chunkSections(sections), nil
+}
+
+// Search returns the passages in the user's books and articles that match
+// query, best first, with the matched words marked in each snippet.
+func (s *TextIndexService) Search(
+	ctx context.Context,
+	userID string,
+	query string,
+	limit int32,
+	offset int32,
+) ([]models.TextHit, bool, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, false, nil
+	}
+	return s.repo.Search(ctx, userID, query, limit, offset)
+}
+
+// chunkSections splits sections into chunks of about textChunkChars,
+// breaking at word boundaries. A chunk never spans two sections, so its
+// chapter and location are exact.
+func chunkSections(sections []ebookmeta.TextSection) []models.TextChunk {
+	var chunks []models.TextChunk
+	for _, sec := range sections {
+		text := sec.Text
+		for text != "" {
+			body := text
+			if len(body) > textChunkChars {
+				cut := strings.LastIndexByte(body[:textChunkChars], ' ')
+				if cut <= 0 {
+					// One long "word": cut it at a character boundary.
+					cut = textChunkChars
+					for cut > 0 && !utf8.RuneStart(body[cut]) {
+						cut--
+					}
+				}
+				body = body[:cut]
+			}
+			text = strings.TrimLeft(text[len(body):], " ")
+			chunks = append(chunks, models.TextChunk{
+				Seq:      len(chunks),
+				Chapter:  sec.Heading,
+				Location: sec.Href,
+				Body:     body,
+			})
+		}
+	}
+	return chunks
+}
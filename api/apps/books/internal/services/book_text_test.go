@@ -0,0 +1,176 @@
+//nolint:testpackage // testing unexported text index helpers
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+	"tools.xdoubleu.com/apps/books/pkg/objectstore"
+)
+
+type fakeBookTextRepo struct {
+	files    []models.BookFile
+	articles []models.PendingArticle
+	byFile   map[uuid.UUID][]models.TextChunk
+	byBook   map[uuid.UUID][]models.TextChunk
+}
+
+func (f *fakeBookTextRepo) ListPendingFiles(
+	_ context.Context,
+	_ int,
+) ([]models.BookFile, error) {
+	return f.files, nil
+}
+
+func (f *fakeBookTextRepo) ListPendingArticles(
+	_ context.Context,
+	_ int,
+) ([]models.PendingArticle, error) {
+	return f.articles, nil
+}
+
+func (f *fakeBookTextRepo) ReplaceFileChunks(
+	_ context.Context,
+	file models.BookFile,
+	chunks []models.TextChunk,
+) error {
+	f.byFile[file.ID] = chunks
+	return nil
+}
+
+func (f *fakeBookTextRepo) ReplaceArticleChunks(
+	_ context.Context,
+	bookID uuid.UUID,
+	chunks []models.TextChunk,
+) error {
+	f.byBook[bookID] = chunks
+	return nil
+}
+
+func (f *fakeBookTextRepo) Search(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ int32,
+	_ int32,
+) ([]models.TextHit, bool, error) {
+	return nil, false, nil
+}
+
+func TestChunkSections(t *testing.T) {
+	long := strings.Repeat("word ", textChunkChars/5+10)
+	chunks := chunkSections([]ebookmeta.TextSection{
+		{Href: "a.xhtml", Heading: "", Text: "Preface."},
+		{Href: "b.xhtml", Heading: "One", Text: strings.TrimSpace(long)},
+	})
+
+	require.Len(t, chunks, 3)
+	assert.Equal(t, models.TextChunk{
+		Seq: 0, Chapter: "", Location: "a.xhtml", Body: "Preface.",
+	}, chunks[0])
+	for i, c := range chunks[1:] {
+		assert.Equal(t, i+1, c.Seq)
+		assert.Equal(t, "One", c.Chapter)
+		assert.Equal(t, "b.xhtml", c.Location)
+		assert.LessOrEqual(t, len(c.Body), textChunkChars)
+		assert.False(t, strings.HasPrefix(c.Body, " "))
+		assert.False(t, strings.HasSuffix(c.Body, " "))
+	}
+	assert.Equal(t,
+		strings.TrimSpace(long),
+		chunks[1].Body+" "+chunks[2].Body,
+	)
+}
+
+func TestChunkSections_LongWordKeepsRunesWhole(t *testing.T) {
+	text := "x" + strings.Repeat("é", textChunkChars)
+	chunks := chunkSections([]ebookmeta.TextSection{
+		{Href: "", Heading: "", Text: text},
+	})
+
+	require.Len(t, chunks, 3)
+	joined := ""
+	for _, c := range chunks {
+		assert.True(t, utf8.ValidString(c.Body))
+		joined += c.Body
+	}
+	assert.Equal(t, text, joined)
+}
+
+func TestTextIndexService_IndexPending(t *testing.T) {
+	ctx := context.Background()
+	store := objectstore.NewFake()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, body := range map[string]string{
+		"META-INF/container.xml": `<container><rootfiles>` +
+			`<rootfile full-path="content.opf"/></rootfiles></container>`,
+		"content.opf": `<package xmlns="http://www.idpf.org/2007/opf">` +
+			`<metadata/><manifest>` +
+			`<item id="c1" href="ch1.xhtml" media-type="application/xhtml+xml"/>` +
+			`</manifest><spine><itemref idref="c1"/></spine></package>`,
+		"ch1.xhtml": `<html><body><h1>Start</h1><p>Call me Ishmael.</p></body></html>`,
+	} {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	require.NoError(t, store.Put(
+		ctx, "books/u/b/book.epub", bytes.NewReader(buf.Bytes()),
+		int64(buf.Len()), "application/epub+zip",
+	))
+
+	//nolint:exhaustruct //only the fields IndexPending reads
+	good := models.BookFile{
+		ID:         uuid.New(),
+		BookID:     uuid.New(),
+		Format:     models.FileFormatEPUB,
+		StorageKey: "books/u/b/book.epub",
+	}
+	//nolint:exhaustruct //only the fields IndexPending reads
+	missing := models.BookFile{
+		ID:         uuid.New(),
+		BookID:     uuid.New(),
+		Format:     models.FileFormatEPUB,
+		StorageKey: "books/u/gone/book.epub",
+	}
+	articleID := uuid.New()
+	repo := &fakeBookTextRepo{
+		files: []models.BookFile{good, missing},
+		articles: []models.PendingArticle{
+			{BookID: articleID, HTML: `<p>Breaking news.</p>`},
+		},
+		byFile: map[uuid.UUID][]models.TextChunk{},
+		byBook: map[uuid.UUID][]models.TextChunk{},
+	}
+	svc := NewTextIndexService(
+		repo,
+		NewConversionService(slog.Default(), nil, store, nil, nil, nil),
+	)
+
+	require.NoError(t, svc.IndexPending(ctx, slog.Default()))
+
+	assert.Equal(t, []models.TextChunk{
+		{Seq: 0, Chapter: "Start", Location: "ch1.xhtml", Body: "Call me Ishmael."},
+	}, repo.byFile[good.ID])
+	// An unreadable file is still marked indexed, just without text.
+	assert.Contains(t, repo.byFile, missing.ID)
+	assert.Empty(t, repo.byFile[missing.ID])
+	assert.Equal(t, []models.TextChunk{
+		{Seq: 0, Chapter: "", Location: "", Body: "Breaking news."},
+	}, repo.byBook[articleID])
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"log/slog"
 	"slices"
 	"strings"
@@ -14,7 +13,6 @@ import (
 
 	"tools.xdoubleu.com/apps/books/internal/models"
 	"tools.xdoubleu.com/apps/books/internal/repositories"
-	"tools.xdoubleu.com/apps/books/pkg/books"
 	"tools.xdoubleu.com/apps/books/pkg/hardcover"
 	"tools.xdoubleu.com/apps/books/pkg/objectstore"
 	"tools.xdoubleu.com/apps/books/pkg/unicat"
@@ -44,8 +42,19 @@ type BookService struct {
 	bookFiles    *repositories.BookFilesRepository
 	objectStore  objectstore.Client
 	readingState *repositories.BookReadingStateRepository
-	uniCat       unicat.Client
-	hardcover    hardcover.Client
+	sessions     *SessionService
+	annotations  *repositories.AnnotationsRepository
+	// digests marks feed items read as progress on a feeds digest passes
+	// them; nil in unit tests.
+	digests *DigestService
+	// feedArticles passes progress on articles sent from feeds back to
+	// their feed items; nil in unit tests.
+	feedArticles *FeedArticleService
+	// queue is the "up next" reading queue; nil in unit tests, which turns
+	// auto-advance off.
+	queue     *repositories.ReadingQueueRepository
+	uniCat    unicat.Client
+	hardcover hardcover.Client
 	// booksResync overrides s.books for the resync path in unit tests.
 	// Nil in production — resyncRepo() falls back to s.books.
 	booksResync booksResyncSource
@@ -290,15 +299,44 @@ func (s *BookService) AddToLibrary(
 	return s.books.GetUserBook(ctx, userID, saved.ID)
 }
 
+// UpdateStatus saves ub's shelf, tags and read dates. Finishing a book the
+// user was reading starts the next queued one when the user has
+// QueueSettings.AutoAdvance on (see advanceQueue). A book that moves off
+// to-read leaves the queue, so the Kobo is settled (see settleKoboQueue).
 func (s *BookService) UpdateStatus(
 	ctx context.Context,
 	userID string,
 	ub models.UserBook,
 ) error {
-	if err := s.books.UpsertUserBook(ctx, ub); err != nil {
+	before, err := s.koboQueued(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	var previous string
+	if ub.Status == models.StatusRead && s.queue != nil {
+		existing, getErr := s.books.GetUserBook(ctx, userID, ub.BookID)
+		if getErr != nil && !errors.Is(getErr, database.ErrResourceNotFound) {
+			return getErr
+		}
+		if existing != nil {
+			previous = existing.Status
+		}
+	}
+
+	if err = s.books.UpsertUserBook(ctx, ub); err != nil {
 		return err
 	}
-	return s.registerCustomShelf(ctx, userID, ub.Status)
+	if err = s.registerCustomShelf(ctx, userID, ub.Status); err != nil {
+		return err
+	}
+
+	if previous == models.StatusReading {
+		if err = s.advanceQueue(ctx, userID); err != nil {
+			return err
+		}
+	}
+	return s.settleKoboQueue(ctx, userID, before)
 }
 
 // registerCustomShelf records a custom (non-built-in) status in the shelves
@@ -497,35 +535,6 @@ func (s *BookService) GetLibrary(
 	return s.books.GetLibrary(ctx, userID)
 }
 
-// ImportFromCSV parses a Goodreads CSV export and upserts all entries into the library.
-// Returns the number of entries successfully imported.
-func (s *BookService) ImportFromCSV(
-	ctx context.Context,
-	userID string,
-	r io.Reader,
-) (int, error) {
-	entries, err := books.ParseCSV(r)
-	if err != nil {
-		return 0, err
-	}
-
-	bookList := make([]models.Book, len(entries))
-	ubList := make([]models.UserBook, len(entries))
-	for i, e := range entries {
-		bookList[i] = e.Book
-		ubList[i] = e.UserBook
-		ubList[i].UserID = userID
-	}
-
-	s.logger.DebugContext(ctx, fmt.Sprintf("importing %d books from CSV", len(entries)))
-
-	if err = s.books.BatchUpsert(ctx, userID, bookList, ubList); err != nil {
-		return 0, err
-	}
-
-	return len(entries), nil
-}
-
 // BuildReadProgress returns sorted cumulative labels+values for the progress chart.
 func (s *BookService) BuildReadProgress(
 	ctx context.Context,
@@ -594,6 +603,9 @@ func (s *BookService) enrichByISBN(
 			ext.Description = fillStrIfEmpty(ext.Description, detail.Description)
 			ext.PageCount = fillIntIfZero(ext.PageCount, detail.PageCount)
 			ext.CoverURL = fillStrIfEmpty(ext.CoverURL, detail.CoverURL)
+			fillSeriesIfEmpty(
+				&ext, detail.Series, detail.SeriesPosition, detail.SeriesTotal,
+			)
 		}
 	}
 
@@ -605,12 +617,32 @@ func (s *BookService) enrichByISBN(
 		if detail != nil {
 			ext.Description = fillStrIfEmpty(ext.Description, detail.Description)
 			ext.PageCount = fillIntIfZero(ext.PageCount, detail.PageCount)
+			fillSeriesIfEmpty(&ext, detail.Series, detail.SeriesPosition, nil)
 		}
 	}
 
 	return ext
 }
 
+// fillSeriesIfEmpty copies a source's series onto ext when ext has none.
+// The name, position and total are taken together so a position never ends
+// up attached to another source's series.
+func fillSeriesIfEmpty(
+	ext *SourceProposal,
+	series string,
+	position *float64,
+	total *int,
+) {
+	if ext.Series != "" || series == "" {
+		return
+	}
+	ext.Series = series
+	ext.SeriesPosition = position
+	if total != nil {
+		ext.SeriesTotal = *total
+	}
+}
+
 // fillStrIfEmpty returns src's value when cur is empty and src is set,
 // otherwise cur unchanged.
 func fillStrIfEmpty(cur string, src *string) string {
@@ -647,6 +679,19 @@ func externalToBook(ext SourceProposal) models.Book {
 		pageCount = &ext.PageCount
 	}
 
+	// Sources rarely know a book's series, but titles often carry it.
+	series, seriesPosition := ext.Series, ext.SeriesPosition
+	var seriesTotal *int
+	if series == "" {
+		series, seriesPosition = seriesFromTitle(ext.Title)
+	} else if ext.SeriesTotal > 0 {
+		seriesTotal = &ext.SeriesTotal
+	}
+	var seriesName *string
+	if series != "" {
+		seriesName = &series
+	}
+
 	// Record provenance only for books whose metadata actually came from a
 	// source — hand-entered books (Source "manual"/"") stay NULL.
 	var metadataSource *string
@@ -662,12 +707,16 @@ func externalToBook(ext SourceProposal) models.Book {
 		CoverURL:       coverURL,
 		Description:    description,
 		PageCount:      pageCount,
+		Series:         seriesName,
+		SeriesPosition: seriesPosition,
+		SeriesTotal:    seriesTotal,
 		MetadataSource: metadataSource,
 	}
 }
 
-// ListKoboSyncBooks returns every book the user has enabled Kobo sync for and
-// that has a ready KEPUB — the exact set served by the sync protocol routes.
+// ListKoboSyncBooks returns every book the user has enabled Kobo sync for,
+// plus the top of their reading queue (QueueSettings.KoboSyncCount), that
+// has a ready KEPUB — the exact set served by the sync protocol routes.
 func (s *BookService) ListKoboSyncBooks(
 	ctx context.Context,
 	userID string,
@@ -696,7 +745,9 @@ func (s *BookService) GetKoboSyncBook(
 }
 
 // UpdateReadingProgress upserts a resumable reading position for a book.
-// source must be one of web/kobo/manual; percent is clamped to 0-100.
+// source must be one of web/kobo/koreader/manual; percent is clamped to
+// 0-100. Updates from a reader (anything but manual) also extend or start
+// a reading session.
 func (s *BookService) UpdateReadingProgress(
 	ctx context.Context,
 	userID string,
@@ -705,10 +756,75 @@ func (s *BookService) UpdateReadingProgress(
 	percent int,
 	location *string,
 ) error {
+	var prevPercent *int
+	prev, err := s.readingState.Get(ctx, userID, bookID)
+	switch {
+	case err == nil:
+		prevPercent = &prev.Percent
+	case !errors.Is(err, database.ErrResourceNotFound):
+		return err
+	}
+
+	percent, err = s.saveReadingProgress(
+		ctx, userID, bookID, source, percent, location,
+	)
+	if err != nil {
+		return err
+	}
+
+	if s.digests != nil {
+		// Like the session below, a failure here doesn't undo the saved
+		// position; the items are marked on the next progress update.
+		if err = s.digests.SyncProgress(ctx, userID, bookID, percent); err != nil {
+			s.logger.WarnContext(ctx, "failed to mark digest items read",
+				slog.String("book_id", bookID.String()),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	// Progress from the feeds reader came from the item in the first place.
+	if s.feedArticles != nil && source != models.ReadingSourceFeeds {
+		if err = s.feedArticles.SyncProgress(ctx, userID, bookID, percent); err != nil {
+			s.logger.WarnContext(ctx, "failed to sync feed item progress",
+				slog.String("book_id", bookID.String()),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if source == models.ReadingSourceManual || s.sessions == nil {
+		return nil
+	}
+	// The position is saved either way; a lost session only makes the
+	// reading-time stats a little less complete.
+	if err = s.sessions.TrackProgress(
+		ctx, userID, bookID, source, prevPercent, percent, time.Now(),
+	); err != nil {
+		s.logger.WarnContext(ctx, "failed to track reading session",
+			slog.String("book_id", bookID.String()),
+			slog.Any("error", err),
+		)
+	}
+	return nil
+}
+
+// saveReadingProgress validates and stores a reading position without
+// touching reading sessions, and returns the clamped percent.
+func (s *BookService) saveReadingProgress(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	source string,
+	percent int,
+	location *string,
+) (int, error) {
 	if source != models.ReadingSourceWeb &&
 		source != models.ReadingSourceKobo &&
-		source != models.ReadingSourceManual {
-		return fmt.Errorf("invalid reading source %q", source)
+		source != models.ReadingSourceManual &&
+		source != models.ReadingSourceKOReader &&
+		source != models.ReadingSourceFeeds {
+		return 0, fmt.Errorf("invalid reading source %q", source)
 	}
 	if percent < 0 {
 		percent = 0
@@ -727,16 +843,16 @@ func (s *BookService) UpdateReadingProgress(
 			Location: location,
 		},
 	); err != nil {
-		return err
+		return 0, err
 	}
 
 	// Reflect progress on the library entry and promote from to-read / dropped
 	// → currently-reading whenever progress is non-zero. No-op for books
 	// already reading, read, or not in the library at all.
 	if percent > 0 {
-		return s.books.UpdateLibraryProgress(ctx, userID, bookID, percent)
+		return percent, s.books.UpdateLibraryProgress(ctx, userID, bookID, percent)
 	}
-	return nil
+	return percent, nil
 }
 
 // GetReadingState returns the current resumable position for a book.
@@ -872,7 +988,11 @@ func (s *BookService) RemoveFromLibrary(
 	userID string,
 	bookID uuid.UUID,
 ) error {
-	if err := s.tombstoneIfKoboSynced(ctx, userID, bookID); err != nil {
+	queued, err := s.koboQueued(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err = s.tombstoneIfKoboSynced(ctx, userID, bookID); err != nil {
 		return err
 	}
 
@@ -912,6 +1032,11 @@ func (s *BookService) RemoveFromLibrary(
 	if err = s.books.DeleteUserBook(ctx, userID, bookID); err != nil {
 		return err
 	}
+	// A queued book syncing to Kobo gets its tombstone here, and the one
+	// that moves up into its place loses any stale one.
+	if err = s.settleKoboQueue(ctx, userID, queued); err != nil {
+		return err
+	}
 
 	deleted, err := s.books.DeleteOrphanedBook(ctx, bookID)
 	if err != nil {
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// ListOPDSBooks returns one page of the user's books that have a file to
+// download, with Formats set to every ready format (kepub included).
+func (s *BookService) ListOPDSBooks(
+	ctx context.Context,
+	userID string,
+	filter models.OPDSFilter,
+	limit int32,
+	offset int32,
+) ([]models.UserBook, bool, error) {
+	books, hasMore, err := s.books.ListOPDSBooks(ctx, userID, filter, limit, offset)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ids := make([]uuid.UUID, len(books))
+	for i, ub := range books {
+		ids[i] = ub.BookID
+	}
+	formats, err := s.bookFiles.ReadyFormatsByBooks(ctx, userID, ids)
+	if err != nil {
+		return nil, false, err
+	}
+	for i := range books {
+		books[i].Formats = formats[books[i].BookID]
+	}
+	return books, hasMore, nil
+}
+
+// ListOPDSTags returns the tags worth browsing by in the OPDS catalog: those
+// on at least one book with a file.
+func (s *BookService) ListOPDSTags(
+	ctx context.Context,
+	userID string,
+) ([]string, error) {
+	return s.books.ListOPDSTags(ctx, userID)
+}
@@ -84,6 +84,32 @@ func TestExternalToBook(t *testing.T) {
 	assert.Equal(t, "hardcover", *book.MetadataSource)
 }
 
+func TestExternalToBook_Series(t *testing.T) {
+	pos := 2.0
+	ext := SourceProposal{ //nolint:exhaustruct //Index/Differs unused
+		Source:         "hardcover",
+		Title:          "Dune Messiah (Dune, #2)",
+		Series:         "Dune Chronicles",
+		SeriesPosition: &pos,
+		SeriesTotal:    6,
+	}
+	book := externalToBook(ext)
+	require.NotNil(t, book.Series)
+	assert.Equal(t, "Dune Chronicles", *book.Series)
+	assert.Equal(t, &pos, book.SeriesPosition)
+	require.NotNil(t, book.SeriesTotal)
+	assert.Equal(t, 6, *book.SeriesTotal)
+
+	// Without a source series, the title's annotation fills it.
+	ext.Series, ext.SeriesPosition, ext.SeriesTotal = "", nil, 0
+	book = externalToBook(ext)
+	require.NotNil(t, book.Series)
+	assert.Equal(t, "Dune", *book.Series)
+	require.NotNil(t, book.SeriesPosition)
+	assert.InDelta(t, 2.0, *book.SeriesPosition, 0)
+	assert.Nil(t, book.SeriesTotal)
+}
+
 // TestExternalToBook_NoCoverStaysNil verifies a proposal with no cover URL
 // leaves the book's CoverURL nil — there is no ISBN-keyed cover fallback
 // anymore (that was Open Library's covers.openlibrary.org, now removed).
@@ -233,6 +259,35 @@ func TestEnrichByISBN_FillsMissingFieldsFromHardcover(t *testing.T) {
 	assert.Equal(t, "https://example.com/fetched.jpg", out.CoverURL)
 }
 
+func TestEnrichByISBN_FillsSeriesFromHardcover(t *testing.T) {
+	pos := 3.0
+	fake := &fakeHCClient{ //nolint:exhaustruct //err nil
+		byISBN: &hardcover.ExternalBook{ //nolint:exhaustruct //only series
+			Series:         "The Expanse",
+			SeriesPosition: &pos,
+			SeriesTotal:    intPtr(9),
+		},
+	}
+	svc := &BookService{ //nolint:exhaustruct //only hardcover needed
+		logger:    logging.NewNopLogger(),
+		hardcover: fake,
+	}
+	ext := SourceProposal{ //nolint:exhaustruct //missing fields filled
+		Title:  "Abaddon's Gate",
+		ISBN13: "9780316129077",
+	}
+	out := svc.enrichByISBN(context.Background(), ext)
+	assert.Equal(t, "The Expanse", out.Series)
+	assert.Equal(t, &pos, out.SeriesPosition)
+	assert.Equal(t, 9, out.SeriesTotal)
+
+	// A series the caller already has is kept, position and all.
+	ext.Series = "Expanse"
+	out = svc.enrichByISBN(context.Background(), ext)
+	assert.Equal(t, "Expanse", out.Series)
+	assert.Nil(t, out.SeriesPosition)
+}
+
 // TestEnrichByISBN_FillsRemainingFromUniCat verifies that when Hardcover
 // fills some but not all fields, UniCat (which has no cover) is consulted
 // for the still-missing description/page count.
@@ -38,27 +38,30 @@ type PDFConverter func(ctx context.Context, inPath, outPath string) error
 // as stale and regenerates it on next access (issue #594).
 const currentKEPUBConverterVersion int16 = 1
 
-// ConversionService produces KEPUBs from stored EPUBs or PDFs.
+// ConversionService produces KEPUBs from stored EPUBs, CBZs or PDFs.
 // Callers must use EnsureKEPUB; internal conversion is lazy and idempotent,
 // except that a KEPUB stamped with an older currentKEPUBConverterVersion is
 // treated as stale and regenerated rather than returned as-is.
 type ConversionService struct {
-	logger      *slog.Logger
-	bookFiles   *repositories.BookFilesRepository
-	objectStore objectstore.Client
-	converter   EPUBConverter
-	convertPDF  PDFConverter
+	logger       *slog.Logger
+	bookFiles    *repositories.BookFilesRepository
+	objectStore  objectstore.Client
+	converter    EPUBConverter
+	convertPDF   PDFConverter
+	convertComic ComicConverter
 }
 
-// NewConversionService constructs a ConversionService. Pass nil for converter
-// or convertPDF to use the default implementations (kepubify and
-// goPDFConverter respectively).
+// NewConversionService constructs a ConversionService. Pass nil for
+// converter, convertPDF or convertComic to use the default implementations
+// (kepubify, goPDFConverter and a ComicConverter for DefaultComicScreen
+// respectively).
 func NewConversionService(
 	logger *slog.Logger,
 	bookFiles *repositories.BookFilesRepository,
 	objectStore objectstore.Client,
 	converter EPUBConverter,
 	convertPDF PDFConverter,
+	convertComic ComicConverter,
 ) *ConversionService {
 	if converter == nil {
 		converter = newKepubifyConverter()
@@ -66,18 +69,22 @@ func NewConversionService(
 	if convertPDF == nil {
 		convertPDF = goPDFConverter
 	}
+	if convertComic == nil {
+		convertComic = NewComicConverter(DefaultComicScreen)
+	}
 	return &ConversionService{
-		logger:      logger,
-		bookFiles:   bookFiles,
-		objectStore: objectStore,
-		converter:   converter,
-		convertPDF:  convertPDF,
+		logger:       logger,
+		bookFiles:    bookFiles,
+		objectStore:  objectStore,
+		converter:    converter,
+		convertPDF:   convertPDF,
+		convertComic: convertComic,
 	}
 }
 
 // EnsureKEPUB returns the existing KEPUB book_files row for (userID, bookID),
-// or creates one by converting the stored EPUB or PDF. If neither is stored the
-// call returns a FailedPrecondition error.
+// or creates one by converting the stored EPUB, CBZ or PDF. If none is stored
+// the call returns a FailedPrecondition error.
 func (s *ConversionService) EnsureKEPUB(
 	ctx context.Context,
 	userID string,
@@ -100,7 +107,7 @@ func (s *ConversionService) EnsureKEPUB(
 		return nil, err
 	}
 
-	// Resolve the source file: prefer EPUB, fall back to PDF.
+	// Resolve the source file: prefer EPUB, then CBZ, then PDF.
 	sourceFile, sourceFormat, err := s.resolveSourceFile(ctx, userID, bookID)
 	if err != nil {
 		return nil, err
@@ -208,7 +215,7 @@ func (s *ConversionService) resolveCanonicalKEPUB(
 	if sourceFile.Checksum == nil || *sourceFile.Checksum == "" {
 		return nil, "", nil
 	}
-	canonicalKey := bookFileKey(bookID, *sourceFile.Checksum, extKEPUB)
+	canonicalKey := blobKey(*sourceFile.Checksum, extKEPUB)
 
 	globalRow, err := s.bookFiles.FindByStorageKeyGlobal(ctx, canonicalKey)
 	if errors.Is(err, database.ErrResourceNotFound) {
@@ -241,41 +248,34 @@ func (s *ConversionService) resolveCanonicalKEPUB(
 }
 
 // resolveSourceFile finds the best available source file for KEPUB conversion:
-// EPUB is preferred; PDF is used as a fallback. Returns FailedPrecondition when
-// neither is available.
+// EPUB is preferred, then CBZ; PDF is the last resort since its conversion
+// loses the most. Returns FailedPrecondition when none is available.
 func (s *ConversionService) resolveSourceFile(
 	ctx context.Context,
 	userID string,
 	bookID uuid.UUID,
 ) (*models.BookFile, string, error) {
-	epubFile, err := s.bookFiles.GetByBookAndFormat(
-		ctx, userID, bookID, models.FileFormatEPUB,
-	)
-	if err == nil {
-		return epubFile, models.FileFormatEPUB, nil
-	}
-	if !errors.Is(err, database.ErrResourceNotFound) {
-		return nil, "", err
-	}
-
-	pdfFile, err := s.bookFiles.GetByBookAndFormat(
-		ctx, userID, bookID, models.FileFormatPDF,
-	)
-	if err == nil {
-		return pdfFile, models.FileFormatPDF, nil
-	}
-	if !errors.Is(err, database.ErrResourceNotFound) {
-		return nil, "", err
+	for _, format := range []string{
+		models.FileFormatEPUB, models.FileFormatCBZ, models.FileFormatPDF,
+	} {
+		file, err := s.bookFiles.GetByBookAndFormat(ctx, userID, bookID, format)
+		if err == nil {
+			return file, format, nil
+		}
+		if !errors.Is(err, database.ErrResourceNotFound) {
+			return nil, "", err
+		}
 	}
 
 	return nil, "", connect.NewError(
 		connect.CodeFailedPrecondition,
-		errors.New("no EPUB or PDF available for this book"),
+		errors.New("no EPUB, CBZ or PDF available for this book"),
 	)
 }
 
 // getEPUBBytes returns raw EPUB bytes ready for kepubify.
 // When the source is an EPUB it downloads it directly.
+// When the source is a CBZ it downloads it and renders it with convertComic.
 // When the source is a PDF it downloads to a temp file, calls convertPDF to
 // produce a temp EPUB, reads that, then cleans up both temp files.
 func (s *ConversionService) getEPUBBytes(
@@ -283,8 +283,19 @@ func (s *ConversionService) getEPUBBytes(
 	storageKey string,
 	sourceFormat string,
 ) ([]byte, error) {
-	if sourceFormat == models.FileFormatEPUB {
+	switch sourceFormat {
+	case models.FileFormatEPUB:
 		return s.downloadBytes(ctx, storageKey)
+	case models.FileFormatCBZ:
+		cbzData, err := s.downloadBytes(ctx, storageKey)
+		if err != nil {
+			return nil, err
+		}
+		epubData, err := s.convertComic(ctx, cbzData)
+		if err != nil {
+			return nil, fmt.Errorf("cbz to epub: %w", err)
+		}
+		return epubData, nil
 	}
 
 	// PDF path: download to temp file, convert to temp EPUB, read, clean up.
@@ -0,0 +1,436 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // register GIF pages with image.Decode
+	"image/jpeg"
+	_ "image/png" // register PNG pages with image.Decode
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+)
+
+const (
+	// maxComicPages caps the pages converted from one archive.
+	maxComicPages = 5_000
+	// maxComicPagePixels rejects page images whose declared size would
+	// decode into an unreasonable amount of memory.
+	maxComicPagePixels = 80 << 20
+	// maxComicPageBytes caps one page image's uncompressed size, so a
+	// zip bomb can't exhaust memory before its pixel size is known.
+	maxComicPageBytes = 64 << 20
+	// comicJPEGQuality is the quality rendered pages are encoded at.
+	comicJPEGQuality = 85
+)
+
+// ComicScreen is the portrait screen size, in pixels, comic pages are fitted
+// to.
+type ComicScreen struct {
+	Width  int
+	Height int
+}
+
+// DefaultComicScreen is the Kobo Libra 2 / Libra Colour screen.
+//
+//nolint:gochecknoglobals // fixed default, read-only
+var DefaultComicScreen = ComicScreen{Width: 1264, Height: 1680}
+
+// ComicConverter converts raw CBZ bytes into fixed-layout EPUB bytes.
+// The type exists for test injection; production uses NewComicConverter.
+type ComicConverter func(ctx context.Context, cbzData []byte) ([]byte, error)
+
+var errNoComicPages = errors.New("comic archive has no page images")
+
+// NewComicConverter returns a ComicConverter rendering pages for screen.
+// A screen with a non-positive side falls back to DefaultComicScreen.
+func NewComicConverter(screen ComicScreen) ComicConverter {
+	if screen.Width <= 0 || screen.Height <= 0 {
+		screen = DefaultComicScreen
+	}
+	return func(ctx context.Context, cbzData []byte) ([]byte, error) {
+		return buildComicEPUB(ctx, cbzData, screen)
+	}
+}
+
+// comicPage is one rendered page of the output EPUB.
+type comicPage struct {
+	JPEG   []byte
+	Width  int
+	Height int
+}
+
+// buildComicEPUB renders every page image of a CBZ to a JPEG that fits
+// screen and wraps them in a pre-paginated EPUB 3. Landscape pages are
+// treated as double-page spreads: the whole spread is emitted rotated to
+// portrait, followed by its two halves in reading order.
+func buildComicEPUB(
+	ctx context.Context, cbzData []byte, screen ComicScreen,
+) ([]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(cbzData), int64(len(cbzData)))
+	if err != nil {
+		return nil, fmt.Errorf("open cbz zip: %w", err)
+	}
+	info, err := ebookmeta.ReadComicInfo(zr)
+	if err != nil {
+		return nil, err
+	}
+	files := ebookmeta.ComicPages(zr)
+	if len(files) == 0 {
+		return nil, errNoComicPages
+	}
+	if len(files) > maxComicPages {
+		return nil, fmt.Errorf("comic has too many pages (%d)", len(files))
+	}
+
+	var pages []comicPage
+	for _, f := range files {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		img, decodeErr := decodeComicPage(f)
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
+		gray := img.ColorModel() == color.GrayModel
+		for _, part := range layoutComicPage(img, info.RightToLeft()) {
+			page, encodeErr := renderComicPage(part, screen, gray)
+			if encodeErr != nil {
+				return nil, fmt.Errorf("encode %s: %w", f.Name, encodeErr)
+			}
+			pages = append(pages, page)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err = writeComicEPUBZip(&buf, info, pages); err != nil {
+		return nil, fmt.Errorf("write epub zip: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeComicPage(f *zip.File) (image.Image, error) {
+	if f.UncompressedSize64 > maxComicPageBytes {
+		return nil, fmt.Errorf(
+			"page %s is too large (%d bytes)", f.Name, f.UncompressedSize64,
+		)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", f.Name, err)
+	}
+	defer func() { _ = rc.Close() }()
+
+	// The declared size can lie; never read past the cap either way.
+	data, err := io.ReadAll(io.LimitReader(rc, maxComicPageBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", f.Name, err)
+	}
+	if len(data) > maxComicPageBytes {
+		return nil, fmt.Errorf("page %s is too large", f.Name)
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", f.Name, err)
+	}
+	if cfg.Width*cfg.Height > maxComicPagePixels {
+		return nil, fmt.Errorf(
+			"page %s is too large (%dx%d)", f.Name, cfg.Width, cfg.Height,
+		)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", f.Name, err)
+	}
+	return img, nil
+}
+
+// layoutComicPage splits a landscape page (a double-page spread) into the
+// spread rotated a quarter turn, then its left and right halves, swapping the
+// halves for right-to-left comics. Portrait pages are returned as they are.
+func layoutComicPage(img image.Image, rightToLeft bool) []image.Image {
+	b := img.Bounds()
+	if b.Dx() <= b.Dy() {
+		return []image.Image{img}
+	}
+	src := toRGBA(img)
+	mid := b.Min.X + b.Dx()/2
+	left := src.SubImage(image.Rect(b.Min.X, b.Min.Y, mid, b.Max.Y))
+	right := src.SubImage(image.Rect(mid, b.Min.Y, b.Max.X, b.Max.Y))
+	if rightToLeft {
+		left, right = right, left
+	}
+	return []image.Image{rotateClockwise(src), left, right}
+}
+
+// renderComicPage fits img inside screen and encodes it as JPEG, in
+// grayscale when gray is set. Pages that are already small enough keep their
+// size.
+func renderComicPage(
+	img image.Image, screen ComicScreen, gray bool,
+) (comicPage, error) {
+	out := fitToScreen(img, screen)
+	if gray {
+		g := image.NewGray(out.Bounds())
+		draw.Draw(g, g.Bounds(), out, out.Bounds().Min, draw.Src)
+		out = g
+	}
+
+	var buf bytes.Buffer
+	opts := &jpeg.Options{Quality: comicJPEGQuality}
+	if err := jpeg.Encode(&buf, out, opts); err != nil {
+		return comicPage{}, err
+	}
+	return comicPage{
+		JPEG:   buf.Bytes(),
+		Width:  out.Bounds().Dx(),
+		Height: out.Bounds().Dy(),
+	}, nil
+}
+
+// fitToScreen scales img down, keeping its aspect ratio, until it fits
+// screen. Each output pixel averages the source pixels it covers.
+func fitToScreen(img image.Image, screen ComicScreen) image.Image {
+	b := img.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	if sw <= screen.Width && sh <= screen.Height {
+		return img
+	}
+	dw, dh := screen.Width, sh*screen.Width/sw
+	if dh > screen.Height {
+		dw, dh = sw*screen.Height/sh, screen.Height
+	}
+	dw, dh = max(dw, 1), max(dh, 1)
+
+	src := toRGBA(img)
+	sb := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := range dh {
+		y0, y1 := y*sh/dh, max((y+1)*sh/dh, y*sh/dh+1)
+		for x := range dw {
+			x0, x1 := x*sw/dw, max((x+1)*sw/dw, x*sw/dw+1)
+			var r, g, bl, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				row := src.PixOffset(sb.Min.X+x0, sb.Min.Y+sy)
+				for range x1 - x0 {
+					r += uint32(src.Pix[row])
+					g += uint32(src.Pix[row+1])
+					bl += uint32(src.Pix[row+2])
+					a += uint32(src.Pix[row+3])
+					row += 4
+					n++
+				}
+			}
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n)    //nolint:gosec // average of uint8s
+			dst.Pix[i+1] = uint8(g / n)  //nolint:gosec // average of uint8s
+			dst.Pix[i+2] = uint8(bl / n) //nolint:gosec // average of uint8s
+			dst.Pix[i+3] = uint8(a / n)  //nolint:gosec // average of uint8s
+		}
+	}
+	return dst
+}
+
+// rotateClockwise turns img a quarter turn clockwise.
+func rotateClockwise(img *image.RGBA) *image.RGBA {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, h, w))
+	for y := range h {
+		for x := range w {
+			si := img.PixOffset(b.Min.X+x, b.Min.Y+y)
+			di := dst.PixOffset(h-1-y, x)
+			copy(dst.Pix[di:di+4], img.Pix[si:si+4])
+		}
+	}
+	return dst
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok {
+		return rgba
+	}
+	b := img.Bounds()
+	rgba := image.NewRGBA(b)
+	draw.Draw(rgba, b, img, b.Min, draw.Src)
+	return rgba
+}
+
+// writeComicEPUBZip assembles the fixed-layout EPUB: one XHTML document per
+// page, each showing a single full-page image.
+func writeComicEPUBZip(w io.Writer, info ebookmeta.ComicInfo, pages []comicPage) error {
+	zw := zip.NewWriter(w)
+
+	if err := writeStoredEntry(zw, "mimetype", "application/epub+zip"); err != nil {
+		return err
+	}
+	if err := writeEntry(
+		zw, "META-INF/container.xml", buildContainerXML(),
+	); err != nil {
+		return err
+	}
+	if err := writeEntry(
+		zw, "OEBPS/content.opf", buildComicOPF(info, pages),
+	); err != nil {
+		return err
+	}
+	if err := writeEntry(
+		zw, "OEBPS/nav.xhtml", buildComicNavXHTML(comicTitle(info)),
+	); err != nil {
+		return err
+	}
+	for i, page := range pages {
+		if err := writeEntry(
+			zw, "OEBPS/"+comicPageName(i)+".xhtml", buildComicPageXHTML(i, page),
+		); err != nil {
+			return err
+		}
+		img, err := zw.Create("OEBPS/images/" + comicPageName(i) + ".jpg")
+		if err != nil {
+			return fmt.Errorf("create page image %d: %w", i+1, err)
+		}
+		if _, err = img.Write(page.JPEG); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func comicPageName(i int) string {
+	return fmt.Sprintf("page-%04d", i+1)
+}
+
+func comicTitle(info ebookmeta.ComicInfo) string {
+	if title := info.Metadata().Title; title != "" {
+		return title
+	}
+	return "Comic"
+}
+
+func buildComicOPF(info ebookmeta.ComicInfo, pages []comicPage) string {
+	meta := info.Metadata()
+	lang := "en"
+	if meta.Language != nil {
+		lang = *meta.Language
+	}
+	direction := "ltr"
+	if info.RightToLeft() {
+		direction = "rtl"
+	}
+
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n")
+	b.WriteString(
+		`<package xmlns="http://www.idpf.org/2007/opf" version="3.0" ` +
+			`unique-identifier="pub-id" ` +
+			`prefix="rendition: http://www.idpf.org/vocab/rendition/#">` + "\n",
+	)
+	b.WriteString(
+		`  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">` + "\n",
+	)
+	b.WriteString(
+		`    <dc:identifier id="pub-id">urn:uuid:` + uuid.NewString() +
+			"</dc:identifier>\n",
+	)
+	b.WriteString(
+		"    <dc:title>" + escapeXMLText(comicTitle(info)) + "</dc:title>\n",
+	)
+	for _, author := range meta.Authors {
+		b.WriteString(
+			"    <dc:creator>" + escapeXMLText(author) + "</dc:creator>\n",
+		)
+	}
+	b.WriteString("    <dc:language>" + escapeXMLText(lang) + "</dc:language>\n")
+	b.WriteString(
+		`    <meta property="rendition:layout">pre-paginated</meta>` + "\n" +
+			`    <meta property="rendition:orientation">portrait</meta>` + "\n" +
+			`    <meta property="rendition:spread">none</meta>` + "\n" +
+			`    <meta name="cover" content="img-0001"/>` + "\n",
+	)
+	b.WriteString("  </metadata>\n")
+
+	b.WriteString("  <manifest>\n")
+	b.WriteString(
+		`    <item id="nav" href="nav.xhtml" ` +
+			`media-type="application/xhtml+xml" properties="nav"/>` + "\n",
+	)
+	for i := range pages {
+		name := comicPageName(i)
+		fmt.Fprintf(&b,
+			"    <item id=\"p-%04d\" href=\"%s.xhtml\" "+
+				"media-type=\"application/xhtml+xml\"/>\n",
+			i+1, name)
+		cover := ""
+		if i == 0 {
+			cover = ` properties="cover-image"`
+		}
+		fmt.Fprintf(&b,
+			"    <item id=\"img-%04d\" href=\"images/%s.jpg\" "+
+				"media-type=\"%s\"%s/>\n",
+			i+1, name, contentTypeJPEG, cover)
+	}
+	b.WriteString("  </manifest>\n")
+
+	b.WriteString(`  <spine page-progression-direction="` + direction + `">` + "\n")
+	for i := range pages {
+		fmt.Fprintf(&b, "    <itemref idref=\"p-%04d\"/>\n", i+1)
+	}
+	b.WriteString("  </spine>\n")
+	b.WriteString("</package>\n")
+	return b.String()
+}
+
+func buildComicPageXHTML(i int, page comicPage) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n")
+	b.WriteString("<!DOCTYPE html>\n")
+	b.WriteString(`<html xmlns="http://www.w3.org/1999/xhtml">` + "\n")
+	fmt.Fprintf(&b, "<head><title>Page %d</title>\n", i+1)
+	fmt.Fprintf(&b,
+		"<meta name=\"viewport\" content=\"width=%d, height=%d\"/>\n",
+		page.Width, page.Height)
+	b.WriteString(
+		"<style>html, body { margin: 0; padding: 0; } " +
+			"img { display: block; }</style></head>\n",
+	)
+	fmt.Fprintf(&b,
+		"<body><img src=\"images/%s.jpg\" alt=\"\" width=\"%d\" height=\"%d\"/>"+
+			"</body>\n",
+		comicPageName(i), page.Width, page.Height)
+	b.WriteString("</html>\n")
+	return b.String()
+}
+
+func buildComicNavXHTML(title string) string {
+	escaped := escapeXMLText(title)
+
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n")
+	b.WriteString("<!DOCTYPE html>\n")
+	b.WriteString(
+		`<html xmlns="http://www.w3.org/1999/xhtml" ` +
+			`xmlns:epub="http://www.idpf.org/2007/ops">` + "\n",
+	)
+	b.WriteString("<head><title>" + escaped + "</title></head>\n")
+	b.WriteString("<body>\n")
+	b.WriteString(`  <nav epub:type="toc" id="toc">` + "\n")
+	b.WriteString("    <ol>\n")
+	b.WriteString(
+		`      <li><a href="` + comicPageName(0) + `.xhtml">` + escaped +
+			"</a></li>\n",
+	)
+	b.WriteString("    </ol>\n")
+	b.WriteString("  </nav>\n")
+	b.WriteString("</body>\n")
+	b.WriteString("</html>\n")
+	return b.String()
+}
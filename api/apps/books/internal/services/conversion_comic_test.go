@@ -0,0 +1,155 @@
+//nolint:testpackage // testing unexported comic rendering helpers
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// splitPNG encodes a w×h PNG whose left half is left and right half is
+// right, so tests can tell which half of a split spread they are looking at.
+func splitPNG(t *testing.T, w, h int, left, right color.Color) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			c := left
+			if x >= w/2 {
+				c = right
+			}
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func buildCBZ(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, data := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func zipEntryImage(t *testing.T, zr *zip.Reader, name string) image.Image {
+	t.Helper()
+	img, err := jpeg.Decode(strings.NewReader(zipEntryContent(t, zr, name)))
+	require.NoError(t, err)
+	return img
+}
+
+//nolint:gochecknoglobals // test palette
+var (
+	testRed  = color.RGBA{R: 255, A: 255}
+	testBlue = color.RGBA{B: 255, A: 255}
+)
+
+func TestBuildComicEPUB_SplitsAndRotatesSpreads(t *testing.T) {
+	cbz := buildCBZ(t, map[string][]byte{
+		"ComicInfo.xml": []byte(`<ComicInfo><Series>Akira</Series>` +
+			`<Number>2</Number><Writer>Katsuhiro Otomo</Writer>` +
+			`<Manga>YesAndRightToLeft</Manga></ComicInfo>`),
+		"p01.png": splitPNG(t, 200, 300, testRed, testRed),
+		"p02.png": splitPNG(t, 600, 300, testRed, testBlue),
+	})
+	screen := ComicScreen{Width: 100, Height: 150}
+
+	out, err := buildComicEPUB(context.Background(), cbz, screen)
+	require.NoError(t, err)
+	zr, err := zip.NewReader(bytes.NewReader(out), int64(len(out)))
+	require.NoError(t, err)
+	assert.Equal(t, "mimetype", zr.File[0].Name)
+
+	opf := zipEntryContent(t, zr, "OEBPS/content.opf")
+	assert.Contains(t, opf, "<dc:title>Akira #2</dc:title>")
+	assert.Contains(t, opf, "<dc:creator>Katsuhiro Otomo</dc:creator>")
+	assert.Contains(t, opf, `<meta property="rendition:layout">pre-paginated</meta>`)
+	assert.Contains(t, opf, `page-progression-direction="rtl"`)
+	assert.Equal(t, 4, strings.Count(opf, "<itemref "))
+
+	sizes := [][2]int{{100, 150}, {75, 150}, {100, 100}, {100, 100}}
+	for i, want := range sizes {
+		img := zipEntryImage(t, zr, "OEBPS/images/"+comicPageName(i)+".jpg")
+		assert.Equal(t, want[0], img.Bounds().Dx(), "page %d width", i+1)
+		assert.Equal(t, want[1], img.Bounds().Dy(), "page %d height", i+1)
+		page := zipEntryContent(t, zr, "OEBPS/"+comicPageName(i)+".xhtml")
+		assert.Contains(t, page,
+			fmt.Sprintf("width=%d, height=%d", want[0], want[1]),
+			"page %d viewport", i+1)
+	}
+
+	// Right to left: the right (blue) half of the spread is read first.
+	r, _, b, _ := zipEntryImage(t, zr, "OEBPS/images/page-0003.jpg").
+		At(50, 50).RGBA()
+	assert.Greater(t, b, r)
+}
+
+func TestBuildComicEPUB_ConvertsToKEPUB(t *testing.T) {
+	cbz := buildCBZ(t, map[string][]byte{
+		"1.png": splitPNG(t, 40, 60, testRed, testRed),
+	})
+	epub, err := NewComicConverter(ComicScreen{Width: 0, Height: 0})(
+		context.Background(), cbz,
+	)
+	require.NoError(t, err)
+
+	kepub, err := newKepubifyConverter().Convert(context.Background(), epub)
+	require.NoError(t, err)
+	assert.NotEmpty(t, kepub)
+}
+
+func TestBuildComicEPUB_NoPages(t *testing.T) {
+	cbz := buildCBZ(t, map[string][]byte{"readme.txt": []byte("hi")})
+	_, err := buildComicEPUB(context.Background(), cbz, DefaultComicScreen)
+	require.ErrorIs(t, err, errNoComicPages)
+}
+
+func TestBuildComicEPUB_OversizedPage(t *testing.T) {
+	cbz := buildCBZ(t, map[string][]byte{
+		"001.png": make([]byte, maxComicPageBytes+1),
+	})
+	_, err := buildComicEPUB(context.Background(), cbz, DefaultComicScreen)
+	require.ErrorContains(t, err, "too large")
+}
+
+func TestFitToScreen(t *testing.T) {
+	screen := ComicScreen{Width: 100, Height: 150}
+	small := image.NewRGBA(image.Rect(0, 0, 50, 60))
+	assert.Same(t, small, fitToScreen(small, screen), "never upscales")
+
+	wide := fitToScreen(image.NewRGBA(image.Rect(0, 0, 400, 100)), screen)
+	assert.Equal(t, image.Rect(0, 0, 100, 25), wide.Bounds())
+
+	tall := fitToScreen(image.NewRGBA(image.Rect(0, 0, 300, 900)), screen)
+	assert.Equal(t, image.Rect(0, 0, 50, 150), tall.Bounds())
+}
+
+func TestRotateClockwise(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, testRed)
+	img.Set(1, 0, testBlue)
+
+	rotated := rotateClockwise(img)
+	assert.Equal(t, image.Rect(0, 0, 1, 2), rotated.Bounds())
+	assert.Equal(t, testRed, rotated.RGBAAt(0, 0))
+	assert.Equal(t, testBlue, rotated.RGBAAt(0, 1))
+}
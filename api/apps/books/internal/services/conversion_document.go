@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+)
+
+// needsEPUBConversion reports whether an upload of format is stored as EPUB
+// rather than as-is. MOBI/AZW3 and FB2 have no reader-side support anywhere
+// downstream (OPDS, Kobo sync, the web reader), so they are converted once
+// at upload time and never exist in the library in their original form.
+func needsEPUBConversion(format string) bool {
+	return format == ebookmeta.FormatMOBI || format == ebookmeta.FormatFB2
+}
+
+// readDocument decodes the body of a convertible upload into HTML plus the
+// images it references.
+func readDocument(
+	format string, r io.ReaderAt, size int64,
+) (ebookmeta.Document, error) {
+	switch format {
+	case ebookmeta.FormatMOBI:
+		return ebookmeta.ReadMOBI(r, size)
+	case ebookmeta.FormatFB2:
+		return ebookmeta.ReadFB2(r, size)
+	}
+	return ebookmeta.Document{}, fmt.Errorf("no document reader for %q", format)
+}
+
+// documentToEPUB writes doc to outPath as an EPUB. The HTML and its images
+// are laid out in a scratch directory the way goHTMLConverter expects: one
+// index.html with the images as bare-filename siblings.
+func documentToEPUB(
+	ctx context.Context, doc ebookmeta.Document, meta ArticleMeta, outPath string,
+) error {
+	workDir, err := os.MkdirTemp(filepath.Dir(outPath), "docconvert-*")
+	if err != nil {
+		return fmt.Errorf("create work dir: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(workDir) }()
+
+	for _, img := range doc.Images {
+		imgPath := filepath.Join(workDir, filepath.Base(img.Name))
+		if err = os.WriteFile(imgPath, img.Data, 0o600); err != nil {
+			return fmt.Errorf("write document image: %w", err)
+		}
+	}
+
+	htmlPath := filepath.Join(workDir, "index.html")
+	if err = os.WriteFile(htmlPath, doc.HTML, 0o600); err != nil {
+		return fmt.Errorf("write document html: %w", err)
+	}
+
+	return goHTMLConverter(ctx, htmlPath, outPath, meta)
+}
+
+// convertUploadToEPUB replaces uf's temp file with an EPUB built from it,
+// titled and credited from meta. uf.checksum is left untouched: it stays
+// the checksum of the uploaded bytes, so re-uploading the same MOBI or FB2
+// hits the FinalizeUpload fast path instead of converting it again.
+// Failures wrap ErrInvalidFormat, since a file that cannot be read (e.g.
+// one that is DRM-protected) is as unusable as one of an unknown format.
+func convertUploadToEPUB(
+	ctx context.Context, uf *uploadedFile, meta ebookmeta.Metadata,
+) error {
+	doc, err := readDocument(uf.format, uf.tmp, uf.size)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidFormat, err)
+	}
+
+	out, err := os.CreateTemp("", "bookconvert-*.epub")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	_ = out.Close()
+
+	title := meta.Title
+	if title == "" {
+		title = "Untitled"
+	}
+	articleMeta := ArticleMeta{Title: title, Authors: meta.Authors}
+	if err = documentToEPUB(ctx, doc, articleMeta, out.Name()); err != nil {
+		_ = os.Remove(out.Name())
+		return fmt.Errorf("%w: %w", ErrInvalidFormat, err)
+	}
+
+	converted, err := os.Open(out.Name())
+	if err != nil {
+		_ = os.Remove(out.Name())
+		return fmt.Errorf("open converted epub: %w", err)
+	}
+	info, err := converted.Stat()
+	if err != nil {
+		_ = converted.Close()
+		_ = os.Remove(out.Name())
+		return fmt.Errorf("stat converted epub: %w", err)
+	}
+
+	_ = uf.tmp.Close()
+	_ = os.Remove(uf.tmp.Name())
+	uf.tmp = converted
+	uf.size = info.Size()
+	uf.format = ebookmeta.FormatEPUB
+	uf.converted = true
+	return nil
+}
@@ -0,0 +1,123 @@
+//nolint:testpackage // testing unexported service helpers
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+)
+
+const testFB2Upload = `<?xml version="1.0" encoding="utf-8"?>
+<FictionBook xmlns="http://www.gribuser.ru/xml/fictionbook/2.0">
+  <description><title-info>
+    <author><first-name>Arkady</first-name><last-name>Strugatsky</last-name></author>
+    <book-title>Roadside Picnic</book-title>
+  </title-info></description>
+  <body><section><title><p>Chapter 1</p></title><p>The Zone.</p></section></body>
+</FictionBook>`
+
+func TestDocumentToEPUB_EmbedsImages(t *testing.T) {
+	jpg := []byte{0xFF, 0xD8, 0xFF}
+	doc := ebookmeta.Document{
+		HTML: []byte(
+			`<html><body><p>text</p><img src="fb2_img_0.jpg" alt=""/></body></html>`,
+		),
+		Images: []ebookmeta.Image{{Name: "fb2_img_0.jpg", Data: jpg}},
+	}
+	outPath := filepath.Join(t.TempDir(), "out.epub")
+	err := documentToEPUB(
+		context.Background(), doc, ArticleMeta{Title: "Doc", Authors: nil}, outPath,
+	)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	assert.Equal(t, string(jpg), zipEntryContent(t, zr, "OEBPS/fb2_img_0.jpg"))
+	index := zipEntryContent(t, zr, "OEBPS/index.xhtml")
+	assert.Contains(t, index, "<p>text</p>")
+	assert.Contains(t, index, `src="fb2_img_0.jpg"`)
+}
+
+func TestConvertUploadToEPUB_FB2(t *testing.T) {
+	tmp, err := os.CreateTemp(t.TempDir(), "upload-*")
+	require.NoError(t, err)
+	_, err = tmp.WriteString(testFB2Upload)
+	require.NoError(t, err)
+
+	uf := &uploadedFile{
+		tmp:       tmp,
+		size:      int64(len(testFB2Upload)),
+		format:    ebookmeta.FormatFB2,
+		meta:      ebookmeta.Metadata{}, //nolint:exhaustruct // unused here
+		checksum:  "abc",
+		converted: false,
+	}
+	origPath := tmp.Name()
+	//nolint:exhaustruct // only title/authors feed the EPUB
+	meta := ebookmeta.Metadata{
+		Title:   "Roadside Picnic",
+		Authors: []string{"Arkady Strugatsky"},
+	}
+	require.NoError(t, convertUploadToEPUB(context.Background(), uf, meta))
+	t.Cleanup(func() {
+		_ = uf.tmp.Close()
+		_ = os.Remove(uf.tmp.Name())
+	})
+
+	assert.True(t, uf.converted)
+	assert.Equal(t, ebookmeta.FormatEPUB, uf.format)
+	assert.Equal(t, "abc", uf.checksum)
+	assert.NoFileExists(t, origPath)
+	assert.Equal(t,
+		ebookmeta.FormatEPUB, ebookmeta.DetectFormatFromFile(uf.tmp, uf.size))
+
+	got, err := ebookmeta.Extract(ebookmeta.FormatEPUB, uf.tmp, uf.size)
+	require.NoError(t, err)
+	assert.Equal(t, "Roadside Picnic", got.Title)
+	assert.Equal(t, []string{"Arkady Strugatsky"}, got.Authors)
+}
+
+func TestConvertUploadToEPUB_UnreadableIsInvalidFormat(t *testing.T) {
+	tmp, err := os.CreateTemp(t.TempDir(), "upload-*")
+	require.NoError(t, err)
+	defer func() { _ = tmp.Close() }()
+	_, err = tmp.WriteString("BOOKMOBI but not really")
+	require.NoError(t, err)
+
+	uf := &uploadedFile{
+		tmp:       tmp,
+		size:      int64(len("BOOKMOBI but not really")),
+		format:    ebookmeta.FormatMOBI,
+		meta:      ebookmeta.Metadata{}, //nolint:exhaustruct // unused here
+		checksum:  "abc",
+		converted: false,
+	}
+	//nolint:exhaustruct // title only
+	err = convertUploadToEPUB(context.Background(), uf, ebookmeta.Metadata{Title: "X"})
+	require.ErrorIs(t, err, ErrInvalidFormat)
+	assert.False(t, uf.converted)
+	assert.Equal(t, ebookmeta.FormatMOBI, uf.format)
+}
+
+func TestExtForContentType_ConvertedFormats(t *testing.T) {
+	tests := map[string][2]string{
+		".azw3":    {"application/vnd.amazon.mobi8-ebook", ""},
+		".mobi":    {"", "Dune.MOBI"},
+		".fb2.zip": {"application/zip", "picnic.fb2.zip"},
+		".fb2":     {"application/x-fictionbook+xml", ""},
+	}
+	for want, in := range tests {
+		assert.Equal(t, want, extForContentType(in[0], in[1]))
+	}
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/repositories"
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+	"tools.xdoubleu.com/internal/database"
+)
+
+// DeviceImportService merges reading history read off an e-reader's local
+// database into the library: highlights, sessions, reading time and
+// read/finished status. It is what gives history to books read before sync
+// was enabled, and to sideloaded books the server never saw.
+type DeviceImportService struct {
+	logger      *slog.Logger
+	books       *BookService
+	annotations *repositories.AnnotationsRepository
+	sessions    *repositories.ReadingSessionsRepository
+}
+
+// Import applies imp to the user's library. source tags the written rows
+// (annotations, sessions, reading state) with the device family.
+func (s *DeviceImportService) Import(
+	ctx context.Context,
+	userID string,
+	source string,
+	imp models.DeviceImport,
+) (models.DeviceImportResult, error) {
+	var result models.DeviceImportResult
+	result.SkippedHighlights = imp.SkippedHighlights
+
+	lib, err := s.books.GetLibrary(ctx, userID)
+	if err != nil {
+		return result, err
+	}
+
+	for _, b := range imp.Books {
+		result.Books++
+
+		ub := findDeviceBook(lib, b)
+		if ub == nil {
+			if b.Title == "" || !b.HasHistory() {
+				result.Skipped++
+				continue
+			}
+			ub, err = s.books.AddToLibrary(ctx, userID, SourceProposal{
+				Source:  "manual",
+				Title:   b.Title,
+				Authors: b.Authors,
+				ISBN13:  deviceISBN13(b.ISBN),
+			}, models.StatusToRead, []string{})
+			if err != nil {
+				return result, err
+			}
+			lib = append(lib, *ub)
+			result.Created++
+		} else {
+			result.Matched++
+		}
+
+		markedRead, applyErr := s.applyBook(ctx, userID, source, ub, b, &result)
+		if applyErr != nil {
+			return result, applyErr
+		}
+		if markedRead {
+			result.MarkedRead++
+		}
+	}
+
+	s.logger.DebugContext(ctx, "device import finished",
+		"serial", imp.Serial, "books", result.Books, "created", result.Created)
+	return result, nil
+}
+
+// applyBook writes one book's status, progress, highlights and sessions, and
+// reports whether it moved the book to read.
+func (s *DeviceImportService) applyBook(
+	ctx context.Context,
+	userID, source string,
+	ub *models.UserBook,
+	b models.DeviceImportBook,
+	result *models.DeviceImportResult,
+) (bool, error) {
+	markedRead, err := s.applyStatus(ctx, userID, ub, b)
+	if err != nil {
+		return false, err
+	}
+
+	// Only seed progress when we have none: the device has no resumable
+	// location to give us, and live Kobo sync keeps existing state current.
+	if b.PercentRead > 0 {
+		_, stateErr := s.books.GetReadingState(ctx, userID, ub.BookID)
+		if errors.Is(stateErr, database.ErrResourceNotFound) {
+			// Seeded without a session: the device already told us how
+			// long it was read, and when.
+			if _, err = s.books.saveReadingProgress(
+				ctx, userID, ub.BookID, source, b.PercentRead, nil,
+			); err != nil {
+				return false, err
+			}
+		} else if stateErr != nil {
+			return false, stateErr
+		}
+	}
+
+	for _, a := range b.Highlights {
+		a.UserID = userID
+		a.BookID = ub.BookID
+		a.Source = source
+		if err = s.annotations.Upsert(ctx, nil, a); err != nil {
+			return false, err
+		}
+		result.Highlights++
+	}
+
+	for _, rs := range b.Sessions {
+		rs.UserID = userID
+		rs.BookID = ub.BookID
+		rs.Source = source
+		inserted, insertErr := s.sessions.Insert(ctx, rs)
+		if insertErr != nil {
+			return false, insertErr
+		}
+		if inserted {
+			result.Sessions++
+		}
+	}
+
+	return markedRead, s.reconcileReadingTime(ctx, userID, source, ub.BookID, b)
+}
+
+// applyStatus promotes the library entry to match the device: finished books
+// become read (with a finished date), books being read leave to-read. It
+// never demotes, so a re-read tracked on the web isn't undone by stale device
+// data.
+func (s *DeviceImportService) applyStatus(
+	ctx context.Context,
+	userID string,
+	ub *models.UserBook,
+	b models.DeviceImportBook,
+) (bool, error) {
+	updated := *ub
+	switch {
+	case b.ReadStatus == models.DeviceReadStatusFinished &&
+		(ub.Status != models.StatusRead || len(ub.FinishedAt) == 0):
+		finished := time.Now()
+		switch {
+		case b.FinishedAt != nil:
+			finished = *b.FinishedAt
+		case b.LastReadAt != nil:
+			finished = *b.LastReadAt
+		}
+		updated.Status = models.StatusRead
+		updated.FinishedAt = append(slices.Clone(ub.FinishedAt), finished)
+	case b.ReadStatus == models.DeviceReadStatusReading &&
+		ub.Status == models.StatusToRead:
+		updated.Status = models.StatusReading
+	default:
+		return false, nil
+	}
+
+	if updated.ShelfPositions == nil {
+		updated.ShelfPositions = map[string]int{}
+	}
+	if err := s.books.UpdateStatus(ctx, userID, updated); err != nil {
+		return false, err
+	}
+	*ub = updated
+	return updated.Status == models.StatusRead, nil
+}
+
+// reconcileReadingTime keeps one estimated session carrying the device's
+// total reading time minus what individual sessions already account for,
+// spanning first open to last read.
+func (s *DeviceImportService) reconcileReadingTime(
+	ctx context.Context,
+	userID, source string,
+	bookID uuid.UUID,
+	b models.DeviceImportBook,
+) error {
+	if b.LastReadAt == nil {
+		return nil
+	}
+
+	tracked, err := s.sessions.SumSeconds(ctx, userID, bookID, source)
+	if err != nil {
+		return err
+	}
+	remainder := b.TimeSpentSeconds - tracked
+	if remainder <= 0 {
+		return s.sessions.ReplaceEstimated(ctx, userID, bookID, source, nil)
+	}
+
+	started := b.LastReadAt.Add(-time.Duration(remainder) * time.Second)
+	if b.FirstOpenedAt != nil && b.FirstOpenedAt.Before(started) {
+		started = *b.FirstOpenedAt
+	}
+	return s.sessions.ReplaceEstimated(ctx, userID, bookID, source,
+		&models.ReadingSession{ //nolint:exhaustruct //ID/CreatedAt set by DB
+			StartedAt: started,
+			EndedAt:   *b.LastReadAt,
+			Seconds:   remainder,
+			Estimated: true,
+		})
+}
+
+// findDeviceBook resolves a device book to a library entry: by our UUID (the
+// content ID of every book we synced), then by ISBN, then by title + author.
+func findDeviceBook(
+	lib []models.UserBook,
+	b models.DeviceImportBook,
+) *models.UserBook {
+	if id, err := uuid.Parse(b.ContentID); err == nil {
+		for i := range lib {
+			if lib[i].BookID == id {
+				return &lib[i]
+			}
+		}
+	}
+
+	if isbn := normalizeISBN(b.ISBN); isbn != "" {
+		for i := range lib {
+			book := lib[i].Book
+			if book == nil || book.ISBN13 == nil {
+				continue
+			}
+			if normalizeISBN(*book.ISBN13) == isbn {
+				return &lib[i]
+			}
+		}
+	}
+
+	return matchLibraryByMetadata(lib, ebookmeta.Metadata{
+		Title:    b.Title,
+		Authors:  b.Authors,
+		ISBN13:   nil,
+		Language: nil,
+	})
+}
+
+// deviceISBN13 returns the device's ISBN when it is a 13-digit one; devices
+// also carry ISBN-10s and publisher IDs, which must not land in isbn13.
+func deviceISBN13(raw string) string {
+	const isbn13Len = 13
+	if isbn := normalizeISBN(raw); len(isbn) == isbn13Len {
+		return isbn
+	}
+	return ""
+}
@@ -0,0 +1,285 @@
+package services
+
+import (
+	"archive/zip"
+	"cmp"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// digestArticle is one feed item laid out as a chapter of a digest EPUB.
+type digestArticle struct {
+	models.FeedDigestItem
+	HTML string
+}
+
+// digestSection is one feed's articles, in the order the digest lists them.
+type digestSection struct {
+	Title    string
+	Articles []digestArticle
+}
+
+// groupDigestArticles groups articles by feed: feeds ordered by title,
+// each feed's articles oldest first.
+func groupDigestArticles(articles []digestArticle) []digestSection {
+	byFeed := map[uuid.UUID]int{}
+	var sections []digestSection
+	for _, a := range articles {
+		i, ok := byFeed[a.FeedID]
+		if !ok {
+			i = len(sections)
+			byFeed[a.FeedID] = i
+			sections = append(sections, digestSection{Title: a.FeedTitle, Articles: nil})
+		}
+		sections[i].Articles = append(sections[i].Articles, a)
+	}
+
+	slices.SortStableFunc(sections, func(a, b digestSection) int {
+		return cmp.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+	})
+	for _, s := range sections {
+		slices.SortStableFunc(s.Articles, func(a, b digestArticle) int {
+			return a.PublishedAt.Compare(b.PublishedAt)
+		})
+	}
+	return sections
+}
+
+// digestDocument is one content document of a digest EPUB.
+type digestDocument struct {
+	article digestArticle
+	href    string
+	xhtml   string
+}
+
+// buildDigestEPUB writes a digest EPUB to w: a contents page listing each
+// feed with its articles, then one chapter per article. It returns where
+// each article ended up, with the start of its chapter as a percentage of
+// the book's size — a stand-in for how far into the book a reader is once
+// they reach it, since that is all synced reading progress tells us.
+func buildDigestEPUB(
+	w io.Writer, meta ArticleMeta, sections []digestSection,
+) ([]models.FeedDigestEntry, error) {
+	// Feed content links its images remotely; nothing resolves in an empty
+	// directory, so buildArticleXHTML drops them all rather than embedding
+	// anything.
+	imgDir, err := os.MkdirTemp("", "digest-*")
+	if err != nil {
+		return nil, fmt.Errorf("create image dir: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(imgDir) }()
+
+	var docs []digestDocument
+	for _, s := range sections {
+		for _, a := range s.Articles {
+			doc, _, buildErr := buildArticleXHTML([]byte(digestArticleHTML(a)), imgDir)
+			if buildErr != nil {
+				return nil, fmt.Errorf("build article %s: %w", a.ID, buildErr)
+			}
+			docs = append(docs, digestDocument{
+				article: a,
+				href:    fmt.Sprintf("item-%03d.xhtml", len(docs)+1),
+				xhtml:   doc,
+			})
+		}
+	}
+	if len(docs) == 0 {
+		return nil, fmt.Errorf("digest has no articles")
+	}
+
+	uid := "urn:uuid:" + uuid.NewString()
+	nav := buildDigestNavXHTML(meta.Title, sections, docs)
+
+	zw := zip.NewWriter(w)
+	if err = writeStoredEntry(zw, "mimetype", "application/epub+zip"); err != nil {
+		return nil, err
+	}
+	if err = writeEntry(zw, "META-INF/container.xml", buildContainerXML()); err != nil {
+		return nil, err
+	}
+	if err = writeEntry(
+		zw, "OEBPS/content.opf", buildDigestOPF(meta, uid, docs),
+	); err != nil {
+		return nil, err
+	}
+	if err = writeEntry(
+		zw, "OEBPS/toc.ncx", buildDigestNCX(meta.Title, uid, sections, docs),
+	); err != nil {
+		return nil, err
+	}
+	if err = writeEntry(zw, "OEBPS/nav.xhtml", nav); err != nil {
+		return nil, err
+	}
+
+	total := len(nav)
+	for _, d := range docs {
+		total += len(d.xhtml)
+	}
+	before := len(nav)
+	entries := make([]models.FeedDigestEntry, 0, len(docs))
+	for _, d := range docs {
+		if err = writeEntry(zw, "OEBPS/"+d.href, d.xhtml); err != nil {
+			return nil, err
+		}
+		entries = append(entries, models.FeedDigestEntry{
+			ItemID:       d.article.ID,
+			Href:         d.href,
+			StartPercent: before * models.MaxProgressPercent / total,
+		})
+		before += len(d.xhtml)
+	}
+
+	if err = zw.Close(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// digestArticleHTML heads an article's stored body with its title, feed,
+// date and link, so each chapter says where it came from. A single feed
+// article sent to the library is headed the same way.
+func digestArticleHTML(a digestArticle) string {
+	var b strings.Builder
+	b.WriteString("<html><head><title>" + html.EscapeString(a.Title) +
+		"</title></head><body>\n")
+	b.WriteString("<h1>" + html.EscapeString(a.Title) + "</h1>\n")
+	b.WriteString("<p><em>" + html.EscapeString(a.FeedTitle))
+	if !a.PublishedAt.IsZero() {
+		b.WriteString(" · " + a.PublishedAt.Format("2 January 2006"))
+	}
+	b.WriteString("</em></p>\n")
+	if a.SourceURL != "" {
+		b.WriteString(`<p><a href="` + html.EscapeString(a.SourceURL) + `">` +
+			html.EscapeString(a.SourceURL) + "</a></p>\n")
+	}
+	b.WriteString(a.HTML)
+	b.WriteString("\n</body></html>\n")
+	return b.String()
+}
+
+// buildDigestOPF lists the contents page first in the spine, so the digest
+// opens on it, followed by the articles.
+func buildDigestOPF(meta ArticleMeta, uid string, docs []digestDocument) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n")
+	b.WriteString(
+		`<package xmlns="http://www.idpf.org/2007/opf" version="3.0" ` +
+			`unique-identifier="pub-id" xml:lang="en">` + "\n",
+	)
+	b.WriteString(
+		`  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">` + "\n",
+	)
+	b.WriteString(`    <dc:identifier id="pub-id">` + uid + "</dc:identifier>\n")
+	b.WriteString("    <dc:title>" + escapeXMLText(meta.Title) + "</dc:title>\n")
+	for _, author := range meta.Authors {
+		b.WriteString("    <dc:creator>" + escapeXMLText(author) + "</dc:creator>\n")
+	}
+	b.WriteString("    <dc:language>en</dc:language>\n")
+	b.WriteString("  </metadata>\n")
+
+	b.WriteString("  <manifest>\n")
+	b.WriteString(
+		`    <item id="nav" href="nav.xhtml" ` +
+			`media-type="application/xhtml+xml" properties="nav"/>` + "\n",
+	)
+	b.WriteString(
+		`    <item id="ncx" href="toc.ncx" ` +
+			`media-type="application/x-dtbncx+xml"/>` + "\n",
+	)
+	for i, d := range docs {
+		fmt.Fprintf(&b,
+			"    <item id=\"item%d\" href=\"%s\" media-type=\"application/xhtml+xml\"/>\n",
+			i+1, d.href)
+	}
+	b.WriteString("  </manifest>\n")
+
+	b.WriteString(`  <spine toc="ncx">` + "\n")
+	b.WriteString(`    <itemref idref="nav"/>` + "\n")
+	for i := range docs {
+		fmt.Fprintf(&b, "    <itemref idref=\"item%d\"/>\n", i+1)
+	}
+	b.WriteString("  </spine>\n")
+	b.WriteString("</package>\n")
+	return b.String()
+}
+
+// buildDigestNavXHTML builds the EPUB 3 table of contents, which doubles as
+// the digest's visible contents page: one entry per feed, leading to its
+// first article, with the feed's articles nested under it.
+func buildDigestNavXHTML(
+	title string, sections []digestSection, docs []digestDocument,
+) string {
+	escaped := escapeXMLText(title)
+
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n")
+	b.WriteString("<!DOCTYPE html>\n")
+	b.WriteString(
+		`<html xmlns="http://www.w3.org/1999/xhtml" ` +
+			`xmlns:epub="http://www.idpf.org/2007/ops">` + "\n",
+	)
+	b.WriteString("<head><title>" + escaped + "</title></head>\n")
+	b.WriteString("<body>\n")
+	b.WriteString(`  <nav epub:type="toc" id="toc">` + "\n")
+	b.WriteString("    <h1>" + escaped + "</h1>\n")
+	b.WriteString("    <ol>\n")
+	next := 0
+	for _, s := range sections {
+		fmt.Fprintf(&b, "      <li><a href=\"%s\">%s</a>\n        <ol>\n",
+			docs[next].href, escapeXMLText(s.Title))
+		for range s.Articles {
+			fmt.Fprintf(&b, "          <li><a href=\"%s\">%s</a></li>\n",
+				docs[next].href, escapeXMLText(docs[next].article.Title))
+			next++
+		}
+		b.WriteString("        </ol>\n      </li>\n")
+	}
+	b.WriteString("    </ol>\n")
+	b.WriteString("  </nav>\n")
+	b.WriteString("</body>\n")
+	b.WriteString("</html>\n")
+	return b.String()
+}
+
+// buildDigestNCX builds the EPUB 2 table of contents alongside the nav
+// document: Kobo firmware takes a KEPUB's table of contents from the NCX.
+// A feed's entry shares the play order of its first article, which it
+// points at.
+func buildDigestNCX(
+	title, uid string, sections []digestSection, docs []digestDocument,
+) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n")
+	b.WriteString(
+		`<ncx xmlns="http://www.daisy.org/z3986/2005/ncx/" version="2005-1">` + "\n",
+	)
+	b.WriteString(`  <head><meta name="dtb:uid" content="` + uid + `"/></head>` + "\n")
+	b.WriteString("  <docTitle><text>" + escapeXMLText(title) + "</text></docTitle>\n")
+	b.WriteString("  <navMap>\n")
+	next := 0
+	for i, s := range sections {
+		fmt.Fprintf(&b,
+			"    <navPoint id=\"feed%d\" playOrder=\"%d\">"+
+				"<navLabel><text>%s</text></navLabel><content src=\"%s\"/>\n",
+			i+1, next+1, escapeXMLText(s.Title), docs[next].href)
+		for range s.Articles {
+			fmt.Fprintf(&b,
+				"      <navPoint id=\"item%d\" playOrder=\"%d\">"+
+					"<navLabel><text>%s</text></navLabel><content src=\"%s\"/></navPoint>\n",
+				next+1, next+1, escapeXMLText(docs[next].article.Title), docs[next].href)
+			next++
+		}
+		b.WriteString("    </navPoint>\n")
+	}
+	b.WriteString("  </navMap>\n")
+	b.WriteString("</ncx>\n")
+	return b.String()
+}
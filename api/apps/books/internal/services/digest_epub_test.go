@@ -0,0 +1,116 @@
+//nolint:testpackage // testing unexported digest EPUB builder
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+)
+
+func digestTestArticle(
+	feedID uuid.UUID, feed, title string, published time.Time, body string,
+) digestArticle {
+	return digestArticle{
+		FeedDigestItem: models.FeedDigestItem{
+			ID:          uuid.New(),
+			UserID:      "u",
+			FeedID:      feedID,
+			FeedTitle:   feed,
+			Title:       title,
+			SourceURL:   "https://example.com/" + title,
+			PublishedAt: published,
+		},
+		HTML: body,
+	}
+}
+
+func TestGroupDigestArticles(t *testing.T) {
+	zeta, alpha := uuid.New(), uuid.New()
+	day := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	sections := groupDigestArticles([]digestArticle{
+		digestTestArticle(zeta, "Zeta", "z-late", day.Add(2*time.Hour), ""),
+		digestTestArticle(alpha, "alpha", "a-only", day, ""),
+		digestTestArticle(zeta, "Zeta", "z-early", day.Add(time.Hour), ""),
+	})
+
+	require.Len(t, sections, 2)
+	assert.Equal(t, "alpha", sections[0].Title)
+	assert.Equal(t, "Zeta", sections[1].Title)
+	require.Len(t, sections[1].Articles, 2)
+	assert.Equal(t, "z-early", sections[1].Articles[0].Title)
+	assert.Equal(t, "z-late", sections[1].Articles[1].Title)
+}
+
+func TestBuildDigestEPUB(t *testing.T) {
+	day := time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC)
+	news, blog := uuid.New(), uuid.New()
+	sections := groupDigestArticles([]digestArticle{
+		digestTestArticle(news, "News & Co", "Rates <rise>", day,
+			`<p>`+strings.Repeat("markets ", 300)+`</p><script>x()</script>`+
+				`<img src="https://cdn.example.com/chart.png">`),
+		digestTestArticle(blog, "A Blog", "Hello", day,
+			`<p>`+strings.Repeat("words ", 300)+`</p>`),
+		digestTestArticle(news, "News & Co", "Later", day.Add(time.Hour),
+			`<p>`+strings.Repeat("more ", 300)+`</p>`),
+	})
+
+	var buf bytes.Buffer
+	entries, err := buildDigestEPUB(&buf, ArticleMeta{
+		Title: "Feeds digest 2026-10-18", Authors: []string{"Feeds"},
+	}, sections)
+	require.NoError(t, err)
+
+	require.Len(t, entries, 3)
+	assert.Equal(t, sections[0].Articles[0].ID, entries[0].ItemID)
+	assert.Equal(t, "item-001.xhtml", entries[0].Href)
+	assert.Equal(t, sections[1].Articles[1].ID, entries[2].ItemID)
+	assert.Positive(t, entries[0].StartPercent)
+	assert.Less(t, entries[0].StartPercent, entries[1].StartPercent)
+	assert.Less(t, entries[1].StartPercent, entries[2].StartPercent)
+	assert.Less(t, entries[2].StartPercent, models.MaxProgressPercent)
+
+	meta, err := ebookmeta.Extract(
+		ebookmeta.FormatEPUB, bytes.NewReader(buf.Bytes()), int64(buf.Len()),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "Feeds digest 2026-10-18", meta.Title)
+	assert.Equal(t, []string{"Feeds"}, meta.Authors)
+
+	text, err := ebookmeta.ReadText(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	var hrefs []string
+	for _, s := range text {
+		assert.NotContains(t, s.Text, "x()")
+		if len(hrefs) == 0 || hrefs[len(hrefs)-1] != s.Href {
+			hrefs = append(hrefs, s.Href)
+		}
+	}
+	assert.Equal(t,
+		[]string{"nav.xhtml", "item-001.xhtml", "item-002.xhtml", "item-003.xhtml"},
+		hrefs)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	nav := zipEntryContent(t, zr, "OEBPS/nav.xhtml")
+	assert.Contains(t, nav, `<li><a href="item-002.xhtml">News &amp; Co</a>`)
+	assert.Contains(t, nav, `<li><a href="item-003.xhtml">Later</a></li>`)
+	ncx := zipEntryContent(t, zr, "OEBPS/toc.ncx")
+	assert.Contains(t, ncx, `<navPoint id="feed2" playOrder="2">`)
+	assert.Contains(t, ncx, `<text>Rates &lt;rise&gt;</text>`)
+	assert.NotContains(t, zipEntryContent(t, zr, "OEBPS/item-002.xhtml"), "<img")
+}
+
+func TestBuildDigestEPUB_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := buildDigestEPUB(&buf, ArticleMeta{Title: "t", Authors: nil}, nil)
+	assert.Error(t, err)
+}
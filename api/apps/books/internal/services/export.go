@@ -0,0 +1,231 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/repositories"
+	"tools.xdoubleu.com/apps/books/pkg/books"
+)
+
+const (
+	LibraryExportCSV  = "csv"
+	LibraryExportJSON = "json"
+)
+
+// LibraryExportFile is a rendered library export ready to be served as a
+// download.
+type LibraryExportFile struct {
+	Data        []byte
+	ContentType string
+	Filename    string
+}
+
+// ExportService renders a user's library for backup or for moving to
+// another service.
+type ExportService struct {
+	books        *repositories.BooksRepository
+	readingState *repositories.BookReadingStateRepository
+	sessions     *repositories.ReadingSessionsRepository
+	annotations  *repositories.AnnotationsRepository
+	progress     *repositories.ProgressRepository
+}
+
+// ExportLibrary renders the user's library in the requested format: a
+// Goodreads CSV (the default) that ImportFromCSV reads back, or the
+// lossless JSON backup.
+func (s *ExportService) ExportLibrary(
+	ctx context.Context,
+	userID string,
+	format string,
+	now time.Time,
+) (*LibraryExportFile, error) {
+	library, err := s.books.GetLibrary(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	filename := "library-" + now.UTC().Format(models.ProgressDateFormat)
+	var buf bytes.Buffer
+
+	switch format {
+	case "", LibraryExportCSV:
+		if err = books.WriteCSV(&buf, libraryEntries(library)); err != nil {
+			return nil, err
+		}
+		return &LibraryExportFile{
+			Data:        buf.Bytes(),
+			ContentType: "text/csv; charset=utf-8",
+			Filename:    filename + ".csv",
+		}, nil
+	case LibraryExportJSON:
+		export, buildErr := s.buildJSONExport(ctx, userID, library, now)
+		if buildErr != nil {
+			return nil, buildErr
+		}
+		if err = books.WriteJSON(&buf, export); err != nil {
+			return nil, err
+		}
+		return &LibraryExportFile{
+			Data:        buf.Bytes(),
+			ContentType: "application/json",
+			Filename:    filename + ".json",
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+func libraryEntries(library []models.UserBook) []books.ParsedEntry {
+	entries := make([]books.ParsedEntry, 0, len(library))
+	for _, ub := range library {
+		if ub.Book == nil {
+			continue
+		}
+		entries = append(entries, books.ParsedEntry{
+			Book:     *ub.Book,
+			UserBook: ub,
+			History:  nil,
+		})
+	}
+	return entries
+}
+
+func (s *ExportService) buildJSONExport(
+	ctx context.Context,
+	userID string,
+	library []models.UserBook,
+	now time.Time,
+) (books.LibraryExport, error) {
+	shelves, err := s.books.ListShelves(ctx, userID)
+	if err != nil {
+		return books.LibraryExport{}, err
+	}
+	states, err := s.readingState.ListByUser(ctx, userID)
+	if err != nil {
+		return books.LibraryExport{}, err
+	}
+	sessions, err := s.sessions.ListByUser(ctx, userID)
+	if err != nil {
+		return books.LibraryExport{}, err
+	}
+	annotations, err := s.annotations.ListByUser(ctx, userID)
+	if err != nil {
+		return books.LibraryExport{}, err
+	}
+	progress, err := s.progress.GetByDates(ctx, userID, time.Time{}, now)
+	if err != nil {
+		return books.LibraryExport{}, err
+	}
+
+	statesByBook := map[uuid.UUID][]books.ExportedReadingState{}
+	for _, st := range states {
+		statesByBook[st.BookID] = append(statesByBook[st.BookID],
+			books.ExportedReadingState{
+				Source:    st.Source,
+				Percent:   st.Percent,
+				Location:  st.Location,
+				UpdatedAt: st.UpdatedAt,
+			})
+	}
+	sessionsByBook := map[uuid.UUID][]books.ExportedSession{}
+	for _, rs := range sessions {
+		sessionsByBook[rs.BookID] = append(sessionsByBook[rs.BookID],
+			books.ExportedSession{
+				Source:       rs.Source,
+				StartedAt:    rs.StartedAt,
+				EndedAt:      rs.EndedAt,
+				Seconds:      rs.Seconds,
+				PagesTurned:  rs.PagesTurned,
+				StartPercent: rs.StartPercent,
+				EndPercent:   rs.EndPercent,
+				Estimated:    rs.Estimated,
+			})
+	}
+	annotationsByBook := map[uuid.UUID][]books.ExportedAnnotation{}
+	for _, a := range annotations {
+		annotationsByBook[a.BookID] = append(annotationsByBook[a.BookID],
+			books.ExportedAnnotation{
+				ID:               &a.ID,
+				Type:             a.Type,
+				HighlightedText:  a.HighlightedText,
+				NoteText:         a.NoteText,
+				Color:            a.Color,
+				ChapterTitle:     a.ChapterTitle,
+				ChapterProgress:  a.ChapterProgress,
+				Location:         a.Location,
+				Source:           a.Source,
+				ClientModifiedAt: a.ClientModifiedAt,
+				CreatedAt:        a.CreatedAt,
+			})
+	}
+
+	if shelves == nil {
+		shelves = []string{}
+	}
+	export := books.LibraryExport{
+		Version:    books.ExportVersion,
+		ExportedAt: now.UTC(),
+		Shelves:    shelves,
+		Books:      make([]books.ExportedBook, 0, len(library)),
+		Progress:   make([]books.ExportedProgress, 0, len(progress)),
+	}
+	for _, p := range progress {
+		export.Progress = append(export.Progress, books.ExportedProgress{
+			Date:  p.Date.Format(models.ProgressDateFormat),
+			Value: p.Value,
+		})
+	}
+	for _, ub := range library {
+		if ub.Book == nil {
+			continue
+		}
+		b := exportedBook(ub)
+		b.ReadingStates = statesByBook[ub.BookID]
+		b.Sessions = sessionsByBook[ub.BookID]
+		b.Annotations = annotationsByBook[ub.BookID]
+		export.Books = append(export.Books, b)
+	}
+	return export, nil
+}
+
+func exportedBook(ub models.UserBook) books.ExportedBook {
+	tags := ub.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	finishedAt := ub.FinishedAt
+	if finishedAt == nil {
+		finishedAt = []time.Time{}
+	}
+
+	return books.ExportedBook{
+		Title:           ub.Book.Title,
+		Authors:         ub.Book.Authors,
+		ISBN13:          ub.Book.ISBN13,
+		CoverURL:        ub.Book.CoverURL,
+		Description:     ub.Book.Description,
+		PageCount:       ub.Book.PageCount,
+		SourceURL:       ub.Book.SourceURL,
+		Series:          ub.Book.Series,
+		SeriesPosition:  ub.Book.SeriesPosition,
+		SeriesTotal:     ub.Book.SeriesTotal,
+		AddedAt:         ub.AddedAt,
+		Status:          ub.Status,
+		Tags:            tags,
+		ShelfPositions:  ub.ShelfPositions,
+		Rating:          ub.Rating,
+		FinishedAt:      finishedAt,
+		ProgressMode:    ub.ProgressMode,
+		CurrentPage:     ub.CurrentPage,
+		ProgressPercent: ub.ProgressPercent,
+		ReadingStates:   nil,
+		Sessions:        nil,
+		Annotations:     nil,
+	}
+}
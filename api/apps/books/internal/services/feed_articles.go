@@ -0,0 +1,237 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/repositories"
+	"tools.xdoubleu.com/apps/books/pkg/objectstore"
+	"tools.xdoubleu.com/internal/database"
+)
+
+// FeedProgressSink is the feeds app, as articles sent from it see it. It is
+// set from outside the app (see Books.SetFeedProgressSink) because the books
+// app never writes the feeds schema itself.
+type FeedProgressSink interface {
+	// SetItemProgress records percent as the read progress of the user's
+	// feed item.
+	SetItemProgress(
+		ctx context.Context, userID string, itemID uuid.UUID, percent int,
+	) error
+}
+
+// FeedArticleService adds feed items to the library as articles, built from
+// the body the feeds app already stored rather than fetched again, and
+// passes reading progress on them back to the items.
+type FeedArticleService struct {
+	logger      *slog.Logger
+	books       *repositories.BooksRepository
+	bookFiles   *repositories.BookFilesRepository
+	links       *repositories.FeedItemBooksRepository
+	objectStore objectstore.Client
+	sink        FeedProgressSink
+}
+
+// NewFeedArticleService constructs a FeedArticleService with no sink set.
+func NewFeedArticleService(
+	logger *slog.Logger,
+	repos *repositories.Repositories,
+	objectStore objectstore.Client,
+) *FeedArticleService {
+	return &FeedArticleService{
+		logger:      logger,
+		books:       repos.Books,
+		bookFiles:   repos.BookFiles,
+		links:       repos.FeedItems,
+		objectStore: objectStore,
+		sink:        nil,
+	}
+}
+
+// SetSink connects the service to the feeds app. Until it is called,
+// SyncProgress does nothing.
+func (s *FeedArticleService) SetSink(sink FeedProgressSink) {
+	s.sink = sink
+}
+
+// Add puts article in the user's library and links it to its feed item,
+// returning the book id. Like any URL-ingested item the entry is keyed by
+// its source URL, so sending the same article again — from the same feed
+// or another — finds the existing entry; items without a link are keyed by
+// the item instead. The entry gets the article body for the in-app reader
+// and an EPUB of it for e-readers.
+func (s *FeedArticleService) Add(
+	ctx context.Context,
+	userID string,
+	article models.FeedArticle,
+) (uuid.UUID, error) {
+	sourceURL := article.SourceURL
+	if sourceURL == "" {
+		sourceURL = "urn:uuid:" + article.ItemID.String()
+	}
+	meta := ArticleMeta{Title: article.Title, Authors: nil}
+	if meta.Title == "" {
+		meta.Title = sourceURL
+	}
+	if article.FeedTitle != "" {
+		meta.Authors = []string{article.FeedTitle}
+	}
+
+	//nolint:exhaustruct //optional fields
+	book, err := s.books.UpsertBookBySourceURL(ctx, models.Book{
+		Title:     meta.Title,
+		Authors:   meta.Authors,
+		SourceURL: &sourceURL,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if err = s.books.SetBookContentHTML(ctx, book.ID, article.ContentHTML); err != nil {
+		return uuid.Nil, err
+	}
+
+	// An entry already in the library keeps its status, tags and shelves.
+	_, err = s.books.GetUserBook(ctx, userID, book.ID)
+	switch {
+	case errors.Is(err, database.ErrResourceNotFound):
+		if err = s.books.UpsertUserBook(ctx, models.UserBook{ //nolint:exhaustruct //optional fields
+			UserID:         userID,
+			BookID:         book.ID,
+			Status:         models.StatusToRead,
+			Tags:           []string{models.TagOwnDigital},
+			ShelfPositions: map[string]int{},
+		}); err != nil {
+			return uuid.Nil, err
+		}
+	case err != nil:
+		return uuid.Nil, err
+	}
+
+	if err = s.ensureEPUB(ctx, userID, book.ID, meta, article); err != nil {
+		return uuid.Nil, err
+	}
+	if err = s.links.Link(ctx, userID, article.ItemID, book.ID); err != nil {
+		return uuid.Nil, err
+	}
+	return book.ID, nil
+}
+
+// ensureEPUB gives the user's entry an EPUB of article unless it already
+// has one, so it can go to an e-reader like any other book. Images the
+// article links remotely are left out, as in a digest.
+func (s *FeedArticleService) ensureEPUB(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	meta ArticleMeta,
+	article models.FeedArticle,
+) error {
+	_, err := s.bookFiles.GetByBookAndFormat(ctx, userID, bookID, models.FileFormatEPUB)
+	if err == nil || !errors.Is(err, database.ErrResourceNotFound) {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "feed-article-*")
+	if err != nil {
+		return fmt.Errorf("create work dir: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	htmlPath := filepath.Join(dir, "index.html")
+	body := digestArticleHTML(digestArticle{
+		FeedDigestItem: models.FeedDigestItem{ //nolint:exhaustruct //not grouped
+			ID:          article.ItemID,
+			FeedTitle:   article.FeedTitle,
+			Title:       meta.Title,
+			SourceURL:   article.SourceURL,
+			PublishedAt: article.PublishedAt,
+		},
+		HTML: article.ContentHTML,
+	})
+	if err = os.WriteFile(htmlPath, []byte(body), 0o600); err != nil {
+		return fmt.Errorf("write article html: %w", err)
+	}
+	epubPath := filepath.Join(dir, "article"+extEPUB)
+	if err = goHTMLConverter(ctx, htmlPath, epubPath, meta); err != nil {
+		return fmt.Errorf("build article epub: %w", err)
+	}
+	epub, err := os.ReadFile(epubPath)
+	if err != nil {
+		return fmt.Errorf("read article epub: %w", err)
+	}
+
+	filename := "feed-item-" + article.ItemID.String() + extEPUB
+	return storeGeneratedEPUB(
+		ctx, s.objectStore, s.bookFiles, userID, bookID, epub, filename,
+	)
+}
+
+// SyncProgress passes percent on as the read progress of every feed item
+// the user sent to the library as bookID. It does nothing for books that
+// didn't come from a feed.
+func (s *FeedArticleService) SyncProgress(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	percent int,
+) error {
+	if s.sink == nil {
+		return nil
+	}
+	itemIDs, err := s.links.ListItems(ctx, userID, bookID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, itemID := range itemIDs {
+		if setErr := s.sink.SetItemProgress(ctx, userID, itemID, percent); setErr != nil {
+			errs = append(errs, fmt.Errorf("item %s: %w", itemID, setErr))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// storeGeneratedEPUB stores an EPUB the app built itself (a digest or a
+// feed article) as a ready book file of the user's bookID, under the same
+// content-addressed key as an uploaded one.
+func storeGeneratedEPUB(
+	ctx context.Context,
+	objectStore objectstore.Client,
+	bookFiles *repositories.BookFilesRepository,
+	userID string,
+	bookID uuid.UUID,
+	epub []byte,
+	filename string,
+) error {
+	sum := sha256.Sum256(epub)
+	checksum := hex.EncodeToString(sum[:])
+	key := blobKey(checksum, extEPUB)
+	if err := objectStore.Put(
+		ctx, key, bytes.NewReader(epub), int64(len(epub)), "application/epub+zip",
+	); err != nil {
+		return fmt.Errorf("store epub: %w", err)
+	}
+
+	_, err := bookFiles.Insert(ctx, models.BookFile{ //nolint:exhaustruct //optional fields
+		BookID:           bookID,
+		UserID:           userID,
+		Format:           models.FileFormatEPUB,
+		StorageKey:       key,
+		SizeBytes:        int64(len(epub)),
+		Checksum:         &checksum,
+		OriginalFilename: &filename,
+		Status:           models.FileStatusReady,
+	})
+	return err
+}
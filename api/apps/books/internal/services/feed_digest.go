@@ -0,0 +1,270 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/repositories"
+	"tools.xdoubleu.com/apps/books/pkg/objectstore"
+)
+
+const (
+	// digestLookback bounds which feed items a digest considers: those
+	// ingested this recently. Older ones were either offered to an earlier
+	// digest already or predate digests altogether.
+	digestLookback = 7 * 24 * time.Hour
+	// maxDigestItems caps one digest; beyond it the newest items win.
+	maxDigestItems = 50
+	// digestAuthor is the digest's dc:creator and library author.
+	digestAuthor = "Feeds"
+)
+
+// FeedItemSource is the feeds app, as the digest sees it. It is set from
+// outside the app (see Books.SetFeedDigestSource) because the books app
+// never reads the feeds schema itself.
+type FeedItemSource interface {
+	// ListDigestCandidates returns every user's unread or bookmarked items
+	// ingested since since.
+	ListDigestCandidates(
+		ctx context.Context, since time.Time,
+	) ([]models.FeedDigestItem, error)
+	// ItemContent returns the article bodies of the user's itemIDs.
+	ItemContent(
+		ctx context.Context, userID string, itemIDs []uuid.UUID,
+	) (map[uuid.UUID]string, error)
+	// MarkItemsRead marks the user's itemIDs read.
+	MarkItemsRead(ctx context.Context, userID string, itemIDs []uuid.UUID) error
+}
+
+// DigestService bundles each user's unread and bookmarked feed items into
+// a daily EPUB that syncs to their Kobo, and marks the items read in feeds
+// as reading progress on the digest passes them.
+type DigestService struct {
+	logger      *slog.Logger
+	repo        *repositories.FeedDigestsRepository
+	books       *repositories.BooksRepository
+	bookFiles   *repositories.BookFilesRepository
+	objectStore objectstore.Client
+	conversion  *ConversionService
+	source      FeedItemSource
+	now         func() time.Time
+}
+
+// NewDigestService constructs a DigestService with no source set.
+func NewDigestService(
+	logger *slog.Logger,
+	repos *repositories.Repositories,
+	objectStore objectstore.Client,
+	conversion *ConversionService,
+) *DigestService {
+	return &DigestService{
+		logger:      logger,
+		repo:        repos.FeedDigests,
+		books:       repos.Books,
+		bookFiles:   repos.BookFiles,
+		objectStore: objectStore,
+		conversion:  conversion,
+		source:      nil,
+		now:         time.Now,
+	}
+}
+
+// SetSource connects the service to the feeds app. Until it is called,
+// BuildDaily and SyncProgress do nothing.
+func (s *DigestService) SetSource(source FeedItemSource) {
+	s.source = source
+}
+
+// BuildDaily builds today's digest for every user with new unread or
+// bookmarked feed items who doesn't have one yet. A failure for one user is
+// logged and doesn't stop the others.
+func (s *DigestService) BuildDaily(ctx context.Context, logger *slog.Logger) error {
+	if s.source == nil {
+		return nil
+	}
+
+	now := s.now().UTC()
+	candidates, err := s.source.ListDigestCandidates(ctx, now.Add(-digestLookback))
+	if err != nil {
+		return fmt.Errorf("list digest candidates: %w", err)
+	}
+
+	byUser := map[string][]models.FeedDigestItem{}
+	var users []string
+	for _, c := range candidates {
+		if _, ok := byUser[c.UserID]; !ok {
+			users = append(users, c.UserID)
+		}
+		byUser[c.UserID] = append(byUser[c.UserID], c)
+	}
+
+	date := now.Truncate(24 * time.Hour)
+	for _, userID := range users {
+		if buildErr := s.buildForUser(ctx, userID, date, byUser[userID]); buildErr != nil {
+			logger.WarnContext(ctx, "failed to build feeds digest",
+				slog.String("user_id", userID),
+				slog.Any("error", buildErr),
+			)
+		}
+	}
+	return nil
+}
+
+func (s *DigestService) buildForUser(
+	ctx context.Context,
+	userID string,
+	date time.Time,
+	candidates []models.FeedDigestItem,
+) error {
+	exists, err := s.repo.ExistsForDate(ctx, userID, date)
+	if err != nil || exists {
+		return err
+	}
+
+	ids := make([]uuid.UUID, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.ID
+	}
+	fresh, err := s.repo.FilterUndigested(ctx, userID, ids)
+	if err != nil {
+		return err
+	}
+	if len(fresh) > maxDigestItems {
+		fresh = fresh[len(fresh)-maxDigestItems:]
+	}
+	if len(fresh) == 0 {
+		return nil
+	}
+
+	content, err := s.source.ItemContent(ctx, userID, fresh)
+	if err != nil {
+		return fmt.Errorf("fetch item content: %w", err)
+	}
+	byID := make(map[uuid.UUID]models.FeedDigestItem, len(candidates))
+	for _, c := range candidates {
+		byID[c.ID] = c
+	}
+	var articles []digestArticle
+	for _, id := range fresh {
+		if body := content[id]; body != "" {
+			articles = append(articles, digestArticle{FeedDigestItem: byID[id], HTML: body})
+		}
+	}
+	if len(articles) == 0 {
+		return nil
+	}
+
+	meta := ArticleMeta{
+		Title:   "Feeds digest " + date.Format(time.DateOnly),
+		Authors: []string{digestAuthor},
+	}
+	var buf bytes.Buffer
+	entries, err := buildDigestEPUB(&buf, meta, groupDigestArticles(articles))
+	if err != nil {
+		return err
+	}
+
+	bookID, err := s.addToLibrary(ctx, userID, date, meta, len(articles), buf.Bytes())
+	if err != nil {
+		return err
+	}
+	if err = s.repo.Insert(ctx, userID, bookID, date, entries); err != nil {
+		return err
+	}
+
+	// The digest stays in the library either way; without a KEPUB it just
+	// isn't on the device until one is made (e.g. by toggling Kobo sync).
+	if _, err = s.conversion.EnsureKEPUB(ctx, userID, bookID); err != nil {
+		s.logger.WarnContext(ctx, "failed to convert feeds digest for kobo",
+			slog.String("book_id", bookID.String()),
+			slog.Any("error", err),
+		)
+	}
+	return nil
+}
+
+// addToLibrary stores epub as a book in the user's library, to read and
+// synced to Kobo. Its source_url (the catalog's dedup key, set on every
+// non-book entry) is derived from the user and date, which keeps metadata
+// resync away from it and makes the step idempotent: should the digest row
+// fail to be recorded after it, the next BuildDaily lands on the same book
+// and replaces the file it left behind rather than adding a second digest.
+func (s *DigestService) addToLibrary(
+	ctx context.Context,
+	userID string,
+	date time.Time,
+	meta ArticleMeta,
+	articleCount int,
+	epub []byte,
+) (uuid.UUID, error) {
+	sourceURL := "urn:uuid:" + uuid.NewSHA1(
+		uuid.NameSpaceURL,
+		[]byte("feeds-digest:"+userID+":"+date.Format(time.DateOnly)),
+	).String()
+	description := fmt.Sprintf("%d unread and bookmarked feed items.", articleCount)
+	//nolint:exhaustruct //optional fields
+	book, err := s.books.UpsertBookBySourceURL(ctx, models.Book{
+		Title:       meta.Title,
+		Authors:     meta.Authors,
+		Description: &description,
+		SourceURL:   &sourceURL,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	tags := []string{models.TagOwnDigital, models.TagKoboSync}
+	if err = s.books.UpsertUserBook(ctx, models.UserBook{ //nolint:exhaustruct //optional fields
+		UserID:         userID,
+		BookID:         book.ID,
+		Status:         models.StatusToRead,
+		Tags:           tags,
+		ShelfPositions: map[string]int{},
+	}); err != nil {
+		return uuid.Nil, err
+	}
+	// UpsertUserBook leaves kobo_sync_enabled_at alone; UpdateTags sets it.
+	if err = s.books.UpdateTags(ctx, userID, book.ID, tags, true); err != nil {
+		return uuid.Nil, err
+	}
+
+	// Only an earlier, unrecorded build of this digest has files here.
+	if _, err = s.bookFiles.DeleteByUserBook(ctx, userID, book.ID); err != nil {
+		return uuid.Nil, err
+	}
+	filename := "feeds-digest-" + date.Format(time.DateOnly) + extEPUB
+	if err = storeGeneratedEPUB(
+		ctx, s.objectStore, s.bookFiles, userID, book.ID, epub, filename,
+	); err != nil {
+		return uuid.Nil, err
+	}
+	return book.ID, nil
+}
+
+// SyncProgress marks read in feeds every item of digest bookID whose
+// chapter the reader has reached at percent. It does nothing for books that
+// aren't digests.
+func (s *DigestService) SyncProgress(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	percent int,
+) error {
+	if s.source == nil || percent <= 0 {
+		return nil
+	}
+	reached, err := s.repo.ListReached(ctx, userID, bookID, percent)
+	if err != nil || len(reached) == 0 {
+		return err
+	}
+	if err = s.source.MarkItemsRead(ctx, userID, reached); err != nil {
+		return fmt.Errorf("mark feed items read: %w", err)
+	}
+	return s.repo.SetMarkedRead(ctx, bookID, reached)
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/repositories"
+)
+
+// ErrInvalidGoal is returned when a reading goal has an unknown kind, a
+// target below one or no year.
+var ErrInvalidGoal = errors.New(
+	"goal needs a year, a kind of books or pages and a positive target",
+)
+
+// goalPaceTolerance is the share of a goal's target progress may be off an
+// even pace and still count as on track. It never drops below one book or
+// page.
+const goalPaceTolerance = 0.01
+
+// GoalService manages yearly reading goals and measures progress against
+// them from the dates books were finished.
+type GoalService struct {
+	repo *repositories.ReadingGoalsRepository
+}
+
+// SetGoal creates or replaces the goal of g.Kind for g.Year.
+func (s *GoalService) SetGoal(
+	ctx context.Context,
+	g models.ReadingGoal,
+) (models.ReadingGoal, error) {
+	if g.Year <= 0 || g.Target <= 0 ||
+		(g.Kind != models.GoalKindBooks && g.Kind != models.GoalKindPages) {
+		return models.ReadingGoal{}, ErrInvalidGoal
+	}
+	return s.repo.Upsert(ctx, g)
+}
+
+// DeleteGoal removes a goal. Returns database.ErrResourceNotFound when the
+// user has no such goal.
+func (s *GoalService) DeleteGoal(
+	ctx context.Context,
+	userID string,
+	year int,
+	kind string,
+) error {
+	return s.repo.Delete(ctx, userID, year, kind)
+}
+
+// GetProgress reports progress on the user's goals for a year. With
+// publicOnly set only goals shared on the public dashboard are included.
+func (s *GoalService) GetProgress(
+	ctx context.Context,
+	userID string,
+	year int,
+	publicOnly bool,
+	now time.Time,
+) ([]models.ReadingGoalProgress, error) {
+	goals, err := s.repo.ListByYear(ctx, userID, year, publicOnly)
+	if err != nil || len(goals) == 0 {
+		return nil, err
+	}
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	books, pages, err := s.repo.GetFinishedTotals(
+		ctx, userID, start, start.AddDate(1, 0, 0),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make([]models.ReadingGoalProgress, 0, len(goals))
+	for _, g := range goals {
+		current := books
+		if g.Kind == models.GoalKindPages {
+			current = pages
+		}
+		progress = append(progress, goalProgress(g, current, now))
+	}
+	return progress, nil
+}
+
+// goalProgress compares current against an even pace through the goal's
+// year as of now, and projects the year-end total at the pace so far.
+func goalProgress(
+	g models.ReadingGoal,
+	current int,
+	now time.Time,
+) models.ReadingGoalProgress {
+	start := time.Date(g.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+
+	elapsed := 0.0
+	switch {
+	case !now.After(start):
+	case !now.Before(end):
+		elapsed = 1
+	default:
+		elapsed = float64(now.Sub(start)) / float64(end.Sub(start))
+	}
+
+	p := models.ReadingGoalProgress{
+		Goal:      g,
+		Current:   current,
+		Expected:  float64(g.Target) * elapsed,
+		Projected: 0,
+		Pace:      models.GoalPaceOnTrack,
+	}
+	if elapsed > 0 {
+		p.Projected = float64(current) / elapsed
+	}
+
+	tolerance := max(1, float64(g.Target)*goalPaceTolerance)
+	switch delta := float64(current) - p.Expected; {
+	case delta >= tolerance:
+		p.Pace = models.GoalPaceAhead
+	case delta <= -tolerance:
+		p.Pace = models.GoalPaceBehind
+	}
+	return p
+}
@@ -0,0 +1,87 @@
+//nolint:testpackage // testing unexported pace helper
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+func TestGoalProgress_Pace(t *testing.T) {
+	// Noon on 2 July 2023 is exactly halfway through the year.
+	mid := time.Date(2023, 7, 2, 12, 0, 0, 0, time.UTC)
+	goal := models.ReadingGoal{ //nolint:exhaustruct // partial
+		Year: 2023, Kind: models.GoalKindBooks, Target: 24,
+	}
+
+	tests := map[string]struct {
+		current int
+		pace    string
+	}{
+		"ahead":    {current: 14, pace: models.GoalPaceAhead},
+		"on track": {current: 12, pace: models.GoalPaceOnTrack},
+		"behind":   {current: 11, pace: models.GoalPaceBehind},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := goalProgress(goal, tt.current, mid)
+			assert.InDelta(t, 12, p.Expected, 1e-9)
+			assert.InDelta(t, float64(tt.current)*2, p.Projected, 1e-9)
+			assert.Equal(t, tt.pace, p.Pace)
+		})
+	}
+}
+
+func TestGoalProgress_ToleranceScalesWithTarget(t *testing.T) {
+	mid := time.Date(2023, 7, 2, 12, 0, 0, 0, time.UTC)
+	goal := models.ReadingGoal{ //nolint:exhaustruct // partial
+		Year: 2023, Kind: models.GoalKindPages, Target: 10000,
+	}
+
+	// 1% of 10000 pages is 100, so 60 pages short is still on track.
+	assert.Equal(t, models.GoalPaceOnTrack, goalProgress(goal, 4940, mid).Pace)
+	assert.Equal(t, models.GoalPaceBehind, goalProgress(goal, 4890, mid).Pace)
+}
+
+func TestGoalProgress_OutsideTheYear(t *testing.T) {
+	goal := models.ReadingGoal{ //nolint:exhaustruct // partial
+		Year: 2023, Kind: models.GoalKindBooks, Target: 12,
+	}
+
+	before := goalProgress(goal, 0, time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC))
+	assert.Zero(t, before.Expected)
+	assert.Zero(t, before.Projected)
+	assert.Equal(t, models.GoalPaceOnTrack, before.Pace)
+
+	after := goalProgress(goal, 10, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	assert.InDelta(t, 12, after.Expected, 1e-9)
+	assert.InDelta(t, 10, after.Projected, 1e-9)
+	assert.Equal(t, models.GoalPaceBehind, after.Pace)
+}
+
+func TestSetGoal_RejectsInvalidGoals(t *testing.T) {
+	s := &GoalService{repo: nil}
+	tests := map[string]models.ReadingGoal{
+		"no year": { //nolint:exhaustruct // partial
+			Kind: models.GoalKindBooks, Target: 10,
+		},
+		"zero target": { //nolint:exhaustruct // partial
+			Year: 2024, Kind: models.GoalKindBooks,
+		},
+		"unknown kind": { //nolint:exhaustruct // partial
+			Year: 2024, Kind: "chapters", Target: 10,
+		},
+	}
+
+	for name, g := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := s.SetGoal(context.Background(), g)
+			assert.ErrorIs(t, err, ErrInvalidGoal)
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -20,9 +22,21 @@ type KoboService struct {
 	repo *repositories.KoboDevicesRepository
 }
 
-// koboTokenBytes is the number of random bytes for a Kobo sync token
-// (256 bits of entropy — URL-safe base64 yields a 43-character string).
-const koboTokenBytes = 32
+// accessTokenBytes is the number of random bytes for a Kobo sync or OPDS
+// token (256 bits of entropy — URL-safe base64 yields a 43-character string).
+const accessTokenBytes = 32
+
+// newAccessToken generates a random URL-safe token for embedding in a device
+// or reader URL, and the hex SHA-256 hash that is stored in its place.
+func newAccessToken() (string, string, error) {
+	raw := make([]byte, accessTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	rawToken := base64.RawURLEncoding.EncodeToString(raw)
+	h := sha256.Sum256([]byte(rawToken))
+	return rawToken, hex.EncodeToString(h[:]), nil
+}
 
 // RegisterKoboDevice generates a new high-entropy random token, stores only
 // its sha256 hash (never the raw token), and returns the persisted device
@@ -31,13 +45,10 @@ func (s *KoboService) RegisterKoboDevice(
 	ctx context.Context,
 	userID, name, serial string,
 ) (models.KoboDevice, string, error) {
-	raw := make([]byte, koboTokenBytes)
-	if _, err := rand.Read(raw); err != nil {
+	rawToken, hash, err := newAccessToken()
+	if err != nil {
 		return models.KoboDevice{}, "", err
 	}
-	rawToken := base64.RawURLEncoding.EncodeToString(raw)
-	h := sha256.Sum256([]byte(rawToken))
-	hash := hex.EncodeToString(h[:])
 	device, err := s.repo.CreateKoboDevice(ctx, userID, name, serial, hash)
 	if err != nil {
 		return models.KoboDevice{}, "", err
@@ -80,3 +91,61 @@ func (s *KoboService) GetKoboDevice(
 ) (models.KoboDevice, error) {
 	return s.repo.GetKoboDevice(ctx, userID, deviceID)
 }
+
+// SetKoboDeviceFilter replaces the device's sync filter. Blank and repeated
+// entries are dropped; shelves and tags need not exist yet, so a filter can
+// name a shelf before any book is on it.
+func (s *KoboService) SetKoboDeviceFilter(
+	ctx context.Context,
+	userID string,
+	deviceID uuid.UUID,
+	filter models.KoboSyncFilter,
+) (models.KoboDevice, error) {
+	filter = models.KoboSyncFilter{
+		Shelves: normalizeFilterValues(filter.Shelves),
+		Tags:    normalizeFilterValues(filter.Tags),
+	}
+	return s.repo.SetKoboDeviceFilter(ctx, userID, deviceID, filter)
+}
+
+// ApplyKoboDeviceFilter narrows books to those the device's filter lets
+// through and records them as the device's books. It also returns the books
+// the device was sent before but should no longer have, so the sync can
+// archive them there.
+func (s *KoboService) ApplyKoboDeviceFilter(
+	ctx context.Context,
+	userID string,
+	deviceID uuid.UUID,
+	books []models.KoboSyncBook,
+) ([]models.KoboSyncBook, []models.KoboRemoval, error) {
+	device, err := s.repo.GetKoboDevice(ctx, userID, deviceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kept := make([]models.KoboSyncBook, 0, len(books))
+	ids := make([]uuid.UUID, 0, len(books))
+	for _, b := range books {
+		if device.Filter.Matches(b.Status, b.Tags) {
+			kept = append(kept, b)
+			ids = append(ids, b.BookID)
+		}
+	}
+
+	archived, err := s.repo.RecordKoboDeviceBooks(ctx, deviceID, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	return kept, archived, nil
+}
+
+func normalizeFilterValues(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v != "" && !slices.Contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
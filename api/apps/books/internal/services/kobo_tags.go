@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// Shelves reach the Kobo as collections (the store protocol's "tags").
+// Custom shelves get a collection automatically; the built-in statuses only
+// get one when the user creates a collection by that name on the device,
+// since the firmware already has its own views for reading progress.
+
+// ListKoboTags returns the user's Kobo collections, tombstones included,
+// after bringing them in line with the current shelves.
+func (s *BookService) ListKoboTags(
+	ctx context.Context,
+	userID string,
+) ([]models.KoboTag, error) {
+	builtIns := slices.Sorted(maps.Keys(builtInStatuses))
+	if err := s.books.RefreshKoboTags(ctx, userID, builtIns); err != nil {
+		return nil, err
+	}
+	return s.books.ListKoboTags(ctx, userID)
+}
+
+// GetKoboTag returns a live collection by ID, or
+// database.ErrResourceNotFound when it isn't one of ours (store collections
+// share the same endpoints).
+func (s *BookService) GetKoboTag(
+	ctx context.Context,
+	userID string,
+	id uuid.UUID,
+) (*models.KoboTag, error) {
+	return s.books.GetKoboTag(ctx, userID, id)
+}
+
+// CreateKoboTag handles a collection created on the device: it becomes a
+// shelf of the same name, or joins the existing one.
+func (s *BookService) CreateKoboTag(
+	ctx context.Context,
+	userID string,
+	name string,
+) (models.KoboTag, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return models.KoboTag{}, fmt.Errorf("collection name cannot be empty")
+	}
+	if err := s.registerCustomShelf(ctx, userID, name); err != nil {
+		return models.KoboTag{}, err
+	}
+	return s.books.EnsureKoboTag(ctx, userID, name)
+}
+
+// RenameKoboTag renames the shelf behind a collection renamed on the device.
+// Collections backed by a built-in status cannot be renamed.
+func (s *BookService) RenameKoboTag(
+	ctx context.Context,
+	userID string,
+	tag models.KoboTag,
+	newName string,
+) error {
+	newName = strings.TrimSpace(newName)
+	if newName == tag.Shelf {
+		return nil
+	}
+	_, err := s.RenameShelf(ctx, userID, tag.Shelf, newName)
+	return err
+}
+
+// DeleteKoboTag handles a collection deleted on the device. A custom shelf
+// is deleted with it, its books moving back to to-read; a built-in status
+// only loses the collection.
+func (s *BookService) DeleteKoboTag(
+	ctx context.Context,
+	userID string,
+	tag models.KoboTag,
+) error {
+	if builtInStatuses[tag.Shelf] {
+		return s.books.DeleteKoboTag(ctx, userID, tag.ID)
+	}
+	_, err := s.DeleteShelf(ctx, userID, tag.Shelf, models.StatusToRead)
+	return err
+}
@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // KOReader's protocol is built on MD5
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/repositories"
+	"tools.xdoubleu.com/apps/books/pkg/objectstore"
+	"tools.xdoubleu.com/internal/database"
+)
+
+// ErrKOReaderUsername is returned when a KOReader device is registered
+// without a username.
+var ErrKOReaderUsername = errors.New("username cannot be empty")
+
+// koreaderPasswordBytes is the number of random bytes in a generated
+// KOReader password. Lower-case base32 keeps it typeable on an e-ink
+// keyboard: 10 bytes give 16 characters and 80 bits of entropy.
+const koreaderPasswordBytes = 10
+
+// koreaderSampleSize is the size of each sample in KOReader's partial MD5.
+const koreaderSampleSize = 1024
+
+// KOReaderService implements the server side of KOReader's progress sync
+// (kosync). KOReader logs in with a username and the MD5 of a password we
+// generate; it identifies books by a partial MD5 of the file, which is
+// matched against the digests of the user's book files.
+type KOReaderService struct {
+	logger      *slog.Logger
+	repo        *repositories.KOReaderRepository
+	bookFiles   *repositories.BookFilesRepository
+	objectStore objectstore.Client
+	books       *BookService
+}
+
+// KOReaderKey derives the key KOReader sends in x-auth-key from a password.
+func KOReaderKey(password string) string {
+	sum := md5.Sum([]byte(password)) //nolint:gosec // kosync key derivation
+	return hex.EncodeToString(sum[:])
+}
+
+func koreaderKeyHash(key string) string {
+	h := sha256.Sum256([]byte(strings.ToLower(key)))
+	return hex.EncodeToString(h[:])
+}
+
+// RegisterDevice creates credentials for a KOReader install and returns the
+// persisted device together with the generated password for one-time
+// display.
+func (s *KOReaderService) RegisterDevice(
+	ctx context.Context,
+	userID, username string,
+) (models.KOReaderDevice, string, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return models.KOReaderDevice{}, "", ErrKOReaderUsername
+	}
+	raw := make([]byte, koreaderPasswordBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return models.KOReaderDevice{}, "", err
+	}
+	password := strings.ToLower(
+		base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(raw),
+	)
+	device, err := s.repo.CreateDevice(
+		ctx, userID, username, koreaderKeyHash(KOReaderKey(password)),
+	)
+	if err != nil {
+		return models.KOReaderDevice{}, "", err
+	}
+	return device, password, nil
+}
+
+// ListDevices returns all KOReader devices of a user.
+func (s *KOReaderService) ListDevices(
+	ctx context.Context,
+	userID string,
+) ([]models.KOReaderDevice, error) {
+	return s.repo.ListDevices(ctx, userID)
+}
+
+// DisconnectDevice deletes a device's credentials.
+func (s *KOReaderService) DisconnectDevice(
+	ctx context.Context,
+	userID string,
+	deviceID uuid.UUID,
+) error {
+	return s.repo.DeleteDevice(ctx, userID, deviceID)
+}
+
+// Authenticate returns the user owning the credentials KOReader sent.
+// Returns database.ErrResourceNotFound when the key is unknown or belongs to
+// a different username.
+func (s *KOReaderService) Authenticate(
+	ctx context.Context,
+	username, key string,
+) (string, error) {
+	if username == "" || key == "" {
+		return "", database.ErrResourceNotFound
+	}
+	device, err := s.repo.GetDeviceByKeyHash(ctx, koreaderKeyHash(key))
+	if err != nil {
+		return "", err
+	}
+	if device.Username != username {
+		return "", database.ErrResourceNotFound
+	}
+	return device.UserID, nil
+}
+
+// PushProgress stores a position sent by KOReader. When the document matches
+// one of the user's book files, the position also becomes the book's reading
+// state, next to Kobo and web progress.
+func (s *KOReaderService) PushProgress(
+	ctx context.Context,
+	p models.KOSyncProgress,
+) (*models.KOSyncProgress, error) {
+	bookID, found, err := s.findBook(ctx, p.UserID, p.Document)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		p.BookID = &bookID
+	}
+
+	saved, err := s.repo.UpsertProgress(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if found {
+		percent := int(math.Round(p.Percentage * float64(models.MaxProgressPercent)))
+		location := p.Progress
+		if err = s.books.UpdateReadingProgress(
+			ctx, p.UserID, bookID, models.ReadingSourceKOReader, percent, &location,
+		); err != nil {
+			return nil, err
+		}
+	}
+	return saved, nil
+}
+
+// GetProgress returns the last position pushed for a document.
+func (s *KOReaderService) GetProgress(
+	ctx context.Context,
+	userID, document string,
+) (*models.KOSyncProgress, error) {
+	return s.repo.GetProgress(ctx, userID, document)
+}
+
+// findBook maps a KOReader document digest to one of the user's books,
+// reporting whether one matched. Digests are computed lazily: on a miss, the files
+// that don't have one yet are fetched and hashed until a match turns up.
+func (s *KOReaderService) findBook(
+	ctx context.Context,
+	userID, digest string,
+) (uuid.UUID, bool, error) {
+	bookID, err := s.bookFiles.FindBookByKOReaderDigest(ctx, userID, digest)
+	if err == nil {
+		return bookID, true, nil
+	}
+	if !errors.Is(err, database.ErrResourceNotFound) {
+		return uuid.Nil, false, err
+	}
+
+	files, err := s.bookFiles.ListMissingKOReaderDigests(ctx, userID)
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	for _, f := range files {
+		fileDigest, digestErr := s.fileDigest(ctx, f.ServedStorageKey())
+		if digestErr != nil {
+			// Leave it for a later miss; one unreadable object shouldn't
+			// break sync for the rest of the library.
+			s.logger.WarnContext(ctx, "koreader digest failed",
+				slog.String("file_id", f.ID.String()), slog.Any("error", digestErr))
+			continue
+		}
+		if err = s.bookFiles.SetKOReaderDigest(ctx, f.ID, fileDigest); err != nil {
+			return uuid.Nil, false, err
+		}
+		if fileDigest == digest {
+			return f.BookID, true, nil
+		}
+	}
+	return uuid.Nil, false, nil
+}
+
+func (s *KOReaderService) fileDigest(
+	ctx context.Context,
+	storageKey string,
+) (string, error) {
+	return objectKOReaderDigest(ctx, s.objectStore, storageKey)
+}
+
+// objectKOReaderDigest computes the KOReader digest of the object at key.
+func objectKOReaderDigest(
+	ctx context.Context,
+	store objectstore.Client,
+	key string,
+) (string, error) {
+	rc, err := store.Get(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+	return koreaderDigest(rc)
+}
+
+// koreaderDigest computes KOReader's "binary" document digest: the MD5 of
+// 1 KiB samples taken at offset 0 and at 1024·4^i for i = 0..10, stopping at
+// the end of the file. (KOReader computes the first offset as
+// lshift(1024, -2), which LuaJIT wraps around to 0.)
+func koreaderDigest(r io.Reader) (string, error) {
+	h := md5.New() //nolint:gosec // KOReader's document digest
+	buf := make([]byte, koreaderSampleSize)
+	var pos int64
+	for i := -1; i <= 10; i++ {
+		var offset int64
+		if i >= 0 {
+			offset = koreaderSampleSize << (2 * i)
+		}
+		if offset > pos {
+			skipped, err := io.CopyN(io.Discard, r, offset-pos)
+			pos += skipped
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return "", err
+			}
+		}
+		n, err := io.ReadFull(r, buf)
+		pos += int64(n)
+		h.Write(buf[:n])
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
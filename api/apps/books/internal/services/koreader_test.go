@@ -0,0 +1,47 @@
+//nolint:testpackage // testing unexported service helpers
+package services
+
+import (
+	"bytes"
+	"crypto/md5" //nolint:gosec // comparing against KOReader's digest
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// koreaderTestFile returns size bytes of a repeating, non-aligned pattern so
+// every sample differs.
+func koreaderTestFile(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	return data
+}
+
+func TestKOReaderDigest_SmallFileIsPlainMD5(t *testing.T) {
+	data := koreaderTestFile(700)
+	sum := md5.Sum(data) //nolint:gosec // see import
+	digest, err := koreaderDigest(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(sum[:]), digest)
+}
+
+func TestKOReaderDigest_SamplesAtPowersOfFour(t *testing.T) {
+	// Expected values from KOReader's util.partialMD5 algorithm.
+	for size, want := range map[int]string{
+		5000:  "e77dcca7f22a949ae8492c260ca19f32",
+		70000: "f34024643c82aec13b2fd5eb2842658a",
+	} {
+		digest, err := koreaderDigest(bytes.NewReader(koreaderTestFile(size)))
+		require.NoError(t, err)
+		assert.Equal(t, want, digest, "size %d", size)
+	}
+}
+
+func TestKOReaderKey(t *testing.T) {
+	// md5("password"), as KOReader sends it in x-auth-key.
+	assert.Equal(t, "5f4dcc3b5aa765d61d8327deb882cf99", KOReaderKey("password"))
+}
@@ -0,0 +1,437 @@
+package services
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/pkg/books"
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+)
+
+const (
+	ImportActionCreate = "create"
+	ImportActionMerge  = "merge"
+	ImportActionSkip   = "skip"
+)
+
+// Reasons an import entry is skipped.
+const (
+	importSkipNoTitle   = "no title"
+	importSkipDuplicate = "duplicate in file"
+	importSkipUnchanged = "unchanged"
+)
+
+// ErrInvalidImport is returned when an import file is in no known format or
+// can't be parsed in the one asked for.
+var ErrInvalidImport = errors.New("could not read import file")
+
+// ImportPlanItem is the decision made for one entry of an import file.
+type ImportPlanItem struct {
+	Entry  books.ParsedEntry
+	Action string
+	// Reason is the matching signal for a merge ("isbn13" | "title+author")
+	// or why the entry is skipped.
+	Reason string
+	// Existing is the library entry a merge or an unchanged skip matched,
+	// as it was before the import.
+	Existing *models.UserBook
+	// Result is what a merge writes back to the library.
+	Result models.UserBook
+}
+
+// LibraryImport is the outcome of ImportLibrary: the plan, and on a real
+// run, what was written.
+type LibraryImport struct {
+	Format  string
+	Items   []ImportPlanItem
+	Created int
+	Merged  int
+	Skipped int
+}
+
+// ImportLibrary reads another service's library export and merges it into
+// the user's library. format names one of books.Importers(); empty detects
+// it. Entries matching a library book by ISBN-13 or by title and author are
+// merged into it rather than added again, and duplicates within the file
+// are folded together first. With dryRun set the plan is returned without
+// writing anything.
+func (s *BookService) ImportLibrary(
+	ctx context.Context,
+	userID string,
+	data []byte,
+	format string,
+	dryRun bool,
+) (*LibraryImport, error) {
+	var (
+		importer books.Importer
+		err      error
+	)
+	if format == "" {
+		importer, err = books.DetectFormat(data)
+	} else {
+		importer, err = books.ImporterFor(format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImport, err)
+	}
+
+	entries, err := importer.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImport, err)
+	}
+
+	lib, err := s.books.GetLibrary(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LibraryImport{
+		Format:  importer.Name(),
+		Items:   planImport(lib, entries),
+		Created: 0,
+		Merged:  0,
+		Skipped: 0,
+	}
+	for _, item := range result.Items {
+		switch item.Action {
+		case ImportActionCreate:
+			result.Created++
+		case ImportActionMerge:
+			result.Merged++
+		default:
+			result.Skipped++
+		}
+	}
+
+	s.logger.DebugContext(ctx, fmt.Sprintf(
+		"import from %s: %d to create, %d to merge, %d skipped (dry run: %t)",
+		result.Format, result.Created, result.Merged, result.Skipped, dryRun,
+	))
+
+	if dryRun {
+		return result, nil
+	}
+	if err = s.applyImport(ctx, userID, result.Items); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ImportFromCSV imports a Goodreads CSV export and returns how many books
+// were created or merged.
+func (s *BookService) ImportFromCSV(
+	ctx context.Context,
+	userID string,
+	r io.Reader,
+) (int, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	result, err := s.ImportLibrary(ctx, userID, data, books.FormatGoodreads, false)
+	if err != nil {
+		return 0, err
+	}
+	return result.Created + result.Merged, nil
+}
+
+func (s *BookService) applyImport(
+	ctx context.Context,
+	userID string,
+	items []ImportPlanItem,
+) error {
+	var (
+		bookList []models.Book
+		ubList   []models.UserBook
+		created  []books.ParsedEntry
+	)
+	for _, item := range items {
+		if item.Existing != nil {
+			if err := s.restoreHistory(
+				ctx, userID, *item.Existing, item.Entry,
+			); err != nil {
+				return err
+			}
+		}
+		switch item.Action {
+		case ImportActionCreate:
+			ub := item.Entry.UserBook
+			ub.UserID = userID
+			book := item.Entry.Book
+			if book.Series == nil {
+				if series, pos := seriesFromTitle(book.Title); series != "" {
+					book.Series, book.SeriesPosition = &series, pos
+				}
+			}
+			bookList = append(bookList, book)
+			ubList = append(ubList, ub)
+			created = append(created, item.Entry)
+		case ImportActionMerge:
+			if err := s.books.UpsertUserBook(ctx, item.Result); err != nil {
+				return err
+			}
+		}
+	}
+	if err := s.books.BatchUpsert(ctx, userID, bookList, ubList); err != nil {
+		return err
+	}
+	// BatchUpsert filled in the new entries' book IDs.
+	for i, entry := range created {
+		if err := s.restoreHistory(ctx, userID, ubList[i], entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreHistory writes what a backup entry carries beyond the library
+// entry onto the user's copy of the book: its reading progress, when the
+// library has none yet, and its reading positions, sessions and
+// annotations. Each of those is keyed so restoring a backup twice adds
+// nothing.
+func (s *BookService) restoreHistory(
+	ctx context.Context,
+	userID string,
+	ub models.UserBook,
+	entry books.ParsedEntry,
+) error {
+	h := entry.History
+	if h == nil {
+		return nil
+	}
+
+	imported := entry.UserBook
+	if ub.CurrentPage == 0 && ub.ProgressPercent == 0 &&
+		(imported.CurrentPage > 0 || imported.ProgressPercent > 0) &&
+		(imported.ProgressMode == models.ProgressModePages ||
+			imported.ProgressMode == models.ProgressModePercent) {
+		if err := s.books.UpdateProgress(
+			ctx, userID, ub.BookID, imported.ProgressMode,
+			imported.CurrentPage, imported.ProgressPercent,
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, st := range h.ReadingStates {
+		st.UserID, st.BookID = userID, ub.BookID
+		if err := s.readingState.Restore(ctx, st); err != nil {
+			return err
+		}
+	}
+	for _, rs := range h.Sessions {
+		rs.UserID, rs.BookID = userID, ub.BookID
+		if err := s.sessions.Restore(ctx, rs); err != nil {
+			return err
+		}
+	}
+	for _, a := range h.Annotations {
+		a.UserID, a.BookID = userID, ub.BookID
+		if err := s.annotations.Restore(ctx, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// planImport decides, for every parsed entry, whether it creates a library
+// book, merges into an existing one or is skipped. It only reads lib.
+//
+//nolint:funlen // three passes over the same items read best together
+func planImport(
+	lib []models.UserBook,
+	entries []books.ParsedEntry,
+) []ImportPlanItem {
+	items := make([]ImportPlanItem, len(entries))
+	for i, e := range entries {
+		items[i] = ImportPlanItem{
+			Entry:    e,
+			Action:   ImportActionCreate,
+			Reason:   "",
+			Existing: nil,
+			Result:   models.UserBook{}, //nolint:exhaustruct // set for merges
+		}
+		if strings.TrimSpace(e.Book.Title) == "" {
+			items[i].Action = ImportActionSkip
+			items[i].Reason = importSkipNoTitle
+		}
+	}
+
+	// Fold duplicates within the file into the entry with the richest
+	// metadata, exactly as FindDuplicates would pick a winner in the
+	// library.
+	candidates := make([]models.UserBook, 0, len(items))
+	byKey := make(map[uuid.UUID]int, len(items))
+	for i := range items {
+		if items[i].Action == ImportActionSkip {
+			continue
+		}
+		ub := items[i].Entry.UserBook
+		ub.Book = &items[i].Entry.Book
+		ub.BookID = importEntryKey(i)
+		byKey[ub.BookID] = i
+		candidates = append(candidates, ub)
+	}
+	for _, group := range FindDuplicateGroups(candidates) {
+		winner := byKey[group.Entries[0].BookID]
+		for _, loser := range group.Entries[1:] {
+			i := byKey[loser.BookID]
+			w := &items[winner].Entry.UserBook
+			added := items[i].Entry.UserBook.AddedAt
+			*w, _ = mergeImportedUserBook(*w, items[i].Entry.UserBook)
+			items[winner].Entry.History = foldHistory(
+				items[winner].Entry.History, items[i].Entry.History,
+			)
+			if !added.IsZero() && (w.AddedAt.IsZero() || added.Before(w.AddedAt)) {
+				w.AddedAt = added
+			}
+			items[i].Action = ImportActionSkip
+			items[i].Reason = importSkipDuplicate
+		}
+	}
+
+	byISBN := make(map[string]int, len(lib))
+	for i, ub := range lib {
+		if ub.Book != nil && ub.Book.ISBN13 != nil {
+			byISBN[normalizeISBN(*ub.Book.ISBN13)] = i
+		}
+	}
+	// Merges build on each other when two entries land on the same book.
+	current := map[uuid.UUID]models.UserBook{}
+
+	for i := range items {
+		item := &items[i]
+		if item.Action == ImportActionSkip {
+			continue
+		}
+
+		var existing *models.UserBook
+		reason := "isbn13"
+		if item.Entry.Book.ISBN13 != nil {
+			if j, ok := byISBN[normalizeISBN(*item.Entry.Book.ISBN13)]; ok {
+				existing = &lib[j]
+			}
+		}
+		if existing == nil {
+			reason = "title+author"
+			existing = matchLibraryByMetadata(lib, ebookmeta.Metadata{
+				Title:    item.Entry.Book.Title,
+				Authors:  item.Entry.Book.Authors,
+				ISBN13:   nil,
+				Language: nil,
+			})
+		}
+		if existing == nil {
+			continue
+		}
+
+		base, ok := current[existing.BookID]
+		if !ok {
+			base = *existing
+		}
+		merged, changed := mergeImportedUserBook(base, item.Entry.UserBook)
+		item.Existing = existing
+		if !changed {
+			item.Action = ImportActionSkip
+			item.Reason = importSkipUnchanged
+			continue
+		}
+		current[existing.BookID] = merged
+		item.Action = ImportActionMerge
+		item.Reason = reason
+		item.Result = merged
+	}
+	return items
+}
+
+// foldHistory adds a duplicate entry's reading history to the one it is
+// folded into.
+func foldHistory(into, from *books.ReadingHistory) *books.ReadingHistory {
+	if from == nil {
+		return into
+	}
+	if into == nil {
+		return from
+	}
+	return &books.ReadingHistory{
+		ReadingStates: slices.Concat(into.ReadingStates, from.ReadingStates),
+		Sessions:      slices.Concat(into.Sessions, from.Sessions),
+		Annotations:   slices.Concat(into.Annotations, from.Annotations),
+	}
+}
+
+// importEntryKey gives each import entry a stable stand-in book ID so
+// FindDuplicateGroups can group entries that aren't in the database yet.
+func importEntryKey(i int) uuid.UUID {
+	var key uuid.UUID
+	binary.BigEndian.PutUint64(key[8:], uint64(i)) //nolint:gosec // i ≥ 0
+	return key
+}
+
+// mergeImportedUserBook folds an imported entry into an existing one without
+// losing anything the library already knows: the status keeps whichever
+// ranks higher (as MergeBooks does), tags, shelf positions and read dates are
+// unioned and an existing rating is kept. Reports whether anything changed.
+func mergeImportedUserBook(
+	existing, imported models.UserBook,
+) (models.UserBook, bool) {
+	merged := existing
+	changed := false
+
+	if statusRank(imported.Status) > statusRank(existing.Status) {
+		merged.Status = imported.Status
+		changed = true
+	}
+
+	merged.Tags = slices.Clone(existing.Tags)
+	for _, tag := range imported.Tags {
+		if tag != "" && !slices.Contains(merged.Tags, tag) {
+			merged.Tags = append(merged.Tags, tag)
+			changed = true
+		}
+	}
+
+	merged.ShelfPositions = make(map[string]int, len(existing.ShelfPositions))
+	for name, pos := range existing.ShelfPositions {
+		merged.ShelfPositions[name] = pos
+	}
+	for name, pos := range imported.ShelfPositions {
+		if _, ok := merged.ShelfPositions[name]; !ok {
+			merged.ShelfPositions[name] = pos
+			changed = true
+		}
+	}
+
+	if merged.Rating == nil && imported.Rating != nil {
+		merged.Rating = imported.Rating
+		changed = true
+	}
+
+	merged.FinishedAt = slices.Clone(existing.FinishedAt)
+	for _, t := range imported.FinishedAt {
+		if !slices.ContainsFunc(merged.FinishedAt, func(f time.Time) bool {
+			return sameDay(f, t)
+		}) {
+			merged.FinishedAt = append(merged.FinishedAt, t)
+			changed = true
+		}
+	}
+	slices.SortFunc(merged.FinishedAt, time.Time.Compare)
+
+	return merged, changed
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.UTC().Date()
+	by, bm, bd := b.UTC().Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,171 @@
+//nolint:testpackage // testing unexported import planning
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/pkg/books"
+)
+
+func importEntry(title string, authors []string, isbn *string) books.ParsedEntry {
+	return books.ParsedEntry{
+		Book: models.Book{ //nolint:exhaustruct // partial
+			Title:   title,
+			Authors: authors,
+			ISBN13:  isbn,
+		},
+		UserBook: models.UserBook{ //nolint:exhaustruct // partial
+			Status:         models.StatusToRead,
+			Tags:           []string{},
+			ShelfPositions: map[string]int{},
+		},
+		History: nil,
+	}
+}
+
+func libraryEntry(title string, authors []string, isbn *string) models.UserBook {
+	return models.UserBook{ //nolint:exhaustruct // partial
+		UserID:         "reader",
+		BookID:         uuid.New(),
+		Status:         models.StatusToRead,
+		Tags:           []string{},
+		ShelfPositions: map[string]int{},
+		Book: &models.Book{ //nolint:exhaustruct // partial
+			Title:   title,
+			Authors: authors,
+			ISBN13:  isbn,
+		},
+	}
+}
+
+func TestPlanImport(t *testing.T) {
+	dune := libraryEntry("Dune", []string{"Frank Herbert"}, strPtr("9780441013593"))
+	kindred := libraryEntry("Kindred", []string{"Octavia E. Butler"}, nil)
+	kindred.Status = models.StatusRead
+	kindred.Tags = []string{models.TagOwnPhysical}
+	lib := []models.UserBook{dune, kindred}
+
+	finished := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+	byISBN := importEntry("Dune (Dune Chronicles, #1)", []string{"Frank Herbert"},
+		strPtr("978-0-441-01359-3"))
+	byISBN.UserBook.Status = models.StatusRead
+	byISBN.UserBook.FinishedAt = []time.Time{finished}
+
+	unchanged := importEntry("Kindred", []string{"Octavia Butler"}, nil)
+	unchanged.UserBook.Tags = []string{models.TagOwnPhysical}
+
+	fresh := importEntry("Piranesi", []string{"Susanna Clarke"}, nil)
+	fresh.UserBook.Tags = []string{"fantasy"}
+	freshAgain := importEntry("Piranesi", []string{"Susanna Clarke"}, nil)
+	freshAgain.UserBook.Tags = []string{models.TagOwnDigital}
+
+	untitled := importEntry("  ", []string{"Nobody"}, nil)
+
+	items := planImport(lib, []books.ParsedEntry{
+		byISBN, unchanged, fresh, freshAgain, untitled,
+	})
+	require.Len(t, items, 5)
+
+	assert.Equal(t, ImportActionMerge, items[0].Action)
+	assert.Equal(t, "isbn13", items[0].Reason)
+	assert.Equal(t, dune.BookID, items[0].Existing.BookID)
+	assert.Equal(t, dune.BookID, items[0].Result.BookID)
+	assert.Equal(t, models.StatusRead, items[0].Result.Status)
+	assert.Equal(t, []time.Time{finished}, items[0].Result.FinishedAt)
+
+	assert.Equal(t, ImportActionSkip, items[1].Action)
+	assert.Equal(t, importSkipUnchanged, items[1].Reason)
+	assert.Equal(t, kindred.BookID, items[1].Existing.BookID)
+
+	assert.Equal(t, ImportActionCreate, items[2].Action)
+	assert.ElementsMatch(t,
+		[]string{"fantasy", models.TagOwnDigital}, items[2].Entry.UserBook.Tags)
+	assert.Equal(t, ImportActionSkip, items[3].Action)
+	assert.Equal(t, importSkipDuplicate, items[3].Reason)
+
+	assert.Equal(t, ImportActionSkip, items[4].Action)
+	assert.Equal(t, importSkipNoTitle, items[4].Reason)
+}
+
+func TestPlanImport_MatchesByTitleAndAuthor(t *testing.T) {
+	kindred := libraryEntry("Kindred", []string{"Octavia E. Butler"}, nil)
+
+	entry := importEntry("Kindred: A Novel", []string{"Butler, Octavia"}, nil)
+	entry.UserBook.Status = models.StatusReading
+
+	items := planImport([]models.UserBook{kindred}, []books.ParsedEntry{entry})
+	require.Len(t, items, 1)
+	assert.Equal(t, ImportActionMerge, items[0].Action)
+	assert.Equal(t, "title+author", items[0].Reason)
+	assert.Equal(t, models.StatusReading, items[0].Result.Status)
+}
+
+func TestPlanImport_FoldsDuplicateHistory(t *testing.T) {
+	session := func(source string) models.ReadingSession {
+		return models.ReadingSession{Source: source} //nolint:exhaustruct // partial
+	}
+	first := importEntry("Piranesi", []string{"Susanna Clarke"}, nil)
+	first.History = &books.ReadingHistory{
+		ReadingStates: nil,
+		Sessions:      []models.ReadingSession{session(models.ReadingSourceWeb)},
+		Annotations:   nil,
+	}
+	second := importEntry("Piranesi", []string{"Susanna Clarke"}, nil)
+	second.History = &books.ReadingHistory{
+		ReadingStates: nil,
+		Sessions:      []models.ReadingSession{session(models.ReadingSourceKobo)},
+		Annotations:   nil,
+	}
+
+	items := planImport(nil, []books.ParsedEntry{first, second})
+	require.Len(t, items, 2)
+	assert.Equal(t, ImportActionCreate, items[0].Action)
+	require.NotNil(t, items[0].Entry.History)
+	assert.Equal(t, []models.ReadingSession{
+		session(models.ReadingSourceWeb), session(models.ReadingSourceKobo),
+	}, items[0].Entry.History.Sessions)
+	assert.Equal(t, importSkipDuplicate, items[1].Reason)
+}
+
+func TestMergeImportedUserBook_KeepsLibraryState(t *testing.T) {
+	rating := int16(5)
+	other := int16(2)
+	read := time.Date(2023, 6, 1, 8, 0, 0, 0, time.UTC)
+	reread := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	existing := models.UserBook{ //nolint:exhaustruct // partial
+		Status:         models.StatusRead,
+		Tags:           []string{models.TagFavourite},
+		ShelfPositions: map[string]int{models.StatusRead: 3},
+		Rating:         &rating,
+		FinishedAt:     []time.Time{read},
+	}
+	imported := models.UserBook{ //nolint:exhaustruct // partial
+		Status:         models.StatusToRead,
+		Tags:           []string{models.TagFavourite, "classics"},
+		ShelfPositions: map[string]int{models.StatusRead: 9, "classics": 1},
+		Rating:         &other,
+		FinishedAt: []time.Time{
+			reread, time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	merged, changed := mergeImportedUserBook(existing, imported)
+	assert.True(t, changed)
+	assert.Equal(t, models.StatusRead, merged.Status)
+	assert.Equal(t, []string{models.TagFavourite, "classics"}, merged.Tags)
+	assert.Equal(t,
+		map[string]int{models.StatusRead: 3, "classics": 1}, merged.ShelfPositions)
+	assert.Equal(t, rating, *merged.Rating)
+	assert.Equal(t, []time.Time{read, reread}, merged.FinishedAt)
+	assert.Equal(t, []string{models.TagFavourite}, existing.Tags, "input untouched")
+
+	_, changed = mergeImportedUserBook(merged, imported)
+	assert.False(t, changed)
+}
@@ -16,14 +16,24 @@ import (
 )
 
 type Services struct {
-	Auth       auth.Service
-	Books      *BookService
-	Conversion *ConversionService
-	Progress   *ProgressService
-	Kobo       *KoboService
-	KoboLog    *KoboLogStore
-	Ingest     *IngestService
-	WebSocket  *progressws.Service
+	Auth        auth.Service
+	Books       *BookService
+	Conversion  *ConversionService
+	Progress    *ProgressService
+	Kobo        *KoboService
+	OPDS        *OPDSService
+	KOReader    *KOReaderService
+	Sessions    *SessionService
+	Goals       *GoalService
+	Export      *ExportService
+	Annotation  *AnnotationService
+	Import      *DeviceImportService
+	KoboLog     *KoboLogStore
+	Ingest      *IngestService
+	Text        *TextIndexService
+	Digest      *DigestService
+	FeedArticle *FeedArticleService
+	WebSocket   *progressws.Service
 }
 
 func New(
@@ -44,24 +54,61 @@ func New(
 
 	koboLog := NewKoboLogStore()
 
+	annotationSvc := &AnnotationService{
+		annotations: repositories.Annotations,
+		books:       repositories.Books,
+	}
+
+	sessionSvc := &SessionService{
+		repo:  repositories.Sessions,
+		books: repositories.Books,
+	}
+
+	conversionSvc := NewConversionService(
+		logger,
+		repositories.BookFiles,
+		objectStore,
+		nil, // converter: defaults to kepubify
+		nil, // convertPDF: defaults to goPDFConverter (pure-Go, go-pdfium)
+		NewComicConverter(ComicScreen{
+			Width:  config.KoboScreenWidth,
+			Height: config.KoboScreenHeight,
+		}),
+	)
+
+	digestSvc := NewDigestService(logger, repositories, objectStore, conversionSvc)
+	feedArticleSvc := NewFeedArticleService(logger, repositories, objectStore)
+
 	booksSvc := &BookService{
 		logger:       logger,
 		books:        repositories.Books,
 		bookFiles:    repositories.BookFiles,
 		objectStore:  objectStore,
 		readingState: repositories.ReadingState,
+		sessions:     sessionSvc,
+		annotations:  repositories.Annotations,
+		digests:      digestSvc,
+		feedArticles: feedArticleSvc,
+		queue:        repositories.Queue,
 		uniCat:       uniCat,
 		hardcover:    hardcoverClient,
 		booksResync:  nil, // nil → resyncRepo() falls back to books
 	}
 
-	conversionSvc := NewConversionService(
-		logger,
-		repositories.BookFiles,
-		objectStore,
-		nil, // converter: defaults to kepubify
-		nil, // convertPDF: defaults to goPDFConverter (pure-Go, go-pdfium)
-	)
+	importSvc := &DeviceImportService{
+		logger:      logger,
+		books:       booksSvc,
+		annotations: repositories.Annotations,
+		sessions:    repositories.Sessions,
+	}
+
+	exportSvc := &ExportService{
+		books:        repositories.Books,
+		readingState: repositories.ReadingState,
+		sessions:     repositories.Sessions,
+		annotations:  repositories.Annotations,
+		progress:     repositories.Progress,
+	}
 
 	ingestSvc := NewIngestService(
 		logger,
@@ -75,8 +122,24 @@ func New(
 		Conversion: conversionSvc,
 		Progress:   NewProgressService(repositories.Progress),
 		Kobo:       kobo,
-		KoboLog:    koboLog,
-		Ingest:     ingestSvc,
+		OPDS:       &OPDSService{repo: repositories.OPDSTokens},
+		KOReader: &KOReaderService{
+			logger:      logger,
+			repo:        repositories.KOReader,
+			bookFiles:   repositories.BookFiles,
+			objectStore: objectStore,
+			books:       booksSvc,
+		},
+		Sessions:    sessionSvc,
+		Goals:       &GoalService{repo: repositories.Goals},
+		Export:      exportSvc,
+		Annotation:  annotationSvc,
+		Import:      importSvc,
+		KoboLog:     koboLog,
+		Ingest:      ingestSvc,
+		Text:        NewTextIndexService(repositories.Text, conversionSvc),
+		Digest:      digestSvc,
+		FeedArticle: feedArticleSvc,
 		WebSocket: progressws.NewService(
 			ctx,
 			logger,
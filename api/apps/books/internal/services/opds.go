@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/repositories"
+)
+
+// ErrOPDSTokenName is returned when an OPDS token is created without a name.
+var ErrOPDSTokenName = errors.New("token name cannot be empty")
+
+// OPDSService manages the tokens that give reader apps (KOReader, Thorium,
+// Moon+ Reader, …) access to the user's OPDS catalog. Tokens work like Kobo
+// device tokens: the raw token is embedded in the catalog URL and only its
+// SHA-256 hash is stored.
+type OPDSService struct {
+	repo *repositories.OPDSTokensRepository
+}
+
+// CreateOPDSToken generates a new token and returns the persisted record
+// together with the raw token for one-time display.
+func (s *OPDSService) CreateOPDSToken(
+	ctx context.Context,
+	userID, name string,
+) (models.OPDSToken, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return models.OPDSToken{}, "", ErrOPDSTokenName
+	}
+	rawToken, hash, err := newAccessToken()
+	if err != nil {
+		return models.OPDSToken{}, "", err
+	}
+	token, err := s.repo.CreateOPDSToken(ctx, userID, name, hash)
+	if err != nil {
+		return models.OPDSToken{}, "", err
+	}
+	return token, rawToken, nil
+}
+
+// ListOPDSTokens returns all tokens of a user.
+func (s *OPDSService) ListOPDSTokens(
+	ctx context.Context,
+	userID string,
+) ([]models.OPDSToken, error) {
+	return s.repo.ListOPDSTokens(ctx, userID)
+}
+
+// RevokeOPDSToken deletes a token, cutting off the reader app using it.
+func (s *OPDSService) RevokeOPDSToken(
+	ctx context.Context,
+	userID string,
+	tokenID uuid.UUID,
+) error {
+	return s.repo.DeleteOPDSToken(ctx, userID, tokenID)
+}
+
+// GetOPDSUserByTokenHash returns the user owning a pre-hashed token, for
+// authenticating catalog requests.
+func (s *OPDSService) GetOPDSUserByTokenHash(
+	ctx context.Context,
+	hash string,
+) (string, error) {
+	return s.repo.GetOPDSUserByTokenHash(ctx, hash)
+}
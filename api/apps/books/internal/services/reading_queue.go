@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/database"
+)
+
+// ErrInvalidQueueSettings is returned by UpdateQueueSettings for a Kobo sync
+// count outside 0..models.MaxQueueKoboSyncCount.
+var ErrInvalidQueueSettings = errors.New("invalid reading queue settings")
+
+// QueueBook appends a to-read book to the end of the user's "up next"
+// queue; a queued book keeps its place. Returns
+// database.ErrResourceNotFound when the book isn't on the to-read shelf.
+func (s *BookService) QueueBook(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+) error {
+	before, err := s.koboQueued(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err = s.queue.Enqueue(ctx, userID, bookID); err != nil {
+		return err
+	}
+	return s.settleKoboQueue(ctx, userID, before)
+}
+
+// DequeueBook takes a book out of the user's queue, leaving it on to-read.
+func (s *BookService) DequeueBook(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+) error {
+	before, err := s.koboQueued(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err = s.queue.Dequeue(ctx, userID, bookID); err != nil {
+		return err
+	}
+	return s.settleKoboQueue(ctx, userID, before)
+}
+
+// ReorderQueue puts the given books at the front of the user's queue in
+// that order, queueing those that weren't; see
+// ReadingQueueRepository.Reorder.
+func (s *BookService) ReorderQueue(
+	ctx context.Context,
+	userID string,
+	bookIDs []uuid.UUID,
+) error {
+	before, err := s.koboQueued(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err = s.queue.Reorder(ctx, userID, bookIDs); err != nil {
+		return err
+	}
+	return s.settleKoboQueue(ctx, userID, before)
+}
+
+// GetQueueSettings returns the user's queue rules.
+func (s *BookService) GetQueueSettings(
+	ctx context.Context,
+	userID string,
+) (models.QueueSettings, error) {
+	return s.queue.GetSettings(ctx, userID)
+}
+
+// UpdateQueueSettings stores the user's queue rules.
+func (s *BookService) UpdateQueueSettings(
+	ctx context.Context,
+	userID string,
+	settings models.QueueSettings,
+) error {
+	if settings.KoboSyncCount < 0 ||
+		settings.KoboSyncCount > models.MaxQueueKoboSyncCount {
+		return fmt.Errorf(
+			"%w: Kobo sync count must be between 0 and %d",
+			ErrInvalidQueueSettings, models.MaxQueueKoboSyncCount,
+		)
+	}
+	before, err := s.koboQueued(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err = s.queue.UpsertSettings(ctx, userID, settings); err != nil {
+		return err
+	}
+	return s.settleKoboQueue(ctx, userID, before)
+}
+
+// QueuedForKobo returns the queued books that sync to Kobo on the queue's
+// account, first to last: the top QueueSettings.KoboSyncCount of them.
+func (s *BookService) QueuedForKobo(
+	ctx context.Context,
+	userID string,
+) ([]uuid.UUID, error) {
+	settings, err := s.queue.GetSettings(ctx, userID)
+	if err != nil || settings.KoboSyncCount == 0 {
+		return nil, err
+	}
+	queued, err := s.queue.ListQueued(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return queued[:min(len(queued), settings.KoboSyncCount)], nil
+}
+
+// koboQueued is QueuedForKobo for a service that may have no queue wired.
+func (s *BookService) koboQueued(
+	ctx context.Context,
+	userID string,
+) ([]uuid.UUID, error) {
+	if s.queue == nil {
+		return nil, nil
+	}
+	return s.QueuedForKobo(ctx, userID)
+}
+
+// settleKoboQueue keeps the Kobo in step after a change to the user's
+// library moved books in or out of QueuedForKobo, which was before when the
+// change began. A book that left is tombstoned so the next sync takes it
+// off the device, unless the kobo-sync tag still syncs it; one that left
+// because the user started reading it gets that tag instead, as
+// advanceQueue's pick does. A book that joined loses any stale tombstone.
+func (s *BookService) settleKoboQueue(
+	ctx context.Context,
+	userID string,
+	before []uuid.UUID,
+) error {
+	after, err := s.koboQueued(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, bookID := range before {
+		if slices.Contains(after, bookID) {
+			continue
+		}
+		if err = s.leaveKoboQueue(ctx, userID, bookID); err != nil {
+			return err
+		}
+	}
+	for _, bookID := range after {
+		if slices.Contains(before, bookID) {
+			continue
+		}
+		if err = s.books.DeleteKoboRemoval(ctx, userID, bookID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// leaveKoboQueue handles bookID no longer syncing to Kobo through the
+// queue; see settleKoboQueue.
+func (s *BookService) leaveKoboQueue(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+) error {
+	ub, err := s.books.GetUserBook(ctx, userID, bookID)
+	if errors.Is(err, database.ErrResourceNotFound) {
+		// Removed from the library: nothing server-side syncs it any more.
+		return s.books.UpsertKoboRemoval(ctx, userID, bookID)
+	}
+	if err != nil {
+		return err
+	}
+	switch {
+	case ub.HasTag(models.TagKoboSync):
+		return nil
+	case ub.Status == models.StatusReading:
+		return s.EnableKoboSync(ctx, userID, bookID)
+	default:
+		return s.books.UpsertKoboRemoval(ctx, userID, bookID)
+	}
+}
+
+// advanceQueue starts the first queued book when the user has auto-advance
+// on. When the queue syncs to Kobo the started book gets the kobo-sync tag,
+// so leaving the queue doesn't take it off the device just as it becomes
+// the one being read.
+func (s *BookService) advanceQueue(ctx context.Context, userID string) error {
+	settings, err := s.queue.GetSettings(ctx, userID)
+	if err != nil || !settings.AutoAdvance {
+		return err
+	}
+
+	bookID, err := s.queue.StartNext(ctx, userID)
+	if errors.Is(err, database.ErrResourceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "started next queued book",
+		slog.String("user_id", userID),
+		slog.String("book_id", bookID.String()),
+	)
+
+	if settings.KoboSyncCount > 0 {
+		return s.EnableKoboSync(ctx, userID, bookID)
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/internal/repositories"
+	"tools.xdoubleu.com/internal/database"
+)
+
+// readingSessionGap is how long a reader can go without reporting progress
+// before the next update starts a new session rather than extending the
+// current one.
+const readingSessionGap = 15 * time.Minute
+
+// readingPaceDays is the window the daily reading pace behind finish-date
+// estimates is measured over.
+const readingPaceDays = 14
+
+const secondsPerHour = 3600
+
+// SessionService turns progress updates into reading sessions and reports
+// reading time and speed over them.
+type SessionService struct {
+	repo  *repositories.ReadingSessionsRepository
+	books *repositories.BooksRepository
+}
+
+// TrackProgress folds a progress update into the book's reading sessions
+// for source: it extends the latest session when that ended less than
+// readingSessionGap ago and starts a new one otherwise. prevPercent is the
+// book's position before the update, nil when there was none.
+//
+// Reading time only accrues between updates, so the stretch before a
+// session's first update isn't counted.
+func (s *SessionService) TrackProgress(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	source string,
+	prevPercent *int,
+	percent int,
+	at time.Time,
+) error {
+	start := percent
+	if prevPercent != nil {
+		start = *prevPercent
+	}
+
+	latest, err := s.repo.GetLatest(
+		ctx, userID, bookID, source, at.Add(-readingSessionGap),
+	)
+	if errors.Is(err, database.ErrResourceNotFound) {
+		_, err = s.repo.Insert(
+			ctx,
+			models.ReadingSession{ //nolint:exhaustruct //ID/CreatedAt set by DB
+				UserID:       userID,
+				BookID:       bookID,
+				Source:       source,
+				StartedAt:    at,
+				EndedAt:      at,
+				StartPercent: &start,
+				EndPercent:   &percent,
+			},
+		)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if at.After(latest.EndedAt) {
+		latest.Seconds += int(at.Sub(latest.EndedAt).Seconds())
+		latest.EndedAt = at
+	}
+	if latest.StartPercent == nil {
+		latest.StartPercent = &start
+	}
+	latest.EndPercent = &percent
+	return s.repo.Update(ctx, latest)
+}
+
+// RecordDeviceSession stores a session a device timed itself (Kobo's
+// LeaveContent analytics). When progress updates already opened an untimed
+// session around the same time, the device's timing is merged into it so
+// the session keeps its percentages; otherwise a new session is inserted.
+// Re-sending the same session is a no-op, and sessions for books outside
+// the user's library (store purchases) are ignored.
+func (s *SessionService) RecordDeviceSession(
+	ctx context.Context,
+	rs models.ReadingSession,
+) error {
+	_, err := s.books.GetUserBook(ctx, rs.UserID, rs.BookID)
+	if errors.Is(err, database.ErrResourceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	latest, err := s.repo.GetLatest(
+		ctx, rs.UserID, rs.BookID, rs.Source, rs.StartedAt.Add(-readingSessionGap),
+	)
+	if err != nil && !errors.Is(err, database.ErrResourceNotFound) {
+		return err
+	}
+
+	if err == nil && latest.Seconds == 0 &&
+		!latest.StartedAt.After(rs.EndedAt.Add(readingSessionGap)) {
+		if rs.StartedAt.Before(latest.StartedAt) {
+			latest.StartedAt = rs.StartedAt
+		}
+		if rs.EndedAt.After(latest.EndedAt) {
+			latest.EndedAt = rs.EndedAt
+		}
+		latest.Seconds = rs.Seconds
+		latest.PagesTurned = max(latest.PagesTurned, rs.PagesTurned)
+		return s.repo.Update(ctx, latest)
+	}
+
+	_, err = s.repo.Insert(ctx, rs)
+	return err
+}
+
+// Restore stores a session read back from a backup. Like Insert it is a
+// no-op when the book already has a session from the same source starting
+// at the same instant, so restoring a backup twice adds nothing.
+func (s *SessionService) Restore(
+	ctx context.Context,
+	rs models.ReadingSession,
+) error {
+	_, err := s.repo.Insert(ctx, rs)
+	return err
+}
+
+// GetStats reports reading time per day and book for sessions that started
+// in [start, end), and reading speed and finish estimates for the books
+// read in that range.
+func (s *SessionService) GetStats(
+	ctx context.Context,
+	userID string,
+	start, end, now time.Time,
+) (models.ReadingStats, error) {
+	days, err := s.repo.ListDays(ctx, userID, start, end)
+	if err != nil {
+		return models.ReadingStats{}, err
+	}
+
+	totals, err := s.repo.ListBookTotals(
+		ctx, userID, start, end, now.AddDate(0, 0, -readingPaceDays),
+	)
+	if err != nil {
+		return models.ReadingStats{}, err
+	}
+
+	stats := models.ReadingStats{
+		Days:  days,
+		Books: make([]models.BookReadingStats, 0, len(totals)),
+	}
+	for _, t := range totals {
+		stats.Books = append(stats.Books, bookReadingStats(t, now))
+	}
+	return stats, nil
+}
+
+// bookReadingStats derives a book's reading speed from its session totals
+// and, for books still being read, how much reading is left and the day it
+// should be finished at the recent daily pace.
+func bookReadingStats(
+	t models.BookSessionTotals,
+	now time.Time,
+) models.BookReadingStats {
+	stats := models.BookReadingStats{ //nolint:exhaustruct //filled in below
+		BookSessionTotals: t,
+	}
+
+	if t.PercentSeconds > 0 && t.PercentRead > 0 {
+		stats.PercentPerHour = float64(t.PercentRead) * secondsPerHour /
+			float64(t.PercentSeconds)
+	}
+	if t.Seconds > 0 && t.PagesTurned > 0 {
+		stats.PagesPerHour = float64(t.PagesTurned) * secondsPerHour /
+			float64(t.Seconds)
+	}
+
+	if t.Status != models.StatusReading || t.Percent >= models.MaxProgressPercent ||
+		stats.PercentPerHour == 0 {
+		return stats
+	}
+
+	left := float64(models.MaxProgressPercent - t.Percent)
+	stats.RemainingSeconds = int(
+		math.Round(left / stats.PercentPerHour * secondsPerHour),
+	)
+
+	if t.RecentSeconds > 0 {
+		// remaining / (recent / paceDays), rounded up to whole days.
+		days := (stats.RemainingSeconds*readingPaceDays + t.RecentSeconds - 1) /
+			t.RecentSeconds
+		finish := now.UTC().Truncate(24*time.Hour).AddDate(0, 0, days)
+		stats.EstimatedFinish = &finish
+	}
+	return stats
+}
@@ -0,0 +1,71 @@
+//nolint:testpackage // testing unexported stats helpers
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+func TestBookReadingStats_EstimatesFinishForBooksBeingRead(t *testing.T) {
+	now := time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)
+	stats := bookReadingStats(models.BookSessionTotals{ //nolint:exhaustruct // partial
+		Status:         models.StatusReading,
+		Percent:        60,
+		Seconds:        7200,
+		PagesTurned:    90,
+		PercentRead:    40,
+		PercentSeconds: 7200,
+		RecentSeconds:  7 * 3600,
+	}, now)
+
+	assert.InDelta(t, 20, stats.PercentPerHour, 1e-9)
+	assert.InDelta(t, 45, stats.PagesPerHour, 1e-9)
+	// 40% left at 20%/h is two hours; half an hour a day makes that 4 days.
+	assert.Equal(t, 7200, stats.RemainingSeconds)
+	require.NotNil(t, stats.EstimatedFinish)
+	assert.Equal(t,
+		time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC), *stats.EstimatedFinish,
+	)
+}
+
+func TestBookReadingStats_NoEstimateWithoutRecentReading(t *testing.T) {
+	stats := bookReadingStats(models.BookSessionTotals{ //nolint:exhaustruct // partial
+		Status:         models.StatusReading,
+		Percent:        50,
+		Seconds:        3600,
+		PercentRead:    10,
+		PercentSeconds: 3600,
+	}, time.Now())
+
+	assert.Equal(t, 18000, stats.RemainingSeconds)
+	assert.Nil(t, stats.EstimatedFinish)
+}
+
+func TestBookReadingStats_SkipsEstimates(t *testing.T) {
+	tests := map[string]models.BookSessionTotals{
+		"finished book": { //nolint:exhaustruct // partial
+			Status: models.StatusRead, Percent: 100,
+			PercentRead: 50, PercentSeconds: 3600, RecentSeconds: 3600,
+		},
+		"no percentages": { //nolint:exhaustruct // partial
+			Status: models.StatusReading, Percent: 30,
+			Seconds: 3600, RecentSeconds: 3600,
+		},
+		"untimed sessions": { //nolint:exhaustruct // partial
+			Status: models.StatusReading, Percent: 30, PercentRead: 30,
+		},
+	}
+
+	for name, totals := range tests {
+		t.Run(name, func(t *testing.T) {
+			stats := bookReadingStats(totals, time.Now())
+			assert.Zero(t, stats.RemainingSeconds)
+			assert.Nil(t, stats.EstimatedFinish)
+		})
+	}
+}
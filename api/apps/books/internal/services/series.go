@@ -0,0 +1,108 @@
+package services
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// seriesTitleRe matches a trailing Goodreads-style series annotation such as
+// "(The Expanse, #3)" or "(Discworld #2.5)". Keep in sync with the backfill
+// in migration 00026_book_series.sql.
+var seriesTitleRe = regexp.MustCompile(`\(([^()#]+)#(\d+(?:\.\d+)?)\)\s*$`)
+
+// seriesFromTitle extracts the series name and position from a title's
+// trailing series annotation. Returns "", nil when the title has none.
+func seriesFromTitle(title string) (string, *float64) {
+	m := seriesTitleRe.FindStringSubmatch(title)
+	if m == nil {
+		return "", nil
+	}
+	name := strings.Trim(m[1], " ,")
+	if name == "" {
+		return "", nil
+	}
+	pos, err := strconv.ParseFloat(m[2], 64)
+	if err != nil {
+		return "", nil
+	}
+	return name, &pos
+}
+
+// parseSeriesIndex parses a series position as files write it ("2", "2.5",
+// "2,5"). Returns nil for an empty, non-numeric or negative index.
+func parseSeriesIndex(s string) *float64 {
+	s = strings.Replace(strings.TrimSpace(s), ",", ".", 1)
+	pos, err := strconv.ParseFloat(s, 64)
+	if err != nil || pos < 0 {
+		return nil
+	}
+	return &pos
+}
+
+// NextInSeries picks, for every series in lib the user has started, the
+// unread book that comes next: the lowest-positioned book past the furthest
+// one they have read or are reading. Dropped books are neither progress nor
+// a suggestion. Books without a series position are ignored, since their
+// place in the reading order is unknown. The result is ordered by series
+// name.
+func NextInSeries(lib []models.UserBook) []models.UserBook {
+	type seriesState struct {
+		reached float64
+		started bool
+		books   []models.UserBook
+	}
+	bySeries := map[string]*seriesState{}
+	for _, ub := range lib {
+		b := ub.Book
+		if b == nil || b.Series == nil || b.SeriesPosition == nil {
+			continue
+		}
+		key := normalizeString(*b.Series)
+		if key == "" {
+			continue
+		}
+		st, ok := bySeries[key]
+		if !ok {
+			st = &seriesState{} //nolint:exhaustruct // filled below
+			bySeries[key] = st
+		}
+		switch ub.Status {
+		case models.StatusRead, models.StatusReading:
+			if !st.started || *b.SeriesPosition > st.reached {
+				st.reached = *b.SeriesPosition
+			}
+			st.started = true
+		case models.StatusDropped:
+		default:
+			st.books = append(st.books, ub)
+		}
+	}
+
+	var out []models.UserBook
+	for _, st := range bySeries {
+		if !st.started {
+			continue
+		}
+		var next *models.UserBook
+		for i, ub := range st.books {
+			pos := *ub.Book.SeriesPosition
+			if pos > st.reached &&
+				(next == nil || pos < *next.Book.SeriesPosition) {
+				next = &st.books[i]
+			}
+		}
+		if next != nil {
+			out = append(out, *next)
+		}
+	}
+	slices.SortFunc(out, func(a, b models.UserBook) int {
+		return strings.Compare(
+			strings.ToLower(*a.Book.Series), strings.ToLower(*b.Book.Series),
+		)
+	})
+	return out
+}
@@ -0,0 +1,84 @@
+//nolint:testpackage // testing unexported series helpers
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+func TestSeriesFromTitle(t *testing.T) {
+	pos := func(f float64) *float64 { return &f }
+	tests := []struct {
+		title    string
+		series   string
+		position *float64
+	}{
+		{"Leviathan Wakes (The Expanse, #1)", "The Expanse", pos(1)},
+		{"Thief of Time (Discworld #26.5) ", "Discworld", pos(26.5)},
+		{"Dune", "", nil},
+		{"The Hobbit (Illustrated)", "", nil},
+		{"Title (#3)", "", nil},
+		{"Title (Series, #3) Extra", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			series, position := seriesFromTitle(tt.title)
+			assert.Equal(t, tt.series, series)
+			assert.Equal(t, tt.position, position)
+		})
+	}
+}
+
+func TestParseSeriesIndex(t *testing.T) {
+	require.NotNil(t, parseSeriesIndex(" 2,5 "))
+	assert.InDelta(t, 2.5, *parseSeriesIndex(" 2,5 "), 0)
+	assert.InDelta(t, 0.0, *parseSeriesIndex("0"), 0)
+	assert.Nil(t, parseSeriesIndex(""))
+	assert.Nil(t, parseSeriesIndex("II"))
+	assert.Nil(t, parseSeriesIndex("-1"))
+}
+
+func TestNextInSeries(t *testing.T) {
+	entry := func(title, series string, pos float64, status string) models.UserBook {
+		//nolint:exhaustruct // only series fields matter
+		return models.UserBook{
+			Status: status,
+			Book: &models.Book{
+				Title: title, Series: &series, SeriesPosition: &pos,
+			},
+		}
+	}
+	lib := []models.UserBook{
+		entry("Expanse 1", "The Expanse", 1, models.StatusRead),
+		entry("Expanse 2", "The Expanse", 2, models.StatusRead),
+		entry("Expanse 4", "The Expanse", 4, models.StatusToRead),
+		entry("Expanse 3", "the expanse", 3, models.StatusToRead),
+		entry("Expanse 2 again", "The Expanse", 2, models.StatusToRead),
+		// Reading book 1 makes book 2 next, even with book 3 dropped.
+		entry("Discworld 1", "Discworld", 1, models.StatusReading),
+		entry("Discworld 3", "Discworld", 3, models.StatusDropped),
+		entry("Discworld 2", "Discworld", 2, models.StatusToRead),
+		// Never started: no suggestion.
+		entry("Narnia 1", "Narnia", 1, models.StatusToRead),
+		// Finished: nothing left to suggest.
+		entry("Duology 2", "Duology", 2, models.StatusRead),
+		entry("Duology 1", "Duology", 1, models.StatusToRead),
+	}
+	//nolint:exhaustruct // a book without a series is skipped
+	lib = append(lib, models.UserBook{
+		Status: models.StatusRead,
+		Book:   &models.Book{Title: "Standalone"},
+	})
+
+	got := NextInSeries(lib)
+	titles := make([]string, len(got))
+	for i, ub := range got {
+		titles[i] = ub.Book.Title
+	}
+	assert.Equal(t, []string{"Discworld 2", "Expanse 3"}, titles)
+	assert.Empty(t, NextInSeries(nil))
+}
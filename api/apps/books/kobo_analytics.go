@@ -0,0 +1,112 @@
+package books
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// maxKoboAnalyticsBytes caps an analytics upload we read before proxying it.
+// The device batches events between syncs; a few hundred fit in well under
+// a megabyte.
+const maxKoboAnalyticsBytes = 4 << 20
+
+// koboLeaveContentEvent is logged by the device when the reader closes a
+// book; its metrics say how long the book was open and read.
+const koboLeaveContentEvent = "LeaveContent"
+
+type koboAnalyticsRequest struct {
+	Events []koboAnalyticsEvent `json:"Events"`
+}
+
+type koboAnalyticsEvent struct {
+	EventType  string `json:"EventType"`
+	Timestamp  string `json:"Timestamp"`
+	Attributes struct {
+		VolumeID string `json:"volumeid"`
+	} `json:"Attributes"`
+	Metrics struct {
+		SecondsRead json.Number `json:"SecondsRead"`
+		PagesTurned json.Number `json:"PagesTurned"`
+	} `json:"Metrics"`
+}
+
+// koboAnalyticsHandler handles POST /v1/analytics/event. LeaveContent events
+// for our books become reading sessions, the live counterpart of what
+// kobo-gateway imports from the device's database over USB. The upload is
+// then proxied to the Kobo store unchanged, which answers the device.
+func (app *Books) koboAnalyticsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := app.koboAuth(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxKoboAnalyticsBytes))
+	if err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	var req koboAnalyticsRequest
+	if json.Unmarshal(body, &req) == nil {
+		for _, ev := range req.Events {
+			s, isSession := parseKoboAnalyticsSession(ev)
+			if !isSession {
+				continue
+			}
+			s.UserID = userID
+			if err = app.Services.Sessions.RecordDeviceSession(
+				r.Context(), s,
+			); err != nil {
+				app.Logger.WarnContext(r.Context(), "failed to record kobo session",
+					slog.String("book_id", s.BookID.String()),
+					slog.Any("error", err),
+				)
+			}
+		}
+	}
+
+	app.koboProxy(w, r)
+}
+
+// parseKoboAnalyticsSession turns a LeaveContent event for a book we synced
+// (its volume ID is our book UUID) into a reading session ending at the
+// event's timestamp. Any other event reports false.
+func parseKoboAnalyticsSession(
+	ev koboAnalyticsEvent,
+) (models.ReadingSession, bool) {
+	if ev.EventType != koboLeaveContentEvent {
+		return models.ReadingSession{}, false
+	}
+	bookID, err := uuid.Parse(ev.Attributes.VolumeID)
+	if err != nil {
+		return models.ReadingSession{}, false
+	}
+	ended, err := time.Parse(time.RFC3339Nano, ev.Timestamp)
+	if err != nil {
+		return models.ReadingSession{}, false
+	}
+	seconds, err := ev.Metrics.SecondsRead.Int64()
+	if err != nil || seconds <= 0 {
+		return models.ReadingSession{}, false
+	}
+	pages, _ := ev.Metrics.PagesTurned.Int64()
+
+	//nolint:exhaustruct // user filled in by the caller, ID/CreatedAt by DB
+	return models.ReadingSession{
+		BookID:      bookID,
+		Source:      models.ReadingSourceKobo,
+		StartedAt:   ended.Add(-time.Duration(seconds) * time.Second),
+		EndedAt:     ended,
+		Seconds:     int(seconds),
+		PagesTurned: int(max(pages, 0)),
+	}, true
+}
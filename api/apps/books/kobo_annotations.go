@@ -0,0 +1,341 @@
+package books
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// --- JSON types for the Kobo reading services annotation API ---
+
+type koboAnnotationsResponse struct {
+	Annotations         []koboAnnotation `json:"annotations"`
+	NextPageOffsetToken *string          `json:"nextPageOffsetToken"`
+}
+
+type koboAnnotationsPatch struct {
+	UpdatedAnnotations   []koboAnnotation `json:"updatedAnnotations"`
+	DeletedAnnotationIDs []string         `json:"deletedAnnotationIds"`
+}
+
+type koboAnnotation struct {
+	ID                    string          `json:"id"`
+	Type                  string          `json:"type"`
+	HighlightedText       *string         `json:"highlightedText"`
+	NoteText              *string         `json:"noteText"`
+	HighlightColor        *string         `json:"highlightColor"`
+	ClientLastModifiedUTC string          `json:"clientLastModifiedUtc"`
+	Location              json.RawMessage `json:"location"`
+}
+
+// koboAnnotationSpan is the part of an annotation's location we index on:
+// enough to group highlights by chapter and order them within a book. The
+// start/end paths are left inside the raw location blob.
+type koboAnnotationSpan struct {
+	Span struct {
+		ChapterTitle    string  `json:"chapterTitle"`
+		ChapterProgress float64 `json:"chapterProgress"`
+	} `json:"span"`
+}
+
+type koboContentChange struct {
+	ContentID string `json:"contentId"`
+	Etag      string `json:"etag"`
+}
+
+// koboAnnotationContent resolves {contentId} to one of the user's own books.
+// Anything else — a store purchase, or an ID we never issued — is proxied
+// upstream and ok is false, as it is when an error response was written.
+func (app *Books) koboAnnotationContent(
+	w http.ResponseWriter,
+	r *http.Request,
+	userID string,
+) (uuid.UUID, bool) {
+	bookID, err := uuid.Parse(r.PathValue("contentId"))
+	if err != nil {
+		app.koboProxy(w, r)
+		return uuid.Nil, false
+	}
+
+	owned, err := app.Services.Annotation.OwnsBook(r.Context(), userID, bookID)
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return uuid.Nil, false
+	}
+	if !owned {
+		app.koboProxy(w, r)
+		return uuid.Nil, false
+	}
+	return bookID, true
+}
+
+// koboGetAnnotationsHandler handles GET /api/v3/content/{contentId}/annotations.
+func (app *Books) koboGetAnnotationsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := app.koboAuth(w, r)
+	if !ok {
+		return
+	}
+	bookID, ok := app.koboAnnotationContent(w, r, userID)
+	if !ok {
+		return
+	}
+
+	annotations, err := app.Services.Annotation.ListForBook(
+		r.Context(), userID, bookID,
+	)
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	etag := koboAnnotationsETag(annotations)
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	out := make([]koboAnnotation, len(annotations))
+	for i, a := range annotations {
+		out[i] = buildKoboAnnotation(a)
+	}
+	koboWriteJSON(w, koboAnnotationsResponse{
+		Annotations:         out,
+		NextPageOffsetToken: nil,
+	})
+}
+
+// koboPatchAnnotationsHandler handles PATCH
+// /api/v3/content/{contentId}/annotations: the device's batch of created,
+// edited and deleted annotations since its last sync.
+func (app *Books) koboPatchAnnotationsHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	userID, ok := app.koboAuth(w, r)
+	if !ok {
+		return
+	}
+	bookID, ok := app.koboAnnotationContent(w, r, userID)
+	if !ok {
+		return
+	}
+
+	var body koboAnnotationsPatch
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	updated := make([]models.Annotation, 0, len(body.UpdatedAnnotations))
+	for _, ka := range body.UpdatedAnnotations {
+		a, err := parseKoboAnnotation(ka)
+		if err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		updated = append(updated, a)
+	}
+
+	deleted := make([]uuid.UUID, 0, len(body.DeletedAnnotationIDs))
+	for _, raw := range body.DeletedAnnotationIDs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		deleted = append(deleted, id)
+	}
+
+	if err := app.Services.Annotation.Sync(
+		r.Context(), userID, bookID, updated, deleted,
+	); err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// koboCheckForChangesHandler handles POST /api/v3/content/checkforchanges.
+// The device lists the ETags it last saw per book; we answer with the content
+// IDs whose annotations changed since, so it only re-fetches those. Entries
+// that aren't our books (store purchases) are asked of the upstream reading
+// services and its answer is merged in.
+func (app *Books) koboCheckForChangesHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	userID, ok := app.koboAuth(w, r)
+	if !ok {
+		return
+	}
+
+	var body []koboContentChange
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	changed := []string{}
+	var upstream []koboContentChange
+	for _, c := range body {
+		bookID, err := uuid.Parse(c.ContentID)
+		if err != nil {
+			upstream = append(upstream, c)
+			continue
+		}
+		owned, err := app.Services.Annotation.OwnsBook(r.Context(), userID, bookID)
+		if err != nil {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		if !owned {
+			upstream = append(upstream, c)
+			continue
+		}
+		annotations, err := app.Services.Annotation.ListForBook(
+			r.Context(), userID, bookID,
+		)
+		if err != nil {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		if koboAnnotationsETag(annotations) != c.Etag {
+			changed = append(changed, c.ContentID)
+		}
+	}
+	if len(upstream) > 0 {
+		changed = append(changed, app.koboUpstreamChanges(r, upstream)...)
+	}
+	koboWriteJSON(w, changed)
+}
+
+// koboUpstreamChanges forwards the store books' entries of a checkforchanges
+// request to the upstream reading services and returns the content IDs it
+// reports changed. On any error it reports none, so a store outage never
+// fails the check for our own books.
+func (app *Books) koboUpstreamChanges(
+	r *http.Request,
+	entries []koboContentChange,
+) []string {
+	// json.Marshal cannot fail on this fully-typed slice.
+	body, _ := json.Marshal(entries)
+
+	//nolint:gosec // the URL is built from KoboReadingServicesURL, set by config
+	req, err := http.NewRequestWithContext(
+		r.Context(), http.MethodPost,
+		app.clients.KoboReadingServicesURL+"/api/v3/content/checkforchanges",
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil
+	}
+	req.Header = koboUpstreamHeader(r.Header)
+
+	//nolint:gosec // intentional call to upstream Kobo reading services
+	resp, err := koboUpstreamClient.Do(req)
+	if err != nil {
+		return nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	var changed []string
+	if decErr := json.NewDecoder(resp.Body).Decode(&changed); decErr != nil {
+		return nil
+	}
+	return changed
+}
+
+// parseKoboAnnotation converts one device annotation to our model. The ID must
+// be a UUID since it becomes the row's primary key.
+func parseKoboAnnotation(ka koboAnnotation) (models.Annotation, error) {
+	id, err := uuid.Parse(ka.ID)
+	if err != nil {
+		return models.Annotation{}, err
+	}
+
+	a := models.Annotation{ //nolint:exhaustruct // user/book/timestamps set later
+		ID:     id,
+		Type:   ka.Type,
+		Source: models.ReadingSourceKobo,
+	}
+	switch a.Type {
+	case "":
+		a.Type = models.AnnotationTypeHighlight
+	case models.AnnotationTypeHighlight,
+		models.AnnotationTypeNote,
+		models.AnnotationTypeDogear:
+	default:
+		return models.Annotation{}, fmt.Errorf("unknown annotation type %q", a.Type)
+	}
+	if ka.HighlightedText != nil {
+		a.HighlightedText = *ka.HighlightedText
+	}
+	if ka.NoteText != nil {
+		a.NoteText = *ka.NoteText
+	}
+	if ka.HighlightColor != nil {
+		a.Color = *ka.HighlightColor
+	}
+	if len(ka.Location) > 0 && string(ka.Location) != "null" {
+		a.Location = ka.Location
+		var loc koboAnnotationSpan
+		if json.Unmarshal(ka.Location, &loc) == nil {
+			a.ChapterTitle = loc.Span.ChapterTitle
+			a.ChapterProgress = loc.Span.ChapterProgress
+		}
+	}
+	modified, err := time.Parse(time.RFC3339Nano, ka.ClientLastModifiedUTC)
+	if err == nil {
+		a.ClientModifiedAt = &modified
+	}
+	return a, nil
+}
+
+// buildKoboAnnotation renders a stored annotation in the device's shape,
+// echoing its original location blob unchanged.
+func buildKoboAnnotation(a models.Annotation) koboAnnotation {
+	modified := a.UpdatedAt
+	if a.ClientModifiedAt != nil {
+		modified = *a.ClientModifiedAt
+	}
+	return koboAnnotation{
+		ID:                    a.ID.String(),
+		Type:                  a.Type,
+		HighlightedText:       nonEmpty(a.HighlightedText),
+		NoteText:              nonEmpty(a.NoteText),
+		HighlightColor:        nonEmpty(a.Color),
+		ClientLastModifiedUTC: modified.UTC().Format(time.RFC3339),
+		Location:              json.RawMessage(a.Location),
+	}
+}
+
+// koboAnnotationsETag fingerprints a book's annotation set so the device can
+// skip unchanged books via If-None-Match and checkforchanges.
+func koboAnnotationsETag(annotations []models.Annotation) string {
+	h := sha256.New()
+	for _, a := range annotations {
+		h.Write(a.ID[:])
+		h.Write([]byte(a.UpdatedAt.UTC().Format(time.RFC3339Nano)))
+	}
+	const etagLen = 16
+	return `"` + hex.EncodeToString(h.Sum(nil))[:etagLen] + `"`
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
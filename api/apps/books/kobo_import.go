@@ -0,0 +1,202 @@
+package books
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// maxKoboImportBytes caps a kobo-gateway history upload. A heavily used
+// device's highlights and sessions are a few MB of JSON at most.
+const maxKoboImportBytes = 32 << 20
+
+// --- JSON contract for kobo-gateway's POST /import upload ---
+// Mirrors kobo-gateway/internal/kobogateway/koboreader.go.
+
+type koboImportRequest struct {
+	Serial string           `json:"serial"`
+	Books  []koboImportBook `json:"books"`
+}
+
+type koboImportBook struct {
+	ContentID               string                `json:"contentId"`
+	Title                   string                `json:"title"`
+	Authors                 []string              `json:"authors"`
+	ISBN                    string                `json:"isbn"`
+	ReadStatus              string                `json:"readStatus"`
+	PercentRead             int                   `json:"percentRead"`
+	FirstOpenedAt           *time.Time            `json:"firstOpenedAt"`
+	LastReadAt              *time.Time            `json:"lastReadAt"`
+	FinishedAt              *time.Time            `json:"finishedAt"`
+	TimeSpentReadingSeconds int                   `json:"timeSpentReadingSeconds"`
+	Highlights              []koboImportHighlight `json:"highlights"`
+	Sessions                []koboImportSession   `json:"sessions"`
+}
+
+type koboImportHighlight struct {
+	ID              string     `json:"id"`
+	Type            string     `json:"type"`
+	Text            string     `json:"text"`
+	Note            string     `json:"note"`
+	Color           string     `json:"color"`
+	ChapterTitle    string     `json:"chapterTitle"`
+	ChapterFilename string     `json:"chapterFilename"`
+	ChapterProgress float64    `json:"chapterProgress"`
+	StartPath       string     `json:"startPath"`
+	StartOffset     int        `json:"startOffset"`
+	EndPath         string     `json:"endPath"`
+	EndOffset       int        `json:"endOffset"`
+	CreatedAt       *time.Time `json:"createdAt"`
+	ModifiedAt      *time.Time `json:"modifiedAt"`
+}
+
+type koboImportSession struct {
+	StartedAt   time.Time `json:"startedAt"`
+	EndedAt     time.Time `json:"endedAt"`
+	Seconds     int       `json:"seconds"`
+	PagesTurned int       `json:"pagesTurned"`
+}
+
+type koboImportResponse struct {
+	Books      int `json:"books"`
+	Matched    int `json:"matched"`
+	Created    int `json:"created"`
+	Skipped    int `json:"skipped"`
+	MarkedRead int `json:"markedRead"`
+	Highlights int `json:"highlights"`
+	Sessions   int `json:"sessions"`
+	// SkippedHighlights counts Bookmark rows that could not be mapped to an
+	// annotation, e.g. ones whose ID is not a UUID.
+	SkippedHighlights int `json:"skippedHighlights"`
+}
+
+// koboImportHandler handles POST /gateway/import: reading history that
+// kobo-gateway read out of the device's KoboReader.sqlite over USB. It is
+// authenticated by the same URL token as the sync endpoints, since the
+// gateway only ever holds the device's sync URL.
+func (app *Books) koboImportHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := app.koboAuth(w, r)
+	if !ok {
+		return
+	}
+
+	var body koboImportRequest
+	r.Body = http.MaxBytesReader(w, r.Body, maxKoboImportBytes)
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	imp := parseKoboImport(body)
+	result, err := app.Services.Import.Import(
+		r.Context(), userID, models.ReadingSourceKobo, imp,
+	)
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	if result.MarkedRead > 0 {
+		if err = app.rebuildReadProgress(r.Context(), userID); err != nil {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	koboWriteJSON(w, koboImportResponse{
+		Books:      result.Books,
+		Matched:    result.Matched,
+		Created:    result.Created,
+		Skipped:    result.Skipped,
+		MarkedRead: result.MarkedRead,
+		Highlights: result.Highlights,
+		Sessions:   result.Sessions,
+
+		SkippedHighlights: result.SkippedHighlights,
+	})
+}
+
+// parseKoboImport maps the upload to a device import. A highlight that does
+// not parse (older firmware wrote non-UUID bookmark IDs) is skipped and
+// counted rather than failing the whole import.
+func parseKoboImport(body koboImportRequest) models.DeviceImport {
+	imp := models.DeviceImport{
+		Serial:            body.Serial,
+		Books:             make([]models.DeviceImportBook, 0, len(body.Books)),
+		SkippedHighlights: 0,
+	}
+	for _, kb := range body.Books {
+		b := models.DeviceImportBook{
+			ContentID:        kb.ContentID,
+			Title:            kb.Title,
+			Authors:          kb.Authors,
+			ISBN:             kb.ISBN,
+			ReadStatus:       kb.ReadStatus,
+			PercentRead:      min(max(kb.PercentRead, 0), models.MaxProgressPercent),
+			FirstOpenedAt:    kb.FirstOpenedAt,
+			LastReadAt:       kb.LastReadAt,
+			FinishedAt:       kb.FinishedAt,
+			TimeSpentSeconds: max(kb.TimeSpentReadingSeconds, 0),
+			Highlights:       make([]models.Annotation, 0, len(kb.Highlights)),
+			Sessions:         make([]models.ReadingSession, 0, len(kb.Sessions)),
+		}
+		for _, h := range kb.Highlights {
+			a, err := parseKoboImportHighlight(h)
+			if err != nil {
+				imp.SkippedHighlights++
+				continue
+			}
+			b.Highlights = append(b.Highlights, a)
+		}
+		for _, s := range kb.Sessions {
+			if s.Seconds <= 0 || s.EndedAt.Before(s.StartedAt) {
+				continue
+			}
+			//nolint:exhaustruct // user/book/source filled in by the service
+			b.Sessions = append(b.Sessions, models.ReadingSession{
+				StartedAt:   s.StartedAt,
+				EndedAt:     s.EndedAt,
+				Seconds:     s.Seconds,
+				PagesTurned: s.PagesTurned,
+			})
+		}
+		imp.Books = append(imp.Books, b)
+	}
+	return imp
+}
+
+// parseKoboImportHighlight maps a Bookmark row to an annotation, rebuilding
+// the same location span the reading services API sends so highlights read
+// over USB and synced over the air look identical to the device.
+func parseKoboImportHighlight(h koboImportHighlight) (models.Annotation, error) {
+	ka := koboAnnotation{
+		ID:                    h.ID,
+		Type:                  h.Type,
+		HighlightedText:       nonEmpty(h.Text),
+		NoteText:              nonEmpty(h.Note),
+		HighlightColor:        nonEmpty(h.Color),
+		ClientLastModifiedUTC: "",
+		Location:              nil,
+	}
+	if h.ModifiedAt != nil {
+		ka.ClientLastModifiedUTC = h.ModifiedAt.UTC().Format(time.RFC3339Nano)
+	} else if h.CreatedAt != nil {
+		ka.ClientLastModifiedUTC = h.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	// json.Marshal cannot fail on this fully-typed map.
+	ka.Location, _ = json.Marshal(map[string]any{
+		"span": map[string]any{
+			"chapterFilename": h.ChapterFilename,
+			"chapterProgress": h.ChapterProgress,
+			"chapterTitle":    h.ChapterTitle,
+			"startPath":       h.StartPath,
+			"startChar":       h.StartOffset,
+			"endPath":         h.EndPath,
+			"endChar":         h.EndOffset,
+		},
+	})
+
+	return parseKoboAnnotation(ka)
+}
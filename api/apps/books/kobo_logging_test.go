@@ -50,3 +50,27 @@ func TestKoboUpstreamClient_TimesOutOnSlowUpstream(t *testing.T) {
 	assert.Less(t, elapsed, 2*time.Second,
 		"koboUpstreamClient.Timeout must bound the call to a stalled upstream")
 }
+
+// TestKoboUpstreamHeader checks the device's Accept-Encoding and connection
+// headers stay behind: forwarding Accept-Encoding turns off the transport's
+// transparent gzip, leaving us to decode a compressed body as JSON.
+func TestKoboUpstreamHeader(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Bearer device")
+	h.Set("User-Agent", "Kobo Touch")
+	h.Set("Accept-Encoding", "gzip, deflate")
+	h.Set("Content-Length", "42")
+	h.Set("Connection", "keep-alive, X-Device-Hop")
+	h.Set("X-Device-Hop", "1")
+	h.Set("Keep-Alive", "timeout=5")
+	h.Set("Transfer-Encoding", "chunked")
+
+	out := koboUpstreamHeader(h)
+
+	assert.Equal(t, http.Header{
+		"Authorization": {"Bearer device"},
+		"User-Agent":    {"Kobo Touch"},
+	}, out)
+	assert.Equal(t, "gzip, deflate", h.Get("Accept-Encoding"),
+		"the device's request must be left untouched")
+}
@@ -7,12 +7,14 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 
 	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/communication/httptools"
 	"tools.xdoubleu.com/internal/database"
 )
 
@@ -25,6 +27,34 @@ const koboUpstreamTimeout = 10 * time.Second
 //nolint:gochecknoglobals // shared client, Timeout mutated only in tests
 var koboUpstreamClient = &http.Client{Timeout: koboUpstreamTimeout}
 
+// koboHopHeaders only describe the device's connection to us, so they are
+// never sent on to the upstream store.
+//
+//nolint:gochecknoglobals // read-only list
+var koboHopHeaders = []string{
+	"Connection", "Proxy-Connection", "Keep-Alive", "Proxy-Authenticate",
+	"Proxy-Authorization", "Te", "Trailer", "Transfer-Encoding", "Upgrade",
+}
+
+// koboUpstreamHeader copies the device's headers (auth, user agent, …) for a
+// request we make to the upstream store ourselves. Accept-Encoding is dropped
+// so the transport negotiates gzip and decompresses the response for us, and
+// Content-Length so it can't disagree with the body we send.
+func koboUpstreamHeader(h http.Header) http.Header {
+	out := h.Clone()
+	for _, field := range out.Values("Connection") {
+		for name := range strings.SplitSeq(field, ",") {
+			out.Del(strings.TrimSpace(name))
+		}
+	}
+	for _, name := range koboHopHeaders {
+		out.Del(name)
+	}
+	out.Del("Accept-Encoding")
+	out.Del("Content-Length")
+	return out
+}
+
 // koboRoutes mounts the Kobo native sync protocol endpoints under
 // /{prefix}/kobo/{token}/. The token is a raw bearer secret embedded in the
 // device's api_endpoint URL by the web setup flow; it is SHA-256 hashed before
@@ -38,6 +68,9 @@ var koboUpstreamClient = &http.Client{Timeout: koboUpstreamTimeout}
 // those continue to work.
 func (app *Books) koboRoutes(prefix string, mux *http.ServeMux) {
 	base := "/" + prefix + "/kobo/{token}"
+	mux.HandleFunc(
+		"GET "+base+"/v1/initialization", app.koboLogged(app.koboInitHandler),
+	)
 	mux.HandleFunc(
 		"POST "+base+"/v1/initialization", app.koboLogged(app.koboInitHandler),
 	)
@@ -60,6 +93,46 @@ func (app *Books) koboRoutes(prefix string, mux *http.ServeMux) {
 		"PUT "+base+"/v1/library/{revisionId}/state",
 		app.koboLogged(app.koboPutStateHandler),
 	)
+	mux.HandleFunc(
+		"POST "+base+"/v1/library/tags", app.koboLogged(app.koboCreateTagHandler),
+	)
+	mux.HandleFunc(
+		"PUT "+base+"/v1/library/{parent}/{tagId}",
+		app.koboLogged(app.koboRenameTagHandler),
+	)
+	mux.HandleFunc(
+		"DELETE "+base+"/v1/library/tags/{tagId}",
+		app.koboLogged(app.koboDeleteTagHandler),
+	)
+	mux.HandleFunc(
+		"POST "+base+"/v1/library/tags/{tagId}/items",
+		app.koboLogged(app.koboAddTagItemsHandler),
+	)
+	mux.HandleFunc(
+		"POST "+base+"/v1/library/tags/{tagId}/items/delete",
+		app.koboLogged(app.koboRemoveTagItemsHandler),
+	)
+	mux.HandleFunc(
+		"GET "+base+"/api/v3/content/{contentId}/annotations",
+		app.koboLogged(app.koboGetAnnotationsHandler),
+	)
+	mux.HandleFunc(
+		"PATCH "+base+"/api/v3/content/{contentId}/annotations",
+		app.koboLogged(app.koboPatchAnnotationsHandler),
+	)
+	mux.HandleFunc(
+		"POST "+base+"/api/v3/content/checkforchanges",
+		app.koboLogged(app.koboCheckForChangesHandler),
+	)
+	mux.HandleFunc(
+		"POST "+base+"/v1/analytics/event",
+		app.koboLogged(app.koboAnalyticsHandler),
+	)
+	// Not part of the Kobo protocol: kobo-gateway's USB history upload,
+	// authenticated by the same device token.
+	mux.HandleFunc(
+		"POST "+base+"/gateway/import", app.koboLogged(app.koboImportHandler),
+	)
 	// Catch-all: proxy unrecognised paths to the upstream Kobo store.
 	mux.HandleFunc(
 		"/"+prefix+"/kobo/{token}/", app.koboLogged(app.koboProxyHandler),
@@ -70,19 +143,24 @@ func (app *Books) koboRoutes(prefix string, mux *http.ServeMux) {
 // Returns (userID, true) on success; writes an error response and returns
 // ("", false) on failure — callers must return immediately on false.
 func (app *Books) koboAuth(w http.ResponseWriter, r *http.Request) (string, bool) {
-	proto := r.Header.Get("X-Forwarded-Proto")
-	if proto == "" {
-		proto = "http"
-	}
-	if proto != "https" {
-		http.Error(w, "https required", http.StatusForbidden)
-		return "", false
+	userID, _, ok := app.koboAuthDevice(w, r)
+	return userID, ok
+}
+
+// koboAuthDevice is koboAuth for handlers that also need to know which of
+// the user's devices is calling.
+func (app *Books) koboAuthDevice(
+	w http.ResponseWriter,
+	r *http.Request,
+) (string, uuid.UUID, bool) {
+	if !httptools.RequireHTTPS(w, r) {
+		return "", uuid.Nil, false
 	}
 
 	raw := r.PathValue("token")
 	if raw == "" {
 		http.Error(w, "unauthorized", http.StatusUnauthorized)
-		return "", false
+		return "", uuid.Nil, false
 	}
 
 	// Always hash — keeps the lookup constant-time-ish regardless of match.
@@ -95,10 +173,10 @@ func (app *Books) koboAuth(w http.ResponseWriter, r *http.Request) (string, bool
 	if err != nil {
 		if errors.Is(err, database.ErrResourceNotFound) {
 			http.Error(w, "unauthorized", http.StatusUnauthorized)
-			return "", false
+			return "", uuid.Nil, false
 		}
 		http.Error(w, "internal error", http.StatusInternalServerError)
-		return "", false
+		return "", uuid.Nil, false
 	}
 
 	// Arm request/response capture for this device when debug logging is on.
@@ -108,7 +186,12 @@ func (app *Books) koboAuth(w http.ResponseWriter, r *http.Request) (string, bool
 		holder.deviceID = deviceID
 		holder.enabled = app.Services.KoboLog.IsEnabled(deviceID)
 	}
-	return userID, true
+	id, err := uuid.Parse(deviceID)
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return "", uuid.Nil, false
+	}
+	return userID, id, true
 }
 
 // koboEpoch is used as LastModified when no reading state exists server-side.
@@ -129,9 +212,9 @@ func koboWriteJSON(w http.ResponseWriter, v any) {
 // --- JSON types for the Kobo store sync protocol ---
 
 type koboInitResponse struct {
-	Resources json.RawMessage `json:"Resources"`
-	Settings  koboSettings    `json:"Settings"`
-	TokenList []string        `json:"TokenList"`
+	Resources map[string]any `json:"Resources"`
+	Settings  koboSettings   `json:"Settings"`
+	TokenList []string       `json:"TokenList"`
 }
 
 type koboSettings struct {
@@ -185,6 +268,18 @@ type koboBookMetadata struct {
 	RevisionId   string            `json:"RevisionId"`
 	Language     string            `json:"Language"`
 	DownloadUrls []koboDownloadURL `json:"DownloadUrls"`
+	Series       *koboSeries       `json:"Series,omitempty"`
+}
+
+// koboSeries groups synced books by series on the device. The firmware keys
+// series by Id, so it is derived from the name (a UUIDv3 in the DNS
+// namespace, the same id calibre-web sends) to stay stable across syncs.
+type koboSeries struct {
+	Name        string  `json:"Name"`
+	Number      string  `json:"Number"`
+	NumberFloat float64 `json:"NumberFloat"`
+	//nolint:revive // Kobo protocol field name
+	Id string `json:"Id"`
 }
 
 type koboDownloadURL struct {
@@ -220,14 +315,32 @@ type koboStatusInfo struct {
 
 // --- Handlers ---
 
-// koboInitHandler handles POST /v1/initialization — the device registration
-// handshake. We validate the token and return minimal init metadata.
+// koboInitHandler handles GET and POST /v1/initialization — the device
+// registration handshake. The Resources map tells the firmware where every
+// service lives; we return the upstream store's with the store and reading
+// services URLs pointed back at this API, so annotation traffic reaches our
+// handlers and the rest is proxied.
 func (app *Books) koboInitHandler(w http.ResponseWriter, r *http.Request) {
 	if _, ok := app.koboAuth(w, r); !ok {
 		return
 	}
+
+	resources, hdrs := app.koboFetchUpstreamResources(r)
+	base := app.koboDeviceBase(r)
+	for key, v := range resources {
+		if raw, isString := v.(string); isString {
+			resources[key] = app.koboRewriteUpstreamURL(raw, base)
+		}
+	}
+	for key, own := range koboOwnResources(base) {
+		resources[key] = own
+	}
+
+	if v := hdrs.Get("x-kobo-apitoken"); v != "" {
+		w.Header().Set("x-kobo-apitoken", v)
+	}
 	koboWriteJSON(w, koboInitResponse{
-		Resources: json.RawMessage(`{}`),
+		Resources: resources,
 		Settings: koboSettings{
 			SynchronizationDelay: 0,
 			TestEmailAddress:     "",
@@ -238,9 +351,11 @@ func (app *Books) koboInitHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 // koboLibrarySyncHandler handles GET /v1/library/sync — merges the upstream
-// Kobo store's entitlements with our own kobo-sync books (additive).
+// Kobo store's entitlements with our own kobo-sync books and shelves
+// (additive). Our books are narrowed to the calling device's sync filter;
+// books it was sent before that no longer pass are archived on it.
 func (app *Books) koboLibrarySyncHandler(w http.ResponseWriter, r *http.Request) {
-	userID, ok := app.koboAuth(w, r)
+	userID, deviceID, ok := app.koboAuthDevice(w, r)
 	if !ok {
 		return
 	}
@@ -250,6 +365,13 @@ func (app *Books) koboLibrarySyncHandler(w http.ResponseWriter, r *http.Request)
 		http.Error(w, "internal error", http.StatusInternalServerError)
 		return
 	}
+	books, archived, err := app.Services.Kobo.ApplyKoboDeviceFilter(
+		r.Context(), userID, deviceID, books,
+	)
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
 
 	// Batch-load all reading states so the sync manifest can include them
 	// without issuing a per-book query (avoids N+1).
@@ -262,7 +384,9 @@ func (app *Books) koboLibrarySyncHandler(w http.ResponseWriter, r *http.Request)
 	libraryBase := app.koboLibraryBase(r)
 
 	ourEntries := make([]json.RawMessage, len(books))
+	synced := make(map[uuid.UUID]bool, len(books))
 	for i, b := range books {
+		synced[b.BookID] = true
 		id := b.BookID.String()
 		// Use the time kobo-sync was enabled for this book so the entitlement
 		// payload is byte-identical on every sync. time.Now() would produce a
@@ -304,11 +428,26 @@ func (app *Books) koboLibrarySyncHandler(w http.ResponseWriter, r *http.Request)
 		http.Error(w, "internal error", http.StatusInternalServerError)
 		return
 	}
-	removalEntries := make([]json.RawMessage, len(removals))
-	for i, rm := range removals {
-		removalEntries[i] = buildKoboRemovalEntry(rm)
+	removalEntries := make([]json.RawMessage, 0, len(removals)+len(archived))
+	removed := make(map[uuid.UUID]bool, len(removals))
+	for _, rm := range removals {
+		removed[rm.BookID] = true
+		removalEntries = append(removalEntries, buildKoboRemovalEntry(rm))
+	}
+	// A book that left kobo-sync altogether is already covered above.
+	for _, rm := range archived {
+		if !removed[rm.BookID] {
+			removalEntries = append(removalEntries, buildKoboRemovalEntry(rm))
+		}
 	}
 
+	tags, err := app.Services.Books.ListKoboTags(r.Context(), userID)
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	tagEntries := buildKoboTagEntries(tags, synced)
+
 	// Fetch upstream items (gracefully degrade to empty on error).
 	upstreamItems, upstreamHdrs := app.koboFetchUpstreamSync(r)
 
@@ -319,10 +458,12 @@ func (app *Books) koboLibrarySyncHandler(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	// Upstream items first, then ours, then removals (additive — never drops
-	// store items).
+	// Upstream items first, then ours, then removals, then our collections
+	// (additive — never drops store items). Collections come last so every
+	// book they list is already entitled.
 	all := append(upstreamItems, ourEntries...) //nolint:gocritic // intentional
 	all = append(all, removalEntries...)
+	all = append(all, tagEntries...)
 	if all == nil {
 		// A nil slice would encode as JSON null, which the Kobo firmware doesn't
 		// treat as "sync complete" — it hangs at "Checking for updates…". Happens
@@ -339,12 +480,19 @@ func (app *Books) koboProxyHandler(w http.ResponseWriter, r *http.Request) {
 	if _, ok := app.koboAuth(w, r); !ok {
 		return
 	}
+	app.koboProxy(w, r)
+}
 
+// koboProxy forwards an already-authenticated request to the upstream store,
+// or to the upstream reading services for their /api/… paths. Handlers that
+// own a path but not every resource under it (e.g. annotations for
+// store-bought books) fall back to this.
+func (app *Books) koboProxy(w http.ResponseWriter, r *http.Request) {
 	// Strip "/{prefix}/kobo/{token}" to obtain the Kobo-relative path.
 	token := r.PathValue("token")
 	_, koboPath, _ := strings.Cut(r.URL.Path, "/kobo/"+token)
 
-	targetURL := app.clients.KoboStoreBaseURL + koboPath
+	targetURL := app.koboUpstreamFor(koboPath) + koboPath
 	if r.URL.RawQuery != "" {
 		targetURL += "?" + r.URL.RawQuery
 	}
@@ -376,8 +524,92 @@ func (app *Books) koboProxyHandler(w http.ResponseWriter, r *http.Request) {
 	_, _ = io.Copy(w, resp.Body)
 }
 
+// koboUpstreamFor picks the upstream a Kobo-relative path belongs to: the
+// reading services own /api/…, the store everything else.
+func (app *Books) koboUpstreamFor(koboPath string) string {
+	if strings.HasPrefix(koboPath, "/api/") {
+		return app.clients.KoboReadingServicesURL
+	}
+	return app.clients.KoboStoreBaseURL
+}
+
+// koboFetchUpstreamResources calls the upstream store's /v1/initialization,
+// forwarding the device's headers, and returns its Resources map. On any
+// error the map is empty (caller falls back to koboOwnResources).
+func (app *Books) koboFetchUpstreamResources(
+	r *http.Request,
+) (map[string]any, http.Header) {
+	resources := map[string]any{}
+
+	//nolint:gosec // the URL is built from KoboStoreBaseURL, set by config
+	req, err := http.NewRequestWithContext(
+		r.Context(), http.MethodGet,
+		app.clients.KoboStoreBaseURL+"/v1/initialization", nil,
+	)
+	if err != nil {
+		return resources, nil
+	}
+	req.Header = koboUpstreamHeader(r.Header)
+
+	//nolint:gosec // intentional call to upstream Kobo store
+	resp, err := koboUpstreamClient.Do(req)
+	if err != nil {
+		return resources, nil
+	}
+	defer resp.Body.Close()
+
+	hdrs := resp.Header.Clone()
+	if resp.StatusCode != http.StatusOK {
+		return resources, hdrs
+	}
+
+	var body struct {
+		Resources map[string]any `json:"Resources"`
+	}
+	if decErr := json.NewDecoder(resp.Body).Decode(&body); decErr != nil ||
+		body.Resources == nil {
+		return resources, hdrs
+	}
+	return body.Resources, hdrs
+}
+
+// koboOwnResources are the init resources for the endpoints this API
+// serves itself. They point here even when the upstream store is
+// unreachable or lists them elsewhere.
+func koboOwnResources(base string) map[string]string {
+	return map[string]string{
+		"library_sync":          base + "/v1/library/sync",
+		"library_metadata":      base + "/v1/library/{Ids}/metadata",
+		"reading_state":         base + "/v1/library/{Ids}/state",
+		"tags":                  base + "/v1/library/tags",
+		"tag_items":             base + "/v1/library/tags/{TagId}/items",
+		"delete_tag":            base + "/v1/library/tags/{TagId}",
+		"delete_tag_items":      base + "/v1/library/tags/{TagId}/items/delete",
+		"rename_tag":            base + "/v1/library/tags/{TagId}",
+		"reading_services_host": base,
+	}
+}
+
+// koboRewriteUpstreamURL points a URL on the upstream store or reading
+// services at the same path under base, where the catch-all proxies it
+// back. Any other URL (e.g. the image CDN) is returned unchanged.
+func (app *Books) koboRewriteUpstreamURL(raw, base string) string {
+	for _, upstream := range []string{
+		app.clients.KoboStoreBaseURL, app.clients.KoboReadingServicesURL,
+	} {
+		if upstream == "" {
+			continue
+		}
+		if rest, ok := strings.CutPrefix(raw, upstream); ok &&
+			(rest == "" || strings.HasPrefix(rest, "/")) {
+			return base + rest
+		}
+	}
+	return raw
+}
+
 // koboFetchUpstreamSync calls the upstream store's /v1/library/sync,
-// forwarding all original headers. Returns (items, responseHeaders).
+// forwarding the device's headers. Returns (items, responseHeaders).
 // On any error the items slice is nil (caller degrades gracefully).
 func (app *Books) koboFetchUpstreamSync(
 	r *http.Request,
@@ -394,7 +626,7 @@ func (app *Books) koboFetchUpstreamSync(
 	if err != nil {
 		return nil, nil
 	}
-	req.Header = r.Header.Clone()
+	req.Header = koboUpstreamHeader(r.Header)
 
 	//nolint:gosec // intentional call to upstream Kobo store
 	resp, err := koboUpstreamClient.Do(req)
@@ -437,9 +669,29 @@ func buildKoboMetadata(b models.KoboSyncBook, libraryBase string) koboBookMetada
 			URL:      libraryBase + "/" + id + "/file",
 			Platform: "Generic",
 		}},
+		Series: buildKoboSeries(b),
 	}
 }
 
+// buildKoboSeries returns b's series for the device, or nil when the book is
+// in none.
+func buildKoboSeries(b models.KoboSyncBook) *koboSeries {
+	if b.Series == nil || *b.Series == "" {
+		return nil
+	}
+	series := &koboSeries{
+		Name:        *b.Series,
+		Number:      "",
+		NumberFloat: 0,
+		Id:          uuid.NewMD5(uuid.NameSpaceDNS, []byte(*b.Series)).String(),
+	}
+	if b.SeriesPosition != nil {
+		series.Number = strconv.FormatFloat(*b.SeriesPosition, 'f', -1, 64)
+		series.NumberFloat = *b.SeriesPosition
+	}
+	return series
+}
+
 // buildKoboRemovalEntry builds a ChangedEntitlement payload telling the
 // device to delete a book it previously synced. We only have the book ID and
 // the tombstone timestamp (the catalog row may already be gone), so
@@ -645,15 +897,30 @@ func (app *Books) koboLibraryBase(r *http.Request) string {
 	if idx := strings.Index(path, "/v1/library"); idx != -1 {
 		path = path[:idx] + "/v1/library"
 	}
+	return app.publicOrigin(r) + path
+}
+
+// koboDeviceBase is the https://host/…/kobo/{token} URL the device reaches
+// this API under; every store and reading-services path hangs off it.
+func (app *Books) koboDeviceBase(r *http.Request) string {
+	token := r.PathValue("token")
+	path, _, _ := strings.Cut(r.URL.Path, "/kobo/"+token)
+	return app.publicOrigin(r) + path + "/kobo/" + token
+}
+
+// publicOrigin is the externally reachable base URL to prefix request paths
+// with when building absolute links: clients.PublicAPIBaseURL when set, else
+// derived from the request headers (dev / test). The scheme is always https
+// because the token-authenticated routes that need this enforce it.
+func (app *Books) publicOrigin(r *http.Request) string {
 	if app.clients.PublicAPIBaseURL != "" {
-		return strings.TrimSuffix(app.clients.PublicAPIBaseURL, "/") + path
+		return strings.TrimSuffix(app.clients.PublicAPIBaseURL, "/")
 	}
-	// Fallback: derive scheme+host from request headers (dev / test).
 	host := r.Header.Get("X-Forwarded-Host")
 	if host == "" {
 		host = r.Host
 	}
-	return "https://" + host + path
+	return "https://" + host
 }
 
 // parseKoboLocation extracts a resumable location string from the Kobo
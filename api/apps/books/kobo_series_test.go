@@ -0,0 +1,37 @@
+//nolint:testpackage // testing unexported buildKoboMetadata
+package books
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+func TestBuildKoboMetadata_Series(t *testing.T) {
+	series, pos := "The Expanse", 3.5
+	book := models.KoboSyncBook{ //nolint:exhaustruct // only series matters
+		BookID:         uuid.New(),
+		Title:          "Abaddon's Gate",
+		Series:         &series,
+		SeriesPosition: &pos,
+	}
+
+	meta := buildKoboMetadata(book, "https://example.com/library")
+	require.NotNil(t, meta.Series)
+	assert.Equal(t, "The Expanse", meta.Series.Name)
+	assert.Equal(t, "3.5", meta.Series.Number)
+	assert.InDelta(t, 3.5, meta.Series.NumberFloat, 0)
+	// Python's uuid.uuid3(uuid.NAMESPACE_DNS, "The Expanse"), as calibre-web
+	// sends it, so both servers group the device's books the same way.
+	assert.Equal(t, "1923ad62-02da-3588-8aae-22b874c5e9bf", meta.Series.Id)
+
+	book.Series = nil
+	raw, err := json.Marshal(buildKoboMetadata(book, ""))
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), `"Series"`)
+}
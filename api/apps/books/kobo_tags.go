@@ -0,0 +1,344 @@
+package books
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/database"
+)
+
+// Kobo collections are "tags" in the store protocol. Shelves are sent to the
+// device as tags in the library sync, and the device's own collection edits
+// arrive on /v1/library/tags. Tag IDs we don't know are store collections
+// and go to the upstream store, like annotations for store books.
+
+const (
+	koboTagTypeUser     = "UserTag"
+	koboTagItemRevision = "ProductRevisionTag"
+)
+
+// --- JSON types for the Kobo store tags API ---
+
+type koboTag struct {
+	Created string `json:"Created"`
+	//nolint:revive // Kobo protocol field name
+	Id           string        `json:"Id"`
+	Items        []koboTagItem `json:"Items"`
+	LastModified string        `json:"LastModified"`
+	Name         string        `json:"Name"`
+	Type         string        `json:"Type"`
+}
+
+type koboTagItem struct {
+	//nolint:revive // Kobo protocol field name
+	RevisionId string `json:"RevisionId"`
+	Type       string `json:"Type"`
+}
+
+type koboTagRef struct {
+	//nolint:revive // Kobo protocol field name
+	Id           string `json:"Id"`
+	LastModified string `json:"LastModified"`
+}
+
+// koboNewTag and koboDeletedTag are the sync discriminators for tags, in
+// the same style as koboNewEntitlement.
+type koboNewTag struct {
+	NewTag struct {
+		Tag koboTag `json:"Tag"`
+	} `json:"NewTag"`
+}
+
+type koboDeletedTag struct {
+	DeletedTag struct {
+		Tag koboTagRef `json:"Tag"`
+	} `json:"DeletedTag"`
+}
+
+type koboTagRequest struct {
+	Name  *string       `json:"Name"`
+	Items []koboTagItem `json:"Items"`
+}
+
+// buildKoboTagEntries renders the user's collections for the sync response.
+// Items are limited to books in this sync, since the device drops a
+// collection entry that points at a book it doesn't have.
+func buildKoboTagEntries(
+	tags []models.KoboTag,
+	synced map[uuid.UUID]bool,
+) []json.RawMessage {
+	entries := make([]json.RawMessage, 0, len(tags))
+	for _, t := range tags {
+		modified := t.UpdatedAt.UTC().Format(time.RFC3339)
+		if t.DeletedAt != nil {
+			var entry koboDeletedTag
+			entry.DeletedTag.Tag = koboTagRef{Id: t.ID.String(), LastModified: modified}
+			// json.Marshal cannot fail on this fully-typed struct.
+			raw, _ := json.Marshal(entry)
+			entries = append(entries, raw)
+			continue
+		}
+
+		items := []koboTagItem{}
+		for _, id := range t.Items {
+			if synced[id] {
+				items = append(items, koboTagItem{
+					RevisionId: id.String(),
+					Type:       koboTagItemRevision,
+				})
+			}
+		}
+		var entry koboNewTag
+		entry.NewTag.Tag = koboTag{
+			Created:      t.CreatedAt.UTC().Format(time.RFC3339),
+			Id:           t.ID.String(),
+			Items:        items,
+			LastModified: modified,
+			Name:         t.Shelf,
+			Type:         koboTagTypeUser,
+		}
+		// json.Marshal cannot fail on this fully-typed struct.
+		raw, _ := json.Marshal(entry)
+		entries = append(entries, raw)
+	}
+	return entries
+}
+
+// decodeKoboTagRequest reads a tags API body, rejecting items of any type
+// other than book revisions.
+func decodeKoboTagRequest(r *http.Request) (koboTagRequest, bool) {
+	var body koboTagRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return body, false
+	}
+	for _, item := range body.Items {
+		if item.Type != koboTagItemRevision {
+			return body, false
+		}
+	}
+	return body, true
+}
+
+// koboOwnTag resolves {tagId} to one of the user's collections. Unknown IDs
+// are proxied upstream and ok is false, as it is when an error response was
+// written.
+func (app *Books) koboOwnTag(
+	w http.ResponseWriter,
+	r *http.Request,
+	userID string,
+) (models.KoboTag, bool) {
+	id, err := uuid.Parse(r.PathValue("tagId"))
+	if err != nil {
+		app.koboProxy(w, r)
+		return models.KoboTag{}, false
+	}
+
+	tag, err := app.Services.Books.GetKoboTag(r.Context(), userID, id)
+	if err != nil {
+		if errors.Is(err, database.ErrResourceNotFound) {
+			app.koboProxy(w, r)
+			return models.KoboTag{}, false
+		}
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return models.KoboTag{}, false
+	}
+	return *tag, true
+}
+
+// koboCreateTagHandler handles POST /v1/library/tags: a collection created
+// on the device becomes a shelf. The response is the new tag's ID as a bare
+// JSON string.
+func (app *Books) koboCreateTagHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := app.koboAuth(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := decodeKoboTagRequest(r)
+	if !ok || body.Name == nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	tag, err := app.Services.Books.CreateKoboTag(r.Context(), userID, *body.Name)
+	if err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	if err = app.koboShelveItems(r, userID, tag.Shelf, body.Items); err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(tag.ID.String())
+}
+
+// koboRenameTagHandler handles PUT /v1/library/tags/{tagId}. It is mounted
+// as /v1/library/{parent}/{tagId} because a literal "tags" segment would
+// collide with PUT /v1/library/{revisionId}/state in ServeMux; any other
+// parent is not ours and is proxied.
+func (app *Books) koboRenameTagHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := app.koboAuth(w, r)
+	if !ok {
+		return
+	}
+	if r.PathValue("parent") != "tags" {
+		app.koboProxy(w, r)
+		return
+	}
+	tag, ok := app.koboOwnTag(w, r, userID)
+	if !ok {
+		return
+	}
+
+	body, ok := decodeKoboTagRequest(r)
+	if !ok || body.Name == nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	if err := app.Services.Books.RenameKoboTag(
+		r.Context(), userID, tag, *body.Name,
+	); err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// koboDeleteTagHandler handles DELETE /v1/library/tags/{tagId}.
+func (app *Books) koboDeleteTagHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := app.koboAuth(w, r)
+	if !ok {
+		return
+	}
+	tag, ok := app.koboOwnTag(w, r, userID)
+	if !ok {
+		return
+	}
+
+	if err := app.Services.Books.DeleteKoboTag(r.Context(), userID, tag); err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// koboAddTagItemsHandler handles POST /v1/library/tags/{tagId}/items: the
+// books move onto the collection's shelf.
+func (app *Books) koboAddTagItemsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := app.koboAuth(w, r)
+	if !ok {
+		return
+	}
+	tag, ok := app.koboOwnTag(w, r, userID)
+	if !ok {
+		return
+	}
+
+	body, ok := decodeKoboTagRequest(r)
+	if !ok {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	if err := app.koboShelveItems(r, userID, tag.Shelf, body.Items); err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// koboRemoveTagItemsHandler handles POST
+// /v1/library/tags/{tagId}/items/delete: books still on the collection's
+// shelf move back to to-read, the same place DeleteShelf sends them.
+func (app *Books) koboRemoveTagItemsHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	userID, ok := app.koboAuth(w, r)
+	if !ok {
+		return
+	}
+	tag, ok := app.koboOwnTag(w, r, userID)
+	if !ok {
+		return
+	}
+
+	body, ok := decodeKoboTagRequest(r)
+	if !ok {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	for _, item := range body.Items {
+		bookID, err := uuid.Parse(item.RevisionId)
+		if err != nil {
+			continue
+		}
+		err = app.koboMoveToShelf(r, userID, bookID, tag.Shelf, models.StatusToRead)
+		if err != nil {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// koboShelveItems moves every book in items onto shelf. Store books and IDs
+// outside the user's library are skipped.
+func (app *Books) koboShelveItems(
+	r *http.Request,
+	userID, shelf string,
+	items []koboTagItem,
+) error {
+	movedToRead := false
+	for _, item := range items {
+		bookID, err := uuid.Parse(item.RevisionId)
+		if err != nil {
+			continue
+		}
+		if err = app.koboMoveToShelf(r, userID, bookID, "", shelf); err != nil {
+			return err
+		}
+		movedToRead = movedToRead || shelf == models.StatusRead
+	}
+	if movedToRead {
+		return app.rebuildReadProgress(r.Context(), userID)
+	}
+	return nil
+}
+
+// koboMoveToShelf sets a book's status to shelf. When from is non-empty the
+// book only moves if it is currently on from. Read dates are kept, and one
+// is added when the book lands on read, as UpdateBookStatus does.
+func (app *Books) koboMoveToShelf(
+	r *http.Request,
+	userID string,
+	bookID uuid.UUID,
+	from, shelf string,
+) error {
+	ub, err := app.Services.Books.GetUserBook(r.Context(), userID, bookID)
+	if errors.Is(err, database.ErrResourceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if ub.Status == shelf || (from != "" && ub.Status != from) {
+		return nil
+	}
+
+	if shelf == models.StatusRead {
+		ub.FinishedAt = buildFinishedAt(ub, shelf)
+	}
+	ub.Status = shelf
+	if ub.ShelfPositions == nil {
+		ub.ShelfPositions = map[string]int{}
+	}
+	return app.Services.Books.UpdateStatus(r.Context(), userID, *ub)
+}
@@ -0,0 +1,194 @@
+package books
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/communication/httptools"
+	"tools.xdoubleu.com/internal/database"
+)
+
+// kosync error codes, as defined by koreader-sync-server. KOReader shows a
+// message per code, so the codes matter more than the text.
+const (
+	kosyncErrUnauthorized         = 2001
+	kosyncErrInvalidFields        = 2003
+	kosyncErrDocumentMissing      = 2004
+	kosyncErrRegistrationDisabled = 2005
+)
+
+// koreaderRoutes mounts a KOReader progress sync server (the kosync protocol
+// spoken by KOReader's "Progress sync" plugin) under /{prefix}/kosync, the
+// custom sync server URL users enter in KOReader.
+//
+// KOReader authenticates every request with x-auth-user and x-auth-key (the
+// MD5 of the password); credentials are created in the web app, so the
+// protocol's own registration endpoint is disabled.
+func (app *Books) koreaderRoutes(prefix string, mux *http.ServeMux) {
+	base := "/" + prefix + "/kosync"
+	mux.HandleFunc("GET "+base+"/healthcheck", app.kosyncHealthcheckHandler)
+	mux.HandleFunc("POST "+base+"/users/create", app.kosyncCreateUserHandler)
+	mux.HandleFunc("GET "+base+"/users/auth", app.kosyncAuthHandler)
+	mux.HandleFunc("PUT "+base+"/syncs/progress", app.kosyncPutProgressHandler)
+	mux.HandleFunc(
+		"GET "+base+"/syncs/progress/{document}", app.kosyncGetProgressHandler,
+	)
+}
+
+type kosyncError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// kosyncProgress is the progress document exchanged with KOReader.
+// Percentage is a fraction between 0 and 1.
+type kosyncProgress struct {
+	Document   string  `json:"document"`
+	Progress   string  `json:"progress"`
+	Percentage float64 `json:"percentage"`
+	Device     string  `json:"device"`
+	DeviceID   string  `json:"device_id"`
+	Timestamp  int64   `json:"timestamp"`
+}
+
+func kosyncWriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func kosyncWriteError(w http.ResponseWriter, status, code int, message string) {
+	kosyncWriteJSON(w, status, kosyncError{Code: code, Message: message})
+}
+
+// kosyncAuth validates HTTPS and the KOReader credential headers, like
+// koboAuth does for the device token. Returns (userID, true) on success;
+// writes an error response and returns ("", false) on failure.
+func (app *Books) kosyncAuth(w http.ResponseWriter, r *http.Request) (string, bool) {
+	if !httptools.RequireHTTPS(w, r) {
+		return "", false
+	}
+
+	userID, err := app.Services.KOReader.Authenticate(
+		r.Context(), r.Header.Get("x-auth-user"), r.Header.Get("x-auth-key"),
+	)
+	if err != nil {
+		if errors.Is(err, database.ErrResourceNotFound) {
+			kosyncWriteError(
+				w, http.StatusUnauthorized, kosyncErrUnauthorized, "Unauthorized",
+			)
+			return "", false
+		}
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return "", false
+	}
+	return userID, true
+}
+
+// kosyncHealthcheckHandler handles GET /healthcheck.
+func (app *Books) kosyncHealthcheckHandler(w http.ResponseWriter, _ *http.Request) {
+	kosyncWriteJSON(w, http.StatusOK, map[string]string{"state": "OK"})
+}
+
+// kosyncCreateUserHandler handles POST /users/create, KOReader's "Register"
+// button. Accounts are created from the books settings page instead.
+func (app *Books) kosyncCreateUserHandler(w http.ResponseWriter, r *http.Request) {
+	if !httptools.RequireHTTPS(w, r) {
+		return
+	}
+	kosyncWriteError(
+		w, http.StatusPaymentRequired, kosyncErrRegistrationDisabled,
+		"Create KOReader credentials in the books settings instead.",
+	)
+}
+
+// kosyncAuthHandler handles GET /users/auth, KOReader's "Login" check.
+func (app *Books) kosyncAuthHandler(w http.ResponseWriter, r *http.Request) {
+	if _, ok := app.kosyncAuth(w, r); !ok {
+		return
+	}
+	kosyncWriteJSON(w, http.StatusOK, map[string]string{"authorized": "OK"})
+}
+
+// kosyncPutProgressHandler handles PUT /syncs/progress.
+func (app *Books) kosyncPutProgressHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := app.kosyncAuth(w, r)
+	if !ok {
+		return
+	}
+
+	var body kosyncProgress
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		kosyncWriteError(
+			w, http.StatusForbidden, kosyncErrInvalidFields, "Invalid request",
+		)
+		return
+	}
+	if body.Document == "" {
+		kosyncWriteError(
+			w, http.StatusForbidden, kosyncErrDocumentMissing,
+			"Field 'document' not provided.",
+		)
+		return
+	}
+	if body.Percentage < 0 || body.Percentage > 1 {
+		kosyncWriteError(
+			w, http.StatusForbidden, kosyncErrInvalidFields, "Invalid request",
+		)
+		return
+	}
+
+	saved, err := app.Services.KOReader.PushProgress(
+		r.Context(),
+		models.KOSyncProgress{ //nolint:exhaustruct //BookID, UpdatedAt set by service
+			UserID:     userID,
+			Document:   body.Document,
+			Progress:   body.Progress,
+			Percentage: body.Percentage,
+			Device:     body.Device,
+			DeviceID:   body.DeviceID,
+		},
+	)
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	kosyncWriteJSON(w, http.StatusOK, map[string]any{
+		"document":  saved.Document,
+		"timestamp": saved.UpdatedAt.Unix(),
+	})
+}
+
+// kosyncGetProgressHandler handles GET /syncs/progress/{document}. A
+// document nobody has pushed yet gets an empty object, which KOReader reads
+// as "no progress on the server".
+func (app *Books) kosyncGetProgressHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := app.kosyncAuth(w, r)
+	if !ok {
+		return
+	}
+
+	p, err := app.Services.KOReader.GetProgress(
+		r.Context(), userID, r.PathValue("document"),
+	)
+	if err != nil {
+		if errors.Is(err, database.ErrResourceNotFound) {
+			kosyncWriteJSON(w, http.StatusOK, struct{}{})
+			return
+		}
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	kosyncWriteJSON(w, http.StatusOK, kosyncProgress{
+		Document:   p.Document,
+		Progress:   p.Progress,
+		Percentage: p.Percentage,
+		Device:     p.Device,
+		DeviceID:   p.DeviceID,
+		Timestamp:  p.UpdatedAt.Unix(),
+	})
+}
@@ -0,0 +1,36 @@
+-- Highlights, notes and bookmarks pushed by a Kobo's reading services
+-- client (PATCH /api/v3/content/{id}/annotations). The id is the device's
+-- own annotation UUID so repeated PATCHes of the same highlight upsert
+-- instead of duplicating. location keeps the raw Kobo span JSON so the
+-- device gets back exactly what it sent on the next GET.
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE books.annotations (
+    id UUID PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    book_id UUID NOT NULL REFERENCES books.books (id) ON DELETE CASCADE,
+    type TEXT NOT NULL CHECK (type IN ('highlight', 'note', 'dogear')),
+    highlighted_text TEXT NOT NULL DEFAULT '',
+    note_text TEXT NOT NULL DEFAULT '',
+    highlight_color TEXT NOT NULL DEFAULT '',
+    chapter_title TEXT NOT NULL DEFAULT '',
+    chapter_progress DOUBLE PRECISION NOT NULL DEFAULT 0,
+    location JSONB,
+    source TEXT NOT NULL DEFAULT 'kobo',
+    client_modified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_annotations_user_book ON books.annotations (user_id, book_id);
+
+CREATE TRIGGER trg_annotations_updated_at
+BEFORE UPDATE ON books.annotations
+FOR EACH ROW EXECUTE FUNCTION books.set_updated_at();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE books.annotations;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- Individual reading sessions (start/end, seconds actually read) per book.
+-- The first writer is the kobo-gateway import of KoboReader.sqlite, which
+-- turns the device's LeaveContent analytics events into sessions; rows are
+-- keyed on (user, book, source, started_at) so re-importing the same device
+-- database is idempotent. estimated marks the single catch-all row that
+-- accounts for device reading time no individual session explains (Kobo
+-- purges its analytics after uploading them to the store).
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE books.reading_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id TEXT NOT NULL,
+    book_id UUID NOT NULL REFERENCES books.books (id) ON DELETE CASCADE,
+    source TEXT NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ NOT NULL,
+    seconds INTEGER NOT NULL CHECK (seconds >= 0),
+    pages_turned INTEGER NOT NULL DEFAULT 0,
+    estimated BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (user_id, book_id, source, started_at)
+);
+
+CREATE INDEX idx_reading_sessions_user_started ON books.reading_sessions (
+    user_id, started_at
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE books.reading_sessions;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- Kobo collections ("tags" in the store protocol) mirroring the user's
+-- shelves. The id is what the device knows the collection by, so it must
+-- survive renames; that is why this is a separate table rather than a column
+-- on books.shelves, whose rows are replaced on rename. items holds the book
+-- IDs last sent to the device, so updated_at only moves when membership or
+-- the name actually changes. Deleted collections keep their row as a
+-- tombstone (deleted_at) so the deletion still reaches the device.
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE books.kobo_tags (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id TEXT NOT NULL,
+    shelf TEXT NOT NULL,
+    items UUID [] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_kobo_tags_user_shelf
+ON books.kobo_tags (user_id, shelf)
+WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE books.kobo_tags;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- Per-device Kobo sync filters. sync_shelves and sync_tags narrow the
+-- kobo-sync books a device receives; both empty means the whole library.
+--
+-- kobo_device_books records which of our books each device was last sent, so
+-- a book that falls out of the device's filter (or out of kobo-sync) can be
+-- archived on that device rather than lingering there. A row stays behind
+-- with archived_at set for as long as the book is excluded, the same way
+-- kobo_removals keeps repeating a removal; it is cleared again if the book
+-- comes back. No FK to books.books, for the reason given in kobo_removals.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE books.kobo_devices
+ADD COLUMN sync_shelves TEXT [] NOT NULL DEFAULT '{}',
+ADD COLUMN sync_tags TEXT [] NOT NULL DEFAULT '{}';
+
+CREATE TABLE books.kobo_device_books (
+    device_id UUID NOT NULL REFERENCES books.kobo_devices (id) ON DELETE CASCADE,
+    book_id UUID NOT NULL,
+    synced_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    archived_at TIMESTAMPTZ,
+    PRIMARY KEY (device_id, book_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE books.kobo_device_books;
+
+ALTER TABLE books.kobo_devices
+DROP COLUMN sync_shelves,
+DROP COLUMN sync_tags;
+-- +goose StatementEnd
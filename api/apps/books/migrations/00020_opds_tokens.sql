@@ -0,0 +1,22 @@
+-- Bearer tokens for the OPDS catalog. Like kobo_devices, only the SHA-256
+-- hash of a token is stored; the raw token is shown once when it is created
+-- and then lives in the reader app's catalog URL.
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE books.opds_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_used_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_opds_tokens_user_id ON books.opds_tokens (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE books.opds_tokens;
+-- +goose StatementEnd
@@ -0,0 +1,70 @@
+-- KOReader progress sync (the kosync protocol).
+--
+-- koreader_devices holds the credentials a KOReader install logs in with.
+-- KOReader only ever sends the MD5 of the password (x-auth-key), so key_hash
+-- is the SHA-256 of that MD5 — the password itself is never stored.
+--
+-- KOReader identifies books by a partial MD5 of the file ("document"), so
+-- book_files gains that digest, filled in lazily the first time a device asks
+-- about a document we can't place. kosync_progress keeps the position exactly
+-- as KOReader sent it, keyed by document, so devices can pull it back even
+-- for files that don't match one of our books; book_id is set when they do.
+-- Matched positions are also written to book_reading_state with the new
+-- 'koreader' source.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE books.book_reading_state
+DROP CONSTRAINT IF EXISTS book_reading_state_source_check;
+
+ALTER TABLE books.book_reading_state
+ADD CONSTRAINT book_reading_state_source_check
+CHECK (source IN ('web', 'kobo', 'manual', 'koreader'));
+
+ALTER TABLE books.book_files ADD COLUMN koreader_digest TEXT;
+
+CREATE INDEX idx_book_files_koreader_digest
+ON books.book_files (user_id, koreader_digest);
+
+CREATE TABLE books.koreader_devices (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id TEXT NOT NULL,
+    username TEXT NOT NULL,
+    key_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_used_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_koreader_devices_user_id ON books.koreader_devices (user_id);
+
+CREATE TABLE books.kosync_progress (
+    user_id TEXT NOT NULL,
+    document TEXT NOT NULL,
+    progress TEXT NOT NULL,
+    percentage DOUBLE PRECISION NOT NULL,
+    device TEXT NOT NULL,
+    device_id TEXT NOT NULL,
+    book_id UUID,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, document)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE books.kosync_progress;
+DROP TABLE books.koreader_devices;
+
+DROP INDEX books.idx_book_files_koreader_digest;
+ALTER TABLE books.book_files DROP COLUMN koreader_digest;
+
+UPDATE books.book_reading_state SET source = 'manual'
+WHERE source = 'koreader';
+
+ALTER TABLE books.book_reading_state
+DROP CONSTRAINT book_reading_state_source_check;
+
+ALTER TABLE books.book_reading_state
+ADD CONSTRAINT book_reading_state_source_check
+CHECK (source IN ('web', 'kobo', 'manual'));
+-- +goose StatementEnd
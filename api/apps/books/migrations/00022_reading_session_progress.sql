@@ -0,0 +1,25 @@
+-- Reading sessions are now also derived live from progress updates (web
+-- reader, KOReader, Kobo state syncs) and from the Kobo's analytics upload,
+-- not only from kobo-gateway imports. start_percent/end_percent record how
+-- far a session moved through the book, which the reading-speed and
+-- finish-date estimates are built on; NULL when the source didn't say.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE books.reading_sessions
+ADD COLUMN start_percent SMALLINT CHECK (start_percent BETWEEN 0 AND 100),
+ADD COLUMN end_percent SMALLINT CHECK (end_percent BETWEEN 0 AND 100);
+
+CREATE INDEX idx_reading_sessions_user_book_ended ON books.reading_sessions (
+    user_id, book_id, source, ended_at DESC
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX books.idx_reading_sessions_user_book_ended;
+
+ALTER TABLE books.reading_sessions
+DROP COLUMN start_percent,
+DROP COLUMN end_percent;
+-- +goose StatementEnd
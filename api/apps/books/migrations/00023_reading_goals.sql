@@ -0,0 +1,23 @@
+-- Yearly reading goals: a target number of books or pages to finish in a
+-- calendar year, at most one of each kind per year. Progress is derived
+-- from user_books.finished_at, so nothing else needs storing. public opts a
+-- goal into the shared reading dashboard.
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE books.reading_goals (
+    user_id TEXT NOT NULL,
+    year INTEGER NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('books', 'pages')),
+    target INTEGER NOT NULL CHECK (target > 0),
+    public BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, year, kind)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE books.reading_goals;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- Comic book archives (CBZ) are stored as their own book_files format and
+-- converted to a fixed-layout KEPUB like EPUBs and PDFs are.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE books.book_files DROP CONSTRAINT book_files_format_check;
+ALTER TABLE books.book_files ADD CONSTRAINT book_files_format_check
+CHECK (format IN ('pdf', 'epub', 'kepub', 'cbz'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM books.book_files WHERE format = 'cbz';
+ALTER TABLE books.book_files DROP CONSTRAINT book_files_format_check;
+ALTER TABLE books.book_files ADD CONSTRAINT book_files_format_check
+CHECK (format IN ('pdf', 'epub', 'kepub'));
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- EPUB and KEPUB files are served with their OPF metadata and cover rewritten
+-- from the catalog. The rewritten copy lives at rendered_storage_key; its
+-- rendered_meta_hash covers the catalog fields, the cover and the writer
+-- version it was built from, so a mismatch means it is stale.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE books.book_files
+ADD COLUMN rendered_storage_key TEXT,
+ADD COLUMN rendered_meta_hash TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE books.book_files
+DROP COLUMN rendered_storage_key,
+DROP COLUMN rendered_meta_hash;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- Books can belong to a series: its name, the book's position in it (which
+-- can be fractional for novellas, e.g. 2.5, or 0 for a prequel) and, when a
+-- source knows it, how many books the series has.
+--
+-- Existing books whose title carries a Goodreads-style "(Series, #2)"
+-- annotation are backfilled from it, the same way seriesFromTitle fills new
+-- ones.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE books.books
+ADD COLUMN series TEXT,
+ADD COLUMN series_position DOUBLE PRECISION CHECK (series_position >= 0),
+ADD COLUMN series_total INTEGER CHECK (series_total > 0);
+
+UPDATE books.books b
+SET series = btrim(p.m[1], ' ,'),
+    series_position = p.m[2]::DOUBLE PRECISION
+FROM (
+    SELECT id, regexp_match(title, '\(([^()#]+)#(\d+(?:\.\d+)?)\)\s*$') AS m
+    FROM books.books
+    WHERE source_url IS NULL
+) p
+WHERE b.id = p.id AND p.m IS NOT NULL AND btrim(p.m[1], ' ,') <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE books.books
+DROP COLUMN series,
+DROP COLUMN series_position,
+DROP COLUMN series_total;
+-- +goose StatementEnd
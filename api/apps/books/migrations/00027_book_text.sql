@@ -0,0 +1,42 @@
+-- Full-text search inside books: the text of uploaded EPUBs, of PDFs (as
+-- their EPUB conversion extracts it) and of ingested articles, split into
+-- chunks so a hit can point at the chapter it is in. File chunks belong to
+-- one user's book_files row; article chunks (book_file_id NULL) to the
+-- catalog book, like content_html itself.
+--
+-- The 'simple' configuration neither stems nor drops stop words, so books
+-- in any language index the same way.
+--
+-- text_indexed_at marks what the indexing job has processed; clearing it
+-- (as SetBookContentHTML does) queues a re-index.
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE books.book_text_chunks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    book_id UUID NOT NULL REFERENCES books.books (id) ON DELETE CASCADE,
+    book_file_id UUID REFERENCES books.book_files (id) ON DELETE CASCADE,
+    seq INTEGER NOT NULL,
+    chapter TEXT NOT NULL DEFAULT '',
+    location TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', body)) STORED
+);
+
+CREATE INDEX book_text_chunks_tsv_idx
+ON books.book_text_chunks USING gin (tsv);
+CREATE INDEX book_text_chunks_file_idx
+ON books.book_text_chunks (book_file_id, seq);
+CREATE INDEX book_text_chunks_article_idx
+ON books.book_text_chunks (book_id, seq) WHERE book_file_id IS NULL;
+
+ALTER TABLE books.book_files ADD COLUMN text_indexed_at TIMESTAMPTZ;
+ALTER TABLE books.books ADD COLUMN text_indexed_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE books.books DROP COLUMN text_indexed_at;
+ALTER TABLE books.book_files DROP COLUMN text_indexed_at;
+DROP TABLE books.book_text_chunks;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- Daily feeds digests: an EPUB of the user's unread and bookmarked feed
+-- items, added to the library with Kobo sync on. The items themselves live
+-- in the feeds app's schema; this only records which digest carries which
+-- item, so no item goes into two digests and reading progress on the
+-- digest can mark them read back in feeds.
+--
+-- start_percent is where an item's chapter begins, as a whole percentage
+-- of the digest, which is what reading progress is synced as.
+-- marked_read_at is set once the item has been marked read in feeds.
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE books.feed_digests (
+    book_id UUID PRIMARY KEY REFERENCES books.books (id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    digest_date DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (user_id, digest_date)
+);
+
+CREATE TABLE books.feed_digest_items (
+    book_id UUID NOT NULL
+    REFERENCES books.feed_digests (book_id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    item_id UUID NOT NULL,
+    href TEXT NOT NULL,
+    start_percent SMALLINT NOT NULL,
+    marked_read_at TIMESTAMPTZ,
+    PRIMARY KEY (book_id, item_id),
+    UNIQUE (user_id, item_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE books.feed_digest_items;
+DROP TABLE books.feed_digests;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- Feed items sent to the library as articles. The item lives in the feeds
+-- app's schema; feed_item_books links the library entry back to it, so
+-- reading progress on the entry can be passed on to the item. An entry
+-- (keyed by the item's source URL) can be linked to more than one item
+-- when two feeds carry the same article.
+--
+-- Progress passed the other way, from the feeds reader, is saved with the
+-- new 'feeds' reading source.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE books.book_reading_state
+DROP CONSTRAINT book_reading_state_source_check;
+
+ALTER TABLE books.book_reading_state
+ADD CONSTRAINT book_reading_state_source_check
+CHECK (source IN ('web', 'kobo', 'manual', 'koreader', 'feeds'));
+
+CREATE TABLE books.feed_item_books (
+    user_id TEXT NOT NULL,
+    item_id UUID NOT NULL,
+    book_id UUID NOT NULL REFERENCES books.books (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, item_id)
+);
+
+CREATE INDEX idx_feed_item_books_book
+ON books.feed_item_books (user_id, book_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE books.feed_item_books;
+
+UPDATE books.book_reading_state SET source = 'web'
+WHERE source = 'feeds';
+
+ALTER TABLE books.book_reading_state
+DROP CONSTRAINT book_reading_state_source_check;
+
+ALTER TABLE books.book_reading_state
+ADD CONSTRAINT book_reading_state_source_check
+CHECK (source IN ('web', 'kobo', 'manual', 'koreader'));
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- The "up next" reading queue: an explicit order over some of the user's
+-- to-read books. queue_position is NULL for books that aren't queued; it is
+-- only meaningful while the book is on to-read and is cleared when it
+-- leaves.
+--
+-- reading_queue_settings holds the per-user queue rules: auto_advance moves
+-- the first queued book to currently-reading when the user finishes a book
+-- they were reading, and kobo_sync_count is how many books from the top of
+-- the queue sync to Kobo without the kobo-sync tag. A user without a row has
+-- both off.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE books.user_books
+ADD COLUMN queue_position INTEGER CHECK (queue_position >= 0);
+
+CREATE INDEX idx_user_books_queue
+ON books.user_books (user_id, queue_position)
+WHERE queue_position IS NOT NULL;
+
+CREATE TABLE books.reading_queue_settings (
+    user_id TEXT PRIMARY KEY,
+    auto_advance BOOLEAN NOT NULL DEFAULT FALSE,
+    kobo_sync_count INTEGER NOT NULL DEFAULT 0 CHECK (kobo_sync_count >= 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE books.reading_queue_settings;
+
+ALTER TABLE books.user_books
+DROP COLUMN queue_position;
+-- +goose StatementEnd
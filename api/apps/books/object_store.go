@@ -0,0 +1,103 @@
+package books
+
+import (
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"tools.xdoubleu.com/apps/books/pkg/objectstore"
+	"tools.xdoubleu.com/internal/config"
+)
+
+// objectsPath is where the fs backend's signed URLs are served, under the
+// app prefix ("books", see GetName).
+const objectsPath = "/api/objects"
+
+// objectTransferTimeout replaces the server's short global read/write
+// timeouts (cmd/api/main.go) for fs-backend transfers: a book upload or
+// download through a signed URL streams the whole file through the api, as
+// it would to or from R2.
+const objectTransferTimeout = 30 * time.Minute
+
+// newObjectStore builds the object store cfg.ObjectStoreBackend selects.
+// Missing credentials only warn — uploads then fail at request time, as
+// they always have for a half-configured R2.
+func newObjectStore(logger *slog.Logger, cfg config.Config) objectstore.Client {
+	switch cfg.ObjectStoreBackend {
+	case config.ObjectStoreS3:
+		if cfg.S3Endpoint == "" || cfg.S3AccessKeyID == "" ||
+			cfg.S3SecretKey == "" || cfg.S3Bucket == "" {
+			logger.Warn(
+				"S3 object store is not fully configured — book file uploads will fail;" +
+					" set S3_ENDPOINT, S3_ACCESS_KEY_ID, S3_SECRET_ACCESS_KEY, S3_BUCKET",
+			)
+		}
+		return objectstore.NewS3(
+			cfg.S3Endpoint,
+			cfg.S3Region,
+			cfg.S3AccessKeyID,
+			cfg.S3SecretKey,
+			cfg.S3Bucket,
+		)
+
+	case config.ObjectStoreFS:
+		secret := []byte(cfg.ObjectStoreSigningKey)
+		if len(secret) == 0 {
+			logger.Warn(
+				"OBJECT_STORE_SIGNING_KEY is not set — using a random key, so file" +
+					" URLs stop working on restart and across replicas",
+			)
+			secret = []byte(rand.Text())
+		}
+		client, err := objectstore.NewFS(
+			cfg.ObjectStoreDir,
+			strings.TrimSuffix(cfg.APIURL, "/")+"/books"+objectsPath,
+			secret,
+		)
+		if err != nil {
+			panic(fmt.Sprintf("can't open OBJECT_STORE_DIR: %v", err))
+		}
+		return client
+
+	default:
+		if cfg.R2AccountID == "" || cfg.R2AccessKeyID == "" ||
+			cfg.R2SecretKey == "" || cfg.R2Bucket == "" {
+			logger.Warn(
+				"R2 object store is not fully configured — book file uploads will fail;" +
+					" set R2_ACCOUNT_ID, R2_ACCESS_KEY_ID, R2_SECRET_ACCESS_KEY, R2_BUCKET",
+			)
+		}
+		return objectstore.NewR2(
+			"https://"+cfg.R2AccountID+".r2.cloudflarestorage.com",
+			cfg.R2AccessKeyID,
+			cfg.R2SecretKey,
+			cfg.R2Bucket,
+		)
+	}
+}
+
+// objectRoutes mounts the signed-URL endpoint for object stores that serve
+// their own presigned URLs (the fs backend). R2 and S3 URLs point at the
+// store itself, so nothing is mounted for them. No auth: the signature is
+// the authorisation, as it is for a presigned R2 URL.
+func (a *Books) objectRoutes(prefix string, mux *http.ServeMux) {
+	handler, ok := a.clients.ObjectStore.(http.Handler)
+	if !ok {
+		return
+	}
+
+	mountPath := "/" + prefix + objectsPath
+	stripped := http.StripPrefix(mountPath, handler)
+	mux.HandleFunc(mountPath+"/", func(w http.ResponseWriter, r *http.Request) {
+		rc := http.NewResponseController(w)
+		deadline := time.Now().Add(objectTransferTimeout)
+		// Not all ResponseWriters support deadlines (e.g. in tests using
+		// httptest.ResponseRecorder) — fall back to the ambient ones.
+		_ = rc.SetReadDeadline(deadline)
+		_ = rc.SetWriteDeadline(deadline)
+		stripped.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,78 @@
+package books_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books"
+	"tools.xdoubleu.com/apps/books/internal/mocks"
+	"tools.xdoubleu.com/apps/books/pkg/objectstore"
+	"tools.xdoubleu.com/internal/logging"
+	sharedmocks "tools.xdoubleu.com/internal/mocks"
+	"tools.xdoubleu.com/internal/testhelper"
+)
+
+// TestObjectRoutes_FSBackendServesSignedURLs runs the browser's upload
+// round trip against the fs backend: the presigned PUT URL CreateUpload
+// hands out is served by the api itself, as is the GET URL for the stored
+// bytes.
+func TestObjectRoutes_FSBackendServesSignedURLs(t *testing.T) {
+	store, err := objectstore.NewFS(
+		t.TempDir(),
+		"https://api.example.com/books/api/objects",
+		[]byte("test-secret"),
+	)
+	require.NoError(t, err)
+
+	app := books.NewInner(
+		sharedmocks.NewMockedAuthService(userID),
+		logging.NewNopLogger(),
+		testCfg,
+		testDB,
+		books.Clients{
+			UniCat:                 nil,
+			Hardcover:              mocks.NewMockHardcoverClient(),
+			ObjectStore:            store,
+			WebFetch:               nil,
+			KoboStoreBaseURL:       "",
+			KoboReadingServicesURL: "",
+			PublicAPIBaseURL:       "",
+		},
+	)
+	mux := testhelper.BuildMux(app)
+
+	uploadID, putURL, exists, err := app.Services.Books.CreateUpload(
+		t.Context(), userID, "book.epub", "application/epub+zip", 9, "",
+	)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	u, err := url.Parse(putURL)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPut, u.RequestURI(), strings.NewReader("epub data"))
+	req.Header.Set("Content-Type", "application/epub+zip")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	getURL, err := store.PresignGet(t.Context(), uploadID, time.Minute)
+	require.NoError(t, err)
+	u, err = url.Parse(getURL)
+	require.NoError(t, err)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "epub data", rec.Body.String())
+
+	// Without a valid signature nothing is served.
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, u.Path, nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
@@ -0,0 +1,363 @@
+package books
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"time"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// An opdsCatalog is one page of the catalog, independent of the OPDS version
+// it is rendered in: either a navigation feed (Nav) or an acquisition feed
+// (Books). Hrefs are relative to the version root, e.g. "/shelves/read".
+type opdsCatalog struct {
+	ID    string
+	Title string
+	Self  string
+	Next  string // empty on the last page
+	Nav   []opdsNavItem
+	Books []models.UserBook
+	// Acquisition is set for book lists, so an empty one still renders as
+	// an acquisition feed.
+	Acquisition bool
+}
+
+type opdsNavItem struct {
+	Title string
+	Href  string
+	// Acquisition is set when the target is a book list rather than another
+	// navigation feed.
+	Acquisition bool
+}
+
+// opdsLinks are the absolute URL prefixes the renderers build links from.
+type opdsLinks struct {
+	Root    string // the version root, e.g. https://host/books/opds/{token}/v2
+	Token   string // version-independent routes, e.g. downloads
+	Covers  string // the public cover route
+	Updated string
+}
+
+const (
+	opdsRelAcquisition = "http://opds-spec.org/acquisition"
+	opdsRelImage       = "http://opds-spec.org/image"
+	opdsRelThumbnail   = "http://opds-spec.org/image/thumbnail"
+	opdsUUIDPrefix     = "urn:uuid:"
+
+	opds1Navigation  = "application/atom+xml;profile=opds-catalog;kind=navigation"
+	opds1Acquisition = "application/atom+xml;profile=opds-catalog;kind=acquisition"
+	opds1Search      = "application/opensearchdescription+xml"
+	opds2Type        = "application/opds+json"
+)
+
+// opdsFileType returns the media type of a stored file format.
+func opdsFileType(format string) string {
+	switch format {
+	case models.FileFormatEPUB:
+		return "application/epub+zip"
+	case models.FileFormatKEPUB:
+		return "application/kepub+zip"
+	case models.FileFormatPDF:
+		return "application/pdf"
+	case models.FileFormatCBZ:
+		return "application/vnd.comicbook+zip"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+func (l opdsLinks) download(ub models.UserBook, format string) string {
+	return l.Token + "/download/" + ub.BookID.String() + "/" + format
+}
+
+// cover returns the cover URL of a book, or "" when it has none.
+func (l opdsLinks) cover(ub models.UserBook) string {
+	if ub.Book == nil || ub.Book.CoverURL == nil {
+		return ""
+	}
+	return l.Covers + "/" + ub.BookID.String()
+}
+
+func opdsBookTitle(ub models.UserBook) (string, []string) {
+	if ub.Book == nil {
+		return "", nil
+	}
+	return ub.Book.Title, ub.Book.Authors
+}
+
+// --- OPDS 1.2 (Atom) ---
+
+type opds1Feed struct {
+	XMLName   xml.Name     `xml:"feed"`
+	Xmlns     string       `xml:"xmlns,attr"`
+	XmlnsDC   string       `xml:"xmlns:dc,attr"`
+	XmlnsOPDS string       `xml:"xmlns:opds,attr"`
+	ID        string       `xml:"id"`
+	Title     string       `xml:"title"`
+	Updated   string       `xml:"updated"`
+	Links     []opds1Link  `xml:"link"`
+	Entries   []opds1Entry `xml:"entry"`
+}
+
+type opds1Link struct {
+	Rel   string `xml:"rel,attr,omitempty"`
+	Href  string `xml:"href,attr"`
+	Type  string `xml:"type,attr,omitempty"`
+	Title string `xml:"title,attr,omitempty"`
+}
+
+type opds1Author struct {
+	Name string `xml:"name"`
+}
+
+type opds1Entry struct {
+	ID         string        `xml:"id"`
+	Title      string        `xml:"title"`
+	Updated    string        `xml:"updated"`
+	Authors    []opds1Author `xml:"author"`
+	Identifier []string      `xml:"dc:identifier"`
+	Summary    string        `xml:"summary,omitempty"`
+	Links      []opds1Link   `xml:"link"`
+}
+
+func renderOPDS1(w http.ResponseWriter, links opdsLinks, c opdsCatalog) {
+	kind := opds1Navigation
+	if c.Acquisition {
+		kind = opds1Acquisition
+	}
+	feed := opds1Feed{
+		XMLName:   xml.Name{Space: "", Local: "feed"},
+		Xmlns:     "http://www.w3.org/2005/Atom",
+		XmlnsDC:   "http://purl.org/dc/terms/",
+		XmlnsOPDS: "http://opds-spec.org/2010/catalog",
+		ID:        c.ID,
+		Title:     c.Title,
+		Updated:   links.Updated,
+		Links: []opds1Link{
+			{Rel: "self", Href: links.Root + c.Self, Type: kind, Title: ""},
+			{Rel: "start", Href: links.Root, Type: opds1Navigation, Title: ""},
+			{
+				Rel:   "search",
+				Href:  links.Root + "/opensearch.xml",
+				Type:  opds1Search,
+				Title: "Search",
+			},
+		},
+		Entries: make([]opds1Entry, 0, len(c.Nav)+len(c.Books)),
+	}
+	if c.Next != "" {
+		feed.Links = append(feed.Links, opds1Link{
+			Rel: "next", Href: links.Root + c.Next, Type: kind, Title: "",
+		})
+	}
+
+	for _, n := range c.Nav {
+		target := opds1Navigation
+		if n.Acquisition {
+			target = opds1Acquisition
+		}
+		feed.Entries = append(feed.Entries, opds1Entry{
+			ID:         c.ID + n.Href,
+			Title:      n.Title,
+			Updated:    links.Updated,
+			Authors:    nil,
+			Identifier: nil,
+			Summary:    "",
+			Links: []opds1Link{{
+				Rel: "subsection", Href: links.Root + n.Href, Type: target, Title: "",
+			}},
+		})
+	}
+	for _, ub := range c.Books {
+		feed.Entries = append(feed.Entries, opds1BookEntry(links, ub))
+	}
+
+	w.Header().Set("Content-Type", kind+";charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(xml.Header))
+	_ = xml.NewEncoder(w).Encode(feed)
+}
+
+func opds1BookEntry(links opdsLinks, ub models.UserBook) opds1Entry {
+	title, authors := opdsBookTitle(ub)
+	entry := opds1Entry{
+		ID:         opdsUUIDPrefix + ub.BookID.String(),
+		Title:      title,
+		Updated:    ub.UpdatedAt.UTC().Format(time.RFC3339),
+		Authors:    make([]opds1Author, len(authors)),
+		Identifier: []string{opdsUUIDPrefix + ub.BookID.String()},
+		Summary:    "",
+		Links:      nil,
+	}
+	for i, a := range authors {
+		entry.Authors[i] = opds1Author{Name: a}
+	}
+	if ub.Book != nil && ub.Book.ISBN13 != nil {
+		entry.Identifier = append(entry.Identifier, "urn:isbn:"+*ub.Book.ISBN13)
+	}
+	if ub.Book != nil && ub.Book.Description != nil {
+		entry.Summary = *ub.Book.Description
+	}
+	if cover := links.cover(ub); cover != "" {
+		entry.Links = append(entry.Links,
+			opds1Link{Rel: opdsRelImage, Href: cover, Type: "", Title: ""},
+			opds1Link{Rel: opdsRelThumbnail, Href: cover, Type: "", Title: ""},
+		)
+	}
+	for _, f := range ub.Formats {
+		entry.Links = append(entry.Links, opds1Link{
+			Rel:   opdsRelAcquisition,
+			Href:  links.download(ub, f),
+			Type:  opdsFileType(f),
+			Title: "",
+		})
+	}
+	return entry
+}
+
+type opensearchDescription struct {
+	XMLName     xml.Name      `xml:"OpenSearchDescription"`
+	Xmlns       string        `xml:"xmlns,attr"`
+	ShortName   string        `xml:"ShortName"`
+	Description string        `xml:"Description"`
+	URL         opensearchURL `xml:"Url"`
+}
+
+type opensearchURL struct {
+	Type     string `xml:"type,attr"`
+	Template string `xml:"template,attr"`
+}
+
+func renderOpenSearch(w http.ResponseWriter, links opdsLinks) {
+	desc := opensearchDescription{
+		XMLName:     xml.Name{Space: "", Local: "OpenSearchDescription"},
+		Xmlns:       "http://a9.com/-/spec/opensearch/1.1/",
+		ShortName:   "Books",
+		Description: "Search your books by title or author",
+		URL: opensearchURL{
+			Type:     opds1Acquisition,
+			Template: links.Root + "/search?q={searchTerms}",
+		},
+	}
+
+	w.Header().Set("Content-Type", opds1Search+";charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(xml.Header))
+	_ = xml.NewEncoder(w).Encode(desc)
+}
+
+// --- OPDS 2.0 (JSON) ---
+
+type opds2Feed struct {
+	Metadata     opds2FeedMetadata  `json:"metadata"`
+	Links        []opds2Link        `json:"links"`
+	Navigation   []opds2Link        `json:"navigation,omitempty"`
+	Publications []opds2Publication `json:"publications,omitempty"`
+}
+
+type opds2FeedMetadata struct {
+	Title    string `json:"title"`
+	Modified string `json:"modified"`
+}
+
+type opds2Link struct {
+	Href      string `json:"href"`
+	Type      string `json:"type,omitempty"`
+	Rel       string `json:"rel,omitempty"`
+	Title     string `json:"title,omitempty"`
+	Templated bool   `json:"templated,omitempty"`
+}
+
+// opds2NewLink builds a plain (non-templated) link; empty fields are omitted
+// from the JSON.
+func opds2NewLink(href, typ, rel, title string) opds2Link {
+	return opds2Link{Href: href, Type: typ, Rel: rel, Title: title, Templated: false}
+}
+
+type opds2Publication struct {
+	Metadata opds2PublicationMetadata `json:"metadata"`
+	Links    []opds2Link              `json:"links"`
+	Images   []opds2Link              `json:"images,omitempty"`
+}
+
+type opds2PublicationMetadata struct {
+	Type        string             `json:"@type"`
+	Identifier  string             `json:"identifier"`
+	Title       string             `json:"title"`
+	Author      []opds2Contributor `json:"author,omitempty"`
+	Modified    string             `json:"modified"`
+	Description string             `json:"description,omitempty"`
+}
+
+type opds2Contributor struct {
+	Name string `json:"name"`
+}
+
+func renderOPDS2(w http.ResponseWriter, links opdsLinks, c opdsCatalog) {
+	feed := opds2Feed{
+		Metadata: opds2FeedMetadata{Title: c.Title, Modified: links.Updated},
+		Links: []opds2Link{
+			opds2NewLink(links.Root+c.Self, opds2Type, "self", ""),
+			opds2NewLink(links.Root, opds2Type, "start", ""),
+			{
+				Href:      links.Root + "/search{?query}",
+				Type:      opds2Type,
+				Rel:       "search",
+				Title:     "",
+				Templated: true,
+			},
+		},
+		Navigation:   make([]opds2Link, 0, len(c.Nav)),
+		Publications: make([]opds2Publication, 0, len(c.Books)),
+	}
+	if c.Next != "" {
+		feed.Links = append(feed.Links,
+			opds2NewLink(links.Root+c.Next, opds2Type, "next", ""),
+		)
+	}
+	for _, n := range c.Nav {
+		feed.Navigation = append(feed.Navigation,
+			opds2NewLink(links.Root+n.Href, opds2Type, "", n.Title),
+		)
+	}
+	for _, ub := range c.Books {
+		feed.Publications = append(feed.Publications, opds2BookPublication(links, ub))
+	}
+
+	w.Header().Set("Content-Type", opds2Type)
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(feed)
+}
+
+func opds2BookPublication(links opdsLinks, ub models.UserBook) opds2Publication {
+	title, authors := opdsBookTitle(ub)
+	pub := opds2Publication{
+		Metadata: opds2PublicationMetadata{
+			Type:        "http://schema.org/Book",
+			Identifier:  opdsUUIDPrefix + ub.BookID.String(),
+			Title:       title,
+			Author:      make([]opds2Contributor, len(authors)),
+			Modified:    ub.UpdatedAt.UTC().Format(time.RFC3339),
+			Description: "",
+		},
+		Links:  make([]opds2Link, 0, len(ub.Formats)),
+		Images: nil,
+	}
+	for i, a := range authors {
+		pub.Metadata.Author[i] = opds2Contributor{Name: a}
+	}
+	if ub.Book != nil && ub.Book.Description != nil {
+		pub.Metadata.Description = *ub.Book.Description
+	}
+	if cover := links.cover(ub); cover != "" {
+		pub.Images = []opds2Link{opds2NewLink(cover, "", "", "")}
+	}
+	for _, f := range ub.Formats {
+		pub.Links = append(pub.Links, opds2NewLink(
+			links.download(ub, f), opdsFileType(f), opdsRelAcquisition, "",
+		))
+	}
+	return pub
+}
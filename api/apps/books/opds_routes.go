@@ -0,0 +1,376 @@
+package books
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/internal/communication/httptools"
+	"tools.xdoubleu.com/internal/database"
+)
+
+// opdsPageSize is the number of books per page of an acquisition feed.
+const opdsPageSize = 50
+
+// opdsRenderer writes a catalog page in one OPDS version.
+type opdsRenderer func(w http.ResponseWriter, links opdsLinks, c opdsCatalog)
+
+// opdsBuilder assembles a catalog page for the authenticated user.
+type opdsBuilder func(r *http.Request, userID string) (opdsCatalog, error)
+
+// opdsRoutes mounts the OPDS catalog under /{prefix}/opds/{token}/, for
+// reader apps that speak neither the Kobo store protocol nor our web app.
+// Authentication works like the Kobo routes: the token is a bearer secret in
+// the catalog URL, looked up by its SHA-256 hash.
+//
+// The same catalog is served as OPDS 1.2 (Atom) under /v1.2 and OPDS 2.0
+// (JSON) under /v2. Book files are downloaded from /download/{bookId}/
+// {format}, which redirects to a presigned object-store URL.
+func (app *Books) opdsRoutes(prefix string, mux *http.ServeMux) {
+	base := "/" + prefix + "/opds/{token}"
+	versions := []struct {
+		path   string
+		render opdsRenderer
+	}{
+		{path: "/v1.2", render: renderOPDS1},
+		{path: "/v2", render: renderOPDS2},
+	}
+	for _, v := range versions {
+		pages := map[string]opdsBuilder{
+			"":                 app.opdsRootCatalog,
+			"/statuses":        app.opdsStatusesCatalog,
+			"/shelves":         app.opdsShelvesCatalog,
+			"/shelves/{shelf}": app.opdsShelfCatalog,
+			"/tags":            app.opdsTagsCatalog,
+			"/tags/{tag}":      app.opdsTagCatalog,
+			"/recent":          app.opdsRecentCatalog,
+			"/search":          app.opdsSearchCatalog,
+		}
+		for path, build := range pages {
+			mux.HandleFunc(
+				"GET "+base+v.path+path, app.opdsHandler(v.path, v.render, build),
+			)
+		}
+	}
+	mux.HandleFunc(
+		"GET "+base+"/v1.2/opensearch.xml", app.opdsOpenSearchHandler,
+	)
+	mux.HandleFunc(
+		"GET "+base+"/download/{bookId}/{format}", app.opdsDownloadHandler,
+	)
+}
+
+// opdsAuth validates HTTPS and the token in the request URL path, like
+// koboAuth. Returns (userID, true) on success; writes an error response and
+// returns ("", false) on failure.
+func (app *Books) opdsAuth(w http.ResponseWriter, r *http.Request) (string, bool) {
+	if !httptools.RequireHTTPS(w, r) {
+		return "", false
+	}
+
+	raw := r.PathValue("token")
+	if raw == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return "", false
+	}
+
+	h := sha256.Sum256([]byte(raw))
+	hash := hex.EncodeToString(h[:])
+
+	userID, err := app.Services.OPDS.GetOPDSUserByTokenHash(r.Context(), hash)
+	if err != nil {
+		if errors.Is(err, database.ErrResourceNotFound) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return "", false
+		}
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return "", false
+	}
+	return userID, true
+}
+
+// opdsLinksFor derives the absolute URL prefixes for a request under the
+// given version root ("" for version-independent routes).
+func (app *Books) opdsLinksFor(r *http.Request, version string) opdsLinks {
+	token := r.PathValue("token")
+	prefixPath, _, _ := strings.Cut(r.URL.Path, "/opds/"+token)
+	root := app.publicOrigin(r) + prefixPath
+	tokenBase := root + "/opds/" + token
+	return opdsLinks{
+		Root:    tokenBase + version,
+		Token:   tokenBase,
+		Covers:  root + "/api/cover",
+		Updated: time.Now().UTC().Format(time.RFC3339),
+	}
+}
+
+func (app *Books) opdsHandler(
+	version string,
+	render opdsRenderer,
+	build opdsBuilder,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := app.opdsAuth(w, r)
+		if !ok {
+			return
+		}
+		catalog, err := build(r, userID)
+		if err != nil {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		render(w, app.opdsLinksFor(r, version), catalog)
+	}
+}
+
+// opdsOpenSearchHandler handles GET /v1.2/opensearch.xml, the search
+// description OPDS 1.2 clients fetch before searching.
+func (app *Books) opdsOpenSearchHandler(w http.ResponseWriter, r *http.Request) {
+	if _, ok := app.opdsAuth(w, r); !ok {
+		return
+	}
+	renderOpenSearch(w, app.opdsLinksFor(r, "/v1.2"))
+}
+
+// opdsDownloadHandler handles GET /download/{bookId}/{format} by redirecting
+// to a short-lived presigned URL for the file, as koboFileHandler does.
+func (app *Books) opdsDownloadHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := app.opdsAuth(w, r)
+	if !ok {
+		return
+	}
+
+	bookID, err := uuid.Parse(r.PathValue("bookId"))
+	if err != nil {
+		http.Error(w, "invalid book id", http.StatusBadRequest)
+		return
+	}
+	format := r.PathValue("format")
+	if !slices.Contains([]string{
+		models.FileFormatEPUB, models.FileFormatKEPUB, models.FileFormatPDF,
+		models.FileFormatCBZ,
+	}, format) {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	result, err := app.Services.Books.GetBookFile(r.Context(), userID, bookID, format)
+	if err != nil {
+		if errors.Is(err, database.ErrResourceNotFound) {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, result.URL, http.StatusFound)
+}
+
+// --- catalog pages ---
+
+func opdsID(path string) string {
+	return "urn:books:opds" + path
+}
+
+// opdsStatusLabel matches the labels the web app uses for the built-in
+// statuses; custom shelves are shown by name.
+func opdsStatusLabel(status string) string {
+	switch status {
+	case models.StatusToRead:
+		return "Want to read"
+	case models.StatusReading:
+		return "Currently reading"
+	case models.StatusRead:
+		return "Read"
+	case models.StatusDropped:
+		return "Dropped"
+	default:
+		return status
+	}
+}
+
+func opdsNavCatalog(path, title string, nav []opdsNavItem) opdsCatalog {
+	return opdsCatalog{
+		ID:          opdsID(path),
+		Title:       title,
+		Self:        path,
+		Next:        "",
+		Nav:         nav,
+		Books:       nil,
+		Acquisition: false,
+	}
+}
+
+func (app *Books) opdsRootCatalog(_ *http.Request, _ string) (opdsCatalog, error) {
+	return opdsNavCatalog("", "Books", []opdsNavItem{
+		{Title: "Recently added", Href: "/recent", Acquisition: true},
+		{Title: "Reading status", Href: "/statuses", Acquisition: false},
+		{Title: "Shelves", Href: "/shelves", Acquisition: false},
+		{Title: "Tags", Href: "/tags", Acquisition: false},
+	}), nil
+}
+
+func (app *Books) opdsStatusesCatalog(
+	_ *http.Request,
+	_ string,
+) (opdsCatalog, error) {
+	statuses := []string{
+		models.StatusReading,
+		models.StatusToRead,
+		models.StatusRead,
+		models.StatusDropped,
+	}
+	nav := make([]opdsNavItem, len(statuses))
+	for i, s := range statuses {
+		nav[i] = opdsNavItem{
+			Title:       opdsStatusLabel(s),
+			Href:        "/shelves/" + url.PathEscape(s),
+			Acquisition: true,
+		}
+	}
+	return opdsNavCatalog("/statuses", "Reading status", nav), nil
+}
+
+func (app *Books) opdsShelvesCatalog(
+	r *http.Request,
+	userID string,
+) (opdsCatalog, error) {
+	shelves, err := app.Services.Books.ListShelves(r.Context(), userID)
+	if err != nil {
+		return opdsCatalog{}, err
+	}
+	slices.Sort(shelves)
+	nav := make([]opdsNavItem, len(shelves))
+	for i, s := range shelves {
+		nav[i] = opdsNavItem{
+			Title:       s,
+			Href:        "/shelves/" + url.PathEscape(s),
+			Acquisition: true,
+		}
+	}
+	return opdsNavCatalog("/shelves", "Shelves", nav), nil
+}
+
+func (app *Books) opdsTagsCatalog(
+	r *http.Request,
+	userID string,
+) (opdsCatalog, error) {
+	tags, err := app.Services.Books.ListOPDSTags(r.Context(), userID)
+	if err != nil {
+		return opdsCatalog{}, err
+	}
+	nav := make([]opdsNavItem, len(tags))
+	for i, t := range tags {
+		nav[i] = opdsNavItem{
+			Title:       t,
+			Href:        "/tags/" + url.PathEscape(t),
+			Acquisition: true,
+		}
+	}
+	return opdsNavCatalog("/tags", "Tags", nav), nil
+}
+
+func (app *Books) opdsShelfCatalog(
+	r *http.Request,
+	userID string,
+) (opdsCatalog, error) {
+	shelf := r.PathValue("shelf")
+	return app.opdsBooks(
+		r, userID, "/shelves/"+url.PathEscape(shelf), opdsStatusLabel(shelf),
+		models.OPDSFilter{Shelf: shelf, Tag: "", Query: "", Recent: false},
+	)
+}
+
+func (app *Books) opdsTagCatalog(
+	r *http.Request,
+	userID string,
+) (opdsCatalog, error) {
+	tag := r.PathValue("tag")
+	return app.opdsBooks(
+		r, userID, "/tags/"+url.PathEscape(tag), tag,
+		models.OPDSFilter{Shelf: "", Tag: tag, Query: "", Recent: false},
+	)
+}
+
+func (app *Books) opdsRecentCatalog(
+	r *http.Request,
+	userID string,
+) (opdsCatalog, error) {
+	return app.opdsBooks(
+		r, userID, "/recent", "Recently added",
+		models.OPDSFilter{Shelf: "", Tag: "", Query: "", Recent: true},
+	)
+}
+
+// opdsSearchCatalog serves both versions' search: OPDS 1.2 clients fill the
+// OpenSearch template's q, OPDS 2.0 clients the link template's query.
+func (app *Books) opdsSearchCatalog(
+	r *http.Request,
+	userID string,
+) (opdsCatalog, error) {
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		query = r.URL.Query().Get("query")
+	}
+	return app.opdsBooks(
+		r, userID, "/search", "Search: "+query,
+		models.OPDSFilter{Shelf: "", Tag: "", Query: query, Recent: false},
+	)
+}
+
+// opdsBooks builds one page of an acquisition feed. The page is chosen by
+// the offset query parameter; other parameters (the search query) are kept
+// in the self and next links.
+func (app *Books) opdsBooks(
+	r *http.Request,
+	userID, path, title string,
+	filter models.OPDSFilter,
+) (opdsCatalog, error) {
+	params := r.URL.Query()
+	offset := opdsOffset(params.Get("offset"))
+
+	books, hasMore, err := app.Services.Books.ListOPDSBooks(
+		r.Context(), userID, filter, opdsPageSize, offset,
+	)
+	if err != nil {
+		return opdsCatalog{}, err
+	}
+
+	catalog := opdsCatalog{
+		ID:          opdsID(path),
+		Title:       title,
+		Self:        path,
+		Next:        "",
+		Nav:         nil,
+		Books:       books,
+		Acquisition: true,
+	}
+	if len(params) > 0 {
+		catalog.Self += "?" + params.Encode()
+	}
+	if hasMore {
+		params.Set("offset", strconv.Itoa(int(offset)+len(books)))
+		catalog.Next = path + "?" + params.Encode()
+	}
+	return catalog, nil
+}
+
+// opdsOffset parses the offset query parameter, treating anything invalid
+// as the first page.
+func opdsOffset(raw string) int32 {
+	n, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return int32(n) //nolint:gosec // ParseInt with bitSize 32 bounds n
+}
@@ -0,0 +1,222 @@
+package books
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/sqlitefile"
+)
+
+// calibreTimestampFormat is how Calibre stores dates; the fraction is
+// optional.
+const calibreTimestampFormat = "2006-01-02 15:04:05.999999-07:00"
+
+// calibreRatingScale converts Calibre's ratings, stored out of ten so they
+// can hold half stars, to whole stars.
+const calibreRatingScale = 2
+
+// calibreImporter reads a Calibre library's metadata.db. Calibre only
+// catalogues files the user has, so every book is tagged own-digital and
+// left on to-read; read state lives in per-user custom columns Calibre
+// doesn't standardise.
+type calibreImporter struct{}
+
+func (calibreImporter) Name() string { return FormatCalibre }
+
+func (calibreImporter) Detect(data []byte) bool {
+	if !sqlitefile.IsSQLite(data) {
+		return false
+	}
+	db, err := sqlitefile.Open(data)
+	return err == nil && db.HasTable("books") && db.HasTable("books_authors_link")
+}
+
+func (calibreImporter) Parse(data []byte) ([]ParsedEntry, error) {
+	db, err := sqlitefile.Open(data)
+	if err != nil {
+		return nil, fmt.Errorf("opening Calibre library: %w", err)
+	}
+
+	bookRows, err := db.Rows("books")
+	if err != nil {
+		return nil, fmt.Errorf("reading Calibre books: %w", err)
+	}
+	authors, err := calibreLinked(
+		db, "authors", "name", "books_authors_link", "author",
+	)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := calibreLinked(db, "tags", "name", "books_tags_link", "tag")
+	if err != nil {
+		return nil, err
+	}
+	ratings, err := calibreLinked(
+		db, "ratings", "rating", "books_ratings_link", "rating",
+	)
+	if err != nil {
+		return nil, err
+	}
+	isbns, err := calibreISBNs(db)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := calibreComments(db)
+	if err != nil {
+		return nil, err
+	}
+	series, err := calibreLinked(
+		db, "series", "name", "books_series_link", "series",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]ParsedEntry, 0, len(bookRows))
+	for _, row := range bookRows {
+		id, _ := row["id"].(int64)
+		title, _ := row["title"].(string)
+		if strings.TrimSpace(title) == "" {
+			continue
+		}
+
+		names := make([]string, 0, len(authors[id]))
+		for _, a := range authors[id] {
+			if s, ok := a.(string); ok && s != "" {
+				names = append(names, s)
+			}
+		}
+		e := newEntry(title, names)
+
+		// Older libraries keep the ISBN on the books row itself.
+		legacy, _ := row["isbn"].(string)
+		e.Book.ISBN13 = firstISBN13(append(isbns[id], legacy))
+		if c, ok := comments[id]; ok {
+			e.Book.Description = &c
+		}
+		for _, sr := range series[id] {
+			if name, ok := sr.(string); ok && strings.TrimSpace(name) != "" {
+				e.Book.Series = &name
+				e.Book.SeriesPosition = calibreSeriesIndex(row["series_index"])
+			}
+		}
+		if ts, ok := row["timestamp"].(string); ok {
+			e.UserBook.AddedAt = parseDate(ts, calibreTimestampFormat)
+		}
+		for _, r := range ratings[id] {
+			if n, ok := r.(int64); ok {
+				e.UserBook.Rating = starRating(float64(n) / calibreRatingScale)
+			}
+		}
+		for _, t := range tags[id] {
+			if s, ok := t.(string); ok {
+				e.UserBook.Tags = appendTag(e.UserBook.Tags, s)
+			}
+		}
+		e.UserBook.Tags = appendTag(e.UserBook.Tags, models.TagOwnDigital)
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// calibreLinked resolves a many-to-many link table: for every book id it
+// returns column of the linked rows in table, in link order (which for
+// authors is the order Calibre displays them in).
+func calibreLinked(
+	db *sqlitefile.DB,
+	table, column, linkTable, linkColumn string,
+) (map[int64][]any, error) {
+	if !db.HasTable(table) || !db.HasTable(linkTable) {
+		return map[int64][]any{}, nil
+	}
+	rows, err := db.Rows(table)
+	if err != nil {
+		return nil, fmt.Errorf("reading Calibre %s: %w", table, err)
+	}
+	values := make(map[int64]any, len(rows))
+	for _, r := range rows {
+		id, _ := r["id"].(int64)
+		values[id] = r[column]
+	}
+
+	links, err := db.Rows(linkTable)
+	if err != nil {
+		return nil, fmt.Errorf("reading Calibre %s: %w", linkTable, err)
+	}
+	slices.SortStableFunc(links, func(a, b sqlitefile.Row) int {
+		ia, _ := a["id"].(int64)
+		ib, _ := b["id"].(int64)
+		return cmp.Compare(ia, ib)
+	})
+
+	linked := map[int64][]any{}
+	for _, l := range links {
+		book, _ := l["book"].(int64)
+		target, _ := l[linkColumn].(int64)
+		if v, ok := values[target]; ok {
+			linked[book] = append(linked[book], v)
+		}
+	}
+	return linked, nil
+}
+
+// calibreSeriesIndex reads books.series_index, which SQLite may store as an
+// integer when it has no fractional part.
+func calibreSeriesIndex(v any) *float64 {
+	var idx float64
+	switch n := v.(type) {
+	case float64:
+		idx = n
+	case int64:
+		idx = float64(n)
+	default:
+		return nil
+	}
+	if idx < 0 {
+		return nil
+	}
+	return &idx
+}
+
+func calibreISBNs(db *sqlitefile.DB) (map[int64][]string, error) {
+	isbns := map[int64][]string{}
+	if !db.HasTable("identifiers") {
+		return isbns, nil
+	}
+	rows, err := db.Rows("identifiers")
+	if err != nil {
+		return nil, fmt.Errorf("reading Calibre identifiers: %w", err)
+	}
+	for _, r := range rows {
+		kind, _ := r["type"].(string)
+		if !strings.EqualFold(kind, "isbn") {
+			continue
+		}
+		book, _ := r["book"].(int64)
+		if val, ok := r["val"].(string); ok {
+			isbns[book] = append(isbns[book], val)
+		}
+	}
+	return isbns, nil
+}
+
+func calibreComments(db *sqlitefile.DB) (map[int64]string, error) {
+	comments := map[int64]string{}
+	if !db.HasTable("comments") {
+		return comments, nil
+	}
+	rows, err := db.Rows("comments")
+	if err != nil {
+		return nil, fmt.Errorf("reading Calibre comments: %w", err)
+	}
+	for _, r := range rows {
+		book, _ := r["book"].(int64)
+		if text, ok := r["text"].(string); ok && strings.TrimSpace(text) != "" {
+			comments[book] = text
+		}
+	}
+	return comments, nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,10 +17,33 @@ const (
 	isbn13Len           = 13
 )
 
+// goodreadsHeader is the column layout WriteCSV emits, following Goodreads'
+// own library export so other services importing Goodreads files (e.g.
+// StoryGraph) accept it too.
+var goodreadsHeader = []string{ //nolint:gochecknoglobals //fixed column layout
+	"Book Id",
+	"Title",
+	"Author",
+	"Additional Authors",
+	"ISBN",
+	"ISBN13",
+	"My Rating",
+	"Number of Pages",
+	"Date Read",
+	"Date Added",
+	"Bookshelves",
+	"Bookshelves with positions",
+	"Exclusive Shelf",
+	"Read Count",
+}
+
 // ParsedEntry holds the extracted data from one row of a Goodreads CSV export.
 type ParsedEntry struct {
 	Book     models.Book
 	UserBook models.UserBook
+	// History is the reading history a JSON backup carries for the entry;
+	// nil for every other format.
+	History *ReadingHistory
 }
 
 // ParseCSV parses a Goodreads library export CSV into a slice of ParsedEntry.
@@ -84,7 +108,12 @@ func parseRow(row []string, idx map[string]int) (ParsedEntry, error) {
 	}
 
 	title := get(row, idx, "Title")
-	author := get(row, idx, "Author")
+	authors := []string{get(row, idx, "Author")}
+	for _, a := range strings.Split(get(row, idx, "Additional Authors"), ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			authors = append(authors, a)
+		}
+	}
 
 	var isbn13 *string
 	if v := get(row, idx, "ISBN13"); v != "" && v != `=""` {
@@ -94,6 +123,13 @@ func parseRow(row []string, idx map[string]int) (ParsedEntry, error) {
 		}
 	}
 
+	var pageCount *int
+	if v := get(row, idx, "Number of Pages"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			pageCount = &n
+		}
+	}
+
 	status := shelfToStatus(get(row, idx, "Exclusive Shelf"))
 
 	var rating *int16
@@ -127,9 +163,10 @@ func parseRow(row []string, idx map[string]int) (ParsedEntry, error) {
 	// guess this used to fall back to (Open Library's covers.openlibrary.org)
 	// is gone. A later metadata resync fills the cover in.
 	book := models.Book{ //nolint:exhaustruct //optional fields
-		Title:   title,
-		Authors: []string{author},
-		ISBN13:  isbn13,
+		Title:     title,
+		Authors:   authors,
+		ISBN13:    isbn13,
+		PageCount: pageCount,
 	}
 
 	userBook := models.UserBook{ //nolint:exhaustruct //IDs assigned later
@@ -141,7 +178,92 @@ func parseRow(row []string, idx map[string]int) (ParsedEntry, error) {
 		AddedAt:        addedAt,
 	}
 
-	return ParsedEntry{Book: book, UserBook: userBook}, nil
+	return ParsedEntry{Book: book, UserBook: userBook, History: nil}, nil
+}
+
+// WriteCSV writes entries as a Goodreads library export that ParseCSV reads
+// back. Goodreads keeps a single read date, so only the latest of
+// FinishedAt is written; Read Count still reflects every finish. Book Id
+// has no meaning outside Goodreads and is just the row number.
+func WriteCSV(w io.Writer, entries []ParsedEntry) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(goodreadsHeader); err != nil {
+		return err
+	}
+
+	for i, e := range entries {
+		if err := writer.Write(formatRow(i+1, e)); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatRow(n int, e ParsedEntry) []string {
+	var author, additional string
+	if len(e.Book.Authors) > 0 {
+		author = e.Book.Authors[0]
+		additional = strings.Join(e.Book.Authors[1:], ", ")
+	}
+
+	isbn13 := ""
+	if e.Book.ISBN13 != nil {
+		isbn13 = *e.Book.ISBN13
+	}
+
+	rating := "0"
+	if e.UserBook.Rating != nil {
+		rating = strconv.Itoa(int(*e.UserBook.Rating))
+	}
+
+	pages := ""
+	if e.Book.PageCount != nil {
+		pages = strconv.Itoa(*e.Book.PageCount)
+	}
+
+	dateRead := ""
+	if len(e.UserBook.FinishedAt) > 0 {
+		latest := slices.MaxFunc(e.UserBook.FinishedAt, time.Time.Compare)
+		dateRead = latest.UTC().Format(goodreadsDateFormat)
+	}
+
+	dateAdded := ""
+	if !e.UserBook.AddedAt.IsZero() {
+		dateAdded = e.UserBook.AddedAt.UTC().Format(goodreadsDateFormat)
+	}
+
+	shelves := append([]string{e.UserBook.Status}, e.UserBook.Tags...)
+	withPositions := make([]string, len(shelves))
+	for i, name := range shelves {
+		withPositions[i] = formatShelfEntry(name, e.UserBook.ShelfPositions[name])
+	}
+
+	return []string{
+		strconv.Itoa(n),
+		e.Book.Title,
+		author,
+		additional,
+		`=""`,
+		`="` + isbn13 + `"`,
+		rating,
+		pages,
+		dateRead,
+		dateAdded,
+		strings.Join(shelves, ", "),
+		strings.Join(withPositions, ", "),
+		e.UserBook.Status,
+		strconv.Itoa(len(e.UserBook.FinishedAt)),
+	}
+}
+
+// formatShelfEntry is the inverse of parseShelfEntry.
+func formatShelfEntry(name string, pos int) string {
+	if pos <= 0 {
+		return name
+	}
+	return fmt.Sprintf("%s (#%d)", name, pos)
 }
 
 // parseShelvesWithPositions parses the "Bookshelves with positions" CSV column.
@@ -1,8 +1,10 @@
 package books_test
 
 import (
+	"bytes"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -108,3 +110,73 @@ func TestParseCSV_CanonicalisesFavouriteTag(t *testing.T) {
 		assert.NotContains(t, e.UserBook.Tags, "favorite")
 	}
 }
+
+func TestWriteCSV_RoundTrip(t *testing.T) {
+	isbn := "9780140449112"
+	pages := 541
+	rating := int16(4)
+	finished := time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC)
+	entries := []books.ParsedEntry{
+		{
+			Book: models.Book{ //nolint:exhaustruct // partial
+				Title:     "The Odyssey",
+				Authors:   []string{"Homer", "Emily Wilson"},
+				ISBN13:    &isbn,
+				PageCount: &pages,
+			},
+			UserBook: models.UserBook{ //nolint:exhaustruct // partial
+				Status: models.StatusRead,
+				Tags:   []string{models.TagOwnPhysical, models.TagFavourite},
+				ShelfPositions: map[string]int{
+					models.StatusRead:   2,
+					models.TagFavourite: 1,
+				},
+				Rating:     &rating,
+				FinishedAt: []time.Time{finished},
+				AddedAt:    time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			Book: models.Book{ //nolint:exhaustruct // partial
+				Title:   `Quotes, "Commas" and More`,
+				Authors: []string{"Anon"},
+			},
+			UserBook: models.UserBook{ //nolint:exhaustruct // partial
+				Status:         "dnf-maybe-later",
+				Tags:           []string{},
+				ShelfPositions: map[string]int{},
+				AddedAt:        time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, books.WriteCSV(&buf, entries))
+
+	parsed, err := books.ParseCSV(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, entries, parsed)
+}
+
+func TestWriteCSV_KeepsLatestReadDate(t *testing.T) {
+	entries := []books.ParsedEntry{{
+		Book: models.Book{ //nolint:exhaustruct // partial
+			Title: "Dune", Authors: []string{"Frank Herbert"},
+		},
+		UserBook: models.UserBook{ //nolint:exhaustruct // partial
+			Status: models.StatusRead,
+			FinishedAt: []time.Time{
+				time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2019, 5, 6, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, books.WriteCSV(&buf, entries))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[1], ",2024/02/01,")
+	assert.True(t, strings.HasSuffix(lines[1], ",read,2"))
+}
@@ -0,0 +1,299 @@
+package books
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// Names of the supported import formats.
+const (
+	FormatGoodreads    = "goodreads"
+	FormatStoryGraph   = "storygraph"
+	FormatLibraryThing = "librarything"
+	FormatCalibre      = "calibre"
+	FormatBackup       = "backup"
+)
+
+const (
+	isbn10Len = 10
+	maxRating = 5
+)
+
+// ErrUnknownFormat is returned when an import file matches none of the
+// supported layouts, or names a format that doesn't exist.
+var ErrUnknownFormat = errors.New("unrecognised library export format")
+
+// Importer turns another service's library export into ParsedEntry values.
+type Importer interface {
+	// Name is the format name clients pass to pick the importer explicitly.
+	Name() string
+	// Detect reports whether data looks like this importer's format. It must
+	// be cheap and must not claim files another importer handles.
+	Detect(data []byte) bool
+	// Parse extracts every library entry from data.
+	Parse(data []byte) ([]ParsedEntry, error)
+}
+
+// importers is consulted in order by DetectFormat: binary and JSON layouts
+// first, then the CSV dialects, which are told apart by their headers.
+var importers = []Importer{ //nolint:gochecknoglobals //fixed registry
+	calibreImporter{},
+	backupImporter{},
+	libraryThingImporter{},
+	storyGraphImporter{},
+	goodreadsImporter{},
+}
+
+// Importers returns every registered importer in detection order.
+func Importers() []Importer {
+	return importers
+}
+
+// ImporterFor returns the importer called name.
+func ImporterFor(name string) (Importer, error) {
+	for _, imp := range importers {
+		if imp.Name() == name {
+			return imp, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, name)
+}
+
+// DetectFormat returns the first importer that recognises data.
+func DetectFormat(data []byte) (Importer, error) {
+	for _, imp := range importers {
+		if imp.Detect(data) {
+			return imp, nil
+		}
+	}
+	return nil, ErrUnknownFormat
+}
+
+type goodreadsImporter struct{}
+
+func (goodreadsImporter) Name() string { return FormatGoodreads }
+
+func (goodreadsImporter) Detect(data []byte) bool {
+	idx := sniffHeader(data, ',')
+	_, hasID := idx["Book Id"]
+	_, hasShelf := idx["Exclusive Shelf"]
+	return hasID && hasShelf
+}
+
+func (goodreadsImporter) Parse(data []byte) ([]ParsedEntry, error) {
+	return ParseCSV(bytes.NewReader(stripBOM(data)))
+}
+
+// sniffHeader returns the column index of the first record in data, or nil
+// when it can't be read as delimited text.
+func sniffHeader(data []byte, comma rune) map[string]int {
+	reader := csv.NewReader(bytes.NewReader(stripBOM(data)))
+	reader.Comma = comma
+	reader.LazyQuotes = true
+	header, err := reader.Read()
+	if err != nil || len(header) < 2 {
+		return nil
+	}
+	return buildIndex(header)
+}
+
+// readDelimited reads a header row and every record after it. Rows with a
+// different field count than the header are kept; get tolerates short rows.
+func readDelimited(data []byte, comma rune) (map[string]int, [][]string, error) {
+	reader := csv.NewReader(bytes.NewReader(stripBOM(data)))
+	reader.Comma = comma
+	reader.LazyQuotes = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading header: %w", err)
+	}
+
+	var rows [][]string
+	for {
+		row, readErr := reader.Read()
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return nil, nil, fmt.Errorf("reading row: %w", readErr)
+		}
+		rows = append(rows, row)
+	}
+	return buildIndex(header), rows, nil
+}
+
+func stripBOM(data []byte) []byte {
+	return bytes.TrimPrefix(data, []byte("\ufeff"))
+}
+
+// isbn13From returns the ISBN-13 in raw, converting an ISBN-10 when that is
+// all there is. Hyphens, spaces and spreadsheet quoting are ignored.
+func isbn13From(raw string) *string {
+	var b strings.Builder
+	for _, r := range raw {
+		if unicode.IsDigit(r) || r == 'X' || r == 'x' {
+			b.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	s := b.String()
+
+	switch len(s) {
+	case isbn13Len:
+		if strings.ContainsRune(s, 'X') {
+			return nil
+		}
+		return &s
+	case isbn10Len:
+		if strings.ContainsRune(s[:isbn10Len-1], 'X') {
+			return nil
+		}
+		converted := "978" + s[:isbn10Len-1]
+		sum := 0
+		for i, r := range converted {
+			d := int(r - '0')
+			if i%2 == 1 {
+				d *= 3
+			}
+			sum += d
+		}
+		converted += string(rune('0' + (10-sum%10)%10))
+		return &converted
+	default:
+		return nil
+	}
+}
+
+// firstISBN13 returns the first ISBN in a list of candidates, preferring a
+// real ISBN-13 over one converted from an ISBN-10.
+func firstISBN13(candidates []string) *string {
+	var fallback *string
+	for _, c := range candidates {
+		isbn := isbn13From(c)
+		if isbn == nil {
+			continue
+		}
+		if len(normalizeDigits(c)) == isbn13Len {
+			return isbn
+		}
+		if fallback == nil {
+			fallback = isbn
+		}
+	}
+	return fallback
+}
+
+func normalizeDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) || r == 'X' || r == 'x' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// splitList splits a comma-separated cell, trimming and dropping blanks.
+func splitList(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+// flipName turns a "Last, First" author into "First Last".
+func flipName(s string) string {
+	last, first, ok := strings.Cut(s, ",")
+	if !ok {
+		return strings.TrimSpace(s)
+	}
+	return strings.TrimSpace(strings.TrimSpace(first) + " " + strings.TrimSpace(last))
+}
+
+// parseDate tries each layout in turn, returning the zero time when none fit.
+func parseDate(s string, layouts ...string) time.Time {
+	s = strings.TrimSpace(s)
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// appendTag adds tag unless it is blank or already present.
+func appendTag(tags []string, tag string) []string {
+	tag = normaliseFavouriteTag(strings.TrimSpace(tag))
+	if tag == "" {
+		return tags
+	}
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return tags
+		}
+	}
+	return append(tags, tag)
+}
+
+// parseRating reads a possibly fractional star rating.
+func parseRating(s string) *int16 {
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return nil
+	}
+	return starRating(v)
+}
+
+// parsePageCount reads the leading number of a page count like "320" or
+// "320 p.".
+func parsePageCount(s string) *int {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return nil
+	}
+	n, err := strconv.Atoi(fields[0])
+	if err != nil || n <= 0 {
+		return nil
+	}
+	return &n
+}
+
+// starRating rounds a fractional star rating to the library's whole stars,
+// returning nil for unrated entries.
+func starRating(stars float64) *int16 {
+	r := int16(math.Round(stars))
+	if r <= 0 {
+		return nil
+	}
+	r = min(r, maxRating)
+	return &r
+}
+
+// newEntry builds the ParsedEntry every importer starts from.
+func newEntry(title string, authors []string) ParsedEntry {
+	return ParsedEntry{
+		Book: models.Book{ //nolint:exhaustruct //optional fields
+			Title:   strings.TrimSpace(title),
+			Authors: authors,
+		},
+		UserBook: models.UserBook{ //nolint:exhaustruct //IDs assigned later
+			Status:         models.StatusToRead,
+			Tags:           []string{},
+			ShelfPositions: map[string]int{},
+		},
+		History: nil,
+	}
+}
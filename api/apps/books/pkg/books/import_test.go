@@ -0,0 +1,329 @@
+package books_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+	"tools.xdoubleu.com/apps/books/pkg/books"
+)
+
+// StoryGraph's export, trimmed to the columns the importer reads plus one it
+// ignores. Star ratings are fractional and books can be read several times.
+//
+//nolint:lll // CSV header and data rows are inherently long
+const storyGraphCSV = `Title,Authors,Contributors,ISBN/UID,Format,Read Status,Date Added,Last Date Read,Dates Read,Read Count,Moods,Star Rating,Review,Tags,Owned?
+Dune,Frank Herbert,,9780441013593,paperback,read,2022/12/01,2024/03/09,"2023/01/02-2023/01/20, 2024/03/01-2024/03/09",2,adventurous,4.5,,"sci-fi, classics",Yes
+Piranesi,Susanna Clarke,,9781635575637,digital,did-not-finish,2023/05/05,,,0,,,,,Yes
+The Hobbit,J.R.R. Tolkien,,,audio,paused,2024/01/01,,,0,,3.25,,favorites,No
+`
+
+// LibraryThing's tab-separated export, trimmed. Primary authors are
+// "Last, First" and the ISBN column is bracketed.
+//
+//nolint:lll // TSV header and data rows are inherently long
+const libraryThingTSV = "Book Id\tTitle\tPrimary Author\tSecondary Author\tRating\tPage Count\tDate Read\tTags\tCollections\tISBN\tISBNs\tEntry Date\tMedia\n" +
+	"101\tDune\tHerbert, Frank\t\t5\t412\t2023-01-20\tsci-fi\tYour library, Favorites\t[0441013597]\t0441013597\t2022-12-01\tPaperback\n" +
+	"102\tGood Omens\tPratchett, Terry\tGaiman, Neil\t0\t\t\t\tWishlist\t\t\t2024-02-02\t\n" +
+	"103\tKindred\tButler, Octavia E.\t\t\t\t\t\tCurrently reading, Your library\t\t9780807083697, 0807083690\t2024-03-03\tEbook\n"
+
+// LibraryThing's JSON export is keyed by book id and loosely typed.
+//
+//nolint:lll // one record per line reads more easily
+const libraryThingJSON = `{
+  "102": {"books_id": "102", "title": "Good Omens", "primaryauthor": "Pratchett, Terry",
+          "authors": [{"lf": "Pratchett, Terry", "fl": "Terry Pratchett"}, {"lf": "Gaiman, Neil", "fl": "Neil Gaiman"}],
+          "rating": 4, "isbn": {"0": "0060853980", "2": "9780060853983"}, "pages": "412 p.",
+          "dateread": "2023-07-01", "entrydate": "2023-06-01", "tags": ["humour"],
+          "collections": ["Your library"]},
+  "11": {"books_id": "11", "title": "Kindred", "primaryauthor": "Butler, Octavia E.",
+         "collections": ["To read"]}
+}`
+
+func TestDetectFormat(t *testing.T) {
+	calibre, err := os.ReadFile("testdata/calibre.db")
+	require.NoError(t, err)
+
+	var backup bytes.Buffer
+	require.NoError(t, books.WriteJSON(&backup, books.LibraryExport{
+		Version: books.ExportVersion,
+		Shelves: []string{},
+		Books:   []books.ExportedBook{},
+	}))
+
+	tests := map[string][]byte{
+		books.FormatGoodreads:    []byte(goodreadsCSV),
+		books.FormatStoryGraph:   []byte(storyGraphCSV),
+		books.FormatLibraryThing: []byte(libraryThingTSV),
+		books.FormatCalibre:      calibre,
+		books.FormatBackup:       backup.Bytes(),
+	}
+	for want, data := range tests {
+		t.Run(want, func(t *testing.T) {
+			imp, detectErr := books.DetectFormat(data)
+			require.NoError(t, detectErr)
+			assert.Equal(t, want, imp.Name())
+		})
+	}
+
+	imp, err := books.DetectFormat([]byte(libraryThingJSON))
+	require.NoError(t, err)
+	assert.Equal(t, books.FormatLibraryThing, imp.Name())
+
+	_, err = books.DetectFormat([]byte("just some text\n"))
+	require.ErrorIs(t, err, books.ErrUnknownFormat)
+}
+
+func TestImporterFor(t *testing.T) {
+	for _, imp := range books.Importers() {
+		got, err := books.ImporterFor(imp.Name())
+		require.NoError(t, err)
+		assert.Equal(t, imp.Name(), got.Name())
+	}
+
+	_, err := books.ImporterFor("delicious")
+	require.ErrorIs(t, err, books.ErrUnknownFormat)
+}
+
+func TestStoryGraphImporter(t *testing.T) {
+	imp, err := books.ImporterFor(books.FormatStoryGraph)
+	require.NoError(t, err)
+
+	entries, err := imp.Parse([]byte(storyGraphCSV))
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	dune := entries[0]
+	assert.Equal(t, "Dune", dune.Book.Title)
+	assert.Equal(t, "9780441013593", *dune.Book.ISBN13)
+	assert.Equal(t, models.StatusRead, dune.UserBook.Status)
+	assert.EqualValues(t, 5, *dune.UserBook.Rating)
+	assert.Equal(t, []time.Time{
+		time.Date(2023, 1, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC),
+	}, dune.UserBook.FinishedAt)
+	assert.Equal(t, time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC), dune.UserBook.AddedAt)
+	assert.Equal(t,
+		[]string{"sci-fi", "classics", models.TagOwnPhysical}, dune.UserBook.Tags)
+
+	piranesi := entries[1]
+	assert.Equal(t, models.StatusDropped, piranesi.UserBook.Status)
+	assert.Nil(t, piranesi.UserBook.Rating)
+	assert.Equal(t, []string{models.TagOwnDigital}, piranesi.UserBook.Tags)
+
+	hobbit := entries[2]
+	assert.Equal(t, models.StatusReading, hobbit.UserBook.Status)
+	assert.Nil(t, hobbit.Book.ISBN13)
+	assert.EqualValues(t, 3, *hobbit.UserBook.Rating)
+	assert.Equal(t, []string{models.TagFavourite}, hobbit.UserBook.Tags)
+}
+
+func TestLibraryThingImporter_TSV(t *testing.T) {
+	imp, err := books.ImporterFor(books.FormatLibraryThing)
+	require.NoError(t, err)
+
+	entries, err := imp.Parse([]byte(libraryThingTSV))
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	dune := entries[0]
+	assert.Equal(t, []string{"Frank Herbert"}, dune.Book.Authors)
+	assert.Equal(t, "9780441013593", *dune.Book.ISBN13, "ISBN-10 converted")
+	assert.Equal(t, 412, *dune.Book.PageCount)
+	assert.Equal(t, models.StatusRead, dune.UserBook.Status)
+	assert.EqualValues(t, 5, *dune.UserBook.Rating)
+	assert.Equal(t,
+		[]string{"sci-fi", models.TagFavourite, models.TagOwnPhysical},
+		dune.UserBook.Tags)
+
+	omens := entries[1]
+	assert.Equal(t, []string{"Terry Pratchett", "Neil Gaiman"}, omens.Book.Authors)
+	assert.Equal(t, models.StatusToRead, omens.UserBook.Status)
+	assert.Nil(t, omens.UserBook.Rating)
+	assert.Empty(t, omens.UserBook.Tags)
+
+	kindred := entries[2]
+	assert.Equal(t, "9780807083697", *kindred.Book.ISBN13)
+	assert.Equal(t, models.StatusReading, kindred.UserBook.Status)
+	assert.Equal(t, []string{models.TagOwnDigital}, kindred.UserBook.Tags)
+}
+
+func TestLibraryThingImporter_JSON(t *testing.T) {
+	imp, err := books.ImporterFor(books.FormatLibraryThing)
+	require.NoError(t, err)
+
+	entries, err := imp.Parse([]byte(libraryThingJSON))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	kindred := entries[0]
+	assert.Equal(t, "Kindred", kindred.Book.Title)
+	assert.Equal(t, []string{"Octavia E. Butler"}, kindred.Book.Authors)
+
+	omens := entries[1]
+	assert.Equal(t, []string{"Terry Pratchett", "Neil Gaiman"}, omens.Book.Authors)
+	assert.Equal(t, "9780060853983", *omens.Book.ISBN13)
+	assert.Equal(t, 412, *omens.Book.PageCount)
+	assert.EqualValues(t, 4, *omens.UserBook.Rating)
+	assert.Equal(t, models.StatusRead, omens.UserBook.Status)
+	assert.Equal(t, []string{"humour", models.TagOwnPhysical}, omens.UserBook.Tags)
+}
+
+// testdata/calibre.db is a Calibre library cut down to the tables the
+// importer reads, with Calibre's own CREATE TABLE statements.
+func TestCalibreImporter(t *testing.T) {
+	data, err := os.ReadFile("testdata/calibre.db")
+	require.NoError(t, err)
+	imp, err := books.ImporterFor(books.FormatCalibre)
+	require.NoError(t, err)
+
+	entries, err := imp.Parse(data)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	omens := entries[0]
+	assert.Equal(t, "Good Omens", omens.Book.Title)
+	assert.Equal(t, []string{"Terry Pratchett", "Neil Gaiman"}, omens.Book.Authors)
+	assert.Equal(t, "9780060853983", *omens.Book.ISBN13)
+	assert.Equal(t, "<p>The world will end on Saturday.</p>", *omens.Book.Description)
+	assert.EqualValues(t, 4, *omens.UserBook.Rating)
+	assert.Equal(t, models.StatusToRead, omens.UserBook.Status)
+	assert.Equal(t,
+		[]string{"Fantasy", "Humour", models.TagOwnDigital}, omens.UserBook.Tags)
+	assert.Equal(t,
+		time.Date(2023, 3, 4, 10, 15, 0, 0, time.UTC), omens.UserBook.AddedAt.UTC())
+
+	darkness := entries[1]
+	assert.Equal(t, []string{"Ursula K. Le Guin"}, darkness.Book.Authors)
+	assert.Equal(t, "9780441478125", *darkness.Book.ISBN13)
+	assert.Nil(t, darkness.UserBook.Rating)
+	assert.False(t, darkness.UserBook.AddedAt.IsZero())
+	require.NotNil(t, darkness.Book.Series)
+	assert.Equal(t, "Hainish Cycle", *darkness.Book.Series)
+	require.NotNil(t, darkness.Book.SeriesPosition)
+	assert.InDelta(t, 4.5, *darkness.Book.SeriesPosition, 0)
+
+	// Calibre gives every book a series_index; it only counts with a series.
+	assert.Nil(t, omens.Book.Series)
+	assert.Nil(t, omens.Book.SeriesPosition)
+
+	notes := entries[2]
+	assert.Empty(t, notes.Book.Authors)
+	assert.Nil(t, notes.Book.ISBN13)
+	assert.Nil(t, notes.Book.Description)
+}
+
+func TestBackupImporter(t *testing.T) {
+	isbn := "9780441013593"
+	rating := int16(4)
+	added := time.Date(2024, 1, 1, 9, 30, 0, 0, time.UTC)
+	finished := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	series, position, total := "Dune", 1.0, 6
+
+	var buf bytes.Buffer
+	require.NoError(t, books.WriteJSON(&buf, books.LibraryExport{
+		Version:    books.ExportVersion,
+		ExportedAt: added,
+		Shelves:    []string{},
+		Books: []books.ExportedBook{{ //nolint:exhaustruct // partial
+			Title:      "Dune",
+			Authors:    []string{"Frank Herbert"},
+			ISBN13:     &isbn,
+			AddedAt:    added,
+			Status:     models.StatusRead,
+			Tags:       []string{models.TagOwnDigital},
+			Rating:     &rating,
+			FinishedAt: []time.Time{finished},
+
+			Series:         &series,
+			SeriesPosition: &position,
+			SeriesTotal:    &total,
+		}},
+		Progress: nil,
+	}))
+
+	imp, err := books.ImporterFor(books.FormatBackup)
+	require.NoError(t, err)
+	entries, err := imp.Parse(buf.Bytes())
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	dune := entries[0]
+	assert.Equal(t, "Dune", dune.Book.Title)
+	assert.Equal(t, isbn, *dune.Book.ISBN13)
+	assert.Equal(t, models.StatusRead, dune.UserBook.Status)
+	assert.Equal(t, []string{models.TagOwnDigital}, dune.UserBook.Tags)
+	assert.Equal(t, rating, *dune.UserBook.Rating)
+	assert.Equal(t, []time.Time{finished}, dune.UserBook.FinishedAt)
+	assert.Equal(t, added, dune.UserBook.AddedAt)
+	assert.Equal(t, &series, dune.Book.Series)
+	assert.Equal(t, &position, dune.Book.SeriesPosition)
+	assert.Equal(t, &total, dune.Book.SeriesTotal)
+}
+
+func TestBackupImporter_ReadingHistory(t *testing.T) {
+	at := time.Date(2024, 5, 2, 8, 0, 0, 0, time.UTC)
+	id := uuid.New()
+	annotation := books.ExportedAnnotation{ //nolint:exhaustruct // partial
+		Type:            models.AnnotationTypeHighlight,
+		HighlightedText: "Fear is the mind-killer.",
+		Source:          models.ReadingSourceKobo,
+		CreatedAt:       at,
+	}
+	withID := annotation
+	withID.ID = &id
+
+	var buf bytes.Buffer
+	require.NoError(t, books.WriteJSON(&buf, books.LibraryExport{
+		Version:    books.ExportVersion,
+		ExportedAt: at,
+		Shelves:    []string{},
+		Books: []books.ExportedBook{{ //nolint:exhaustruct // partial
+			Title:  "Dune",
+			Status: models.StatusReading,
+			ReadingStates: []books.ExportedReadingState{{
+				Source:    models.ReadingSourceKobo,
+				Percent:   25,
+				Location:  nil,
+				UpdatedAt: at,
+			}},
+			Sessions: []books.ExportedSession{{ //nolint:exhaustruct // partial
+				Source:    models.ReadingSourceKobo,
+				StartedAt: at,
+				EndedAt:   at.Add(time.Hour),
+				Seconds:   3600,
+			}},
+			Annotations: []books.ExportedAnnotation{withID, annotation},
+		}},
+		Progress: nil,
+	}))
+
+	imp, err := books.ImporterFor(books.FormatBackup)
+	require.NoError(t, err)
+	parsed, err := imp.Parse(buf.Bytes())
+	require.NoError(t, err)
+	require.Len(t, parsed, 1)
+
+	h := parsed[0].History
+	require.NotNil(t, h)
+	require.Len(t, h.ReadingStates, 1)
+	assert.Equal(t, 25, h.ReadingStates[0].Percent)
+	assert.Equal(t, at, h.ReadingStates[0].UpdatedAt)
+	require.Len(t, h.Sessions, 1)
+	assert.Equal(t, 3600, h.Sessions[0].Seconds)
+	require.Len(t, h.Annotations, 2)
+	assert.Equal(t, id, h.Annotations[0].ID)
+	assert.Equal(t, at, h.Annotations[0].CreatedAt)
+
+	// A backup without annotation IDs gets the same stand-in ID every time.
+	assert.NotEqual(t, uuid.Nil, h.Annotations[1].ID)
+	again, err := imp.Parse(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, h.Annotations[1].ID, again[0].History.Annotations[1].ID)
+}
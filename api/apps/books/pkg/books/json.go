@@ -0,0 +1,262 @@
+package books
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// ExportVersion identifies the shape of LibraryExport. Bump it when a field
+// changes meaning, so an importer can tell which layout it is reading.
+const ExportVersion = 1
+
+// LibraryExport is the lossless JSON backup of a user's library: every
+// book with its shelf, tags, ratings and read dates, plus the per-device
+// reading positions, the reading sessions that make up the progress history
+// and the annotations made on devices. Importing it restores all of these.
+// The ebook files themselves are not part of it.
+type LibraryExport struct {
+	Version    int            `json:"version"`
+	ExportedAt time.Time      `json:"exportedAt"`
+	Shelves    []string       `json:"shelves"`
+	Books      []ExportedBook `json:"books"`
+	// Progress is the books-read-over-time history behind the reading
+	// charts. It is derived from the books' finish dates, so importing a
+	// backup rebuilds it from those rather than reading it back.
+	Progress []ExportedProgress `json:"progress,omitempty"`
+}
+
+// ExportedProgress is one day of the books-read history: the number of
+// books finished up to and including Date.
+type ExportedProgress struct {
+	Date  string `json:"date"`
+	Value string `json:"value"`
+}
+
+// ExportedBook is one library entry. Books are matched by ISBN-13 where set
+// and by title and authors otherwise, so no internal IDs are kept.
+// Annotations keep theirs: those are assigned by the device, which syncs
+// them by that ID.
+type ExportedBook struct {
+	Title       string    `json:"title"`
+	Authors     []string  `json:"authors"`
+	ISBN13      *string   `json:"isbn13,omitempty"`
+	CoverURL    *string   `json:"coverUrl,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	PageCount   *int      `json:"pageCount,omitempty"`
+	SourceURL   *string   `json:"sourceUrl,omitempty"`
+	AddedAt     time.Time `json:"addedAt"`
+
+	Series         *string  `json:"series,omitempty"`
+	SeriesPosition *float64 `json:"seriesPosition,omitempty"`
+	SeriesTotal    *int     `json:"seriesTotal,omitempty"`
+
+	Status          string         `json:"status"`
+	Tags            []string       `json:"tags"`
+	ShelfPositions  map[string]int `json:"shelfPositions,omitempty"`
+	Rating          *int16         `json:"rating,omitempty"`
+	FinishedAt      []time.Time    `json:"finishedAt"`
+	ProgressMode    string         `json:"progressMode"`
+	CurrentPage     int            `json:"currentPage"`
+	ProgressPercent int            `json:"progressPercent"`
+
+	ReadingStates []ExportedReadingState `json:"readingStates,omitempty"`
+	Sessions      []ExportedSession      `json:"sessions,omitempty"`
+	Annotations   []ExportedAnnotation   `json:"annotations,omitempty"`
+}
+
+// ExportedReadingState is the last position one reader reported.
+type ExportedReadingState struct {
+	Source    string    `json:"source"`
+	Percent   int       `json:"percent"`
+	Location  *string   `json:"location,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ExportedSession is one stretch of reading.
+type ExportedSession struct {
+	Source       string    `json:"source"`
+	StartedAt    time.Time `json:"startedAt"`
+	EndedAt      time.Time `json:"endedAt"`
+	Seconds      int       `json:"seconds"`
+	PagesTurned  int       `json:"pagesTurned"`
+	StartPercent *int      `json:"startPercent,omitempty"`
+	EndPercent   *int      `json:"endPercent,omitempty"`
+	Estimated    bool      `json:"estimated"`
+}
+
+// ExportedAnnotation is a highlight, note or bookmark. Location is the
+// device's raw position payload.
+type ExportedAnnotation struct {
+	ID               *uuid.UUID `json:"id,omitempty"`
+	Type             string     `json:"type"`
+	HighlightedText  string     `json:"highlightedText,omitempty"`
+	NoteText         string     `json:"noteText,omitempty"`
+	Color            string     `json:"color,omitempty"`
+	ChapterTitle     string     `json:"chapterTitle,omitempty"`
+	ChapterProgress  float64    `json:"chapterProgress"`
+	Location         []byte     `json:"location,omitempty"`
+	Source           string     `json:"source"`
+	ClientModifiedAt *time.Time `json:"clientModifiedAt,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
+
+// WriteJSON writes export as indented JSON.
+func WriteJSON(w io.Writer, export LibraryExport) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(export)
+}
+
+// ParseJSON reads a library written by WriteJSON.
+func ParseJSON(r io.Reader) (LibraryExport, error) {
+	var export LibraryExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return LibraryExport{}, fmt.Errorf("decoding library export: %w", err)
+	}
+	if export.Version != ExportVersion {
+		return LibraryExport{}, fmt.Errorf(
+			"unsupported library export version %d", export.Version,
+		)
+	}
+	return export, nil
+}
+
+// ReadingHistory is what a backup entry carries beyond the library entry
+// itself. User and book IDs are filled in when it is restored.
+type ReadingHistory struct {
+	ReadingStates []models.BookReadingState
+	Sessions      []models.ReadingSession
+	Annotations   []models.Annotation
+}
+
+// backupImporter reads the JSON backup written by WriteJSON, including each
+// book's reading positions, sessions and annotations.
+type backupImporter struct{}
+
+func (backupImporter) Name() string { return FormatBackup }
+
+func (backupImporter) Detect(data []byte) bool {
+	if !isJSONObject(data) {
+		return false
+	}
+	var probe struct {
+		Version *int            `json:"version"`
+		Books   json.RawMessage `json:"books"`
+	}
+	if err := json.Unmarshal(stripBOM(data), &probe); err != nil {
+		return false
+	}
+	return probe.Version != nil && probe.Books != nil
+}
+
+func (backupImporter) Parse(data []byte) ([]ParsedEntry, error) {
+	export, err := ParseJSON(bytes.NewReader(stripBOM(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]ParsedEntry, 0, len(export.Books))
+	for _, b := range export.Books {
+		entries = append(entries, ParsedEntry{
+			Book: models.Book{ //nolint:exhaustruct //IDs assigned on import
+				Title:          b.Title,
+				Authors:        b.Authors,
+				ISBN13:         b.ISBN13,
+				CoverURL:       b.CoverURL,
+				Description:    b.Description,
+				PageCount:      b.PageCount,
+				SourceURL:      b.SourceURL,
+				Series:         b.Series,
+				SeriesPosition: b.SeriesPosition,
+				SeriesTotal:    b.SeriesTotal,
+			},
+			UserBook: models.UserBook{ //nolint:exhaustruct //IDs assigned on import
+				Status:          b.Status,
+				Tags:            b.Tags,
+				ShelfPositions:  b.ShelfPositions,
+				Rating:          b.Rating,
+				FinishedAt:      b.FinishedAt,
+				AddedAt:         b.AddedAt,
+				ProgressMode:    b.ProgressMode,
+				CurrentPage:     b.CurrentPage,
+				ProgressPercent: b.ProgressPercent,
+			},
+			History: backupHistory(b),
+		})
+	}
+	return entries, nil
+}
+
+func backupHistory(b ExportedBook) *ReadingHistory {
+	h := &ReadingHistory{
+		ReadingStates: make([]models.BookReadingState, 0, len(b.ReadingStates)),
+		Sessions:      make([]models.ReadingSession, 0, len(b.Sessions)),
+		Annotations:   make([]models.Annotation, 0, len(b.Annotations)),
+	}
+	for _, st := range b.ReadingStates {
+		//nolint:exhaustruct //user/book filled in on restore
+		h.ReadingStates = append(h.ReadingStates, models.BookReadingState{
+			Source:    st.Source,
+			Percent:   st.Percent,
+			Location:  st.Location,
+			UpdatedAt: st.UpdatedAt,
+		})
+	}
+	for _, rs := range b.Sessions {
+		//nolint:exhaustruct //IDs filled in on restore
+		h.Sessions = append(h.Sessions, models.ReadingSession{
+			Source:       rs.Source,
+			StartedAt:    rs.StartedAt,
+			EndedAt:      rs.EndedAt,
+			Seconds:      rs.Seconds,
+			PagesTurned:  rs.PagesTurned,
+			StartPercent: rs.StartPercent,
+			EndPercent:   rs.EndPercent,
+			Estimated:    rs.Estimated,
+		})
+	}
+	for _, a := range b.Annotations {
+		//nolint:exhaustruct //user/book filled in on restore
+		h.Annotations = append(h.Annotations, models.Annotation{
+			ID:               backupAnnotationID(b, a),
+			Type:             a.Type,
+			HighlightedText:  a.HighlightedText,
+			NoteText:         a.NoteText,
+			Color:            a.Color,
+			ChapterTitle:     a.ChapterTitle,
+			ChapterProgress:  a.ChapterProgress,
+			Location:         a.Location,
+			Source:           a.Source,
+			ClientModifiedAt: a.ClientModifiedAt,
+			CreatedAt:        a.CreatedAt,
+		})
+	}
+	return h
+}
+
+// backupAnnotationID returns the annotation's device ID, or for a backup
+// written before IDs were exported, one derived from its book and content so
+// restoring the same file twice doesn't duplicate it.
+func backupAnnotationID(b ExportedBook, a ExportedAnnotation) uuid.UUID {
+	if a.ID != nil {
+		return *a.ID
+	}
+	key := strings.Join([]string{
+		b.Title,
+		strings.Join(b.Authors, ","),
+		a.Type,
+		a.HighlightedText,
+		a.NoteText,
+		string(a.Location),
+		a.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}, "\x00")
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(key))
+}
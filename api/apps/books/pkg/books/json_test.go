@@ -0,0 +1,81 @@
+package books_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/pkg/books"
+)
+
+func TestWriteJSON_RoundTrip(t *testing.T) {
+	isbn := "9780441013593"
+	rating := int16(5)
+	start, end := 10, 25
+	location := "epubcfi(/6/4!/4/2)"
+	modified := time.Date(2024, 5, 2, 8, 0, 0, 0, time.UTC)
+	annotationID := uuid.New()
+
+	export := books.LibraryExport{
+		Version:    books.ExportVersion,
+		ExportedAt: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+		Shelves:    []string{"dnf-maybe-later"},
+		Books: []books.ExportedBook{{
+			Title:           "Dune",
+			Authors:         []string{"Frank Herbert"},
+			ISBN13:          &isbn,
+			AddedAt:         time.Date(2024, 1, 1, 9, 30, 0, 0, time.UTC),
+			Status:          "currently-reading",
+			Tags:            []string{"own-digital", "sci-fi"},
+			ShelfPositions:  map[string]int{"sci-fi": 3},
+			Rating:          &rating,
+			FinishedAt:      []time.Time{time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)},
+			ProgressMode:    "percent",
+			ProgressPercent: 25,
+			ReadingStates: []books.ExportedReadingState{{
+				Source:    "kobo",
+				Percent:   25,
+				Location:  &location,
+				UpdatedAt: time.Date(2024, 5, 2, 9, 0, 0, 0, time.UTC),
+			}},
+			Sessions: []books.ExportedSession{{
+				Source:       "kobo",
+				StartedAt:    time.Date(2024, 5, 2, 8, 0, 0, 0, time.UTC),
+				EndedAt:      time.Date(2024, 5, 2, 9, 0, 0, 0, time.UTC),
+				Seconds:      3600,
+				PagesTurned:  40,
+				StartPercent: &start,
+				EndPercent:   &end,
+			}},
+			Annotations: []books.ExportedAnnotation{{
+				ID:               &annotationID,
+				Type:             "highlight",
+				HighlightedText:  "Fear is the mind-killer.",
+				ChapterProgress:  0.2,
+				Location:         []byte(`{"type":"span"}`),
+				Source:           "kobo",
+				ClientModifiedAt: &modified,
+				CreatedAt:        modified,
+			}},
+		}},
+		Progress: []books.ExportedProgress{{Date: "2019-03-01", Value: "1"}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, books.WriteJSON(&buf, export))
+
+	parsed, err := books.ParseJSON(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, export, parsed)
+}
+
+func TestParseJSON_RejectsUnknownVersion(t *testing.T) {
+	_, err := books.ParseJSON(strings.NewReader(`{"version": 99, "books": []}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported library export version")
+}
@@ -0,0 +1,256 @@
+package books
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+const libraryThingDateFormat = "2006-01-02"
+
+// libraryThingImporter reads both LibraryThing export flavours: the
+// tab-separated "export all" file and the JSON export.
+type libraryThingImporter struct{}
+
+func (libraryThingImporter) Name() string { return FormatLibraryThing }
+
+func (libraryThingImporter) Detect(data []byte) bool {
+	if isJSONObject(data) {
+		_, ok := decodeLibraryThingJSON(data)
+		return ok
+	}
+	idx := sniffHeader(data, '\t')
+	_, hasAuthor := idx["Primary Author"]
+	_, hasTitle := idx["Title"]
+	return hasAuthor && hasTitle
+}
+
+func (libraryThingImporter) Parse(data []byte) ([]ParsedEntry, error) {
+	if isJSONObject(data) {
+		records, ok := decodeLibraryThingJSON(data)
+		if !ok {
+			return nil, fmt.Errorf(
+				"%w: not a LibraryThing JSON export", ErrUnknownFormat,
+			)
+		}
+		entries := make([]ParsedEntry, 0, len(records))
+		for _, rec := range records {
+			if e, keep := rec.entry(); keep {
+				entries = append(entries, e)
+			}
+		}
+		return entries, nil
+	}
+
+	idx, rows, err := readDelimited(data, '\t')
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]ParsedEntry, 0, len(rows))
+	for _, row := range rows {
+		col := func(name string) string { return get(row, idx, name) }
+		if col("Title") == "" {
+			continue
+		}
+
+		authors := []string{}
+		if a := col("Primary Author"); a != "" {
+			authors = append(authors, flipName(a))
+		}
+		for _, a := range strings.Split(col("Secondary Author"), "|") {
+			if a = strings.TrimSpace(a); a != "" {
+				authors = append(authors, flipName(a))
+			}
+		}
+
+		e := newEntry(col("Title"), authors)
+		// "ISBNs" lists every edition number; "ISBN" is the bracketed
+		// primary one, e.g. "[0441013597]".
+		e.Book.ISBN13 = firstISBN13(
+			append(splitList(col("ISBNs")), col("ISBN")),
+		)
+		e.Book.PageCount = parsePageCount(col("Page Count"))
+		e.UserBook.Rating = parseRating(col("Rating"))
+		if t := parseDate(col("Date Read"), libraryThingDateFormat); !t.IsZero() {
+			e.UserBook.FinishedAt = append(e.UserBook.FinishedAt, t)
+		}
+		e.UserBook.AddedAt = parseDate(col("Entry Date"), libraryThingDateFormat)
+
+		for _, tag := range splitList(col("Tags")) {
+			e.UserBook.Tags = appendTag(e.UserBook.Tags, tag)
+		}
+		applyLibraryThingCollections(&e, splitList(col("Collections")), col("Media"))
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// applyLibraryThingCollections maps LibraryThing's built-in collections onto
+// a status and ownership tags. Any other collection becomes a tag.
+func applyLibraryThingCollections(e *ParsedEntry, collections []string, media string) {
+	owned := false
+	read := len(e.UserBook.FinishedAt) > 0
+	for _, c := range collections {
+		switch strings.ToLower(c) {
+		case "your library":
+			owned = true
+		case "currently reading":
+			e.UserBook.Status = models.StatusReading
+		case "read but unowned":
+			read = true
+		case "to read", "wishlist":
+		default:
+			e.UserBook.Tags = appendTag(e.UserBook.Tags, c)
+		}
+	}
+	if read && e.UserBook.Status == models.StatusToRead {
+		e.UserBook.Status = models.StatusRead
+	}
+	if owned {
+		tag := models.TagOwnPhysical
+		if strings.Contains(strings.ToLower(media), "ebook") {
+			tag = models.TagOwnDigital
+		}
+		e.UserBook.Tags = appendTag(e.UserBook.Tags, tag)
+	}
+}
+
+// libraryThingRecord is one book of the JSON export. LibraryThing is loose
+// with types (numbers arrive as strings and vice versa), so the awkward
+// fields are kept raw.
+type libraryThingRecord struct {
+	Title         string `json:"title"`
+	PrimaryAuthor string `json:"primaryauthor"`
+	Authors       []struct {
+		FL string `json:"fl"`
+	} `json:"authors"`
+	Rating      json.RawMessage `json:"rating"`
+	ISBN        json.RawMessage `json:"isbn"`
+	Pages       json.RawMessage `json:"pages"`
+	DateRead    string          `json:"dateread"`
+	EntryDate   string          `json:"entrydate"`
+	Tags        []string        `json:"tags"`
+	Collections []string        `json:"collections"`
+	Media       json.RawMessage `json:"media"`
+}
+
+func isJSONObject(data []byte) bool {
+	trimmed := bytes.TrimLeft(stripBOM(data), " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '{'
+}
+
+// decodeLibraryThingJSON decodes an export keyed by LibraryThing book id.
+// ok is false unless every value looks like a LibraryThing record.
+func decodeLibraryThingJSON(data []byte) ([]libraryThingRecord, bool) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(stripBOM(data), &raw); err != nil || len(raw) == 0 {
+		return nil, false
+	}
+
+	ids := make([]string, 0, len(raw))
+	for id := range raw {
+		ids = append(ids, id)
+	}
+	// Keys are numeric ids; sorting them keeps the import order stable.
+	slices.SortFunc(ids, func(a, b string) int {
+		if len(a) != len(b) {
+			return len(a) - len(b)
+		}
+		return strings.Compare(a, b)
+	})
+
+	records := make([]libraryThingRecord, 0, len(raw))
+	for _, id := range ids {
+		var probe map[string]json.RawMessage
+		if err := json.Unmarshal(raw[id], &probe); err != nil {
+			return nil, false
+		}
+		if _, ok := probe["primaryauthor"]; !ok {
+			if _, ok = probe["books_id"]; !ok {
+				return nil, false
+			}
+		}
+		var rec libraryThingRecord
+		if err := json.Unmarshal(raw[id], &rec); err != nil {
+			return nil, false
+		}
+		records = append(records, rec)
+	}
+	return records, true
+}
+
+func (rec libraryThingRecord) entry() (ParsedEntry, bool) {
+	if strings.TrimSpace(rec.Title) == "" {
+		return ParsedEntry{}, false
+	}
+
+	authors := []string{}
+	for _, a := range rec.Authors {
+		if a.FL != "" {
+			authors = append(authors, a.FL)
+		}
+	}
+	if len(authors) == 0 && rec.PrimaryAuthor != "" {
+		authors = append(authors, flipName(rec.PrimaryAuthor))
+	}
+
+	e := newEntry(rec.Title, authors)
+	e.Book.ISBN13 = firstISBN13(looseStrings(rec.ISBN))
+	e.Book.PageCount = parsePageCount(strings.Join(looseStrings(rec.Pages), " "))
+	if ratings := looseStrings(rec.Rating); len(ratings) > 0 {
+		e.UserBook.Rating = parseRating(ratings[0])
+	}
+	if t := parseDate(rec.DateRead, libraryThingDateFormat); !t.IsZero() {
+		e.UserBook.FinishedAt = append(e.UserBook.FinishedAt, t)
+	}
+	e.UserBook.AddedAt = parseDate(rec.EntryDate, libraryThingDateFormat)
+	for _, tag := range rec.Tags {
+		e.UserBook.Tags = appendTag(e.UserBook.Tags, tag)
+	}
+
+	media := strings.Join(looseStrings(rec.Media), " ")
+	applyLibraryThingCollections(&e, rec.Collections, media)
+	return e, true
+}
+
+// looseStrings flattens a JSON string, number, array or object of those into
+// strings, in order (object values by key).
+func looseStrings(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return []string{s}
+	}
+	var n json.Number
+	if json.Unmarshal(raw, &n) == nil {
+		return []string{n.String()}
+	}
+	var list []json.RawMessage
+	if json.Unmarshal(raw, &list) == nil {
+		var out []string
+		for _, item := range list {
+			out = append(out, looseStrings(item)...)
+		}
+		return out
+	}
+	var obj map[string]json.RawMessage
+	if json.Unmarshal(raw, &obj) == nil {
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		var out []string
+		for _, k := range keys {
+			out = append(out, looseStrings(obj[k])...)
+		}
+		return out
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package books
+
+import (
+	"strings"
+	"time"
+
+	"tools.xdoubleu.com/apps/books/internal/models"
+)
+
+// storyGraphDateFormat is used for every date column in a StoryGraph export.
+const storyGraphDateFormat = "2006/01/02"
+
+// storyGraphImporter reads the CSV StoryGraph offers under "Manage Account →
+// Export StoryGraph Library".
+type storyGraphImporter struct{}
+
+func (storyGraphImporter) Name() string { return FormatStoryGraph }
+
+func (storyGraphImporter) Detect(data []byte) bool {
+	idx := sniffHeader(data, ',')
+	_, hasStatus := idx["Read Status"]
+	_, hasRating := idx["Star Rating"]
+	return hasStatus && hasRating
+}
+
+func (storyGraphImporter) Parse(data []byte) ([]ParsedEntry, error) {
+	idx, rows, err := readDelimited(data, ',')
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]ParsedEntry, 0, len(rows))
+	for _, row := range rows {
+		col := func(name string) string { return get(row, idx, name) }
+		if col("Title") == "" {
+			continue
+		}
+		e := newEntry(col("Title"), splitList(col("Authors")))
+		e.Book.ISBN13 = isbn13From(col("ISBN/UID"))
+		e.UserBook.Status = storyGraphStatus(col("Read Status"))
+		e.UserBook.AddedAt = parseDate(col("Date Added"), storyGraphDateFormat)
+		e.UserBook.FinishedAt = storyGraphReadDates(
+			col("Dates Read"), col("Last Date Read"),
+		)
+		e.UserBook.Rating = parseRating(col("Star Rating"))
+
+		for _, tag := range splitList(col("Tags")) {
+			e.UserBook.Tags = appendTag(e.UserBook.Tags, tag)
+		}
+		if strings.EqualFold(col("Owned?"), "yes") {
+			owned := models.TagOwnPhysical
+			switch strings.ToLower(col("Format")) {
+			case "digital", "ebook", "audio":
+				owned = models.TagOwnDigital
+			}
+			e.UserBook.Tags = appendTag(e.UserBook.Tags, owned)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func storyGraphStatus(s string) string {
+	switch strings.ToLower(s) {
+	case "read":
+		return models.StatusRead
+	case "currently-reading", "paused":
+		return models.StatusReading
+	case "did-not-finish":
+		return models.StatusDropped
+	default:
+		return models.StatusToRead
+	}
+}
+
+// storyGraphReadDates collects the end date of every read in "Dates Read"
+// ("2023/01/02-2023/01/20, 2024/03/01-2024/03/09"), falling back to "Last
+// Date Read" for exports that predate the column.
+func storyGraphReadDates(datesRead, lastDateRead string) []time.Time {
+	var dates []time.Time
+	for _, span := range splitList(datesRead) {
+		end := span
+		if _, after, ok := strings.Cut(span, "-"); ok {
+			end = after
+		}
+		if t := parseDate(end, storyGraphDateFormat); !t.IsZero() {
+			dates = append(dates, t)
+		}
+	}
+	if len(dates) == 0 {
+		if t := parseDate(lastDateRead, storyGraphDateFormat); !t.IsZero() {
+			dates = append(dates, t)
+		}
+	}
+	return dates
+}
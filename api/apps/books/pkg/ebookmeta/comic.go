@@ -0,0 +1,188 @@
+package ebookmeta
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+)
+
+// comicInfoName is the ComicRack metadata file carried by most CBZs.
+const comicInfoName = "comicinfo.xml"
+
+// mangaRightToLeft is ComicInfo's Manga value for right-to-left reading.
+const mangaRightToLeft = "YesAndRightToLeft"
+
+// comicPageExts are the image types counted as pages of a comic archive.
+//
+//nolint:gochecknoglobals // static lookup table
+var comicPageExts = []string{".jpg", ".jpeg", ".png", ".gif", ".webp"}
+
+// ComicInfo is the subset of a ComicRack ComicInfo.xml this package reads.
+type ComicInfo struct {
+	Title       string `xml:"Title"`
+	Series      string `xml:"Series"`
+	Number      string `xml:"Number"`
+	Summary     string `xml:"Summary"`
+	Writer      string `xml:"Writer"`
+	Penciller   string `xml:"Penciller"`
+	LanguageISO string `xml:"LanguageISO"`
+	GTIN        string `xml:"GTIN"`
+	Manga       string `xml:"Manga"`
+}
+
+// RightToLeft reports whether the comic's pages are read right to left.
+func (c ComicInfo) RightToLeft() bool {
+	return strings.EqualFold(strings.TrimSpace(c.Manga), mangaRightToLeft)
+}
+
+// Metadata maps the comic's credits and series onto Metadata. The title
+// falls back to "Series #Number" and the authors to the penciller when no
+// writer is given.
+func (c ComicInfo) Metadata() Metadata {
+	var m Metadata
+	m.Title = strings.TrimSpace(c.Title)
+	series := strings.TrimSpace(c.Series)
+	number := strings.TrimSpace(c.Number)
+	switch {
+	case m.Title != "":
+	case series != "" && number != "":
+		m.Title = series + " #" + number
+	default:
+		m.Title = series
+	}
+
+	credits := c.Writer
+	if strings.TrimSpace(credits) == "" {
+		credits = c.Penciller
+	}
+	for name := range strings.SplitSeq(credits, ",") {
+		if s := strings.TrimSpace(name); s != "" {
+			m.Authors = append(m.Authors, s)
+		}
+	}
+
+	if lang := strings.TrimSpace(c.LanguageISO); lang != "" {
+		m.Language = &lang
+	}
+	m.ISBN13, _ = classifyISBN("", c.GTIN)
+	if series != "" {
+		m.Series = series
+		m.SeriesIndex = number
+	}
+	return m
+}
+
+// IsComicArchive reports whether zr is a comic book archive rather than an
+// EPUB: it has no EPUB container and at least one page image.
+func IsComicArchive(zr *zip.Reader) bool {
+	if zipFile(zr, "META-INF/container.xml") != nil {
+		return false
+	}
+	return len(ComicPages(zr)) > 0
+}
+
+// ComicPages returns the page images of a comic archive in reading order:
+// sorted by path, comparing runs of digits by value so "page10" follows
+// "page9". Hidden files and macOS resource forks are skipped.
+func ComicPages(zr *zip.Reader) []*zip.File {
+	var pages []*zip.File
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || strings.HasPrefix(f.Name, "__MACOSX/") ||
+			strings.HasPrefix(path.Base(f.Name), ".") {
+			continue
+		}
+		ext := strings.ToLower(path.Ext(f.Name))
+		if slices.Contains(comicPageExts, ext) {
+			pages = append(pages, f)
+		}
+	}
+	slices.SortStableFunc(pages, func(a, b *zip.File) int {
+		return naturalCompare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+	return pages
+}
+
+// ReadComicInfo reads ComicInfo.xml from a comic archive. An archive without
+// one yields a zero ComicInfo and no error.
+func ReadComicInfo(zr *zip.Reader) (ComicInfo, error) {
+	var info ComicInfo
+	var f *zip.File
+	for _, candidate := range zr.File {
+		if strings.ToLower(path.Base(candidate.Name)) == comicInfoName {
+			f = candidate
+			break
+		}
+	}
+	if f == nil {
+		return info, nil
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return info, fmt.Errorf("ebookmeta: open ComicInfo.xml: %w", err)
+	}
+	defer rc.Close()
+
+	decodeErr := xml.NewDecoder(io.LimitReader(rc, maxXMLReadBytes)).Decode(&info)
+	if decodeErr != nil {
+		return ComicInfo{}, fmt.Errorf(
+			"ebookmeta: parse ComicInfo.xml: %w", decodeErr,
+		)
+	}
+	return info, nil
+}
+
+func extractCBZ(r io.ReaderAt, size int64) (Metadata, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return Metadata{}, fmt.Errorf("ebookmeta: open cbz zip: %w", err)
+	}
+	if len(zr.File) > maxZipEntries {
+		return Metadata{}, fmt.Errorf(
+			"ebookmeta: zip has too many entries (%d)",
+			len(zr.File),
+		)
+	}
+	info, err := ReadComicInfo(zr)
+	if err != nil {
+		return Metadata{}, err
+	}
+	return info.Metadata(), nil
+}
+
+// naturalCompare orders a and b as strings, except that runs of digits are
+// compared by numeric value.
+func naturalCompare(a, b string) int {
+	for a != "" && b != "" {
+		da, db := digitPrefix(a), digitPrefix(b)
+		if da != "" && db != "" {
+			na := strings.TrimLeft(da, "0")
+			nb := strings.TrimLeft(db, "0")
+			if c := len(na) - len(nb); c != 0 {
+				return c
+			}
+			if c := strings.Compare(na, nb); c != 0 {
+				return c
+			}
+			a, b = a[len(da):], b[len(db):]
+			continue
+		}
+		if a[0] != b[0] {
+			return int(a[0]) - int(b[0])
+		}
+		a, b = a[1:], b[1:]
+	}
+	return len(a) - len(b)
+}
+
+func digitPrefix(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return s[:i]
+}
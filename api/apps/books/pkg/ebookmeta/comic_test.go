@@ -0,0 +1,145 @@
+package ebookmeta_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+)
+
+// buildTestCBZ zips the named entries; page images only need a name here.
+func buildTestCBZ(entries map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range entries {
+		writeZipEntry(zw, name, content)
+	}
+	_ = zw.Close()
+	return buf.Bytes()
+}
+
+func openZip(t *testing.T, data []byte) *zip.Reader {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	return zr
+}
+
+const testComicInfo = `<?xml version="1.0" encoding="utf-8"?>
+<ComicInfo xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">
+  <Series>Saga</Series>
+  <Number>1</Number>
+  <Writer>Brian K. Vaughan</Writer>
+  <Penciller>Fiona Staples</Penciller>
+  <LanguageISO>en</LanguageISO>
+  <GTIN>978-1-60706-601-9</GTIN>
+  <Manga>No</Manga>
+</ComicInfo>`
+
+func TestDetectFormatFromFile(t *testing.T) {
+	t.Parallel()
+
+	cbz := buildTestCBZ(map[string]string{
+		"001.jpg": "x", "ComicInfo.xml": testComicInfo,
+	})
+	epub := buildTestEPUB("Dune", []string{"Frank Herbert"}, "", "")
+	// A zip with neither an EPUB container nor images stays an EPUB so the
+	// metadata extraction reports what is wrong with it.
+	other := buildTestCBZ(map[string]string{"notes.txt": "x"})
+	pdf := buildTestPDF("T", "A")
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"cbz", cbz, ebookmeta.FormatCBZ},
+		{"epub", epub, ebookmeta.FormatEPUB},
+		{"zip without pages", other, ebookmeta.FormatEPUB},
+		{"pdf", pdf, ebookmeta.FormatPDF},
+		{"too short", []byte{0x50, 0x4B}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := ebookmeta.DetectFormatFromFile(
+				bytes.NewReader(tt.data), int64(len(tt.data)),
+			)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExtract_CBZ(t *testing.T) {
+	t.Parallel()
+
+	data := buildTestCBZ(map[string]string{
+		"Saga/ComicInfo.xml": testComicInfo, "Saga/001.jpg": "x",
+	})
+	got, err := ebookmeta.Extract(
+		ebookmeta.FormatCBZ, bytes.NewReader(data), int64(len(data)),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "Saga #1", got.Title)
+	assert.Equal(t, []string{"Brian K. Vaughan"}, got.Authors)
+	assert.Equal(t, ptr("9781607066019"), got.ISBN13)
+	assert.Equal(t, ptr("en"), got.Language)
+}
+
+func TestExtract_CBZ_NoComicInfo(t *testing.T) {
+	t.Parallel()
+
+	data := buildTestCBZ(map[string]string{"001.png": "x"})
+	got, err := ebookmeta.Extract(
+		ebookmeta.FormatCBZ, bytes.NewReader(data), int64(len(data)),
+	)
+	require.NoError(t, err)
+	assert.Empty(t, got.Title)
+	assert.Empty(t, got.Authors)
+}
+
+func TestComicInfo_Metadata(t *testing.T) {
+	t.Parallel()
+
+	info := ebookmeta.ComicInfo{ //nolint:exhaustruct // partial
+		Title:     "The Wild Hunt",
+		Series:    "Berserk",
+		Number:    "3",
+		Penciller: "Kentaro Miura, Studio Gaga",
+		Manga:     "YesAndRightToLeft",
+	}
+	m := info.Metadata()
+	assert.Equal(t, "The Wild Hunt", m.Title)
+	assert.Equal(t, []string{"Kentaro Miura", "Studio Gaga"}, m.Authors)
+	assert.Nil(t, m.ISBN13)
+	assert.Equal(t, "Berserk", m.Series)
+	assert.Equal(t, "3", m.SeriesIndex)
+	assert.True(t, info.RightToLeft())
+}
+
+func TestComicPages_NaturalOrder(t *testing.T) {
+	t.Parallel()
+
+	zr := openZip(t, buildTestCBZ(map[string]string{
+		"ch1/page10.jpg":       "x",
+		"ch1/page9.jpg":        "x",
+		"ch1/Page2.PNG":        "x",
+		"ch2/page1.jpg":        "x",
+		"ch1/.thumb.jpg":       "x",
+		"__MACOSX/ch1/._1.jpg": "x",
+		"ComicInfo.xml":        testComicInfo,
+	}))
+
+	var names []string
+	for _, f := range ebookmeta.ComicPages(zr) {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{
+		"ch1/Page2.PNG", "ch1/page9.jpg", "ch1/page10.jpg", "ch2/page1.jpg",
+	}, names)
+	assert.True(t, ebookmeta.IsComicArchive(zr))
+}
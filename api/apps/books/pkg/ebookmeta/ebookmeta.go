@@ -15,9 +15,16 @@ import (
 const (
 	FormatEPUB = "epub"
 	FormatPDF  = "pdf"
+	FormatCBZ  = "cbz"
+	// FormatMOBI covers every PalmDB "BOOKMOBI" book: MOBI, AZW and AZW3.
+	FormatMOBI = "mobi"
+	FormatFB2  = "fb2"
 
-	// magicPrefixLen is the number of bytes read for format detection.
+	// magicPrefixLen is the number of bytes that identify a zip or PDF.
 	magicPrefixLen = 4
+	// sniffLen is the number of leading bytes read for format detection;
+	// enough to reach the PalmDB type field and an FB2 root element.
+	sniffLen = 1024
 
 	// maxZipEntries caps the number of entries scanned in an EPUB zip.
 	maxZipEntries = 10_000
@@ -32,57 +39,133 @@ type Metadata struct {
 	Authors  []string
 	ISBN13   *string
 	Language *string
+	// Series is the series the book belongs to and SeriesIndex its position
+	// there as written in the file ("2", "2.5"); both empty when unknown.
+	Series      string
+	SeriesIndex string
 }
 
-// DetectFormat returns FormatEPUB or FormatPDF based on magic bytes,
-// filename extension, or MIME type (checked in that order).
+// Document is the reflowable content of a MOBI or FB2 book: one HTML
+// document plus the images it references by bare file name.
+type Document struct {
+	HTML   []byte
+	Images []Image
+}
+
+// Image is an image referenced from a Document's HTML as src=Name.
+type Image struct {
+	Name string
+	Data []byte
+}
+
+// DetectFormat returns the format of a file based on magic bytes, filename
+// extension, or MIME type (checked in that order). A zip is only reported as
+// FormatCBZ or FormatFB2 when the name or MIME type says so, since their
+// magic bytes are the same as an EPUB's.
 // Returns empty string when the format cannot be determined.
 func DetectFormat(magic []byte, filename, contentType string) string {
-	if len(magic) >= magicPrefixLen {
-		// PK\x03\x04 = zip/EPUB
-		if magic[0] == 0x50 && magic[1] == 0x4B &&
-			magic[2] == 0x03 && magic[3] == 0x04 {
-			return FormatEPUB
-		}
-		// %PDF
-		if bytes.Equal(magic[:magicPrefixLen], []byte("%PDF")) {
-			return FormatPDF
+	lower := strings.ToLower(filename)
+	comic := strings.HasSuffix(lower, ".cbz") ||
+		contentType == "application/vnd.comicbook+zip"
+	zippedFB2 := strings.HasSuffix(lower, ".fb2.zip")
+	if format := DetectFormatFromMagic(magic); format != "" {
+		switch {
+		case format != FormatEPUB:
+			return format
+		case comic:
+			return FormatCBZ
+		case zippedFB2:
+			return FormatFB2
 		}
+		return format
 	}
-	lower := strings.ToLower(filename)
 	switch {
 	case strings.HasSuffix(lower, ".epub"):
 		return FormatEPUB
 	case strings.HasSuffix(lower, ".pdf"):
 		return FormatPDF
+	case comic:
+		return FormatCBZ
+	case strings.HasSuffix(lower, ".mobi"), strings.HasSuffix(lower, ".azw"),
+		strings.HasSuffix(lower, ".azw3"):
+		return FormatMOBI
+	case strings.HasSuffix(lower, ".fb2"), zippedFB2:
+		return FormatFB2
 	}
 	switch contentType {
 	case "application/epub+zip":
 		return FormatEPUB
 	case "application/pdf":
 		return FormatPDF
+	case "application/x-mobipocket-ebook", "application/vnd.amazon.ebook",
+		"application/vnd.amazon.mobi8-ebook":
+		return FormatMOBI
+	case "application/x-fictionbook+xml":
+		return FormatFB2
 	}
 	return ""
 }
 
-// DetectFormatFromMagic returns FormatEPUB or FormatPDF based solely on the
-// first four magic bytes of data. Returns empty string when neither matches.
+// DetectFormatFromMagic returns the format implied by the leading bytes of
+// data: FormatEPUB for any zip, FormatPDF, FormatMOBI for a PalmDB BOOKMOBI
+// database or FormatFB2 for FictionBook XML. The last two need up to sniffLen
+// bytes. Returns empty string when nothing matches.
 // Use this on the server path — never trust filename or content-type alone.
 func DetectFormatFromMagic(data []byte) string {
 	if len(data) < magicPrefixLen {
 		return ""
 	}
-	if data[0] == 0x50 && data[1] == 0x4B && data[2] == 0x03 && data[3] == 0x04 {
+	if isZipMagic(data) {
 		return FormatEPUB
 	}
 	if bytes.Equal(data[:magicPrefixLen], []byte("%PDF")) {
 		return FormatPDF
 	}
+	if isMOBIMagic(data) {
+		return FormatMOBI
+	}
+	if isFB2Magic(data) {
+		return FormatFB2
+	}
 	return ""
 }
 
+// DetectFormatFromFile is DetectFormatFromMagic for a whole file: a zip is
+// opened and reported as FormatCBZ when it holds page images rather than an
+// EPUB container, or as FormatFB2 when it wraps a single FictionBook. A zip
+// that can't be opened stays FormatEPUB so Extract reports the error as
+// before.
+func DetectFormatFromFile(r io.ReaderAt, size int64) string {
+	head := make([]byte, min(size, sniffLen))
+	if n, _ := r.ReadAt(head, 0); n < len(head) {
+		return ""
+	}
+	format := DetectFormatFromMagic(head)
+	if format != FormatEPUB {
+		return format
+	}
+	zr, err := zip.NewReader(r, size)
+	if err != nil || len(zr.File) > maxZipEntries {
+		return format
+	}
+	if fb2ZipEntry(zr) != nil {
+		return FormatFB2
+	}
+	if IsComicArchive(zr) {
+		return FormatCBZ
+	}
+	return format
+}
+
+// isZipMagic reports whether data starts with a zip local file header
+// (PK\x03\x04), the magic shared by EPUB, CBZ and zipped FB2.
+func isZipMagic(data []byte) bool {
+	return len(data) >= magicPrefixLen &&
+		data[0] == 0x50 && data[1] == 0x4B && data[2] == 0x03 && data[3] == 0x04
+}
+
 // Extract reads bibliographic metadata from r.
-// format must be FormatEPUB or FormatPDF.
+// format must be one of the Format constants.
 func Extract(
 	format string,
 	r io.ReaderAt,
@@ -93,6 +176,12 @@ func Extract(
 		return extractEPUB(r, size)
 	case FormatPDF:
 		return extractPDF(r, size)
+	case FormatCBZ:
+		return extractCBZ(r, size)
+	case FormatMOBI:
+		return extractMOBI(r, size)
+	case FormatFB2:
+		return extractFB2(r, size)
 	default:
 		return Metadata{}, fmt.Errorf("ebookmeta: unsupported format %q", format)
 	}
@@ -110,7 +199,19 @@ type epubRootfile struct {
 }
 
 type opfPackage struct {
-	Metadata opfMetadata `xml:"http://www.idpf.org/2007/opf metadata"`
+	Metadata opfMetadata  `xml:"http://www.idpf.org/2007/opf metadata"`
+	Manifest []opfItem    `xml:"http://www.idpf.org/2007/opf manifest>item"`
+	Spine    []opfItemRef `xml:"http://www.idpf.org/2007/opf spine>itemref"`
+}
+
+type opfItem struct {
+	ID        string `xml:"id,attr"`
+	Href      string `xml:"href,attr"`
+	MediaType string `xml:"media-type,attr"`
+}
+
+type opfItemRef struct {
+	IDRef string `xml:"idref,attr"`
 }
 
 type opfMetadata struct {
@@ -119,6 +220,18 @@ type opfMetadata struct {
 	Creators    []string        `xml:"http://purl.org/dc/elements/1.1/ creator"`
 	Identifiers []opfIdentifier `xml:"http://purl.org/dc/elements/1.1/ identifier"`
 	Languages   []string        `xml:"http://purl.org/dc/elements/1.1/ language"`
+	Metas       []opfMeta       `xml:"http://www.idpf.org/2007/opf meta"`
+}
+
+// opfMeta is an OPF <meta>: either the EPUB 2 name/content form calibre
+// writes or an EPUB 3 property, optionally refining another element by id.
+type opfMeta struct {
+	Name     string `xml:"name,attr"`
+	Content  string `xml:"content,attr"`
+	Property string `xml:"property,attr"`
+	Refines  string `xml:"refines,attr"`
+	ID       string `xml:"id,attr"`
+	Value    string `xml:",chardata"`
 }
 
 type opfIdentifier struct {
@@ -127,36 +240,56 @@ type opfIdentifier struct {
 }
 
 func extractEPUB(r io.ReaderAt, size int64) (Metadata, error) {
+	zr, err := openEPUBZip(r, size)
+	if err != nil {
+		return Metadata{}, err
+	}
+	pkg, _, err := readOPF(zr)
+	if err != nil {
+		return Metadata{}, err
+	}
+	return opfToMetadata(pkg.Metadata), nil
+}
+
+func openEPUBZip(r io.ReaderAt, size int64) (*zip.Reader, error) {
 	zr, err := zip.NewReader(r, size)
 	if err != nil {
-		return Metadata{}, fmt.Errorf("ebookmeta: open epub zip: %w", err)
+		return nil, fmt.Errorf("ebookmeta: open epub zip: %w", err)
 	}
 	if len(zr.File) > maxZipEntries {
-		return Metadata{}, fmt.Errorf(
+		return nil, fmt.Errorf(
 			"ebookmeta: zip has too many entries (%d)",
 			len(zr.File),
 		)
 	}
+	return zr, nil
+}
+
+// readOPF parses the EPUB's package document and returns it along with its
+// path inside the zip.
+func readOPF(zr *zip.Reader) (opfPackage, string, error) {
 	opfPath, err := epubOPFPath(zr)
 	if err != nil {
-		return Metadata{}, err
+		return opfPackage{}, "", err
 	}
 	f := zipFile(zr, opfPath)
 	if f == nil {
-		return Metadata{}, fmt.Errorf("ebookmeta: OPF not found at %q", opfPath)
+		return opfPackage{}, "", fmt.Errorf(
+			"ebookmeta: OPF not found at %q", opfPath,
+		)
 	}
 	rc, err := f.Open()
 	if err != nil {
-		return Metadata{}, fmt.Errorf("ebookmeta: open OPF: %w", err)
+		return opfPackage{}, "", fmt.Errorf("ebookmeta: open OPF: %w", err)
 	}
 	defer rc.Close()
 
 	var pkg opfPackage
 	decodeErr := xml.NewDecoder(io.LimitReader(rc, maxXMLReadBytes)).Decode(&pkg)
 	if decodeErr != nil {
-		return Metadata{}, fmt.Errorf("ebookmeta: parse OPF: %w", decodeErr)
+		return opfPackage{}, "", fmt.Errorf("ebookmeta: parse OPF: %w", decodeErr)
 	}
-	return opfToMetadata(pkg.Metadata), nil
+	return pkg, opfPath, nil
 }
 
 func opfToMetadata(meta opfMetadata) Metadata {
@@ -180,9 +313,50 @@ func opfToMetadata(meta opfMetadata) Metadata {
 			m.ISBN13 = i13
 		}
 	}
+	m.Series, m.SeriesIndex = opfSeries(meta.Metas)
 	return m
 }
 
+// opfSeries reads the book's series from calibre's series metas, falling
+// back to an EPUB 3 belongs-to-collection that isn't refined as a "set".
+func opfSeries(metas []opfMeta) (string, string) {
+	var series, index string
+	for _, m := range metas {
+		switch m.Name {
+		case "calibre:series":
+			series = strings.TrimSpace(m.Content)
+		case "calibre:series_index":
+			index = strings.TrimSpace(m.Content)
+		}
+	}
+	if series != "" {
+		return series, index
+	}
+
+	for _, c := range metas {
+		name := strings.TrimSpace(c.Value)
+		if c.Property != "belongs-to-collection" || name == "" {
+			continue
+		}
+		var kind, position string
+		for _, r := range metas {
+			if c.ID == "" || r.Refines != "#"+c.ID {
+				continue
+			}
+			switch r.Property {
+			case "collection-type":
+				kind = strings.TrimSpace(r.Value)
+			case "group-position":
+				position = strings.TrimSpace(r.Value)
+			}
+		}
+		if kind != "set" {
+			return name, position
+		}
+	}
+	return "", ""
+}
+
 func epubOPFPath(zr *zip.Reader) (string, error) {
 	cf := zipFile(zr, "META-INF/container.xml")
 	if cf == nil {
@@ -178,6 +178,48 @@ func TestDetectFormat(t *testing.T) {
 			contentType: "application/pdf",
 			want:        ebookmeta.FormatPDF,
 		},
+		{
+			name:        "zip magic named cbz",
+			magic:       epubMagic,
+			filename:    "Saga 01.CBZ",
+			contentType: "application/zip",
+			want:        ebookmeta.FormatCBZ,
+		},
+		{
+			name:        "cbz content-type fallback",
+			magic:       nil,
+			filename:    "",
+			contentType: "application/vnd.comicbook+zip",
+			want:        ebookmeta.FormatCBZ,
+		},
+		{
+			name:        "azw3 filename fallback",
+			magic:       []byte{0x00, 0x01},
+			filename:    "Dune.azw3",
+			contentType: "",
+			want:        ebookmeta.FormatMOBI,
+		},
+		{
+			name:        "mobi content-type fallback",
+			magic:       nil,
+			filename:    "",
+			contentType: "application/x-mobipocket-ebook",
+			want:        ebookmeta.FormatMOBI,
+		},
+		{
+			name:        "fb2 filename fallback",
+			magic:       []byte{0x00, 0x01},
+			filename:    "picnic.fb2",
+			contentType: "",
+			want:        ebookmeta.FormatFB2,
+		},
+		{
+			name:        "zip magic named fb2.zip",
+			magic:       epubMagic,
+			filename:    "picnic.fb2.zip",
+			contentType: "application/zip",
+			want:        ebookmeta.FormatFB2,
+		},
 		{
 			name:        "unknown returns empty",
 			magic:       []byte{0xDE, 0xAD},
@@ -299,6 +341,55 @@ func TestExtract_EPUB(t *testing.T) {
 	}
 }
 
+func TestExtract_EPUB_Series(t *testing.T) {
+	t.Parallel()
+
+	extract := func(t *testing.T, data []byte) ebookmeta.Metadata {
+		t.Helper()
+		got, err := ebookmeta.Extract(
+			ebookmeta.FormatEPUB, bytes.NewReader(data), int64(len(data)),
+		)
+		require.NoError(t, err)
+		return got
+	}
+
+	t.Run("calibre metas", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		src := buildTestEPUB3(t)
+		require.NoError(t, ebookmeta.RewriteEPUB(&out,
+			bytes.NewReader(src), int64(len(src)),
+			ebookmeta.Overrides{ //nolint:exhaustruct // series only
+				Series:      "New Series",
+				SeriesIndex: "2.5",
+			}))
+		got := extract(t, out.Bytes())
+		assert.Equal(t, "New Series", got.Series)
+		assert.Equal(t, "2.5", got.SeriesIndex)
+	})
+
+	t.Run("epub3 collection", func(t *testing.T) {
+		t.Parallel()
+		opf := strings.Replace(testEPUB3OPF,
+			`<meta name="calibre:series" content="Old Series"/>`,
+			`<meta property="belongs-to-collection" id="set">Box Set</meta>
+    <meta refines="#set" property="collection-type">set</meta>`, 1)
+		opf = strings.Replace(opf,
+			`<meta refines="#s1" property="collection-type">series</meta>`,
+			`<meta refines="#s1" property="group-position">3</meta>`, 1)
+		got := extract(t, buildTestEPUBWithOPF(t, opf))
+		assert.Equal(t, "Old Series", got.Series)
+		assert.Equal(t, "3", got.SeriesIndex)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+		got := extract(t, buildTestEPUB("Title", []string{"A"}, "", ""))
+		assert.Empty(t, got.Series)
+		assert.Empty(t, got.SeriesIndex)
+	})
+}
+
 func TestExtract_EPUB_InvalidZip(t *testing.T) {
 	t.Parallel()
 	r := strings.NewReader("not a zip file at all")
@@ -342,6 +433,10 @@ func TestDetectFormatFromMagic(t *testing.T) {
 	}{
 		{"epub magic", []byte{0x50, 0x4B, 0x03, 0x04, 0x00}, ebookmeta.FormatEPUB},
 		{"pdf magic", []byte("%PDF-rest"), ebookmeta.FormatPDF},
+		{"mobi magic", append(make([]byte, 60), "BOOKMOBI"...), ebookmeta.FormatMOBI},
+		{"fb2 magic", []byte(`<?xml version="1.0"?><FictionBook>`),
+			ebookmeta.FormatFB2},
+		{"plain xml", []byte(`<?xml version="1.0"?><html>`), ""},
 		{"wrong magic", []byte{0x00, 0x01, 0x02, 0x03}, ""},
 		{"too short", []byte{0x50, 0x4B}, ""},
 		{"empty", []byte{}, ""},
@@ -414,6 +509,6 @@ func TestExtract_PDF(t *testing.T) {
 func TestExtract_UnsupportedFormat(t *testing.T) {
 	t.Parallel()
 	r := strings.NewReader("")
-	_, err := ebookmeta.Extract("mobi", r, 0)
+	_, err := ebookmeta.Extract("djvu", r, 0)
 	assert.ErrorContains(t, err, "unsupported format")
 }
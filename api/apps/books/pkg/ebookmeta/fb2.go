@@ -0,0 +1,461 @@
+package ebookmeta
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html/charset"
+)
+
+// maxFB2Bytes caps the size of an FB2 document, which embeds its images as
+// base64 and is read into memory whole.
+const maxFB2Bytes = 64 << 20
+
+// maxHeadingLevel is the deepest HTML heading section titles map to.
+const maxHeadingLevel = 6
+
+// fb2Elements maps FictionBook body elements onto the HTML that opens and
+// closes them. Elements not listed here keep their text but lose their tag.
+//
+//nolint:gochecknoglobals // static lookup table
+var fb2Elements = map[string][2]string{
+	"p":             {"<p>", "</p>"},
+	"emphasis":      {"<em>", "</em>"},
+	"strong":        {"<strong>", "</strong>"},
+	"strikethrough": {"<s>", "</s>"},
+	"sub":           {"<sub>", "</sub>"},
+	"sup":           {"<sup>", "</sup>"},
+	"code":          {"<code>", "</code>"},
+	"empty-line":    {"<br/>", ""},
+	"subtitle":      {"<p><strong>", "</strong></p>"},
+	"epigraph":      {"<blockquote>", "</blockquote>"},
+	"cite":          {"<blockquote>", "</blockquote>"},
+	"annotation":    {"<div>", "</div>"},
+	"text-author":   {"<p><em>", "</em></p>"},
+	"date":          {"<p><em>", "</em></p>"},
+	"poem":          {"<div>", "</div>"},
+	"stanza":        {"<p>", "</p>"},
+	"v":             {"", "<br/>"},
+	"table":         {"<table>", "</table>"},
+	"tr":            {"<tr>", "</tr>"},
+	"th":            {"<th>", "</th>"},
+	"td":            {"<td>", "</td>"},
+}
+
+// fb2ImageExts maps FB2 binary content types to image file extensions.
+//
+//nolint:gochecknoglobals // static lookup table
+var fb2ImageExts = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/jpg":  ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+type fb2Description struct {
+	TitleInfo struct {
+		Authors   []fb2Author `xml:"author"`
+		BookTitle string      `xml:"book-title"`
+		Lang      string      `xml:"lang"`
+		Sequences []struct {
+			Name   string `xml:"name,attr"`
+			Number string `xml:"number,attr"`
+		} `xml:"sequence"`
+	} `xml:"title-info"`
+	PublishInfo struct {
+		ISBN string `xml:"isbn"`
+	} `xml:"publish-info"`
+}
+
+type fb2Author struct {
+	FirstName  string `xml:"first-name"`
+	MiddleName string `xml:"middle-name"`
+	LastName   string `xml:"last-name"`
+	Nickname   string `xml:"nickname"`
+}
+
+func (a fb2Author) name() string {
+	name := strings.Join(strings.Fields(
+		a.FirstName+" "+a.MiddleName+" "+a.LastName,
+	), " ")
+	if name == "" {
+		return strings.TrimSpace(a.Nickname)
+	}
+	return name
+}
+
+func (d fb2Description) metadata() Metadata {
+	var m Metadata
+	m.Title = strings.TrimSpace(d.TitleInfo.BookTitle)
+	for _, a := range d.TitleInfo.Authors {
+		if name := a.name(); name != "" {
+			m.Authors = append(m.Authors, name)
+		}
+	}
+	if lang := strings.TrimSpace(d.TitleInfo.Lang); lang != "" {
+		m.Language = &lang
+	}
+	m.ISBN13, _ = classifyISBN("", d.PublishInfo.ISBN)
+	for _, seq := range d.TitleInfo.Sequences {
+		if name := strings.TrimSpace(seq.Name); name != "" {
+			m.Series = name
+			m.SeriesIndex = strings.TrimSpace(seq.Number)
+			break
+		}
+	}
+	return m
+}
+
+// isFB2Magic reports whether data starts like a FictionBook document: XML
+// whose root element, within the sniffed prefix, is FictionBook.
+func isFB2Magic(data []byte) bool {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if !bytes.HasPrefix(data, []byte("<?xml")) &&
+		!bytes.HasPrefix(data, []byte("<FictionBook")) {
+		return false
+	}
+	return bytes.Contains(data, []byte("<FictionBook"))
+}
+
+// fb2ZipEntry returns the FictionBook inside a zipped FB2 (.fb2.zip), or nil
+// when zr is not one.
+func fb2ZipEntry(zr *zip.Reader) *zip.File {
+	if zipFile(zr, "META-INF/container.xml") != nil {
+		return nil
+	}
+	for _, f := range zr.File {
+		if strings.HasSuffix(strings.ToLower(f.Name), ".fb2") {
+			return f
+		}
+	}
+	return nil
+}
+
+// readFB2 returns the FictionBook XML of a plain or zipped FB2 file.
+func readFB2(r io.ReaderAt, size int64) ([]byte, error) {
+	head := make([]byte, min(size, magicPrefixLen))
+	if err := readFullAt(r, head, 0); err != nil {
+		return nil, fmt.Errorf("ebookmeta: read fb2: %w", err)
+	}
+	if !isZipMagic(head) {
+		if size > maxFB2Bytes {
+			return nil, errors.New("ebookmeta: fb2 too large")
+		}
+		data := make([]byte, size)
+		if err := readFullAt(r, data, 0); err != nil {
+			return nil, fmt.Errorf("ebookmeta: read fb2: %w", err)
+		}
+		return data, nil
+	}
+
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("ebookmeta: open fb2 zip: %w", err)
+	}
+	if len(zr.File) > maxZipEntries {
+		return nil, fmt.Errorf(
+			"ebookmeta: zip has too many entries (%d)",
+			len(zr.File),
+		)
+	}
+	f := fb2ZipEntry(zr)
+	if f == nil {
+		return nil, errors.New("ebookmeta: no .fb2 file in zip")
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("ebookmeta: open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(io.LimitReader(rc, maxFB2Bytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("ebookmeta: read %s: %w", f.Name, err)
+	}
+	if len(data) > maxFB2Bytes {
+		return nil, errors.New("ebookmeta: fb2 too large")
+	}
+	return data, nil
+}
+
+// newFB2Decoder returns a lenient decoder for FictionBook XML, which is
+// frequently windows-1251 and sprinkled with HTML entities.
+func newFB2Decoder(data []byte) *xml.Decoder {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	d.CharsetReader = charset.NewReaderLabel
+	d.Strict = false
+	d.Entity = xml.HTMLEntity
+	return d
+}
+
+func extractFB2(r io.ReaderAt, size int64) (Metadata, error) {
+	data, err := readFB2(r, size)
+	if err != nil {
+		return Metadata{}, err
+	}
+	desc, err := readFB2Description(newFB2Decoder(data))
+	if err != nil {
+		return Metadata{}, err
+	}
+	return desc.metadata(), nil
+}
+
+func readFB2Description(d *xml.Decoder) (fb2Description, error) {
+	var desc fb2Description
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return desc, nil
+		}
+		if err != nil {
+			return desc, fmt.Errorf("ebookmeta: parse fb2: %w", err)
+		}
+		if start, ok := tok.(xml.StartElement); ok &&
+			start.Name.Local == "description" {
+			if err = d.DecodeElement(&desc, &start); err != nil {
+				return desc, fmt.Errorf("ebookmeta: parse fb2 description: %w", err)
+			}
+			return desc, nil
+		}
+	}
+}
+
+// ReadFB2 reads the content of a FictionBook (.fb2 or .fb2.zip) as a
+// Document: its bodies become one HTML document and its embedded binaries
+// the images.
+func ReadFB2(r io.ReaderAt, size int64) (Document, error) {
+	data, err := readFB2(r, size)
+	if err != nil {
+		return Document{}, err
+	}
+	desc, err := readFB2Description(newFB2Decoder(data))
+	if err != nil {
+		return Document{}, err
+	}
+	// Binaries follow the bodies that reference them, so they are collected
+	// in a first pass.
+	binaries, err := readFB2Binaries(newFB2Decoder(data))
+	if err != nil {
+		return Document{}, err
+	}
+
+	fr := &fb2Renderer{ //nolint:exhaustruct // output and stacks start empty
+		binaries: binaries,
+		named:    map[string]string{},
+	}
+	fr.out.WriteString("<!DOCTYPE html>\n<html><head><meta charset=\"utf-8\"/>")
+	fr.out.WriteString(
+		"<title>" + html.EscapeString(desc.metadata().Title) + "</title>",
+	)
+	fr.out.WriteString("</head><body>\n")
+	if err = fr.render(newFB2Decoder(data)); err != nil {
+		return Document{}, err
+	}
+	fr.out.WriteString("</body></html>\n")
+	return Document{HTML: fr.out.Bytes(), Images: fr.images}, nil
+}
+
+// fb2Binary is an embedded image, decoded.
+type fb2Binary struct {
+	ext  string
+	data []byte
+}
+
+func readFB2Binaries(d *xml.Decoder) (map[string]fb2Binary, error) {
+	binaries := map[string]fb2Binary{}
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return binaries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ebookmeta: parse fb2: %w", err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "binary" {
+			continue
+		}
+		var bin struct {
+			ID          string `xml:"id,attr"`
+			ContentType string `xml:"content-type,attr"`
+			Data        string `xml:",chardata"`
+		}
+		if err = d.DecodeElement(&bin, &start); err != nil {
+			return nil, fmt.Errorf("ebookmeta: parse fb2 binary: %w", err)
+		}
+		ext, known := fb2ImageExts[strings.ToLower(bin.ContentType)]
+		if !known || bin.ID == "" {
+			continue
+		}
+		raw, decodeErr := base64.StdEncoding.DecodeString(
+			strings.Join(strings.Fields(bin.Data), ""),
+		)
+		if decodeErr != nil {
+			continue
+		}
+		binaries[bin.ID] = fb2Binary{ext: ext, data: raw}
+	}
+}
+
+// fb2Renderer streams FictionBook bodies out as HTML.
+type fb2Renderer struct {
+	out      bytes.Buffer
+	binaries map[string]fb2Binary
+	// named maps a binary id to the Image name it was emitted under.
+	named  map[string]string
+	images []Image
+	// closers holds the closing HTML of each open element.
+	closers []string
+	// sections is the current <section> nesting depth.
+	sections int
+	// title counts the paragraphs of the open <title>, nil outside one.
+	title *int
+}
+
+func (fr *fb2Renderer) render(d *xml.Decoder) error {
+	inBody := 0
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("ebookmeta: parse fb2: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch {
+			case t.Name.Local == "body":
+				inBody++
+				fr.open("<div>", "</div>")
+			case inBody == 0:
+				// description, binaries and stylesheets are not content.
+				if t.Name.Local != "FictionBook" {
+					if err = d.Skip(); err != nil {
+						return fmt.Errorf("ebookmeta: parse fb2: %w", err)
+					}
+				}
+			case t.Name.Local == "image":
+				fr.image(t)
+				if err = d.Skip(); err != nil {
+					return fmt.Errorf("ebookmeta: parse fb2: %w", err)
+				}
+			default:
+				fr.start(t)
+			}
+		case xml.EndElement:
+			if inBody == 0 {
+				continue
+			}
+			if t.Name.Local == "body" {
+				inBody--
+			}
+			fr.end(t)
+		case xml.CharData:
+			if inBody > 0 {
+				fr.out.WriteString(html.EscapeString(string(t)))
+			}
+		}
+	}
+}
+
+func (fr *fb2Renderer) open(openHTML, closeHTML string) {
+	fr.out.WriteString(openHTML)
+	fr.closers = append(fr.closers, closeHTML)
+}
+
+func (fr *fb2Renderer) start(t xml.StartElement) {
+	id := xmlAttr(t, "id")
+	switch t.Name.Local {
+	case "section":
+		fr.sections++
+		fr.open("<section"+idAttr(id)+">", "</section>")
+	case "title":
+		level := min(fr.sections+1, maxHeadingLevel)
+		paragraphs := 0
+		fr.title = &paragraphs
+		fr.open(fmt.Sprintf("<h%d>", level), fmt.Sprintf("</h%d>", level))
+	case "p":
+		if fr.title != nil {
+			// Title lines are one heading, broken rather than nested.
+			if *fr.title > 0 {
+				fr.out.WriteString("<br/>")
+			}
+			*fr.title++
+			fr.open("", "")
+			return
+		}
+		fr.open("<p"+idAttr(id)+">", "</p>")
+	case "a":
+		href := xmlAttr(t, "href")
+		if href == "" {
+			fr.open("", "")
+			return
+		}
+		fr.open(`<a href="`+html.EscapeString(href)+`">`, "</a>")
+	default:
+		tags, ok := fb2Elements[t.Name.Local]
+		if !ok {
+			fr.open("", "")
+			return
+		}
+		fr.open(tags[0], tags[1])
+	}
+}
+
+func (fr *fb2Renderer) end(t xml.EndElement) {
+	if len(fr.closers) == 0 {
+		return
+	}
+	last := len(fr.closers) - 1
+	fr.out.WriteString(fr.closers[last])
+	fr.closers = fr.closers[:last]
+	switch t.Name.Local {
+	case "section":
+		fr.sections--
+	case "title":
+		fr.title = nil
+	}
+}
+
+// image emits an <img> for an FB2 image element whose href names one of the
+// document's binaries ("#cover.jpg"); any other reference is dropped.
+func (fr *fb2Renderer) image(t xml.StartElement) {
+	id := strings.TrimPrefix(xmlAttr(t, "href"), "#")
+	name, ok := fr.named[id]
+	if !ok {
+		bin, found := fr.binaries[id]
+		if !found {
+			return
+		}
+		name = "fb2_img_" + strconv.Itoa(len(fr.images)) + bin.ext
+		fr.named[id] = name
+		fr.images = append(fr.images, Image{Name: name, Data: bin.data})
+	}
+	fr.out.WriteString(`<img src="` + name + `" alt=""/>`)
+}
+
+// xmlAttr returns the value of t's attribute local, in any namespace (FB2
+// links use xlink:href, usually bound to the "l" prefix).
+func xmlAttr(t xml.StartElement, local string) string {
+	for _, a := range t.Attr {
+		if a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func idAttr(id string) string {
+	if id == "" {
+		return ""
+	}
+	return ` id="` + html.EscapeString(id) + `"`
+}
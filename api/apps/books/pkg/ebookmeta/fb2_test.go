@@ -0,0 +1,138 @@
+package ebookmeta_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+)
+
+//nolint:gochecknoglobals // test fixture
+var testFB2 = `<?xml version="1.0" encoding="utf-8"?>
+<FictionBook xmlns="http://www.gribuser.ru/xml/fictionbook/2.0"
+    xmlns:l="http://www.w3.org/1999/xlink">
+  <description>
+    <title-info>
+      <genre>sf</genre>
+      <author><first-name>Arkady</first-name><last-name>Strugatsky</last-name></author>
+      <author><first-name>Boris</first-name><last-name>Strugatsky</last-name></author>
+      <book-title>Roadside Picnic</book-title>
+      <lang>en</lang>
+      <sequence name="Noon Universe" number="7"/>
+      <coverpage><image l:href="#cover.jpg"/></coverpage>
+    </title-info>
+    <publish-info><isbn>978-1-61374-341-6</isbn></publish-info>
+  </description>
+  <body>
+    <title><p>Roadside Picnic</p><p>A Novel</p></title>
+    <section id="ch1">
+      <title><p>Chapter 1</p></title>
+      <epigraph><p>You have to make good out of evil.</p></epigraph>
+      <p>Red &amp; <emphasis>Kirill</emphasis>&nbsp;went<a l:href="#n1"
+        type="note">1</a>.</p>
+      <image l:href="#cover.jpg"/>
+      <empty-line/>
+      <poem><stanza><v>line one</v><v>line two</v></stanza></poem>
+    </section>
+  </body>
+  <body name="notes">
+    <section id="n1"><p>A footnote.</p></section>
+  </body>
+  <binary id="cover.jpg" content-type="image/jpeg">` +
+	base64.StdEncoding.EncodeToString(testJPEG) + `</binary>
+  <binary id="font.ttf" content-type="application/x-font-ttf">AAAA</binary>
+</FictionBook>`
+
+func TestExtract_FB2(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(testFB2)
+	got, err := ebookmeta.Extract(
+		ebookmeta.FormatFB2, bytes.NewReader(data), int64(len(data)),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "Roadside Picnic", got.Title)
+	assert.Equal(t,
+		[]string{"Arkady Strugatsky", "Boris Strugatsky"}, got.Authors)
+	assert.Equal(t, ptr("9781613743416"), got.ISBN13)
+	assert.Equal(t, ptr("en"), got.Language)
+	assert.Equal(t, "Noon Universe", got.Series)
+	assert.Equal(t, "7", got.SeriesIndex)
+}
+
+func TestExtract_FB2_Windows1251(t *testing.T) {
+	t.Parallel()
+
+	// "Пикник" in windows-1251.
+	title := "\xcf\xe8\xea\xed\xe8\xea"
+	data := []byte(`<?xml version="1.0" encoding="windows-1251"?>` +
+		`<FictionBook><description><title-info>` +
+		`<author><nickname>anon</nickname></author>` +
+		`<book-title>` + title + `</book-title>` +
+		`</title-info></description></FictionBook>`)
+
+	got, err := ebookmeta.Extract(
+		ebookmeta.FormatFB2, bytes.NewReader(data), int64(len(data)),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "Пикник", got.Title)
+	assert.Equal(t, []string{"anon"}, got.Authors)
+}
+
+func TestReadFB2(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(testFB2)
+	doc, err := ebookmeta.ReadFB2(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	out := string(doc.HTML)
+	assert.Contains(t, out, "<title>Roadside Picnic</title>")
+	assert.Contains(t, out, "<h1>Roadside Picnic<br/>A Novel</h1>")
+	assert.Contains(t, out, `<section id="ch1">`)
+	assert.Contains(t, out, "<h2>Chapter 1</h2>")
+	assert.Contains(t, out,
+		"<blockquote><p>You have to make good out of evil.</p></blockquote>")
+	assert.Contains(t, out,
+		"<p>Red &amp; <em>Kirill</em>\u00a0went<a href=\"#n1\">1</a>.</p>")
+	assert.Contains(t, out, `<img src="fb2_img_0.jpg" alt=""/>`)
+	assert.Contains(t, out, "<p>line one<br/>line two<br/></p>")
+	assert.Contains(t, out, `<section id="n1"><p>A footnote.</p></section>`)
+	assert.NotContains(t, out, "sf")
+	assert.NotContains(t, out, "AAAA")
+
+	require.Len(t, doc.Images, 1)
+	assert.Equal(t, "fb2_img_0.jpg", doc.Images[0].Name)
+	assert.Equal(t, testJPEG, doc.Images[0].Data)
+}
+
+func TestDetectFormatFromFile_FB2(t *testing.T) {
+	t.Parallel()
+
+	var zipped bytes.Buffer
+	zw := zip.NewWriter(&zipped)
+	writeZipEntry(zw, "picnic.fb2", testFB2)
+	require.NoError(t, zw.Close())
+
+	for name, data := range map[string][]byte{
+		"plain":  []byte(testFB2),
+		"zipped": zipped.Bytes(),
+		"bom":    append([]byte("\xef\xbb\xbf"), testFB2...),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			r := bytes.NewReader(data)
+			assert.Equal(t, ebookmeta.FormatFB2,
+				ebookmeta.DetectFormatFromFile(r, int64(len(data))))
+
+			got, err := ebookmeta.Extract(ebookmeta.FormatFB2, r, int64(len(data)))
+			require.NoError(t, err)
+			assert.Equal(t, "Roadside Picnic", got.Title)
+		})
+	}
+}
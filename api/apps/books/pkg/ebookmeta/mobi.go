@@ -0,0 +1,494 @@
+package ebookmeta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// PalmDB container layout. All integers in a MOBI file are big-endian.
+const (
+	palmDBHeaderLen  = 78
+	palmDBTypeOffset = 60
+	palmDBCountOff   = 76
+	palmDBEntryLen   = 8
+	palmDBTypeMOBI   = "BOOKMOBI"
+)
+
+// Offsets into a section's record 0: a 16-byte PalmDOC header followed by
+// the MOBI header proper, which starts at rec0MOBIMagic.
+const (
+	rec0Compression   = 0x00
+	rec0TextRecords   = 0x08
+	rec0Encryption    = 0x0C
+	rec0MOBIMagic     = 0x10
+	rec0HeaderLen     = 0x14
+	rec0Encoding      = 0x1C
+	rec0Version       = 0x24
+	rec0FullName      = 0x54
+	rec0FullNameLen   = 0x58
+	rec0FirstResource = 0x6C
+	rec0HuffRecord    = 0x70
+	rec0HuffCount     = 0x74
+	rec0EXTHFlags     = 0x80
+	rec0FDST          = 0xC0
+	rec0ExtraFlags    = 0xF2
+	rec0FragIndex     = 0xF8
+	rec0SkelIndex     = 0xFC
+
+	// extraFlagsMinHeaderLen is the shortest MOBI header that carries the
+	// trailing-entry flags at rec0ExtraFlags.
+	extraFlagsMinHeaderLen = 0xE4
+)
+
+const (
+	compressionNone     = 1
+	compressionPalmDoc  = 2
+	compressionHuffCDIC = 17480
+
+	encodingUTF8 = 65001
+
+	exthFlagPresent = 0x40
+	kf8Version      = 8
+
+	// nullIndex marks an absent record index in a MOBI header.
+	nullIndex = 0xFFFFFFFF
+)
+
+// EXTH record types read by this package.
+const (
+	exthAuthor      = 100
+	exthISBN        = 104
+	exthKF8Boundary = 121
+	exthTitle       = 503
+	exthLanguage    = 524
+)
+
+// maxMOBITextBytes caps the decompressed text of a MOBI book, guarding
+// against corrupt record counts and compression bombs alike.
+const maxMOBITextBytes = 64 << 20
+
+// ErrDRMProtected is returned for books whose text is encrypted.
+var ErrDRMProtected = errors.New("ebookmeta: book is DRM-protected")
+
+var errCorruptMOBI = errors.New("ebookmeta: corrupt mobi")
+
+// palmDB is the record table of a PalmDB file. Records are read on demand,
+// so opening a large book for its metadata only touches record 0.
+type palmDB struct {
+	r io.ReaderAt
+	// offsets[i] is where record i starts; the last entry is the file size.
+	offsets []int64
+}
+
+func openPalmDB(r io.ReaderAt, size int64) (*palmDB, error) {
+	hdr := make([]byte, palmDBHeaderLen)
+	if err := readFullAt(r, hdr, 0); err != nil {
+		return nil, fmt.Errorf("ebookmeta: read palmdb header: %w", err)
+	}
+	if !isMOBIMagic(hdr) {
+		return nil, errors.New("ebookmeta: not a mobi file")
+	}
+	n := int(binary.BigEndian.Uint16(hdr[palmDBCountOff:]))
+	table := make([]byte, n*palmDBEntryLen)
+	if err := readFullAt(r, table, palmDBHeaderLen); err != nil {
+		return nil, fmt.Errorf("ebookmeta: read palmdb records: %w", err)
+	}
+
+	offsets := make([]int64, n+1)
+	for i := range n {
+		offsets[i] = int64(binary.BigEndian.Uint32(table[i*palmDBEntryLen:]))
+	}
+	offsets[n] = size
+	for i := range n {
+		if offsets[i] < palmDBHeaderLen || offsets[i] > offsets[i+1] {
+			return nil, fmt.Errorf("%w: record table out of order", errCorruptMOBI)
+		}
+	}
+	return &palmDB{r: r, offsets: offsets}, nil
+}
+
+func (db *palmDB) count() int {
+	return len(db.offsets) - 1
+}
+
+func (db *palmDB) record(i int) ([]byte, error) {
+	if i < 0 || i >= db.count() {
+		return nil, fmt.Errorf("%w: record %d out of range", errCorruptMOBI, i)
+	}
+	buf := make([]byte, db.offsets[i+1]-db.offsets[i])
+	if err := readFullAt(db.r, buf, db.offsets[i]); err != nil {
+		return nil, fmt.Errorf("ebookmeta: read record %d: %w", i, err)
+	}
+	return buf, nil
+}
+
+// readFullAt fills buf from r at off. io.ReaderAt may report io.EOF
+// alongside a full read at the end of the input, which is not an error here.
+func readFullAt(r io.ReaderAt, buf []byte, off int64) error {
+	n, err := r.ReadAt(buf, off)
+	if n == len(buf) {
+		return nil
+	}
+	if err == nil || errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// isMOBIMagic reports whether data starts with a PalmDB header of type
+// BOOKMOBI.
+func isMOBIMagic(data []byte) bool {
+	end := palmDBTypeOffset + len(palmDBTypeMOBI)
+	return len(data) >= end && string(data[palmDBTypeOffset:end]) == palmDBTypeMOBI
+}
+
+// mobiHeader is the parsed record 0 of one MOBI section. A joint MOBI/KF8
+// file has two sections: the legacy MOBI 6 one starting at record 0 and the
+// KF8 one its EXTH 121 points at. Record indices stored in a header are
+// relative to the section's first record, start.
+type mobiHeader struct {
+	start         int
+	compression   uint16
+	textRecords   int
+	utf8          bool
+	version       uint32
+	fullName      []byte
+	firstResource uint32
+	huffRecord    uint32
+	huffCount     uint32
+	extraFlags    uint16
+	fdst          uint32
+	fragIndex     uint32
+	skelIndex     uint32
+	exth          map[uint32][][]byte
+}
+
+func parseMOBIHeader(db *palmDB, start int) (*mobiHeader, error) {
+	rec, err := db.record(start)
+	if err != nil {
+		return nil, err
+	}
+	if len(rec) < rec0HeaderLen+4 ||
+		string(rec[rec0MOBIMagic:rec0MOBIMagic+4]) != "MOBI" {
+		return nil, fmt.Errorf("%w: no MOBI header", errCorruptMOBI)
+	}
+	if be16(rec, rec0Encryption) != 0 {
+		return nil, ErrDRMProtected
+	}
+
+	headerEnd := min(rec0MOBIMagic+int(be32(rec, rec0HeaderLen)), len(rec))
+	// field reads a 32-bit header field, treating fields past the end of a
+	// short (older) header as absent.
+	field := func(off int) uint32 {
+		if off+4 > headerEnd {
+			return nullIndex
+		}
+		return be32(rec, off)
+	}
+
+	h := &mobiHeader{
+		start:         start,
+		compression:   be16(rec, rec0Compression),
+		textRecords:   int(be16(rec, rec0TextRecords)),
+		utf8:          field(rec0Encoding) == encodingUTF8,
+		version:       field(rec0Version),
+		fullName:      nil,
+		firstResource: field(rec0FirstResource),
+		huffRecord:    field(rec0HuffRecord),
+		huffCount:     field(rec0HuffCount),
+		extraFlags:    0,
+		fdst:          nullIndex,
+		fragIndex:     nullIndex,
+		skelIndex:     nullIndex,
+		exth:          nil,
+	}
+	if headerEnd-rec0MOBIMagic >= extraFlagsMinHeaderLen {
+		h.extraFlags = be16(rec, rec0ExtraFlags)
+	}
+	if h.isKF8() {
+		h.fdst = field(rec0FDST)
+		h.fragIndex = field(rec0FragIndex)
+		h.skelIndex = field(rec0SkelIndex)
+	}
+
+	nameOff, nameLen := int(field(rec0FullName)), int(field(rec0FullNameLen))
+	if nameOff >= 0 && nameLen >= 0 && nameOff+nameLen <= len(rec) {
+		h.fullName = rec[nameOff : nameOff+nameLen]
+	}
+	if field(rec0EXTHFlags)&exthFlagPresent != 0 {
+		h.exth = parseEXTH(rec[headerEnd:])
+	}
+	return h, nil
+}
+
+// isKF8 reports whether the section holds KF8 (AZW3) content.
+func (h *mobiHeader) isKF8() bool {
+	return h.version >= kf8Version && h.version != nullIndex
+}
+
+// parseEXTH reads the EXTH metadata block that follows the MOBI header.
+// A truncated block yields whatever records were complete.
+func parseEXTH(b []byte) map[uint32][][]byte {
+	exth := map[uint32][][]byte{}
+	if !bytes.HasPrefix(b, []byte("EXTH")) {
+		return exth
+	}
+	count := be32(b, 8)
+	off := 12
+	for range count {
+		typ, n := be32(b, off), int(be32(b, off+4))
+		if n < 8 || off+n > len(b) {
+			break
+		}
+		exth[typ] = append(exth[typ], b[off+8:off+n])
+		off += n
+	}
+	return exth
+}
+
+// decode converts text in the section's declared encoding (UTF-8 or
+// CP1252) to UTF-8.
+func (h *mobiHeader) decode(b []byte) string {
+	if h.utf8 {
+		return string(bytes.ToValidUTF8(b, []byte("\uFFFD")))
+	}
+	out, err := charmap.Windows1252.NewDecoder().Bytes(b)
+	if err != nil {
+		return string(b)
+	}
+	return string(out)
+}
+
+func (h *mobiHeader) exthString(typ uint32) string {
+	for _, v := range h.exth[typ] {
+		if s := strings.TrimSpace(h.decode(v)); s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+func (h *mobiHeader) metadata() Metadata {
+	var m Metadata
+	m.Title = h.exthString(exthTitle)
+	if m.Title == "" {
+		m.Title = strings.TrimSpace(h.decode(h.fullName))
+	}
+	for _, v := range h.exth[exthAuthor] {
+		if s := strings.TrimSpace(h.decode(v)); s != "" {
+			m.Authors = append(m.Authors, s)
+		}
+	}
+	for _, v := range h.exth[exthISBN] {
+		if i13, _ := classifyISBN("", h.decode(v)); i13 != nil {
+			m.ISBN13 = i13
+			break
+		}
+	}
+	if lang := h.exthString(exthLanguage); lang != "" {
+		m.Language = &lang
+	}
+	return m
+}
+
+// record reads record i of this section.
+func (h *mobiHeader) record(db *palmDB, i uint32) ([]byte, error) {
+	if i == nullIndex {
+		return nil, fmt.Errorf("%w: missing record", errCorruptMOBI)
+	}
+	return db.record(h.start + int(i))
+}
+
+// openMOBI opens a MOBI file and returns its record table and the header of
+// the section to read content from: the KF8 section when the file has one,
+// since its HTML is much closer to the publisher's source.
+func openMOBI(r io.ReaderAt, size int64) (*palmDB, *mobiHeader, error) {
+	db, err := openPalmDB(r, size)
+	if err != nil {
+		return nil, nil, err
+	}
+	h, err := parseMOBIHeader(db, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	if h.isKF8() {
+		return db, h, nil
+	}
+
+	boundary := h.exth[exthKF8Boundary]
+	if len(boundary) == 0 || len(boundary[0]) < 4 {
+		return db, h, nil
+	}
+	// EXTH 121 points at the KF8 record 0, though some writers point at the
+	// "BOUNDARY" marker record just before it.
+	at := int(binary.BigEndian.Uint32(boundary[0]))
+	for _, candidate := range []int{at, at + 1} {
+		if kf8, kf8Err := parseMOBIHeader(db, candidate); kf8Err == nil {
+			return db, kf8, nil
+		}
+	}
+	return db, h, nil
+}
+
+func extractMOBI(r io.ReaderAt, size int64) (Metadata, error) {
+	db, err := openPalmDB(r, size)
+	if err != nil {
+		return Metadata{}, err
+	}
+	h, err := parseMOBIHeader(db, 0)
+	if err != nil {
+		return Metadata{}, err
+	}
+	return h.metadata(), nil
+}
+
+// ReadMOBI reads the content of a MOBI, AZW or AZW3 book as a Document.
+func ReadMOBI(r io.ReaderAt, size int64) (Document, error) {
+	db, h, err := openMOBI(r, size)
+	if err != nil {
+		return Document{}, err
+	}
+	text, err := h.readText(db)
+	if err != nil {
+		return Document{}, err
+	}
+
+	parts := [][]byte{text}
+	if h.isKF8() {
+		parts, err = h.kf8Parts(db, text)
+		if err != nil {
+			return Document{}, err
+		}
+	}
+
+	return renderMOBIDocument(db, h, parts)
+}
+
+// readText decompresses and concatenates the section's text records.
+func (h *mobiHeader) readText(db *palmDB) ([]byte, error) {
+	var decompress func([]byte) ([]byte, error)
+	switch h.compression {
+	case compressionNone:
+		decompress = func(b []byte) ([]byte, error) { return b, nil }
+	case compressionPalmDoc:
+		decompress = palmDocDecompress
+	case compressionHuffCDIC:
+		huff, err := loadHuffCDIC(db, h)
+		if err != nil {
+			return nil, err
+		}
+		decompress = huff.decompress
+	default:
+		return nil, fmt.Errorf(
+			"ebookmeta: unsupported mobi compression %d", h.compression,
+		)
+	}
+
+	var text bytes.Buffer
+	for i := 1; i <= h.textRecords; i++ {
+		rec, err := h.record(db, uint32(i)) //nolint:gosec // i <= 65535
+		if err != nil {
+			return nil, err
+		}
+		chunk, err := decompress(trimTrailingEntries(rec, h.extraFlags))
+		if err != nil {
+			return nil, fmt.Errorf("ebookmeta: text record %d: %w", i, err)
+		}
+		if text.Len()+len(chunk) > maxMOBITextBytes {
+			return nil, fmt.Errorf("%w: text too large", errCorruptMOBI)
+		}
+		text.Write(chunk)
+	}
+	return text.Bytes(), nil
+}
+
+// trimTrailingEntries strips the trailing data MOBI appends to each text
+// record after the compressed bytes: one backward-sized entry per set bit of
+// flags above bit 0, then, when bit 0 is set, the multibyte overlap bytes.
+func trimTrailingEntries(rec []byte, flags uint16) []byte {
+	for f := flags >> 1; f != 0; f >>= 1 {
+		if f&1 == 0 {
+			continue
+		}
+		n := trailingEntrySize(rec)
+		if n > len(rec) {
+			return nil
+		}
+		rec = rec[:len(rec)-n]
+	}
+	if flags&1 != 0 && len(rec) > 0 {
+		n := min(int(rec[len(rec)-1]&0x3)+1, len(rec))
+		rec = rec[:len(rec)-n]
+	}
+	return rec
+}
+
+// trailingEntrySize decodes the size stored at the end of rec: up to four
+// 7-bit groups, where a set high bit marks the first group.
+func trailingEntrySize(rec []byte) int {
+	n := 0
+	for _, b := range rec[max(0, len(rec)-4):] {
+		if b&0x80 != 0 {
+			n = 0
+		}
+		n = n<<7 | int(b&0x7F)
+	}
+	return n
+}
+
+// palmDocDecompress expands PalmDOC's LZ77 variant: literals, runs of up to
+// eight raw bytes, space-prefixed characters and back references of 3–10
+// bytes up to 2047 bytes back.
+func palmDocDecompress(src []byte) ([]byte, error) {
+	out := make([]byte, 0, 2*len(src))
+	for i := 0; i < len(src); {
+		c := src[i]
+		i++
+		switch {
+		case c == 0 || (c >= 0x09 && c <= 0x7F):
+			out = append(out, c)
+		case c <= 0x08:
+			n := min(int(c), len(src)-i)
+			out = append(out, src[i:i+n]...)
+			i += n
+		case c >= 0xC0:
+			out = append(out, ' ', c^0x80)
+		default:
+			if i >= len(src) {
+				return out, nil
+			}
+			pair := int(c)<<8 | int(src[i])
+			i++
+			dist, n := (pair&0x3FFF)>>3, pair&0x7+3
+			if dist == 0 || dist > len(out) {
+				return nil, fmt.Errorf("%w: bad back reference", errCorruptMOBI)
+			}
+			for range n {
+				out = append(out, out[len(out)-dist])
+			}
+		}
+	}
+	return out, nil
+}
+
+// be16 and be32 read big-endian integers, returning 0 when b is too short so
+// truncated structures read as empty rather than panicking.
+func be16(b []byte, off int) uint16 {
+	if off < 0 || off+2 > len(b) {
+		return 0
+	}
+	return binary.BigEndian.Uint16(b[off:])
+}
+
+func be32(b []byte, off int) uint32 {
+	if off < 0 || off+4 > len(b) {
+		return 0
+	}
+	return binary.BigEndian.Uint32(b[off:])
+}
@@ -0,0 +1,237 @@
+package ebookmeta
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	xhtml "golang.org/x/net/html"
+)
+
+// kindleEmbedPrefix starts a KF8 image reference: kindle:embed:XXXX, where
+// XXXX is the 1-based resource number in base 32.
+const kindleEmbedPrefix = "kindle:embed:"
+
+// mobiDroppedElements are removed with their content: Kindle navigation
+// markup, and head elements that end up in the body once parts are merged.
+//
+//nolint:gochecknoglobals // static lookup table
+var mobiDroppedElements = map[string]struct{}{
+	"guide":  {},
+	"link":   {},
+	"meta":   {},
+	"script": {},
+	"style":  {},
+	"title":  {},
+}
+
+// mobiDroppedAttrs point into the MOBI file itself and mean nothing once the
+// HTML is extracted.
+//
+//nolint:gochecknoglobals // static lookup table
+var mobiDroppedAttrs = map[string]struct{}{
+	"aid":        {},
+	"filepos":    {},
+	"recindex":   {},
+	"hirecindex": {},
+	"lorecindex": {},
+}
+
+// mobiRenderer merges a book's HTML parts into one Document, extracting the
+// image resources they reference.
+type mobiRenderer struct {
+	db     *palmDB
+	h      *mobiHeader
+	images []Image
+	// named maps a resource number to its Image name; "" marks one that is
+	// not a usable image.
+	named map[int]string
+}
+
+func renderMOBIDocument(
+	db *palmDB, h *mobiHeader, parts [][]byte,
+) (Document, error) {
+	r := &mobiRenderer{db: db, h: h, images: nil, named: map[int]string{}}
+
+	var body bytes.Buffer
+	for _, part := range parts {
+		doc, err := xhtml.Parse(strings.NewReader(h.decode(part)))
+		if err != nil {
+			return Document{}, fmt.Errorf("ebookmeta: parse mobi html: %w", err)
+		}
+		b := findElement(doc, "body")
+		if b == nil {
+			continue
+		}
+		r.clean(b)
+		for c := b.FirstChild; c != nil; c = c.NextSibling {
+			if err = xhtml.Render(&body, c); err != nil {
+				return Document{}, fmt.Errorf("ebookmeta: render mobi html: %w", err)
+			}
+		}
+		body.WriteByte('\n')
+	}
+
+	var out bytes.Buffer
+	out.WriteString("<!DOCTYPE html>\n<html><head><meta charset=\"utf-8\"/>")
+	out.WriteString("<title>" + html.EscapeString(h.metadata().Title) + "</title>")
+	out.WriteString("</head><body>\n")
+	out.Write(body.Bytes())
+	out.WriteString("</body></html>\n")
+	return Document{HTML: out.Bytes(), Images: r.images}, nil
+}
+
+// clean rewrites n's subtree in place: Kindle-only elements and attributes
+// are removed, mbp: elements (page breaks and the like) are unwrapped and
+// image references are pointed at the extracted resources.
+func (r *mobiRenderer) clean(n *xhtml.Node) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		if c.Type != xhtml.ElementNode {
+			c = next
+			continue
+		}
+		if _, drop := mobiDroppedElements[c.Data]; drop {
+			n.RemoveChild(c)
+			c = next
+			continue
+		}
+		if c.Data == "img" {
+			if !r.resolveImage(c) {
+				n.RemoveChild(c)
+			}
+			c = next
+			continue
+		}
+
+		r.clean(c)
+		if strings.HasPrefix(c.Data, "mbp:") {
+			for gc := c.FirstChild; gc != nil; gc = c.FirstChild {
+				c.RemoveChild(gc)
+				n.InsertBefore(gc, c)
+			}
+			n.RemoveChild(c)
+		} else {
+			c.Attr = cleanMOBIAttrs(c.Attr)
+		}
+		c = next
+	}
+}
+
+func cleanMOBIAttrs(attrs []xhtml.Attribute) []xhtml.Attribute {
+	out := attrs[:0]
+	for _, a := range attrs {
+		if _, drop := mobiDroppedAttrs[a.Key]; drop {
+			continue
+		}
+		if a.Key == "href" && strings.HasPrefix(a.Val, "kindle:") {
+			continue
+		}
+		out = append(out, a)
+	}
+	return out
+}
+
+// resolveImage points an <img> at its extracted resource, reporting false
+// when it references none. MOBI 6 uses a decimal recindex attribute, KF8 a
+// kindle:embed src; both count resources from 1.
+func (r *mobiRenderer) resolveImage(img *xhtml.Node) bool {
+	num := -1
+	for _, a := range img.Attr {
+		var err error
+		switch {
+		case a.Key == "recindex" || a.Key == "hirecindex":
+			num, err = strconv.Atoi(a.Val)
+		case a.Key == "src" && strings.HasPrefix(a.Val, kindleEmbedPrefix):
+			ref, _, _ := strings.Cut(strings.TrimPrefix(a.Val, kindleEmbedPrefix), "?")
+			var v uint64
+			v, err = strconv.ParseUint(ref, 32, 16)
+			num = int(v)
+		default:
+			continue
+		}
+		if err != nil {
+			num = -1
+		}
+		if num > 0 {
+			break
+		}
+	}
+	name := r.imageName(num)
+	if name == "" {
+		return false
+	}
+
+	attrs := cleanMOBIAttrs(img.Attr)
+	img.Attr = attrs[:0]
+	hasAlt := false
+	for _, a := range attrs {
+		switch a.Key {
+		case "src":
+			continue
+		case "alt":
+			hasAlt = true
+		}
+		img.Attr = append(img.Attr, a)
+	}
+	img.Attr = append(img.Attr, xhtml.Attribute{Namespace: "", Key: "src", Val: name})
+	if !hasAlt {
+		img.Attr = append(img.Attr, xhtml.Attribute{Namespace: "", Key: "alt", Val: ""})
+	}
+	return true
+}
+
+// imageName extracts resource num on first use and returns its Image name,
+// or "" when num is not an image this package can embed.
+func (r *mobiRenderer) imageName(num int) string {
+	if num <= 0 || r.h.firstResource == nullIndex {
+		return ""
+	}
+	if name, ok := r.named[num]; ok {
+		return name
+	}
+	r.named[num] = ""
+
+	//nolint:gosec // num < 1<<16
+	rec, err := r.h.record(r.db, r.h.firstResource+uint32(num)-1)
+	if err != nil {
+		return ""
+	}
+	ext := imageExt(rec)
+	if ext == "" {
+		return ""
+	}
+	name := fmt.Sprintf("mobi_img_%04d%s", num, ext)
+	r.named[num] = name
+	r.images = append(r.images, Image{Name: name, Data: rec})
+	return name
+}
+
+// imageExt returns the file extension for image data by its magic bytes, or
+// "" for anything else (fonts, FLIS/FCIS records and the like).
+func imageExt(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8, 0xFF}):
+		return ".jpg"
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return ".png"
+	case bytes.HasPrefix(data, []byte("GIF8")):
+		return ".gif"
+	}
+	return ""
+}
+
+// findElement returns the first element named tag in n's subtree.
+func findElement(n *xhtml.Node, tag string) *xhtml.Node {
+	if n.Type == xhtml.ElementNode && n.Data == tag {
+		return n
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if found := findElement(c, tag); found != nil {
+			return found
+		}
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package ebookmeta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// HUFF/CDIC layout: one HUFF record holding the code tables, followed by
+// CDIC records holding the phrase dictionary the codes index into.
+const (
+	huffCacheOff   = 8
+	huffBaseOff    = 12
+	huffCacheLen   = 256
+	huffBaseLen    = 64
+	cdicHeaderLen  = 16
+	cdicPhrasesOff = 8
+	cdicBitsOff    = 12
+
+	// phraseFlagDone marks a dictionary phrase stored already expanded.
+	phraseFlagDone = 0x8000
+	phraseLenMask  = 0x7FFF
+
+	// maxHuffDepth bounds phrase-within-phrase expansion; real files nest
+	// only a few levels.
+	maxHuffDepth = 32
+	// maxHuffRecordBytes caps the expansion of one text record (4 KiB in
+	// practice).
+	maxHuffRecordBytes = 1 << 20
+)
+
+// huffCode is one entry of the HUFF lookup table indexed by a code's top
+// byte.
+type huffCode struct {
+	length   int
+	terminal bool
+	maxCode  uint32
+}
+
+type huffPhrase struct {
+	data     []byte
+	expanded bool
+	// busy marks a phrase being expanded, so a phrase that refers to itself
+	// is reported as corrupt instead of recursing forever.
+	busy bool
+}
+
+// huffCDIC decompresses text records of MOBI books compressed with Amazon's
+// Huffman/dictionary scheme (compression type 17480).
+type huffCDIC struct {
+	codes   [huffCacheLen]huffCode
+	minCode [33]uint32
+	maxCode [33]uint32
+	phrases []huffPhrase
+}
+
+func loadHuffCDIC(db *palmDB, h *mobiHeader) (*huffCDIC, error) {
+	if h.huffRecord == nullIndex || h.huffCount == 0 {
+		return nil, fmt.Errorf("%w: no huffman records", errCorruptMOBI)
+	}
+	rec, err := h.record(db, h.huffRecord)
+	if err != nil {
+		return nil, err
+	}
+	huff := &huffCDIC{}
+	if err = huff.loadHUFF(rec); err != nil {
+		return nil, err
+	}
+	for i := uint32(1); i < h.huffCount; i++ {
+		cdic, cdicErr := h.record(db, h.huffRecord+i)
+		if cdicErr != nil {
+			return nil, cdicErr
+		}
+		if err = huff.loadCDIC(cdic); err != nil {
+			return nil, err
+		}
+	}
+	return huff, nil
+}
+
+func (d *huffCDIC) loadHUFF(rec []byte) error {
+	cacheOff, baseOff := int(be32(rec, huffCacheOff)), int(be32(rec, huffBaseOff))
+	if !bytes.HasPrefix(rec, []byte("HUFF")) ||
+		cacheOff+huffCacheLen*4 > len(rec) || baseOff+huffBaseLen*4 > len(rec) {
+		return fmt.Errorf("%w: bad HUFF record", errCorruptMOBI)
+	}
+
+	for i := range huffCacheLen {
+		v := be32(rec, cacheOff+i*4)
+		length := int(v & 0x1F)
+		if length == 0 {
+			return fmt.Errorf("%w: zero-length huffman code", errCorruptMOBI)
+		}
+		d.codes[i] = huffCode{
+			length:   length,
+			terminal: v&0x80 != 0,
+			maxCode:  spreadCode(v>>8, length),
+		}
+	}
+	for length := 1; length <= 32; length++ {
+		off := baseOff + (length-1)*8
+		d.minCode[length] = uint32(uint64(be32(rec, off)) << (32 - length))
+		d.maxCode[length] = spreadCode(be32(rec, off+4), length)
+	}
+	return nil
+}
+
+// spreadCode left-aligns a length-bit maximum code in 32 bits, filling the
+// low bits with ones.
+func spreadCode(code uint32, length int) uint32 {
+	return uint32((uint64(code)+1)<<(32-length) - 1)
+}
+
+func (d *huffCDIC) loadCDIC(rec []byte) error {
+	if !bytes.HasPrefix(rec, []byte("CDIC")) {
+		return fmt.Errorf("%w: bad CDIC record", errCorruptMOBI)
+	}
+	total := int(be32(rec, cdicPhrasesOff))
+	bits := be32(rec, cdicBitsOff)
+	if bits > 16 {
+		return fmt.Errorf("%w: bad CDIC record", errCorruptMOBI)
+	}
+	n := min(1<<bits, total-len(d.phrases))
+	for i := range n {
+		off := cdicHeaderLen + int(be16(rec, cdicHeaderLen+i*2))
+		flags := int(be16(rec, off))
+		end := off + 2 + flags&phraseLenMask
+		if off+2 > len(rec) || end > len(rec) {
+			return fmt.Errorf("%w: CDIC phrase out of range", errCorruptMOBI)
+		}
+		d.phrases = append(d.phrases, huffPhrase{
+			data:     rec[off+2 : end],
+			expanded: flags&phraseFlagDone != 0,
+			busy:     false,
+		})
+	}
+	return nil
+}
+
+func (d *huffCDIC) decompress(data []byte) ([]byte, error) {
+	return d.unpack(data, 0)
+}
+
+// unpack decodes data bit by bit: each code's top byte picks its length from
+// the lookup table (walking minCode for longer codes), and the code then
+// indexes a phrase that may itself need unpacking.
+func (d *huffCDIC) unpack(data []byte, depth int) ([]byte, error) {
+	if depth > maxHuffDepth {
+		return nil, fmt.Errorf("%w: huffman phrases nest too deep", errCorruptMOBI)
+	}
+	bitsLeft := len(data) * 8
+	padded := make([]byte, len(data)+8)
+	copy(padded, data)
+
+	var out []byte
+	pos, shift := 0, 32
+	window := binary.BigEndian.Uint64(padded)
+	for {
+		if shift <= 0 {
+			pos += 4
+			window = binary.BigEndian.Uint64(padded[pos:])
+			shift += 32
+		}
+		code := uint32(window >> shift)
+
+		entry := d.codes[code>>24]
+		length, maxCode := entry.length, entry.maxCode
+		if !entry.terminal {
+			for length < 32 && code < d.minCode[length] {
+				length++
+			}
+			maxCode = d.maxCode[length]
+		}
+		shift -= length
+		bitsLeft -= length
+		if bitsLeft < 0 {
+			return out, nil
+		}
+
+		idx := int((maxCode - code) >> (32 - length))
+		if idx >= len(d.phrases) {
+			return nil, fmt.Errorf("%w: huffman phrase out of range", errCorruptMOBI)
+		}
+		phrase, err := d.phrase(idx, depth)
+		if err != nil {
+			return nil, err
+		}
+		if len(out)+len(phrase) > maxHuffRecordBytes {
+			return nil, fmt.Errorf("%w: huffman record too large", errCorruptMOBI)
+		}
+		out = append(out, phrase...)
+	}
+}
+
+// phrase returns dictionary phrase idx fully expanded, expanding and caching
+// it on first use.
+func (d *huffCDIC) phrase(idx, depth int) ([]byte, error) {
+	p := &d.phrases[idx]
+	if p.expanded {
+		return p.data, nil
+	}
+	if p.busy {
+		return nil, fmt.Errorf("%w: huffman phrase refers to itself", errCorruptMOBI)
+	}
+	p.busy = true
+	data, err := d.unpack(p.data, depth+1)
+	if err != nil {
+		return nil, err
+	}
+	p.data, p.expanded, p.busy = data, true, false
+	return data, nil
+}
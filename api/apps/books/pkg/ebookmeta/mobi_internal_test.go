@@ -0,0 +1,127 @@
+//nolint:testpackage // testing unexported MOBI decoding helpers
+package ebookmeta
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPalmDocDecompress(t *testing.T) {
+	t.Parallel()
+
+	// "ab" literals, a space+"c" pair, two raw bytes, then a back reference
+	// of distance 6, length 4 ("ab c" again).
+	src := []byte{'a', 'b', 'c' ^ 0x80, 0x02, 0xE9, 0x00, 0x80, 6<<3 | 1}
+	got, err := palmDocDecompress(src)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("ab c\xe9\x00ab c"), got)
+
+	_, err = palmDocDecompress([]byte{'a', 0x80, 9 << 3})
+	require.ErrorIs(t, err, errCorruptMOBI)
+}
+
+func TestTrimTrailingEntries(t *testing.T) {
+	t.Parallel()
+
+	// One trailing entry of 3 bytes, then one multibyte overlap byte.
+	rec := []byte{'t', 'e', 'x', 't', 0x00, 0xAA, 0xBB, 0x83}
+	assert.Equal(t, []byte("text"), trimTrailingEntries(rec, 0b11))
+	assert.Equal(t, rec, trimTrailingEntries(rec, 0))
+}
+
+func TestForwardVarint(t *testing.T) {
+	t.Parallel()
+
+	v, n := forwardVarint([]byte{0x01, 0x82, 0xFF})
+	assert.Equal(t, uint32(130), v)
+	assert.Equal(t, 2, n)
+
+	_, n = forwardVarint(nil)
+	assert.Zero(t, n)
+}
+
+// testHuffRecords builds a HUFF record whose codes are all 8 bits long, so
+// byte c selects phrase 255-c, and one CDIC record holding phrases.
+func testHuffRecords(phrases [][]byte, expanded []bool) ([]byte, []byte) {
+	const cacheOff, baseOff = 24, 24 + 256*4
+	huff := make([]byte, baseOff+64*4)
+	copy(huff, "HUFF")
+	binary.BigEndian.PutUint32(huff[8:], cacheOff)
+	binary.BigEndian.PutUint32(huff[12:], baseOff)
+	for i := range 256 {
+		binary.BigEndian.PutUint32(huff[cacheOff+i*4:], 0xFF<<8|0x80|8)
+	}
+
+	cdic := make([]byte, 16+2*len(phrases))
+	copy(cdic, "CDIC")
+	//nolint:gosec // test data
+	binary.BigEndian.PutUint32(cdic[8:], uint32(len(phrases)))
+	binary.BigEndian.PutUint32(cdic[12:], 8)
+	for i, p := range phrases {
+		//nolint:gosec // test data
+		binary.BigEndian.PutUint16(cdic[16+i*2:], uint16(len(cdic)-16))
+		//nolint:gosec // test data
+		flags := uint16(len(p))
+		if expanded[i] {
+			flags |= phraseFlagDone
+		}
+		cdic = binary.BigEndian.AppendUint16(cdic, flags)
+		cdic = append(cdic, p...)
+	}
+	return huff, cdic
+}
+
+func TestHuffCDIC(t *testing.T) {
+	t.Parallel()
+
+	phrases := make([][]byte, 256)
+	expanded := make([]bool, 256)
+	for i := range phrases {
+		phrases[i] = []byte{'?'}
+		expanded[i] = true
+	}
+	phrases[255-'a'] = []byte("Hello")
+	phrases[255-'b'] = []byte(", ")
+	phrases[255-'c'] = []byte("world")
+	// Phrase for 'd' is itself compressed: "a" then "c".
+	phrases[255-'d'], expanded[255-'d'] = []byte("ac"), false
+
+	huff, cdic := testHuffRecords(phrases, expanded)
+	d := &huffCDIC{}
+	require.NoError(t, d.loadHUFF(huff))
+	require.NoError(t, d.loadCDIC(cdic))
+
+	got, err := d.decompress([]byte("abc"))
+	require.NoError(t, err)
+	assert.Equal(t, "Hello, world", string(got))
+
+	got, err = d.decompress([]byte("dbd"))
+	require.NoError(t, err)
+	assert.Equal(t, "Helloworld, Helloworld", string(got))
+}
+
+func TestAssembleKF8(t *testing.T) {
+	t.Parallel()
+
+	flow := []byte("<body></body><p>one</p><p>two</p><body></body><p>three</p>")
+	skels := []kf8Skeleton{
+		{fragCount: 2, start: 0, length: 13},
+		{fragCount: 1, start: 33, length: 13},
+	}
+	frags := []kf8Fragment{
+		{insertPos: 6, length: 10},
+		{insertPos: 16, length: 10},
+		{insertPos: 39, length: 12},
+	}
+	parts, err := assembleKF8(flow, skels, frags)
+	require.NoError(t, err)
+	require.Len(t, parts, 2)
+	assert.Equal(t, "<body><p>one</p><p>two</p></body>", string(parts[0]))
+	assert.Equal(t, "<body><p>three</p></body>", string(parts[1]))
+
+	_, err = assembleKF8(flow, skels, frags[:2])
+	require.ErrorIs(t, err, errCorruptMOBI)
+}
@@ -0,0 +1,337 @@
+package ebookmeta
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+	"slices"
+	"strconv"
+)
+
+// INDX record layout. The first record of an index holds the TAGX table
+// describing each entry's tags; the records after it hold the entries.
+const (
+	indxHeaderLenOff = 4
+	indxIDXTOff      = 20
+	indxCountOff     = 24
+	tagxEntriesOff   = 12
+	tagxEntryLen     = 4
+	tagxEOF          = 0x01
+)
+
+// Tags of the KF8 skeleton and fragment indexes.
+const (
+	skelTagFragCount = 1
+	kf8TagPosition   = 6
+)
+
+// kf8Skeleton is one SKEL index entry: the HTML shell of one source file,
+// stored at [start, start+length) in the text and followed by its fragments.
+type kf8Skeleton struct {
+	fragCount int
+	start     int
+	length    int
+}
+
+// kf8Fragment is one FRAG index entry: length bytes of content inserted
+// into its skeleton at insertPos (an offset into the whole text).
+type kf8Fragment struct {
+	insertPos int
+	length    int
+}
+
+// kf8Parts rebuilds the book's HTML files from the KF8 text. KF8 stores each
+// file as a skeleton followed by its content fragments; the SKEL and FRAG
+// indexes say where each fragment goes. Without usable indexes the first
+// flow is returned whole — it is still HTML with the fragments in reading
+// order, just not nested inside their skeletons.
+func (h *mobiHeader) kf8Parts(db *palmDB, text []byte) ([][]byte, error) {
+	flow := h.firstFlow(db, text)
+	if h.skelIndex == nullIndex || h.fragIndex == nullIndex {
+		return [][]byte{flow}, nil
+	}
+	skels, err := readKF8Skeletons(db, h)
+	if err != nil {
+		return nil, err
+	}
+	frags, err := readKF8Fragments(db, h)
+	if err != nil {
+		return nil, err
+	}
+	return assembleKF8(flow, skels, frags)
+}
+
+// firstFlow returns the HTML flow of the text as delimited by the FDST
+// record; later flows hold CSS and SVG, which are not rendered.
+func (h *mobiHeader) firstFlow(db *palmDB, text []byte) []byte {
+	if h.fdst == nullIndex {
+		return text
+	}
+	rec, err := h.record(db, h.fdst)
+	if err != nil || !bytes.HasPrefix(rec, []byte("FDST")) || be32(rec, 8) == 0 {
+		return text
+	}
+	entries := int(be32(rec, 4))
+	start, end := int(be32(rec, entries)), int(be32(rec, entries+4))
+	if start > end || end > len(text) {
+		return text
+	}
+	return text[start:end]
+}
+
+func readKF8Skeletons(db *palmDB, h *mobiHeader) ([]kf8Skeleton, error) {
+	entries, err := readIndex(db, h, h.skelIndex)
+	if err != nil {
+		return nil, fmt.Errorf("ebookmeta: read skeleton index: %w", err)
+	}
+	skels := make([]kf8Skeleton, 0, len(entries))
+	for _, e := range entries {
+		count, pos := e.tags[skelTagFragCount], e.tags[kf8TagPosition]
+		if len(count) < 1 || len(pos) < 2 {
+			return nil, fmt.Errorf("%w: incomplete skeleton entry", errCorruptMOBI)
+		}
+		skels = append(skels, kf8Skeleton{
+			fragCount: int(count[0]),
+			start:     int(pos[0]),
+			length:    int(pos[1]),
+		})
+	}
+	return skels, nil
+}
+
+func readKF8Fragments(db *palmDB, h *mobiHeader) ([]kf8Fragment, error) {
+	entries, err := readIndex(db, h, h.fragIndex)
+	if err != nil {
+		return nil, fmt.Errorf("ebookmeta: read fragment index: %w", err)
+	}
+	frags := make([]kf8Fragment, 0, len(entries))
+	for _, e := range entries {
+		// A fragment's key is its insert position in decimal.
+		insertPos, convErr := strconv.Atoi(e.key)
+		pos := e.tags[kf8TagPosition]
+		if convErr != nil || len(pos) < 2 {
+			return nil, fmt.Errorf("%w: incomplete fragment entry", errCorruptMOBI)
+		}
+		frags = append(frags, kf8Fragment{insertPos: insertPos, length: int(pos[1])})
+	}
+	return frags, nil
+}
+
+// assembleKF8 inserts each skeleton's fragments into it, in order.
+func assembleKF8(
+	flow []byte, skels []kf8Skeleton, frags []kf8Fragment,
+) ([][]byte, error) {
+	errRange := fmt.Errorf("%w: kf8 fragment out of range", errCorruptMOBI)
+	parts := make([][]byte, 0, len(skels))
+	next := 0
+	for _, sk := range skels {
+		base := sk.start + sk.length
+		if sk.start < 0 || sk.length < 0 || base > len(flow) {
+			return nil, errRange
+		}
+		part := slices.Clone(flow[sk.start:base])
+		for range sk.fragCount {
+			if next >= len(frags) {
+				return nil, errRange
+			}
+			fr := frags[next]
+			next++
+			at := fr.insertPos - sk.start
+			if fr.length < 0 || base+fr.length > len(flow) || at < 0 || at > len(part) {
+				return nil, errRange
+			}
+			part = slices.Insert(part, at, flow[base:base+fr.length]...)
+			base += fr.length
+		}
+		parts = append(parts, part)
+	}
+	return parts, nil
+}
+
+// indexEntry is one entry of an INDX table: its key and tag values.
+type indexEntry struct {
+	key  string
+	tags map[byte][]uint32
+}
+
+// tagxEntry describes one tag of an index: how many values each occurrence
+// has and which control-byte bits encode its occurrence count.
+type tagxEntry struct {
+	tag            byte
+	valuesPerEntry int
+	mask           byte
+	eof            bool
+}
+
+// readIndex reads the INDX table whose header record is first (relative to
+// the section).
+func readIndex(db *palmDB, h *mobiHeader, first uint32) ([]indexEntry, error) {
+	hdr, err := h.record(db, first)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(hdr, []byte("INDX")) {
+		return nil, fmt.Errorf("%w: bad INDX record", errCorruptMOBI)
+	}
+	headerLen := int(be32(hdr, indxHeaderLenOff))
+	if headerLen > len(hdr) {
+		return nil, fmt.Errorf("%w: bad INDX record", errCorruptMOBI)
+	}
+	controlBytes, tagx, err := parseTAGX(hdr[headerLen:])
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []indexEntry
+	for i := range be32(hdr, indxCountOff) {
+		rec, recErr := h.record(db, first+1+i)
+		if recErr != nil {
+			return nil, recErr
+		}
+		recEntries, recErr := readIndexRecord(rec, controlBytes, tagx)
+		if recErr != nil {
+			return nil, recErr
+		}
+		entries = append(entries, recEntries...)
+	}
+	return entries, nil
+}
+
+func parseTAGX(b []byte) (int, []tagxEntry, error) {
+	if !bytes.HasPrefix(b, []byte("TAGX")) {
+		return 0, nil, fmt.Errorf("%w: missing TAGX", errCorruptMOBI)
+	}
+	end, controlBytes := int(be32(b, 4)), int(be32(b, 8))
+	if end > len(b) {
+		return 0, nil, fmt.Errorf("%w: bad TAGX", errCorruptMOBI)
+	}
+	var tagx []tagxEntry
+	for off := tagxEntriesOff; off+tagxEntryLen <= end; off += tagxEntryLen {
+		tagx = append(tagx, tagxEntry{
+			tag:            b[off],
+			valuesPerEntry: int(b[off+1]),
+			mask:           b[off+2],
+			eof:            b[off+3] == tagxEOF,
+		})
+	}
+	return controlBytes, tagx, nil
+}
+
+// readIndexRecord reads the entries of one INDX data record. The IDXT table
+// at the end of the record lists where each entry starts.
+func readIndexRecord(
+	rec []byte, controlBytes int, tagx []tagxEntry,
+) ([]indexEntry, error) {
+	idxt, count := int(be32(rec, indxIDXTOff)), int(be32(rec, indxCountOff))
+	if idxt+4+count*2 > len(rec) {
+		return nil, fmt.Errorf("%w: bad IDXT", errCorruptMOBI)
+	}
+	starts := make([]int, 0, count+1)
+	for j := range count {
+		starts = append(starts, int(be16(rec, idxt+4+j*2)))
+	}
+	starts = append(starts, idxt)
+
+	entries := make([]indexEntry, 0, count)
+	for j := range count {
+		start, end := starts[j], starts[j+1]
+		if start >= end || end > len(rec) {
+			return nil, fmt.Errorf("%w: bad index entry", errCorruptMOBI)
+		}
+		keyEnd := start + 1 + int(rec[start])
+		if keyEnd > end {
+			return nil, fmt.Errorf("%w: bad index entry", errCorruptMOBI)
+		}
+		tags, err := decodeTags(rec[keyEnd:end], controlBytes, tagx)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, indexEntry{
+			key:  string(rec[start+1 : keyEnd]),
+			tags: tags,
+		})
+	}
+	return entries, nil
+}
+
+// decodeTags decodes an index entry's tag values. The control bytes say, per
+// tag, either how many occurrences follow or (when every bit of a multi-bit
+// mask is set) how many bytes of values follow; the values themselves are
+// forward-encoded variable-width integers.
+func decodeTags(
+	data []byte, controlBytes int, tagx []tagxEntry,
+) (map[byte][]uint32, error) {
+	errTags := fmt.Errorf("%w: bad index tags", errCorruptMOBI)
+	if controlBytes > len(data) {
+		return nil, errTags
+	}
+	control, data := data[:controlBytes], data[controlBytes:]
+
+	type occurrence struct {
+		tag     byte
+		values  int // number of values, or -1 when byteLen applies
+		byteLen int
+	}
+	var found []occurrence
+	for _, t := range tagx {
+		if t.eof {
+			if len(control) > 0 {
+				control = control[1:]
+			}
+			continue
+		}
+		if len(control) == 0 {
+			break
+		}
+		v := control[0] & t.mask
+		switch {
+		case v == 0:
+			continue
+		case v == t.mask && bits.OnesCount8(t.mask) > 1:
+			n, used := forwardVarint(data)
+			if used == 0 {
+				return nil, errTags
+			}
+			data = data[used:]
+			found = append(found, occurrence{tag: t.tag, values: -1, byteLen: int(n)})
+		default:
+			for m := t.mask; m&1 == 0; m >>= 1 {
+				v >>= 1
+			}
+			found = append(found, occurrence{
+				tag: t.tag, values: int(v) * t.valuesPerEntry, byteLen: 0,
+			})
+		}
+	}
+
+	tags := make(map[byte][]uint32, len(found))
+	for _, o := range found {
+		var values []uint32
+		for read := 0; (o.values >= 0 && len(values) < o.values) ||
+			(o.values < 0 && read < o.byteLen); {
+			v, used := forwardVarint(data)
+			if used == 0 {
+				return nil, errTags
+			}
+			data = data[used:]
+			read += used
+			values = append(values, v)
+		}
+		tags[o.tag] = values
+	}
+	return tags, nil
+}
+
+// forwardVarint decodes a big-endian variable-width integer of up to four
+// 7-bit groups whose last byte has the high bit set. It returns the number
+// of bytes used, 0 when b is empty.
+func forwardVarint(b []byte) (uint32, int) {
+	var v uint32
+	for i := 0; i < len(b) && i < 4; i++ {
+		v = v<<7 | uint32(b[i]&0x7F)
+		if b[i]&0x80 != 0 {
+			return v, i + 1
+		}
+	}
+	return v, min(len(b), 4)
+}
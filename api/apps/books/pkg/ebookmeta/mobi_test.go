@@ -0,0 +1,373 @@
+package ebookmeta_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+)
+
+// ---- MOBI fixture builder ----
+
+const testMOBIHeaderLen = 0x108
+
+type exthRecord struct {
+	typ  uint32
+	data string
+}
+
+// testMOBI describes a MOBI file to build. Records are laid out as record 0,
+// the text records, the images, then extra (FDST, INDX, ...).
+type testMOBI struct {
+	kf8         bool
+	utf8        bool
+	encrypted   bool
+	compression uint16
+	extraFlags  uint16
+	fullName    string
+	exth        []exthRecord
+	text        [][]byte
+	images      [][]byte
+	extra       [][]byte
+	// fdst, frag and skel index into extra; -1 when absent.
+	fdst, frag, skel int
+}
+
+func newTestMOBI(text ...[]byte) testMOBI {
+	return testMOBI{
+		kf8:         false,
+		utf8:        true,
+		encrypted:   false,
+		compression: 1,
+		extraFlags:  0,
+		fullName:    "Full Name",
+		exth:        nil,
+		text:        text,
+		images:      nil,
+		extra:       nil,
+		fdst:        -1,
+		frag:        -1,
+		skel:        -1,
+	}
+}
+
+func (m testMOBI) build() []byte {
+	records := [][]byte{m.record0()}
+	records = append(records, m.text...)
+	records = append(records, m.images...)
+	records = append(records, m.extra...)
+
+	var buf bytes.Buffer
+	hdr := make([]byte, 78)
+	copy(hdr, "test book")
+	copy(hdr[60:], "BOOKMOBI")
+	//nolint:gosec // test data
+	binary.BigEndian.PutUint16(hdr[76:], uint16(len(records)))
+	buf.Write(hdr)
+
+	off := 78 + 8*len(records) + 2
+	for i, rec := range records {
+		entry := make([]byte, 8)
+		binary.BigEndian.PutUint32(entry, uint32(off))     //nolint:gosec // test data
+		binary.BigEndian.PutUint32(entry[4:], uint32(2*i)) //nolint:gosec // test data
+		buf.Write(entry)
+		off += len(rec)
+	}
+	buf.Write([]byte{0, 0})
+	for _, rec := range records {
+		buf.Write(rec)
+	}
+	return buf.Bytes()
+}
+
+func (m testMOBI) record0() []byte {
+	rec := make([]byte, 0x10+testMOBIHeaderLen)
+	put16 := func(off int, v uint16) { binary.BigEndian.PutUint16(rec[off:], v) }
+	put32 := func(off int, v uint32) { binary.BigEndian.PutUint32(rec[off:], v) }
+	extraIndex := func(i int) uint32 {
+		if i < 0 {
+			return 0xFFFFFFFF
+		}
+		return uint32(1 + len(m.text) + len(m.images) + i) //nolint:gosec // test data
+	}
+
+	textLen := 0
+	for _, t := range m.text {
+		textLen += len(t)
+	}
+	put16(0x00, m.compression)
+	put32(0x04, uint32(textLen))     //nolint:gosec // test data
+	put16(0x08, uint16(len(m.text))) //nolint:gosec // test data
+	put16(0x0A, 4096)
+	if m.encrypted {
+		put16(0x0C, 2)
+	}
+	copy(rec[0x10:], "MOBI")
+	put32(0x14, testMOBIHeaderLen)
+	put32(0x18, 2)
+	encoding := uint32(1252)
+	if m.utf8 {
+		encoding = 65001
+	}
+	put32(0x1C, encoding)
+	version := uint32(6)
+	if m.kf8 {
+		version = 8
+	}
+	put32(0x24, version)
+	put32(0x6C, uint32(1+len(m.text))) //nolint:gosec // test data
+	put32(0x70, 0xFFFFFFFF)
+	put32(0x80, 0x40)
+	put32(0xC0, extraIndex(m.fdst))
+	put16(0xF2, m.extraFlags)
+	put32(0xF8, extraIndex(m.frag))
+	put32(0xFC, extraIndex(m.skel))
+
+	var exth bytes.Buffer
+	for _, r := range m.exth {
+		_ = binary.Write(&exth, binary.BigEndian, r.typ)
+		//nolint:gosec // test data
+		_ = binary.Write(&exth, binary.BigEndian, uint32(8+len(r.data)))
+		exth.WriteString(r.data)
+	}
+	rec = append(rec, "EXTH"...)
+	//nolint:gosec // test data
+	rec = binary.BigEndian.AppendUint32(rec, uint32(12+exth.Len()))
+	//nolint:gosec // test data
+	rec = binary.BigEndian.AppendUint32(rec, uint32(len(m.exth)))
+	rec = append(rec, exth.Bytes()...)
+
+	put32(0x54, uint32(len(rec)))        //nolint:gosec // test data
+	put32(0x58, uint32(len(m.fullName))) //nolint:gosec // test data
+	rec = append(rec, m.fullName...)
+	return append(rec, 0, 0)
+}
+
+// literalPalmDoc "compresses" b with PalmDOC literals only, escaping the
+// bytes that would otherwise read as commands.
+func literalPalmDoc(b []byte) []byte {
+	var out []byte
+	for _, c := range b {
+		if c == 0 || (c >= 0x09 && c <= 0x7F) {
+			out = append(out, c)
+		} else {
+			out = append(out, 0x01, c)
+		}
+	}
+	return out
+}
+
+// varint forward-encodes v the way INDX entries store tag values.
+func varint(v uint32) []byte {
+	out := []byte{byte(v&0x7F) | 0x80}
+	for v >>= 7; v > 0; v >>= 7 {
+		out = append([]byte{byte(v & 0x7F)}, out...)
+	}
+	return out
+}
+
+// buildTestINDX returns the header and data records of an index whose
+// entries all carry the given tags, one control byte per entry.
+func buildTestINDX(tagx [][4]byte, keys []string, entries [][]byte) [][]byte {
+	const headerLen = 56
+	hdr := make([]byte, headerLen)
+	copy(hdr, "INDX")
+	binary.BigEndian.PutUint32(hdr[4:], headerLen)
+	binary.BigEndian.PutUint32(hdr[24:], 1)
+	hdr = append(hdr, "TAGX"...)
+	//nolint:gosec // test data
+	hdr = binary.BigEndian.AppendUint32(hdr, uint32(12+4*len(tagx)))
+	hdr = binary.BigEndian.AppendUint32(hdr, 1)
+	for _, t := range tagx {
+		hdr = append(hdr, t[:]...)
+	}
+
+	data := make([]byte, headerLen)
+	copy(data, "INDX")
+	binary.BigEndian.PutUint32(data[4:], headerLen)
+	var starts []uint16
+	for i, key := range keys {
+		starts = append(starts, uint16(len(data))) //nolint:gosec // test data
+		data = append(data, byte(len(key)))
+		data = append(data, key...)
+		data = append(data, entries[i]...)
+	}
+	binary.BigEndian.PutUint32(data[20:], uint32(len(data))) //nolint:gosec // test data
+	binary.BigEndian.PutUint32(data[24:], uint32(len(keys))) //nolint:gosec // test data
+	data = append(data, "IDXT"...)
+	for _, s := range starts {
+		data = binary.BigEndian.AppendUint16(data, s)
+	}
+	return [][]byte{hdr, data}
+}
+
+// testJPEG is enough of a JPEG for the resource sniffing to accept it.
+//
+//nolint:gochecknoglobals // test fixture
+var testJPEG = []byte{0xFF, 0xD8, 0xFF, 0xE0, 'J', 'F', 'I', 'F', 0xFF, 0xD9}
+
+// ---- tests ----
+
+func TestExtract_MOBI(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMOBI([]byte("<html><body>x</body></html>"))
+	m.exth = []exthRecord{
+		{100, "Frank Herbert"},
+		{100, "Brian Herbert"},
+		{104, "978-0-441-01359-3"},
+		{503, "Dune"},
+		{524, "en"},
+	}
+	data := m.build()
+
+	got, err := ebookmeta.Extract(
+		ebookmeta.FormatMOBI, bytes.NewReader(data), int64(len(data)),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "Dune", got.Title)
+	assert.Equal(t, []string{"Frank Herbert", "Brian Herbert"}, got.Authors)
+	assert.Equal(t, ptr("9780441013593"), got.ISBN13)
+	assert.Equal(t, ptr("en"), got.Language)
+}
+
+func TestExtract_MOBI_FullNameFallbackCP1252(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMOBI([]byte("x"))
+	m.utf8 = false
+	m.fullName = "Caf\xe9 Society"
+	data := m.build()
+
+	got, err := ebookmeta.Extract(
+		ebookmeta.FormatMOBI, bytes.NewReader(data), int64(len(data)),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "Café Society", got.Title)
+	assert.Empty(t, got.Authors)
+}
+
+func TestReadMOBI_MOBI6(t *testing.T) {
+	t.Parallel()
+
+	html := "<html><head><guide><reference type=\"toc\" filepos=\"1\"/></guide>" +
+		"</head><body><p>Caf\xe9 <b>au lait</b></p><mbp:pagebreak/>" +
+		"<p><a filepos=\"0000000012\">Next</a></p><img recindex=\"00001\"/>" +
+		"<img recindex=\"00002\"/></body></html>"
+	half := len(html) / 2
+	// Each record carries a 3-byte trailing entry and one multibyte byte.
+	trailer := []byte{0x00, 0xAA, 0xBB, 0x83}
+	m := newTestMOBI(
+		append(literalPalmDoc([]byte(html[:half])), trailer...),
+		append(literalPalmDoc([]byte(html[half:])), trailer...),
+	)
+	m.utf8 = false
+	m.compression = 2
+	m.extraFlags = 0b11
+	m.images = [][]byte{testJPEG, []byte("FONT not an image")}
+	m.exth = []exthRecord{{503, "Caf\xe9"}}
+	data := m.build()
+
+	doc, err := ebookmeta.ReadMOBI(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	out := string(doc.HTML)
+	assert.Contains(t, out, "<title>Café</title>")
+	assert.Contains(t, out, "<p>Café <b>au lait</b></p>")
+	assert.Contains(t, out, "<p><a>Next</a></p>")
+	assert.Contains(t, out, `<img src="mobi_img_0001.jpg" alt=""/>`)
+	assert.NotContains(t, out, "mbp:")
+	assert.NotContains(t, out, "guide")
+	assert.NotContains(t, out, "recindex")
+	require.Len(t, doc.Images, 1)
+	assert.Equal(t, "mobi_img_0001.jpg", doc.Images[0].Name)
+	assert.Equal(t, testJPEG, doc.Images[0].Data)
+}
+
+func TestReadMOBI_KF8(t *testing.T) {
+	t.Parallel()
+
+	skeleton := `<html><head><title>t</title>` +
+		`<link href="kindle:flow:0002?mime=text/css" rel="stylesheet"/>` +
+		`</head><body aid="0"></body></html>`
+	fragment := `<p aid="1">Chapter one</p>` +
+		`<img src="kindle:embed:0001?mime=image/jpeg"/>` +
+		`<a href="kindle:pos:fid:0000:off:0000000000">link</a>`
+	css := "p { margin: 0 }"
+	text := skeleton + fragment + css
+	insertAt := bytes.Index([]byte(skeleton), []byte("</body>"))
+
+	fdst := []byte("FDST")
+	fdst = binary.BigEndian.AppendUint32(fdst, 12)
+	fdst = binary.BigEndian.AppendUint32(fdst, 2)
+	for _, v := range []int{
+		0, len(skeleton) + len(fragment), len(skeleton) + len(fragment), len(text),
+	} {
+		//nolint:gosec // test data
+		fdst = binary.BigEndian.AppendUint32(fdst, uint32(v))
+	}
+
+	skelEntry := append([]byte{0x05}, varint(1)...)
+	skelEntry = append(skelEntry, varint(0)...)
+	//nolint:gosec // test data
+	skelEntry = append(skelEntry, varint(uint32(len(skeleton)))...)
+	skel := buildTestINDX(
+		[][4]byte{{1, 1, 0x03, 0}, {6, 2, 0x0C, 0}, {0, 0, 0, 1}},
+		[]string{"SKEL0000000"}, [][]byte{skelEntry},
+	)
+	fragEntry := append([]byte{0x0F}, varint(0)...)
+	fragEntry = append(fragEntry, varint(0)...)
+	fragEntry = append(fragEntry, varint(0)...)
+	fragEntry = append(fragEntry, varint(0)...)
+	//nolint:gosec // test data
+	fragEntry = append(fragEntry, varint(uint32(len(fragment)))...)
+	frag := buildTestINDX(
+		[][4]byte{
+			{2, 1, 0x01, 0}, {3, 1, 0x02, 0}, {4, 1, 0x04, 0},
+			{6, 2, 0x08, 0}, {0, 0, 0, 1},
+		},
+		[]string{fmt.Sprintf("%010d", insertAt)}, [][]byte{fragEntry},
+	)
+
+	m := newTestMOBI([]byte(text))
+	m.kf8 = true
+	m.images = [][]byte{testJPEG}
+	m.extra = append([][]byte{fdst}, append(skel, frag...)...)
+	m.fdst, m.skel, m.frag = 0, 1, 3
+	m.exth = []exthRecord{{503, "KF8 Book"}}
+	data := m.build()
+
+	doc, err := ebookmeta.ReadMOBI(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	out := string(doc.HTML)
+	assert.Contains(t, out, "<body>\n<p>Chapter one</p>")
+	assert.Contains(t, out, `<img src="mobi_img_0001.jpg" alt=""/>`)
+	assert.Contains(t, out, "<a>link</a>")
+	assert.NotContains(t, out, "margin")
+	assert.NotContains(t, out, "kindle:")
+	assert.NotContains(t, out, "aid=")
+	assert.Len(t, doc.Images, 1)
+}
+
+func TestReadMOBI_DRM(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMOBI([]byte("x"))
+	m.encrypted = true
+	data := m.build()
+
+	_, err := ebookmeta.ReadMOBI(bytes.NewReader(data), int64(len(data)))
+	require.ErrorIs(t, err, ebookmeta.ErrDRMProtected)
+}
+
+func TestDetectFormatFromFile_MOBI(t *testing.T) {
+	t.Parallel()
+
+	data := newTestMOBI([]byte("x")).build()
+	assert.Equal(t, ebookmeta.FormatMOBI,
+		ebookmeta.DetectFormatFromFile(bytes.NewReader(data), int64(len(data))))
+}
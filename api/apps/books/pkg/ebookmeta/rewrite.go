@@ -0,0 +1,143 @@
+package ebookmeta
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+)
+
+// Overrides are catalog values RewriteEPUB writes into an EPUB. Zero fields
+// leave the file's own metadata for that field in place.
+type Overrides struct {
+	Title   string
+	Authors []string
+	ISBN13  *string
+	// Description is written as dc:description; it may contain HTML, which
+	// is stored escaped the way calibre writes it.
+	Description *string
+	// Series is written both as calibre:series metadata (read by Kobo and
+	// KOReader) and, for EPUB 3, as a belongs-to-collection series.
+	Series string
+	// SeriesIndex is the book's position in Series, e.g. "2" or "2.5".
+	SeriesIndex string
+	// Cover replaces the book's cover image. It must be a JPEG, PNG or GIF;
+	// anything else is ignored.
+	Cover []byte
+}
+
+// RewriteEPUB copies the EPUB (or KEPUB) in r to w with its package
+// document's metadata and cover image replaced by o. Every other entry is
+// copied byte for byte, still compressed, so the book's content and any
+// KEPUB markup are untouched.
+func RewriteEPUB(w io.Writer, r io.ReaderAt, size int64, o Overrides) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return fmt.Errorf("ebookmeta: open epub zip: %w", err)
+	}
+	if len(zr.File) > maxZipEntries {
+		return fmt.Errorf("ebookmeta: zip has too many entries (%d)", len(zr.File))
+	}
+	opfPath, err := epubOPFPath(zr)
+	if err != nil {
+		return err
+	}
+	opfFile := zipFile(zr, opfPath)
+	if opfFile == nil {
+		return fmt.Errorf("ebookmeta: OPF not found at %q", opfPath)
+	}
+	opfData, err := readZipEntry(opfFile, maxXMLReadBytes)
+	if err != nil {
+		return fmt.Errorf("ebookmeta: read OPF: %w", err)
+	}
+
+	doc, err := scanOPF(opfData)
+	if err != nil {
+		return fmt.Errorf("ebookmeta: parse OPF: %w", err)
+	}
+	newOPF, coverHrefs := doc.rewrite(o)
+
+	coverPaths := map[string]bool{}
+	opfDir := path.Dir(opfPath)
+	for _, href := range coverHrefs {
+		if unescaped, unescErr := url.PathUnescape(href); unescErr == nil {
+			href = unescaped
+		}
+		coverPaths[path.Join(opfDir, href)] = true
+	}
+
+	zw := zip.NewWriter(w)
+	for _, f := range zr.File {
+		switch {
+		case f.Name == opfPath:
+			err = writeZipEntry(zw, f.Name, newOPF, zip.Deflate)
+		case coverPaths[f.Name]:
+			err = writeZipEntry(zw, f.Name, o.Cover, zip.Store)
+			delete(coverPaths, f.Name)
+		default:
+			err = copyZipEntry(zw, f)
+		}
+		if err != nil {
+			return fmt.Errorf("ebookmeta: write %s: %w", f.Name, err)
+		}
+	}
+	// A cover path not found among the entries is the one the rewrite
+	// added to the manifest.
+	for name := range coverPaths {
+		if err = writeZipEntry(zw, name, o.Cover, zip.Store); err != nil {
+			return fmt.Errorf("ebookmeta: write %s: %w", name, err)
+		}
+	}
+	if err = zw.Close(); err != nil {
+		return fmt.Errorf("ebookmeta: finish epub zip: %w", err)
+	}
+	return nil
+}
+
+// readZipEntry reads f whole, failing rather than truncating when it
+// decompresses to more than limit bytes.
+func readZipEntry(f *zip.File, limit int64) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("%s exceeds %d bytes", f.Name, limit)
+	}
+	return data, nil
+}
+
+func writeZipEntry(zw *zip.Writer, name string, data []byte, method uint16) error {
+	fw, err := zw.CreateHeader(&zip.FileHeader{ //nolint:exhaustruct // defaults
+		Name:   name,
+		Method: method,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fw, bytes.NewReader(data))
+	return err
+}
+
+// copyZipEntry copies f into zw without recompressing it, which also keeps
+// an EPUB's leading stored mimetype entry exactly as it was.
+func copyZipEntry(zw *zip.Writer, f *zip.File) error {
+	fw, err := zw.CreateRaw(&f.FileHeader)
+	if err != nil {
+		return err
+	}
+	rc, err := f.OpenRaw()
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fw, rc)
+	return err
+}
@@ -0,0 +1,511 @@
+package ebookmeta
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+)
+
+const (
+	dcNamespace = "http://purl.org/dc/elements/1.1/"
+
+	// libraryCoverID and librarySeriesID are the ids of elements the rewrite
+	// adds; neither is used by any common EPUB producer.
+	libraryCoverID  = "library-cover"
+	librarySeriesID = "library-series"
+
+	coverImageProperty = "cover-image"
+)
+
+// coverMediaTypes maps the image extensions imageExt sniffs to their media
+// types.
+//
+//nolint:gochecknoglobals // static lookup table
+var coverMediaTypes = map[string]string{
+	".jpg": "image/jpeg",
+	".png": "image/png",
+	".gif": "image/gif",
+}
+
+// opfElement is one element of the package document, located by byte
+// offsets into the original OPF so edits can splice around it and leave
+// everything else (comments, formatting, unknown metadata) as it was.
+type opfElement struct {
+	start, end int // the whole element, start tag to end tag
+	tagEnd     int // end of the start tag
+	prefix     string
+	local      string
+	attrs      []xml.Attr
+	text       string
+}
+
+func (e *opfElement) attr(local string) string {
+	for _, a := range e.attrs {
+		if a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// opfDocument is the parts of a package document the rewrite edits.
+type opfDocument struct {
+	data []byte
+	// ns maps the namespace prefixes declared on the package and metadata
+	// elements to their URIs.
+	ns       map[string]string
+	version  string
+	uniqueID string
+	metadata *opfElement
+	meta     []*opfElement
+	manifest *opfElement
+	items    []*opfElement
+}
+
+// opfEdit replaces data[start:end] with text; start == end inserts.
+type opfEdit struct {
+	start, end int
+	text       string
+}
+
+// scanOPF locates the metadata children and manifest items of an OPF. It
+// reads raw tokens so that namespace prefixes survive as written.
+func scanOPF(data []byte) (*opfDocument, error) {
+	doc := &opfDocument{
+		data:     data,
+		ns:       map[string]string{},
+		version:  "",
+		uniqueID: "",
+		metadata: nil,
+		meta:     nil,
+		manifest: nil,
+		items:    nil,
+	}
+	d := xml.NewDecoder(bytes.NewReader(data))
+	d.Entity = xml.HTMLEntity
+
+	var stack []*opfElement
+	for {
+		start := int(d.InputOffset())
+		tok, err := d.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			e := &opfElement{
+				start:  start,
+				end:    0,
+				tagEnd: int(d.InputOffset()),
+				prefix: t.Name.Space,
+				local:  t.Name.Local,
+				attrs:  t.Attr,
+				text:   "",
+			}
+			doc.enter(e, stack)
+			stack = append(stack, e)
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return nil, errors.New("unbalanced end element")
+			}
+			stack[len(stack)-1].end = int(d.InputOffset())
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			for _, e := range stack {
+				if doc.isMetaChild(e) {
+					e.text += string(t)
+				}
+			}
+		}
+	}
+	if doc.metadata == nil || doc.metadata.end == 0 {
+		return nil, errors.New("no metadata element")
+	}
+	return doc, nil
+}
+
+// enter records e, whose ancestors are stack, if the rewrite needs it.
+func (doc *opfDocument) enter(e *opfElement, stack []*opfElement) {
+	switch len(stack) {
+	case 0:
+		if e.local == "package" {
+			doc.declare(e)
+			doc.version = e.attr("version")
+			doc.uniqueID = e.attr("unique-identifier")
+		}
+	case 1:
+		switch e.local {
+		case "metadata":
+			doc.declare(e)
+			doc.metadata = e
+		case "manifest":
+			doc.manifest = e
+		}
+	case 2:
+		switch {
+		case stack[1] == doc.metadata:
+			doc.meta = append(doc.meta, e)
+		case stack[1] == doc.manifest && e.local == "item":
+			doc.items = append(doc.items, e)
+		}
+	}
+}
+
+func (doc *opfDocument) declare(e *opfElement) {
+	for _, a := range e.attrs {
+		switch {
+		case a.Name.Space == "xmlns":
+			doc.ns[a.Name.Local] = a.Value
+		case a.Name.Space == "" && a.Name.Local == "xmlns":
+			doc.ns[""] = a.Value
+		}
+	}
+}
+
+func (doc *opfDocument) isMetaChild(e *opfElement) bool {
+	return slices.Contains(doc.meta, e)
+}
+
+func (doc *opfDocument) isDC(e *opfElement, local string) bool {
+	return e.local == local && doc.ns[e.prefix] == dcNamespace
+}
+
+func (doc *opfDocument) epub3() bool {
+	return strings.HasPrefix(doc.version, "3")
+}
+
+// rewrite returns the OPF with o applied, plus the manifest hrefs whose
+// content must be replaced by o.Cover (including one it added, if the book
+// had no cover).
+func (doc *opfDocument) rewrite(o Overrides) ([]byte, []string) {
+	dropped := map[*opfElement]bool{}
+	droppedIDs := map[string]bool{}
+	refinedBy := doc.refinements()
+	for _, e := range doc.meta {
+		if e.attr("refines") == "" && doc.replaced(e, o, refinedBy) {
+			dropped[e] = true
+			if id := e.attr("id"); id != "" {
+				droppedIDs[id] = true
+			}
+		}
+	}
+	for _, e := range doc.meta {
+		if ref := e.attr("refines"); droppedIDs[strings.TrimPrefix(ref, "#")] {
+			dropped[e] = true
+		}
+	}
+
+	var edits []opfEdit
+	var coverHrefs []string
+	addedCover := false
+	if mediaType := coverMediaTypes[imageExt(o.Cover)]; mediaType != "" {
+		var coverEdits []opfEdit
+		coverHrefs, coverEdits, addedCover = doc.replaceCover(mediaType, dropped)
+		edits = append(edits, coverEdits...)
+	}
+
+	var lastKept *opfElement
+	for _, e := range doc.meta {
+		if dropped[e] {
+			edits = append(edits, doc.remove(e))
+		} else {
+			lastKept = e
+		}
+	}
+	if added := doc.additions(o, addedCover); added != "" {
+		edits = append(edits, doc.appendChild(doc.metadata, lastKept, added))
+	}
+	return applyEdits(doc.data, edits), coverHrefs
+}
+
+// refinements maps an element id to the values of the EPUB 3 <meta
+// refines="#id" property="…"> elements describing it, keyed by property.
+func (doc *opfDocument) refinements() map[string]map[string]string {
+	out := map[string]map[string]string{}
+	for _, e := range doc.meta {
+		ref := strings.TrimPrefix(e.attr("refines"), "#")
+		if ref == "" || e.local != "meta" {
+			continue
+		}
+		if out[ref] == nil {
+			out[ref] = map[string]string{}
+		}
+		out[ref][e.attr("property")] = strings.TrimSpace(e.text)
+	}
+	return out
+}
+
+// replaced reports whether o supplies a value that supersedes e.
+func (doc *opfDocument) replaced(
+	e *opfElement, o Overrides, refinedBy map[string]map[string]string,
+) bool {
+	id := e.attr("id")
+	switch {
+	case doc.isDC(e, "title"):
+		return o.Title != ""
+	case doc.isDC(e, "creator"):
+		role := e.attr("role")
+		if role == "" {
+			role = refinedBy[id]["role"]
+		}
+		return len(o.Authors) > 0 && (role == "" || role == "aut")
+	case doc.isDC(e, "identifier"):
+		if o.ISBN13 == nil || id == doc.uniqueID {
+			return false
+		}
+		isbn13, isbn10 := classifyISBN("", e.text)
+		return isbn13 != nil || isbn10 != nil
+	case doc.isDC(e, "description"):
+		return o.Description != nil
+	case e.local != "meta" || o.Series == "":
+		return false
+	}
+	switch e.attr("name") {
+	case "calibre:series", "calibre:series_index":
+		return true
+	}
+	return e.attr("property") == "belongs-to-collection" &&
+		refinedBy[id]["collection-type"] != "set"
+}
+
+// replaceCover finds the manifest items holding the cover image and
+// returns their hrefs, with an edit correcting each one's media-type if the
+// new image's differs. When there are none it adds a libraryCoverID item
+// instead, reports added and drops any <meta name="cover"> pointing at
+// nothing.
+func (doc *opfDocument) replaceCover(
+	mediaType string, dropped map[*opfElement]bool,
+) ([]string, []opfEdit, bool) {
+	coverIDs := map[string]bool{}
+	var coverMeta []*opfElement
+	for _, e := range doc.meta {
+		if e.local == "meta" && e.attr("name") == "cover" {
+			coverIDs[e.attr("content")] = true
+			coverMeta = append(coverMeta, e)
+		}
+	}
+
+	var hrefs []string
+	var edits []opfEdit
+	for _, item := range doc.items {
+		props := strings.Fields(item.attr("properties"))
+		if !coverIDs[item.attr("id")] && !slices.Contains(props, coverImageProperty) {
+			continue
+		}
+		hrefs = append(hrefs, item.attr("href"))
+		if item.attr("media-type") != mediaType {
+			edits = append(edits, doc.setAttr(item, "media-type", mediaType))
+		}
+	}
+	if len(hrefs) > 0 || doc.manifest == nil {
+		return hrefs, edits, false
+	}
+
+	for _, e := range coverMeta {
+		dropped[e] = true
+	}
+	item := "<" + qualify(doc.manifest.prefix, "item") +
+		` id="` + libraryCoverID + `" href="` + libraryCoverHref(mediaType) +
+		`" media-type="` + mediaType + `"`
+	if doc.epub3() {
+		item += ` properties="` + coverImageProperty + `"`
+	}
+	item += "/>"
+
+	var last *opfElement
+	indent := doc.indentAt(doc.manifest.tagEnd)
+	if n := len(doc.items); n > 0 {
+		last = doc.items[n-1]
+		indent = doc.indentAt(last.start)
+	}
+	edit := doc.appendChild(doc.manifest, last, indent+item)
+	return []string{libraryCoverHref(mediaType)}, []opfEdit{edit}, true
+}
+
+func libraryCoverHref(mediaType string) string {
+	for ext, mt := range coverMediaTypes {
+		if mt == mediaType {
+			return libraryCoverID + ext
+		}
+	}
+	return libraryCoverID
+}
+
+// additions renders the metadata elements carrying o, one per line, plus
+// the EPUB 2 cover pointer when the rewrite added a cover item.
+func (doc *opfDocument) additions(o Overrides, addedCover bool) string {
+	var lines []string
+	dc, dcDecl := "dc", ""
+	for prefix, uri := range doc.ns {
+		if uri == dcNamespace && prefix != "" {
+			dc = prefix
+			break
+		}
+	}
+	if doc.ns[dc] != dcNamespace {
+		dcDecl = ` xmlns:` + dc + `="` + dcNamespace + `"`
+	}
+	dcElem := func(local, text string) {
+		name := qualify(dc, local)
+		lines = append(lines, "<"+name+dcDecl+">"+escapeXML(text)+"</"+name+">")
+	}
+	meta := qualify(doc.metadata.prefix, "meta")
+	metaElem := func(attrs, text string) {
+		if text == "" {
+			lines = append(lines, "<"+meta+attrs+"/>")
+			return
+		}
+		lines = append(lines, "<"+meta+attrs+">"+escapeXML(text)+"</"+meta+">")
+	}
+
+	if o.Title != "" {
+		dcElem("title", o.Title)
+	}
+	for _, a := range o.Authors {
+		dcElem("creator", a)
+	}
+	if o.ISBN13 != nil {
+		dcElem("identifier", "urn:isbn:"+*o.ISBN13)
+	}
+	if o.Description != nil {
+		dcElem("description", *o.Description)
+	}
+	if o.Series != "" {
+		metaElem(` name="calibre:series" content="`+escapeXML(o.Series)+`"`, "")
+		if o.SeriesIndex != "" {
+			metaElem(` name="calibre:series_index" content="`+
+				escapeXML(o.SeriesIndex)+`"`, "")
+		}
+		if doc.epub3() {
+			metaElem(` property="belongs-to-collection" id="`+librarySeriesID+`"`,
+				o.Series)
+			ref := ` refines="#` + librarySeriesID + `"`
+			metaElem(ref+` property="collection-type"`, "series")
+			if o.SeriesIndex != "" {
+				metaElem(ref+` property="group-position"`, o.SeriesIndex)
+			}
+		}
+	}
+	if addedCover {
+		metaElem(` name="cover" content="`+libraryCoverID+`"`, "")
+	}
+
+	if len(lines) == 0 {
+		return ""
+	}
+	indent := "\n    "
+	if len(doc.meta) > 0 {
+		indent = doc.indentAt(doc.meta[0].start)
+	}
+	return indent + strings.Join(lines, indent)
+}
+
+// appendChild inserts text as parent's last child, right after last when
+// parent has children. A self-closing parent is expanded to hold it.
+func (doc *opfDocument) appendChild(parent, last *opfElement, text string) opfEdit {
+	if last != nil {
+		return opfEdit{start: last.end, end: last.end, text: text}
+	}
+	tag := string(doc.data[parent.start:parent.tagEnd])
+	if parent.end != parent.tagEnd || !strings.HasSuffix(tag, "/>") {
+		return opfEdit{start: parent.tagEnd, end: parent.tagEnd, text: text}
+	}
+	open := strings.TrimSpace(strings.TrimSuffix(tag, "/>")) + ">"
+	closing := "</" + qualify(parent.prefix, parent.local) + ">"
+	return opfEdit{
+		start: parent.start,
+		end:   parent.tagEnd,
+		text:  open + text + doc.indentAt(parent.start) + closing,
+	}
+}
+
+// remove deletes e along with the indentation and line break before it.
+func (doc *opfDocument) remove(e *opfElement) opfEdit {
+	start := e.start
+	for start > 0 && (doc.data[start-1] == ' ' || doc.data[start-1] == '\t') {
+		start--
+	}
+	if start > 0 && doc.data[start-1] == '\n' {
+		start--
+		if start > 0 && doc.data[start-1] == '\r' {
+			start--
+		}
+	}
+	return opfEdit{start: start, end: e.end, text: ""}
+}
+
+// indentAt returns the line break and indentation preceding offset, or a
+// line break and four spaces when the element there shares its line.
+func (doc *opfDocument) indentAt(offset int) string {
+	i := offset
+	for i > 0 && (doc.data[i-1] == ' ' || doc.data[i-1] == '\t') {
+		i--
+	}
+	if i == offset || i == 0 || doc.data[i-1] != '\n' {
+		return "\n    "
+	}
+	return "\n" + string(doc.data[i:offset])
+}
+
+// setAttr rewrites e's start tag with attribute local set to value.
+func (doc *opfDocument) setAttr(e *opfElement, local, value string) opfEdit {
+	var b strings.Builder
+	b.WriteString("<" + qualify(e.prefix, e.local))
+	for _, a := range e.attrs {
+		v := a.Value
+		if a.Name.Local == local {
+			v = value
+		}
+		b.WriteString(" " + qualify(a.Name.Space, a.Name.Local) +
+			`="` + escapeXML(v) + `"`)
+	}
+	if bytes.HasSuffix(doc.data[e.start:e.tagEnd], []byte("/>")) {
+		b.WriteString("/>")
+	} else {
+		b.WriteString(">")
+	}
+	return opfEdit{start: e.start, end: e.tagEnd, text: b.String()}
+}
+
+func applyEdits(data []byte, edits []opfEdit) []byte {
+	slices.SortStableFunc(edits, func(a, b opfEdit) int {
+		return a.start - b.start
+	})
+	var out bytes.Buffer
+	pos := 0
+	for _, e := range edits {
+		if e.start < pos {
+			// An insertion at the end of an element whose next sibling is
+			// removed along with the whitespace between them; writing it
+			// here lands it in the same place.
+			if e.start == e.end {
+				out.WriteString(e.text)
+			}
+			continue
+		}
+		out.Write(data[pos:e.start])
+		out.WriteString(e.text)
+		pos = e.end
+	}
+	out.Write(data[pos:])
+	return out.Bytes()
+}
+
+func qualify(prefix, local string) string {
+	if prefix == "" {
+		return local
+	}
+	return prefix + ":" + local
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,195 @@
+package ebookmeta_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+)
+
+const testEPUB3OPF = `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0"
+    unique-identifier="uid">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    <dc:identifier id="uid">urn:uuid:0b7f4c3e-1111-4222-8333-9444</dc:identifier>
+    <dc:identifier>9780000000002</dc:identifier>
+    <dc:title id="t1">Publisher Title</dc:title>
+    <meta refines="#t1" property="title-type">main</meta>
+    <dc:creator id="c1">Wrong Author</dc:creator>
+    <meta refines="#c1" property="role" scheme="marc:relators">aut</meta>
+    <dc:creator id="c2">Ilse Illustrator</dc:creator>
+    <meta refines="#c2" property="role" scheme="marc:relators">ill</meta>
+    <dc:language>en</dc:language>
+    <meta name="calibre:series" content="Old Series"/>
+    <meta property="belongs-to-collection" id="s1">Old Series</meta>
+    <meta refines="#s1" property="collection-type">series</meta>
+    <meta property="dcterms:modified">2020-01-01T00:00:00Z</meta>
+  </metadata>
+  <manifest>
+    <item id="cover" href="images/cover%20art.png" media-type="image/png"
+        properties="cover-image"/>
+    <item id="ch1" href="ch1.xhtml" media-type="application/xhtml+xml"/>
+  </manifest>
+  <spine><itemref idref="ch1"/></spine>
+</package>`
+
+const testChapter = `<html><body><p>Chapter one.</p></body></html>`
+
+// buildTestEPUB3 writes an EPUB with a stored mimetype entry, testEPUB3OPF
+// and a PNG-declared cover.
+func buildTestEPUB3(t *testing.T) []byte {
+	t.Helper()
+	return buildTestEPUBWithOPF(t, testEPUB3OPF)
+}
+
+// buildTestEPUBWithOPF writes an EPUB with a stored mimetype entry, the given
+// package document and the files testEPUB3OPF's manifest lists.
+func buildTestEPUBWithOPF(t *testing.T, opf string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	mt, err := zw.CreateHeader(&zip.FileHeader{ //nolint:exhaustruct // defaults
+		Name:   "mimetype",
+		Method: zip.Store,
+	})
+	require.NoError(t, err)
+	_, err = mt.Write([]byte("application/epub+zip"))
+	require.NoError(t, err)
+	writeZipEntry(zw, "META-INF/container.xml",
+		`<?xml version="1.0"?>`+
+			`<container xmlns="urn:oasis:names:tc:opendocument:xmlns:container"`+
+			` version="1.0"><rootfiles><rootfile full-path="OEBPS/content.opf"`+
+			` media-type="application/oebps-package+xml"/></rootfiles></container>`,
+	)
+	writeZipEntry(zw, "OEBPS/content.opf", opf)
+	writeZipEntry(zw, "OEBPS/images/cover art.png", "\x89PNG\r\n\x1a\nold")
+	writeZipEntry(zw, "OEBPS/ch1.xhtml", testChapter)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func rewriteEPUB(t *testing.T, data []byte, o ebookmeta.Overrides) *zip.Reader {
+	t.Helper()
+	var out bytes.Buffer
+	require.NoError(t,
+		ebookmeta.RewriteEPUB(&out, bytes.NewReader(data), int64(len(data)), o))
+	zr, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	require.NoError(t, err)
+	return zr
+}
+
+func zipEntryString(t *testing.T, zr *zip.Reader, name string) string {
+	t.Helper()
+	for _, f := range zr.File {
+		if f.Name != name {
+			continue
+		}
+		rc, err := f.Open()
+		require.NoError(t, err)
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		return string(data)
+	}
+	t.Fatalf("zip entry %s not found", name)
+	return ""
+}
+
+func TestRewriteEPUB_ReplacesMetadataAndCover(t *testing.T) {
+	t.Parallel()
+
+	zr := rewriteEPUB(t, buildTestEPUB3(t), ebookmeta.Overrides{
+		Title:       "Catalog Title",
+		Authors:     []string{"Right Author"},
+		ISBN13:      ptr("9781234567897"),
+		Description: ptr("<p>Blurb & more</p>"),
+		Series:      "New Series",
+		SeriesIndex: "2",
+		Cover:       testJPEG,
+	})
+
+	assert.Equal(t, "mimetype", zr.File[0].Name)
+	assert.Equal(t, zip.Store, zr.File[0].Method)
+	assert.Equal(t, testChapter, zipEntryString(t, zr, "OEBPS/ch1.xhtml"))
+	assert.Equal(t, string(testJPEG),
+		zipEntryString(t, zr, "OEBPS/images/cover art.png"))
+
+	opf := zipEntryString(t, zr, "OEBPS/content.opf")
+	for _, gone := range []string{
+		"Publisher Title", `refines="#t1"`, "Wrong Author", `refines="#c1"`,
+		"9780000000002", "Old Series", `refines="#s1"`, `media-type="image/png"`,
+	} {
+		assert.NotContains(t, opf, gone)
+	}
+	for _, kept := range []string{
+		"urn:uuid:0b7f4c3e", "Ilse Illustrator", `refines="#c2"`,
+		"<dc:language>en</dc:language>", "dcterms:modified",
+	} {
+		assert.Contains(t, opf, kept)
+	}
+	for _, added := range []string{
+		"\n    <dc:title>Catalog Title</dc:title>",
+		"<dc:creator>Right Author</dc:creator>",
+		"<dc:identifier>urn:isbn:9781234567897</dc:identifier>",
+		"<dc:description>&lt;p&gt;Blurb &amp; more&lt;/p&gt;</dc:description>",
+		`<meta name="calibre:series" content="New Series"/>`,
+		`<meta name="calibre:series_index" content="2"/>`,
+		`<meta property="belongs-to-collection" id="library-series">New Series</meta>`,
+		`<meta refines="#library-series" property="group-position">2</meta>`,
+		`href="images/cover%20art.png" media-type="image/jpeg"`,
+	} {
+		assert.Contains(t, opf, added)
+	}
+
+	var out bytes.Buffer
+	data := buildTestEPUB3(t)
+	require.NoError(t, ebookmeta.RewriteEPUB(&out, bytes.NewReader(data),
+		int64(len(data)), ebookmeta.Overrides{Title: "Catalog Title"}))
+	got, err := ebookmeta.Extract(
+		ebookmeta.FormatEPUB, bytes.NewReader(out.Bytes()), int64(out.Len()),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "Catalog Title", got.Title)
+	assert.Equal(t, []string{"Wrong Author", "Ilse Illustrator"}, got.Authors)
+}
+
+func TestRewriteEPUB_AddsMissingCover(t *testing.T) {
+	t.Parallel()
+
+	data := buildTestEPUB("Bare", []string{"A"}, "", "")
+	zr := rewriteEPUB(t, data, ebookmeta.Overrides{Cover: testJPEG})
+
+	assert.Equal(t, string(testJPEG),
+		zipEntryString(t, zr, "OEBPS/library-cover.jpg"))
+	opf := zipEntryString(t, zr, "OEBPS/content.opf")
+	assert.Contains(t, opf, `<meta name="cover" content="library-cover"/>`)
+	assert.Contains(t, opf, `<manifest>`)
+	assert.Contains(t, opf,
+		`<item id="library-cover" href="library-cover.jpg" media-type="image/jpeg"/>`)
+	assert.NotContains(t, opf, "cover-image", "EPUB 2 has no manifest properties")
+	assert.Contains(t, opf, "<dc:title>Bare</dc:title>")
+}
+
+func TestRewriteEPUB_ZeroOverridesKeepOPF(t *testing.T) {
+	t.Parallel()
+
+	zr := rewriteEPUB(t, buildTestEPUB3(t), ebookmeta.Overrides{
+		Cover: []byte("not an image"),
+	})
+	assert.Equal(t, testEPUB3OPF, zipEntryString(t, zr, "OEBPS/content.opf"))
+}
+
+func TestRewriteEPUB_NotAnEPUB(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("%PDF-1.4")
+	err := ebookmeta.RewriteEPUB(
+		io.Discard, bytes.NewReader(data), int64(len(data)), ebookmeta.Overrides{},
+	)
+	require.Error(t, err)
+}
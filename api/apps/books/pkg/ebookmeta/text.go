@@ -0,0 +1,219 @@
+package ebookmeta
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// maxDocumentReadBytes caps the decompressed bytes read from any single
+// content document of an EPUB when extracting its text.
+const maxDocumentReadBytes = 8 << 20 // 8 MB
+
+// TextSection is a run of a book's plain text under one heading.
+type TextSection struct {
+	// Href is the content document the text is in, as the OPF manifest lists
+	// it (relative to the OPF) — what EPUB readers accept as a location.
+	// Empty for text that didn't come from an EPUB.
+	Href string
+	// Heading is the nearest h1–h3 before the text, carried over from earlier
+	// documents when a chapter spans several; empty before the first one.
+	Heading string
+	Text    string
+}
+
+// ReadText extracts the plain text of an EPUB in reading (spine) order,
+// split into sections at its headings. Documents that aren't XHTML, or that
+// fail to open, are skipped.
+func ReadText(r io.ReaderAt, size int64) ([]TextSection, error) {
+	zr, err := openEPUBZip(r, size)
+	if err != nil {
+		return nil, err
+	}
+	pkg, opfPath, err := readOPF(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkg.Spine) == 0 {
+		return nil, fmt.Errorf("ebookmeta: epub has an empty spine")
+	}
+
+	items := make(map[string]opfItem, len(pkg.Manifest))
+	for _, item := range pkg.Manifest {
+		items[item.ID] = item
+	}
+
+	var sections []TextSection
+	heading := ""
+	for _, ref := range pkg.Spine {
+		item, ok := items[ref.IDRef]
+		if !ok || !isXHTMLItem(item) {
+			continue
+		}
+		name := path.Join(path.Dir(opfPath), unescapeHref(item.Href))
+		f := zipFile(zr, name)
+		if f == nil {
+			continue
+		}
+		rc, openErr := f.Open()
+		if openErr != nil {
+			continue
+		}
+		doc, readErr := io.ReadAll(io.LimitReader(rc, maxDocumentReadBytes))
+		_ = rc.Close()
+		if readErr != nil {
+			continue
+		}
+
+		docSections := HTMLText(doc, heading)
+		for i := range docSections {
+			docSections[i].Href = item.Href
+		}
+		if n := len(docSections); n > 0 {
+			heading = docSections[n-1].Heading
+		}
+		sections = append(sections, docSections...)
+	}
+	return sections, nil
+}
+
+func isXHTMLItem(item opfItem) bool {
+	switch item.MediaType {
+	case "application/xhtml+xml", "text/html":
+		return true
+	}
+	return false
+}
+
+// unescapeHref turns a manifest href into a zip entry name; hrefs are URLs
+// and may percent-encode spaces and the like.
+func unescapeHref(href string) string {
+	if i := strings.IndexByte(href, '#'); i >= 0 {
+		href = href[:i]
+	}
+	if u, err := url.PathUnescape(href); err == nil {
+		return u
+	}
+	return href
+}
+
+// HTMLText extracts the plain text of an (X)HTML document, split into
+// sections at its h1–h3 headings. heading is the section heading in effect
+// before the document starts. Sections without any text are dropped.
+func HTMLText(doc []byte, heading string) []TextSection {
+	t := textExtractor{
+		sections: nil,
+		current:  TextSection{Href: "", Heading: heading, Text: ""},
+		text:     strings.Builder{},
+		headingB: strings.Builder{},
+	}
+	t.run(html.NewTokenizer(bytes.NewReader(doc)))
+	return t.sections
+}
+
+// textExtractor walks an HTML token stream, collecting text into the current
+// section and starting a new one at each heading.
+type textExtractor struct {
+	sections []TextSection
+	current  TextSection
+	text     strings.Builder
+	headingB strings.Builder
+}
+
+func (t *textExtractor) run(z *html.Tokenizer) {
+	skipDepth, headingDepth := 0, 0
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			t.flush()
+			return
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			a := atom.Lookup(name)
+			switch {
+			case a == atom.Script || a == atom.Style || a == atom.Head:
+				if tt == html.StartTagToken {
+					skipDepth++
+				}
+			case isHeading(a):
+				headingDepth++
+				t.headingB.Reset()
+			case isBlock(a):
+				t.space()
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			a := atom.Lookup(name)
+			switch {
+			case a == atom.Script || a == atom.Style || a == atom.Head:
+				if skipDepth > 0 {
+					skipDepth--
+				}
+			case isHeading(a) && headingDepth > 0:
+				headingDepth--
+				if h := collapseSpace(t.headingB.String()); h != "" {
+					t.flush()
+					t.current.Heading = h
+				}
+			case isBlock(a):
+				t.space()
+			}
+		case html.TextToken:
+			if skipDepth > 0 {
+				continue
+			}
+			text := string(z.Text())
+			if headingDepth > 0 {
+				t.headingB.WriteString(text)
+				t.headingB.WriteByte(' ')
+				continue
+			}
+			t.text.WriteString(text)
+		case html.CommentToken, html.DoctypeToken:
+		}
+	}
+}
+
+// space separates the text of adjacent blocks, which would otherwise run
+// together ("end.Next").
+func (t *textExtractor) space() {
+	t.text.WriteByte(' ')
+}
+
+// flush closes the current section, keeping it when it has any text.
+func (t *textExtractor) flush() {
+	text := collapseSpace(t.text.String())
+	t.text.Reset()
+	if text == "" {
+		return
+	}
+	t.current.Text = text
+	t.sections = append(t.sections, t.current)
+	t.current.Text = ""
+}
+
+func isHeading(a atom.Atom) bool {
+	return a == atom.H1 || a == atom.H2 || a == atom.H3
+}
+
+func isBlock(a atom.Atom) bool {
+	switch a {
+	case atom.P, atom.Div, atom.Br, atom.Li, atom.Tr, atom.Td, atom.Th,
+		atom.Blockquote, atom.Section, atom.Article, atom.H4, atom.H5,
+		atom.H6, atom.Pre, atom.Hr, atom.Dd, atom.Dt, atom.Figcaption:
+		return true
+	}
+	return false
+}
+
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
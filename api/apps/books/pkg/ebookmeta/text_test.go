@@ -0,0 +1,88 @@
+package ebookmeta_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/pkg/ebookmeta"
+)
+
+func buildTextEPUB(docs map[string]string, spine ...string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	writeZipEntry(zw, "META-INF/container.xml",
+		`<container xmlns="urn:oasis:names:tc:opendocument:xmlns:container">`+
+			`<rootfiles><rootfile full-path="OEBPS/content.opf"`+
+			` media-type="application/oebps-package+xml"/></rootfiles>`+
+			`</container>`,
+	)
+
+	// A stylesheet in the spine is skipped as non-XHTML.
+	manifest := `<item id="css" href="style.css" media-type="text/css"/>`
+	itemrefs := ""
+	if len(spine) > 0 {
+		itemrefs = `<itemref idref="css"/>`
+	}
+	for _, href := range spine {
+		manifest += `<item id="` + href + `" href="` + href +
+			`" media-type="application/xhtml+xml"/>`
+		itemrefs += `<itemref idref="` + href + `"/>`
+	}
+	writeZipEntry(zw, "OEBPS/content.opf",
+		`<package xmlns="http://www.idpf.org/2007/opf" version="3.0">`+
+			`<metadata/><manifest>`+manifest+`</manifest>`+
+			`<spine>`+itemrefs+`</spine>`+
+			`</package>`,
+	)
+	for href, body := range docs {
+		writeZipEntry(zw, "OEBPS/"+href, body)
+	}
+	_ = zw.Close()
+	return buf.Bytes()
+}
+
+func TestReadText_SpineOrderAndHeadings(t *testing.T) {
+	data := buildTextEPUB(map[string]string{
+		"text/ch1.xhtml": `<html><head><title>Ignored</title>` +
+			`<style>p { color: red }</style></head><body>` +
+			`<p>Front matter.</p>` +
+			`<h1>Chapter <em>One</em></h1><p>It was a dark</p><p>and stormy night.</p>` +
+			`</body></html>`,
+		"text/ch2.xhtml": `<html><body><p>Still chapter one.</p>` +
+			`<h2>Chapter Two</h2><script>var x = 1;</script><p>Morning.</p>` +
+			`</body></html>`,
+	}, "text/ch1.xhtml", "text/ch2.xhtml")
+
+	sections, err := ebookmeta.ReadText(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	assert.Equal(t, []ebookmeta.TextSection{
+		{Href: "text/ch1.xhtml", Heading: "", Text: "Front matter."},
+		{
+			Href: "text/ch1.xhtml", Heading: "Chapter One",
+			Text: "It was a dark and stormy night.",
+		},
+		{Href: "text/ch2.xhtml", Heading: "Chapter One", Text: "Still chapter one."},
+		{Href: "text/ch2.xhtml", Heading: "Chapter Two", Text: "Morning."},
+	}, sections)
+}
+
+func TestReadText_EmptySpine(t *testing.T) {
+	data := buildTextEPUB(nil)
+	_, err := ebookmeta.ReadText(bytes.NewReader(data), int64(len(data)))
+	require.Error(t, err)
+}
+
+func TestHTMLText_Article(t *testing.T) {
+	sections := ebookmeta.HTMLText(
+		[]byte(`<article><p>Intro &amp; more.</p><h3>Results</h3><p>All good.</p></article>`),
+		"",
+	)
+	assert.Equal(t, []ebookmeta.TextSection{
+		{Href: "", Heading: "", Text: "Intro & more."},
+		{Href: "", Heading: "Results", Text: "All good."},
+	}, sections)
+}
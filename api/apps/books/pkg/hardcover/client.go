@@ -64,6 +64,23 @@ const isbnQuery = `query BookByISBN($isbn: String!) {
   }
 }`
 
+// isbnSeriesQuery fetches the series of the book an ISBN-13 belongs to,
+// featured series first. It is sent separately from isbnQuery so a schema
+// mismatch here only costs the series, not the rest of the metadata.
+// ponytail: assumes where-clause relation filters don't count towards the
+// depth limit and that featured marks a book's main series.
+const isbnSeriesQuery = `query BookSeriesByISBN($isbn: String!) {
+  book_series(
+    where: {book: {editions: {isbn_13: {_eq: $isbn}}}}
+    order_by: {featured: desc}
+    limit: 1
+  ) {
+    book_id
+    position
+    series { name primary_books_count }
+  }
+}`
+
 // searchIDsQuery finds book IDs via Hardcover's Typesense-backed search index
 // (the same index the website uses). This is the only fuzzy-match path
 // Hardcover permits: per its API docs, _like/_ilike/_similar/_regex (and
@@ -79,7 +96,8 @@ const searchIDsQuery = `query SearchBookIDs($query: String!, $perPage: Int!) {
 }`
 
 // booksByIDsQuery fetches full book records for IDs returned by
-// searchIDsQuery. Uses _in, which (unlike ilike) is a permitted operator.
+// searchIDsQuery, plus their series links (featured first, see isbnQuery).
+// Uses _in, which (unlike ilike) is a permitted operator.
 const booksByIDsQuery = `query BooksByIDs($ids: [Int!]!) {
   books(where: {id: {_in: $ids}}) {
     id
@@ -92,6 +110,11 @@ const booksByIDsQuery = `query BooksByIDs($ids: [Int!]!) {
       isbn_13
     }
   }
+  book_series(where: {book_id: {_in: $ids}}, order_by: {featured: desc}) {
+    book_id
+    position
+    series { name primary_books_count }
+  }
 }`
 
 type client struct {
@@ -135,9 +158,28 @@ func (c client) GetByISBN(
 	}
 
 	out := editionToExternalBook(resp.Data.Editions[0])
+	c.applyISBNSeries(ctx, isbn, &out)
 	return &out, nil
 }
 
+// applyISBNSeries adds the series of isbn's book to out. Best-effort: a
+// failed lookup is logged and out is left series-less.
+func (c client) applyISBNSeries(ctx context.Context, isbn string, out *ExternalBook) {
+	var resp seriesResponse
+	err := c.post(ctx, isbnSeriesQuery, map[string]any{"isbn": isbn}, &resp)
+	if err == nil {
+		err = graphQLErr(resp.Errors)
+	}
+	if err != nil {
+		c.logger.WarnContext(ctx, "hardcover series lookup failed",
+			slog.String("isbn", isbn), slog.Any("error", err))
+		return
+	}
+	if len(resp.Data.BookSeries) > 0 {
+		applySeries(out, resp.Data.BookSeries[0])
+	}
+}
+
 // Search queries Hardcover for books matching the title in query. It first
 // resolves matching book IDs via the Typesense search index, then fetches
 // the full records for those IDs.
@@ -183,10 +225,20 @@ func (c client) Search(
 	for _, b := range resp.Data.Books {
 		byID[b.ID] = b
 	}
+	seriesByID := make(map[int]bookSeries, len(resp.Data.BookSeries))
+	for _, bs := range resp.Data.BookSeries {
+		if _, seen := seriesByID[bs.BookID]; !seen {
+			seriesByID[bs.BookID] = bs
+		}
+	}
 	books := make([]ExternalBook, 0, len(idsResp.Data.Search.IDs))
 	for _, id := range idsResp.Data.Search.IDs {
 		if b, ok := byID[id]; ok {
-			books = append(books, bookToExternalBook(b))
+			out := bookToExternalBook(b)
+			if bs, hasSeries := seriesByID[id]; hasSeries {
+				applySeries(&out, bs)
+			}
+			books = append(books, out)
 		}
 	}
 	return books, nil
@@ -254,6 +306,23 @@ func bookToExternalBook(b book) ExternalBook {
 	return out
 }
 
+// applySeries copies a book_series link onto out. A link without a named
+// series leaves out untouched.
+func applySeries(out *ExternalBook, bs bookSeries) {
+	if bs.Series == nil || strings.TrimSpace(bs.Series.Name) == "" {
+		return
+	}
+	out.Series = strings.TrimSpace(bs.Series.Name)
+	if bs.Position != nil && *bs.Position >= 0 {
+		pos := *bs.Position
+		out.SeriesPosition = &pos
+	}
+	if bs.Series.PrimaryBooksCount > 0 {
+		total := bs.Series.PrimaryBooksCount
+		out.SeriesTotal = &total
+	}
+}
+
 // graphQLErr collapses a GraphQL errors array into a single Go error.
 func graphQLErr(errs []graphQLError) error {
 	if len(errs) == 0 {
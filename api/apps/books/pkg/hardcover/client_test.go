@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -132,6 +133,62 @@ func TestGetByISBN_FlatContributorName(t *testing.T) {
 	assert.Equal(t, []string{"Homer"}, got.Authors)
 }
 
+// isbnThenSeriesHandler serves edition for GetByISBN's edition query and
+// series for its separate series query.
+func isbnThenSeriesHandler(edition, series json.RawMessage) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query string `json:"query"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(req.Query, "book_series") {
+			_, _ = w.Write(series)
+			return
+		}
+		_, _ = w.Write(edition)
+	})
+}
+
+func TestGetByISBN_Series(t *testing.T) {
+	cleanup := buildServer(isbnThenSeriesHandler(
+		json.RawMessage(`{"data":{
+			"editions":[{"title":"Dune Messiah","isbn_13":"9780593098233"}]}}`),
+		json.RawMessage(`{"data":{"book_series":[{"book_id":7,"position":2,
+			"series":{"name":" Dune ","primary_books_count":6}}]}}`),
+	))
+	defer cleanup()
+
+	c := hardcover.New(logging.NewNopLogger(), "token")
+	got, err := c.GetByISBN(context.Background(), "9780593098233")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "Dune", got.Series)
+	require.NotNil(t, got.SeriesPosition)
+	assert.InDelta(t, 2.0, *got.SeriesPosition, 0)
+	require.NotNil(t, got.SeriesTotal)
+	assert.Equal(t, 6, *got.SeriesTotal)
+}
+
+// TestGetByISBN_SeriesErrorKeepsMetadata verifies a rejected series query
+// only drops the series, not the edition's metadata.
+func TestGetByISBN_SeriesErrorKeepsMetadata(t *testing.T) {
+	cleanup := buildServer(isbnThenSeriesHandler(
+		json.RawMessage(`{"data":{
+			"editions":[{"title":"Dune Messiah","isbn_13":"9780593098233"}]}}`),
+		json.RawMessage(`{"errors":[{"message":"field \"featured\" not found"}]}`),
+	))
+	defer cleanup()
+
+	c := hardcover.New(logging.NewNopLogger(), "token")
+	got, err := c.GetByISBN(context.Background(), "9780593098233")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "Dune Messiah", got.Title)
+	assert.Empty(t, got.Series)
+	assert.Nil(t, got.SeriesPosition)
+}
+
 func TestGetByISBN_NotFound(t *testing.T) {
 	cleanup := buildServer(jsonHandler(json.RawMessage(`{"data":{"editions":[]}}`)))
 	defer cleanup()
@@ -269,6 +326,29 @@ func TestSearch_PreservesRelevanceOrder(t *testing.T) {
 	assert.Equal(t, "Least Relevant", results[1].Title)
 }
 
+// TestSearch_Series verifies each result picks up its own first (featured)
+// series link, and results without one stay series-less.
+func TestSearch_Series(t *testing.T) {
+	books := json.RawMessage(`{"data":{
+		"books":[{"id":1,"title":"Dune"},{"id":2,"title":"Standalone"}],
+		"book_series":[
+			{"book_id":1,"position":1,"series":{"name":"Dune"}},
+			{"book_id":1,"position":4,"series":{"name":"Dune Chronicles"}}]}}`)
+	cleanup := buildServer(searchIDsThenBooksHandler(t, []int{1, 2}, books))
+	defer cleanup()
+
+	c := hardcover.New(logging.NewNopLogger(), "token")
+	results, err := c.Search(context.Background(), `intitle:"Dune"`)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "Dune", results[0].Series)
+	require.NotNil(t, results[0].SeriesPosition)
+	assert.InDelta(t, 1.0, *results[0].SeriesPosition, 0)
+	assert.Nil(t, results[0].SeriesTotal)
+	assert.Empty(t, results[1].Series)
+	assert.Nil(t, results[1].SeriesPosition)
+}
+
 // TestSearch_DropsAuthor_SendsTitleOnly verifies the Typesense query is
 // title-only even when the caller's query has an inauthor: token. Hardcover's
 // Typesense index weights the title field highest, so appending the author
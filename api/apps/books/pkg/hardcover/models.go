@@ -13,6 +13,12 @@ type ExternalBook struct {
 	// text is needed.
 	Description *string
 	PageCount   *int
+	// Series is the book's featured series, with its position in it and the
+	// number of primary books the series has. Nil/empty when Hardcover lists
+	// the book in no series.
+	Series         string
+	SeriesPosition *float64
+	SeriesTotal    *int
 }
 
 // --- Hardcover GraphQL request/response types ---
@@ -37,10 +43,19 @@ type isbnResponse struct {
 	Errors []graphQLError `json:"errors"`
 }
 
+// seriesResponse is the response shape for GetByISBN's isbnSeriesQuery.
+type seriesResponse struct {
+	Data struct {
+		BookSeries []bookSeries `json:"book_series"`
+	} `json:"data"`
+	Errors []graphQLError `json:"errors"`
+}
+
 // searchResponse is the response shape for the booksByIDsQuery half of Search.
 type searchResponse struct {
 	Data struct {
-		Books []book `json:"books"`
+		Books      []book       `json:"books"`
+		BookSeries []bookSeries `json:"book_series"`
 	} `json:"data"`
 	Errors []graphQLError `json:"errors"`
 }
@@ -87,6 +102,18 @@ type book struct {
 	CachedContributor []cachedContributor `json:"cached_contributors"`
 }
 
+// bookSeries links a book to one series it belongs to. Queried as a root
+// field rather than nested under editions/books: editions →
+// book → book_series → series would exceed the max query depth of 3.
+type bookSeries struct {
+	BookID   int      `json:"book_id"`
+	Position *float64 `json:"position"`
+	Series   *struct {
+		Name              string `json:"name"`
+		PrimaryBooksCount int    `json:"primary_books_count"`
+	} `json:"series"`
+}
+
 // cachedImage is the denormalised image JSON ({"url": "..."}). Also the shape
 // of the edition-level image relation's selected fields.
 type cachedImage struct {
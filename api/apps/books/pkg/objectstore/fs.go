@@ -0,0 +1,342 @@
+package objectstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// partialDir holds in-flight writes under the root. Keys can't contain a
+// segment starting with ".", so it never collides with a stored object.
+const partialDir = ".partial"
+
+// ErrInvalidKey is returned for keys that can't name a file under the
+// root: empty, absolute, or containing empty, "." / ".."-led or backslash
+// segments.
+var ErrInvalidKey = errors.New("invalid object key")
+
+// FSClient is a Client that stores objects as files under a local
+// directory, for self-hosting without a cloud object store. Writes are
+// atomic: bytes land in a temporary file that is synced and then renamed
+// into place, so readers never see a partial object.
+//
+// Presigned URLs point back at the API itself: they carry an expiry and an
+// HMAC-SHA256 signature over the method, key and expiry (and, for PUT, the
+// content type), and FSClient serves them as an http.Handler mounted at
+// baseURL's path.
+type FSClient struct {
+	root    string
+	baseURL string
+	secret  []byte
+	now     func() time.Time
+}
+
+// NewFS creates an FSClient rooted at root, creating the directory if
+// needed. baseURL is the externally reachable URL the handler is mounted at
+// (e.g. https://tools.xdoubleu.com/api/books/api/objects); secret keys the
+// URL signatures and must be shared by every replica serving them.
+func NewFS(root string, baseURL string, secret []byte) (*FSClient, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("fs object store: signing secret is empty")
+	}
+
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("fs object store root %q: %w", root, err)
+	}
+	//nolint:mnd //owner-only directory permissions
+	if err = os.MkdirAll(filepath.Join(absRoot, partialDir), 0o700); err != nil {
+		return nil, fmt.Errorf("fs object store root %q: %w", root, err)
+	}
+
+	return &FSClient{
+		root:    absRoot,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		secret:  secret,
+		now:     time.Now,
+	}, nil
+}
+
+// filePath maps key to its file under the root, rejecting keys that would
+// escape it or collide with partialDir.
+func (c *FSClient) filePath(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, `\`) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	for segment := range strings.SplitSeq(key, "/") {
+		if segment == "" || strings.HasPrefix(segment, ".") {
+			return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+		}
+	}
+	return filepath.Join(c.root, filepath.FromSlash(key)), nil
+}
+
+func (c *FSClient) Put(
+	_ context.Context,
+	key string,
+	r io.Reader,
+	size int64,
+	_ string,
+) error {
+	dst, err := c.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Join(c.root, partialDir), "put-*")
+	if err != nil {
+		return fmt.Errorf("fs put %q: %w", key, err)
+	}
+	// Once renamed, the temp name is gone and this is a no-op.
+	defer os.Remove(tmp.Name()) //nolint:errcheck //best-effort cleanup
+
+	written, err := io.Copy(tmp, r)
+	if err == nil && size >= 0 && written != size {
+		err = fmt.Errorf("wrote %d bytes, expected %d", written, size)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("fs put %q: %w", key, err)
+	}
+
+	//nolint:mnd //owner-only directory permissions
+	if err = os.MkdirAll(filepath.Dir(dst), 0o700); err != nil {
+		return fmt.Errorf("fs put %q: %w", key, err)
+	}
+	if err = os.Rename(tmp.Name(), dst); err != nil {
+		return fmt.Errorf("fs put %q: %w", key, err)
+	}
+	return nil
+}
+
+func (c *FSClient) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	p, err := c.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, fmt.Errorf("fs get %q: %w", key, err)
+	}
+	return f, nil
+}
+
+// PresignGet returns a URL the handler serves key at until ttl elapses.
+// Like R2, the key need not exist yet.
+func (c *FSClient) PresignGet(
+	_ context.Context,
+	key string,
+	ttl time.Duration,
+) (string, error) {
+	return c.signedURL(http.MethodGet, key, ttl, "")
+}
+
+// PresignPut returns a URL the handler accepts a PUT of key at until ttl
+// elapses. The PUT must send contentType as its Content-Type.
+func (c *FSClient) PresignPut(
+	_ context.Context,
+	key string,
+	ttl time.Duration,
+	contentType string,
+) (string, error) {
+	return c.signedURL(http.MethodPut, key, ttl, contentType)
+}
+
+func (c *FSClient) Delete(_ context.Context, key string) error {
+	p, err := c.filePath(key)
+	if err != nil {
+		return err
+	}
+	// Deleting a missing key succeeds, as it does on S3.
+	if err = os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("fs delete %q: %w", key, err)
+	}
+	return nil
+}
+
+func (c *FSClient) Exists(_ context.Context, key string) (bool, error) {
+	p, err := c.filePath(key)
+	if err != nil {
+		return false, err
+	}
+	info, err := os.Stat(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("fs exists %q: %w", key, err)
+	}
+	return info.Mode().IsRegular(), nil
+}
+
+func (c *FSClient) Copy(ctx context.Context, srcKey, dstKey string) error {
+	src, err := c.Get(ctx, srcKey)
+	if err != nil {
+		return fmt.Errorf("fs copy: %w", err)
+	}
+	defer src.Close()
+
+	return c.Put(ctx, dstKey, src, -1, "")
+}
+
+func (c *FSClient) List(_ context.Context, prefix string) ([]ObjectInfo, error) {
+	// Only walk the directory the prefix's last full segment names.
+	start := c.root
+	if i := strings.LastIndex(prefix, "/"); i != -1 {
+		start = filepath.Join(c.root, filepath.FromSlash(prefix[:i]))
+	}
+
+	var objects []ObjectInfo
+	err := filepath.WalkDir(start, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == partialDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, relErr := filepath.Rel(c.root, p)
+		if relErr != nil {
+			return relErr
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, infoErr := d.Info()
+		if infoErr != nil {
+			return infoErr
+		}
+		objects = append(objects, ObjectInfo{
+			Key:          key,
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list objects %q: %w", prefix, err)
+	}
+	return objects, nil
+}
+
+func (c *FSClient) signedURL(
+	method string,
+	key string,
+	ttl time.Duration,
+	contentType string,
+) (string, error) {
+	if _, err := c.filePath(key); err != nil {
+		return "", err
+	}
+
+	expires := c.now().Add(ttl).Unix()
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", c.sign(method, key, expires, contentType))
+
+	escaped := make([]string, 0, strings.Count(key, "/")+1)
+	for segment := range strings.SplitSeq(key, "/") {
+		escaped = append(escaped, url.PathEscape(segment))
+	}
+	return c.baseURL + "/" + strings.Join(escaped, "/") + "?" + query.Encode(), nil
+}
+
+func (c *FSClient) sign(
+	method string,
+	key string,
+	expires int64,
+	contentType string,
+) string {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(method + "\n" + key + "\n" + strconv.FormatInt(expires, 10) +
+		"\n" + contentType))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ServeHTTP serves the URLs PresignGet and PresignPut hand out. It expects
+// the request path to be the key alone (mount it behind http.StripPrefix):
+// GET and HEAD stream the object, PUT stores the request body. Requests
+// with a missing, tampered or expired signature get 403.
+func (c *FSClient) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/")
+
+	method := r.Method
+	contentType := ""
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		method = http.MethodGet
+	case http.MethodPut:
+		contentType = r.Header.Get("Content-Type")
+	default:
+		w.Header().Set("Allow", "GET, HEAD, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	p, err := c.filePath(key)
+	if err != nil {
+		http.Error(w, "invalid key", http.StatusBadRequest)
+		return
+	}
+
+	expires, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64)
+	if err != nil || c.now().Unix() > expires ||
+		!hmac.Equal(
+			[]byte(r.URL.Query().Get("signature")),
+			[]byte(c.sign(method, key, expires, contentType)),
+		) {
+		http.Error(w, "invalid or expired signature", http.StatusForbidden)
+		return
+	}
+
+	if method == http.MethodPut {
+		if err = c.Put(r.Context(), key, r.Body, r.ContentLength, contentType); err != nil {
+			http.Error(w, "store failed", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	// ServeContent picks the Content-Type from the key's extension, sniffing
+	// the bytes when it has none, and handles Range and conditional requests.
+	http.ServeContent(w, r, path.Base(key), info.ModTime(), f)
+}
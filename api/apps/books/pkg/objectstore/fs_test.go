@@ -0,0 +1,221 @@
+package objectstore_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/pkg/objectstore"
+)
+
+const fsMount = "/books/api/objects"
+
+// serveSigned replays a presigned URL against c's handler mounted at
+// fsMount, the way the books app mounts it.
+func serveSigned(
+	t *testing.T,
+	c *objectstore.FSClient,
+	method string,
+	signedURL string,
+	body io.Reader,
+	contentType string,
+) *httptest.ResponseRecorder {
+	t.Helper()
+
+	u, err := url.Parse(signedURL)
+	require.NoError(t, err)
+	req := httptest.NewRequest(method, u.RequestURI(), body)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	rec := httptest.NewRecorder()
+	http.StripPrefix(fsMount, c).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestFS_NewRequiresSecret(t *testing.T) {
+	t.Parallel()
+
+	_, err := objectstore.NewFS(t.TempDir(), "https://api.example.com", nil)
+	assert.Error(t, err)
+}
+
+func TestFS_RejectsKeysOutsideRoot(t *testing.T) {
+	t.Parallel()
+
+	c := newTestFS(t)
+	for _, key := range []string{
+		"", "/abs", "../escape", "a/../../b", "a//b", ".partial/x", `a\b`,
+	} {
+		r := strings.NewReader("x")
+		err := c.Put(t.Context(), key, r, int64(r.Len()), "text/plain")
+		require.ErrorIs(t, err, objectstore.ErrInvalidKey, key)
+
+		_, err = c.PresignGet(t.Context(), key, time.Minute)
+		require.ErrorIs(t, err, objectstore.ErrInvalidKey, key)
+	}
+}
+
+// failingReader yields some bytes and then an error, like a dropped upload.
+type failingReader struct{ sent bool }
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	if f.sent {
+		return 0, errors.New("connection reset")
+	}
+	f.sent = true
+	return copy(p, "partial"), nil
+}
+
+func TestFS_FailedPutLeavesNoPartialObject(t *testing.T) {
+	t.Parallel()
+
+	c := newTestFS(t)
+	ctx := t.Context()
+
+	r := strings.NewReader("complete")
+	require.NoError(t, c.Put(ctx, "books/a.epub", r, int64(r.Len()), "epub"))
+
+	require.Error(t, c.Put(ctx, "books/a.epub", &failingReader{}, 100, "epub"))
+	require.Error(t, c.Put(ctx, "books/b.epub", &failingReader{}, 100, "epub"))
+
+	// The old object is intact, the new one never appeared, and the
+	// abandoned temp files aren't listed.
+	assertContent(t, c, "books/a.epub", "complete")
+	exists, err := c.Exists(ctx, "books/b.epub")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	all, err := c.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, "books/a.epub", all[0].Key)
+}
+
+func TestFS_PutRejectsShortBody(t *testing.T) {
+	t.Parallel()
+
+	c := newTestFS(t)
+	r := strings.NewReader("short")
+	require.Error(t, c.Put(t.Context(), "k", r, 100, "text/plain"))
+
+	exists, err := c.Exists(t.Context(), "k")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestFS_SignedGetServesObject(t *testing.T) {
+	t.Parallel()
+
+	c := newTestFS(t)
+	r := strings.NewReader("epub bytes")
+	require.NoError(
+		t,
+		c.Put(t.Context(), "users/u1/f.epub", r, int64(r.Len()), "application/epub+zip"),
+	)
+
+	signed, err := c.PresignGet(t.Context(), "users/u1/f.epub", time.Minute)
+	require.NoError(t, err)
+	assert.True(
+		t,
+		strings.HasPrefix(signed, "https://api.example.com"+fsMount+"/users/u1/f.epub?"),
+		signed,
+	)
+
+	rec := serveSigned(t, c, http.MethodGet, signed, nil, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "epub bytes", rec.Body.String())
+	assert.Equal(t, "application/epub+zip", rec.Header().Get("Content-Type"))
+
+	rec = serveSigned(t, c, http.MethodHead, signed, nil, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestFS_SignedGetRejectsTamperedOrExpiredURL(t *testing.T) {
+	t.Parallel()
+
+	c := newTestFS(t)
+	r := strings.NewReader("secret")
+	require.NoError(t, c.Put(t.Context(), "users/u1/a.epub", r, int64(r.Len()), "epub"))
+	r = strings.NewReader("other")
+	require.NoError(t, c.Put(t.Context(), "users/u2/b.epub", r, int64(r.Len()), "epub"))
+
+	signed, err := c.PresignGet(t.Context(), "users/u1/a.epub", time.Minute)
+	require.NoError(t, err)
+
+	// A signature for one key doesn't open another.
+	otherKey := strings.Replace(signed, "users/u1/a.epub", "users/u2/b.epub", 1)
+	rec := serveSigned(t, c, http.MethodGet, otherKey, nil, "")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	// Nor does a pushed-out expiry.
+	u, err := url.Parse(signed)
+	require.NoError(t, err)
+	q := u.Query()
+	q.Set("expires", "99999999999")
+	u.RawQuery = q.Encode()
+	rec = serveSigned(t, c, http.MethodGet, u.String(), nil, "")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	// A GET signature doesn't authorise a PUT.
+	rec = serveSigned(t, c, http.MethodPut, signed, strings.NewReader("x"), "")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	expired, err := c.PresignGet(t.Context(), "users/u1/a.epub", -time.Minute)
+	require.NoError(t, err)
+	rec = serveSigned(t, c, http.MethodGet, expired, nil, "")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestFS_SignedGetMissingObject(t *testing.T) {
+	t.Parallel()
+
+	c := newTestFS(t)
+	signed, err := c.PresignGet(t.Context(), "users/u1/none.epub", time.Minute)
+	require.NoError(t, err)
+
+	rec := serveSigned(t, c, http.MethodGet, signed, nil, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestFS_SignedPutStoresBody(t *testing.T) {
+	t.Parallel()
+
+	c := newTestFS(t)
+	signed, err := c.PresignPut(
+		t.Context(), "users/u1/uploads/x.epub", time.Hour, "application/epub+zip",
+	)
+	require.NoError(t, err)
+
+	// The content type is part of the signature.
+	rec := serveSigned(
+		t, c, http.MethodPut, signed, strings.NewReader("upload"), "text/html",
+	)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = serveSigned(
+		t, c, http.MethodPut, signed, strings.NewReader("upload"), "application/epub+zip",
+	)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assertContent(t, c, "users/u1/uploads/x.epub", "upload")
+}
+
+func TestFS_HandlerRejectsOtherMethods(t *testing.T) {
+	t.Parallel()
+
+	c := newTestFS(t)
+	signed, err := c.PresignGet(t.Context(), "k", time.Minute)
+	require.NoError(t, err)
+
+	rec := serveSigned(t, c, http.MethodDelete, signed, nil, "")
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -0,0 +1,352 @@
+package objectstore_test
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/books/pkg/objectstore"
+)
+
+// backend names one Client implementation the shared tests below run
+// against.
+type backend struct {
+	name string
+	new  func(t *testing.T) objectstore.Client
+}
+
+func backends() []backend {
+	return []backend{
+		{name: "fake", new: func(*testing.T) objectstore.Client {
+			return objectstore.NewFake()
+		}},
+		{name: "fs", new: func(t *testing.T) objectstore.Client {
+			return newTestFS(t)
+		}},
+		{name: "s3", new: newServerS3},
+	}
+}
+
+// forEachBackend runs fn as a parallel subtest per backend.
+func forEachBackend(
+	t *testing.T,
+	fn func(t *testing.T, ctx context.Context, c objectstore.Client),
+) {
+	t.Helper()
+	t.Parallel()
+
+	for _, b := range backends() {
+		t.Run(b.name, func(t *testing.T) {
+			t.Parallel()
+			fn(t, t.Context(), b.new(t))
+		})
+	}
+}
+
+func newTestFS(t *testing.T) *objectstore.FSClient {
+	t.Helper()
+
+	c, err := objectstore.NewFS(
+		t.TempDir(),
+		"https://api.example.com/books/api/objects",
+		[]byte("test-secret"),
+	)
+	require.NoError(t, err)
+	return c
+}
+
+// newServerS3 starts an S3-compatible httptest server backed by a
+// FakeClient and returns an S3 client pointed at it. It speaks just enough
+// of the protocol for the SDK calls Client makes: object PUT (including
+// x-amz-copy-source copies), GET, HEAD, DELETE and ListObjectsV2.
+func newServerS3(t *testing.T) objectstore.Client {
+	t.Helper()
+
+	const bucket = "bucket"
+	store := objectstore.NewFake()
+
+	srv := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/"+bucket), "/")
+
+			switch {
+			case r.Method == http.MethodGet && key == "":
+				writeListResult(w, r, store)
+
+			case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+				src, _ := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+				src = strings.TrimPrefix(strings.TrimPrefix(src, "/"), bucket+"/")
+				if err := store.Copy(r.Context(), src, key); err != nil {
+					writeNoSuchKey(w)
+					return
+				}
+				_, _ = io.WriteString(w, "<CopyObjectResult></CopyObjectResult>")
+
+			case r.Method == http.MethodPut:
+				_ = store.Put(r.Context(), key, r.Body, r.ContentLength, "")
+
+			case r.Method == http.MethodGet, r.Method == http.MethodHead:
+				data, ok := store.GetContent(key)
+				if !ok {
+					writeNoSuchKey(w)
+					return
+				}
+				if r.Method == http.MethodGet {
+					_, _ = w.Write(data)
+				}
+
+			case r.Method == http.MethodDelete:
+				_ = store.Delete(r.Context(), key)
+				w.WriteHeader(http.StatusNoContent)
+
+			default:
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		}),
+	)
+	t.Cleanup(srv.Close)
+
+	return objectstore.NewS3(srv.URL, "us-east-1", "fake-key", "fake-secret", bucket)
+}
+
+func writeNoSuchKey(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusNotFound)
+	_, _ = io.WriteString(
+		w,
+		"<Error><Code>NoSuchKey</Code><Message>not found</Message></Error>",
+	)
+}
+
+func writeListResult(
+	w http.ResponseWriter,
+	r *http.Request,
+	store *objectstore.FakeClient,
+) {
+	type content struct {
+		Key          string
+		Size         int64
+		LastModified string
+	}
+	type listResult struct {
+		XMLName     xml.Name `xml:"ListBucketResult"`
+		IsTruncated bool
+		KeyCount    int
+		Contents    []content
+	}
+
+	objects, _ := store.List(r.Context(), r.URL.Query().Get("prefix"))
+	result := listResult{
+		XMLName:     xml.Name{Space: "", Local: "ListBucketResult"},
+		IsTruncated: false,
+		KeyCount:    len(objects),
+		Contents:    make([]content, 0, len(objects)),
+	}
+	for _, obj := range objects {
+		result.Contents = append(result.Contents, content{
+			Key:          obj.Key,
+			Size:         obj.Size,
+			LastModified: obj.LastModified.UTC().Format(time.RFC3339),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+func TestStore_PutAndGet(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, ctx context.Context, c objectstore.Client) {
+		body := strings.NewReader("hello epub")
+		err := c.Put(ctx, "books/a.epub", body, int64(body.Len()), "application/epub+zip")
+		require.NoError(t, err)
+
+		rc, err := c.Get(ctx, "books/a.epub")
+		require.NoError(t, err)
+		defer rc.Close()
+
+		got, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "hello epub", string(got))
+	})
+}
+
+func TestStore_PutOverwrites(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, ctx context.Context, c objectstore.Client) {
+		r1 := strings.NewReader("first version")
+		require.NoError(t, c.Put(ctx, "k", r1, int64(r1.Len()), "text/plain"))
+		r2 := strings.NewReader("second")
+		require.NoError(t, c.Put(ctx, "k", r2, int64(r2.Len()), "text/plain"))
+
+		rc, err := c.Get(ctx, "k")
+		require.NoError(t, err)
+		defer rc.Close()
+
+		got, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "second", string(got))
+	})
+}
+
+func TestStore_GetMissing(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, ctx context.Context, c objectstore.Client) {
+		_, err := c.Get(ctx, "missing/key")
+		assert.Error(t, err)
+	})
+}
+
+func TestStore_Exists(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, ctx context.Context, c objectstore.Client) {
+		exists, err := c.Exists(ctx, "k")
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		r := strings.NewReader("data")
+		require.NoError(t, c.Put(ctx, "k", r, int64(r.Len()), "application/octet-stream"))
+
+		exists, err = c.Exists(ctx, "k")
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
+
+func TestStore_Delete(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, ctx context.Context, c objectstore.Client) {
+		r := strings.NewReader("content")
+		require.NoError(t, c.Put(ctx, "del/me", r, int64(r.Len()), "text/plain"))
+
+		require.NoError(t, c.Delete(ctx, "del/me"))
+
+		exists, err := c.Exists(ctx, "del/me")
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		// Deleting again is not an error.
+		require.NoError(t, c.Delete(ctx, "del/me"))
+	})
+}
+
+func TestStore_PresignGet(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, ctx context.Context, c objectstore.Client) {
+		r := strings.NewReader("epub bytes")
+		require.NoError(
+			t,
+			c.Put(
+				ctx,
+				"users/u1/books/b1/f.epub",
+				r,
+				int64(r.Len()),
+				"application/epub+zip",
+			),
+		)
+
+		url, err := c.PresignGet(ctx, "users/u1/books/b1/f.epub", 5*time.Minute)
+		require.NoError(t, err)
+		assert.Contains(t, url, "users/u1/books/b1/f.epub")
+	})
+}
+
+func TestStore_PresignPut(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, ctx context.Context, c objectstore.Client) {
+		// Key does not need to exist yet — PresignPut is called before the upload.
+		url, err := c.PresignPut(
+			ctx,
+			"users/u1/uploads/uuid.epub",
+			60*time.Minute,
+			"application/epub+zip",
+		)
+		require.NoError(t, err)
+		assert.Contains(t, url, "users/u1/uploads/uuid.epub")
+	})
+}
+
+func TestStore_Copy(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, ctx context.Context, c objectstore.Client) {
+		r := strings.NewReader("epub content")
+		require.NoError(
+			t,
+			c.Put(ctx, "src/file.epub", r, int64(r.Len()), "application/epub+zip"),
+		)
+
+		// Copy to a new key.
+		require.NoError(t, c.Copy(ctx, "src/file.epub", "books/sha256abc.epub"))
+
+		// Both keys exist with the same content.
+		assertContent(t, c, "src/file.epub", "epub content")
+		assertContent(t, c, "books/sha256abc.epub", "epub content")
+	})
+}
+
+func TestStore_Copy_OverwritesExisting(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, ctx context.Context, c objectstore.Client) {
+		r1 := strings.NewReader("original")
+		require.NoError(t, c.Put(ctx, "src", r1, int64(r1.Len()), "text/plain"))
+		r2 := strings.NewReader("old value")
+		require.NoError(t, c.Put(ctx, "dst", r2, int64(r2.Len()), "text/plain"))
+
+		// Copy src → dst should silently overwrite.
+		require.NoError(t, c.Copy(ctx, "src", "dst"))
+
+		assertContent(t, c, "dst", "original")
+	})
+}
+
+func TestStore_Copy_MissingSource(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, ctx context.Context, c objectstore.Client) {
+		err := c.Copy(ctx, "nonexistent", "dst")
+		assert.Error(t, err)
+	})
+}
+
+func TestStore_List(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, ctx context.Context, c objectstore.Client) {
+		r := strings.NewReader("hello")
+		require.NoError(t, c.Put(ctx, "books/1/abc.epub", r, int64(r.Len()), "epub"))
+		r2 := strings.NewReader("hi")
+		require.NoError(
+			t,
+			c.Put(ctx, "users/u1/uploads/tmp.epub", r2, int64(r2.Len()), "epub"),
+		)
+
+		all, err := c.List(ctx, "")
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+
+		booksOnly, err := c.List(ctx, "books/")
+		require.NoError(t, err)
+		require.Len(t, booksOnly, 1)
+		assert.Equal(t, "books/1/abc.epub", booksOnly[0].Key)
+		assert.Equal(t, int64(5), booksOnly[0].Size)
+		assert.WithinDuration(t, time.Now(), booksOnly[0].LastModified, time.Minute)
+
+		// A prefix can end mid-segment.
+		users, err := c.List(ctx, "users/u")
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, "users/u1/uploads/tmp.epub", users[0].Key)
+
+		none, err := c.List(ctx, "missing/")
+		require.NoError(t, err)
+		assert.Empty(t, none)
+	})
+}
+
+func assertContent(t *testing.T, c objectstore.Client, key string, want string) {
+	t.Helper()
+
+	rc, err := c.Get(t.Context(), key)
+	require.NoError(t, err)
+	defer rc.Close()
+
+	got, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, want, string(got))
+}
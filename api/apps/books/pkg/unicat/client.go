@@ -209,10 +209,13 @@ func (c client) doWithRetry(
 //   - 020$a → ISBN-13 (first non-empty value)
 //   - 520$a → description (summary note)
 //   - 300$a → page count (leading integer extracted from extent statement)
+//   - 830$a/$v, 800$t/$v → series and position (controlled series entries),
+//     falling back to the transcribed 490$a/$v statement
 //
 //nolint:gocognit // MARC field mapping is inherently branchy
 func marcToExternalBook(rec marcRecord) ExternalBook {
 	var book ExternalBook
+	var statement marcDataField
 
 	for _, df := range rec.DataFields {
 		switch df.Tag {
@@ -251,12 +254,70 @@ func marcToExternalBook(rec marcRecord) ExternalBook {
 					}
 				}
 			}
+		case "830":
+			if book.Series == "" {
+				setSeries(&book, df.subfieldA(), df.subfield("v"))
+			}
+		case "800":
+			if book.Series == "" {
+				setSeries(&book, df.subfield("t"), df.subfield("v"))
+			}
+		case "490":
+			if statement.Tag == "" {
+				statement = df
+			}
 		}
 	}
+	if book.Series == "" && statement.Tag != "" {
+		setSeries(&book, statement.subfieldA(), statement.subfield("v"))
+	}
 
 	return book
 }
 
+// setSeries fills book's series from a MARC series title and volume
+// designation ("deel 3", "v. 2.5", "; 4"), trimming the ISBD punctuation
+// catalogers leave behind. A volume with no number leaves the position nil.
+func setSeries(book *ExternalBook, title, volume string) {
+	title = strings.Trim(strings.TrimSpace(title), " ;,.:/")
+	if title == "" {
+		return
+	}
+	book.Series = title
+	if pos, ok := parseLeadingNumber(volume); ok {
+		book.SeriesPosition = &pos
+	}
+}
+
+// parseLeadingNumber extracts the first number in s, with an optional
+// decimal part ("2.5", "2,5"). Reports false when s contains no digits.
+func parseLeadingNumber(s string) (float64, bool) {
+	start := strings.IndexFunc(s, unicode.IsDigit)
+	if start < 0 {
+		return 0, false
+	}
+	end := start
+	seenSep := false
+	for end < len(s) {
+		c := s[end]
+		switch {
+		case c >= '0' && c <= '9':
+		case (c == '.' || c == ',') && !seenSep &&
+			end+1 < len(s) && s[end+1] >= '0' && s[end+1] <= '9':
+			seenSep = true
+		default:
+			return parseNumber(s[start:end])
+		}
+		end++
+	}
+	return parseNumber(s[start:end])
+}
+
+func parseNumber(s string) (float64, bool) {
+	n, err := strconv.ParseFloat(strings.Replace(s, ",", ".", 1), 64)
+	return n, err == nil
+}
+
 // flipLastFirst converts a MARC "Last, First" personal name to the
 // "First Last" form the other metadata providers use. Names without a comma
 // are already in that form; names with two or more commas (suffixes like
@@ -251,6 +251,89 @@ func TestGetByISBN_MultipleAuthors(t *testing.T) {
 	)
 }
 
+// seriesFixture wraps MARC datafields in a one-record SRU response.
+func seriesFixture(fields string) string {
+	return `<?xml version='1.0' encoding='UTF-8'?>
+<srw:searchRetrieveResponse xmlns:srw="http://www.loc.gov/zing/srw/">
+  <srw:numberOfRecords>1</srw:numberOfRecords>
+  <srw:records><srw:record><srw:recordData>
+    <record xmlns="http://www.loc.gov/MARC21/slim">
+      <datafield tag="245" ind1="1" ind2="0">
+        <subfield code="a">Test Book</subfield>
+      </datafield>` + fields + `
+    </record>
+  </srw:recordData></srw:record></srw:records>
+</srw:searchRetrieveResponse>`
+}
+
+func TestGetByISBN_Series(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   string
+		series   string
+		position *float64
+	}{
+		{
+			name: "490 statement",
+			fields: `<datafield tag="490" ind1="0" ind2=" ">
+				<subfield code="a">De Kronieken van Narnia ;</subfield>
+				<subfield code="v">deel 2,5</subfield></datafield>`,
+			series:   "De Kronieken van Narnia",
+			position: pos(2.5),
+		},
+		{
+			name: "830 preferred over 490",
+			fields: `<datafield tag="490" ind1="1" ind2=" ">
+				<subfield code="a">Narnia ;</subfield>
+				<subfield code="v">3</subfield></datafield>
+				<datafield tag="830" ind1=" " ind2="0">
+				<subfield code="a">Kronieken van Narnia.</subfield>
+				<subfield code="v">3.</subfield></datafield>`,
+			series:   "Kronieken van Narnia",
+			position: pos(3),
+		},
+		{
+			name: "800 uses its title subfield",
+			fields: `<datafield tag="800" ind1="1" ind2=" ">
+				<subfield code="a">Lewis, C. S.</subfield>
+				<subfield code="t">Chronicles of Narnia ;</subfield>
+				<subfield code="v">v. 7</subfield></datafield>`,
+			series:   "Chronicles of Narnia",
+			position: pos(7),
+		},
+		{
+			name: "volume without number",
+			fields: `<datafield tag="490" ind1="0" ind2=" ">
+				<subfield code="a">Penguin classics</subfield></datafield>`,
+			series:   "Penguin classics",
+			position: nil,
+		},
+		{
+			name:     "no series",
+			fields:   "",
+			series:   "",
+			position: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := buildServer(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/xml")
+				_, _ = w.Write([]byte(seriesFixture(tt.fields)))
+			})
+			defer cleanup()
+
+			book, err := newClient(t).GetByISBN(context.Background(), "9780000000000")
+			require.NoError(t, err)
+			require.NotNil(t, book)
+			assert.Equal(t, tt.series, book.Series)
+			assert.Equal(t, tt.position, book.SeriesPosition)
+		})
+	}
+}
+
+func pos(f float64) *float64 { return &f }
+
 func TestGetByISBN_MalformedXML(t *testing.T) {
 	cleanup := buildServer(func(w http.ResponseWriter, _ *http.Request) {
 		w.Header().Set("Content-Type", "application/xml")
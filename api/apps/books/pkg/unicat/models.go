@@ -11,6 +11,10 @@ type ExternalBook struct {
 	ISBN13      *string
 	Description *string
 	PageCount   *int
+	// Series and SeriesPosition come from the record's series statements.
+	// MARC has no notion of how many books a series has.
+	Series         string
+	SeriesPosition *float64
 }
 
 // --- SRU/MARCXML response types ---
@@ -51,8 +55,14 @@ type marcSubField struct {
 
 // subfieldA returns the value of the first subfield with code "a", or "".
 func (df marcDataField) subfieldA() string {
+	return df.subfield("a")
+}
+
+// subfield returns the value of the first subfield with the given code, or
+// "".
+func (df marcDataField) subfield(code string) string {
 	for _, sf := range df.SubFields {
-		if sf.Code == "a" {
+		if sf.Code == code {
 			return sf.Value
 		}
 	}
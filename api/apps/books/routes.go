@@ -52,10 +52,34 @@ func (a *Books) booksRoutes(prefix string, mux *http.ServeMux) {
 		"POST "+catalogPath,
 		a.Services.Auth.AppAccess(prefix, catalogHandler.ServeHTTP),
 	)
+
+	opdsPath, opdsHandler := booksv1connect.NewOPDSServiceHandler(handler, scrub)
+	mux.Handle(
+		"POST "+opdsPath,
+		a.Services.Auth.AppAccess(prefix, opdsHandler.ServeHTTP),
+	)
+
+	koreaderPath, koreaderHandler := booksv1connect.NewKOReaderServiceHandler(
+		handler,
+		scrub,
+	)
+	mux.Handle(
+		"POST "+koreaderPath,
+		a.Services.Auth.AppAccess(prefix, koreaderHandler.ServeHTTP),
+	)
+
+	goalsPath, goalsHandler := booksv1connect.NewGoalsServiceHandler(handler, scrub)
+	mux.Handle(
+		"POST "+goalsPath,
+		a.Services.Auth.AppAccess(prefix, goalsHandler.ServeHTTP),
+	)
 }
 
 func (a *Books) Routes(prefix string, mux *http.ServeMux) {
 	a.booksRoutes(prefix, mux)
 	a.coverRoutes(prefix, mux)
 	a.koboRoutes(prefix, mux)
+	a.opdsRoutes(prefix, mux)
+	a.koreaderRoutes(prefix, mux)
+	a.objectRoutes(prefix, mux)
 }
@@ -3,6 +3,7 @@ package dashboard_test
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"os"
 	"testing"
 	"time"
@@ -134,6 +135,14 @@ func (fakeFeedsWebFetchClient) Get(
 	return nil, feedswebfetch.ErrNetwork
 }
 
+func (fakeFeedsWebFetchClient) PostForm(
+	_ context.Context,
+	_ string,
+	_ url.Values,
+) error {
+	return feedswebfetch.ErrNetwork
+}
+
 func TestMain(m *testing.M) {
 	testCfg = testhelper.NewTestConfig()
 	testCfg.SteamAPIKey = "test-steam-api-key"
@@ -146,12 +155,13 @@ func TestMain(m *testing.M) {
 	logger := logging.NewNopLogger()
 
 	testBooks = books.NewInner(auth, logger, testCfg, postgresDB, books.Clients{
-		UniCat:           nil,
-		Hardcover:        nil,
-		ObjectStore:      booksobjectstore.NewFake(),
-		WebFetch:         fakeBooksWebFetchClient{},
-		KoboStoreBaseURL: "",
-		PublicAPIBaseURL: "",
+		UniCat:                 nil,
+		Hardcover:              nil,
+		ObjectStore:            booksobjectstore.NewFake(),
+		WebFetch:               fakeBooksWebFetchClient{},
+		KoboStoreBaseURL:       "",
+		KoboReadingServicesURL: "",
+		PublicAPIBaseURL:       "",
 	})
 	if err := testBooks.ApplyMigrations(context.Background(), postgresDB); err != nil {
 		panic(err)
@@ -202,3 +202,26 @@ func (h *publicConnectHandler) GetSharedFeedsSummary(
 		Feeds: protoSharedFeeds(sharedFeeds),
 	}), nil
 }
+
+func (h *publicConnectHandler) GetSharedReadingGoals(
+	ctx context.Context,
+	req *connect.Request[dashboardv1.GetSharedReadingGoalsRequest],
+) (*connect.Response[dashboardv1.GetSharedReadingGoalsResponse], error) {
+	userID, _, err := h.resolveToken(
+		ctx,
+		req.Msg.Token,
+		sharedmodels.DashboardKindReading,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	goals, err := h.app.books.BuildSharedReadingGoals(ctx, userID, req.Msg.Year)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&dashboardv1.GetSharedReadingGoalsResponse{
+		Goals: goals,
+	}), nil
+}
@@ -393,6 +393,46 @@ func TestGetSharedBooksProgress_UnknownToken(t *testing.T) {
 	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
 }
 
+func TestGetSharedReadingGoals_OnlyPublicGoals(t *testing.T) {
+	ensureProfileShare(t)
+	ctx := context.Background()
+
+	_, err := testDB.Exec(ctx, `
+		INSERT INTO books.reading_goals (user_id, year, kind, target, public)
+		VALUES ($1, 2024, 'books', 24, true), ($1, 2024, 'pages', 8000, false)
+		ON CONFLICT (user_id, year, kind) DO UPDATE SET
+		    target = EXCLUDED.target, public = EXCLUDED.public
+	`, publicReadingUserID)
+	require.NoError(t, err)
+
+	client := newPublicReadingClient(t)
+	resp, err := client.GetSharedReadingGoals(
+		ctx,
+		connect.NewRequest(&dashboardv1.GetSharedReadingGoalsRequest{
+			Token: publicReadingToken,
+			Year:  2024,
+		}),
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Goals, 1)
+	assert.Equal(t, "books", resp.Msg.Goals[0].Goal.Kind)
+	assert.EqualValues(t, 24, resp.Msg.Goals[0].Goal.Target)
+}
+
+func TestGetSharedReadingGoals_UnknownToken(t *testing.T) {
+	ensureProfileShare(t)
+
+	client := newPublicReadingClient(t)
+	_, err := client.GetSharedReadingGoals(
+		context.Background(),
+		connect.NewRequest(&dashboardv1.GetSharedReadingGoalsRequest{
+			Token: "definitely-not-a-token",
+		}),
+	)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
 func TestGetSharedFeedsSummary_Success(t *testing.T) {
 	ensureProfileShare(t)
 	seedPublicFeed(t, "Dashboard Test Feed", "https://example.com/dashboard-test-feed")
@@ -4,6 +4,7 @@ import (
 	"context"
 	"embed"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -90,6 +91,7 @@ func NewInner(
 		notifications,
 		appUsersRepo,
 		cfg.WebURL,
+		strings.TrimSuffix(cfg.APIURL, "/")+"/"+a.GetName()+webSubPath,
 	)
 	a.feedPollJob = jobs.NewFeedPollJob(a.Services.Feeds)
 
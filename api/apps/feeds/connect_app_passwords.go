@@ -0,0 +1,91 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+	feedsv1 "tools.xdoubleu.com/gen/feeds/v1"
+)
+
+func protoAppPassword(p models.AppPassword) *feedsv1.AppPassword {
+	lastUsed := ""
+	if p.LastUsedAt != nil {
+		lastUsed = p.LastUsedAt.Format(time.RFC3339)
+	}
+	return &feedsv1.AppPassword{
+		Id:         p.ID.String(),
+		Username:   p.Username,
+		CreatedAt:  p.CreatedAt.Format(time.RFC3339),
+		LastUsedAt: lastUsed,
+	}
+}
+
+func (h *feedsConnectHandler) ListAppPasswords(
+	ctx context.Context,
+	_ *connect.Request[feedsv1.ListAppPasswordsRequest],
+) (*connect.Response[feedsv1.ListAppPasswordsResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	passwords, err := h.app.Services.Feeds.ListAppPasswords(ctx, user.ID)
+	if err != nil {
+		return nil, feedErrorToConnect(err)
+	}
+	out := make([]*feedsv1.AppPassword, 0, len(passwords))
+	for _, p := range passwords {
+		out = append(out, protoAppPassword(p))
+	}
+	return connect.NewResponse(&feedsv1.ListAppPasswordsResponse{
+		AppPasswords: out,
+	}), nil
+}
+
+func (h *feedsConnectHandler) CreateAppPassword(
+	ctx context.Context,
+	req *connect.Request[feedsv1.CreateAppPasswordRequest],
+) (*connect.Response[feedsv1.CreateAppPasswordResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	created, password, err := h.app.Services.Feeds.CreateAppPassword(
+		ctx, user.ID, req.Msg.Username,
+	)
+	if err != nil {
+		return nil, feedErrorToConnect(err)
+	}
+	return connect.NewResponse(&feedsv1.CreateAppPasswordResponse{
+		AppPassword: protoAppPassword(*created),
+		Password:    password,
+	}), nil
+}
+
+func (h *feedsConnectHandler) DeleteAppPassword(
+	ctx context.Context,
+	req *connect.Request[feedsv1.DeleteAppPasswordRequest],
+) (*connect.Response[feedsv1.DeleteAppPasswordResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+	id, err := uuid.Parse(req.Msg.AppPasswordId)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("invalid app password ID"),
+		)
+	}
+
+	if err = h.app.Services.Feeds.DeleteAppPassword(ctx, user.ID, id); err != nil {
+		return nil, feedErrorToConnect(err)
+	}
+	return connect.NewResponse(&feedsv1.DeleteAppPasswordResponse{}), nil
+}
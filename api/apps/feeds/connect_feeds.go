@@ -46,6 +46,10 @@ func protoFeed(f models.Feed) *feedsv1.Feed {
 	if f.NotifiedAt != nil {
 		notifiedAt = f.NotifiedAt.Format(time.RFC3339)
 	}
+	folderID := ""
+	if f.FolderID != nil {
+		folderID = f.FolderID.String()
+	}
 	//nolint:gosec // failure count never approaches int32 range
 	consecutiveFailures := int32(f.ConsecutiveFailures)
 	return &feedsv1.Feed{
@@ -60,6 +64,8 @@ func protoFeed(f models.Feed) *feedsv1.Feed {
 		LastModified:        lastModified,
 		ConsecutiveFailures: consecutiveFailures,
 		NotifiedAt:          notifiedAt,
+		FolderId:            folderID,
+		UnreadCount:         int32(f.UnreadCount), //nolint:gosec // item count
 	}
 }
 
@@ -76,11 +82,16 @@ func protoItem(item models.Item) *feedsv1.Item {
 	if !item.PublishedAt.IsZero() {
 		publishedAt = item.PublishedAt.Format(time.RFC3339)
 	}
+	libraryBookID := ""
+	if item.LibraryBookID != nil {
+		libraryBookID = item.LibraryBookID.String()
+	}
 	return &feedsv1.Item{
 		Id:              item.ID.String(),
 		FeedId:          item.FeedID.String(),
 		Title:           item.Title,
 		SourceUrl:       item.SourceURL,
+		Author:          item.Author,
 		ContentHtml:     item.ContentHTML,
 		PublishedAt:     publishedAt,
 		ReadAt:          readAt,
@@ -90,6 +101,8 @@ func protoItem(item models.Item) *feedsv1.Item {
 		CreatedAt:       item.CreatedAt.Format(time.RFC3339),
 		ReadProgressPct: int32(item.ReadProgressPct), //nolint:gosec // clamped [0,100]
 		HasContent:      item.HasContent,
+		LibraryBookId:   libraryBookID,
+		Tags:            item.Tags,
 	}
 }
 
@@ -138,9 +151,18 @@ func feedErrorToConnect(err error) *connect.Error {
 			errors.New("feed already exists"),
 		)
 	case errors.Is(err, services.ErrInvalidFeed),
+		errors.Is(err, services.ErrFeedUnreachable),
+		errors.Is(err, services.ErrInvalidOPML),
+		errors.Is(err, services.ErrInvalidFolder),
+		errors.Is(err, services.ErrInvalidRule),
+		errors.Is(err, services.ErrAppPasswordUsername),
 		errors.Is(err, services.ErrUnsupportedURL),
 		errors.Is(err, services.ErrNoPostsFound):
 		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, services.ErrNoArticleContent):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, services.ErrLibraryUnavailable):
+		return connect.NewError(connect.CodeUnavailable, err)
 	default:
 		return connect.NewError(connect.CodeInternal, err)
 	}
@@ -155,7 +177,7 @@ func (h *feedsConnectHandler) ListFeeds(
 		return nil, cerr
 	}
 
-	feeds, err := h.app.Services.Feeds.List(ctx, user.ID)
+	feeds, folders, err := h.app.Services.Feeds.ListWithFolders(ctx, user.ID)
 	if err != nil {
 		return nil, feedErrorToConnect(err)
 	}
@@ -164,7 +186,14 @@ func (h *feedsConnectHandler) ListFeeds(
 	for i, f := range feeds {
 		out[i] = protoFeed(f)
 	}
-	return connect.NewResponse(&feedsv1.ListFeedsResponse{Feeds: out}), nil
+	outFolders := make([]*feedsv1.Folder, len(folders))
+	for i, f := range folders {
+		outFolders[i] = protoFolder(f)
+	}
+	return connect.NewResponse(&feedsv1.ListFeedsResponse{
+		Feeds:   out,
+		Folders: outFolders,
+	}), nil
 }
 
 func (h *feedsConnectHandler) CreateFeed(
@@ -304,9 +333,18 @@ func (h *feedsConnectHandler) ListFeedItems(
 		feedID = &parsed
 	}
 
+	var folderID *uuid.UUID
+	if req.Msg.FolderId != nil {
+		parsed, perr := parseFolderID(req.Msg.GetFolderId())
+		if perr != nil {
+			return nil, perr
+		}
+		folderID = &parsed
+	}
+
 	items, hasMore, err := h.app.Services.Feeds.ListItems(
 		ctx, user.ID, req.Msg.Limit, req.Msg.Offset, req.Msg.GetUnreadOnly(),
-		feedID, req.Msg.GetBookmarkedOnly(),
+		feedID, folderID, req.Msg.GetBookmarkedOnly(),
 	)
 	if err != nil {
 		return nil, feedErrorToConnect(err)
@@ -371,6 +409,30 @@ func (h *feedsConnectHandler) UpdateItem(
 	}), nil
 }
 
+// SendItemToLibrary adds the item to the books library from its stored
+// body; see services.FeedService.SendToLibrary.
+func (h *feedsConnectHandler) SendItemToLibrary(
+	ctx context.Context,
+	req *connect.Request[feedsv1.SendItemToLibraryRequest],
+) (*connect.Response[feedsv1.SendItemToLibraryResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+	itemID, cerr := parseItemID(req.Msg.ItemId)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	item, err := h.app.Services.Feeds.SendToLibrary(ctx, user.ID, itemID)
+	if err != nil {
+		return nil, feedErrorToConnect(err)
+	}
+	return connect.NewResponse(&feedsv1.SendItemToLibraryResponse{
+		Item: protoItem(*item),
+	}), nil
+}
+
 func (h *feedsConnectHandler) GetFeedStats(
 	ctx context.Context,
 	_ *connect.Request[feedsv1.GetFeedStatsRequest],
@@ -0,0 +1,188 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+	feedsv1 "tools.xdoubleu.com/gen/feeds/v1"
+)
+
+func protoFilterRule(r models.FilterRule) *feedsv1.FilterRule {
+	feedID := ""
+	if r.FeedID != nil {
+		feedID = r.FeedID.String()
+	}
+	return &feedsv1.FilterRule{
+		Id:        r.ID.String(),
+		FeedId:    feedID,
+		Field:     r.Field,
+		MatchType: r.MatchType,
+		Pattern:   r.Pattern,
+		Action:    r.Action,
+		Tag:       r.Tag,
+		Enabled:   r.Enabled,
+		CreatedAt: r.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// filterRuleFromProto converts a request's rule, leaving its ID nil (the
+// ID is only read by UpdateFilterRule, via parseRuleID).
+func filterRuleFromProto(r *feedsv1.FilterRule) (models.FilterRule, *connect.Error) {
+	if r == nil {
+		return models.FilterRule{}, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("rule is required"),
+		)
+	}
+	var feedID *uuid.UUID
+	if r.FeedId != "" {
+		id, cerr := parseFeedID(r.FeedId)
+		if cerr != nil {
+			return models.FilterRule{}, cerr
+		}
+		feedID = &id
+	}
+	//nolint:exhaustruct // id/user/timestamps are set by the service and database
+	return models.FilterRule{
+		FeedID:    feedID,
+		Field:     r.Field,
+		MatchType: r.MatchType,
+		Pattern:   r.Pattern,
+		Action:    r.Action,
+		Tag:       r.Tag,
+		Enabled:   r.Enabled,
+	}, nil
+}
+
+// parseRuleID mirrors parseFeedID for the rule-scoped RPCs.
+func parseRuleID(id string) (uuid.UUID, *connect.Error) {
+	ruleID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("invalid rule ID"),
+		)
+	}
+	return ruleID, nil
+}
+
+func (h *feedsConnectHandler) ListFilterRules(
+	ctx context.Context,
+	_ *connect.Request[feedsv1.ListFilterRulesRequest],
+) (*connect.Response[feedsv1.ListFilterRulesResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	rules, err := h.app.Services.Feeds.ListFilterRules(ctx, user.ID)
+	if err != nil {
+		return nil, feedErrorToConnect(err)
+	}
+	out := make([]*feedsv1.FilterRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, protoFilterRule(r))
+	}
+	return connect.NewResponse(&feedsv1.ListFilterRulesResponse{Rules: out}), nil
+}
+
+func (h *feedsConnectHandler) CreateFilterRule(
+	ctx context.Context,
+	req *connect.Request[feedsv1.CreateFilterRuleRequest],
+) (*connect.Response[feedsv1.CreateFilterRuleResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+	rule, cerr := filterRuleFromProto(req.Msg.Rule)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	created, err := h.app.Services.Feeds.CreateFilterRule(ctx, user.ID, rule)
+	if err != nil {
+		return nil, feedErrorToConnect(err)
+	}
+	return connect.NewResponse(&feedsv1.CreateFilterRuleResponse{
+		Rule: protoFilterRule(*created),
+	}), nil
+}
+
+func (h *feedsConnectHandler) UpdateFilterRule(
+	ctx context.Context,
+	req *connect.Request[feedsv1.UpdateFilterRuleRequest],
+) (*connect.Response[feedsv1.UpdateFilterRuleResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+	rule, cerr := filterRuleFromProto(req.Msg.Rule)
+	if cerr != nil {
+		return nil, cerr
+	}
+	rule.ID, cerr = parseRuleID(req.Msg.Rule.GetId())
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	updated, err := h.app.Services.Feeds.UpdateFilterRule(ctx, user.ID, rule)
+	if err != nil {
+		return nil, feedErrorToConnect(err)
+	}
+	return connect.NewResponse(&feedsv1.UpdateFilterRuleResponse{
+		Rule: protoFilterRule(*updated),
+	}), nil
+}
+
+func (h *feedsConnectHandler) DeleteFilterRule(
+	ctx context.Context,
+	req *connect.Request[feedsv1.DeleteFilterRuleRequest],
+) (*connect.Response[feedsv1.DeleteFilterRuleResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+	ruleID, cerr := parseRuleID(req.Msg.RuleId)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	if err := h.app.Services.Feeds.DeleteFilterRule(ctx, user.ID, ruleID); err != nil {
+		return nil, feedErrorToConnect(err)
+	}
+	return connect.NewResponse(&feedsv1.DeleteFilterRuleResponse{}), nil
+}
+
+func (h *feedsConnectHandler) TestFilterRule(
+	ctx context.Context,
+	req *connect.Request[feedsv1.TestFilterRuleRequest],
+) (*connect.Response[feedsv1.TestFilterRuleResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+	rule, cerr := filterRuleFromProto(req.Msg.Rule)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	matches, checked, err := h.app.Services.Feeds.TestFilterRule(
+		ctx, user.ID, rule, int(req.Msg.Limit),
+	)
+	if err != nil {
+		return nil, feedErrorToConnect(err)
+	}
+	out := make([]*feedsv1.Item, 0, len(matches))
+	for _, item := range matches {
+		out = append(out, protoItem(item))
+	}
+	return connect.NewResponse(&feedsv1.TestFilterRuleResponse{
+		Matches:      out,
+		CheckedCount: int32(checked), //nolint:gosec // capped at 200
+	}), nil
+}
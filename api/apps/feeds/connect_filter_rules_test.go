@@ -0,0 +1,263 @@
+package feeds_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	feedsv1 "tools.xdoubleu.com/gen/feeds/v1"
+	"tools.xdoubleu.com/gen/feeds/v1/feedsv1connect"
+)
+
+// Every rule in these tests is limited to a feed the test created: the
+// tests share one user, and a rule for all feeds would act on the items
+// every other test ingests.
+
+func createFilterRule(
+	t *testing.T,
+	client feedsv1connect.FeedServiceClient,
+	rule *feedsv1.FilterRule,
+) *feedsv1.FilterRule {
+	t.Helper()
+	resp, err := client.CreateFilterRule(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateFilterRuleRequest{Rule: rule}),
+	)
+	require.NoError(t, err)
+	return resp.Msg.Rule
+}
+
+func feedItemsByTitle(
+	t *testing.T,
+	client feedsv1connect.FeedServiceClient,
+	feedID string,
+) map[string]*feedsv1.Item {
+	t.Helper()
+	resp, err := client.ListFeedItems(
+		context.Background(),
+		connect.NewRequest(&feedsv1.ListFeedItemsRequest{FeedId: &feedID}),
+	)
+	require.NoError(t, err)
+	out := map[string]*feedsv1.Item{}
+	for _, item := range resp.Msg.Items {
+		out[item.Title] = item
+	}
+	return out
+}
+
+func TestFilterRules_AppliedAtIngest(t *testing.T) {
+	base := uniqueBlogBase()
+	feedURL := base + "/feed.xml"
+	mockWebFetch.SetBody(feedURL, "application/rss+xml", []byte(rssXML(
+		"Noisy Blog",
+		rssItem{"Sponsored: before the rule", base + "/old", "old", itemContent},
+	)))
+
+	client := newFeedsClient(t)
+	created, err := client.CreateFeed(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateFeedRequest{Url: feedURL}),
+	)
+	require.NoError(t, err)
+	feedID := created.Msg.Feed.Id
+	waitForFeedImport(t, client, feedID)
+
+	createFilterRule(t, client, &feedsv1.FilterRule{
+		FeedId: feedID, Field: "title", MatchType: "keyword",
+		Pattern: " SPONSORED ", Action: "dismiss", Enabled: true,
+	})
+	createFilterRule(t, client, &feedsv1.FilterRule{
+		FeedId: feedID, Field: "title", MatchType: "regex",
+		Pattern: `v\d+\.\d+`, Action: "tag", Tag: "release", Enabled: true,
+	})
+	createFilterRule(t, client, &feedsv1.FilterRule{
+		FeedId: feedID, Field: "content", MatchType: "keyword",
+		Pattern: "generics", Action: "bookmark", Enabled: true,
+	})
+	createFilterRule(t, client, &feedsv1.FilterRule{
+		FeedId: feedID, Field: "url", MatchType: "keyword",
+		Pattern: "/changelog/", Action: "mark_read", Enabled: true,
+	})
+	createFilterRule(t, client, &feedsv1.FilterRule{
+		FeedId: feedID, Field: "title", MatchType: "keyword",
+		Pattern: "Plain", Action: "dismiss", Enabled: false,
+	})
+
+	mockWebFetch.SetBody(feedURL, "application/rss+xml", []byte(rssXML(
+		"Noisy Blog",
+		rssItem{"Sponsored: before the rule", base + "/old", "old", itemContent},
+		rssItem{"A sponsored post", base + "/ad", "ad", itemContent},
+		rssItem{
+			"Go v1.30 is out", base + "/changelog/go", "release",
+			"<p>Now with <b>Generics</b> everywhere.</p>",
+		},
+		rssItem{"Plain post", base + "/plain", "plain", itemContent},
+	)))
+	refreshed, err := client.RefreshFeed(
+		context.Background(),
+		connect.NewRequest(&feedsv1.RefreshFeedRequest{FeedId: feedID}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), refreshed.Msg.Ingested)
+
+	items := feedItemsByTitle(t, client, feedID)
+	// Rules only act on new items: the old match stays visible.
+	assert.Contains(t, items, "Sponsored: before the rule")
+	assert.NotContains(t, items, "A sponsored post")
+
+	release := items["Go v1.30 is out"]
+	require.NotNil(t, release)
+	assert.Equal(t, []string{"release"}, release.Tags)
+	assert.True(t, release.Bookmarked)
+	assert.NotEmpty(t, release.ReadAt)
+
+	plain := items["Plain post"]
+	require.NotNil(t, plain)
+	assert.Empty(t, plain.Tags)
+	assert.False(t, plain.Bookmarked)
+	assert.Empty(t, plain.ReadAt)
+}
+
+func TestFilterRules_CRUD(t *testing.T) {
+	client := newFeedsClient(t)
+	_, feedID := createItemAndFeed(t, client)
+
+	rule := createFilterRule(t, client, &feedsv1.FilterRule{
+		FeedId: feedID, Field: "author", MatchType: "keyword",
+		Pattern: "bot", Action: "tag", Tag: " automated ", Enabled: true,
+	})
+	assert.NotEmpty(t, rule.Id)
+	assert.Equal(t, "automated", rule.Tag)
+
+	updated, err := client.UpdateFilterRule(
+		context.Background(),
+		connect.NewRequest(&feedsv1.UpdateFilterRuleRequest{Rule: &feedsv1.FilterRule{
+			Id: rule.Id, FeedId: feedID, Field: "author", MatchType: "keyword",
+			Pattern: "bot", Action: "dismiss", Tag: "automated", Enabled: false,
+		}}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "dismiss", updated.Msg.Rule.Action)
+	assert.Empty(t, updated.Msg.Rule.Tag, "only the tag action keeps a tag")
+	assert.False(t, updated.Msg.Rule.Enabled)
+
+	listed, err := client.ListFilterRules(
+		context.Background(), connect.NewRequest(&feedsv1.ListFilterRulesRequest{}),
+	)
+	require.NoError(t, err)
+	var found *feedsv1.FilterRule
+	for _, r := range listed.Msg.Rules {
+		if r.Id == rule.Id {
+			found = r
+		}
+	}
+	require.NotNil(t, found)
+	assert.Equal(t, "dismiss", found.Action)
+
+	_, err = client.DeleteFilterRule(
+		context.Background(),
+		connect.NewRequest(&feedsv1.DeleteFilterRuleRequest{RuleId: rule.Id}),
+	)
+	require.NoError(t, err)
+	_, err = client.DeleteFilterRule(
+		context.Background(),
+		connect.NewRequest(&feedsv1.DeleteFilterRuleRequest{RuleId: rule.Id}),
+	)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestCreateFilterRule_RejectsInvalidRules(t *testing.T) {
+	client := newFeedsClient(t)
+	for name, rule := range map[string]*feedsv1.FilterRule{
+		"bad regex": {
+			Field: "title", MatchType: "regex", Pattern: "(", Action: "dismiss",
+		},
+		"empty pattern": {
+			Field: "title", MatchType: "keyword", Pattern: "  ", Action: "dismiss",
+		},
+		"unknown field": {
+			Field: "body", MatchType: "keyword", Pattern: "x", Action: "dismiss",
+		},
+		"unknown action": {
+			Field: "title", MatchType: "keyword", Pattern: "x", Action: "delete",
+		},
+		"tag without tag": {
+			Field: "title", MatchType: "keyword", Pattern: "x", Action: "tag",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.CreateFilterRule(
+				context.Background(),
+				connect.NewRequest(&feedsv1.CreateFilterRuleRequest{Rule: rule}),
+			)
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		})
+	}
+
+	_, err := client.CreateFilterRule(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateFilterRuleRequest{Rule: &feedsv1.FilterRule{
+			FeedId: uuid.NewString(), Field: "title", MatchType: "keyword",
+			Pattern: "x", Action: "dismiss",
+		}}),
+	)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestTestFilterRule_PreviewsWithoutChangingItems(t *testing.T) {
+	base := uniqueBlogBase()
+	feedURL := base + "/feed.xml"
+	marker := uuid.NewString()
+	mockWebFetch.SetBody(feedURL, "application/rss+xml", []byte(rssXML(
+		"Preview Blog",
+		rssItem{"Weekly " + marker, base + "/one", "one", itemContent},
+		rssItem{"Other post", base + "/two", "two", itemContent},
+		rssItem{"weekly roundup " + marker, base + "/three", "three", itemContent},
+	)))
+
+	client := newFeedsClient(t)
+	created, err := client.CreateFeed(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateFeedRequest{Url: feedURL}),
+	)
+	require.NoError(t, err)
+	feedID := created.Msg.Feed.Id
+	waitForFeedImport(t, client, feedID)
+
+	resp, err := client.TestFilterRule(
+		context.Background(),
+		connect.NewRequest(&feedsv1.TestFilterRuleRequest{Rule: &feedsv1.FilterRule{
+			FeedId: feedID, Field: "title", MatchType: "keyword",
+			Pattern: "WEEKLY", Action: "dismiss",
+		}}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.Msg.CheckedCount)
+	require.Len(t, resp.Msg.Matches, 2)
+	for _, item := range resp.Msg.Matches {
+		assert.Contains(t, item.Title, marker)
+		assert.Empty(t, item.ContentHtml)
+		assert.True(t, item.HasContent)
+	}
+
+	resp, err = client.TestFilterRule(
+		context.Background(),
+		connect.NewRequest(&feedsv1.TestFilterRuleRequest{
+			Rule: &feedsv1.FilterRule{
+				FeedId: feedID, Field: "content", MatchType: "regex",
+				Pattern: `ipsum article`, Action: "tag", Tag: "lorem",
+			},
+			Limit: 2,
+		}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.Msg.CheckedCount)
+	assert.Len(t, resp.Msg.Matches, 2)
+
+	// Previewing a dismiss rule dismissed nothing.
+	assert.Len(t, feedItemsByTitle(t, client, feedID), 3)
+}
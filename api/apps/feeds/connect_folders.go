@@ -0,0 +1,176 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+	feedsv1 "tools.xdoubleu.com/gen/feeds/v1"
+	"tools.xdoubleu.com/internal/database"
+)
+
+func protoFolder(f models.Folder) *feedsv1.Folder {
+	parentID := ""
+	if f.ParentID != nil {
+		parentID = f.ParentID.String()
+	}
+	return &feedsv1.Folder{
+		Id:          f.ID.String(),
+		Name:        f.Name,
+		ParentId:    parentID,
+		Position:    int32(f.Position),    //nolint:gosec // sibling index
+		UnreadCount: int32(f.UnreadCount), //nolint:gosec // item count
+	}
+}
+
+// parseFolderID mirrors parseFeedID for the folder-scoped RPCs.
+func parseFolderID(id string) (uuid.UUID, *connect.Error) {
+	folderID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("invalid folder ID"),
+		)
+	}
+	return folderID, nil
+}
+
+// parseOptionalFolderID parses a folder ID where empty means "no folder".
+func parseOptionalFolderID(id string) (*uuid.UUID, *connect.Error) {
+	if id == "" {
+		return nil, nil
+	}
+	folderID, cerr := parseFolderID(id)
+	if cerr != nil {
+		return nil, cerr
+	}
+	return &folderID, nil
+}
+
+// folderErrorToConnect is feedErrorToConnect with a folder's wording for a
+// name conflict.
+func folderErrorToConnect(err error) *connect.Error {
+	if errors.Is(err, database.ErrResourceConflict) {
+		return connect.NewError(
+			connect.CodeAlreadyExists,
+			errors.New("a folder with this name already exists here"),
+		)
+	}
+	return feedErrorToConnect(err)
+}
+
+func (h *feedsConnectHandler) CreateFolder(
+	ctx context.Context,
+	req *connect.Request[feedsv1.CreateFolderRequest],
+) (*connect.Response[feedsv1.CreateFolderResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+	parentID, cerr := parseOptionalFolderID(req.Msg.ParentId)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	folder, err := h.app.Services.Feeds.CreateFolder(
+		ctx, user.ID, req.Msg.Name, parentID,
+	)
+	if err != nil {
+		return nil, folderErrorToConnect(err)
+	}
+	return connect.NewResponse(&feedsv1.CreateFolderResponse{
+		Folder: protoFolder(*folder),
+	}), nil
+}
+
+func (h *feedsConnectHandler) RenameFolder(
+	ctx context.Context,
+	req *connect.Request[feedsv1.RenameFolderRequest],
+) (*connect.Response[feedsv1.RenameFolderResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+	folderID, cerr := parseFolderID(req.Msg.FolderId)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	if err := h.app.Services.Feeds.RenameFolder(
+		ctx, user.ID, folderID, req.Msg.Name,
+	); err != nil {
+		return nil, folderErrorToConnect(err)
+	}
+	return connect.NewResponse(&feedsv1.RenameFolderResponse{}), nil
+}
+
+func (h *feedsConnectHandler) DeleteFolder(
+	ctx context.Context,
+	req *connect.Request[feedsv1.DeleteFolderRequest],
+) (*connect.Response[feedsv1.DeleteFolderResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+	folderID, cerr := parseFolderID(req.Msg.FolderId)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	if err := h.app.Services.Feeds.DeleteFolder(ctx, user.ID, folderID); err != nil {
+		return nil, folderErrorToConnect(err)
+	}
+	return connect.NewResponse(&feedsv1.DeleteFolderResponse{}), nil
+}
+
+func (h *feedsConnectHandler) ReorderFolders(
+	ctx context.Context,
+	req *connect.Request[feedsv1.ReorderFoldersRequest],
+) (*connect.Response[feedsv1.ReorderFoldersResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	ids := make([]uuid.UUID, 0, len(req.Msg.Ids))
+	for _, raw := range req.Msg.Ids {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	if err := h.app.Services.Feeds.ReorderFolders(ctx, user.ID, ids); err != nil {
+		return nil, folderErrorToConnect(err)
+	}
+	return connect.NewResponse(&feedsv1.ReorderFoldersResponse{}), nil
+}
+
+func (h *feedsConnectHandler) MoveFeed(
+	ctx context.Context,
+	req *connect.Request[feedsv1.MoveFeedRequest],
+) (*connect.Response[feedsv1.MoveFeedResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+	feedID, cerr := parseFeedID(req.Msg.FeedId)
+	if cerr != nil {
+		return nil, cerr
+	}
+	folderID, cerr := parseOptionalFolderID(req.Msg.FolderId)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	if err := h.app.Services.Feeds.MoveFeed(
+		ctx, user.ID, feedID, folderID,
+	); err != nil {
+		return nil, folderErrorToConnect(err)
+	}
+	return connect.NewResponse(&feedsv1.MoveFeedResponse{}), nil
+}
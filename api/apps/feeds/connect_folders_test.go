@@ -0,0 +1,185 @@
+package feeds_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	feedsv1 "tools.xdoubleu.com/gen/feeds/v1"
+	"tools.xdoubleu.com/gen/feeds/v1/feedsv1connect"
+)
+
+func findFolder(folders []*feedsv1.Folder, id string) *feedsv1.Folder {
+	for _, f := range folders {
+		if f.Id == id {
+			return f
+		}
+	}
+	return nil
+}
+
+func createFolder(
+	t *testing.T,
+	client feedsv1connect.FeedServiceClient,
+	name, parentID string,
+) *feedsv1.Folder {
+	t.Helper()
+	resp, err := client.CreateFolder(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateFolderRequest{Name: name, ParentId: parentID}),
+	)
+	require.NoError(t, err)
+	return resp.Msg.Folder
+}
+
+func listFeeds(
+	t *testing.T,
+	client feedsv1connect.FeedServiceClient,
+) *feedsv1.ListFeedsResponse {
+	t.Helper()
+	resp, err := client.ListFeeds(
+		context.Background(), connect.NewRequest(&feedsv1.ListFeedsRequest{}),
+	)
+	require.NoError(t, err)
+	return resp.Msg
+}
+
+func TestCreateFolder_NestsOneLevel(t *testing.T) {
+	client := newFeedsClient(t)
+	name := "Tech " + uuid.NewString()
+	top := createFolder(t, client, "  "+name+" ", "")
+	assert.Equal(t, name, top.Name)
+	assert.Empty(t, top.ParentId)
+
+	sub := createFolder(t, client, "Go", top.Id)
+	assert.Equal(t, top.Id, sub.ParentId)
+	assert.Equal(t, int32(0), sub.Position)
+	second := createFolder(t, client, "Rust", top.Id)
+	assert.Equal(t, int32(1), second.Position)
+
+	_, err := client.CreateFolder(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateFolderRequest{Name: "Deeper", ParentId: sub.Id}),
+	)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = client.CreateFolder(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateFolderRequest{Name: "Go", ParentId: top.Id}),
+	)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	_, err = client.CreateFolder(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateFolderRequest{Name: "   "}),
+	)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestRenameFolder(t *testing.T) {
+	client := newFeedsClient(t)
+	folder := createFolder(t, client, "Old "+uuid.NewString(), "")
+	newName := "New " + uuid.NewString()
+
+	_, err := client.RenameFolder(
+		context.Background(),
+		connect.NewRequest(&feedsv1.RenameFolderRequest{FolderId: folder.Id, Name: newName}),
+	)
+	require.NoError(t, err)
+	got := findFolder(listFeeds(t, client).Folders, folder.Id)
+	require.NotNil(t, got)
+	assert.Equal(t, newName, got.Name)
+
+	_, err = client.RenameFolder(
+		context.Background(),
+		connect.NewRequest(&feedsv1.RenameFolderRequest{
+			FolderId: uuid.NewString(),
+			Name:     "Missing",
+		}),
+	)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestReorderFolders(t *testing.T) {
+	client := newFeedsClient(t)
+	parent := createFolder(t, client, "Parent "+uuid.NewString(), "")
+	a := createFolder(t, client, "A", parent.Id)
+	b := createFolder(t, client, "B", parent.Id)
+
+	_, err := client.ReorderFolders(
+		context.Background(),
+		connect.NewRequest(&feedsv1.ReorderFoldersRequest{
+			Ids: []string{b.Id, "not-a-uuid", a.Id},
+		}),
+	)
+	require.NoError(t, err)
+
+	folders := listFeeds(t, client).Folders
+	assert.Equal(t, int32(0), findFolder(folders, b.Id).Position)
+	assert.Equal(t, int32(1), findFolder(folders, a.Id).Position)
+}
+
+func TestMoveFeed_FolderFilterAndUnreadCounts(t *testing.T) {
+	client := newFeedsClient(t)
+	itemID, feedID := createItemAndFeed(t, client)
+	top := createFolder(t, client, "Reading "+uuid.NewString(), "")
+	sub := createFolder(t, client, "Later", top.Id)
+
+	_, err := client.MoveFeed(
+		context.Background(),
+		connect.NewRequest(&feedsv1.MoveFeedRequest{FeedId: feedID, FolderId: sub.Id}),
+	)
+	require.NoError(t, err)
+
+	// The top-level folder's view includes its subfolders' feeds.
+	items, err := client.ListFeedItems(
+		context.Background(),
+		connect.NewRequest(&feedsv1.ListFeedItemsRequest{FolderId: &top.Id}),
+	)
+	require.NoError(t, err)
+	require.Len(t, items.Msg.Items, 1)
+	assert.Equal(t, itemID, items.Msg.Items[0].Id)
+
+	listed := listFeeds(t, client)
+	feed := findFeed(listed.Feeds, feedID)
+	require.NotNil(t, feed)
+	assert.Equal(t, sub.Id, feed.FolderId)
+	assert.Equal(t, int32(1), feed.UnreadCount)
+	assert.Equal(t, int32(1), findFolder(listed.Folders, sub.Id).UnreadCount)
+	assert.Equal(t, int32(1), findFolder(listed.Folders, top.Id).UnreadCount)
+
+	// Deleting the folder takes its subfolder with it but keeps the feed.
+	_, err = client.DeleteFolder(
+		context.Background(),
+		connect.NewRequest(&feedsv1.DeleteFolderRequest{FolderId: top.Id}),
+	)
+	require.NoError(t, err)
+	listed = listFeeds(t, client)
+	assert.Nil(t, findFolder(listed.Folders, sub.Id))
+	feed = findFeed(listed.Feeds, feedID)
+	require.NotNil(t, feed)
+	assert.Empty(t, feed.FolderId)
+}
+
+func TestMoveFeed_UnknownFolder_NotFound(t *testing.T) {
+	client := newFeedsClient(t)
+	_, feedID := createItemAndFeed(t, client)
+
+	_, err := client.MoveFeed(
+		context.Background(),
+		connect.NewRequest(&feedsv1.MoveFeedRequest{
+			FeedId:   feedID,
+			FolderId: uuid.NewString(),
+		}),
+	)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
@@ -0,0 +1,77 @@
+package feeds
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+	feedsv1 "tools.xdoubleu.com/gen/feeds/v1"
+)
+
+// opmlContentType is the media type of an exported OPML file.
+const opmlContentType = "text/x-opml+xml"
+
+func (h *feedsConnectHandler) ImportOPML(
+	ctx context.Context,
+	req *connect.Request[feedsv1.ImportOPMLRequest],
+) (*connect.Response[feedsv1.ImportOPMLResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	results, err := h.app.Services.Feeds.ImportOPML(ctx, user.ID, req.Msg.Data)
+	if err != nil {
+		return nil, feedErrorToConnect(err)
+	}
+
+	//nolint:exhaustruct // counts accumulated below
+	resp := &feedsv1.ImportOPMLResponse{
+		Results: make([]*feedsv1.OPMLImportResult, len(results)),
+	}
+	for i, r := range results {
+		//nolint:exhaustruct // feed only set for created outlines
+		result := &feedsv1.OPMLImportResult{
+			Url:    r.URL,
+			Title:  r.Title,
+			Folder: r.Folder,
+			Status: r.Status,
+			Error:  r.Error,
+		}
+		switch r.Status {
+		case models.OPMLImportCreated:
+			result.Feed = protoFeed(*r.Feed)
+			resp.CreatedCount++
+		case models.OPMLImportDuplicate:
+			resp.DuplicateCount++
+		case models.OPMLImportDeferred:
+			// Not a failure: importing the file again picks it up.
+		default:
+			resp.FailedCount++
+		}
+		resp.Results[i] = result
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *feedsConnectHandler) ExportOPML(
+	ctx context.Context,
+	_ *connect.Request[feedsv1.ExportOPMLRequest],
+) (*connect.Response[feedsv1.ExportOPMLResponse], error) {
+	user, cerr := feedUser(ctx)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	data, err := h.app.Services.Feeds.ExportOPML(ctx, user.ID)
+	if err != nil {
+		return nil, feedErrorToConnect(err)
+	}
+	return connect.NewResponse(&feedsv1.ExportOPMLResponse{
+		Data:        data,
+		ContentType: opmlContentType,
+		Filename:    "feeds-" + time.Now().UTC().Format(time.DateOnly) + ".opml",
+	}), nil
+}
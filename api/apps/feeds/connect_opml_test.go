@@ -0,0 +1,237 @@
+package feeds_test
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	feedsv1 "tools.xdoubleu.com/gen/feeds/v1"
+)
+
+func TestImportOPML_CreatesAndReportsEachOutline(t *testing.T) {
+	base := uniqueBlogBase()
+	existingURL := base + "/existing.xml"
+	newURL := base + "/new.xml"
+	notAFeedURL := base + "/not-a-feed"
+	unreachableURL := base + "/never-registered.xml"
+	mockWebFetch.SetBody(existingURL, "application/rss+xml", []byte(rssXML("Existing")))
+	mockWebFetch.SetBody(newURL, "application/rss+xml", []byte(rssXML(
+		"Parsed Title", rssItem{"Post", base + "/post", "opml-1", itemContent},
+	)))
+	mockWebFetch.SetHTML(notAFeedURL, "<html>not xml</html>")
+
+	client := newFeedsClient(t)
+	_, err := client.CreateFeed(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateFeedRequest{Url: existingURL}),
+	)
+	require.NoError(t, err)
+
+	opml := `<?xml version="1.0"?><opml version="2.0"><body>` +
+		`<outline text="Existing" xmlUrl="` + existingURL + `"/>` +
+		`<outline text="News"><outline text="Reader Title" xmlUrl="` + newURL + `"/>` +
+		`<outline text="Again" xmlUrl="` + newURL + `?utm_source=x"/></outline>` +
+		`<outline text="Broken" xmlUrl="` + notAFeedURL + `"/>` +
+		`<outline text="Gone" xmlUrl="` + unreachableURL + `"/>` +
+		`<outline text="FTP" xmlUrl="ftp://example.com/feed"/>` +
+		`</body></opml>`
+	resp, err := client.ImportOPML(
+		context.Background(),
+		connect.NewRequest(&feedsv1.ImportOPMLRequest{Data: []byte(opml)}),
+	)
+	require.NoError(t, err)
+
+	statuses := make([]string, 0, len(resp.Msg.Results))
+	for _, r := range resp.Msg.Results {
+		statuses = append(statuses, r.Status)
+	}
+	assert.Equal(t, []string{
+		"duplicate", "created", "duplicate", "invalid", "unreachable", "invalid",
+	}, statuses)
+	assert.Equal(t, int32(1), resp.Msg.CreatedCount)
+	assert.Equal(t, int32(2), resp.Msg.DuplicateCount)
+	assert.Equal(t, int32(3), resp.Msg.FailedCount)
+	assert.NotEmpty(t, resp.Msg.Results[3].Error)
+
+	created := resp.Msg.Results[1].Feed
+	require.NotNil(t, created)
+	// The reader's name for the feed wins over the one it reports itself.
+	assert.Equal(t, "Reader Title", created.Title)
+	assert.Equal(t, newURL, created.Url)
+	assert.Equal(t, "News", resp.Msg.Results[1].Folder)
+	waitForFeedImport(t, client, created.Id)
+
+	listed, err := client.ListFeeds(
+		context.Background(), connect.NewRequest(&feedsv1.ListFeedsRequest{}),
+	)
+	require.NoError(t, err)
+	folder := findFolder(listed.Msg.Folders, created.FolderId)
+	require.NotNil(t, folder)
+	assert.Equal(t, "News", folder.Name)
+	assert.Empty(t, folder.ParentId)
+}
+
+func TestImportOPML_CollapsesDeepFolders(t *testing.T) {
+	base := uniqueBlogBase()
+	feedURL := base + "/deep.xml"
+	mockWebFetch.SetBody(feedURL, "application/rss+xml", []byte(rssXML("Deep")))
+	top := "Top " + uuid.NewString()
+
+	client := newFeedsClient(t)
+	opml := `<opml version="2.0"><body><outline text="` + top + `">` +
+		`<outline text="Mid"><outline text="Leaf">` +
+		`<outline text="Deep" xmlUrl="` + feedURL + `"/>` +
+		`</outline></outline></outline></body></opml>`
+	resp, err := client.ImportOPML(
+		context.Background(),
+		connect.NewRequest(&feedsv1.ImportOPMLRequest{Data: []byte(opml)}),
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Results, 1)
+	created := resp.Msg.Results[0].Feed
+	require.NotNil(t, created)
+	waitForFeedImport(t, client, created.Id)
+
+	listed, err := client.ListFeeds(
+		context.Background(), connect.NewRequest(&feedsv1.ListFeedsRequest{}),
+	)
+	require.NoError(t, err)
+	sub := findFolder(listed.Msg.Folders, created.FolderId)
+	require.NotNil(t, sub)
+	assert.Equal(t, "Mid / Leaf", sub.Name)
+	parent := findFolder(listed.Msg.Folders, sub.ParentId)
+	require.NotNil(t, parent)
+	assert.Equal(t, top, parent.Name)
+}
+
+func TestImportOPML_ManyFeedsKeepDocumentOrder(t *testing.T) {
+	base := uniqueBlogBase()
+	folder := "Batch " + uuid.NewString()
+	const n = 20
+	urls := make([]string, n)
+	var outlines strings.Builder
+	for i := range n {
+		urls[i] = base + "/feed-" + strconv.Itoa(i) + ".xml"
+		mockWebFetch.SetBody(urls[i], "application/rss+xml", []byte(rssXML("Feed")))
+		outlines.WriteString(`<outline text="F` + strconv.Itoa(i) +
+			`" xmlUrl="` + urls[i] + `"/>`)
+	}
+
+	client := newFeedsClient(t)
+	opml := `<opml version="2.0"><body><outline text="` + folder + `">` +
+		outlines.String() + `</outline></body></opml>`
+	resp, err := client.ImportOPML(
+		context.Background(),
+		connect.NewRequest(&feedsv1.ImportOPMLRequest{Data: []byte(opml)}),
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Results, n)
+	assert.Equal(t, int32(n), resp.Msg.CreatedCount)
+
+	var folderID string
+	for i, r := range resp.Msg.Results {
+		assert.Equal(t, urls[i], r.Url)
+		require.NotNil(t, r.Feed, r.Url)
+		assert.Equal(t, urls[i], r.Feed.Url)
+		if folderID == "" {
+			folderID = r.Feed.FolderId
+		}
+		assert.Equal(t, folderID, r.Feed.FolderId, "one folder for the batch")
+		waitForFeedImport(t, client, r.Feed.Id)
+	}
+	assert.NotEmpty(t, folderID)
+}
+
+// TestImportOPML_DefersFeedsPastTheCap checks a file with more new feeds than
+// one import fetches (100) imports the first ones, reports the rest as
+// deferred, and that importing it again adds those.
+func TestImportOPML_DefersFeedsPastTheCap(t *testing.T) {
+	const limit, n = 100, 102
+	base := uniqueBlogBase()
+	var outlines strings.Builder
+	for i := range n {
+		url := base + "/capped-" + strconv.Itoa(i) + ".xml"
+		mockWebFetch.SetBody(url, "application/rss+xml", []byte(rssXML("Feed")))
+		outlines.WriteString(`<outline text="F` + strconv.Itoa(i) +
+			`" xmlUrl="` + url + `"/>`)
+	}
+	opml := `<opml version="2.0"><body>` + outlines.String() + `</body></opml>`
+
+	client := newFeedsClient(t)
+	importFile := func() *feedsv1.ImportOPMLResponse {
+		resp, err := client.ImportOPML(
+			context.Background(),
+			connect.NewRequest(&feedsv1.ImportOPMLRequest{Data: []byte(opml)}),
+		)
+		require.NoError(t, err)
+		require.Len(t, resp.Msg.Results, n)
+		return resp.Msg
+	}
+
+	first := importFile()
+	assert.Equal(t, int32(limit), first.CreatedCount)
+	assert.Equal(t, int32(0), first.FailedCount)
+	for _, r := range first.Results[limit:] {
+		assert.Equal(t, "deferred", r.Status)
+		assert.NotEmpty(t, r.Error)
+	}
+
+	second := importFile()
+	assert.Equal(t, int32(n-limit), second.CreatedCount)
+	assert.Equal(t, int32(limit), second.DuplicateCount)
+	for _, r := range slices.Concat(first.Results[:limit], second.Results[limit:]) {
+		require.NotNil(t, r.Feed, r.Url)
+		waitForFeedImport(t, client, r.Feed.Id)
+	}
+}
+
+func TestImportOPML_RejectsNonOPML(t *testing.T) {
+	client := newFeedsClient(t)
+	_, err := client.ImportOPML(
+		context.Background(),
+		connect.NewRequest(&feedsv1.ImportOPMLRequest{Data: []byte("<rss/>")}),
+	)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestExportOPML_ListsFeedsAndEmailComments(t *testing.T) {
+	base := uniqueBlogBase()
+	feedURL := base + "/export.xml"
+	mockWebFetch.SetBody(feedURL, "application/rss+xml", []byte(rssXML("Export Blog")))
+
+	client := newFeedsClient(t)
+	_, err := client.CreateFeed(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateFeedRequest{Url: feedURL}),
+	)
+	require.NoError(t, err)
+	_, err = client.CreateFeed(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateFeedRequest{
+			Kind:  feedsv1.FeedKind_FEED_KIND_EMAIL,
+			Title: "Export Newsletter",
+		}),
+	)
+	require.NoError(t, err)
+
+	resp, err := client.ExportOPML(
+		context.Background(), connect.NewRequest(&feedsv1.ExportOPMLRequest{}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "text/x-opml+xml", resp.Msg.ContentType)
+	assert.Regexp(t, `^feeds-\d{4}-\d{2}-\d{2}\.opml$`, resp.Msg.Filename)
+
+	out := string(resp.Msg.Data)
+	assert.Contains(t, out, `<opml version="2.0">`)
+	assert.Contains(t, out,
+		`<outline type="rss" text="Export Blog" title="Export Blog" xmlUrl="`+feedURL+`">`)
+	assert.Contains(t, out, `<!-- Email newsletter: Export Newsletter`)
+}
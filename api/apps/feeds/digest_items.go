@@ -0,0 +1,70 @@
+package feeds
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DigestItem is a lightweight, read-only projection of one item the books
+// app's daily feeds digest may include — its article body is fetched
+// separately, through ItemContent, for the items a digest ends up using.
+type DigestItem struct {
+	ID          uuid.UUID
+	UserID      string
+	FeedID      uuid.UUID
+	FeedTitle   string
+	Title       string
+	SourceURL   string
+	PublishedAt time.Time
+}
+
+// ListDigestCandidates returns every user's unread (and not dismissed) or
+// bookmarked items ingested since since. Together with ItemContent and
+// MarkItemsRead it is the only entry point the books app's feeds digest
+// reaches feeds' items through — cmd/api adapts them to books'
+// FeedDigestSource instead of books querying feeds.items directly.
+func (a *Feeds) ListDigestCandidates(
+	ctx context.Context,
+	since time.Time,
+) ([]DigestItem, error) {
+	items, err := a.Services.Feeds.ListDigestCandidates(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]DigestItem, 0, len(items))
+	for _, d := range items {
+		out = append(out, DigestItem{
+			ID:          d.Item.ID,
+			UserID:      d.UserID,
+			FeedID:      d.Item.FeedID,
+			FeedTitle:   d.FeedTitle,
+			Title:       d.Item.Title,
+			SourceURL:   d.Item.SourceURL,
+			PublishedAt: d.Item.PublishedAt,
+		})
+	}
+	return out, nil
+}
+
+// ItemContent returns the article bodies of userID's items among itemIDs,
+// keyed by item id.
+func (a *Feeds) ItemContent(
+	ctx context.Context,
+	userID string,
+	itemIDs []uuid.UUID,
+) (map[uuid.UUID]string, error) {
+	return a.Services.Feeds.ItemContent(ctx, userID, itemIDs)
+}
+
+// MarkItemsRead marks userID's items among itemIDs read — how reading them
+// in a digest on the e-reader shows up in feeds.
+func (a *Feeds) MarkItemsRead(
+	ctx context.Context,
+	userID string,
+	itemIDs []uuid.UUID,
+) error {
+	return a.Services.Feeds.MarkItemsRead(ctx, userID, itemIDs)
+}
@@ -141,7 +141,8 @@ func (a *Feeds) emailInboundHandler(w http.ResponseWriter, r *http.Request) {
 		messageID = payload.Data.EmailID
 	}
 	a.Services.Feeds.IngestEmail(
-		r.Context(), *feed, messageID, payload.Data.Subject, htmlBody,
+		r.Context(), *feed, messageID, payload.Data.From, payload.Data.Subject,
+		htmlBody,
 	)
 	w.WriteHeader(http.StatusOK)
 }
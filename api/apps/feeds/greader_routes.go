@@ -0,0 +1,697 @@
+package feeds
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+	"tools.xdoubleu.com/internal/communication/httptools"
+	"tools.xdoubleu.com/internal/database"
+)
+
+// Google Reader stream and tag ids. Clients may put the user id from
+// user-info in place of the "-", which greaderNormalizeTag undoes.
+const (
+	greaderReadingList = "user/-/state/com.google/reading-list"
+	greaderRead        = "user/-/state/com.google/read"
+	greaderStarred     = "user/-/state/com.google/starred"
+	greaderKeptUnread  = "user/-/state/com.google/kept-unread"
+	greaderLabelPrefix = "user/-/label/"
+	greaderFeedPrefix  = "feed/"
+	greaderItemPrefix  = "tag:google.com,2005:reader/item/"
+)
+
+// errGreaderStream is returned for a stream id that names no stream of the
+// user's: an unknown state, feed or label.
+var errGreaderStream = errors.New("unknown stream")
+
+// greaderRoutes mounts a Google Reader-compatible API under
+// /{prefix}/greader, the server URL users enter in native reader apps
+// (Reeder, NetNewsWire, ReadKit) that don't speak ConnectRPC. It covers
+// what those apps need to read: ClientLogin, the subscription and tag
+// lists, unread counts, streams and edit-tag for read/starred state, which
+// is the items' read_at and bookmarked as the web reader sees them.
+// Subscriptions are managed in the web app, so subscription/edit and
+// quickadd are not served. The Fever API is not served either.
+//
+// Apps log in with a username and an app password created in the web app;
+// the Auth token ClientLogin hands back carries both and is checked again
+// on every request. The T token edits send is not checked: requests are
+// authenticated by header, so there is no cross-site request to forge.
+func (a *Feeds) greaderRoutes(prefix string, mux *http.ServeMux) {
+	base := "/" + prefix + "/greader"
+	mux.HandleFunc("POST "+base+"/accounts/ClientLogin", a.greaderClientLoginHandler)
+
+	api := base + "/reader/api/0"
+	mux.HandleFunc("GET "+api+"/token", a.greaderTokenHandler)
+	mux.HandleFunc("GET "+api+"/user-info", a.greaderUserInfoHandler)
+	mux.HandleFunc("GET "+api+"/subscription/list", a.greaderSubscriptionsHandler)
+	mux.HandleFunc("GET "+api+"/tag/list", a.greaderTagsHandler)
+	mux.HandleFunc("GET "+api+"/unread-count", a.greaderUnreadCountHandler)
+	mux.HandleFunc("GET "+api+"/stream/items/ids", a.greaderItemIDsHandler)
+	mux.HandleFunc("GET "+api+"/stream/items/contents", a.greaderItemContentsHandler)
+	mux.HandleFunc("POST "+api+"/stream/items/contents", a.greaderItemContentsHandler)
+	mux.HandleFunc("GET "+api+"/stream/contents", a.greaderStreamContentsHandler)
+	mux.HandleFunc(
+		"GET "+api+"/stream/contents/{stream...}", a.greaderStreamContentsHandler,
+	)
+	mux.HandleFunc("POST "+api+"/edit-tag", a.greaderEditTagHandler)
+	mux.HandleFunc("POST "+api+"/mark-all-as-read", a.greaderMarkAllAsReadHandler)
+}
+
+func greaderWriteJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func greaderWriteText(w http.ResponseWriter, text string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte(text))
+}
+
+func (a *Feeds) greaderInternalError(
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+) {
+	a.Logger.ErrorContext(r.Context(), "greader: request failed",
+		"path", r.URL.Path, "error", err)
+	http.Error(w, "internal error", http.StatusInternalServerError)
+}
+
+// greaderToken is the Auth token ClientLogin returns: the username and
+// app password, which greaderAuth splits apart again.
+func greaderToken(username, password string) string {
+	return username + "/" + password
+}
+
+// greaderAuth validates HTTPS and the "GoogleLogin auth=" header every API
+// request carries, and parses the request's form. Returns the user id and
+// the username the app logged in with, or writes an error response and
+// returns ok=false.
+func (a *Feeds) greaderAuth(
+	w http.ResponseWriter,
+	r *http.Request,
+) (string, string, bool) {
+	if !httptools.RequireHTTPS(w, r) {
+		return "", "", false
+	}
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "GoogleLogin auth=")
+	// Generated passwords never contain a slash, so the last one ends the
+	// username.
+	sep := strings.LastIndex(token, "/")
+	if !found || sep < 0 {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return "", "", false
+	}
+	username := token[:sep]
+	userID, err := a.Services.Feeds.AuthenticateAppPassword(
+		r.Context(), username, token[sep+1:],
+	)
+	if err != nil {
+		if errors.Is(err, database.ErrResourceNotFound) {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return "", "", false
+		}
+		a.greaderInternalError(w, r, err)
+		return "", "", false
+	}
+	if err = r.ParseForm(); err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return "", "", false
+	}
+	return userID, username, true
+}
+
+// greaderClientLoginHandler handles ClientLogin: Email is the app
+// password's username and Passwd the password. Only a POST body is read,
+// so the password never ends up in a URL and the access logs with it.
+func (a *Feeds) greaderClientLoginHandler(w http.ResponseWriter, r *http.Request) {
+	if !httptools.RequireHTTPS(w, r) {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	username, password := r.PostForm.Get("Email"), r.PostForm.Get("Passwd")
+	_, err := a.Services.Feeds.AuthenticateAppPassword(r.Context(), username, password)
+	if err != nil {
+		if errors.Is(err, database.ErrResourceNotFound) {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte("Error=BadAuthentication\n"))
+			return
+		}
+		a.greaderInternalError(w, r, err)
+		return
+	}
+	token := greaderToken(username, password)
+	greaderWriteText(w, "SID="+token+"\nLSID=null\nAuth="+token+"\n")
+}
+
+// greaderTokenHandler hands out the T token clients send with edits. It is
+// derived from the credentials only so it stays stable between calls.
+func (a *Feeds) greaderTokenHandler(w http.ResponseWriter, r *http.Request) {
+	if _, _, ok := a.greaderAuth(w, r); !ok {
+		return
+	}
+	sum := sha256.Sum256([]byte(r.Header.Get("Authorization")))
+	greaderWriteText(w, hex.EncodeToString(sum[:]))
+}
+
+func (a *Feeds) greaderUserInfoHandler(w http.ResponseWriter, r *http.Request) {
+	userID, username, ok := a.greaderAuth(w, r)
+	if !ok {
+		return
+	}
+	greaderWriteJSON(w, map[string]string{
+		"userId":        userID,
+		"userName":      username,
+		"userProfileId": userID,
+		"userEmail":     "",
+	})
+}
+
+type greaderCategory struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+}
+
+type greaderSubscription struct {
+	ID         string            `json:"id"`
+	Title      string            `json:"title"`
+	Categories []greaderCategory `json:"categories"`
+	URL        string            `json:"url"`
+	HTMLURL    string            `json:"htmlUrl"`
+	IconURL    string            `json:"iconUrl"`
+}
+
+func greaderFeedID(id uuid.UUID) string {
+	return greaderFeedPrefix + id.String()
+}
+
+func greaderFeedTitle(f models.Feed) string {
+	if f.Title != "" {
+		return f.Title
+	}
+	if f.URL != "" {
+		return f.URL
+	}
+	return "Email newsletter"
+}
+
+func (a *Feeds) greaderSubscriptionsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, _, ok := a.greaderAuth(w, r)
+	if !ok {
+		return
+	}
+	feeds, _, err := a.Services.Feeds.ListWithFolders(r.Context(), userID)
+	if err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+	labels, err := a.Services.Feeds.ReaderLabels(r.Context(), userID)
+	if err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+
+	subs := make([]greaderSubscription, 0, len(feeds))
+	for _, f := range feeds {
+		categories := []greaderCategory{}
+		if f.FolderID != nil {
+			label := labels[*f.FolderID]
+			categories = append(categories, greaderCategory{
+				ID: greaderLabelPrefix + label, Label: label,
+			})
+		}
+		subs = append(subs, greaderSubscription{
+			ID:         greaderFeedID(f.ID),
+			Title:      greaderFeedTitle(f),
+			Categories: categories,
+			URL:        f.URL,
+			HTMLURL:    f.URL,
+			IconURL:    "",
+		})
+	}
+	greaderWriteJSON(w, map[string]any{"subscriptions": subs})
+}
+
+type greaderTag struct {
+	ID   string `json:"id"`
+	Type string `json:"type,omitempty"`
+}
+
+func (a *Feeds) greaderTagsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, _, ok := a.greaderAuth(w, r)
+	if !ok {
+		return
+	}
+	_, folders, err := a.Services.Feeds.ListWithFolders(r.Context(), userID)
+	if err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+	labels, err := a.Services.Feeds.ReaderLabels(r.Context(), userID)
+	if err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+
+	tags := []greaderTag{{ID: greaderStarred, Type: ""}}
+	for _, f := range folders {
+		tags = append(tags, greaderTag{
+			ID: greaderLabelPrefix + labels[f.ID], Type: "folder",
+		})
+	}
+	greaderWriteJSON(w, map[string]any{"tags": tags})
+}
+
+type greaderUnreadCount struct {
+	ID    string `json:"id"`
+	Count int    `json:"count"`
+}
+
+func (a *Feeds) greaderUnreadCountHandler(w http.ResponseWriter, r *http.Request) {
+	userID, _, ok := a.greaderAuth(w, r)
+	if !ok {
+		return
+	}
+	feeds, folders, err := a.Services.Feeds.ListWithFolders(r.Context(), userID)
+	if err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+	labels, err := a.Services.Feeds.ReaderLabels(r.Context(), userID)
+	if err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+
+	total := 0
+	counts := []greaderUnreadCount{}
+	for _, f := range feeds {
+		total += f.UnreadCount
+		if f.UnreadCount > 0 {
+			counts = append(counts, greaderUnreadCount{
+				ID: greaderFeedID(f.ID), Count: f.UnreadCount,
+			})
+		}
+	}
+	for _, f := range folders {
+		if f.UnreadCount > 0 {
+			counts = append(counts, greaderUnreadCount{
+				ID: greaderLabelPrefix + labels[f.ID], Count: f.UnreadCount,
+			})
+		}
+	}
+	counts = append(counts, greaderUnreadCount{ID: greaderReadingList, Count: total})
+	greaderWriteJSON(w, map[string]any{"max": total, "unreadcounts": counts})
+}
+
+// greaderNormalizeTag rewrites "user/<id>/…" to "user/-/…", the form the
+// stream and tag ids are matched in.
+func greaderNormalizeTag(tag string) string {
+	rest, ok := strings.CutPrefix(tag, "user/")
+	if !ok {
+		return tag
+	}
+	if _, after, found := strings.Cut(rest, "/"); found {
+		return "user/-/" + after
+	}
+	return tag
+}
+
+// greaderStreamQuery resolves a stream id into the query selecting its
+// items. An empty id is the reading list. Returns errGreaderStream for a
+// stream that is not one of the user's.
+func greaderStreamQuery(
+	streamID string,
+	labels map[uuid.UUID]string,
+) (models.ReaderQuery, error) {
+	//nolint:exhaustruct // paging and filters are set by the caller
+	q := models.ReaderQuery{Stream: models.ReaderStreamAll}
+	streamID = greaderNormalizeTag(streamID)
+	switch {
+	case streamID == "" || streamID == greaderReadingList:
+	case streamID == greaderStarred:
+		q.Stream = models.ReaderStreamStarred
+	case streamID == greaderRead:
+		q.Stream = models.ReaderStreamRead
+	case strings.HasPrefix(streamID, greaderFeedPrefix):
+		id, err := uuid.Parse(strings.TrimPrefix(streamID, greaderFeedPrefix))
+		if err != nil {
+			return q, errGreaderStream
+		}
+		q.Stream, q.FeedID = models.ReaderStreamFeed, &id
+	case strings.HasPrefix(streamID, greaderLabelPrefix):
+		label := strings.TrimPrefix(streamID, greaderLabelPrefix)
+		for id, l := range labels {
+			if l == label {
+				q.Stream, q.FolderID = models.ReaderStreamFolder, &id
+				return q, nil
+			}
+		}
+		return q, errGreaderStream
+	default:
+		return q, errGreaderStream
+	}
+	return q, nil
+}
+
+// greaderStreamRequest reads a stream request's parameters: s (or the
+// stream in the path), n, r=o, c, xt, ot and nt.
+func (a *Feeds) greaderStreamRequest(
+	r *http.Request,
+	userID string,
+) (models.ReaderQuery, map[uuid.UUID]string, error) {
+	labels, err := a.Services.Feeds.ReaderLabels(r.Context(), userID)
+	if err != nil {
+		return models.ReaderQuery{}, nil, err
+	}
+	streamID := r.PathValue("stream")
+	if streamID == "" {
+		streamID = r.Form.Get("s")
+	}
+	q, err := greaderStreamQuery(streamID, labels)
+	if err != nil {
+		return models.ReaderQuery{}, nil, err
+	}
+
+	q.Limit, _ = strconv.Atoi(r.Form.Get("n"))
+	q.Offset, _ = strconv.Atoi(r.Form.Get("c"))
+	q.OldestFirst = r.Form.Get("r") == "o"
+	for _, xt := range r.Form["xt"] {
+		if greaderNormalizeTag(xt) == greaderRead {
+			q.ExcludeRead = true
+		}
+	}
+	if ot, parseErr := strconv.ParseInt(r.Form.Get("ot"), 10, 64); parseErr == nil {
+		q.Since = time.Unix(ot, 0)
+	}
+	if nt, parseErr := strconv.ParseInt(r.Form.Get("nt"), 10, 64); parseErr == nil {
+		q.Until = time.Unix(nt, 0)
+	}
+	return q, labels, nil
+}
+
+// greaderLongItemID formats a reader id the way item objects carry it.
+func greaderLongItemID(id int64) string {
+	//nolint:gosec // the protocol's ids are the same 64 bits, shown unsigned
+	return fmt.Sprintf("%s%016x", greaderItemPrefix, uint64(id))
+}
+
+// greaderParseItemID accepts an item id in either form clients send: the
+// long "tag:google.com,2005:reader/item/<hex>" form or the decimal short
+// form item id listings use.
+func greaderParseItemID(s string) (int64, error) {
+	if hexID, ok := strings.CutPrefix(s, greaderItemPrefix); ok {
+		id, err := strconv.ParseUint(hexID, 16, 64)
+		//nolint:gosec // the protocol's ids are the same 64 bits, shown unsigned
+		return int64(id), err
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func greaderItemIDs(values []string) ([]int64, error) {
+	ids := make([]int64, 0, len(values))
+	for _, v := range values {
+		id, err := greaderParseItemID(v)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+type greaderItemRef struct {
+	ID              string   `json:"id"`
+	DirectStreamIDs []string `json:"directStreamIds"`
+	TimestampUsec   string   `json:"timestampUsec"`
+}
+
+func (a *Feeds) greaderItemIDsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, _, ok := a.greaderAuth(w, r)
+	if !ok {
+		return
+	}
+	q, _, err := a.greaderStreamRequest(r, userID)
+	if errors.Is(err, errGreaderStream) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+	items, more, err := a.Services.Feeds.ReaderStream(r.Context(), userID, q, false)
+	if err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+
+	refs := make([]greaderItemRef, 0, len(items))
+	for _, item := range items {
+		refs = append(refs, greaderItemRef{
+			ID:              strconv.FormatInt(item.ReaderID, 10),
+			DirectStreamIDs: []string{},
+			TimestampUsec:   strconv.FormatInt(item.Item.CreatedAt.UnixMicro(), 10),
+		})
+	}
+	resp := map[string]any{"itemRefs": refs}
+	if more {
+		resp["continuation"] = strconv.Itoa(q.Offset + len(items))
+	}
+	greaderWriteJSON(w, resp)
+}
+
+type greaderLink struct {
+	Href string `json:"href"`
+	Type string `json:"type,omitempty"`
+}
+
+type greaderOrigin struct {
+	StreamID string `json:"streamId"`
+	Title    string `json:"title"`
+	HTMLURL  string `json:"htmlUrl"`
+}
+
+type greaderContent struct {
+	Direction string `json:"direction"`
+	Content   string `json:"content"`
+}
+
+type greaderItem struct {
+	ID            string         `json:"id"`
+	CrawlTimeMsec string         `json:"crawlTimeMsec"`
+	TimestampUsec string         `json:"timestampUsec"`
+	Published     int64          `json:"published"`
+	Updated       int64          `json:"updated"`
+	Title         string         `json:"title"`
+	Author        string         `json:"author,omitempty"`
+	Canonical     []greaderLink  `json:"canonical"`
+	Alternate     []greaderLink  `json:"alternate"`
+	Categories    []string       `json:"categories"`
+	Origin        greaderOrigin  `json:"origin"`
+	Summary       greaderContent `json:"summary"`
+}
+
+type greaderStream struct {
+	ID           string        `json:"id"`
+	Updated      int64         `json:"updated"`
+	Items        []greaderItem `json:"items"`
+	Continuation string        `json:"continuation,omitempty"`
+}
+
+func greaderItemJSON(r models.ReaderItem, labels map[uuid.UUID]string) greaderItem {
+	item := r.Item
+	categories := []string{greaderReadingList}
+	if item.ReadAt != nil {
+		categories = append(categories, greaderRead)
+	}
+	if item.Bookmarked {
+		categories = append(categories, greaderStarred)
+	}
+	if r.FolderID != nil {
+		categories = append(categories, greaderLabelPrefix+labels[*r.FolderID])
+	}
+	links := []greaderLink{}
+	if item.SourceURL != "" {
+		links = append(links, greaderLink{Href: item.SourceURL, Type: "text/html"})
+	}
+	return greaderItem{
+		ID:            greaderLongItemID(r.ReaderID),
+		CrawlTimeMsec: strconv.FormatInt(item.CreatedAt.UnixMilli(), 10),
+		TimestampUsec: strconv.FormatInt(item.CreatedAt.UnixMicro(), 10),
+		Published:     item.PublishedAt.Unix(),
+		Updated:       item.PublishedAt.Unix(),
+		Title:         item.Title,
+		Author:        item.Author,
+		Canonical:     links,
+		Alternate:     links,
+		Categories:    categories,
+		Origin: greaderOrigin{
+			StreamID: greaderFeedID(item.FeedID),
+			Title:    r.FeedTitle,
+			HTMLURL:  r.FeedURL,
+		},
+		Summary: greaderContent{Direction: "ltr", Content: item.ContentHTML},
+	}
+}
+
+func greaderStreamJSON(
+	id string,
+	items []models.ReaderItem,
+	labels map[uuid.UUID]string,
+) greaderStream {
+	out := greaderStream{
+		ID:           id,
+		Updated:      time.Now().Unix(),
+		Items:        make([]greaderItem, 0, len(items)),
+		Continuation: "",
+	}
+	for _, item := range items {
+		out.Items = append(out.Items, greaderItemJSON(item, labels))
+	}
+	return out
+}
+
+// greaderStreamContentsHandler serves a page of a stream's items with their
+// content; the stream is named in the path or by s.
+func (a *Feeds) greaderStreamContentsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, _, ok := a.greaderAuth(w, r)
+	if !ok {
+		return
+	}
+	q, labels, err := a.greaderStreamRequest(r, userID)
+	if errors.Is(err, errGreaderStream) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+	items, more, err := a.Services.Feeds.ReaderStream(r.Context(), userID, q, true)
+	if err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+
+	streamID := r.PathValue("stream")
+	if streamID == "" {
+		streamID = r.Form.Get("s")
+	}
+	if streamID == "" {
+		streamID = greaderReadingList
+	}
+	resp := greaderStreamJSON(streamID, items, labels)
+	if more {
+		resp.Continuation = strconv.Itoa(q.Offset + len(items))
+	}
+	greaderWriteJSON(w, resp)
+}
+
+// greaderItemContentsHandler serves the items whose ids are given as i,
+// how clients fetch the content of ids they listed.
+func (a *Feeds) greaderItemContentsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, _, ok := a.greaderAuth(w, r)
+	if !ok {
+		return
+	}
+	ids, err := greaderItemIDs(r.Form["i"])
+	if err != nil {
+		http.Error(w, "invalid item id", http.StatusBadRequest)
+		return
+	}
+	labels, err := a.Services.Feeds.ReaderLabels(r.Context(), userID)
+	if err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+	items, err := a.Services.Feeds.ReaderItems(r.Context(), userID, ids)
+	if err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+	greaderWriteJSON(w, greaderStreamJSON(greaderReadingList, items, labels))
+}
+
+// greaderEditTagHandler adds (a) and removes (r) tags on the items i. Only
+// the read, kept-unread and starred states are stored; other tags are
+// accepted and ignored.
+func (a *Feeds) greaderEditTagHandler(w http.ResponseWriter, r *http.Request) {
+	userID, _, ok := a.greaderAuth(w, r)
+	if !ok {
+		return
+	}
+	ids, err := greaderItemIDs(r.Form["i"])
+	if err != nil {
+		http.Error(w, "invalid item id", http.StatusBadRequest)
+		return
+	}
+
+	var read, starred *bool
+	set := func(tags []string, add bool) {
+		for _, tag := range tags {
+			switch greaderNormalizeTag(tag) {
+			case greaderRead:
+				read = &add
+			case greaderKeptUnread:
+				unread := !add
+				read = &unread
+			case greaderStarred:
+				starred = &add
+			}
+		}
+	}
+	set(r.Form["a"], true)
+	set(r.Form["r"], false)
+
+	if err = a.Services.Feeds.SetReaderItemState(
+		r.Context(), userID, ids, read, starred,
+	); err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+	greaderWriteText(w, "OK")
+}
+
+// greaderMarkAllAsReadHandler marks the stream s read, up to ts
+// (microseconds) when given so items that arrived since the app last
+// refreshed stay unread.
+func (a *Feeds) greaderMarkAllAsReadHandler(w http.ResponseWriter, r *http.Request) {
+	userID, _, ok := a.greaderAuth(w, r)
+	if !ok {
+		return
+	}
+	labels, err := a.Services.Feeds.ReaderLabels(r.Context(), userID)
+	if err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+	q, err := greaderStreamQuery(r.Form.Get("s"), labels)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if ts, parseErr := strconv.ParseInt(r.Form.Get("ts"), 10, 64); parseErr == nil {
+		q.Until = time.UnixMicro(ts)
+	}
+	if err = a.Services.Feeds.MarkReaderStreamRead(r.Context(), userID, q); err != nil {
+		a.greaderInternalError(w, r, err)
+		return
+	}
+	greaderWriteText(w, "OK")
+}
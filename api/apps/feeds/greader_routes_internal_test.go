@@ -0,0 +1,74 @@
+package feeds
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+)
+
+func TestGReaderItemIDs(t *testing.T) {
+	for _, id := range []int64{1, 255, 1 << 40, -2} {
+		long := greaderLongItemID(id)
+		assert.Len(t, long, len(greaderItemPrefix)+16)
+		parsed, err := greaderParseItemID(long)
+		require.NoError(t, err)
+		assert.Equal(t, id, parsed)
+	}
+	assert.Equal(t,
+		"tag:google.com,2005:reader/item/00000000000000ff", greaderLongItemID(255),
+	)
+
+	parsed, err := greaderParseItemID("255")
+	require.NoError(t, err)
+	assert.Equal(t, int64(255), parsed)
+
+	for _, bad := range []string{"", "abc", greaderItemPrefix + "xyz"} {
+		_, err = greaderParseItemID(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestGReaderNormalizeTag(t *testing.T) {
+	assert.Equal(t, greaderRead, greaderNormalizeTag("user/1234/state/com.google/read"))
+	assert.Equal(t, greaderStarred, greaderNormalizeTag(greaderStarred))
+	assert.Equal(t, "feed/x", greaderNormalizeTag("feed/x"))
+	assert.Equal(t, "user/", greaderNormalizeTag("user/"))
+}
+
+func TestGReaderStreamQuery(t *testing.T) {
+	feedID, top, sub := uuid.New(), uuid.New(), uuid.New()
+	labels := map[uuid.UUID]string{top: "News", sub: "News / Tech"}
+
+	for stream, want := range map[string]string{
+		"":                                 models.ReaderStreamAll,
+		greaderReadingList:                 models.ReaderStreamAll,
+		"user/42/state/com.google/starred": models.ReaderStreamStarred,
+		greaderRead:                        models.ReaderStreamRead,
+		"feed/" + feedID.String():          models.ReaderStreamFeed,
+		"user/-/label/News / Tech":         models.ReaderStreamFolder,
+	} {
+		q, err := greaderStreamQuery(stream, labels)
+		require.NoError(t, err, stream)
+		assert.Equal(t, want, q.Stream, stream)
+	}
+
+	q, err := greaderStreamQuery("feed/"+feedID.String(), labels)
+	require.NoError(t, err)
+	assert.Equal(t, &feedID, q.FeedID)
+	q, err = greaderStreamQuery("user/-/label/News / Tech", labels)
+	require.NoError(t, err)
+	assert.Equal(t, &sub, q.FolderID)
+
+	for _, bad := range []string{
+		"feed/https://example.com/feed.xml",
+		"user/-/label/Sports",
+		"user/-/state/com.google/broadcast",
+	} {
+		_, err = greaderStreamQuery(bad, labels)
+		assert.ErrorIs(t, err, errGreaderStream, bad)
+	}
+}
@@ -0,0 +1,464 @@
+package feeds_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	feedsv1 "tools.xdoubleu.com/gen/feeds/v1"
+	"tools.xdoubleu.com/gen/feeds/v1/feedsv1connect"
+)
+
+// Like the filter rule tests, these only look at streams of feeds the test
+// created: the tests share one user, so the reading list holds every other
+// test's items too.
+
+// greaderClient speaks the Google Reader API to the test server the way a
+// native app does once it has logged in.
+type greaderClient struct {
+	ts    *httptest.Server
+	token string
+}
+
+func greaderRequest(
+	t *testing.T,
+	method, target string,
+	form url.Values,
+) *http.Request {
+	t.Helper()
+	var body io.Reader
+	if method == http.MethodPost {
+		body = strings.NewReader(form.Encode())
+	} else if form != nil {
+		target += "?" + form.Encode()
+	}
+	req, err := http.NewRequestWithContext(context.Background(), method, target, body)
+	require.NoError(t, err)
+	if method == http.MethodPost {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	req.Header.Set("X-Forwarded-Proto", "https")
+	return req
+}
+
+func greaderSend(t *testing.T, req *http.Request) (int, string) {
+	t.Helper()
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func greaderLogin(
+	t *testing.T,
+	ts *httptest.Server,
+	username, password string,
+) (int, string) {
+	t.Helper()
+	return greaderSend(t, greaderRequest(
+		t, http.MethodPost, ts.URL+"/feeds/greader/accounts/ClientLogin",
+		url.Values{"Email": {username}, "Passwd": {password}},
+	))
+}
+
+// newGReaderClient creates an app password and logs in with it.
+func newGReaderClient(
+	t *testing.T,
+	ts *httptest.Server,
+	client feedsv1connect.FeedServiceClient,
+) greaderClient {
+	t.Helper()
+	created, err := client.CreateAppPassword(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateAppPasswordRequest{Username: "reeder"}),
+	)
+	require.NoError(t, err)
+
+	status, body := greaderLogin(t, ts, "reeder", created.Msg.Password)
+	require.Equal(t, http.StatusOK, status, body)
+	for line := range strings.Lines(body) {
+		if token, ok := strings.CutPrefix(strings.TrimSpace(line), "Auth="); ok {
+			return greaderClient{ts: ts, token: token}
+		}
+	}
+	t.Fatalf("no Auth token in %q", body)
+	return greaderClient{}
+}
+
+func (c greaderClient) do(
+	t *testing.T,
+	method, path string,
+	form url.Values,
+) (int, string) {
+	t.Helper()
+	req := greaderRequest(t, method, c.ts.URL+"/feeds/greader/reader/api/0"+path, form)
+	req.Header.Set("Authorization", "GoogleLogin auth="+c.token)
+	return greaderSend(t, req)
+}
+
+func (c greaderClient) getJSON(t *testing.T, path string, form url.Values, v any) {
+	t.Helper()
+	status, body := c.do(t, http.MethodGet, path, form)
+	require.Equal(t, http.StatusOK, status, body)
+	require.NoError(t, json.Unmarshal([]byte(body), v))
+}
+
+type greaderStreamJSON struct {
+	Items []struct {
+		ID         string   `json:"id"`
+		Title      string   `json:"title"`
+		Categories []string `json:"categories"`
+		Origin     struct {
+			StreamID string `json:"streamId"`
+		} `json:"origin"`
+		Summary struct {
+			Content string `json:"content"`
+		} `json:"summary"`
+	} `json:"items"`
+	Continuation string `json:"continuation"`
+}
+
+type greaderIDsJSON struct {
+	ItemRefs []struct {
+		ID string `json:"id"`
+	} `json:"itemRefs"`
+	Continuation string `json:"continuation"`
+}
+
+// createGReaderFeed subscribes to a feed with the given item titles and
+// waits for them to be ingested.
+func createGReaderFeed(
+	t *testing.T,
+	client feedsv1connect.FeedServiceClient,
+	titles ...string,
+) string {
+	t.Helper()
+	base := uniqueBlogBase()
+	feedURL := base + "/feed.xml"
+	items := make([]rssItem, 0, len(titles))
+	for _, title := range titles {
+		link := base + "/" + uuid.NewString()
+		items = append(items, rssItem{title, link, link, itemContent})
+	}
+	mockWebFetch.SetBody(
+		feedURL, "application/rss+xml", []byte(rssXML("Reader Blog", items...)),
+	)
+	created, err := client.CreateFeed(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateFeedRequest{Url: feedURL}),
+	)
+	require.NoError(t, err)
+	waitForFeedImport(t, client, created.Msg.Feed.Id)
+	return created.Msg.Feed.Id
+}
+
+func TestGReader_ClientLogin(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+	client := newFeedsClient(t)
+
+	created, err := client.CreateAppPassword(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateAppPasswordRequest{Username: " phone "}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "phone", created.Msg.AppPassword.Username)
+	assert.Len(t, created.Msg.Password, 16)
+
+	status, body := greaderLogin(t, ts, "phone", created.Msg.Password)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, "Auth=phone/"+created.Msg.Password+"\n")
+
+	for _, bad := range [][2]string{
+		{"phone", "wrong"},
+		{"tablet", created.Msg.Password},
+		{"", ""},
+	} {
+		status, body = greaderLogin(t, ts, bad[0], bad[1])
+		assert.Equal(t, http.StatusUnauthorized, status)
+		assert.Equal(t, "Error=BadAuthentication\n", body)
+	}
+
+	creds := url.Values{"Email": {"phone"}, "Passwd": {created.Msg.Password}}
+	status, _ = greaderSend(t, greaderRequest(
+		t, http.MethodGet, ts.URL+"/feeds/greader/accounts/ClientLogin", creds,
+	))
+	assert.Equal(t, http.StatusMethodNotAllowed, status)
+	req := greaderRequest(
+		t, http.MethodPost,
+		ts.URL+"/feeds/greader/accounts/ClientLogin?"+creds.Encode(), nil,
+	)
+	status, _ = greaderSend(t, req)
+	assert.Equal(t, http.StatusUnauthorized, status,
+		"credentials in the query string are ignored")
+
+	reader := greaderClient{ts: ts, token: "phone/" + created.Msg.Password}
+	var info map[string]string
+	reader.getJSON(t, "/user-info", nil, &info)
+	assert.Equal(t, userID, info["userId"])
+	assert.Equal(t, "phone", info["userName"])
+
+	status, _ = greaderClient{ts: ts, token: "phone/wrong"}.do(
+		t, http.MethodGet, "/user-info", nil,
+	)
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	req = greaderRequest(
+		t, http.MethodGet, ts.URL+"/feeds/greader/reader/api/0/token", nil,
+	)
+	req.Header.Del("X-Forwarded-Proto")
+	req.Header.Set("Authorization", "GoogleLogin auth="+reader.token)
+	status, _ = greaderSend(t, req)
+	assert.Equal(t, http.StatusForbidden, status)
+}
+
+func TestGReader_SubscriptionsAndStreams(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+	client := newFeedsClient(t)
+	reader := newGReaderClient(t, ts, client)
+
+	feedID := createGReaderFeed(t, client, "First", "Second", "Third")
+	top := createFolder(t, client, "Reader "+uuid.NewString(), "")
+	sub := createFolder(t, client, "Tech", top.Id)
+	_, err := client.MoveFeed(
+		context.Background(),
+		connect.NewRequest(&feedsv1.MoveFeedRequest{FeedId: feedID, FolderId: sub.Id}),
+	)
+	require.NoError(t, err)
+	label := "user/-/label/" + top.Name + " / Tech"
+
+	var subs struct {
+		Subscriptions []struct {
+			ID         string `json:"id"`
+			Title      string `json:"title"`
+			Categories []struct {
+				ID    string `json:"id"`
+				Label string `json:"label"`
+			} `json:"categories"`
+		} `json:"subscriptions"`
+	}
+	reader.getJSON(t, "/subscription/list", url.Values{"output": {"json"}}, &subs)
+	found := false
+	for _, s := range subs.Subscriptions {
+		if s.ID == "feed/"+feedID {
+			found = true
+			assert.Equal(t, "Reader Blog", s.Title)
+			require.Len(t, s.Categories, 1)
+			assert.Equal(t, label, s.Categories[0].ID)
+		}
+	}
+	assert.True(t, found, "feed missing from subscription/list")
+
+	var tags struct {
+		Tags []struct {
+			ID string `json:"id"`
+		} `json:"tags"`
+	}
+	reader.getJSON(t, "/tag/list", nil, &tags)
+	assert.Contains(t, tags.Tags, struct {
+		ID string `json:"id"`
+	}{label})
+
+	// Ids page newest first, continuing where the last page stopped.
+	var ids greaderIDsJSON
+	reader.getJSON(t, "/stream/items/ids", url.Values{
+		"s": {"feed/" + feedID}, "n": {"2"},
+	}, &ids)
+	require.Len(t, ids.ItemRefs, 2)
+	assert.Equal(t, "2", ids.Continuation)
+	firstPage := ids.ItemRefs
+	reader.getJSON(t, "/stream/items/ids", url.Values{
+		"s": {"feed/" + feedID}, "n": {"2"}, "c": {ids.Continuation},
+	}, &ids)
+	require.Len(t, ids.ItemRefs, 1)
+	assert.Empty(t, ids.Continuation)
+	assert.NotContains(t, firstPage, ids.ItemRefs[0])
+
+	// The label stream takes in the subfolder's feed, by path or by s.
+	var stream greaderStreamJSON
+	reader.getJSON(
+		t, "/stream/contents/"+url.PathEscape(label), url.Values{"r": {"o"}}, &stream,
+	)
+	require.Len(t, stream.Items, 3)
+	titles := []string{}
+	for _, item := range stream.Items {
+		titles = append(titles, item.Title)
+		assert.Equal(t, "feed/"+feedID, item.Origin.StreamID)
+		assert.Equal(t, itemContent, item.Summary.Content)
+		assert.Contains(t, item.Categories, label)
+		assert.NotContains(t, item.Categories, "user/-/state/com.google/read")
+	}
+	assert.ElementsMatch(t, []string{"First", "Second", "Third"}, titles)
+
+	// Contents by id, in the short and the long form.
+	status, body := reader.do(t, http.MethodPost, "/stream/items/contents", url.Values{
+		"i": {ids.ItemRefs[0].ID, stream.Items[0].ID},
+	})
+	require.Equal(t, http.StatusOK, status, body)
+	require.NoError(t, json.Unmarshal([]byte(body), &stream))
+	assert.Len(t, stream.Items, 2)
+
+	status, _ = reader.do(t, http.MethodGet, "/stream/items/ids", url.Values{
+		"s": {"feed/" + uuid.NewString()},
+	})
+	assert.Equal(t, http.StatusOK, status, "another feed's stream is just empty")
+	status, _ = reader.do(t, http.MethodGet, "/stream/items/ids", url.Values{
+		"s": {"user/-/label/No such folder " + uuid.NewString()},
+	})
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestGReader_ReadAndStarredRoundTrip(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+	client := newFeedsClient(t)
+	reader := newGReaderClient(t, ts, client)
+	feedID := createGReaderFeed(t, client, "Keep", "Skim")
+	stream := url.Values{"s": {"feed/" + feedID}}
+
+	var contents greaderStreamJSON
+	reader.getJSON(t, "/stream/contents", stream, &contents)
+	require.Len(t, contents.Items, 2)
+	readerIDs := map[string]string{}
+	for _, item := range contents.Items {
+		readerIDs[item.Title] = item.ID
+	}
+
+	// The app marks "Keep" read and starred; the web reader sees both.
+	status, body := reader.do(t, http.MethodPost, "/edit-tag", url.Values{
+		"i": {readerIDs["Keep"]},
+		"a": {"user/-/state/com.google/read", "user/-/state/com.google/starred"},
+		"T": {"token"},
+	})
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "OK", body)
+
+	web := feedItemsByTitle(t, client, feedID)
+	assert.NotEmpty(t, web["Keep"].ReadAt)
+	assert.True(t, web["Keep"].Bookmarked)
+	assert.Empty(t, web["Skim"].ReadAt)
+
+	var ids greaderIDsJSON
+	reader.getJSON(t, "/stream/items/ids", url.Values{
+		"s": {"feed/" + feedID}, "xt": {"user/-/state/com.google/read"},
+	}, &ids)
+	assert.Len(t, ids.ItemRefs, 1, "only Skim is unread")
+
+	// The web reader marks it unread again and the app sees that.
+	unread := false
+	_, err := client.UpdateItem(
+		context.Background(),
+		connect.NewRequest(&feedsv1.UpdateItemRequest{
+			ItemId: web["Keep"].Id, Read: &unread,
+		}),
+	)
+	require.NoError(t, err)
+	status, body = reader.do(t, http.MethodPost, "/stream/items/contents", url.Values{
+		"i": {readerIDs["Keep"]},
+	})
+	require.Equal(t, http.StatusOK, status)
+	require.NoError(t, json.Unmarshal([]byte(body), &contents))
+	require.Len(t, contents.Items, 1)
+	assert.NotContains(t, contents.Items[0].Categories, "user/-/state/com.google/read")
+	assert.Contains(t, contents.Items[0].Categories, "user/-/state/com.google/starred")
+
+	// Unstarring, with the user id in place of "-" as some apps send it.
+	status, _ = reader.do(t, http.MethodPost, "/edit-tag", url.Values{
+		"i": {readerIDs["Keep"]},
+		"r": {"user/" + userID + "/state/com.google/starred"},
+	})
+	require.Equal(t, http.StatusOK, status)
+	assert.False(t, feedItemsByTitle(t, client, feedID)["Keep"].Bookmarked)
+
+	status, _ = reader.do(t, http.MethodPost, "/edit-tag", url.Values{
+		"i": {"not-an-id"}, "a": {"user/-/state/com.google/read"},
+	})
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestGReader_MarkAllAsRead(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+	client := newFeedsClient(t)
+	reader := newGReaderClient(t, ts, client)
+	feedID := createGReaderFeed(t, client, "One", "Two")
+
+	// A cutoff before the items arrived leaves them unread.
+	status, _ := reader.do(t, http.MethodPost, "/mark-all-as-read", url.Values{
+		"s": {"feed/" + feedID}, "ts": {"1000000"},
+	})
+	require.Equal(t, http.StatusOK, status)
+	for _, item := range feedItemsByTitle(t, client, feedID) {
+		assert.Empty(t, item.ReadAt)
+	}
+
+	status, _ = reader.do(t, http.MethodPost, "/mark-all-as-read", url.Values{
+		"s": {"feed/" + feedID},
+	})
+	require.Equal(t, http.StatusOK, status)
+	for _, item := range feedItemsByTitle(t, client, feedID) {
+		assert.NotEmpty(t, item.ReadAt)
+	}
+}
+
+func TestAppPasswords_ListAndRevoke(t *testing.T) {
+	ts := httptest.NewServer(getRoutes())
+	t.Cleanup(ts.Close)
+	client := newFeedsClient(t)
+	username := "revoke-" + uuid.NewString()
+
+	created, err := client.CreateAppPassword(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateAppPasswordRequest{Username: username}),
+	)
+	require.NoError(t, err)
+	status, _ := greaderLogin(t, ts, username, created.Msg.Password)
+	require.Equal(t, http.StatusOK, status)
+
+	listed, err := client.ListAppPasswords(
+		context.Background(), connect.NewRequest(&feedsv1.ListAppPasswordsRequest{}),
+	)
+	require.NoError(t, err)
+	var found *feedsv1.AppPassword
+	for _, p := range listed.Msg.AppPasswords {
+		if p.Id == created.Msg.AppPassword.Id {
+			found = p
+		}
+	}
+	require.NotNil(t, found)
+	assert.Equal(t, username, found.Username)
+	assert.NotEmpty(t, found.LastUsedAt, "logging in records the use")
+
+	_, err = client.DeleteAppPassword(
+		context.Background(),
+		connect.NewRequest(&feedsv1.DeleteAppPasswordRequest{AppPasswordId: found.Id}),
+	)
+	require.NoError(t, err)
+	status, _ = greaderLogin(t, ts, username, created.Msg.Password)
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	_, err = client.DeleteAppPassword(
+		context.Background(),
+		connect.NewRequest(&feedsv1.DeleteAppPasswordRequest{AppPasswordId: found.Id}),
+	)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = client.CreateAppPassword(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateAppPasswordRequest{Username: "  "}),
+	)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
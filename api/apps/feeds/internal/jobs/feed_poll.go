@@ -10,7 +10,8 @@ import (
 
 // FeedPollJob periodically polls every RSS/Atom subscription and ingests new
 // items. Unlike jobs that need arming, the startup run is desirable, and
-// conditional GETs make quiet polls nearly free.
+// conditional GETs make quiet polls nearly free. Feeds pushed over WebSub
+// are only polled as a daily fallback, and have their leases renewed here.
 type FeedPollJob struct {
 	feeds *services.FeedService
 }
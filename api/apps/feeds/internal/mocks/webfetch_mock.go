@@ -3,6 +3,8 @@ package mocks
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"sync"
 
 	"tools.xdoubleu.com/apps/feeds/pkg/webfetch"
 )
@@ -10,6 +12,9 @@ import (
 // MockWebFetchClient is a configurable in-memory webfetch.Client keyed by
 // exact URL.
 type MockWebFetchClient struct {
+	// mu guards the maps and recorded calls: ingest fetches from several
+	// goroutines at once.
+	mu sync.Mutex
 	// Responses maps URL -> result. URLs not present return an error wrapping
 	// webfetch.ErrStatus (as a real 404 would).
 	Responses map[string]*webfetch.Result
@@ -21,20 +26,35 @@ type MockWebFetchClient struct {
 	// lets tests deterministically observe state while a fetch is in flight
 	// (e.g. proving a caller doesn't block on a detached background fetch).
 	Gates map[string]chan struct{}
+	// Posts records every PostForm in order; PostErrs maps URL -> forced
+	// error. URLs without one are accepted.
+	Posts    []PostedForm
+	PostErrs map[string]error
+}
+
+// PostedForm is one recorded PostForm call.
+type PostedForm struct {
+	URL  string
+	Form url.Values
 }
 
 // NewMockWebFetchClient returns an empty mock (every URL 404s).
 func NewMockWebFetchClient() *MockWebFetchClient {
 	return &MockWebFetchClient{
+		mu:        sync.Mutex{},
 		Responses: map[string]*webfetch.Result{},
 		Errs:      map[string]error{},
 		Calls:     nil,
 		Gates:     nil,
+		Posts:     nil,
+		PostErrs:  map[string]error{},
 	}
 }
 
 // SetHTML registers an HTML page response for url.
 func (m *MockWebFetchClient) SetHTML(url, html string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	//nolint:exhaustruct // validators/NotModified unused in mock responses
 	m.Responses[url] = &webfetch.Result{
 		Body:        []byte(html),
@@ -45,6 +65,8 @@ func (m *MockWebFetchClient) SetHTML(url, html string) {
 
 // SetBody registers a raw response with the given content type for url.
 func (m *MockWebFetchClient) SetBody(url, contentType string, body []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	//nolint:exhaustruct // validators/NotModified unused in mock responses
 	m.Responses[url] = &webfetch.Result{
 		Body:        body,
@@ -56,6 +78,8 @@ func (m *MockWebFetchClient) SetBody(url, contentType string, body []byte) {
 // SetNotModified registers a 304 (conditional GET short-circuit) response for
 // url — Body empty, NotModified true.
 func (m *MockWebFetchClient) SetNotModified(url string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	//nolint:exhaustruct // a 304 carries no body or content type
 	m.Responses[url] = &webfetch.Result{
 		FinalURL:    url,
@@ -68,10 +92,16 @@ func (m *MockWebFetchClient) Get(
 	rawURL string,
 	_ webfetch.Options,
 ) (*webfetch.Result, error) {
+	m.mu.Lock()
 	m.Calls = append(m.Calls, rawURL)
-	if gate, ok := m.Gates[rawURL]; ok {
+	gate, gated := m.Gates[rawURL]
+	m.mu.Unlock()
+	if gated {
 		<-gate
 	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	if err, ok := m.Errs[rawURL]; ok {
 		return nil, err
 	}
@@ -81,3 +111,14 @@ func (m *MockWebFetchClient) Get(
 	}
 	return res, nil
 }
+
+func (m *MockWebFetchClient) PostForm(
+	_ context.Context,
+	rawURL string,
+	form url.Values,
+) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Posts = append(m.Posts, PostedForm{URL: rawURL, Form: form})
+	return m.PostErrs[rawURL]
+}
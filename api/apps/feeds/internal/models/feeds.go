@@ -28,6 +28,8 @@ type Feed struct {
 	Title  string
 	// SourceType is FeedSourceRSS or FeedSourceEmail.
 	SourceType string
+	// FolderID is the folder the feed is filed under, nil when unfiled.
+	FolderID *uuid.UUID
 	// InboundToken is the SHA-256 hash of the per-feed inbound email alias's
 	// token; nil for rss feeds. The plaintext token is never stored — it is
 	// returned to the caller once, at creation time.
@@ -47,8 +49,39 @@ type Feed struct {
 	// feed (error streak or quiet-feed detection); cleared on recovery so
 	// at most one notification is outstanding at a time (issue #799).
 	NotifiedAt *time.Time
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	// WebSubHub/WebSubTopic are the WebSub hub and topic the feed last asked
+	// to be pushed updates for, nil when its feed advertises no hub.
+	// WebSubSecret is the HMAC key the hub signs pushes with.
+	WebSubHub    *string
+	WebSubTopic  *string
+	WebSubSecret *string
+	// WebSubRequestedAt marks a subscribe request still awaiting the hub's
+	// verification; WebSubLeaseExpiresAt is when the verified subscription
+	// lapses, nil until one is verified.
+	WebSubRequestedAt    *time.Time
+	WebSubLeaseExpiresAt *time.Time
+	// UnreadCount is the feed's unread, non-dismissed items. Only set by
+	// FeedService.ListWithFolders.
+	UnreadCount int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Folder groups a user's feeds (feeds.folders). Folders nest one level: a
+// subfolder's parent is always a top-level folder.
+type Folder struct {
+	ID     uuid.UUID
+	UserID string
+	// ParentID is nil for a top-level folder.
+	ParentID *uuid.UUID
+	Name     string
+	// Position orders the folder among its siblings.
+	Position int
+	// UnreadCount totals the unread items of the folder's feeds, including
+	// its subfolders'. Only set by FeedService.ListWithFolders.
+	UnreadCount int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 // Item is one ingested feed entry (feeds.items). A feed and its items only
@@ -60,6 +93,9 @@ type Item struct {
 	GUID      string
 	Title     string
 	SourceURL string
+	// Author is the byline the feed reports (an email's sender), empty when
+	// it has none.
+	Author string
 	// ContentHTML is the extracted article body. It is only populated by the
 	// single-item read (GetByIDForUser) — list and update queries leave it
 	// empty and set HasContent instead, so a page of items never drags every
@@ -84,7 +120,54 @@ type Item struct {
 	// (e.g. its linked page could not be fetched/extracted); the item is
 	// still tracked (guid marked seen) so it is never retried automatically.
 	IngestError *string
-	CreatedAt   time.Time
+	// LibraryBookID is the books-library entry the item was sent to as an
+	// article, nil if it never was.
+	LibraryBookID *uuid.UUID
+	// Tags are the labels filter rules gave the item at ingest.
+	Tags      []string
+	CreatedAt time.Time
+}
+
+// FilterField*/FilterMatch*/FilterAction* are the FilterRule.Field,
+// MatchType and Action values.
+const (
+	FilterFieldTitle   = "title"
+	FilterFieldAuthor  = "author"
+	FilterFieldURL     = "url"
+	FilterFieldContent = "content"
+
+	FilterMatchKeyword = "keyword"
+	FilterMatchRegex   = "regex"
+
+	FilterActionDismiss  = "dismiss"
+	FilterActionBookmark = "bookmark"
+	FilterActionMarkRead = "mark_read"
+	FilterActionTag      = "tag"
+)
+
+// FilterRule acts on each new item whose Field matches Pattern
+// (feeds.filter_rules). Rules only run at ingest; items already stored are
+// never re-filtered.
+type FilterRule struct {
+	ID     uuid.UUID
+	UserID string
+	// FeedID limits the rule to one feed; nil applies it to all of the
+	// user's feeds.
+	FeedID *uuid.UUID
+	// Field is one of the FilterField* values. Content is matched against
+	// the article's text, not its HTML.
+	Field string
+	// MatchType is FilterMatchKeyword (case-insensitive substring) or
+	// FilterMatchRegex (Go RE2 syntax, as written).
+	MatchType string
+	Pattern   string
+	// Action is one of the FilterAction* values.
+	Action string
+	// Tag is the label FilterActionTag adds; empty for other actions.
+	Tag       string
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 // FeedStats aggregates one feed's posting cadence and read/completion
@@ -108,3 +191,107 @@ type DayCount struct {
 	Day   time.Time
 	Count int
 }
+
+// DigestItem is an Item offered for the books app's daily feeds digest,
+// with the owner and feed title the digest groups it by. Item.ContentHTML
+// is left empty, like every multi-row read (issue #1027).
+type DigestItem struct {
+	Item      Item
+	UserID    string
+	FeedTitle string
+}
+
+// LibraryArticle is an item as it is sent to the books library: its stored
+// article body, with the title, link and feed the entry is catalogued by.
+type LibraryArticle struct {
+	ItemID      uuid.UUID
+	FeedTitle   string
+	Title       string
+	SourceURL   string
+	ContentHTML string
+	PublishedAt time.Time
+}
+
+// OPMLImportStatus values: what ImportOPML did with one outline.
+const (
+	OPMLImportCreated     = "created"
+	OPMLImportDuplicate   = "duplicate"
+	OPMLImportInvalid     = "invalid"
+	OPMLImportUnreachable = "unreachable"
+	// OPMLImportDeferred marks a new feed past the maxOPMLFeeds of one
+	// import; importing the file again picks it up.
+	OPMLImportDeferred = "deferred"
+)
+
+// OPMLImportResult is the outcome of importing one OPML feed outline.
+type OPMLImportResult struct {
+	URL   string
+	Title string
+	// Folder is the outline path the feed was filed under, nested outlines
+	// joined with " / "; empty outside any folder.
+	Folder string
+	// Status is one of the OPMLImport* values.
+	Status string
+	// Error says why an invalid, unreachable or deferred outline was
+	// skipped.
+	Error string
+	// Feed is the new subscription, nil unless Status is OPMLImportCreated.
+	Feed *Feed
+}
+
+// AppPassword is a set of credentials a native reader app logs in to the
+// Google Reader API with (feeds.app_passwords). Only a hash of the
+// password is stored.
+type AppPassword struct {
+	ID         uuid.UUID
+	UserID     string
+	Username   string
+	CreatedAt  time.Time
+	LastUsedAt *time.Time // nil if the app has never logged in
+}
+
+// ReaderStream* are the Google Reader stream kinds ReaderQuery.Stream
+// selects from.
+const (
+	// ReaderStreamAll is every item (user/-/state/com.google/reading-list).
+	ReaderStreamAll = "all"
+	// ReaderStreamStarred is bookmarked items (…/com.google/starred).
+	ReaderStreamStarred = "starred"
+	// ReaderStreamRead is read items (…/com.google/read).
+	ReaderStreamRead = "read"
+	// ReaderStreamFeed is one feed's items (feed/<id>).
+	ReaderStreamFeed = "feed"
+	// ReaderStreamFolder is a folder's items, subfolders included
+	// (user/-/label/<name>).
+	ReaderStreamFolder = "folder"
+)
+
+// ReaderQuery selects a page of a Google Reader stream. Items are ordered
+// by ReaderID, which follows ingest order — the protocol's "crawl time".
+type ReaderQuery struct {
+	// Stream is one of the ReaderStream* values; FeedID or FolderID is set
+	// for ReaderStreamFeed and ReaderStreamFolder.
+	Stream   string
+	FeedID   *uuid.UUID
+	FolderID *uuid.UUID
+	// ExcludeRead leaves out read items (xt=…/com.google/read).
+	ExcludeRead bool
+	// Since/Until bound the items' ingest time (ot/nt); zero means no bound.
+	Since time.Time
+	Until time.Time
+	// OldestFirst reverses the default newest-first order (r=o).
+	OldestFirst bool
+	Limit       int
+	Offset      int
+}
+
+// ReaderItem is an Item as the Google Reader API presents it: with its
+// 64-bit id and the feed it came from.
+type ReaderItem struct {
+	Item      Item
+	ReaderID  int64
+	FeedTitle string
+	FeedURL   string
+	// FolderID is the feed's folder, nil when unfiled.
+	FolderID *uuid.UUID
+}
@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+	"tools.xdoubleu.com/internal/database"
+	"tools.xdoubleu.com/internal/database/postgres"
+)
+
+// AppPasswordsRepository stores the credentials native reader apps log in
+// to the Google Reader API with (feeds.app_passwords).
+type AppPasswordsRepository struct {
+	db postgres.DB
+}
+
+const appPasswordColumns = `id, user_id, username, created_at, last_used_at`
+
+func scanAppPassword(row pgx.Row) (*models.AppPassword, error) {
+	var p models.AppPassword
+	err := row.Scan(&p.ID, &p.UserID, &p.Username, &p.CreatedAt, &p.LastUsedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Insert stores a new app password by the hash of the password.
+func (repo *AppPasswordsRepository) Insert(
+	ctx context.Context,
+	userID, username, passwordHash string,
+) (*models.AppPassword, error) {
+	query := `
+		INSERT INTO feeds.app_passwords (user_id, username, password_hash)
+		VALUES ($1, $2, $3)
+		RETURNING ` + appPasswordColumns
+	p, err := scanAppPassword(
+		repo.db.QueryRow(ctx, query, userID, username, passwordHash),
+	)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return p, nil
+}
+
+// List returns the user's app passwords, oldest first.
+func (repo *AppPasswordsRepository) List(
+	ctx context.Context,
+	userID string,
+) ([]models.AppPassword, error) {
+	rows, err := repo.db.Query(ctx, `
+		SELECT `+appPasswordColumns+`
+		FROM feeds.app_passwords
+		WHERE user_id = $1
+		ORDER BY created_at, id
+	`, userID)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var out []models.AppPassword
+	for rows.Next() {
+		p, scanErr := scanAppPassword(rows)
+		if scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		out = append(out, *p)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return out, nil
+}
+
+// Delete revokes an app password, scoped to the owning user.
+func (repo *AppPasswordsRepository) Delete(
+	ctx context.Context,
+	userID string,
+	id uuid.UUID,
+) error {
+	query := `DELETE FROM feeds.app_passwords WHERE user_id = $1 AND id = $2`
+	tag, err := repo.db.Exec(ctx, query, userID, id)
+	if err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return database.ErrResourceNotFound
+	}
+	return nil
+}
+
+// GetByPasswordHash looks up an app password by the hash of the password
+// and records the current time as last_used_at in one statement. Returns
+// database.ErrResourceNotFound when the hash is unknown.
+func (repo *AppPasswordsRepository) GetByPasswordHash(
+	ctx context.Context,
+	passwordHash string,
+) (*models.AppPassword, error) {
+	query := `
+		UPDATE feeds.app_passwords
+		SET last_used_at = now()
+		WHERE password_hash = $1
+		RETURNING ` + appPasswordColumns
+	p, err := scanAppPassword(repo.db.QueryRow(ctx, query, passwordHash))
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return p, nil
+}
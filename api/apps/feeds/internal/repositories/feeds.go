@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -17,9 +18,11 @@ type FeedsRepository struct {
 	db postgres.DB
 }
 
-const feedColumns = `id, user_id, url, title, source_type,
+const feedColumns = `id, user_id, url, title, source_type, folder_id,
 	inbound_token, etag, last_modified, last_fetched_at, last_error,
-	consecutive_failures, notified_at, created_at, updated_at`
+	consecutive_failures, notified_at, websub_hub, websub_topic,
+	websub_secret, websub_requested_at, websub_lease_expires_at, created_at,
+	updated_at`
 
 func scanFeed(row pgx.Row) (*models.Feed, error) {
 	var f models.Feed
@@ -30,6 +33,7 @@ func scanFeed(row pgx.Row) (*models.Feed, error) {
 		&url,
 		&f.Title,
 		&f.SourceType,
+		&f.FolderID,
 		&f.InboundToken,
 		&f.ETag,
 		&f.LastModified,
@@ -37,6 +41,11 @@ func scanFeed(row pgx.Row) (*models.Feed, error) {
 		&f.LastError,
 		&f.ConsecutiveFailures,
 		&f.NotifiedAt,
+		&f.WebSubHub,
+		&f.WebSubTopic,
+		&f.WebSubSecret,
+		&f.WebSubRequestedAt,
+		&f.WebSubLeaseExpiresAt,
 		&f.CreatedAt,
 		&f.UpdatedAt,
 	)
@@ -151,13 +160,13 @@ func (repo *FeedsRepository) Insert(
 
 	query := `
 		INSERT INTO feeds.feeds
-			(user_id, url, title, source_type, inbound_token)
-		VALUES ($1, $2, $3, $4, $5)
+			(user_id, url, title, source_type, folder_id, inbound_token)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING ` + feedColumns
 
 	f, err := scanFeed(repo.db.QueryRow(
 		ctx, query,
-		feed.UserID, url, feed.Title, sourceType, feed.InboundToken,
+		feed.UserID, url, feed.Title, sourceType, feed.FolderID, feed.InboundToken,
 	))
 	if err != nil {
 		return nil, postgres.PgxErrorToHTTPError(err)
@@ -207,6 +216,28 @@ func (repo *FeedsRepository) Update(
 	return nil
 }
 
+// SetFolder files the feed under folderID, or unfiles it when nil.
+func (repo *FeedsRepository) SetFolder(
+	ctx context.Context,
+	userID string,
+	id uuid.UUID,
+	folderID *uuid.UUID,
+) error {
+	query := `
+		UPDATE feeds.feeds
+		SET folder_id = $3
+		WHERE user_id = $1 AND id = $2
+	`
+	tag, err := repo.db.Exec(ctx, query, userID, id, folderID)
+	if err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return database.ErrResourceNotFound
+	}
+	return nil
+}
+
 // Delete removes the feed; its items cascade (feeds.items.feed_id ON DELETE
 // CASCADE) — unlike reading's former FeedsRepository, there is no library
 // linkage to preserve, so deleting a feed always deletes every item it
@@ -277,3 +308,90 @@ func (repo *FeedsRepository) ClearNotified(ctx context.Context, id uuid.UUID) er
 	)
 	return postgres.PgxErrorToHTTPError(err)
 }
+
+// GetByIDForWebSub returns the feed with a WebSub subscription for the
+// unauthenticated hub callback, which has no user_id to scope by — the hub
+// proves itself with the topic or the push signature instead.
+// Returns database.ErrResourceNotFound when no such feed exists.
+func (repo *FeedsRepository) GetByIDForWebSub(
+	ctx context.Context,
+	id uuid.UUID,
+) (*models.Feed, error) {
+	query := `
+		SELECT ` + feedColumns + `
+		FROM feeds.feeds
+		WHERE id = $1 AND websub_hub IS NOT NULL
+	`
+	f, err := scanFeed(repo.db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return f, nil
+}
+
+// SetWebSubRequested records a subscribe request sent to hub for topic,
+// pending the hub's verification. Moving to a different hub or topic drops
+// the old subscription's lease, since it no longer covers the feed.
+func (repo *FeedsRepository) SetWebSubRequested(
+	ctx context.Context,
+	id uuid.UUID,
+	hub, topic, secret string,
+) error {
+	_, err := repo.db.Exec(ctx, `
+		UPDATE feeds.feeds
+		SET websub_lease_expires_at = CASE
+		        WHEN websub_hub = $2 AND websub_topic = $3
+		        THEN websub_lease_expires_at
+		    END,
+		    websub_hub = $2,
+		    websub_topic = $3,
+		    websub_secret = $4,
+		    websub_requested_at = now()
+		WHERE id = $1
+	`, id, hub, topic, secret)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// SetWebSubVerified records the hub's verification of the pending
+// subscription and when its lease expires.
+func (repo *FeedsRepository) SetWebSubVerified(
+	ctx context.Context,
+	id uuid.UUID,
+	expiresAt time.Time,
+) error {
+	_, err := repo.db.Exec(ctx, `
+		UPDATE feeds.feeds
+		SET websub_lease_expires_at = $2,
+		    websub_requested_at = NULL
+		WHERE id = $1
+	`, id, expiresAt)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// SetWebSubDenied records that the hub refused or ended the subscription.
+// websub_requested_at restarts so the next attempt waits out the retry
+// interval instead of following on the next poll.
+func (repo *FeedsRepository) SetWebSubDenied(ctx context.Context, id uuid.UUID) error {
+	_, err := repo.db.Exec(ctx, `
+		UPDATE feeds.feeds
+		SET websub_lease_expires_at = NULL,
+		    websub_requested_at = now()
+		WHERE id = $1
+	`, id)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// ClearWebSub forgets the feed's WebSub subscription once its feed stops
+// advertising a hub, returning it to hourly polling.
+func (repo *FeedsRepository) ClearWebSub(ctx context.Context, id uuid.UUID) error {
+	_, err := repo.db.Exec(ctx, `
+		UPDATE feeds.feeds
+		SET websub_hub = NULL,
+		    websub_topic = NULL,
+		    websub_secret = NULL,
+		    websub_requested_at = NULL,
+		    websub_lease_expires_at = NULL
+		WHERE id = $1
+	`, id)
+	return postgres.PgxErrorToHTTPError(err)
+}
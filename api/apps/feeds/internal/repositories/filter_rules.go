@@ -0,0 +1,157 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+	"tools.xdoubleu.com/internal/database"
+	"tools.xdoubleu.com/internal/database/postgres"
+)
+
+// FilterRulesRepository stores the rules applied to items at ingest
+// (feeds.filter_rules).
+type FilterRulesRepository struct {
+	db postgres.DB
+}
+
+const filterRuleColumns = `id, user_id, feed_id, field, match_type, pattern,
+	action, tag, enabled, created_at, updated_at`
+
+func scanFilterRule(row pgx.Row) (*models.FilterRule, error) {
+	var r models.FilterRule
+	err := row.Scan(
+		&r.ID,
+		&r.UserID,
+		&r.FeedID,
+		&r.Field,
+		&r.MatchType,
+		&r.Pattern,
+		&r.Action,
+		&r.Tag,
+		&r.Enabled,
+		&r.CreatedAt,
+		&r.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (repo *FilterRulesRepository) list(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]models.FilterRule, error) {
+	rows, err := repo.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var out []models.FilterRule
+	for rows.Next() {
+		r, scanErr := scanFilterRule(rows)
+		if scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		out = append(out, *r)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return out, nil
+}
+
+// List returns all of the user's rules, oldest first.
+func (repo *FilterRulesRepository) List(
+	ctx context.Context,
+	userID string,
+) ([]models.FilterRule, error) {
+	return repo.list(ctx, `
+		SELECT `+filterRuleColumns+`
+		FROM feeds.filter_rules
+		WHERE user_id = $1
+		ORDER BY created_at, id
+	`, userID)
+}
+
+// ListForFeed returns the user's enabled rules that apply to feedID — its
+// own and those for all feeds — oldest first, the order they are applied in.
+func (repo *FilterRulesRepository) ListForFeed(
+	ctx context.Context,
+	userID string,
+	feedID uuid.UUID,
+) ([]models.FilterRule, error) {
+	return repo.list(ctx, `
+		SELECT `+filterRuleColumns+`
+		FROM feeds.filter_rules
+		WHERE user_id = $1 AND enabled
+		  AND (feed_id IS NULL OR feed_id = $2)
+		ORDER BY created_at, id
+	`, userID, feedID)
+}
+
+// Insert stores a new rule for rule.UserID.
+func (repo *FilterRulesRepository) Insert(
+	ctx context.Context,
+	rule models.FilterRule,
+) (*models.FilterRule, error) {
+	query := `
+		INSERT INTO feeds.filter_rules
+			(user_id, feed_id, field, match_type, pattern, action, tag, enabled)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ` + filterRuleColumns
+	r, err := scanFilterRule(repo.db.QueryRow(
+		ctx, query,
+		rule.UserID, rule.FeedID, rule.Field, rule.MatchType, rule.Pattern,
+		rule.Action, rule.Tag, rule.Enabled,
+	))
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return r, nil
+}
+
+// Update replaces every editable field of the rule, scoped to
+// rule.UserID. Returns database.ErrResourceNotFound when no rule matches.
+func (repo *FilterRulesRepository) Update(
+	ctx context.Context,
+	rule models.FilterRule,
+) (*models.FilterRule, error) {
+	query := `
+		UPDATE feeds.filter_rules
+		SET feed_id = $3, field = $4, match_type = $5, pattern = $6,
+		    action = $7, tag = $8, enabled = $9
+		WHERE user_id = $1 AND id = $2
+		RETURNING ` + filterRuleColumns
+	r, err := scanFilterRule(repo.db.QueryRow(
+		ctx, query,
+		rule.UserID, rule.ID, rule.FeedID, rule.Field, rule.MatchType,
+		rule.Pattern, rule.Action, rule.Tag, rule.Enabled,
+	))
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return r, nil
+}
+
+// Delete removes the rule. Items it already acted on keep their state.
+func (repo *FilterRulesRepository) Delete(
+	ctx context.Context,
+	userID string,
+	id uuid.UUID,
+) error {
+	query := `DELETE FROM feeds.filter_rules WHERE user_id = $1 AND id = $2`
+	tag, err := repo.db.Exec(ctx, query, userID, id)
+	if err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return database.ErrResourceNotFound
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+	"tools.xdoubleu.com/internal/database"
+	"tools.xdoubleu.com/internal/database/postgres"
+)
+
+// FoldersRepository stores the folders users file their feeds under
+// (feeds.folders).
+type FoldersRepository struct {
+	db postgres.DB
+}
+
+const folderColumns = `id, user_id, parent_id, name, position, created_at, updated_at`
+
+func scanFolder(row pgx.Row) (*models.Folder, error) {
+	var f models.Folder
+	err := row.Scan(
+		&f.ID,
+		&f.UserID,
+		&f.ParentID,
+		&f.Name,
+		&f.Position,
+		&f.CreatedAt,
+		&f.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// List returns the user's folders, top-level folders and subfolders alike,
+// each ordered by position among its siblings.
+func (repo *FoldersRepository) List(
+	ctx context.Context,
+	userID string,
+) ([]models.Folder, error) {
+	query := `
+		SELECT ` + folderColumns + `
+		FROM feeds.folders
+		WHERE user_id = $1
+		ORDER BY position, name
+	`
+	rows, err := repo.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var out []models.Folder
+	for rows.Next() {
+		f, scanErr := scanFolder(rows)
+		if scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		out = append(out, *f)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return out, nil
+}
+
+// GetByID returns a single folder scoped to its owner.
+// Returns database.ErrResourceNotFound when no folder matches.
+func (repo *FoldersRepository) GetByID(
+	ctx context.Context,
+	userID string,
+	id uuid.UUID,
+) (*models.Folder, error) {
+	query := `
+		SELECT ` + folderColumns + `
+		FROM feeds.folders
+		WHERE user_id = $1 AND id = $2
+	`
+	f, err := scanFolder(repo.db.QueryRow(ctx, query, userID, id))
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return f, nil
+}
+
+// Insert creates a folder after its existing siblings. A sibling with the
+// same name maps to database.ErrResourceConflict via the unique constraint.
+func (repo *FoldersRepository) Insert(
+	ctx context.Context,
+	userID string,
+	parentID *uuid.UUID,
+	name string,
+) (*models.Folder, error) {
+	query := `
+		INSERT INTO feeds.folders (user_id, parent_id, name, position)
+		SELECT $1, $2, $3, COALESCE(max(position) + 1, 0)
+		FROM feeds.folders
+		WHERE user_id = $1 AND parent_id IS NOT DISTINCT FROM $2
+		RETURNING ` + folderColumns
+	f, err := scanFolder(repo.db.QueryRow(ctx, query, userID, parentID, name))
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return f, nil
+}
+
+// GetOrCreate returns the id of the user's folder named name under
+// parentID, creating it after its siblings if there is none yet.
+func (repo *FoldersRepository) GetOrCreate(
+	ctx context.Context,
+	userID string,
+	parentID *uuid.UUID,
+	name string,
+) (uuid.UUID, error) {
+	query := `
+		INSERT INTO feeds.folders (user_id, parent_id, name, position)
+		SELECT $1, $2, $3, COALESCE(max(position) + 1, 0)
+		FROM feeds.folders
+		WHERE user_id = $1 AND parent_id IS NOT DISTINCT FROM $2
+		ON CONFLICT (user_id, parent_id, name)
+		DO UPDATE SET name = EXCLUDED.name
+		RETURNING id
+	`
+	var id uuid.UUID
+	err := repo.db.QueryRow(ctx, query, userID, parentID, name).Scan(&id)
+	if err != nil {
+		return uuid.Nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return id, nil
+}
+
+// Rename changes a folder's name. A sibling with the same name maps to
+// database.ErrResourceConflict.
+func (repo *FoldersRepository) Rename(
+	ctx context.Context,
+	userID string,
+	id uuid.UUID,
+	name string,
+) error {
+	query := `
+		UPDATE feeds.folders
+		SET name = $3
+		WHERE user_id = $1 AND id = $2
+	`
+	tag, err := repo.db.Exec(ctx, query, userID, id, name)
+	if err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return database.ErrResourceNotFound
+	}
+	return nil
+}
+
+// Delete removes the folder. Its subfolders cascade
+// (feeds.folders.parent_id ON DELETE CASCADE) and the feeds filed under
+// either become unfiled (feeds.feeds.folder_id ON DELETE SET NULL).
+func (repo *FoldersRepository) Delete(
+	ctx context.Context,
+	userID string,
+	id uuid.UUID,
+) error {
+	query := `DELETE FROM feeds.folders WHERE user_id = $1 AND id = $2`
+	tag, err := repo.db.Exec(ctx, query, userID, id)
+	if err != nil {
+		return postgres.PgxErrorToHTTPError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return database.ErrResourceNotFound
+	}
+	return nil
+}
+
+// Reorder sets position = 0,1,2,... for each folder ID in the given order.
+// Only updates folders owned by userID.
+func (repo *FoldersRepository) Reorder(
+	ctx context.Context,
+	userID string,
+	ids []uuid.UUID,
+) error {
+	for i, id := range ids {
+		_, err := repo.db.Exec(ctx, `
+			UPDATE feeds.folders SET position = $1
+			WHERE id = $2 AND user_id = $3`,
+			i, id, userID,
+		)
+		if err != nil {
+			return postgres.PgxErrorToHTTPError(err)
+		}
+	}
+	return nil
+}
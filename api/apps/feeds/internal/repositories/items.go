@@ -24,8 +24,9 @@ type ItemsRepository struct {
 // schema, so anything returning more than one row must use itemListColumns
 // instead (issue #1027).
 const itemColumns = `i.id, i.feed_id, i.guid, i.title, i.source_url,
-	i.content_html, i.published_at, i.read_at, i.dismissed, i.bookmarked,
-	i.read_progress_pct, i.ingest_error, i.created_at`
+	i.author, i.content_html, i.published_at, i.read_at, i.dismissed,
+	i.bookmarked, i.read_progress_pct, i.ingest_error, i.library_book_id,
+	i.tags, i.created_at`
 
 // itemListColumns is itemColumns with the article body replaced by a
 // boolean saying whether there is one, mirroring books' bookColumns
@@ -34,8 +35,9 @@ const itemColumns = `i.id, i.feed_id, i.guid, i.title, i.source_url,
 // extracted HTML out of Postgres on each request otherwise, which is what
 // exhausted the egress quota in issue #1027.
 const itemListColumns = `i.id, i.feed_id, i.guid, i.title, i.source_url,
-	i.content_html <> '', i.published_at, i.read_at, i.dismissed, i.bookmarked,
-	i.read_progress_pct, i.ingest_error, i.created_at`
+	i.author, i.content_html <> '', i.published_at, i.read_at, i.dismissed,
+	i.bookmarked, i.read_progress_pct, i.ingest_error, i.library_book_id,
+	i.tags, i.created_at`
 
 // scanItem scans a row selected with itemColumns (body included), deriving
 // HasContent so callers see the same field set either way.
@@ -55,31 +57,40 @@ func scanListItem(row pgx.Row) (*models.Item, error) {
 }
 
 // scanItemInto holds the column order shared by both column lists, which
-// differ only in what the sixth column is.
+// differ only in what the seventh column is.
 func scanItemInto(
 	row pgx.Row,
 	contentTarget func(*models.Item) any,
 ) (*models.Item, error) {
 	var item models.Item
-	err := row.Scan(
+	if err := row.Scan(itemTargets(&item, contentTarget(&item))...); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// itemTargets lists the scan destinations for one item's columns, in
+// itemColumns order, with content as the body (or has-content) target.
+// Queries selecting extra columns after them append their own.
+func itemTargets(item *models.Item, content any) []any {
+	return []any{
 		&item.ID,
 		&item.FeedID,
 		&item.GUID,
 		&item.Title,
 		&item.SourceURL,
-		contentTarget(&item),
+		&item.Author,
+		content,
 		&item.PublishedAt,
 		&item.ReadAt,
 		&item.Dismissed,
 		&item.Bookmarked,
 		&item.ReadProgressPct,
 		&item.IngestError,
+		&item.LibraryBookID,
+		&item.Tags,
 		&item.CreatedAt,
-	)
-	if err != nil {
-		return nil, err
 	}
-	return &item, nil
 }
 
 // FilterNewGUIDs returns the subset of guids with no feeds.items row yet,
@@ -123,10 +134,13 @@ func (repo *ItemsRepository) FilterNewGUIDs(
 
 // Insert stores one ingested item (or a metadata-only/error row when ingest
 // failed — content_html/source_url/title may be empty and ingest_error set).
-// A duplicate (feed_id, guid) re-opens the existing item (clears read/
-// dismissed) instead of inserting — polling never retries a seen guid (it
-// pre-filters via FilterNewGUIDs), but resending the same email reuses the
-// same guid and is the intended way to bring a dismissed/read item back.
+// The item's Dismissed, Bookmarked and Tags are stored as filter rules left
+// them, and a non-nil ReadAt stores it as read now.
+// A duplicate (feed_id, guid) re-opens the existing item (resets read/
+// dismissed to what the rules decided, unread and visible when none matched)
+// instead of inserting — polling never retries a seen guid (it pre-filters
+// via FilterNewGUIDs), but resending the same email reuses the same guid and
+// is the intended way to bring a dismissed/read item back.
 func (repo *ItemsRepository) Insert(
 	ctx context.Context,
 	item models.Item,
@@ -136,19 +150,30 @@ func (repo *ItemsRepository) Insert(
 		publishedAt = &item.PublishedAt
 	}
 
+	tags := item.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
 	query := `
 		INSERT INTO feeds.items
-			(feed_id, guid, title, source_url, content_html, published_at,
-			 ingest_error)
-		VALUES ($1, $2, $3, $4, $5, COALESCE($6, now()), $7)
+			(feed_id, guid, title, source_url, author, content_html,
+			 published_at, ingest_error, read_at, dismissed, bookmarked, tags)
+		VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, now()), $8,
+			CASE WHEN $9::bool THEN now() END, $10, $11, $12)
 		ON CONFLICT (feed_id, guid) DO UPDATE SET
-			read_at = NULL,
-			dismissed = false
+			read_at = EXCLUDED.read_at,
+			dismissed = EXCLUDED.dismissed,
+			bookmarked = feeds.items.bookmarked OR EXCLUDED.bookmarked,
+			tags = ARRAY(
+			    SELECT DISTINCT unnest(feeds.items.tags || EXCLUDED.tags)
+			)
 	`
 	_, err := repo.db.Exec(
 		ctx, query,
-		item.FeedID, item.GUID, item.Title, item.SourceURL, item.ContentHTML,
-		publishedAt, item.IngestError,
+		item.FeedID, item.GUID, item.Title, item.SourceURL, item.Author,
+		item.ContentHTML, publishedAt, item.IngestError, item.ReadAt != nil,
+		item.Dismissed, item.Bookmarked, tags,
 	)
 	return postgres.PgxErrorToHTTPError(err)
 }
@@ -220,14 +245,15 @@ func (repo *ItemsRepository) GetByIDForUser(
 // pagination.Clamp). Error/skip dedup markers (ingest_error set, no title or
 // content) are excluded — they exist only so polling doesn't retry a guid,
 // not for display. unreadOnly, when true, excludes items with a set read_at.
-// feedID, when non-nil, restricts results to that one feed. bookmarkedOnly,
-// when true, excludes items without bookmarked set.
+// feedID, when non-nil, restricts results to that one feed and folderID to
+// the feeds filed under that folder or its subfolders. bookmarkedOnly, when
+// true, excludes items without bookmarked set.
 func (repo *ItemsRepository) ListByUser(
 	ctx context.Context,
 	userID string,
 	limit, offset int32,
 	unreadOnly bool,
-	feedID *uuid.UUID,
+	feedID, folderID *uuid.UUID,
 	bookmarkedOnly bool,
 ) ([]models.Item, bool, error) {
 	safeLimit, sqlLimit := pagination.Clamp(limit)
@@ -240,11 +266,15 @@ func (repo *ItemsRepository) ListByUser(
 		  AND ($4::bool = false OR i.read_at IS NULL)
 		  AND ($5::uuid IS NULL OR i.feed_id = $5)
 		  AND ($6::bool = false OR i.bookmarked = true)
+		  AND ($7::uuid IS NULL OR f.folder_id = $7 OR f.folder_id IN (
+		      SELECT id FROM feeds.folders WHERE parent_id = $7
+		  ))
 		ORDER BY i.published_at DESC, i.created_at DESC
 		LIMIT $2 OFFSET $3
 	`
 	rows, err := repo.db.Query(
-		ctx, query, userID, sqlLimit, offset, unreadOnly, feedID, bookmarkedOnly,
+		ctx, query,
+		userID, sqlLimit, offset, unreadOnly, feedID, bookmarkedOnly, folderID,
 	)
 	if err != nil {
 		return nil, false, postgres.PgxErrorToHTTPError(err)
@@ -267,6 +297,119 @@ func (repo *ItemsRepository) ListByUser(
 	return page, hasMore, nil
 }
 
+// ListDigestCandidates returns, across every user, the successfully
+// ingested items created since since that are unread and not dismissed, or
+// bookmarked — what the books app's daily feeds digest picks from. Ordered
+// by owner, then oldest first. Bodies are left out (issue #1027); the
+// digest fetches the ones it uses through ContentByIDs.
+func (repo *ItemsRepository) ListDigestCandidates(
+	ctx context.Context,
+	since time.Time,
+) ([]models.DigestItem, error) {
+	query := `
+		SELECT ` + itemListColumns + `, f.user_id,
+		    COALESCE(NULLIF(f.title, ''), f.url, '')
+		FROM feeds.items i
+		JOIN feeds.feeds f ON f.id = i.feed_id
+		WHERE i.ingest_error IS NULL AND i.content_html <> ''
+		  AND i.created_at >= $1
+		  AND ((i.read_at IS NULL AND i.dismissed = false) OR i.bookmarked)
+		ORDER BY f.user_id, i.published_at, i.created_at
+	`
+	rows, err := repo.db.Query(ctx, query, since)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var out []models.DigestItem
+	for rows.Next() {
+		var d models.DigestItem
+		i := &d.Item
+		targets := append(itemTargets(i, &i.HasContent), &d.UserID, &d.FeedTitle)
+		if scanErr := rows.Scan(targets...); scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		out = append(out, d)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return out, nil
+}
+
+// ContentByIDs returns the article bodies of userID's items among itemIDs,
+// keyed by item id. Unknown ids and other users' items are left out.
+func (repo *ItemsRepository) ContentByIDs(
+	ctx context.Context,
+	userID string,
+	itemIDs []uuid.UUID,
+) (map[uuid.UUID]string, error) {
+	query := `
+		SELECT i.id, i.content_html
+		FROM feeds.items i
+		JOIN feeds.feeds f ON f.id = i.feed_id
+		WHERE f.user_id = $1 AND i.id = ANY($2)
+	`
+	rows, err := repo.db.Query(ctx, query, userID, itemIDs)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	out := make(map[uuid.UUID]string, len(itemIDs))
+	for rows.Next() {
+		var id uuid.UUID
+		var content string
+		if scanErr := rows.Scan(&id, &content); scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		out[id] = content
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return out, nil
+}
+
+// MarkRead sets read_at on userID's items among itemIDs that are still
+// unread; items already read keep the time they were first read.
+func (repo *ItemsRepository) MarkRead(
+	ctx context.Context,
+	userID string,
+	itemIDs []uuid.UUID,
+) error {
+	_, err := repo.db.Exec(ctx, `
+		UPDATE feeds.items i
+		SET read_at = now()
+		FROM feeds.feeds f
+		WHERE i.feed_id = f.id AND f.user_id = $1 AND i.id = ANY($2)
+		  AND i.read_at IS NULL
+	`, userID, itemIDs)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// SetLibraryBookID records that userID's item was sent to the books library
+// as bookID. Returns database.ErrResourceNotFound when no item matches
+// (unknown id or owned by another user).
+func (repo *ItemsRepository) SetLibraryBookID(
+	ctx context.Context,
+	userID string,
+	itemID, bookID uuid.UUID,
+) (*models.Item, error) {
+	query := `
+		UPDATE feeds.items i
+		SET library_book_id = $3
+		FROM feeds.feeds f
+		WHERE i.feed_id = f.id AND f.user_id = $1 AND i.id = $2
+		RETURNING ` + itemListColumns
+	item, err := scanListItem(repo.db.QueryRow(ctx, query, userID, itemID, bookID))
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return item, nil
+}
+
 // CountUnread returns the number of non-dismissed, successfully ingested,
 // unread items across any of userID's feeds — the reading dashboard's feeds
 // widget shows this alongside a few recent items from ListByUser.
@@ -288,6 +431,85 @@ func (repo *ItemsRepository) CountUnread(
 	return count, nil
 }
 
+// UnreadCountsByFeed returns CountUnread per feed for userID's feeds; feeds
+// with nothing unread are left out.
+func (repo *ItemsRepository) UnreadCountsByFeed(
+	ctx context.Context,
+	userID string,
+) (map[uuid.UUID]int, error) {
+	rows, err := repo.db.Query(ctx, `
+		SELECT i.feed_id, count(*)
+		FROM feeds.items i
+		JOIN feeds.feeds f ON f.id = i.feed_id
+		WHERE f.user_id = $1 AND i.ingest_error IS NULL AND i.dismissed = false
+		  AND i.read_at IS NULL
+		GROUP BY i.feed_id
+	`, userID)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	out := map[uuid.UUID]int{}
+	for rows.Next() {
+		var feedID uuid.UUID
+		var count int
+		if scanErr := rows.Scan(&feedID, &count); scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		out[feedID] = count
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return out, nil
+}
+
+// RecentForUser returns userID's latest successfully ingested items, newest
+// first, limited to feedID when non-nil — what a filter rule is previewed
+// against. Dismissed and read items are included: the preview shows what
+// the rule would have done to each, whatever happened to it since. Bodies
+// are only read when withContent is set, for content rules (issue #1027).
+func (repo *ItemsRepository) RecentForUser(
+	ctx context.Context,
+	userID string,
+	feedID *uuid.UUID,
+	limit int,
+	withContent bool,
+) ([]models.Item, error) {
+	columns, scan := itemListColumns, scanListItem
+	if withContent {
+		columns, scan = itemColumns, scanItem
+	}
+	query := `
+		SELECT ` + columns + `
+		FROM feeds.items i
+		JOIN feeds.feeds f ON f.id = i.feed_id
+		WHERE f.user_id = $1 AND i.ingest_error IS NULL
+		  AND ($2::uuid IS NULL OR i.feed_id = $2)
+		ORDER BY i.published_at DESC, i.created_at DESC
+		LIMIT $3
+	`
+	rows, err := repo.db.Query(ctx, query, userID, feedID, limit)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var out []models.Item
+	for rows.Next() {
+		item, scanErr := scan(rows)
+		if scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		out = append(out, *item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return out, nil
+}
+
 // RecentPublishedAt returns the publish timestamps of the feed's most recent
 // successfully-ingested items, newest first, for the quiet-feed cadence
 // check (issue #799).
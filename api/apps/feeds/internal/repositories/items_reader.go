@@ -0,0 +1,174 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+	"tools.xdoubleu.com/internal/database/postgres"
+)
+
+// readerItemColumns follows the item columns of the Google Reader queries
+// with what ReaderItem adds to an item.
+const readerItemColumns = `, i.reader_id,
+	COALESCE(NULLIF(f.title, ''), f.url, ''), f.url, f.folder_id`
+
+// readerStreamFilter selects a ReaderQuery's items from feeds.items i
+// joined to feeds.feeds f, with args in readerStreamArgs order. Like
+// ListByUser it skips dismissed items and ingest-error markers.
+const readerStreamFilter = `
+	f.user_id = $1 AND i.ingest_error IS NULL AND i.dismissed = false
+	  AND ($2::text <> 'starred' OR i.bookmarked)
+	  AND ($2::text <> 'read' OR i.read_at IS NOT NULL)
+	  AND ($3::uuid IS NULL OR i.feed_id = $3)
+	  AND ($4::uuid IS NULL OR f.folder_id = $4 OR f.folder_id IN (
+	      SELECT id FROM feeds.folders WHERE parent_id = $4
+	  ))
+	  AND ($5::bool = false OR i.read_at IS NULL)
+	  AND ($6::timestamptz IS NULL OR i.created_at >= $6)
+	  AND ($7::timestamptz IS NULL OR i.created_at < $7)`
+
+func readerStreamArgs(userID string, q models.ReaderQuery) []any {
+	optionalTime := func(t time.Time) *time.Time {
+		if t.IsZero() {
+			return nil
+		}
+		return &t
+	}
+	return []any{
+		userID, q.Stream, q.FeedID, q.FolderID, q.ExcludeRead,
+		optionalTime(q.Since), optionalTime(q.Until),
+	}
+}
+
+// ListReaderStream returns a page of the Google Reader stream q selects
+// from userID's items, and whether there are more. Bodies are only read
+// when withContent is set: an id listing has no use for them (issue
+// #1027).
+func (repo *ItemsRepository) ListReaderStream(
+	ctx context.Context,
+	userID string,
+	q models.ReaderQuery,
+	withContent bool,
+) ([]models.ReaderItem, bool, error) {
+	columns, content := itemListColumns, false
+	if withContent {
+		columns, content = itemColumns, true
+	}
+	order := "DESC"
+	if q.OldestFirst {
+		order = "ASC"
+	}
+	query := `
+		SELECT ` + columns + readerItemColumns + `
+		FROM feeds.items i
+		JOIN feeds.feeds f ON f.id = i.feed_id
+		WHERE ` + readerStreamFilter + `
+		ORDER BY i.reader_id ` + order + `
+		LIMIT $8 OFFSET $9
+	`
+	args := append(readerStreamArgs(userID, q), q.Limit+1, q.Offset)
+	items, err := repo.queryReaderItems(ctx, content, query, args...)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(items) > q.Limit {
+		return items[:q.Limit], true, nil
+	}
+	return items, false, nil
+}
+
+// ReaderItemsByIDs returns userID's items among readerIDs, bodies
+// included, newest first. Unknown ids and other users' items are left out.
+func (repo *ItemsRepository) ReaderItemsByIDs(
+	ctx context.Context,
+	userID string,
+	readerIDs []int64,
+) ([]models.ReaderItem, error) {
+	query := `
+		SELECT ` + itemColumns + readerItemColumns + `
+		FROM feeds.items i
+		JOIN feeds.feeds f ON f.id = i.feed_id
+		WHERE f.user_id = $1 AND i.reader_id = ANY($2)
+		ORDER BY i.reader_id DESC
+	`
+	return repo.queryReaderItems(ctx, true, query, userID, readerIDs)
+}
+
+func (repo *ItemsRepository) queryReaderItems(
+	ctx context.Context,
+	withContent bool,
+	query string,
+	args ...any,
+) ([]models.ReaderItem, error) {
+	rows, err := repo.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	defer rows.Close()
+
+	var out []models.ReaderItem
+	for rows.Next() {
+		var r models.ReaderItem
+		i := &r.Item
+		var content any = &i.HasContent
+		if withContent {
+			content = &i.ContentHTML
+		}
+		targets := append(
+			itemTargets(i, content),
+			&r.ReaderID, &r.FeedTitle, &r.FeedURL, &r.FolderID,
+		)
+		if scanErr := rows.Scan(targets...); scanErr != nil {
+			return nil, postgres.PgxErrorToHTTPError(scanErr)
+		}
+		if withContent {
+			i.HasContent = i.ContentHTML != ""
+		}
+		out = append(out, r)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, postgres.PgxErrorToHTTPError(err)
+	}
+	return out, nil
+}
+
+// SetReaderState marks userID's items among readerIDs read or unread and
+// bookmarked or not; nil leaves that state unchanged. Items already read
+// keep the time they were first read, like MarkRead.
+func (repo *ItemsRepository) SetReaderState(
+	ctx context.Context,
+	userID string,
+	readerIDs []int64,
+	read, bookmarked *bool,
+) error {
+	_, err := repo.db.Exec(ctx, `
+		UPDATE feeds.items i
+		SET read_at = CASE
+		        WHEN $3::bool IS NULL THEN i.read_at
+		        WHEN $3::bool THEN COALESCE(i.read_at, now())
+		        ELSE NULL
+		      END,
+		    bookmarked = COALESCE($4, i.bookmarked)
+		FROM feeds.feeds f
+		WHERE i.feed_id = f.id AND f.user_id = $1 AND i.reader_id = ANY($2)
+	`, userID, readerIDs, read, bookmarked)
+	return postgres.PgxErrorToHTTPError(err)
+}
+
+// MarkReaderStreamRead marks every unread item of the stream q selects
+// read. q's paging and order are ignored.
+func (repo *ItemsRepository) MarkReaderStreamRead(
+	ctx context.Context,
+	userID string,
+	q models.ReaderQuery,
+) error {
+	_, err := repo.db.Exec(ctx, `
+		UPDATE feeds.items i
+		SET read_at = now()
+		FROM feeds.feeds f
+		WHERE i.feed_id = f.id AND i.read_at IS NULL AND `+readerStreamFilter,
+		readerStreamArgs(userID, q)...,
+	)
+	return postgres.PgxErrorToHTTPError(err)
+}
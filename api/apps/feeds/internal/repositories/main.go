@@ -5,13 +5,19 @@ import (
 )
 
 type Repositories struct {
-	Feeds *FeedsRepository
-	Items *ItemsRepository
+	Feeds        *FeedsRepository
+	Folders      *FoldersRepository
+	FilterRules  *FilterRulesRepository
+	Items        *ItemsRepository
+	AppPasswords *AppPasswordsRepository
 }
 
 func New(db postgres.DB) *Repositories {
 	return &Repositories{
-		Feeds: &FeedsRepository{db: db},
-		Items: &ItemsRepository{db: db},
+		Feeds:        &FeedsRepository{db: db},
+		Folders:      &FoldersRepository{db: db},
+		FilterRules:  &FilterRulesRepository{db: db},
+		Items:        &ItemsRepository{db: db},
+		AppPasswords: &AppPasswordsRepository{db: db},
 	}
 }
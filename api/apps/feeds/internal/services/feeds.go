@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/mail"
 	"sort"
 	"strings"
 	"time"
@@ -27,6 +28,10 @@ import (
 // ErrInvalidFeed is returned when a subscribed URL does not parse as RSS/Atom.
 var ErrInvalidFeed = errors.New("url is not a valid RSS/Atom feed")
 
+// ErrFeedUnreachable is returned when a subscribed URL can't be fetched at
+// all, before anything could be parsed from it.
+var ErrFeedUnreachable = errors.New("url could not be fetched")
+
 // ErrEmailFeedsNotConfigured is returned by CreateEmail when
 // EMAIL_INBOUND_DOMAIN is unset — minting an inbound address without a
 // receiving domain would produce one that can never receive mail.
@@ -65,15 +70,25 @@ const quietMinGap = 48 * time.Hour
 // FeedService manages RSS/Atom subscriptions and email-relay newsletter
 // subscriptions (issue #595), ingesting their items directly as feeds.items
 // rows — items are self-contained and never reference another app's schema.
+// Sending an item to the books library goes through library instead.
 type FeedService struct {
 	logger        *slog.Logger
 	feeds         *repositories.FeedsRepository
+	folders       *repositories.FoldersRepository
+	filterRules   *repositories.FilterRulesRepository
 	items         *repositories.ItemsRepository
+	appPasswords  *repositories.AppPasswordsRepository
 	webFetch      webfetch.Client
 	inboundDomain string
 	notifications *notifications.Service
 	users         *globalrepositories.AppUsersRepository
 	webURL        string
+	// webSubCallbackURL is where WebSub hubs reach the feeds app, a feed's
+	// id appended; empty disables WebSub subscriptions.
+	webSubCallbackURL string
+	// library is nil until SetLibrary is called; SendToLibrary fails
+	// without it.
+	library Library
 }
 
 // NewFeedService constructs a FeedService. inboundDomain is the
@@ -81,25 +96,35 @@ type FeedService struct {
 // disables CreateEmail (see ErrEmailFeedsNotConfigured). notifications/
 // users/webURL back the issue #799 problem-email alert, delivered off the
 // polling job's own path via notifications.Service (issue #923).
+// webSubCallbackURL is the public URL of the WebSub callback route.
 func NewFeedService(
 	logger *slog.Logger,
 	feeds *repositories.FeedsRepository,
+	folders *repositories.FoldersRepository,
+	filterRules *repositories.FilterRulesRepository,
 	items *repositories.ItemsRepository,
+	appPasswords *repositories.AppPasswordsRepository,
 	webFetchClient webfetch.Client,
 	inboundDomain string,
 	notifications *notifications.Service,
 	users *globalrepositories.AppUsersRepository,
 	webURL string,
+	webSubCallbackURL string,
 ) *FeedService {
 	return &FeedService{
-		logger:        logger,
-		feeds:         feeds,
-		items:         items,
-		webFetch:      webFetchClient,
-		inboundDomain: inboundDomain,
-		notifications: notifications,
-		users:         users,
-		webURL:        webURL,
+		logger:            logger,
+		feeds:             feeds,
+		folders:           folders,
+		filterRules:       filterRules,
+		items:             items,
+		appPasswords:      appPasswords,
+		webFetch:          webFetchClient,
+		inboundDomain:     inboundDomain,
+		notifications:     notifications,
+		users:             users,
+		webURL:            webURL,
+		webSubCallbackURL: webSubCallbackURL,
+		library:           nil,
 	}
 }
 
@@ -112,18 +137,19 @@ func (s *FeedService) List(
 }
 
 // ListItems returns a page of items ingested by any of the user's feeds.
-// feedID, when non-nil, restricts results to that one feed. bookmarkedOnly,
-// when true, excludes items that aren't bookmarked.
+// feedID, when non-nil, restricts results to that one feed and folderID to
+// the feeds filed under that folder or its subfolders. bookmarkedOnly, when
+// true, excludes items that aren't bookmarked.
 func (s *FeedService) ListItems(
 	ctx context.Context,
 	userID string,
 	limit, offset int32,
 	unreadOnly bool,
-	feedID *uuid.UUID,
+	feedID, folderID *uuid.UUID,
 	bookmarkedOnly bool,
 ) ([]models.Item, bool, error) {
 	return s.items.ListByUser(
-		ctx, userID, limit, offset, unreadOnly, feedID, bookmarkedOnly,
+		ctx, userID, limit, offset, unreadOnly, feedID, folderID, bookmarkedOnly,
 	)
 }
 
@@ -155,26 +181,36 @@ func (s *FeedService) Create(
 	ctx context.Context,
 	userID, rawURL string,
 ) (*models.Feed, error) {
-	canonical, err := canonicalURL(rawURL)
+	//nolint:exhaustruct // fetch state starts empty; ids are DB-owned
+	return s.createRSS(ctx, models.Feed{UserID: userID, URL: rawURL})
+}
+
+// createRSS is Create for a feed prepared by the caller: its URL is
+// canonicalized and its title, when empty, taken from the feed itself.
+func (s *FeedService) createRSS(
+	ctx context.Context,
+	newFeed models.Feed,
+) (*models.Feed, error) {
+	canonical, err := canonicalURL(newFeed.URL)
 	if err != nil {
 		return nil, err
 	}
 
 	res, err := s.webFetch.Get(ctx, canonical, fetchOptions(0, ""))
 	if err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrInvalidFeed, err)
+		return nil, fmt.Errorf("%w: %w", ErrFeedUnreachable, err)
 	}
 	parsed, err := gofeed.NewParser().Parse(bytes.NewReader(res.Body))
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidFeed, err)
 	}
 
-	//nolint:exhaustruct // fetch state starts empty; ids are DB-owned
-	feed, err := s.feeds.Insert(ctx, models.Feed{
-		UserID: userID,
-		URL:    canonical,
-		Title:  parsed.Title,
-	})
+	newFeed.URL = canonical
+	newFeed.SourceType = models.FeedSourceRSS
+	if newFeed.Title == "" {
+		newFeed.Title = parsed.Title
+	}
+	feed, err := s.feeds.Insert(ctx, newFeed)
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +222,7 @@ func (s *FeedService) Create(
 		importCtx := context.WithoutCancel(ctx)
 		s.processItems(importCtx, importFeed, parsed.Items)
 		s.recordFetchResult(importCtx, importFeed.ID, res, nil)
+		s.syncWebSub(importCtx, importFeed, res.Body)
 	}()
 	return feed, nil
 }
@@ -246,14 +283,15 @@ func (s *FeedService) GetByInboundTokenHash(
 // IngestEmail ingests one inbound email as an item for the given email feed
 // (issue #595) — the webhook-push counterpart to ingestItem for polled RSS
 // items. messageID is Resend's email id, used to build a stable dedup guid
-// alongside the feed ID. Best-effort: any ingest failure is recorded on the
+// alongside the feed ID, and from (the sender, as in the From header) is
+// kept as the item's author. Best-effort: any ingest failure is recorded on the
 // feed (visible in the UI's last-error) rather than returned, since the
 // caller must still ack the webhook so Resend doesn't retry a
 // permanently-broken email forever.
 func (s *FeedService) IngestEmail(
 	ctx context.Context,
 	feed models.Feed,
-	messageID, subject, htmlBody string,
+	messageID, from, subject, htmlBody string,
 ) {
 	guid := "mailto:" + feed.ID.String() + "/" + messageID
 	//nolint:exhaustruct // read/dismissed/bookmarked/ingest_error start empty
@@ -262,10 +300,12 @@ func (s *FeedService) IngestEmail(
 		GUID:        guid,
 		Title:       titleOrDefault(subject, "Email newsletter"),
 		SourceURL:   guid,
+		Author:      emailAuthor(from),
 		ContentHTML: htmlBody,
 		PublishedAt: time.Now(),
 	}
 
+	s.loadFilterRules(ctx, feed).apply(&item)
 	if err := s.items.Insert(ctx, item); err != nil {
 		s.logger.WarnContext(ctx, "email feed ingest failed",
 			"feedID", feed.ID, "messageID", messageID, "error", err)
@@ -277,6 +317,19 @@ func (s *FeedService) IngestEmail(
 	s.recordFetchResultRaw(ctx, feed.ID, nil, nil, nil)
 }
 
+// emailAuthor returns the display name of a From header value, or its bare
+// address when it has no name; unparseable values are kept as they are.
+func emailAuthor(from string) string {
+	addr, err := mail.ParseAddress(from)
+	if err != nil {
+		return strings.TrimSpace(from)
+	}
+	if addr.Name != "" {
+		return addr.Name
+	}
+	return addr.Address
+}
+
 // RecordEmailFetchFailure persists a fetch failure for an email feed when the
 // inbound webhook itself couldn't retrieve the email body (e.g. Resend's
 // receiving API erroring) — before IngestEmail is ever reached. Mirrors
@@ -303,7 +356,8 @@ func (s *FeedService) Update(
 
 // UpdateItem partially updates an item's read/dismissed/bookmarked/
 // read-progress state, scoped to userID. nil fields are left unchanged.
-// readProgressPct is clamped to [0,100] and only ever increases.
+// readProgressPct is clamped to [0,100] and only ever increases; for an item
+// sent to the library it is passed on to the library entry too.
 func (s *FeedService) UpdateItem(
 	ctx context.Context,
 	userID string,
@@ -315,7 +369,7 @@ func (s *FeedService) UpdateItem(
 		clamped := clampPct(*readProgressPct)
 		readProgressPct = &clamped
 	}
-	return s.items.Update(
+	item, err := s.items.Update(
 		ctx,
 		userID,
 		itemID,
@@ -324,6 +378,42 @@ func (s *FeedService) UpdateItem(
 		bookmarked,
 		readProgressPct,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if readProgressPct != nil {
+		s.syncLibraryProgress(ctx, userID, item)
+	}
+	return item, nil
+}
+
+// ListDigestCandidates returns every user's unread or bookmarked items
+// ingested since since, without bodies, for the books app's daily digest.
+func (s *FeedService) ListDigestCandidates(
+	ctx context.Context,
+	since time.Time,
+) ([]models.DigestItem, error) {
+	return s.items.ListDigestCandidates(ctx, since)
+}
+
+// ItemContent returns the article bodies of userID's items among itemIDs,
+// keyed by item id.
+func (s *FeedService) ItemContent(
+	ctx context.Context,
+	userID string,
+	itemIDs []uuid.UUID,
+) (map[uuid.UUID]string, error) {
+	return s.items.ContentByIDs(ctx, userID, itemIDs)
+}
+
+// MarkItemsRead marks userID's items among itemIDs read, leaving the read
+// time of any already read untouched.
+func (s *FeedService) MarkItemsRead(
+	ctx context.Context,
+	userID string,
+	itemIDs []uuid.UUID,
+) error {
+	return s.items.MarkRead(ctx, userID, itemIDs)
 }
 
 // maxPct is the upper clamp bound for a 0-100 percentage value.
@@ -389,7 +479,9 @@ func (s *FeedService) Refresh(
 }
 
 // PollAll polls every feed of every user; per-feed failures are recorded on
-// the feed and never abort the run. Called by the background job.
+// the feed and never abort the run. Called by the background job. Feeds
+// kept up to date by WebSub pushes are only polled every webSubFallbackPoll,
+// but have their lease renewed when it is close to expiring.
 func (s *FeedService) PollAll(
 	ctx context.Context,
 	logger *slog.Logger,
@@ -404,7 +496,9 @@ func (s *FeedService) PollAll(
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
-		if _, pollErr := s.pollFeed(ctx, feed); pollErr != nil {
+		if webSubPushed(feed, time.Now()) {
+			s.renewWebSub(ctx, feed)
+		} else if _, pollErr := s.pollFeed(ctx, feed); pollErr != nil {
 			logger.WarnContext(ctx, "feed poll failed",
 				"feedID", feed.ID, "url", feed.URL, "error", pollErr)
 		}
@@ -446,6 +540,7 @@ func (s *FeedService) pollFeed(
 	}
 	if res.NotModified {
 		s.recordFetchResult(ctx, feed.ID, res, nil)
+		s.renewWebSub(ctx, feed)
 		return 0, nil
 	}
 
@@ -458,6 +553,7 @@ func (s *FeedService) pollFeed(
 
 	ingested := s.processItems(ctx, feed, parsed.Items)
 	s.recordFetchResult(ctx, feed.ID, res, nil)
+	s.syncWebSub(ctx, feed, res.Body)
 	return ingested, nil
 }
 
@@ -499,6 +595,11 @@ func (s *FeedService) processItems(
 		return 0
 	}
 
+	if len(newGUIDs) == 0 {
+		return 0
+	}
+	rules := s.loadFilterRules(ctx, feed)
+
 	ingested := 0
 	for i, guid := range newGUIDs {
 		if ctx.Err() != nil {
@@ -510,7 +611,7 @@ func (s *FeedService) processItems(
 			s.markSeenError(ctx, feed.ID, guid, "skipped: over per-poll cap")
 			continue
 		}
-		if s.ingestItem(ctx, feed, byGUID[guid], guid) {
+		if s.ingestItem(ctx, feed, rules, byGUID[guid], guid) {
 			ingested++
 		}
 	}
@@ -524,6 +625,7 @@ func (s *FeedService) processItems(
 func (s *FeedService) ingestItem(
 	ctx context.Context,
 	feed models.Feed,
+	rules filterRules,
 	item *gofeed.Item,
 	guid string,
 ) bool {
@@ -535,6 +637,7 @@ func (s *FeedService) ingestItem(
 		return false
 	}
 
+	rules.apply(built)
 	if err = s.items.Insert(ctx, *built); err != nil {
 		s.logger.WarnContext(ctx, "feed item store failed",
 			"feedID", feed.ID, "guid", guid, "error", err)
@@ -582,6 +685,7 @@ func (s *FeedService) buildItem(
 		GUID:        guid,
 		Title:       title,
 		SourceURL:   canonical,
+		Author:      feedItemAuthor(item),
 		ContentHTML: html,
 		PublishedAt: publishedAt,
 	}, nil
@@ -813,3 +917,19 @@ func feedItemHTML(item *gofeed.Item) string {
 	}
 	return item.Custom["encoded"]
 }
+
+// feedItemAuthor returns the item's byline: its author's name, or the
+// first of its authors', falling back to their email; "" when it has none.
+func feedItemAuthor(item *gofeed.Item) string {
+	author := item.Author
+	if author == nil && len(item.Authors) > 0 {
+		author = item.Authors[0]
+	}
+	if author == nil {
+		return ""
+	}
+	if name := strings.TrimSpace(author.Name); name != "" {
+		return name
+	}
+	return strings.TrimSpace(author.Email)
+}
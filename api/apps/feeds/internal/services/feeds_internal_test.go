@@ -40,7 +40,8 @@ func TestTitleOrDefault(t *testing.T) {
 // fetch also fails to yield a title.
 func TestBuildItemWhitespaceTitleFallsBackToCanonical(t *testing.T) {
 	s := NewFeedService(
-		slog.Default(), nil, nil, mocks.NewMockWebFetchClient(), "", nil, nil, "",
+		slog.Default(), nil, nil, nil, nil, nil, mocks.NewMockWebFetchClient(), "", nil,
+		nil, "", "",
 	)
 	//nolint:exhaustruct // only title/link/description are relevant here
 	item := &gofeed.Item{
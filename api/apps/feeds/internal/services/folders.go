@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+)
+
+// ErrInvalidFolder is returned for a folder without a name, or one that
+// would nest more than one level deep.
+var ErrInvalidFolder = errors.New("invalid folder")
+
+// ListWithFolders returns the user's feeds and folders with their unread
+// counts; a folder's count includes its subfolders'.
+func (s *FeedService) ListWithFolders(
+	ctx context.Context,
+	userID string,
+) ([]models.Feed, []models.Folder, error) {
+	feeds, err := s.feeds.List(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	folders, err := s.folders.List(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	unread, err := s.items.UnreadCountsByFeed(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	direct := map[uuid.UUID]int{}
+	for i := range feeds {
+		feeds[i].UnreadCount = unread[feeds[i].ID]
+		if feeds[i].FolderID != nil {
+			direct[*feeds[i].FolderID] += feeds[i].UnreadCount
+		}
+	}
+	for i := range folders {
+		folders[i].UnreadCount = direct[folders[i].ID]
+		for _, sub := range folders {
+			if sub.ParentID != nil && *sub.ParentID == folders[i].ID {
+				folders[i].UnreadCount += direct[sub.ID]
+			}
+		}
+	}
+	return feeds, folders, nil
+}
+
+// CreateFolder adds a folder after its siblings: top-level when parentID is
+// nil, otherwise under that top-level folder.
+func (s *FeedService) CreateFolder(
+	ctx context.Context,
+	userID, name string,
+	parentID *uuid.UUID,
+) (*models.Folder, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidFolder)
+	}
+	if parentID != nil {
+		parent, err := s.folders.GetByID(ctx, userID, *parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.ParentID != nil {
+			return nil, fmt.Errorf(
+				"%w: folders nest only one level deep", ErrInvalidFolder,
+			)
+		}
+	}
+	return s.folders.Insert(ctx, userID, parentID, name)
+}
+
+// RenameFolder changes a folder's name.
+func (s *FeedService) RenameFolder(
+	ctx context.Context,
+	userID string,
+	id uuid.UUID,
+	name string,
+) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidFolder)
+	}
+	return s.folders.Rename(ctx, userID, id, name)
+}
+
+// DeleteFolder removes a folder and its subfolders; the feeds filed under
+// them become unfiled, not deleted.
+func (s *FeedService) DeleteFolder(
+	ctx context.Context,
+	userID string,
+	id uuid.UUID,
+) error {
+	return s.folders.Delete(ctx, userID, id)
+}
+
+// ReorderFolders sets the position of each folder to its index in ids.
+func (s *FeedService) ReorderFolders(
+	ctx context.Context,
+	userID string,
+	ids []uuid.UUID,
+) error {
+	return s.folders.Reorder(ctx, userID, ids)
+}
+
+// MoveFeed files the feed under folderID, or unfiles it when nil.
+func (s *FeedService) MoveFeed(
+	ctx context.Context,
+	userID string,
+	feedID uuid.UUID,
+	folderID *uuid.UUID,
+) error {
+	if folderID != nil {
+		if _, err := s.folders.GetByID(ctx, userID, *folderID); err != nil {
+			return err
+		}
+	}
+	return s.feeds.SetFolder(ctx, userID, feedID, folderID)
+}
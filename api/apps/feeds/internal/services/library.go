@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+)
+
+// ErrNoArticleContent is returned by SendToLibrary for an item without a
+// stored article body — there is nothing to read in the library then, and
+// the library never re-fetches the page itself.
+var ErrNoArticleContent = errors.New("item has no article content")
+
+// ErrLibraryUnavailable is returned by SendToLibrary when no library is
+// connected (see SetLibrary).
+var ErrLibraryUnavailable = errors.New("library is not available")
+
+// Library is the books app, as feeds sees it. It is set from outside the
+// app (see Feeds.SetLibrary) because feeds never writes the books schema
+// itself.
+type Library interface {
+	// AddArticle adds article to userID's library, or finds the entry an
+	// earlier send created, and returns its book id.
+	AddArticle(
+		ctx context.Context, userID string, article models.LibraryArticle,
+	) (uuid.UUID, error)
+	// SetArticleProgress records percent as userID's reading progress on
+	// library entry bookID.
+	SetArticleProgress(
+		ctx context.Context, userID string, bookID uuid.UUID, percent int,
+	) error
+}
+
+// SetLibrary connects the service to the books app. Until it is called,
+// SendToLibrary fails with ErrLibraryUnavailable.
+func (s *FeedService) SetLibrary(library Library) {
+	s.library = library
+}
+
+// SendToLibrary adds userID's item to the books library as an article made
+// from its stored body, and links the item to the entry so reading progress
+// shows up in both apps. Sending an item again returns the same entry, or
+// re-adds it if it was removed from the library since.
+func (s *FeedService) SendToLibrary(
+	ctx context.Context,
+	userID string,
+	itemID uuid.UUID,
+) (*models.Item, error) {
+	if s.library == nil {
+		return nil, ErrLibraryUnavailable
+	}
+
+	item, err := s.items.GetByIDForUser(ctx, userID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item.ContentHTML == "" {
+		return nil, ErrNoArticleContent
+	}
+	feed, err := s.feeds.GetByID(ctx, userID, item.FeedID)
+	if err != nil {
+		return nil, err
+	}
+	feedTitle := feed.Title
+	if feedTitle == "" {
+		feedTitle = feed.URL
+	}
+
+	bookID, err := s.library.AddArticle(ctx, userID, models.LibraryArticle{
+		ItemID:      item.ID,
+		FeedTitle:   feedTitle,
+		Title:       item.Title,
+		SourceURL:   item.SourceURL,
+		ContentHTML: item.ContentHTML,
+		PublishedAt: item.PublishedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.items.SetLibraryBookID(ctx, userID, itemID, bookID)
+	if err != nil {
+		return nil, err
+	}
+	// Carry over what was already read in the feeds reader.
+	if updated.ReadProgressPct > 0 {
+		s.syncLibraryProgress(ctx, userID, updated)
+	}
+	return updated, nil
+}
+
+// syncLibraryProgress passes item's read progress on to its library entry,
+// if it has one. A failure is only logged: the progress is saved in feeds
+// either way, and the next update sends it again.
+func (s *FeedService) syncLibraryProgress(
+	ctx context.Context,
+	userID string,
+	item *models.Item,
+) {
+	if s.library == nil || item.LibraryBookID == nil {
+		return
+	}
+	if err := s.library.SetArticleProgress(
+		ctx, userID, *item.LibraryBookID, item.ReadProgressPct,
+	); err != nil {
+		s.logger.WarnContext(ctx, "failed to sync item progress to library",
+			slog.String("item_id", item.ID.String()),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// SetItemProgress records reading progress made on userID's item in the
+// library, as read progress here. It only ever raises the item's progress,
+// like the reader's own updates, and marks the item read once the library
+// entry is finished. It doesn't pass the progress back to the library.
+func (s *FeedService) SetItemProgress(
+	ctx context.Context,
+	userID string,
+	itemID uuid.UUID,
+	percent int,
+) error {
+	pct := int32(min(max(percent, 0), maxPct)) //nolint:gosec // clamped [0,100]
+	if _, err := s.items.Update(
+		ctx, userID, itemID, nil, nil, nil, &pct,
+	); err != nil {
+		return err
+	}
+	if pct < maxPct {
+		return nil
+	}
+	return s.items.MarkRead(ctx, userID, []uuid.UUID{itemID})
+}
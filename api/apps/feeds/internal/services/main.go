@@ -24,18 +24,23 @@ func New(
 	notifications *notifications.Service,
 	appUsersRepo *globalrepositories.AppUsersRepository,
 	webURL string,
+	webSubCallbackURL string,
 ) *Services {
 	return &Services{
 		Auth: authService,
 		Feeds: NewFeedService(
 			logger,
 			repos.Feeds,
+			repos.Folders,
+			repos.FilterRules,
 			repos.Items,
+			repos.AppPasswords,
 			webFetchClient,
 			inboundDomain,
 			notifications,
 			appUsersRepo,
 			webURL,
+			webSubCallbackURL,
 		),
 	}
 }
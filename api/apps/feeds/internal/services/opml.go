@@ -0,0 +1,530 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+	"tools.xdoubleu.com/internal/database"
+)
+
+// ErrInvalidOPML is returned by ImportOPML for a file that isn't OPML.
+var ErrInvalidOPML = errors.New("not a valid OPML file")
+
+// maxOPMLFeeds caps how many new feeds one import fetches; every one is
+// fetched while the request waits. The rest of a bigger file are reported
+// as deferred, and since the feeds created meanwhile count as duplicates
+// next time, importing the file again works through it.
+const maxOPMLFeeds = 100
+
+// errOPMLDeferred is the error of an outline past maxOPMLFeeds.
+var errOPMLDeferred = fmt.Errorf(
+	"only %d new feeds are added per import; import the file again", maxOPMLFeeds,
+)
+
+// opmlImportWorkers is how many of an import's feeds are fetched at once.
+const opmlImportWorkers = 8
+
+// opmlFetchBudget bounds how long an import spends fetching feeds, leaving
+// the rest of the route's 20s deadline (importOPMLCtxTimeout in the feeds
+// package) for filing them into folders and answering. Feeds not checked
+// in time are reported as unreachable; importing the same file again picks
+// them up.
+const opmlFetchBudget = 15 * time.Second
+
+// errOPMLTimedOut is the error of an outline the fetch budget ran out on.
+var errOPMLTimedOut = errors.New(
+	"import timed out before this feed was checked; import the file again",
+)
+
+// opmlFolderSeparator joins nested folder outlines into an opmlEntry's
+// folder path.
+const opmlFolderSeparator = " / "
+
+// opmlOutlineTypeLink marks an outline that links to a page rather than a
+// feed; scrape feeds export as these, so they import back as scrape feeds.
+const opmlOutlineTypeLink = "link"
+
+type opmlDocument struct {
+	XMLName xml.Name      `xml:"opml"`
+	Body    []opmlOutline `xml:"body>outline"`
+}
+
+type opmlOutline struct {
+	Text     string        `xml:"text,attr"`
+	Title    string        `xml:"title,attr"`
+	Type     string        `xml:"type,attr"`
+	XMLURL   string        `xml:"xmlUrl,attr"`
+	URL      string        `xml:"url,attr"`
+	Outlines []opmlOutline `xml:"outline"`
+}
+
+// opmlEntry is one feed outline of an OPML file.
+type opmlEntry struct {
+	URL    string
+	Title  string
+	Folder string
+	// SourceType is models.FeedSourceRSS or models.FeedSourceScrape.
+	SourceType string
+}
+
+// parseOPML flattens an OPML file's feed outlines, in document order. An
+// outline with an xmlUrl is an RSS/Atom feed and a type="link" outline a
+// scrape feed; any other outline with children is a folder. Folders may
+// nest deeper than models.Folder allows; ImportOPML collapses them.
+func parseOPML(data []byte) ([]opmlEntry, error) {
+	var doc opmlDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidOPML, err)
+	}
+
+	var entries []opmlEntry
+	var walk func(outlines []opmlOutline, folders []string)
+	walk = func(outlines []opmlOutline, folders []string) {
+		for _, o := range outlines {
+			title := strings.TrimSpace(o.Title)
+			if title == "" {
+				title = strings.TrimSpace(o.Text)
+			}
+			entry := opmlEntry{
+				URL:        strings.TrimSpace(o.XMLURL),
+				Title:      title,
+				Folder:     strings.Join(folders, opmlFolderSeparator),
+				SourceType: models.FeedSourceRSS,
+			}
+			if entry.URL == "" && strings.EqualFold(o.Type, opmlOutlineTypeLink) {
+				entry.URL = strings.TrimSpace(o.URL)
+				entry.SourceType = models.FeedSourceScrape
+			}
+			if entry.URL != "" {
+				entries = append(entries, entry)
+				continue
+			}
+			if len(o.Outlines) > 0 {
+				// An untitled folder files its feeds under its parent.
+				sub := folders
+				if title != "" {
+					sub = append(folders[:len(folders):len(folders)], title)
+				}
+				walk(o.Outlines, sub)
+			}
+		}
+	}
+	walk(doc.Body, nil)
+	return entries, nil
+}
+
+// opmlFolder is one folder outline of an export, with the feeds and
+// subfolders filed under it.
+type opmlFolder struct {
+	name    string
+	feeds   []models.Feed
+	folders []*opmlFolder
+}
+
+// writeOPML renders feeds as an OPML 2.0 document. RSS feeds become
+// type="rss" outlines and scrape feeds type="link" ones, nested in their
+// folders' outlines in folder order; email feeds have no URL another reader
+// could subscribe to, so they are only listed as comments.
+func writeOPML(
+	feeds []models.Feed,
+	folders []models.Folder,
+	now time.Time,
+) ([]byte, error) {
+	root := &opmlFolder{name: "", feeds: nil, folders: nil}
+	byID := make(map[uuid.UUID]*opmlFolder, len(folders))
+	for _, f := range folders {
+		byID[f.ID] = &opmlFolder{name: f.Name, feeds: nil, folders: nil}
+	}
+	// folders is ordered by position, so appending keeps sibling order.
+	for _, f := range folders {
+		parent := root
+		if f.ParentID != nil {
+			if p, ok := byID[*f.ParentID]; ok {
+				parent = p
+			}
+		}
+		parent.folders = append(parent.folders, byID[f.ID])
+	}
+	for _, f := range feeds {
+		folder := root
+		if f.FolderID != nil {
+			if byFolder, ok := byID[*f.FolderID]; ok {
+				folder = byFolder
+			}
+		}
+		folder.feeds = append(folder.feeds, f)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+
+	opml := xml.StartElement{
+		Name: xml.Name{Space: "", Local: "opml"},
+		Attr: []xml.Attr{{Name: xml.Name{Space: "", Local: "version"}, Value: "2.0"}},
+	}
+	head := struct {
+		XMLName     xml.Name `xml:"head"`
+		Title       string   `xml:"title"`
+		DateCreated string   `xml:"dateCreated"`
+	}{
+		XMLName:     xml.Name{Space: "", Local: "head"},
+		Title:       "Feeds",
+		DateCreated: now.UTC().Format(time.RFC1123Z),
+	}
+	body := xml.StartElement{Name: xml.Name{Space: "", Local: "body"}, Attr: nil}
+
+	if err := enc.EncodeToken(opml); err != nil {
+		return nil, err
+	}
+	if err := enc.Encode(head); err != nil {
+		return nil, err
+	}
+	if err := enc.EncodeToken(body); err != nil {
+		return nil, err
+	}
+	if err := writeOPMLFolder(enc, root); err != nil {
+		return nil, err
+	}
+	if err := enc.EncodeToken(body.End()); err != nil {
+		return nil, err
+	}
+	if err := enc.EncodeToken(opml.End()); err != nil {
+		return nil, err
+	}
+	if err := enc.Flush(); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+// writeOPMLFolder writes folder's feeds, then its subfolders.
+func writeOPMLFolder(enc *xml.Encoder, folder *opmlFolder) error {
+	for _, f := range folder.feeds {
+		if err := writeOPMLFeed(enc, f); err != nil {
+			return err
+		}
+	}
+
+	for _, sub := range folder.folders {
+		outline := xml.StartElement{
+			Name: xml.Name{Space: "", Local: "outline"},
+			Attr: []xml.Attr{
+				{Name: xml.Name{Space: "", Local: "text"}, Value: sub.name},
+				{Name: xml.Name{Space: "", Local: "title"}, Value: sub.name},
+			},
+		}
+		if err := enc.EncodeToken(outline); err != nil {
+			return err
+		}
+		if err := writeOPMLFolder(enc, sub); err != nil {
+			return err
+		}
+		if err := enc.EncodeToken(outline.End()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeOPMLFeed(enc *xml.Encoder, f models.Feed) error {
+	attr := func(name, value string) xml.Attr {
+		return xml.Attr{Name: xml.Name{Space: "", Local: name}, Value: value}
+	}
+
+	var attrs []xml.Attr
+	switch f.SourceType {
+	case models.FeedSourceEmail:
+		// "--" may not appear inside an XML comment, and a single pass
+		// leaves "---" as "- --".
+		title := f.Title
+		for strings.Contains(title, "--") {
+			title = strings.ReplaceAll(title, "--", "- -")
+		}
+		return enc.EncodeToken(xml.Comment(
+			" Email newsletter: " + title + " (delivered by email, not exportable) ",
+		))
+	case models.FeedSourceScrape:
+		attrs = []xml.Attr{
+			attr("type", opmlOutlineTypeLink),
+			attr("text", f.Title),
+			attr("title", f.Title),
+			attr("url", f.URL),
+		}
+	default:
+		attrs = []xml.Attr{
+			attr("type", "rss"),
+			attr("text", f.Title),
+			attr("title", f.Title),
+			attr("xmlUrl", f.URL),
+		}
+	}
+
+	outline := xml.StartElement{Name: xml.Name{Space: "", Local: "outline"}, Attr: attrs}
+	if err := enc.EncodeToken(outline); err != nil {
+		return err
+	}
+	return enc.EncodeToken(outline.End())
+}
+
+// ImportOPML subscribes the user to every feed outline of an OPML file,
+// filing it under its folder (see opmlFolderID). Each feed is validated like
+// Create (CreateScrape for type="link" outlines), opmlImportWorkers at a
+// time within opmlFetchBudget, and the outcome reported per outline in
+// document order: feeds the user already has, or that the file lists twice,
+// are duplicates; a URL that can't be fetched in time is unreachable; one
+// that fetches but isn't a feed is invalid; new feeds past maxOPMLFeeds are
+// deferred. Only a storage failure aborts the import, leaving the feeds
+// created so far in place.
+func (s *FeedService) ImportOPML(
+	ctx context.Context,
+	userID string,
+	data []byte,
+) ([]models.OPMLImportResult, error) {
+	entries, err := parseOPML(data)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.feeds.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(existing)+len(entries))
+	for _, f := range existing {
+		if f.URL != "" {
+			seen[f.URL] = true
+		}
+	}
+
+	results := make([]models.OPMLImportResult, len(entries))
+	var pending []int
+	for i, entry := range entries {
+		if !checkOPMLEntry(&results[i], entry, seen) {
+			continue
+		}
+		if len(pending) == maxOPMLFeeds {
+			results[i].Status = models.OPMLImportDeferred
+			results[i].Error = errOPMLDeferred.Error()
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	if err = s.createOPMLFeeds(ctx, userID, entries, pending, results); err != nil {
+		return nil, err
+	}
+
+	folderIDs := map[string]uuid.UUID{}
+	for i, entry := range entries {
+		if results[i].Feed == nil || entry.Folder == "" {
+			continue
+		}
+		err = s.fileOPMLFeed(ctx, userID, results[i].Feed, entry.Folder, folderIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// checkOPMLEntry starts result for entry, settling it unless its URL still
+// has to be fetched: an invalid URL, or one seen already (in the user's
+// feeds or earlier in the file). Reports whether the entry needs fetching.
+func checkOPMLEntry(
+	result *models.OPMLImportResult,
+	entry opmlEntry,
+	seen map[string]bool,
+) bool {
+	//nolint:exhaustruct // status/error/feed filled in below
+	*result = models.OPMLImportResult{
+		URL:    entry.URL,
+		Title:  entry.Title,
+		Folder: entry.Folder,
+	}
+
+	canonical, err := canonicalURL(entry.URL)
+	if err != nil {
+		result.Status = models.OPMLImportInvalid
+		result.Error = err.Error()
+		return false
+	}
+	if seen[canonical] {
+		result.Status = models.OPMLImportDuplicate
+		return false
+	}
+	seen[canonical] = true
+	return true
+}
+
+// createOPMLFeeds creates the feeds of entries at the pending indexes,
+// opmlImportWorkers at a time, recording each outcome in results. Returns
+// the first storage failure; outlines not yet started then stay unset.
+func (s *FeedService) createOPMLFeeds(
+	ctx context.Context,
+	userID string,
+	entries []opmlEntry,
+	pending []int,
+	results []models.OPMLImportResult,
+) error {
+	fetchCtx, cancel := context.WithTimeout(ctx, opmlFetchBudget)
+	defer cancel()
+
+	jobs := make(chan int)
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	for range min(opmlImportWorkers, len(pending)) {
+		wg.Go(func() {
+			for i := range jobs {
+				err := s.importOPMLEntry(fetchCtx, userID, entries[i], &results[i])
+				if err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+					mu.Unlock()
+				}
+			}
+		})
+	}
+	for _, i := range pending {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return firstErr
+}
+
+// importOPMLEntry creates one OPML feed, settling result. A failure once
+// ctx is done, storage included, counts as the budget running out.
+func (s *FeedService) importOPMLEntry(
+	ctx context.Context,
+	userID string,
+	entry opmlEntry,
+	result *models.OPMLImportResult,
+) error {
+	if ctx.Err() != nil {
+		result.Status = models.OPMLImportUnreachable
+		result.Error = errOPMLTimedOut.Error()
+		return nil
+	}
+
+	//nolint:exhaustruct // fetch state starts empty; ids are DB-owned
+	newFeed := models.Feed{
+		UserID: userID,
+		URL:    entry.URL,
+		Title:  entry.Title,
+	}
+	var feed *models.Feed
+	var err error
+	if entry.SourceType == models.FeedSourceScrape {
+		feed, err = s.createScrape(ctx, newFeed)
+	} else {
+		feed, err = s.createRSS(ctx, newFeed)
+	}
+
+	switch {
+	case err == nil:
+		result.Status = models.OPMLImportCreated
+		result.Feed = feed
+	case errors.Is(err, database.ErrResourceConflict):
+		result.Status = models.OPMLImportDuplicate
+	case ctx.Err() != nil:
+		result.Status = models.OPMLImportUnreachable
+		result.Error = errOPMLTimedOut.Error()
+	case errors.Is(err, ErrFeedUnreachable):
+		result.Status = models.OPMLImportUnreachable
+		result.Error = err.Error()
+	case errors.Is(err, ErrInvalidFeed),
+		errors.Is(err, ErrNoPostsFound),
+		errors.Is(err, ErrUnsupportedURL):
+		result.Status = models.OPMLImportInvalid
+		result.Error = err.Error()
+	default:
+		return err
+	}
+	return nil
+}
+
+// fileOPMLFeed files a newly imported feed under the folder at path. The
+// feed is only filed once it has been created, so outlines that fail to
+// import leave no empty folders behind.
+func (s *FeedService) fileOPMLFeed(
+	ctx context.Context,
+	userID string,
+	feed *models.Feed,
+	path string,
+	folderIDs map[string]uuid.UUID,
+) error {
+	folderID, err := s.opmlFolderID(ctx, userID, path, folderIDs)
+	if err != nil {
+		return err
+	}
+	if err = s.feeds.SetFolder(ctx, userID, feed.ID, &folderID); err != nil {
+		return err
+	}
+	feed.FolderID = &folderID
+	return nil
+}
+
+// opmlFolderID resolves an OPML folder path to the user's folder, creating
+// it if needed. The first outline becomes a top-level folder and everything
+// below it a single subfolder named by the rest of the path, since folders
+// only nest one level.
+func (s *FeedService) opmlFolderID(
+	ctx context.Context,
+	userID, path string,
+	folderIDs map[string]uuid.UUID,
+) (uuid.UUID, error) {
+	if id, ok := folderIDs[path]; ok {
+		return id, nil
+	}
+
+	top, rest, nested := strings.Cut(path, opmlFolderSeparator)
+	var id uuid.UUID
+	var err error
+	if nested {
+		var parentID uuid.UUID
+		parentID, err = s.opmlFolderID(ctx, userID, top, folderIDs)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		id, err = s.folders.GetOrCreate(ctx, userID, &parentID, rest)
+	} else {
+		id, err = s.folders.GetOrCreate(ctx, userID, nil, top)
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	folderIDs[path] = id
+	return id, nil
+}
+
+// ExportOPML renders the user's feeds as an OPML 2.0 document; see
+// writeOPML.
+func (s *FeedService) ExportOPML(ctx context.Context, userID string) ([]byte, error) {
+	feeds, err := s.feeds.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	folders, err := s.folders.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return writeOPML(feeds, folders, time.Now())
+}
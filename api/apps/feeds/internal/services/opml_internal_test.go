@@ -0,0 +1,123 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+)
+
+func TestParseOPML_FlattensFoldersAndFeedKinds(t *testing.T) {
+	data := []byte(`<?xml version="1.0"?>
+<opml version="2.0">
+  <head><title>Subscriptions</title></head>
+  <body>
+    <outline type="rss" text="Top Level" xmlUrl=" https://a.example.com/feed.xml "/>
+    <outline text="Tech">
+      <outline type="rss" text="Text Only" xmlUrl="https://b.example.com/rss"/>
+      <outline text="Go">
+        <outline type="rss" text="ignored" title="Go Blog" xmlUrl="https://go.dev/blog/feed.atom"/>
+      </outline>
+      <outline type="link" text="Scraped" url="https://c.example.com/blog"/>
+    </outline>
+    <outline>
+      <outline type="rss" text="Untitled Parent" xmlUrl="https://d.example.com/rss"/>
+    </outline>
+    <outline text="Empty folder"/>
+    <outline type="link" text="Bookmark without url"/>
+  </body>
+</opml>`)
+
+	entries, err := parseOPML(data)
+	require.NoError(t, err)
+	assert.Equal(t, []opmlEntry{
+		{"https://a.example.com/feed.xml", "Top Level", "", models.FeedSourceRSS},
+		{"https://b.example.com/rss", "Text Only", "Tech", models.FeedSourceRSS},
+		{"https://go.dev/blog/feed.atom", "Go Blog", "Tech / Go", models.FeedSourceRSS},
+		{"https://c.example.com/blog", "Scraped", "Tech", models.FeedSourceScrape},
+		{"https://d.example.com/rss", "Untitled Parent", "", models.FeedSourceRSS},
+	}, entries)
+}
+
+func TestParseOPML_RejectsNonOPML(t *testing.T) {
+	_, err := parseOPML([]byte(`<rss version="2.0"><channel/></rss>`))
+	require.ErrorIs(t, err, ErrInvalidOPML)
+
+	_, err = parseOPML([]byte("not xml"))
+	require.ErrorIs(t, err, ErrInvalidOPML)
+}
+
+func TestParseOPML_ReadsFilesPastTheImportCap(t *testing.T) {
+	var b strings.Builder
+	b.WriteString(`<opml version="2.0"><body>`)
+	for i := range maxOPMLFeeds + 1 {
+		fmt.Fprintf(&b, `<outline xmlUrl="https://example.com/%d.xml"/>`, i)
+	}
+	b.WriteString(`</body></opml>`)
+
+	entries, err := parseOPML([]byte(b.String()))
+	require.NoError(t, err)
+	assert.Len(t, entries, maxOPMLFeeds+1)
+}
+
+func TestWriteOPML_RoundTripsThroughParse(t *testing.T) {
+	tech, news, goFolder := uuid.New(), uuid.New(), uuid.New()
+	//nolint:exhaustruct // only the exported fields matter
+	folders := []models.Folder{
+		{ID: tech, Name: "Tech", Position: 0},
+		{ID: goFolder, ParentID: &tech, Name: "Go", Position: 0},
+		{ID: news, Name: "News", Position: 1},
+	}
+	//nolint:exhaustruct // only the exported fields matter
+	feeds := []models.Feed{
+		{URL: "https://a.example.com/feed.xml", Title: "A & B", SourceType: models.FeedSourceRSS},
+		{
+			URL:        "https://go.dev/blog/feed.atom",
+			Title:      "Go Blog",
+			SourceType: models.FeedSourceRSS,
+			FolderID:   &goFolder,
+		},
+		{
+			URL:        "https://n.example.com/rss",
+			Title:      "Daily",
+			SourceType: models.FeedSourceRSS,
+			FolderID:   &news,
+		},
+		{
+			URL:        "https://c.example.com/blog",
+			Title:      "Scraped",
+			SourceType: models.FeedSourceScrape,
+			FolderID:   &tech,
+		},
+		{Title: "Weekly -- digest", SourceType: models.FeedSourceEmail, FolderID: &tech},
+		{Title: "Notes --- and ----", SourceType: models.FeedSourceEmail},
+	}
+
+	data, err := writeOPML(feeds, folders, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	require.NoError(t, err)
+	out := string(data)
+	assert.True(t, strings.HasPrefix(out, `<?xml version="1.0" encoding="UTF-8"?>`))
+	assert.Contains(t, out, `<opml version="2.0">`)
+	assert.Contains(t, out, `<dateCreated>Fri, 02 Jan 2026 03:04:05 +0000</dateCreated>`)
+	assert.Contains(t, out, `<outline type="rss" text="A &amp; B"`)
+	assert.Contains(t, out,
+		`<!-- Email newsletter: Weekly - - digest (delivered by email, not exportable) -->`)
+	assert.Contains(t, out,
+		`<!-- Email newsletter: Notes - - - and - - - - (delivered by email, not exportable) -->`)
+
+	// Folders follow their position, not their name.
+	entries, err := parseOPML(data)
+	require.NoError(t, err)
+	assert.Equal(t, []opmlEntry{
+		{"https://a.example.com/feed.xml", "A & B", "", models.FeedSourceRSS},
+		{"https://c.example.com/blog", "Scraped", "Tech", models.FeedSourceScrape},
+		{"https://go.dev/blog/feed.atom", "Go Blog", "Tech / Go", models.FeedSourceRSS},
+		{"https://n.example.com/rss", "Daily", "News", models.FeedSourceRSS},
+	}, entries)
+}
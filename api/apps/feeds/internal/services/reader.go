@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+	"tools.xdoubleu.com/internal/database"
+)
+
+// ErrAppPasswordUsername is returned when an app password is created
+// without a username.
+var ErrAppPasswordUsername = errors.New("username cannot be empty")
+
+// appPasswordBytes is the number of random bytes in a generated app
+// password. Lower-case base32 keeps it typeable on a phone keyboard, like
+// the books app's KOReader passwords: 10 bytes give 16 characters and 80
+// bits of entropy.
+const appPasswordBytes = 10
+
+// defaultReaderItems/maxReaderItemIDs/maxReaderItemContents bound a Google
+// Reader stream page. Id listings are cheap and clients ask for thousands
+// at once to sync unread and starred state (NetNewsWire asks for 10000);
+// pages with content carry article bodies, so they stay small (issue
+// #1027) and clients follow the continuation instead.
+const (
+	defaultReaderItems    = 20
+	maxReaderItemIDs      = 10000
+	maxReaderItemContents = 100
+)
+
+func appPasswordHash(password string) string {
+	h := sha256.Sum256([]byte(password))
+	return hex.EncodeToString(h[:])
+}
+
+// CreateAppPassword generates a password a reader app logs in to the
+// Google Reader API with, returning it once alongside the stored record.
+func (s *FeedService) CreateAppPassword(
+	ctx context.Context,
+	userID, username string,
+) (*models.AppPassword, string, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, "", ErrAppPasswordUsername
+	}
+	raw := make([]byte, appPasswordBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", err
+	}
+	password := strings.ToLower(
+		base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(raw),
+	)
+	p, err := s.appPasswords.Insert(ctx, userID, username, appPasswordHash(password))
+	if err != nil {
+		return nil, "", err
+	}
+	return p, password, nil
+}
+
+// ListAppPasswords returns the user's app passwords, oldest first.
+func (s *FeedService) ListAppPasswords(
+	ctx context.Context,
+	userID string,
+) ([]models.AppPassword, error) {
+	return s.appPasswords.List(ctx, userID)
+}
+
+// DeleteAppPassword revokes an app password; apps using it are logged out
+// on their next request.
+func (s *FeedService) DeleteAppPassword(
+	ctx context.Context,
+	userID string,
+	id uuid.UUID,
+) error {
+	return s.appPasswords.Delete(ctx, userID, id)
+}
+
+// AuthenticateAppPassword returns the user owning the app password.
+// Returns database.ErrResourceNotFound when the password is unknown or
+// was created for a different username.
+func (s *FeedService) AuthenticateAppPassword(
+	ctx context.Context,
+	username, password string,
+) (string, error) {
+	if username == "" || password == "" {
+		return "", database.ErrResourceNotFound
+	}
+	p, err := s.appPasswords.GetByPasswordHash(ctx, appPasswordHash(password))
+	if err != nil {
+		return "", err
+	}
+	if p.Username != username {
+		return "", database.ErrResourceNotFound
+	}
+	return p.UserID, nil
+}
+
+// ReaderLabels names the user's folders as Google Reader labels. The
+// protocol's labels are flat, so a subfolder is named after its parent
+// too, "Parent / Child", the way OPML export nests them.
+func (s *FeedService) ReaderLabels(
+	ctx context.Context,
+	userID string,
+) (map[uuid.UUID]string, error) {
+	folders, err := s.folders.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[uuid.UUID]string, len(folders))
+	for _, f := range folders {
+		names[f.ID] = f.Name
+	}
+	labels := make(map[uuid.UUID]string, len(folders))
+	for _, f := range folders {
+		labels[f.ID] = f.Name
+		if f.ParentID != nil {
+			labels[f.ID] = names[*f.ParentID] + " / " + f.Name
+		}
+	}
+	return labels, nil
+}
+
+// ReaderStream returns a page of the Google Reader stream q selects and
+// whether there are more. q.Limit defaults to defaultReaderItems and is
+// capped at maxReaderItemContents when withContent is set, at
+// maxReaderItemIDs otherwise.
+func (s *FeedService) ReaderStream(
+	ctx context.Context,
+	userID string,
+	q models.ReaderQuery,
+	withContent bool,
+) ([]models.ReaderItem, bool, error) {
+	if q.Limit <= 0 {
+		q.Limit = defaultReaderItems
+	}
+	if withContent {
+		q.Limit = min(q.Limit, maxReaderItemContents)
+	} else {
+		q.Limit = min(q.Limit, maxReaderItemIDs)
+	}
+	q.Offset = max(q.Offset, 0)
+	return s.items.ListReaderStream(ctx, userID, q, withContent)
+}
+
+// ReaderItems returns the user's items among readerIDs with their bodies.
+// At most maxReaderItemContents are looked up.
+func (s *FeedService) ReaderItems(
+	ctx context.Context,
+	userID string,
+	readerIDs []int64,
+) ([]models.ReaderItem, error) {
+	if len(readerIDs) > maxReaderItemContents {
+		readerIDs = readerIDs[:maxReaderItemContents]
+	}
+	return s.items.ReaderItemsByIDs(ctx, userID, readerIDs)
+}
+
+// SetReaderItemState marks the user's items among readerIDs read or unread
+// and bookmarked or not, as a reader app's edit-tag asks; nil leaves that
+// state unchanged. The web reader sees the same state.
+func (s *FeedService) SetReaderItemState(
+	ctx context.Context,
+	userID string,
+	readerIDs []int64,
+	read, bookmarked *bool,
+) error {
+	if len(readerIDs) == 0 || (read == nil && bookmarked == nil) {
+		return nil
+	}
+	return s.items.SetReaderState(ctx, userID, readerIDs, read, bookmarked)
+}
+
+// MarkReaderStreamRead marks every unread item of the stream q selects
+// read, up to q.Until when it is set.
+func (s *FeedService) MarkReaderStreamRead(
+	ctx context.Context,
+	userID string,
+	q models.ReaderQuery,
+) error {
+	return s.items.MarkReaderStreamRead(ctx, userID, q)
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+)
+
+func mustCompileRule(t *testing.T, field, matchType, pattern string) *compiledRule {
+	t.Helper()
+	//nolint:exhaustruct // only the match is under test
+	c, err := compileRule(models.FilterRule{
+		Field:     field,
+		MatchType: matchType,
+		Pattern:   pattern,
+		Action:    models.FilterActionDismiss,
+	})
+	require.NoError(t, err)
+	return c
+}
+
+func TestCompiledRuleMatches(t *testing.T) {
+	//nolint:exhaustruct // only the matched fields are relevant here
+	item := &models.Item{
+		Title:       "Go 1.30 Released",
+		Author:      "The Go Team",
+		SourceURL:   "https://go.dev/blog/go1.30",
+		ContentHTML: `<p>Now with <b class="sponsor">iterators</b>.</p>`,
+	}
+	content := &itemText{html: item.ContentHTML, text: nil}
+
+	for _, tc := range []struct {
+		name                      string
+		field, matchType, pattern string
+		want                      bool
+	}{
+		{"keyword ignores case", "title", "keyword", "released", true},
+		{"keyword misses", "title", "keyword", "sponsored", false},
+		{"regex is case-sensitive", "title", "regex", `released`, false},
+		{"regex with flag", "title", "regex", `(?i)released`, true},
+		{"author", "author", "keyword", "go team", true},
+		{"url", "url", "regex", `^https://go\.dev/blog/`, true},
+		{"content text", "content", "keyword", "with iterators", true},
+		{"content skips markup", "content", "keyword", "sponsor", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := mustCompileRule(t, tc.field, tc.matchType, tc.pattern)
+			assert.Equal(t, tc.want, c.matches(item, content))
+		})
+	}
+}
+
+func TestCompileRuleRejectsInvalidRules(t *testing.T) {
+	//nolint:exhaustruct // filled per case below
+	valid := models.FilterRule{
+		Field:     models.FilterFieldTitle,
+		MatchType: models.FilterMatchKeyword,
+		Pattern:   "x",
+		Action:    models.FilterActionDismiss,
+	}
+	for name, mutate := range map[string]func(r *models.FilterRule){
+		"field":      func(r *models.FilterRule) { r.Field = "body" },
+		"match type": func(r *models.FilterRule) { r.MatchType = "glob" },
+		"action":     func(r *models.FilterRule) { r.Action = "delete" },
+		"pattern":    func(r *models.FilterRule) { r.Pattern = "" },
+		"regex": func(r *models.FilterRule) {
+			r.MatchType = models.FilterMatchRegex
+			r.Pattern = "(unclosed"
+		},
+		"tag": func(r *models.FilterRule) { r.Action = models.FilterActionTag },
+	} {
+		t.Run(name, func(t *testing.T) {
+			rule := valid
+			mutate(&rule)
+			_, err := compileRule(rule)
+			assert.ErrorIs(t, err, ErrInvalidRule)
+		})
+	}
+}
+
+func TestCompiledRuleApply(t *testing.T) {
+	now := time.Now()
+	//nolint:exhaustruct // only the acted-on fields are relevant here
+	item := &models.Item{Tags: []string{"release"}}
+	//nolint:exhaustruct // apply only reads the action and tag
+	for _, rule := range []models.FilterRule{
+		{Action: models.FilterActionDismiss},
+		{Action: models.FilterActionBookmark},
+		{Action: models.FilterActionMarkRead},
+		{Action: models.FilterActionTag, Tag: "release"},
+		{Action: models.FilterActionTag, Tag: "go"},
+	} {
+		(&compiledRule{rule: rule, re: nil, keyword: ""}).apply(item, now)
+	}
+	assert.True(t, item.Dismissed)
+	assert.True(t, item.Bookmarked)
+	require.NotNil(t, item.ReadAt)
+	assert.Equal(t, now, *item.ReadAt)
+	assert.Equal(t, []string{"release", "go"}, item.Tags)
+}
+
+func TestFilterRulesApply(t *testing.T) {
+	rules := filterRules{
+		mustCompileRule(t, "title", "keyword", "sponsored"),
+		mustCompileRule(t, "content", "keyword", "giveaway"),
+	}
+	//nolint:exhaustruct // only the matched fields are relevant here
+	sponsored := &models.Item{Title: "Sponsored: a new IDE"}
+	rules.apply(sponsored)
+	assert.True(t, sponsored.Dismissed)
+
+	//nolint:exhaustruct // only the matched fields are relevant here
+	plain := &models.Item{Title: "Go 1.30", ContentHTML: "<p>Release notes</p>"}
+	rules.apply(plain)
+	assert.False(t, plain.Dismissed)
+
+	//nolint:exhaustruct // nil rules leave an item untouched
+	unfiltered := &models.Item{Title: "Sponsored"}
+	filterRules(nil).apply(unfiltered)
+	assert.False(t, unfiltered.Dismissed)
+}
+
+func TestFeedItemAuthor(t *testing.T) {
+	//nolint:exhaustruct // only the authors are relevant here
+	assert.Equal(t, "Ann", feedItemAuthor(&gofeed.Item{
+		Author: &gofeed.Person{Name: " Ann ", Email: "ann@example.com"},
+	}))
+	//nolint:exhaustruct // only the authors are relevant here
+	assert.Equal(t, "bob@example.com", feedItemAuthor(&gofeed.Item{
+		Authors: []*gofeed.Person{{Name: "", Email: "bob@example.com"}},
+	}))
+	//nolint:exhaustruct // an item without a byline
+	assert.Empty(t, feedItemAuthor(&gofeed.Item{}))
+}
+
+func TestEmailAuthor(t *testing.T) {
+	assert.Equal(t, "Weekly News", emailAuthor(`"Weekly News" <news@example.com>`))
+	assert.Equal(t, "news@example.com", emailAuthor("news@example.com"))
+	assert.Equal(t, "not an address", emailAuthor(" not an address "))
+}
@@ -298,7 +298,17 @@ func (s *FeedService) CreateScrape(
 	ctx context.Context,
 	userID, rawURL string,
 ) (*models.Feed, error) {
-	canonical, err := canonicalURL(rawURL)
+	//nolint:exhaustruct // fetch state starts empty; ids are DB-owned
+	return s.createScrape(ctx, models.Feed{UserID: userID, URL: rawURL})
+}
+
+// createScrape is CreateScrape for a feed prepared by the caller, as
+// createRSS is for Create; an empty title is taken from the page.
+func (s *FeedService) createScrape(
+	ctx context.Context,
+	newFeed models.Feed,
+) (*models.Feed, error) {
+	canonical, err := canonicalURL(newFeed.URL)
 	if err != nil {
 		return nil, err
 	}
@@ -307,20 +317,19 @@ func (s *FeedService) CreateScrape(
 		ctx, canonical, fetchOptions(0, "text/html,application/xhtml+xml"),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrNoPostsFound, err)
+		return nil, fmt.Errorf("%w: %w", ErrFeedUnreachable, err)
 	}
 	links, err := discoverPostLinks(res.FinalURL, res.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	//nolint:exhaustruct // fetch state starts empty; ids are DB-owned
-	feed, err := s.feeds.Insert(ctx, models.Feed{
-		UserID:     userID,
-		URL:        canonical,
-		Title:      pageTitle(res.Body),
-		SourceType: models.FeedSourceScrape,
-	})
+	newFeed.URL = canonical
+	newFeed.SourceType = models.FeedSourceScrape
+	if newFeed.Title == "" {
+		newFeed.Title = pageTitle(res.Body)
+	}
+	feed, err := s.feeds.Insert(ctx, newFeed)
 	if err != nil {
 		return nil, err
 	}
@@ -403,6 +412,11 @@ func (s *FeedService) ingestDiscoveredLinks(
 		return 0
 	}
 
+	if len(newGUIDs) == 0 {
+		return 0
+	}
+	rules := s.loadFilterRules(ctx, feed)
+
 	ingested := 0
 	for i, guid := range newGUIDs {
 		if ctx.Err() != nil {
@@ -412,7 +426,7 @@ func (s *FeedService) ingestDiscoveredLinks(
 			s.markSeenError(ctx, feed.ID, guid, "skipped: over per-poll cap")
 			continue
 		}
-		if s.ingestDiscoveredLink(ctx, feed, byGUID[guid], guid) {
+		if s.ingestDiscoveredLink(ctx, feed, rules, byGUID[guid], guid) {
 			ingested++
 		}
 	}
@@ -430,6 +444,7 @@ func (s *FeedService) ingestDiscoveredLinks(
 func (s *FeedService) ingestDiscoveredLink(
 	ctx context.Context,
 	feed models.Feed,
+	rules filterRules,
 	link discoveredLink,
 	guid string,
 ) bool {
@@ -454,6 +469,7 @@ func (s *FeedService) ingestDiscoveredLink(
 		ContentHTML: body,
 		PublishedAt: publishedAt,
 	}
+	rules.apply(&item)
 	if err := s.items.Insert(ctx, item); err != nil {
 		s.logger.WarnContext(ctx, "scrape feed item store failed",
 			"feedID", feed.ID, "guid", guid, "error", err)
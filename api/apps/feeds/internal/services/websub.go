@@ -0,0 +1,307 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // WebSub hubs may sign pushes with HMAC-SHA1
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mmcdole/gofeed"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+)
+
+// ErrWebSubSignature is returned for a WebSub push whose X-Hub-Signature is
+// missing or doesn't match the subscription's secret.
+var ErrWebSubSignature = errors.New("websub push signature mismatch")
+
+// webSubLease is the lease asked of a hub; hubs may grant a different one,
+// and the one they grant is what gets recorded.
+const webSubLease = 10 * 24 * time.Hour
+
+// webSubRenewBefore renews a lease this long before it expires, leaving the
+// hourly poll-feeds job plenty of runs to get it renewed.
+const webSubRenewBefore = 24 * time.Hour
+
+// webSubRetryAfter is how long a subscribe request is left to be verified,
+// or a denied one left alone, before it is sent again.
+const webSubRetryAfter = 6 * time.Hour
+
+// webSubFallbackPoll is how often a feed with a verified subscription is
+// still polled, in case the hub drops a push.
+const webSubFallbackPoll = 24 * time.Hour
+
+// webSubMaxLease caps the lease a hub grants, so a bogus hub.lease_seconds
+// can neither keep a feed off the poll for long nor overflow a Duration.
+const webSubMaxLease = 2 * webSubLease
+
+// webSubSecretBytes is the number of random bytes in a subscription's HMAC
+// secret; hex-encoded it stays well under the spec's 200-byte limit.
+const webSubSecretBytes = 32
+
+// webSubLinks returns the hub and self URLs a feed body advertises with
+// <link rel="hub"> (Atom) or <atom:link rel="hub"> (RSS), "" when absent.
+// Only the feed's own header is scanned: links inside items and entries
+// belong to those.
+func webSubLinks(body []byte) (string, string) {
+	d := xml.NewDecoder(bytes.NewReader(body))
+	d.Strict = false
+	// Hub and self URLs are ASCII, so any declared charset reads as is.
+	d.CharsetReader = func(_ string, r io.Reader) (io.Reader, error) {
+		return r, nil
+	}
+
+	var hub, self string
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return hub, self
+		}
+		el, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch el.Name.Local {
+		case "item", "entry":
+			return hub, self
+		case "link":
+			var rel, href string
+			for _, attr := range el.Attr {
+				switch attr.Name.Local {
+				case "rel":
+					rel = attr.Value
+				case "href":
+					href = strings.TrimSpace(attr.Value)
+				}
+			}
+			for _, r := range strings.Fields(rel) {
+				if r == "hub" && hub == "" {
+					hub = href
+				}
+				if r == "self" && self == "" {
+					self = href
+				}
+			}
+		}
+	}
+}
+
+// webSubPushed reports whether feed is kept up to date by WebSub pushes at
+// now, so the poll-feeds job can leave it until its fallback poll is due.
+func webSubPushed(feed models.Feed, now time.Time) bool {
+	if feed.WebSubLeaseExpiresAt == nil || !feed.WebSubLeaseExpiresAt.After(now) {
+		return false
+	}
+	return feed.LastFetchedAt != nil &&
+		now.Sub(*feed.LastFetchedAt) < webSubFallbackPoll
+}
+
+// webSubDue reports whether feed needs a subscribe request sent to hub for
+// topic at now: it isn't subscribed there yet, or its lease is close to
+// expiring, and no recent request is still awaiting the hub.
+func webSubDue(feed models.Feed, hub, topic string, now time.Time) bool {
+	if feed.WebSubHub == nil || *feed.WebSubHub != hub ||
+		feed.WebSubTopic == nil || *feed.WebSubTopic != topic {
+		return true
+	}
+	if feed.WebSubRequestedAt != nil &&
+		now.Sub(*feed.WebSubRequestedAt) < webSubRetryAfter {
+		return false
+	}
+	return feed.WebSubLeaseExpiresAt == nil ||
+		feed.WebSubLeaseExpiresAt.Sub(now) < webSubRenewBefore
+}
+
+// syncWebSub subscribes feed to the WebSub hub its freshly fetched body
+// advertises, with the feed's self URL as the topic, and forgets the
+// subscription once the feed stops advertising one. Best-effort: failures
+// are logged and the feed simply keeps being polled.
+func (s *FeedService) syncWebSub(
+	ctx context.Context,
+	feed models.Feed,
+	body []byte,
+) {
+	hub, topic := webSubLinks(body)
+	if hub == "" {
+		if feed.WebSubHub == nil {
+			return
+		}
+		if err := s.feeds.ClearWebSub(ctx, feed.ID); err != nil {
+			s.logger.WarnContext(ctx, "websub clear failed",
+				"feedID", feed.ID, "error", err)
+		}
+		return
+	}
+	if topic == "" {
+		topic = feed.URL
+	}
+	s.subscribeWebSub(ctx, feed, hub, topic)
+}
+
+// renewWebSub renews feed's existing WebSub subscription when its lease is
+// close to expiring, for polls that didn't fetch a body to rediscover the
+// hub from.
+func (s *FeedService) renewWebSub(ctx context.Context, feed models.Feed) {
+	if feed.WebSubHub == nil || feed.WebSubTopic == nil {
+		return
+	}
+	s.subscribeWebSub(ctx, feed, *feed.WebSubHub, *feed.WebSubTopic)
+}
+
+// subscribeWebSub asks hub to push topic's updates to the feed's callback,
+// when webSubDue says it should. The request is recorded before it is sent:
+// hubs may verify it before they even answer.
+func (s *FeedService) subscribeWebSub(
+	ctx context.Context,
+	feed models.Feed,
+	hub, topic string,
+) {
+	if s.webSubCallbackURL == "" || !webSubDue(feed, hub, topic, time.Now()) {
+		return
+	}
+
+	var secret string
+	if feed.WebSubSecret != nil && feed.WebSubHub != nil && *feed.WebSubHub == hub {
+		secret = *feed.WebSubSecret
+	} else {
+		raw := make([]byte, webSubSecretBytes)
+		if _, err := rand.Read(raw); err != nil {
+			s.logger.WarnContext(ctx, "websub secret generation failed",
+				"feedID", feed.ID, "error", err)
+			return
+		}
+		secret = hex.EncodeToString(raw)
+	}
+
+	if err := s.feeds.SetWebSubRequested(ctx, feed.ID, hub, topic, secret); err != nil {
+		s.logger.WarnContext(ctx, "websub request record failed",
+			"feedID", feed.ID, "error", err)
+		return
+	}
+	form := url.Values{
+		"hub.mode":          {"subscribe"},
+		"hub.topic":         {topic},
+		"hub.callback":      {s.webSubCallbackURL + "/" + feed.ID.String()},
+		"hub.secret":        {secret},
+		"hub.lease_seconds": {strconv.Itoa(int(webSubLease.Seconds()))},
+	}
+	if err := s.webFetch.PostForm(ctx, hub, form); err != nil {
+		s.logger.WarnContext(ctx, "websub subscribe failed",
+			"feedID", feed.ID, "hub", hub, "error", err)
+	}
+}
+
+// VerifyWebSub answers a hub's verification request for feedID's
+// subscription, reporting whether to confirm it. A subscribe for the topic
+// the feed has a request pending for is confirmed and its lease, capped at
+// webSubMaxLease, recorded; unsolicited ones are refused. A denial is
+// acknowledged and the feed stays polled. Unsubscribes are never confirmed,
+// since a feed that still exists never asks for one.
+// Returns database.ErrResourceNotFound when the feed has no subscription.
+func (s *FeedService) VerifyWebSub(
+	ctx context.Context,
+	feedID uuid.UUID,
+	mode, topic string,
+	leaseSeconds int,
+) (bool, error) {
+	feed, err := s.feeds.GetByIDForWebSub(ctx, feedID)
+	if err != nil {
+		return false, err
+	}
+	if feed.WebSubTopic == nil || *feed.WebSubTopic != topic {
+		return false, nil
+	}
+
+	switch mode {
+	case "subscribe":
+		if feed.WebSubRequestedAt == nil {
+			return false, nil
+		}
+		lease := webSubLease
+		if leaseSeconds > 0 {
+			maxSeconds := int(webSubMaxLease / time.Second)
+			lease = time.Duration(min(leaseSeconds, maxSeconds)) * time.Second
+		}
+		err = s.feeds.SetWebSubVerified(ctx, feed.ID, time.Now().Add(lease))
+		return err == nil, err
+	case "denied":
+		return true, s.feeds.SetWebSubDenied(ctx, feed.ID)
+	default:
+		return false, nil
+	}
+}
+
+// IngestWebSubPush ingests the content a hub pushed for feedID's topic,
+// after checking signature (the X-Hub-Signature header) against the
+// subscription's secret. The body is parsed before returning, so a
+// malformed push is reported as ErrInvalidFeed, but its items are ingested
+// in the background like Create's initial import: hubs expect a prompt
+// answer, and fetching linked pages can take a while. A push doesn't count
+// as a fetch, so the fallback poll still runs on schedule.
+// Returns database.ErrResourceNotFound when the feed has no subscription.
+func (s *FeedService) IngestWebSubPush(
+	ctx context.Context,
+	feedID uuid.UUID,
+	signature string,
+	body []byte,
+) error {
+	feed, err := s.feeds.GetByIDForWebSub(ctx, feedID)
+	if err != nil {
+		return err
+	}
+	if feed.WebSubSecret == nil ||
+		!validWebSubSignature(*feed.WebSubSecret, signature, body) {
+		return ErrWebSubSignature
+	}
+	parsed, err := gofeed.NewParser().Parse(bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidFeed, err)
+	}
+
+	// ponytail: detached goroutine, as in createRSS — a restart mid-ingest
+	// drops the push, and the fallback poll picks its items up.
+	pushed := *feed
+	go s.processItems(context.WithoutCancel(ctx), pushed, parsed.Items)
+	return nil
+}
+
+// validWebSubSignature checks an X-Hub-Signature header, "<method>=<hex
+// HMAC of body>", against secret. The spec lets hubs pick any of sha1,
+// sha256, sha384 and sha512.
+func validWebSubSignature(secret, signature string, body []byte) bool {
+	method, sig, ok := strings.Cut(signature, "=")
+	if !ok {
+		return false
+	}
+	var newHash func() hash.Hash
+	switch method {
+	case "sha1":
+		newHash = sha1.New
+	case "sha256":
+		newHash = sha256.New
+	case "sha384":
+		newHash = sha512.New384
+	case "sha512":
+		newHash = sha512.New
+	default:
+		return false
+	}
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(strings.ToLower(sig)), []byte(expected))
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+)
+
+func TestWebSubLinks(t *testing.T) {
+	for _, tc := range []struct {
+		name, body, hub, self string
+	}{
+		{
+			name: "rss atom links",
+			body: `<?xml version="1.0" encoding="ISO-8859-1"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel>
+<link>https://example.com/</link>
+<atom:link rel="self" href="https://example.com/feed.xml"/>
+<atom:link rel="hub" href=" https://hub.example.com/ "/>
+<item><link>https://example.com/post</link></item>
+</channel></rss>`,
+			hub:  "https://hub.example.com/",
+			self: "https://example.com/feed.xml",
+		},
+		{
+			name: "atom",
+			body: `<feed xmlns="http://www.w3.org/2005/Atom">
+<link rel="alternate" href="https://example.com/"/>
+<link rel="hub" href="https://hub.example.com/"/>
+<link rel="self" href="https://example.com/atom"/>
+</feed>`,
+			hub:  "https://hub.example.com/",
+			self: "https://example.com/atom",
+		},
+		{
+			name: "entry links ignored",
+			body: `<feed xmlns="http://www.w3.org/2005/Atom">
+<entry><link rel="hub" href="https://hub.example.com/"/></entry>
+</feed>`,
+			hub:  "",
+			self: "",
+		},
+		{name: "not xml", body: "<html><p>hi", hub: "", self: ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			hub, self := webSubLinks([]byte(tc.body))
+			assert.Equal(t, tc.hub, hub)
+			assert.Equal(t, tc.self, self)
+		})
+	}
+}
+
+func TestWebSubDue(t *testing.T) {
+	now := time.Now()
+	hub, topic := "https://hub.example.com/", "https://example.com/feed.xml"
+	at := func(d time.Duration) *time.Time {
+		v := now.Add(d)
+		return &v
+	}
+	otherHub := "https://other.example.com/"
+
+	for _, tc := range []struct {
+		name      string
+		hub       *string
+		requested *time.Time
+		lease     *time.Time
+		want      bool
+	}{
+		{"never subscribed", nil, nil, nil, true},
+		{"hub changed", &otherHub, nil, at(5 * 24 * time.Hour), true},
+		{"awaiting verification", &hub, at(-time.Hour), nil, false},
+		{"verification never came", &hub, at(-7 * time.Hour), nil, true},
+		{"lease current", &hub, nil, at(5 * 24 * time.Hour), false},
+		{"lease nearly expired", &hub, nil, at(time.Hour), true},
+		{"renewal in flight", &hub, at(-time.Hour), at(time.Hour), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct // only the WebSub state is relevant here
+			feed := models.Feed{
+				WebSubHub:            tc.hub,
+				WebSubTopic:          &topic,
+				WebSubRequestedAt:    tc.requested,
+				WebSubLeaseExpiresAt: tc.lease,
+			}
+			assert.Equal(t, tc.want, webSubDue(feed, hub, topic, now))
+		})
+	}
+}
+
+func TestWebSubPushed(t *testing.T) {
+	now := time.Now()
+	lease := now.Add(24 * time.Hour)
+	expired := now.Add(-time.Minute)
+	recent := now.Add(-time.Hour)
+	stale := now.Add(-25 * time.Hour)
+
+	//nolint:exhaustruct // only the lease and last fetch are relevant here
+	feed := models.Feed{WebSubLeaseExpiresAt: &lease, LastFetchedAt: &recent}
+	assert.True(t, webSubPushed(feed, now))
+
+	feed.LastFetchedAt = &stale
+	assert.False(t, webSubPushed(feed, now), "fallback poll due")
+
+	feed.LastFetchedAt = &recent
+	feed.WebSubLeaseExpiresAt = &expired
+	assert.False(t, webSubPushed(feed, now), "lease expired")
+
+	feed.WebSubLeaseExpiresAt = nil
+	assert.False(t, webSubPushed(feed, now), "never verified")
+}
+
+func TestValidWebSubSignature(t *testing.T) {
+	body := []byte("<rss></rss>")
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(body)
+	sig := hex.EncodeToString(mac.Sum(nil))
+
+	assert.True(t, validWebSubSignature("secret", "sha256="+sig, body))
+	assert.False(t, validWebSubSignature("other", "sha256="+sig, body))
+	assert.False(t, validWebSubSignature("secret", "sha512="+sig, body))
+	assert.False(t, validWebSubSignature("secret", "md5="+sig, body))
+	assert.False(t, validWebSubSignature("secret", sig, body))
+	assert.False(t, validWebSubSignature("secret", "", body))
+}
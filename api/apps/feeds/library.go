@@ -0,0 +1,74 @@
+package feeds
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/feeds/internal/models"
+)
+
+// LibraryArticle is a feed item sent to the books library: its stored
+// article body, with the title, link and feed the entry is catalogued by.
+type LibraryArticle struct {
+	ItemID      uuid.UUID
+	FeedTitle   string
+	Title       string
+	SourceURL   string
+	ContentHTML string
+	PublishedAt time.Time
+}
+
+// Library is what sending items to the books library needs from the books
+// app. The composition root (cmd/api) adapts *books.Books to it, so the
+// feeds app never imports apps/books or writes its schema.
+type Library interface {
+	AddArticle(
+		ctx context.Context, userID string, article LibraryArticle,
+	) (uuid.UUID, error)
+	SetArticleProgress(
+		ctx context.Context, userID string, bookID uuid.UUID, percent int,
+	) error
+}
+
+// SetLibrary connects sending items to the library to the books app. Until
+// it is called, SendItemToLibrary fails.
+func (a *Feeds) SetLibrary(library Library) {
+	a.Services.Feeds.SetLibrary(libraryAdapter{library: library})
+}
+
+// SetItemProgress records reading progress made on a library entry sent
+// from userID's item as the item's read progress — how reading it in the
+// books app or on the e-reader shows up in feeds.
+func (a *Feeds) SetItemProgress(
+	ctx context.Context,
+	userID string,
+	itemID uuid.UUID,
+	percent int,
+) error {
+	return a.Services.Feeds.SetItemProgress(ctx, userID, itemID, percent)
+}
+
+// libraryAdapter adapts a Library to services.Library, whose article is the
+// internal model type.
+type libraryAdapter struct {
+	library Library
+}
+
+func (a libraryAdapter) AddArticle(
+	ctx context.Context,
+	userID string,
+	article models.LibraryArticle,
+) (uuid.UUID, error) {
+	return a.library.AddArticle(ctx, userID, LibraryArticle(article))
+}
+
+func (a libraryAdapter) SetArticleProgress(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	percent int,
+) error {
+	return a.library.SetArticleProgress(ctx, userID, bookID, percent)
+}
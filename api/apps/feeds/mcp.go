@@ -15,6 +15,7 @@ const mcpAppName = "feeds"
 
 type mcpListItemsArgs struct {
 	FeedID     string `json:"feed_id,omitempty"     jsonschema:"restrict to one feed"`
+	FolderID   string `json:"folder_id,omitempty"   jsonschema:"restrict to one folder"`
 	Limit      int32  `json:"limit,omitempty"       jsonschema:"page size, 0 for default"`
 	Offset     int32  `json:"offset,omitempty"      jsonschema:"page offset"`
 	UnreadOnly bool   `json:"unread_only,omitempty" jsonschema:"exclude read items"`
@@ -26,7 +27,8 @@ func (a *Feeds) RegisterMCPTools(srv *mcp.Server) {
 	h := &feedsConnectHandler{app: a}
 
 	mcptools.AddReadTool(srv, mcpAppName, "feeds_list_feeds",
-		"The user's RSS/Atom and email-newsletter feed subscriptions.",
+		"The user's RSS/Atom and email-newsletter feed subscriptions and "+
+			"the folders they are filed under, with unread counts.",
 		h.mcpListFeeds)
 	mcptools.AddReadTool(srv, mcpAppName, "feeds_list_items",
 		"Items ingested by any of the user's feeds. Article bodies are "+
@@ -59,6 +61,9 @@ func (h *feedsConnectHandler) mcpListItems(
 	if args.FeedID != "" {
 		req.FeedId = proto.String(args.FeedID)
 	}
+	if args.FolderID != "" {
+		req.FolderId = proto.String(args.FolderID)
+	}
 	return mcptools.Unwrap(h.ListFeedItems(ctx, connect.NewRequest(req)))
 }
 
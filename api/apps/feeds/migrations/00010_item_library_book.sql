@@ -0,0 +1,15 @@
+-- Items sent to the books library as articles: library_book_id is the books
+-- app's catalog id for the entry, so the reader can link to it and pass
+-- scroll progress on. It is a plain id, not a foreign key — feeds never
+-- references another app's schema — and may outlive the entry if it is
+-- removed from the library; sending the item again re-adds it.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE feeds.items ADD COLUMN library_book_id UUID;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE feeds.items DROP COLUMN library_book_id;
+-- +goose StatementEnd
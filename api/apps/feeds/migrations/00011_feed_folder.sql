@@ -0,0 +1,13 @@
+-- The folder a feed was filed under in an imported OPML file, kept so a
+-- later export puts it back in the same outline. Nested outlines are joined
+-- with " / "; empty for feeds outside any folder.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE feeds.feeds ADD COLUMN folder TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE feeds.feeds DROP COLUMN folder;
+-- +goose StatementEnd
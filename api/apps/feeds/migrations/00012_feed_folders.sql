@@ -0,0 +1,84 @@
+-- User-defined feed folders, nested at most one level (a subfolder's parent
+-- is always top-level; the service enforces it). position orders folders
+-- among their siblings. Deleting a folder deletes its subfolders and leaves
+-- their feeds unfiled.
+--
+-- The OPML folder labels imported so far (feeds.folder, "Parent / Child")
+-- become folders: the first segment a top-level folder, the rest its
+-- subfolder.
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS feeds.folders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id TEXT NOT NULL,
+    parent_id UUID REFERENCES feeds.folders (id) ON DELETE CASCADE,
+    name TEXT NOT NULL CHECK (name <> ''),
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE NULLS NOT DISTINCT (user_id, parent_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_folders_user_id ON feeds.folders (user_id);
+
+CREATE TRIGGER trg_folders_updated_at
+BEFORE UPDATE ON feeds.folders
+FOR EACH ROW EXECUTE FUNCTION feeds.set_updated_at();
+
+ALTER TABLE feeds.feeds
+ADD COLUMN folder_id UUID REFERENCES feeds.folders (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_feeds_folder_id ON feeds.feeds (folder_id);
+
+INSERT INTO feeds.folders (user_id, name)
+SELECT DISTINCT user_id, split_part(folder, ' / ', 1)
+FROM feeds.feeds
+WHERE split_part(folder, ' / ', 1) <> '';
+
+INSERT INTO feeds.folders (user_id, parent_id, name)
+SELECT DISTINCT f.user_id, p.id, substr(f.folder, length(p.name) + 4)
+FROM feeds.feeds f
+JOIN feeds.folders p
+    ON p.user_id = f.user_id AND p.parent_id IS NULL
+    AND p.name = split_part(f.folder, ' / ', 1)
+WHERE f.folder LIKE '% / %' AND substr(f.folder, length(p.name) + 4) <> '';
+
+UPDATE feeds.feeds f SET folder_id = (
+    SELECT COALESCE(c.id, p.id)
+    FROM feeds.folders p
+    LEFT JOIN feeds.folders c
+        ON c.parent_id = p.id AND f.folder LIKE '% / %'
+        AND c.name = substr(f.folder, length(p.name) + 4)
+    WHERE p.user_id = f.user_id AND p.parent_id IS NULL
+      AND p.name = split_part(f.folder, ' / ', 1)
+)
+WHERE f.folder <> '';
+
+UPDATE feeds.folders fo SET position = ranked.position
+FROM (
+    SELECT id, row_number() OVER (
+        PARTITION BY user_id, parent_id ORDER BY name
+    ) - 1 AS position
+    FROM feeds.folders
+) ranked
+WHERE fo.id = ranked.id;
+
+ALTER TABLE feeds.feeds DROP COLUMN folder;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE feeds.feeds ADD COLUMN folder TEXT NOT NULL DEFAULT '';
+
+UPDATE feeds.feeds f SET folder = (
+    SELECT CASE WHEN p.id IS NULL THEN fo.name ELSE p.name || ' / ' || fo.name END
+    FROM feeds.folders fo
+    LEFT JOIN feeds.folders p ON p.id = fo.parent_id
+    WHERE fo.id = f.folder_id
+)
+WHERE f.folder_id IS NOT NULL;
+
+ALTER TABLE feeds.feeds DROP COLUMN folder_id;
+DROP TABLE IF EXISTS feeds.folders;
+-- +goose StatementEnd
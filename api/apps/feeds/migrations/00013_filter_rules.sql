@@ -0,0 +1,48 @@
+-- Filter rules run on each item as it is ingested: when field (title,
+-- author, url or content) matches pattern — a case-insensitive keyword or
+-- a regular expression — the item is dismissed, bookmarked, marked read or
+-- tagged. A rule with a NULL feed_id applies to all of the user's feeds.
+-- Rules never touch items already ingested, so the category-pill cleanups
+-- of 00007/00008 stay one-off deletes.
+--
+-- Items gain the author the feed reports and the tags rules gave them.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE feeds.items
+ADD COLUMN author TEXT NOT NULL DEFAULT '',
+ADD COLUMN tags TEXT [] NOT NULL DEFAULT '{}';
+
+CREATE TABLE IF NOT EXISTS feeds.filter_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id TEXT NOT NULL,
+    feed_id UUID REFERENCES feeds.feeds (id) ON DELETE CASCADE,
+    field TEXT NOT NULL
+    CHECK (field IN ('title', 'author', 'url', 'content')),
+    match_type TEXT NOT NULL CHECK (match_type IN ('keyword', 'regex')),
+    pattern TEXT NOT NULL CHECK (pattern <> ''),
+    action TEXT NOT NULL
+    CHECK (action IN ('dismiss', 'bookmark', 'mark_read', 'tag')),
+    tag TEXT NOT NULL DEFAULT '',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (action <> 'tag' OR tag <> '')
+);
+
+CREATE INDEX IF NOT EXISTS idx_filter_rules_user_id
+ON feeds.filter_rules (user_id);
+
+CREATE TRIGGER trg_filter_rules_updated_at
+BEFORE UPDATE ON feeds.filter_rules
+FOR EACH ROW EXECUTE FUNCTION feeds.set_updated_at();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS feeds.filter_rules;
+
+ALTER TABLE feeds.items
+DROP COLUMN tags,
+DROP COLUMN author;
+-- +goose StatementEnd
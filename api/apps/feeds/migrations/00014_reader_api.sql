@@ -0,0 +1,40 @@
+-- Google Reader API compatibility, for native reader apps (Reeder,
+-- NetNewsWire, ReadKit) that don't speak ConnectRPC.
+--
+-- The protocol identifies items by 64-bit integers, so items gain reader_id:
+-- an identity column assigned in ingest order, which existing rows get
+-- filled in for here.
+--
+-- app_passwords holds the credentials those apps log in with (ClientLogin).
+-- Passwords are generated, shown once and stored only as their SHA-256;
+-- username is what the app sends alongside it and must match too.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE feeds.items
+ADD COLUMN reader_id BIGINT GENERATED ALWAYS AS IDENTITY;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_items_reader_id
+ON feeds.items (reader_id);
+
+CREATE TABLE IF NOT EXISTS feeds.app_passwords (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id TEXT NOT NULL,
+    username TEXT NOT NULL,
+    password_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_used_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_app_passwords_user_id
+ON feeds.app_passwords (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS feeds.app_passwords;
+
+DROP INDEX IF EXISTS feeds.idx_items_reader_id;
+
+ALTER TABLE feeds.items DROP COLUMN reader_id;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- WebSub (PubSubHubbub) push subscriptions for RSS feeds that advertise a
+-- hub. websub_hub/websub_topic are the hub and topic (the feed's self URL)
+-- last subscribed to and websub_secret the HMAC key the hub signs pushes
+-- with. websub_requested_at marks a subscribe request awaiting the hub's
+-- verification; websub_lease_expires_at is set once it is verified, and
+-- while it lies in the future the feed is only polled as a slow fallback.
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE feeds.feeds
+ADD COLUMN websub_hub TEXT,
+ADD COLUMN websub_topic TEXT,
+ADD COLUMN websub_secret TEXT,
+ADD COLUMN websub_requested_at TIMESTAMPTZ,
+ADD COLUMN websub_lease_expires_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE feeds.feeds
+DROP COLUMN websub_hub,
+DROP COLUMN websub_topic,
+DROP COLUMN websub_secret,
+DROP COLUMN websub_requested_at,
+DROP COLUMN websub_lease_expires_at;
+-- +goose StatementEnd
@@ -21,6 +21,10 @@ const (
 	requestTimeout = 30 * time.Second
 	maxRedirects   = 10
 
+	// postDrainBytes bounds how much of a PostForm response is read before
+	// it is discarded.
+	postDrainBytes = 64 << 10 // 64 KiB
+
 	// userAgent identifies us honestly to origin servers.
 	userAgent = "tools.xdoubleu.com feeds bot"
 )
@@ -46,12 +50,9 @@ func (c *client) Get(
 	rawURL string,
 	opts Options,
 ) (*Result, error) {
-	parsed, err := url.Parse(rawURL)
+	parsed, err := parseHTTPURL(rawURL)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrScheme, rawURL)
-	}
-	if parsed.Scheme != "http" && parsed.Scheme != "https" {
-		return nil, fmt.Errorf("%w: %s", ErrScheme, parsed.Scheme)
+		return nil, err
 	}
 
 	req, err := http.NewRequestWithContext(
@@ -109,6 +110,51 @@ func (c *client) Get(
 	return result, nil
 }
 
+func (c *client) PostForm(
+	ctx context.Context,
+	rawURL string,
+	form url.Values,
+) error {
+	parsed, err := parseHTTPURL(rawURL)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, parsed.String(), strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrNetwork, err)
+	}
+	defer resp.Body.Close()
+	// Drain a bounded amount so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, postDrainBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &StatusError{Code: resp.StatusCode}
+	}
+	return nil
+}
+
+// parseHTTPURL parses rawURL, rejecting anything but http and https.
+func parseHTTPURL(rawURL string) (*url.URL, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrScheme, rawURL)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("%w: %s", ErrScheme, parsed.Scheme)
+	}
+	return parsed, nil
+}
+
 // normalizeContentType strips parameters and lowercases the media type;
 // invalid headers degrade to the raw lowercased value's first segment.
 func normalizeContentType(header string) string {
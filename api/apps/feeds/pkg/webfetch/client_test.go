@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -137,3 +138,44 @@ func TestGet_SchemeRejected(t *testing.T) {
 		assert.ErrorIs(t, err, webfetch.ErrScheme, u)
 	}
 }
+
+func TestPostForm(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(
+				t, "application/x-www-form-urlencoded",
+				r.Header.Get("Content-Type"),
+			)
+			assert.NotEmpty(t, r.Header.Get("User-Agent"))
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "subscribe", r.PostForm.Get("hub.mode"))
+			w.WriteHeader(http.StatusAccepted)
+		},
+	))
+	t.Cleanup(ts.Close)
+
+	err := newClient().PostForm(
+		context.Background(), ts.URL, url.Values{"hub.mode": {"subscribe"}},
+	)
+	require.NoError(t, err)
+}
+
+func TestPostForm_ErrorStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		},
+	))
+	t.Cleanup(ts.Close)
+
+	err := newClient().PostForm(context.Background(), ts.URL, url.Values{})
+	var statusErr *webfetch.StatusError
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusBadRequest, statusErr.Code)
+
+	err = newClient().PostForm(
+		context.Background(), "ftp://example.com/x", url.Values{},
+	)
+	assert.ErrorIs(t, err, webfetch.ErrScheme)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 )
 
 var (
@@ -67,4 +68,8 @@ type Result struct {
 // Client fetches external URLs.
 type Client interface {
 	Get(ctx context.Context, rawURL string, opts Options) (*Result, error)
+	// PostForm submits form to rawURL as application/x-www-form-urlencoded,
+	// for the few external endpoints that take a request rather than serve
+	// content (a WebSub hub's subscribe). The response body is discarded.
+	PostForm(ctx context.Context, rawURL string, form url.Values) error
 }
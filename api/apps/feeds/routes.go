@@ -2,9 +2,21 @@ package feeds
 
 import (
 	"net/http"
+	"time"
 
 	"tools.xdoubleu.com/gen/feeds/v1/feedsv1connect"
 	iapp "tools.xdoubleu.com/internal/app"
+	"tools.xdoubleu.com/internal/communication/httptools"
+)
+
+// ImportOPML fetches every feed of the file while the request waits (see
+// FeedService.ImportOPML), well past the server's global 10s
+// httpWriteTimeout. Its context timeout stays under the edge proxy's 30s
+// response timeout and below the write deadline by a real margin, for the
+// same reasons as GetDeployLogs' (issue #672, cmd/api/routes.go).
+const (
+	importOPMLWriteDeadline = 60 * time.Second
+	importOPMLCtxTimeout    = 20 * time.Second
 )
 
 func (a *Feeds) Routes(prefix string, mux *http.ServeMux) {
@@ -16,6 +28,16 @@ func (a *Feeds) Routes(prefix string, mux *http.ServeMux) {
 		"POST "+feedsPath,
 		a.Services.Auth.AppAccess(prefix, feedsHandler.ServeHTTP),
 	)
+	// More specific than the prefix registration above, so net/http's mux
+	// routes this one procedure through the extended deadline instead.
+	mux.Handle(
+		"POST "+feedsv1connect.FeedServiceImportOPMLProcedure,
+		a.Services.Auth.AppAccess(prefix, httptools.WithExtendedDeadline(
+			importOPMLWriteDeadline, importOPMLCtxTimeout, feedsHandler.ServeHTTP,
+		)),
+	)
 
 	a.emailRoutes(prefix, mux)
+	a.greaderRoutes(prefix, mux)
+	a.webSubRoutes(prefix, mux)
 }
@@ -0,0 +1,93 @@
+package feeds
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"tools.xdoubleu.com/apps/feeds/internal/services"
+	"tools.xdoubleu.com/internal/database"
+)
+
+// webSubPath is where WebSub hubs reach the feeds app; each subscription's
+// callback appends its feed's id.
+const webSubPath = "/websub"
+
+// webSubMaxPushBytes bounds a pushed feed body, in line with what a poll
+// would fetch.
+const webSubMaxPushBytes = 5 << 20 // 5 MiB
+
+// webSubRoutes mounts the WebSub subscriber callback for RSS feeds whose
+// feed advertises a hub (FeedService.syncWebSub subscribes them). GET is the
+// hub verifying a subscribe request or announcing a denial, POST a content
+// push. AppAccess is NOT used: hubs prove themselves with the subscribed
+// topic on GET and the subscription's HMAC secret on POST.
+func (a *Feeds) webSubRoutes(prefix string, mux *http.ServeMux) {
+	path := "/" + prefix + webSubPath + "/{feedID}"
+	mux.HandleFunc("GET "+path, a.webSubVerifyHandler)
+	mux.HandleFunc("POST "+path, a.webSubPushHandler)
+}
+
+func (a *Feeds) webSubVerifyHandler(w http.ResponseWriter, r *http.Request) {
+	feedID, err := uuid.Parse(r.PathValue("feedID"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	q := r.URL.Query()
+	leaseSeconds, _ := strconv.Atoi(q.Get("hub.lease_seconds"))
+
+	ok, err := a.Services.Feeds.VerifyWebSub(
+		r.Context(), feedID, q.Get("hub.mode"), q.Get("hub.topic"), leaseSeconds,
+	)
+	if err != nil && !errors.Is(err, database.ErrResourceNotFound) {
+		a.Logger.ErrorContext(r.Context(), "websub: verification failed",
+			"feedID", feedID, "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	// A 404 tells the hub the subscription isn't wanted.
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = io.WriteString(w, q.Get("hub.challenge"))
+}
+
+func (a *Feeds) webSubPushHandler(w http.ResponseWriter, r *http.Request) {
+	feedID, err := uuid.Parse(r.PathValue("feedID"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, webSubMaxPushBytes))
+	if err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	err = a.Services.Feeds.IngestWebSubPush(
+		r.Context(), feedID, r.Header.Get("X-Hub-Signature"), body,
+	)
+	switch {
+	case errors.Is(err, database.ErrResourceNotFound):
+		http.NotFound(w, r)
+		return
+	case errors.Is(err, services.ErrWebSubSignature),
+		errors.Is(err, services.ErrInvalidFeed):
+		// The spec has subscribers ignore such pushes but still accept them,
+		// so a forger learns nothing from the response.
+		a.Logger.WarnContext(r.Context(), "websub: push ignored",
+			"feedID", feedID, "error", err)
+	case err != nil:
+		a.Logger.ErrorContext(r.Context(), "websub: push failed",
+			"feedID", feedID, "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
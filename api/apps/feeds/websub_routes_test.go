@@ -0,0 +1,276 @@
+package feeds_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	feedsv1 "tools.xdoubleu.com/gen/feeds/v1"
+	"tools.xdoubleu.com/gen/feeds/v1/feedsv1connect"
+	"tools.xdoubleu.com/internal/logging"
+)
+
+// webSubRSSXML is rssXML advertising hub, with self as the topic.
+func webSubRSSXML(feedTitle, hub, self string, items ...rssItem) string {
+	const atom = `<atom:link xmlns:atom="http://www.w3.org/2005/Atom" `
+	links := atom + `rel="hub" href="` + hub + `"/>` +
+		atom + `rel="self" href="` + self + `"/>`
+	return strings.Replace(
+		rssXML(feedTitle, items...), "<channel>", "<channel>"+links, 1,
+	)
+}
+
+// webSubFeed is an RSS feed subscribed to a hub: the subscribe request the
+// hub received, and the path its callback is served on.
+type webSubFeed struct {
+	id, url, topic, secret, callbackPath string
+}
+
+// createWebSubFeed creates an RSS feed, then refreshes it once its feed
+// advertises a hub so it subscribes, returning that subscribe request.
+func createWebSubFeed(
+	t *testing.T,
+	client feedsv1connect.FeedServiceClient,
+	hub string,
+) webSubFeed {
+	t.Helper()
+	base := uniqueBlogBase()
+	feedURL := base + "/feed.xml"
+	topic := base + "/self.xml"
+	seed := rssItem{"Seed", base + "/seed", "seed", itemContent}
+	mockWebFetch.SetBody(
+		feedURL, "application/rss+xml", []byte(rssXML("Push Blog", seed)),
+	)
+
+	created, err := client.CreateFeed(
+		context.Background(),
+		connect.NewRequest(&feedsv1.CreateFeedRequest{Url: feedURL}),
+	)
+	require.NoError(t, err)
+	feedID := created.Msg.Feed.Id
+	waitForFeedImport(t, client, feedID)
+
+	mockWebFetch.SetBody(feedURL, "application/rss+xml", []byte(webSubRSSXML(
+		"Push Blog", hub, topic, seed,
+	)))
+	_, err = client.RefreshFeed(
+		context.Background(),
+		connect.NewRequest(&feedsv1.RefreshFeedRequest{FeedId: feedID}),
+	)
+	require.NoError(t, err)
+
+	var form url.Values
+	for _, post := range slices.Backward(mockWebFetch.Posts) {
+		if post.URL == hub && strings.HasSuffix(post.Form.Get("hub.callback"), feedID) {
+			form = post.Form
+			break
+		}
+	}
+	require.NotNil(t, form, "feed should subscribe to its hub")
+	assert.Equal(t, "subscribe", form.Get("hub.mode"))
+	assert.Equal(t, topic, form.Get("hub.topic"))
+	assert.NotEmpty(t, form.Get("hub.lease_seconds"))
+	callback, err := url.Parse(form.Get("hub.callback"))
+	require.NoError(t, err)
+	return webSubFeed{
+		id:           feedID,
+		url:          feedURL,
+		topic:        topic,
+		secret:       form.Get("hub.secret"),
+		callbackPath: callback.Path,
+	}
+}
+
+func webSubVerify(
+	t *testing.T,
+	path string,
+	query url.Values,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path+"?"+query.Encode(), nil)
+	rec := httptest.NewRecorder()
+	getRoutes().ServeHTTP(rec, req)
+	return rec
+}
+
+func webSubPush(t *testing.T, path, signature, body string) int {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/rss+xml")
+	if signature != "" {
+		req.Header.Set("X-Hub-Signature", signature)
+	}
+	rec := httptest.NewRecorder()
+	getRoutes().ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func webSubSign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// waitForItem polls ListFeedItems until an item linking to sourceURL is
+// listed, or fails the test after a timeout.
+func waitForItem(
+	t *testing.T,
+	client feedsv1connect.FeedServiceClient,
+	sourceURL string,
+) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := client.ListFeedItems(
+			context.Background(), connect.NewRequest(&feedsv1.ListFeedItemsRequest{}),
+		)
+		require.NoError(t, err)
+		for _, item := range resp.Msg.Items {
+			if item.SourceUrl == sourceURL {
+				return
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("item %s was never ingested", sourceURL)
+}
+
+func TestWebSub_VerifyAndPush(t *testing.T) {
+	client := newFeedsClient(t)
+	hub := "https://hub.example.com/"
+	feed := createWebSubFeed(t, client, hub)
+
+	wrongTopic := webSubVerify(t, feed.callbackPath, url.Values{
+		"hub.mode":      {"subscribe"},
+		"hub.topic":     {feed.url},
+		"hub.challenge": {"abc"},
+	})
+	assert.Equal(t, http.StatusNotFound, wrongTopic.Code)
+
+	unsubscribe := webSubVerify(t, feed.callbackPath, url.Values{
+		"hub.mode":      {"unsubscribe"},
+		"hub.topic":     {feed.topic},
+		"hub.challenge": {"abc"},
+	})
+	assert.Equal(t, http.StatusNotFound, unsubscribe.Code)
+
+	verified := webSubVerify(t, feed.callbackPath, url.Values{
+		"hub.mode":          {"subscribe"},
+		"hub.topic":         {feed.topic},
+		"hub.challenge":     {"challenge-123"},
+		"hub.lease_seconds": {"86400"},
+	})
+	assert.Equal(t, http.StatusOK, verified.Code)
+	assert.Equal(t, "challenge-123", verified.Body.String())
+
+	base := strings.TrimSuffix(feed.url, "/feed.xml")
+	pushed := rssXML(
+		"Push Blog", rssItem{"Pushed", base + "/pushed", "pushed", itemContent},
+	)
+	assert.Equal(
+		t, http.StatusAccepted,
+		webSubPush(t, feed.callbackPath, webSubSign(feed.secret, pushed), pushed),
+	)
+	waitForItem(t, client, base+"/pushed")
+	seen := countSeenRows(t, feed.id)
+
+	forged := rssXML(
+		"Push Blog", rssItem{"Forged", base + "/forged", "forged", itemContent},
+	)
+	assert.Equal(
+		t, http.StatusAccepted,
+		webSubPush(t, feed.callbackPath, webSubSign("not-the-secret", forged), forged),
+	)
+	assert.Equal(t, http.StatusAccepted, webSubPush(t, feed.callbackPath, "", forged))
+	assert.Equal(t, seen, countSeenRows(t, feed.id), "forged pushes are ignored")
+}
+
+func TestWebSub_UnknownFeed(t *testing.T) {
+	path := "/feeds/websub/" + "00000000-0000-0000-0000-000000000000"
+	rec := webSubVerify(t, path, url.Values{
+		"hub.mode":      {"subscribe"},
+		"hub.topic":     {"https://example.com/feed.xml"},
+		"hub.challenge": {"abc"},
+	})
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, http.StatusNotFound, webSubPush(t, path, "sha256=00", "<rss/>"))
+	assert.Equal(t, http.StatusNotFound, webSubPush(t, "/feeds/websub/nope", "", ""))
+}
+
+func TestWebSub_PollAllFallsBackForPushedFeeds(t *testing.T) {
+	client := newFeedsClient(t)
+	hub := "https://hub-poll.example.com/"
+	feed := createWebSubFeed(t, client, hub)
+
+	verified := webSubVerify(t, feed.callbackPath, url.Values{
+		"hub.mode":          {"subscribe"},
+		"hub.topic":         {feed.topic},
+		"hub.challenge":     {"abc"},
+		"hub.lease_seconds": {"864000"},
+	})
+	require.Equal(t, http.StatusOK, verified.Code)
+
+	calls := len(mockWebFetch.Calls)
+	posts := len(mockWebFetch.Posts)
+	require.NoError(t, testApp.Services.Feeds.PollAll(
+		context.Background(), logging.NewNopLogger(), nil,
+	))
+	assert.NotContains(t, mockWebFetch.Calls[calls:], feed.url,
+		"a pushed feed waits for its fallback poll")
+	for _, post := range mockWebFetch.Posts[posts:] {
+		assert.NotEqual(t, hub, post.URL, "a fresh lease is not renewed")
+	}
+
+	denied := webSubVerify(t, feed.callbackPath, url.Values{
+		"hub.mode":   {"denied"},
+		"hub.topic":  {feed.topic},
+		"hub.reason": {"unsubscribed by publisher"},
+	})
+	require.Equal(t, http.StatusOK, denied.Code)
+
+	calls = len(mockWebFetch.Calls)
+	require.NoError(t, testApp.Services.Feeds.PollAll(
+		context.Background(), logging.NewNopLogger(), nil,
+	))
+	assert.Contains(t, mockWebFetch.Calls[calls:], feed.url,
+		"a denied subscription goes back to hourly polling")
+}
+
+func TestWebSub_UnsolicitedVerifyRefused(t *testing.T) {
+	client := newFeedsClient(t)
+	feed := createWebSubFeed(t, client, "https://hub-unsolicited.example.com/")
+
+	verify := url.Values{
+		"hub.mode":          {"subscribe"},
+		"hub.topic":         {feed.topic},
+		"hub.challenge":     {"abc"},
+		"hub.lease_seconds": {"9223372036"},
+	}
+	require.Equal(t, http.StatusOK, webSubVerify(t, feed.callbackPath, verify).Code)
+
+	var lease time.Time
+	err := testDB.QueryRow(
+		context.Background(),
+		"SELECT websub_lease_expires_at FROM feeds.feeds WHERE id = $1",
+		feed.id,
+	).Scan(&lease)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(20*24*time.Hour), lease, time.Minute,
+		"an oversized lease is capped")
+
+	again := webSubVerify(t, feed.callbackPath, verify)
+	assert.Equal(t, http.StatusNotFound, again.Code,
+		"a verify with no request pending is refused")
+}
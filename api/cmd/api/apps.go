@@ -4,7 +4,9 @@ import (
 	"context"
 	"log/slog"
 	"net/http"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
@@ -68,6 +70,9 @@ func NewApps(
 	apps.addApp(booksApp)
 	feedsApp := feeds.New(authService, logger, cfg, db, notifications, appUsersRepo)
 	apps.addApp(feedsApp)
+	booksApp.SetFeedDigestSource(feedsDigestAdapter{feeds: feedsApp})
+	feedsApp.SetLibrary(booksLibraryAdapter{books: booksApp})
+	booksApp.SetFeedProgressSink(feedsApp)
 	gamesApp := games.New(authService, logger, cfg, db)
 	apps.addApp(gamesApp)
 	apps.addApp(watchparty.New(authService, logger, cfg))
@@ -82,6 +87,78 @@ func NewApps(
 	return &apps, booksApp, feedsApp
 }
 
+// feedsDigestAdapter adapts *feeds.Feeds to books.FeedDigestSource, so the
+// books app's daily feeds digest never imports apps/feeds —
+// feeds.DigestItem and books.FeedDigestItem are structurally identical but
+// distinct types, bridged here like feedsHealthAdapter in main.go.
+type feedsDigestAdapter struct {
+	feeds *feeds.Feeds
+}
+
+func (a feedsDigestAdapter) ListDigestCandidates(
+	ctx context.Context,
+	since time.Time,
+) ([]books.FeedDigestItem, error) {
+	items, err := a.feeds.ListDigestCandidates(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]books.FeedDigestItem, len(items))
+	for i, item := range items {
+		out[i] = books.FeedDigestItem{
+			ID:          item.ID,
+			UserID:      item.UserID,
+			FeedID:      item.FeedID,
+			FeedTitle:   item.FeedTitle,
+			Title:       item.Title,
+			SourceURL:   item.SourceURL,
+			PublishedAt: item.PublishedAt,
+		}
+	}
+	return out, nil
+}
+
+func (a feedsDigestAdapter) ItemContent(
+	ctx context.Context,
+	userID string,
+	itemIDs []uuid.UUID,
+) (map[uuid.UUID]string, error) {
+	return a.feeds.ItemContent(ctx, userID, itemIDs)
+}
+
+func (a feedsDigestAdapter) MarkItemsRead(
+	ctx context.Context,
+	userID string,
+	itemIDs []uuid.UUID,
+) error {
+	return a.feeds.MarkItemsRead(ctx, userID, itemIDs)
+}
+
+// booksLibraryAdapter adapts *books.Books to feeds.Library, so sending feed
+// items to the library never imports apps/books from feeds — the article
+// types are bridged here like feedsDigestAdapter's items.
+type booksLibraryAdapter struct {
+	books *books.Books
+}
+
+func (a booksLibraryAdapter) AddArticle(
+	ctx context.Context,
+	userID string,
+	article feeds.LibraryArticle,
+) (uuid.UUID, error) {
+	return a.books.AddFeedArticle(ctx, userID, books.FeedArticle(article))
+}
+
+func (a booksLibraryAdapter) SetArticleProgress(
+	ctx context.Context,
+	userID string,
+	bookID uuid.UUID,
+	percent int,
+) error {
+	return a.books.SetFeedArticleProgress(ctx, userID, bookID, percent)
+}
+
 func (apps *Apps) ApplyMigrations(ctx context.Context, db *pgxpool.Pool) error {
 	for _, app := range *apps {
 		err := app.ApplyMigrations(ctx, db)
@@ -247,6 +247,9 @@ func protoStorageSnapshot(s *models.StorageSnapshot) *observabilityv1.StorageSna
 		StaleUploadSizeBytes: s.StaleUploadSizeBytes,
 		StaleUploadCount:     s.StaleUploadCount,
 		PrefixBreakdown:      breakdown,
+		DedupSavedBytes:      s.DedupSavedBytes,
+		ReclaimedSizeBytes:   s.ReclaimedSizeBytes,
+		ReclaimedCount:       s.ReclaimedCount,
 	}
 }
 
@@ -160,12 +160,13 @@ func TestRunStorageScanNow_Success(t *testing.T) {
 		testApp.config,
 		testApp.db,
 		books.Clients{
-			UniCat:           nil,
-			Hardcover:        nil,
-			ObjectStore:      objectstore.NewFake(),
-			WebFetch:         nil,
-			KoboStoreBaseURL: "",
-			PublicAPIBaseURL: "",
+			UniCat:                 nil,
+			Hardcover:              nil,
+			ObjectStore:            objectstore.NewFake(),
+			WebFetch:               nil,
+			KoboStoreBaseURL:       "",
+			KoboReadingServicesURL: "",
+			PublicAPIBaseURL:       "",
 		},
 	)
 
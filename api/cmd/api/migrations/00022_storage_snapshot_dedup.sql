@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The books storage scan now reports the space shared content-addressed
+-- blobs save and what its garbage collection deleted
+-- (apps/books/internal/jobs/storage_scan.go).
+ALTER TABLE global.storage_snapshots
+    ADD COLUMN dedup_saved_bytes BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN reclaimed_size_bytes BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN reclaimed_count BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE global.storage_snapshots
+    DROP COLUMN dedup_saved_bytes,
+    DROP COLUMN reclaimed_size_bytes,
+    DROP COLUMN reclaimed_count;
+-- +goose StatementEnd
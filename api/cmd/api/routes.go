@@ -15,6 +15,7 @@ import (
 	dashboardv1connect "tools.xdoubleu.com/gen/dashboard/v1/dashboardv1connect"
 	"tools.xdoubleu.com/gen/observability/v1/observabilityv1connect"
 	iapp "tools.xdoubleu.com/internal/app"
+	"tools.xdoubleu.com/internal/communication/httptools"
 	"tools.xdoubleu.com/internal/constants"
 	"tools.xdoubleu.com/internal/middleware"
 	"tools.xdoubleu.com/internal/oauth2as"
@@ -53,29 +54,6 @@ const (
 	deployLogsCtxTimeout    = 20 * time.Second
 )
 
-// withExtendedDeadline raises the response write deadline (net/http's
-// ResponseController) and gives the request context its own, shorter
-// timeout, for one slow route — without touching the server-wide
-// httpWriteTimeout that every other (fast) route relies on.
-func withExtendedDeadline(
-	writeDeadline, ctxTimeout time.Duration, next http.HandlerFunc,
-) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		if err := http.NewResponseController(w).SetWriteDeadline(
-			time.Now().Add(writeDeadline),
-		); err != nil {
-			// Not all ResponseWriters support deadlines (e.g. in tests using
-			// httptest.ResponseRecorder) — fall back to the ambient deadline.
-			next.ServeHTTP(w, r)
-			return
-		}
-
-		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeout)
-		defer cancel()
-		next.ServeHTTP(w, r.WithContext(ctx))
-	}
-}
-
 //nolint:funlen //route registration: a long list, not complex logic
 func (app *Application) Routes() http.Handler {
 	mux := http.NewServeMux()
@@ -106,7 +84,7 @@ func (app *Application) Routes() http.Handler {
 	mux.Handle(
 		"POST "+observabilityv1connect.ObservabilityServiceGetDeployLogsProcedure,
 		app.auth.Access(
-			withExtendedDeadline(
+			httptools.WithExtendedDeadline(
 				deployLogsWriteDeadline, deployLogsCtxTimeout, obsHandler.ServeHTTP,
 			),
 		),
@@ -36,6 +36,9 @@ const (
 	// CatalogServiceImportBooksProcedure is the fully-qualified name of the CatalogService's
 	// ImportBooks RPC.
 	CatalogServiceImportBooksProcedure = "/books.v1.CatalogService/ImportBooks"
+	// CatalogServiceExportLibraryProcedure is the fully-qualified name of the CatalogService's
+	// ExportLibrary RPC.
+	CatalogServiceExportLibraryProcedure = "/books.v1.CatalogService/ExportLibrary"
 	// CatalogServiceFindDuplicatesProcedure is the fully-qualified name of the CatalogService's
 	// FindDuplicates RPC.
 	CatalogServiceFindDuplicatesProcedure = "/books.v1.CatalogService/FindDuplicates"
@@ -77,6 +80,7 @@ const (
 // CatalogServiceClient is a client for the books.v1.CatalogService service.
 type CatalogServiceClient interface {
 	ImportBooks(context.Context, *connect.Request[v1.ImportBooksRequest]) (*connect.Response[v1.ImportBooksResponse], error)
+	ExportLibrary(context.Context, *connect.Request[v1.ExportLibraryRequest]) (*connect.Response[v1.ExportLibraryResponse], error)
 	FindDuplicates(context.Context, *connect.Request[v1.FindDuplicatesRequest]) (*connect.Response[v1.FindDuplicatesResponse], error)
 	MergeBooks(context.Context, *connect.Request[v1.MergeBooksRequest]) (*connect.Response[v1.MergeBooksResponse], error)
 	StartResync(context.Context, *connect.Request[v1.StartResyncRequest]) (*connect.Response[v1.StartResyncResponse], error)
@@ -108,6 +112,12 @@ func NewCatalogServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(catalogServiceMethods.ByName("ImportBooks")),
 			connect.WithClientOptions(opts...),
 		),
+		exportLibrary: connect.NewClient[v1.ExportLibraryRequest, v1.ExportLibraryResponse](
+			httpClient,
+			baseURL+CatalogServiceExportLibraryProcedure,
+			connect.WithSchema(catalogServiceMethods.ByName("ExportLibrary")),
+			connect.WithClientOptions(opts...),
+		),
 		findDuplicates: connect.NewClient[v1.FindDuplicatesRequest, v1.FindDuplicatesResponse](
 			httpClient,
 			baseURL+CatalogServiceFindDuplicatesProcedure,
@@ -186,6 +196,7 @@ func NewCatalogServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 // catalogServiceClient implements CatalogServiceClient.
 type catalogServiceClient struct {
 	importBooks             *connect.Client[v1.ImportBooksRequest, v1.ImportBooksResponse]
+	exportLibrary           *connect.Client[v1.ExportLibraryRequest, v1.ExportLibraryResponse]
 	findDuplicates          *connect.Client[v1.FindDuplicatesRequest, v1.FindDuplicatesResponse]
 	mergeBooks              *connect.Client[v1.MergeBooksRequest, v1.MergeBooksResponse]
 	startResync             *connect.Client[v1.StartResyncRequest, v1.StartResyncResponse]
@@ -205,6 +216,11 @@ func (c *catalogServiceClient) ImportBooks(ctx context.Context, req *connect.Req
 	return c.importBooks.CallUnary(ctx, req)
 }
 
+// ExportLibrary calls books.v1.CatalogService.ExportLibrary.
+func (c *catalogServiceClient) ExportLibrary(ctx context.Context, req *connect.Request[v1.ExportLibraryRequest]) (*connect.Response[v1.ExportLibraryResponse], error) {
+	return c.exportLibrary.CallUnary(ctx, req)
+}
+
 // FindDuplicates calls books.v1.CatalogService.FindDuplicates.
 func (c *catalogServiceClient) FindDuplicates(ctx context.Context, req *connect.Request[v1.FindDuplicatesRequest]) (*connect.Response[v1.FindDuplicatesResponse], error) {
 	return c.findDuplicates.CallUnary(ctx, req)
@@ -268,6 +284,7 @@ func (c *catalogServiceClient) ListBooksInExactSources(ctx context.Context, req
 // CatalogServiceHandler is an implementation of the books.v1.CatalogService service.
 type CatalogServiceHandler interface {
 	ImportBooks(context.Context, *connect.Request[v1.ImportBooksRequest]) (*connect.Response[v1.ImportBooksResponse], error)
+	ExportLibrary(context.Context, *connect.Request[v1.ExportLibraryRequest]) (*connect.Response[v1.ExportLibraryResponse], error)
 	FindDuplicates(context.Context, *connect.Request[v1.FindDuplicatesRequest]) (*connect.Response[v1.FindDuplicatesResponse], error)
 	MergeBooks(context.Context, *connect.Request[v1.MergeBooksRequest]) (*connect.Response[v1.MergeBooksResponse], error)
 	StartResync(context.Context, *connect.Request[v1.StartResyncRequest]) (*connect.Response[v1.StartResyncResponse], error)
@@ -295,6 +312,12 @@ func NewCatalogServiceHandler(svc CatalogServiceHandler, opts ...connect.Handler
 		connect.WithSchema(catalogServiceMethods.ByName("ImportBooks")),
 		connect.WithHandlerOptions(opts...),
 	)
+	catalogServiceExportLibraryHandler := connect.NewUnaryHandler(
+		CatalogServiceExportLibraryProcedure,
+		svc.ExportLibrary,
+		connect.WithSchema(catalogServiceMethods.ByName("ExportLibrary")),
+		connect.WithHandlerOptions(opts...),
+	)
 	catalogServiceFindDuplicatesHandler := connect.NewUnaryHandler(
 		CatalogServiceFindDuplicatesProcedure,
 		svc.FindDuplicates,
@@ -371,6 +394,8 @@ func NewCatalogServiceHandler(svc CatalogServiceHandler, opts ...connect.Handler
 		switch r.URL.Path {
 		case CatalogServiceImportBooksProcedure:
 			catalogServiceImportBooksHandler.ServeHTTP(w, r)
+		case CatalogServiceExportLibraryProcedure:
+			catalogServiceExportLibraryHandler.ServeHTTP(w, r)
 		case CatalogServiceFindDuplicatesProcedure:
 			catalogServiceFindDuplicatesHandler.ServeHTTP(w, r)
 		case CatalogServiceMergeBooksProcedure:
@@ -408,6 +433,10 @@ func (UnimplementedCatalogServiceHandler) ImportBooks(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.CatalogService.ImportBooks is not implemented"))
 }
 
+func (UnimplementedCatalogServiceHandler) ExportLibrary(context.Context, *connect.Request[v1.ExportLibraryRequest]) (*connect.Response[v1.ExportLibraryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.CatalogService.ExportLibrary is not implemented"))
+}
+
 func (UnimplementedCatalogServiceHandler) FindDuplicates(context.Context, *connect.Request[v1.FindDuplicatesRequest]) (*connect.Response[v1.FindDuplicatesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.CatalogService.FindDuplicates is not implemented"))
 }
@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: books/v1/goals.proto
+
+package booksv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "tools.xdoubleu.com/gen/books/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// GoalsServiceName is the fully-qualified name of the GoalsService service.
+	GoalsServiceName = "books.v1.GoalsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// GoalsServiceSetReadingGoalProcedure is the fully-qualified name of the GoalsService's
+	// SetReadingGoal RPC.
+	GoalsServiceSetReadingGoalProcedure = "/books.v1.GoalsService/SetReadingGoal"
+	// GoalsServiceDeleteReadingGoalProcedure is the fully-qualified name of the GoalsService's
+	// DeleteReadingGoal RPC.
+	GoalsServiceDeleteReadingGoalProcedure = "/books.v1.GoalsService/DeleteReadingGoal"
+	// GoalsServiceGetReadingGoalsProcedure is the fully-qualified name of the GoalsService's
+	// GetReadingGoals RPC.
+	GoalsServiceGetReadingGoalsProcedure = "/books.v1.GoalsService/GetReadingGoals"
+)
+
+// GoalsServiceClient is a client for the books.v1.GoalsService service.
+type GoalsServiceClient interface {
+	SetReadingGoal(context.Context, *connect.Request[v1.SetReadingGoalRequest]) (*connect.Response[v1.SetReadingGoalResponse], error)
+	DeleteReadingGoal(context.Context, *connect.Request[v1.DeleteReadingGoalRequest]) (*connect.Response[v1.DeleteReadingGoalResponse], error)
+	GetReadingGoals(context.Context, *connect.Request[v1.GetReadingGoalsRequest]) (*connect.Response[v1.GetReadingGoalsResponse], error)
+}
+
+// NewGoalsServiceClient constructs a client for the books.v1.GoalsService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGoalsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) GoalsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	goalsServiceMethods := v1.File_books_v1_goals_proto.Services().ByName("GoalsService").Methods()
+	return &goalsServiceClient{
+		setReadingGoal: connect.NewClient[v1.SetReadingGoalRequest, v1.SetReadingGoalResponse](
+			httpClient,
+			baseURL+GoalsServiceSetReadingGoalProcedure,
+			connect.WithSchema(goalsServiceMethods.ByName("SetReadingGoal")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteReadingGoal: connect.NewClient[v1.DeleteReadingGoalRequest, v1.DeleteReadingGoalResponse](
+			httpClient,
+			baseURL+GoalsServiceDeleteReadingGoalProcedure,
+			connect.WithSchema(goalsServiceMethods.ByName("DeleteReadingGoal")),
+			connect.WithClientOptions(opts...),
+		),
+		getReadingGoals: connect.NewClient[v1.GetReadingGoalsRequest, v1.GetReadingGoalsResponse](
+			httpClient,
+			baseURL+GoalsServiceGetReadingGoalsProcedure,
+			connect.WithSchema(goalsServiceMethods.ByName("GetReadingGoals")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// goalsServiceClient implements GoalsServiceClient.
+type goalsServiceClient struct {
+	setReadingGoal    *connect.Client[v1.SetReadingGoalRequest, v1.SetReadingGoalResponse]
+	deleteReadingGoal *connect.Client[v1.DeleteReadingGoalRequest, v1.DeleteReadingGoalResponse]
+	getReadingGoals   *connect.Client[v1.GetReadingGoalsRequest, v1.GetReadingGoalsResponse]
+}
+
+// SetReadingGoal calls books.v1.GoalsService.SetReadingGoal.
+func (c *goalsServiceClient) SetReadingGoal(ctx context.Context, req *connect.Request[v1.SetReadingGoalRequest]) (*connect.Response[v1.SetReadingGoalResponse], error) {
+	return c.setReadingGoal.CallUnary(ctx, req)
+}
+
+// DeleteReadingGoal calls books.v1.GoalsService.DeleteReadingGoal.
+func (c *goalsServiceClient) DeleteReadingGoal(ctx context.Context, req *connect.Request[v1.DeleteReadingGoalRequest]) (*connect.Response[v1.DeleteReadingGoalResponse], error) {
+	return c.deleteReadingGoal.CallUnary(ctx, req)
+}
+
+// GetReadingGoals calls books.v1.GoalsService.GetReadingGoals.
+func (c *goalsServiceClient) GetReadingGoals(ctx context.Context, req *connect.Request[v1.GetReadingGoalsRequest]) (*connect.Response[v1.GetReadingGoalsResponse], error) {
+	return c.getReadingGoals.CallUnary(ctx, req)
+}
+
+// GoalsServiceHandler is an implementation of the books.v1.GoalsService service.
+type GoalsServiceHandler interface {
+	SetReadingGoal(context.Context, *connect.Request[v1.SetReadingGoalRequest]) (*connect.Response[v1.SetReadingGoalResponse], error)
+	DeleteReadingGoal(context.Context, *connect.Request[v1.DeleteReadingGoalRequest]) (*connect.Response[v1.DeleteReadingGoalResponse], error)
+	GetReadingGoals(context.Context, *connect.Request[v1.GetReadingGoalsRequest]) (*connect.Response[v1.GetReadingGoalsResponse], error)
+}
+
+// NewGoalsServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGoalsServiceHandler(svc GoalsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	goalsServiceMethods := v1.File_books_v1_goals_proto.Services().ByName("GoalsService").Methods()
+	goalsServiceSetReadingGoalHandler := connect.NewUnaryHandler(
+		GoalsServiceSetReadingGoalProcedure,
+		svc.SetReadingGoal,
+		connect.WithSchema(goalsServiceMethods.ByName("SetReadingGoal")),
+		connect.WithHandlerOptions(opts...),
+	)
+	goalsServiceDeleteReadingGoalHandler := connect.NewUnaryHandler(
+		GoalsServiceDeleteReadingGoalProcedure,
+		svc.DeleteReadingGoal,
+		connect.WithSchema(goalsServiceMethods.ByName("DeleteReadingGoal")),
+		connect.WithHandlerOptions(opts...),
+	)
+	goalsServiceGetReadingGoalsHandler := connect.NewUnaryHandler(
+		GoalsServiceGetReadingGoalsProcedure,
+		svc.GetReadingGoals,
+		connect.WithSchema(goalsServiceMethods.ByName("GetReadingGoals")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/books.v1.GoalsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case GoalsServiceSetReadingGoalProcedure:
+			goalsServiceSetReadingGoalHandler.ServeHTTP(w, r)
+		case GoalsServiceDeleteReadingGoalProcedure:
+			goalsServiceDeleteReadingGoalHandler.ServeHTTP(w, r)
+		case GoalsServiceGetReadingGoalsProcedure:
+			goalsServiceGetReadingGoalsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedGoalsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedGoalsServiceHandler struct{}
+
+func (UnimplementedGoalsServiceHandler) SetReadingGoal(context.Context, *connect.Request[v1.SetReadingGoalRequest]) (*connect.Response[v1.SetReadingGoalResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.GoalsService.SetReadingGoal is not implemented"))
+}
+
+func (UnimplementedGoalsServiceHandler) DeleteReadingGoal(context.Context, *connect.Request[v1.DeleteReadingGoalRequest]) (*connect.Response[v1.DeleteReadingGoalResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.GoalsService.DeleteReadingGoal is not implemented"))
+}
+
+func (UnimplementedGoalsServiceHandler) GetReadingGoals(context.Context, *connect.Request[v1.GetReadingGoalsRequest]) (*connect.Response[v1.GetReadingGoalsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.GoalsService.GetReadingGoals is not implemented"))
+}
@@ -45,6 +45,9 @@ const (
 	// KoboServiceDisconnectKoboDeviceProcedure is the fully-qualified name of the KoboService's
 	// DisconnectKoboDevice RPC.
 	KoboServiceDisconnectKoboDeviceProcedure = "/books.v1.KoboService/DisconnectKoboDevice"
+	// KoboServiceSetKoboDeviceFilterProcedure is the fully-qualified name of the KoboService's
+	// SetKoboDeviceFilter RPC.
+	KoboServiceSetKoboDeviceFilterProcedure = "/books.v1.KoboService/SetKoboDeviceFilter"
 	// KoboServiceSetKoboDeviceLoggingProcedure is the fully-qualified name of the KoboService's
 	// SetKoboDeviceLogging RPC.
 	KoboServiceSetKoboDeviceLoggingProcedure = "/books.v1.KoboService/SetKoboDeviceLogging"
@@ -62,6 +65,7 @@ type KoboServiceClient interface {
 	RegisterKoboDevice(context.Context, *connect.Request[v1.RegisterKoboDeviceRequest]) (*connect.Response[v1.RegisterKoboDeviceResponse], error)
 	ListKoboDevices(context.Context, *connect.Request[v1.ListKoboDevicesRequest]) (*connect.Response[v1.ListKoboDevicesResponse], error)
 	DisconnectKoboDevice(context.Context, *connect.Request[v1.DisconnectKoboDeviceRequest]) (*connect.Response[v1.DisconnectKoboDeviceResponse], error)
+	SetKoboDeviceFilter(context.Context, *connect.Request[v1.SetKoboDeviceFilterRequest]) (*connect.Response[v1.SetKoboDeviceFilterResponse], error)
 	SetKoboDeviceLogging(context.Context, *connect.Request[v1.SetKoboDeviceLoggingRequest]) (*connect.Response[v1.SetKoboDeviceLoggingResponse], error)
 	GetKoboDeviceLogs(context.Context, *connect.Request[v1.GetKoboDeviceLogsRequest]) (*connect.Response[v1.GetKoboDeviceLogsResponse], error)
 	ClearKoboDeviceLogs(context.Context, *connect.Request[v1.ClearKoboDeviceLogsRequest]) (*connect.Response[v1.ClearKoboDeviceLogsResponse], error)
@@ -102,6 +106,12 @@ func NewKoboServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(koboServiceMethods.ByName("DisconnectKoboDevice")),
 			connect.WithClientOptions(opts...),
 		),
+		setKoboDeviceFilter: connect.NewClient[v1.SetKoboDeviceFilterRequest, v1.SetKoboDeviceFilterResponse](
+			httpClient,
+			baseURL+KoboServiceSetKoboDeviceFilterProcedure,
+			connect.WithSchema(koboServiceMethods.ByName("SetKoboDeviceFilter")),
+			connect.WithClientOptions(opts...),
+		),
 		setKoboDeviceLogging: connect.NewClient[v1.SetKoboDeviceLoggingRequest, v1.SetKoboDeviceLoggingResponse](
 			httpClient,
 			baseURL+KoboServiceSetKoboDeviceLoggingProcedure,
@@ -129,6 +139,7 @@ type koboServiceClient struct {
 	registerKoboDevice   *connect.Client[v1.RegisterKoboDeviceRequest, v1.RegisterKoboDeviceResponse]
 	listKoboDevices      *connect.Client[v1.ListKoboDevicesRequest, v1.ListKoboDevicesResponse]
 	disconnectKoboDevice *connect.Client[v1.DisconnectKoboDeviceRequest, v1.DisconnectKoboDeviceResponse]
+	setKoboDeviceFilter  *connect.Client[v1.SetKoboDeviceFilterRequest, v1.SetKoboDeviceFilterResponse]
 	setKoboDeviceLogging *connect.Client[v1.SetKoboDeviceLoggingRequest, v1.SetKoboDeviceLoggingResponse]
 	getKoboDeviceLogs    *connect.Client[v1.GetKoboDeviceLogsRequest, v1.GetKoboDeviceLogsResponse]
 	clearKoboDeviceLogs  *connect.Client[v1.ClearKoboDeviceLogsRequest, v1.ClearKoboDeviceLogsResponse]
@@ -154,6 +165,11 @@ func (c *koboServiceClient) DisconnectKoboDevice(ctx context.Context, req *conne
 	return c.disconnectKoboDevice.CallUnary(ctx, req)
 }
 
+// SetKoboDeviceFilter calls books.v1.KoboService.SetKoboDeviceFilter.
+func (c *koboServiceClient) SetKoboDeviceFilter(ctx context.Context, req *connect.Request[v1.SetKoboDeviceFilterRequest]) (*connect.Response[v1.SetKoboDeviceFilterResponse], error) {
+	return c.setKoboDeviceFilter.CallUnary(ctx, req)
+}
+
 // SetKoboDeviceLogging calls books.v1.KoboService.SetKoboDeviceLogging.
 func (c *koboServiceClient) SetKoboDeviceLogging(ctx context.Context, req *connect.Request[v1.SetKoboDeviceLoggingRequest]) (*connect.Response[v1.SetKoboDeviceLoggingResponse], error) {
 	return c.setKoboDeviceLogging.CallUnary(ctx, req)
@@ -175,6 +191,7 @@ type KoboServiceHandler interface {
 	RegisterKoboDevice(context.Context, *connect.Request[v1.RegisterKoboDeviceRequest]) (*connect.Response[v1.RegisterKoboDeviceResponse], error)
 	ListKoboDevices(context.Context, *connect.Request[v1.ListKoboDevicesRequest]) (*connect.Response[v1.ListKoboDevicesResponse], error)
 	DisconnectKoboDevice(context.Context, *connect.Request[v1.DisconnectKoboDeviceRequest]) (*connect.Response[v1.DisconnectKoboDeviceResponse], error)
+	SetKoboDeviceFilter(context.Context, *connect.Request[v1.SetKoboDeviceFilterRequest]) (*connect.Response[v1.SetKoboDeviceFilterResponse], error)
 	SetKoboDeviceLogging(context.Context, *connect.Request[v1.SetKoboDeviceLoggingRequest]) (*connect.Response[v1.SetKoboDeviceLoggingResponse], error)
 	GetKoboDeviceLogs(context.Context, *connect.Request[v1.GetKoboDeviceLogsRequest]) (*connect.Response[v1.GetKoboDeviceLogsResponse], error)
 	ClearKoboDeviceLogs(context.Context, *connect.Request[v1.ClearKoboDeviceLogsRequest]) (*connect.Response[v1.ClearKoboDeviceLogsResponse], error)
@@ -211,6 +228,12 @@ func NewKoboServiceHandler(svc KoboServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(koboServiceMethods.ByName("DisconnectKoboDevice")),
 		connect.WithHandlerOptions(opts...),
 	)
+	koboServiceSetKoboDeviceFilterHandler := connect.NewUnaryHandler(
+		KoboServiceSetKoboDeviceFilterProcedure,
+		svc.SetKoboDeviceFilter,
+		connect.WithSchema(koboServiceMethods.ByName("SetKoboDeviceFilter")),
+		connect.WithHandlerOptions(opts...),
+	)
 	koboServiceSetKoboDeviceLoggingHandler := connect.NewUnaryHandler(
 		KoboServiceSetKoboDeviceLoggingProcedure,
 		svc.SetKoboDeviceLogging,
@@ -239,6 +262,8 @@ func NewKoboServiceHandler(svc KoboServiceHandler, opts ...connect.HandlerOption
 			koboServiceListKoboDevicesHandler.ServeHTTP(w, r)
 		case KoboServiceDisconnectKoboDeviceProcedure:
 			koboServiceDisconnectKoboDeviceHandler.ServeHTTP(w, r)
+		case KoboServiceSetKoboDeviceFilterProcedure:
+			koboServiceSetKoboDeviceFilterHandler.ServeHTTP(w, r)
 		case KoboServiceSetKoboDeviceLoggingProcedure:
 			koboServiceSetKoboDeviceLoggingHandler.ServeHTTP(w, r)
 		case KoboServiceGetKoboDeviceLogsProcedure:
@@ -270,6 +295,10 @@ func (UnimplementedKoboServiceHandler) DisconnectKoboDevice(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.KoboService.DisconnectKoboDevice is not implemented"))
 }
 
+func (UnimplementedKoboServiceHandler) SetKoboDeviceFilter(context.Context, *connect.Request[v1.SetKoboDeviceFilterRequest]) (*connect.Response[v1.SetKoboDeviceFilterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.KoboService.SetKoboDeviceFilter is not implemented"))
+}
+
 func (UnimplementedKoboServiceHandler) SetKoboDeviceLogging(context.Context, *connect.Request[v1.SetKoboDeviceLoggingRequest]) (*connect.Response[v1.SetKoboDeviceLoggingResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.KoboService.SetKoboDeviceLogging is not implemented"))
 }
@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: books/v1/koreader.proto
+
+package booksv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "tools.xdoubleu.com/gen/books/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// KOReaderServiceName is the fully-qualified name of the KOReaderService service.
+	KOReaderServiceName = "books.v1.KOReaderService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// KOReaderServiceRegisterKOReaderDeviceProcedure is the fully-qualified name of the
+	// KOReaderService's RegisterKOReaderDevice RPC.
+	KOReaderServiceRegisterKOReaderDeviceProcedure = "/books.v1.KOReaderService/RegisterKOReaderDevice"
+	// KOReaderServiceListKOReaderDevicesProcedure is the fully-qualified name of the KOReaderService's
+	// ListKOReaderDevices RPC.
+	KOReaderServiceListKOReaderDevicesProcedure = "/books.v1.KOReaderService/ListKOReaderDevices"
+	// KOReaderServiceDisconnectKOReaderDeviceProcedure is the fully-qualified name of the
+	// KOReaderService's DisconnectKOReaderDevice RPC.
+	KOReaderServiceDisconnectKOReaderDeviceProcedure = "/books.v1.KOReaderService/DisconnectKOReaderDevice"
+)
+
+// KOReaderServiceClient is a client for the books.v1.KOReaderService service.
+type KOReaderServiceClient interface {
+	RegisterKOReaderDevice(context.Context, *connect.Request[v1.RegisterKOReaderDeviceRequest]) (*connect.Response[v1.RegisterKOReaderDeviceResponse], error)
+	ListKOReaderDevices(context.Context, *connect.Request[v1.ListKOReaderDevicesRequest]) (*connect.Response[v1.ListKOReaderDevicesResponse], error)
+	DisconnectKOReaderDevice(context.Context, *connect.Request[v1.DisconnectKOReaderDeviceRequest]) (*connect.Response[v1.DisconnectKOReaderDeviceResponse], error)
+}
+
+// NewKOReaderServiceClient constructs a client for the books.v1.KOReaderService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewKOReaderServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) KOReaderServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	kOReaderServiceMethods := v1.File_books_v1_koreader_proto.Services().ByName("KOReaderService").Methods()
+	return &kOReaderServiceClient{
+		registerKOReaderDevice: connect.NewClient[v1.RegisterKOReaderDeviceRequest, v1.RegisterKOReaderDeviceResponse](
+			httpClient,
+			baseURL+KOReaderServiceRegisterKOReaderDeviceProcedure,
+			connect.WithSchema(kOReaderServiceMethods.ByName("RegisterKOReaderDevice")),
+			connect.WithClientOptions(opts...),
+		),
+		listKOReaderDevices: connect.NewClient[v1.ListKOReaderDevicesRequest, v1.ListKOReaderDevicesResponse](
+			httpClient,
+			baseURL+KOReaderServiceListKOReaderDevicesProcedure,
+			connect.WithSchema(kOReaderServiceMethods.ByName("ListKOReaderDevices")),
+			connect.WithClientOptions(opts...),
+		),
+		disconnectKOReaderDevice: connect.NewClient[v1.DisconnectKOReaderDeviceRequest, v1.DisconnectKOReaderDeviceResponse](
+			httpClient,
+			baseURL+KOReaderServiceDisconnectKOReaderDeviceProcedure,
+			connect.WithSchema(kOReaderServiceMethods.ByName("DisconnectKOReaderDevice")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// kOReaderServiceClient implements KOReaderServiceClient.
+type kOReaderServiceClient struct {
+	registerKOReaderDevice   *connect.Client[v1.RegisterKOReaderDeviceRequest, v1.RegisterKOReaderDeviceResponse]
+	listKOReaderDevices      *connect.Client[v1.ListKOReaderDevicesRequest, v1.ListKOReaderDevicesResponse]
+	disconnectKOReaderDevice *connect.Client[v1.DisconnectKOReaderDeviceRequest, v1.DisconnectKOReaderDeviceResponse]
+}
+
+// RegisterKOReaderDevice calls books.v1.KOReaderService.RegisterKOReaderDevice.
+func (c *kOReaderServiceClient) RegisterKOReaderDevice(ctx context.Context, req *connect.Request[v1.RegisterKOReaderDeviceRequest]) (*connect.Response[v1.RegisterKOReaderDeviceResponse], error) {
+	return c.registerKOReaderDevice.CallUnary(ctx, req)
+}
+
+// ListKOReaderDevices calls books.v1.KOReaderService.ListKOReaderDevices.
+func (c *kOReaderServiceClient) ListKOReaderDevices(ctx context.Context, req *connect.Request[v1.ListKOReaderDevicesRequest]) (*connect.Response[v1.ListKOReaderDevicesResponse], error) {
+	return c.listKOReaderDevices.CallUnary(ctx, req)
+}
+
+// DisconnectKOReaderDevice calls books.v1.KOReaderService.DisconnectKOReaderDevice.
+func (c *kOReaderServiceClient) DisconnectKOReaderDevice(ctx context.Context, req *connect.Request[v1.DisconnectKOReaderDeviceRequest]) (*connect.Response[v1.DisconnectKOReaderDeviceResponse], error) {
+	return c.disconnectKOReaderDevice.CallUnary(ctx, req)
+}
+
+// KOReaderServiceHandler is an implementation of the books.v1.KOReaderService service.
+type KOReaderServiceHandler interface {
+	RegisterKOReaderDevice(context.Context, *connect.Request[v1.RegisterKOReaderDeviceRequest]) (*connect.Response[v1.RegisterKOReaderDeviceResponse], error)
+	ListKOReaderDevices(context.Context, *connect.Request[v1.ListKOReaderDevicesRequest]) (*connect.Response[v1.ListKOReaderDevicesResponse], error)
+	DisconnectKOReaderDevice(context.Context, *connect.Request[v1.DisconnectKOReaderDeviceRequest]) (*connect.Response[v1.DisconnectKOReaderDeviceResponse], error)
+}
+
+// NewKOReaderServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewKOReaderServiceHandler(svc KOReaderServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	kOReaderServiceMethods := v1.File_books_v1_koreader_proto.Services().ByName("KOReaderService").Methods()
+	kOReaderServiceRegisterKOReaderDeviceHandler := connect.NewUnaryHandler(
+		KOReaderServiceRegisterKOReaderDeviceProcedure,
+		svc.RegisterKOReaderDevice,
+		connect.WithSchema(kOReaderServiceMethods.ByName("RegisterKOReaderDevice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	kOReaderServiceListKOReaderDevicesHandler := connect.NewUnaryHandler(
+		KOReaderServiceListKOReaderDevicesProcedure,
+		svc.ListKOReaderDevices,
+		connect.WithSchema(kOReaderServiceMethods.ByName("ListKOReaderDevices")),
+		connect.WithHandlerOptions(opts...),
+	)
+	kOReaderServiceDisconnectKOReaderDeviceHandler := connect.NewUnaryHandler(
+		KOReaderServiceDisconnectKOReaderDeviceProcedure,
+		svc.DisconnectKOReaderDevice,
+		connect.WithSchema(kOReaderServiceMethods.ByName("DisconnectKOReaderDevice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/books.v1.KOReaderService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case KOReaderServiceRegisterKOReaderDeviceProcedure:
+			kOReaderServiceRegisterKOReaderDeviceHandler.ServeHTTP(w, r)
+		case KOReaderServiceListKOReaderDevicesProcedure:
+			kOReaderServiceListKOReaderDevicesHandler.ServeHTTP(w, r)
+		case KOReaderServiceDisconnectKOReaderDeviceProcedure:
+			kOReaderServiceDisconnectKOReaderDeviceHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedKOReaderServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedKOReaderServiceHandler struct{}
+
+func (UnimplementedKOReaderServiceHandler) RegisterKOReaderDevice(context.Context, *connect.Request[v1.RegisterKOReaderDeviceRequest]) (*connect.Response[v1.RegisterKOReaderDeviceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.KOReaderService.RegisterKOReaderDevice is not implemented"))
+}
+
+func (UnimplementedKOReaderServiceHandler) ListKOReaderDevices(context.Context, *connect.Request[v1.ListKOReaderDevicesRequest]) (*connect.Response[v1.ListKOReaderDevicesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.KOReaderService.ListKOReaderDevices is not implemented"))
+}
+
+func (UnimplementedKOReaderServiceHandler) DisconnectKOReaderDevice(context.Context, *connect.Request[v1.DisconnectKOReaderDeviceRequest]) (*connect.Response[v1.DisconnectKOReaderDeviceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.KOReaderService.DisconnectKOReaderDevice is not implemented"))
+}
@@ -39,6 +39,9 @@ const (
 	// LibraryServiceGetBooksProgressProcedure is the fully-qualified name of the LibraryService's
 	// GetBooksProgress RPC.
 	LibraryServiceGetBooksProgressProcedure = "/books.v1.LibraryService/GetBooksProgress"
+	// LibraryServiceGetReadingStatsProcedure is the fully-qualified name of the LibraryService's
+	// GetReadingStats RPC.
+	LibraryServiceGetReadingStatsProcedure = "/books.v1.LibraryService/GetReadingStats"
 	// LibraryServiceSearchLibraryProcedure is the fully-qualified name of the LibraryService's
 	// SearchLibrary RPC.
 	LibraryServiceSearchLibraryProcedure = "/books.v1.LibraryService/SearchLibrary"
@@ -66,6 +69,18 @@ const (
 	// LibraryServiceRemoveBookProcedure is the fully-qualified name of the LibraryService's RemoveBook
 	// RPC.
 	LibraryServiceRemoveBookProcedure = "/books.v1.LibraryService/RemoveBook"
+	// LibraryServiceQueueBookProcedure is the fully-qualified name of the LibraryService's QueueBook
+	// RPC.
+	LibraryServiceQueueBookProcedure = "/books.v1.LibraryService/QueueBook"
+	// LibraryServiceDequeueBookProcedure is the fully-qualified name of the LibraryService's
+	// DequeueBook RPC.
+	LibraryServiceDequeueBookProcedure = "/books.v1.LibraryService/DequeueBook"
+	// LibraryServiceReorderQueueProcedure is the fully-qualified name of the LibraryService's
+	// ReorderQueue RPC.
+	LibraryServiceReorderQueueProcedure = "/books.v1.LibraryService/ReorderQueue"
+	// LibraryServiceUpdateQueueSettingsProcedure is the fully-qualified name of the LibraryService's
+	// UpdateQueueSettings RPC.
+	LibraryServiceUpdateQueueSettingsProcedure = "/books.v1.LibraryService/UpdateQueueSettings"
 	// LibraryServiceUpdateReadingProgressProcedure is the fully-qualified name of the LibraryService's
 	// UpdateReadingProgress RPC.
 	LibraryServiceUpdateReadingProgressProcedure = "/books.v1.LibraryService/UpdateReadingProgress"
@@ -90,12 +105,25 @@ const (
 	// LibraryServiceDeleteTagProcedure is the fully-qualified name of the LibraryService's DeleteTag
 	// RPC.
 	LibraryServiceDeleteTagProcedure = "/books.v1.LibraryService/DeleteTag"
+	// LibraryServiceListAnnotationsProcedure is the fully-qualified name of the LibraryService's
+	// ListAnnotations RPC.
+	LibraryServiceListAnnotationsProcedure = "/books.v1.LibraryService/ListAnnotations"
+	// LibraryServiceSearchAnnotationsProcedure is the fully-qualified name of the LibraryService's
+	// SearchAnnotations RPC.
+	LibraryServiceSearchAnnotationsProcedure = "/books.v1.LibraryService/SearchAnnotations"
+	// LibraryServiceExportAnnotationsProcedure is the fully-qualified name of the LibraryService's
+	// ExportAnnotations RPC.
+	LibraryServiceExportAnnotationsProcedure = "/books.v1.LibraryService/ExportAnnotations"
+	// LibraryServiceSearchBookTextProcedure is the fully-qualified name of the LibraryService's
+	// SearchBookText RPC.
+	LibraryServiceSearchBookTextProcedure = "/books.v1.LibraryService/SearchBookText"
 )
 
 // LibraryServiceClient is a client for the books.v1.LibraryService service.
 type LibraryServiceClient interface {
 	GetLibrary(context.Context, *connect.Request[v1.GetLibraryRequest]) (*connect.Response[v1.GetLibraryResponse], error)
 	GetBooksProgress(context.Context, *connect.Request[v1.GetBooksProgressRequest]) (*connect.Response[v1.GetBooksProgressResponse], error)
+	GetReadingStats(context.Context, *connect.Request[v1.GetReadingStatsRequest]) (*connect.Response[v1.GetReadingStatsResponse], error)
 	SearchLibrary(context.Context, *connect.Request[v1.SearchLibraryRequest]) (*connect.Response[v1.SearchLibraryResponse], error)
 	SearchExternal(context.Context, *connect.Request[v1.SearchExternalRequest]) (*connect.Response[v1.SearchExternalResponse], error)
 	GetExternalBook(context.Context, *connect.Request[v1.GetExternalBookRequest]) (*connect.Response[v1.GetExternalBookResponse], error)
@@ -105,6 +133,10 @@ type LibraryServiceClient interface {
 	UpdateProgress(context.Context, *connect.Request[v1.UpdateProgressRequest]) (*connect.Response[v1.UpdateProgressResponse], error)
 	ToggleTag(context.Context, *connect.Request[v1.ToggleTagRequest]) (*connect.Response[v1.ToggleTagResponse], error)
 	RemoveBook(context.Context, *connect.Request[v1.RemoveBookRequest]) (*connect.Response[v1.RemoveBookResponse], error)
+	QueueBook(context.Context, *connect.Request[v1.QueueBookRequest]) (*connect.Response[v1.QueueBookResponse], error)
+	DequeueBook(context.Context, *connect.Request[v1.DequeueBookRequest]) (*connect.Response[v1.DequeueBookResponse], error)
+	ReorderQueue(context.Context, *connect.Request[v1.ReorderQueueRequest]) (*connect.Response[v1.ReorderQueueResponse], error)
+	UpdateQueueSettings(context.Context, *connect.Request[v1.UpdateQueueSettingsRequest]) (*connect.Response[v1.UpdateQueueSettingsResponse], error)
 	UpdateReadingProgress(context.Context, *connect.Request[v1.UpdateReadingProgressRequest]) (*connect.Response[v1.UpdateReadingProgressResponse], error)
 	GetReadingState(context.Context, *connect.Request[v1.GetReadingStateRequest]) (*connect.Response[v1.GetReadingStateResponse], error)
 	GetBookContent(context.Context, *connect.Request[v1.GetBookContentRequest]) (*connect.Response[v1.GetBookContentResponse], error)
@@ -113,6 +145,10 @@ type LibraryServiceClient interface {
 	DeleteShelf(context.Context, *connect.Request[v1.DeleteShelfRequest]) (*connect.Response[v1.DeleteShelfResponse], error)
 	RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error)
 	DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error)
+	ListAnnotations(context.Context, *connect.Request[v1.ListAnnotationsRequest]) (*connect.Response[v1.ListAnnotationsResponse], error)
+	SearchAnnotations(context.Context, *connect.Request[v1.SearchAnnotationsRequest]) (*connect.Response[v1.SearchAnnotationsResponse], error)
+	ExportAnnotations(context.Context, *connect.Request[v1.ExportAnnotationsRequest]) (*connect.Response[v1.ExportAnnotationsResponse], error)
+	SearchBookText(context.Context, *connect.Request[v1.SearchBookTextRequest]) (*connect.Response[v1.SearchBookTextResponse], error)
 }
 
 // NewLibraryServiceClient constructs a client for the books.v1.LibraryService service. By default,
@@ -138,6 +174,12 @@ func NewLibraryServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(libraryServiceMethods.ByName("GetBooksProgress")),
 			connect.WithClientOptions(opts...),
 		),
+		getReadingStats: connect.NewClient[v1.GetReadingStatsRequest, v1.GetReadingStatsResponse](
+			httpClient,
+			baseURL+LibraryServiceGetReadingStatsProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("GetReadingStats")),
+			connect.WithClientOptions(opts...),
+		),
 		searchLibrary: connect.NewClient[v1.SearchLibraryRequest, v1.SearchLibraryResponse](
 			httpClient,
 			baseURL+LibraryServiceSearchLibraryProcedure,
@@ -192,6 +234,30 @@ func NewLibraryServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(libraryServiceMethods.ByName("RemoveBook")),
 			connect.WithClientOptions(opts...),
 		),
+		queueBook: connect.NewClient[v1.QueueBookRequest, v1.QueueBookResponse](
+			httpClient,
+			baseURL+LibraryServiceQueueBookProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("QueueBook")),
+			connect.WithClientOptions(opts...),
+		),
+		dequeueBook: connect.NewClient[v1.DequeueBookRequest, v1.DequeueBookResponse](
+			httpClient,
+			baseURL+LibraryServiceDequeueBookProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("DequeueBook")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderQueue: connect.NewClient[v1.ReorderQueueRequest, v1.ReorderQueueResponse](
+			httpClient,
+			baseURL+LibraryServiceReorderQueueProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("ReorderQueue")),
+			connect.WithClientOptions(opts...),
+		),
+		updateQueueSettings: connect.NewClient[v1.UpdateQueueSettingsRequest, v1.UpdateQueueSettingsResponse](
+			httpClient,
+			baseURL+LibraryServiceUpdateQueueSettingsProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("UpdateQueueSettings")),
+			connect.WithClientOptions(opts...),
+		),
 		updateReadingProgress: connect.NewClient[v1.UpdateReadingProgressRequest, v1.UpdateReadingProgressResponse](
 			httpClient,
 			baseURL+LibraryServiceUpdateReadingProgressProcedure,
@@ -240,6 +306,30 @@ func NewLibraryServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(libraryServiceMethods.ByName("DeleteTag")),
 			connect.WithClientOptions(opts...),
 		),
+		listAnnotations: connect.NewClient[v1.ListAnnotationsRequest, v1.ListAnnotationsResponse](
+			httpClient,
+			baseURL+LibraryServiceListAnnotationsProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("ListAnnotations")),
+			connect.WithClientOptions(opts...),
+		),
+		searchAnnotations: connect.NewClient[v1.SearchAnnotationsRequest, v1.SearchAnnotationsResponse](
+			httpClient,
+			baseURL+LibraryServiceSearchAnnotationsProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("SearchAnnotations")),
+			connect.WithClientOptions(opts...),
+		),
+		exportAnnotations: connect.NewClient[v1.ExportAnnotationsRequest, v1.ExportAnnotationsResponse](
+			httpClient,
+			baseURL+LibraryServiceExportAnnotationsProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("ExportAnnotations")),
+			connect.WithClientOptions(opts...),
+		),
+		searchBookText: connect.NewClient[v1.SearchBookTextRequest, v1.SearchBookTextResponse](
+			httpClient,
+			baseURL+LibraryServiceSearchBookTextProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("SearchBookText")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -247,6 +337,7 @@ func NewLibraryServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 type libraryServiceClient struct {
 	getLibrary            *connect.Client[v1.GetLibraryRequest, v1.GetLibraryResponse]
 	getBooksProgress      *connect.Client[v1.GetBooksProgressRequest, v1.GetBooksProgressResponse]
+	getReadingStats       *connect.Client[v1.GetReadingStatsRequest, v1.GetReadingStatsResponse]
 	searchLibrary         *connect.Client[v1.SearchLibraryRequest, v1.SearchLibraryResponse]
 	searchExternal        *connect.Client[v1.SearchExternalRequest, v1.SearchExternalResponse]
 	getExternalBook       *connect.Client[v1.GetExternalBookRequest, v1.GetExternalBookResponse]
@@ -256,6 +347,10 @@ type libraryServiceClient struct {
 	updateProgress        *connect.Client[v1.UpdateProgressRequest, v1.UpdateProgressResponse]
 	toggleTag             *connect.Client[v1.ToggleTagRequest, v1.ToggleTagResponse]
 	removeBook            *connect.Client[v1.RemoveBookRequest, v1.RemoveBookResponse]
+	queueBook             *connect.Client[v1.QueueBookRequest, v1.QueueBookResponse]
+	dequeueBook           *connect.Client[v1.DequeueBookRequest, v1.DequeueBookResponse]
+	reorderQueue          *connect.Client[v1.ReorderQueueRequest, v1.ReorderQueueResponse]
+	updateQueueSettings   *connect.Client[v1.UpdateQueueSettingsRequest, v1.UpdateQueueSettingsResponse]
 	updateReadingProgress *connect.Client[v1.UpdateReadingProgressRequest, v1.UpdateReadingProgressResponse]
 	getReadingState       *connect.Client[v1.GetReadingStateRequest, v1.GetReadingStateResponse]
 	getBookContent        *connect.Client[v1.GetBookContentRequest, v1.GetBookContentResponse]
@@ -264,6 +359,10 @@ type libraryServiceClient struct {
 	deleteShelf           *connect.Client[v1.DeleteShelfRequest, v1.DeleteShelfResponse]
 	renameTag             *connect.Client[v1.RenameTagRequest, v1.RenameTagResponse]
 	deleteTag             *connect.Client[v1.DeleteTagRequest, v1.DeleteTagResponse]
+	listAnnotations       *connect.Client[v1.ListAnnotationsRequest, v1.ListAnnotationsResponse]
+	searchAnnotations     *connect.Client[v1.SearchAnnotationsRequest, v1.SearchAnnotationsResponse]
+	exportAnnotations     *connect.Client[v1.ExportAnnotationsRequest, v1.ExportAnnotationsResponse]
+	searchBookText        *connect.Client[v1.SearchBookTextRequest, v1.SearchBookTextResponse]
 }
 
 // GetLibrary calls books.v1.LibraryService.GetLibrary.
@@ -276,6 +375,11 @@ func (c *libraryServiceClient) GetBooksProgress(ctx context.Context, req *connec
 	return c.getBooksProgress.CallUnary(ctx, req)
 }
 
+// GetReadingStats calls books.v1.LibraryService.GetReadingStats.
+func (c *libraryServiceClient) GetReadingStats(ctx context.Context, req *connect.Request[v1.GetReadingStatsRequest]) (*connect.Response[v1.GetReadingStatsResponse], error) {
+	return c.getReadingStats.CallUnary(ctx, req)
+}
+
 // SearchLibrary calls books.v1.LibraryService.SearchLibrary.
 func (c *libraryServiceClient) SearchLibrary(ctx context.Context, req *connect.Request[v1.SearchLibraryRequest]) (*connect.Response[v1.SearchLibraryResponse], error) {
 	return c.searchLibrary.CallUnary(ctx, req)
@@ -321,6 +425,26 @@ func (c *libraryServiceClient) RemoveBook(ctx context.Context, req *connect.Requ
 	return c.removeBook.CallUnary(ctx, req)
 }
 
+// QueueBook calls books.v1.LibraryService.QueueBook.
+func (c *libraryServiceClient) QueueBook(ctx context.Context, req *connect.Request[v1.QueueBookRequest]) (*connect.Response[v1.QueueBookResponse], error) {
+	return c.queueBook.CallUnary(ctx, req)
+}
+
+// DequeueBook calls books.v1.LibraryService.DequeueBook.
+func (c *libraryServiceClient) DequeueBook(ctx context.Context, req *connect.Request[v1.DequeueBookRequest]) (*connect.Response[v1.DequeueBookResponse], error) {
+	return c.dequeueBook.CallUnary(ctx, req)
+}
+
+// ReorderQueue calls books.v1.LibraryService.ReorderQueue.
+func (c *libraryServiceClient) ReorderQueue(ctx context.Context, req *connect.Request[v1.ReorderQueueRequest]) (*connect.Response[v1.ReorderQueueResponse], error) {
+	return c.reorderQueue.CallUnary(ctx, req)
+}
+
+// UpdateQueueSettings calls books.v1.LibraryService.UpdateQueueSettings.
+func (c *libraryServiceClient) UpdateQueueSettings(ctx context.Context, req *connect.Request[v1.UpdateQueueSettingsRequest]) (*connect.Response[v1.UpdateQueueSettingsResponse], error) {
+	return c.updateQueueSettings.CallUnary(ctx, req)
+}
+
 // UpdateReadingProgress calls books.v1.LibraryService.UpdateReadingProgress.
 func (c *libraryServiceClient) UpdateReadingProgress(ctx context.Context, req *connect.Request[v1.UpdateReadingProgressRequest]) (*connect.Response[v1.UpdateReadingProgressResponse], error) {
 	return c.updateReadingProgress.CallUnary(ctx, req)
@@ -361,10 +485,31 @@ func (c *libraryServiceClient) DeleteTag(ctx context.Context, req *connect.Reque
 	return c.deleteTag.CallUnary(ctx, req)
 }
 
+// ListAnnotations calls books.v1.LibraryService.ListAnnotations.
+func (c *libraryServiceClient) ListAnnotations(ctx context.Context, req *connect.Request[v1.ListAnnotationsRequest]) (*connect.Response[v1.ListAnnotationsResponse], error) {
+	return c.listAnnotations.CallUnary(ctx, req)
+}
+
+// SearchAnnotations calls books.v1.LibraryService.SearchAnnotations.
+func (c *libraryServiceClient) SearchAnnotations(ctx context.Context, req *connect.Request[v1.SearchAnnotationsRequest]) (*connect.Response[v1.SearchAnnotationsResponse], error) {
+	return c.searchAnnotations.CallUnary(ctx, req)
+}
+
+// ExportAnnotations calls books.v1.LibraryService.ExportAnnotations.
+func (c *libraryServiceClient) ExportAnnotations(ctx context.Context, req *connect.Request[v1.ExportAnnotationsRequest]) (*connect.Response[v1.ExportAnnotationsResponse], error) {
+	return c.exportAnnotations.CallUnary(ctx, req)
+}
+
+// SearchBookText calls books.v1.LibraryService.SearchBookText.
+func (c *libraryServiceClient) SearchBookText(ctx context.Context, req *connect.Request[v1.SearchBookTextRequest]) (*connect.Response[v1.SearchBookTextResponse], error) {
+	return c.searchBookText.CallUnary(ctx, req)
+}
+
 // LibraryServiceHandler is an implementation of the books.v1.LibraryService service.
 type LibraryServiceHandler interface {
 	GetLibrary(context.Context, *connect.Request[v1.GetLibraryRequest]) (*connect.Response[v1.GetLibraryResponse], error)
 	GetBooksProgress(context.Context, *connect.Request[v1.GetBooksProgressRequest]) (*connect.Response[v1.GetBooksProgressResponse], error)
+	GetReadingStats(context.Context, *connect.Request[v1.GetReadingStatsRequest]) (*connect.Response[v1.GetReadingStatsResponse], error)
 	SearchLibrary(context.Context, *connect.Request[v1.SearchLibraryRequest]) (*connect.Response[v1.SearchLibraryResponse], error)
 	SearchExternal(context.Context, *connect.Request[v1.SearchExternalRequest]) (*connect.Response[v1.SearchExternalResponse], error)
 	GetExternalBook(context.Context, *connect.Request[v1.GetExternalBookRequest]) (*connect.Response[v1.GetExternalBookResponse], error)
@@ -374,6 +519,10 @@ type LibraryServiceHandler interface {
 	UpdateProgress(context.Context, *connect.Request[v1.UpdateProgressRequest]) (*connect.Response[v1.UpdateProgressResponse], error)
 	ToggleTag(context.Context, *connect.Request[v1.ToggleTagRequest]) (*connect.Response[v1.ToggleTagResponse], error)
 	RemoveBook(context.Context, *connect.Request[v1.RemoveBookRequest]) (*connect.Response[v1.RemoveBookResponse], error)
+	QueueBook(context.Context, *connect.Request[v1.QueueBookRequest]) (*connect.Response[v1.QueueBookResponse], error)
+	DequeueBook(context.Context, *connect.Request[v1.DequeueBookRequest]) (*connect.Response[v1.DequeueBookResponse], error)
+	ReorderQueue(context.Context, *connect.Request[v1.ReorderQueueRequest]) (*connect.Response[v1.ReorderQueueResponse], error)
+	UpdateQueueSettings(context.Context, *connect.Request[v1.UpdateQueueSettingsRequest]) (*connect.Response[v1.UpdateQueueSettingsResponse], error)
 	UpdateReadingProgress(context.Context, *connect.Request[v1.UpdateReadingProgressRequest]) (*connect.Response[v1.UpdateReadingProgressResponse], error)
 	GetReadingState(context.Context, *connect.Request[v1.GetReadingStateRequest]) (*connect.Response[v1.GetReadingStateResponse], error)
 	GetBookContent(context.Context, *connect.Request[v1.GetBookContentRequest]) (*connect.Response[v1.GetBookContentResponse], error)
@@ -382,6 +531,10 @@ type LibraryServiceHandler interface {
 	DeleteShelf(context.Context, *connect.Request[v1.DeleteShelfRequest]) (*connect.Response[v1.DeleteShelfResponse], error)
 	RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error)
 	DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error)
+	ListAnnotations(context.Context, *connect.Request[v1.ListAnnotationsRequest]) (*connect.Response[v1.ListAnnotationsResponse], error)
+	SearchAnnotations(context.Context, *connect.Request[v1.SearchAnnotationsRequest]) (*connect.Response[v1.SearchAnnotationsResponse], error)
+	ExportAnnotations(context.Context, *connect.Request[v1.ExportAnnotationsRequest]) (*connect.Response[v1.ExportAnnotationsResponse], error)
+	SearchBookText(context.Context, *connect.Request[v1.SearchBookTextRequest]) (*connect.Response[v1.SearchBookTextResponse], error)
 }
 
 // NewLibraryServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -403,6 +556,12 @@ func NewLibraryServiceHandler(svc LibraryServiceHandler, opts ...connect.Handler
 		connect.WithSchema(libraryServiceMethods.ByName("GetBooksProgress")),
 		connect.WithHandlerOptions(opts...),
 	)
+	libraryServiceGetReadingStatsHandler := connect.NewUnaryHandler(
+		LibraryServiceGetReadingStatsProcedure,
+		svc.GetReadingStats,
+		connect.WithSchema(libraryServiceMethods.ByName("GetReadingStats")),
+		connect.WithHandlerOptions(opts...),
+	)
 	libraryServiceSearchLibraryHandler := connect.NewUnaryHandler(
 		LibraryServiceSearchLibraryProcedure,
 		svc.SearchLibrary,
@@ -457,6 +616,30 @@ func NewLibraryServiceHandler(svc LibraryServiceHandler, opts ...connect.Handler
 		connect.WithSchema(libraryServiceMethods.ByName("RemoveBook")),
 		connect.WithHandlerOptions(opts...),
 	)
+	libraryServiceQueueBookHandler := connect.NewUnaryHandler(
+		LibraryServiceQueueBookProcedure,
+		svc.QueueBook,
+		connect.WithSchema(libraryServiceMethods.ByName("QueueBook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceDequeueBookHandler := connect.NewUnaryHandler(
+		LibraryServiceDequeueBookProcedure,
+		svc.DequeueBook,
+		connect.WithSchema(libraryServiceMethods.ByName("DequeueBook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceReorderQueueHandler := connect.NewUnaryHandler(
+		LibraryServiceReorderQueueProcedure,
+		svc.ReorderQueue,
+		connect.WithSchema(libraryServiceMethods.ByName("ReorderQueue")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceUpdateQueueSettingsHandler := connect.NewUnaryHandler(
+		LibraryServiceUpdateQueueSettingsProcedure,
+		svc.UpdateQueueSettings,
+		connect.WithSchema(libraryServiceMethods.ByName("UpdateQueueSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
 	libraryServiceUpdateReadingProgressHandler := connect.NewUnaryHandler(
 		LibraryServiceUpdateReadingProgressProcedure,
 		svc.UpdateReadingProgress,
@@ -505,12 +688,38 @@ func NewLibraryServiceHandler(svc LibraryServiceHandler, opts ...connect.Handler
 		connect.WithSchema(libraryServiceMethods.ByName("DeleteTag")),
 		connect.WithHandlerOptions(opts...),
 	)
+	libraryServiceListAnnotationsHandler := connect.NewUnaryHandler(
+		LibraryServiceListAnnotationsProcedure,
+		svc.ListAnnotations,
+		connect.WithSchema(libraryServiceMethods.ByName("ListAnnotations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceSearchAnnotationsHandler := connect.NewUnaryHandler(
+		LibraryServiceSearchAnnotationsProcedure,
+		svc.SearchAnnotations,
+		connect.WithSchema(libraryServiceMethods.ByName("SearchAnnotations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceExportAnnotationsHandler := connect.NewUnaryHandler(
+		LibraryServiceExportAnnotationsProcedure,
+		svc.ExportAnnotations,
+		connect.WithSchema(libraryServiceMethods.ByName("ExportAnnotations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceSearchBookTextHandler := connect.NewUnaryHandler(
+		LibraryServiceSearchBookTextProcedure,
+		svc.SearchBookText,
+		connect.WithSchema(libraryServiceMethods.ByName("SearchBookText")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/books.v1.LibraryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case LibraryServiceGetLibraryProcedure:
 			libraryServiceGetLibraryHandler.ServeHTTP(w, r)
 		case LibraryServiceGetBooksProgressProcedure:
 			libraryServiceGetBooksProgressHandler.ServeHTTP(w, r)
+		case LibraryServiceGetReadingStatsProcedure:
+			libraryServiceGetReadingStatsHandler.ServeHTTP(w, r)
 		case LibraryServiceSearchLibraryProcedure:
 			libraryServiceSearchLibraryHandler.ServeHTTP(w, r)
 		case LibraryServiceSearchExternalProcedure:
@@ -529,6 +738,14 @@ func NewLibraryServiceHandler(svc LibraryServiceHandler, opts ...connect.Handler
 			libraryServiceToggleTagHandler.ServeHTTP(w, r)
 		case LibraryServiceRemoveBookProcedure:
 			libraryServiceRemoveBookHandler.ServeHTTP(w, r)
+		case LibraryServiceQueueBookProcedure:
+			libraryServiceQueueBookHandler.ServeHTTP(w, r)
+		case LibraryServiceDequeueBookProcedure:
+			libraryServiceDequeueBookHandler.ServeHTTP(w, r)
+		case LibraryServiceReorderQueueProcedure:
+			libraryServiceReorderQueueHandler.ServeHTTP(w, r)
+		case LibraryServiceUpdateQueueSettingsProcedure:
+			libraryServiceUpdateQueueSettingsHandler.ServeHTTP(w, r)
 		case LibraryServiceUpdateReadingProgressProcedure:
 			libraryServiceUpdateReadingProgressHandler.ServeHTTP(w, r)
 		case LibraryServiceGetReadingStateProcedure:
@@ -545,6 +762,14 @@ func NewLibraryServiceHandler(svc LibraryServiceHandler, opts ...connect.Handler
 			libraryServiceRenameTagHandler.ServeHTTP(w, r)
 		case LibraryServiceDeleteTagProcedure:
 			libraryServiceDeleteTagHandler.ServeHTTP(w, r)
+		case LibraryServiceListAnnotationsProcedure:
+			libraryServiceListAnnotationsHandler.ServeHTTP(w, r)
+		case LibraryServiceSearchAnnotationsProcedure:
+			libraryServiceSearchAnnotationsHandler.ServeHTTP(w, r)
+		case LibraryServiceExportAnnotationsProcedure:
+			libraryServiceExportAnnotationsHandler.ServeHTTP(w, r)
+		case LibraryServiceSearchBookTextProcedure:
+			libraryServiceSearchBookTextHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -562,6 +787,10 @@ func (UnimplementedLibraryServiceHandler) GetBooksProgress(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.GetBooksProgress is not implemented"))
 }
 
+func (UnimplementedLibraryServiceHandler) GetReadingStats(context.Context, *connect.Request[v1.GetReadingStatsRequest]) (*connect.Response[v1.GetReadingStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.GetReadingStats is not implemented"))
+}
+
 func (UnimplementedLibraryServiceHandler) SearchLibrary(context.Context, *connect.Request[v1.SearchLibraryRequest]) (*connect.Response[v1.SearchLibraryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.SearchLibrary is not implemented"))
 }
@@ -598,6 +827,22 @@ func (UnimplementedLibraryServiceHandler) RemoveBook(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.RemoveBook is not implemented"))
 }
 
+func (UnimplementedLibraryServiceHandler) QueueBook(context.Context, *connect.Request[v1.QueueBookRequest]) (*connect.Response[v1.QueueBookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.QueueBook is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) DequeueBook(context.Context, *connect.Request[v1.DequeueBookRequest]) (*connect.Response[v1.DequeueBookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.DequeueBook is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) ReorderQueue(context.Context, *connect.Request[v1.ReorderQueueRequest]) (*connect.Response[v1.ReorderQueueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.ReorderQueue is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) UpdateQueueSettings(context.Context, *connect.Request[v1.UpdateQueueSettingsRequest]) (*connect.Response[v1.UpdateQueueSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.UpdateQueueSettings is not implemented"))
+}
+
 func (UnimplementedLibraryServiceHandler) UpdateReadingProgress(context.Context, *connect.Request[v1.UpdateReadingProgressRequest]) (*connect.Response[v1.UpdateReadingProgressResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.UpdateReadingProgress is not implemented"))
 }
@@ -629,3 +874,19 @@ func (UnimplementedLibraryServiceHandler) RenameTag(context.Context, *connect.Re
 func (UnimplementedLibraryServiceHandler) DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.DeleteTag is not implemented"))
 }
+
+func (UnimplementedLibraryServiceHandler) ListAnnotations(context.Context, *connect.Request[v1.ListAnnotationsRequest]) (*connect.Response[v1.ListAnnotationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.ListAnnotations is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) SearchAnnotations(context.Context, *connect.Request[v1.SearchAnnotationsRequest]) (*connect.Response[v1.SearchAnnotationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.SearchAnnotations is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) ExportAnnotations(context.Context, *connect.Request[v1.ExportAnnotationsRequest]) (*connect.Response[v1.ExportAnnotationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.ExportAnnotations is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) SearchBookText(context.Context, *connect.Request[v1.SearchBookTextRequest]) (*connect.Response[v1.SearchBookTextResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.LibraryService.SearchBookText is not implemented"))
+}
@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: books/v1/opds.proto
+
+package booksv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "tools.xdoubleu.com/gen/books/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// OPDSServiceName is the fully-qualified name of the OPDSService service.
+	OPDSServiceName = "books.v1.OPDSService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OPDSServiceCreateOPDSTokenProcedure is the fully-qualified name of the OPDSService's
+	// CreateOPDSToken RPC.
+	OPDSServiceCreateOPDSTokenProcedure = "/books.v1.OPDSService/CreateOPDSToken"
+	// OPDSServiceListOPDSTokensProcedure is the fully-qualified name of the OPDSService's
+	// ListOPDSTokens RPC.
+	OPDSServiceListOPDSTokensProcedure = "/books.v1.OPDSService/ListOPDSTokens"
+	// OPDSServiceRevokeOPDSTokenProcedure is the fully-qualified name of the OPDSService's
+	// RevokeOPDSToken RPC.
+	OPDSServiceRevokeOPDSTokenProcedure = "/books.v1.OPDSService/RevokeOPDSToken"
+)
+
+// OPDSServiceClient is a client for the books.v1.OPDSService service.
+type OPDSServiceClient interface {
+	CreateOPDSToken(context.Context, *connect.Request[v1.CreateOPDSTokenRequest]) (*connect.Response[v1.CreateOPDSTokenResponse], error)
+	ListOPDSTokens(context.Context, *connect.Request[v1.ListOPDSTokensRequest]) (*connect.Response[v1.ListOPDSTokensResponse], error)
+	RevokeOPDSToken(context.Context, *connect.Request[v1.RevokeOPDSTokenRequest]) (*connect.Response[v1.RevokeOPDSTokenResponse], error)
+}
+
+// NewOPDSServiceClient constructs a client for the books.v1.OPDSService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOPDSServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OPDSServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	oPDSServiceMethods := v1.File_books_v1_opds_proto.Services().ByName("OPDSService").Methods()
+	return &oPDSServiceClient{
+		createOPDSToken: connect.NewClient[v1.CreateOPDSTokenRequest, v1.CreateOPDSTokenResponse](
+			httpClient,
+			baseURL+OPDSServiceCreateOPDSTokenProcedure,
+			connect.WithSchema(oPDSServiceMethods.ByName("CreateOPDSToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listOPDSTokens: connect.NewClient[v1.ListOPDSTokensRequest, v1.ListOPDSTokensResponse](
+			httpClient,
+			baseURL+OPDSServiceListOPDSTokensProcedure,
+			connect.WithSchema(oPDSServiceMethods.ByName("ListOPDSTokens")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeOPDSToken: connect.NewClient[v1.RevokeOPDSTokenRequest, v1.RevokeOPDSTokenResponse](
+			httpClient,
+			baseURL+OPDSServiceRevokeOPDSTokenProcedure,
+			connect.WithSchema(oPDSServiceMethods.ByName("RevokeOPDSToken")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// oPDSServiceClient implements OPDSServiceClient.
+type oPDSServiceClient struct {
+	createOPDSToken *connect.Client[v1.CreateOPDSTokenRequest, v1.CreateOPDSTokenResponse]
+	listOPDSTokens  *connect.Client[v1.ListOPDSTokensRequest, v1.ListOPDSTokensResponse]
+	revokeOPDSToken *connect.Client[v1.RevokeOPDSTokenRequest, v1.RevokeOPDSTokenResponse]
+}
+
+// CreateOPDSToken calls books.v1.OPDSService.CreateOPDSToken.
+func (c *oPDSServiceClient) CreateOPDSToken(ctx context.Context, req *connect.Request[v1.CreateOPDSTokenRequest]) (*connect.Response[v1.CreateOPDSTokenResponse], error) {
+	return c.createOPDSToken.CallUnary(ctx, req)
+}
+
+// ListOPDSTokens calls books.v1.OPDSService.ListOPDSTokens.
+func (c *oPDSServiceClient) ListOPDSTokens(ctx context.Context, req *connect.Request[v1.ListOPDSTokensRequest]) (*connect.Response[v1.ListOPDSTokensResponse], error) {
+	return c.listOPDSTokens.CallUnary(ctx, req)
+}
+
+// RevokeOPDSToken calls books.v1.OPDSService.RevokeOPDSToken.
+func (c *oPDSServiceClient) RevokeOPDSToken(ctx context.Context, req *connect.Request[v1.RevokeOPDSTokenRequest]) (*connect.Response[v1.RevokeOPDSTokenResponse], error) {
+	return c.revokeOPDSToken.CallUnary(ctx, req)
+}
+
+// OPDSServiceHandler is an implementation of the books.v1.OPDSService service.
+type OPDSServiceHandler interface {
+	CreateOPDSToken(context.Context, *connect.Request[v1.CreateOPDSTokenRequest]) (*connect.Response[v1.CreateOPDSTokenResponse], error)
+	ListOPDSTokens(context.Context, *connect.Request[v1.ListOPDSTokensRequest]) (*connect.Response[v1.ListOPDSTokensResponse], error)
+	RevokeOPDSToken(context.Context, *connect.Request[v1.RevokeOPDSTokenRequest]) (*connect.Response[v1.RevokeOPDSTokenResponse], error)
+}
+
+// NewOPDSServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOPDSServiceHandler(svc OPDSServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	oPDSServiceMethods := v1.File_books_v1_opds_proto.Services().ByName("OPDSService").Methods()
+	oPDSServiceCreateOPDSTokenHandler := connect.NewUnaryHandler(
+		OPDSServiceCreateOPDSTokenProcedure,
+		svc.CreateOPDSToken,
+		connect.WithSchema(oPDSServiceMethods.ByName("CreateOPDSToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oPDSServiceListOPDSTokensHandler := connect.NewUnaryHandler(
+		OPDSServiceListOPDSTokensProcedure,
+		svc.ListOPDSTokens,
+		connect.WithSchema(oPDSServiceMethods.ByName("ListOPDSTokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oPDSServiceRevokeOPDSTokenHandler := connect.NewUnaryHandler(
+		OPDSServiceRevokeOPDSTokenProcedure,
+		svc.RevokeOPDSToken,
+		connect.WithSchema(oPDSServiceMethods.ByName("RevokeOPDSToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/books.v1.OPDSService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OPDSServiceCreateOPDSTokenProcedure:
+			oPDSServiceCreateOPDSTokenHandler.ServeHTTP(w, r)
+		case OPDSServiceListOPDSTokensProcedure:
+			oPDSServiceListOPDSTokensHandler.ServeHTTP(w, r)
+		case OPDSServiceRevokeOPDSTokenProcedure:
+			oPDSServiceRevokeOPDSTokenHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOPDSServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOPDSServiceHandler struct{}
+
+func (UnimplementedOPDSServiceHandler) CreateOPDSToken(context.Context, *connect.Request[v1.CreateOPDSTokenRequest]) (*connect.Response[v1.CreateOPDSTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.OPDSService.CreateOPDSToken is not implemented"))
+}
+
+func (UnimplementedOPDSServiceHandler) ListOPDSTokens(context.Context, *connect.Request[v1.ListOPDSTokensRequest]) (*connect.Response[v1.ListOPDSTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.OPDSService.ListOPDSTokens is not implemented"))
+}
+
+func (UnimplementedOPDSServiceHandler) RevokeOPDSToken(context.Context, *connect.Request[v1.RevokeOPDSTokenRequest]) (*connect.Response[v1.RevokeOPDSTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("books.v1.OPDSService.RevokeOPDSToken is not implemented"))
+}
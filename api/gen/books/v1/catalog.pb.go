@@ -21,9 +21,16 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ImportBooks reads another service's library export. format is one of
+// "goodreads", "storygraph", "librarything" (TSV or JSON), "calibre" (a
+// metadata.db) or "backup" (an ExportLibrary JSON file); empty detects it
+// from the data. With dry_run set nothing is written and the response only
+// previews what an import would do.
 type ImportBooksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CsvData       []byte                 `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -58,23 +65,126 @@ func (*ImportBooksRequest) Descriptor() ([]byte, []int) {
 	return file_books_v1_catalog_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *ImportBooksRequest) GetCsvData() []byte {
+func (x *ImportBooksRequest) GetData() []byte {
 	if x != nil {
-		return x.CsvData
+		return x.Data
 	}
 	return nil
 }
 
+func (x *ImportBooksRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportBooksRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ImportPlanItem is what happens to one entry of an import file.
+type ImportPlanItem struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Title   string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Authors []string               `protobuf:"bytes,2,rep,name=authors,proto3" json:"authors,omitempty"`
+	// "create" | "merge" | "skip"
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// For merges the matching signal ("isbn13" | "title+author"); for skips
+	// why the entry is left out ("no title" | "duplicate in file" |
+	// "unchanged").
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The library book a merge or an unchanged skip refers to.
+	ExistingBookId string `protobuf:"bytes,5,opt,name=existing_book_id,json=existingBookId,proto3" json:"existing_book_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportPlanItem) Reset() {
+	*x = ImportPlanItem{}
+	mi := &file_books_v1_catalog_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPlanItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPlanItem) ProtoMessage() {}
+
+func (x *ImportPlanItem) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_catalog_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPlanItem.ProtoReflect.Descriptor instead.
+func (*ImportPlanItem) Descriptor() ([]byte, []int) {
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportPlanItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportPlanItem) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *ImportPlanItem) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ImportPlanItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImportPlanItem) GetExistingBookId() string {
+	if x != nil {
+		return x.ExistingBookId
+	}
+	return ""
+}
+
 type ImportBooksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ImportedCount int32                  `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Books created or merged; always 0 on a dry run.
+	ImportedCount int32 `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	// The format that was used, as detected or requested.
+	Format        string            `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	CreatedCount  int32             `protobuf:"varint,3,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	MergedCount   int32             `protobuf:"varint,4,opt,name=merged_count,json=mergedCount,proto3" json:"merged_count,omitempty"`
+	SkippedCount  int32             `protobuf:"varint,5,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	Items         []*ImportPlanItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ImportBooksResponse) Reset() {
 	*x = ImportBooksResponse{}
-	mi := &file_books_v1_catalog_proto_msgTypes[1]
+	mi := &file_books_v1_catalog_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -86,7 +196,7 @@ func (x *ImportBooksResponse) String() string {
 func (*ImportBooksResponse) ProtoMessage() {}
 
 func (x *ImportBooksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[1]
+	mi := &file_books_v1_catalog_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -99,7 +209,7 @@ func (x *ImportBooksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportBooksResponse.ProtoReflect.Descriptor instead.
 func (*ImportBooksResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{1}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ImportBooksResponse) GetImportedCount() int32 {
@@ -109,6 +219,148 @@ func (x *ImportBooksResponse) GetImportedCount() int32 {
 	return 0
 }
 
+func (x *ImportBooksResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportBooksResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportBooksResponse) GetMergedCount() int32 {
+	if x != nil {
+		return x.MergedCount
+	}
+	return 0
+}
+
+func (x *ImportBooksResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *ImportBooksResponse) GetItems() []*ImportPlanItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ExportLibrary renders the library as a downloadable file. format is "csv"
+// (default), a Goodreads export ImportBooks reads back, or "json", a
+// lossless backup that also carries reading state, sessions and annotations.
+type ExportLibraryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportLibraryRequest) Reset() {
+	*x = ExportLibraryRequest{}
+	mi := &file_books_v1_catalog_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportLibraryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportLibraryRequest) ProtoMessage() {}
+
+func (x *ExportLibraryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_catalog_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportLibraryRequest.ProtoReflect.Descriptor instead.
+func (*ExportLibraryRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExportLibraryRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportLibraryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportLibraryResponse) Reset() {
+	*x = ExportLibraryResponse{}
+	mi := &file_books_v1_catalog_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportLibraryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportLibraryResponse) ProtoMessage() {}
+
+func (x *ExportLibraryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_catalog_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportLibraryResponse.ProtoReflect.Descriptor instead.
+func (*ExportLibraryResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExportLibraryResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportLibraryResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportLibraryResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
 type DuplicateGroup struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Library entries judged to be the same book. entries[0] is the suggested
@@ -122,7 +374,7 @@ type DuplicateGroup struct {
 
 func (x *DuplicateGroup) Reset() {
 	*x = DuplicateGroup{}
-	mi := &file_books_v1_catalog_proto_msgTypes[2]
+	mi := &file_books_v1_catalog_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -134,7 +386,7 @@ func (x *DuplicateGroup) String() string {
 func (*DuplicateGroup) ProtoMessage() {}
 
 func (x *DuplicateGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[2]
+	mi := &file_books_v1_catalog_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -147,7 +399,7 @@ func (x *DuplicateGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DuplicateGroup.ProtoReflect.Descriptor instead.
 func (*DuplicateGroup) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{2}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DuplicateGroup) GetEntries() []*UserBook {
@@ -172,7 +424,7 @@ type FindDuplicatesRequest struct {
 
 func (x *FindDuplicatesRequest) Reset() {
 	*x = FindDuplicatesRequest{}
-	mi := &file_books_v1_catalog_proto_msgTypes[3]
+	mi := &file_books_v1_catalog_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -184,7 +436,7 @@ func (x *FindDuplicatesRequest) String() string {
 func (*FindDuplicatesRequest) ProtoMessage() {}
 
 func (x *FindDuplicatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[3]
+	mi := &file_books_v1_catalog_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -197,7 +449,7 @@ func (x *FindDuplicatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindDuplicatesRequest.ProtoReflect.Descriptor instead.
 func (*FindDuplicatesRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{3}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{6}
 }
 
 type FindDuplicatesResponse struct {
@@ -209,7 +461,7 @@ type FindDuplicatesResponse struct {
 
 func (x *FindDuplicatesResponse) Reset() {
 	*x = FindDuplicatesResponse{}
-	mi := &file_books_v1_catalog_proto_msgTypes[4]
+	mi := &file_books_v1_catalog_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -221,7 +473,7 @@ func (x *FindDuplicatesResponse) String() string {
 func (*FindDuplicatesResponse) ProtoMessage() {}
 
 func (x *FindDuplicatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[4]
+	mi := &file_books_v1_catalog_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,7 +486,7 @@ func (x *FindDuplicatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindDuplicatesResponse.ProtoReflect.Descriptor instead.
 func (*FindDuplicatesResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{4}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *FindDuplicatesResponse) GetGroups() []*DuplicateGroup {
@@ -269,7 +521,7 @@ type MergeBooksRequest struct {
 
 func (x *MergeBooksRequest) Reset() {
 	*x = MergeBooksRequest{}
-	mi := &file_books_v1_catalog_proto_msgTypes[5]
+	mi := &file_books_v1_catalog_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -281,7 +533,7 @@ func (x *MergeBooksRequest) String() string {
 func (*MergeBooksRequest) ProtoMessage() {}
 
 func (x *MergeBooksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[5]
+	mi := &file_books_v1_catalog_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,7 +546,7 @@ func (x *MergeBooksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MergeBooksRequest.ProtoReflect.Descriptor instead.
 func (*MergeBooksRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{5}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MergeBooksRequest) GetWinnerBookId() string {
@@ -344,7 +596,7 @@ type MergeBooksResponse struct {
 
 func (x *MergeBooksResponse) Reset() {
 	*x = MergeBooksResponse{}
-	mi := &file_books_v1_catalog_proto_msgTypes[6]
+	mi := &file_books_v1_catalog_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -356,7 +608,7 @@ func (x *MergeBooksResponse) String() string {
 func (*MergeBooksResponse) ProtoMessage() {}
 
 func (x *MergeBooksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[6]
+	mi := &file_books_v1_catalog_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,7 +621,7 @@ func (x *MergeBooksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MergeBooksResponse.ProtoReflect.Descriptor instead.
 func (*MergeBooksResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{6}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *MergeBooksResponse) GetMergedGroups() uint32 {
@@ -400,7 +652,7 @@ type StartResyncRequest struct {
 
 func (x *StartResyncRequest) Reset() {
 	*x = StartResyncRequest{}
-	mi := &file_books_v1_catalog_proto_msgTypes[7]
+	mi := &file_books_v1_catalog_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -412,7 +664,7 @@ func (x *StartResyncRequest) String() string {
 func (*StartResyncRequest) ProtoMessage() {}
 
 func (x *StartResyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[7]
+	mi := &file_books_v1_catalog_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -425,7 +677,7 @@ func (x *StartResyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartResyncRequest.ProtoReflect.Descriptor instead.
 func (*StartResyncRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{7}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StartResyncRequest) GetForce() bool {
@@ -443,7 +695,7 @@ type StartResyncResponse struct {
 
 func (x *StartResyncResponse) Reset() {
 	*x = StartResyncResponse{}
-	mi := &file_books_v1_catalog_proto_msgTypes[8]
+	mi := &file_books_v1_catalog_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -455,7 +707,7 @@ func (x *StartResyncResponse) String() string {
 func (*StartResyncResponse) ProtoMessage() {}
 
 func (x *StartResyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[8]
+	mi := &file_books_v1_catalog_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,7 +720,7 @@ func (x *StartResyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartResyncResponse.ProtoReflect.Descriptor instead.
 func (*StartResyncResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{8}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{11}
 }
 
 // CancelResync stops an in-progress resync scan started by StartResync. A
@@ -482,7 +734,7 @@ type CancelResyncRequest struct {
 
 func (x *CancelResyncRequest) Reset() {
 	*x = CancelResyncRequest{}
-	mi := &file_books_v1_catalog_proto_msgTypes[9]
+	mi := &file_books_v1_catalog_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -494,7 +746,7 @@ func (x *CancelResyncRequest) String() string {
 func (*CancelResyncRequest) ProtoMessage() {}
 
 func (x *CancelResyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[9]
+	mi := &file_books_v1_catalog_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,7 +759,7 @@ func (x *CancelResyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelResyncRequest.ProtoReflect.Descriptor instead.
 func (*CancelResyncRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{9}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{12}
 }
 
 type CancelResyncResponse struct {
@@ -518,7 +770,7 @@ type CancelResyncResponse struct {
 
 func (x *CancelResyncResponse) Reset() {
 	*x = CancelResyncResponse{}
-	mi := &file_books_v1_catalog_proto_msgTypes[10]
+	mi := &file_books_v1_catalog_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -530,7 +782,7 @@ func (x *CancelResyncResponse) String() string {
 func (*CancelResyncResponse) ProtoMessage() {}
 
 func (x *CancelResyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[10]
+	mi := &file_books_v1_catalog_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,7 +795,7 @@ func (x *CancelResyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelResyncResponse.ProtoReflect.Descriptor instead.
 func (*CancelResyncResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{10}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{13}
 }
 
 // SourceBook is one candidate set of metadata for a catalog book — either the
@@ -566,14 +818,17 @@ type SourceBook struct {
 	// and for sources that only ever produce one candidate (the guarded
 	// search). The manual override search ("Search with these terms") can
 	// return up to 5 candidates per source, distinguished by this index.
-	Index         int32 `protobuf:"varint,9,opt,name=index,proto3" json:"index,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Index          int32    `protobuf:"varint,9,opt,name=index,proto3" json:"index,omitempty"`
+	Series         string   `protobuf:"bytes,10,opt,name=series,proto3" json:"series,omitempty"`
+	SeriesPosition *float64 `protobuf:"fixed64,11,opt,name=series_position,json=seriesPosition,proto3,oneof" json:"series_position,omitempty"`
+	SeriesTotal    int32    `protobuf:"varint,12,opt,name=series_total,json=seriesTotal,proto3" json:"series_total,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SourceBook) Reset() {
 	*x = SourceBook{}
-	mi := &file_books_v1_catalog_proto_msgTypes[11]
+	mi := &file_books_v1_catalog_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -585,7 +840,7 @@ func (x *SourceBook) String() string {
 func (*SourceBook) ProtoMessage() {}
 
 func (x *SourceBook) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[11]
+	mi := &file_books_v1_catalog_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,7 +853,7 @@ func (x *SourceBook) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceBook.ProtoReflect.Descriptor instead.
 func (*SourceBook) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{11}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SourceBook) GetSource() string {
@@ -664,6 +919,27 @@ func (x *SourceBook) GetIndex() int32 {
 	return 0
 }
 
+func (x *SourceBook) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *SourceBook) GetSeriesPosition() float64 {
+	if x != nil && x.SeriesPosition != nil {
+		return *x.SeriesPosition
+	}
+	return 0
+}
+
+func (x *SourceBook) GetSeriesTotal() int32 {
+	if x != nil {
+		return x.SeriesTotal
+	}
+	return 0
+}
+
 // ResyncProposal describes one catalog book that differs from at least one
 // external source, for the admin resync wizard to step through.
 type ResyncProposal struct {
@@ -677,7 +953,7 @@ type ResyncProposal struct {
 
 func (x *ResyncProposal) Reset() {
 	*x = ResyncProposal{}
-	mi := &file_books_v1_catalog_proto_msgTypes[12]
+	mi := &file_books_v1_catalog_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -689,7 +965,7 @@ func (x *ResyncProposal) String() string {
 func (*ResyncProposal) ProtoMessage() {}
 
 func (x *ResyncProposal) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[12]
+	mi := &file_books_v1_catalog_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +978,7 @@ func (x *ResyncProposal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResyncProposal.ProtoReflect.Descriptor instead.
 func (*ResyncProposal) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{12}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ResyncProposal) GetBookId() string {
@@ -734,7 +1010,7 @@ type ListResyncProposalsRequest struct {
 
 func (x *ListResyncProposalsRequest) Reset() {
 	*x = ListResyncProposalsRequest{}
-	mi := &file_books_v1_catalog_proto_msgTypes[13]
+	mi := &file_books_v1_catalog_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -746,7 +1022,7 @@ func (x *ListResyncProposalsRequest) String() string {
 func (*ListResyncProposalsRequest) ProtoMessage() {}
 
 func (x *ListResyncProposalsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[13]
+	mi := &file_books_v1_catalog_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -759,7 +1035,7 @@ func (x *ListResyncProposalsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListResyncProposalsRequest.ProtoReflect.Descriptor instead.
 func (*ListResyncProposalsRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{13}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{16}
 }
 
 type ListResyncProposalsResponse struct {
@@ -771,7 +1047,7 @@ type ListResyncProposalsResponse struct {
 
 func (x *ListResyncProposalsResponse) Reset() {
 	*x = ListResyncProposalsResponse{}
-	mi := &file_books_v1_catalog_proto_msgTypes[14]
+	mi := &file_books_v1_catalog_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -783,7 +1059,7 @@ func (x *ListResyncProposalsResponse) String() string {
 func (*ListResyncProposalsResponse) ProtoMessage() {}
 
 func (x *ListResyncProposalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[14]
+	mi := &file_books_v1_catalog_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -796,7 +1072,7 @@ func (x *ListResyncProposalsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListResyncProposalsResponse.ProtoReflect.Descriptor instead.
 func (*ListResyncProposalsResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{14}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListResyncProposalsResponse) GetProposals() []*ResyncProposal {
@@ -819,7 +1095,7 @@ type ApplyResyncChoiceRequest struct {
 
 func (x *ApplyResyncChoiceRequest) Reset() {
 	*x = ApplyResyncChoiceRequest{}
-	mi := &file_books_v1_catalog_proto_msgTypes[15]
+	mi := &file_books_v1_catalog_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -831,7 +1107,7 @@ func (x *ApplyResyncChoiceRequest) String() string {
 func (*ApplyResyncChoiceRequest) ProtoMessage() {}
 
 func (x *ApplyResyncChoiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[15]
+	mi := &file_books_v1_catalog_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,7 +1120,7 @@ func (x *ApplyResyncChoiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyResyncChoiceRequest.ProtoReflect.Descriptor instead.
 func (*ApplyResyncChoiceRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{15}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ApplyResyncChoiceRequest) GetBookId() string {
@@ -869,7 +1145,7 @@ type ApplyResyncChoiceResponse struct {
 
 func (x *ApplyResyncChoiceResponse) Reset() {
 	*x = ApplyResyncChoiceResponse{}
-	mi := &file_books_v1_catalog_proto_msgTypes[16]
+	mi := &file_books_v1_catalog_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -881,7 +1157,7 @@ func (x *ApplyResyncChoiceResponse) String() string {
 func (*ApplyResyncChoiceResponse) ProtoMessage() {}
 
 func (x *ApplyResyncChoiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[16]
+	mi := &file_books_v1_catalog_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,7 +1170,7 @@ func (x *ApplyResyncChoiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyResyncChoiceResponse.ProtoReflect.Descriptor instead.
 func (*ApplyResyncChoiceResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{16}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{19}
 }
 
 type SetBookISBNRequest struct {
@@ -907,7 +1183,7 @@ type SetBookISBNRequest struct {
 
 func (x *SetBookISBNRequest) Reset() {
 	*x = SetBookISBNRequest{}
-	mi := &file_books_v1_catalog_proto_msgTypes[17]
+	mi := &file_books_v1_catalog_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -919,7 +1195,7 @@ func (x *SetBookISBNRequest) String() string {
 func (*SetBookISBNRequest) ProtoMessage() {}
 
 func (x *SetBookISBNRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[17]
+	mi := &file_books_v1_catalog_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -932,7 +1208,7 @@ func (x *SetBookISBNRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetBookISBNRequest.ProtoReflect.Descriptor instead.
 func (*SetBookISBNRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{17}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SetBookISBNRequest) GetBookId() string {
@@ -957,7 +1233,7 @@ type SetBookISBNResponse struct {
 
 func (x *SetBookISBNResponse) Reset() {
 	*x = SetBookISBNResponse{}
-	mi := &file_books_v1_catalog_proto_msgTypes[18]
+	mi := &file_books_v1_catalog_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -969,7 +1245,7 @@ func (x *SetBookISBNResponse) String() string {
 func (*SetBookISBNResponse) ProtoMessage() {}
 
 func (x *SetBookISBNResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[18]
+	mi := &file_books_v1_catalog_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,7 +1258,7 @@ func (x *SetBookISBNResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetBookISBNResponse.ProtoReflect.Descriptor instead.
 func (*SetBookISBNResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{18}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{21}
 }
 
 // UpdateBook lets an admin hand-correct a catalog book's metadata directly,
@@ -1000,7 +1276,7 @@ type UpdateBookRequest struct {
 
 func (x *UpdateBookRequest) Reset() {
 	*x = UpdateBookRequest{}
-	mi := &file_books_v1_catalog_proto_msgTypes[19]
+	mi := &file_books_v1_catalog_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1012,7 +1288,7 @@ func (x *UpdateBookRequest) String() string {
 func (*UpdateBookRequest) ProtoMessage() {}
 
 func (x *UpdateBookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[19]
+	mi := &file_books_v1_catalog_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1025,7 +1301,7 @@ func (x *UpdateBookRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBookRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBookRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{19}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdateBookRequest) GetBookId() string {
@@ -1051,7 +1327,7 @@ type UpdateBookResponse struct {
 
 func (x *UpdateBookResponse) Reset() {
 	*x = UpdateBookResponse{}
-	mi := &file_books_v1_catalog_proto_msgTypes[20]
+	mi := &file_books_v1_catalog_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1063,7 +1339,7 @@ func (x *UpdateBookResponse) String() string {
 func (*UpdateBookResponse) ProtoMessage() {}
 
 func (x *UpdateBookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[20]
+	mi := &file_books_v1_catalog_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,7 +1352,7 @@ func (x *UpdateBookResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBookResponse.ProtoReflect.Descriptor instead.
 func (*UpdateBookResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{20}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpdateBookResponse) GetBook() *Book {
@@ -1104,7 +1380,7 @@ type GetBookSourcesRequest struct {
 
 func (x *GetBookSourcesRequest) Reset() {
 	*x = GetBookSourcesRequest{}
-	mi := &file_books_v1_catalog_proto_msgTypes[21]
+	mi := &file_books_v1_catalog_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1116,7 +1392,7 @@ func (x *GetBookSourcesRequest) String() string {
 func (*GetBookSourcesRequest) ProtoMessage() {}
 
 func (x *GetBookSourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[21]
+	mi := &file_books_v1_catalog_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,7 +1405,7 @@ func (x *GetBookSourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBookSourcesRequest.ProtoReflect.Descriptor instead.
 func (*GetBookSourcesRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{21}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetBookSourcesRequest) GetBookId() string {
@@ -1162,7 +1438,7 @@ type GetBookSourcesResponse struct {
 
 func (x *GetBookSourcesResponse) Reset() {
 	*x = GetBookSourcesResponse{}
-	mi := &file_books_v1_catalog_proto_msgTypes[22]
+	mi := &file_books_v1_catalog_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1174,7 +1450,7 @@ func (x *GetBookSourcesResponse) String() string {
 func (*GetBookSourcesResponse) ProtoMessage() {}
 
 func (x *GetBookSourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[22]
+	mi := &file_books_v1_catalog_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,7 +1463,7 @@ func (x *GetBookSourcesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBookSourcesResponse.ProtoReflect.Descriptor instead.
 func (*GetBookSourcesResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{22}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetBookSourcesResponse) GetProposal() *ResyncProposal {
@@ -1219,7 +1495,7 @@ type ApplyBookSourceRequest struct {
 
 func (x *ApplyBookSourceRequest) Reset() {
 	*x = ApplyBookSourceRequest{}
-	mi := &file_books_v1_catalog_proto_msgTypes[23]
+	mi := &file_books_v1_catalog_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1231,7 +1507,7 @@ func (x *ApplyBookSourceRequest) String() string {
 func (*ApplyBookSourceRequest) ProtoMessage() {}
 
 func (x *ApplyBookSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[23]
+	mi := &file_books_v1_catalog_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1244,7 +1520,7 @@ func (x *ApplyBookSourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyBookSourceRequest.ProtoReflect.Descriptor instead.
 func (*ApplyBookSourceRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{23}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ApplyBookSourceRequest) GetBookId() string {
@@ -1290,7 +1566,7 @@ type ApplyBookSourceResponse struct {
 
 func (x *ApplyBookSourceResponse) Reset() {
 	*x = ApplyBookSourceResponse{}
-	mi := &file_books_v1_catalog_proto_msgTypes[24]
+	mi := &file_books_v1_catalog_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1302,7 +1578,7 @@ func (x *ApplyBookSourceResponse) String() string {
 func (*ApplyBookSourceResponse) ProtoMessage() {}
 
 func (x *ApplyBookSourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[24]
+	mi := &file_books_v1_catalog_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1315,7 +1591,7 @@ func (x *ApplyBookSourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyBookSourceResponse.ProtoReflect.Descriptor instead.
 func (*ApplyBookSourceResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{24}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{27}
 }
 
 // GetSourceStats reports per-source coverage over the whole catalog, for
@@ -1328,7 +1604,7 @@ type GetSourceStatsRequest struct {
 
 func (x *GetSourceStatsRequest) Reset() {
 	*x = GetSourceStatsRequest{}
-	mi := &file_books_v1_catalog_proto_msgTypes[25]
+	mi := &file_books_v1_catalog_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1340,7 +1616,7 @@ func (x *GetSourceStatsRequest) String() string {
 func (*GetSourceStatsRequest) ProtoMessage() {}
 
 func (x *GetSourceStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[25]
+	mi := &file_books_v1_catalog_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1353,7 +1629,7 @@ func (x *GetSourceStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSourceStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetSourceStatsRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{25}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{28}
 }
 
 type SourceStat struct {
@@ -1373,7 +1649,7 @@ type SourceStat struct {
 
 func (x *SourceStat) Reset() {
 	*x = SourceStat{}
-	mi := &file_books_v1_catalog_proto_msgTypes[26]
+	mi := &file_books_v1_catalog_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1385,7 +1661,7 @@ func (x *SourceStat) String() string {
 func (*SourceStat) ProtoMessage() {}
 
 func (x *SourceStat) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[26]
+	mi := &file_books_v1_catalog_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1398,7 +1674,7 @@ func (x *SourceStat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceStat.ProtoReflect.Descriptor instead.
 func (*SourceStat) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{26}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SourceStat) GetSource() string {
@@ -1444,7 +1720,7 @@ type SourceComboStat struct {
 
 func (x *SourceComboStat) Reset() {
 	*x = SourceComboStat{}
-	mi := &file_books_v1_catalog_proto_msgTypes[27]
+	mi := &file_books_v1_catalog_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1456,7 +1732,7 @@ func (x *SourceComboStat) String() string {
 func (*SourceComboStat) ProtoMessage() {}
 
 func (x *SourceComboStat) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[27]
+	mi := &file_books_v1_catalog_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1469,7 +1745,7 @@ func (x *SourceComboStat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceComboStat.ProtoReflect.Descriptor instead.
 func (*SourceComboStat) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{27}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SourceComboStat) GetSources() []string {
@@ -1506,7 +1782,7 @@ type GetSourceStatsResponse struct {
 
 func (x *GetSourceStatsResponse) Reset() {
 	*x = GetSourceStatsResponse{}
-	mi := &file_books_v1_catalog_proto_msgTypes[28]
+	mi := &file_books_v1_catalog_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1518,7 +1794,7 @@ func (x *GetSourceStatsResponse) String() string {
 func (*GetSourceStatsResponse) ProtoMessage() {}
 
 func (x *GetSourceStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[28]
+	mi := &file_books_v1_catalog_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,7 +1807,7 @@ func (x *GetSourceStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSourceStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetSourceStatsResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{28}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetSourceStatsResponse) GetSources() []*SourceStat {
@@ -1590,7 +1866,7 @@ type ListBooksInExactSourcesRequest struct {
 
 func (x *ListBooksInExactSourcesRequest) Reset() {
 	*x = ListBooksInExactSourcesRequest{}
-	mi := &file_books_v1_catalog_proto_msgTypes[29]
+	mi := &file_books_v1_catalog_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1602,7 +1878,7 @@ func (x *ListBooksInExactSourcesRequest) String() string {
 func (*ListBooksInExactSourcesRequest) ProtoMessage() {}
 
 func (x *ListBooksInExactSourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[29]
+	mi := &file_books_v1_catalog_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1615,7 +1891,7 @@ func (x *ListBooksInExactSourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBooksInExactSourcesRequest.ProtoReflect.Descriptor instead.
 func (*ListBooksInExactSourcesRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{29}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ListBooksInExactSourcesRequest) GetSources() []string {
@@ -1634,7 +1910,7 @@ type ListBooksInExactSourcesResponse struct {
 
 func (x *ListBooksInExactSourcesResponse) Reset() {
 	*x = ListBooksInExactSourcesResponse{}
-	mi := &file_books_v1_catalog_proto_msgTypes[30]
+	mi := &file_books_v1_catalog_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1646,7 +1922,7 @@ func (x *ListBooksInExactSourcesResponse) String() string {
 func (*ListBooksInExactSourcesResponse) ProtoMessage() {}
 
 func (x *ListBooksInExactSourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_catalog_proto_msgTypes[30]
+	mi := &file_books_v1_catalog_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,7 +1935,7 @@ func (x *ListBooksInExactSourcesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBooksInExactSourcesResponse.ProtoReflect.Descriptor instead.
 func (*ListBooksInExactSourcesResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_catalog_proto_rawDescGZIP(), []int{30}
+	return file_books_v1_catalog_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ListBooksInExactSourcesResponse) GetBooks() []*Book {
@@ -1673,11 +1949,30 @@ var File_books_v1_catalog_proto protoreflect.FileDescriptor
 
 const file_books_v1_catalog_proto_rawDesc = "" +
 	"\n" +
-	"\x16books/v1/catalog.proto\x12\bbooks.v1\x1a\x16books/v1/library.proto\"/\n" +
-	"\x12ImportBooksRequest\x12\x19\n" +
-	"\bcsv_data\x18\x01 \x01(\fR\acsvData\"<\n" +
+	"\x16books/v1/catalog.proto\x12\bbooks.v1\x1a\x16books/v1/library.proto\"Y\n" +
+	"\x12ImportBooksRequest\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\x9a\x01\n" +
+	"\x0eImportPlanItem\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\aauthors\x18\x02 \x03(\tR\aauthors\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12(\n" +
+	"\x10existing_book_id\x18\x05 \x01(\tR\x0eexistingBookId\"\xf1\x01\n" +
 	"\x13ImportBooksResponse\x12%\n" +
-	"\x0eimported_count\x18\x01 \x01(\x05R\rimportedCount\"V\n" +
+	"\x0eimported_count\x18\x01 \x01(\x05R\rimportedCount\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12#\n" +
+	"\rcreated_count\x18\x03 \x01(\x05R\fcreatedCount\x12!\n" +
+	"\fmerged_count\x18\x04 \x01(\x05R\vmergedCount\x12#\n" +
+	"\rskipped_count\x18\x05 \x01(\x05R\fskippedCount\x12.\n" +
+	"\x05items\x18\x06 \x03(\v2\x18.books.v1.ImportPlanItemR\x05items\".\n" +
+	"\x14ExportLibraryRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\"j\n" +
+	"\x15ExportLibraryResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"V\n" +
 	"\x0eDuplicateGroup\x12,\n" +
 	"\aentries\x18\x01 \x03(\v2\x12.books.v1.UserBookR\aentries\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x17\n" +
@@ -1700,7 +1995,7 @@ const file_books_v1_catalog_proto_rawDesc = "" +
 	"\x05force\x18\x01 \x01(\bR\x05force\"\x15\n" +
 	"\x13StartResyncResponse\"\x15\n" +
 	"\x13CancelResyncRequest\"\x16\n" +
-	"\x14CancelResyncResponse\"\xfa\x01\n" +
+	"\x14CancelResyncResponse\"\xf7\x02\n" +
 	"\n" +
 	"SourceBook\x12\x16\n" +
 	"\x06source\x18\x01 \x01(\tR\x06source\x12\x1b\n" +
@@ -1712,7 +2007,12 @@ const file_books_v1_catalog_proto_rawDesc = "" +
 	"\x05title\x18\x06 \x01(\tR\x05title\x12\x18\n" +
 	"\aauthors\x18\a \x03(\tR\aauthors\x12\x18\n" +
 	"\adiffers\x18\b \x03(\tR\adiffers\x12\x14\n" +
-	"\x05index\x18\t \x01(\x05R\x05index\"\x89\x01\n" +
+	"\x05index\x18\t \x01(\x05R\x05index\x12\x16\n" +
+	"\x06series\x18\n" +
+	" \x01(\tR\x06series\x12,\n" +
+	"\x0fseries_position\x18\v \x01(\x01H\x00R\x0eseriesPosition\x88\x01\x01\x12!\n" +
+	"\fseries_total\x18\f \x01(\x05R\vseriesTotalB\x12\n" +
+	"\x10_series_position\"\x89\x01\n" +
 	"\x0eResyncProposal\x12\x17\n" +
 	"\abook_id\x18\x01 \x01(\tR\x06bookId\x12.\n" +
 	"\alibrary\x18\x02 \x01(\v2\x14.books.v1.SourceBookR\alibrary\x12.\n" +
@@ -1772,9 +2072,10 @@ const file_books_v1_catalog_proto_rawDesc = "" +
 	"\x1eListBooksInExactSourcesRequest\x12\x18\n" +
 	"\asources\x18\x01 \x03(\tR\asources\"G\n" +
 	"\x1fListBooksInExactSourcesResponse\x12$\n" +
-	"\x05books\x18\x01 \x03(\v2\x0e.books.v1.BookR\x05books2\xde\b\n" +
+	"\x05books\x18\x01 \x03(\v2\x0e.books.v1.BookR\x05books2\xb0\t\n" +
 	"\x0eCatalogService\x12J\n" +
-	"\vImportBooks\x12\x1c.books.v1.ImportBooksRequest\x1a\x1d.books.v1.ImportBooksResponse\x12S\n" +
+	"\vImportBooks\x12\x1c.books.v1.ImportBooksRequest\x1a\x1d.books.v1.ImportBooksResponse\x12P\n" +
+	"\rExportLibrary\x12\x1e.books.v1.ExportLibraryRequest\x1a\x1f.books.v1.ExportLibraryResponse\x12S\n" +
 	"\x0eFindDuplicates\x12\x1f.books.v1.FindDuplicatesRequest\x1a .books.v1.FindDuplicatesResponse\x12G\n" +
 	"\n" +
 	"MergeBooks\x12\x1b.books.v1.MergeBooksRequest\x1a\x1c.books.v1.MergeBooksResponse\x12J\n" +
@@ -1802,87 +2103,93 @@ func file_books_v1_catalog_proto_rawDescGZIP() []byte {
 	return file_books_v1_catalog_proto_rawDescData
 }
 
-var file_books_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_books_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_books_v1_catalog_proto_goTypes = []any{
 	(*ImportBooksRequest)(nil),              // 0: books.v1.ImportBooksRequest
-	(*ImportBooksResponse)(nil),             // 1: books.v1.ImportBooksResponse
-	(*DuplicateGroup)(nil),                  // 2: books.v1.DuplicateGroup
-	(*FindDuplicatesRequest)(nil),           // 3: books.v1.FindDuplicatesRequest
-	(*FindDuplicatesResponse)(nil),          // 4: books.v1.FindDuplicatesResponse
-	(*MergeBooksRequest)(nil),               // 5: books.v1.MergeBooksRequest
-	(*MergeBooksResponse)(nil),              // 6: books.v1.MergeBooksResponse
-	(*StartResyncRequest)(nil),              // 7: books.v1.StartResyncRequest
-	(*StartResyncResponse)(nil),             // 8: books.v1.StartResyncResponse
-	(*CancelResyncRequest)(nil),             // 9: books.v1.CancelResyncRequest
-	(*CancelResyncResponse)(nil),            // 10: books.v1.CancelResyncResponse
-	(*SourceBook)(nil),                      // 11: books.v1.SourceBook
-	(*ResyncProposal)(nil),                  // 12: books.v1.ResyncProposal
-	(*ListResyncProposalsRequest)(nil),      // 13: books.v1.ListResyncProposalsRequest
-	(*ListResyncProposalsResponse)(nil),     // 14: books.v1.ListResyncProposalsResponse
-	(*ApplyResyncChoiceRequest)(nil),        // 15: books.v1.ApplyResyncChoiceRequest
-	(*ApplyResyncChoiceResponse)(nil),       // 16: books.v1.ApplyResyncChoiceResponse
-	(*SetBookISBNRequest)(nil),              // 17: books.v1.SetBookISBNRequest
-	(*SetBookISBNResponse)(nil),             // 18: books.v1.SetBookISBNResponse
-	(*UpdateBookRequest)(nil),               // 19: books.v1.UpdateBookRequest
-	(*UpdateBookResponse)(nil),              // 20: books.v1.UpdateBookResponse
-	(*GetBookSourcesRequest)(nil),           // 21: books.v1.GetBookSourcesRequest
-	(*GetBookSourcesResponse)(nil),          // 22: books.v1.GetBookSourcesResponse
-	(*ApplyBookSourceRequest)(nil),          // 23: books.v1.ApplyBookSourceRequest
-	(*ApplyBookSourceResponse)(nil),         // 24: books.v1.ApplyBookSourceResponse
-	(*GetSourceStatsRequest)(nil),           // 25: books.v1.GetSourceStatsRequest
-	(*SourceStat)(nil),                      // 26: books.v1.SourceStat
-	(*SourceComboStat)(nil),                 // 27: books.v1.SourceComboStat
-	(*GetSourceStatsResponse)(nil),          // 28: books.v1.GetSourceStatsResponse
-	(*ListBooksInExactSourcesRequest)(nil),  // 29: books.v1.ListBooksInExactSourcesRequest
-	(*ListBooksInExactSourcesResponse)(nil), // 30: books.v1.ListBooksInExactSourcesResponse
-	(*UserBook)(nil),                        // 31: books.v1.UserBook
-	(*Book)(nil),                            // 32: books.v1.Book
+	(*ImportPlanItem)(nil),                  // 1: books.v1.ImportPlanItem
+	(*ImportBooksResponse)(nil),             // 2: books.v1.ImportBooksResponse
+	(*ExportLibraryRequest)(nil),            // 3: books.v1.ExportLibraryRequest
+	(*ExportLibraryResponse)(nil),           // 4: books.v1.ExportLibraryResponse
+	(*DuplicateGroup)(nil),                  // 5: books.v1.DuplicateGroup
+	(*FindDuplicatesRequest)(nil),           // 6: books.v1.FindDuplicatesRequest
+	(*FindDuplicatesResponse)(nil),          // 7: books.v1.FindDuplicatesResponse
+	(*MergeBooksRequest)(nil),               // 8: books.v1.MergeBooksRequest
+	(*MergeBooksResponse)(nil),              // 9: books.v1.MergeBooksResponse
+	(*StartResyncRequest)(nil),              // 10: books.v1.StartResyncRequest
+	(*StartResyncResponse)(nil),             // 11: books.v1.StartResyncResponse
+	(*CancelResyncRequest)(nil),             // 12: books.v1.CancelResyncRequest
+	(*CancelResyncResponse)(nil),            // 13: books.v1.CancelResyncResponse
+	(*SourceBook)(nil),                      // 14: books.v1.SourceBook
+	(*ResyncProposal)(nil),                  // 15: books.v1.ResyncProposal
+	(*ListResyncProposalsRequest)(nil),      // 16: books.v1.ListResyncProposalsRequest
+	(*ListResyncProposalsResponse)(nil),     // 17: books.v1.ListResyncProposalsResponse
+	(*ApplyResyncChoiceRequest)(nil),        // 18: books.v1.ApplyResyncChoiceRequest
+	(*ApplyResyncChoiceResponse)(nil),       // 19: books.v1.ApplyResyncChoiceResponse
+	(*SetBookISBNRequest)(nil),              // 20: books.v1.SetBookISBNRequest
+	(*SetBookISBNResponse)(nil),             // 21: books.v1.SetBookISBNResponse
+	(*UpdateBookRequest)(nil),               // 22: books.v1.UpdateBookRequest
+	(*UpdateBookResponse)(nil),              // 23: books.v1.UpdateBookResponse
+	(*GetBookSourcesRequest)(nil),           // 24: books.v1.GetBookSourcesRequest
+	(*GetBookSourcesResponse)(nil),          // 25: books.v1.GetBookSourcesResponse
+	(*ApplyBookSourceRequest)(nil),          // 26: books.v1.ApplyBookSourceRequest
+	(*ApplyBookSourceResponse)(nil),         // 27: books.v1.ApplyBookSourceResponse
+	(*GetSourceStatsRequest)(nil),           // 28: books.v1.GetSourceStatsRequest
+	(*SourceStat)(nil),                      // 29: books.v1.SourceStat
+	(*SourceComboStat)(nil),                 // 30: books.v1.SourceComboStat
+	(*GetSourceStatsResponse)(nil),          // 31: books.v1.GetSourceStatsResponse
+	(*ListBooksInExactSourcesRequest)(nil),  // 32: books.v1.ListBooksInExactSourcesRequest
+	(*ListBooksInExactSourcesResponse)(nil), // 33: books.v1.ListBooksInExactSourcesResponse
+	(*UserBook)(nil),                        // 34: books.v1.UserBook
+	(*Book)(nil),                            // 35: books.v1.Book
 }
 var file_books_v1_catalog_proto_depIdxs = []int32{
-	31, // 0: books.v1.DuplicateGroup.entries:type_name -> books.v1.UserBook
-	2,  // 1: books.v1.FindDuplicatesResponse.groups:type_name -> books.v1.DuplicateGroup
-	32, // 2: books.v1.MergeBooksRequest.resolved_metadata:type_name -> books.v1.Book
-	11, // 3: books.v1.ResyncProposal.library:type_name -> books.v1.SourceBook
-	11, // 4: books.v1.ResyncProposal.sources:type_name -> books.v1.SourceBook
-	12, // 5: books.v1.ListResyncProposalsResponse.proposals:type_name -> books.v1.ResyncProposal
-	32, // 6: books.v1.UpdateBookRequest.metadata:type_name -> books.v1.Book
-	32, // 7: books.v1.UpdateBookResponse.book:type_name -> books.v1.Book
-	12, // 8: books.v1.GetBookSourcesResponse.proposal:type_name -> books.v1.ResyncProposal
-	26, // 9: books.v1.GetSourceStatsResponse.sources:type_name -> books.v1.SourceStat
-	27, // 10: books.v1.GetSourceStatsResponse.overlaps:type_name -> books.v1.SourceComboStat
-	27, // 11: books.v1.GetSourceStatsResponse.missed_overlaps:type_name -> books.v1.SourceComboStat
-	32, // 12: books.v1.ListBooksInExactSourcesResponse.books:type_name -> books.v1.Book
-	0,  // 13: books.v1.CatalogService.ImportBooks:input_type -> books.v1.ImportBooksRequest
-	3,  // 14: books.v1.CatalogService.FindDuplicates:input_type -> books.v1.FindDuplicatesRequest
-	5,  // 15: books.v1.CatalogService.MergeBooks:input_type -> books.v1.MergeBooksRequest
-	7,  // 16: books.v1.CatalogService.StartResync:input_type -> books.v1.StartResyncRequest
-	9,  // 17: books.v1.CatalogService.CancelResync:input_type -> books.v1.CancelResyncRequest
-	13, // 18: books.v1.CatalogService.ListResyncProposals:input_type -> books.v1.ListResyncProposalsRequest
-	15, // 19: books.v1.CatalogService.ApplyResyncChoice:input_type -> books.v1.ApplyResyncChoiceRequest
-	17, // 20: books.v1.CatalogService.SetBookISBN:input_type -> books.v1.SetBookISBNRequest
-	19, // 21: books.v1.CatalogService.UpdateBook:input_type -> books.v1.UpdateBookRequest
-	21, // 22: books.v1.CatalogService.GetBookSources:input_type -> books.v1.GetBookSourcesRequest
-	23, // 23: books.v1.CatalogService.ApplyBookSource:input_type -> books.v1.ApplyBookSourceRequest
-	25, // 24: books.v1.CatalogService.GetSourceStats:input_type -> books.v1.GetSourceStatsRequest
-	29, // 25: books.v1.CatalogService.ListBooksInExactSources:input_type -> books.v1.ListBooksInExactSourcesRequest
-	1,  // 26: books.v1.CatalogService.ImportBooks:output_type -> books.v1.ImportBooksResponse
-	4,  // 27: books.v1.CatalogService.FindDuplicates:output_type -> books.v1.FindDuplicatesResponse
-	6,  // 28: books.v1.CatalogService.MergeBooks:output_type -> books.v1.MergeBooksResponse
-	8,  // 29: books.v1.CatalogService.StartResync:output_type -> books.v1.StartResyncResponse
-	10, // 30: books.v1.CatalogService.CancelResync:output_type -> books.v1.CancelResyncResponse
-	14, // 31: books.v1.CatalogService.ListResyncProposals:output_type -> books.v1.ListResyncProposalsResponse
-	16, // 32: books.v1.CatalogService.ApplyResyncChoice:output_type -> books.v1.ApplyResyncChoiceResponse
-	18, // 33: books.v1.CatalogService.SetBookISBN:output_type -> books.v1.SetBookISBNResponse
-	20, // 34: books.v1.CatalogService.UpdateBook:output_type -> books.v1.UpdateBookResponse
-	22, // 35: books.v1.CatalogService.GetBookSources:output_type -> books.v1.GetBookSourcesResponse
-	24, // 36: books.v1.CatalogService.ApplyBookSource:output_type -> books.v1.ApplyBookSourceResponse
-	28, // 37: books.v1.CatalogService.GetSourceStats:output_type -> books.v1.GetSourceStatsResponse
-	30, // 38: books.v1.CatalogService.ListBooksInExactSources:output_type -> books.v1.ListBooksInExactSourcesResponse
-	26, // [26:39] is the sub-list for method output_type
-	13, // [13:26] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	1,  // 0: books.v1.ImportBooksResponse.items:type_name -> books.v1.ImportPlanItem
+	34, // 1: books.v1.DuplicateGroup.entries:type_name -> books.v1.UserBook
+	5,  // 2: books.v1.FindDuplicatesResponse.groups:type_name -> books.v1.DuplicateGroup
+	35, // 3: books.v1.MergeBooksRequest.resolved_metadata:type_name -> books.v1.Book
+	14, // 4: books.v1.ResyncProposal.library:type_name -> books.v1.SourceBook
+	14, // 5: books.v1.ResyncProposal.sources:type_name -> books.v1.SourceBook
+	15, // 6: books.v1.ListResyncProposalsResponse.proposals:type_name -> books.v1.ResyncProposal
+	35, // 7: books.v1.UpdateBookRequest.metadata:type_name -> books.v1.Book
+	35, // 8: books.v1.UpdateBookResponse.book:type_name -> books.v1.Book
+	15, // 9: books.v1.GetBookSourcesResponse.proposal:type_name -> books.v1.ResyncProposal
+	29, // 10: books.v1.GetSourceStatsResponse.sources:type_name -> books.v1.SourceStat
+	30, // 11: books.v1.GetSourceStatsResponse.overlaps:type_name -> books.v1.SourceComboStat
+	30, // 12: books.v1.GetSourceStatsResponse.missed_overlaps:type_name -> books.v1.SourceComboStat
+	35, // 13: books.v1.ListBooksInExactSourcesResponse.books:type_name -> books.v1.Book
+	0,  // 14: books.v1.CatalogService.ImportBooks:input_type -> books.v1.ImportBooksRequest
+	3,  // 15: books.v1.CatalogService.ExportLibrary:input_type -> books.v1.ExportLibraryRequest
+	6,  // 16: books.v1.CatalogService.FindDuplicates:input_type -> books.v1.FindDuplicatesRequest
+	8,  // 17: books.v1.CatalogService.MergeBooks:input_type -> books.v1.MergeBooksRequest
+	10, // 18: books.v1.CatalogService.StartResync:input_type -> books.v1.StartResyncRequest
+	12, // 19: books.v1.CatalogService.CancelResync:input_type -> books.v1.CancelResyncRequest
+	16, // 20: books.v1.CatalogService.ListResyncProposals:input_type -> books.v1.ListResyncProposalsRequest
+	18, // 21: books.v1.CatalogService.ApplyResyncChoice:input_type -> books.v1.ApplyResyncChoiceRequest
+	20, // 22: books.v1.CatalogService.SetBookISBN:input_type -> books.v1.SetBookISBNRequest
+	22, // 23: books.v1.CatalogService.UpdateBook:input_type -> books.v1.UpdateBookRequest
+	24, // 24: books.v1.CatalogService.GetBookSources:input_type -> books.v1.GetBookSourcesRequest
+	26, // 25: books.v1.CatalogService.ApplyBookSource:input_type -> books.v1.ApplyBookSourceRequest
+	28, // 26: books.v1.CatalogService.GetSourceStats:input_type -> books.v1.GetSourceStatsRequest
+	32, // 27: books.v1.CatalogService.ListBooksInExactSources:input_type -> books.v1.ListBooksInExactSourcesRequest
+	2,  // 28: books.v1.CatalogService.ImportBooks:output_type -> books.v1.ImportBooksResponse
+	4,  // 29: books.v1.CatalogService.ExportLibrary:output_type -> books.v1.ExportLibraryResponse
+	7,  // 30: books.v1.CatalogService.FindDuplicates:output_type -> books.v1.FindDuplicatesResponse
+	9,  // 31: books.v1.CatalogService.MergeBooks:output_type -> books.v1.MergeBooksResponse
+	11, // 32: books.v1.CatalogService.StartResync:output_type -> books.v1.StartResyncResponse
+	13, // 33: books.v1.CatalogService.CancelResync:output_type -> books.v1.CancelResyncResponse
+	17, // 34: books.v1.CatalogService.ListResyncProposals:output_type -> books.v1.ListResyncProposalsResponse
+	19, // 35: books.v1.CatalogService.ApplyResyncChoice:output_type -> books.v1.ApplyResyncChoiceResponse
+	21, // 36: books.v1.CatalogService.SetBookISBN:output_type -> books.v1.SetBookISBNResponse
+	23, // 37: books.v1.CatalogService.UpdateBook:output_type -> books.v1.UpdateBookResponse
+	25, // 38: books.v1.CatalogService.GetBookSources:output_type -> books.v1.GetBookSourcesResponse
+	27, // 39: books.v1.CatalogService.ApplyBookSource:output_type -> books.v1.ApplyBookSourceResponse
+	31, // 40: books.v1.CatalogService.GetSourceStats:output_type -> books.v1.GetSourceStatsResponse
+	33, // 41: books.v1.CatalogService.ListBooksInExactSources:output_type -> books.v1.ListBooksInExactSourcesResponse
+	28, // [28:42] is the sub-list for method output_type
+	14, // [14:28] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_books_v1_catalog_proto_init() }
@@ -1891,16 +2198,17 @@ func file_books_v1_catalog_proto_init() {
 		return
 	}
 	file_books_v1_library_proto_init()
-	file_books_v1_catalog_proto_msgTypes[5].OneofWrappers = []any{}
-	file_books_v1_catalog_proto_msgTypes[21].OneofWrappers = []any{}
-	file_books_v1_catalog_proto_msgTypes[23].OneofWrappers = []any{}
+	file_books_v1_catalog_proto_msgTypes[8].OneofWrappers = []any{}
+	file_books_v1_catalog_proto_msgTypes[14].OneofWrappers = []any{}
+	file_books_v1_catalog_proto_msgTypes[24].OneofWrappers = []any{}
+	file_books_v1_catalog_proto_msgTypes[26].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_books_v1_catalog_proto_rawDesc), len(file_books_v1_catalog_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -568,6 +568,7 @@ type GetKEPUBStatusResponse struct {
 	HasEpub       bool                   `protobuf:"varint,1,opt,name=has_epub,json=hasEpub,proto3" json:"has_epub,omitempty"`
 	KepubStatus   string                 `protobuf:"bytes,2,opt,name=kepub_status,json=kepubStatus,proto3" json:"kepub_status,omitempty"`
 	HasPdf        bool                   `protobuf:"varint,3,opt,name=has_pdf,json=hasPdf,proto3" json:"has_pdf,omitempty"`
+	HasCbz        bool                   `protobuf:"varint,4,opt,name=has_cbz,json=hasCbz,proto3" json:"has_cbz,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -623,6 +624,13 @@ func (x *GetKEPUBStatusResponse) GetHasPdf() bool {
 	return false
 }
 
+func (x *GetKEPUBStatusResponse) GetHasCbz() bool {
+	if x != nil {
+		return x.HasCbz
+	}
+	return false
+}
+
 var File_books_v1_files_proto protoreflect.FileDescriptor
 
 const file_books_v1_files_proto_rawDesc = "" +
@@ -663,11 +671,12 @@ const file_books_v1_files_proto_rawDesc = "" +
 	"\x1eRequestKEPUBConversionResponse\x12!\n" +
 	"\fkepub_status\x18\x01 \x01(\tR\vkepubStatus\"0\n" +
 	"\x15GetKEPUBStatusRequest\x12\x17\n" +
-	"\abook_id\x18\x01 \x01(\tR\x06bookId\"o\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\"\x88\x01\n" +
 	"\x16GetKEPUBStatusResponse\x12\x19\n" +
 	"\bhas_epub\x18\x01 \x01(\bR\ahasEpub\x12!\n" +
 	"\fkepub_status\x18\x02 \x01(\tR\vkepubStatus\x12\x17\n" +
-	"\ahas_pdf\x18\x03 \x01(\bR\x06hasPdf2\xdc\x03\n" +
+	"\ahas_pdf\x18\x03 \x01(\bR\x06hasPdf\x12\x17\n" +
+	"\ahas_cbz\x18\x04 \x01(\bR\x06hasCbz2\xdc\x03\n" +
 	"\x10BookFilesService\x12Y\n" +
 	"\x10CreateBookUpload\x12!.books.v1.CreateBookUploadRequest\x1a\".books.v1.CreateBookUploadResponse\x12_\n" +
 	"\x12FinalizeBookUpload\x12#.books.v1.FinalizeBookUploadRequest\x1a$.books.v1.FinalizeBookUploadResponse\x12J\n" +
@@ -0,0 +1,547 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: books/v1/goals.proto
+
+package booksv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ReadingGoal is a target of books or pages to finish in a calendar year,
+// one of each kind per year. Public goals show on the shared reading
+// dashboard.
+type ReadingGoal struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Year  int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// "books" or "pages".
+	Kind          string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Target        int32  `protobuf:"varint,3,opt,name=target,proto3" json:"target,omitempty"`
+	Public        bool   `protobuf:"varint,4,opt,name=public,proto3" json:"public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadingGoal) Reset() {
+	*x = ReadingGoal{}
+	mi := &file_books_v1_goals_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadingGoal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingGoal) ProtoMessage() {}
+
+func (x *ReadingGoal) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_goals_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingGoal.ProtoReflect.Descriptor instead.
+func (*ReadingGoal) Descriptor() ([]byte, []int) {
+	return file_books_v1_goals_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReadingGoal) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *ReadingGoal) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReadingGoal) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *ReadingGoal) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+// ReadingGoalProgress is a goal with the books (or pages of books) finished
+// so far that year. expected is where an even pace through the year would
+// be today and projected the year-end total at the pace so far; pace is
+// "ahead", "on-track" or "behind".
+type ReadingGoalProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *ReadingGoal           `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	Current       int32                  `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	Expected      float64                `protobuf:"fixed64,3,opt,name=expected,proto3" json:"expected,omitempty"`
+	Projected     float64                `protobuf:"fixed64,4,opt,name=projected,proto3" json:"projected,omitempty"`
+	Pace          string                 `protobuf:"bytes,5,opt,name=pace,proto3" json:"pace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadingGoalProgress) Reset() {
+	*x = ReadingGoalProgress{}
+	mi := &file_books_v1_goals_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadingGoalProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingGoalProgress) ProtoMessage() {}
+
+func (x *ReadingGoalProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_goals_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingGoalProgress.ProtoReflect.Descriptor instead.
+func (*ReadingGoalProgress) Descriptor() ([]byte, []int) {
+	return file_books_v1_goals_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReadingGoalProgress) GetGoal() *ReadingGoal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+func (x *ReadingGoalProgress) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *ReadingGoalProgress) GetExpected() float64 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *ReadingGoalProgress) GetProjected() float64 {
+	if x != nil {
+		return x.Projected
+	}
+	return 0
+}
+
+func (x *ReadingGoalProgress) GetPace() string {
+	if x != nil {
+		return x.Pace
+	}
+	return ""
+}
+
+type SetReadingGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *ReadingGoal           `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReadingGoalRequest) Reset() {
+	*x = SetReadingGoalRequest{}
+	mi := &file_books_v1_goals_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReadingGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReadingGoalRequest) ProtoMessage() {}
+
+func (x *SetReadingGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_goals_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReadingGoalRequest.ProtoReflect.Descriptor instead.
+func (*SetReadingGoalRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_goals_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetReadingGoalRequest) GetGoal() *ReadingGoal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type SetReadingGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *ReadingGoal           `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReadingGoalResponse) Reset() {
+	*x = SetReadingGoalResponse{}
+	mi := &file_books_v1_goals_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReadingGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReadingGoalResponse) ProtoMessage() {}
+
+func (x *SetReadingGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_goals_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReadingGoalResponse.ProtoReflect.Descriptor instead.
+func (*SetReadingGoalResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_goals_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetReadingGoalResponse) GetGoal() *ReadingGoal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type DeleteReadingGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReadingGoalRequest) Reset() {
+	*x = DeleteReadingGoalRequest{}
+	mi := &file_books_v1_goals_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReadingGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReadingGoalRequest) ProtoMessage() {}
+
+func (x *DeleteReadingGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_goals_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReadingGoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReadingGoalRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_goals_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteReadingGoalRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *DeleteReadingGoalRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type DeleteReadingGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReadingGoalResponse) Reset() {
+	*x = DeleteReadingGoalResponse{}
+	mi := &file_books_v1_goals_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReadingGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReadingGoalResponse) ProtoMessage() {}
+
+func (x *DeleteReadingGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_goals_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReadingGoalResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReadingGoalResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_goals_proto_rawDescGZIP(), []int{5}
+}
+
+// GetReadingGoals returns the goals for a year (default: the current one)
+// with their progress.
+type GetReadingGoalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReadingGoalsRequest) Reset() {
+	*x = GetReadingGoalsRequest{}
+	mi := &file_books_v1_goals_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingGoalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingGoalsRequest) ProtoMessage() {}
+
+func (x *GetReadingGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_goals_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingGoalsRequest.ProtoReflect.Descriptor instead.
+func (*GetReadingGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_goals_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetReadingGoalsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type GetReadingGoalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Goals         []*ReadingGoalProgress `protobuf:"bytes,2,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReadingGoalsResponse) Reset() {
+	*x = GetReadingGoalsResponse{}
+	mi := &file_books_v1_goals_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingGoalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingGoalsResponse) ProtoMessage() {}
+
+func (x *GetReadingGoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_goals_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingGoalsResponse.ProtoReflect.Descriptor instead.
+func (*GetReadingGoalsResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_goals_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetReadingGoalsResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetReadingGoalsResponse) GetGoals() []*ReadingGoalProgress {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+var File_books_v1_goals_proto protoreflect.FileDescriptor
+
+const file_books_v1_goals_proto_rawDesc = "" +
+	"\n" +
+	"\x14books/v1/goals.proto\x12\bbooks.v1\"e\n" +
+	"\vReadingGoal\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\x05R\x06target\x12\x16\n" +
+	"\x06public\x18\x04 \x01(\bR\x06public\"\xa8\x01\n" +
+	"\x13ReadingGoalProgress\x12)\n" +
+	"\x04goal\x18\x01 \x01(\v2\x15.books.v1.ReadingGoalR\x04goal\x12\x18\n" +
+	"\acurrent\x18\x02 \x01(\x05R\acurrent\x12\x1a\n" +
+	"\bexpected\x18\x03 \x01(\x01R\bexpected\x12\x1c\n" +
+	"\tprojected\x18\x04 \x01(\x01R\tprojected\x12\x12\n" +
+	"\x04pace\x18\x05 \x01(\tR\x04pace\"B\n" +
+	"\x15SetReadingGoalRequest\x12)\n" +
+	"\x04goal\x18\x01 \x01(\v2\x15.books.v1.ReadingGoalR\x04goal\"C\n" +
+	"\x16SetReadingGoalResponse\x12)\n" +
+	"\x04goal\x18\x01 \x01(\v2\x15.books.v1.ReadingGoalR\x04goal\"B\n" +
+	"\x18DeleteReadingGoalRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\"\x1b\n" +
+	"\x19DeleteReadingGoalResponse\",\n" +
+	"\x16GetReadingGoalsRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\"b\n" +
+	"\x17GetReadingGoalsResponse\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x123\n" +
+	"\x05goals\x18\x02 \x03(\v2\x1d.books.v1.ReadingGoalProgressR\x05goals2\x99\x02\n" +
+	"\fGoalsService\x12S\n" +
+	"\x0eSetReadingGoal\x12\x1f.books.v1.SetReadingGoalRequest\x1a .books.v1.SetReadingGoalResponse\x12\\\n" +
+	"\x11DeleteReadingGoal\x12\".books.v1.DeleteReadingGoalRequest\x1a#.books.v1.DeleteReadingGoalResponse\x12V\n" +
+	"\x0fGetReadingGoals\x12 .books.v1.GetReadingGoalsRequest\x1a!.books.v1.GetReadingGoalsResponseB)Z'tools.xdoubleu.com/gen/books/v1;booksv1b\x06proto3"
+
+var (
+	file_books_v1_goals_proto_rawDescOnce sync.Once
+	file_books_v1_goals_proto_rawDescData []byte
+)
+
+func file_books_v1_goals_proto_rawDescGZIP() []byte {
+	file_books_v1_goals_proto_rawDescOnce.Do(func() {
+		file_books_v1_goals_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_books_v1_goals_proto_rawDesc), len(file_books_v1_goals_proto_rawDesc)))
+	})
+	return file_books_v1_goals_proto_rawDescData
+}
+
+var file_books_v1_goals_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_books_v1_goals_proto_goTypes = []any{
+	(*ReadingGoal)(nil),               // 0: books.v1.ReadingGoal
+	(*ReadingGoalProgress)(nil),       // 1: books.v1.ReadingGoalProgress
+	(*SetReadingGoalRequest)(nil),     // 2: books.v1.SetReadingGoalRequest
+	(*SetReadingGoalResponse)(nil),    // 3: books.v1.SetReadingGoalResponse
+	(*DeleteReadingGoalRequest)(nil),  // 4: books.v1.DeleteReadingGoalRequest
+	(*DeleteReadingGoalResponse)(nil), // 5: books.v1.DeleteReadingGoalResponse
+	(*GetReadingGoalsRequest)(nil),    // 6: books.v1.GetReadingGoalsRequest
+	(*GetReadingGoalsResponse)(nil),   // 7: books.v1.GetReadingGoalsResponse
+}
+var file_books_v1_goals_proto_depIdxs = []int32{
+	0, // 0: books.v1.ReadingGoalProgress.goal:type_name -> books.v1.ReadingGoal
+	0, // 1: books.v1.SetReadingGoalRequest.goal:type_name -> books.v1.ReadingGoal
+	0, // 2: books.v1.SetReadingGoalResponse.goal:type_name -> books.v1.ReadingGoal
+	1, // 3: books.v1.GetReadingGoalsResponse.goals:type_name -> books.v1.ReadingGoalProgress
+	2, // 4: books.v1.GoalsService.SetReadingGoal:input_type -> books.v1.SetReadingGoalRequest
+	4, // 5: books.v1.GoalsService.DeleteReadingGoal:input_type -> books.v1.DeleteReadingGoalRequest
+	6, // 6: books.v1.GoalsService.GetReadingGoals:input_type -> books.v1.GetReadingGoalsRequest
+	3, // 7: books.v1.GoalsService.SetReadingGoal:output_type -> books.v1.SetReadingGoalResponse
+	5, // 8: books.v1.GoalsService.DeleteReadingGoal:output_type -> books.v1.DeleteReadingGoalResponse
+	7, // 9: books.v1.GoalsService.GetReadingGoals:output_type -> books.v1.GetReadingGoalsResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_books_v1_goals_proto_init() }
+func file_books_v1_goals_proto_init() {
+	if File_books_v1_goals_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_books_v1_goals_proto_rawDesc), len(file_books_v1_goals_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_books_v1_goals_proto_goTypes,
+		DependencyIndexes: file_books_v1_goals_proto_depIdxs,
+		MessageInfos:      file_books_v1_goals_proto_msgTypes,
+	}.Build()
+	File_books_v1_goals_proto = out.File
+	file_books_v1_goals_proto_goTypes = nil
+	file_books_v1_goals_proto_depIdxs = nil
+}
@@ -29,8 +29,12 @@ type KoboDevice struct {
 	CreatedAt      string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	LastSeenAt     string                 `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
 	LoggingEnabled bool                   `protobuf:"varint,6,opt,name=logging_enabled,json=loggingEnabled,proto3" json:"logging_enabled,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Shelves (including the reading statuses) and tags whose books sync to
+	// this device. Both empty means every kobo-sync book.
+	SyncShelves   []string `protobuf:"bytes,7,rep,name=sync_shelves,json=syncShelves,proto3" json:"sync_shelves,omitempty"`
+	SyncTags      []string `protobuf:"bytes,8,rep,name=sync_tags,json=syncTags,proto3" json:"sync_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *KoboDevice) Reset() {
@@ -105,6 +109,20 @@ func (x *KoboDevice) GetLoggingEnabled() bool {
 	return false
 }
 
+func (x *KoboDevice) GetSyncShelves() []string {
+	if x != nil {
+		return x.SyncShelves
+	}
+	return nil
+}
+
+func (x *KoboDevice) GetSyncTags() []string {
+	if x != nil {
+		return x.SyncTags
+	}
+	return nil
+}
+
 // KoboLogEntry is a single captured device request/response pair, held in
 // memory while debug logging is enabled for a device.
 type KoboLogEntry struct {
@@ -291,6 +309,8 @@ type RegisterKoboDeviceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Serial        string                 `protobuf:"bytes,2,opt,name=serial,proto3" json:"serial,omitempty"`
+	SyncShelves   []string               `protobuf:"bytes,3,rep,name=sync_shelves,json=syncShelves,proto3" json:"sync_shelves,omitempty"`
+	SyncTags      []string               `protobuf:"bytes,4,rep,name=sync_tags,json=syncTags,proto3" json:"sync_tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -339,6 +359,20 @@ func (x *RegisterKoboDeviceRequest) GetSerial() string {
 	return ""
 }
 
+func (x *RegisterKoboDeviceRequest) GetSyncShelves() []string {
+	if x != nil {
+		return x.SyncShelves
+	}
+	return nil
+}
+
+func (x *RegisterKoboDeviceRequest) GetSyncTags() []string {
+	if x != nil {
+		return x.SyncTags
+	}
+	return nil
+}
+
 type RegisterKoboDeviceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Device        *KoboDevice            `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
@@ -551,6 +585,110 @@ func (*DisconnectKoboDeviceResponse) Descriptor() ([]byte, []int) {
 	return file_books_v1_kobo_proto_rawDescGZIP(), []int{9}
 }
 
+type SetKoboDeviceFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SyncShelves   []string               `protobuf:"bytes,2,rep,name=sync_shelves,json=syncShelves,proto3" json:"sync_shelves,omitempty"`
+	SyncTags      []string               `protobuf:"bytes,3,rep,name=sync_tags,json=syncTags,proto3" json:"sync_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetKoboDeviceFilterRequest) Reset() {
+	*x = SetKoboDeviceFilterRequest{}
+	mi := &file_books_v1_kobo_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetKoboDeviceFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKoboDeviceFilterRequest) ProtoMessage() {}
+
+func (x *SetKoboDeviceFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_kobo_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKoboDeviceFilterRequest.ProtoReflect.Descriptor instead.
+func (*SetKoboDeviceFilterRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_kobo_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetKoboDeviceFilterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetKoboDeviceFilterRequest) GetSyncShelves() []string {
+	if x != nil {
+		return x.SyncShelves
+	}
+	return nil
+}
+
+func (x *SetKoboDeviceFilterRequest) GetSyncTags() []string {
+	if x != nil {
+		return x.SyncTags
+	}
+	return nil
+}
+
+type SetKoboDeviceFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *KoboDevice            `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetKoboDeviceFilterResponse) Reset() {
+	*x = SetKoboDeviceFilterResponse{}
+	mi := &file_books_v1_kobo_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetKoboDeviceFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKoboDeviceFilterResponse) ProtoMessage() {}
+
+func (x *SetKoboDeviceFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_kobo_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKoboDeviceFilterResponse.ProtoReflect.Descriptor instead.
+func (*SetKoboDeviceFilterResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_kobo_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetKoboDeviceFilterResponse) GetDevice() *KoboDevice {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
 type SetKoboDeviceLoggingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -561,7 +699,7 @@ type SetKoboDeviceLoggingRequest struct {
 
 func (x *SetKoboDeviceLoggingRequest) Reset() {
 	*x = SetKoboDeviceLoggingRequest{}
-	mi := &file_books_v1_kobo_proto_msgTypes[10]
+	mi := &file_books_v1_kobo_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +711,7 @@ func (x *SetKoboDeviceLoggingRequest) String() string {
 func (*SetKoboDeviceLoggingRequest) ProtoMessage() {}
 
 func (x *SetKoboDeviceLoggingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_kobo_proto_msgTypes[10]
+	mi := &file_books_v1_kobo_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +724,7 @@ func (x *SetKoboDeviceLoggingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetKoboDeviceLoggingRequest.ProtoReflect.Descriptor instead.
 func (*SetKoboDeviceLoggingRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_kobo_proto_rawDescGZIP(), []int{10}
+	return file_books_v1_kobo_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SetKoboDeviceLoggingRequest) GetId() string {
@@ -611,7 +749,7 @@ type SetKoboDeviceLoggingResponse struct {
 
 func (x *SetKoboDeviceLoggingResponse) Reset() {
 	*x = SetKoboDeviceLoggingResponse{}
-	mi := &file_books_v1_kobo_proto_msgTypes[11]
+	mi := &file_books_v1_kobo_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -623,7 +761,7 @@ func (x *SetKoboDeviceLoggingResponse) String() string {
 func (*SetKoboDeviceLoggingResponse) ProtoMessage() {}
 
 func (x *SetKoboDeviceLoggingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_kobo_proto_msgTypes[11]
+	mi := &file_books_v1_kobo_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +774,7 @@ func (x *SetKoboDeviceLoggingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetKoboDeviceLoggingResponse.ProtoReflect.Descriptor instead.
 func (*SetKoboDeviceLoggingResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_kobo_proto_rawDescGZIP(), []int{11}
+	return file_books_v1_kobo_proto_rawDescGZIP(), []int{13}
 }
 
 type GetKoboDeviceLogsRequest struct {
@@ -648,7 +786,7 @@ type GetKoboDeviceLogsRequest struct {
 
 func (x *GetKoboDeviceLogsRequest) Reset() {
 	*x = GetKoboDeviceLogsRequest{}
-	mi := &file_books_v1_kobo_proto_msgTypes[12]
+	mi := &file_books_v1_kobo_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -660,7 +798,7 @@ func (x *GetKoboDeviceLogsRequest) String() string {
 func (*GetKoboDeviceLogsRequest) ProtoMessage() {}
 
 func (x *GetKoboDeviceLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_kobo_proto_msgTypes[12]
+	mi := &file_books_v1_kobo_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,7 +811,7 @@ func (x *GetKoboDeviceLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKoboDeviceLogsRequest.ProtoReflect.Descriptor instead.
 func (*GetKoboDeviceLogsRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_kobo_proto_rawDescGZIP(), []int{12}
+	return file_books_v1_kobo_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetKoboDeviceLogsRequest) GetId() string {
@@ -692,7 +830,7 @@ type GetKoboDeviceLogsResponse struct {
 
 func (x *GetKoboDeviceLogsResponse) Reset() {
 	*x = GetKoboDeviceLogsResponse{}
-	mi := &file_books_v1_kobo_proto_msgTypes[13]
+	mi := &file_books_v1_kobo_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -704,7 +842,7 @@ func (x *GetKoboDeviceLogsResponse) String() string {
 func (*GetKoboDeviceLogsResponse) ProtoMessage() {}
 
 func (x *GetKoboDeviceLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_kobo_proto_msgTypes[13]
+	mi := &file_books_v1_kobo_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +855,7 @@ func (x *GetKoboDeviceLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKoboDeviceLogsResponse.ProtoReflect.Descriptor instead.
 func (*GetKoboDeviceLogsResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_kobo_proto_rawDescGZIP(), []int{13}
+	return file_books_v1_kobo_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetKoboDeviceLogsResponse) GetEntries() []*KoboLogEntry {
@@ -736,7 +874,7 @@ type ClearKoboDeviceLogsRequest struct {
 
 func (x *ClearKoboDeviceLogsRequest) Reset() {
 	*x = ClearKoboDeviceLogsRequest{}
-	mi := &file_books_v1_kobo_proto_msgTypes[14]
+	mi := &file_books_v1_kobo_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -748,7 +886,7 @@ func (x *ClearKoboDeviceLogsRequest) String() string {
 func (*ClearKoboDeviceLogsRequest) ProtoMessage() {}
 
 func (x *ClearKoboDeviceLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_kobo_proto_msgTypes[14]
+	mi := &file_books_v1_kobo_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,7 +899,7 @@ func (x *ClearKoboDeviceLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearKoboDeviceLogsRequest.ProtoReflect.Descriptor instead.
 func (*ClearKoboDeviceLogsRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_kobo_proto_rawDescGZIP(), []int{14}
+	return file_books_v1_kobo_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ClearKoboDeviceLogsRequest) GetId() string {
@@ -779,7 +917,7 @@ type ClearKoboDeviceLogsResponse struct {
 
 func (x *ClearKoboDeviceLogsResponse) Reset() {
 	*x = ClearKoboDeviceLogsResponse{}
-	mi := &file_books_v1_kobo_proto_msgTypes[15]
+	mi := &file_books_v1_kobo_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -791,7 +929,7 @@ func (x *ClearKoboDeviceLogsResponse) String() string {
 func (*ClearKoboDeviceLogsResponse) ProtoMessage() {}
 
 func (x *ClearKoboDeviceLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_kobo_proto_msgTypes[15]
+	mi := &file_books_v1_kobo_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -804,14 +942,14 @@ func (x *ClearKoboDeviceLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearKoboDeviceLogsResponse.ProtoReflect.Descriptor instead.
 func (*ClearKoboDeviceLogsResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_kobo_proto_rawDescGZIP(), []int{15}
+	return file_books_v1_kobo_proto_rawDescGZIP(), []int{17}
 }
 
 var File_books_v1_kobo_proto protoreflect.FileDescriptor
 
 const file_books_v1_kobo_proto_rawDesc = "" +
 	"\n" +
-	"\x13books/v1/kobo.proto\x12\bbooks.v1\"\xb2\x01\n" +
+	"\x13books/v1/kobo.proto\x12\bbooks.v1\"\xf2\x01\n" +
 	"\n" +
 	"KoboDevice\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -821,7 +959,9 @@ const file_books_v1_kobo_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12 \n" +
 	"\flast_seen_at\x18\x05 \x01(\tR\n" +
 	"lastSeenAt\x12'\n" +
-	"\x0flogging_enabled\x18\x06 \x01(\bR\x0eloggingEnabled\"\xc4\x01\n" +
+	"\x0flogging_enabled\x18\x06 \x01(\bR\x0eloggingEnabled\x12!\n" +
+	"\fsync_shelves\x18\a \x03(\tR\vsyncShelves\x12\x1b\n" +
+	"\tsync_tags\x18\b \x03(\tR\bsyncTags\"\xc4\x01\n" +
 	"\fKoboLogEntry\x12\x12\n" +
 	"\x04time\x18\x01 \x01(\tR\x04time\x12\x16\n" +
 	"\x06method\x18\x02 \x01(\tR\x06method\x12\x12\n" +
@@ -833,10 +973,12 @@ const file_books_v1_kobo_proto_rawDesc = "" +
 	"\x15EnableKoboSyncRequest\x12\x17\n" +
 	"\abook_id\x18\x01 \x01(\tR\x06bookId\";\n" +
 	"\x16EnableKoboSyncResponse\x12!\n" +
-	"\fkepub_status\x18\x01 \x01(\tR\vkepubStatus\"G\n" +
+	"\fkepub_status\x18\x01 \x01(\tR\vkepubStatus\"\x87\x01\n" +
 	"\x19RegisterKoboDeviceRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
-	"\x06serial\x18\x02 \x01(\tR\x06serial\"g\n" +
+	"\x06serial\x18\x02 \x01(\tR\x06serial\x12!\n" +
+	"\fsync_shelves\x18\x03 \x03(\tR\vsyncShelves\x12\x1b\n" +
+	"\tsync_tags\x18\x04 \x03(\tR\bsyncTags\"g\n" +
 	"\x1aRegisterKoboDeviceResponse\x12,\n" +
 	"\x06device\x18\x01 \x01(\v2\x14.books.v1.KoboDeviceR\x06device\x12\x1b\n" +
 	"\traw_token\x18\x02 \x01(\tR\brawToken\"\x18\n" +
@@ -845,7 +987,13 @@ const file_books_v1_kobo_proto_rawDesc = "" +
 	"\adevices\x18\x01 \x03(\v2\x14.books.v1.KoboDeviceR\adevices\"-\n" +
 	"\x1bDisconnectKoboDeviceRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x1e\n" +
-	"\x1cDisconnectKoboDeviceResponse\"G\n" +
+	"\x1cDisconnectKoboDeviceResponse\"l\n" +
+	"\x1aSetKoboDeviceFilterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fsync_shelves\x18\x02 \x03(\tR\vsyncShelves\x12\x1b\n" +
+	"\tsync_tags\x18\x03 \x03(\tR\bsyncTags\"K\n" +
+	"\x1bSetKoboDeviceFilterResponse\x12,\n" +
+	"\x06device\x18\x01 \x01(\v2\x14.books.v1.KoboDeviceR\x06device\"G\n" +
 	"\x1bSetKoboDeviceLoggingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\aenabled\x18\x02 \x01(\bR\aenabled\"\x1e\n" +
@@ -856,12 +1004,13 @@ const file_books_v1_kobo_proto_rawDesc = "" +
 	"\aentries\x18\x01 \x03(\v2\x16.books.v1.KoboLogEntryR\aentries\",\n" +
 	"\x1aClearKoboDeviceLogsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x1d\n" +
-	"\x1bClearKoboDeviceLogsResponse2\xab\x05\n" +
+	"\x1bClearKoboDeviceLogsResponse2\x8f\x06\n" +
 	"\vKoboService\x12S\n" +
 	"\x0eEnableKoboSync\x12\x1f.books.v1.EnableKoboSyncRequest\x1a .books.v1.EnableKoboSyncResponse\x12_\n" +
 	"\x12RegisterKoboDevice\x12#.books.v1.RegisterKoboDeviceRequest\x1a$.books.v1.RegisterKoboDeviceResponse\x12V\n" +
 	"\x0fListKoboDevices\x12 .books.v1.ListKoboDevicesRequest\x1a!.books.v1.ListKoboDevicesResponse\x12e\n" +
-	"\x14DisconnectKoboDevice\x12%.books.v1.DisconnectKoboDeviceRequest\x1a&.books.v1.DisconnectKoboDeviceResponse\x12e\n" +
+	"\x14DisconnectKoboDevice\x12%.books.v1.DisconnectKoboDeviceRequest\x1a&.books.v1.DisconnectKoboDeviceResponse\x12b\n" +
+	"\x13SetKoboDeviceFilter\x12$.books.v1.SetKoboDeviceFilterRequest\x1a%.books.v1.SetKoboDeviceFilterResponse\x12e\n" +
 	"\x14SetKoboDeviceLogging\x12%.books.v1.SetKoboDeviceLoggingRequest\x1a&.books.v1.SetKoboDeviceLoggingResponse\x12\\\n" +
 	"\x11GetKoboDeviceLogs\x12\".books.v1.GetKoboDeviceLogsRequest\x1a#.books.v1.GetKoboDeviceLogsResponse\x12b\n" +
 	"\x13ClearKoboDeviceLogs\x12$.books.v1.ClearKoboDeviceLogsRequest\x1a%.books.v1.ClearKoboDeviceLogsResponseB)Z'tools.xdoubleu.com/gen/books/v1;booksv1b\x06proto3"
@@ -878,7 +1027,7 @@ func file_books_v1_kobo_proto_rawDescGZIP() []byte {
 	return file_books_v1_kobo_proto_rawDescData
 }
 
-var file_books_v1_kobo_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_books_v1_kobo_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_books_v1_kobo_proto_goTypes = []any{
 	(*KoboDevice)(nil),                   // 0: books.v1.KoboDevice
 	(*KoboLogEntry)(nil),                 // 1: books.v1.KoboLogEntry
@@ -890,36 +1039,41 @@ var file_books_v1_kobo_proto_goTypes = []any{
 	(*ListKoboDevicesResponse)(nil),      // 7: books.v1.ListKoboDevicesResponse
 	(*DisconnectKoboDeviceRequest)(nil),  // 8: books.v1.DisconnectKoboDeviceRequest
 	(*DisconnectKoboDeviceResponse)(nil), // 9: books.v1.DisconnectKoboDeviceResponse
-	(*SetKoboDeviceLoggingRequest)(nil),  // 10: books.v1.SetKoboDeviceLoggingRequest
-	(*SetKoboDeviceLoggingResponse)(nil), // 11: books.v1.SetKoboDeviceLoggingResponse
-	(*GetKoboDeviceLogsRequest)(nil),     // 12: books.v1.GetKoboDeviceLogsRequest
-	(*GetKoboDeviceLogsResponse)(nil),    // 13: books.v1.GetKoboDeviceLogsResponse
-	(*ClearKoboDeviceLogsRequest)(nil),   // 14: books.v1.ClearKoboDeviceLogsRequest
-	(*ClearKoboDeviceLogsResponse)(nil),  // 15: books.v1.ClearKoboDeviceLogsResponse
+	(*SetKoboDeviceFilterRequest)(nil),   // 10: books.v1.SetKoboDeviceFilterRequest
+	(*SetKoboDeviceFilterResponse)(nil),  // 11: books.v1.SetKoboDeviceFilterResponse
+	(*SetKoboDeviceLoggingRequest)(nil),  // 12: books.v1.SetKoboDeviceLoggingRequest
+	(*SetKoboDeviceLoggingResponse)(nil), // 13: books.v1.SetKoboDeviceLoggingResponse
+	(*GetKoboDeviceLogsRequest)(nil),     // 14: books.v1.GetKoboDeviceLogsRequest
+	(*GetKoboDeviceLogsResponse)(nil),    // 15: books.v1.GetKoboDeviceLogsResponse
+	(*ClearKoboDeviceLogsRequest)(nil),   // 16: books.v1.ClearKoboDeviceLogsRequest
+	(*ClearKoboDeviceLogsResponse)(nil),  // 17: books.v1.ClearKoboDeviceLogsResponse
 }
 var file_books_v1_kobo_proto_depIdxs = []int32{
 	0,  // 0: books.v1.RegisterKoboDeviceResponse.device:type_name -> books.v1.KoboDevice
 	0,  // 1: books.v1.ListKoboDevicesResponse.devices:type_name -> books.v1.KoboDevice
-	1,  // 2: books.v1.GetKoboDeviceLogsResponse.entries:type_name -> books.v1.KoboLogEntry
-	2,  // 3: books.v1.KoboService.EnableKoboSync:input_type -> books.v1.EnableKoboSyncRequest
-	4,  // 4: books.v1.KoboService.RegisterKoboDevice:input_type -> books.v1.RegisterKoboDeviceRequest
-	6,  // 5: books.v1.KoboService.ListKoboDevices:input_type -> books.v1.ListKoboDevicesRequest
-	8,  // 6: books.v1.KoboService.DisconnectKoboDevice:input_type -> books.v1.DisconnectKoboDeviceRequest
-	10, // 7: books.v1.KoboService.SetKoboDeviceLogging:input_type -> books.v1.SetKoboDeviceLoggingRequest
-	12, // 8: books.v1.KoboService.GetKoboDeviceLogs:input_type -> books.v1.GetKoboDeviceLogsRequest
-	14, // 9: books.v1.KoboService.ClearKoboDeviceLogs:input_type -> books.v1.ClearKoboDeviceLogsRequest
-	3,  // 10: books.v1.KoboService.EnableKoboSync:output_type -> books.v1.EnableKoboSyncResponse
-	5,  // 11: books.v1.KoboService.RegisterKoboDevice:output_type -> books.v1.RegisterKoboDeviceResponse
-	7,  // 12: books.v1.KoboService.ListKoboDevices:output_type -> books.v1.ListKoboDevicesResponse
-	9,  // 13: books.v1.KoboService.DisconnectKoboDevice:output_type -> books.v1.DisconnectKoboDeviceResponse
-	11, // 14: books.v1.KoboService.SetKoboDeviceLogging:output_type -> books.v1.SetKoboDeviceLoggingResponse
-	13, // 15: books.v1.KoboService.GetKoboDeviceLogs:output_type -> books.v1.GetKoboDeviceLogsResponse
-	15, // 16: books.v1.KoboService.ClearKoboDeviceLogs:output_type -> books.v1.ClearKoboDeviceLogsResponse
-	10, // [10:17] is the sub-list for method output_type
-	3,  // [3:10] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	0,  // 2: books.v1.SetKoboDeviceFilterResponse.device:type_name -> books.v1.KoboDevice
+	1,  // 3: books.v1.GetKoboDeviceLogsResponse.entries:type_name -> books.v1.KoboLogEntry
+	2,  // 4: books.v1.KoboService.EnableKoboSync:input_type -> books.v1.EnableKoboSyncRequest
+	4,  // 5: books.v1.KoboService.RegisterKoboDevice:input_type -> books.v1.RegisterKoboDeviceRequest
+	6,  // 6: books.v1.KoboService.ListKoboDevices:input_type -> books.v1.ListKoboDevicesRequest
+	8,  // 7: books.v1.KoboService.DisconnectKoboDevice:input_type -> books.v1.DisconnectKoboDeviceRequest
+	10, // 8: books.v1.KoboService.SetKoboDeviceFilter:input_type -> books.v1.SetKoboDeviceFilterRequest
+	12, // 9: books.v1.KoboService.SetKoboDeviceLogging:input_type -> books.v1.SetKoboDeviceLoggingRequest
+	14, // 10: books.v1.KoboService.GetKoboDeviceLogs:input_type -> books.v1.GetKoboDeviceLogsRequest
+	16, // 11: books.v1.KoboService.ClearKoboDeviceLogs:input_type -> books.v1.ClearKoboDeviceLogsRequest
+	3,  // 12: books.v1.KoboService.EnableKoboSync:output_type -> books.v1.EnableKoboSyncResponse
+	5,  // 13: books.v1.KoboService.RegisterKoboDevice:output_type -> books.v1.RegisterKoboDeviceResponse
+	7,  // 14: books.v1.KoboService.ListKoboDevices:output_type -> books.v1.ListKoboDevicesResponse
+	9,  // 15: books.v1.KoboService.DisconnectKoboDevice:output_type -> books.v1.DisconnectKoboDeviceResponse
+	11, // 16: books.v1.KoboService.SetKoboDeviceFilter:output_type -> books.v1.SetKoboDeviceFilterResponse
+	13, // 17: books.v1.KoboService.SetKoboDeviceLogging:output_type -> books.v1.SetKoboDeviceLoggingResponse
+	15, // 18: books.v1.KoboService.GetKoboDeviceLogs:output_type -> books.v1.GetKoboDeviceLogsResponse
+	17, // 19: books.v1.KoboService.ClearKoboDeviceLogs:output_type -> books.v1.ClearKoboDeviceLogsResponse
+	12, // [12:20] is the sub-list for method output_type
+	4,  // [4:12] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_books_v1_kobo_proto_init() }
@@ -933,7 +1087,7 @@ func file_books_v1_kobo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_books_v1_kobo_proto_rawDesc), len(file_books_v1_kobo_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,438 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: books/v1/koreader.proto
+
+package booksv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// KOReaderDevice is a set of credentials for KOReader's progress sync. The
+// password is generated by the server and only returned once, on creation.
+type KOReaderDevice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,4,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KOReaderDevice) Reset() {
+	*x = KOReaderDevice{}
+	mi := &file_books_v1_koreader_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KOReaderDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KOReaderDevice) ProtoMessage() {}
+
+func (x *KOReaderDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_koreader_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KOReaderDevice.ProtoReflect.Descriptor instead.
+func (*KOReaderDevice) Descriptor() ([]byte, []int) {
+	return file_books_v1_koreader_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KOReaderDevice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KOReaderDevice) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *KOReaderDevice) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *KOReaderDevice) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+type RegisterKOReaderDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterKOReaderDeviceRequest) Reset() {
+	*x = RegisterKOReaderDeviceRequest{}
+	mi := &file_books_v1_koreader_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterKOReaderDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterKOReaderDeviceRequest) ProtoMessage() {}
+
+func (x *RegisterKOReaderDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_koreader_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterKOReaderDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RegisterKOReaderDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_koreader_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterKOReaderDeviceRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type RegisterKOReaderDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *KOReaderDevice        `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterKOReaderDeviceResponse) Reset() {
+	*x = RegisterKOReaderDeviceResponse{}
+	mi := &file_books_v1_koreader_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterKOReaderDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterKOReaderDeviceResponse) ProtoMessage() {}
+
+func (x *RegisterKOReaderDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_koreader_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterKOReaderDeviceResponse.ProtoReflect.Descriptor instead.
+func (*RegisterKOReaderDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_koreader_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RegisterKOReaderDeviceResponse) GetDevice() *KOReaderDevice {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *RegisterKOReaderDeviceResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ListKOReaderDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKOReaderDevicesRequest) Reset() {
+	*x = ListKOReaderDevicesRequest{}
+	mi := &file_books_v1_koreader_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKOReaderDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKOReaderDevicesRequest) ProtoMessage() {}
+
+func (x *ListKOReaderDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_koreader_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKOReaderDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListKOReaderDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_koreader_proto_rawDescGZIP(), []int{3}
+}
+
+type ListKOReaderDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*KOReaderDevice      `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKOReaderDevicesResponse) Reset() {
+	*x = ListKOReaderDevicesResponse{}
+	mi := &file_books_v1_koreader_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKOReaderDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKOReaderDevicesResponse) ProtoMessage() {}
+
+func (x *ListKOReaderDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_koreader_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKOReaderDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListKOReaderDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_koreader_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListKOReaderDevicesResponse) GetDevices() []*KOReaderDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type DisconnectKOReaderDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectKOReaderDeviceRequest) Reset() {
+	*x = DisconnectKOReaderDeviceRequest{}
+	mi := &file_books_v1_koreader_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectKOReaderDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectKOReaderDeviceRequest) ProtoMessage() {}
+
+func (x *DisconnectKOReaderDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_koreader_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectKOReaderDeviceRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectKOReaderDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_koreader_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DisconnectKOReaderDeviceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DisconnectKOReaderDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectKOReaderDeviceResponse) Reset() {
+	*x = DisconnectKOReaderDeviceResponse{}
+	mi := &file_books_v1_koreader_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectKOReaderDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectKOReaderDeviceResponse) ProtoMessage() {}
+
+func (x *DisconnectKOReaderDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_koreader_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectKOReaderDeviceResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectKOReaderDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_koreader_proto_rawDescGZIP(), []int{6}
+}
+
+var File_books_v1_koreader_proto protoreflect.FileDescriptor
+
+const file_books_v1_koreader_proto_rawDesc = "" +
+	"\n" +
+	"\x17books/v1/koreader.proto\x12\bbooks.v1\"}\n" +
+	"\x0eKOReaderDevice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\x04 \x01(\tR\n" +
+	"lastUsedAt\";\n" +
+	"\x1dRegisterKOReaderDeviceRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"n\n" +
+	"\x1eRegisterKOReaderDeviceResponse\x120\n" +
+	"\x06device\x18\x01 \x01(\v2\x18.books.v1.KOReaderDeviceR\x06device\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x1c\n" +
+	"\x1aListKOReaderDevicesRequest\"Q\n" +
+	"\x1bListKOReaderDevicesResponse\x122\n" +
+	"\adevices\x18\x01 \x03(\v2\x18.books.v1.KOReaderDeviceR\adevices\"1\n" +
+	"\x1fDisconnectKOReaderDeviceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\"\n" +
+	" DisconnectKOReaderDeviceResponse2\xd5\x02\n" +
+	"\x0fKOReaderService\x12k\n" +
+	"\x16RegisterKOReaderDevice\x12'.books.v1.RegisterKOReaderDeviceRequest\x1a(.books.v1.RegisterKOReaderDeviceResponse\x12b\n" +
+	"\x13ListKOReaderDevices\x12$.books.v1.ListKOReaderDevicesRequest\x1a%.books.v1.ListKOReaderDevicesResponse\x12q\n" +
+	"\x18DisconnectKOReaderDevice\x12).books.v1.DisconnectKOReaderDeviceRequest\x1a*.books.v1.DisconnectKOReaderDeviceResponseB)Z'tools.xdoubleu.com/gen/books/v1;booksv1b\x06proto3"
+
+var (
+	file_books_v1_koreader_proto_rawDescOnce sync.Once
+	file_books_v1_koreader_proto_rawDescData []byte
+)
+
+func file_books_v1_koreader_proto_rawDescGZIP() []byte {
+	file_books_v1_koreader_proto_rawDescOnce.Do(func() {
+		file_books_v1_koreader_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_books_v1_koreader_proto_rawDesc), len(file_books_v1_koreader_proto_rawDesc)))
+	})
+	return file_books_v1_koreader_proto_rawDescData
+}
+
+var file_books_v1_koreader_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_books_v1_koreader_proto_goTypes = []any{
+	(*KOReaderDevice)(nil),                   // 0: books.v1.KOReaderDevice
+	(*RegisterKOReaderDeviceRequest)(nil),    // 1: books.v1.RegisterKOReaderDeviceRequest
+	(*RegisterKOReaderDeviceResponse)(nil),   // 2: books.v1.RegisterKOReaderDeviceResponse
+	(*ListKOReaderDevicesRequest)(nil),       // 3: books.v1.ListKOReaderDevicesRequest
+	(*ListKOReaderDevicesResponse)(nil),      // 4: books.v1.ListKOReaderDevicesResponse
+	(*DisconnectKOReaderDeviceRequest)(nil),  // 5: books.v1.DisconnectKOReaderDeviceRequest
+	(*DisconnectKOReaderDeviceResponse)(nil), // 6: books.v1.DisconnectKOReaderDeviceResponse
+}
+var file_books_v1_koreader_proto_depIdxs = []int32{
+	0, // 0: books.v1.RegisterKOReaderDeviceResponse.device:type_name -> books.v1.KOReaderDevice
+	0, // 1: books.v1.ListKOReaderDevicesResponse.devices:type_name -> books.v1.KOReaderDevice
+	1, // 2: books.v1.KOReaderService.RegisterKOReaderDevice:input_type -> books.v1.RegisterKOReaderDeviceRequest
+	3, // 3: books.v1.KOReaderService.ListKOReaderDevices:input_type -> books.v1.ListKOReaderDevicesRequest
+	5, // 4: books.v1.KOReaderService.DisconnectKOReaderDevice:input_type -> books.v1.DisconnectKOReaderDeviceRequest
+	2, // 5: books.v1.KOReaderService.RegisterKOReaderDevice:output_type -> books.v1.RegisterKOReaderDeviceResponse
+	4, // 6: books.v1.KOReaderService.ListKOReaderDevices:output_type -> books.v1.ListKOReaderDevicesResponse
+	6, // 7: books.v1.KOReaderService.DisconnectKOReaderDevice:output_type -> books.v1.DisconnectKOReaderDeviceResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_books_v1_koreader_proto_init() }
+func file_books_v1_koreader_proto_init() {
+	if File_books_v1_koreader_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_books_v1_koreader_proto_rawDesc), len(file_books_v1_koreader_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_books_v1_koreader_proto_goTypes,
+		DependencyIndexes: file_books_v1_koreader_proto_depIdxs,
+		MessageInfos:      file_books_v1_koreader_proto_msgTypes,
+	}.Build()
+	File_books_v1_koreader_proto = out.File
+	file_books_v1_koreader_proto_goTypes = nil
+	file_books_v1_koreader_proto_depIdxs = nil
+}
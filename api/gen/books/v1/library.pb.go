@@ -34,7 +34,14 @@ type Book struct {
 	SourceUrl string `protobuf:"bytes,11,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
 	// True once content extraction succeeded and in-app content is stored;
 	// false for plain books or ones where extraction failed/found nothing.
-	HasContent    bool `protobuf:"varint,12,opt,name=has_content,json=hasContent,proto3" json:"has_content,omitempty"`
+	HasContent bool `protobuf:"varint,12,opt,name=has_content,json=hasContent,proto3" json:"has_content,omitempty"`
+	// Series the book belongs to; empty when it is in none.
+	Series string `protobuf:"bytes,13,opt,name=series,proto3" json:"series,omitempty"`
+	// Position in the series, e.g. 2 or 2.5; unset when unknown.
+	SeriesPosition *float64 `protobuf:"fixed64,14,opt,name=series_position,json=seriesPosition,proto3,oneof" json:"series_position,omitempty"`
+	// Number of books in the series as a metadata source reports it; 0 when
+	// unknown.
+	SeriesTotal   int32 `protobuf:"varint,15,opt,name=series_total,json=seriesTotal,proto3" json:"series_total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -132,6 +139,27 @@ func (x *Book) GetHasContent() bool {
 	return false
 }
 
+func (x *Book) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *Book) GetSeriesPosition() float64 {
+	if x != nil && x.SeriesPosition != nil {
+		return *x.SeriesPosition
+	}
+	return 0
+}
+
+func (x *Book) GetSeriesTotal() int32 {
+	if x != nil {
+		return x.SeriesTotal
+	}
+	return 0
+}
+
 type UserBook struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -148,8 +176,14 @@ type UserBook struct {
 	CurrentPage     int32                  `protobuf:"varint,13,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
 	ProgressPercent int32                  `protobuf:"varint,14,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`
 	Formats         []string               `protobuf:"bytes,15,rep,name=formats,proto3" json:"formats,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// The feeds item the entry was sent from as an article; empty for
+	// anything else.
+	FeedItemId string `protobuf:"bytes,16,opt,name=feed_item_id,json=feedItemId,proto3" json:"feed_item_id,omitempty"`
+	// Place in the "up next" reading queue, from 0; unset when the book isn't
+	// queued.
+	QueuePosition *int32 `protobuf:"varint,17,opt,name=queue_position,json=queuePosition,proto3,oneof" json:"queue_position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserBook) Reset() {
@@ -280,6 +314,20 @@ func (x *UserBook) GetFormats() []string {
 	return nil
 }
 
+func (x *UserBook) GetFeedItemId() string {
+	if x != nil {
+		return x.FeedItemId
+	}
+	return ""
+}
+
+func (x *UserBook) GetQueuePosition() int32 {
+	if x != nil && x.QueuePosition != nil {
+		return *x.QueuePosition
+	}
+	return 0
+}
+
 type BookShelf struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -333,11 +381,19 @@ func (x *BookShelf) GetBooks() []*UserBook {
 }
 
 type LibraryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reading       []*UserBook            `protobuf:"bytes,1,rep,name=reading,proto3" json:"reading,omitempty"`
-	Wishlist      []*UserBook            `protobuf:"bytes,2,rep,name=wishlist,proto3" json:"wishlist,omitempty"`
-	Finished      []*UserBook            `protobuf:"bytes,3,rep,name=finished,proto3" json:"finished,omitempty"`
-	Shelves       []*BookShelf           `protobuf:"bytes,4,rep,name=shelves,proto3" json:"shelves,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Reading  []*UserBook            `protobuf:"bytes,1,rep,name=reading,proto3" json:"reading,omitempty"`
+	Wishlist []*UserBook            `protobuf:"bytes,2,rep,name=wishlist,proto3" json:"wishlist,omitempty"`
+	Finished []*UserBook            `protobuf:"bytes,3,rep,name=finished,proto3" json:"finished,omitempty"`
+	Shelves  []*BookShelf           `protobuf:"bytes,4,rep,name=shelves,proto3" json:"shelves,omitempty"`
+	// The next unread book of every series the user has started, in series
+	// name order.
+	NextInSeries []*UserBook `protobuf:"bytes,6,rep,name=next_in_series,json=nextInSeries,proto3" json:"next_in_series,omitempty"`
+	// The "up next" reading queue, first to last. Its books are also in
+	// wishlist, which lists them first, in the same order.
+	UpNext []*UserBook `protobuf:"bytes,7,rep,name=up_next,json=upNext,proto3" json:"up_next,omitempty"`
+	// Unset on a shared reading dashboard.
+	QueueSettings *QueueSettings `protobuf:"bytes,8,opt,name=queue_settings,json=queueSettings,proto3" json:"queue_settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -400,6 +456,83 @@ func (x *LibraryResponse) GetShelves() []*BookShelf {
 	return nil
 }
 
+func (x *LibraryResponse) GetNextInSeries() []*UserBook {
+	if x != nil {
+		return x.NextInSeries
+	}
+	return nil
+}
+
+func (x *LibraryResponse) GetUpNext() []*UserBook {
+	if x != nil {
+		return x.UpNext
+	}
+	return nil
+}
+
+func (x *LibraryResponse) GetQueueSettings() *QueueSettings {
+	if x != nil {
+		return x.QueueSettings
+	}
+	return nil
+}
+
+// QueueSettings are the reading queue rules. auto_advance moves the first
+// queued book to currently-reading when a book being read is finished;
+// kobo_sync_count is how many books from the top of the queue sync to Kobo
+// without the kobo-sync tag.
+type QueueSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AutoAdvance   bool                   `protobuf:"varint,1,opt,name=auto_advance,json=autoAdvance,proto3" json:"auto_advance,omitempty"`
+	KoboSyncCount int32                  `protobuf:"varint,2,opt,name=kobo_sync_count,json=koboSyncCount,proto3" json:"kobo_sync_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueSettings) Reset() {
+	*x = QueueSettings{}
+	mi := &file_books_v1_library_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueSettings) ProtoMessage() {}
+
+func (x *QueueSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueSettings.ProtoReflect.Descriptor instead.
+func (*QueueSettings) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueueSettings) GetAutoAdvance() bool {
+	if x != nil {
+		return x.AutoAdvance
+	}
+	return false
+}
+
+func (x *QueueSettings) GetKoboSyncCount() int32 {
+	if x != nil {
+		return x.KoboSyncCount
+	}
+	return 0
+}
+
 type BooksProgressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Labels        []string               `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
@@ -412,7 +545,7 @@ type BooksProgressResponse struct {
 
 func (x *BooksProgressResponse) Reset() {
 	*x = BooksProgressResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[4]
+	mi := &file_books_v1_library_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -424,7 +557,7 @@ func (x *BooksProgressResponse) String() string {
 func (*BooksProgressResponse) ProtoMessage() {}
 
 func (x *BooksProgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[4]
+	mi := &file_books_v1_library_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,7 +570,7 @@ func (x *BooksProgressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BooksProgressResponse.ProtoReflect.Descriptor instead.
 func (*BooksProgressResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{4}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BooksProgressResponse) GetLabels() []string {
@@ -483,7 +616,7 @@ type ExternalBookResult struct {
 
 func (x *ExternalBookResult) Reset() {
 	*x = ExternalBookResult{}
-	mi := &file_books_v1_library_proto_msgTypes[5]
+	mi := &file_books_v1_library_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -495,7 +628,7 @@ func (x *ExternalBookResult) String() string {
 func (*ExternalBookResult) ProtoMessage() {}
 
 func (x *ExternalBookResult) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[5]
+	mi := &file_books_v1_library_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,7 +641,7 @@ func (x *ExternalBookResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExternalBookResult.ProtoReflect.Descriptor instead.
 func (*ExternalBookResult) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{5}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ExternalBookResult) GetProvider() string {
@@ -572,7 +705,7 @@ type BookReadingStateData struct {
 
 func (x *BookReadingStateData) Reset() {
 	*x = BookReadingStateData{}
-	mi := &file_books_v1_library_proto_msgTypes[6]
+	mi := &file_books_v1_library_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -584,7 +717,7 @@ func (x *BookReadingStateData) String() string {
 func (*BookReadingStateData) ProtoMessage() {}
 
 func (x *BookReadingStateData) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[6]
+	mi := &file_books_v1_library_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,7 +730,7 @@ func (x *BookReadingStateData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BookReadingStateData.ProtoReflect.Descriptor instead.
 func (*BookReadingStateData) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{6}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BookReadingStateData) GetSource() string {
@@ -636,7 +769,7 @@ type GetLibraryRequest struct {
 
 func (x *GetLibraryRequest) Reset() {
 	*x = GetLibraryRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[7]
+	mi := &file_books_v1_library_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -648,7 +781,7 @@ func (x *GetLibraryRequest) String() string {
 func (*GetLibraryRequest) ProtoMessage() {}
 
 func (x *GetLibraryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[7]
+	mi := &file_books_v1_library_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -661,7 +794,7 @@ func (x *GetLibraryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLibraryRequest.ProtoReflect.Descriptor instead.
 func (*GetLibraryRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{7}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{8}
 }
 
 type GetLibraryResponse struct {
@@ -673,7 +806,7 @@ type GetLibraryResponse struct {
 
 func (x *GetLibraryResponse) Reset() {
 	*x = GetLibraryResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[8]
+	mi := &file_books_v1_library_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -685,7 +818,7 @@ func (x *GetLibraryResponse) String() string {
 func (*GetLibraryResponse) ProtoMessage() {}
 
 func (x *GetLibraryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[8]
+	mi := &file_books_v1_library_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +831,7 @@ func (x *GetLibraryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLibraryResponse.ProtoReflect.Descriptor instead.
 func (*GetLibraryResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{8}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetLibraryResponse) GetLibrary() *LibraryResponse {
@@ -718,7 +851,7 @@ type GetBooksProgressRequest struct {
 
 func (x *GetBooksProgressRequest) Reset() {
 	*x = GetBooksProgressRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[9]
+	mi := &file_books_v1_library_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -730,7 +863,7 @@ func (x *GetBooksProgressRequest) String() string {
 func (*GetBooksProgressRequest) ProtoMessage() {}
 
 func (x *GetBooksProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[9]
+	mi := &file_books_v1_library_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,7 +876,7 @@ func (x *GetBooksProgressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBooksProgressRequest.ProtoReflect.Descriptor instead.
 func (*GetBooksProgressRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{9}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetBooksProgressRequest) GetDateStart() string {
@@ -769,7 +902,7 @@ type GetBooksProgressResponse struct {
 
 func (x *GetBooksProgressResponse) Reset() {
 	*x = GetBooksProgressResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[10]
+	mi := &file_books_v1_library_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -781,7 +914,7 @@ func (x *GetBooksProgressResponse) String() string {
 func (*GetBooksProgressResponse) ProtoMessage() {}
 
 func (x *GetBooksProgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[10]
+	mi := &file_books_v1_library_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -794,7 +927,7 @@ func (x *GetBooksProgressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBooksProgressResponse.ProtoReflect.Descriptor instead.
 func (*GetBooksProgressResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{10}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetBooksProgressResponse) GetProgress() *BooksProgressResponse {
@@ -815,7 +948,7 @@ type SearchLibraryRequest struct {
 
 func (x *SearchLibraryRequest) Reset() {
 	*x = SearchLibraryRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[11]
+	mi := &file_books_v1_library_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -827,7 +960,7 @@ func (x *SearchLibraryRequest) String() string {
 func (*SearchLibraryRequest) ProtoMessage() {}
 
 func (x *SearchLibraryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[11]
+	mi := &file_books_v1_library_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +973,7 @@ func (x *SearchLibraryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchLibraryRequest.ProtoReflect.Descriptor instead.
 func (*SearchLibraryRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{11}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SearchLibraryRequest) GetQuery() string {
@@ -874,7 +1007,7 @@ type SearchLibraryResponse struct {
 
 func (x *SearchLibraryResponse) Reset() {
 	*x = SearchLibraryResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[12]
+	mi := &file_books_v1_library_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -886,7 +1019,7 @@ func (x *SearchLibraryResponse) String() string {
 func (*SearchLibraryResponse) ProtoMessage() {}
 
 func (x *SearchLibraryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[12]
+	mi := &file_books_v1_library_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,7 +1032,7 @@ func (x *SearchLibraryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchLibraryResponse.ProtoReflect.Descriptor instead.
 func (*SearchLibraryResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{12}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SearchLibraryResponse) GetBooks() []*UserBook {
@@ -925,7 +1058,7 @@ type SearchExternalRequest struct {
 
 func (x *SearchExternalRequest) Reset() {
 	*x = SearchExternalRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[13]
+	mi := &file_books_v1_library_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -937,7 +1070,7 @@ func (x *SearchExternalRequest) String() string {
 func (*SearchExternalRequest) ProtoMessage() {}
 
 func (x *SearchExternalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[13]
+	mi := &file_books_v1_library_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,7 +1083,7 @@ func (x *SearchExternalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchExternalRequest.ProtoReflect.Descriptor instead.
 func (*SearchExternalRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{13}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SearchExternalRequest) GetQuery() string {
@@ -969,7 +1102,7 @@ type SearchExternalResponse struct {
 
 func (x *SearchExternalResponse) Reset() {
 	*x = SearchExternalResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[14]
+	mi := &file_books_v1_library_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -981,7 +1114,7 @@ func (x *SearchExternalResponse) String() string {
 func (*SearchExternalResponse) ProtoMessage() {}
 
 func (x *SearchExternalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[14]
+	mi := &file_books_v1_library_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,7 +1127,7 @@ func (x *SearchExternalResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchExternalResponse.ProtoReflect.Descriptor instead.
 func (*SearchExternalResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{14}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SearchExternalResponse) GetResults() []*ExternalBookResult {
@@ -1014,7 +1147,7 @@ type GetExternalBookRequest struct {
 
 func (x *GetExternalBookRequest) Reset() {
 	*x = GetExternalBookRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[15]
+	mi := &file_books_v1_library_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1026,7 +1159,7 @@ func (x *GetExternalBookRequest) String() string {
 func (*GetExternalBookRequest) ProtoMessage() {}
 
 func (x *GetExternalBookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[15]
+	mi := &file_books_v1_library_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1039,7 +1172,7 @@ func (x *GetExternalBookRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExternalBookRequest.ProtoReflect.Descriptor instead.
 func (*GetExternalBookRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{15}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetExternalBookRequest) GetProvider() string {
@@ -1065,7 +1198,7 @@ type GetExternalBookResponse struct {
 
 func (x *GetExternalBookResponse) Reset() {
 	*x = GetExternalBookResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[16]
+	mi := &file_books_v1_library_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1077,7 +1210,7 @@ func (x *GetExternalBookResponse) String() string {
 func (*GetExternalBookResponse) ProtoMessage() {}
 
 func (x *GetExternalBookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[16]
+	mi := &file_books_v1_library_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,7 +1223,7 @@ func (x *GetExternalBookResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExternalBookResponse.ProtoReflect.Descriptor instead.
 func (*GetExternalBookResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{16}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetExternalBookResponse) GetResult() *ExternalBookResult {
@@ -1118,7 +1251,7 @@ type CreateBookRequest struct {
 
 func (x *CreateBookRequest) Reset() {
 	*x = CreateBookRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[17]
+	mi := &file_books_v1_library_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1130,7 +1263,7 @@ func (x *CreateBookRequest) String() string {
 func (*CreateBookRequest) ProtoMessage() {}
 
 func (x *CreateBookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[17]
+	mi := &file_books_v1_library_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,7 +1276,7 @@ func (x *CreateBookRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBookRequest.ProtoReflect.Descriptor instead.
 func (*CreateBookRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{17}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CreateBookRequest) GetProvider() string {
@@ -1224,7 +1357,7 @@ type CreateBookResponse struct {
 
 func (x *CreateBookResponse) Reset() {
 	*x = CreateBookResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[18]
+	mi := &file_books_v1_library_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1236,7 +1369,7 @@ func (x *CreateBookResponse) String() string {
 func (*CreateBookResponse) ProtoMessage() {}
 
 func (x *CreateBookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[18]
+	mi := &file_books_v1_library_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,7 +1382,7 @@ func (x *CreateBookResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBookResponse.ProtoReflect.Descriptor instead.
 func (*CreateBookResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{18}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{19}
 }
 
 type UpdateBookStatusRequest struct {
@@ -1264,7 +1397,7 @@ type UpdateBookStatusRequest struct {
 
 func (x *UpdateBookStatusRequest) Reset() {
 	*x = UpdateBookStatusRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[19]
+	mi := &file_books_v1_library_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1276,7 +1409,7 @@ func (x *UpdateBookStatusRequest) String() string {
 func (*UpdateBookStatusRequest) ProtoMessage() {}
 
 func (x *UpdateBookStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[19]
+	mi := &file_books_v1_library_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1289,7 +1422,7 @@ func (x *UpdateBookStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBookStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBookStatusRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{19}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateBookStatusRequest) GetBookId() string {
@@ -1328,7 +1461,7 @@ type UpdateBookStatusResponse struct {
 
 func (x *UpdateBookStatusResponse) Reset() {
 	*x = UpdateBookStatusResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[20]
+	mi := &file_books_v1_library_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1340,7 +1473,7 @@ func (x *UpdateBookStatusResponse) String() string {
 func (*UpdateBookStatusResponse) ProtoMessage() {}
 
 func (x *UpdateBookStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[20]
+	mi := &file_books_v1_library_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1353,7 +1486,7 @@ func (x *UpdateBookStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBookStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateBookStatusResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{20}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{21}
 }
 
 type ToggleTagRequest struct {
@@ -1366,7 +1499,7 @@ type ToggleTagRequest struct {
 
 func (x *ToggleTagRequest) Reset() {
 	*x = ToggleTagRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[21]
+	mi := &file_books_v1_library_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1378,7 +1511,7 @@ func (x *ToggleTagRequest) String() string {
 func (*ToggleTagRequest) ProtoMessage() {}
 
 func (x *ToggleTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[21]
+	mi := &file_books_v1_library_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1391,7 +1524,7 @@ func (x *ToggleTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToggleTagRequest.ProtoReflect.Descriptor instead.
 func (*ToggleTagRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{21}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ToggleTagRequest) GetBookId() string {
@@ -1416,7 +1549,7 @@ type ToggleTagResponse struct {
 
 func (x *ToggleTagResponse) Reset() {
 	*x = ToggleTagResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[22]
+	mi := &file_books_v1_library_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1428,7 +1561,7 @@ func (x *ToggleTagResponse) String() string {
 func (*ToggleTagResponse) ProtoMessage() {}
 
 func (x *ToggleTagResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[22]
+	mi := &file_books_v1_library_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1441,7 +1574,7 @@ func (x *ToggleTagResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToggleTagResponse.ProtoReflect.Descriptor instead.
 func (*ToggleTagResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{22}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{23}
 }
 
 // RemoveBook removes the book from the caller's own library only. If no other
@@ -1456,7 +1589,7 @@ type RemoveBookRequest struct {
 
 func (x *RemoveBookRequest) Reset() {
 	*x = RemoveBookRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[23]
+	mi := &file_books_v1_library_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1468,7 +1601,7 @@ func (x *RemoveBookRequest) String() string {
 func (*RemoveBookRequest) ProtoMessage() {}
 
 func (x *RemoveBookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[23]
+	mi := &file_books_v1_library_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1481,7 +1614,7 @@ func (x *RemoveBookRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveBookRequest.ProtoReflect.Descriptor instead.
 func (*RemoveBookRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{23}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RemoveBookRequest) GetBookId() string {
@@ -1499,7 +1632,7 @@ type RemoveBookResponse struct {
 
 func (x *RemoveBookResponse) Reset() {
 	*x = RemoveBookResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[24]
+	mi := &file_books_v1_library_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1511,7 +1644,7 @@ func (x *RemoveBookResponse) String() string {
 func (*RemoveBookResponse) ProtoMessage() {}
 
 func (x *RemoveBookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[24]
+	mi := &file_books_v1_library_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1524,7 +1657,7 @@ func (x *RemoveBookResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveBookResponse.ProtoReflect.Descriptor instead.
 func (*RemoveBookResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{24}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{25}
 }
 
 type UpdateFinishedAtRequest struct {
@@ -1537,7 +1670,7 @@ type UpdateFinishedAtRequest struct {
 
 func (x *UpdateFinishedAtRequest) Reset() {
 	*x = UpdateFinishedAtRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[25]
+	mi := &file_books_v1_library_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1549,7 +1682,7 @@ func (x *UpdateFinishedAtRequest) String() string {
 func (*UpdateFinishedAtRequest) ProtoMessage() {}
 
 func (x *UpdateFinishedAtRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[25]
+	mi := &file_books_v1_library_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1562,7 +1695,7 @@ func (x *UpdateFinishedAtRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFinishedAtRequest.ProtoReflect.Descriptor instead.
 func (*UpdateFinishedAtRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{25}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *UpdateFinishedAtRequest) GetBookId() string {
@@ -1587,7 +1720,7 @@ type UpdateFinishedAtResponse struct {
 
 func (x *UpdateFinishedAtResponse) Reset() {
 	*x = UpdateFinishedAtResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[26]
+	mi := &file_books_v1_library_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1599,7 +1732,7 @@ func (x *UpdateFinishedAtResponse) String() string {
 func (*UpdateFinishedAtResponse) ProtoMessage() {}
 
 func (x *UpdateFinishedAtResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[26]
+	mi := &file_books_v1_library_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1612,7 +1745,7 @@ func (x *UpdateFinishedAtResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFinishedAtResponse.ProtoReflect.Descriptor instead.
 func (*UpdateFinishedAtResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{26}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{27}
 }
 
 type UpdateProgressRequest struct {
@@ -1627,7 +1760,7 @@ type UpdateProgressRequest struct {
 
 func (x *UpdateProgressRequest) Reset() {
 	*x = UpdateProgressRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[27]
+	mi := &file_books_v1_library_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1639,7 +1772,7 @@ func (x *UpdateProgressRequest) String() string {
 func (*UpdateProgressRequest) ProtoMessage() {}
 
 func (x *UpdateProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[27]
+	mi := &file_books_v1_library_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1652,7 +1785,7 @@ func (x *UpdateProgressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProgressRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProgressRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{27}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateProgressRequest) GetBookId() string {
@@ -1691,7 +1824,7 @@ type UpdateProgressResponse struct {
 
 func (x *UpdateProgressResponse) Reset() {
 	*x = UpdateProgressResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[28]
+	mi := &file_books_v1_library_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1703,7 +1836,7 @@ func (x *UpdateProgressResponse) String() string {
 func (*UpdateProgressResponse) ProtoMessage() {}
 
 func (x *UpdateProgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[28]
+	mi := &file_books_v1_library_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,7 +1849,7 @@ func (x *UpdateProgressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProgressResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProgressResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{28}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{29}
 }
 
 type UpdateReadingProgressRequest struct {
@@ -1731,7 +1864,7 @@ type UpdateReadingProgressRequest struct {
 
 func (x *UpdateReadingProgressRequest) Reset() {
 	*x = UpdateReadingProgressRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[29]
+	mi := &file_books_v1_library_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1743,7 +1876,7 @@ func (x *UpdateReadingProgressRequest) String() string {
 func (*UpdateReadingProgressRequest) ProtoMessage() {}
 
 func (x *UpdateReadingProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[29]
+	mi := &file_books_v1_library_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1756,7 +1889,7 @@ func (x *UpdateReadingProgressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateReadingProgressRequest.ProtoReflect.Descriptor instead.
 func (*UpdateReadingProgressRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{29}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdateReadingProgressRequest) GetBookId() string {
@@ -1795,7 +1928,7 @@ type UpdateReadingProgressResponse struct {
 
 func (x *UpdateReadingProgressResponse) Reset() {
 	*x = UpdateReadingProgressResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[30]
+	mi := &file_books_v1_library_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1807,7 +1940,7 @@ func (x *UpdateReadingProgressResponse) String() string {
 func (*UpdateReadingProgressResponse) ProtoMessage() {}
 
 func (x *UpdateReadingProgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[30]
+	mi := &file_books_v1_library_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1820,31 +1953,32 @@ func (x *UpdateReadingProgressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateReadingProgressResponse.ProtoReflect.Descriptor instead.
 func (*UpdateReadingProgressResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{30}
+	return file_books_v1_library_proto_rawDescGZIP(), []int{31}
 }
 
-type GetReadingStateRequest struct {
+// QueueBook appends a to-read book to the end of the "up next" queue.
+type QueueBookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReadingStateRequest) Reset() {
-	*x = GetReadingStateRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[31]
+func (x *QueueBookRequest) Reset() {
+	*x = QueueBookRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReadingStateRequest) String() string {
+func (x *QueueBookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReadingStateRequest) ProtoMessage() {}
+func (*QueueBookRequest) ProtoMessage() {}
 
-func (x *GetReadingStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[31]
+func (x *QueueBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1855,40 +1989,1088 @@ func (x *GetReadingStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReadingStateRequest.ProtoReflect.Descriptor instead.
-func (*GetReadingStateRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use QueueBookRequest.ProtoReflect.Descriptor instead.
+func (*QueueBookRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QueueBookRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+type QueueBookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueBookResponse) Reset() {
+	*x = QueueBookResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueBookResponse) ProtoMessage() {}
+
+func (x *QueueBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueBookResponse.ProtoReflect.Descriptor instead.
+func (*QueueBookResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{33}
+}
+
+type DequeueBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DequeueBookRequest) Reset() {
+	*x = DequeueBookRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DequeueBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DequeueBookRequest) ProtoMessage() {}
+
+func (x *DequeueBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DequeueBookRequest.ProtoReflect.Descriptor instead.
+func (*DequeueBookRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DequeueBookRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+type DequeueBookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DequeueBookResponse) Reset() {
+	*x = DequeueBookResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DequeueBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DequeueBookResponse) ProtoMessage() {}
+
+func (x *DequeueBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DequeueBookResponse.ProtoReflect.Descriptor instead.
+func (*DequeueBookResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{35}
+}
+
+// ReorderQueue puts the listed books at the front of the queue in that
+// order, queueing any to-read book among them that wasn't yet.
+type ReorderQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookIds       []string               `protobuf:"bytes,1,rep,name=book_ids,json=bookIds,proto3" json:"book_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderQueueRequest) Reset() {
+	*x = ReorderQueueRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderQueueRequest) ProtoMessage() {}
+
+func (x *ReorderQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderQueueRequest.ProtoReflect.Descriptor instead.
+func (*ReorderQueueRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReorderQueueRequest) GetBookIds() []string {
+	if x != nil {
+		return x.BookIds
+	}
+	return nil
+}
+
+type ReorderQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderQueueResponse) Reset() {
+	*x = ReorderQueueResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderQueueResponse) ProtoMessage() {}
+
+func (x *ReorderQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderQueueResponse.ProtoReflect.Descriptor instead.
+func (*ReorderQueueResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{37}
+}
+
+type UpdateQueueSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *QueueSettings         `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQueueSettingsRequest) Reset() {
+	*x = UpdateQueueSettingsRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQueueSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQueueSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateQueueSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQueueSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQueueSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateQueueSettingsRequest) GetSettings() *QueueSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateQueueSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQueueSettingsResponse) Reset() {
+	*x = UpdateQueueSettingsResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQueueSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQueueSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateQueueSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQueueSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateQueueSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{39}
+}
+
+type GetReadingStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReadingStateRequest) Reset() {
+	*x = GetReadingStateRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingStateRequest) ProtoMessage() {}
+
+func (x *GetReadingStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingStateRequest.ProtoReflect.Descriptor instead.
+func (*GetReadingStateRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetReadingStateRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+type GetReadingStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *BookReadingStateData  `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReadingStateResponse) Reset() {
+	*x = GetReadingStateResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingStateResponse) ProtoMessage() {}
+
+func (x *GetReadingStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingStateResponse.ProtoReflect.Descriptor instead.
+func (*GetReadingStateResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetReadingStateResponse) GetState() *BookReadingStateData {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// GetBookContent returns the readability-extracted article body stored for a
+// URL-ingested book, for in-app reading instead of linking out to the source
+// URL. html is empty when no content was ever extracted.
+type GetBookContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookContentRequest) Reset() {
+	*x = GetBookContentRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookContentRequest) ProtoMessage() {}
+
+func (x *GetBookContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookContentRequest.ProtoReflect.Descriptor instead.
+func (*GetBookContentRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetBookContentRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+type GetBookContentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Html          string                 `protobuf:"bytes,1,opt,name=html,proto3" json:"html,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookContentResponse) Reset() {
+	*x = GetBookContentResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookContentResponse) ProtoMessage() {}
+
+func (x *GetBookContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookContentResponse.ProtoReflect.Descriptor instead.
+func (*GetBookContentResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetBookContentResponse) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+type CreateShelfRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShelfRequest) Reset() {
+	*x = CreateShelfRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShelfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShelfRequest) ProtoMessage() {}
+
+func (x *CreateShelfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShelfRequest.ProtoReflect.Descriptor instead.
+func (*CreateShelfRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateShelfRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateShelfResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShelfResponse) Reset() {
+	*x = CreateShelfResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShelfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShelfResponse) ProtoMessage() {}
+
+func (x *CreateShelfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShelfResponse.ProtoReflect.Descriptor instead.
+func (*CreateShelfResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{45}
+}
+
+type RenameShelfRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldName       string                 `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName       string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameShelfRequest) Reset() {
+	*x = RenameShelfRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameShelfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameShelfRequest) ProtoMessage() {}
+
+func (x *RenameShelfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameShelfRequest.ProtoReflect.Descriptor instead.
+func (*RenameShelfRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RenameShelfRequest) GetOldName() string {
+	if x != nil {
+		return x.OldName
+	}
+	return ""
+}
+
+func (x *RenameShelfRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type RenameShelfResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moved         uint32                 `protobuf:"varint,1,opt,name=moved,proto3" json:"moved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameShelfResponse) Reset() {
+	*x = RenameShelfResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameShelfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameShelfResponse) ProtoMessage() {}
+
+func (x *RenameShelfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameShelfResponse.ProtoReflect.Descriptor instead.
+func (*RenameShelfResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RenameShelfResponse) GetMoved() uint32 {
+	if x != nil {
+		return x.Moved
+	}
+	return 0
+}
+
+// Reassign every book on `name` to `target_name`, after which `name` no longer exists.
+type DeleteShelfRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TargetName    string                 `protobuf:"bytes,2,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShelfRequest) Reset() {
+	*x = DeleteShelfRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShelfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShelfRequest) ProtoMessage() {}
+
+func (x *DeleteShelfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShelfRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShelfRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteShelfRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteShelfRequest) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+type DeleteShelfResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moved         uint32                 `protobuf:"varint,1,opt,name=moved,proto3" json:"moved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShelfResponse) Reset() {
+	*x = DeleteShelfResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShelfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShelfResponse) ProtoMessage() {}
+
+func (x *DeleteShelfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShelfResponse.ProtoReflect.Descriptor instead.
+func (*DeleteShelfResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteShelfResponse) GetMoved() uint32 {
+	if x != nil {
+		return x.Moved
+	}
+	return 0
+}
+
+type RenameTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldName       string                 `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName       string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RenameTagRequest) GetOldName() string {
+	if x != nil {
+		return x.OldName
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type RenameTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Affected      uint32                 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RenameTagResponse) GetAffected() uint32 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Affected      uint32                 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagResponse) ProtoMessage() {}
+
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteTagResponse) GetAffected() uint32 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+// Annotation is a highlight, note or bookmark made on a device (currently
+// Kobo) and synced back through the reading services endpoints.
+type Annotation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookId          string                 `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	BookTitle       string                 `protobuf:"bytes,3,opt,name=book_title,json=bookTitle,proto3" json:"book_title,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	HighlightedText string                 `protobuf:"bytes,5,opt,name=highlighted_text,json=highlightedText,proto3" json:"highlighted_text,omitempty"`
+	NoteText        string                 `protobuf:"bytes,6,opt,name=note_text,json=noteText,proto3" json:"note_text,omitempty"`
+	Color           string                 `protobuf:"bytes,7,opt,name=color,proto3" json:"color,omitempty"`
+	ChapterTitle    string                 `protobuf:"bytes,8,opt,name=chapter_title,json=chapterTitle,proto3" json:"chapter_title,omitempty"`
+	ChapterProgress float64                `protobuf:"fixed64,9,opt,name=chapter_progress,json=chapterProgress,proto3" json:"chapter_progress,omitempty"`
+	Source          string                 `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Annotation) Reset() {
+	*x = Annotation{}
+	mi := &file_books_v1_library_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Annotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Annotation) ProtoMessage() {}
+
+func (x *Annotation) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Annotation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *GetReadingStateRequest) GetBookId() string {
+func (x *Annotation) GetBookId() string {
 	if x != nil {
 		return x.BookId
 	}
 	return ""
 }
 
-type GetReadingStateResponse struct {
+func (x *Annotation) GetBookTitle() string {
+	if x != nil {
+		return x.BookTitle
+	}
+	return ""
+}
+
+func (x *Annotation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Annotation) GetHighlightedText() string {
+	if x != nil {
+		return x.HighlightedText
+	}
+	return ""
+}
+
+func (x *Annotation) GetNoteText() string {
+	if x != nil {
+		return x.NoteText
+	}
+	return ""
+}
+
+func (x *Annotation) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Annotation) GetChapterTitle() string {
+	if x != nil {
+		return x.ChapterTitle
+	}
+	return ""
+}
+
+func (x *Annotation) GetChapterProgress() float64 {
+	if x != nil {
+		return x.ChapterProgress
+	}
+	return 0
+}
+
+func (x *Annotation) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Annotation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Annotation) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListAnnotationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         *BookReadingStateData  `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReadingStateResponse) Reset() {
-	*x = GetReadingStateResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[32]
+func (x *ListAnnotationsRequest) Reset() {
+	*x = ListAnnotationsRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReadingStateResponse) String() string {
+func (x *ListAnnotationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReadingStateResponse) ProtoMessage() {}
+func (*ListAnnotationsRequest) ProtoMessage() {}
 
-func (x *GetReadingStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[32]
+func (x *ListAnnotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1899,43 +3081,86 @@ func (x *GetReadingStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReadingStateResponse.ProtoReflect.Descriptor instead.
-func (*GetReadingStateResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ListAnnotationsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnnotationsRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetReadingStateResponse) GetState() *BookReadingStateData {
+func (x *ListAnnotationsRequest) GetBookId() string {
 	if x != nil {
-		return x.State
+		return x.BookId
+	}
+	return ""
+}
+
+type ListAnnotationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Annotations   []*Annotation          `protobuf:"bytes,1,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnotationsResponse) Reset() {
+	*x = ListAnnotationsResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnotationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnotationsResponse) ProtoMessage() {}
+
+func (x *ListAnnotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnotationsResponse.ProtoReflect.Descriptor instead.
+func (*ListAnnotationsResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListAnnotationsResponse) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
 	}
 	return nil
 }
 
-// GetBookContent returns the readability-extracted article body stored for a
-// URL-ingested book, for in-app reading instead of linking out to the source
-// URL. html is empty when no content was ever extracted.
-type GetBookContentRequest struct {
+type SearchAnnotationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookContentRequest) Reset() {
-	*x = GetBookContentRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[33]
+func (x *SearchAnnotationsRequest) Reset() {
+	*x = SearchAnnotationsRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookContentRequest) String() string {
+func (x *SearchAnnotationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookContentRequest) ProtoMessage() {}
+func (*SearchAnnotationsRequest) ProtoMessage() {}
 
-func (x *GetBookContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[33]
+func (x *SearchAnnotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1946,40 +3171,55 @@ func (x *GetBookContentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookContentRequest.ProtoReflect.Descriptor instead.
-func (*GetBookContentRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use SearchAnnotationsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAnnotationsRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetBookContentRequest) GetBookId() string {
+func (x *SearchAnnotationsRequest) GetQuery() string {
 	if x != nil {
-		return x.BookId
+		return x.Query
 	}
 	return ""
 }
 
-type GetBookContentResponse struct {
+func (x *SearchAnnotationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchAnnotationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchAnnotationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Html          string                 `protobuf:"bytes,1,opt,name=html,proto3" json:"html,omitempty"`
+	Annotations   []*Annotation          `protobuf:"bytes,1,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookContentResponse) Reset() {
-	*x = GetBookContentResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[34]
+func (x *SearchAnnotationsResponse) Reset() {
+	*x = SearchAnnotationsResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookContentResponse) String() string {
+func (x *SearchAnnotationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookContentResponse) ProtoMessage() {}
+func (*SearchAnnotationsResponse) ProtoMessage() {}
 
-func (x *GetBookContentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[34]
+func (x *SearchAnnotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1990,40 +3230,50 @@ func (x *GetBookContentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookContentResponse.ProtoReflect.Descriptor instead.
-func (*GetBookContentResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use SearchAnnotationsResponse.ProtoReflect.Descriptor instead.
+func (*SearchAnnotationsResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetBookContentResponse) GetHtml() string {
+func (x *SearchAnnotationsResponse) GetAnnotations() []*Annotation {
 	if x != nil {
-		return x.Html
+		return x.Annotations
 	}
-	return ""
+	return nil
 }
 
-type CreateShelfRequest struct {
+func (x *SearchAnnotationsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// ExportAnnotations renders highlights as a downloadable file. An empty
+// book_id exports every book; format is "markdown" (default) or "json".
+type ExportAnnotationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateShelfRequest) Reset() {
-	*x = CreateShelfRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[35]
+func (x *ExportAnnotationsRequest) Reset() {
+	*x = ExportAnnotationsRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateShelfRequest) String() string {
+func (x *ExportAnnotationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateShelfRequest) ProtoMessage() {}
+func (*ExportAnnotationsRequest) ProtoMessage() {}
 
-func (x *CreateShelfRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[35]
+func (x *ExportAnnotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2034,39 +3284,49 @@ func (x *CreateShelfRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateShelfRequest.ProtoReflect.Descriptor instead.
-func (*CreateShelfRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ExportAnnotationsRequest.ProtoReflect.Descriptor instead.
+func (*ExportAnnotationsRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *CreateShelfRequest) GetName() string {
+func (x *ExportAnnotationsRequest) GetBookId() string {
 	if x != nil {
-		return x.Name
+		return x.BookId
 	}
 	return ""
 }
 
-type CreateShelfResponse struct {
+func (x *ExportAnnotationsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportAnnotationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateShelfResponse) Reset() {
-	*x = CreateShelfResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[36]
+func (x *ExportAnnotationsResponse) Reset() {
+	*x = ExportAnnotationsResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateShelfResponse) String() string {
+func (x *ExportAnnotationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateShelfResponse) ProtoMessage() {}
+func (*ExportAnnotationsResponse) ProtoMessage() {}
 
-func (x *CreateShelfResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[36]
+func (x *ExportAnnotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2077,34 +3337,66 @@ func (x *CreateShelfResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateShelfResponse.ProtoReflect.Descriptor instead.
-func (*CreateShelfResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ExportAnnotationsResponse.ProtoReflect.Descriptor instead.
+func (*ExportAnnotationsResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{60}
 }
 
-type RenameShelfRequest struct {
+func (x *ExportAnnotationsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportAnnotationsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportAnnotationsResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// TextHit is a passage in the text of a book file or article that matched a
+// SearchBookText query. location is where the reader opens it: the content
+// document of the file named by format ("epub" or "kepub"); it is empty
+// when format is "article". snippet is plain text with the matched words wrapped in
+// <mark></mark>.
+type TextHit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OldName       string                 `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
-	NewName       string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	UserBookId    string                 `protobuf:"bytes,1,opt,name=user_book_id,json=userBookId,proto3" json:"user_book_id,omitempty"`
+	BookId        string                 `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Authors       []string               `protobuf:"bytes,4,rep,name=authors,proto3" json:"authors,omitempty"`
+	Chapter       string                 `protobuf:"bytes,5,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	Location      string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Format        string                 `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
+	Snippet       string                 `protobuf:"bytes,8,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RenameShelfRequest) Reset() {
-	*x = RenameShelfRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[37]
+func (x *TextHit) Reset() {
+	*x = TextHit{}
+	mi := &file_books_v1_library_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameShelfRequest) String() string {
+func (x *TextHit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameShelfRequest) ProtoMessage() {}
+func (*TextHit) ProtoMessage() {}
 
-func (x *RenameShelfRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[37]
+func (x *TextHit) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2115,47 +3407,93 @@ func (x *RenameShelfRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameShelfRequest.ProtoReflect.Descriptor instead.
-func (*RenameShelfRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use TextHit.ProtoReflect.Descriptor instead.
+func (*TextHit) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *TextHit) GetUserBookId() string {
+	if x != nil {
+		return x.UserBookId
+	}
+	return ""
+}
+
+func (x *TextHit) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *TextHit) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TextHit) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *TextHit) GetChapter() string {
+	if x != nil {
+		return x.Chapter
+	}
+	return ""
+}
+
+func (x *TextHit) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
 }
 
-func (x *RenameShelfRequest) GetOldName() string {
+func (x *TextHit) GetFormat() string {
 	if x != nil {
-		return x.OldName
+		return x.Format
 	}
 	return ""
 }
 
-func (x *RenameShelfRequest) GetNewName() string {
+func (x *TextHit) GetSnippet() string {
 	if x != nil {
-		return x.NewName
+		return x.Snippet
 	}
 	return ""
 }
 
-type RenameShelfResponse struct {
+// SearchBookText searches inside the user's uploaded books and ingested
+// articles. query accepts web search syntax: "quoted phrases", or, -word.
+type SearchBookTextRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Moved         uint32                 `protobuf:"varint,1,opt,name=moved,proto3" json:"moved,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RenameShelfResponse) Reset() {
-	*x = RenameShelfResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[38]
+func (x *SearchBookTextRequest) Reset() {
+	*x = SearchBookTextRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameShelfResponse) String() string {
+func (x *SearchBookTextRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameShelfResponse) ProtoMessage() {}
+func (*SearchBookTextRequest) ProtoMessage() {}
 
-func (x *RenameShelfResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[38]
+func (x *SearchBookTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2166,42 +3504,55 @@ func (x *RenameShelfResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameShelfResponse.ProtoReflect.Descriptor instead.
-func (*RenameShelfResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use SearchBookTextRequest.ProtoReflect.Descriptor instead.
+func (*SearchBookTextRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *RenameShelfResponse) GetMoved() uint32 {
+func (x *SearchBookTextRequest) GetQuery() string {
 	if x != nil {
-		return x.Moved
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchBookTextRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-// Reassign every book on `name` to `target_name`, after which `name` no longer exists.
-type DeleteShelfRequest struct {
+func (x *SearchBookTextRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchBookTextResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	TargetName    string                 `protobuf:"bytes,2,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	Hits          []*TextHit             `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteShelfRequest) Reset() {
-	*x = DeleteShelfRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[39]
+func (x *SearchBookTextResponse) Reset() {
+	*x = SearchBookTextResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteShelfRequest) String() string {
+func (x *SearchBookTextResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteShelfRequest) ProtoMessage() {}
+func (*SearchBookTextResponse) ProtoMessage() {}
 
-func (x *DeleteShelfRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[39]
+func (x *SearchBookTextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2212,47 +3563,52 @@ func (x *DeleteShelfRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteShelfRequest.ProtoReflect.Descriptor instead.
-func (*DeleteShelfRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use SearchBookTextResponse.ProtoReflect.Descriptor instead.
+func (*SearchBookTextResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *DeleteShelfRequest) GetName() string {
+func (x *SearchBookTextResponse) GetHits() []*TextHit {
 	if x != nil {
-		return x.Name
+		return x.Hits
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteShelfRequest) GetTargetName() string {
+func (x *SearchBookTextResponse) GetHasMore() bool {
 	if x != nil {
-		return x.TargetName
+		return x.HasMore
 	}
-	return ""
+	return false
 }
 
-type DeleteShelfResponse struct {
+// ReadingDay is the time spent reading one book on one UTC day.
+type ReadingDay struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Moved         uint32                 `protobuf:"varint,1,opt,name=moved,proto3" json:"moved,omitempty"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	BookId        string                 `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Seconds       int32                  `protobuf:"varint,3,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	Sessions      int32                  `protobuf:"varint,4,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	PagesTurned   int32                  `protobuf:"varint,5,opt,name=pages_turned,json=pagesTurned,proto3" json:"pages_turned,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteShelfResponse) Reset() {
-	*x = DeleteShelfResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[40]
+func (x *ReadingDay) Reset() {
+	*x = ReadingDay{}
+	mi := &file_books_v1_library_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteShelfResponse) String() string {
+func (x *ReadingDay) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteShelfResponse) ProtoMessage() {}
+func (*ReadingDay) ProtoMessage() {}
 
-func (x *DeleteShelfResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[40]
+func (x *ReadingDay) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2263,41 +3619,84 @@ func (x *DeleteShelfResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteShelfResponse.ProtoReflect.Descriptor instead.
-func (*DeleteShelfResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ReadingDay.ProtoReflect.Descriptor instead.
+func (*ReadingDay) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *DeleteShelfResponse) GetMoved() uint32 {
+func (x *ReadingDay) GetDate() string {
 	if x != nil {
-		return x.Moved
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ReadingDay) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *ReadingDay) GetSeconds() int32 {
+	if x != nil {
+		return x.Seconds
 	}
 	return 0
 }
 
-type RenameTagRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OldName       string                 `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
-	NewName       string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ReadingDay) GetSessions() int32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
 }
 
-func (x *RenameTagRequest) Reset() {
-	*x = RenameTagRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[41]
+func (x *ReadingDay) GetPagesTurned() int32 {
+	if x != nil {
+		return x.PagesTurned
+	}
+	return 0
+}
+
+// BookReadingStats summarises every reading session of a book. Speeds are 0
+// when the sessions don't say how far they got; remaining_seconds and
+// estimated_finish are only set for books still being read, the latter from
+// the reading pace of the last two weeks.
+type BookReadingStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BookId           string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Authors          []string               `protobuf:"bytes,3,rep,name=authors,proto3" json:"authors,omitempty"`
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Percent          int32                  `protobuf:"varint,5,opt,name=percent,proto3" json:"percent,omitempty"`
+	Seconds          int32                  `protobuf:"varint,6,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	Sessions         int32                  `protobuf:"varint,7,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	PagesTurned      int32                  `protobuf:"varint,8,opt,name=pages_turned,json=pagesTurned,proto3" json:"pages_turned,omitempty"`
+	PercentPerHour   float64                `protobuf:"fixed64,9,opt,name=percent_per_hour,json=percentPerHour,proto3" json:"percent_per_hour,omitempty"`
+	PagesPerHour     float64                `protobuf:"fixed64,10,opt,name=pages_per_hour,json=pagesPerHour,proto3" json:"pages_per_hour,omitempty"`
+	RemainingSeconds int32                  `protobuf:"varint,11,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
+	EstimatedFinish  string                 `protobuf:"bytes,12,opt,name=estimated_finish,json=estimatedFinish,proto3" json:"estimated_finish,omitempty"`
+	LastReadAt       string                 `protobuf:"bytes,13,opt,name=last_read_at,json=lastReadAt,proto3" json:"last_read_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BookReadingStats) Reset() {
+	*x = BookReadingStats{}
+	mi := &file_books_v1_library_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameTagRequest) String() string {
+func (x *BookReadingStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameTagRequest) ProtoMessage() {}
+func (*BookReadingStats) ProtoMessage() {}
 
-func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[41]
+func (x *BookReadingStats) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2308,91 +3707,127 @@ func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
-func (*RenameTagRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use BookReadingStats.ProtoReflect.Descriptor instead.
+func (*BookReadingStats) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *RenameTagRequest) GetOldName() string {
+func (x *BookReadingStats) GetBookId() string {
 	if x != nil {
-		return x.OldName
+		return x.BookId
 	}
 	return ""
 }
 
-func (x *RenameTagRequest) GetNewName() string {
+func (x *BookReadingStats) GetTitle() string {
 	if x != nil {
-		return x.NewName
+		return x.Title
 	}
 	return ""
 }
 
-type RenameTagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Affected      uint32                 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BookReadingStats) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
 }
 
-func (x *RenameTagResponse) Reset() {
-	*x = RenameTagResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[42]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BookReadingStats) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *RenameTagResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BookReadingStats) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
 }
 
-func (*RenameTagResponse) ProtoMessage() {}
+func (x *BookReadingStats) GetSeconds() int32 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
 
-func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[42]
+func (x *BookReadingStats) GetSessions() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Sessions
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
-func (*RenameTagResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{42}
+func (x *BookReadingStats) GetPagesTurned() int32 {
+	if x != nil {
+		return x.PagesTurned
+	}
+	return 0
 }
 
-func (x *RenameTagResponse) GetAffected() uint32 {
+func (x *BookReadingStats) GetPercentPerHour() float64 {
 	if x != nil {
-		return x.Affected
+		return x.PercentPerHour
 	}
 	return 0
 }
 
-type DeleteTagRequest struct {
+func (x *BookReadingStats) GetPagesPerHour() float64 {
+	if x != nil {
+		return x.PagesPerHour
+	}
+	return 0
+}
+
+func (x *BookReadingStats) GetRemainingSeconds() int32 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+func (x *BookReadingStats) GetEstimatedFinish() string {
+	if x != nil {
+		return x.EstimatedFinish
+	}
+	return ""
+}
+
+func (x *BookReadingStats) GetLastReadAt() string {
+	if x != nil {
+		return x.LastReadAt
+	}
+	return ""
+}
+
+// GetReadingStats reports reading sessions (Kobo, KOReader, web reader)
+// that started in the date range, which defaults to the last year.
+type GetReadingStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DateStart     string                 `protobuf:"bytes,1,opt,name=date_start,json=dateStart,proto3" json:"date_start,omitempty"`
+	DateEnd       string                 `protobuf:"bytes,2,opt,name=date_end,json=dateEnd,proto3" json:"date_end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTagRequest) Reset() {
-	*x = DeleteTagRequest{}
-	mi := &file_books_v1_library_proto_msgTypes[43]
+func (x *GetReadingStatsRequest) Reset() {
+	*x = GetReadingStatsRequest{}
+	mi := &file_books_v1_library_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTagRequest) String() string {
+func (x *GetReadingStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTagRequest) ProtoMessage() {}
+func (*GetReadingStatsRequest) ProtoMessage() {}
 
-func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[43]
+func (x *GetReadingStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2403,40 +3838,50 @@ func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetReadingStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetReadingStatsRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *DeleteTagRequest) GetName() string {
+func (x *GetReadingStatsRequest) GetDateStart() string {
 	if x != nil {
-		return x.Name
+		return x.DateStart
 	}
 	return ""
 }
 
-type DeleteTagResponse struct {
+func (x *GetReadingStatsRequest) GetDateEnd() string {
+	if x != nil {
+		return x.DateEnd
+	}
+	return ""
+}
+
+type GetReadingStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Affected      uint32                 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	Days          []*ReadingDay          `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	Books         []*BookReadingStats    `protobuf:"bytes,2,rep,name=books,proto3" json:"books,omitempty"`
+	DateStart     string                 `protobuf:"bytes,3,opt,name=date_start,json=dateStart,proto3" json:"date_start,omitempty"`
+	DateEnd       string                 `protobuf:"bytes,4,opt,name=date_end,json=dateEnd,proto3" json:"date_end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTagResponse) Reset() {
-	*x = DeleteTagResponse{}
-	mi := &file_books_v1_library_proto_msgTypes[44]
+func (x *GetReadingStatsResponse) Reset() {
+	*x = GetReadingStatsResponse{}
+	mi := &file_books_v1_library_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTagResponse) String() string {
+func (x *GetReadingStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTagResponse) ProtoMessage() {}
+func (*GetReadingStatsResponse) ProtoMessage() {}
 
-func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_books_v1_library_proto_msgTypes[44]
+func (x *GetReadingStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_library_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2447,23 +3892,44 @@ func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
-	return file_books_v1_library_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetReadingStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetReadingStatsResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_library_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *DeleteTagResponse) GetAffected() uint32 {
+func (x *GetReadingStatsResponse) GetDays() []*ReadingDay {
 	if x != nil {
-		return x.Affected
+		return x.Days
 	}
-	return 0
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetBooks() []*BookReadingStats {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetDateStart() string {
+	if x != nil {
+		return x.DateStart
+	}
+	return ""
+}
+
+func (x *GetReadingStatsResponse) GetDateEnd() string {
+	if x != nil {
+		return x.DateEnd
+	}
+	return ""
 }
 
 var File_books_v1_library_proto protoreflect.FileDescriptor
 
 const file_books_v1_library_proto_rawDesc = "" +
 	"\n" +
-	"\x16books/v1/library.proto\x12\bbooks.v1\"\xaf\x02\n" +
+	"\x16books/v1/library.proto\x12\bbooks.v1\"\xac\x03\n" +
 	"\x04Book\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
@@ -2476,9 +3942,13 @@ const file_books_v1_library_proto_rawDesc = "" +
 	"\n" +
 	"source_url\x18\v \x01(\tR\tsourceUrl\x12\x1f\n" +
 	"\vhas_content\x18\f \x01(\bR\n" +
-	"hasContentJ\x04\b\b\x10\tJ\x04\b\t\x10\n" +
+	"hasContent\x12\x16\n" +
+	"\x06series\x18\r \x01(\tR\x06series\x12,\n" +
+	"\x0fseries_position\x18\x0e \x01(\x01H\x00R\x0eseriesPosition\x88\x01\x01\x12!\n" +
+	"\fseries_total\x18\x0f \x01(\x05R\vseriesTotalB\x12\n" +
+	"\x10_series_positionJ\x04\b\b\x10\tJ\x04\b\t\x10\n" +
 	"J\x04\b\n" +
-	"\x10\vR\x06isbn10R\rexternal_refsR\bcategory\"\xa2\x03\n" +
+	"\x10\vR\x06isbn10R\rexternal_refsR\bcategory\"\x83\x04\n" +
 	"\bUserBook\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
@@ -2496,15 +3966,25 @@ const file_books_v1_library_proto_rawDesc = "" +
 	"\rprogress_mode\x18\f \x01(\tR\fprogressMode\x12!\n" +
 	"\fcurrent_page\x18\r \x01(\x05R\vcurrentPage\x12)\n" +
 	"\x10progress_percent\x18\x0e \x01(\x05R\x0fprogressPercent\x12\x18\n" +
-	"\aformats\x18\x0f \x03(\tR\aformatsJ\x04\b\b\x10\t\"I\n" +
+	"\aformats\x18\x0f \x03(\tR\aformats\x12 \n" +
+	"\ffeed_item_id\x18\x10 \x01(\tR\n" +
+	"feedItemId\x12*\n" +
+	"\x0equeue_position\x18\x11 \x01(\x05H\x00R\rqueuePosition\x88\x01\x01B\x11\n" +
+	"\x0f_queue_positionJ\x04\b\b\x10\t\"I\n" +
 	"\tBookShelf\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12(\n" +
-	"\x05books\x18\x02 \x03(\v2\x12.books.v1.UserBookR\x05books\"\xd9\x01\n" +
+	"\x05books\x18\x02 \x03(\v2\x12.books.v1.UserBookR\x05books\"\x80\x03\n" +
 	"\x0fLibraryResponse\x12,\n" +
 	"\areading\x18\x01 \x03(\v2\x12.books.v1.UserBookR\areading\x12.\n" +
 	"\bwishlist\x18\x02 \x03(\v2\x12.books.v1.UserBookR\bwishlist\x12.\n" +
 	"\bfinished\x18\x03 \x03(\v2\x12.books.v1.UserBookR\bfinished\x12-\n" +
-	"\ashelves\x18\x04 \x03(\v2\x13.books.v1.BookShelfR\ashelvesJ\x04\b\x05\x10\x06R\x03rss\"\x81\x01\n" +
+	"\ashelves\x18\x04 \x03(\v2\x13.books.v1.BookShelfR\ashelves\x128\n" +
+	"\x0enext_in_series\x18\x06 \x03(\v2\x12.books.v1.UserBookR\fnextInSeries\x12+\n" +
+	"\aup_next\x18\a \x03(\v2\x12.books.v1.UserBookR\x06upNext\x12>\n" +
+	"\x0equeue_settings\x18\b \x01(\v2\x17.books.v1.QueueSettingsR\rqueueSettingsJ\x04\b\x05\x10\x06R\x03rss\"Z\n" +
+	"\rQueueSettings\x12!\n" +
+	"\fauto_advance\x18\x01 \x01(\bR\vautoAdvance\x12&\n" +
+	"\x0fkobo_sync_count\x18\x02 \x01(\x05R\rkoboSyncCount\"\x81\x01\n" +
 	"\x15BooksProgressResponse\x12\x16\n" +
 	"\x06labels\x18\x01 \x03(\tR\x06labels\x12\x16\n" +
 	"\x06values\x18\x02 \x03(\tR\x06values\x12\x1d\n" +
@@ -2596,7 +4076,19 @@ const file_books_v1_library_proto_rawDesc = "" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12\x18\n" +
 	"\apercent\x18\x03 \x01(\x05R\apercent\x12\x1a\n" +
 	"\blocation\x18\x04 \x01(\tR\blocation\"\x1f\n" +
-	"\x1dUpdateReadingProgressResponse\"1\n" +
+	"\x1dUpdateReadingProgressResponse\"+\n" +
+	"\x10QueueBookRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\"\x13\n" +
+	"\x11QueueBookResponse\"-\n" +
+	"\x12DequeueBookRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\"\x15\n" +
+	"\x13DequeueBookResponse\"0\n" +
+	"\x13ReorderQueueRequest\x12\x19\n" +
+	"\bbook_ids\x18\x01 \x03(\tR\abookIds\"\x16\n" +
+	"\x14ReorderQueueResponse\"Q\n" +
+	"\x1aUpdateQueueSettingsRequest\x123\n" +
+	"\bsettings\x18\x01 \x01(\v2\x17.books.v1.QueueSettingsR\bsettings\"\x1d\n" +
+	"\x1bUpdateQueueSettingsResponse\"1\n" +
 	"\x16GetReadingStateRequest\x12\x17\n" +
 	"\abook_id\x18\x01 \x01(\tR\x06bookId\"O\n" +
 	"\x17GetReadingStateResponse\x124\n" +
@@ -2627,11 +4119,98 @@ const file_books_v1_library_proto_rawDesc = "" +
 	"\x10DeleteTagRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"/\n" +
 	"\x11DeleteTagResponse\x12\x1a\n" +
-	"\baffected\x18\x01 \x01(\rR\baffected2\x9d\f\n" +
+	"\baffected\x18\x01 \x01(\rR\baffected\"\xec\x02\n" +
+	"\n" +
+	"Annotation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\abook_id\x18\x02 \x01(\tR\x06bookId\x12\x1d\n" +
+	"\n" +
+	"book_title\x18\x03 \x01(\tR\tbookTitle\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12)\n" +
+	"\x10highlighted_text\x18\x05 \x01(\tR\x0fhighlightedText\x12\x1b\n" +
+	"\tnote_text\x18\x06 \x01(\tR\bnoteText\x12\x14\n" +
+	"\x05color\x18\a \x01(\tR\x05color\x12#\n" +
+	"\rchapter_title\x18\b \x01(\tR\fchapterTitle\x12)\n" +
+	"\x10chapter_progress\x18\t \x01(\x01R\x0fchapterProgress\x12\x16\n" +
+	"\x06source\x18\n" +
+	" \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\"1\n" +
+	"\x16ListAnnotationsRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\"Q\n" +
+	"\x17ListAnnotationsResponse\x126\n" +
+	"\vannotations\x18\x01 \x03(\v2\x14.books.v1.AnnotationR\vannotations\"^\n" +
+	"\x18SearchAnnotationsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"n\n" +
+	"\x19SearchAnnotationsResponse\x126\n" +
+	"\vannotations\x18\x01 \x03(\v2\x14.books.v1.AnnotationR\vannotations\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"K\n" +
+	"\x18ExportAnnotationsRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"n\n" +
+	"\x19ExportAnnotationsResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"\xdc\x01\n" +
+	"\aTextHit\x12 \n" +
+	"\fuser_book_id\x18\x01 \x01(\tR\n" +
+	"userBookId\x12\x17\n" +
+	"\abook_id\x18\x02 \x01(\tR\x06bookId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\aauthors\x18\x04 \x03(\tR\aauthors\x12\x18\n" +
+	"\achapter\x18\x05 \x01(\tR\achapter\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12\x16\n" +
+	"\x06format\x18\a \x01(\tR\x06format\x12\x18\n" +
+	"\asnippet\x18\b \x01(\tR\asnippet\"[\n" +
+	"\x15SearchBookTextRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"Z\n" +
+	"\x16SearchBookTextResponse\x12%\n" +
+	"\x04hits\x18\x01 \x03(\v2\x11.books.v1.TextHitR\x04hits\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\x92\x01\n" +
+	"\n" +
+	"ReadingDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x17\n" +
+	"\abook_id\x18\x02 \x01(\tR\x06bookId\x12\x18\n" +
+	"\aseconds\x18\x03 \x01(\x05R\aseconds\x12\x1a\n" +
+	"\bsessions\x18\x04 \x01(\x05R\bsessions\x12!\n" +
+	"\fpages_turned\x18\x05 \x01(\x05R\vpagesTurned\"\xb0\x03\n" +
+	"\x10BookReadingStats\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\aauthors\x18\x03 \x03(\tR\aauthors\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x18\n" +
+	"\apercent\x18\x05 \x01(\x05R\apercent\x12\x18\n" +
+	"\aseconds\x18\x06 \x01(\x05R\aseconds\x12\x1a\n" +
+	"\bsessions\x18\a \x01(\x05R\bsessions\x12!\n" +
+	"\fpages_turned\x18\b \x01(\x05R\vpagesTurned\x12(\n" +
+	"\x10percent_per_hour\x18\t \x01(\x01R\x0epercentPerHour\x12$\n" +
+	"\x0epages_per_hour\x18\n" +
+	" \x01(\x01R\fpagesPerHour\x12+\n" +
+	"\x11remaining_seconds\x18\v \x01(\x05R\x10remainingSeconds\x12)\n" +
+	"\x10estimated_finish\x18\f \x01(\tR\x0festimatedFinish\x12 \n" +
+	"\flast_read_at\x18\r \x01(\tR\n" +
+	"lastReadAt\"R\n" +
+	"\x16GetReadingStatsRequest\x12\x1d\n" +
+	"\n" +
+	"date_start\x18\x01 \x01(\tR\tdateStart\x12\x19\n" +
+	"\bdate_end\x18\x02 \x01(\tR\adateEnd\"\xaf\x01\n" +
+	"\x17GetReadingStatsResponse\x12(\n" +
+	"\x04days\x18\x01 \x03(\v2\x14.books.v1.ReadingDayR\x04days\x120\n" +
+	"\x05books\x18\x02 \x03(\v2\x1a.books.v1.BookReadingStatsR\x05books\x12\x1d\n" +
+	"\n" +
+	"date_start\x18\x03 \x01(\tR\tdateStart\x12\x19\n" +
+	"\bdate_end\x18\x04 \x01(\tR\adateEnd2\xa3\x12\n" +
 	"\x0eLibraryService\x12G\n" +
 	"\n" +
 	"GetLibrary\x12\x1b.books.v1.GetLibraryRequest\x1a\x1c.books.v1.GetLibraryResponse\x12Y\n" +
-	"\x10GetBooksProgress\x12!.books.v1.GetBooksProgressRequest\x1a\".books.v1.GetBooksProgressResponse\x12P\n" +
+	"\x10GetBooksProgress\x12!.books.v1.GetBooksProgressRequest\x1a\".books.v1.GetBooksProgressResponse\x12V\n" +
+	"\x0fGetReadingStats\x12 .books.v1.GetReadingStatsRequest\x1a!.books.v1.GetReadingStatsResponse\x12P\n" +
 	"\rSearchLibrary\x12\x1e.books.v1.SearchLibraryRequest\x1a\x1f.books.v1.SearchLibraryResponse\x12S\n" +
 	"\x0eSearchExternal\x12\x1f.books.v1.SearchExternalRequest\x1a .books.v1.SearchExternalResponse\x12V\n" +
 	"\x0fGetExternalBook\x12 .books.v1.GetExternalBookRequest\x1a!.books.v1.GetExternalBookResponse\x12G\n" +
@@ -2642,7 +4221,11 @@ const file_books_v1_library_proto_rawDesc = "" +
 	"\x0eUpdateProgress\x12\x1f.books.v1.UpdateProgressRequest\x1a .books.v1.UpdateProgressResponse\x12D\n" +
 	"\tToggleTag\x12\x1a.books.v1.ToggleTagRequest\x1a\x1b.books.v1.ToggleTagResponse\x12G\n" +
 	"\n" +
-	"RemoveBook\x12\x1b.books.v1.RemoveBookRequest\x1a\x1c.books.v1.RemoveBookResponse\x12h\n" +
+	"RemoveBook\x12\x1b.books.v1.RemoveBookRequest\x1a\x1c.books.v1.RemoveBookResponse\x12D\n" +
+	"\tQueueBook\x12\x1a.books.v1.QueueBookRequest\x1a\x1b.books.v1.QueueBookResponse\x12J\n" +
+	"\vDequeueBook\x12\x1c.books.v1.DequeueBookRequest\x1a\x1d.books.v1.DequeueBookResponse\x12M\n" +
+	"\fReorderQueue\x12\x1d.books.v1.ReorderQueueRequest\x1a\x1e.books.v1.ReorderQueueResponse\x12b\n" +
+	"\x13UpdateQueueSettings\x12$.books.v1.UpdateQueueSettingsRequest\x1a%.books.v1.UpdateQueueSettingsResponse\x12h\n" +
 	"\x15UpdateReadingProgress\x12&.books.v1.UpdateReadingProgressRequest\x1a'.books.v1.UpdateReadingProgressResponse\x12V\n" +
 	"\x0fGetReadingState\x12 .books.v1.GetReadingStateRequest\x1a!.books.v1.GetReadingStateResponse\x12S\n" +
 	"\x0eGetBookContent\x12\x1f.books.v1.GetBookContentRequest\x1a .books.v1.GetBookContentResponse\x12J\n" +
@@ -2650,7 +4233,11 @@ const file_books_v1_library_proto_rawDesc = "" +
 	"\vRenameShelf\x12\x1c.books.v1.RenameShelfRequest\x1a\x1d.books.v1.RenameShelfResponse\x12J\n" +
 	"\vDeleteShelf\x12\x1c.books.v1.DeleteShelfRequest\x1a\x1d.books.v1.DeleteShelfResponse\x12D\n" +
 	"\tRenameTag\x12\x1a.books.v1.RenameTagRequest\x1a\x1b.books.v1.RenameTagResponse\x12D\n" +
-	"\tDeleteTag\x12\x1a.books.v1.DeleteTagRequest\x1a\x1b.books.v1.DeleteTagResponseB)Z'tools.xdoubleu.com/gen/books/v1;booksv1b\x06proto3"
+	"\tDeleteTag\x12\x1a.books.v1.DeleteTagRequest\x1a\x1b.books.v1.DeleteTagResponse\x12V\n" +
+	"\x0fListAnnotations\x12 .books.v1.ListAnnotationsRequest\x1a!.books.v1.ListAnnotationsResponse\x12\\\n" +
+	"\x11SearchAnnotations\x12\".books.v1.SearchAnnotationsRequest\x1a#.books.v1.SearchAnnotationsResponse\x12\\\n" +
+	"\x11ExportAnnotations\x12\".books.v1.ExportAnnotationsRequest\x1a#.books.v1.ExportAnnotationsResponse\x12S\n" +
+	"\x0eSearchBookText\x12\x1f.books.v1.SearchBookTextRequest\x1a .books.v1.SearchBookTextResponseB)Z'tools.xdoubleu.com/gen/books/v1;booksv1b\x06proto3"
 
 var (
 	file_books_v1_library_proto_rawDescOnce sync.Once
@@ -2664,53 +4251,76 @@ func file_books_v1_library_proto_rawDescGZIP() []byte {
 	return file_books_v1_library_proto_rawDescData
 }
 
-var file_books_v1_library_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_books_v1_library_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_books_v1_library_proto_goTypes = []any{
 	(*Book)(nil),                          // 0: books.v1.Book
 	(*UserBook)(nil),                      // 1: books.v1.UserBook
 	(*BookShelf)(nil),                     // 2: books.v1.BookShelf
 	(*LibraryResponse)(nil),               // 3: books.v1.LibraryResponse
-	(*BooksProgressResponse)(nil),         // 4: books.v1.BooksProgressResponse
-	(*ExternalBookResult)(nil),            // 5: books.v1.ExternalBookResult
-	(*BookReadingStateData)(nil),          // 6: books.v1.BookReadingStateData
-	(*GetLibraryRequest)(nil),             // 7: books.v1.GetLibraryRequest
-	(*GetLibraryResponse)(nil),            // 8: books.v1.GetLibraryResponse
-	(*GetBooksProgressRequest)(nil),       // 9: books.v1.GetBooksProgressRequest
-	(*GetBooksProgressResponse)(nil),      // 10: books.v1.GetBooksProgressResponse
-	(*SearchLibraryRequest)(nil),          // 11: books.v1.SearchLibraryRequest
-	(*SearchLibraryResponse)(nil),         // 12: books.v1.SearchLibraryResponse
-	(*SearchExternalRequest)(nil),         // 13: books.v1.SearchExternalRequest
-	(*SearchExternalResponse)(nil),        // 14: books.v1.SearchExternalResponse
-	(*GetExternalBookRequest)(nil),        // 15: books.v1.GetExternalBookRequest
-	(*GetExternalBookResponse)(nil),       // 16: books.v1.GetExternalBookResponse
-	(*CreateBookRequest)(nil),             // 17: books.v1.CreateBookRequest
-	(*CreateBookResponse)(nil),            // 18: books.v1.CreateBookResponse
-	(*UpdateBookStatusRequest)(nil),       // 19: books.v1.UpdateBookStatusRequest
-	(*UpdateBookStatusResponse)(nil),      // 20: books.v1.UpdateBookStatusResponse
-	(*ToggleTagRequest)(nil),              // 21: books.v1.ToggleTagRequest
-	(*ToggleTagResponse)(nil),             // 22: books.v1.ToggleTagResponse
-	(*RemoveBookRequest)(nil),             // 23: books.v1.RemoveBookRequest
-	(*RemoveBookResponse)(nil),            // 24: books.v1.RemoveBookResponse
-	(*UpdateFinishedAtRequest)(nil),       // 25: books.v1.UpdateFinishedAtRequest
-	(*UpdateFinishedAtResponse)(nil),      // 26: books.v1.UpdateFinishedAtResponse
-	(*UpdateProgressRequest)(nil),         // 27: books.v1.UpdateProgressRequest
-	(*UpdateProgressResponse)(nil),        // 28: books.v1.UpdateProgressResponse
-	(*UpdateReadingProgressRequest)(nil),  // 29: books.v1.UpdateReadingProgressRequest
-	(*UpdateReadingProgressResponse)(nil), // 30: books.v1.UpdateReadingProgressResponse
-	(*GetReadingStateRequest)(nil),        // 31: books.v1.GetReadingStateRequest
-	(*GetReadingStateResponse)(nil),       // 32: books.v1.GetReadingStateResponse
-	(*GetBookContentRequest)(nil),         // 33: books.v1.GetBookContentRequest
-	(*GetBookContentResponse)(nil),        // 34: books.v1.GetBookContentResponse
-	(*CreateShelfRequest)(nil),            // 35: books.v1.CreateShelfRequest
-	(*CreateShelfResponse)(nil),           // 36: books.v1.CreateShelfResponse
-	(*RenameShelfRequest)(nil),            // 37: books.v1.RenameShelfRequest
-	(*RenameShelfResponse)(nil),           // 38: books.v1.RenameShelfResponse
-	(*DeleteShelfRequest)(nil),            // 39: books.v1.DeleteShelfRequest
-	(*DeleteShelfResponse)(nil),           // 40: books.v1.DeleteShelfResponse
-	(*RenameTagRequest)(nil),              // 41: books.v1.RenameTagRequest
-	(*RenameTagResponse)(nil),             // 42: books.v1.RenameTagResponse
-	(*DeleteTagRequest)(nil),              // 43: books.v1.DeleteTagRequest
-	(*DeleteTagResponse)(nil),             // 44: books.v1.DeleteTagResponse
+	(*QueueSettings)(nil),                 // 4: books.v1.QueueSettings
+	(*BooksProgressResponse)(nil),         // 5: books.v1.BooksProgressResponse
+	(*ExternalBookResult)(nil),            // 6: books.v1.ExternalBookResult
+	(*BookReadingStateData)(nil),          // 7: books.v1.BookReadingStateData
+	(*GetLibraryRequest)(nil),             // 8: books.v1.GetLibraryRequest
+	(*GetLibraryResponse)(nil),            // 9: books.v1.GetLibraryResponse
+	(*GetBooksProgressRequest)(nil),       // 10: books.v1.GetBooksProgressRequest
+	(*GetBooksProgressResponse)(nil),      // 11: books.v1.GetBooksProgressResponse
+	(*SearchLibraryRequest)(nil),          // 12: books.v1.SearchLibraryRequest
+	(*SearchLibraryResponse)(nil),         // 13: books.v1.SearchLibraryResponse
+	(*SearchExternalRequest)(nil),         // 14: books.v1.SearchExternalRequest
+	(*SearchExternalResponse)(nil),        // 15: books.v1.SearchExternalResponse
+	(*GetExternalBookRequest)(nil),        // 16: books.v1.GetExternalBookRequest
+	(*GetExternalBookResponse)(nil),       // 17: books.v1.GetExternalBookResponse
+	(*CreateBookRequest)(nil),             // 18: books.v1.CreateBookRequest
+	(*CreateBookResponse)(nil),            // 19: books.v1.CreateBookResponse
+	(*UpdateBookStatusRequest)(nil),       // 20: books.v1.UpdateBookStatusRequest
+	(*UpdateBookStatusResponse)(nil),      // 21: books.v1.UpdateBookStatusResponse
+	(*ToggleTagRequest)(nil),              // 22: books.v1.ToggleTagRequest
+	(*ToggleTagResponse)(nil),             // 23: books.v1.ToggleTagResponse
+	(*RemoveBookRequest)(nil),             // 24: books.v1.RemoveBookRequest
+	(*RemoveBookResponse)(nil),            // 25: books.v1.RemoveBookResponse
+	(*UpdateFinishedAtRequest)(nil),       // 26: books.v1.UpdateFinishedAtRequest
+	(*UpdateFinishedAtResponse)(nil),      // 27: books.v1.UpdateFinishedAtResponse
+	(*UpdateProgressRequest)(nil),         // 28: books.v1.UpdateProgressRequest
+	(*UpdateProgressResponse)(nil),        // 29: books.v1.UpdateProgressResponse
+	(*UpdateReadingProgressRequest)(nil),  // 30: books.v1.UpdateReadingProgressRequest
+	(*UpdateReadingProgressResponse)(nil), // 31: books.v1.UpdateReadingProgressResponse
+	(*QueueBookRequest)(nil),              // 32: books.v1.QueueBookRequest
+	(*QueueBookResponse)(nil),             // 33: books.v1.QueueBookResponse
+	(*DequeueBookRequest)(nil),            // 34: books.v1.DequeueBookRequest
+	(*DequeueBookResponse)(nil),           // 35: books.v1.DequeueBookResponse
+	(*ReorderQueueRequest)(nil),           // 36: books.v1.ReorderQueueRequest
+	(*ReorderQueueResponse)(nil),          // 37: books.v1.ReorderQueueResponse
+	(*UpdateQueueSettingsRequest)(nil),    // 38: books.v1.UpdateQueueSettingsRequest
+	(*UpdateQueueSettingsResponse)(nil),   // 39: books.v1.UpdateQueueSettingsResponse
+	(*GetReadingStateRequest)(nil),        // 40: books.v1.GetReadingStateRequest
+	(*GetReadingStateResponse)(nil),       // 41: books.v1.GetReadingStateResponse
+	(*GetBookContentRequest)(nil),         // 42: books.v1.GetBookContentRequest
+	(*GetBookContentResponse)(nil),        // 43: books.v1.GetBookContentResponse
+	(*CreateShelfRequest)(nil),            // 44: books.v1.CreateShelfRequest
+	(*CreateShelfResponse)(nil),           // 45: books.v1.CreateShelfResponse
+	(*RenameShelfRequest)(nil),            // 46: books.v1.RenameShelfRequest
+	(*RenameShelfResponse)(nil),           // 47: books.v1.RenameShelfResponse
+	(*DeleteShelfRequest)(nil),            // 48: books.v1.DeleteShelfRequest
+	(*DeleteShelfResponse)(nil),           // 49: books.v1.DeleteShelfResponse
+	(*RenameTagRequest)(nil),              // 50: books.v1.RenameTagRequest
+	(*RenameTagResponse)(nil),             // 51: books.v1.RenameTagResponse
+	(*DeleteTagRequest)(nil),              // 52: books.v1.DeleteTagRequest
+	(*DeleteTagResponse)(nil),             // 53: books.v1.DeleteTagResponse
+	(*Annotation)(nil),                    // 54: books.v1.Annotation
+	(*ListAnnotationsRequest)(nil),        // 55: books.v1.ListAnnotationsRequest
+	(*ListAnnotationsResponse)(nil),       // 56: books.v1.ListAnnotationsResponse
+	(*SearchAnnotationsRequest)(nil),      // 57: books.v1.SearchAnnotationsRequest
+	(*SearchAnnotationsResponse)(nil),     // 58: books.v1.SearchAnnotationsResponse
+	(*ExportAnnotationsRequest)(nil),      // 59: books.v1.ExportAnnotationsRequest
+	(*ExportAnnotationsResponse)(nil),     // 60: books.v1.ExportAnnotationsResponse
+	(*TextHit)(nil),                       // 61: books.v1.TextHit
+	(*SearchBookTextRequest)(nil),         // 62: books.v1.SearchBookTextRequest
+	(*SearchBookTextResponse)(nil),        // 63: books.v1.SearchBookTextResponse
+	(*ReadingDay)(nil),                    // 64: books.v1.ReadingDay
+	(*BookReadingStats)(nil),              // 65: books.v1.BookReadingStats
+	(*GetReadingStatsRequest)(nil),        // 66: books.v1.GetReadingStatsRequest
+	(*GetReadingStatsResponse)(nil),       // 67: books.v1.GetReadingStatsResponse
 }
 var file_books_v1_library_proto_depIdxs = []int32{
 	0,  // 0: books.v1.UserBook.book:type_name -> books.v1.Book
@@ -2719,55 +4329,82 @@ var file_books_v1_library_proto_depIdxs = []int32{
 	1,  // 3: books.v1.LibraryResponse.wishlist:type_name -> books.v1.UserBook
 	1,  // 4: books.v1.LibraryResponse.finished:type_name -> books.v1.UserBook
 	2,  // 5: books.v1.LibraryResponse.shelves:type_name -> books.v1.BookShelf
-	3,  // 6: books.v1.GetLibraryResponse.library:type_name -> books.v1.LibraryResponse
-	4,  // 7: books.v1.GetBooksProgressResponse.progress:type_name -> books.v1.BooksProgressResponse
-	1,  // 8: books.v1.SearchLibraryResponse.books:type_name -> books.v1.UserBook
-	5,  // 9: books.v1.SearchExternalResponse.results:type_name -> books.v1.ExternalBookResult
-	5,  // 10: books.v1.GetExternalBookResponse.result:type_name -> books.v1.ExternalBookResult
-	6,  // 11: books.v1.GetReadingStateResponse.state:type_name -> books.v1.BookReadingStateData
-	7,  // 12: books.v1.LibraryService.GetLibrary:input_type -> books.v1.GetLibraryRequest
-	9,  // 13: books.v1.LibraryService.GetBooksProgress:input_type -> books.v1.GetBooksProgressRequest
-	11, // 14: books.v1.LibraryService.SearchLibrary:input_type -> books.v1.SearchLibraryRequest
-	13, // 15: books.v1.LibraryService.SearchExternal:input_type -> books.v1.SearchExternalRequest
-	15, // 16: books.v1.LibraryService.GetExternalBook:input_type -> books.v1.GetExternalBookRequest
-	17, // 17: books.v1.LibraryService.CreateBook:input_type -> books.v1.CreateBookRequest
-	19, // 18: books.v1.LibraryService.UpdateBookStatus:input_type -> books.v1.UpdateBookStatusRequest
-	25, // 19: books.v1.LibraryService.UpdateFinishedAt:input_type -> books.v1.UpdateFinishedAtRequest
-	27, // 20: books.v1.LibraryService.UpdateProgress:input_type -> books.v1.UpdateProgressRequest
-	21, // 21: books.v1.LibraryService.ToggleTag:input_type -> books.v1.ToggleTagRequest
-	23, // 22: books.v1.LibraryService.RemoveBook:input_type -> books.v1.RemoveBookRequest
-	29, // 23: books.v1.LibraryService.UpdateReadingProgress:input_type -> books.v1.UpdateReadingProgressRequest
-	31, // 24: books.v1.LibraryService.GetReadingState:input_type -> books.v1.GetReadingStateRequest
-	33, // 25: books.v1.LibraryService.GetBookContent:input_type -> books.v1.GetBookContentRequest
-	35, // 26: books.v1.LibraryService.CreateShelf:input_type -> books.v1.CreateShelfRequest
-	37, // 27: books.v1.LibraryService.RenameShelf:input_type -> books.v1.RenameShelfRequest
-	39, // 28: books.v1.LibraryService.DeleteShelf:input_type -> books.v1.DeleteShelfRequest
-	41, // 29: books.v1.LibraryService.RenameTag:input_type -> books.v1.RenameTagRequest
-	43, // 30: books.v1.LibraryService.DeleteTag:input_type -> books.v1.DeleteTagRequest
-	8,  // 31: books.v1.LibraryService.GetLibrary:output_type -> books.v1.GetLibraryResponse
-	10, // 32: books.v1.LibraryService.GetBooksProgress:output_type -> books.v1.GetBooksProgressResponse
-	12, // 33: books.v1.LibraryService.SearchLibrary:output_type -> books.v1.SearchLibraryResponse
-	14, // 34: books.v1.LibraryService.SearchExternal:output_type -> books.v1.SearchExternalResponse
-	16, // 35: books.v1.LibraryService.GetExternalBook:output_type -> books.v1.GetExternalBookResponse
-	18, // 36: books.v1.LibraryService.CreateBook:output_type -> books.v1.CreateBookResponse
-	20, // 37: books.v1.LibraryService.UpdateBookStatus:output_type -> books.v1.UpdateBookStatusResponse
-	26, // 38: books.v1.LibraryService.UpdateFinishedAt:output_type -> books.v1.UpdateFinishedAtResponse
-	28, // 39: books.v1.LibraryService.UpdateProgress:output_type -> books.v1.UpdateProgressResponse
-	22, // 40: books.v1.LibraryService.ToggleTag:output_type -> books.v1.ToggleTagResponse
-	24, // 41: books.v1.LibraryService.RemoveBook:output_type -> books.v1.RemoveBookResponse
-	30, // 42: books.v1.LibraryService.UpdateReadingProgress:output_type -> books.v1.UpdateReadingProgressResponse
-	32, // 43: books.v1.LibraryService.GetReadingState:output_type -> books.v1.GetReadingStateResponse
-	34, // 44: books.v1.LibraryService.GetBookContent:output_type -> books.v1.GetBookContentResponse
-	36, // 45: books.v1.LibraryService.CreateShelf:output_type -> books.v1.CreateShelfResponse
-	38, // 46: books.v1.LibraryService.RenameShelf:output_type -> books.v1.RenameShelfResponse
-	40, // 47: books.v1.LibraryService.DeleteShelf:output_type -> books.v1.DeleteShelfResponse
-	42, // 48: books.v1.LibraryService.RenameTag:output_type -> books.v1.RenameTagResponse
-	44, // 49: books.v1.LibraryService.DeleteTag:output_type -> books.v1.DeleteTagResponse
-	31, // [31:50] is the sub-list for method output_type
-	12, // [12:31] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	1,  // 6: books.v1.LibraryResponse.next_in_series:type_name -> books.v1.UserBook
+	1,  // 7: books.v1.LibraryResponse.up_next:type_name -> books.v1.UserBook
+	4,  // 8: books.v1.LibraryResponse.queue_settings:type_name -> books.v1.QueueSettings
+	3,  // 9: books.v1.GetLibraryResponse.library:type_name -> books.v1.LibraryResponse
+	5,  // 10: books.v1.GetBooksProgressResponse.progress:type_name -> books.v1.BooksProgressResponse
+	1,  // 11: books.v1.SearchLibraryResponse.books:type_name -> books.v1.UserBook
+	6,  // 12: books.v1.SearchExternalResponse.results:type_name -> books.v1.ExternalBookResult
+	6,  // 13: books.v1.GetExternalBookResponse.result:type_name -> books.v1.ExternalBookResult
+	4,  // 14: books.v1.UpdateQueueSettingsRequest.settings:type_name -> books.v1.QueueSettings
+	7,  // 15: books.v1.GetReadingStateResponse.state:type_name -> books.v1.BookReadingStateData
+	54, // 16: books.v1.ListAnnotationsResponse.annotations:type_name -> books.v1.Annotation
+	54, // 17: books.v1.SearchAnnotationsResponse.annotations:type_name -> books.v1.Annotation
+	61, // 18: books.v1.SearchBookTextResponse.hits:type_name -> books.v1.TextHit
+	64, // 19: books.v1.GetReadingStatsResponse.days:type_name -> books.v1.ReadingDay
+	65, // 20: books.v1.GetReadingStatsResponse.books:type_name -> books.v1.BookReadingStats
+	8,  // 21: books.v1.LibraryService.GetLibrary:input_type -> books.v1.GetLibraryRequest
+	10, // 22: books.v1.LibraryService.GetBooksProgress:input_type -> books.v1.GetBooksProgressRequest
+	66, // 23: books.v1.LibraryService.GetReadingStats:input_type -> books.v1.GetReadingStatsRequest
+	12, // 24: books.v1.LibraryService.SearchLibrary:input_type -> books.v1.SearchLibraryRequest
+	14, // 25: books.v1.LibraryService.SearchExternal:input_type -> books.v1.SearchExternalRequest
+	16, // 26: books.v1.LibraryService.GetExternalBook:input_type -> books.v1.GetExternalBookRequest
+	18, // 27: books.v1.LibraryService.CreateBook:input_type -> books.v1.CreateBookRequest
+	20, // 28: books.v1.LibraryService.UpdateBookStatus:input_type -> books.v1.UpdateBookStatusRequest
+	26, // 29: books.v1.LibraryService.UpdateFinishedAt:input_type -> books.v1.UpdateFinishedAtRequest
+	28, // 30: books.v1.LibraryService.UpdateProgress:input_type -> books.v1.UpdateProgressRequest
+	22, // 31: books.v1.LibraryService.ToggleTag:input_type -> books.v1.ToggleTagRequest
+	24, // 32: books.v1.LibraryService.RemoveBook:input_type -> books.v1.RemoveBookRequest
+	32, // 33: books.v1.LibraryService.QueueBook:input_type -> books.v1.QueueBookRequest
+	34, // 34: books.v1.LibraryService.DequeueBook:input_type -> books.v1.DequeueBookRequest
+	36, // 35: books.v1.LibraryService.ReorderQueue:input_type -> books.v1.ReorderQueueRequest
+	38, // 36: books.v1.LibraryService.UpdateQueueSettings:input_type -> books.v1.UpdateQueueSettingsRequest
+	30, // 37: books.v1.LibraryService.UpdateReadingProgress:input_type -> books.v1.UpdateReadingProgressRequest
+	40, // 38: books.v1.LibraryService.GetReadingState:input_type -> books.v1.GetReadingStateRequest
+	42, // 39: books.v1.LibraryService.GetBookContent:input_type -> books.v1.GetBookContentRequest
+	44, // 40: books.v1.LibraryService.CreateShelf:input_type -> books.v1.CreateShelfRequest
+	46, // 41: books.v1.LibraryService.RenameShelf:input_type -> books.v1.RenameShelfRequest
+	48, // 42: books.v1.LibraryService.DeleteShelf:input_type -> books.v1.DeleteShelfRequest
+	50, // 43: books.v1.LibraryService.RenameTag:input_type -> books.v1.RenameTagRequest
+	52, // 44: books.v1.LibraryService.DeleteTag:input_type -> books.v1.DeleteTagRequest
+	55, // 45: books.v1.LibraryService.ListAnnotations:input_type -> books.v1.ListAnnotationsRequest
+	57, // 46: books.v1.LibraryService.SearchAnnotations:input_type -> books.v1.SearchAnnotationsRequest
+	59, // 47: books.v1.LibraryService.ExportAnnotations:input_type -> books.v1.ExportAnnotationsRequest
+	62, // 48: books.v1.LibraryService.SearchBookText:input_type -> books.v1.SearchBookTextRequest
+	9,  // 49: books.v1.LibraryService.GetLibrary:output_type -> books.v1.GetLibraryResponse
+	11, // 50: books.v1.LibraryService.GetBooksProgress:output_type -> books.v1.GetBooksProgressResponse
+	67, // 51: books.v1.LibraryService.GetReadingStats:output_type -> books.v1.GetReadingStatsResponse
+	13, // 52: books.v1.LibraryService.SearchLibrary:output_type -> books.v1.SearchLibraryResponse
+	15, // 53: books.v1.LibraryService.SearchExternal:output_type -> books.v1.SearchExternalResponse
+	17, // 54: books.v1.LibraryService.GetExternalBook:output_type -> books.v1.GetExternalBookResponse
+	19, // 55: books.v1.LibraryService.CreateBook:output_type -> books.v1.CreateBookResponse
+	21, // 56: books.v1.LibraryService.UpdateBookStatus:output_type -> books.v1.UpdateBookStatusResponse
+	27, // 57: books.v1.LibraryService.UpdateFinishedAt:output_type -> books.v1.UpdateFinishedAtResponse
+	29, // 58: books.v1.LibraryService.UpdateProgress:output_type -> books.v1.UpdateProgressResponse
+	23, // 59: books.v1.LibraryService.ToggleTag:output_type -> books.v1.ToggleTagResponse
+	25, // 60: books.v1.LibraryService.RemoveBook:output_type -> books.v1.RemoveBookResponse
+	33, // 61: books.v1.LibraryService.QueueBook:output_type -> books.v1.QueueBookResponse
+	35, // 62: books.v1.LibraryService.DequeueBook:output_type -> books.v1.DequeueBookResponse
+	37, // 63: books.v1.LibraryService.ReorderQueue:output_type -> books.v1.ReorderQueueResponse
+	39, // 64: books.v1.LibraryService.UpdateQueueSettings:output_type -> books.v1.UpdateQueueSettingsResponse
+	31, // 65: books.v1.LibraryService.UpdateReadingProgress:output_type -> books.v1.UpdateReadingProgressResponse
+	41, // 66: books.v1.LibraryService.GetReadingState:output_type -> books.v1.GetReadingStateResponse
+	43, // 67: books.v1.LibraryService.GetBookContent:output_type -> books.v1.GetBookContentResponse
+	45, // 68: books.v1.LibraryService.CreateShelf:output_type -> books.v1.CreateShelfResponse
+	47, // 69: books.v1.LibraryService.RenameShelf:output_type -> books.v1.RenameShelfResponse
+	49, // 70: books.v1.LibraryService.DeleteShelf:output_type -> books.v1.DeleteShelfResponse
+	51, // 71: books.v1.LibraryService.RenameTag:output_type -> books.v1.RenameTagResponse
+	53, // 72: books.v1.LibraryService.DeleteTag:output_type -> books.v1.DeleteTagResponse
+	56, // 73: books.v1.LibraryService.ListAnnotations:output_type -> books.v1.ListAnnotationsResponse
+	58, // 74: books.v1.LibraryService.SearchAnnotations:output_type -> books.v1.SearchAnnotationsResponse
+	60, // 75: books.v1.LibraryService.ExportAnnotations:output_type -> books.v1.ExportAnnotationsResponse
+	63, // 76: books.v1.LibraryService.SearchBookText:output_type -> books.v1.SearchBookTextResponse
+	49, // [49:77] is the sub-list for method output_type
+	21, // [21:49] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_books_v1_library_proto_init() }
@@ -2775,13 +4412,15 @@ func file_books_v1_library_proto_init() {
 	if File_books_v1_library_proto != nil {
 		return
 	}
+	file_books_v1_library_proto_msgTypes[0].OneofWrappers = []any{}
+	file_books_v1_library_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_books_v1_library_proto_rawDesc), len(file_books_v1_library_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   45,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,438 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: books/v1/opds.proto
+
+package booksv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OPDSToken grants a reader app access to the OPDS catalog. The raw token is
+// part of the catalog URL and is only returned once, on creation.
+type OPDSToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,4,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OPDSToken) Reset() {
+	*x = OPDSToken{}
+	mi := &file_books_v1_opds_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OPDSToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OPDSToken) ProtoMessage() {}
+
+func (x *OPDSToken) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_opds_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OPDSToken.ProtoReflect.Descriptor instead.
+func (*OPDSToken) Descriptor() ([]byte, []int) {
+	return file_books_v1_opds_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OPDSToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OPDSToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OPDSToken) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *OPDSToken) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+type CreateOPDSTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOPDSTokenRequest) Reset() {
+	*x = CreateOPDSTokenRequest{}
+	mi := &file_books_v1_opds_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOPDSTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOPDSTokenRequest) ProtoMessage() {}
+
+func (x *CreateOPDSTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_opds_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOPDSTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateOPDSTokenRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_opds_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateOPDSTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateOPDSTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *OPDSToken             `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RawToken      string                 `protobuf:"bytes,2,opt,name=raw_token,json=rawToken,proto3" json:"raw_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOPDSTokenResponse) Reset() {
+	*x = CreateOPDSTokenResponse{}
+	mi := &file_books_v1_opds_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOPDSTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOPDSTokenResponse) ProtoMessage() {}
+
+func (x *CreateOPDSTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_opds_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOPDSTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateOPDSTokenResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_opds_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateOPDSTokenResponse) GetToken() *OPDSToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *CreateOPDSTokenResponse) GetRawToken() string {
+	if x != nil {
+		return x.RawToken
+	}
+	return ""
+}
+
+type ListOPDSTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOPDSTokensRequest) Reset() {
+	*x = ListOPDSTokensRequest{}
+	mi := &file_books_v1_opds_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOPDSTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOPDSTokensRequest) ProtoMessage() {}
+
+func (x *ListOPDSTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_opds_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOPDSTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListOPDSTokensRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_opds_proto_rawDescGZIP(), []int{3}
+}
+
+type ListOPDSTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*OPDSToken           `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOPDSTokensResponse) Reset() {
+	*x = ListOPDSTokensResponse{}
+	mi := &file_books_v1_opds_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOPDSTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOPDSTokensResponse) ProtoMessage() {}
+
+func (x *ListOPDSTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_opds_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOPDSTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListOPDSTokensResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_opds_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListOPDSTokensResponse) GetTokens() []*OPDSToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type RevokeOPDSTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOPDSTokenRequest) Reset() {
+	*x = RevokeOPDSTokenRequest{}
+	mi := &file_books_v1_opds_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOPDSTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOPDSTokenRequest) ProtoMessage() {}
+
+func (x *RevokeOPDSTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_opds_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOPDSTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeOPDSTokenRequest) Descriptor() ([]byte, []int) {
+	return file_books_v1_opds_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevokeOPDSTokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeOPDSTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOPDSTokenResponse) Reset() {
+	*x = RevokeOPDSTokenResponse{}
+	mi := &file_books_v1_opds_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOPDSTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOPDSTokenResponse) ProtoMessage() {}
+
+func (x *RevokeOPDSTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_books_v1_opds_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOPDSTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeOPDSTokenResponse) Descriptor() ([]byte, []int) {
+	return file_books_v1_opds_proto_rawDescGZIP(), []int{6}
+}
+
+var File_books_v1_opds_proto protoreflect.FileDescriptor
+
+const file_books_v1_opds_proto_rawDesc = "" +
+	"\n" +
+	"\x13books/v1/opds.proto\x12\bbooks.v1\"p\n" +
+	"\tOPDSToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\x04 \x01(\tR\n" +
+	"lastUsedAt\",\n" +
+	"\x16CreateOPDSTokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"a\n" +
+	"\x17CreateOPDSTokenResponse\x12)\n" +
+	"\x05token\x18\x01 \x01(\v2\x13.books.v1.OPDSTokenR\x05token\x12\x1b\n" +
+	"\traw_token\x18\x02 \x01(\tR\brawToken\"\x17\n" +
+	"\x15ListOPDSTokensRequest\"E\n" +
+	"\x16ListOPDSTokensResponse\x12+\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x13.books.v1.OPDSTokenR\x06tokens\"(\n" +
+	"\x16RevokeOPDSTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x19\n" +
+	"\x17RevokeOPDSTokenResponse2\x92\x02\n" +
+	"\vOPDSService\x12V\n" +
+	"\x0fCreateOPDSToken\x12 .books.v1.CreateOPDSTokenRequest\x1a!.books.v1.CreateOPDSTokenResponse\x12S\n" +
+	"\x0eListOPDSTokens\x12\x1f.books.v1.ListOPDSTokensRequest\x1a .books.v1.ListOPDSTokensResponse\x12V\n" +
+	"\x0fRevokeOPDSToken\x12 .books.v1.RevokeOPDSTokenRequest\x1a!.books.v1.RevokeOPDSTokenResponseB)Z'tools.xdoubleu.com/gen/books/v1;booksv1b\x06proto3"
+
+var (
+	file_books_v1_opds_proto_rawDescOnce sync.Once
+	file_books_v1_opds_proto_rawDescData []byte
+)
+
+func file_books_v1_opds_proto_rawDescGZIP() []byte {
+	file_books_v1_opds_proto_rawDescOnce.Do(func() {
+		file_books_v1_opds_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_books_v1_opds_proto_rawDesc), len(file_books_v1_opds_proto_rawDesc)))
+	})
+	return file_books_v1_opds_proto_rawDescData
+}
+
+var file_books_v1_opds_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_books_v1_opds_proto_goTypes = []any{
+	(*OPDSToken)(nil),               // 0: books.v1.OPDSToken
+	(*CreateOPDSTokenRequest)(nil),  // 1: books.v1.CreateOPDSTokenRequest
+	(*CreateOPDSTokenResponse)(nil), // 2: books.v1.CreateOPDSTokenResponse
+	(*ListOPDSTokensRequest)(nil),   // 3: books.v1.ListOPDSTokensRequest
+	(*ListOPDSTokensResponse)(nil),  // 4: books.v1.ListOPDSTokensResponse
+	(*RevokeOPDSTokenRequest)(nil),  // 5: books.v1.RevokeOPDSTokenRequest
+	(*RevokeOPDSTokenResponse)(nil), // 6: books.v1.RevokeOPDSTokenResponse
+}
+var file_books_v1_opds_proto_depIdxs = []int32{
+	0, // 0: books.v1.CreateOPDSTokenResponse.token:type_name -> books.v1.OPDSToken
+	0, // 1: books.v1.ListOPDSTokensResponse.tokens:type_name -> books.v1.OPDSToken
+	1, // 2: books.v1.OPDSService.CreateOPDSToken:input_type -> books.v1.CreateOPDSTokenRequest
+	3, // 3: books.v1.OPDSService.ListOPDSTokens:input_type -> books.v1.ListOPDSTokensRequest
+	5, // 4: books.v1.OPDSService.RevokeOPDSToken:input_type -> books.v1.RevokeOPDSTokenRequest
+	2, // 5: books.v1.OPDSService.CreateOPDSToken:output_type -> books.v1.CreateOPDSTokenResponse
+	4, // 6: books.v1.OPDSService.ListOPDSTokens:output_type -> books.v1.ListOPDSTokensResponse
+	6, // 7: books.v1.OPDSService.RevokeOPDSToken:output_type -> books.v1.RevokeOPDSTokenResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_books_v1_opds_proto_init() }
+func file_books_v1_opds_proto_init() {
+	if File_books_v1_opds_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_books_v1_opds_proto_rawDesc), len(file_books_v1_opds_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_books_v1_opds_proto_goTypes,
+		DependencyIndexes: file_books_v1_opds_proto_depIdxs,
+		MessageInfos:      file_books_v1_opds_proto_msgTypes,
+	}.Build()
+	File_books_v1_opds_proto = out.File
+	file_books_v1_opds_proto_goTypes = nil
+	file_books_v1_opds_proto_depIdxs = nil
+}
@@ -43,6 +43,9 @@ const (
 	// PublicReadingDashboardServiceGetSharedFeedsSummaryProcedure is the fully-qualified name of the
 	// PublicReadingDashboardService's GetSharedFeedsSummary RPC.
 	PublicReadingDashboardServiceGetSharedFeedsSummaryProcedure = "/dashboard.v1.PublicReadingDashboardService/GetSharedFeedsSummary"
+	// PublicReadingDashboardServiceGetSharedReadingGoalsProcedure is the fully-qualified name of the
+	// PublicReadingDashboardService's GetSharedReadingGoals RPC.
+	PublicReadingDashboardServiceGetSharedReadingGoalsProcedure = "/dashboard.v1.PublicReadingDashboardService/GetSharedReadingGoals"
 )
 
 // PublicReadingDashboardServiceClient is a client for the
@@ -51,6 +54,7 @@ type PublicReadingDashboardServiceClient interface {
 	GetSharedLibrary(context.Context, *connect.Request[v1.GetSharedLibraryRequest]) (*connect.Response[v1.GetSharedLibraryResponse], error)
 	GetSharedBooksProgress(context.Context, *connect.Request[v1.GetSharedBooksProgressRequest]) (*connect.Response[v1.GetSharedBooksProgressResponse], error)
 	GetSharedFeedsSummary(context.Context, *connect.Request[v1.GetSharedFeedsSummaryRequest]) (*connect.Response[v1.GetSharedFeedsSummaryResponse], error)
+	GetSharedReadingGoals(context.Context, *connect.Request[v1.GetSharedReadingGoalsRequest]) (*connect.Response[v1.GetSharedReadingGoalsResponse], error)
 }
 
 // NewPublicReadingDashboardServiceClient constructs a client for the
@@ -82,6 +86,12 @@ func NewPublicReadingDashboardServiceClient(httpClient connect.HTTPClient, baseU
 			connect.WithSchema(publicReadingDashboardServiceMethods.ByName("GetSharedFeedsSummary")),
 			connect.WithClientOptions(opts...),
 		),
+		getSharedReadingGoals: connect.NewClient[v1.GetSharedReadingGoalsRequest, v1.GetSharedReadingGoalsResponse](
+			httpClient,
+			baseURL+PublicReadingDashboardServiceGetSharedReadingGoalsProcedure,
+			connect.WithSchema(publicReadingDashboardServiceMethods.ByName("GetSharedReadingGoals")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -90,6 +100,7 @@ type publicReadingDashboardServiceClient struct {
 	getSharedLibrary       *connect.Client[v1.GetSharedLibraryRequest, v1.GetSharedLibraryResponse]
 	getSharedBooksProgress *connect.Client[v1.GetSharedBooksProgressRequest, v1.GetSharedBooksProgressResponse]
 	getSharedFeedsSummary  *connect.Client[v1.GetSharedFeedsSummaryRequest, v1.GetSharedFeedsSummaryResponse]
+	getSharedReadingGoals  *connect.Client[v1.GetSharedReadingGoalsRequest, v1.GetSharedReadingGoalsResponse]
 }
 
 // GetSharedLibrary calls dashboard.v1.PublicReadingDashboardService.GetSharedLibrary.
@@ -107,12 +118,18 @@ func (c *publicReadingDashboardServiceClient) GetSharedFeedsSummary(ctx context.
 	return c.getSharedFeedsSummary.CallUnary(ctx, req)
 }
 
+// GetSharedReadingGoals calls dashboard.v1.PublicReadingDashboardService.GetSharedReadingGoals.
+func (c *publicReadingDashboardServiceClient) GetSharedReadingGoals(ctx context.Context, req *connect.Request[v1.GetSharedReadingGoalsRequest]) (*connect.Response[v1.GetSharedReadingGoalsResponse], error) {
+	return c.getSharedReadingGoals.CallUnary(ctx, req)
+}
+
 // PublicReadingDashboardServiceHandler is an implementation of the
 // dashboard.v1.PublicReadingDashboardService service.
 type PublicReadingDashboardServiceHandler interface {
 	GetSharedLibrary(context.Context, *connect.Request[v1.GetSharedLibraryRequest]) (*connect.Response[v1.GetSharedLibraryResponse], error)
 	GetSharedBooksProgress(context.Context, *connect.Request[v1.GetSharedBooksProgressRequest]) (*connect.Response[v1.GetSharedBooksProgressResponse], error)
 	GetSharedFeedsSummary(context.Context, *connect.Request[v1.GetSharedFeedsSummaryRequest]) (*connect.Response[v1.GetSharedFeedsSummaryResponse], error)
+	GetSharedReadingGoals(context.Context, *connect.Request[v1.GetSharedReadingGoalsRequest]) (*connect.Response[v1.GetSharedReadingGoalsResponse], error)
 }
 
 // NewPublicReadingDashboardServiceHandler builds an HTTP handler from the service implementation.
@@ -140,6 +157,12 @@ func NewPublicReadingDashboardServiceHandler(svc PublicReadingDashboardServiceHa
 		connect.WithSchema(publicReadingDashboardServiceMethods.ByName("GetSharedFeedsSummary")),
 		connect.WithHandlerOptions(opts...),
 	)
+	publicReadingDashboardServiceGetSharedReadingGoalsHandler := connect.NewUnaryHandler(
+		PublicReadingDashboardServiceGetSharedReadingGoalsProcedure,
+		svc.GetSharedReadingGoals,
+		connect.WithSchema(publicReadingDashboardServiceMethods.ByName("GetSharedReadingGoals")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/dashboard.v1.PublicReadingDashboardService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PublicReadingDashboardServiceGetSharedLibraryProcedure:
@@ -148,6 +171,8 @@ func NewPublicReadingDashboardServiceHandler(svc PublicReadingDashboardServiceHa
 			publicReadingDashboardServiceGetSharedBooksProgressHandler.ServeHTTP(w, r)
 		case PublicReadingDashboardServiceGetSharedFeedsSummaryProcedure:
 			publicReadingDashboardServiceGetSharedFeedsSummaryHandler.ServeHTTP(w, r)
+		case PublicReadingDashboardServiceGetSharedReadingGoalsProcedure:
+			publicReadingDashboardServiceGetSharedReadingGoalsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -168,3 +193,7 @@ func (UnimplementedPublicReadingDashboardServiceHandler) GetSharedBooksProgress(
 func (UnimplementedPublicReadingDashboardServiceHandler) GetSharedFeedsSummary(context.Context, *connect.Request[v1.GetSharedFeedsSummaryRequest]) (*connect.Response[v1.GetSharedFeedsSummaryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dashboard.v1.PublicReadingDashboardService.GetSharedFeedsSummary is not implemented"))
 }
+
+func (UnimplementedPublicReadingDashboardServiceHandler) GetSharedReadingGoals(context.Context, *connect.Request[v1.GetSharedReadingGoalsRequest]) (*connect.Response[v1.GetSharedReadingGoalsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dashboard.v1.PublicReadingDashboardService.GetSharedReadingGoals is not implemented"))
+}
@@ -379,11 +379,110 @@ func (x *GetSharedFeedsSummaryResponse) GetFeeds() []*SharedFeed {
 	return nil
 }
 
+// GetSharedReadingGoals returns progress on the goals the owner opted into
+// showing publicly, for a year (default: the current one). Empty when none
+// are shared.
+type GetSharedReadingGoalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedReadingGoalsRequest) Reset() {
+	*x = GetSharedReadingGoalsRequest{}
+	mi := &file_dashboard_v1_reading_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedReadingGoalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedReadingGoalsRequest) ProtoMessage() {}
+
+func (x *GetSharedReadingGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dashboard_v1_reading_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedReadingGoalsRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedReadingGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_dashboard_v1_reading_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetSharedReadingGoalsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetSharedReadingGoalsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type GetSharedReadingGoalsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Goals         []*v1.ReadingGoalProgress `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedReadingGoalsResponse) Reset() {
+	*x = GetSharedReadingGoalsResponse{}
+	mi := &file_dashboard_v1_reading_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedReadingGoalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedReadingGoalsResponse) ProtoMessage() {}
+
+func (x *GetSharedReadingGoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dashboard_v1_reading_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedReadingGoalsResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedReadingGoalsResponse) Descriptor() ([]byte, []int) {
+	return file_dashboard_v1_reading_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetSharedReadingGoalsResponse) GetGoals() []*v1.ReadingGoalProgress {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
 var File_dashboard_v1_reading_proto protoreflect.FileDescriptor
 
 const file_dashboard_v1_reading_proto_rawDesc = "" +
 	"\n" +
-	"\x1adashboard/v1/reading.proto\x12\fdashboard.v1\x1a\x16books/v1/library.proto\"/\n" +
+	"\x1adashboard/v1/reading.proto\x12\fdashboard.v1\x1a\x14books/v1/goals.proto\x1a\x16books/v1/library.proto\"/\n" +
 	"\x17GetSharedLibraryRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\"\x98\x01\n" +
 	"\x18GetSharedLibraryResponse\x123\n" +
@@ -404,11 +503,17 @@ const file_dashboard_v1_reading_proto_rawDesc = "" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\"O\n" +
 	"\x1dGetSharedFeedsSummaryResponse\x12.\n" +
-	"\x05feeds\x18\x01 \x03(\v2\x18.dashboard.v1.SharedFeedR\x05feeds2\xe9\x02\n" +
+	"\x05feeds\x18\x01 \x03(\v2\x18.dashboard.v1.SharedFeedR\x05feeds\"H\n" +
+	"\x1cGetSharedReadingGoalsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\"T\n" +
+	"\x1dGetSharedReadingGoalsResponse\x123\n" +
+	"\x05goals\x18\x01 \x03(\v2\x1d.books.v1.ReadingGoalProgressR\x05goals2\xdb\x03\n" +
 	"\x1dPublicReadingDashboardService\x12a\n" +
 	"\x10GetSharedLibrary\x12%.dashboard.v1.GetSharedLibraryRequest\x1a&.dashboard.v1.GetSharedLibraryResponse\x12s\n" +
 	"\x16GetSharedBooksProgress\x12+.dashboard.v1.GetSharedBooksProgressRequest\x1a,.dashboard.v1.GetSharedBooksProgressResponse\x12p\n" +
-	"\x15GetSharedFeedsSummary\x12*.dashboard.v1.GetSharedFeedsSummaryRequest\x1a+.dashboard.v1.GetSharedFeedsSummaryResponseB1Z/tools.xdoubleu.com/gen/dashboard/v1;dashboardv1b\x06proto3"
+	"\x15GetSharedFeedsSummary\x12*.dashboard.v1.GetSharedFeedsSummaryRequest\x1a+.dashboard.v1.GetSharedFeedsSummaryResponse\x12p\n" +
+	"\x15GetSharedReadingGoals\x12*.dashboard.v1.GetSharedReadingGoalsRequest\x1a+.dashboard.v1.GetSharedReadingGoalsResponseB1Z/tools.xdoubleu.com/gen/dashboard/v1;dashboardv1b\x06proto3"
 
 var (
 	file_dashboard_v1_reading_proto_rawDescOnce sync.Once
@@ -422,7 +527,7 @@ func file_dashboard_v1_reading_proto_rawDescGZIP() []byte {
 	return file_dashboard_v1_reading_proto_rawDescData
 }
 
-var file_dashboard_v1_reading_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_dashboard_v1_reading_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_dashboard_v1_reading_proto_goTypes = []any{
 	(*GetSharedLibraryRequest)(nil),        // 0: dashboard.v1.GetSharedLibraryRequest
 	(*GetSharedLibraryResponse)(nil),       // 1: dashboard.v1.GetSharedLibraryResponse
@@ -431,24 +536,30 @@ var file_dashboard_v1_reading_proto_goTypes = []any{
 	(*GetSharedFeedsSummaryRequest)(nil),   // 4: dashboard.v1.GetSharedFeedsSummaryRequest
 	(*SharedFeed)(nil),                     // 5: dashboard.v1.SharedFeed
 	(*GetSharedFeedsSummaryResponse)(nil),  // 6: dashboard.v1.GetSharedFeedsSummaryResponse
-	(*v1.LibraryResponse)(nil),             // 7: books.v1.LibraryResponse
-	(*v1.BooksProgressResponse)(nil),       // 8: books.v1.BooksProgressResponse
+	(*GetSharedReadingGoalsRequest)(nil),   // 7: dashboard.v1.GetSharedReadingGoalsRequest
+	(*GetSharedReadingGoalsResponse)(nil),  // 8: dashboard.v1.GetSharedReadingGoalsResponse
+	(*v1.LibraryResponse)(nil),             // 9: books.v1.LibraryResponse
+	(*v1.BooksProgressResponse)(nil),       // 10: books.v1.BooksProgressResponse
+	(*v1.ReadingGoalProgress)(nil),         // 11: books.v1.ReadingGoalProgress
 }
 var file_dashboard_v1_reading_proto_depIdxs = []int32{
-	7, // 0: dashboard.v1.GetSharedLibraryResponse.library:type_name -> books.v1.LibraryResponse
-	8, // 1: dashboard.v1.GetSharedBooksProgressResponse.progress:type_name -> books.v1.BooksProgressResponse
-	5, // 2: dashboard.v1.GetSharedFeedsSummaryResponse.feeds:type_name -> dashboard.v1.SharedFeed
-	0, // 3: dashboard.v1.PublicReadingDashboardService.GetSharedLibrary:input_type -> dashboard.v1.GetSharedLibraryRequest
-	2, // 4: dashboard.v1.PublicReadingDashboardService.GetSharedBooksProgress:input_type -> dashboard.v1.GetSharedBooksProgressRequest
-	4, // 5: dashboard.v1.PublicReadingDashboardService.GetSharedFeedsSummary:input_type -> dashboard.v1.GetSharedFeedsSummaryRequest
-	1, // 6: dashboard.v1.PublicReadingDashboardService.GetSharedLibrary:output_type -> dashboard.v1.GetSharedLibraryResponse
-	3, // 7: dashboard.v1.PublicReadingDashboardService.GetSharedBooksProgress:output_type -> dashboard.v1.GetSharedBooksProgressResponse
-	6, // 8: dashboard.v1.PublicReadingDashboardService.GetSharedFeedsSummary:output_type -> dashboard.v1.GetSharedFeedsSummaryResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	9,  // 0: dashboard.v1.GetSharedLibraryResponse.library:type_name -> books.v1.LibraryResponse
+	10, // 1: dashboard.v1.GetSharedBooksProgressResponse.progress:type_name -> books.v1.BooksProgressResponse
+	5,  // 2: dashboard.v1.GetSharedFeedsSummaryResponse.feeds:type_name -> dashboard.v1.SharedFeed
+	11, // 3: dashboard.v1.GetSharedReadingGoalsResponse.goals:type_name -> books.v1.ReadingGoalProgress
+	0,  // 4: dashboard.v1.PublicReadingDashboardService.GetSharedLibrary:input_type -> dashboard.v1.GetSharedLibraryRequest
+	2,  // 5: dashboard.v1.PublicReadingDashboardService.GetSharedBooksProgress:input_type -> dashboard.v1.GetSharedBooksProgressRequest
+	4,  // 6: dashboard.v1.PublicReadingDashboardService.GetSharedFeedsSummary:input_type -> dashboard.v1.GetSharedFeedsSummaryRequest
+	7,  // 7: dashboard.v1.PublicReadingDashboardService.GetSharedReadingGoals:input_type -> dashboard.v1.GetSharedReadingGoalsRequest
+	1,  // 8: dashboard.v1.PublicReadingDashboardService.GetSharedLibrary:output_type -> dashboard.v1.GetSharedLibraryResponse
+	3,  // 9: dashboard.v1.PublicReadingDashboardService.GetSharedBooksProgress:output_type -> dashboard.v1.GetSharedBooksProgressResponse
+	6,  // 10: dashboard.v1.PublicReadingDashboardService.GetSharedFeedsSummary:output_type -> dashboard.v1.GetSharedFeedsSummaryResponse
+	8,  // 11: dashboard.v1.PublicReadingDashboardService.GetSharedReadingGoals:output_type -> dashboard.v1.GetSharedReadingGoalsResponse
+	8,  // [8:12] is the sub-list for method output_type
+	4,  // [4:8] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_dashboard_v1_reading_proto_init() }
@@ -462,7 +573,7 @@ func file_dashboard_v1_reading_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dashboard_v1_reading_proto_rawDesc), len(file_dashboard_v1_reading_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -102,7 +102,11 @@ type Feed struct {
 	ConsecutiveFailures int32 `protobuf:"varint,11,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
 	// RFC3339; set while a problem email is outstanding for this feed, empty
 	// once it recovers.
-	NotifiedAt    string `protobuf:"bytes,12,opt,name=notified_at,json=notifiedAt,proto3" json:"notified_at,omitempty"`
+	NotifiedAt string `protobuf:"bytes,12,opt,name=notified_at,json=notifiedAt,proto3" json:"notified_at,omitempty"`
+	// The folder the feed is filed under; empty when unfiled.
+	FolderId string `protobuf:"bytes,14,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	// Unread, non-dismissed items; only set by ListFeeds.
+	UnreadCount   int32 `protobuf:"varint,15,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -221,6 +225,101 @@ func (x *Feed) GetNotifiedAt() string {
 	return ""
 }
 
+func (x *Feed) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *Feed) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+// Folder groups feeds. Folders nest one level: a subfolder's parent is
+// always a top-level folder.
+type Folder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Empty for a top-level folder.
+	ParentId string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Orders the folder among its siblings.
+	Position int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	// Unread items across the folder's feeds, including its subfolders'.
+	UnreadCount   int32 `protobuf:"varint,5,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Folder) Reset() {
+	*x = Folder{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Folder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Folder) ProtoMessage() {}
+
+func (x *Folder) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Folder.ProtoReflect.Descriptor instead.
+func (*Folder) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Folder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Folder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Folder) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Folder) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Folder) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
 type ListFeedsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -229,7 +328,7 @@ type ListFeedsRequest struct {
 
 func (x *ListFeedsRequest) Reset() {
 	*x = ListFeedsRequest{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[1]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -241,7 +340,7 @@ func (x *ListFeedsRequest) String() string {
 func (*ListFeedsRequest) ProtoMessage() {}
 
 func (x *ListFeedsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[1]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -254,19 +353,21 @@ func (x *ListFeedsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFeedsRequest.ProtoReflect.Descriptor instead.
 func (*ListFeedsRequest) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{1}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{2}
 }
 
 type ListFeedsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Feeds         []*Feed                `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Feeds []*Feed                `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	// Every folder, top-level and subfolders alike, ordered by position.
+	Folders       []*Folder `protobuf:"bytes,2,rep,name=folders,proto3" json:"folders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListFeedsResponse) Reset() {
 	*x = ListFeedsResponse{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[2]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +379,7 @@ func (x *ListFeedsResponse) String() string {
 func (*ListFeedsResponse) ProtoMessage() {}
 
 func (x *ListFeedsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[2]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +392,7 @@ func (x *ListFeedsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFeedsResponse.ProtoReflect.Descriptor instead.
 func (*ListFeedsResponse) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{2}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListFeedsResponse) GetFeeds() []*Feed {
@@ -301,6 +402,13 @@ func (x *ListFeedsResponse) GetFeeds() []*Feed {
 	return nil
 }
 
+func (x *ListFeedsResponse) GetFolders() []*Folder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
 // CreateFeed with kind RSS (the default) validates the URL by fetching and
 // parsing it, then imports the feed's current contents (newest first,
 // capped) as a first batch in the background — the import can take longer
@@ -326,7 +434,7 @@ type CreateFeedRequest struct {
 
 func (x *CreateFeedRequest) Reset() {
 	*x = CreateFeedRequest{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[3]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -338,7 +446,7 @@ func (x *CreateFeedRequest) String() string {
 func (*CreateFeedRequest) ProtoMessage() {}
 
 func (x *CreateFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[3]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +459,7 @@ func (x *CreateFeedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFeedRequest.ProtoReflect.Descriptor instead.
 func (*CreateFeedRequest) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{3}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateFeedRequest) GetUrl() string {
@@ -384,7 +492,7 @@ type CreateFeedResponse struct {
 
 func (x *CreateFeedResponse) Reset() {
 	*x = CreateFeedResponse{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[4]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -396,7 +504,7 @@ func (x *CreateFeedResponse) String() string {
 func (*CreateFeedResponse) ProtoMessage() {}
 
 func (x *CreateFeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[4]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +517,7 @@ func (x *CreateFeedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFeedResponse.ProtoReflect.Descriptor instead.
 func (*CreateFeedResponse) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{4}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateFeedResponse) GetFeed() *Feed {
@@ -429,7 +537,7 @@ type UpdateFeedRequest struct {
 
 func (x *UpdateFeedRequest) Reset() {
 	*x = UpdateFeedRequest{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[5]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -441,7 +549,7 @@ func (x *UpdateFeedRequest) String() string {
 func (*UpdateFeedRequest) ProtoMessage() {}
 
 func (x *UpdateFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[5]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +562,7 @@ func (x *UpdateFeedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFeedRequest.ProtoReflect.Descriptor instead.
 func (*UpdateFeedRequest) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{5}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateFeedRequest) GetFeedId() string {
@@ -479,7 +587,7 @@ type UpdateFeedResponse struct {
 
 func (x *UpdateFeedResponse) Reset() {
 	*x = UpdateFeedResponse{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[6]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +599,7 @@ func (x *UpdateFeedResponse) String() string {
 func (*UpdateFeedResponse) ProtoMessage() {}
 
 func (x *UpdateFeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[6]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +612,7 @@ func (x *UpdateFeedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFeedResponse.ProtoReflect.Descriptor instead.
 func (*UpdateFeedResponse) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{6}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{7}
 }
 
 // DeleteFeed removes the subscription and every item it ingested.
@@ -517,7 +625,7 @@ type DeleteFeedRequest struct {
 
 func (x *DeleteFeedRequest) Reset() {
 	*x = DeleteFeedRequest{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[7]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -529,7 +637,7 @@ func (x *DeleteFeedRequest) String() string {
 func (*DeleteFeedRequest) ProtoMessage() {}
 
 func (x *DeleteFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[7]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,7 +650,7 @@ func (x *DeleteFeedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFeedRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFeedRequest) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{7}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteFeedRequest) GetFeedId() string {
@@ -560,7 +668,7 @@ type DeleteFeedResponse struct {
 
 func (x *DeleteFeedResponse) Reset() {
 	*x = DeleteFeedResponse{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[8]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -572,7 +680,7 @@ func (x *DeleteFeedResponse) String() string {
 func (*DeleteFeedResponse) ProtoMessage() {}
 
 func (x *DeleteFeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[8]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,7 +693,7 @@ func (x *DeleteFeedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFeedResponse.ProtoReflect.Descriptor instead.
 func (*DeleteFeedResponse) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{8}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{9}
 }
 
 // RefreshFeed polls the feed synchronously and ingests new items.
@@ -598,7 +706,7 @@ type RefreshFeedRequest struct {
 
 func (x *RefreshFeedRequest) Reset() {
 	*x = RefreshFeedRequest{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[9]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -610,7 +718,7 @@ func (x *RefreshFeedRequest) String() string {
 func (*RefreshFeedRequest) ProtoMessage() {}
 
 func (x *RefreshFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[9]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +731,7 @@ func (x *RefreshFeedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshFeedRequest.ProtoReflect.Descriptor instead.
 func (*RefreshFeedRequest) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{9}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RefreshFeedRequest) GetFeedId() string {
@@ -642,7 +750,7 @@ type RefreshFeedResponse struct {
 
 func (x *RefreshFeedResponse) Reset() {
 	*x = RefreshFeedResponse{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[10]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -654,7 +762,7 @@ func (x *RefreshFeedResponse) String() string {
 func (*RefreshFeedResponse) ProtoMessage() {}
 
 func (x *RefreshFeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[10]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +775,7 @@ func (x *RefreshFeedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshFeedResponse.ProtoReflect.Descriptor instead.
 func (*RefreshFeedResponse) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{10}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RefreshFeedResponse) GetIngested() int32 {
@@ -677,8 +785,9 @@ func (x *RefreshFeedResponse) GetIngested() int32 {
 	return 0
 }
 
-// Item is one ingested feed entry, self-contained (no library/book
-// linkage) — a feed and its items only ever belong to one user.
+// Item is one ingested feed entry, self-contained apart from an optional
+// link to the books-library entry it was sent to — a feed and its items
+// only ever belong to one user.
 type Item struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -705,14 +814,21 @@ type Item struct {
 	// Whether the item has an extracted article body, without carrying it.
 	// Set by every RPC returning an Item; lets a list distinguish "no content"
 	// from "content not loaded yet".
-	HasContent    bool `protobuf:"varint,13,opt,name=has_content,json=hasContent,proto3" json:"has_content,omitempty"`
+	HasContent bool `protobuf:"varint,13,opt,name=has_content,json=hasContent,proto3" json:"has_content,omitempty"`
+	// The books-library entry the item was sent to by SendItemToLibrary;
+	// empty if it never was.
+	LibraryBookId string `protobuf:"bytes,14,opt,name=library_book_id,json=libraryBookId,proto3" json:"library_book_id,omitempty"`
+	// The byline the feed reported (an email's sender); empty when none.
+	Author string `protobuf:"bytes,15,opt,name=author,proto3" json:"author,omitempty"`
+	// Labels filter rules gave the item when it was ingested.
+	Tags          []string `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Item) Reset() {
 	*x = Item{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[11]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -724,7 +840,7 @@ func (x *Item) String() string {
 func (*Item) ProtoMessage() {}
 
 func (x *Item) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[11]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,7 +853,7 @@ func (x *Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Item.ProtoReflect.Descriptor instead.
 func (*Item) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{11}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Item) GetId() string {
@@ -831,6 +947,27 @@ func (x *Item) GetHasContent() bool {
 	return false
 }
 
+func (x *Item) GetLibraryBookId() string {
+	if x != nil {
+		return x.LibraryBookId
+	}
+	return ""
+}
+
+func (x *Item) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Item) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type ListFeedItemsRequest struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Limit  int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -844,13 +981,16 @@ type ListFeedItemsRequest struct {
 	// Excludes items without bookmarked set when true. Unset/false returns
 	// both bookmarked and unbookmarked items.
 	BookmarkedOnly *bool `protobuf:"varint,5,opt,name=bookmarked_only,json=bookmarkedOnly,proto3,oneof" json:"bookmarked_only,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Restricts results to the feeds filed under one folder or its
+	// subfolders. Unset returns items from any of the caller's feeds.
+	FolderId      *string `protobuf:"bytes,6,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListFeedItemsRequest) Reset() {
 	*x = ListFeedItemsRequest{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[12]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -862,7 +1002,7 @@ func (x *ListFeedItemsRequest) String() string {
 func (*ListFeedItemsRequest) ProtoMessage() {}
 
 func (x *ListFeedItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[12]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +1015,7 @@ func (x *ListFeedItemsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFeedItemsRequest.ProtoReflect.Descriptor instead.
 func (*ListFeedItemsRequest) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{12}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListFeedItemsRequest) GetLimit() int32 {
@@ -913,6 +1053,13 @@ func (x *ListFeedItemsRequest) GetBookmarkedOnly() bool {
 	return false
 }
 
+func (x *ListFeedItemsRequest) GetFolderId() string {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return ""
+}
+
 type ListFeedItemsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -923,7 +1070,7 @@ type ListFeedItemsResponse struct {
 
 func (x *ListFeedItemsResponse) Reset() {
 	*x = ListFeedItemsResponse{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[13]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -935,7 +1082,7 @@ func (x *ListFeedItemsResponse) String() string {
 func (*ListFeedItemsResponse) ProtoMessage() {}
 
 func (x *ListFeedItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[13]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -948,7 +1095,7 @@ func (x *ListFeedItemsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFeedItemsResponse.ProtoReflect.Descriptor instead.
 func (*ListFeedItemsResponse) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{13}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListFeedItemsResponse) GetItems() []*Item {
@@ -977,7 +1124,7 @@ type GetFeedItemRequest struct {
 
 func (x *GetFeedItemRequest) Reset() {
 	*x = GetFeedItemRequest{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[14]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -989,7 +1136,7 @@ func (x *GetFeedItemRequest) String() string {
 func (*GetFeedItemRequest) ProtoMessage() {}
 
 func (x *GetFeedItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[14]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +1149,7 @@ func (x *GetFeedItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFeedItemRequest.ProtoReflect.Descriptor instead.
 func (*GetFeedItemRequest) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{14}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetFeedItemRequest) GetItemId() string {
@@ -1021,7 +1168,7 @@ type GetFeedItemResponse struct {
 
 func (x *GetFeedItemResponse) Reset() {
 	*x = GetFeedItemResponse{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[15]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1033,7 +1180,7 @@ func (x *GetFeedItemResponse) String() string {
 func (*GetFeedItemResponse) ProtoMessage() {}
 
 func (x *GetFeedItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[15]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,7 +1193,7 @@ func (x *GetFeedItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFeedItemResponse.ProtoReflect.Descriptor instead.
 func (*GetFeedItemResponse) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{15}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetFeedItemResponse) GetItem() *Item {
@@ -1074,7 +1221,7 @@ type UpdateItemRequest struct {
 
 func (x *UpdateItemRequest) Reset() {
 	*x = UpdateItemRequest{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[16]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1086,7 +1233,7 @@ func (x *UpdateItemRequest) String() string {
 func (*UpdateItemRequest) ProtoMessage() {}
 
 func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[16]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +1246,7 @@ func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
 func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{16}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpdateItemRequest) GetItemId() string {
@@ -1146,7 +1293,7 @@ type UpdateItemResponse struct {
 
 func (x *UpdateItemResponse) Reset() {
 	*x = UpdateItemResponse{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[17]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1158,7 +1305,7 @@ func (x *UpdateItemResponse) String() string {
 func (*UpdateItemResponse) ProtoMessage() {}
 
 func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[17]
+	mi := &file_feeds_v1_feeds_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1171,7 +1318,7 @@ func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateItemResponse.ProtoReflect.Descriptor instead.
 func (*UpdateItemResponse) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{17}
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateItemResponse) GetItem() *Item {
@@ -1181,39 +1328,33 @@ func (x *UpdateItemResponse) GetItem() *Item {
 	return nil
 }
 
-// FeedStats aggregates one feed's posting cadence and read/completion
-// metrics (issue #798).
-type FeedStats struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	FeedId    string                 `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
-	FeedTitle string                 `protobuf:"bytes,2,opt,name=feed_title,json=feedTitle,proto3" json:"feed_title,omitempty"`
-	ItemCount int32                  `protobuf:"varint,3,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
-	// Mean hours between consecutive items' published_at; 0 when the feed has
-	// fewer than 2 items.
-	AvgIntervalHours float64 `protobuf:"fixed64,4,opt,name=avg_interval_hours,json=avgIntervalHours,proto3" json:"avg_interval_hours,omitempty"`
-	// Fraction (0-1) of items with read_at set.
-	ReadRate float64 `protobuf:"fixed64,5,opt,name=read_rate,json=readRate,proto3" json:"read_rate,omitempty"`
-	// Mean furthest-scroll-reached across items (0-100).
-	AvgReadProgressPct float64 `protobuf:"fixed64,6,opt,name=avg_read_progress_pct,json=avgReadProgressPct,proto3" json:"avg_read_progress_pct,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// SendItemToLibrary adds the item to the books library as an article made
+// from its stored content_html — the page is not fetched again — and links
+// the two, so reading progress in either app shows up in the other. Sending
+// an item again returns the same entry. FAILED_PRECONDITION when the item
+// has no content.
+type SendItemToLibraryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FeedStats) Reset() {
-	*x = FeedStats{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[18]
+func (x *SendItemToLibraryRequest) Reset() {
+	*x = SendItemToLibraryRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FeedStats) String() string {
+func (x *SendItemToLibraryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeedStats) ProtoMessage() {}
+func (*SendItemToLibraryRequest) ProtoMessage() {}
 
-func (x *FeedStats) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[18]
+func (x *SendItemToLibraryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1224,79 +1365,1587 @@ func (x *FeedStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeedStats.ProtoReflect.Descriptor instead.
-func (*FeedStats) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SendItemToLibraryRequest.ProtoReflect.Descriptor instead.
+func (*SendItemToLibraryRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *FeedStats) GetFeedId() string {
+func (x *SendItemToLibraryRequest) GetItemId() string {
 	if x != nil {
-		return x.FeedId
+		return x.ItemId
 	}
 	return ""
 }
 
-func (x *FeedStats) GetFeedTitle() string {
-	if x != nil {
-		return x.FeedTitle
-	}
-	return ""
+type SendItemToLibraryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FeedStats) GetItemCount() int32 {
-	if x != nil {
-		return x.ItemCount
-	}
-	return 0
+func (x *SendItemToLibraryResponse) Reset() {
+	*x = SendItemToLibraryResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FeedStats) GetAvgIntervalHours() float64 {
-	if x != nil {
-		return x.AvgIntervalHours
-	}
-	return 0
+func (x *SendItemToLibraryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FeedStats) GetReadRate() float64 {
-	if x != nil {
+func (*SendItemToLibraryResponse) ProtoMessage() {}
+
+func (x *SendItemToLibraryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendItemToLibraryResponse.ProtoReflect.Descriptor instead.
+func (*SendItemToLibraryResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SendItemToLibraryResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// FeedStats aggregates one feed's posting cadence and read/completion
+// metrics (issue #798).
+type FeedStats struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	FeedId    string                 `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	FeedTitle string                 `protobuf:"bytes,2,opt,name=feed_title,json=feedTitle,proto3" json:"feed_title,omitempty"`
+	ItemCount int32                  `protobuf:"varint,3,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	// Mean hours between consecutive items' published_at; 0 when the feed has
+	// fewer than 2 items.
+	AvgIntervalHours float64 `protobuf:"fixed64,4,opt,name=avg_interval_hours,json=avgIntervalHours,proto3" json:"avg_interval_hours,omitempty"`
+	// Fraction (0-1) of items with read_at set.
+	ReadRate float64 `protobuf:"fixed64,5,opt,name=read_rate,json=readRate,proto3" json:"read_rate,omitempty"`
+	// Mean furthest-scroll-reached across items (0-100).
+	AvgReadProgressPct float64 `protobuf:"fixed64,6,opt,name=avg_read_progress_pct,json=avgReadProgressPct,proto3" json:"avg_read_progress_pct,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FeedStats) Reset() {
+	*x = FeedStats{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedStats) ProtoMessage() {}
+
+func (x *FeedStats) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedStats.ProtoReflect.Descriptor instead.
+func (*FeedStats) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FeedStats) GetFeedId() string {
+	if x != nil {
+		return x.FeedId
+	}
+	return ""
+}
+
+func (x *FeedStats) GetFeedTitle() string {
+	if x != nil {
+		return x.FeedTitle
+	}
+	return ""
+}
+
+func (x *FeedStats) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *FeedStats) GetAvgIntervalHours() float64 {
+	if x != nil {
+		return x.AvgIntervalHours
+	}
+	return 0
+}
+
+func (x *FeedStats) GetReadRate() float64 {
+	if x != nil {
 		return x.ReadRate
 	}
-	return 0
+	return 0
+}
+
+func (x *FeedStats) GetAvgReadProgressPct() float64 {
+	if x != nil {
+		return x.AvgReadProgressPct
+	}
+	return 0
+}
+
+// One day's ingested-item count, for the "when do new items appear"
+// histogram (issue #798).
+type DayCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RFC3339 (midnight UTC).
+	Day           string `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Count         int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DayCount) Reset() {
+	*x = DayCount{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayCount) ProtoMessage() {}
+
+func (x *DayCount) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayCount.ProtoReflect.Descriptor instead.
+func (*DayCount) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DayCount) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *DayCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetFeedStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedStatsRequest) Reset() {
+	*x = GetFeedStatsRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedStatsRequest) ProtoMessage() {}
+
+func (x *GetFeedStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedStatsRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{23}
+}
+
+type GetFeedStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Stats []*FeedStats           `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	// Trailing-90-day histogram across all of the caller's feeds.
+	ItemsPerDay   []*DayCount `protobuf:"bytes,2,rep,name=items_per_day,json=itemsPerDay,proto3" json:"items_per_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedStatsResponse) Reset() {
+	*x = GetFeedStatsResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedStatsResponse) ProtoMessage() {}
+
+func (x *GetFeedStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedStatsResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetFeedStatsResponse) GetStats() []*FeedStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *GetFeedStatsResponse) GetItemsPerDay() []*DayCount {
+	if x != nil {
+		return x.ItemsPerDay
+	}
+	return nil
+}
+
+// ImportOPML subscribes to every feed in an OPML file, filing each under
+// its folder outline (created as needed; outlines nested deeper than one
+// level collapse into one subfolder named by the rest of the path). RSS/Atom outlines (xmlUrl) become RSS feeds and type="link"
+// outlines scrape feeds; each is validated like CreateFeed. Feeds already
+// subscribed to, or listed twice, are skipped as duplicates. Only the
+// first 100 new feeds of a file are fetched; the rest are reported as
+// "deferred" and picked up by importing the file again. INVALID_ARGUMENT
+// for a file that isn't OPML.
+type ImportOPMLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOPMLRequest) Reset() {
+	*x = ImportOPMLRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOPMLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOPMLRequest) ProtoMessage() {}
+
+func (x *ImportOPMLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOPMLRequest.ProtoReflect.Descriptor instead.
+func (*ImportOPMLRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportOPMLRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// OPMLImportResult is what happened to one feed outline of the file.
+type OPMLImportResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The outline path the feed was filed under, nested outlines joined with
+	// " / "; empty outside any folder.
+	Folder string `protobuf:"bytes,3,opt,name=folder,proto3" json:"folder,omitempty"`
+	// "created" | "duplicate" | "invalid" (not a feed, or not an http(s)
+	// URL) | "unreachable" (could not be fetched) | "deferred" (past the
+	// per-import limit; import the file again)
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Why an invalid, unreachable or deferred outline was skipped.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// The new subscription; only set when status is "created".
+	Feed          *Feed `protobuf:"bytes,6,opt,name=feed,proto3" json:"feed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OPMLImportResult) Reset() {
+	*x = OPMLImportResult{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OPMLImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OPMLImportResult) ProtoMessage() {}
+
+func (x *OPMLImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OPMLImportResult.ProtoReflect.Descriptor instead.
+func (*OPMLImportResult) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OPMLImportResult) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *OPMLImportResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OPMLImportResult) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *OPMLImportResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OPMLImportResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *OPMLImportResult) GetFeed() *Feed {
+	if x != nil {
+		return x.Feed
+	}
+	return nil
+}
+
+type ImportOPMLResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// In file order.
+	Results        []*OPMLImportResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	CreatedCount   int32               `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	DuplicateCount int32               `protobuf:"varint,3,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
+	// Invalid and unreachable outlines; deferred ones are not counted.
+	FailedCount   int32 `protobuf:"varint,4,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOPMLResponse) Reset() {
+	*x = ImportOPMLResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOPMLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOPMLResponse) ProtoMessage() {}
+
+func (x *ImportOPMLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOPMLResponse.ProtoReflect.Descriptor instead.
+func (*ImportOPMLResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportOPMLResponse) GetResults() []*OPMLImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportOPMLResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportOPMLResponse) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+func (x *ImportOPMLResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+// ExportOPML renders the caller's feeds as an OPML 2.0 file, in their
+// folders. Scrape feeds are type="link" outlines, which ImportOPML reads
+// back as scrape feeds; email feeds can't be subscribed to elsewhere, so
+// they are only listed as comments.
+type ExportOPMLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOPMLRequest) Reset() {
+	*x = ExportOPMLRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOPMLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOPMLRequest) ProtoMessage() {}
+
+func (x *ExportOPMLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOPMLRequest.ProtoReflect.Descriptor instead.
+func (*ExportOPMLRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{28}
+}
+
+type ExportOPMLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOPMLResponse) Reset() {
+	*x = ExportOPMLResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOPMLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOPMLResponse) ProtoMessage() {}
+
+func (x *ExportOPMLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOPMLResponse.ProtoReflect.Descriptor instead.
+func (*ExportOPMLResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExportOPMLResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportOPMLResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportOPMLResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// CreateFolder adds a folder after its siblings, top-level unless parent_id
+// names a top-level folder. INVALID_ARGUMENT for an empty name or a parent
+// that is itself a subfolder; ALREADY_EXISTS for a sibling of the same name.
+type CreateFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFolderRequest) Reset() {
+	*x = CreateFolderRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderRequest) ProtoMessage() {}
+
+func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateFolderRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFolderRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type CreateFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFolderResponse) Reset() {
+	*x = CreateFolderResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderResponse) ProtoMessage() {}
+
+func (x *CreateFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFolderResponse.ProtoReflect.Descriptor instead.
+func (*CreateFolderResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateFolderResponse) GetFolder() *Folder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+type RenameFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameFolderRequest) Reset() {
+	*x = RenameFolderRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFolderRequest) ProtoMessage() {}
+
+func (x *RenameFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFolderRequest.ProtoReflect.Descriptor instead.
+func (*RenameFolderRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RenameFolderRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *RenameFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameFolderResponse) Reset() {
+	*x = RenameFolderResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFolderResponse) ProtoMessage() {}
+
+func (x *RenameFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFolderResponse.ProtoReflect.Descriptor instead.
+func (*RenameFolderResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{33}
+}
+
+// DeleteFolder removes the folder and its subfolders; their feeds become
+// unfiled, not deleted.
+type DeleteFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFolderRequest) Reset() {
+	*x = DeleteFolderRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFolderRequest) ProtoMessage() {}
+
+func (x *DeleteFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFolderRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteFolderRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type DeleteFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFolderResponse) Reset() {
+	*x = DeleteFolderResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFolderResponse) ProtoMessage() {}
+
+func (x *DeleteFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFolderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFolderResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{35}
+}
+
+// ReorderFolders sets each listed folder's position to its index in ids.
+type ReorderFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderFoldersRequest) Reset() {
+	*x = ReorderFoldersRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderFoldersRequest) ProtoMessage() {}
+
+func (x *ReorderFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ReorderFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReorderFoldersRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ReorderFoldersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderFoldersResponse) Reset() {
+	*x = ReorderFoldersResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderFoldersResponse) ProtoMessage() {}
+
+func (x *ReorderFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ReorderFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{37}
+}
+
+// MoveFeed files the feed under folder_id, or unfiles it when empty.
+type MoveFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeedId        string                 `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	FolderId      string                 `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveFeedRequest) Reset() {
+	*x = MoveFeedRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFeedRequest) ProtoMessage() {}
+
+func (x *MoveFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFeedRequest.ProtoReflect.Descriptor instead.
+func (*MoveFeedRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MoveFeedRequest) GetFeedId() string {
+	if x != nil {
+		return x.FeedId
+	}
+	return ""
+}
+
+func (x *MoveFeedRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type MoveFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveFeedResponse) Reset() {
+	*x = MoveFeedResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFeedResponse) ProtoMessage() {}
+
+func (x *MoveFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFeedResponse.ProtoReflect.Descriptor instead.
+func (*MoveFeedResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{39}
+}
+
+// FilterRule acts on each new item whose field matches pattern as it is
+// ingested. Rules never change items already stored.
+type FilterRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Limits the rule to one feed; empty applies it to all the caller's feeds.
+	FeedId string `protobuf:"bytes,2,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	// "title", "author", "url" or "content" (the article's text, not its
+	// HTML).
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// "keyword" (case-insensitive substring) or "regex" (RE2 syntax, case-
+	// sensitive unless the pattern starts with (?i)).
+	MatchType string `protobuf:"bytes,4,opt,name=match_type,json=matchType,proto3" json:"match_type,omitempty"`
+	Pattern   string `protobuf:"bytes,5,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// "dismiss", "bookmark", "mark_read" or "tag".
+	Action string `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	// The label the tag action adds; ignored for other actions.
+	Tag           string `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
+	Enabled       bool   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt     string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterRule) Reset() {
+	*x = FilterRule{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterRule) ProtoMessage() {}
+
+func (x *FilterRule) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterRule.ProtoReflect.Descriptor instead.
+func (*FilterRule) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FilterRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FilterRule) GetFeedId() string {
+	if x != nil {
+		return x.FeedId
+	}
+	return ""
+}
+
+func (x *FilterRule) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FilterRule) GetMatchType() string {
+	if x != nil {
+		return x.MatchType
+	}
+	return ""
+}
+
+func (x *FilterRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *FilterRule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *FilterRule) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *FilterRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *FilterRule) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// ListFilterRules returns the caller's rules, oldest first — the order they
+// are applied in.
+type ListFilterRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFilterRulesRequest) Reset() {
+	*x = ListFilterRulesRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilterRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilterRulesRequest) ProtoMessage() {}
+
+func (x *ListFilterRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilterRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListFilterRulesRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{41}
+}
+
+type ListFilterRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*FilterRule          `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFilterRulesResponse) Reset() {
+	*x = ListFilterRulesResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilterRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilterRulesResponse) ProtoMessage() {}
+
+func (x *ListFilterRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilterRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListFilterRulesResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListFilterRulesResponse) GetRules() []*FilterRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// CreateFilterRule ignores rule.id. INVALID_ARGUMENT for an unknown field,
+// match type or action, an empty pattern, a regex that does not compile, or
+// a tag action without a tag.
+type CreateFilterRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *FilterRule            `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFilterRuleRequest) Reset() {
+	*x = CreateFilterRuleRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFilterRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFilterRuleRequest) ProtoMessage() {}
+
+func (x *CreateFilterRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFilterRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateFilterRuleRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateFilterRuleRequest) GetRule() *FilterRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type CreateFilterRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *FilterRule            `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFilterRuleResponse) Reset() {
+	*x = CreateFilterRuleResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFilterRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFilterRuleResponse) ProtoMessage() {}
+
+func (x *CreateFilterRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFilterRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateFilterRuleResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateFilterRuleResponse) GetRule() *FilterRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// UpdateFilterRule replaces every field of the rule named by rule.id.
+type UpdateFilterRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *FilterRule            `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFilterRuleRequest) Reset() {
+	*x = UpdateFilterRuleRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFilterRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFilterRuleRequest) ProtoMessage() {}
+
+func (x *UpdateFilterRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFilterRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFilterRuleRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateFilterRuleRequest) GetRule() *FilterRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateFilterRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *FilterRule            `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFilterRuleResponse) Reset() {
+	*x = UpdateFilterRuleResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFilterRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFilterRuleResponse) ProtoMessage() {}
+
+func (x *UpdateFilterRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFilterRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFilterRuleResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateFilterRuleResponse) GetRule() *FilterRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteFilterRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFilterRuleRequest) Reset() {
+	*x = DeleteFilterRuleRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFilterRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilterRuleRequest) ProtoMessage() {}
+
+func (x *DeleteFilterRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFilterRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFilterRuleRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteFilterRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type DeleteFilterRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFilterRuleResponse) Reset() {
+	*x = DeleteFilterRuleResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFilterRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilterRuleResponse) ProtoMessage() {}
+
+func (x *DeleteFilterRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFilterRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFilterRuleResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{48}
+}
+
+// TestFilterRule previews an unsaved rule against the caller's limit most
+// recent items (its feed's, when feed_id is set), read and dismissed ones
+// included; limit defaults to 50 and is capped at 200. Nothing is changed.
+type TestFilterRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *FilterRule            `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestFilterRuleRequest) Reset() {
+	*x = TestFilterRuleRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestFilterRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestFilterRuleRequest) ProtoMessage() {}
+
+func (x *TestFilterRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *FeedStats) GetAvgReadProgressPct() float64 {
+// Deprecated: Use TestFilterRuleRequest.ProtoReflect.Descriptor instead.
+func (*TestFilterRuleRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TestFilterRuleRequest) GetRule() *FilterRule {
 	if x != nil {
-		return x.AvgReadProgressPct
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *TestFilterRuleRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-// One day's ingested-item count, for the "when do new items appear"
-// histogram (issue #798).
-type DayCount struct {
+type TestFilterRuleResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// RFC3339 (midnight UTC).
-	Day           string `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
-	Count         int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// The checked items the rule matches, newest first.
+	Matches       []*Item `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	CheckedCount  int32   `protobuf:"varint,2,opt,name=checked_count,json=checkedCount,proto3" json:"checked_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DayCount) Reset() {
-	*x = DayCount{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[19]
+func (x *TestFilterRuleResponse) Reset() {
+	*x = TestFilterRuleResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DayCount) String() string {
+func (x *TestFilterRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DayCount) ProtoMessage() {}
+func (*TestFilterRuleResponse) ProtoMessage() {}
 
-func (x *DayCount) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[19]
+func (x *TestFilterRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1307,46 +2956,118 @@ func (x *DayCount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DayCount.ProtoReflect.Descriptor instead.
-func (*DayCount) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use TestFilterRuleResponse.ProtoReflect.Descriptor instead.
+func (*TestFilterRuleResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DayCount) GetDay() string {
+func (x *TestFilterRuleResponse) GetMatches() []*Item {
 	if x != nil {
-		return x.Day
+		return x.Matches
 	}
-	return ""
+	return nil
 }
 
-func (x *DayCount) GetCount() int32 {
+func (x *TestFilterRuleResponse) GetCheckedCount() int32 {
 	if x != nil {
-		return x.Count
+		return x.CheckedCount
 	}
 	return 0
 }
 
-type GetFeedStatsRequest struct {
+// AppPassword is a set of credentials a native reader app logs in to the
+// Google Reader API (served under /feeds/greader) with. The password itself
+// is only ever returned by CreateAppPassword.
+type AppPassword struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username  string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	CreatedAt string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Empty until the app has logged in.
+	LastUsedAt    string `protobuf:"bytes,4,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppPassword) Reset() {
+	*x = AppPassword{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppPassword) ProtoMessage() {}
+
+func (x *AppPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppPassword.ProtoReflect.Descriptor instead.
+func (*AppPassword) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AppPassword) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AppPassword) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AppPassword) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AppPassword) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+type ListAppPasswordsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFeedStatsRequest) Reset() {
-	*x = GetFeedStatsRequest{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[20]
+func (x *ListAppPasswordsRequest) Reset() {
+	*x = ListAppPasswordsRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFeedStatsRequest) String() string {
+func (x *ListAppPasswordsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFeedStatsRequest) ProtoMessage() {}
+func (*ListAppPasswordsRequest) ProtoMessage() {}
 
-func (x *GetFeedStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[20]
+func (x *ListAppPasswordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1357,35 +3078,33 @@ func (x *GetFeedStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFeedStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetFeedStatsRequest) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListAppPasswordsRequest.ProtoReflect.Descriptor instead.
+func (*ListAppPasswordsRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{52}
 }
 
-type GetFeedStatsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Stats []*FeedStats           `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
-	// Trailing-90-day histogram across all of the caller's feeds.
-	ItemsPerDay   []*DayCount `protobuf:"bytes,2,rep,name=items_per_day,json=itemsPerDay,proto3" json:"items_per_day,omitempty"`
+type ListAppPasswordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppPasswords  []*AppPassword         `protobuf:"bytes,1,rep,name=app_passwords,json=appPasswords,proto3" json:"app_passwords,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFeedStatsResponse) Reset() {
-	*x = GetFeedStatsResponse{}
-	mi := &file_feeds_v1_feeds_proto_msgTypes[21]
+func (x *ListAppPasswordsResponse) Reset() {
+	*x = ListAppPasswordsResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFeedStatsResponse) String() string {
+func (x *ListAppPasswordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFeedStatsResponse) ProtoMessage() {}
+func (*ListAppPasswordsResponse) ProtoMessage() {}
 
-func (x *GetFeedStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feeds_proto_msgTypes[21]
+func (x *ListAppPasswordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1396,30 +3115,202 @@ func (x *GetFeedStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFeedStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetFeedStatsResponse) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListAppPasswordsResponse.ProtoReflect.Descriptor instead.
+func (*ListAppPasswordsResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetFeedStatsResponse) GetStats() []*FeedStats {
+func (x *ListAppPasswordsResponse) GetAppPasswords() []*AppPassword {
 	if x != nil {
-		return x.Stats
+		return x.AppPasswords
 	}
 	return nil
 }
 
-func (x *GetFeedStatsResponse) GetItemsPerDay() []*DayCount {
+// CreateAppPassword generates a password for the username the app will log
+// in with. INVALID_ARGUMENT for an empty username.
+type CreateAppPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAppPasswordRequest) Reset() {
+	*x = CreateAppPasswordRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppPasswordRequest) ProtoMessage() {}
+
+func (x *CreateAppPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[54]
 	if x != nil {
-		return x.ItemsPerDay
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppPasswordRequest.ProtoReflect.Descriptor instead.
+func (*CreateAppPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateAppPasswordRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type CreateAppPasswordResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AppPassword *AppPassword           `protobuf:"bytes,1,opt,name=app_password,json=appPassword,proto3" json:"app_password,omitempty"`
+	// Shown once; only a hash is stored.
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAppPasswordResponse) Reset() {
+	*x = CreateAppPasswordResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppPasswordResponse) ProtoMessage() {}
+
+func (x *CreateAppPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppPasswordResponse.ProtoReflect.Descriptor instead.
+func (*CreateAppPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateAppPasswordResponse) GetAppPassword() *AppPassword {
+	if x != nil {
+		return x.AppPassword
 	}
 	return nil
 }
 
+func (x *CreateAppPasswordResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteAppPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppPasswordId string                 `protobuf:"bytes,1,opt,name=app_password_id,json=appPasswordId,proto3" json:"app_password_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppPasswordRequest) Reset() {
+	*x = DeleteAppPasswordRequest{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppPasswordRequest) ProtoMessage() {}
+
+func (x *DeleteAppPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppPasswordRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAppPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeleteAppPasswordRequest) GetAppPasswordId() string {
+	if x != nil {
+		return x.AppPasswordId
+	}
+	return ""
+}
+
+type DeleteAppPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppPasswordResponse) Reset() {
+	*x = DeleteAppPasswordResponse{}
+	mi := &file_feeds_v1_feeds_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppPasswordResponse) ProtoMessage() {}
+
+func (x *DeleteAppPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feeds_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppPasswordResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAppPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feeds_proto_rawDescGZIP(), []int{57}
+}
+
 var File_feeds_v1_feeds_proto protoreflect.FileDescriptor
 
 const file_feeds_v1_feeds_proto_rawDesc = "" +
 	"\n" +
-	"\x14feeds/v1/feeds.proto\x12\bfeeds.v1\"\xfb\x02\n" +
+	"\x14feeds/v1/feeds.proto\x12\bfeeds.v1\"\xc9\x03\n" +
 	"\x04Feed\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x14\n" +
@@ -1437,10 +3328,19 @@ const file_feeds_v1_feeds_proto_rawDesc = "" +
 	" \x01(\tR\flastModified\x121\n" +
 	"\x14consecutive_failures\x18\v \x01(\x05R\x13consecutiveFailures\x12\x1f\n" +
 	"\vnotified_at\x18\f \x01(\tR\n" +
-	"notifiedAt\"\x12\n" +
-	"\x10ListFeedsRequest\"9\n" +
+	"notifiedAt\x12\x1b\n" +
+	"\tfolder_id\x18\x0e \x01(\tR\bfolderId\x12!\n" +
+	"\funread_count\x18\x0f \x01(\x05R\vunreadCountJ\x04\b\r\x10\x0eR\x06folder\"\x88\x01\n" +
+	"\x06Folder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12!\n" +
+	"\funread_count\x18\x05 \x01(\x05R\vunreadCount\"\x12\n" +
+	"\x10ListFeedsRequest\"e\n" +
 	"\x11ListFeedsResponse\x12$\n" +
-	"\x05feeds\x18\x01 \x03(\v2\x0e.feeds.v1.FeedR\x05feeds\"c\n" +
+	"\x05feeds\x18\x01 \x03(\v2\x0e.feeds.v1.FeedR\x05feeds\x12*\n" +
+	"\afolders\x18\x02 \x03(\v2\x10.feeds.v1.FolderR\afolders\"c\n" +
 	"\x11CreateFeedRequest\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12&\n" +
 	"\x04kind\x18\x02 \x01(\x0e2\x12.feeds.v1.FeedKindR\x04kind\x12\x14\n" +
@@ -1457,7 +3357,7 @@ const file_feeds_v1_feeds_proto_rawDesc = "" +
 	"\x12RefreshFeedRequest\x12\x17\n" +
 	"\afeed_id\x18\x01 \x01(\tR\x06feedId\"1\n" +
 	"\x13RefreshFeedResponse\x12\x1a\n" +
-	"\bingested\x18\x01 \x01(\x05R\bingested\"\x90\x03\n" +
+	"\bingested\x18\x01 \x01(\x05R\bingested\"\xe4\x03\n" +
 	"\x04Item\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\afeed_id\x18\x02 \x01(\tR\x06feedId\x12\x14\n" +
@@ -1477,18 +3377,24 @@ const file_feeds_v1_feeds_proto_rawDesc = "" +
 	"created_at\x18\v \x01(\tR\tcreatedAt\x12*\n" +
 	"\x11read_progress_pct\x18\f \x01(\x05R\x0freadProgressPct\x12\x1f\n" +
 	"\vhas_content\x18\r \x01(\bR\n" +
-	"hasContent\"\xe6\x01\n" +
+	"hasContent\x12&\n" +
+	"\x0flibrary_book_id\x18\x0e \x01(\tR\rlibraryBookId\x12\x16\n" +
+	"\x06author\x18\x0f \x01(\tR\x06author\x12\x12\n" +
+	"\x04tags\x18\x10 \x03(\tR\x04tags\"\x96\x02\n" +
 	"\x14ListFeedItemsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12$\n" +
 	"\vunread_only\x18\x03 \x01(\bH\x00R\n" +
 	"unreadOnly\x88\x01\x01\x12\x1c\n" +
 	"\afeed_id\x18\x04 \x01(\tH\x01R\x06feedId\x88\x01\x01\x12,\n" +
-	"\x0fbookmarked_only\x18\x05 \x01(\bH\x02R\x0ebookmarkedOnly\x88\x01\x01B\x0e\n" +
+	"\x0fbookmarked_only\x18\x05 \x01(\bH\x02R\x0ebookmarkedOnly\x88\x01\x01\x12 \n" +
+	"\tfolder_id\x18\x06 \x01(\tH\x03R\bfolderId\x88\x01\x01B\x0e\n" +
 	"\f_unread_onlyB\n" +
 	"\n" +
 	"\b_feed_idB\x12\n" +
-	"\x10_bookmarked_only\"X\n" +
+	"\x10_bookmarked_onlyB\f\n" +
+	"\n" +
+	"_folder_id\"X\n" +
 	"\x15ListFeedItemsResponse\x12$\n" +
 	"\x05items\x18\x01 \x03(\v2\x0e.feeds.v1.ItemR\x05items\x12\x19\n" +
 	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"-\n" +
@@ -1510,6 +3416,10 @@ const file_feeds_v1_feeds_proto_rawDesc = "" +
 	"\v_bookmarkedB\x14\n" +
 	"\x12_read_progress_pct\"8\n" +
 	"\x12UpdateItemResponse\x12\"\n" +
+	"\x04item\x18\x01 \x01(\v2\x0e.feeds.v1.ItemR\x04item\"3\n" +
+	"\x18SendItemToLibraryRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"?\n" +
+	"\x19SendItemToLibraryResponse\x12\"\n" +
 	"\x04item\x18\x01 \x01(\v2\x0e.feeds.v1.ItemR\x04item\"\xe0\x01\n" +
 	"\tFeedStats\x12\x17\n" +
 	"\afeed_id\x18\x01 \x01(\tR\x06feedId\x12\x1d\n" +
@@ -1526,12 +3436,101 @@ const file_feeds_v1_feeds_proto_rawDesc = "" +
 	"\x13GetFeedStatsRequest\"y\n" +
 	"\x14GetFeedStatsResponse\x12)\n" +
 	"\x05stats\x18\x01 \x03(\v2\x13.feeds.v1.FeedStatsR\x05stats\x126\n" +
-	"\ritems_per_day\x18\x02 \x03(\v2\x12.feeds.v1.DayCountR\vitemsPerDay*c\n" +
+	"\ritems_per_day\x18\x02 \x03(\v2\x12.feeds.v1.DayCountR\vitemsPerDay\"'\n" +
+	"\x11ImportOPMLRequest\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xa4\x01\n" +
+	"\x10OPMLImportResult\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06folder\x18\x03 \x01(\tR\x06folder\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\"\n" +
+	"\x04feed\x18\x06 \x01(\v2\x0e.feeds.v1.FeedR\x04feed\"\xbb\x01\n" +
+	"\x12ImportOPMLResponse\x124\n" +
+	"\aresults\x18\x01 \x03(\v2\x1a.feeds.v1.OPMLImportResultR\aresults\x12#\n" +
+	"\rcreated_count\x18\x02 \x01(\x05R\fcreatedCount\x12'\n" +
+	"\x0fduplicate_count\x18\x03 \x01(\x05R\x0eduplicateCount\x12!\n" +
+	"\ffailed_count\x18\x04 \x01(\x05R\vfailedCount\"\x13\n" +
+	"\x11ExportOPMLRequest\"g\n" +
+	"\x12ExportOPMLResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"F\n" +
+	"\x13CreateFolderRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"@\n" +
+	"\x14CreateFolderResponse\x12(\n" +
+	"\x06folder\x18\x01 \x01(\v2\x10.feeds.v1.FolderR\x06folder\"F\n" +
+	"\x13RenameFolderRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x16\n" +
+	"\x14RenameFolderResponse\"2\n" +
+	"\x13DeleteFolderRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\"\x16\n" +
+	"\x14DeleteFolderResponse\")\n" +
+	"\x15ReorderFoldersRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\x18\n" +
+	"\x16ReorderFoldersResponse\"G\n" +
+	"\x0fMoveFeedRequest\x12\x17\n" +
+	"\afeed_id\x18\x01 \x01(\tR\x06feedId\x12\x1b\n" +
+	"\tfolder_id\x18\x02 \x01(\tR\bfolderId\"\x12\n" +
+	"\x10MoveFeedResponse\"\xe7\x01\n" +
+	"\n" +
+	"FilterRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\afeed_id\x18\x02 \x01(\tR\x06feedId\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12\x1d\n" +
+	"\n" +
+	"match_type\x18\x04 \x01(\tR\tmatchType\x12\x18\n" +
+	"\apattern\x18\x05 \x01(\tR\apattern\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12\x10\n" +
+	"\x03tag\x18\a \x01(\tR\x03tag\x12\x18\n" +
+	"\aenabled\x18\b \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\x18\n" +
+	"\x16ListFilterRulesRequest\"E\n" +
+	"\x17ListFilterRulesResponse\x12*\n" +
+	"\x05rules\x18\x01 \x03(\v2\x14.feeds.v1.FilterRuleR\x05rules\"C\n" +
+	"\x17CreateFilterRuleRequest\x12(\n" +
+	"\x04rule\x18\x01 \x01(\v2\x14.feeds.v1.FilterRuleR\x04rule\"D\n" +
+	"\x18CreateFilterRuleResponse\x12(\n" +
+	"\x04rule\x18\x01 \x01(\v2\x14.feeds.v1.FilterRuleR\x04rule\"C\n" +
+	"\x17UpdateFilterRuleRequest\x12(\n" +
+	"\x04rule\x18\x01 \x01(\v2\x14.feeds.v1.FilterRuleR\x04rule\"D\n" +
+	"\x18UpdateFilterRuleResponse\x12(\n" +
+	"\x04rule\x18\x01 \x01(\v2\x14.feeds.v1.FilterRuleR\x04rule\"2\n" +
+	"\x17DeleteFilterRuleRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\"\x1a\n" +
+	"\x18DeleteFilterRuleResponse\"W\n" +
+	"\x15TestFilterRuleRequest\x12(\n" +
+	"\x04rule\x18\x01 \x01(\v2\x14.feeds.v1.FilterRuleR\x04rule\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"g\n" +
+	"\x16TestFilterRuleResponse\x12(\n" +
+	"\amatches\x18\x01 \x03(\v2\x0e.feeds.v1.ItemR\amatches\x12#\n" +
+	"\rchecked_count\x18\x02 \x01(\x05R\fcheckedCount\"z\n" +
+	"\vAppPassword\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\x04 \x01(\tR\n" +
+	"lastUsedAt\"\x19\n" +
+	"\x17ListAppPasswordsRequest\"V\n" +
+	"\x18ListAppPasswordsResponse\x12:\n" +
+	"\rapp_passwords\x18\x01 \x03(\v2\x15.feeds.v1.AppPasswordR\fappPasswords\"6\n" +
+	"\x18CreateAppPasswordRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"q\n" +
+	"\x19CreateAppPasswordResponse\x128\n" +
+	"\fapp_password\x18\x01 \x01(\v2\x15.feeds.v1.AppPasswordR\vappPassword\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"B\n" +
+	"\x18DeleteAppPasswordRequest\x12&\n" +
+	"\x0fapp_password_id\x18\x01 \x01(\tR\rappPasswordId\"\x1b\n" +
+	"\x19DeleteAppPasswordResponse*c\n" +
 	"\bFeedKind\x12\x19\n" +
 	"\x15FEED_KIND_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rFEED_KIND_RSS\x10\x01\x12\x13\n" +
 	"\x0fFEED_KIND_EMAIL\x10\x02\x12\x14\n" +
-	"\x10FEED_KIND_SCRAPE\x10\x032\xb0\x05\n" +
+	"\x10FEED_KIND_SCRAPE\x10\x032\xfa\x0f\n" +
 	"\vFeedService\x12D\n" +
 	"\tListFeeds\x12\x1a.feeds.v1.ListFeedsRequest\x1a\x1b.feeds.v1.ListFeedsResponse\x12G\n" +
 	"\n" +
@@ -1544,8 +3543,26 @@ const file_feeds_v1_feeds_proto_rawDesc = "" +
 	"\rListFeedItems\x12\x1e.feeds.v1.ListFeedItemsRequest\x1a\x1f.feeds.v1.ListFeedItemsResponse\x12J\n" +
 	"\vGetFeedItem\x12\x1c.feeds.v1.GetFeedItemRequest\x1a\x1d.feeds.v1.GetFeedItemResponse\x12G\n" +
 	"\n" +
-	"UpdateItem\x12\x1b.feeds.v1.UpdateItemRequest\x1a\x1c.feeds.v1.UpdateItemResponse\x12M\n" +
-	"\fGetFeedStats\x12\x1d.feeds.v1.GetFeedStatsRequest\x1a\x1e.feeds.v1.GetFeedStatsResponseB)Z'tools.xdoubleu.com/gen/feeds/v1;feedsv1b\x06proto3"
+	"UpdateItem\x12\x1b.feeds.v1.UpdateItemRequest\x1a\x1c.feeds.v1.UpdateItemResponse\x12\\\n" +
+	"\x11SendItemToLibrary\x12\".feeds.v1.SendItemToLibraryRequest\x1a#.feeds.v1.SendItemToLibraryResponse\x12M\n" +
+	"\fGetFeedStats\x12\x1d.feeds.v1.GetFeedStatsRequest\x1a\x1e.feeds.v1.GetFeedStatsResponse\x12G\n" +
+	"\n" +
+	"ImportOPML\x12\x1b.feeds.v1.ImportOPMLRequest\x1a\x1c.feeds.v1.ImportOPMLResponse\x12G\n" +
+	"\n" +
+	"ExportOPML\x12\x1b.feeds.v1.ExportOPMLRequest\x1a\x1c.feeds.v1.ExportOPMLResponse\x12M\n" +
+	"\fCreateFolder\x12\x1d.feeds.v1.CreateFolderRequest\x1a\x1e.feeds.v1.CreateFolderResponse\x12M\n" +
+	"\fRenameFolder\x12\x1d.feeds.v1.RenameFolderRequest\x1a\x1e.feeds.v1.RenameFolderResponse\x12M\n" +
+	"\fDeleteFolder\x12\x1d.feeds.v1.DeleteFolderRequest\x1a\x1e.feeds.v1.DeleteFolderResponse\x12S\n" +
+	"\x0eReorderFolders\x12\x1f.feeds.v1.ReorderFoldersRequest\x1a .feeds.v1.ReorderFoldersResponse\x12A\n" +
+	"\bMoveFeed\x12\x19.feeds.v1.MoveFeedRequest\x1a\x1a.feeds.v1.MoveFeedResponse\x12V\n" +
+	"\x0fListFilterRules\x12 .feeds.v1.ListFilterRulesRequest\x1a!.feeds.v1.ListFilterRulesResponse\x12Y\n" +
+	"\x10CreateFilterRule\x12!.feeds.v1.CreateFilterRuleRequest\x1a\".feeds.v1.CreateFilterRuleResponse\x12Y\n" +
+	"\x10UpdateFilterRule\x12!.feeds.v1.UpdateFilterRuleRequest\x1a\".feeds.v1.UpdateFilterRuleResponse\x12Y\n" +
+	"\x10DeleteFilterRule\x12!.feeds.v1.DeleteFilterRuleRequest\x1a\".feeds.v1.DeleteFilterRuleResponse\x12S\n" +
+	"\x0eTestFilterRule\x12\x1f.feeds.v1.TestFilterRuleRequest\x1a .feeds.v1.TestFilterRuleResponse\x12Y\n" +
+	"\x10ListAppPasswords\x12!.feeds.v1.ListAppPasswordsRequest\x1a\".feeds.v1.ListAppPasswordsResponse\x12\\\n" +
+	"\x11CreateAppPassword\x12\".feeds.v1.CreateAppPasswordRequest\x1a#.feeds.v1.CreateAppPasswordResponse\x12\\\n" +
+	"\x11DeleteAppPassword\x12\".feeds.v1.DeleteAppPasswordRequest\x1a#.feeds.v1.DeleteAppPasswordResponseB)Z'tools.xdoubleu.com/gen/feeds/v1;feedsv1b\x06proto3"
 
 var (
 	file_feeds_v1_feeds_proto_rawDescOnce sync.Once
@@ -1560,64 +3577,146 @@ func file_feeds_v1_feeds_proto_rawDescGZIP() []byte {
 }
 
 var file_feeds_v1_feeds_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_feeds_v1_feeds_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_feeds_v1_feeds_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_feeds_v1_feeds_proto_goTypes = []any{
-	(FeedKind)(0),                 // 0: feeds.v1.FeedKind
-	(*Feed)(nil),                  // 1: feeds.v1.Feed
-	(*ListFeedsRequest)(nil),      // 2: feeds.v1.ListFeedsRequest
-	(*ListFeedsResponse)(nil),     // 3: feeds.v1.ListFeedsResponse
-	(*CreateFeedRequest)(nil),     // 4: feeds.v1.CreateFeedRequest
-	(*CreateFeedResponse)(nil),    // 5: feeds.v1.CreateFeedResponse
-	(*UpdateFeedRequest)(nil),     // 6: feeds.v1.UpdateFeedRequest
-	(*UpdateFeedResponse)(nil),    // 7: feeds.v1.UpdateFeedResponse
-	(*DeleteFeedRequest)(nil),     // 8: feeds.v1.DeleteFeedRequest
-	(*DeleteFeedResponse)(nil),    // 9: feeds.v1.DeleteFeedResponse
-	(*RefreshFeedRequest)(nil),    // 10: feeds.v1.RefreshFeedRequest
-	(*RefreshFeedResponse)(nil),   // 11: feeds.v1.RefreshFeedResponse
-	(*Item)(nil),                  // 12: feeds.v1.Item
-	(*ListFeedItemsRequest)(nil),  // 13: feeds.v1.ListFeedItemsRequest
-	(*ListFeedItemsResponse)(nil), // 14: feeds.v1.ListFeedItemsResponse
-	(*GetFeedItemRequest)(nil),    // 15: feeds.v1.GetFeedItemRequest
-	(*GetFeedItemResponse)(nil),   // 16: feeds.v1.GetFeedItemResponse
-	(*UpdateItemRequest)(nil),     // 17: feeds.v1.UpdateItemRequest
-	(*UpdateItemResponse)(nil),    // 18: feeds.v1.UpdateItemResponse
-	(*FeedStats)(nil),             // 19: feeds.v1.FeedStats
-	(*DayCount)(nil),              // 20: feeds.v1.DayCount
-	(*GetFeedStatsRequest)(nil),   // 21: feeds.v1.GetFeedStatsRequest
-	(*GetFeedStatsResponse)(nil),  // 22: feeds.v1.GetFeedStatsResponse
+	(FeedKind)(0),                     // 0: feeds.v1.FeedKind
+	(*Feed)(nil),                      // 1: feeds.v1.Feed
+	(*Folder)(nil),                    // 2: feeds.v1.Folder
+	(*ListFeedsRequest)(nil),          // 3: feeds.v1.ListFeedsRequest
+	(*ListFeedsResponse)(nil),         // 4: feeds.v1.ListFeedsResponse
+	(*CreateFeedRequest)(nil),         // 5: feeds.v1.CreateFeedRequest
+	(*CreateFeedResponse)(nil),        // 6: feeds.v1.CreateFeedResponse
+	(*UpdateFeedRequest)(nil),         // 7: feeds.v1.UpdateFeedRequest
+	(*UpdateFeedResponse)(nil),        // 8: feeds.v1.UpdateFeedResponse
+	(*DeleteFeedRequest)(nil),         // 9: feeds.v1.DeleteFeedRequest
+	(*DeleteFeedResponse)(nil),        // 10: feeds.v1.DeleteFeedResponse
+	(*RefreshFeedRequest)(nil),        // 11: feeds.v1.RefreshFeedRequest
+	(*RefreshFeedResponse)(nil),       // 12: feeds.v1.RefreshFeedResponse
+	(*Item)(nil),                      // 13: feeds.v1.Item
+	(*ListFeedItemsRequest)(nil),      // 14: feeds.v1.ListFeedItemsRequest
+	(*ListFeedItemsResponse)(nil),     // 15: feeds.v1.ListFeedItemsResponse
+	(*GetFeedItemRequest)(nil),        // 16: feeds.v1.GetFeedItemRequest
+	(*GetFeedItemResponse)(nil),       // 17: feeds.v1.GetFeedItemResponse
+	(*UpdateItemRequest)(nil),         // 18: feeds.v1.UpdateItemRequest
+	(*UpdateItemResponse)(nil),        // 19: feeds.v1.UpdateItemResponse
+	(*SendItemToLibraryRequest)(nil),  // 20: feeds.v1.SendItemToLibraryRequest
+	(*SendItemToLibraryResponse)(nil), // 21: feeds.v1.SendItemToLibraryResponse
+	(*FeedStats)(nil),                 // 22: feeds.v1.FeedStats
+	(*DayCount)(nil),                  // 23: feeds.v1.DayCount
+	(*GetFeedStatsRequest)(nil),       // 24: feeds.v1.GetFeedStatsRequest
+	(*GetFeedStatsResponse)(nil),      // 25: feeds.v1.GetFeedStatsResponse
+	(*ImportOPMLRequest)(nil),         // 26: feeds.v1.ImportOPMLRequest
+	(*OPMLImportResult)(nil),          // 27: feeds.v1.OPMLImportResult
+	(*ImportOPMLResponse)(nil),        // 28: feeds.v1.ImportOPMLResponse
+	(*ExportOPMLRequest)(nil),         // 29: feeds.v1.ExportOPMLRequest
+	(*ExportOPMLResponse)(nil),        // 30: feeds.v1.ExportOPMLResponse
+	(*CreateFolderRequest)(nil),       // 31: feeds.v1.CreateFolderRequest
+	(*CreateFolderResponse)(nil),      // 32: feeds.v1.CreateFolderResponse
+	(*RenameFolderRequest)(nil),       // 33: feeds.v1.RenameFolderRequest
+	(*RenameFolderResponse)(nil),      // 34: feeds.v1.RenameFolderResponse
+	(*DeleteFolderRequest)(nil),       // 35: feeds.v1.DeleteFolderRequest
+	(*DeleteFolderResponse)(nil),      // 36: feeds.v1.DeleteFolderResponse
+	(*ReorderFoldersRequest)(nil),     // 37: feeds.v1.ReorderFoldersRequest
+	(*ReorderFoldersResponse)(nil),    // 38: feeds.v1.ReorderFoldersResponse
+	(*MoveFeedRequest)(nil),           // 39: feeds.v1.MoveFeedRequest
+	(*MoveFeedResponse)(nil),          // 40: feeds.v1.MoveFeedResponse
+	(*FilterRule)(nil),                // 41: feeds.v1.FilterRule
+	(*ListFilterRulesRequest)(nil),    // 42: feeds.v1.ListFilterRulesRequest
+	(*ListFilterRulesResponse)(nil),   // 43: feeds.v1.ListFilterRulesResponse
+	(*CreateFilterRuleRequest)(nil),   // 44: feeds.v1.CreateFilterRuleRequest
+	(*CreateFilterRuleResponse)(nil),  // 45: feeds.v1.CreateFilterRuleResponse
+	(*UpdateFilterRuleRequest)(nil),   // 46: feeds.v1.UpdateFilterRuleRequest
+	(*UpdateFilterRuleResponse)(nil),  // 47: feeds.v1.UpdateFilterRuleResponse
+	(*DeleteFilterRuleRequest)(nil),   // 48: feeds.v1.DeleteFilterRuleRequest
+	(*DeleteFilterRuleResponse)(nil),  // 49: feeds.v1.DeleteFilterRuleResponse
+	(*TestFilterRuleRequest)(nil),     // 50: feeds.v1.TestFilterRuleRequest
+	(*TestFilterRuleResponse)(nil),    // 51: feeds.v1.TestFilterRuleResponse
+	(*AppPassword)(nil),               // 52: feeds.v1.AppPassword
+	(*ListAppPasswordsRequest)(nil),   // 53: feeds.v1.ListAppPasswordsRequest
+	(*ListAppPasswordsResponse)(nil),  // 54: feeds.v1.ListAppPasswordsResponse
+	(*CreateAppPasswordRequest)(nil),  // 55: feeds.v1.CreateAppPasswordRequest
+	(*CreateAppPasswordResponse)(nil), // 56: feeds.v1.CreateAppPasswordResponse
+	(*DeleteAppPasswordRequest)(nil),  // 57: feeds.v1.DeleteAppPasswordRequest
+	(*DeleteAppPasswordResponse)(nil), // 58: feeds.v1.DeleteAppPasswordResponse
 }
 var file_feeds_v1_feeds_proto_depIdxs = []int32{
 	1,  // 0: feeds.v1.ListFeedsResponse.feeds:type_name -> feeds.v1.Feed
-	0,  // 1: feeds.v1.CreateFeedRequest.kind:type_name -> feeds.v1.FeedKind
-	1,  // 2: feeds.v1.CreateFeedResponse.feed:type_name -> feeds.v1.Feed
-	12, // 3: feeds.v1.ListFeedItemsResponse.items:type_name -> feeds.v1.Item
-	12, // 4: feeds.v1.GetFeedItemResponse.item:type_name -> feeds.v1.Item
-	12, // 5: feeds.v1.UpdateItemResponse.item:type_name -> feeds.v1.Item
-	19, // 6: feeds.v1.GetFeedStatsResponse.stats:type_name -> feeds.v1.FeedStats
-	20, // 7: feeds.v1.GetFeedStatsResponse.items_per_day:type_name -> feeds.v1.DayCount
-	2,  // 8: feeds.v1.FeedService.ListFeeds:input_type -> feeds.v1.ListFeedsRequest
-	4,  // 9: feeds.v1.FeedService.CreateFeed:input_type -> feeds.v1.CreateFeedRequest
-	6,  // 10: feeds.v1.FeedService.UpdateFeed:input_type -> feeds.v1.UpdateFeedRequest
-	8,  // 11: feeds.v1.FeedService.DeleteFeed:input_type -> feeds.v1.DeleteFeedRequest
-	10, // 12: feeds.v1.FeedService.RefreshFeed:input_type -> feeds.v1.RefreshFeedRequest
-	13, // 13: feeds.v1.FeedService.ListFeedItems:input_type -> feeds.v1.ListFeedItemsRequest
-	15, // 14: feeds.v1.FeedService.GetFeedItem:input_type -> feeds.v1.GetFeedItemRequest
-	17, // 15: feeds.v1.FeedService.UpdateItem:input_type -> feeds.v1.UpdateItemRequest
-	21, // 16: feeds.v1.FeedService.GetFeedStats:input_type -> feeds.v1.GetFeedStatsRequest
-	3,  // 17: feeds.v1.FeedService.ListFeeds:output_type -> feeds.v1.ListFeedsResponse
-	5,  // 18: feeds.v1.FeedService.CreateFeed:output_type -> feeds.v1.CreateFeedResponse
-	7,  // 19: feeds.v1.FeedService.UpdateFeed:output_type -> feeds.v1.UpdateFeedResponse
-	9,  // 20: feeds.v1.FeedService.DeleteFeed:output_type -> feeds.v1.DeleteFeedResponse
-	11, // 21: feeds.v1.FeedService.RefreshFeed:output_type -> feeds.v1.RefreshFeedResponse
-	14, // 22: feeds.v1.FeedService.ListFeedItems:output_type -> feeds.v1.ListFeedItemsResponse
-	16, // 23: feeds.v1.FeedService.GetFeedItem:output_type -> feeds.v1.GetFeedItemResponse
-	18, // 24: feeds.v1.FeedService.UpdateItem:output_type -> feeds.v1.UpdateItemResponse
-	22, // 25: feeds.v1.FeedService.GetFeedStats:output_type -> feeds.v1.GetFeedStatsResponse
-	17, // [17:26] is the sub-list for method output_type
-	8,  // [8:17] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	2,  // 1: feeds.v1.ListFeedsResponse.folders:type_name -> feeds.v1.Folder
+	0,  // 2: feeds.v1.CreateFeedRequest.kind:type_name -> feeds.v1.FeedKind
+	1,  // 3: feeds.v1.CreateFeedResponse.feed:type_name -> feeds.v1.Feed
+	13, // 4: feeds.v1.ListFeedItemsResponse.items:type_name -> feeds.v1.Item
+	13, // 5: feeds.v1.GetFeedItemResponse.item:type_name -> feeds.v1.Item
+	13, // 6: feeds.v1.UpdateItemResponse.item:type_name -> feeds.v1.Item
+	13, // 7: feeds.v1.SendItemToLibraryResponse.item:type_name -> feeds.v1.Item
+	22, // 8: feeds.v1.GetFeedStatsResponse.stats:type_name -> feeds.v1.FeedStats
+	23, // 9: feeds.v1.GetFeedStatsResponse.items_per_day:type_name -> feeds.v1.DayCount
+	1,  // 10: feeds.v1.OPMLImportResult.feed:type_name -> feeds.v1.Feed
+	27, // 11: feeds.v1.ImportOPMLResponse.results:type_name -> feeds.v1.OPMLImportResult
+	2,  // 12: feeds.v1.CreateFolderResponse.folder:type_name -> feeds.v1.Folder
+	41, // 13: feeds.v1.ListFilterRulesResponse.rules:type_name -> feeds.v1.FilterRule
+	41, // 14: feeds.v1.CreateFilterRuleRequest.rule:type_name -> feeds.v1.FilterRule
+	41, // 15: feeds.v1.CreateFilterRuleResponse.rule:type_name -> feeds.v1.FilterRule
+	41, // 16: feeds.v1.UpdateFilterRuleRequest.rule:type_name -> feeds.v1.FilterRule
+	41, // 17: feeds.v1.UpdateFilterRuleResponse.rule:type_name -> feeds.v1.FilterRule
+	41, // 18: feeds.v1.TestFilterRuleRequest.rule:type_name -> feeds.v1.FilterRule
+	13, // 19: feeds.v1.TestFilterRuleResponse.matches:type_name -> feeds.v1.Item
+	52, // 20: feeds.v1.ListAppPasswordsResponse.app_passwords:type_name -> feeds.v1.AppPassword
+	52, // 21: feeds.v1.CreateAppPasswordResponse.app_password:type_name -> feeds.v1.AppPassword
+	3,  // 22: feeds.v1.FeedService.ListFeeds:input_type -> feeds.v1.ListFeedsRequest
+	5,  // 23: feeds.v1.FeedService.CreateFeed:input_type -> feeds.v1.CreateFeedRequest
+	7,  // 24: feeds.v1.FeedService.UpdateFeed:input_type -> feeds.v1.UpdateFeedRequest
+	9,  // 25: feeds.v1.FeedService.DeleteFeed:input_type -> feeds.v1.DeleteFeedRequest
+	11, // 26: feeds.v1.FeedService.RefreshFeed:input_type -> feeds.v1.RefreshFeedRequest
+	14, // 27: feeds.v1.FeedService.ListFeedItems:input_type -> feeds.v1.ListFeedItemsRequest
+	16, // 28: feeds.v1.FeedService.GetFeedItem:input_type -> feeds.v1.GetFeedItemRequest
+	18, // 29: feeds.v1.FeedService.UpdateItem:input_type -> feeds.v1.UpdateItemRequest
+	20, // 30: feeds.v1.FeedService.SendItemToLibrary:input_type -> feeds.v1.SendItemToLibraryRequest
+	24, // 31: feeds.v1.FeedService.GetFeedStats:input_type -> feeds.v1.GetFeedStatsRequest
+	26, // 32: feeds.v1.FeedService.ImportOPML:input_type -> feeds.v1.ImportOPMLRequest
+	29, // 33: feeds.v1.FeedService.ExportOPML:input_type -> feeds.v1.ExportOPMLRequest
+	31, // 34: feeds.v1.FeedService.CreateFolder:input_type -> feeds.v1.CreateFolderRequest
+	33, // 35: feeds.v1.FeedService.RenameFolder:input_type -> feeds.v1.RenameFolderRequest
+	35, // 36: feeds.v1.FeedService.DeleteFolder:input_type -> feeds.v1.DeleteFolderRequest
+	37, // 37: feeds.v1.FeedService.ReorderFolders:input_type -> feeds.v1.ReorderFoldersRequest
+	39, // 38: feeds.v1.FeedService.MoveFeed:input_type -> feeds.v1.MoveFeedRequest
+	42, // 39: feeds.v1.FeedService.ListFilterRules:input_type -> feeds.v1.ListFilterRulesRequest
+	44, // 40: feeds.v1.FeedService.CreateFilterRule:input_type -> feeds.v1.CreateFilterRuleRequest
+	46, // 41: feeds.v1.FeedService.UpdateFilterRule:input_type -> feeds.v1.UpdateFilterRuleRequest
+	48, // 42: feeds.v1.FeedService.DeleteFilterRule:input_type -> feeds.v1.DeleteFilterRuleRequest
+	50, // 43: feeds.v1.FeedService.TestFilterRule:input_type -> feeds.v1.TestFilterRuleRequest
+	53, // 44: feeds.v1.FeedService.ListAppPasswords:input_type -> feeds.v1.ListAppPasswordsRequest
+	55, // 45: feeds.v1.FeedService.CreateAppPassword:input_type -> feeds.v1.CreateAppPasswordRequest
+	57, // 46: feeds.v1.FeedService.DeleteAppPassword:input_type -> feeds.v1.DeleteAppPasswordRequest
+	4,  // 47: feeds.v1.FeedService.ListFeeds:output_type -> feeds.v1.ListFeedsResponse
+	6,  // 48: feeds.v1.FeedService.CreateFeed:output_type -> feeds.v1.CreateFeedResponse
+	8,  // 49: feeds.v1.FeedService.UpdateFeed:output_type -> feeds.v1.UpdateFeedResponse
+	10, // 50: feeds.v1.FeedService.DeleteFeed:output_type -> feeds.v1.DeleteFeedResponse
+	12, // 51: feeds.v1.FeedService.RefreshFeed:output_type -> feeds.v1.RefreshFeedResponse
+	15, // 52: feeds.v1.FeedService.ListFeedItems:output_type -> feeds.v1.ListFeedItemsResponse
+	17, // 53: feeds.v1.FeedService.GetFeedItem:output_type -> feeds.v1.GetFeedItemResponse
+	19, // 54: feeds.v1.FeedService.UpdateItem:output_type -> feeds.v1.UpdateItemResponse
+	21, // 55: feeds.v1.FeedService.SendItemToLibrary:output_type -> feeds.v1.SendItemToLibraryResponse
+	25, // 56: feeds.v1.FeedService.GetFeedStats:output_type -> feeds.v1.GetFeedStatsResponse
+	28, // 57: feeds.v1.FeedService.ImportOPML:output_type -> feeds.v1.ImportOPMLResponse
+	30, // 58: feeds.v1.FeedService.ExportOPML:output_type -> feeds.v1.ExportOPMLResponse
+	32, // 59: feeds.v1.FeedService.CreateFolder:output_type -> feeds.v1.CreateFolderResponse
+	34, // 60: feeds.v1.FeedService.RenameFolder:output_type -> feeds.v1.RenameFolderResponse
+	36, // 61: feeds.v1.FeedService.DeleteFolder:output_type -> feeds.v1.DeleteFolderResponse
+	38, // 62: feeds.v1.FeedService.ReorderFolders:output_type -> feeds.v1.ReorderFoldersResponse
+	40, // 63: feeds.v1.FeedService.MoveFeed:output_type -> feeds.v1.MoveFeedResponse
+	43, // 64: feeds.v1.FeedService.ListFilterRules:output_type -> feeds.v1.ListFilterRulesResponse
+	45, // 65: feeds.v1.FeedService.CreateFilterRule:output_type -> feeds.v1.CreateFilterRuleResponse
+	47, // 66: feeds.v1.FeedService.UpdateFilterRule:output_type -> feeds.v1.UpdateFilterRuleResponse
+	49, // 67: feeds.v1.FeedService.DeleteFilterRule:output_type -> feeds.v1.DeleteFilterRuleResponse
+	51, // 68: feeds.v1.FeedService.TestFilterRule:output_type -> feeds.v1.TestFilterRuleResponse
+	54, // 69: feeds.v1.FeedService.ListAppPasswords:output_type -> feeds.v1.ListAppPasswordsResponse
+	56, // 70: feeds.v1.FeedService.CreateAppPassword:output_type -> feeds.v1.CreateAppPasswordResponse
+	58, // 71: feeds.v1.FeedService.DeleteAppPassword:output_type -> feeds.v1.DeleteAppPasswordResponse
+	47, // [47:72] is the sub-list for method output_type
+	22, // [22:47] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_feeds_v1_feeds_proto_init() }
@@ -1625,15 +3724,15 @@ func file_feeds_v1_feeds_proto_init() {
 	if File_feeds_v1_feeds_proto != nil {
 		return
 	}
-	file_feeds_v1_feeds_proto_msgTypes[12].OneofWrappers = []any{}
-	file_feeds_v1_feeds_proto_msgTypes[16].OneofWrappers = []any{}
+	file_feeds_v1_feeds_proto_msgTypes[13].OneofWrappers = []any{}
+	file_feeds_v1_feeds_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_feeds_v1_feeds_proto_rawDesc), len(file_feeds_v1_feeds_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   22,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -50,9 +50,54 @@ const (
 	FeedServiceGetFeedItemProcedure = "/feeds.v1.FeedService/GetFeedItem"
 	// FeedServiceUpdateItemProcedure is the fully-qualified name of the FeedService's UpdateItem RPC.
 	FeedServiceUpdateItemProcedure = "/feeds.v1.FeedService/UpdateItem"
+	// FeedServiceSendItemToLibraryProcedure is the fully-qualified name of the FeedService's
+	// SendItemToLibrary RPC.
+	FeedServiceSendItemToLibraryProcedure = "/feeds.v1.FeedService/SendItemToLibrary"
 	// FeedServiceGetFeedStatsProcedure is the fully-qualified name of the FeedService's GetFeedStats
 	// RPC.
 	FeedServiceGetFeedStatsProcedure = "/feeds.v1.FeedService/GetFeedStats"
+	// FeedServiceImportOPMLProcedure is the fully-qualified name of the FeedService's ImportOPML RPC.
+	FeedServiceImportOPMLProcedure = "/feeds.v1.FeedService/ImportOPML"
+	// FeedServiceExportOPMLProcedure is the fully-qualified name of the FeedService's ExportOPML RPC.
+	FeedServiceExportOPMLProcedure = "/feeds.v1.FeedService/ExportOPML"
+	// FeedServiceCreateFolderProcedure is the fully-qualified name of the FeedService's CreateFolder
+	// RPC.
+	FeedServiceCreateFolderProcedure = "/feeds.v1.FeedService/CreateFolder"
+	// FeedServiceRenameFolderProcedure is the fully-qualified name of the FeedService's RenameFolder
+	// RPC.
+	FeedServiceRenameFolderProcedure = "/feeds.v1.FeedService/RenameFolder"
+	// FeedServiceDeleteFolderProcedure is the fully-qualified name of the FeedService's DeleteFolder
+	// RPC.
+	FeedServiceDeleteFolderProcedure = "/feeds.v1.FeedService/DeleteFolder"
+	// FeedServiceReorderFoldersProcedure is the fully-qualified name of the FeedService's
+	// ReorderFolders RPC.
+	FeedServiceReorderFoldersProcedure = "/feeds.v1.FeedService/ReorderFolders"
+	// FeedServiceMoveFeedProcedure is the fully-qualified name of the FeedService's MoveFeed RPC.
+	FeedServiceMoveFeedProcedure = "/feeds.v1.FeedService/MoveFeed"
+	// FeedServiceListFilterRulesProcedure is the fully-qualified name of the FeedService's
+	// ListFilterRules RPC.
+	FeedServiceListFilterRulesProcedure = "/feeds.v1.FeedService/ListFilterRules"
+	// FeedServiceCreateFilterRuleProcedure is the fully-qualified name of the FeedService's
+	// CreateFilterRule RPC.
+	FeedServiceCreateFilterRuleProcedure = "/feeds.v1.FeedService/CreateFilterRule"
+	// FeedServiceUpdateFilterRuleProcedure is the fully-qualified name of the FeedService's
+	// UpdateFilterRule RPC.
+	FeedServiceUpdateFilterRuleProcedure = "/feeds.v1.FeedService/UpdateFilterRule"
+	// FeedServiceDeleteFilterRuleProcedure is the fully-qualified name of the FeedService's
+	// DeleteFilterRule RPC.
+	FeedServiceDeleteFilterRuleProcedure = "/feeds.v1.FeedService/DeleteFilterRule"
+	// FeedServiceTestFilterRuleProcedure is the fully-qualified name of the FeedService's
+	// TestFilterRule RPC.
+	FeedServiceTestFilterRuleProcedure = "/feeds.v1.FeedService/TestFilterRule"
+	// FeedServiceListAppPasswordsProcedure is the fully-qualified name of the FeedService's
+	// ListAppPasswords RPC.
+	FeedServiceListAppPasswordsProcedure = "/feeds.v1.FeedService/ListAppPasswords"
+	// FeedServiceCreateAppPasswordProcedure is the fully-qualified name of the FeedService's
+	// CreateAppPassword RPC.
+	FeedServiceCreateAppPasswordProcedure = "/feeds.v1.FeedService/CreateAppPassword"
+	// FeedServiceDeleteAppPasswordProcedure is the fully-qualified name of the FeedService's
+	// DeleteAppPassword RPC.
+	FeedServiceDeleteAppPasswordProcedure = "/feeds.v1.FeedService/DeleteAppPassword"
 )
 
 // FeedServiceClient is a client for the feeds.v1.FeedService service.
@@ -65,7 +110,23 @@ type FeedServiceClient interface {
 	ListFeedItems(context.Context, *connect.Request[v1.ListFeedItemsRequest]) (*connect.Response[v1.ListFeedItemsResponse], error)
 	GetFeedItem(context.Context, *connect.Request[v1.GetFeedItemRequest]) (*connect.Response[v1.GetFeedItemResponse], error)
 	UpdateItem(context.Context, *connect.Request[v1.UpdateItemRequest]) (*connect.Response[v1.UpdateItemResponse], error)
+	SendItemToLibrary(context.Context, *connect.Request[v1.SendItemToLibraryRequest]) (*connect.Response[v1.SendItemToLibraryResponse], error)
 	GetFeedStats(context.Context, *connect.Request[v1.GetFeedStatsRequest]) (*connect.Response[v1.GetFeedStatsResponse], error)
+	ImportOPML(context.Context, *connect.Request[v1.ImportOPMLRequest]) (*connect.Response[v1.ImportOPMLResponse], error)
+	ExportOPML(context.Context, *connect.Request[v1.ExportOPMLRequest]) (*connect.Response[v1.ExportOPMLResponse], error)
+	CreateFolder(context.Context, *connect.Request[v1.CreateFolderRequest]) (*connect.Response[v1.CreateFolderResponse], error)
+	RenameFolder(context.Context, *connect.Request[v1.RenameFolderRequest]) (*connect.Response[v1.RenameFolderResponse], error)
+	DeleteFolder(context.Context, *connect.Request[v1.DeleteFolderRequest]) (*connect.Response[v1.DeleteFolderResponse], error)
+	ReorderFolders(context.Context, *connect.Request[v1.ReorderFoldersRequest]) (*connect.Response[v1.ReorderFoldersResponse], error)
+	MoveFeed(context.Context, *connect.Request[v1.MoveFeedRequest]) (*connect.Response[v1.MoveFeedResponse], error)
+	ListFilterRules(context.Context, *connect.Request[v1.ListFilterRulesRequest]) (*connect.Response[v1.ListFilterRulesResponse], error)
+	CreateFilterRule(context.Context, *connect.Request[v1.CreateFilterRuleRequest]) (*connect.Response[v1.CreateFilterRuleResponse], error)
+	UpdateFilterRule(context.Context, *connect.Request[v1.UpdateFilterRuleRequest]) (*connect.Response[v1.UpdateFilterRuleResponse], error)
+	DeleteFilterRule(context.Context, *connect.Request[v1.DeleteFilterRuleRequest]) (*connect.Response[v1.DeleteFilterRuleResponse], error)
+	TestFilterRule(context.Context, *connect.Request[v1.TestFilterRuleRequest]) (*connect.Response[v1.TestFilterRuleResponse], error)
+	ListAppPasswords(context.Context, *connect.Request[v1.ListAppPasswordsRequest]) (*connect.Response[v1.ListAppPasswordsResponse], error)
+	CreateAppPassword(context.Context, *connect.Request[v1.CreateAppPasswordRequest]) (*connect.Response[v1.CreateAppPasswordResponse], error)
+	DeleteAppPassword(context.Context, *connect.Request[v1.DeleteAppPasswordRequest]) (*connect.Response[v1.DeleteAppPasswordResponse], error)
 }
 
 // NewFeedServiceClient constructs a client for the feeds.v1.FeedService service. By default, it
@@ -127,26 +188,138 @@ func NewFeedServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(feedServiceMethods.ByName("UpdateItem")),
 			connect.WithClientOptions(opts...),
 		),
+		sendItemToLibrary: connect.NewClient[v1.SendItemToLibraryRequest, v1.SendItemToLibraryResponse](
+			httpClient,
+			baseURL+FeedServiceSendItemToLibraryProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("SendItemToLibrary")),
+			connect.WithClientOptions(opts...),
+		),
 		getFeedStats: connect.NewClient[v1.GetFeedStatsRequest, v1.GetFeedStatsResponse](
 			httpClient,
 			baseURL+FeedServiceGetFeedStatsProcedure,
 			connect.WithSchema(feedServiceMethods.ByName("GetFeedStats")),
 			connect.WithClientOptions(opts...),
 		),
+		importOPML: connect.NewClient[v1.ImportOPMLRequest, v1.ImportOPMLResponse](
+			httpClient,
+			baseURL+FeedServiceImportOPMLProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("ImportOPML")),
+			connect.WithClientOptions(opts...),
+		),
+		exportOPML: connect.NewClient[v1.ExportOPMLRequest, v1.ExportOPMLResponse](
+			httpClient,
+			baseURL+FeedServiceExportOPMLProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("ExportOPML")),
+			connect.WithClientOptions(opts...),
+		),
+		createFolder: connect.NewClient[v1.CreateFolderRequest, v1.CreateFolderResponse](
+			httpClient,
+			baseURL+FeedServiceCreateFolderProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("CreateFolder")),
+			connect.WithClientOptions(opts...),
+		),
+		renameFolder: connect.NewClient[v1.RenameFolderRequest, v1.RenameFolderResponse](
+			httpClient,
+			baseURL+FeedServiceRenameFolderProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("RenameFolder")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFolder: connect.NewClient[v1.DeleteFolderRequest, v1.DeleteFolderResponse](
+			httpClient,
+			baseURL+FeedServiceDeleteFolderProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("DeleteFolder")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderFolders: connect.NewClient[v1.ReorderFoldersRequest, v1.ReorderFoldersResponse](
+			httpClient,
+			baseURL+FeedServiceReorderFoldersProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("ReorderFolders")),
+			connect.WithClientOptions(opts...),
+		),
+		moveFeed: connect.NewClient[v1.MoveFeedRequest, v1.MoveFeedResponse](
+			httpClient,
+			baseURL+FeedServiceMoveFeedProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("MoveFeed")),
+			connect.WithClientOptions(opts...),
+		),
+		listFilterRules: connect.NewClient[v1.ListFilterRulesRequest, v1.ListFilterRulesResponse](
+			httpClient,
+			baseURL+FeedServiceListFilterRulesProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("ListFilterRules")),
+			connect.WithClientOptions(opts...),
+		),
+		createFilterRule: connect.NewClient[v1.CreateFilterRuleRequest, v1.CreateFilterRuleResponse](
+			httpClient,
+			baseURL+FeedServiceCreateFilterRuleProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("CreateFilterRule")),
+			connect.WithClientOptions(opts...),
+		),
+		updateFilterRule: connect.NewClient[v1.UpdateFilterRuleRequest, v1.UpdateFilterRuleResponse](
+			httpClient,
+			baseURL+FeedServiceUpdateFilterRuleProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("UpdateFilterRule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFilterRule: connect.NewClient[v1.DeleteFilterRuleRequest, v1.DeleteFilterRuleResponse](
+			httpClient,
+			baseURL+FeedServiceDeleteFilterRuleProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("DeleteFilterRule")),
+			connect.WithClientOptions(opts...),
+		),
+		testFilterRule: connect.NewClient[v1.TestFilterRuleRequest, v1.TestFilterRuleResponse](
+			httpClient,
+			baseURL+FeedServiceTestFilterRuleProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("TestFilterRule")),
+			connect.WithClientOptions(opts...),
+		),
+		listAppPasswords: connect.NewClient[v1.ListAppPasswordsRequest, v1.ListAppPasswordsResponse](
+			httpClient,
+			baseURL+FeedServiceListAppPasswordsProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("ListAppPasswords")),
+			connect.WithClientOptions(opts...),
+		),
+		createAppPassword: connect.NewClient[v1.CreateAppPasswordRequest, v1.CreateAppPasswordResponse](
+			httpClient,
+			baseURL+FeedServiceCreateAppPasswordProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("CreateAppPassword")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAppPassword: connect.NewClient[v1.DeleteAppPasswordRequest, v1.DeleteAppPasswordResponse](
+			httpClient,
+			baseURL+FeedServiceDeleteAppPasswordProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("DeleteAppPassword")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // feedServiceClient implements FeedServiceClient.
 type feedServiceClient struct {
-	listFeeds     *connect.Client[v1.ListFeedsRequest, v1.ListFeedsResponse]
-	createFeed    *connect.Client[v1.CreateFeedRequest, v1.CreateFeedResponse]
-	updateFeed    *connect.Client[v1.UpdateFeedRequest, v1.UpdateFeedResponse]
-	deleteFeed    *connect.Client[v1.DeleteFeedRequest, v1.DeleteFeedResponse]
-	refreshFeed   *connect.Client[v1.RefreshFeedRequest, v1.RefreshFeedResponse]
-	listFeedItems *connect.Client[v1.ListFeedItemsRequest, v1.ListFeedItemsResponse]
-	getFeedItem   *connect.Client[v1.GetFeedItemRequest, v1.GetFeedItemResponse]
-	updateItem    *connect.Client[v1.UpdateItemRequest, v1.UpdateItemResponse]
-	getFeedStats  *connect.Client[v1.GetFeedStatsRequest, v1.GetFeedStatsResponse]
+	listFeeds         *connect.Client[v1.ListFeedsRequest, v1.ListFeedsResponse]
+	createFeed        *connect.Client[v1.CreateFeedRequest, v1.CreateFeedResponse]
+	updateFeed        *connect.Client[v1.UpdateFeedRequest, v1.UpdateFeedResponse]
+	deleteFeed        *connect.Client[v1.DeleteFeedRequest, v1.DeleteFeedResponse]
+	refreshFeed       *connect.Client[v1.RefreshFeedRequest, v1.RefreshFeedResponse]
+	listFeedItems     *connect.Client[v1.ListFeedItemsRequest, v1.ListFeedItemsResponse]
+	getFeedItem       *connect.Client[v1.GetFeedItemRequest, v1.GetFeedItemResponse]
+	updateItem        *connect.Client[v1.UpdateItemRequest, v1.UpdateItemResponse]
+	sendItemToLibrary *connect.Client[v1.SendItemToLibraryRequest, v1.SendItemToLibraryResponse]
+	getFeedStats      *connect.Client[v1.GetFeedStatsRequest, v1.GetFeedStatsResponse]
+	importOPML        *connect.Client[v1.ImportOPMLRequest, v1.ImportOPMLResponse]
+	exportOPML        *connect.Client[v1.ExportOPMLRequest, v1.ExportOPMLResponse]
+	createFolder      *connect.Client[v1.CreateFolderRequest, v1.CreateFolderResponse]
+	renameFolder      *connect.Client[v1.RenameFolderRequest, v1.RenameFolderResponse]
+	deleteFolder      *connect.Client[v1.DeleteFolderRequest, v1.DeleteFolderResponse]
+	reorderFolders    *connect.Client[v1.ReorderFoldersRequest, v1.ReorderFoldersResponse]
+	moveFeed          *connect.Client[v1.MoveFeedRequest, v1.MoveFeedResponse]
+	listFilterRules   *connect.Client[v1.ListFilterRulesRequest, v1.ListFilterRulesResponse]
+	createFilterRule  *connect.Client[v1.CreateFilterRuleRequest, v1.CreateFilterRuleResponse]
+	updateFilterRule  *connect.Client[v1.UpdateFilterRuleRequest, v1.UpdateFilterRuleResponse]
+	deleteFilterRule  *connect.Client[v1.DeleteFilterRuleRequest, v1.DeleteFilterRuleResponse]
+	testFilterRule    *connect.Client[v1.TestFilterRuleRequest, v1.TestFilterRuleResponse]
+	listAppPasswords  *connect.Client[v1.ListAppPasswordsRequest, v1.ListAppPasswordsResponse]
+	createAppPassword *connect.Client[v1.CreateAppPasswordRequest, v1.CreateAppPasswordResponse]
+	deleteAppPassword *connect.Client[v1.DeleteAppPasswordRequest, v1.DeleteAppPasswordResponse]
 }
 
 // ListFeeds calls feeds.v1.FeedService.ListFeeds.
@@ -189,11 +362,91 @@ func (c *feedServiceClient) UpdateItem(ctx context.Context, req *connect.Request
 	return c.updateItem.CallUnary(ctx, req)
 }
 
+// SendItemToLibrary calls feeds.v1.FeedService.SendItemToLibrary.
+func (c *feedServiceClient) SendItemToLibrary(ctx context.Context, req *connect.Request[v1.SendItemToLibraryRequest]) (*connect.Response[v1.SendItemToLibraryResponse], error) {
+	return c.sendItemToLibrary.CallUnary(ctx, req)
+}
+
 // GetFeedStats calls feeds.v1.FeedService.GetFeedStats.
 func (c *feedServiceClient) GetFeedStats(ctx context.Context, req *connect.Request[v1.GetFeedStatsRequest]) (*connect.Response[v1.GetFeedStatsResponse], error) {
 	return c.getFeedStats.CallUnary(ctx, req)
 }
 
+// ImportOPML calls feeds.v1.FeedService.ImportOPML.
+func (c *feedServiceClient) ImportOPML(ctx context.Context, req *connect.Request[v1.ImportOPMLRequest]) (*connect.Response[v1.ImportOPMLResponse], error) {
+	return c.importOPML.CallUnary(ctx, req)
+}
+
+// ExportOPML calls feeds.v1.FeedService.ExportOPML.
+func (c *feedServiceClient) ExportOPML(ctx context.Context, req *connect.Request[v1.ExportOPMLRequest]) (*connect.Response[v1.ExportOPMLResponse], error) {
+	return c.exportOPML.CallUnary(ctx, req)
+}
+
+// CreateFolder calls feeds.v1.FeedService.CreateFolder.
+func (c *feedServiceClient) CreateFolder(ctx context.Context, req *connect.Request[v1.CreateFolderRequest]) (*connect.Response[v1.CreateFolderResponse], error) {
+	return c.createFolder.CallUnary(ctx, req)
+}
+
+// RenameFolder calls feeds.v1.FeedService.RenameFolder.
+func (c *feedServiceClient) RenameFolder(ctx context.Context, req *connect.Request[v1.RenameFolderRequest]) (*connect.Response[v1.RenameFolderResponse], error) {
+	return c.renameFolder.CallUnary(ctx, req)
+}
+
+// DeleteFolder calls feeds.v1.FeedService.DeleteFolder.
+func (c *feedServiceClient) DeleteFolder(ctx context.Context, req *connect.Request[v1.DeleteFolderRequest]) (*connect.Response[v1.DeleteFolderResponse], error) {
+	return c.deleteFolder.CallUnary(ctx, req)
+}
+
+// ReorderFolders calls feeds.v1.FeedService.ReorderFolders.
+func (c *feedServiceClient) ReorderFolders(ctx context.Context, req *connect.Request[v1.ReorderFoldersRequest]) (*connect.Response[v1.ReorderFoldersResponse], error) {
+	return c.reorderFolders.CallUnary(ctx, req)
+}
+
+// MoveFeed calls feeds.v1.FeedService.MoveFeed.
+func (c *feedServiceClient) MoveFeed(ctx context.Context, req *connect.Request[v1.MoveFeedRequest]) (*connect.Response[v1.MoveFeedResponse], error) {
+	return c.moveFeed.CallUnary(ctx, req)
+}
+
+// ListFilterRules calls feeds.v1.FeedService.ListFilterRules.
+func (c *feedServiceClient) ListFilterRules(ctx context.Context, req *connect.Request[v1.ListFilterRulesRequest]) (*connect.Response[v1.ListFilterRulesResponse], error) {
+	return c.listFilterRules.CallUnary(ctx, req)
+}
+
+// CreateFilterRule calls feeds.v1.FeedService.CreateFilterRule.
+func (c *feedServiceClient) CreateFilterRule(ctx context.Context, req *connect.Request[v1.CreateFilterRuleRequest]) (*connect.Response[v1.CreateFilterRuleResponse], error) {
+	return c.createFilterRule.CallUnary(ctx, req)
+}
+
+// UpdateFilterRule calls feeds.v1.FeedService.UpdateFilterRule.
+func (c *feedServiceClient) UpdateFilterRule(ctx context.Context, req *connect.Request[v1.UpdateFilterRuleRequest]) (*connect.Response[v1.UpdateFilterRuleResponse], error) {
+	return c.updateFilterRule.CallUnary(ctx, req)
+}
+
+// DeleteFilterRule calls feeds.v1.FeedService.DeleteFilterRule.
+func (c *feedServiceClient) DeleteFilterRule(ctx context.Context, req *connect.Request[v1.DeleteFilterRuleRequest]) (*connect.Response[v1.DeleteFilterRuleResponse], error) {
+	return c.deleteFilterRule.CallUnary(ctx, req)
+}
+
+// TestFilterRule calls feeds.v1.FeedService.TestFilterRule.
+func (c *feedServiceClient) TestFilterRule(ctx context.Context, req *connect.Request[v1.TestFilterRuleRequest]) (*connect.Response[v1.TestFilterRuleResponse], error) {
+	return c.testFilterRule.CallUnary(ctx, req)
+}
+
+// ListAppPasswords calls feeds.v1.FeedService.ListAppPasswords.
+func (c *feedServiceClient) ListAppPasswords(ctx context.Context, req *connect.Request[v1.ListAppPasswordsRequest]) (*connect.Response[v1.ListAppPasswordsResponse], error) {
+	return c.listAppPasswords.CallUnary(ctx, req)
+}
+
+// CreateAppPassword calls feeds.v1.FeedService.CreateAppPassword.
+func (c *feedServiceClient) CreateAppPassword(ctx context.Context, req *connect.Request[v1.CreateAppPasswordRequest]) (*connect.Response[v1.CreateAppPasswordResponse], error) {
+	return c.createAppPassword.CallUnary(ctx, req)
+}
+
+// DeleteAppPassword calls feeds.v1.FeedService.DeleteAppPassword.
+func (c *feedServiceClient) DeleteAppPassword(ctx context.Context, req *connect.Request[v1.DeleteAppPasswordRequest]) (*connect.Response[v1.DeleteAppPasswordResponse], error) {
+	return c.deleteAppPassword.CallUnary(ctx, req)
+}
+
 // FeedServiceHandler is an implementation of the feeds.v1.FeedService service.
 type FeedServiceHandler interface {
 	ListFeeds(context.Context, *connect.Request[v1.ListFeedsRequest]) (*connect.Response[v1.ListFeedsResponse], error)
@@ -204,7 +457,23 @@ type FeedServiceHandler interface {
 	ListFeedItems(context.Context, *connect.Request[v1.ListFeedItemsRequest]) (*connect.Response[v1.ListFeedItemsResponse], error)
 	GetFeedItem(context.Context, *connect.Request[v1.GetFeedItemRequest]) (*connect.Response[v1.GetFeedItemResponse], error)
 	UpdateItem(context.Context, *connect.Request[v1.UpdateItemRequest]) (*connect.Response[v1.UpdateItemResponse], error)
+	SendItemToLibrary(context.Context, *connect.Request[v1.SendItemToLibraryRequest]) (*connect.Response[v1.SendItemToLibraryResponse], error)
 	GetFeedStats(context.Context, *connect.Request[v1.GetFeedStatsRequest]) (*connect.Response[v1.GetFeedStatsResponse], error)
+	ImportOPML(context.Context, *connect.Request[v1.ImportOPMLRequest]) (*connect.Response[v1.ImportOPMLResponse], error)
+	ExportOPML(context.Context, *connect.Request[v1.ExportOPMLRequest]) (*connect.Response[v1.ExportOPMLResponse], error)
+	CreateFolder(context.Context, *connect.Request[v1.CreateFolderRequest]) (*connect.Response[v1.CreateFolderResponse], error)
+	RenameFolder(context.Context, *connect.Request[v1.RenameFolderRequest]) (*connect.Response[v1.RenameFolderResponse], error)
+	DeleteFolder(context.Context, *connect.Request[v1.DeleteFolderRequest]) (*connect.Response[v1.DeleteFolderResponse], error)
+	ReorderFolders(context.Context, *connect.Request[v1.ReorderFoldersRequest]) (*connect.Response[v1.ReorderFoldersResponse], error)
+	MoveFeed(context.Context, *connect.Request[v1.MoveFeedRequest]) (*connect.Response[v1.MoveFeedResponse], error)
+	ListFilterRules(context.Context, *connect.Request[v1.ListFilterRulesRequest]) (*connect.Response[v1.ListFilterRulesResponse], error)
+	CreateFilterRule(context.Context, *connect.Request[v1.CreateFilterRuleRequest]) (*connect.Response[v1.CreateFilterRuleResponse], error)
+	UpdateFilterRule(context.Context, *connect.Request[v1.UpdateFilterRuleRequest]) (*connect.Response[v1.UpdateFilterRuleResponse], error)
+	DeleteFilterRule(context.Context, *connect.Request[v1.DeleteFilterRuleRequest]) (*connect.Response[v1.DeleteFilterRuleResponse], error)
+	TestFilterRule(context.Context, *connect.Request[v1.TestFilterRuleRequest]) (*connect.Response[v1.TestFilterRuleResponse], error)
+	ListAppPasswords(context.Context, *connect.Request[v1.ListAppPasswordsRequest]) (*connect.Response[v1.ListAppPasswordsResponse], error)
+	CreateAppPassword(context.Context, *connect.Request[v1.CreateAppPasswordRequest]) (*connect.Response[v1.CreateAppPasswordResponse], error)
+	DeleteAppPassword(context.Context, *connect.Request[v1.DeleteAppPasswordRequest]) (*connect.Response[v1.DeleteAppPasswordResponse], error)
 }
 
 // NewFeedServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -262,12 +531,108 @@ func NewFeedServiceHandler(svc FeedServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(feedServiceMethods.ByName("UpdateItem")),
 		connect.WithHandlerOptions(opts...),
 	)
+	feedServiceSendItemToLibraryHandler := connect.NewUnaryHandler(
+		FeedServiceSendItemToLibraryProcedure,
+		svc.SendItemToLibrary,
+		connect.WithSchema(feedServiceMethods.ByName("SendItemToLibrary")),
+		connect.WithHandlerOptions(opts...),
+	)
 	feedServiceGetFeedStatsHandler := connect.NewUnaryHandler(
 		FeedServiceGetFeedStatsProcedure,
 		svc.GetFeedStats,
 		connect.WithSchema(feedServiceMethods.ByName("GetFeedStats")),
 		connect.WithHandlerOptions(opts...),
 	)
+	feedServiceImportOPMLHandler := connect.NewUnaryHandler(
+		FeedServiceImportOPMLProcedure,
+		svc.ImportOPML,
+		connect.WithSchema(feedServiceMethods.ByName("ImportOPML")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceExportOPMLHandler := connect.NewUnaryHandler(
+		FeedServiceExportOPMLProcedure,
+		svc.ExportOPML,
+		connect.WithSchema(feedServiceMethods.ByName("ExportOPML")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceCreateFolderHandler := connect.NewUnaryHandler(
+		FeedServiceCreateFolderProcedure,
+		svc.CreateFolder,
+		connect.WithSchema(feedServiceMethods.ByName("CreateFolder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceRenameFolderHandler := connect.NewUnaryHandler(
+		FeedServiceRenameFolderProcedure,
+		svc.RenameFolder,
+		connect.WithSchema(feedServiceMethods.ByName("RenameFolder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceDeleteFolderHandler := connect.NewUnaryHandler(
+		FeedServiceDeleteFolderProcedure,
+		svc.DeleteFolder,
+		connect.WithSchema(feedServiceMethods.ByName("DeleteFolder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceReorderFoldersHandler := connect.NewUnaryHandler(
+		FeedServiceReorderFoldersProcedure,
+		svc.ReorderFolders,
+		connect.WithSchema(feedServiceMethods.ByName("ReorderFolders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceMoveFeedHandler := connect.NewUnaryHandler(
+		FeedServiceMoveFeedProcedure,
+		svc.MoveFeed,
+		connect.WithSchema(feedServiceMethods.ByName("MoveFeed")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceListFilterRulesHandler := connect.NewUnaryHandler(
+		FeedServiceListFilterRulesProcedure,
+		svc.ListFilterRules,
+		connect.WithSchema(feedServiceMethods.ByName("ListFilterRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceCreateFilterRuleHandler := connect.NewUnaryHandler(
+		FeedServiceCreateFilterRuleProcedure,
+		svc.CreateFilterRule,
+		connect.WithSchema(feedServiceMethods.ByName("CreateFilterRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceUpdateFilterRuleHandler := connect.NewUnaryHandler(
+		FeedServiceUpdateFilterRuleProcedure,
+		svc.UpdateFilterRule,
+		connect.WithSchema(feedServiceMethods.ByName("UpdateFilterRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceDeleteFilterRuleHandler := connect.NewUnaryHandler(
+		FeedServiceDeleteFilterRuleProcedure,
+		svc.DeleteFilterRule,
+		connect.WithSchema(feedServiceMethods.ByName("DeleteFilterRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceTestFilterRuleHandler := connect.NewUnaryHandler(
+		FeedServiceTestFilterRuleProcedure,
+		svc.TestFilterRule,
+		connect.WithSchema(feedServiceMethods.ByName("TestFilterRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceListAppPasswordsHandler := connect.NewUnaryHandler(
+		FeedServiceListAppPasswordsProcedure,
+		svc.ListAppPasswords,
+		connect.WithSchema(feedServiceMethods.ByName("ListAppPasswords")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceCreateAppPasswordHandler := connect.NewUnaryHandler(
+		FeedServiceCreateAppPasswordProcedure,
+		svc.CreateAppPassword,
+		connect.WithSchema(feedServiceMethods.ByName("CreateAppPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceDeleteAppPasswordHandler := connect.NewUnaryHandler(
+		FeedServiceDeleteAppPasswordProcedure,
+		svc.DeleteAppPassword,
+		connect.WithSchema(feedServiceMethods.ByName("DeleteAppPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/feeds.v1.FeedService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case FeedServiceListFeedsProcedure:
@@ -286,8 +651,40 @@ func NewFeedServiceHandler(svc FeedServiceHandler, opts ...connect.HandlerOption
 			feedServiceGetFeedItemHandler.ServeHTTP(w, r)
 		case FeedServiceUpdateItemProcedure:
 			feedServiceUpdateItemHandler.ServeHTTP(w, r)
+		case FeedServiceSendItemToLibraryProcedure:
+			feedServiceSendItemToLibraryHandler.ServeHTTP(w, r)
 		case FeedServiceGetFeedStatsProcedure:
 			feedServiceGetFeedStatsHandler.ServeHTTP(w, r)
+		case FeedServiceImportOPMLProcedure:
+			feedServiceImportOPMLHandler.ServeHTTP(w, r)
+		case FeedServiceExportOPMLProcedure:
+			feedServiceExportOPMLHandler.ServeHTTP(w, r)
+		case FeedServiceCreateFolderProcedure:
+			feedServiceCreateFolderHandler.ServeHTTP(w, r)
+		case FeedServiceRenameFolderProcedure:
+			feedServiceRenameFolderHandler.ServeHTTP(w, r)
+		case FeedServiceDeleteFolderProcedure:
+			feedServiceDeleteFolderHandler.ServeHTTP(w, r)
+		case FeedServiceReorderFoldersProcedure:
+			feedServiceReorderFoldersHandler.ServeHTTP(w, r)
+		case FeedServiceMoveFeedProcedure:
+			feedServiceMoveFeedHandler.ServeHTTP(w, r)
+		case FeedServiceListFilterRulesProcedure:
+			feedServiceListFilterRulesHandler.ServeHTTP(w, r)
+		case FeedServiceCreateFilterRuleProcedure:
+			feedServiceCreateFilterRuleHandler.ServeHTTP(w, r)
+		case FeedServiceUpdateFilterRuleProcedure:
+			feedServiceUpdateFilterRuleHandler.ServeHTTP(w, r)
+		case FeedServiceDeleteFilterRuleProcedure:
+			feedServiceDeleteFilterRuleHandler.ServeHTTP(w, r)
+		case FeedServiceTestFilterRuleProcedure:
+			feedServiceTestFilterRuleHandler.ServeHTTP(w, r)
+		case FeedServiceListAppPasswordsProcedure:
+			feedServiceListAppPasswordsHandler.ServeHTTP(w, r)
+		case FeedServiceCreateAppPasswordProcedure:
+			feedServiceCreateAppPasswordHandler.ServeHTTP(w, r)
+		case FeedServiceDeleteAppPasswordProcedure:
+			feedServiceDeleteAppPasswordHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -329,6 +726,70 @@ func (UnimplementedFeedServiceHandler) UpdateItem(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.UpdateItem is not implemented"))
 }
 
+func (UnimplementedFeedServiceHandler) SendItemToLibrary(context.Context, *connect.Request[v1.SendItemToLibraryRequest]) (*connect.Response[v1.SendItemToLibraryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.SendItemToLibrary is not implemented"))
+}
+
 func (UnimplementedFeedServiceHandler) GetFeedStats(context.Context, *connect.Request[v1.GetFeedStatsRequest]) (*connect.Response[v1.GetFeedStatsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.GetFeedStats is not implemented"))
 }
+
+func (UnimplementedFeedServiceHandler) ImportOPML(context.Context, *connect.Request[v1.ImportOPMLRequest]) (*connect.Response[v1.ImportOPMLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.ImportOPML is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) ExportOPML(context.Context, *connect.Request[v1.ExportOPMLRequest]) (*connect.Response[v1.ExportOPMLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.ExportOPML is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) CreateFolder(context.Context, *connect.Request[v1.CreateFolderRequest]) (*connect.Response[v1.CreateFolderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.CreateFolder is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) RenameFolder(context.Context, *connect.Request[v1.RenameFolderRequest]) (*connect.Response[v1.RenameFolderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.RenameFolder is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) DeleteFolder(context.Context, *connect.Request[v1.DeleteFolderRequest]) (*connect.Response[v1.DeleteFolderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.DeleteFolder is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) ReorderFolders(context.Context, *connect.Request[v1.ReorderFoldersRequest]) (*connect.Response[v1.ReorderFoldersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.ReorderFolders is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) MoveFeed(context.Context, *connect.Request[v1.MoveFeedRequest]) (*connect.Response[v1.MoveFeedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.MoveFeed is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) ListFilterRules(context.Context, *connect.Request[v1.ListFilterRulesRequest]) (*connect.Response[v1.ListFilterRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.ListFilterRules is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) CreateFilterRule(context.Context, *connect.Request[v1.CreateFilterRuleRequest]) (*connect.Response[v1.CreateFilterRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.CreateFilterRule is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) UpdateFilterRule(context.Context, *connect.Request[v1.UpdateFilterRuleRequest]) (*connect.Response[v1.UpdateFilterRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.UpdateFilterRule is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) DeleteFilterRule(context.Context, *connect.Request[v1.DeleteFilterRuleRequest]) (*connect.Response[v1.DeleteFilterRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.DeleteFilterRule is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) TestFilterRule(context.Context, *connect.Request[v1.TestFilterRuleRequest]) (*connect.Response[v1.TestFilterRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.TestFilterRule is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) ListAppPasswords(context.Context, *connect.Request[v1.ListAppPasswordsRequest]) (*connect.Response[v1.ListAppPasswordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.ListAppPasswords is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) CreateAppPassword(context.Context, *connect.Request[v1.CreateAppPasswordRequest]) (*connect.Response[v1.CreateAppPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.CreateAppPassword is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) DeleteAppPassword(context.Context, *connect.Request[v1.DeleteAppPasswordRequest]) (*connect.Response[v1.DeleteAppPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedService.DeleteAppPassword is not implemented"))
+}
@@ -567,8 +567,13 @@ type StorageSnapshot struct {
 	StaleUploadSizeBytes int64                  `protobuf:"varint,6,opt,name=stale_upload_size_bytes,json=staleUploadSizeBytes,proto3" json:"stale_upload_size_bytes,omitempty"`
 	StaleUploadCount     int64                  `protobuf:"varint,7,opt,name=stale_upload_count,json=staleUploadCount,proto3" json:"stale_upload_count,omitempty"`
 	PrefixBreakdown      []*PrefixStat          `protobuf:"bytes,8,rep,name=prefix_breakdown,json=prefixBreakdown,proto3" json:"prefix_breakdown,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Space saved by blobs shared across book files (size × extra references).
+	DedupSavedBytes int64 `protobuf:"varint,9,opt,name=dedup_saved_bytes,json=dedupSavedBytes,proto3" json:"dedup_saved_bytes,omitempty"`
+	// What the scan's garbage collection deleted: orphans and stale uploads.
+	ReclaimedSizeBytes int64 `protobuf:"varint,10,opt,name=reclaimed_size_bytes,json=reclaimedSizeBytes,proto3" json:"reclaimed_size_bytes,omitempty"`
+	ReclaimedCount     int64 `protobuf:"varint,11,opt,name=reclaimed_count,json=reclaimedCount,proto3" json:"reclaimed_count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *StorageSnapshot) Reset() {
@@ -657,6 +662,27 @@ func (x *StorageSnapshot) GetPrefixBreakdown() []*PrefixStat {
 	return nil
 }
 
+func (x *StorageSnapshot) GetDedupSavedBytes() int64 {
+	if x != nil {
+		return x.DedupSavedBytes
+	}
+	return 0
+}
+
+func (x *StorageSnapshot) GetReclaimedSizeBytes() int64 {
+	if x != nil {
+		return x.ReclaimedSizeBytes
+	}
+	return 0
+}
+
+func (x *StorageSnapshot) GetReclaimedCount() int64 {
+	if x != nil {
+		return x.ReclaimedCount
+	}
+	return 0
+}
+
 type GetStorageStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -3186,7 +3212,7 @@ const file_observability_v1_observability_proto_rawDesc = "" +
 	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x1d\n" +
 	"\n" +
 	"size_bytes\x18\x02 \x01(\x03R\tsizeBytes\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x03R\x05count\"\xfa\x02\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\x81\x04\n" +
 	"\x0fStorageSnapshot\x12\x1d\n" +
 	"\n" +
 	"scanned_at\x18\x01 \x01(\tR\tscannedAt\x12(\n" +
@@ -3196,7 +3222,11 @@ const file_observability_v1_observability_proto_rawDesc = "" +
 	"\forphan_count\x18\x05 \x01(\x03R\vorphanCount\x125\n" +
 	"\x17stale_upload_size_bytes\x18\x06 \x01(\x03R\x14staleUploadSizeBytes\x12,\n" +
 	"\x12stale_upload_count\x18\a \x01(\x03R\x10staleUploadCount\x12G\n" +
-	"\x10prefix_breakdown\x18\b \x03(\v2\x1c.observability.v1.PrefixStatR\x0fprefixBreakdown\"\x18\n" +
+	"\x10prefix_breakdown\x18\b \x03(\v2\x1c.observability.v1.PrefixStatR\x0fprefixBreakdown\x12*\n" +
+	"\x11dedup_saved_bytes\x18\t \x01(\x03R\x0fdedupSavedBytes\x120\n" +
+	"\x14reclaimed_size_bytes\x18\n" +
+	" \x01(\x03R\x12reclaimedSizeBytes\x12'\n" +
+	"\x0freclaimed_count\x18\v \x01(\x03R\x0ereclaimedCount\"\x18\n" +
 	"\x16GetStorageStatsRequest\"\x91\x01\n" +
 	"\x17GetStorageStatsResponse\x129\n" +
 	"\x06latest\x18\x01 \x01(\v2!.observability.v1.StorageSnapshotR\x06latest\x12;\n" +
@@ -143,6 +143,9 @@ require (
 	go.uber.org/multierr v1.11.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	tools.xdoubleu.com/sentrytools v0.0.0
+	tools.xdoubleu.com/sqlitefile v0.0.0
 )
 
 replace tools.xdoubleu.com/sentrytools => ../sentrytools
+
+replace tools.xdoubleu.com/sqlitefile => ../sqlitefile
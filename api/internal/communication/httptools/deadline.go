@@ -0,0 +1,32 @@
+package httptools
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// WithExtendedDeadline raises the response write deadline (net/http's
+// ResponseController) and gives the request context its own, shorter
+// timeout, for one slow route — without touching the server-wide
+// httpWriteTimeout that every other (fast) route relies on. ctxTimeout
+// must stay below writeDeadline by a real margin (issue #672), leaving the
+// handler time to write its response once the context is cancelled.
+func WithExtendedDeadline(
+	writeDeadline, ctxTimeout time.Duration, next http.HandlerFunc,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := http.NewResponseController(w).SetWriteDeadline(
+			time.Now().Add(writeDeadline),
+		); err != nil {
+			// Not all ResponseWriters support deadlines (e.g. in tests using
+			// httptest.ResponseRecorder) — fall back to the ambient deadline.
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeout)
+		defer cancel()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	}
+}
@@ -0,0 +1,76 @@
+package httptools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/internal/communication/httptools"
+)
+
+// deadlineSettingRecorder wraps httptest.ResponseRecorder to additionally
+// implement http.ResponseController's SetWriteDeadline, which
+// ResponseRecorder alone does not — WithExtendedDeadline falls back to a
+// no-op when the underlying writer doesn't support it, so exercising the
+// "deadline actually applied" branch needs a writer that does.
+type deadlineSettingRecorder struct {
+	*httptest.ResponseRecorder
+	deadline time.Time
+}
+
+//nolint:unparam // satisfies http.ResponseController's interface signature
+func (r *deadlineSettingRecorder) SetWriteDeadline(t time.Time) error {
+	r.deadline = t
+	return nil
+}
+
+// TestWithExtendedDeadline_AppliesWriteAndContextDeadline verifies that, when
+// the ResponseWriter supports it, WithExtendedDeadline both sets the
+// response write deadline and gives the downstream handler's request context
+// its own, shorter deadline.
+func TestWithExtendedDeadline_AppliesWriteAndContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	//nolint:exhaustruct // deadline is set by the handler under test, not the fixture
+	rec := &deadlineSettingRecorder{ResponseRecorder: httptest.NewRecorder()}
+
+	var gotDeadline time.Time
+	var gotOK bool
+	handler := httptools.WithExtendedDeadline(
+		30*time.Second,
+		20*time.Second,
+		func(_ http.ResponseWriter, r *http.Request) {
+			gotDeadline, gotOK = r.Context().Deadline()
+		},
+	)
+
+	before := time.Now()
+	handler(rec, httptest.NewRequest(http.MethodPost, "/x", nil))
+
+	require.True(t, gotOK, "downstream handler must see a context deadline")
+	assert.WithinDuration(t, before.Add(20*time.Second), gotDeadline, time.Second)
+	assert.WithinDuration(t, before.Add(30*time.Second), rec.deadline, time.Second)
+}
+
+// TestWithExtendedDeadline_FallsBackWhenUnsupported verifies that a
+// ResponseWriter without SetWriteDeadline support (e.g. a plain
+// httptest.ResponseRecorder) still reaches the downstream handler instead of
+// erroring out.
+func TestWithExtendedDeadline_FallsBackWhenUnsupported(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	handler := httptools.WithExtendedDeadline(
+		30*time.Second,
+		20*time.Second,
+		func(_ http.ResponseWriter, _ *http.Request) { called = true },
+	)
+
+	handler(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/x", nil))
+
+	assert.True(t, called)
+}
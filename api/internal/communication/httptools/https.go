@@ -0,0 +1,15 @@
+package httptools
+
+import "net/http"
+
+// RequireHTTPS rejects requests that didn't reach the reverse proxy over
+// HTTPS, for routes whose credentials travel with every request (tokens in
+// URLs, app passwords in headers). It writes a 403 and returns false in that
+// case.
+func RequireHTTPS(w http.ResponseWriter, r *http.Request) bool {
+	if r.Header.Get("X-Forwarded-Proto") != "https" {
+		http.Error(w, "https required", http.StatusForbidden)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,34 @@
+package httptools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"tools.xdoubleu.com/internal/communication/httptools"
+)
+
+func TestRequireHTTPS(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		proto string
+		ok    bool
+	}{
+		{"https", true},
+		{"http", false},
+		{"", false},
+	} {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if tc.proto != "" {
+			req.Header.Set("X-Forwarded-Proto", tc.proto)
+		}
+		assert.Equal(t, tc.ok, httptools.RequireHTTPS(rec, req), tc.proto)
+		if !tc.ok {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+	}
+}
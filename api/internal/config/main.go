@@ -21,6 +21,17 @@ const TestEnv string = "test"
 // DevEnv can be used as value when reading out the type of environment.
 const DevEnv string = "development"
 
+// Object store backends selectable with OBJECT_STORE_BACKEND.
+const (
+	// ObjectStoreR2 stores book files in Cloudflare R2 (the default).
+	ObjectStoreR2 string = "r2"
+	// ObjectStoreS3 stores them in any S3-compatible store, e.g. MinIO.
+	ObjectStoreS3 string = "s3"
+	// ObjectStoreFS stores them under a local directory, served through
+	// the api's own signed URLs.
+	ObjectStoreFS string = "fs"
+)
+
 type Config struct {
 	Env           string
 	Port          int
@@ -53,6 +64,21 @@ type Config struct {
 	R2SecretKey     string
 	R2Bucket        string
 
+	// ObjectStoreBackend picks where book files live: ObjectStoreR2,
+	// ObjectStoreS3 or ObjectStoreFS. Only the chosen backend's settings
+	// below are read.
+	ObjectStoreBackend string
+	S3Endpoint         string
+	S3Region           string
+	S3AccessKeyID      string
+	S3SecretKey        string
+	S3Bucket           string
+	// ObjectStoreDir is the ObjectStoreFS root; ObjectStoreSigningKey keys
+	// the HMAC signatures on its download/upload URLs and must be the same
+	// on every replica.
+	ObjectStoreDir        string
+	ObjectStoreSigningKey string
+
 	// OAuth app registration credentials for the observability integrations
 	// (issue #440): each provider's connection itself is stored in
 	// global.oauth_connections, not here — these are only the app's own
@@ -81,6 +107,11 @@ type Config struct {
 	// refuses, the webhook handler rejects all requests).
 	EmailInboundDomain string
 	EmailInboundSecret string
+
+	// Kobo screen size in portrait pixels that comic (CBZ) pages are
+	// rendered for when converted to KEPUB. Defaults to the Kobo Libra 2.
+	KoboScreenWidth  int
+	KoboScreenHeight int
 }
 
 // parser extracts environment variables and parses them to the right type.
@@ -233,6 +264,23 @@ func New(logger *slog.Logger) Config {
 	cfg.R2SecretKey = p.envSecret("R2_SECRET_ACCESS_KEY", "")
 	cfg.R2Bucket = p.envStr("R2_BUCKET", "")
 
+	cfg.ObjectStoreBackend = p.envStr("OBJECT_STORE_BACKEND", ObjectStoreR2)
+	switch cfg.ObjectStoreBackend {
+	case ObjectStoreR2, ObjectStoreS3, ObjectStoreFS:
+	default:
+		panic(fmt.Sprintf(
+			"unknown OBJECT_STORE_BACKEND '%s', expected r2, s3 or fs",
+			cfg.ObjectStoreBackend,
+		))
+	}
+	cfg.S3Endpoint = p.envStr("S3_ENDPOINT", "")
+	cfg.S3Region = p.envStr("S3_REGION", "us-east-1")
+	cfg.S3AccessKeyID = p.envSecret("S3_ACCESS_KEY_ID", "")
+	cfg.S3SecretKey = p.envSecret("S3_SECRET_ACCESS_KEY", "")
+	cfg.S3Bucket = p.envStr("S3_BUCKET", "")
+	cfg.ObjectStoreDir = p.envStr("OBJECT_STORE_DIR", "data/objects")
+	cfg.ObjectStoreSigningKey = p.envSecret("OBJECT_STORE_SIGNING_KEY", "")
+
 	cfg.GithubOAuthClientID = p.envStr("GITHUB_OAUTH_CLIENT_ID", "")
 	cfg.GithubOAuthClientSecret = p.envSecret("GITHUB_OAUTH_CLIENT_SECRET", "")
 	cfg.SentryOAuthClientID = p.envStr("SENTRY_OAUTH_CLIENT_ID", "")
@@ -248,5 +296,8 @@ func New(logger *slog.Logger) Config {
 	cfg.EmailInboundDomain = p.envStr("EMAIL_INBOUND_DOMAIN", "")
 	cfg.EmailInboundSecret = p.envSecret("EMAIL_INBOUND_SECRET", "")
 
+	cfg.KoboScreenWidth = p.envInt("KOBO_SCREEN_WIDTH", 1264)
+	cfg.KoboScreenHeight = p.envInt("KOBO_SCREEN_HEIGHT", 1680)
+
 	return cfg
 }
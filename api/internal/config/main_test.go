@@ -56,3 +56,21 @@ func TestNewHonorsOverrides(t *testing.T) {
 	require.Equal(t, 9001, cfg.Port)
 	assert.False(t, cfg.Throttle)
 }
+
+func TestNewObjectStoreBackend(t *testing.T) {
+	assert.Equal(
+		t,
+		config.ObjectStoreR2,
+		config.New(logging.NewNopLogger()).ObjectStoreBackend,
+	)
+
+	t.Setenv("OBJECT_STORE_BACKEND", "fs")
+	assert.Equal(
+		t,
+		config.ObjectStoreFS,
+		config.New(logging.NewNopLogger()).ObjectStoreBackend,
+	)
+
+	t.Setenv("OBJECT_STORE_BACKEND", "gcs")
+	assert.Panics(t, func() { config.New(logging.NewNopLogger()) })
+}
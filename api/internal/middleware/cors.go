@@ -18,11 +18,12 @@ func CORS(
 	}
 	allowedHeaders = append(allowedHeaders, extraHeaders...)
 
+	// PUT is only for uploads through the fs object store's signed URLs.
 	//nolint:exhaustruct //other fields are optional
 	c := cors.New(cors.Options{
 		AllowedOrigins:   allowedOrigins,
 		AllowCredentials: true,
-		AllowedMethods:   []string{"GET", "POST", "PATCH", "DELETE"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
 		AllowedHeaders:   allowedHeaders,
 	})
 
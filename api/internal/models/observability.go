@@ -52,7 +52,14 @@ type StorageSnapshot struct {
 	OrphanCount          int64
 	StaleUploadSizeBytes int64
 	StaleUploadCount     int64
-	PrefixBreakdown      []PrefixStat
+	// DedupSavedBytes is the space shared content-addressed blobs save: for
+	// each object, its size times the references past the first.
+	DedupSavedBytes int64
+	// ReclaimedSizeBytes/ReclaimedCount are what the scan's garbage
+	// collection deleted: orphans past their grace period and stale uploads.
+	ReclaimedSizeBytes int64
+	ReclaimedCount     int64
+	PrefixBreakdown    []PrefixStat
 }
 
 // SchemaStats is the on-disk size of one database schema.
@@ -69,7 +69,10 @@ func TestMain(m *testing.M) {
 			orphan_count BIGINT NOT NULL,
 			stale_upload_size_bytes BIGINT NOT NULL,
 			stale_upload_count BIGINT NOT NULL,
-			prefix_breakdown JSONB NOT NULL
+			prefix_breakdown JSONB NOT NULL,
+			dedup_saved_bytes BIGINT NOT NULL DEFAULT 0,
+			reclaimed_size_bytes BIGINT NOT NULL DEFAULT 0,
+			reclaimed_count BIGINT NOT NULL DEFAULT 0
 		)`,
 		// Mirrors cmd/api/migrations/00009_oauth_connections.sql,
 		// 00010_oauth_connections_config.sql, and
@@ -36,12 +36,14 @@ func (r *StorageSnapshotsRepository) Insert(
 		INSERT INTO global.storage_snapshots (
 			scanned_at, total_size_bytes, object_count,
 			orphan_size_bytes, orphan_count,
-			stale_upload_size_bytes, stale_upload_count, prefix_breakdown
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			stale_upload_size_bytes, stale_upload_count, prefix_breakdown,
+			dedup_saved_bytes, reclaimed_size_bytes, reclaimed_count
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`,
 		snap.ScannedAt, snap.TotalSizeBytes, snap.ObjectCount,
 		snap.OrphanSizeBytes, snap.OrphanCount,
 		snap.StaleUploadSizeBytes, snap.StaleUploadCount, string(breakdown),
+		snap.DedupSavedBytes, snap.ReclaimedSizeBytes, snap.ReclaimedCount,
 	)
 	if err != nil {
 		return err
@@ -60,7 +62,8 @@ func (r *StorageSnapshotsRepository) Latest(
 	snap, err := scanSnapshot(r.db.QueryRow(ctx, `
 		SELECT scanned_at, total_size_bytes, object_count,
 		       orphan_size_bytes, orphan_count,
-		       stale_upload_size_bytes, stale_upload_count, prefix_breakdown
+		       stale_upload_size_bytes, stale_upload_count, prefix_breakdown,
+		       dedup_saved_bytes, reclaimed_size_bytes, reclaimed_count
 		FROM global.storage_snapshots
 		ORDER BY scanned_at DESC
 		LIMIT 1
@@ -80,7 +83,8 @@ func (r *StorageSnapshotsRepository) History(
 	rows, err := r.db.Query(ctx, `
 		SELECT scanned_at, total_size_bytes, object_count,
 		       orphan_size_bytes, orphan_count,
-		       stale_upload_size_bytes, stale_upload_count, prefix_breakdown
+		       stale_upload_size_bytes, stale_upload_count, prefix_breakdown,
+		       dedup_saved_bytes, reclaimed_size_bytes, reclaimed_count
 		FROM global.storage_snapshots
 		WHERE scanned_at >= $1
 		ORDER BY scanned_at
@@ -116,6 +120,7 @@ func scanSnapshot(row rowScanner) (*models.StorageSnapshot, error) {
 		&snap.ScannedAt, &snap.TotalSizeBytes, &snap.ObjectCount,
 		&snap.OrphanSizeBytes, &snap.OrphanCount,
 		&snap.StaleUploadSizeBytes, &snap.StaleUploadCount, &breakdown,
+		&snap.DedupSavedBytes, &snap.ReclaimedSizeBytes, &snap.ReclaimedCount,
 	); err != nil {
 		return nil, err
 	}
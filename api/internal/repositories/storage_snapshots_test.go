@@ -29,6 +29,9 @@ func sampleSnapshot(scannedAt time.Time) models.StorageSnapshot {
 		OrphanCount:          2,
 		StaleUploadSizeBytes: 50,
 		StaleUploadCount:     1,
+		DedupSavedBytes:      300,
+		ReclaimedSizeBytes:   250,
+		ReclaimedCount:       3,
 		PrefixBreakdown: []models.PrefixStat{
 			{Prefix: "books", SizeBytes: 900, Count: 8},
 			{Prefix: "users", SizeBytes: 100, Count: 2},
@@ -51,6 +54,9 @@ func TestStorageSnapshotsInsertAndLatest(t *testing.T) {
 	require.NotNil(t, got)
 	assert.Equal(t, int64(2000), got.TotalSizeBytes)
 	assert.Equal(t, int64(2), got.OrphanCount)
+	assert.Equal(t, int64(300), got.DedupSavedBytes)
+	assert.Equal(t, int64(250), got.ReclaimedSizeBytes)
+	assert.Equal(t, int64(3), got.ReclaimedCount)
 	require.Len(t, got.PrefixBreakdown, 2)
 	assert.Equal(t, "books", got.PrefixBreakdown[0].Prefix)
 }
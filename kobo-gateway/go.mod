@@ -6,6 +6,7 @@ require (
 	github.com/getsentry/sentry-go v0.45.1
 	github.com/progrium/darwinkit v0.5.0
 	github.com/stretchr/testify v1.11.1
+	tools.xdoubleu.com/sqlitefile v0.0.0
 )
 
 require (
@@ -16,3 +17,5 @@ require (
 	golang.org/x/text v0.14.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace tools.xdoubleu.com/sqlitefile => ../sqlitefile
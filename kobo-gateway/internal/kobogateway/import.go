@@ -0,0 +1,124 @@
+package kobogateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// ImportPath is the server endpoint, relative to the device's sync URL,
+	// that accepts an uploaded History.
+	ImportPath = "/gateway/import"
+
+	importTimeout = 2 * time.Minute
+
+	// maxImportResponseSize caps the server's reply, a handful of counters.
+	maxImportResponseSize = 1 << 20
+)
+
+var errNotConfigured = errors.New(
+	"this Kobo is not configured for sync, pass syncUrl",
+)
+
+// importHandler reads the reading history off the Kobo and uploads it to
+// the server behind its sync URL. The sync URL carries the device token, so
+// the upload is authenticated exactly like the device's own sync traffic;
+// without an explicit syncUrl the endpoint the device is configured with
+// is used.
+func (s *Server) importHandler(w http.ResponseWriter, r *http.Request) {
+	var req ImportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+
+		return
+	}
+
+	kobo, status, err := s.resolveKobo(req.VolumePath)
+	if err != nil {
+		writeError(w, status, err.Error())
+
+		return
+	}
+
+	syncURL := req.SyncURL
+	if syncURL == "" {
+		syncURL = kobo.CurrentEndpoint
+	}
+	if syncURL == "" || syncURL == DefaultKoboEndpoint {
+		writeError(w, http.StatusBadRequest, errNotConfigured.Error())
+
+		return
+	}
+	if !isAbsoluteHTTPURL(syncURL) {
+		writeError(w, http.StatusBadRequest, "syncUrl must be an absolute http(s) URL")
+
+		return
+	}
+
+	history, err := ReadHistory(kobo.VolumePath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+
+		return
+	}
+
+	result, err := s.uploadHistory(r.Context(), syncURL, history)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err.Error())
+
+		return
+	}
+	result.Serial = kobo.Serial
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) uploadHistory(
+	ctx context.Context,
+	syncURL string,
+	history History,
+) (ImportResponse, error) {
+	var none ImportResponse
+
+	body, err := json.Marshal(history)
+	if err != nil {
+		return none, fmt.Errorf("could not encode reading history: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		strings.TrimRight(syncURL, "/")+ImportPath,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return none, fmt.Errorf("could not build import request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return none, fmt.Errorf("could not upload reading history: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return none, fmt.Errorf("reading history upload failed: %s", resp.Status)
+	}
+
+	var result ImportResponse
+	if err = json.NewDecoder(
+		io.LimitReader(resp.Body, maxImportResponseSize),
+	).Decode(&result); err != nil {
+		return none, fmt.Errorf("could not read import result: %w", err)
+	}
+
+	return result, nil
+}
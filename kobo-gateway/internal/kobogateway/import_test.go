@@ -0,0 +1,159 @@
+package kobogateway_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/kobo-gateway/internal/kobogateway"
+)
+
+// fakeImportServer stands in for the books server's /gateway/import and
+// records the last uploaded history.
+type fakeImportServer struct {
+	*httptest.Server
+
+	path    string
+	history kobogateway.History
+}
+
+func newFakeImportServer(t *testing.T, status int) *fakeImportServer {
+	t.Helper()
+
+	fake := &fakeImportServer{Server: nil, path: "", history: kobogateway.History{}}
+	fake.Server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fake.path = r.URL.Path
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&fake.history))
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+
+				return
+			}
+			_, _ = w.Write([]byte(`{"books":2,"matched":1,"created":1,` +
+				`"markedRead":1,"highlights":3,"sessions":2}`))
+		},
+	))
+	t.Cleanup(fake.Close)
+
+	return fake
+}
+
+func TestImport(t *testing.T) {
+	fake := newFakeImportServer(t, http.StatusOK)
+	root := t.TempDir()
+	conf := strings.Replace(
+		sampleConf, kobogateway.DefaultKoboEndpoint, fake.URL+"/books/kobo/TOKEN", 1,
+	)
+	makeKoboVolumeWithHistory(t, root, conf)
+	handler := newTestServer(root, nil).Handler()
+
+	rec := doRequest(handler, http.MethodPost, "/import", testOrigin, `{}`)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	res := decodeBody[kobogateway.ImportResponse](t, rec)
+	assert.Equal(t, kobogateway.ImportResponse{
+		Serial:     "N418ABCD1234",
+		Books:      2,
+		Matched:    1,
+		Created:    1,
+		Skipped:    0,
+		MarkedRead: 1,
+		Highlights: 3,
+		Sessions:   2,
+	}, res)
+	assert.Equal(t, "/books/kobo/TOKEN/gateway/import", fake.path)
+	assert.Equal(t, "N418ABCD1234", fake.history.Serial)
+	assert.Len(t, fake.history.Books, 2)
+}
+
+func TestImportExplicitSyncURL(t *testing.T) {
+	fake := newFakeImportServer(t, http.StatusOK)
+	root := t.TempDir()
+	makeKoboVolumeWithHistory(t, root, sampleConf)
+	handler := newTestServer(root, nil).Handler()
+
+	rec := doRequest(
+		handler,
+		http.MethodPost,
+		"/import",
+		testOrigin,
+		`{"syncUrl":"`+fake.URL+`/books/kobo/OTHER/"}`,
+	)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "/books/kobo/OTHER/gateway/import", fake.path)
+}
+
+func TestImportDeviceNotConfigured(t *testing.T) {
+	root := t.TempDir()
+	makeKoboVolumeWithHistory(t, root, sampleConf)
+	handler := newTestServer(root, nil).Handler()
+
+	rec := doRequest(handler, http.MethodPost, "/import", testOrigin, `{}`)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	res := decodeBody[kobogateway.ErrorResponse](t, rec)
+	assert.Contains(t, res.Error, "not configured for sync")
+}
+
+func TestImportInvalidSyncURL(t *testing.T) {
+	root := t.TempDir()
+	makeKoboVolumeWithHistory(t, root, sampleConf)
+	handler := newTestServer(root, nil).Handler()
+
+	rec := doRequest(
+		handler, http.MethodPost, "/import", testOrigin, `{"syncUrl":"/relative"}`,
+	)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestImportNoKobo(t *testing.T) {
+	handler := newTestServer(t.TempDir(), nil).Handler()
+
+	rec := doRequest(handler, http.MethodPost, "/import", testOrigin, `{}`)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestImportMissingDatabase(t *testing.T) {
+	root := t.TempDir()
+	makeKoboVolume(t, root, "KOBOeReader", sampleConf, "S1")
+	handler := newTestServer(root, nil).Handler()
+
+	rec := doRequest(
+		handler,
+		http.MethodPost,
+		"/import",
+		testOrigin,
+		`{"syncUrl":"https://tools.xdoubleu.com/books/kobo/TOKEN"}`,
+	)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestImportUpstreamRejects(t *testing.T) {
+	fake := newFakeImportServer(t, http.StatusUnauthorized)
+	root := t.TempDir()
+	makeKoboVolumeWithHistory(t, root, sampleConf)
+	handler := newTestServer(root, nil).Handler()
+
+	rec := doRequest(
+		handler,
+		http.MethodPost,
+		"/import",
+		testOrigin,
+		`{"syncUrl":"`+fake.URL+`/books/kobo/TOKEN"}`,
+	)
+
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+	res := decodeBody[kobogateway.ErrorResponse](t, rec)
+	assert.Contains(t, res.Error, "401")
+}
@@ -0,0 +1,483 @@
+package kobogateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"tools.xdoubleu.com/sqlitefile"
+)
+
+const (
+	koboReaderRelPath = ".kobo/KoboReader.sqlite"
+
+	// content.ContentType for a whole book; chapters use other types.
+	contentTypeBook = 6
+
+	// content.ReadStatus values.
+	readStatusReading  = 1
+	readStatusFinished = 2
+
+	// analyticsLeaveContent is logged when the reader closes a book, with
+	// the seconds read and pages turned during that visit.
+	analyticsLeaveContent = "LeaveContent"
+)
+
+// Read statuses in the upload, matching models.DeviceReadStatus on the API.
+const (
+	HistoryStatusUnread   = "unread"
+	HistoryStatusReading  = "reading"
+	HistoryStatusFinished = "finished"
+)
+
+// koboTimeLayouts covers the timestamp formats different firmware versions
+// write; all are UTC, with or without an explicit offset.
+//
+//nolint:gochecknoglobals //read-only lookup table
+var koboTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05.000-07:00",
+	"2006-01-02 15:04:05-07:00",
+	"2006-01-02 15:04:05.000",
+	"2006-01-02 15:04:05",
+}
+
+// History is the reading history read off a device, uploaded as-is to the
+// server's /gateway/import endpoint (see api/apps/books/kobo_import.go).
+type History struct {
+	Serial string        `json:"serial"`
+	Books  []HistoryBook `json:"books"`
+}
+
+// HistoryBook is one book the device has opened, highlighted or finished.
+// ContentID is the server's book UUID for books that arrived via sync and a
+// file:// path for sideloaded ones.
+type HistoryBook struct {
+	ContentID               string             `json:"contentId"`
+	Title                   string             `json:"title"`
+	Authors                 []string           `json:"authors"`
+	ISBN                    string             `json:"isbn"`
+	ReadStatus              string             `json:"readStatus"`
+	PercentRead             int                `json:"percentRead"`
+	FirstOpenedAt           *time.Time         `json:"firstOpenedAt,omitempty"`
+	LastReadAt              *time.Time         `json:"lastReadAt,omitempty"`
+	FinishedAt              *time.Time         `json:"finishedAt,omitempty"`
+	TimeSpentReadingSeconds int                `json:"timeSpentReadingSeconds"`
+	Highlights              []HistoryHighlight `json:"highlights"`
+	Sessions                []HistorySession   `json:"sessions"`
+}
+
+// HistoryHighlight is a Bookmark row: a highlight, note or dog-ear.
+type HistoryHighlight struct {
+	ID              string     `json:"id"`
+	Type            string     `json:"type"`
+	Text            string     `json:"text"`
+	Note            string     `json:"note"`
+	Color           string     `json:"color"`
+	ChapterTitle    string     `json:"chapterTitle"`
+	ChapterFilename string     `json:"chapterFilename"`
+	ChapterProgress float64    `json:"chapterProgress"`
+	StartPath       string     `json:"startPath"`
+	StartOffset     int        `json:"startOffset"`
+	EndPath         string     `json:"endPath"`
+	EndOffset       int        `json:"endOffset"`
+	CreatedAt       *time.Time `json:"createdAt,omitempty"`
+	ModifiedAt      *time.Time `json:"modifiedAt,omitempty"`
+}
+
+// HistorySession is one reading session from the device's analytics log.
+type HistorySession struct {
+	StartedAt   time.Time `json:"startedAt"`
+	EndedAt     time.Time `json:"endedAt"`
+	Seconds     int       `json:"seconds"`
+	PagesTurned int       `json:"pagesTurned"`
+}
+
+// ReadHistory reads KoboReader.sqlite on the mounted volume and returns
+// every book with some reading history. Books that were never opened are
+// left out; the server has nothing to learn from them.
+func ReadHistory(volumePath string) (History, error) {
+	// The reader sees the main database file only, not a -wal file; the
+	// device checkpoints before it exposes the volume over USB.
+	data, err := os.ReadFile(filepath.Join(volumePath, koboReaderRelPath))
+	if err != nil {
+		return History{}, fmt.Errorf("could not open KoboReader.sqlite: %w", err)
+	}
+	db, err := sqlitefile.Open(data)
+	if err != nil {
+		return History{}, fmt.Errorf("could not open KoboReader.sqlite: %w", err)
+	}
+
+	books, err := readHistory(db)
+	if err != nil {
+		return History{}, err
+	}
+
+	return History{Serial: ReadSerial(volumePath), Books: books}, nil
+}
+
+func readHistory(db *sqlitefile.DB) ([]HistoryBook, error) {
+	content, err := db.Rows("content")
+	if err != nil {
+		return nil, err
+	}
+
+	chapters := map[string]string{}
+	var books []*HistoryBook
+	byID := map[string]*HistoryBook{}
+	for _, row := range content {
+		id := rowString(row, "ContentID")
+		if rowInt(row, "ContentType") != contentTypeBook {
+			chapters[id] = rowString(row, "Title")
+
+			continue
+		}
+		book := historyBook(row)
+		books = append(books, book)
+		byID[id] = book
+	}
+
+	if err = readBookmarks(db, byID, chapters); err != nil {
+		return nil, err
+	}
+	if err = readFirstOpened(db, byID); err != nil {
+		return nil, err
+	}
+	if err = readSessions(db, byID); err != nil {
+		return nil, err
+	}
+
+	result := []HistoryBook{}
+	for _, book := range books {
+		if book.hasHistory() {
+			result = append(result, *book)
+		}
+	}
+
+	return result, nil
+}
+
+func historyBook(row sqlitefile.Row) *HistoryBook {
+	status := HistoryStatusUnread
+	switch rowInt(row, "ReadStatus") {
+	case readStatusReading:
+		status = HistoryStatusReading
+	case readStatusFinished:
+		status = HistoryStatusFinished
+	}
+
+	book := &HistoryBook{
+		ContentID:               rowString(row, "ContentID"),
+		Title:                   rowString(row, "Title"),
+		Authors:                 splitAttribution(rowString(row, "Attribution")),
+		ISBN:                    rowString(row, "ISBN"),
+		ReadStatus:              status,
+		PercentRead:             rowInt(row, "___PercentRead"),
+		FirstOpenedAt:           nil,
+		LastReadAt:              rowTime(row, "DateLastRead"),
+		FinishedAt:              nil,
+		TimeSpentReadingSeconds: max(rowInt(row, "TimeSpentReading"), 0),
+		Highlights:              []HistoryHighlight{},
+		Sessions:                []HistorySession{},
+	}
+	if status == HistoryStatusFinished {
+		book.FinishedAt = rowTime(row, "LastTimeFinishedReading")
+		if book.FinishedAt == nil {
+			book.FinishedAt = book.LastReadAt
+		}
+	}
+
+	return book
+}
+
+func (b *HistoryBook) hasHistory() bool {
+	return b.ReadStatus != HistoryStatusUnread ||
+		b.PercentRead > 0 ||
+		b.TimeSpentReadingSeconds > 0 ||
+		len(b.Highlights) > 0 ||
+		len(b.Sessions) > 0
+}
+
+func readBookmarks(
+	db *sqlitefile.DB,
+	books map[string]*HistoryBook,
+	chapters map[string]string,
+) error {
+	if !db.HasTable("Bookmark") {
+		return nil
+	}
+	rows, err := db.Rows("Bookmark")
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		book, ok := books[rowString(row, "VolumeID")]
+		if !ok || rowBool(row, "Hidden") {
+			continue
+		}
+		kind := strings.ToLower(rowString(row, "Type"))
+		switch kind {
+		case "highlight", "note", "dogear":
+		default:
+			// Handwritten "markup" has no text to carry over.
+			continue
+		}
+
+		chapterID := rowString(row, "ContentID")
+		book.Highlights = append(book.Highlights, HistoryHighlight{
+			ID:              rowString(row, "BookmarkID"),
+			Type:            kind,
+			Text:            strings.TrimSpace(rowString(row, "Text")),
+			Note:            strings.TrimSpace(rowString(row, "Annotation")),
+			Color:           "",
+			ChapterTitle:    chapterTitle(chapters, chapterID),
+			ChapterFilename: chapterFilename(book.ContentID, chapterID),
+			ChapterProgress: rowFloat(row, "ChapterProgress"),
+			StartPath:       rowString(row, "StartContainerPath"),
+			StartOffset:     rowInt(row, "StartOffset"),
+			EndPath:         rowString(row, "EndContainerPath"),
+			EndOffset:       rowInt(row, "EndOffset"),
+			CreatedAt:       rowTime(row, "DateCreated"),
+			ModifiedAt:      rowTime(row, "DateModified"),
+		})
+	}
+
+	return nil
+}
+
+// chapterTitle looks up the content row of a bookmark's chapter. KEPUBs
+// split long chapters into parts whose IDs carry a "-N" suffix, so fall back
+// to the closest shorter ID that prefixes this one.
+func chapterTitle(chapters map[string]string, chapterID string) string {
+	if title, ok := chapters[chapterID]; ok {
+		return title
+	}
+	best := ""
+	for id := range chapters {
+		if len(id) > len(best) && strings.HasPrefix(chapterID, id+"-") {
+			best = id
+		}
+	}
+
+	return chapters[best]
+}
+
+// chapterFilename strips the volume ID and the firmware's separators from a
+// chapter content ID, e.g. "<uuid>!!OEBPS!ch02.xhtml" or
+// "file:///book.kepub.epub#(3)OEBPS/ch02.xhtml", leaving "OEBPS/ch02.xhtml".
+func chapterFilename(volumeID, chapterID string) string {
+	rest := strings.TrimPrefix(chapterID, volumeID)
+	if strings.HasPrefix(rest, "#(") {
+		if _, after, ok := strings.Cut(rest, ")"); ok {
+			rest = after
+		}
+	}
+	rest = strings.TrimLeft(rest, "!#")
+
+	return strings.ReplaceAll(rest, "!", "/")
+}
+
+// readFirstOpened takes the earliest Event row of any kind per book as the
+// moment it was first opened.
+func readFirstOpened(db *sqlitefile.DB, books map[string]*HistoryBook) error {
+	if !db.HasTable("Event") {
+		return nil
+	}
+	rows, err := db.Rows("Event")
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		book, ok := books[rowString(row, "ContentID")]
+		if !ok {
+			continue
+		}
+		at := rowTime(row, "FirstOccurrence")
+		if at != nil && (book.FirstOpenedAt == nil || at.Before(*book.FirstOpenedAt)) {
+			book.FirstOpenedAt = at
+		}
+	}
+
+	return nil
+}
+
+type analyticsAttributes struct {
+	VolumeID string `json:"volumeid"`
+}
+
+type analyticsMetrics struct {
+	SecondsRead json.Number `json:"SecondsRead"`
+	PagesTurned json.Number `json:"PagesTurned"`
+}
+
+// readSessions turns LeaveContent analytics events into reading sessions.
+// The device purges this log once it uploads it to the Kobo store, so it
+// only covers reading done since the last stock sync.
+func readSessions(db *sqlitefile.DB, books map[string]*HistoryBook) error {
+	if !db.HasTable("AnalyticsEvents") {
+		return nil
+	}
+	rows, err := db.Rows("AnalyticsEvents")
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if rowString(row, "Type") != analyticsLeaveContent {
+			continue
+		}
+		var attrs analyticsAttributes
+		var metrics analyticsMetrics
+		if json.Unmarshal([]byte(rowString(row, "Attributes")), &attrs) != nil ||
+			json.Unmarshal([]byte(rowString(row, "Metrics")), &metrics) != nil {
+			continue
+		}
+		book, ok := books[attrs.VolumeID]
+		ended := rowTime(row, "Timestamp")
+		seconds := jsonInt(metrics.SecondsRead)
+		if !ok || ended == nil || seconds <= 0 {
+			continue
+		}
+
+		book.Sessions = append(book.Sessions, HistorySession{
+			StartedAt:   ended.Add(-time.Duration(seconds) * time.Second),
+			EndedAt:     *ended,
+			Seconds:     seconds,
+			PagesTurned: jsonInt(metrics.PagesTurned),
+		})
+	}
+
+	for _, book := range books {
+		sort.Slice(book.Sessions, func(i, j int) bool {
+			return book.Sessions[i].StartedAt.Before(book.Sessions[j].StartedAt)
+		})
+	}
+
+	return nil
+}
+
+// splitAttribution splits the content.Attribution column, which joins
+// multiple authors with commas or ampersands.
+func splitAttribution(attribution string) []string {
+	authors := []string{}
+	for _, part := range strings.FieldsFunc(attribution, func(r rune) bool {
+		return r == ',' || r == '&'
+	}) {
+		if name := strings.TrimSpace(part); name != "" {
+			authors = append(authors, name)
+		}
+	}
+
+	return authors
+}
+
+func rowString(row sqlitefile.Row, col string) string {
+	switch v := row[col].(type) {
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []byte:
+		return string(v)
+	default:
+		return ""
+	}
+}
+
+// rowInt reads an integer column. Kobo declares several numeric columns as
+// TEXT (ContentType among them), so numeric strings are accepted too.
+func rowInt(row sqlitefile.Row, col string) int {
+	switch v := row[col].(type) {
+	case int64:
+		return int(v)
+	case float64:
+		return int(math.Round(v))
+	case string:
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return 0
+		}
+
+		return n
+	default:
+		return 0
+	}
+}
+
+// rowBool reads a BOOL column, which Kobo fills with "true"/"false".
+func rowBool(row sqlitefile.Row, col string) bool {
+	if s, ok := row[col].(string); ok {
+		return strings.EqualFold(s, "true")
+	}
+
+	return rowInt(row, col) != 0
+}
+
+func rowFloat(row sqlitefile.Row, col string) float64 {
+	switch v := row[col].(type) {
+	case float64:
+		return v
+	case int64:
+		return float64(v)
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0
+		}
+
+		return f
+	default:
+		return 0
+	}
+}
+
+func rowTime(row sqlitefile.Row, col string) *time.Time {
+	t, err := parseKoboTime(rowString(row, col))
+	if err != nil {
+		return nil
+	}
+
+	return &t
+}
+
+func parseKoboTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, errors.New("empty timestamp")
+	}
+	for _, layout := range koboTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognised timestamp %q", s)
+}
+
+// jsonInt reads a metric that firmware writes either as a number or as a
+// numeric string.
+func jsonInt(n json.Number) int {
+	s := n.String()
+	if i, err := strconv.Atoi(s); err == nil {
+		return i
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+
+	return int(math.Round(f))
+}
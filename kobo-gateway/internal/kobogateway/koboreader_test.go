@@ -0,0 +1,175 @@
+package kobogateway_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/kobo-gateway/internal/kobogateway"
+	"tools.xdoubleu.com/sqlitefile"
+)
+
+const syncedContentID = "0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01"
+
+// makeKoboVolumeWithHistory creates a fake Kobo whose KoboReader.sqlite is
+// testdata/KoboReader.sqlite (built from testdata/koboreader.sql).
+func makeKoboVolumeWithHistory(t *testing.T, root, conf string) string {
+	t.Helper()
+
+	volumePath := makeKoboVolume(t, root, "KOBOeReader", conf, "N418ABCD1234")
+	data, err := os.ReadFile(filepath.Join("testdata", "KoboReader.sqlite"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(
+		filepath.Join(volumePath, ".kobo", "KoboReader.sqlite"),
+		data,
+		0o644,
+	))
+
+	return volumePath
+}
+
+func findHistoryBook(
+	t *testing.T,
+	history kobogateway.History,
+	contentID string,
+) kobogateway.HistoryBook {
+	t.Helper()
+
+	for _, book := range history.Books {
+		if book.ContentID == contentID {
+			return book
+		}
+	}
+	require.Failf(t, "book not found", "content ID %s", contentID)
+
+	return kobogateway.HistoryBook{}
+}
+
+func utc(value string) time.Time {
+	t, _ := time.Parse(time.RFC3339, value)
+
+	return t
+}
+
+func TestReadHistorySkipsUnopenedBooks(t *testing.T) {
+	volumePath := makeKoboVolumeWithHistory(t, t.TempDir(), sampleConf)
+
+	history, err := kobogateway.ReadHistory(volumePath)
+
+	require.NoError(t, err)
+	assert.Equal(t, "N418ABCD1234", history.Serial)
+	require.Len(t, history.Books, 2)
+	for _, book := range history.Books {
+		assert.NotEqual(t, "Never Opened", book.Title)
+	}
+}
+
+func TestReadHistorySyncedBook(t *testing.T) {
+	volumePath := makeKoboVolumeWithHistory(t, t.TempDir(), sampleConf)
+
+	history, err := kobogateway.ReadHistory(volumePath)
+	require.NoError(t, err)
+	book := findHistoryBook(t, history, syncedContentID)
+
+	assert.Equal(t, "Synced Book", book.Title)
+	assert.Equal(t, []string{"Ann Author", "Bob Writer"}, book.Authors)
+	assert.Equal(t, "9780141036144", book.ISBN)
+	assert.Equal(t, kobogateway.HistoryStatusReading, book.ReadStatus)
+	assert.Equal(t, 37, book.PercentRead)
+	assert.Equal(t, 5400, book.TimeSpentReadingSeconds)
+	require.NotNil(t, book.FirstOpenedAt)
+	assert.Equal(t, utc("2025-01-02T18:00:00Z"), *book.FirstOpenedAt)
+	require.NotNil(t, book.LastReadAt)
+	assert.Equal(t, utc("2025-01-05T21:30:00Z"), *book.LastReadAt)
+	assert.Nil(t, book.FinishedAt)
+
+	// The hidden (deleted) highlight is left out.
+	require.Len(t, book.Highlights, 2)
+	highlight := book.Highlights[0]
+	assert.Equal(t, "0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f7e01", highlight.ID)
+	assert.Equal(t, "highlight", highlight.Type)
+	assert.Equal(t, "A sentence worth keeping.", highlight.Text)
+	assert.Equal(t, "Chapter 2", highlight.ChapterTitle)
+	assert.Equal(t, "OEBPS/ch02.xhtml-1", highlight.ChapterFilename)
+	assert.InDelta(t, 0.25, highlight.ChapterProgress, 1e-9)
+	assert.Equal(t, `span#kobo\.4\.1`, highlight.StartPath)
+	assert.Equal(t, 25, highlight.EndOffset)
+	require.NotNil(t, highlight.CreatedAt)
+	assert.Equal(t, utc("2025-01-04T20:01:00Z"), *highlight.CreatedAt)
+
+	// Long enough to be stored on overflow pages.
+	note := book.Highlights[1]
+	assert.Equal(t, "note", note.Type)
+	assert.Equal(t, "Worth rereading", note.Note)
+	assert.True(t, strings.HasPrefix(note.Text, "The long passage: word word"))
+	assert.Len(t, note.Text, len("The long passage: ")+2000*len("word ")-1)
+
+	require.Len(t, book.Sessions, 2)
+	assert.Equal(t, kobogateway.HistorySession{
+		StartedAt:   utc("2025-01-04T20:00:00Z"),
+		EndedAt:     utc("2025-01-04T20:30:00Z"),
+		Seconds:     1800,
+		PagesTurned: 22,
+	}, book.Sessions[0])
+	assert.Equal(t, 14, book.Sessions[1].PagesTurned)
+}
+
+func TestReadHistorySideloadedBook(t *testing.T) {
+	volumePath := makeKoboVolumeWithHistory(t, t.TempDir(), sampleConf)
+
+	history, err := kobogateway.ReadHistory(volumePath)
+	require.NoError(t, err)
+	book := findHistoryBook(
+		t, history, "file:///mnt/onboard/Books/sideloaded.kepub.epub",
+	)
+
+	assert.Equal(t, kobogateway.HistoryStatusFinished, book.ReadStatus)
+	assert.Equal(t, []string{"Side Loader"}, book.Authors)
+	require.NotNil(t, book.FinishedAt)
+	assert.Equal(t, utc("2024-11-20T22:00:00Z"), *book.FinishedAt)
+	assert.Empty(t, book.Sessions)
+
+	// The handwritten markup bookmark has no text and is skipped.
+	require.Len(t, book.Highlights, 1)
+	assert.Equal(t, "dogear", book.Highlights[0].Type)
+	assert.Equal(t, "Part Four", book.Highlights[0].ChapterTitle)
+	assert.Equal(t, "OEBPS/text/part4.xhtml", book.Highlights[0].ChapterFilename)
+}
+
+func TestReadHistoryMissingDatabase(t *testing.T) {
+	volumePath := makeKoboVolume(t, t.TempDir(), "KOBOeReader", sampleConf, "")
+
+	_, err := kobogateway.ReadHistory(volumePath)
+
+	assert.Error(t, err)
+}
+
+func TestReadHistoryNotSQLite(t *testing.T) {
+	volumePath := makeKoboVolume(t, t.TempDir(), "KOBOeReader", sampleConf, "")
+	require.NoError(t, os.WriteFile(
+		filepath.Join(volumePath, ".kobo", "KoboReader.sqlite"),
+		[]byte(strings.Repeat("not a database ", 10)),
+		0o644,
+	))
+
+	_, err := kobogateway.ReadHistory(volumePath)
+
+	assert.ErrorIs(t, err, sqlitefile.ErrNotSQLite)
+}
+
+func TestReadHistoryTruncatedDatabase(t *testing.T) {
+	volumePath := makeKoboVolumeWithHistory(t, t.TempDir(), sampleConf)
+	path := filepath.Join(volumePath, ".kobo", "KoboReader.sqlite")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data[:len(data)/3], 0o644))
+
+	_, err = kobogateway.ReadHistory(volumePath)
+
+	assert.Error(t, err)
+}
@@ -14,7 +14,7 @@ const (
 	// GatewayVersion is the protocol version reported by /status. Bump it
 	// whenever the gateway's HTTP API or file handling changes so the web
 	// UI can trigger a self-update; routine releases don't bump it.
-	GatewayVersion = 2
+	GatewayVersion = 3
 
 	// DefaultPort is the fixed port the web UI probes for a running gateway.
 	DefaultPort = 41132
@@ -55,6 +55,7 @@ type Config struct {
 type Server struct {
 	cfg     Config
 	updater UpdateRunner
+	client  *http.Client
 	restart chan struct{}
 	notify  func(title, body string)
 }
@@ -64,6 +65,7 @@ func NewServer(cfg Config, updater UpdateRunner) *Server {
 	return &Server{
 		cfg:     cfg,
 		updater: updater,
+		client:  &http.Client{Timeout: importTimeout},
 		restart: make(chan struct{}, 1),
 		notify:  func(string, string) {},
 	}
@@ -95,6 +97,7 @@ func (s *Server) Handler() http.Handler {
 	mux.HandleFunc("POST /configure", s.configureHandler)
 	mux.HandleFunc("POST /revert", s.revertHandler)
 	mux.HandleFunc("POST /update", s.updateHandler)
+	mux.HandleFunc("POST /import", s.importHandler)
 
 	return s.secure(mux)
 }
@@ -0,0 +1,143 @@
+-- Trimmed-down KoboReader.sqlite schema with the columns kobo-gateway reads.
+-- Regenerate the binary fixture with:
+--   rm -f KoboReader.sqlite && sqlite3 KoboReader.sqlite < koboreader.sql
+-- A small page size plus a few hundred chapter rows give the content table
+-- interior b-tree pages, and the long highlight spills onto overflow pages.
+PRAGMA page_size = 1024;
+
+CREATE TABLE content (
+    ContentID TEXT NOT NULL,
+    ContentType TEXT NOT NULL,
+    MimeType TEXT NOT NULL,
+    BookID TEXT,
+    BookTitle TEXT,
+    Title TEXT,
+    Attribution TEXT,
+    ISBN TEXT,
+    DateLastRead TEXT,
+    ReadStatus INT,
+    ___PercentRead INTEGER,
+    TimeSpentReading INTEGER DEFAULT 0,
+    LastTimeFinishedReading TEXT,
+    PRIMARY KEY (ContentID)
+);
+
+CREATE TABLE Bookmark (
+    BookmarkID TEXT NOT NULL,
+    VolumeID TEXT NOT NULL,
+    ContentID TEXT NOT NULL,
+    StartContainerPath TEXT NOT NULL,
+    StartContainerChildIndex INTEGER NOT NULL,
+    StartOffset INTEGER NOT NULL,
+    EndContainerPath TEXT NOT NULL,
+    EndContainerChildIndex INTEGER NOT NULL,
+    EndOffset INTEGER NOT NULL,
+    Text TEXT,
+    Annotation TEXT,
+    ChapterProgress REAL NOT NULL DEFAULT 0,
+    Hidden BOOL NOT NULL DEFAULT 0,
+    DateCreated TEXT,
+    DateModified TEXT,
+    Type TEXT,
+    PRIMARY KEY (BookmarkID)
+);
+
+CREATE TABLE Event (
+    EventType INTEGER NOT NULL,
+    FirstOccurrence TEXT,
+    LastOccurrence TEXT,
+    EventCount INTEGER DEFAULT 0,
+    ContentID TEXT NOT NULL,
+    PRIMARY KEY (EventType, ContentID)
+);
+
+CREATE TABLE AnalyticsEvents (
+    Id TEXT NOT NULL,
+    Type TEXT NOT NULL,
+    Timestamp TEXT NOT NULL,
+    Attributes TEXT,
+    Metrics TEXT,
+    PRIMARY KEY (Id)
+);
+
+-- A book synced from the server (content ID is its UUID), a sideloaded
+-- book marked finished, and a sideloaded book that was never opened.
+INSERT INTO content VALUES
+    ('0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01', '6', 'application/x-kobo-epub+zip',
+     NULL, NULL, 'Synced Book', 'Ann Author, Bob Writer', '9780141036144',
+     '2025-01-05T21:30:00Z', 1, 37, 5400, NULL),
+    ('file:///mnt/onboard/Books/sideloaded.kepub.epub', '6',
+     'application/x-kobo-epub+zip', NULL, NULL, 'Sideloaded Novel',
+     'Side Loader', NULL, '2024-11-20T22:00:00.000', 2, 100, 0,
+     '2024-11-20 22:00:00.000+00:00'),
+    ('file:///mnt/onboard/Books/never-opened.epub', '6', 'application/epub+zip',
+     NULL, NULL, 'Never Opened', 'Nobody', NULL, NULL, 0, 0, 0, NULL);
+
+INSERT INTO content VALUES
+    ('0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01!!OEBPS!ch02.xhtml', '9',
+     'application/xhtml+xml', '0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01',
+     'Synced Book', 'Chapter 2', NULL, NULL, NULL, 0, 0, 0, NULL),
+    ('file:///mnt/onboard/Books/sideloaded.kepub.epub#(4)OEBPS/text/part4.xhtml',
+     '899', 'application/xhtml+xml',
+     'file:///mnt/onboard/Books/sideloaded.kepub.epub', 'Sideloaded Novel',
+     'Part Four', NULL, NULL, NULL, 0, 0, 0, NULL);
+
+WITH RECURSIVE n(i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < 300)
+INSERT INTO content
+SELECT 'file:///mnt/onboard/Books/filler.epub#(' || i || ')chapter' || i || '.xhtml',
+       '899', 'application/xhtml+xml', 'file:///mnt/onboard/Books/filler.epub',
+       'Filler', 'Filler chapter ' || i, NULL, NULL, NULL, 0, 0, 0, NULL
+FROM n;
+
+INSERT INTO Bookmark VALUES
+    ('0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f7e01',
+     '0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01',
+     '0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01!!OEBPS!ch02.xhtml-1',
+     'span#kobo\.4\.1', 0, 0, 'span#kobo\.4\.1', 0, 25,
+     '  A sentence worth keeping.  ', NULL, 0.25, 'false',
+     '2025-01-04T20:01:00.000', '2025-01-04T20:01:00.000', 'highlight'),
+    ('0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f7e02',
+     '0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01',
+     '0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01!!OEBPS!ch02.xhtml',
+     'span#kobo\.9\.1', 0, 4, 'span#kobo\.12\.3', 0, 10,
+     'The long passage: ' || replace(printf('%.2000c', 'x'), 'x', 'word '),
+     'Worth rereading', 0.5, 0,
+     '2025-01-04T20:10:00Z', '2025-01-04T20:12:00Z', 'note'),
+    ('0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f7e03',
+     '0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01',
+     '0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01!!OEBPS!ch02.xhtml',
+     'span#kobo\.20\.1', 0, 0, 'span#kobo\.20\.1', 0, 5, 'Deleted', NULL,
+     0.6, 'true', '2025-01-04T20:20:00Z', NULL, 'highlight'),
+    ('0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f7e04',
+     'file:///mnt/onboard/Books/sideloaded.kepub.epub',
+     'file:///mnt/onboard/Books/sideloaded.kepub.epub#(4)OEBPS/text/part4.xhtml',
+     'span#kobo\.1\.1', 0, 0, 'span#kobo\.1\.1', 0, 0, NULL, NULL,
+     0.1, 0, '2024-11-19 21:00:00.000', NULL, 'dogear'),
+    ('0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f7e05',
+     'file:///mnt/onboard/Books/sideloaded.kepub.epub',
+     'file:///mnt/onboard/Books/sideloaded.kepub.epub#(4)OEBPS/text/part4.xhtml',
+     '', 0, 0, '', 0, 0, NULL, NULL, 0.2, 0, '2024-11-19 21:05:00.000', NULL,
+     'markup');
+
+INSERT INTO Event VALUES
+    (3, '2025-01-03T18:00:00.000', '2025-01-05T21:00:00.000', 4,
+     '0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01'),
+    (46, '2025-01-02T18:00:00.000', '2025-01-05T21:30:00.000', 9,
+     '0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01');
+
+INSERT INTO AnalyticsEvents VALUES
+    ('a1', 'LeaveContent', '2025-01-05T21:30:00.000+00:00',
+     '{"volumeid":"0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01","progress":"37"}',
+     '{"SecondsRead":1800,"PagesTurned":"14"}'),
+    ('a2', 'LeaveContent', '2025-01-04T20:30:00.000+00:00',
+     '{"volumeid":"0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01"}',
+     '{"SecondsRead":"1800","PagesTurned":"22"}'),
+    ('a3', 'OpenContent', '2025-01-04T20:00:00.000+00:00',
+     '{"volumeid":"0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01"}', '{}'),
+    ('a4', 'LeaveContent', '2025-01-04T20:31:00.000+00:00',
+     '{"volumeid":"0b6f3a5e-1c2d-4e5f-8a9b-0c1d2e3f4a01"}',
+     '{"SecondsRead":"0"}');
+
+-- Firmware updates add columns with ALTER TABLE; rows written before that
+-- have fewer values in their records than the schema has columns.
+ALTER TABLE content ADD COLUMN Series TEXT;
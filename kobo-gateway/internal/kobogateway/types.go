@@ -36,6 +36,28 @@ type UpdateResponse struct {
 	Updating bool `json:"updating"`
 }
 
+// ImportRequest is the POST /import payload. SyncURL defaults to the
+// endpoint the Kobo is currently configured with.
+type ImportRequest struct {
+	SyncURL    string `json:"syncUrl"`
+	VolumePath string `json:"volumePath"`
+}
+
+// ImportResponse is the POST /import result: the server's tally of what it
+// did with the uploaded history.
+type ImportResponse struct {
+	Serial     string `json:"serial"`
+	Books      int    `json:"books"`
+	Matched    int    `json:"matched"`
+	Created    int    `json:"created"`
+	Skipped    int    `json:"skipped"`
+	MarkedRead int    `json:"markedRead"`
+	Highlights int    `json:"highlights"`
+	Sessions   int    `json:"sessions"`
+
+	SkippedHighlights int `json:"skippedHighlights"`
+}
+
 // ErrorResponse is the uniform error payload of every endpoint.
 type ErrorResponse struct {
 	Error string `json:"error"`
@@ -0,0 +1,474 @@
+// Package sqlitefile reads table rows straight out of an SQLite database
+// file held in memory. It exists so the api can read a Calibre metadata.db
+// and kobo-gateway a KoboReader.sqlite without a cgo SQLite driver, which
+// would dwarf everything else in either binary. It only walks rowid table
+// b-trees, never writes, and ignores indexes, views and any pending
+// write-ahead log.
+// Format reference: https://www.sqlite.org/fileformat2.html
+package sqlitefile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+const (
+	headerSize      = 100
+	maxPageSize     = 65536
+	encodingUTF8    = 1
+	pageTableLeaf   = 0x0d
+	pageTableInner  = 0x05
+	leafHeaderSize  = 8
+	innerHeaderSize = 12
+	maxTreeDepth    = 64
+)
+
+// magic starts every SQLite 3 database file.
+var magic = []byte("SQLite format 3\x00") //nolint:gochecknoglobals //constant bytes
+
+// ErrNotSQLite is returned by Open for data that isn't an SQLite 3 database.
+var ErrNotSQLite = errors.New("not an SQLite 3 database")
+
+// ErrCorrupt is returned when the file structure doesn't hold together.
+var ErrCorrupt = errors.New("corrupt SQLite database")
+
+// Row is one table row keyed by column name. Values are int64, float64,
+// string, []byte or nil.
+type Row map[string]any
+
+// DB is an opened database file.
+type DB struct {
+	data       []byte
+	pageSize   int
+	usableSize int
+	tables     map[string]table
+}
+
+type table struct {
+	rootPage int
+	columns  []string
+	// rowidColumn is the INTEGER PRIMARY KEY column, whose value SQLite keeps
+	// as the rowid rather than in the record. -1 when there is none.
+	rowidColumn int
+}
+
+// IsSQLite reports whether data starts with the SQLite 3 file header.
+func IsSQLite(data []byte) bool {
+	return bytes.HasPrefix(data, magic)
+}
+
+// Open parses the file header and schema of an SQLite database.
+func Open(data []byte) (*DB, error) {
+	if !IsSQLite(data) || len(data) < headerSize {
+		return nil, ErrNotSQLite
+	}
+
+	pageSize := int(binary.BigEndian.Uint16(data[16:18]))
+	if pageSize == 1 {
+		pageSize = maxPageSize
+	}
+	if pageSize < 512 || pageSize&(pageSize-1) != 0 {
+		return nil, fmt.Errorf("%w: page size %d", ErrCorrupt, pageSize)
+	}
+	if enc := binary.BigEndian.Uint32(data[56:60]); enc != encodingUTF8 && enc != 0 {
+		return nil, fmt.Errorf("unsupported text encoding %d", enc)
+	}
+
+	db := &DB{
+		data:       data,
+		pageSize:   pageSize,
+		usableSize: pageSize - int(data[20]),
+		tables:     map[string]table{},
+	}
+	if err := db.readSchema(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// HasTable reports whether the database has a table called name.
+func (db *DB) HasTable(name string) bool {
+	_, ok := db.tables[strings.ToLower(name)]
+	return ok
+}
+
+// Columns returns a table's column names in declaration order.
+func (db *DB) Columns(name string) ([]string, error) {
+	t, ok := db.tables[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("no such table: %s", name)
+	}
+	return t.columns, nil
+}
+
+// Rows returns every row of a table in rowid order.
+func (db *DB) Rows(name string) ([]Row, error) {
+	t, ok := db.tables[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("no such table: %s", name)
+	}
+
+	var rows []Row
+	err := db.walkTree(t.rootPage, func(rowid int64, values []any) {
+		row := make(Row, len(t.columns))
+		for i, col := range t.columns {
+			switch {
+			case i == t.rowidColumn:
+				row[col] = rowid
+			case i < len(values):
+				row[col] = values[i]
+			default:
+				// Added by ALTER TABLE after this row was written.
+				row[col] = nil
+			}
+		}
+		rows = append(rows, row)
+	})
+	return rows, err
+}
+
+func (db *DB) readSchema() error {
+	// sqlite_schema is always rooted at page 1.
+	var defs [][]any
+	err := db.walkTree(1, func(_ int64, values []any) {
+		defs = append(defs, values)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, def := range defs {
+		if len(def) < 5 || def[0] != "table" {
+			continue
+		}
+		name, _ := def[1].(string)
+		root, _ := def[3].(int64)
+		sql, _ := def[4].(string)
+		if name == "" || root <= 0 {
+			continue
+		}
+		columns, rowidColumn := parseColumns(sql)
+		db.tables[strings.ToLower(name)] = table{
+			rootPage:    int(root),
+			columns:     columns,
+			rowidColumn: rowidColumn,
+		}
+	}
+	return nil
+}
+
+// page returns the bytes of a 1-based page number.
+func (db *DB) page(n int) ([]byte, error) {
+	start := (n - 1) * db.pageSize
+	if n < 1 || start+db.pageSize > len(db.data) {
+		return nil, fmt.Errorf("%w: page %d out of range", ErrCorrupt, n)
+	}
+	return db.data[start : start+db.pageSize], nil
+}
+
+// walkTree visits every cell of the table b-tree rooted at pageNum.
+func (db *DB) walkTree(pageNum int, visit func(int64, []any)) error {
+	return db.walk(pageNum, 0, map[int]bool{}, visit)
+}
+
+// walk visits the cells under pageNum. A page reached twice means the tree
+// is corrupt: without the seen set, interior pages pointing at each other's
+// children would fan out exponentially even within maxTreeDepth.
+func (db *DB) walk(
+	pageNum, depth int,
+	seen map[int]bool,
+	visit func(int64, []any),
+) error {
+	if depth > maxTreeDepth {
+		return fmt.Errorf("%w: b-tree too deep", ErrCorrupt)
+	}
+	if seen[pageNum] {
+		return fmt.Errorf("%w: page %d reached twice", ErrCorrupt, pageNum)
+	}
+	seen[pageNum] = true
+	page, err := db.page(pageNum)
+	if err != nil {
+		return err
+	}
+	hdr := 0
+	if pageNum == 1 {
+		hdr = headerSize
+	}
+	if len(page) < hdr+innerHeaderSize {
+		return fmt.Errorf("%w: short page %d", ErrCorrupt, pageNum)
+	}
+
+	kind := page[hdr]
+	numCells := int(binary.BigEndian.Uint16(page[hdr+3 : hdr+5]))
+
+	switch kind {
+	case pageTableLeaf:
+		return db.walkLeaf(page, hdr+leafHeaderSize, numCells, visit)
+	case pageTableInner:
+		ptrs := hdr + innerHeaderSize
+		if ptrs+2*numCells > len(page) {
+			return fmt.Errorf("%w: cell pointers past page end", ErrCorrupt)
+		}
+		for i := range numCells {
+			off := int(binary.BigEndian.Uint16(page[ptrs+2*i:]))
+			if off+4 > len(page) {
+				return fmt.Errorf("%w: cell past page end", ErrCorrupt)
+			}
+			child := int(binary.BigEndian.Uint32(page[off:]))
+			if err = db.walk(child, depth+1, seen, visit); err != nil {
+				return err
+			}
+		}
+		right := int(binary.BigEndian.Uint32(page[hdr+8:]))
+		return db.walk(right, depth+1, seen, visit)
+	default:
+		return fmt.Errorf("%w: page %d is not a table page", ErrCorrupt, pageNum)
+	}
+}
+
+func (db *DB) walkLeaf(
+	page []byte,
+	ptrs, numCells int,
+	visit func(int64, []any),
+) error {
+	if ptrs+2*numCells > len(page) {
+		return fmt.Errorf("%w: cell pointers past page end", ErrCorrupt)
+	}
+	for i := range numCells {
+		off := int(binary.BigEndian.Uint16(page[ptrs+2*i:]))
+		if off >= len(page) {
+			return fmt.Errorf("%w: cell past page end", ErrCorrupt)
+		}
+		cell := page[off:]
+
+		// The size is checked against the file before anything is
+		// allocated for it: no record can be larger than the file.
+		size, n := readVarint(cell)
+		if n == 0 || size < 0 || size > int64(len(db.data)) {
+			return fmt.Errorf("%w: bad payload size", ErrCorrupt)
+		}
+		cell = cell[n:]
+		rowid, n := readVarint(cell)
+		if n == 0 {
+			return fmt.Errorf("%w: bad rowid", ErrCorrupt)
+		}
+		cell = cell[n:]
+
+		payload, err := db.payload(cell, int(size))
+		if err != nil {
+			return err
+		}
+		values, err := decodeRecord(payload)
+		if err != nil {
+			return err
+		}
+		visit(rowid, values)
+	}
+	return nil
+}
+
+// payload assembles a cell's record, following its overflow chain when the
+// record doesn't fit on the page.
+func (db *DB) payload(cell []byte, size int) ([]byte, error) {
+	maxLocal := db.usableSize - 35
+	if size <= maxLocal {
+		if size > len(cell) {
+			return nil, fmt.Errorf("%w: payload past page end", ErrCorrupt)
+		}
+		return cell[:size], nil
+	}
+
+	minLocal := (db.usableSize-12)*32/255 - 23
+	local := minLocal + (size-minLocal)%(db.usableSize-4)
+	if local > maxLocal {
+		local = minLocal
+	}
+	if local+4 > len(cell) {
+		return nil, fmt.Errorf("%w: payload past page end", ErrCorrupt)
+	}
+	// Every other page of the file could at most be one overflow page.
+	overflowPages := len(db.data)/db.pageSize - 1
+	if size-local > overflowPages*(db.usableSize-4) {
+		return nil, fmt.Errorf("%w: payload larger than its overflow chain", ErrCorrupt)
+	}
+
+	out := make([]byte, 0, size)
+	out = append(out, cell[:local]...)
+	next := int(binary.BigEndian.Uint32(cell[local:]))
+	for hops := 0; len(out) < size; hops++ {
+		if next == 0 || hops > len(db.data)/db.pageSize {
+			return nil, fmt.Errorf("%w: broken overflow chain", ErrCorrupt)
+		}
+		page, err := db.page(next)
+		if err != nil {
+			return nil, err
+		}
+		chunk := page[4:db.usableSize]
+		if remaining := size - len(out); len(chunk) > remaining {
+			chunk = chunk[:remaining]
+		}
+		out = append(out, chunk...)
+		next = int(binary.BigEndian.Uint32(page))
+	}
+	return out, nil
+}
+
+func decodeRecord(rec []byte) ([]any, error) {
+	hdrSize, n := readVarint(rec)
+	if n == 0 || hdrSize < int64(n) || hdrSize > int64(len(rec)) {
+		return nil, fmt.Errorf("%w: bad record header", ErrCorrupt)
+	}
+
+	var types []int64
+	for pos := n; pos < int(hdrSize); {
+		t, m := readVarint(rec[pos:int(hdrSize)])
+		if m == 0 {
+			return nil, fmt.Errorf("%w: bad serial type", ErrCorrupt)
+		}
+		types = append(types, t)
+		pos += m
+	}
+
+	body := rec[hdrSize:]
+	values := make([]any, len(types))
+	for i, t := range types {
+		size := serialSize(t)
+		if size < 0 || size > len(body) {
+			return nil, fmt.Errorf("%w: value past record end", ErrCorrupt)
+		}
+		values[i] = decodeValue(t, body[:size])
+		body = body[size:]
+	}
+	return values, nil
+}
+
+func serialSize(t int64) int {
+	switch {
+	case t >= 12:
+		return int((t - 12) / 2)
+	case t >= 1 && t <= 4:
+		return int(t)
+	case t == 5:
+		return 6
+	case t == 6 || t == 7:
+		return 8
+	case t == 0 || t == 8 || t == 9:
+		return 0
+	default:
+		return -1
+	}
+}
+
+func decodeValue(t int64, b []byte) any {
+	switch {
+	case t == 0:
+		return nil
+	case t == 8:
+		return int64(0)
+	case t == 9:
+		return int64(1)
+	case t == 7:
+		return math.Float64frombits(binary.BigEndian.Uint64(b))
+	case t >= 12 && t%2 == 0:
+		return bytes.Clone(b)
+	case t >= 13:
+		return string(b)
+	default:
+		// Big-endian two's-complement integer of 1–8 bytes.
+		var v int64
+		if b[0]&0x80 != 0 {
+			v = -1
+		}
+		for _, c := range b {
+			v = v<<8 | int64(c)
+		}
+		return v
+	}
+}
+
+// readVarint decodes an SQLite varint, returning the value and the number of
+// bytes read (0 when b is too short).
+func readVarint(b []byte) (int64, int) {
+	var v uint64
+	for i := range 9 {
+		if i >= len(b) {
+			return 0, 0
+		}
+		if i == 8 {
+			v = v<<8 | uint64(b[i])
+			return int64(v), 9 //nolint:gosec // varints are stored two's complement
+		}
+		v = v<<7 | uint64(b[i]&0x7f)
+		if b[i]&0x80 == 0 {
+			return int64(v), i + 1 //nolint:gosec // at most 56 bits here
+		}
+	}
+	return 0, 0
+}
+
+// parseColumns pulls the column names out of a CREATE TABLE statement, and
+// which of them (if any) is the rowid alias.
+func parseColumns(sql string) ([]string, int) {
+	open := strings.IndexByte(sql, '(')
+	closeParen := strings.LastIndexByte(sql, ')')
+	if open < 0 || closeParen <= open {
+		return nil, -1
+	}
+
+	var columns []string
+	rowidColumn := -1
+	for _, def := range splitTopLevel(sql[open+1 : closeParen]) {
+		fields := strings.Fields(def)
+		if len(fields) == 0 {
+			continue
+		}
+		keyword, _, _ := strings.Cut(strings.ToUpper(fields[0]), "(")
+		switch keyword {
+		case "CONSTRAINT", "PRIMARY", "UNIQUE", "CHECK", "FOREIGN":
+			continue
+		}
+		name := strings.Trim(fields[0], "\"`[]'")
+		upper := strings.ToUpper(def)
+		if len(fields) > 1 && strings.ToUpper(fields[1]) == "INTEGER" &&
+			strings.Contains(upper, "PRIMARY KEY") {
+			rowidColumn = len(columns)
+		}
+		columns = append(columns, name)
+	}
+	return columns, rowidColumn
+}
+
+// splitTopLevel splits a column list on commas outside parentheses and
+// quotes.
+func splitTopLevel(s string) []string {
+	var (
+		parts []string
+		depth int
+		quote rune
+		start int
+	)
+	for i, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == '[':
+			quote = ']'
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == ',' && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
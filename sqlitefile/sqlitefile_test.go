@@ -0,0 +1,162 @@
+package sqlitefile_test
+
+import (
+	"encoding/binary"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tools.xdoubleu.com/sqlitefile"
+)
+
+// testdata/sample.db was written by SQLite itself with a 512-byte page
+// size, so its 202 rows span interior pages and the 3000-character name
+// needs an overflow chain. The extra column was added with ALTER TABLE after
+// the first rows were inserted.
+func openSample(t *testing.T) *sqlitefile.DB {
+	t.Helper()
+	data, err := os.ReadFile("testdata/sample.db")
+	require.NoError(t, err)
+	db, err := sqlitefile.Open(data)
+	require.NoError(t, err)
+	return db
+}
+
+func TestOpen_RejectsOtherFiles(t *testing.T) {
+	_, err := sqlitefile.Open([]byte("Title,Author\nDune,Frank Herbert\n"))
+	require.ErrorIs(t, err, sqlitefile.ErrNotSQLite)
+	assert.False(t, sqlitefile.IsSQLite([]byte("PK\x03\x04")))
+}
+
+func TestOpen_RejectsTruncatedFile(t *testing.T) {
+	data, err := os.ReadFile("testdata/sample.db")
+	require.NoError(t, err)
+
+	db, err := sqlitefile.Open(data[:1024])
+	if err == nil {
+		_, err = db.Rows("items")
+	}
+	require.ErrorIs(t, err, sqlitefile.ErrCorrupt)
+}
+
+func TestRows_OverflowPointerPastPageEnd(t *testing.T) {
+	data, err := os.ReadFile("testdata/sample.db")
+	require.NoError(t, err)
+
+	// The 3000-character row is cell 0 of page 22, at offset 33: a 4-byte
+	// cell header and 471 bytes of local payload, then the 4-byte overflow
+	// page number that ends the page. Moving the cell two bytes later
+	// leaves its local payload on the page but not that page number.
+	const (
+		pageSize = 512
+		page     = 21 * pageSize
+		cellOff  = 33
+		shifted  = cellOff + 2
+	)
+	copy(data[page+shifted:page+shifted+4], data[page+cellOff:page+cellOff+4])
+	data[page+8], data[page+9] = 0, shifted
+
+	db, err := sqlitefile.Open(data)
+	require.NoError(t, err)
+	_, err = db.Rows("items")
+	require.ErrorIs(t, err, sqlitefile.ErrCorrupt)
+}
+
+func TestRows_PayloadSizeBeyondFile(t *testing.T) {
+	sample, err := os.ReadFile("testdata/sample.db")
+	require.NoError(t, err)
+
+	// Rewrite the header of the 3000-character row (cell 0 of page 22, see
+	// above) to claim a payload the file can't hold: far past its end, and
+	// the file's own size, which its overflow chain still can't reach.
+	// Neither may be allocated.
+	const page, cellOff = 21 * 512, 33
+	for _, size := range []uint64{1 << 40, uint64(len(sample))} {
+		data := slices.Clone(sample)
+		header := append(putVarint(size), 1) // then rowid 1
+		copy(data[page+cellOff:], header)
+
+		db, openErr := sqlitefile.Open(data)
+		require.NoError(t, openErr)
+		_, err = db.Rows("items")
+		require.ErrorIs(t, err, sqlitefile.ErrCorrupt, "size %d", size)
+	}
+}
+
+func TestRows_PageReachedTwice(t *testing.T) {
+	data, err := os.ReadFile("testdata/sample.db")
+	require.NoError(t, err)
+
+	// Page 2 is the interior root of items. Pointing its right child at
+	// the child of its first cell makes the tree visit that page twice.
+	const page = 512
+	firstCell := int(binary.BigEndian.Uint16(data[page+12:]))
+	copy(data[page+8:page+12], data[page+firstCell:page+firstCell+4])
+
+	db, err := sqlitefile.Open(data)
+	require.NoError(t, err)
+	_, err = db.Rows("items")
+	require.ErrorIs(t, err, sqlitefile.ErrCorrupt)
+}
+
+// putVarint encodes v as an SQLite varint (v < 2^56).
+func putVarint(v uint64) []byte {
+	out := []byte{byte(v & 0x7f)}
+	for v >>= 7; v > 0; v >>= 7 {
+		out = append([]byte{byte(v&0x7f) | 0x80}, out...)
+	}
+	return out
+}
+
+func TestColumns(t *testing.T) {
+	db := openSample(t)
+
+	assert.True(t, db.HasTable("items"))
+	assert.True(t, db.HasTable("ITEMS"))
+	assert.False(t, db.HasTable("missing"))
+
+	cols, err := db.Columns("items")
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{"id", "name", "price", "qty", "data", "note", "extra"}, cols)
+}
+
+func TestRows(t *testing.T) {
+	db := openSample(t)
+
+	rows, err := db.Rows("items")
+	require.NoError(t, err)
+	require.Len(t, rows, 202)
+
+	first := rows[0]
+	assert.Equal(t, int64(1), first["id"])
+	assert.Equal(t, "item-001", first["name"])
+	assert.InDelta(t, 1.5, first["price"], 0.0001)
+	assert.Equal(t, int64(-1000003), first["qty"])
+	assert.Equal(t, []byte{1, 0, 255}, first["data"])
+	assert.Nil(t, first["note"])
+	assert.Nil(t, first["extra"])
+
+	third := rows[2]
+	assert.Equal(t, int64(-3000009), third["qty"])
+	assert.Equal(t, "n3", third["note"])
+
+	long := rows[200]
+	assert.Equal(t, int64(500), long["id"])
+	assert.Equal(t, strings.Repeat("x", 3000), long["name"])
+
+	late := rows[201]
+	assert.Equal(t, "late", late["name"])
+	assert.Equal(t, "added", late["extra"])
+}
+
+func TestRows_UnknownTable(t *testing.T) {
+	db := openSample(t)
+
+	_, err := db.Rows("missing")
+	require.Error(t, err)
+}
@@ -31,6 +31,12 @@ jest.mock('@/components/books/BookSourceSync', () => {
   }
 })
 
+jest.mock('@/components/books/BookHighlights', () => {
+  return function MockBookHighlights() {
+    return <div data-testid="book-highlights" />
+  }
+})
+
 jest.mock('@/components/books/BookEditDialog', () => {
   return function MockBookEditDialog({ open }: { open: boolean }) {
     return open ? <div data-testid="book-edit-dialog" /> : null
@@ -92,9 +98,30 @@ jest.mock('@/components/books/KoboSyncToggle', () => {
   }
 })
 
+jest.mock('@/components/books/UpNextControls', () => {
+  return function MockUpNextControls() {
+    return <div data-testid="up-next-controls" />
+  }
+})
+
 jest.mock('@/components/books/BookPreviewDialog', () => {
-  return function MockBookPreviewDialog() {
-    return <div data-testid="book-preview-dialog" />
+  return function MockBookPreviewDialog({
+    format,
+    initialLocation,
+    findText
+  }: {
+    format: string
+    initialLocation?: string | null
+    findText?: string
+  }) {
+    return (
+      <div
+        data-testid="book-preview-dialog"
+        data-format={format}
+        data-location={initialLocation ?? ''}
+        data-find={findText ?? ''}
+      />
+    )
   }
 })
 
@@ -276,6 +303,21 @@ describe('BookDetailClient', () => {
     expect(screen.queryByRole('progressbar')).not.toBeInTheDocument()
   })
 
+  it('shows up-next controls only for a to-read book', () => {
+    render(<BookDetailClient id="ub-1" />)
+    expect(screen.queryByTestId('up-next-controls')).not.toBeInTheDocument()
+
+    const wishlistBook = create(UserBookSchema, { ...mockUserBook, status: 'to-read' })
+    // @ts-expect-error -- mock returns partial SWRResponse for test purposes
+    jest.mocked(useLibrary).mockReturnValue({
+      data: makeLibraryData([], [wishlistBook]),
+      isLoading: false,
+      error: undefined
+    })
+    render(<BookDetailClient id="ub-1" />)
+    expect(screen.getByTestId('up-next-controls')).toBeInTheDocument()
+  })
+
   it('renders breadcrumb with Books and Library links', () => {
     render(<BookDetailClient id="ub-1" />)
     const booksLink = screen.getByText('Books').closest('a')
@@ -455,4 +497,24 @@ describe('BookDetailClient', () => {
     fireEvent.click(screen.getByRole('button', { name: 'Read in app' }))
     expect(mockUseGetBookContent).toHaveBeenCalledWith('book-1')
   })
+
+  it('opens the EPUB reader at a book text search hit', () => {
+    mockUseSearchParams.mockReturnValueOnce(
+      new URLSearchParams('read=epub&loc=text%2Fch2.xhtml&find=spice')
+    )
+    render(<BookDetailClient id="ub-1" />)
+    const dialog = screen.getByTestId('book-preview-dialog')
+    expect(dialog).toHaveAttribute('data-format', 'epub')
+    expect(dialog).toHaveAttribute('data-location', 'text/ch2.xhtml')
+    expect(dialog).toHaveAttribute('data-find', 'spice')
+  })
+
+  it('opens the article reader for an article text search hit', () => {
+    // @ts-expect-error -- mock returns partial SWRResponse for test purposes
+    mockUseGetBookContent.mockReturnValue({ data: undefined, error: undefined })
+    mockUseSearchParams.mockReturnValueOnce(new URLSearchParams('read=article&find=spice'))
+    render(<BookDetailClient id="ub-1" />)
+    expect(screen.queryByTestId('book-preview-dialog')).not.toBeInTheDocument()
+    expect(mockUseGetBookContent).toHaveBeenCalledWith('book-1')
+  })
 })
@@ -134,6 +134,39 @@ describe('BookEditDialog', () => {
     )
   })
 
+  it('saves the series name and number', async () => {
+    mockUpdateBook.mockResolvedValue({})
+    mockMutate.mockResolvedValue(undefined)
+    renderDialog({ book: makeBook({ series: 'The Expanse', seriesPosition: 1 }) })
+
+    expect(screen.getByDisplayValue('The Expanse')).toBeInTheDocument()
+    fireEvent.change(screen.getByDisplayValue('1'), { target: { value: '2,5' } })
+    fireEvent.click(screen.getByTestId('edit-book-save-btn'))
+
+    await waitFor(() =>
+      expect(mockUpdateBook).toHaveBeenCalledWith(
+        'book-1',
+        expect.objectContaining({ series: 'The Expanse', seriesPosition: 2.5 })
+      )
+    )
+  })
+
+  it('clears the series number when the series is removed', async () => {
+    mockUpdateBook.mockResolvedValue({})
+    mockMutate.mockResolvedValue(undefined)
+    renderDialog({ book: makeBook({ series: 'The Expanse', seriesPosition: 3 }) })
+
+    fireEvent.change(screen.getByDisplayValue('The Expanse'), { target: { value: '' } })
+    fireEvent.click(screen.getByTestId('edit-book-save-btn'))
+
+    await waitFor(() =>
+      expect(mockUpdateBook).toHaveBeenCalledWith(
+        'book-1',
+        expect.objectContaining({ series: '', seriesPosition: undefined })
+      )
+    )
+  })
+
   it('clears the cover URL field when Remove is clicked', () => {
     renderDialog()
     fireEvent.click(screen.getByText('Remove'))
@@ -0,0 +1,102 @@
+import React from 'react'
+import { render, screen, fireEvent, waitFor } from '@testing-library/react'
+
+const mockUseBookAnnotations = jest.fn()
+const mockExportAnnotations = jest.fn()
+const mockSaveFile = jest.fn()
+
+jest.mock('@/hooks/useBooks', () => ({
+  useBookAnnotations: (bookId: string) => mockUseBookAnnotations(bookId),
+  useExportAnnotations: () => mockExportAnnotations
+}))
+
+jest.mock('@/lib/books/download', () => ({
+  saveFile: (...args: unknown[]) => mockSaveFile(...args)
+}))
+
+import BookHighlights from '@/components/books/BookHighlights'
+
+function annotation(overrides: Record<string, unknown>) {
+  return {
+    id: 'a1',
+    bookId: 'book-1',
+    type: 'highlight',
+    highlightedText: '',
+    noteText: '',
+    chapterTitle: '',
+    createdAt: '2024-05-01T10:00:00Z',
+    ...overrides
+  }
+}
+
+describe('BookHighlights', () => {
+  beforeEach(() => {
+    jest.clearAllMocks()
+  })
+
+  it('renders nothing when the book has no highlights', () => {
+    mockUseBookAnnotations.mockReturnValue({ data: { annotations: [] } })
+    const { container } = render(<BookHighlights bookId="book-1" />)
+    expect(container).toBeEmptyDOMElement()
+    expect(mockUseBookAnnotations).toHaveBeenCalledWith('book-1')
+  })
+
+  it('groups highlights and notes by chapter and leaves out bookmarks', () => {
+    mockUseBookAnnotations.mockReturnValue({
+      data: {
+        annotations: [
+          annotation({ id: 'a1', chapterTitle: 'Chapter 1', highlightedText: 'It was cold' }),
+          annotation({ id: 'a2', chapterTitle: 'Chapter 2', highlightedText: 'Big Brother' }),
+          annotation({
+            id: 'a3',
+            chapterTitle: 'Chapter 1',
+            type: 'note',
+            highlightedText: 'thirteen',
+            noteText: 'odd clock'
+          }),
+          annotation({ id: 'a4', chapterTitle: 'Chapter 3', type: 'dogear' })
+        ]
+      }
+    })
+    render(<BookHighlights bookId="book-1" />)
+
+    expect(screen.getAllByTestId('book-highlight')).toHaveLength(3)
+    const headings = screen.getAllByRole('heading', { level: 3 }).map((h) => h.textContent)
+    expect(headings).toEqual(['Chapter 1', 'Chapter 2'])
+    expect(screen.getByText('It was cold')).toBeInTheDocument()
+    expect(screen.getByText('odd clock')).toBeInTheDocument()
+    expect(screen.queryByText('Chapter 3')).not.toBeInTheDocument()
+  })
+
+  it('downloads the Markdown export', async () => {
+    mockUseBookAnnotations.mockReturnValue({
+      data: { annotations: [annotation({ highlightedText: 'It was cold' })] }
+    })
+    const data = new Uint8Array([1])
+    mockExportAnnotations.mockResolvedValue({
+      data,
+      filename: 'highlights.md',
+      contentType: 'text/markdown'
+    })
+    render(<BookHighlights bookId="book-1" />)
+
+    fireEvent.click(screen.getByRole('button', { name: 'Export Markdown' }))
+
+    await waitFor(() =>
+      expect(mockSaveFile).toHaveBeenCalledWith(data, 'highlights.md', 'text/markdown')
+    )
+    expect(mockExportAnnotations).toHaveBeenCalledWith('book-1')
+  })
+
+  it('shows an error when the export fails', async () => {
+    mockUseBookAnnotations.mockReturnValue({
+      data: { annotations: [annotation({ highlightedText: 'It was cold' })] }
+    })
+    mockExportAnnotations.mockRejectedValue(new Error('boom'))
+    render(<BookHighlights bookId="book-1" />)
+
+    fireEvent.click(screen.getByRole('button', { name: 'Export Markdown' }))
+
+    expect(await screen.findByText('Export failed.')).toBeInTheDocument()
+  })
+})
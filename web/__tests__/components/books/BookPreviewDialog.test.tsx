@@ -12,15 +12,26 @@ jest.mock('swr', () => ({
   mutate: (...args: unknown[]) => mockMutate(...args)
 }))
 
+const mockGetRendition = jest.fn()
+
 jest.mock('next/dynamic', () => () => {
   // Return a stub component synchronously so tests don't need async import resolution.
-  const Stub = (props: { url?: unknown; epubInitOptions?: { openAs?: unknown } }) => (
-    <div
-      data-testid="react-reader"
-      data-url={String(props.url ?? '')}
-      data-open-as={String(props.epubInitOptions?.openAs ?? '')}
-    />
-  )
+  const Stub = (props: {
+    url?: unknown
+    epubInitOptions?: { openAs?: unknown }
+    location?: unknown
+    getRendition?: (rendition: unknown) => void
+  }) => {
+    if (props.getRendition) mockGetRendition.mockImplementation(props.getRendition)
+    return (
+      <div
+        data-testid="react-reader"
+        data-url={String(props.url ?? '')}
+        data-open-as={String(props.epubInitOptions?.openAs ?? '')}
+        data-location={String(props.location ?? '')}
+      />
+    )
+  }
   Stub.displayName = 'ReactReaderStub'
   return Stub
 })
@@ -68,6 +79,7 @@ describe('BookPreviewDialog', () => {
     mockRequestConversion.mockReset()
     mockUseRequestKEPUBConversion.mockClear()
     mockMutate.mockReset()
+    mockGetRendition.mockReset()
     setupPdfEpubMocks()
   })
 
@@ -150,6 +162,47 @@ describe('BookPreviewDialog', () => {
     expect(reader).toHaveAttribute('data-open-as', 'epub')
   })
 
+  it('opens at a search hit and highlights the first match in its chapter', async () => {
+    mockUseGetBookFile.mockReturnValue({
+      data: { url: 'https://r2.example.com/book.epub' },
+      error: null
+    })
+    render(
+      <BookPreviewDialog
+        bookId={BOOK_ID}
+        format="epub"
+        title={TITLE}
+        open={true}
+        onOpenChange={jest.fn()}
+        initialLocation="text/ch2.xhtml"
+        findText="whale"
+      />
+    )
+    expect(screen.getByTestId('react-reader')).toHaveAttribute('data-location', 'text/ch2.xhtml')
+
+    const section = {
+      load: jest.fn().mockResolvedValue(undefined),
+      find: jest.fn(() => [{ cfi: 'epubcfi(/6/4!/4/2,/1:0,/1:5)' }])
+    }
+    const rendition = {
+      book: {
+        ready: Promise.resolve(),
+        load: jest.fn(),
+        spine: { get: jest.fn(() => section) }
+      },
+      display: jest.fn().mockResolvedValue(undefined),
+      annotations: { highlight: jest.fn() }
+    }
+    mockGetRendition(rendition)
+
+    await waitFor(() => {
+      expect(rendition.annotations.highlight).toHaveBeenCalledWith('epubcfi(/6/4!/4/2,/1:0,/1:5)')
+    })
+    expect(rendition.book.spine.get).toHaveBeenCalledWith('text/ch2.xhtml')
+    expect(section.find).toHaveBeenCalledWith('whale')
+    expect(rendition.display).toHaveBeenCalledWith('epubcfi(/6/4!/4/2,/1:0,/1:5)')
+  })
+
   it('passes null bookId to useGetBookFile when dialog is closed', () => {
     render(
       <BookPreviewDialog
@@ -59,6 +59,59 @@ describe('BooksDashboardView', () => {
     expect(screen.getByText('card-ub-1')).toBeInTheDocument()
   })
 
+  it('hides the next-in-series list when there is nothing to suggest', () => {
+    renderView()
+    expect(screen.queryByTestId('next-in-series')).not.toBeInTheDocument()
+  })
+
+  it('lists the next unread book of each series', () => {
+    const library = makeLibrary()
+    library.nextInSeries = [
+      create(UserBookSchema, {
+        id: 'ub-4',
+        status: 'to-read',
+        book: create(BookSchema, {
+          title: "Caliban's War",
+          series: 'The Expanse',
+          seriesPosition: 2,
+          seriesTotal: 9
+        })
+      })
+    ]
+    renderView({ library })
+    expect(screen.getByText('Next in series')).toBeInTheDocument()
+    expect(screen.getByText("Caliban's War")).toBeInTheDocument()
+    expect(screen.getByText('· The Expanse #2 of 9')).toBeInTheDocument()
+  })
+
+  it('lists the up-next queue in order', () => {
+    const library = makeLibrary()
+    library.upNext = [
+      create(UserBookSchema, {
+        id: 'ub-5',
+        status: 'to-read',
+        queuePosition: 0,
+        book: create(BookSchema, { title: 'Leviathan Wakes', authors: ['James S. A. Corey'] })
+      }),
+      create(UserBookSchema, {
+        id: 'ub-6',
+        status: 'to-read',
+        queuePosition: 1,
+        book: create(BookSchema, { title: "Abaddon's Gate" })
+      })
+    ]
+    renderView({ library })
+    const items = screen.getByTestId('up-next').querySelectorAll('li')
+    expect(items).toHaveLength(2)
+    expect(items[0]).toHaveTextContent('Leviathan Wakes · James S. A. Corey')
+    expect(items[1]).toHaveTextContent("Abaddon's Gate")
+  })
+
+  it('hides the up-next list when nothing is queued', () => {
+    renderView()
+    expect(screen.queryByTestId('up-next')).not.toBeInTheDocument()
+  })
+
   it('requests a view change when the All time tab is clicked', () => {
     const chart = makeChart('ytd')
     renderView({ chart })
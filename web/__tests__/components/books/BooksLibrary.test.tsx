@@ -11,6 +11,7 @@ import {
 
 const mockSearchExternal = jest.fn()
 const mockCreateBook = jest.fn()
+const mockUseSearchBookText = jest.fn()
 
 // AddManualBookModal (rendered from the "No results." empty state) also
 // calls useLibrary for its custom-shelf options — stub it with a minimal
@@ -20,6 +21,7 @@ jest.mock('@/hooks/useBooks', () => ({
   ...jest.requireActual('@/hooks/useBooks'),
   useSearchExternal: () => mockSearchExternal,
   useCreateBook: () => mockCreateBook,
+  useSearchBookText: (query: string) => mockUseSearchBookText(query),
   useLibrary: () => ({
     data: { library: { shelves: [] } },
     isLoading: false,
@@ -123,6 +125,8 @@ describe('BooksLibrary', () => {
     mockSearchExternal.mockReset()
     mockSearchExternal.mockResolvedValue({ results: [] })
     mockCreateBook.mockReset()
+    mockUseSearchBookText.mockReset()
+    mockUseSearchBookText.mockReturnValue({ data: undefined })
   })
 
   afterEach(() => {
@@ -455,4 +459,32 @@ describe('BooksLibrary', () => {
     fireEvent.click(screen.getByRole('button', { name: 'Cancel' }))
     expect(screen.queryByRole('heading', { name: 'Add book manually' })).not.toBeInTheDocument()
   })
+
+  it('lists matches inside book text below the title results, linking into the reader', () => {
+    mockUseSearchBookText.mockImplementation((query: string) => ({
+      data:
+        query === 'whale'
+          ? {
+              hits: [
+                {
+                  userBookId: '3',
+                  format: 'epub',
+                  location: 'ch1.xhtml',
+                  title: 'Foundation',
+                  authors: ['Author'],
+                  chapter: 'Chapter One',
+                  snippet: 'a white <mark>whale</mark> surfaced'
+                }
+              ],
+              hasMore: false
+            }
+          : undefined
+    }))
+    renderLibrary(makeLibrary(), { searchQuery: 'whale' })
+
+    expect(screen.getByText('In book text')).toBeInTheDocument()
+    expect(screen.getByText('Author · Chapter One')).toBeInTheDocument()
+    const mark = screen.getByText('whale', { selector: 'mark' })
+    expect(mark.closest('a')).toHaveAttribute('href', '/books/3?read=epub&loc=ch1.xhtml&find=whale')
+  })
 })
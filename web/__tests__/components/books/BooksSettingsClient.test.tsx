@@ -1,10 +1,15 @@
 import React from 'react'
-import { render, screen } from '@testing-library/react'
+import { render, screen, fireEvent, waitFor } from '@testing-library/react'
 
-const mockImportBooks = jest.fn()
+const mockExportLibrary = jest.fn()
+const mockSaveFile = jest.fn()
 
 jest.mock('@/hooks/useBooks', () => ({
-  useImportBooks: () => mockImportBooks
+  useExportLibrary: () => mockExportLibrary
+}))
+
+jest.mock('@/lib/books/download', () => ({
+  saveFile: (...args: unknown[]) => mockSaveFile(...args)
 }))
 
 jest.mock('@/components/books/BulkBookUploader', () => ({
@@ -22,7 +27,25 @@ jest.mock('@/components/books/KoboDevices', () => ({
   default: () => <div data-testid="kobo-devices" />
 }))
 
-jest.mock('swr', () => ({ __esModule: true, mutate: jest.fn(), default: jest.fn() }))
+jest.mock('@/components/books/OPDSTokens', () => ({
+  __esModule: true,
+  default: () => <div data-testid="opds-tokens" />
+}))
+
+jest.mock('@/components/books/KOReaderDevices', () => ({
+  __esModule: true,
+  default: () => <div data-testid="koreader-devices" />
+}))
+
+jest.mock('@/components/books/ReadingQueueSettings', () => ({
+  __esModule: true,
+  default: () => <div data-testid="reading-queue-settings" />
+}))
+
+jest.mock('@/components/books/LibraryImporter', () => ({
+  __esModule: true,
+  default: () => <div data-testid="library-importer" />
+}))
 
 import BooksSettingsClient from '@/components/books/BooksSettingsClient'
 
@@ -44,7 +67,38 @@ describe('BooksSettingsClient', () => {
   it('renders the Import books section', () => {
     render(<BooksSettingsClient />)
     expect(screen.getByText('Import books')).toBeInTheDocument()
-    expect(screen.getByText('Import CSV')).toBeInTheDocument()
+    expect(screen.getByTestId('library-importer')).toBeInTheDocument()
+  })
+
+  it('downloads the library export in the chosen format', async () => {
+    const data = new Uint8Array([1, 2, 3])
+    mockExportLibrary.mockResolvedValue({
+      data,
+      filename: 'library-2026-01-01.json',
+      contentType: 'application/json'
+    })
+    render(<BooksSettingsClient />)
+
+    fireEvent.click(screen.getByRole('button', { name: 'Export JSON' }))
+
+    await waitFor(() =>
+      expect(mockSaveFile).toHaveBeenCalledWith(
+        data,
+        'library-2026-01-01.json',
+        'application/json'
+      )
+    )
+    expect(mockExportLibrary).toHaveBeenCalledWith('json')
+  })
+
+  it('reports a failed export', async () => {
+    mockExportLibrary.mockRejectedValue(new Error('boom'))
+    render(<BooksSettingsClient />)
+
+    fireEvent.click(screen.getByRole('button', { name: 'Export CSV' }))
+
+    expect(await screen.findByText('Export failed.')).toBeInTheDocument()
+    expect(mockSaveFile).not.toHaveBeenCalled()
   })
 
   it('renders the Upload ebooks section with BulkBookUploader', () => {
@@ -73,6 +127,18 @@ describe('BooksSettingsClient', () => {
     expect(screen.getByText('Connected devices')).toBeInTheDocument()
   })
 
+  it('renders the OPDS catalog section with OPDSTokens', () => {
+    render(<BooksSettingsClient />)
+    expect(screen.getByText('OPDS catalog')).toBeInTheDocument()
+    expect(screen.getByTestId('opds-tokens')).toBeInTheDocument()
+  })
+
+  it('renders the KOReader section with KOReaderDevices', () => {
+    render(<BooksSettingsClient />)
+    expect(screen.getByText('KOReader progress sync')).toBeInTheDocument()
+    expect(screen.getByTestId('koreader-devices')).toBeInTheDocument()
+  })
+
   it('does not show resync or find-duplicates on the settings page', () => {
     render(<BooksSettingsClient />)
     expect(screen.queryByTestId('resync-books-btn')).not.toBeInTheDocument()
@@ -0,0 +1,114 @@
+import React from 'react'
+import { render, screen, fireEvent, waitFor } from '@testing-library/react'
+
+const mockRegisterKOReaderDevice = jest.fn()
+const mockDisconnectKOReaderDevice = jest.fn()
+const mockMutate = jest.fn()
+const mockUseListKOReaderDevices = jest.fn()
+
+jest.mock('@/hooks/useBooks', () => ({
+  useListKOReaderDevices: () => mockUseListKOReaderDevices(),
+  useRegisterKOReaderDevice: () => mockRegisterKOReaderDevice,
+  useDisconnectKOReaderDevice: () => mockDisconnectKOReaderDevice
+}))
+
+jest.mock('@/lib/env', () => ({ getApiUrl: () => 'https://api.test' }))
+
+import KOReaderDevices, { koreaderServerUrl } from '@/components/books/KOReaderDevices'
+
+const device = {
+  id: 'dev-1',
+  username: 'kindle',
+  createdAt: '2024-01-01T00:00:00Z',
+  lastUsedAt: ''
+}
+
+beforeEach(() => {
+  mockRegisterKOReaderDevice.mockReset()
+  mockDisconnectKOReaderDevice.mockReset()
+  mockMutate.mockReset()
+  mockRegisterKOReaderDevice.mockResolvedValue({ device, password: 'abcdefghijklmnop' })
+  mockDisconnectKOReaderDevice.mockResolvedValue({})
+  mockUseListKOReaderDevices.mockReturnValue({
+    data: { devices: [] },
+    isLoading: false,
+    mutate: mockMutate
+  })
+})
+
+describe('koreaderServerUrl', () => {
+  it('points at the kosync endpoint of the API', () => {
+    expect(koreaderServerUrl()).toBe('https://api.test/books/kosync')
+  })
+})
+
+describe('KOReaderDevices', () => {
+  it('shows loading state', () => {
+    mockUseListKOReaderDevices.mockReturnValue({
+      data: undefined,
+      isLoading: true,
+      mutate: mockMutate
+    })
+    render(<KOReaderDevices />)
+    expect(screen.getByTestId('koreader-devices-loading')).toBeInTheDocument()
+  })
+
+  it('shows empty message when there are no logins', () => {
+    render(<KOReaderDevices />)
+    expect(screen.getByTestId('koreader-devices-empty')).toBeInTheDocument()
+  })
+
+  it('lists logins with their last sync', () => {
+    mockUseListKOReaderDevices.mockReturnValue({
+      data: { devices: [device] },
+      isLoading: false,
+      mutate: mockMutate
+    })
+    render(<KOReaderDevices />)
+    expect(screen.getByText('kindle')).toBeInTheDocument()
+    expect(screen.getByText('Never synced')).toBeInTheDocument()
+  })
+
+  it('disables create until a username is entered', () => {
+    render(<KOReaderDevices />)
+    expect(screen.getByTestId('koreader-register-btn')).toBeDisabled()
+    fireEvent.change(screen.getByTestId('koreader-username'), { target: { value: 'kindle' } })
+    expect(screen.getByTestId('koreader-register-btn')).not.toBeDisabled()
+  })
+
+  it('creates a login and shows the server, username and password once', async () => {
+    render(<KOReaderDevices />)
+    fireEvent.change(screen.getByTestId('koreader-username'), { target: { value: ' kindle ' } })
+    fireEvent.click(screen.getByTestId('koreader-register-btn'))
+
+    await waitFor(() => expect(screen.getByTestId('koreader-credentials')).toBeInTheDocument())
+    expect(mockRegisterKOReaderDevice).toHaveBeenCalledWith('kindle')
+    expect(mockMutate).toHaveBeenCalled()
+    expect(screen.getByText('https://api.test/books/kosync')).toBeInTheDocument()
+    expect(screen.getByText('kindle')).toBeInTheDocument()
+    expect(screen.getByText('abcdefghijklmnop')).toBeInTheDocument()
+  })
+
+  it('shows an error when creating fails', async () => {
+    mockRegisterKOReaderDevice.mockRejectedValue(new Error('boom'))
+    render(<KOReaderDevices />)
+    fireEvent.change(screen.getByTestId('koreader-username'), { target: { value: 'kindle' } })
+    fireEvent.click(screen.getByTestId('koreader-register-btn'))
+
+    await waitFor(() => expect(screen.getByTestId('koreader-error')).toBeInTheDocument())
+    expect(screen.queryByTestId('koreader-credentials')).not.toBeInTheDocument()
+  })
+
+  it('disconnects a login', async () => {
+    mockUseListKOReaderDevices.mockReturnValue({
+      data: { devices: [device] },
+      isLoading: false,
+      mutate: mockMutate
+    })
+    render(<KOReaderDevices />)
+    fireEvent.click(screen.getByTestId('koreader-disconnect-btn-dev-1'))
+
+    await waitFor(() => expect(mockMutate).toHaveBeenCalled())
+    expect(mockDisconnectKOReaderDevice).toHaveBeenCalledWith('dev-1')
+  })
+})
@@ -3,6 +3,7 @@ import { render, screen, fireEvent, waitFor, act } from '@testing-library/react'
 
 const mockDisconnectKoboDevice = jest.fn()
 const mockSetKoboDeviceLogging = jest.fn()
+const mockSetKoboDeviceFilter = jest.fn()
 const mockMutate = jest.fn()
 
 function makeUseListKoboDevices(devices: unknown[]) {
@@ -18,7 +19,8 @@ const mockUseListKoboDevices = jest.fn()
 jest.mock('@/hooks/useBooks', () => ({
   useListKoboDevices: () => mockUseListKoboDevices(),
   useDisconnectKoboDevice: () => mockDisconnectKoboDevice,
-  useSetKoboDeviceLogging: () => mockSetKoboDeviceLogging
+  useSetKoboDeviceLogging: () => mockSetKoboDeviceLogging,
+  useSetKoboDeviceFilter: () => mockSetKoboDeviceFilter
 }))
 
 // Stub the logs viewer — it has its own test and its own hooks.
@@ -43,9 +45,11 @@ import KoboDevices from '@/components/books/KoboDevices'
 beforeEach(() => {
   mockDisconnectKoboDevice.mockReset()
   mockSetKoboDeviceLogging.mockReset()
+  mockSetKoboDeviceFilter.mockReset()
   mockMutate.mockReset()
   mockDisconnectKoboDevice.mockResolvedValue({})
   mockSetKoboDeviceLogging.mockResolvedValue({})
+  mockSetKoboDeviceFilter.mockResolvedValue({})
 })
 
 describe('KoboDevices — empty state', () => {
@@ -68,7 +72,9 @@ describe('KoboDevices — device list', () => {
     name: 'My Kobo',
     serial: 'N4181234',
     createdAt: '2024-01-01T00:00:00Z',
-    lastSeenAt: '2024-06-01T12:00:00Z'
+    lastSeenAt: '2024-06-01T12:00:00Z',
+    syncShelves: [],
+    syncTags: []
   }
 
   const deviceNoLastSeen = {
@@ -76,7 +82,9 @@ describe('KoboDevices — device list', () => {
     name: 'New Kobo',
     serial: '',
     createdAt: '2024-02-01T00:00:00Z',
-    lastSeenAt: ''
+    lastSeenAt: '',
+    syncShelves: [],
+    syncTags: []
   }
 
   it('renders a row for each device', () => {
@@ -124,7 +132,9 @@ describe('KoboDevices — disconnect flow', () => {
     name: 'My Kobo',
     serial: '',
     createdAt: '2024-01-01T00:00:00Z',
-    lastSeenAt: ''
+    lastSeenAt: '',
+    syncShelves: [],
+    syncTags: []
   }
 
   beforeEach(() => {
@@ -188,6 +198,8 @@ describe('KoboDevices — debug logging', () => {
     serial: '',
     createdAt: '2024-01-01T00:00:00Z',
     lastSeenAt: '',
+    syncShelves: [],
+    syncTags: [],
     loggingEnabled: false
   }
   const onDevice = {
@@ -196,6 +208,8 @@ describe('KoboDevices — debug logging', () => {
     serial: '',
     createdAt: '2024-01-01T00:00:00Z',
     lastSeenAt: '',
+    syncShelves: [],
+    syncTags: [],
     loggingEnabled: true
   }
 
@@ -242,3 +256,72 @@ describe('KoboDevices — debug logging', () => {
     expect(screen.queryByTestId(`kobo-logs-toggle-${offDevice.id}`)).not.toBeInTheDocument()
   })
 })
+
+describe('KoboDevices — sync filter', () => {
+  const device = {
+    id: 'dev-abc',
+    name: 'My Kobo',
+    serial: '',
+    createdAt: '2024-01-01T00:00:00Z',
+    lastSeenAt: '',
+    syncShelves: ['currently-reading'],
+    syncTags: [],
+    loggingEnabled: false
+  }
+
+  it('summarises an empty filter as the whole library', () => {
+    mockUseListKoboDevices.mockReturnValue(makeUseListKoboDevices([{ ...device, syncShelves: [] }]))
+    render(<KoboDevices />)
+    expect(screen.getByTestId(`kobo-filter-summary-${device.id}`)).toHaveTextContent(
+      'Syncs all Kobo books'
+    )
+  })
+
+  it('summarises the selected shelves', () => {
+    mockUseListKoboDevices.mockReturnValue(makeUseListKoboDevices([device]))
+    render(<KoboDevices />)
+    expect(screen.getByTestId(`kobo-filter-summary-${device.id}`)).toHaveTextContent(
+      'Syncs shelves: currently-reading'
+    )
+  })
+
+  it('saves the edited filter and mutates', async () => {
+    mockUseListKoboDevices.mockReturnValue(makeUseListKoboDevices([device]))
+    render(<KoboDevices />)
+
+    fireEvent.click(screen.getByTestId(`kobo-filter-btn-${device.id}`))
+    expect(screen.getByTestId('kobo-filter-shelves')).toHaveValue('currently-reading')
+    fireEvent.change(screen.getByTestId('kobo-filter-shelves'), {
+      target: { value: 'currently-reading, to-read' }
+    })
+    fireEvent.change(screen.getByTestId('kobo-filter-tags'), {
+      target: { value: ' commute ,' }
+    })
+
+    await act(async () => {
+      fireEvent.click(screen.getByTestId('kobo-filter-save-btn'))
+    })
+
+    expect(mockSetKoboDeviceFilter).toHaveBeenCalledWith(
+      device.id,
+      ['currently-reading', 'to-read'],
+      ['commute']
+    )
+    expect(mockMutate).toHaveBeenCalled()
+    expect(screen.queryByTestId('dialog')).not.toBeInTheDocument()
+  })
+
+  it('shows an error when saving fails', async () => {
+    mockSetKoboDeviceFilter.mockRejectedValue(new Error('boom'))
+    mockUseListKoboDevices.mockReturnValue(makeUseListKoboDevices([device]))
+    render(<KoboDevices />)
+
+    fireEvent.click(screen.getByTestId(`kobo-filter-btn-${device.id}`))
+    await act(async () => {
+      fireEvent.click(screen.getByTestId('kobo-filter-save-btn'))
+    })
+
+    expect(screen.getByTestId('kobo-filter-error')).toBeInTheDocument()
+    expect(mockMutate).not.toHaveBeenCalled()
+  })
+})
@@ -29,8 +29,10 @@ jest.mock('@/lib/books/gatewayClient', () => ({
 // The polling hook is driven manually in these tests via mockMutateGatewayStatus,
 // standing in for what SWR's mutate() would return from a fresh /status probe.
 const mockMutateGatewayStatus = jest.fn()
+const mockImportGateway = jest.fn()
 jest.mock('@/hooks/useKoboGateway', () => ({
-  useGatewayStatus: () => ({ mutate: mockMutateGatewayStatus })
+  useGatewayStatus: () => ({ mutate: mockMutateGatewayStatus }),
+  useImportGateway: () => mockImportGateway
 }))
 
 const mockRegisterKoboDevice = jest.fn()
@@ -72,6 +74,7 @@ beforeEach(() => {
   mockRegisterKoboDevice.mockReset()
   mockDisconnectKoboDevice.mockReset()
   mockMutateDevices.mockReset()
+  mockImportGateway.mockReset()
   mockDevicesData = undefined
 
   mockRegisterKoboDevice.mockResolvedValue({ device: { id: 'dev-1' }, rawToken: 'my-token' })
@@ -180,6 +183,52 @@ describe('KoboGatewaySetup — already configured', () => {
     expect(mockRegisterKoboDevice).not.toHaveBeenCalled()
   })
 
+  it('imports reading history from the configured Kobo', async () => {
+    mockImportGateway.mockResolvedValue({
+      serial: 'N418ABCD1234',
+      books: 3,
+      matched: 2,
+      created: 1,
+      skipped: 0,
+      markedRead: 1,
+      highlights: 5,
+      sessions: 4,
+      skippedHighlights: 2
+    })
+
+    render(<KoboGatewaySetup status={status([KOBO_MANAGED])} />)
+
+    await act(async () => {
+      fireEvent.click(screen.getByTestId('kobo-gateway-import-btn'))
+    })
+
+    await waitFor(() => {
+      expect(screen.getByTestId('kobo-gateway-import-result')).toHaveTextContent(
+        'Imported 5 highlights, 4 reading sessions, 1 books marked read, 1 books added.'
+      )
+    })
+    expect(screen.getByTestId('kobo-gateway-import-result')).toHaveTextContent(
+      '2 highlights could not be imported.'
+    )
+    expect(mockImportGateway).toHaveBeenCalledWith('/Volumes/KOBOeReader')
+  })
+
+  it('shows the gateway error when the import fails', async () => {
+    mockImportGateway.mockRejectedValue(new Error('KoboReader.sqlite not found'))
+
+    render(<KoboGatewaySetup status={status([KOBO_MANAGED])} />)
+
+    await act(async () => {
+      fireEvent.click(screen.getByTestId('kobo-gateway-import-btn'))
+    })
+
+    await waitFor(() => {
+      expect(screen.getByTestId('kobo-gateway-error')).toHaveTextContent(
+        'KoboReader.sqlite not found'
+      )
+    })
+  })
+
   it('still reverts when no device matches the serial', async () => {
     mockDevicesData = { devices: [{ id: 'dev-other', serial: 'XXXX', name: 'Other' }] }
 
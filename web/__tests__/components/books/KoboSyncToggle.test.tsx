@@ -21,9 +21,11 @@ import KoboSyncToggle from '@/components/books/KoboSyncToggle'
 
 const BOOK_ID = 'book-uuid-1234'
 
-function setupSWR(data: { hasEpub?: boolean; hasPdf?: boolean; kepubStatus?: string } = {}) {
+function setupSWR(
+  data: { hasEpub?: boolean; hasPdf?: boolean; hasCbz?: boolean; kepubStatus?: string } = {}
+) {
   mockUseSWR.mockReturnValue({
-    data: { hasEpub: false, hasPdf: false, kepubStatus: '', ...data }
+    data: { hasEpub: false, hasPdf: false, hasCbz: false, kepubStatus: '', ...data }
   })
 }
 
@@ -46,21 +48,34 @@ describe('KoboSyncToggle', () => {
     setupSWR({ hasEpub: false, hasPdf: false })
     render(<KoboSyncToggle bookId={BOOK_ID} enabled={false} tags={[]} />)
     expect(screen.getByTestId('kobo-sync-checkbox')).toBeDisabled()
-    expect(screen.getByText('Upload an EPUB or PDF to enable Kobo sync.')).toBeInTheDocument()
+    expect(screen.getByText('Upload an EPUB, PDF or CBZ to enable Kobo sync.')).toBeInTheDocument()
   })
 
   it('enables the toggle when epub is available', () => {
     setupSWR({ hasEpub: true })
     render(<KoboSyncToggle bookId={BOOK_ID} enabled={false} tags={[]} />)
     expect(screen.getByTestId('kobo-sync-checkbox')).not.toBeDisabled()
-    expect(screen.queryByText('Upload an EPUB or PDF to enable Kobo sync.')).not.toBeInTheDocument()
+    expect(
+      screen.queryByText('Upload an EPUB, PDF or CBZ to enable Kobo sync.')
+    ).not.toBeInTheDocument()
   })
 
   it('enables the toggle when only pdf is available', () => {
     setupSWR({ hasEpub: false, hasPdf: true })
     render(<KoboSyncToggle bookId={BOOK_ID} enabled={false} tags={[]} />)
     expect(screen.getByTestId('kobo-sync-checkbox')).not.toBeDisabled()
-    expect(screen.queryByText('Upload an EPUB or PDF to enable Kobo sync.')).not.toBeInTheDocument()
+    expect(
+      screen.queryByText('Upload an EPUB, PDF or CBZ to enable Kobo sync.')
+    ).not.toBeInTheDocument()
+  })
+
+  it('enables the toggle when only a comic is available', () => {
+    setupSWR({ hasCbz: true })
+    render(<KoboSyncToggle bookId={BOOK_ID} enabled={false} tags={[]} />)
+    expect(screen.getByTestId('kobo-sync-checkbox')).not.toBeDisabled()
+    expect(
+      screen.queryByText('Upload an EPUB, PDF or CBZ to enable Kobo sync.')
+    ).not.toBeInTheDocument()
   })
 
   it('calls enableKoboSync when toggled on', async () => {
@@ -0,0 +1,108 @@
+import React from 'react'
+import { render, screen, fireEvent, waitFor } from '@testing-library/react'
+
+const mockImportBooks = jest.fn()
+const mockMutate = jest.fn()
+
+jest.mock('@/hooks/useBooks', () => ({
+  useImportBooks: () => mockImportBooks
+}))
+
+jest.mock('swr', () => ({
+  __esModule: true,
+  mutate: (...args: unknown[]) => mockMutate(...args),
+  default: jest.fn()
+}))
+
+import LibraryImporter from '@/components/books/LibraryImporter'
+
+const preview = {
+  importedCount: 0,
+  format: 'storygraph',
+  createdCount: 1,
+  mergedCount: 1,
+  skippedCount: 1,
+  items: [
+    { title: 'Dune', authors: ['Frank Herbert'], action: 'merge', reason: 'isbn13' },
+    { title: 'Piranesi', authors: ['Susanna Clarke'], action: 'create', reason: '' },
+    { title: 'Piranesi', authors: ['Susanna Clarke'], action: 'skip', reason: 'duplicate in file' }
+  ]
+}
+
+function chooseFile(content = 'Title,Authors\n') {
+  const file = new File([content], 'library.csv', { type: 'text/csv' })
+  fireEvent.change(screen.getByTestId('import-file'), { target: { files: [file] } })
+}
+
+beforeEach(() => {
+  mockImportBooks.mockReset()
+  mockMutate.mockReset()
+})
+
+describe('LibraryImporter', () => {
+  it('previews an import with a dry run before writing anything', async () => {
+    mockImportBooks.mockResolvedValue(preview)
+    render(<LibraryImporter />)
+
+    chooseFile()
+
+    expect(await screen.findByTestId('import-preview')).toBeInTheDocument()
+    expect(screen.getByText('StoryGraph CSV: 1 new, 1 to merge, 1 skipped.')).toBeInTheDocument()
+    expect(screen.getByText('duplicate in file')).toBeInTheDocument()
+    expect(mockImportBooks).toHaveBeenCalledTimes(1)
+    const [data, opts] = mockImportBooks.mock.calls[0]
+    expect(Object.prototype.toString.call(data)).toBe('[object Uint8Array]')
+    expect(opts).toEqual({ format: '', dryRun: true })
+  })
+
+  it('imports in the previewed format on confirm', async () => {
+    mockImportBooks.mockResolvedValueOnce(preview).mockResolvedValueOnce({
+      ...preview,
+      importedCount: 2
+    })
+    render(<LibraryImporter />)
+
+    chooseFile()
+    fireEvent.click(await screen.findByRole('button', { name: 'Import' }))
+
+    expect(await screen.findByText('Imported 2 book(s): 1 new, 1 merged.')).toBeInTheDocument()
+    expect(mockImportBooks.mock.calls[1][1]).toEqual({ format: 'storygraph' })
+    expect(mockMutate).toHaveBeenCalled()
+    expect(screen.queryByTestId('import-preview')).not.toBeInTheDocument()
+  })
+
+  it('previews again when the format is changed', async () => {
+    mockImportBooks.mockResolvedValue(preview)
+    render(<LibraryImporter />)
+
+    chooseFile()
+    await screen.findByTestId('import-preview')
+    fireEvent.change(screen.getByLabelText('Import format'), { target: { value: 'goodreads' } })
+
+    await waitFor(() => expect(mockImportBooks).toHaveBeenCalledTimes(2))
+    expect(mockImportBooks.mock.calls[1][1]).toEqual({ format: 'goodreads', dryRun: true })
+  })
+
+  it('asks for a format when the file is not recognised', async () => {
+    mockImportBooks.mockRejectedValue(new Error('invalid'))
+    render(<LibraryImporter />)
+
+    chooseFile('???')
+
+    expect(
+      await screen.findByText('Could not read this file. Try picking its format.')
+    ).toBeInTheDocument()
+    expect(screen.queryByTestId('import-preview')).not.toBeInTheDocument()
+  })
+
+  it('cancels a preview without importing', async () => {
+    mockImportBooks.mockResolvedValue(preview)
+    render(<LibraryImporter />)
+
+    chooseFile()
+    fireEvent.click(await screen.findByRole('button', { name: 'Cancel' }))
+
+    expect(screen.queryByTestId('import-preview')).not.toBeInTheDocument()
+    expect(mockImportBooks).toHaveBeenCalledTimes(1)
+  })
+})
@@ -0,0 +1,112 @@
+import React from 'react'
+import { render, screen, fireEvent, waitFor } from '@testing-library/react'
+
+const mockCreateOPDSToken = jest.fn()
+const mockRevokeOPDSToken = jest.fn()
+const mockMutate = jest.fn()
+const mockUseListOPDSTokens = jest.fn()
+
+jest.mock('@/hooks/useBooks', () => ({
+  useListOPDSTokens: () => mockUseListOPDSTokens(),
+  useCreateOPDSToken: () => mockCreateOPDSToken,
+  useRevokeOPDSToken: () => mockRevokeOPDSToken
+}))
+
+jest.mock('@/lib/env', () => ({ getApiUrl: () => 'https://api.test' }))
+
+import OPDSTokens, { opdsCatalogUrls } from '@/components/books/OPDSTokens'
+
+const token = {
+  id: 'tok-1',
+  name: 'KOReader',
+  createdAt: '2024-01-01T00:00:00Z',
+  lastUsedAt: ''
+}
+
+beforeEach(() => {
+  mockCreateOPDSToken.mockReset()
+  mockRevokeOPDSToken.mockReset()
+  mockMutate.mockReset()
+  mockCreateOPDSToken.mockResolvedValue({ token, rawToken: 'raw-abc' })
+  mockRevokeOPDSToken.mockResolvedValue({})
+  mockUseListOPDSTokens.mockReturnValue({
+    data: { tokens: [] },
+    isLoading: false,
+    mutate: mockMutate
+  })
+})
+
+describe('opdsCatalogUrls', () => {
+  it('builds the OPDS 1.2 and 2.0 catalog URLs', () => {
+    expect(opdsCatalogUrls('raw-abc')).toEqual([
+      { label: 'OPDS 1.2', url: 'https://api.test/books/opds/raw-abc/v1.2' },
+      { label: 'OPDS 2.0', url: 'https://api.test/books/opds/raw-abc/v2' }
+    ])
+  })
+})
+
+describe('OPDSTokens', () => {
+  it('shows loading state', () => {
+    mockUseListOPDSTokens.mockReturnValue({ data: undefined, isLoading: true, mutate: mockMutate })
+    render(<OPDSTokens />)
+    expect(screen.getByTestId('opds-tokens-loading')).toBeInTheDocument()
+  })
+
+  it('shows empty message when there are no tokens', () => {
+    render(<OPDSTokens />)
+    expect(screen.getByTestId('opds-tokens-empty')).toBeInTheDocument()
+  })
+
+  it('lists tokens with their last use', () => {
+    mockUseListOPDSTokens.mockReturnValue({
+      data: { tokens: [token] },
+      isLoading: false,
+      mutate: mockMutate
+    })
+    render(<OPDSTokens />)
+    expect(screen.getByText('KOReader')).toBeInTheDocument()
+    expect(screen.getByText('Never used')).toBeInTheDocument()
+  })
+
+  it('disables create until a name is entered', () => {
+    render(<OPDSTokens />)
+    expect(screen.getByTestId('opds-create-btn')).toBeDisabled()
+    fireEvent.change(screen.getByTestId('opds-token-name'), { target: { value: 'KOReader' } })
+    expect(screen.getByTestId('opds-create-btn')).not.toBeDisabled()
+  })
+
+  it('creates a token and shows its catalog URLs once', async () => {
+    render(<OPDSTokens />)
+    fireEvent.change(screen.getByTestId('opds-token-name'), { target: { value: ' KOReader ' } })
+    fireEvent.click(screen.getByTestId('opds-create-btn'))
+
+    await waitFor(() => expect(screen.getByTestId('opds-new-token')).toBeInTheDocument())
+    expect(mockCreateOPDSToken).toHaveBeenCalledWith('KOReader')
+    expect(mockMutate).toHaveBeenCalled()
+    expect(screen.getByText('https://api.test/books/opds/raw-abc/v1.2')).toBeInTheDocument()
+    expect(screen.getByText('https://api.test/books/opds/raw-abc/v2')).toBeInTheDocument()
+  })
+
+  it('shows an error when creating fails', async () => {
+    mockCreateOPDSToken.mockRejectedValue(new Error('boom'))
+    render(<OPDSTokens />)
+    fireEvent.change(screen.getByTestId('opds-token-name'), { target: { value: 'KOReader' } })
+    fireEvent.click(screen.getByTestId('opds-create-btn'))
+
+    await waitFor(() => expect(screen.getByTestId('opds-error')).toBeInTheDocument())
+    expect(screen.queryByTestId('opds-new-token')).not.toBeInTheDocument()
+  })
+
+  it('revokes a token', async () => {
+    mockUseListOPDSTokens.mockReturnValue({
+      data: { tokens: [token] },
+      isLoading: false,
+      mutate: mockMutate
+    })
+    render(<OPDSTokens />)
+    fireEvent.click(screen.getByTestId('opds-revoke-btn-tok-1'))
+
+    await waitFor(() => expect(mockMutate).toHaveBeenCalled())
+    expect(mockRevokeOPDSToken).toHaveBeenCalledWith('tok-1')
+  })
+})
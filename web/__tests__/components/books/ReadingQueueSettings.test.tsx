@@ -0,0 +1,65 @@
+import React from 'react'
+import { render, screen, fireEvent, waitFor } from '@testing-library/react'
+
+const mockUseLibrary = jest.fn()
+const mockUpdateQueueSettings = jest.fn()
+const mockMutate = jest.fn()
+
+jest.mock('swr', () => ({
+  ...jest.requireActual('swr'),
+  mutate: (...args: unknown[]) => mockMutate(...args)
+}))
+
+jest.mock('@/hooks/useBooks', () => ({
+  useLibrary: () => mockUseLibrary(),
+  useUpdateQueueSettings: () => mockUpdateQueueSettings
+}))
+
+import ReadingQueueSettings from '@/components/books/ReadingQueueSettings'
+
+function setupLibrary(autoAdvance: boolean, koboSyncCount: number) {
+  mockUseLibrary.mockReturnValue({
+    data: { library: { queueSettings: { autoAdvance, koboSyncCount } } }
+  })
+}
+
+describe('ReadingQueueSettings', () => {
+  beforeEach(() => {
+    mockUseLibrary.mockReset()
+    mockUpdateQueueSettings.mockReset().mockResolvedValue({})
+    mockMutate.mockReset()
+    setupLibrary(false, 0)
+  })
+
+  it('shows the saved settings', () => {
+    setupLibrary(true, 3)
+    render(<ReadingQueueSettings />)
+    expect(screen.getByRole('checkbox')).toBeChecked()
+    expect(screen.getByLabelText('Books from the top of the queue to sync to Kobo')).toHaveValue(3)
+  })
+
+  it('disables saving until something changes', () => {
+    render(<ReadingQueueSettings />)
+    expect(screen.getByRole('button', { name: 'Save' })).toBeDisabled()
+  })
+
+  it('saves the settings and refreshes the library', async () => {
+    render(<ReadingQueueSettings />)
+    fireEvent.click(screen.getByRole('checkbox'))
+    fireEvent.change(screen.getByLabelText('Books from the top of the queue to sync to Kobo'), {
+      target: { value: '2' }
+    })
+    fireEvent.click(screen.getByRole('button', { name: 'Save' }))
+    await waitFor(() => expect(screen.getByText('Saved')).toBeInTheDocument())
+    expect(mockUpdateQueueSettings).toHaveBeenCalledWith(true, 2)
+    expect(mockMutate).toHaveBeenCalled()
+  })
+
+  it('reports a failed save', async () => {
+    mockUpdateQueueSettings.mockRejectedValue(new Error('boom'))
+    render(<ReadingQueueSettings />)
+    fireEvent.click(screen.getByRole('checkbox'))
+    fireEvent.click(screen.getByRole('button', { name: 'Save' }))
+    expect(await screen.findByText('Failed to save. Please try again.')).toBeInTheDocument()
+  })
+})
@@ -0,0 +1,76 @@
+import React from 'react'
+import { render, screen, fireEvent, waitFor } from '@testing-library/react'
+import { create } from '@bufbuild/protobuf'
+import { UserBookSchema } from '@/lib/gen/books/v1/library_pb'
+
+const mockQueueBook = jest.fn()
+const mockDequeueBook = jest.fn()
+const mockReorderQueue = jest.fn()
+
+jest.mock('@/hooks/useBooks', () => ({
+  useQueueBook: () => mockQueueBook,
+  useDequeueBook: () => mockDequeueBook,
+  useReorderQueue: () => mockReorderQueue
+}))
+
+import UpNextControls from '@/components/books/UpNextControls'
+
+function book(bookId: string) {
+  return create(UserBookSchema, { id: `ub-${bookId}`, bookId, status: 'to-read' })
+}
+
+const queue = [book('a'), book('b'), book('c')]
+
+describe('UpNextControls', () => {
+  beforeEach(() => {
+    mockQueueBook.mockReset().mockResolvedValue({})
+    mockDequeueBook.mockReset().mockResolvedValue({})
+    mockReorderQueue.mockReset().mockResolvedValue({})
+  })
+
+  it('queues a book that is not in the queue', async () => {
+    const onChanged = jest.fn()
+    render(<UpNextControls userBook={book('d')} upNext={queue} onChanged={onChanged} />)
+    fireEvent.click(screen.getByRole('button', { name: 'Add to up next' }))
+    await waitFor(() => expect(onChanged).toHaveBeenCalled())
+    expect(mockQueueBook).toHaveBeenCalledWith('d')
+  })
+
+  it('shows the position of a queued book', () => {
+    render(<UpNextControls userBook={queue[1]} upNext={queue} />)
+    expect(screen.getByTestId('up-next-position')).toHaveTextContent('#2 of 3')
+    expect(screen.queryByRole('button', { name: 'Add to up next' })).not.toBeInTheDocument()
+  })
+
+  it('sends the reordered queue when moving a book up', async () => {
+    render(<UpNextControls userBook={queue[1]} upNext={queue} />)
+    fireEvent.click(screen.getByRole('button', { name: 'Move up' }))
+    await waitFor(() => expect(mockReorderQueue).toHaveBeenCalledWith(['b', 'a', 'c']))
+  })
+
+  it('sends the reordered queue when moving a book down', async () => {
+    render(<UpNextControls userBook={queue[1]} upNext={queue} />)
+    fireEvent.click(screen.getByRole('button', { name: 'Move down' }))
+    await waitFor(() => expect(mockReorderQueue).toHaveBeenCalledWith(['a', 'c', 'b']))
+  })
+
+  it('disables moving past either end of the queue', () => {
+    const { rerender } = render(<UpNextControls userBook={queue[0]} upNext={queue} />)
+    expect(screen.getByRole('button', { name: 'Move up' })).toBeDisabled()
+    rerender(<UpNextControls userBook={queue[2]} upNext={queue} />)
+    expect(screen.getByRole('button', { name: 'Move down' })).toBeDisabled()
+  })
+
+  it('removes a book from the queue', async () => {
+    render(<UpNextControls userBook={queue[0]} upNext={queue} />)
+    fireEvent.click(screen.getByRole('button', { name: 'Remove from up next' }))
+    await waitFor(() => expect(mockDequeueBook).toHaveBeenCalledWith('a'))
+  })
+
+  it('shows the error when the update fails', async () => {
+    mockQueueBook.mockRejectedValue(new Error('only books on to-read can be queued'))
+    render(<UpNextControls userBook={book('d')} upNext={queue} />)
+    fireEvent.click(screen.getByRole('button', { name: 'Add to up next' }))
+    expect(await screen.findByText('only books on to-read can be queued')).toBeInTheDocument()
+  })
+})
@@ -20,6 +20,8 @@ function makeBook(
     coverUrl: string
     description: string
     pageCount: number
+    series: string
+    seriesPosition: number
   }> = {}
 ) {
   return {
@@ -29,7 +31,9 @@ function makeBook(
     isbn13: overrides.isbn13 ?? '',
     coverUrl: overrides.coverUrl ?? '',
     description: overrides.description ?? '',
-    pageCount: overrides.pageCount ?? 0
+    pageCount: overrides.pageCount ?? 0,
+    series: overrides.series ?? '',
+    seriesPosition: overrides.seriesPosition
   }
 }
 
@@ -294,6 +298,15 @@ describe('buildResolvedMetadata', () => {
     expect(result.pageCount).toBe(400)
   })
 
+  it('keeps the winner series', () => {
+    const winnerBook = makeBook({ id: 'w', series: 'The Expanse', seriesPosition: 2 })
+    const loserBook = makeBook({ id: 'l' })
+    const g = makeGroup([makeEntry('w', winnerBook), makeEntry('l', loserBook)])
+    const result = buildResolvedMetadata(g, { title: 'l', pageCount: 'l' })
+    expect(result.series).toBe('The Expanse')
+    expect(result.seriesPosition).toBe(2)
+  })
+
   it('excludes coverUrl from resolved metadata', () => {
     const winnerBook = makeBook({ id: 'w', coverUrl: 'https://example.com/w.jpg' })
     const loserBook = makeBook({ id: 'l', coverUrl: 'https://example.com/l.jpg' })
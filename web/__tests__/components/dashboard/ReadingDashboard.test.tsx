@@ -3,7 +3,11 @@ import { render, screen, fireEvent } from '@testing-library/react'
 
 jest.mock('@/hooks/useBooks', () => ({
   useLibrary: jest.fn(),
-  useBooksProgress: jest.fn()
+  useBooksProgress: jest.fn(),
+  useReadingStats: jest.fn(),
+  useReadingGoals: jest.fn(),
+  useSetReadingGoal: () => jest.fn(),
+  useDeleteReadingGoal: () => jest.fn()
 }))
 
 jest.mock('@/hooks/useFeeds', () => ({
@@ -45,7 +49,7 @@ jest.mock('@/components/dashboard/DashboardShareButton', () => {
 jest.mock('swr', () => ({ mutate: jest.fn() }))
 
 import ReadingDashboard from '@/components/dashboard/ReadingDashboard'
-import { useLibrary, useBooksProgress } from '@/hooks/useBooks'
+import { useLibrary, useBooksProgress, useReadingStats, useReadingGoals } from '@/hooks/useBooks'
 import { useFeedsSummary } from '@/hooks/useFeeds'
 import { create } from '@bufbuild/protobuf'
 import {
@@ -58,6 +62,8 @@ import {
 const mockUseBacklogLibrary = jest.mocked(useLibrary)
 const mockUseBooksProgress = jest.mocked(useBooksProgress)
 const mockUseFeedsSummary = jest.mocked(useFeedsSummary)
+const mockUseReadingStats = jest.mocked(useReadingStats)
+const mockUseReadingGoals = jest.mocked(useReadingGoals)
 
 const readingBook = create(UserBookSchema, {
   id: '1',
@@ -93,6 +99,19 @@ describe('ReadingDashboard', () => {
   beforeEach(() => {
     jest.clearAllMocks()
     mockUseFeedsSummary.mockReturnValue({ data: undefined, error: undefined, isLoading: false })
+    // @ts-expect-error -- mock returns partial SWRResponse for test purposes
+    mockUseReadingStats.mockReturnValue({ data: undefined })
+    // @ts-expect-error -- mock returns partial SWRResponse for test purposes
+    mockUseReadingGoals.mockReturnValue({ data: undefined })
+  })
+
+  it('shows the goals card with an editor once goals have loaded', () => {
+    mockLibrary()
+    // @ts-expect-error -- mock returns partial SWRResponse for test purposes
+    mockUseReadingGoals.mockReturnValue({ data: { year: 2026, goals: [] }, mutate: jest.fn() })
+    render(<ReadingDashboard />)
+    expect(screen.getByText('2026 reading goals')).toBeInTheDocument()
+    expect(screen.getByTestId('reading-goal-save')).toBeInTheDocument()
   })
 
   it('renders the stat cards derived from the library', () => {
@@ -12,11 +12,13 @@ import { GetSharedLibraryResponseSchema } from '@/lib/gen/dashboard/v1/reading_p
 const mockUseSharedLibrary = jest.fn()
 const mockUseSharedBooksProgress = jest.fn()
 const mockUseSharedFeedsSummary = jest.fn()
+const mockUseSharedReadingGoals = jest.fn()
 
 jest.mock('@/hooks/useDashboardShare', () => ({
   useSharedLibrary: () => mockUseSharedLibrary(),
   useSharedBooksProgress: () => mockUseSharedBooksProgress(),
-  useSharedFeedsSummary: () => mockUseSharedFeedsSummary()
+  useSharedFeedsSummary: () => mockUseSharedFeedsSummary(),
+  useSharedReadingGoals: () => mockUseSharedReadingGoals()
 }))
 
 jest.mock('@/components/books/BooksProgressChart', () => () => (
@@ -77,6 +79,29 @@ describe('ReadingDashboardPublicClient', () => {
     jest.clearAllMocks()
     mockUseSharedBooksProgress.mockReturnValue({ data: undefined })
     mockUseSharedFeedsSummary.mockReturnValue({ data: undefined })
+    mockUseSharedReadingGoals.mockReturnValue({ data: undefined })
+  })
+
+  it('shows shared goals without an editor', () => {
+    mockUseSharedLibrary.mockReturnValue({ data: makeLibrary() })
+    mockUseSharedReadingGoals.mockReturnValue({
+      data: {
+        goals: [
+          {
+            goal: { year: 2026, kind: 'books', target: 24, public: true },
+            current: 10,
+            expected: 12,
+            projected: 20,
+            pace: 'behind'
+          }
+        ]
+      }
+    })
+    render(<ReadingDashboardPublicClient token="tok-1" />)
+
+    expect(screen.getByText('10 / 24 books')).toBeInTheDocument()
+    expect(screen.getByText('Behind pace')).toBeInTheDocument()
+    expect(screen.queryByTestId('reading-goal-save')).not.toBeInTheDocument()
   })
 
   it('renders stat cards and last synced state', () => {
@@ -0,0 +1,69 @@
+import React from 'react'
+import { render, screen, fireEvent, waitFor } from '@testing-library/react'
+import { create } from '@bufbuild/protobuf'
+import { ReadingGoalProgressSchema, ReadingGoalSchema } from '@/lib/gen/books/v1/goals_pb'
+
+const mockSetReadingGoal = jest.fn()
+const mockDeleteReadingGoal = jest.fn()
+
+jest.mock('@/hooks/useBooks', () => ({
+  useSetReadingGoal: () => mockSetReadingGoal,
+  useDeleteReadingGoal: () => mockDeleteReadingGoal
+}))
+
+import ReadingGoalEditor from '@/components/dashboard/ReadingGoalEditor'
+
+const booksGoal = create(ReadingGoalProgressSchema, {
+  goal: create(ReadingGoalSchema, { year: 2026, kind: 'books', target: 24 })
+})
+
+describe('ReadingGoalEditor', () => {
+  beforeEach(() => jest.clearAllMocks())
+
+  it('disables saving until a positive target is entered', () => {
+    render(<ReadingGoalEditor year={2026} goals={[]} onChange={jest.fn()} />)
+    expect(screen.getByTestId('reading-goal-save')).toBeDisabled()
+    fireEvent.change(screen.getByTestId('reading-goal-target'), { target: { value: '0' } })
+    expect(screen.getByTestId('reading-goal-save')).toBeDisabled()
+  })
+
+  it('saves the goal with the chosen kind and sharing', async () => {
+    mockSetReadingGoal.mockResolvedValue({})
+    const onChange = jest.fn().mockResolvedValue(undefined)
+    render(<ReadingGoalEditor year={2026} goals={[]} onChange={onChange} />)
+
+    fireEvent.change(screen.getByTestId('reading-goal-target'), { target: { value: '5000' } })
+    fireEvent.change(screen.getByLabelText('Goal kind'), { target: { value: 'pages' } })
+    fireEvent.click(screen.getByLabelText('Show on shared dashboard'))
+    fireEvent.click(screen.getByTestId('reading-goal-save'))
+
+    await waitFor(() => expect(onChange).toHaveBeenCalled())
+    expect(mockSetReadingGoal).toHaveBeenCalledWith({
+      year: 2026,
+      kind: 'pages',
+      target: 5000,
+      public: true
+    })
+  })
+
+  it('shows an error when saving fails', async () => {
+    mockSetReadingGoal.mockRejectedValue(new Error('boom'))
+    render(<ReadingGoalEditor year={2026} goals={[]} onChange={jest.fn()} />)
+
+    fireEvent.change(screen.getByTestId('reading-goal-target'), { target: { value: '12' } })
+    fireEvent.click(screen.getByTestId('reading-goal-save'))
+
+    expect(await screen.findByTestId('reading-goal-error')).toBeInTheDocument()
+  })
+
+  it('removes an existing goal', async () => {
+    mockDeleteReadingGoal.mockResolvedValue({})
+    const onChange = jest.fn().mockResolvedValue(undefined)
+    render(<ReadingGoalEditor year={2026} goals={[booksGoal]} onChange={onChange} />)
+
+    fireEvent.click(screen.getByTestId('reading-goal-remove-books'))
+
+    await waitFor(() => expect(onChange).toHaveBeenCalled())
+    expect(mockDeleteReadingGoal).toHaveBeenCalledWith(2026, 'books')
+  })
+})
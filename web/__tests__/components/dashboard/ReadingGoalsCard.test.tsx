@@ -0,0 +1,49 @@
+import React from 'react'
+import { render, screen } from '@testing-library/react'
+import { create } from '@bufbuild/protobuf'
+import { ReadingGoalProgressSchema, ReadingGoalSchema } from '@/lib/gen/books/v1/goals_pb'
+import ReadingGoalsCard from '@/components/dashboard/ReadingGoalsCard'
+
+function progress(kind: string, target: number, current: number, pace: string) {
+  return create(ReadingGoalProgressSchema, {
+    goal: create(ReadingGoalSchema, { year: 2026, kind, target }),
+    current,
+    projected: current * 2,
+    pace
+  })
+}
+
+describe('ReadingGoalsCard', () => {
+  it('renders nothing without goals or actions', () => {
+    const { container } = render(<ReadingGoalsCard goals={[]} />)
+    expect(container).toBeEmptyDOMElement()
+  })
+
+  it('still renders the actions slot when there are no goals yet', () => {
+    render(<ReadingGoalsCard year={2026} goals={[]} actions={<button>Set goal</button>} />)
+    expect(screen.getByText('2026 reading goals')).toBeInTheDocument()
+    expect(screen.getByRole('button', { name: 'Set goal' })).toBeInTheDocument()
+  })
+
+  it('shows progress, pace and projection for each goal', () => {
+    render(
+      <ReadingGoalsCard
+        goals={[progress('books', 24, 14, 'ahead'), progress('pages', 8000, 3000, 'behind')]}
+      />
+    )
+
+    const books = screen.getByTestId('reading-goal-books')
+    expect(books).toHaveTextContent('14 / 24 books')
+    expect(books).toHaveTextContent('Ahead of pace')
+    expect(books).toHaveTextContent('on pace for 28')
+
+    const pages = screen.getByTestId('reading-goal-pages')
+    expect(pages).toHaveTextContent('3000 / 8000 pages')
+    expect(pages).toHaveTextContent('Behind pace')
+  })
+
+  it('caps the progress bar at 100%', () => {
+    render(<ReadingGoalsCard goals={[progress('books', 10, 15, 'ahead')]} />)
+    expect(screen.getByRole('progressbar')).toHaveAttribute('aria-valuenow', '100')
+  })
+})
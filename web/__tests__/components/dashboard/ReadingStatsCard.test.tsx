@@ -0,0 +1,58 @@
+import React from 'react'
+import { render, screen } from '@testing-library/react'
+import { create } from '@bufbuild/protobuf'
+import {
+  BookReadingStatsSchema,
+  GetReadingStatsResponseSchema,
+  ReadingDaySchema
+} from '@/lib/gen/books/v1/library_pb'
+import ReadingStatsCard, { formatReadingTime } from '@/components/dashboard/ReadingStatsCard'
+
+describe('formatReadingTime', () => {
+  it('formats minutes and hours', () => {
+    expect(formatReadingTime(45 * 60)).toBe('45m')
+    expect(formatReadingTime(2 * 3600)).toBe('2h')
+    expect(formatReadingTime(3 * 3600 + 20 * 60)).toBe('3h 20m')
+  })
+})
+
+describe('ReadingStatsCard', () => {
+  it('renders nothing without stats', () => {
+    const { container } = render(<ReadingStatsCard stats={undefined} />)
+    expect(container).toBeEmptyDOMElement()
+  })
+
+  it('renders nothing when no books were read', () => {
+    const { container } = render(
+      <ReadingStatsCard stats={create(GetReadingStatsResponseSchema, {})} />
+    )
+    expect(container).toBeEmptyDOMElement()
+  })
+
+  it('shows total reading time and estimates for books in progress', () => {
+    const stats = create(GetReadingStatsResponseSchema, {
+      days: [
+        create(ReadingDaySchema, { date: '2024-03-01', bookId: 'b1', seconds: 1800 }),
+        create(ReadingDaySchema, { date: '2024-03-02', bookId: 'b1', seconds: 3600 }),
+        create(ReadingDaySchema, { date: '2024-03-02', bookId: 'b2', seconds: 600 })
+      ],
+      books: [
+        create(BookReadingStatsSchema, {
+          bookId: 'b1',
+          title: 'Dune',
+          percentPerHour: 12.5,
+          remainingSeconds: 7200,
+          estimatedFinish: '2024-03-20'
+        }),
+        create(BookReadingStatsSchema, { bookId: 'b2', title: 'Finished', remainingSeconds: 0 })
+      ]
+    })
+    render(<ReadingStatsCard stats={stats} />)
+
+    expect(screen.getByText('1h 40m over 2 days')).toBeInTheDocument()
+    expect(screen.getByTestId('reading-stats-book-b1')).toHaveTextContent(
+      'Dune12.5%/h2h leftdone by 20/03/2024'
+    )
+    expect(screen.queryByTestId('reading-stats-book-b2')).not.toBeInTheDocument()
+  })
+})
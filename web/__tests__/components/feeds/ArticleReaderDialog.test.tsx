@@ -1,10 +1,11 @@
-import { render, screen, fireEvent } from '@testing-library/react'
+import { render, screen, fireEvent, waitFor } from '@testing-library/react'
 import { forwardRef, useImperativeHandle } from 'react'
 import { create } from '@bufbuild/protobuf'
 import { ItemSchema } from '@/lib/gen/feeds/v1/feeds_pb'
 
 const markRead = jest.fn()
 const updateItem = jest.fn()
+const sendItemToLibrary = jest.fn()
 
 jest.mock('@/components/feeds/FeedBookmarkButton', () => () => (
   <div data-testid="bookmark-button" />
@@ -22,6 +23,7 @@ let mockBody = ''
 let mockLoading = false
 jest.mock('@/hooks/useFeeds', () => ({
   useUpdateItem: () => updateItem,
+  useSendItemToLibrary: () => sendItemToLibrary,
   useFeedItem: (id: string | null) => ({
     data: id && mockBody ? { item: { contentHtml: mockBody } } : undefined,
     isLoading: mockLoading
@@ -43,6 +45,7 @@ describe('ArticleReaderDialog', () => {
     markRead.mockReset()
     updateItem.mockReset()
     updateItem.mockResolvedValue({})
+    sendItemToLibrary.mockReset()
     mockBody = ''
     mockLoading = false
   })
@@ -308,4 +311,63 @@ describe('ArticleReaderDialog', () => {
     fireEvent.click(screen.getByText('Body'))
     expect(screen.queryByRole('button', { name: 'Close image' })).not.toBeInTheDocument()
   })
+
+  it('sends the item to the library and then links to the entry', async () => {
+    sendItemToLibrary.mockResolvedValue({ item: { libraryBookId: 'book-1' } })
+    const item = readerItem({ id: 'item-1', title: 'Long Read', contentHtml: '<p>Body</p>' })
+    render(
+      <ArticleReaderDialog
+        item={item}
+        open
+        onOpenChange={jest.fn()}
+        onMarkRead={jest.fn()}
+        onSettled={jest.fn()}
+      />
+    )
+
+    fireEvent.click(screen.getByRole('button', { name: 'Send to library' }))
+    expect(sendItemToLibrary).toHaveBeenCalledWith('item-1')
+    await waitFor(() =>
+      expect(screen.getByRole('link', { name: 'In library' })).toHaveAttribute(
+        'href',
+        '/books/book-1'
+      )
+    )
+  })
+
+  it('links to the library entry of an item already sent', () => {
+    const item = readerItem({
+      id: 'item-1',
+      title: 'Sent',
+      contentHtml: '<p>Body</p>',
+      libraryBookId: 'book-1'
+    })
+    render(
+      <ArticleReaderDialog
+        item={item}
+        open
+        onOpenChange={jest.fn()}
+        onMarkRead={jest.fn()}
+        onSettled={jest.fn()}
+      />
+    )
+
+    expect(screen.queryByRole('button', { name: 'Send to library' })).not.toBeInTheDocument()
+    expect(screen.getByRole('link', { name: 'In library' })).toHaveAttribute('href', '/books/book-1')
+  })
+
+  it('offers no send for an item without content', () => {
+    const item = readerItem({ id: 'item-1', title: 'Empty', contentHtml: '' })
+    render(
+      <ArticleReaderDialog
+        item={item}
+        open
+        onOpenChange={jest.fn()}
+        onMarkRead={jest.fn()}
+        onSettled={jest.fn()}
+      />
+    )
+
+    expect(screen.queryByRole('button', { name: 'Send to library' })).not.toBeInTheDocument()
+  })
 })
@@ -1,19 +1,38 @@
-import { render, screen, fireEvent, waitFor } from '@testing-library/react'
+import { render, screen, fireEvent, waitFor, within } from '@testing-library/react'
 
 const mockUseFeeds = jest.fn()
 const deleteFeed = jest.fn()
 const refreshFeed = jest.fn()
+const moveFeed = jest.fn()
 
 jest.mock('@/hooks/useFeeds', () => ({
   useFeeds: () => mockUseFeeds(),
   useDeleteFeed: () => deleteFeed,
   useRefreshFeed: () => refreshFeed,
+  useMoveFeed: () => moveFeed,
   useCreateFeed: () => jest.fn()
 }))
 jest.mock('@/lib/gen/feeds/v1/feeds_pb', () => ({
   FeedKind: { RSS: 1, EMAIL: 2 }
 }))
 
+jest.mock('@/components/feeds/OPMLTransfer', () => ({
+  __esModule: true,
+  default: () => <div data-testid="opml-transfer" />
+}))
+jest.mock('@/components/feeds/FolderManager', () => ({
+  __esModule: true,
+  default: () => <div data-testid="folder-manager" />
+}))
+jest.mock('@/components/feeds/FilterRulesManager', () => ({
+  __esModule: true,
+  default: () => <div data-testid="filter-rules-manager" />
+}))
+jest.mock('@/components/feeds/ReaderAppPasswords', () => ({
+  __esModule: true,
+  default: () => <div data-testid="reader-app-passwords" />
+}))
+
 import FeedManager from '@/components/feeds/FeedManager'
 
 const rssFeed = {
@@ -22,7 +41,9 @@ const rssFeed = {
   title: 'Example Blog',
   lastFetchedAt: '',
   lastError: '',
-  sourceType: 'rss'
+  sourceType: 'rss',
+  folderId: '',
+  unreadCount: 0
 }
 
 const emailFeed = {
@@ -31,7 +52,9 @@ const emailFeed = {
   title: 'My Substack',
   lastFetchedAt: '',
   lastError: '',
-  sourceType: 'email'
+  sourceType: 'email',
+  folderId: '',
+  unreadCount: 0
 }
 
 describe('FeedManager', () => {
@@ -107,4 +130,39 @@ describe('FeedManager', () => {
     expect(screen.queryByRole('button', { name: 'Refresh' })).not.toBeInTheDocument()
     expect(screen.getByText(/Waiting for your first email/)).toBeInTheDocument()
   })
+
+  it('hides the folder picker until there are folders', () => {
+    mockUseFeeds.mockReturnValue({ data: { feeds: [rssFeed] }, error: undefined, isLoading: false })
+    render(<FeedManager />)
+    expect(screen.queryByRole('combobox', { name: 'Folder' })).not.toBeInTheDocument()
+  })
+
+  it('shows unread counts and moves a feed into a folder', async () => {
+    mockUseFeeds.mockReturnValue({
+      data: {
+        feeds: [{ ...rssFeed, unreadCount: 4 }],
+        folders: [
+          { id: 'tech', name: 'Tech', parentId: '', position: 0, unreadCount: 4 },
+          { id: 'go', name: 'Go', parentId: 'tech', position: 0, unreadCount: 0 }
+        ]
+      },
+      error: undefined,
+      isLoading: false
+    })
+    moveFeed.mockResolvedValue(undefined)
+    render(<FeedManager />)
+
+    expect(screen.getByText('4 unread')).toBeInTheDocument()
+    const picker = screen.getByRole('combobox', { name: 'Folder' })
+    expect(within(picker).getAllByRole('option').map((o) => o.textContent)).toEqual([
+      'No folder',
+      'Tech',
+      '\u00a0\u00a0Go'
+    ])
+
+    fireEvent.change(picker, { target: { value: 'go' } })
+    await waitFor(() => {
+      expect(moveFeed).toHaveBeenCalledWith('feed-1', 'go')
+    })
+  })
 })
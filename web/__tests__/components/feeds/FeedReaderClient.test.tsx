@@ -1,17 +1,34 @@
-import { render, screen, fireEvent, waitFor } from '@testing-library/react'
+import { render, screen, fireEvent, waitFor, within } from '@testing-library/react'
 import { create } from '@bufbuild/protobuf'
-import { ItemSchema, FeedSchema } from '@/lib/gen/feeds/v1/feeds_pb'
+import { ItemSchema, FeedSchema, FolderSchema } from '@/lib/gen/feeds/v1/feeds_pb'
 
 const mockUseFeeds = jest.fn()
 const mockUseFeedItems = jest.fn()
 const mockUseFetchFeedItemsPage = jest.fn()
+const mockUseFeedItem = jest.fn()
+const replace = jest.fn()
+let mockSearchParams = new URLSearchParams()
+
+jest.mock('next/navigation', () => ({
+  useRouter: () => ({ replace }),
+  useSearchParams: () => mockSearchParams
+}))
 
 jest.mock('@/hooks/useFeeds', () => ({
   useFeeds: () => mockUseFeeds(),
-  useFeedItems: (unreadOnly: boolean, feedId?: string, bookmarkedOnly?: boolean) =>
-    mockUseFeedItems(unreadOnly, feedId, bookmarkedOnly),
-  useFetchFeedItemsPage: (unreadOnly: boolean, feedId?: string, bookmarkedOnly?: boolean) =>
-    mockUseFetchFeedItemsPage(unreadOnly, feedId, bookmarkedOnly),
+  useFeedItems: (
+    unreadOnly: boolean,
+    feedId?: string,
+    bookmarkedOnly?: boolean,
+    folderId?: string
+  ) => mockUseFeedItems(unreadOnly, feedId, bookmarkedOnly, folderId),
+  useFetchFeedItemsPage: (
+    unreadOnly: boolean,
+    feedId?: string,
+    bookmarkedOnly?: boolean,
+    folderId?: string
+  ) => mockUseFetchFeedItemsPage(unreadOnly, feedId, bookmarkedOnly, folderId),
+  useFeedItem: (itemId: string | null) => mockUseFeedItem(itemId),
   useUpdateItem: () => jest.fn()
 }))
 
@@ -55,6 +72,8 @@ describe('FeedReaderClient', () => {
   beforeEach(() => {
     jest.clearAllMocks()
     window.localStorage.clear()
+    mockSearchParams = new URLSearchParams()
+    mockUseFeedItem.mockReturnValue({ data: undefined })
     mockUseFeeds.mockReturnValue({
       data: { feeds: [create(FeedSchema, { id: 'feed-1', title: 'Example Blog' })] }
     })
@@ -94,7 +113,7 @@ describe('FeedReaderClient', () => {
       isLoading: false
     })
     render(<FeedReaderClient />)
-    expect(mockUseFeedItems).toHaveBeenCalledWith(true, undefined, false)
+    expect(mockUseFeedItems).toHaveBeenCalledWith(true, undefined, false, undefined)
   })
 
   it('filters by the selected feed', () => {
@@ -106,10 +125,37 @@ describe('FeedReaderClient', () => {
     render(<FeedReaderClient />)
 
     fireEvent.change(screen.getByRole('combobox'), { target: { value: 'feed-1' } })
-    expect(mockUseFeedItems).toHaveBeenLastCalledWith(true, 'feed-1', false)
+    expect(mockUseFeedItems).toHaveBeenLastCalledWith(true, 'feed-1', false, undefined)
 
     fireEvent.change(screen.getByRole('combobox'), { target: { value: '' } })
-    expect(mockUseFeedItems).toHaveBeenLastCalledWith(true, undefined, false)
+    expect(mockUseFeedItems).toHaveBeenLastCalledWith(true, undefined, false, undefined)
+  })
+
+  it('offers folders with unread counts and filters by the selected folder', () => {
+    mockUseFeeds.mockReturnValue({
+      data: {
+        feeds: [create(FeedSchema, { id: 'feed-1', title: 'Example Blog', unreadCount: 2 })],
+        folders: [
+          create(FolderSchema, { id: 'sub', name: 'Go', parentId: 'tech', unreadCount: 2 }),
+          create(FolderSchema, { id: 'tech', name: 'Tech', unreadCount: 2 })
+        ]
+      }
+    })
+    mockUseFeedItems.mockReturnValue({
+      data: { items: [item('1')], hasMore: false },
+      error: undefined,
+      isLoading: false
+    })
+    render(<FeedReaderClient />)
+
+    const options = within(screen.getByRole('combobox'))
+      .getAllByRole('option')
+      .map((o) => o.textContent)
+    expect(options).toEqual(['All feeds', 'Tech (2)', '\u00a0\u00a0Go (2)', 'Example Blog (2)'])
+
+    fireEvent.change(screen.getByRole('combobox'), { target: { value: 'folder:tech' } })
+    expect(mockUseFeedItems).toHaveBeenLastCalledWith(true, undefined, false, 'tech')
+    expect(mockUseFetchFeedItemsPage).toHaveBeenLastCalledWith(true, undefined, false, 'tech')
   })
 
   it('lists items with their feed title (server already filters read/dismissed)', () => {
@@ -125,6 +171,26 @@ describe('FeedReaderClient', () => {
     expect(screen.getAllByText('Example Blog')).toHaveLength(2)
   })
 
+  it('shows the author and the tags filter rules gave an item', () => {
+    const tagged = create(ItemSchema, {
+      id: '5',
+      feedId: 'feed-1',
+      title: 'Item 5',
+      author: 'Ann Author',
+      tags: ['release', 'go']
+    })
+    mockUseFeedItems.mockReturnValue({
+      data: { items: [tagged], hasMore: false },
+      error: undefined,
+      isLoading: false
+    })
+    render(<FeedReaderClient />)
+
+    expect(screen.getByText('Example Blog · Ann Author')).toBeInTheDocument()
+    expect(screen.getByText('release')).toBeInTheDocument()
+    expect(screen.getByText('go')).toBeInTheDocument()
+  })
+
   it('shows a no-content hint for items without stored HTML', () => {
     const noContentItem = create(ItemSchema, { id: '4', title: 'Item 4', contentHtml: '' })
     mockUseFeedItems.mockReturnValue({
@@ -309,10 +375,10 @@ describe('FeedReaderClient', () => {
     render(<FeedReaderClient />)
 
     fireEvent.click(screen.getByRole('button', { name: 'Show read items' }))
-    expect(mockUseFeedItems).toHaveBeenLastCalledWith(false, undefined, false)
+    expect(mockUseFeedItems).toHaveBeenLastCalledWith(false, undefined, false, undefined)
 
     fireEvent.click(screen.getByRole('button', { name: 'Show unread only' }))
-    expect(mockUseFeedItems).toHaveBeenLastCalledWith(true, undefined, false)
+    expect(mockUseFeedItems).toHaveBeenLastCalledWith(true, undefined, false, undefined)
   })
 
   it('toggles to show bookmarked items and back, switching the bookmarked_only query', () => {
@@ -324,10 +390,10 @@ describe('FeedReaderClient', () => {
     render(<FeedReaderClient />)
 
     fireEvent.click(screen.getByRole('button', { name: 'Show bookmarked' }))
-    expect(mockUseFeedItems).toHaveBeenLastCalledWith(false, undefined, true)
+    expect(mockUseFeedItems).toHaveBeenLastCalledWith(false, undefined, true, undefined)
 
     fireEvent.click(screen.getByRole('button', { name: 'Show all' }))
-    expect(mockUseFeedItems).toHaveBeenLastCalledWith(true, undefined, false)
+    expect(mockUseFeedItems).toHaveBeenLastCalledWith(true, undefined, false, undefined)
   })
 
   it('keeps read items in the bookmarked view and hides the read/unread toggle', () => {
@@ -340,12 +406,12 @@ describe('FeedReaderClient', () => {
 
     fireEvent.click(screen.getByRole('button', { name: 'Show read items' }))
     fireEvent.click(screen.getByRole('button', { name: 'Show bookmarked' }))
-    expect(mockUseFeedItems).toHaveBeenLastCalledWith(false, undefined, true)
+    expect(mockUseFeedItems).toHaveBeenLastCalledWith(false, undefined, true, undefined)
     expect(screen.queryByRole('button', { name: 'Show unread only' })).toBeNull()
 
     // The pre-bookmark unread/read choice is restored on the way out.
     fireEvent.click(screen.getByRole('button', { name: 'Show all' }))
-    expect(mockUseFeedItems).toHaveBeenLastCalledWith(false, undefined, false)
+    expect(mockUseFeedItems).toHaveBeenLastCalledWith(false, undefined, false, undefined)
   })
 
   it('shows an empty state specific to bookmarked items when none match', () => {
@@ -376,4 +442,20 @@ describe('FeedReaderClient', () => {
     await waitFor(() => expect(screen.getByText('Item 2')).toBeInTheDocument())
     expect(fetchPage).toHaveBeenCalledWith(1)
   })
+
+  it('opens the item a library entry links back to, and drops the link on close', () => {
+    mockSearchParams = new URLSearchParams('item=linked')
+    mockUseFeedItem.mockReturnValue({ data: { item: item('linked') } })
+    mockUseFeedItems.mockReturnValue({
+      data: { items: [item('1')], hasMore: false },
+      error: undefined,
+      isLoading: false
+    })
+    render(<FeedReaderClient />)
+
+    expect(mockUseFeedItem).toHaveBeenCalledWith('linked')
+    const reader = screen.getByTestId('reader-open')
+    fireEvent.click(within(reader).getByRole('button', { name: 'Close reader' }))
+    expect(replace).toHaveBeenCalledWith('/feeds')
+  })
 })
@@ -0,0 +1,147 @@
+import { render, screen, fireEvent, waitFor } from '@testing-library/react'
+import { ConnectError, Code } from '@connectrpc/connect'
+
+const mockUseFilterRules = jest.fn()
+const createRule = jest.fn()
+const updateRule = jest.fn()
+const deleteRule = jest.fn()
+const testRule = jest.fn()
+
+jest.mock('@/hooks/useFeeds', () => ({
+  useFilterRules: () => mockUseFilterRules(),
+  useCreateFilterRule: () => createRule,
+  useUpdateFilterRule: () => updateRule,
+  useDeleteFilterRule: () => deleteRule,
+  useTestFilterRule: () => testRule
+}))
+
+import FilterRulesManager, { describeRule } from '@/components/feeds/FilterRulesManager'
+import type { Feed, FilterRule } from '@/lib/gen/feeds/v1/feeds_pb'
+
+const feeds = [{ id: 'feed-1', title: 'Example Blog', url: 'https://blog.example.com' }] as Feed[]
+
+const savedRule = {
+  id: 'rule-1',
+  feedId: 'feed-1',
+  field: 'title',
+  matchType: 'keyword',
+  pattern: 'sponsored',
+  action: 'dismiss',
+  tag: '',
+  enabled: true,
+  createdAt: ''
+} as FilterRule
+
+function fillPattern(value: string) {
+  fireEvent.change(screen.getByLabelText('Rule pattern'), { target: { value } })
+}
+
+describe('describeRule', () => {
+  it('reads as a sentence', () => {
+    expect(describeRule(savedRule, feeds)).toBe(
+      'Example Blog: title contains "sponsored" → Dismiss'
+    )
+    const tagRule = {
+      ...savedRule,
+      feedId: '',
+      matchType: 'regex',
+      pattern: 'v\\d+',
+      action: 'tag',
+      tag: 'release'
+    }
+    expect(describeRule(tagRule, feeds)).toBe('All feeds: title matches /v\\d+/ → Tag "release"')
+  })
+})
+
+describe('FilterRulesManager', () => {
+  beforeEach(() => {
+    jest.clearAllMocks()
+    mockUseFilterRules.mockReturnValue({ data: { rules: [savedRule] }, error: undefined })
+  })
+
+  it('lists saved rules and toggles and deletes them', async () => {
+    updateRule.mockResolvedValue({})
+    deleteRule.mockResolvedValue(undefined)
+    render(<FilterRulesManager feeds={feeds} />)
+
+    expect(screen.getByTestId('rule-rule-1')).toHaveTextContent(
+      'Example Blog: title contains "sponsored" → Dismiss'
+    )
+    fireEvent.click(screen.getByLabelText('Enabled'))
+    await waitFor(() =>
+      expect(updateRule).toHaveBeenCalledWith('rule-1', {
+        feedId: 'feed-1',
+        field: 'title',
+        matchType: 'keyword',
+        pattern: 'sponsored',
+        action: 'dismiss',
+        tag: '',
+        enabled: false
+      })
+    )
+
+    fireEvent.click(screen.getByRole('button', { name: 'Delete' }))
+    await waitFor(() => expect(deleteRule).toHaveBeenCalledWith('rule-1'))
+  })
+
+  it('previews a draft rule against recent items', async () => {
+    testRule.mockResolvedValue({
+      matches: [{ id: 'item-1', title: 'Sponsored: buy this' }],
+      checkedCount: 50
+    })
+    render(<FilterRulesManager feeds={feeds} />)
+
+    fillPattern('sponsored')
+    fireEvent.click(screen.getByRole('button', { name: 'Preview' }))
+
+    const preview = await screen.findByTestId('rule-preview')
+    expect(preview).toHaveTextContent('Matches 1 of the last 50 item(s).')
+    expect(preview).toHaveTextContent('Sponsored: buy this')
+    expect(testRule).toHaveBeenCalledWith(expect.objectContaining({ pattern: 'sponsored' }))
+    expect(createRule).not.toHaveBeenCalled()
+
+    // Editing the draft drops the now-stale preview.
+    fillPattern('sponsor')
+    expect(screen.queryByTestId('rule-preview')).not.toBeInTheDocument()
+  })
+
+  it('asks for a tag before a tag rule can be saved', async () => {
+    createRule.mockResolvedValue({})
+    render(<FilterRulesManager feeds={feeds} />)
+
+    fireEvent.change(screen.getByLabelText('Rule field'), { target: { value: 'content' } })
+    fireEvent.change(screen.getByLabelText('Rule match'), { target: { value: 'regex' } })
+    fillPattern('v\\d+')
+    fireEvent.change(screen.getByLabelText('Rule action'), { target: { value: 'tag' } })
+    expect(screen.getByRole('button', { name: 'Save rule' })).toBeDisabled()
+
+    fireEvent.change(screen.getByLabelText('Rule tag'), { target: { value: 'release' } })
+    fireEvent.click(screen.getByRole('button', { name: 'Save rule' }))
+
+    await waitFor(() =>
+      expect(createRule).toHaveBeenCalledWith({
+        feedId: '',
+        field: 'content',
+        matchType: 'regex',
+        pattern: 'v\\d+',
+        action: 'tag',
+        tag: 'release',
+        enabled: true
+      })
+    )
+    await waitFor(() => expect(screen.getByLabelText('Rule pattern')).toHaveValue(''))
+  })
+
+  it('shows why the server rejected a rule', async () => {
+    testRule.mockRejectedValue(
+      new ConnectError('invalid filter rule: missing closing )', Code.InvalidArgument)
+    )
+    render(<FilterRulesManager feeds={feeds} />)
+
+    fireEvent.change(screen.getByLabelText('Rule match'), { target: { value: 'regex' } })
+    fillPattern('(')
+    fireEvent.click(screen.getByRole('button', { name: 'Preview' }))
+
+    expect(await screen.findByText('invalid filter rule: missing closing )')).toBeInTheDocument()
+  })
+})
@@ -0,0 +1,99 @@
+import { render, screen, fireEvent, waitFor, within } from '@testing-library/react'
+import { ConnectError, Code } from '@connectrpc/connect'
+
+const createFolder = jest.fn()
+const renameFolder = jest.fn()
+const deleteFolder = jest.fn()
+const reorderFolders = jest.fn()
+
+jest.mock('@/hooks/useFeeds', () => ({
+  useCreateFolder: () => createFolder,
+  useRenameFolder: () => renameFolder,
+  useDeleteFolder: () => deleteFolder,
+  useReorderFolders: () => reorderFolders
+}))
+
+import FolderManager from '@/components/feeds/FolderManager'
+import type { Folder } from '@/lib/gen/feeds/v1/feeds_pb'
+
+function folder(id: string, name: string, position: number, parentId = '', unreadCount = 0) {
+  return { id, name, position, parentId, unreadCount } as Folder
+}
+
+const folders = [
+  folder('news', 'News', 1),
+  folder('tech', 'Tech', 0, '', 3),
+  folder('go', 'Go', 0, 'tech'),
+  folder('rust', 'Rust', 1, 'tech')
+]
+
+describe('FolderManager', () => {
+  beforeEach(() => {
+    jest.clearAllMocks()
+  })
+
+  it('lists folders as a tree with unread counts', () => {
+    render(<FolderManager folders={folders} />)
+    const rows = screen.getAllByRole('listitem').map((li) => li.getAttribute('data-testid'))
+    expect(rows).toEqual(['folder-tech', 'folder-go', 'folder-rust', 'folder-news'])
+    expect(screen.getByText('Tech (3)')).toBeInTheDocument()
+  })
+
+  it('creates a subfolder under the chosen top-level folder', async () => {
+    createFolder.mockResolvedValue({})
+    render(<FolderManager folders={folders} />)
+
+    fireEvent.change(screen.getByLabelText('New folder name'), { target: { value: ' Zig ' } })
+    const parent = screen.getByRole('combobox', { name: 'Parent folder' })
+    // Only top-level folders can hold subfolders.
+    expect(within(parent).getAllByRole('option').map((o) => o.textContent)).toEqual([
+      'Top level',
+      'In Tech',
+      'In News'
+    ])
+    fireEvent.change(parent, { target: { value: 'tech' } })
+    fireEvent.click(screen.getByRole('button', { name: 'Add folder' }))
+
+    await waitFor(() => expect(createFolder).toHaveBeenCalledWith('Zig', 'tech'))
+    expect(screen.getByLabelText('New folder name')).toHaveValue('')
+  })
+
+  it('explains a duplicate folder name', async () => {
+    createFolder.mockRejectedValue(new ConnectError('exists', Code.AlreadyExists))
+    render(<FolderManager folders={folders} />)
+
+    fireEvent.change(screen.getByLabelText('New folder name'), { target: { value: 'News' } })
+    fireEvent.click(screen.getByRole('button', { name: 'Add folder' }))
+
+    expect(
+      await screen.findByText('A folder with that name already exists there.')
+    ).toBeInTheDocument()
+  })
+
+  it('moves a subfolder among its siblings only', async () => {
+    reorderFolders.mockResolvedValue(undefined)
+    render(<FolderManager folders={folders} />)
+
+    const go = within(screen.getByTestId('folder-go'))
+    expect(go.getByRole('button', { name: 'Move up' })).toBeDisabled()
+    fireEvent.click(go.getByRole('button', { name: 'Move down' }))
+
+    await waitFor(() => expect(reorderFolders).toHaveBeenCalledWith(['rust', 'go']))
+  })
+
+  it('renames and deletes a folder', async () => {
+    renameFolder.mockResolvedValue(undefined)
+    deleteFolder.mockResolvedValue(undefined)
+    render(<FolderManager folders={folders} />)
+
+    const news = within(screen.getByTestId('folder-news'))
+    fireEvent.click(news.getByRole('button', { name: 'Rename' }))
+    fireEvent.change(news.getByLabelText('Folder name'), { target: { value: 'Daily' } })
+    fireEvent.click(news.getByRole('button', { name: 'Save' }))
+    await waitFor(() => expect(renameFolder).toHaveBeenCalledWith('news', 'Daily'))
+    expect(news.queryByLabelText('Folder name')).not.toBeInTheDocument()
+
+    fireEvent.click(news.getByRole('button', { name: 'Delete' }))
+    await waitFor(() => expect(deleteFolder).toHaveBeenCalledWith('news'))
+  })
+})
@@ -0,0 +1,103 @@
+import { render, screen, fireEvent, waitFor } from '@testing-library/react'
+
+const importOPML = jest.fn()
+const exportOPML = jest.fn()
+const mockSaveFile = jest.fn()
+
+jest.mock('@/hooks/useFeeds', () => ({
+  useImportOPML: () => importOPML,
+  useExportOPML: () => exportOPML
+}))
+
+jest.mock('@/lib/books/download', () => ({
+  saveFile: (...args: unknown[]) => mockSaveFile(...args)
+}))
+
+import OPMLTransfer from '@/components/feeds/OPMLTransfer'
+
+function chooseFile() {
+  const file = new File(['<opml version="2.0"><body/></opml>'], 'subs.opml', {
+    type: 'text/x-opml'
+  })
+  fireEvent.change(screen.getByTestId('opml-file'), { target: { files: [file] } })
+}
+
+describe('OPMLTransfer', () => {
+  beforeEach(() => {
+    jest.clearAllMocks()
+  })
+
+  it('asks for another import when feeds were deferred', async () => {
+    importOPML.mockResolvedValue({
+      createdCount: 1,
+      duplicateCount: 0,
+      failedCount: 0,
+      results: [
+        { url: 'https://a.example.com/feed', title: 'A', status: 'created', error: '' },
+        { url: 'https://b.example.com/feed', title: 'B', status: 'deferred', error: 'later' }
+      ]
+    })
+    render(<OPMLTransfer />)
+    chooseFile()
+
+    expect(
+      await screen.findByText(
+        'Added 1 feed(s); 0 already subscribed, 0 failed. ' +
+          '1 more left: import the file again to add them.'
+      )
+    ).toBeInTheDocument()
+    expect(screen.queryByTestId('opml-failed')).not.toBeInTheDocument()
+  })
+
+  it('imports a file and lists the outlines that failed', async () => {
+    importOPML.mockResolvedValue({
+      createdCount: 2,
+      duplicateCount: 1,
+      failedCount: 2,
+      results: [
+        { url: 'https://a.example.com/feed', title: 'A', status: 'created', error: '' },
+        {
+          url: 'https://gone.example.com/feed',
+          title: 'Gone',
+          status: 'unreachable',
+          error: '404'
+        },
+        { url: 'https://b.example.com/page', title: '', status: 'invalid', error: '' }
+      ]
+    })
+    render(<OPMLTransfer />)
+    chooseFile()
+
+    expect(
+      await screen.findByText('Added 2 feed(s); 1 already subscribed, 2 failed.')
+    ).toBeInTheDocument()
+    expect(importOPML).toHaveBeenCalledWith(expect.any(Uint8Array))
+    const failed = screen.getByTestId('opml-failed')
+    expect(failed).toHaveTextContent('Gone could not be reached — 404')
+    expect(failed).toHaveTextContent('https://b.example.com/page is not a feed')
+    expect(failed).not.toHaveTextContent('https://a.example.com/feed')
+  })
+
+  it('reports a file that could not be imported', async () => {
+    importOPML.mockRejectedValue(new Error('invalid'))
+    render(<OPMLTransfer />)
+    chooseFile()
+    expect(
+      await screen.findByText('Could not import this file. Is it an OPML export?')
+    ).toBeInTheDocument()
+  })
+
+  it('downloads the export', async () => {
+    const data = new Uint8Array([1, 2, 3])
+    exportOPML.mockResolvedValue({
+      data,
+      filename: 'feeds-2026-10-18.opml',
+      contentType: 'text/x-opml+xml'
+    })
+    render(<OPMLTransfer />)
+    fireEvent.click(screen.getByRole('button', { name: 'Export OPML' }))
+    await waitFor(() =>
+      expect(mockSaveFile).toHaveBeenCalledWith(data, 'feeds-2026-10-18.opml', 'text/x-opml+xml')
+    )
+  })
+})
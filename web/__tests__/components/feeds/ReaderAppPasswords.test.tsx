@@ -0,0 +1,94 @@
+import { render, screen, fireEvent, waitFor } from '@testing-library/react'
+import { ConnectError, Code } from '@connectrpc/connect'
+
+const mockUseAppPasswords = jest.fn()
+const createAppPassword = jest.fn()
+const deleteAppPassword = jest.fn()
+
+jest.mock('@/hooks/useFeeds', () => ({
+  useAppPasswords: () => mockUseAppPasswords(),
+  useCreateAppPassword: () => createAppPassword,
+  useDeleteAppPassword: () => deleteAppPassword
+}))
+
+jest.mock('@/lib/env', () => ({ getApiUrl: () => 'https://api.test' }))
+
+import ReaderAppPasswords, { readerServerUrl } from '@/components/feeds/ReaderAppPasswords'
+
+const appPassword = {
+  id: 'pw-1',
+  username: 'reeder',
+  createdAt: '2024-01-01T00:00:00Z',
+  lastUsedAt: ''
+}
+
+beforeEach(() => {
+  jest.clearAllMocks()
+  mockUseAppPasswords.mockReturnValue({ data: { appPasswords: [] }, error: undefined })
+})
+
+describe('readerServerUrl', () => {
+  it('points at the Google Reader API', () => {
+    expect(readerServerUrl()).toBe('https://api.test/feeds/greader')
+  })
+})
+
+describe('ReaderAppPasswords', () => {
+  it('shows the new credentials once', async () => {
+    createAppPassword.mockResolvedValue({ appPassword, password: 'abcdefghijklmnop' })
+    render(<ReaderAppPasswords />)
+
+    const submit = screen.getByRole('button', { name: 'Create app password' })
+    expect(submit).toBeDisabled()
+    fireEvent.change(screen.getByLabelText('Reader app username'), {
+      target: { value: ' reeder ' }
+    })
+    fireEvent.click(submit)
+
+    const credentials = await screen.findByTestId('app-password-credentials')
+    expect(createAppPassword).toHaveBeenCalledWith('reeder')
+    expect(credentials).toHaveTextContent('https://api.test/feeds/greader')
+    expect(credentials).toHaveTextContent('reeder')
+    expect(credentials).toHaveTextContent('abcdefghijklmnop')
+    expect(screen.getByLabelText('Reader app username')).toHaveValue('')
+  })
+
+  it('lists app passwords and revokes them', async () => {
+    deleteAppPassword.mockResolvedValue(undefined)
+    mockUseAppPasswords.mockReturnValue({
+      data: {
+        appPasswords: [
+          appPassword,
+          {
+            ...appPassword,
+            id: 'pw-2',
+            username: 'netnewswire',
+            lastUsedAt: '2024-03-05T10:00:00Z'
+          }
+        ]
+      },
+      error: undefined
+    })
+    render(<ReaderAppPasswords />)
+
+    expect(screen.getByTestId('app-password-pw-1')).toHaveTextContent('reeder')
+    expect(screen.getByTestId('app-password-pw-1')).toHaveTextContent('Never used')
+    expect(screen.getByTestId('app-password-pw-2')).toHaveTextContent('Last used')
+
+    fireEvent.click(screen.getAllByRole('button', { name: 'Revoke' })[0])
+    await waitFor(() => expect(deleteAppPassword).toHaveBeenCalledWith('pw-1'))
+  })
+
+  it('shows why the server rejected the username', async () => {
+    createAppPassword.mockRejectedValue(
+      new ConnectError('username cannot be empty', Code.InvalidArgument)
+    )
+    render(<ReaderAppPasswords />)
+
+    fireEvent.change(screen.getByLabelText('Reader app username'), { target: { value: 'x' } })
+    fireEvent.click(screen.getByRole('button', { name: 'Create app password' }))
+
+    expect(await screen.findByText('username cannot be empty')).toBeInTheDocument()
+    expect(screen.queryByTestId('app-password-credentials')).not.toBeInTheDocument()
+  })
+})
@@ -130,6 +130,30 @@ describe('StorageCard', () => {
     render(<StorageCard data={data} />)
     expect(screen.getByText('No cleanup needed')).toBeInTheDocument()
   })
+
+  it('reports what the scan reclaimed and what dedup saved', () => {
+    const data = create(GetStorageStatsResponseSchema, {
+      latest: {
+        scannedAt: '2026-01-01T00:00:00Z',
+        totalSizeBytes: 4096n,
+        objectCount: 4n,
+        orphanSizeBytes: 2048n,
+        orphanCount: 2n,
+        staleUploadSizeBytes: 0n,
+        staleUploadCount: 0n,
+        dedupSavedBytes: 1048576n,
+        reclaimedSizeBytes: 2048n,
+        reclaimedCount: 2n,
+        prefixBreakdown: []
+      },
+      history: []
+    })
+
+    render(<StorageCard data={data} />)
+    expect(screen.queryByText(/awaiting cleanup/)).not.toBeInTheDocument()
+    expect(screen.getByText(/Reclaimed 2/)).toBeInTheDocument()
+    expect(screen.getByText('Dedup saved 1.0 MB')).toBeInTheDocument()
+  })
 })
 
 describe('DatabaseCard', () => {
@@ -50,6 +50,12 @@ jest.mock('@/lib/gen/books/v1/library_pb', () => ({
 }))
 jest.mock('@/lib/gen/books/v1/files_pb', () => ({ BookFilesService: {} }))
 jest.mock('@/lib/gen/books/v1/kobo_pb', () => ({ KoboService: {} }))
+jest.mock('@/lib/gen/books/v1/opds_pb', () => ({ OPDSService: {} }))
+jest.mock('@/lib/gen/books/v1/koreader_pb', () => ({ KOReaderService: {} }))
+jest.mock('@/lib/gen/books/v1/goals_pb', () => ({
+  GoalsService: {},
+  ReadingGoalSchema: {}
+}))
 jest.mock('@/lib/gen/books/v1/catalog_pb', () => ({
   CatalogService: {},
   UpdateBookRequestSchema: {}
@@ -61,11 +67,16 @@ import { ConnectError, Code } from '@connectrpc/connect'
 import {
   useLibrary,
   useBooksProgress,
+  useReadingStats,
+  useReadingGoals,
+  useSetReadingGoal,
+  useDeleteReadingGoal,
   useCreateShelf,
   useSearchLibrary,
   useSearchExternal,
   useCreateBook,
   useImportBooks,
+  useExportLibrary,
   useUpdateBookStatus,
   useToggleTag,
   useUpdateFinishedAt,
@@ -75,9 +86,19 @@ import {
   useKEPUBStatus,
   useGetBookFile,
   useGetBookContent,
+  useBookAnnotations,
+  useExportAnnotations,
+  useSearchBookText,
   useRegisterKoboDevice,
   useListKoboDevices,
   useDisconnectKoboDevice,
+  useSetKoboDeviceFilter,
+  useListOPDSTokens,
+  useCreateOPDSToken,
+  useRevokeOPDSToken,
+  useListKOReaderDevices,
+  useRegisterKOReaderDevice,
+  useDisconnectKOReaderDevice,
   useSetBookISBN,
   useUpdateBook,
   useResyncProposals,
@@ -134,6 +155,82 @@ describe('useBooksProgress', () => {
   })
 })
 
+describe('useSearchBookText', () => {
+  it('disables the fetch for a blank query', () => {
+    renderHook(() => useSearchBookText('  '))
+    const [key] = mockUseSWR.mock.calls[0]
+    expect(key).toBeNull()
+  })
+
+  it('fetcher calls client.searchBookText with the trimmed query', async () => {
+    const mockClient = { searchBookText: jest.fn().mockResolvedValue({ hits: [] }) }
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce(mockClient)
+    renderHook(() => useSearchBookText(' whale '))
+    const [key, fetcher] = mockUseSWR.mock.calls[0]!
+    expect(key).toEqual(['/books/text-search', 'whale'])
+    await fetcher!()
+    expect(mockClient.searchBookText).toHaveBeenCalledWith({ query: 'whale', limit: 50 })
+  })
+})
+
+describe('useReadingStats', () => {
+  it('uses the reading-stats key with the date range', () => {
+    renderHook(() => useReadingStats('2024-01-01', '2024-12-31'))
+    const [key] = mockUseSWR.mock.calls[0]
+    expect(key).toEqual(['/books/reading-stats', '2024-01-01', '2024-12-31'])
+  })
+
+  it('fetcher calls client.getReadingStats', async () => {
+    const mockClient = { getReadingStats: jest.fn().mockResolvedValue({}) }
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce(mockClient)
+    renderHook(() => useReadingStats())
+    const fetcher = mockUseSWR.mock.calls[0]![1]!
+    await fetcher()
+    expect(mockClient.getReadingStats).toHaveBeenCalledWith({
+      dateStart: undefined,
+      dateEnd: undefined
+    })
+  })
+})
+
+describe('useReadingGoals', () => {
+  it('keys by year and passes it to client.getReadingGoals', async () => {
+    const mockClient = { getReadingGoals: jest.fn().mockResolvedValue({}) }
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce(mockClient)
+    renderHook(() => useReadingGoals(2024))
+    const [key, fetcher] = mockUseSWR.mock.calls[0]!
+    expect(key).toEqual(['/books/reading-goals', 2024])
+    await fetcher!()
+    expect(mockClient.getReadingGoals).toHaveBeenCalledWith({ year: 2024 })
+  })
+})
+
+describe('useSetReadingGoal', () => {
+  it('calls client.setReadingGoal with the goal', () => {
+    const mockSet = jest.fn().mockResolvedValue({})
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce({ setReadingGoal: mockSet })
+    const { result } = renderHook(() => useSetReadingGoal())
+    const goal = { year: 2024, kind: 'books', target: 24, public: true }
+    result.current(goal)
+    expect(mockSet).toHaveBeenCalledWith({ goal })
+  })
+})
+
+describe('useDeleteReadingGoal', () => {
+  it('calls client.deleteReadingGoal with year and kind', () => {
+    const mockDelete = jest.fn().mockResolvedValue({})
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce({ deleteReadingGoal: mockDelete })
+    const { result } = renderHook(() => useDeleteReadingGoal())
+    result.current(2024, 'pages')
+    expect(mockDelete).toHaveBeenCalledWith({ year: 2024, kind: 'pages' })
+  })
+})
+
 describe('useSearchLibrary', () => {
   it('returns a function', () => {
     const { result } = renderHook(() => useSearchLibrary())
@@ -441,14 +538,35 @@ describe('useImportBooks', () => {
     expect(typeof result.current).toBe('function')
   })
 
-  it('encodes csv and calls client.importBooks', () => {
+  it('sends the file bytes with auto-detection and no dry run by default', () => {
     const mockImportBooks = jest.fn().mockResolvedValue({})
     // @ts-expect-error -- mock client returns partial shape
     mockCreateServiceClient.mockReturnValueOnce({ importBooks: mockImportBooks })
     const { result } = renderHook(() => useImportBooks())
-    result.current('a,b\n1,2')
-    const call = mockImportBooks.mock.calls[0][0]
-    expect(Object.prototype.toString.call(call.csvData)).toBe('[object Uint8Array]')
+    const data = new TextEncoder().encode('a,b\n1,2')
+    result.current(data)
+    expect(mockImportBooks).toHaveBeenCalledWith({ data, format: '', dryRun: false })
+  })
+
+  it('passes the chosen format and dry run flag', () => {
+    const mockImportBooks = jest.fn().mockResolvedValue({})
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce({ importBooks: mockImportBooks })
+    const { result } = renderHook(() => useImportBooks())
+    const data = new Uint8Array([1])
+    result.current(data, { format: 'calibre', dryRun: true })
+    expect(mockImportBooks).toHaveBeenCalledWith({ data, format: 'calibre', dryRun: true })
+  })
+})
+
+describe('useExportLibrary', () => {
+  it('calls client.exportLibrary with the format', () => {
+    const mockExport = jest.fn().mockResolvedValue({})
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce({ exportLibrary: mockExport })
+    const { result } = renderHook(() => useExportLibrary())
+    result.current('json')
+    expect(mockExport).toHaveBeenCalledWith({ format: 'json' })
   })
 })
 
@@ -825,6 +943,79 @@ describe('useDisconnectKoboDevice', () => {
   })
 })
 
+describe('useSetKoboDeviceFilter', () => {
+  it('calls client.setKoboDeviceFilter with id, shelves and tags', () => {
+    const mockSetFilter = jest.fn().mockResolvedValue({})
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce({ setKoboDeviceFilter: mockSetFilter })
+    const { result } = renderHook(() => useSetKoboDeviceFilter())
+    result.current('dev-id-123', ['to-read'], ['commute'])
+    expect(mockSetFilter).toHaveBeenCalledWith({
+      id: 'dev-id-123',
+      syncShelves: ['to-read'],
+      syncTags: ['commute']
+    })
+  })
+})
+
+describe('useListOPDSTokens', () => {
+  it('uses /books/opds/tokens as key', () => {
+    renderHook(() => useListOPDSTokens())
+    expect(mockUseSWR).toHaveBeenCalledWith('/books/opds/tokens', expect.any(Function))
+  })
+})
+
+describe('useCreateOPDSToken', () => {
+  it('calls client.createOPDSToken with the name', () => {
+    const mockCreate = jest.fn().mockResolvedValue({ rawToken: 'raw' })
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce({ createOPDSToken: mockCreate })
+    const { result } = renderHook(() => useCreateOPDSToken())
+    result.current('KOReader')
+    expect(mockCreate).toHaveBeenCalledWith({ name: 'KOReader' })
+  })
+})
+
+describe('useRevokeOPDSToken', () => {
+  it('calls client.revokeOPDSToken with id', () => {
+    const mockRevoke = jest.fn().mockResolvedValue({})
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce({ revokeOPDSToken: mockRevoke })
+    const { result } = renderHook(() => useRevokeOPDSToken())
+    result.current('tok-1')
+    expect(mockRevoke).toHaveBeenCalledWith({ id: 'tok-1' })
+  })
+})
+
+describe('useListKOReaderDevices', () => {
+  it('uses /books/koreader/devices as key', () => {
+    renderHook(() => useListKOReaderDevices())
+    expect(mockUseSWR).toHaveBeenCalledWith('/books/koreader/devices', expect.any(Function))
+  })
+})
+
+describe('useRegisterKOReaderDevice', () => {
+  it('calls client.registerKOReaderDevice with the username', () => {
+    const mockRegister = jest.fn().mockResolvedValue({ password: 'secret' })
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce({ registerKOReaderDevice: mockRegister })
+    const { result } = renderHook(() => useRegisterKOReaderDevice())
+    result.current('kindle')
+    expect(mockRegister).toHaveBeenCalledWith({ username: 'kindle' })
+  })
+})
+
+describe('useDisconnectKOReaderDevice', () => {
+  it('calls client.disconnectKOReaderDevice with id', () => {
+    const mockDisconnect = jest.fn().mockResolvedValue({})
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce({ disconnectKOReaderDevice: mockDisconnect })
+    const { result } = renderHook(() => useDisconnectKOReaderDevice())
+    result.current('dev-1')
+    expect(mockDisconnect).toHaveBeenCalledWith({ id: 'dev-1' })
+  })
+})
+
 describe('useKEPUBStatus', () => {
   it('uses null key when bookId is null', () => {
     renderHook(() => useKEPUBStatus(null))
@@ -913,6 +1104,38 @@ describe('useGetBookContent', () => {
   })
 })
 
+describe('useBookAnnotations', () => {
+  it('uses null key when bookId is null', () => {
+    renderHook(() => useBookAnnotations(null))
+    expect(mockUseSWR).toHaveBeenCalledWith(null, expect.any(Function))
+  })
+
+  it('fetcher calls client.listAnnotations with bookId', async () => {
+    const mockList = jest.fn().mockResolvedValue({ annotations: [] })
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce({ listAnnotations: mockList })
+    renderHook(() => useBookAnnotations('book-abc'))
+    expect(mockUseSWR).toHaveBeenCalledWith(
+      ['/books/annotations', 'book-abc'],
+      expect.any(Function)
+    )
+    const fetcher = mockUseSWR.mock.calls[0]![1]!
+    await fetcher()
+    expect(mockList).toHaveBeenCalledWith({ bookId: 'book-abc' })
+  })
+})
+
+describe('useExportAnnotations', () => {
+  it('exports one book as markdown by default', () => {
+    const mockExport = jest.fn().mockResolvedValue({})
+    // @ts-expect-error -- mock client returns partial shape
+    mockCreateServiceClient.mockReturnValueOnce({ exportAnnotations: mockExport })
+    const { result } = renderHook(() => useExportAnnotations())
+    result.current('book-abc')
+    expect(mockExport).toHaveBeenCalledWith({ bookId: 'book-abc', format: 'markdown' })
+  })
+})
+
 describe('useRequestKEPUBConversion', () => {
   it('returns a function that calls client.requestKEPUBConversion', async () => {
     const mockConvert = jest.fn().mockResolvedValue({ kepubStatus: 'converting' })
@@ -9,6 +9,7 @@ const clientMocks = {
   getSharedLibrary: jest.fn().mockResolvedValue({}),
   getSharedBooksProgress: jest.fn().mockResolvedValue({}),
   getSharedFeedsSummary: jest.fn().mockResolvedValue({}),
+  getSharedReadingGoals: jest.fn().mockResolvedValue({}),
   getSharedSteam: jest.fn().mockResolvedValue({}),
   getSharedSteamGame: jest.fn().mockResolvedValue({}),
   getSharedRecentlyActiveGames: jest.fn().mockResolvedValue({})
@@ -32,6 +33,7 @@ import {
   useSharedLibrary,
   useSharedBooksProgress,
   useSharedFeedsSummary,
+  useSharedReadingGoals,
   useSharedSteam,
   useSharedSteamProgress,
   useSharedSteamGame,
@@ -106,6 +108,19 @@ describe('useDashboardShare hooks', () => {
     expect(clientMocks.getSharedFeedsSummary).toHaveBeenCalledWith({ token: 'tok-1' })
   })
 
+  it('useSharedReadingGoals keys by token and passes it to the RPC', async () => {
+    renderHook(() => useSharedReadingGoals('tok-1'))
+    const [key, fetcher] = mockUseSWR.mock.calls[0]!
+    expect(key).toBe('/dashboard/reading/tok-1/goals')
+    await fetcher!()
+    expect(clientMocks.getSharedReadingGoals).toHaveBeenCalledWith({ token: 'tok-1' })
+  })
+
+  it('useSharedReadingGoals is disabled without a token', () => {
+    renderHook(() => useSharedReadingGoals(''))
+    expect(mockUseSWR.mock.calls[0]![0]).toBeNull()
+  })
+
   it('useSharedFeedsSummary is disabled without a token', () => {
     renderHook(() => useSharedFeedsSummary(''))
     expect(mockUseSWR.mock.calls[0]![0]).toBeNull()
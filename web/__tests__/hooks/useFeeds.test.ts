@@ -15,7 +15,12 @@ const clientMocks = {
   refreshFeed: jest.fn().mockResolvedValue({ ingested: 0 }),
   updateItem: jest.fn().mockResolvedValue({ item: { id: 'item-1', readAt: 'now' } }),
   getFeedItem: jest.fn().mockResolvedValue({ item: { id: 'item-1', contentHtml: '<p>hi</p>' } }),
-  getFeedStats: jest.fn().mockResolvedValue({ stats: [], itemsPerDay: [] })
+  getFeedStats: jest.fn().mockResolvedValue({ stats: [], itemsPerDay: [] }),
+  createFolder: jest.fn().mockResolvedValue({ folder: { id: 'folder-1' } }),
+  renameFolder: jest.fn().mockResolvedValue({}),
+  deleteFolder: jest.fn().mockResolvedValue({}),
+  reorderFolders: jest.fn().mockResolvedValue({}),
+  moveFeed: jest.fn().mockResolvedValue({})
 }
 
 jest.mock('@/lib/client', () => ({
@@ -36,6 +41,11 @@ import {
   useDeleteFeed,
   useRefreshFeed,
   useUpdateItem,
+  useCreateFolder,
+  useRenameFolder,
+  useDeleteFolder,
+  useReorderFolders,
+  useMoveFeed,
   useFeedStats,
   useFeedsSummary
 } from '@/hooks/useFeeds'
@@ -104,6 +114,20 @@ describe('useFeeds', () => {
     })
   })
 
+  it('useFeedItems threads an optional folderId into the key and request', async () => {
+    renderHook(() => useFeedItems(true, undefined, false, 'folder-1'))
+    const [key, fetcher] = mockUseSWR.mock.calls[0]!
+    expect(key).toBe(swrKeys.feedItems(true, undefined, false, 'folder-1'))
+    expect(key).not.toBe(swrKeys.feedItems(true))
+    await fetcher!()
+    expect(clientMocks.listFeedItems).toHaveBeenCalledWith({
+      limit: 50,
+      unreadOnly: true,
+      bookmarkedOnly: false,
+      folderId: 'folder-1'
+    })
+  })
+
   it('useFetchFeedItemsPage fetches a page at the given offset', async () => {
     clientMocks.listFeedItems.mockResolvedValueOnce({ items: [{ id: 'i1' }], hasMore: true })
     const { result } = renderHook(() => useFetchFeedItemsPage(false))
@@ -239,6 +263,50 @@ describe('useFeeds', () => {
     })
   })
 
+  it('useUpdateItem refreshes unread counts only when read state changes', async () => {
+    const { result } = renderHook(() => useUpdateItem())
+    await result.current('item-1', { read: true })
+    expect(mutateMock).toHaveBeenCalledWith(swrKeys.feeds)
+
+    mutateMock.mockClear()
+    await result.current('item-1', { readProgressPct: 42 })
+    expect(mutateMock).not.toHaveBeenCalledWith(swrKeys.feeds)
+  })
+
+  it('useCreateFolder creates and invalidates feeds', async () => {
+    const { result } = renderHook(() => useCreateFolder())
+    await result.current('Tech', 'parent-1')
+    expect(clientMocks.createFolder).toHaveBeenCalledWith({ name: 'Tech', parentId: 'parent-1' })
+    expect(mutateMock).toHaveBeenCalledWith(swrKeys.feeds)
+    expect(mutateMock).toHaveBeenCalledTimes(1)
+  })
+
+  it('useRenameFolder and useReorderFolders only invalidate feeds', async () => {
+    const { result: rename } = renderHook(() => useRenameFolder())
+    await rename.current('folder-1', 'News')
+    expect(clientMocks.renameFolder).toHaveBeenCalledWith({ folderId: 'folder-1', name: 'News' })
+
+    const { result: reorder } = renderHook(() => useReorderFolders())
+    await reorder.current(['folder-2', 'folder-1'])
+    expect(clientMocks.reorderFolders).toHaveBeenCalledWith({ ids: ['folder-2', 'folder-1'] })
+    expect(mutateMock).toHaveBeenCalledTimes(2)
+    expect(mutateMock).toHaveBeenCalledWith(swrKeys.feeds)
+  })
+
+  it('useDeleteFolder and useMoveFeed invalidate feeds + items', async () => {
+    const { result: remove } = renderHook(() => useDeleteFolder())
+    await remove.current('folder-1')
+    expect(clientMocks.deleteFolder).toHaveBeenCalledWith({ folderId: 'folder-1' })
+
+    const { result: move } = renderHook(() => useMoveFeed())
+    await move.current('f1', '')
+    expect(clientMocks.moveFeed).toHaveBeenCalledWith({ feedId: 'f1', folderId: '' })
+
+    expect(mutateMock).toHaveBeenCalledWith(swrKeys.feeds)
+    expect(mutateMock).toHaveBeenCalledWith(expect.any(Function))
+    expect(mutateMock).toHaveBeenCalledTimes(4)
+  })
+
   it('useFeedStats queries the feed stats key', async () => {
     renderHook(() => useFeedStats())
     const [key, fetcher] = mockUseSWR.mock.calls[0]!
@@ -1,15 +1,19 @@
 import { renderHook } from '@testing-library/react'
 
-jest.mock('swr', () => ({ __esModule: true, default: jest.fn() }))
+jest.mock('swr', () => ({ __esModule: true, default: jest.fn(), mutate: jest.fn() }))
 jest.mock('@/lib/books/gatewayClient', () => ({
-  probeGateway: jest.fn()
+  probeGateway: jest.fn(),
+  importGateway: jest.fn()
 }))
 
-import useSWR from 'swr'
-import { useGatewayStatus } from '@/hooks/useKoboGateway'
+import useSWR, { mutate } from 'swr'
+import { importGateway } from '@/lib/books/gatewayClient'
+import { useGatewayStatus, useImportGateway } from '@/hooks/useKoboGateway'
 import { swrKeys } from '@/lib/swrKeys'
 
 const mockUseSWR = jest.mocked(useSWR)
+const mockMutate = jest.mocked(mutate)
+const mockImportGateway = jest.mocked(importGateway)
 
 beforeEach(() => {
   // @ts-expect-error -- mock returns partial SWRResponse for test purposes
@@ -28,3 +32,26 @@ describe('useGatewayStatus', () => {
     )
   })
 })
+
+describe('useImportGateway', () => {
+  it('imports from the given volume and refreshes the library', async () => {
+    const imported = {
+      serial: 'N418',
+      books: 2,
+      matched: 1,
+      created: 1,
+      skipped: 0,
+      markedRead: 1,
+      highlights: 3,
+      sessions: 4,
+      skippedHighlights: 0
+    }
+    mockImportGateway.mockResolvedValue(imported)
+
+    const { result } = renderHook(() => useImportGateway())
+    await expect(result.current('/Volumes/KOBOeReader')).resolves.toEqual(imported)
+
+    expect(mockImportGateway).toHaveBeenCalledWith(undefined, '/Volumes/KOBOeReader')
+    expect(mockMutate).toHaveBeenCalledWith(swrKeys.books)
+  })
+})
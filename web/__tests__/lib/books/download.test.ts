@@ -0,0 +1,27 @@
+import { saveFile } from '@/lib/books/download'
+
+describe('saveFile', () => {
+  const createObjectURL = jest.fn(() => 'blob:export')
+  const revokeObjectURL = jest.fn()
+
+  beforeEach(() => {
+    Object.assign(URL, { createObjectURL, revokeObjectURL })
+    jest.clearAllMocks()
+  })
+
+  it('clicks a temporary download link and releases the blob URL', () => {
+    const click = jest.spyOn(HTMLAnchorElement.prototype, 'click').mockImplementation(() => {})
+
+    saveFile(new TextEncoder().encode('a,b'), 'library.csv', 'text/csv')
+
+    const blob = (createObjectURL.mock.calls[0] as unknown as [Blob])[0]
+    expect(blob.type).toBe('text/csv')
+    expect(click).toHaveBeenCalledTimes(1)
+    const link = click.mock.contexts[0] as HTMLAnchorElement
+    expect(link.download).toBe('library.csv')
+    expect(link.getAttribute('href')).toBe('blob:export')
+    expect(document.querySelector('a[download]')).toBeNull()
+    expect(revokeObjectURL).toHaveBeenCalledWith('blob:export')
+    click.mockRestore()
+  })
+})
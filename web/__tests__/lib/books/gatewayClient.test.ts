@@ -2,6 +2,7 @@ import {
   probeGateway,
   configureGateway,
   revertGateway,
+  importGateway,
   updateGateway,
   gatewayNeedsUpdate,
   REQUIRED_GATEWAY_VERSION,
@@ -134,6 +135,48 @@ describe('revertGateway', () => {
   })
 })
 
+describe('importGateway', () => {
+  const RESULT = {
+    serial: 'N418ABCD1234',
+    books: 2,
+    matched: 1,
+    created: 1,
+    skipped: 0,
+    markedRead: 1,
+    highlights: 3,
+    sessions: 2,
+    skippedHighlights: 0
+  }
+
+  it('POSTs an empty body to use the configured endpoint', async () => {
+    mockFetch.mockResolvedValue(jsonResponse(RESULT))
+
+    const res = await importGateway()
+
+    expect(res).toEqual(RESULT)
+    expect(mockFetch).toHaveBeenCalledWith(
+      'https://127.0.0.1:41132/import',
+      expect.objectContaining({ method: 'POST' })
+    )
+    // eslint-disable-next-line @typescript-eslint/no-unsafe-type-assertion
+    const body = JSON.parse((mockFetch.mock.calls[0][1] as RequestInit).body as string)
+    expect(body).toEqual({})
+  })
+
+  it('includes syncUrl and volumePath when given', async () => {
+    mockFetch.mockResolvedValue(jsonResponse(RESULT))
+
+    await importGateway('https://api.example.com/books/kobo/TOKEN', '/Volumes/KOBOeReader')
+
+    // eslint-disable-next-line @typescript-eslint/no-unsafe-type-assertion
+    const body = JSON.parse((mockFetch.mock.calls[0][1] as RequestInit).body as string)
+    expect(body).toEqual({
+      syncUrl: 'https://api.example.com/books/kobo/TOKEN',
+      volumePath: '/Volumes/KOBOeReader'
+    })
+  })
+})
+
 describe('updateGateway', () => {
   it('POSTs to /update', async () => {
     mockFetch.mockResolvedValue(jsonResponse({ updating: true }))
@@ -0,0 +1,41 @@
+import { parseSeriesPosition, seriesLabel } from '@/lib/books/series'
+
+describe('seriesLabel', () => {
+  it('is empty for standalone books', () => {
+    expect(seriesLabel({ series: '', seriesPosition: undefined, seriesTotal: 0 })).toBe('')
+    expect(seriesLabel(undefined)).toBe('')
+  })
+
+  it('shows only the name when the position is unknown', () => {
+    expect(seriesLabel({ series: 'Discworld', seriesPosition: undefined, seriesTotal: 0 })).toBe(
+      'Discworld'
+    )
+  })
+
+  it('includes position and total when known', () => {
+    expect(seriesLabel({ series: 'The Expanse', seriesPosition: 2, seriesTotal: 0 })).toBe(
+      'The Expanse #2'
+    )
+    expect(seriesLabel({ series: 'The Expanse', seriesPosition: 2.5, seriesTotal: 9 })).toBe(
+      'The Expanse #2.5 of 9'
+    )
+    expect(seriesLabel({ series: 'Earthsea', seriesPosition: 0, seriesTotal: 0 })).toBe(
+      'Earthsea #0'
+    )
+  })
+})
+
+describe('parseSeriesPosition', () => {
+  it('parses whole, fractional and comma numbers', () => {
+    expect(parseSeriesPosition('3')).toBe(3)
+    expect(parseSeriesPosition(' 1.5 ')).toBe(1.5)
+    expect(parseSeriesPosition('2,5')).toBe(2.5)
+    expect(parseSeriesPosition('0')).toBe(0)
+  })
+
+  it('treats blank and invalid input as unknown', () => {
+    expect(parseSeriesPosition('')).toBeUndefined()
+    expect(parseSeriesPosition('abc')).toBeUndefined()
+    expect(parseSeriesPosition('-1')).toBeUndefined()
+  })
+})
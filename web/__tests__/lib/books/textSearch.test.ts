@@ -0,0 +1,82 @@
+import {
+  findTerm,
+  markFirstMatch,
+  parseReaderLink,
+  splitSnippet,
+  textHitHref
+} from '@/lib/books/textSearch'
+
+describe('splitSnippet', () => {
+  it('separates marked words from the surrounding text', () => {
+    expect(splitSnippet('the <mark>whale</mark> and <mark>sea</mark>')).toEqual([
+      { text: 'the ', marked: false },
+      { text: 'whale', marked: true },
+      { text: ' and ', marked: false },
+      { text: 'sea', marked: true }
+    ])
+  })
+
+  it('keeps other markup as text', () => {
+    expect(splitSnippet('a <b>bold</b> claim')).toEqual([
+      { text: 'a <b>bold</b> claim', marked: false }
+    ])
+  })
+})
+
+describe('findTerm', () => {
+  it('takes the first plain term', () => {
+    expect(findTerm('"white whale" -ahab')).toBe('white')
+    expect(findTerm('-ahab or ishmael')).toBe('ishmael')
+    expect(findTerm('  ')).toBe('')
+  })
+})
+
+describe('textHitHref / parseReaderLink', () => {
+  it('round-trips a book hit', () => {
+    const href = textHitHref(
+      { userBookId: 'ub1', format: 'kepub', location: 'text/ch 1.xhtml' },
+      'whale'
+    )
+    expect(href).toBe('/books/ub1?read=kepub&loc=text%2Fch+1.xhtml&find=whale')
+    const params = new URLSearchParams(href.split('?')[1])
+    expect(parseReaderLink(params)).toEqual({
+      read: 'kepub',
+      location: 'text/ch 1.xhtml',
+      find: 'whale'
+    })
+  })
+
+  it('omits the location of an article hit', () => {
+    expect(textHitHref({ userBookId: 'ub2', format: 'article', location: '' }, 'sea')).toBe(
+      '/books/ub2?read=article&find=sea'
+    )
+  })
+
+  it('ignores unknown formats', () => {
+    expect(parseReaderLink(new URLSearchParams('read=mobi'))).toEqual({
+      read: null,
+      location: null,
+      find: ''
+    })
+  })
+})
+
+describe('markFirstMatch', () => {
+  it('wraps the first match in a mark', () => {
+    const root = document.createElement('div')
+    root.innerHTML = '<p>Call me</p><p>Ishmael. Ishmael again.</p>'
+    const mark = markFirstMatch(root, 'ishmael')
+    expect(mark?.textContent).toBe('Ishmael')
+    expect(root.querySelectorAll('mark')).toHaveLength(1)
+    expect(root.querySelector('p:nth-child(2)')?.innerHTML).toBe(
+      '<mark>Ishmael</mark>. Ishmael again.'
+    )
+  })
+
+  it('returns null without a match', () => {
+    const root = document.createElement('div')
+    root.textContent = 'nothing here'
+    expect(markFirstMatch(root, 'whale')).toBeNull()
+    expect(markFirstMatch(root, ' ')).toBeNull()
+  })
+})
@@ -0,0 +1,35 @@
+import { create } from '@bufbuild/protobuf'
+import { FolderSchema } from '@/lib/gen/feeds/v1/feeds_pb'
+import { orderFolders, withUnread } from '@/lib/feeds/folders'
+
+function folder(id: string, name: string, position: number, parentId = '') {
+  return create(FolderSchema, { id, name, position, parentId })
+}
+
+describe('orderFolders', () => {
+  it('nests subfolders under their parent, each level by position', () => {
+    const ordered = orderFolders([
+      folder('go', 'Go', 1, 'tech'),
+      folder('news', 'News', 1),
+      folder('rust', 'Rust', 0, 'tech'),
+      folder('tech', 'Tech', 0)
+    ])
+    expect(ordered.map((e) => [e.folder.id, e.depth])).toEqual([
+      ['tech', 0],
+      ['rust', 1],
+      ['go', 1],
+      ['news', 0]
+    ])
+  })
+
+  it('drops subfolders whose parent is missing', () => {
+    expect(orderFolders([folder('orphan', 'Orphan', 0, 'gone')])).toEqual([])
+  })
+})
+
+describe('withUnread', () => {
+  it('only shows a count when something is unread', () => {
+    expect(withUnread('Tech', 3)).toBe('Tech (3)')
+    expect(withUnread('Tech', 0)).toBe('Tech')
+  })
+})
@@ -34,6 +34,7 @@ describe('swrKeys', () => {
     expect(swrKeys.booksProgress('a', 'b')).toEqual(['/books/progress', 'a', 'b'])
     expect(swrKeys.kepubStatus('42')).toEqual(['/books/kepub-status', '42'])
     expect(swrKeys.bookFile('42', 'epub')).toEqual(['/books/file', '42', 'epub'])
+    expect(swrKeys.bookTextSearch('whale')).toEqual(['/books/text-search', 'whale'])
     expect(swrKeys.game(7)).toBe('/games/7')
     expect(swrKeys.gamesDistribution(3)).toBe('/games/distribution/3')
     expect(swrKeys.gamesProgress('a', 'b')).toEqual(['/games/progress', 'a', 'b'])
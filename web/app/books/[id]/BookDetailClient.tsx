@@ -1,6 +1,7 @@
 'use client'
 
 import { useMemo, useState } from 'react'
+import Link from 'next/link'
 import { useRouter, useSearchParams } from 'next/navigation'
 import { mutate } from 'swr'
 import ReactMarkdown from 'react-markdown'
@@ -10,6 +11,8 @@ import { useCurrentUser } from '@/hooks/useAuth'
 import BookCover from '@/components/books/BookCover'
 import BookSourceSync from '@/components/books/BookSourceSync'
 import { SPECIAL_TAGS, flattenLibrary } from '@/lib/books/bookShelves'
+import { seriesLabel } from '@/lib/books/series'
+import { parseReaderLink } from '@/lib/books/textSearch'
 import BookProgressEditor from '@/components/books/BookProgressEditor'
 import BookRatingStars from '@/components/books/BookRatingStars'
 import BookReadDatesEditor from '@/components/books/BookReadDatesEditor'
@@ -17,10 +20,12 @@ import BookFavouriteButton from '@/components/books/BookFavouriteButton'
 import BookOwnershipToggles from '@/components/books/BookOwnershipToggles'
 import BookShelfTagFields from '@/components/books/BookShelfTagFields'
 import KoboSyncToggle from '@/components/books/KoboSyncToggle'
+import UpNextControls from '@/components/books/UpNextControls'
 import BookPreviewDialog from '@/components/books/BookPreviewDialog'
 import ArticleReaderDialog from '@/components/books/ArticleReaderDialog'
 import RemoveBookDialog from '@/components/books/RemoveBookDialog'
 import BookEditDialog from '@/components/books/BookEditDialog'
+import BookHighlights from '@/components/books/BookHighlights'
 import { Breadcrumb, type BreadcrumbItem } from '@/components/ui/breadcrumb'
 import { Button } from '@/components/ui/button'
 import { PageContainer } from '@/components/ui/page-container'
@@ -31,20 +36,30 @@ export default function BookDetailClient({ id }: { id: string }) {
   const { data, error, isLoading } = useLibrary()
   const { data: currentUser } = useCurrentUser()
   const isAdmin = currentUser?.role === 'admin'
-  const [previewFormat, setPreviewFormat] = useState<'pdf' | 'epub' | 'kepub' | null>(null)
-  const [removeOpen, setRemoveOpen] = useState(false)
-  const [editOpen, setEditOpen] = useState(false)
-  const [readerOpen, setReaderOpen] = useState(false)
   const router = useRouter()
   const searchParams = useSearchParams()
   const query = searchParams.get('q')
+  // A book text search hit links here with the reader to open and where
+  // (see lib/books/textSearch.ts); only the first render honours it.
+  const [readerLink] = useState(() => parseReaderLink(searchParams))
+  const [previewFormat, setPreviewFormat] = useState<'pdf' | 'epub' | 'kepub' | null>(
+    readerLink.read === 'article' ? null : readerLink.read
+  )
+  const [removeOpen, setRemoveOpen] = useState(false)
+  const [editOpen, setEditOpen] = useState(false)
+  const [readerOpen, setReaderOpen] = useState(readerLink.read === 'article')
 
   const userBook = useMemo(() => {
     if (!data?.library) return null
-    return flattenLibrary(data.library).find((ub) => ub.id === id) ?? null
+    // Feeds only knows the catalog id of an entry an item was sent to, so
+    // its "In library" link uses that rather than the library entry id.
+    return flattenLibrary(data.library).find((ub) => ub.id === id || ub.bookId === id) ?? null
   }, [data, id])
 
   const book = userBook?.book
+  // Digests and feed items without a link carry a urn: source URL, which is
+  // only a catalog key — there is no original to open.
+  const originalUrl = book?.sourceUrl.startsWith('http') ? book.sourceUrl : ''
 
   const knownShelves = data?.library?.shelves.map((s) => s.name) ?? []
 
@@ -93,6 +108,11 @@ export default function BookDetailClient({ id }: { id: string }) {
               {book.authors.length > 0 && (
                 <p className="mt-1 text-lg text-muted">{book.authors.join(', ')}</p>
               )}
+              {book.series && (
+                <p className="mt-1 text-sm text-muted" data-testid="book-series">
+                  {seriesLabel(book)}
+                </p>
+              )}
 
               {/* Rating + favourite + page count */}
               <div className="mt-3 flex flex-wrap items-center gap-3">
@@ -119,14 +139,24 @@ export default function BookDetailClient({ id }: { id: string }) {
                   >
                     Read in app
                   </Button>
-                  <a
-                    href={book.sourceUrl}
-                    target="_blank"
-                    rel="noopener noreferrer"
-                    className="text-accent underline-offset-4 hover:underline"
-                  >
-                    Open original ↗
-                  </a>
+                  {originalUrl && (
+                    <a
+                      href={originalUrl}
+                      target="_blank"
+                      rel="noopener noreferrer"
+                      className="text-accent underline-offset-4 hover:underline"
+                    >
+                      Open original ↗
+                    </a>
+                  )}
+                  {userBook.feedItemId && (
+                    <Link
+                      href={`/feeds?item=${userBook.feedItemId}`}
+                      className="text-accent underline-offset-4 hover:underline"
+                    >
+                      Open in Feeds
+                    </Link>
+                  )}
                 </p>
               )}
 
@@ -184,6 +214,17 @@ export default function BookDetailClient({ id }: { id: string }) {
                 </div>
               )}
 
+              {userBook.status === 'to-read' && (
+                <div>
+                  <p className="text-xs text-muted mb-1">Up next</p>
+                  <UpNextControls
+                    userBook={userBook}
+                    upNext={data?.library?.upNext ?? []}
+                    onChanged={handleSaved}
+                  />
+                </div>
+              )}
+
               {(userBook.status === 'read' || userBook.finishedAt.length > 0) && (
                 <BookReadDatesEditor userBook={userBook} onSaved={handleSaved} />
               )}
@@ -263,6 +304,8 @@ export default function BookDetailClient({ id }: { id: string }) {
               </div>
             </div>
           </section>
+
+          <BookHighlights bookId={userBook.bookId} />
         </>
       )}
 
@@ -273,6 +316,8 @@ export default function BookDetailClient({ id }: { id: string }) {
           title={book?.title ?? 'Book Preview'}
           open={!!previewFormat}
           onOpenChange={(open) => !open && setPreviewFormat(null)}
+          initialLocation={previewFormat === readerLink.read ? readerLink.location : null}
+          findText={previewFormat === readerLink.read ? readerLink.find : ''}
         />
       )}
 
@@ -280,9 +325,10 @@ export default function BookDetailClient({ id }: { id: string }) {
         <ArticleReaderDialog
           bookId={userBook.bookId}
           title={book.title}
-          sourceUrl={book.sourceUrl}
+          sourceUrl={originalUrl}
           open={readerOpen}
           onOpenChange={setReaderOpen}
+          findText={readerLink.read === 'article' ? readerLink.find : ''}
         />
       )}
 
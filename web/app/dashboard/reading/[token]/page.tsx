@@ -20,9 +20,10 @@ export default async function ReadingDashboardPublicPage({
 }) {
   const { token } = await params
   const client = await createServerClient(PublicReadingDashboardService)
-  const [library, feedsSummary] = await Promise.all([
+  const [library, feedsSummary, goals] = await Promise.all([
     fetchOrNull(() => client.getSharedLibrary({ token })),
-    fetchOrNull(() => client.getSharedFeedsSummary({ token }))
+    fetchOrNull(() => client.getSharedFeedsSummary({ token })),
+    fetchOrNull(() => client.getSharedReadingGoals({ token }))
   ])
 
   return (
@@ -33,7 +34,8 @@ export default async function ReadingDashboardPublicPage({
       <SWRFallback
         fallback={{
           ...(library ? { [swrKeys.dashboardReading(token)]: library } : {}),
-          ...(feedsSummary ? { [swrKeys.dashboardFeedsSummary(token)]: feedsSummary } : {})
+          ...(feedsSummary ? { [swrKeys.dashboardFeedsSummary(token)]: feedsSummary } : {}),
+          ...(goals ? { [swrKeys.dashboardReadingGoals(token)]: goals } : {})
         }}
       >
         <ReadingDashboardPublicClient token={token} initialData={library ?? undefined} />
@@ -1,5 +1,6 @@
 'use client'
 
+import { useEffect, useRef } from 'react'
 import {
   Dialog,
   DialogContent,
@@ -9,6 +10,7 @@ import {
 } from '@/components/ui/dialog'
 import { useGetBookContent } from '@/hooks/useBooks'
 import { sanitizeArticleHtml } from '@/lib/sanitizeHtml'
+import { markFirstMatch } from '@/lib/books/textSearch'
 
 interface ArticleReaderDialogProps {
   bookId: string
@@ -16,6 +18,8 @@ interface ArticleReaderDialogProps {
   sourceUrl?: string
   open: boolean
   onOpenChange: (open: boolean) => void
+  /** Word to highlight and scroll to, e.g. from a book text search hit. */
+  findText?: string
 }
 
 // In-app reader for a library book's stored content (paper/article ingests
@@ -27,11 +31,18 @@ export default function ArticleReaderDialog({
   title,
   sourceUrl,
   open,
-  onOpenChange
+  onOpenChange,
+  findText = ''
 }: ArticleReaderDialogProps) {
   const { data, error } = useGetBookContent(open ? bookId : null)
   const html = data?.html ?? ''
 
+  const contentRef = useRef<HTMLDivElement>(null)
+  useEffect(() => {
+    if (!html || !findText || !contentRef.current) return
+    markFirstMatch(contentRef.current, findText)?.scrollIntoView?.({ block: 'center' })
+  }, [html, findText])
+
   return (
     <Dialog open={open} onOpenChange={onOpenChange}>
       <DialogContent
@@ -74,6 +85,7 @@ export default function ArticleReaderDialog({
 
           {html && (
             <div
+              ref={contentRef}
               className="prose prose-sm max-w-none text-fg p-1"
               // Content originates from ingested third-party HTML — always
               // sanitize before rendering.
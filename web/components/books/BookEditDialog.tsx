@@ -9,6 +9,7 @@ import { Button } from '@/components/ui/button'
 import { Input } from '@/components/ui/input'
 import { Textarea } from '@/components/ui/textarea'
 import { swrKeys } from '@/lib/swrKeys'
+import { parseSeriesPosition } from '@/lib/books/series'
 
 interface BookEditDialogProps {
   book: Book
@@ -25,6 +26,10 @@ export default function BookEditDialog({ book, open, onOpenChange, onSaved }: Bo
   const [description, setDescription] = useState(book.description)
   const [pageCount, setPageCount] = useState(String(book.pageCount || ''))
   const [coverUrl, setCoverUrl] = useState(book.coverUrl)
+  const [series, setSeries] = useState(book.series)
+  const [seriesPosition, setSeriesPosition] = useState(
+    book.seriesPosition === undefined ? '' : String(book.seriesPosition)
+  )
   const [saving, setSaving] = useState(false)
   const [error, setError] = useState('')
 
@@ -46,7 +51,10 @@ export default function BookEditDialog({ book, open, onOpenChange, onSaved }: Bo
         isbn13,
         description,
         pageCount: Number(pageCount) || 0,
-        coverUrl
+        coverUrl,
+        series: series.trim(),
+        seriesPosition: series.trim() ? parseSeriesPosition(seriesPosition) : undefined,
+        seriesTotal: series.trim() ? book.seriesTotal : 0
       })
       await mutate(swrKeys.books)
       onOpenChange(false)
@@ -99,6 +107,25 @@ export default function BookEditDialog({ book, open, onOpenChange, onSaved }: Bo
             />
           </div>
 
+          <div className="flex gap-2">
+            <div className="flex-1">
+              <p className="text-xs text-muted mb-1">Series</p>
+              <Input
+                value={series}
+                onChange={(e) => setSeries(e.target.value)}
+                placeholder="Standalone"
+              />
+            </div>
+            <div className="w-28">
+              <p className="text-xs text-muted mb-1">Number in series</p>
+              <Input
+                inputMode="decimal"
+                value={seriesPosition}
+                onChange={(e) => setSeriesPosition(e.target.value)}
+              />
+            </div>
+          </div>
+
           <div>
             <p className="text-xs text-muted mb-1">Cover URL</p>
             <div className="flex gap-2">
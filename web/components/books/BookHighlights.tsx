@@ -0,0 +1,93 @@
+'use client'
+
+import { useState } from 'react'
+import { useBookAnnotations, useExportAnnotations } from '@/hooks/useBooks'
+import { Button } from '@/components/ui/button'
+import { saveFile } from '@/lib/books/download'
+import { formatDate } from '@/lib/dates'
+import type { Annotation } from '@/lib/gen/books/v1/library_pb'
+
+interface BookHighlightsProps {
+  bookId: string
+}
+
+// Highlights and notes made on a synced Kobo, grouped by chapter. Renders
+// nothing until the book has any, so books never read on a device look as
+// before. Bookmarks (dog-ears) carry no text and are left out.
+export default function BookHighlights({ bookId }: BookHighlightsProps) {
+  const { data } = useBookAnnotations(bookId)
+  const exportAnnotations = useExportAnnotations()
+  const [exportStatus, setExportStatus] = useState('')
+
+  const annotations = (data?.annotations ?? []).filter((a) => a.type !== 'dogear')
+  if (annotations.length === 0) return null
+
+  async function handleExport() {
+    setExportStatus('Exporting…')
+    try {
+      const res = await exportAnnotations(bookId)
+      saveFile(res.data, res.filename, res.contentType)
+      setExportStatus('')
+    } catch {
+      setExportStatus('Export failed.')
+    }
+  }
+
+  return (
+    <section className="mt-8" aria-label="Highlights">
+      <div className="flex items-center justify-between mb-3">
+        <h2 className="text-lg font-semibold">
+          Highlights
+          <span className="ml-2 text-sm font-normal text-muted">{annotations.length}</span>
+        </h2>
+        <div className="flex items-center gap-2">
+          {exportStatus && <span className="text-sm text-muted">{exportStatus}</span>}
+          <Button type="button" variant="secondary" size="sm" onClick={handleExport}>
+            Export Markdown
+          </Button>
+        </div>
+      </div>
+      <div className="flex flex-col gap-6">
+        {groupByChapter(annotations).map(({ chapter, items }) => (
+          <div key={chapter}>
+            {chapter && <h3 className="mb-2 text-sm font-semibold text-muted">{chapter}</h3>}
+            <ul className="flex flex-col gap-3">
+              {items.map((a) => (
+                <li
+                  key={a.id}
+                  data-testid="book-highlight"
+                  className="rounded-2xl border border-border bg-card shadow-card p-4"
+                >
+                  {a.highlightedText && (
+                    <blockquote className="border-l-2 border-accent pl-3 text-sm text-fg">
+                      {a.highlightedText}
+                    </blockquote>
+                  )}
+                  {a.noteText && <p className="mt-2 text-sm text-fg">{a.noteText}</p>}
+                  {a.createdAt && (
+                    <p className="mt-2 text-xs text-muted">{formatDate(a.createdAt)}</p>
+                  )}
+                </li>
+              ))}
+            </ul>
+          </div>
+        ))}
+      </div>
+    </section>
+  )
+}
+
+// groupByChapter gathers annotations under their chapter title, chapters in
+// the order they first appear, keeping each chapter's own order.
+function groupByChapter(annotations: Annotation[]) {
+  const groups = new Map<string, Annotation[]>()
+  for (const a of annotations) {
+    const items = groups.get(a.chapterTitle)
+    if (items) {
+      items.push(a)
+    } else {
+      groups.set(a.chapterTitle, [a])
+    }
+  }
+  return [...groups].map(([chapter, items]) => ({ chapter, items }))
+}
@@ -37,6 +37,7 @@ export default function BookOwnershipToggles({
 
   const hasPdf = userBook.formats.includes('pdf')
   const hasEpub = userBook.formats.includes('epub')
+  const hasCbz = userBook.formats.includes('cbz')
 
   return (
     <div className="space-y-1.5">
@@ -53,6 +54,7 @@ export default function BookOwnershipToggles({
         />
         {hasPdf && <Badge variant="default">PDF</Badge>}
         {hasEpub && <Badge variant="default">EPUB</Badge>}
+        {hasCbz && <Badge variant="default">CBZ</Badge>}
       </div>
     </div>
   )
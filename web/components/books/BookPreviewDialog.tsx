@@ -1,6 +1,6 @@
 'use client'
 
-import { useEffect, useRef } from 'react'
+import { useEffect, useRef, useState } from 'react'
 import dynamic from 'next/dynamic'
 import { mutate } from 'swr'
 import {
@@ -19,12 +19,50 @@ const ReactReader = dynamic(
   { ssr: false }
 )
 
+// The slice of epub.js's Rendition used to jump to a search match (see
+// showFirstMatch); typed locally since react-reader doesn't re-export it.
+interface SearchableSection {
+  load: (request: (path: string) => Promise<object>) => Promise<unknown>
+  find: (query: string) => { cfi: string }[]
+}
+interface SearchableRendition {
+  book: {
+    ready: Promise<unknown>
+    load: (path: string) => Promise<object>
+    spine: { get: (target: string) => SearchableSection | null }
+  }
+  display: (target: string) => Promise<void>
+  annotations: { highlight: (cfiRange: string) => void }
+}
+
+// Shows and highlights the first occurrence of term in the chapter at href.
+// Best effort: when the chapter or the term can't be found the reader simply
+// stays at the start of the chapter.
+async function showFirstMatch(rendition: SearchableRendition, href: string, term: string) {
+  try {
+    await rendition.book.ready
+    const section = rendition.book.spine.get(href)
+    if (!section) return
+    await section.load(rendition.book.load.bind(rendition.book))
+    const match = section.find(term)[0]
+    if (!match) return
+    await rendition.display(match.cfi)
+    rendition.annotations.highlight(match.cfi)
+  } catch {
+    // Keep the chapter location.
+  }
+}
+
 interface BookPreviewDialogProps {
   bookId: string
   format: 'pdf' | 'epub' | 'kepub'
   title: string
   open: boolean
   onOpenChange: (open: boolean) => void
+  /** EPUB content document to open at, e.g. from a book text search hit. */
+  initialLocation?: string | null
+  /** Word to find and highlight in the initialLocation chapter. */
+  findText?: string
 }
 
 export default function BookPreviewDialog({
@@ -32,10 +70,13 @@ export default function BookPreviewDialog({
   format,
   title,
   open,
-  onOpenChange
+  onOpenChange,
+  initialLocation = null,
+  findText = ''
 }: BookPreviewDialogProps) {
   const isKepub = format === 'kepub'
   const isPDF = format === 'pdf'
+  const [location, setLocation] = useState<string | number | null>(initialLocation)
 
   // For on-demand KEPUB conversion: trigger once per dialog open.
   const requestKEPUBConversion = useRequestKEPUBConversion()
@@ -138,9 +179,17 @@ export default function BookPreviewDialog({
               url={data.url}
               title={title}
               epubInitOptions={{ openAs: 'epub' }}
-              // location/locationChanged are required by ReactReader's props
-              location={null}
-              locationChanged={() => {}}
+              location={location}
+              locationChanged={(loc: string) => setLocation(loc)}
+              getRendition={(rendition) => {
+                if (initialLocation && findText) {
+                  void showFirstMatch(
+                    rendition as unknown as SearchableRendition,
+                    initialLocation,
+                    findText
+                  )
+                }
+              }}
             />
           )}
         </div>
@@ -0,0 +1,70 @@
+'use client'
+
+import { useEffect, useState } from 'react'
+import Link from 'next/link'
+import { useSearchBookText } from '@/hooks/useBooks'
+import { interactiveCardClass } from '@/components/ui/card'
+import { cn } from '@/lib/cn'
+import { splitSnippet, textHitHref } from '@/lib/books/textSearch'
+
+interface BookTextHitsProps {
+  /** Free-text query from the library search bar. */
+  query: string
+}
+
+// Passages inside uploaded books and saved articles that match the library
+// search, each linking into the reader at the matched chapter. Renders
+// nothing until there are hits, so title-only searches look as before.
+export default function BookTextHits({ query }: BookTextHitsProps) {
+  // Debounced like the external search so typing doesn't fire a request per
+  // keystroke.
+  const [debounced, setDebounced] = useState(query)
+  useEffect(() => {
+    const timer = setTimeout(() => setDebounced(query), 300)
+    return () => clearTimeout(timer)
+  }, [query])
+
+  const { data } = useSearchBookText(debounced)
+  const hits = data?.hits ?? []
+  if (hits.length === 0) return null
+
+  return (
+    <section className="mt-6" aria-label="Matches in book text">
+      <h3 className="mb-2 text-sm font-semibold">
+        In book text
+        <span className="ml-2 font-normal text-muted">
+          {hits.length}
+          {data?.hasMore ? '+' : ''}
+        </span>
+      </h3>
+      <ul className="flex flex-col gap-2">
+        {hits.map((hit, i) => (
+          <li key={`${hit.userBookId}-${hit.format}-${i}`}>
+            <Link
+              href={textHitHref(hit, debounced)}
+              className={cn(interactiveCardClass, 'block p-3')}
+            >
+              <p className="text-sm font-semibold leading-snug">{hit.title}</p>
+              <p className="text-xs text-muted">
+                {[hit.authors.join(', '), hit.chapter].filter(Boolean).join(' · ')}
+              </p>
+              <p className="mt-1 text-sm text-fg">
+                …
+                {splitSnippet(hit.snippet).map((part, j) =>
+                  part.marked ? (
+                    <mark key={j} className="rounded bg-accent/20 px-0.5 text-fg">
+                      {part.text}
+                    </mark>
+                  ) : (
+                    <span key={j}>{part.text}</span>
+                  )
+                )}
+                …
+              </p>
+            </Link>
+          </li>
+        ))}
+      </ul>
+    </section>
+  )
+}
@@ -10,6 +10,7 @@ import { Button } from '@/components/ui/button'
 import { DateInput } from '@/components/ui/date-input'
 import { ytdProgress } from '@/lib/books/ytdProgress'
 import { statusLabel } from '@/lib/books/bookShelves'
+import { seriesLabel } from '@/lib/books/series'
 import type { DashboardChartState } from '@/hooks/useDashboardChartState'
 
 /**
@@ -63,6 +64,36 @@ export default function BooksDashboardView({
               </div>
             )}
           </div>
+
+          {library.upNext.length > 0 && (
+            <div data-testid="up-next">
+              <h2 className="mb-2 text-base font-semibold">Up next</h2>
+              <ol className="flex list-inside list-decimal flex-col gap-1 text-sm">
+                {library.upNext.map((ub) => (
+                  <li key={ub.id} className="truncate">
+                    <span className="font-medium">{ub.book?.title}</span>
+                    {ub.book && ub.book.authors.length > 0 && (
+                      <span className="text-muted"> · {ub.book.authors.join(', ')}</span>
+                    )}
+                  </li>
+                ))}
+              </ol>
+            </div>
+          )}
+
+          {library.nextInSeries.length > 0 && (
+            <div data-testid="next-in-series">
+              <h2 className="mb-2 text-base font-semibold">Next in series</h2>
+              <ul className="flex flex-col gap-1 text-sm">
+                {library.nextInSeries.map((ub) => (
+                  <li key={ub.id} className="truncate">
+                    <span className="font-medium">{ub.book?.title}</span>
+                    <span className="text-muted"> · {seriesLabel(ub.book)}</span>
+                  </li>
+                ))}
+              </ul>
+            </div>
+          )}
         </div>
 
         <div className="flex min-h-0 flex-col">
@@ -10,6 +10,7 @@ import LibrarySidebar, {
 import BooksTable from '@/components/books/BooksTable'
 import BookCard from '@/components/books/BookCard'
 import ExternalBookCard from '@/components/books/ExternalBookCard'
+import BookTextHits from '@/components/books/BookTextHits'
 import ManageShelvesTagsDialog from '@/components/books/ManageShelvesTagsDialog'
 import AddManualBookModal from '@/components/books/AddManualBookModal'
 import { Button } from '@/components/ui/button'
@@ -172,20 +173,23 @@ export default function BooksLibrary({
           </div>
 
           {isSearching ? (
-            <div className="grid grid-cols-1 sm:grid-cols-2 gap-3">
-              {filteredBooks.map((ub) => (
-                <BookCard key={ub.id} userBook={ub} onSaved={onSaved} query={searchQuery} />
-              ))}
-              {externalResults.map((book) => (
-                <ExternalBookCard key={`${book.provider}-${book.providerId}`} book={book} />
-              ))}
-              {!isSearchingExternal && resultCount === 0 && (
-                <p className="col-span-full py-16 text-center text-sm text-muted">No results.</p>
-              )}
-              {isSearchingExternal && (
-                <p className="col-span-full text-sm text-muted">Searching…</p>
-              )}
-            </div>
+            <>
+              <div className="grid grid-cols-1 sm:grid-cols-2 gap-3">
+                {filteredBooks.map((ub) => (
+                  <BookCard key={ub.id} userBook={ub} onSaved={onSaved} query={searchQuery} />
+                ))}
+                {externalResults.map((book) => (
+                  <ExternalBookCard key={`${book.provider}-${book.providerId}`} book={book} />
+                ))}
+                {!isSearchingExternal && resultCount === 0 && (
+                  <p className="col-span-full py-16 text-center text-sm text-muted">No results.</p>
+                )}
+                {isSearchingExternal && (
+                  <p className="col-span-full text-sm text-muted">Searching…</p>
+                )}
+              </div>
+              <BookTextHits query={searchQuery} />
+            </>
           ) : (
             <BooksTable
               books={filteredBooks}
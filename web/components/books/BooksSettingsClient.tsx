@@ -1,38 +1,33 @@
 'use client'
 
 import { useState } from 'react'
-import { useImportBooks } from '@/hooks/useBooks'
+import { useExportLibrary } from '@/hooks/useBooks'
 import BulkBookUploader from '@/components/books/BulkBookUploader'
 import KoboSetup from '@/components/books/KoboSetup'
 import KoboDevices from '@/components/books/KoboDevices'
-import { mutate } from 'swr'
+import OPDSTokens from '@/components/books/OPDSTokens'
+import KOReaderDevices from '@/components/books/KOReaderDevices'
+import LibraryImporter from '@/components/books/LibraryImporter'
+import ReadingQueueSettings from '@/components/books/ReadingQueueSettings'
 import { Breadcrumb } from '@/components/ui/breadcrumb'
-import { swrKeys } from '@/lib/swrKeys'
+import { saveFile } from '@/lib/books/download'
+import { Button } from '@/components/ui/button'
 import { PageContainer } from '@/components/ui/page-container'
 
 export default function BooksSettingsClient() {
-  const importBooks = useImportBooks()
+  const exportLibrary = useExportLibrary()
 
-  const [importStatus, setImportStatus] = useState('')
+  const [exportStatus, setExportStatus] = useState('')
 
-  function handleImport(e: React.ChangeEvent<HTMLInputElement>) {
-    const file = e.target.files?.[0]
-    if (!file) return
-    setImportStatus('Importing…')
-    const reader = new FileReader()
-    reader.onload = async (ev) => {
-      const csvData = ev.target?.result
-      if (typeof csvData !== 'string') return
-      try {
-        const res = await importBooks(csvData)
-        setImportStatus(`Imported ${res.importedCount} book(s).`)
-        await mutate(swrKeys.books)
-      } catch {
-        setImportStatus('Import failed.')
-      }
+  async function handleExport(format: 'csv' | 'json') {
+    setExportStatus('Exporting…')
+    try {
+      const res = await exportLibrary(format)
+      saveFile(res.data, res.filename, res.contentType)
+      setExportStatus('')
+    } catch {
+      setExportStatus('Export failed.')
     }
-    reader.readAsText(file)
-    e.target.value = ''
   }
 
   return (
@@ -48,17 +43,41 @@ export default function BooksSettingsClient() {
           Import books
         </h2>
         <p className="mb-3 text-xs text-muted">
-          Import your library from a Goodreads (or compatible) CSV export.
+          Import your library from Goodreads, StoryGraph, LibraryThing, a Calibre metadata.db or a
+          JSON backup. You&apos;ll see which books are added, merged into ones you already have or
+          skipped before anything changes.
+        </p>
+        <LibraryImporter />
+      </section>
+
+      <section className="mt-10 border-t border-border pt-8">
+        <h2 className="mb-3 text-sm font-semibold uppercase tracking-wide text-muted">
+          Export library
+        </h2>
+        <p className="mb-3 text-xs text-muted">
+          Download a Goodreads-compatible CSV to move to another service, or a full JSON backup that
+          also keeps reading positions, reading sessions and highlights.
         </p>
         <div className="flex items-center gap-2">
-          <label className="inline-flex h-9 cursor-pointer items-center rounded-xl border border-border bg-surface px-3 text-sm text-fg transition-colors hover:bg-hover active:bg-hover">
-            Import CSV
-            <input type="file" accept=".csv" onChange={handleImport} className="hidden" />
-          </label>
-          {importStatus && <span className="text-sm text-muted">{importStatus}</span>}
+          <Button variant="secondary" size="sm" onClick={() => handleExport('csv')}>
+            Export CSV
+          </Button>
+          <Button variant="secondary" size="sm" onClick={() => handleExport('json')}>
+            Export JSON
+          </Button>
+          {exportStatus && <span className="text-sm text-muted">{exportStatus}</span>}
         </div>
       </section>
 
+      <section className="mt-10 border-t border-border pt-8">
+        <h2 className="mb-3 text-sm font-semibold uppercase tracking-wide text-muted">Up next</h2>
+        <p className="mb-3 text-xs text-muted">
+          Queue to-read books from a book&apos;s page to decide what you read next. The top of the
+          queue can sync to your Kobo without tagging each book.
+        </p>
+        <ReadingQueueSettings />
+      </section>
+
       <section className="mt-10 border-t border-border pt-8">
         <h2 className="mb-3 text-sm font-semibold uppercase tracking-wide text-muted">
           Upload ebooks
@@ -85,6 +104,28 @@ export default function BooksSettingsClient() {
           <KoboDevices />
         </div>
       </section>
+
+      <section className="mt-10 border-t border-border pt-8">
+        <h2 className="mb-3 text-sm font-semibold uppercase tracking-wide text-muted">
+          OPDS catalog
+        </h2>
+        <p className="mb-3 text-xs text-muted">
+          Browse and download your books from reader apps like KOReader, Thorium or Moon+ Reader.
+          Each app gets its own catalog link; revoking a link immediately cuts off its access.
+        </p>
+        <OPDSTokens />
+      </section>
+
+      <section className="mt-10 border-t border-border pt-8">
+        <h2 className="mb-3 text-sm font-semibold uppercase tracking-wide text-muted">
+          KOReader progress sync
+        </h2>
+        <p className="mb-3 text-xs text-muted">
+          Sync reading positions from KOReader. Progress on books in your library shows up alongside
+          Kobo and web reading progress.
+        </p>
+        <KOReaderDevices />
+      </section>
     </PageContainer>
   )
 }
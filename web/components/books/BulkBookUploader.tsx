@@ -177,12 +177,12 @@ export default function BulkBookUploader() {
         onClick={() => !busy && document.getElementById('bulk-file-input')?.click()}
       >
         <p className="text-sm text-muted">
-          Drop EPUB or PDF files (or a folder) here, or click to browse
+          Drop EPUB, PDF, CBZ, MOBI/AZW3 or FB2 files (or a folder) here, or click to browse
         </p>
         <input
           id="bulk-file-input"
           type="file"
-          accept=".epub,.pdf"
+          accept=".epub,.pdf,.cbz,.mobi,.azw,.azw3,.fb2,.zip"
           multiple
           className="hidden"
           onChange={handleInputChange}
@@ -72,6 +72,7 @@ export default function DuplicateBookSummary({ ub }: DuplicateBookSummaryProps)
   const hasDigital = ub.tags.includes('own-digital')
   const hasPdf = ub.formats.includes('pdf')
   const hasEpub = ub.formats.includes('epub')
+  const hasCbz = ub.formats.includes('cbz')
   const hasKepub = ub.formats.includes('kepub')
 
   const isbn = isbnDisplay(book.isbn13)
@@ -113,6 +114,7 @@ export default function DuplicateBookSummary({ ub }: DuplicateBookSummaryProps)
           {hasDigital && <Badge variant="default">Digital</Badge>}
           {hasPdf && <Badge variant="default">PDF</Badge>}
           {hasEpub && <Badge variant="default">EPUB</Badge>}
+          {hasCbz && <Badge variant="default">CBZ</Badge>}
           {hasKepub && <Badge variant="default">KEPUB</Badge>}
         </div>
       </div>
@@ -0,0 +1,156 @@
+'use client'
+
+import { useState } from 'react'
+import {
+  useListKOReaderDevices,
+  useRegisterKOReaderDevice,
+  useDisconnectKOReaderDevice
+} from '@/hooks/useBooks'
+import { Button } from '@/components/ui/button'
+import { Input } from '@/components/ui/input'
+import { formatDate } from '@/lib/dates'
+import { getApiUrl } from '@/lib/env'
+
+function formatLastSync(lastUsedAt: string): string {
+  if (!lastUsedAt) return 'Never synced'
+  return `Last synced ${formatDate(lastUsedAt)}`
+}
+
+// The custom sync server KOReader's "Progress sync" plugin should point at.
+export function koreaderServerUrl(): string {
+  return `${getApiUrl()}/books/kosync`
+}
+
+interface Credentials {
+  username: string
+  password: string
+}
+
+export default function KOReaderDevices() {
+  const { data, isLoading, mutate } = useListKOReaderDevices()
+  const registerKOReaderDevice = useRegisterKOReaderDevice()
+  const disconnectKOReaderDevice = useDisconnectKOReaderDevice()
+
+  const [username, setUsername] = useState('')
+  const [registering, setRegistering] = useState(false)
+  const [error, setError] = useState('')
+  const [credentials, setCredentials] = useState<Credentials | null>(null)
+
+  const devices = data?.devices ?? []
+
+  async function handleRegister(e: React.FormEvent) {
+    e.preventDefault()
+    if (!username.trim()) return
+    setRegistering(true)
+    setError('')
+    try {
+      const res = await registerKOReaderDevice(username.trim())
+      setCredentials({ username: res.device?.username ?? username.trim(), password: res.password })
+      setUsername('')
+      await mutate()
+    } catch {
+      setError('Failed to create KOReader credentials. Please try again.')
+    } finally {
+      setRegistering(false)
+    }
+  }
+
+  async function handleDisconnect(id: string) {
+    await disconnectKOReaderDevice(id)
+    await mutate()
+  }
+
+  const fields = credentials
+    ? [
+        { label: 'Server', value: koreaderServerUrl() },
+        { label: 'Username', value: credentials.username },
+        { label: 'Password', value: credentials.password }
+      ]
+    : []
+
+  return (
+    <div className="space-y-4">
+      <form className="flex items-center gap-2" onSubmit={handleRegister}>
+        <Input
+          value={username}
+          onChange={(e) => setUsername(e.target.value)}
+          placeholder="Username, e.g. kindle"
+          data-testid="koreader-username"
+        />
+        <Button
+          type="submit"
+          disabled={registering || !username.trim()}
+          data-testid="koreader-register-btn"
+        >
+          {registering ? 'Creating…' : 'Create login'}
+        </Button>
+      </form>
+      {error && (
+        <p className="text-sm text-danger" data-testid="koreader-error">
+          {error}
+        </p>
+      )}
+
+      {credentials && (
+        <div
+          className="space-y-2 rounded-xl border border-border bg-card px-4 py-3"
+          data-testid="koreader-credentials"
+        >
+          <p className="text-xs text-muted">
+            In KOReader, open Progress sync → Custom sync server and enter these. The password is
+            only shown once.
+          </p>
+          {fields.map(({ label, value }) => (
+            <div key={label} className="flex items-center gap-2">
+              <span className="w-16 shrink-0 text-xs text-subtle">{label}</span>
+              <code className="min-w-0 flex-1 truncate text-xs">{value}</code>
+              <Button
+                type="button"
+                variant="ghost"
+                size="sm"
+                onClick={() => void navigator.clipboard.writeText(value)}
+              >
+                Copy
+              </Button>
+            </div>
+          ))}
+        </div>
+      )}
+
+      {isLoading ? (
+        <p className="text-xs text-muted" data-testid="koreader-devices-loading">
+          Loading KOReader logins…
+        </p>
+      ) : devices.length === 0 ? (
+        <p className="text-xs text-muted" data-testid="koreader-devices-empty">
+          No KOReader logins yet.
+        </p>
+      ) : (
+        <ul className="space-y-2" data-testid="koreader-devices-list">
+          {devices.map((device) => (
+            <li
+              key={device.id}
+              className="flex items-center justify-between rounded-xl border border-border bg-card px-4 py-3"
+              data-testid={`koreader-device-${device.id}`}
+            >
+              <div className="min-w-0 flex-1">
+                <p className="truncate text-sm font-medium">{device.username}</p>
+                <p className="text-xs text-muted">{formatLastSync(device.lastUsedAt)}</p>
+              </div>
+              <Button
+                type="button"
+                variant="destructive"
+                size="sm"
+                className="ml-4 shrink-0"
+                onClick={() => handleDisconnect(device.id)}
+                data-testid={`koreader-disconnect-btn-${device.id}`}
+              >
+                Disconnect
+              </Button>
+            </li>
+          ))}
+        </ul>
+      )}
+    </div>
+  )
+}
@@ -4,9 +4,11 @@ import { useState } from 'react'
 import {
   useListKoboDevices,
   useDisconnectKoboDevice,
+  useSetKoboDeviceFilter,
   useSetKoboDeviceLogging
 } from '@/hooks/useBooks'
 import { Button } from '@/components/ui/button'
+import { Input } from '@/components/ui/input'
 import { Dialog, DialogContent, DialogHeader, DialogTitle } from '@/components/ui/dialog'
 import { formatDate } from '@/lib/dates'
 import KoboDeviceLogs from '@/components/books/KoboDeviceLogs'
@@ -16,6 +18,92 @@ function formatLastSeen(lastSeenAt: string): string {
   return `Last synced ${formatDate(lastSeenAt)}`
 }
 
+function formatFilter(shelves: string[], tags: string[]): string {
+  if (shelves.length === 0 && tags.length === 0) return 'Syncs all Kobo books'
+  const parts = []
+  if (shelves.length > 0) parts.push(`shelves: ${shelves.join(', ')}`)
+  if (tags.length > 0) parts.push(`tags: ${tags.join(', ')}`)
+  return `Syncs ${parts.join('; ')}`
+}
+
+function splitList(value: string): string[] {
+  return value
+    .split(',')
+    .map((v) => v.trim())
+    .filter(Boolean)
+}
+
+interface FilterDialogProps {
+  device: { name: string; syncShelves: string[]; syncTags: string[] }
+  onCancel: () => void
+  onSave: (shelves: string[], tags: string[]) => Promise<void>
+}
+
+function FilterDialog({ device, onCancel, onSave }: FilterDialogProps) {
+  const [shelves, setShelves] = useState(device.syncShelves.join(', '))
+  const [tags, setTags] = useState(device.syncTags.join(', '))
+  const [saving, setSaving] = useState(false)
+  const [error, setError] = useState('')
+
+  async function handleSave() {
+    setSaving(true)
+    setError('')
+    try {
+      await onSave(splitList(shelves), splitList(tags))
+    } catch {
+      setError('Failed to save the sync filter. Please try again.')
+    } finally {
+      setSaving(false)
+    }
+  }
+
+  return (
+    <Dialog open onOpenChange={(o) => !o && !saving && onCancel()}>
+      <DialogContent>
+        <DialogHeader>
+          <DialogTitle>Sync filter for {device.name}</DialogTitle>
+        </DialogHeader>
+        <p className="text-sm text-muted">
+          Only Kobo books on one of these shelves or with one of these tags are synced to this
+          device. Leave both empty to sync every Kobo book. Books that no longer match are archived
+          on the device at its next sync.
+        </p>
+        <div className="mt-4 space-y-3">
+          <label className="block text-xs text-subtle">
+            Shelves (comma separated, e.g. currently-reading, to-read)
+            <Input
+              value={shelves}
+              onChange={(e) => setShelves(e.target.value)}
+              data-testid="kobo-filter-shelves"
+            />
+          </label>
+          <label className="block text-xs text-subtle">
+            Tags (comma separated)
+            <Input
+              value={tags}
+              onChange={(e) => setTags(e.target.value)}
+              data-testid="kobo-filter-tags"
+            />
+          </label>
+        </div>
+        {error && (
+          <p className="mt-2 text-sm text-danger" data-testid="kobo-filter-error">
+            {error}
+          </p>
+        )}
+        <div className="mt-6 flex justify-end gap-2">
+          <Button variant="ghost" disabled={saving} onClick={onCancel}>
+            Cancel
+          </Button>
+          <Button disabled={saving} onClick={handleSave} data-testid="kobo-filter-save-btn">
+            {saving ? 'Saving…' : 'Save'}
+          </Button>
+        </div>
+      </DialogContent>
+    </Dialog>
+  )
+}
+
 interface DisconnectDialogProps {
   open: boolean
   deviceName: string
@@ -82,12 +170,15 @@ export default function KoboDevices() {
   const { data, isLoading, mutate } = useListKoboDevices()
   const disconnectKoboDevice = useDisconnectKoboDevice()
   const setKoboDeviceLogging = useSetKoboDeviceLogging()
+  const setKoboDeviceFilter = useSetKoboDeviceFilter()
 
   const [pendingId, setPendingId] = useState<string | null>(null)
   const [pendingName, setPendingName] = useState('')
   const [expandedId, setExpandedId] = useState<string | null>(null)
+  const [filterId, setFilterId] = useState<string | null>(null)
 
   const devices = data?.devices ?? []
+  const filterDevice = devices.find((d) => d.id === filterId) ?? null
 
   async function handleDisconnect() {
     if (!pendingId) return
@@ -96,6 +187,13 @@ export default function KoboDevices() {
     setPendingId(null)
   }
 
+  async function handleSaveFilter(shelves: string[], tags: string[]) {
+    if (!filterId) return
+    await setKoboDeviceFilter(filterId, shelves, tags)
+    await mutate()
+    setFilterId(null)
+  }
+
   async function handleToggleLogging(id: string, enabled: boolean) {
     await setKoboDeviceLogging(id, enabled)
     setExpandedId(enabled ? id : (prev) => (prev === id ? null : prev))
@@ -134,6 +232,9 @@ export default function KoboDevices() {
                   {device.serial ? `Serial: ${device.serial} · ` : ''}
                   {formatLastSeen(device.lastSeenAt)}
                 </p>
+                <p className="text-xs text-muted" data-testid={`kobo-filter-summary-${device.id}`}>
+                  {formatFilter(device.syncShelves, device.syncTags)}
+                </p>
               </div>
               <Button
                 type="button"
@@ -151,6 +252,15 @@ export default function KoboDevices() {
             </div>
 
             <div className="mt-2 flex items-center gap-3">
+              <Button
+                type="button"
+                variant="ghost"
+                size="sm"
+                onClick={() => setFilterId(device.id)}
+                data-testid={`kobo-filter-btn-${device.id}`}
+              >
+                Sync filter
+              </Button>
               <label className="flex cursor-pointer items-center gap-2 text-xs text-subtle">
                 <input
                   type="checkbox"
@@ -181,6 +291,15 @@ export default function KoboDevices() {
         ))}
       </ul>
 
+      {filterDevice && (
+        <FilterDialog
+          key={filterDevice.id}
+          device={filterDevice}
+          onCancel={() => setFilterId(null)}
+          onSave={handleSaveFilter}
+        />
+      )}
+
       <DisconnectDialog
         open={pendingId !== null}
         deviceName={pendingName}
@@ -10,20 +10,42 @@ import {
   gatewayNeedsUpdate,
   revertGateway,
   updateGateway,
+  type GatewayImportResult,
   type GatewayStatus,
   type GatewayKobo
 } from '@/lib/books/gatewayClient'
-import { useGatewayStatus } from '@/hooks/useKoboGateway'
+import { useGatewayStatus, useImportGateway } from '@/hooks/useKoboGateway'
 import {
   useRegisterKoboDevice,
   useDisconnectKoboDevice,
   useListKoboDevices
 } from '@/hooks/useBooks'
 
-type GatewayState = 'idle' | 'updating' | 'configuring' | 'success' | 'reverting' | 'error'
+type GatewayState =
+  | 'idle'
+  | 'updating'
+  | 'configuring'
+  | 'success'
+  | 'reverting'
+  | 'importing'
+  | 'error'
 
 const UPDATE_POLL_ATTEMPTS = 10
 
+function describeImport(result: GatewayImportResult): string {
+  const parts = [
+    `${result.highlights} highlights`,
+    `${result.sessions} reading sessions`,
+    `${result.markedRead} books marked read`
+  ]
+  if (result.created > 0) parts.push(`${result.created} books added`)
+  let summary = `Imported ${parts.join(', ')}.`
+  if (result.skippedHighlights > 0) {
+    summary += ` ${result.skippedHighlights} highlights could not be imported.`
+  }
+  return summary
+}
+
 function sleep(ms: number) {
   return new Promise((resolve) => setTimeout(resolve, ms))
 }
@@ -47,12 +69,14 @@ export default function KoboGatewaySetup({ status, pollIntervalMs = 1500 }: Kobo
   const [selectedVolume, setSelectedVolume] = useState<string | null>(null)
   const [originalEndpoint, setOriginalEndpoint] = useState<string | null>(null)
   const [deviceId, setDeviceId] = useState<string | null>(null)
+  const [imported, setImported] = useState<GatewayImportResult | null>(null)
   // Guards against re-triggering the self-update on every poll tick.
   const updateAttempted = useRef(false)
 
   const registerKoboDevice = useRegisterKoboDevice()
   const disconnectKoboDevice = useDisconnectKoboDevice()
   const { data: devices, mutate: mutateDevices } = useListKoboDevices()
+  const importHistory = useImportGateway()
 
   const runUpdate = useCallback(async () => {
     try {
@@ -140,6 +164,26 @@ export default function KoboGatewaySetup({ status, pollIntervalMs = 1500 }: Kobo
     }
   }
 
+  /**
+   * Uploads the Kobo's local reading history (highlights, sessions, finished
+   * books) through the gateway to the endpoint it is already synced with.
+   */
+  async function handleImport(kobo: GatewayKobo) {
+    setState('importing')
+    setError(null)
+    setImported(null)
+
+    try {
+      setImported(await importHistory(kobo.volumePath))
+      setState('idle')
+    } catch (err: unknown) {
+      setError(
+        err instanceof Error && err.message ? err.message : 'Failed to import reading history.'
+      )
+      setState('error')
+    }
+  }
+
   if (state === 'updating') {
     return (
       <p className="text-sm text-muted" data-testid="kobo-gateway-updating">
@@ -199,6 +243,19 @@ export default function KoboGatewaySetup({ status, pollIntervalMs = 1500 }: Kobo
           >
             This Kobo is already configured for sync with this server.
           </div>
+          <Button
+            type="button"
+            variant="secondary"
+            onClick={() => handleImport(kobo)}
+            data-testid="kobo-gateway-import-btn"
+          >
+            Import reading history from this Kobo
+          </Button>
+          {imported && (
+            <p className="text-sm text-muted" data-testid="kobo-gateway-import-result">
+              {describeImport(imported)}
+            </p>
+          )}
           <Button
             type="button"
             variant="secondary"
@@ -216,6 +273,12 @@ export default function KoboGatewaySetup({ status, pollIntervalMs = 1500 }: Kobo
         </Button>
       )}
 
+      {state === 'importing' && (
+        <Button type="button" disabled data-testid="kobo-gateway-import-btn">
+          Importing…
+        </Button>
+      )}
+
       {state === 'reverting' && (
         <Button type="button" disabled data-testid="kobo-gateway-revert-btn">
           Reverting…
@@ -32,9 +32,10 @@ export default function KoboSyncToggle({ bookId, enabled, tags, onChanged }: Kob
 
   const hasEpub = statusData?.hasEpub ?? false
   const hasPdf = statusData?.hasPdf ?? false
+  const hasCbz = statusData?.hasCbz ?? false
   const kepubStatus = statusData?.kepubStatus ?? ''
 
-  const canEnable = hasEpub || hasPdf
+  const canEnable = hasEpub || hasPdf || hasCbz
 
   const handleToggle = async () => {
     const wasEnabled = enabledState
@@ -99,7 +100,7 @@ export default function KoboSyncToggle({ bookId, enabled, tags, onChanged }: Kob
       </div>
 
       {!canEnable && !enabledState && (
-        <p className="text-xs text-muted">Upload an EPUB or PDF to enable Kobo sync.</p>
+        <p className="text-xs text-muted">Upload an EPUB, PDF or CBZ to enable Kobo sync.</p>
       )}
 
       {enabledState && hasPdf && (
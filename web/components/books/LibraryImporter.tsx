@@ -0,0 +1,179 @@
+'use client'
+
+import { useState } from 'react'
+import { mutate } from 'swr'
+import { useImportBooks } from '@/hooks/useBooks'
+import { swrKeys } from '@/lib/swrKeys'
+import { Badge } from '@/components/ui/badge'
+import { Button } from '@/components/ui/button'
+import { Select } from '@/components/ui/select'
+import type { ImportBooksResponse } from '@/lib/gen/books/v1/catalog_pb'
+
+export const importFormats: { value: string; label: string }[] = [
+  { value: '', label: 'Detect automatically' },
+  { value: 'goodreads', label: 'Goodreads CSV' },
+  { value: 'storygraph', label: 'StoryGraph CSV' },
+  { value: 'librarything', label: 'LibraryThing TSV or JSON' },
+  { value: 'calibre', label: 'Calibre metadata.db' },
+  { value: 'backup', label: 'JSON backup' }
+]
+
+function formatLabel(format: string): string {
+  return importFormats.find((f) => f.value === format)?.label ?? format
+}
+
+const actionBadge = {
+  create: { label: 'New', variant: 'success' },
+  merge: { label: 'Merge', variant: 'default' },
+  skip: { label: 'Skip', variant: 'secondary' }
+} as const
+
+function readFile(file: File): Promise<Uint8Array> {
+  return new Promise((resolve, reject) => {
+    const reader = new FileReader()
+    reader.onload = () => {
+      if (reader.result instanceof ArrayBuffer) resolve(new Uint8Array(reader.result))
+      else reject(new Error('unreadable file'))
+    }
+    reader.onerror = () => reject(reader.error)
+    reader.readAsArrayBuffer(file)
+  })
+}
+
+export default function LibraryImporter() {
+  const importBooks = useImportBooks()
+
+  const [format, setFormat] = useState('')
+  const [data, setData] = useState<Uint8Array | null>(null)
+  const [preview, setPreview] = useState<ImportBooksResponse | null>(null)
+  const [status, setStatus] = useState('')
+  const [busy, setBusy] = useState(false)
+
+  async function runPreview(bytes: Uint8Array, chosen: string) {
+    setBusy(true)
+    setPreview(null)
+    setStatus('Reading file…')
+    try {
+      setPreview(await importBooks(bytes, { format: chosen, dryRun: true }))
+      setStatus('')
+    } catch {
+      setStatus('Could not read this file. Try picking its format.')
+    } finally {
+      setBusy(false)
+    }
+  }
+
+  async function handleFile(e: React.ChangeEvent<HTMLInputElement>) {
+    const file = e.target.files?.[0]
+    e.target.value = ''
+    if (!file) return
+    try {
+      const bytes = await readFile(file)
+      setData(bytes)
+      await runPreview(bytes, format)
+    } catch {
+      setStatus('Could not read this file.')
+    }
+  }
+
+  function handleFormat(next: string) {
+    setFormat(next)
+    if (data) void runPreview(data, next)
+  }
+
+  function reset() {
+    setData(null)
+    setPreview(null)
+  }
+
+  async function handleConfirm() {
+    if (!data || !preview) return
+    setBusy(true)
+    setStatus('Importing…')
+    try {
+      const res = await importBooks(data, { format: preview.format })
+      setStatus(
+        `Imported ${res.importedCount} book(s): ${res.createdCount} new, ${res.mergedCount} merged.`
+      )
+      reset()
+      await mutate(swrKeys.books)
+    } catch {
+      setStatus('Import failed.')
+    } finally {
+      setBusy(false)
+    }
+  }
+
+  return (
+    <div className="space-y-3">
+      <div className="flex flex-wrap items-center gap-2">
+        <Select
+          value={format}
+          onChange={(e) => handleFormat(e.target.value)}
+          className="h-9 w-auto"
+          aria-label="Import format"
+        >
+          {importFormats.map((f) => (
+            <option key={f.value} value={f.value}>
+              {f.label}
+            </option>
+          ))}
+        </Select>
+        <label className="inline-flex h-9 cursor-pointer items-center rounded-xl border border-border bg-surface px-3 text-sm text-fg transition-colors hover:bg-hover active:bg-hover">
+          Choose file
+          <input
+            type="file"
+            accept=".csv,.tsv,.txt,.json,.db"
+            onChange={handleFile}
+            className="hidden"
+            data-testid="import-file"
+          />
+        </label>
+        {status && <span className="text-sm text-muted">{status}</span>}
+      </div>
+
+      {preview && (
+        <div
+          className="space-y-3 rounded-xl border border-border bg-card px-4 py-3"
+          data-testid="import-preview"
+        >
+          <p className="text-sm">
+            {formatLabel(preview.format)}: {preview.createdCount} new, {preview.mergedCount} to
+            merge, {preview.skippedCount} skipped.
+          </p>
+          <ul className="max-h-64 space-y-1 overflow-y-auto text-sm">
+            {preview.items.map((item, i) => {
+              const badge = actionBadge[item.action as keyof typeof actionBadge]
+              return (
+                <li key={i} className="flex items-center gap-2">
+                  <Badge variant={badge?.variant ?? 'secondary'}>
+                    {badge?.label ?? item.action}
+                  </Badge>
+                  <span className="min-w-0 flex-1 truncate">
+                    {item.title || 'Untitled'}
+                    {item.authors.length > 0 && (
+                      <span className="text-muted"> · {item.authors.join(', ')}</span>
+                    )}
+                  </span>
+                  {item.reason && <span className="text-xs text-subtle">{item.reason}</span>}
+                </li>
+              )
+            })}
+          </ul>
+          <div className="flex gap-2">
+            <Button
+              size="sm"
+              onClick={handleConfirm}
+              disabled={busy || preview.createdCount + preview.mergedCount === 0}
+            >
+              Import
+            </Button>
+            <Button size="sm" variant="ghost" onClick={reset} disabled={busy}>
+              Cancel
+            </Button>
+          </div>
+        </div>
+      )}
+    </div>
+  )
+}
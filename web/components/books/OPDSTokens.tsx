@@ -0,0 +1,139 @@
+'use client'
+
+import { useState } from 'react'
+import { useListOPDSTokens, useCreateOPDSToken, useRevokeOPDSToken } from '@/hooks/useBooks'
+import { Button } from '@/components/ui/button'
+import { Input } from '@/components/ui/input'
+import { formatDate } from '@/lib/dates'
+import { getApiUrl } from '@/lib/env'
+
+function formatLastUsed(lastUsedAt: string): string {
+  if (!lastUsedAt) return 'Never used'
+  return `Last used ${formatDate(lastUsedAt)}`
+}
+
+// The catalog URLs for a raw token: OPDS 1.2 for most reader apps, 2.0 for
+// the ones that support it (Thorium, Readium-based readers).
+export function opdsCatalogUrls(rawToken: string): { label: string; url: string }[] {
+  const base = `${getApiUrl()}/books/opds/${rawToken}`
+  return [
+    { label: 'OPDS 1.2', url: `${base}/v1.2` },
+    { label: 'OPDS 2.0', url: `${base}/v2` }
+  ]
+}
+
+export default function OPDSTokens() {
+  const { data, isLoading, mutate } = useListOPDSTokens()
+  const createOPDSToken = useCreateOPDSToken()
+  const revokeOPDSToken = useRevokeOPDSToken()
+
+  const [name, setName] = useState('')
+  const [creating, setCreating] = useState(false)
+  const [error, setError] = useState('')
+  const [rawToken, setRawToken] = useState('')
+
+  const tokens = data?.tokens ?? []
+
+  async function handleCreate(e: React.FormEvent) {
+    e.preventDefault()
+    if (!name.trim()) return
+    setCreating(true)
+    setError('')
+    try {
+      const res = await createOPDSToken(name.trim())
+      setRawToken(res.rawToken)
+      setName('')
+      await mutate()
+    } catch {
+      setError('Failed to create the catalog link. Please try again.')
+    } finally {
+      setCreating(false)
+    }
+  }
+
+  async function handleRevoke(id: string) {
+    await revokeOPDSToken(id)
+    await mutate()
+  }
+
+  return (
+    <div className="space-y-4">
+      <form className="flex items-center gap-2" onSubmit={handleCreate}>
+        <Input
+          value={name}
+          onChange={(e) => setName(e.target.value)}
+          placeholder="Reader app, e.g. KOReader"
+          data-testid="opds-token-name"
+        />
+        <Button type="submit" disabled={creating || !name.trim()} data-testid="opds-create-btn">
+          {creating ? 'Creating…' : 'Create link'}
+        </Button>
+      </form>
+      {error && (
+        <p className="text-sm text-danger" data-testid="opds-error">
+          {error}
+        </p>
+      )}
+
+      {rawToken && (
+        <div
+          className="space-y-2 rounded-xl border border-border bg-card px-4 py-3"
+          data-testid="opds-new-token"
+        >
+          <p className="text-xs text-muted">
+            Add one of these URLs as a catalog in your reader app. It is only shown once.
+          </p>
+          {opdsCatalogUrls(rawToken).map(({ label, url }) => (
+            <div key={label} className="flex items-center gap-2">
+              <span className="w-16 shrink-0 text-xs text-subtle">{label}</span>
+              <code className="min-w-0 flex-1 truncate text-xs">{url}</code>
+              <Button
+                type="button"
+                variant="ghost"
+                size="sm"
+                onClick={() => void navigator.clipboard.writeText(url)}
+              >
+                Copy
+              </Button>
+            </div>
+          ))}
+        </div>
+      )}
+
+      {isLoading ? (
+        <p className="text-xs text-muted" data-testid="opds-tokens-loading">
+          Loading catalog links…
+        </p>
+      ) : tokens.length === 0 ? (
+        <p className="text-xs text-muted" data-testid="opds-tokens-empty">
+          No catalog links yet.
+        </p>
+      ) : (
+        <ul className="space-y-2" data-testid="opds-tokens-list">
+          {tokens.map((token) => (
+            <li
+              key={token.id}
+              className="flex items-center justify-between rounded-xl border border-border bg-card px-4 py-3"
+              data-testid={`opds-token-${token.id}`}
+            >
+              <div className="min-w-0 flex-1">
+                <p className="truncate text-sm font-medium">{token.name}</p>
+                <p className="text-xs text-muted">{formatLastUsed(token.lastUsedAt)}</p>
+              </div>
+              <Button
+                type="button"
+                variant="destructive"
+                size="sm"
+                className="ml-4 shrink-0"
+                onClick={() => handleRevoke(token.id)}
+                data-testid={`opds-revoke-btn-${token.id}`}
+              >
+                Revoke
+              </Button>
+            </li>
+          ))}
+        </ul>
+      )}
+    </div>
+  )
+}
@@ -0,0 +1,80 @@
+'use client'
+
+import { useEffect, useState } from 'react'
+import { mutate } from 'swr'
+import { useLibrary, useUpdateQueueSettings } from '@/hooks/useBooks'
+import { swrKeys } from '@/lib/swrKeys'
+import { Button } from '@/components/ui/button'
+import { Input } from '@/components/ui/input'
+
+// Mirrors models.MaxQueueKoboSyncCount on the API.
+const MAX_KOBO_SYNC_COUNT = 50
+
+export default function ReadingQueueSettings() {
+  const { data } = useLibrary()
+  const updateQueueSettings = useUpdateQueueSettings()
+
+  const savedAutoAdvance = data?.library?.queueSettings?.autoAdvance ?? false
+  const savedKoboSyncCount = data?.library?.queueSettings?.koboSyncCount ?? 0
+  const [autoAdvance, setAutoAdvance] = useState(savedAutoAdvance)
+  const [koboSyncCount, setKoboSyncCount] = useState(savedKoboSyncCount)
+  const [saving, setSaving] = useState(false)
+  const [status, setStatus] = useState('')
+
+  // The library may load after the first render.
+  useEffect(() => {
+    setAutoAdvance(savedAutoAdvance)
+    setKoboSyncCount(savedKoboSyncCount)
+  }, [savedAutoAdvance, savedKoboSyncCount])
+
+  const unchanged = autoAdvance === savedAutoAdvance && koboSyncCount === savedKoboSyncCount
+
+  async function handleSubmit(e: React.FormEvent) {
+    e.preventDefault()
+    setSaving(true)
+    setStatus('')
+    try {
+      await updateQueueSettings(autoAdvance, koboSyncCount)
+      setStatus('Saved')
+      void mutate(swrKeys.books)
+    } catch {
+      setStatus('Failed to save. Please try again.')
+    } finally {
+      setSaving(false)
+    }
+  }
+
+  return (
+    <form onSubmit={handleSubmit} className="space-y-3">
+      <label className="flex items-center gap-2 text-sm text-subtle">
+        <input
+          type="checkbox"
+          checked={autoAdvance}
+          onChange={(e) => setAutoAdvance(e.target.checked)}
+          className="rounded accent-accent"
+        />
+        Start the next book in the queue when I finish one
+      </label>
+      <div className="flex items-center gap-3">
+        <label htmlFor="queue-kobo-sync-count" className="text-sm text-subtle">
+          Books from the top of the queue to sync to Kobo
+        </label>
+        <Input
+          id="queue-kobo-sync-count"
+          type="number"
+          min={0}
+          max={MAX_KOBO_SYNC_COUNT}
+          value={koboSyncCount}
+          onChange={(e) => setKoboSyncCount(Number(e.target.value))}
+          className="h-9 w-20"
+        />
+      </div>
+      <div className="flex items-center gap-3">
+        <Button type="submit" size="sm" disabled={saving || unchanged}>
+          Save
+        </Button>
+        {status && <span className="text-xs text-muted">{status}</span>}
+      </div>
+    </form>
+  )
+}
@@ -0,0 +1,106 @@
+'use client'
+
+import { useState } from 'react'
+import { useDequeueBook, useQueueBook, useReorderQueue } from '@/hooks/useBooks'
+import type { UserBook } from '@/lib/gen/books/v1/library_pb'
+import { Button } from '@/components/ui/button'
+
+interface UpNextControlsProps {
+  userBook: UserBook
+  // The whole queue in order (LibraryResponse.upNext); moving a book sends
+  // the reordered list back.
+  upNext: UserBook[]
+  onChanged?: () => void
+}
+
+// UpNextControls adds a to-read book to the "up next" queue, takes it out
+// again, or moves it one place up or down.
+export default function UpNextControls({ userBook, upNext, onChanged }: UpNextControlsProps) {
+  const [busy, setBusy] = useState(false)
+  const [error, setError] = useState<string | null>(null)
+
+  const queueBook = useQueueBook()
+  const dequeueBook = useDequeueBook()
+  const reorderQueue = useReorderQueue()
+
+  const ids = upNext.map((ub) => ub.bookId)
+  const index = ids.indexOf(userBook.bookId)
+  const queued = index !== -1
+
+  const run = async (call: () => Promise<unknown>) => {
+    setBusy(true)
+    setError(null)
+    try {
+      await call()
+      onChanged?.()
+    } catch (err) {
+      setError(err instanceof Error ? err.message : 'Failed to update up next.')
+    } finally {
+      setBusy(false)
+    }
+  }
+
+  const move = (by: number) => {
+    const next = [...ids]
+    next.splice(index, 1)
+    next.splice(index + by, 0, userBook.bookId)
+    return run(() => reorderQueue(next))
+  }
+
+  return (
+    <div className="space-y-2">
+      <div className="flex flex-wrap items-center gap-2">
+        {!queued && (
+          <Button
+            type="button"
+            variant="secondary"
+            size="sm"
+            className="text-xs"
+            disabled={busy}
+            onClick={() => run(() => queueBook(userBook.bookId))}
+          >
+            Add to up next
+          </Button>
+        )}
+        {queued && (
+          <>
+            <span className="text-sm text-subtle" data-testid="up-next-position">
+              #{index + 1} of {ids.length}
+            </span>
+            <Button
+              type="button"
+              variant="secondary"
+              size="sm"
+              className="text-xs"
+              disabled={busy || index === 0}
+              onClick={() => move(-1)}
+            >
+              Move up
+            </Button>
+            <Button
+              type="button"
+              variant="secondary"
+              size="sm"
+              className="text-xs"
+              disabled={busy || index === ids.length - 1}
+              onClick={() => move(1)}
+            >
+              Move down
+            </Button>
+            <Button
+              type="button"
+              variant="ghost"
+              size="sm"
+              className="text-xs"
+              disabled={busy}
+              onClick={() => run(() => dequeueBook(userBook.bookId))}
+            >
+              Remove from up next
+            </Button>
+          </>
+        )}
+      </div>
+      {error && <p className="text-xs text-danger">{error}</p>}
+    </div>
+  )
+}
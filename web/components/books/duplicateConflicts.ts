@@ -219,12 +219,17 @@ export function buildResolvedMetadata(
 
   // coverUrl and status are intentionally excluded — cover is controlled via
   // resolvedCoverSourceBookId, status via resolvedStatus on MergeBooksRequest.
+  // Series is not a conflict field; the winner's is kept so the merge does not
+  // blank it.
   const resolved = create(BookSchema, {
     title: winner.title,
     authors: winner.authors,
     isbn13: winner.isbn13,
     description: winner.description,
-    pageCount: winner.pageCount
+    pageCount: winner.pageCount,
+    series: winner.series,
+    seriesPosition: winner.seriesPosition,
+    seriesTotal: winner.seriesTotal
   })
 
   const fields: Array<Exclude<BookConflictField, 'cover' | 'status'>> = [
@@ -1,7 +1,7 @@
 'use client'
 
 import Link from 'next/link'
-import { useLibrary, useBooksProgress } from '@/hooks/useBooks'
+import { useLibrary, useBooksProgress, useReadingStats, useReadingGoals } from '@/hooks/useBooks'
 import { useFeedsSummary } from '@/hooks/useFeeds'
 import type { UserBook } from '@/lib/gen/books/v1/library_pb'
 import BookCover from '@/components/books/BookCover'
@@ -9,6 +9,9 @@ import BookSearchBar from '@/components/books/BookSearchBar'
 import BookProgressBar from '@/components/books/BookProgressBar'
 import ReadingDashboardLayout from '@/components/dashboard/ReadingDashboardLayout'
 import ReadingFeedsSummaryCard from '@/components/dashboard/ReadingFeedsSummaryCard'
+import ReadingStatsCard from '@/components/dashboard/ReadingStatsCard'
+import ReadingGoalsCard from '@/components/dashboard/ReadingGoalsCard'
+import ReadingGoalEditor from '@/components/dashboard/ReadingGoalEditor'
 import DashboardShareButton from '@/components/dashboard/DashboardShareButton'
 import { Button } from '@/components/ui/button'
 import { interactiveCardClass } from '@/components/ui/card'
@@ -52,6 +55,8 @@ export default function ReadingDashboard() {
     chart.view === 'all' ? chart.end : undefined
   )
   const { data: feedsSummary } = useFeedsSummary()
+  const { data: readingStats } = useReadingStats()
+  const { data: readingGoals, mutate: mutateGoals } = useReadingGoals()
 
   const library = libraryData?.library
   const allTimeChartData =
@@ -71,6 +76,22 @@ export default function ReadingDashboard() {
       allTimeChartData={allTimeChartData}
       renderReadingCard={(ub) => <ReadingBookCard userBook={ub} />}
       feedsCard={<ReadingFeedsSummaryCard summary={feedsSummary} href="/feeds" />}
+      statsCard={<ReadingStatsCard stats={readingStats} />}
+      goalsCard={
+        readingGoals && (
+          <ReadingGoalsCard
+            year={readingGoals.year}
+            goals={readingGoals.goals}
+            actions={
+              <ReadingGoalEditor
+                year={readingGoals.year}
+                goals={readingGoals.goals}
+                onChange={mutateGoals}
+              />
+            }
+          />
+        )
+      }
       actions={
         <>
           <div className="mr-auto w-full max-w-md">
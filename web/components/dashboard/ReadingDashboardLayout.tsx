@@ -11,14 +11,18 @@ import type { DashboardChartState } from '@/hooks/useDashboardChartState'
 // the merged books+feeds "reading dashboard" (issue #737) can't drift
 // between the two. feedsCard is a slot rather than a fixed component/prop
 // shape: the private view shows an unread-items digest, the public view
-// shows a plain subscribed-feeds list — genuinely different data.
+// shows a plain subscribed-feeds list — genuinely different data. statsCard
+// is the reading-time summary, only shown to the owner. goalsCard shows the
+// yearly goals: editable for the owner, opted-in goals only on the public view.
 export default function ReadingDashboardLayout({
   library,
   chart,
   allTimeChartData,
   renderReadingCard,
   actions,
-  feedsCard
+  feedsCard,
+  statsCard,
+  goalsCard
 }: {
   library: LibraryResponse
   chart: DashboardChartState<'ytd' | 'all'>
@@ -26,10 +30,14 @@ export default function ReadingDashboardLayout({
   renderReadingCard: (ub: UserBook) => ReactNode
   actions: ReactNode
   feedsCard?: ReactNode
+  statsCard?: ReactNode
+  goalsCard?: ReactNode
 }) {
   return (
     <div className="flex flex-col gap-3 lg:h-full lg:min-h-0">
       {feedsCard}
+      {goalsCard}
+      {statsCard}
       <div className="lg:min-h-0 lg:flex-1">
         <BooksDashboardView
           library={library}
@@ -4,12 +4,14 @@ import Link from 'next/link'
 import {
   useSharedLibrary,
   useSharedBooksProgress,
-  useSharedFeedsSummary
+  useSharedFeedsSummary,
+  useSharedReadingGoals
 } from '@/hooks/useDashboardShare'
 import type { GetSharedLibraryResponse } from '@/lib/gen/dashboard/v1/reading_pb'
 import DashboardBookCard from '@/components/dashboard/DashboardBookCard'
 import ReadingDashboardLayout from '@/components/dashboard/ReadingDashboardLayout'
 import SharedFeedsCard from '@/components/dashboard/SharedFeedsCard'
+import ReadingGoalsCard from '@/components/dashboard/ReadingGoalsCard'
 import { Button } from '@/components/ui/button'
 import { useDashboardChartState } from '@/hooks/useDashboardChartState'
 import { formatDateTime } from '@/lib/dates'
@@ -30,6 +32,7 @@ export default function ReadingDashboardPublicClient({
     chart.end
   )
   const { data: feedsSummaryData } = useSharedFeedsSummary(token)
+  const { data: goalsData } = useSharedReadingGoals(token)
   const library = data?.library
   const allTimeChartData =
     progressData?.progress?.labels?.map((label: string, idx: number) => ({
@@ -52,6 +55,7 @@ export default function ReadingDashboardPublicClient({
         </div>
       )}
       feedsCard={<SharedFeedsCard feeds={feedsSummaryData?.feeds} />}
+      goalsCard={<ReadingGoalsCard goals={goalsData?.goals} />}
       actions={
         <>
           {data?.lastSyncedAt ? (
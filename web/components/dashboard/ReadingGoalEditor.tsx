@@ -0,0 +1,116 @@
+'use client'
+
+import { useState } from 'react'
+import { useSetReadingGoal, useDeleteReadingGoal } from '@/hooks/useBooks'
+import type { ReadingGoalProgress } from '@/lib/gen/books/v1/goals_pb'
+import { Button } from '@/components/ui/button'
+import { Checkbox } from '@/components/ui/checkbox'
+import { Input } from '@/components/ui/input'
+import { Select } from '@/components/ui/select'
+
+// ReadingGoalEditor sets or removes the owner's goals for one year. Saving a
+// kind that already has a goal replaces its target and sharing choice.
+export default function ReadingGoalEditor({
+  year,
+  goals,
+  onChange
+}: {
+  year: number
+  goals: ReadingGoalProgress[]
+  onChange: () => Promise<unknown>
+}) {
+  const setReadingGoal = useSetReadingGoal()
+  const deleteReadingGoal = useDeleteReadingGoal()
+
+  const [kind, setKind] = useState('books')
+  const [target, setTarget] = useState('')
+  const [shared, setShared] = useState(false)
+  const [saving, setSaving] = useState(false)
+  const [error, setError] = useState('')
+
+  const targetValue = parseInt(target, 10)
+
+  async function handleSave(e: React.FormEvent) {
+    e.preventDefault()
+    if (!(targetValue > 0)) return
+    setSaving(true)
+    setError('')
+    try {
+      await setReadingGoal({ year, kind, target: targetValue, public: shared })
+      setTarget('')
+      await onChange()
+    } catch {
+      setError('Failed to save the goal. Please try again.')
+    } finally {
+      setSaving(false)
+    }
+  }
+
+  async function handleRemove(goalKind: string) {
+    await deleteReadingGoal(year, goalKind)
+    await onChange()
+  }
+
+  return (
+    <div className="space-y-2">
+      <form className="flex flex-wrap items-center gap-2" onSubmit={handleSave}>
+        <Input
+          type="number"
+          min={1}
+          value={target}
+          onChange={(e) => setTarget(e.target.value)}
+          placeholder="Target"
+          aria-label="Target"
+          className="w-28"
+          data-testid="reading-goal-target"
+        />
+        <Select
+          aria-label="Goal kind"
+          value={kind}
+          onChange={(e) => setKind(e.target.value)}
+          className="w-auto"
+        >
+          <option value="books">books</option>
+          <option value="pages">pages</option>
+        </Select>
+        <Checkbox
+          id="reading-goal-public"
+          label="Show on shared dashboard"
+          checked={shared}
+          onChange={(e) => setShared(e.target.checked)}
+        />
+        <Button
+          type="submit"
+          size="sm"
+          disabled={saving || !(targetValue > 0)}
+          data-testid="reading-goal-save"
+        >
+          {saving ? 'Saving…' : 'Set goal'}
+        </Button>
+      </form>
+      {goals.length > 0 && (
+        <div className="flex flex-wrap gap-2">
+          {goals.map(
+            (g) =>
+              g.goal && (
+                <Button
+                  key={g.goal.kind}
+                  size="sm"
+                  variant="ghost"
+                  onClick={() => handleRemove(g.goal!.kind)}
+                  data-testid={`reading-goal-remove-${g.goal.kind}`}
+                >
+                  Remove {g.goal.kind} goal
+                </Button>
+              )
+          )}
+        </div>
+      )}
+      {error && (
+        <p className="text-sm text-danger" data-testid="reading-goal-error">
+          {error}
+        </p>
+      )}
+    </div>
+  )
+}
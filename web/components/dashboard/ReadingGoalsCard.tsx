@@ -0,0 +1,80 @@
+import type { ReactNode } from 'react'
+import { Card } from '@/components/ui/card'
+import type { ReadingGoalProgress } from '@/lib/gen/books/v1/goals_pb'
+import { cn } from '@/lib/cn'
+
+const PACE_LABELS: Record<string, string> = {
+  ahead: 'Ahead of pace',
+  'on-track': 'On track',
+  behind: 'Behind pace'
+}
+
+const PACE_CLASSES: Record<string, string> = {
+  ahead: 'text-success',
+  'on-track': 'text-muted',
+  behind: 'text-danger'
+}
+
+function GoalRow({ progress }: { progress: ReadingGoalProgress }) {
+  const goal = progress.goal
+  if (!goal) return null
+  const percent = Math.min(100, Math.round((progress.current / goal.target) * 100))
+  const unit = goal.kind === 'pages' ? 'pages' : 'books'
+
+  return (
+    <li className="space-y-1" data-testid={`reading-goal-${goal.kind}`}>
+      <div className="flex flex-wrap items-baseline gap-x-3">
+        <span className="font-medium">
+          {progress.current} / {goal.target} {unit}
+        </span>
+        <span className={PACE_CLASSES[progress.pace]}>{PACE_LABELS[progress.pace]}</span>
+        <span className="text-muted">on pace for {Math.round(progress.projected)}</span>
+      </div>
+      <div
+        className="h-2 w-full overflow-hidden rounded-full bg-surface"
+        role="progressbar"
+        aria-valuenow={percent}
+        aria-valuemin={0}
+        aria-valuemax={100}
+      >
+        <div
+          className={cn(
+            'h-full rounded-full transition-[width] duration-300',
+            progress.pace === 'behind' ? 'bg-danger' : 'bg-accent'
+          )}
+          style={{ width: `${percent}%` }}
+        />
+      </div>
+    </li>
+  )
+}
+
+// ReadingGoalsCard shows how this year's reading compares to the owner's
+// books/pages targets. The public dashboard passes only the goals the
+// owner shared; the private one adds an editor through the actions slot,
+// so the card stays visible there even before any goal is set.
+export default function ReadingGoalsCard({
+  goals,
+  year,
+  actions
+}: {
+  goals?: ReadingGoalProgress[]
+  year?: number
+  actions?: ReactNode
+}) {
+  if (!actions && (!goals || goals.length === 0)) return null
+
+  return (
+    <Card className="space-y-2 p-3 text-sm" data-testid="reading-goals-card">
+      <span className="font-semibold">{year ? `${year} reading goals` : 'Reading goals'}</span>
+      {goals && goals.length > 0 && (
+        <ul className="space-y-2">
+          {goals.map((g) => (
+            <GoalRow key={g.goal?.kind} progress={g} />
+          ))}
+        </ul>
+      )}
+      {actions}
+    </Card>
+  )
+}
@@ -0,0 +1,50 @@
+import { Card } from '@/components/ui/card'
+import type { GetReadingStatsResponse } from '@/lib/gen/books/v1/library_pb'
+import { formatDate } from '@/lib/dates'
+
+/** Formats a duration in seconds as "3h 20m", or "45m" under an hour. */
+export function formatReadingTime(seconds: number): string {
+  const minutes = Math.round(seconds / 60)
+  const hours = Math.floor(minutes / 60)
+  if (hours === 0) return `${minutes}m`
+  const rest = minutes % 60
+  return rest === 0 ? `${hours}h` : `${hours}h ${rest}m`
+}
+
+// ReadingStatsCard summarises the reading sessions recorded from Kobo,
+// KOReader and the web reader: total time read in the range, and for books
+// still in progress how fast they're going and when they should be done.
+export default function ReadingStatsCard({ stats }: { stats?: GetReadingStatsResponse }) {
+  if (!stats || stats.books.length === 0) return null
+
+  const totalSeconds = stats.days.reduce((sum, d) => sum + d.seconds, 0)
+  const daysRead = new Set(stats.days.map((d) => d.date)).size
+  const inProgress = stats.books.filter((b) => b.remainingSeconds > 0)
+
+  return (
+    <Card className="space-y-2 p-3 text-sm" data-testid="reading-stats-card">
+      <div className="flex flex-wrap items-center gap-3">
+        <span className="font-semibold">Reading time</span>
+        <span className="text-muted">
+          {formatReadingTime(totalSeconds)} over {daysRead} {daysRead === 1 ? 'day' : 'days'}
+        </span>
+      </div>
+      {inProgress.length > 0 && (
+        <ul className="space-y-1">
+          {inProgress.map((b) => (
+            <li
+              key={b.bookId}
+              className="flex flex-wrap gap-x-3 text-muted"
+              data-testid={`reading-stats-book-${b.bookId}`}
+            >
+              <span className="max-w-xs truncate font-medium">{b.title}</span>
+              <span>{b.percentPerHour.toFixed(1)}%/h</span>
+              <span>{formatReadingTime(b.remainingSeconds)} left</span>
+              {b.estimatedFinish && <span>done by {formatDate(b.estimatedFinish)}</span>}
+            </li>
+          ))}
+        </ul>
+      )}
+    </Card>
+  )
+}
@@ -9,6 +9,7 @@ import {
   DialogClose
 } from '@/components/ui/dialog'
 import FeedBookmarkButton from '@/components/feeds/FeedBookmarkButton'
+import FeedSendToLibraryButton from '@/components/feeds/FeedSendToLibraryButton'
 import FeedItemMarkReadButton, {
   type FeedItemMarkReadHandle
 } from '@/components/feeds/FeedItemMarkReadButton'
@@ -101,16 +102,25 @@ export default function ArticleReaderDialog({
         <DialogHeader className="items-start gap-3">
           <div className="min-w-0 flex-1">
             <DialogTitle className="leading-tight">{item.title}</DialogTitle>
-            {item.sourceUrl && (
-              <a
-                href={item.sourceUrl}
-                target="_blank"
-                rel="noopener noreferrer"
-                className="mt-1 inline-block py-1 text-xs text-accent underline-offset-4 hover:underline"
-              >
-                View original ↗
-              </a>
-            )}
+            <div className="mt-1 flex flex-wrap items-center gap-3">
+              {item.sourceUrl && (
+                <a
+                  href={item.sourceUrl}
+                  target="_blank"
+                  rel="noopener noreferrer"
+                  className="inline-block py-1 text-xs text-accent underline-offset-4 hover:underline"
+                >
+                  View original ↗
+                </a>
+              )}
+              {item.hasContent && (
+                <FeedSendToLibraryButton
+                  key={item.id}
+                  itemId={item.id}
+                  libraryBookId={item.libraryBookId}
+                />
+              )}
+            </div>
           </div>
           <div className="flex shrink-0 items-center gap-2">
             <FeedBookmarkButton itemId={item.id} bookmarked={item.bookmarked} />
@@ -2,13 +2,20 @@
 
 import { useState } from 'react'
 import { Button } from '@/components/ui/button'
+import { Select } from '@/components/ui/select'
 import AddFeedForm from '@/components/feeds/AddFeedForm'
-import { useFeeds, useDeleteFeed, useRefreshFeed } from '@/hooks/useFeeds'
+import FilterRulesManager from '@/components/feeds/FilterRulesManager'
+import FolderManager from '@/components/feeds/FolderManager'
+import OPMLTransfer from '@/components/feeds/OPMLTransfer'
+import ReaderAppPasswords from '@/components/feeds/ReaderAppPasswords'
+import { useFeeds, useDeleteFeed, useMoveFeed, useRefreshFeed } from '@/hooks/useFeeds'
+import { orderFolders, type FolderEntry } from '@/lib/feeds/folders'
 import type { Feed } from '@/lib/gen/feeds/v1/feeds_pb'
 
-function FeedRow({ feed }: { feed: Feed }) {
+function FeedRow({ feed, folders }: { feed: Feed; folders: FolderEntry[] }) {
   const deleteFeed = useDeleteFeed()
   const refreshFeed = useRefreshFeed()
+  const moveFeed = useMoveFeed()
   const [busy, setBusy] = useState(false)
   const [status, setStatus] = useState('')
 
@@ -35,7 +42,24 @@ function FeedRow({ feed }: { feed: Feed }) {
             {feed.title || feed.url || 'Email newsletter'}
           </p>
           {!isEmailFeed && <p className="truncate text-xs text-muted">{feed.url}</p>}
+          {feed.unreadCount > 0 && <p className="text-xs text-muted">{feed.unreadCount} unread</p>}
         </div>
+        {folders.length > 0 && (
+          <Select
+            value={feed.folderId}
+            onChange={(e) => void run(() => moveFeed(feed.id, e.target.value))}
+            disabled={busy}
+            aria-label="Folder"
+            className="h-9 w-auto"
+          >
+            <option value="">No folder</option>
+            {folders.map(({ folder, depth }) => (
+              <option key={folder.id} value={folder.id}>
+                {(depth > 0 ? '\u00a0\u00a0' : '') + folder.name}
+              </option>
+            ))}
+          </Select>
+        )}
         {!isEmailFeed && (
           <Button
             size="sm"
@@ -84,15 +108,23 @@ function FeedRow({ feed }: { feed: Feed }) {
   )
 }
 
-// FeedManager lists the user's RSS/Atom and email-newsletter subscriptions.
+// FeedManager lists the user's RSS/Atom and email-newsletter subscriptions,
+// the folders they are filed under, the rules filtering their items and the
+// app passwords reader apps sync with.
 export default function FeedManager() {
   const { data, error, isLoading } = useFeeds()
 
   const feeds = data?.feeds ?? []
+  const folders = data?.folders ?? []
+  const orderedFolders = orderFolders(folders)
 
   return (
     <div>
       <AddFeedForm />
+      <OPMLTransfer />
+      <FolderManager folders={folders} />
+      <FilterRulesManager feeds={feeds} />
+      <ReaderAppPasswords />
 
       {isLoading && <p className="mt-3 text-muted">Loading…</p>}
       {error && <p className="mt-3 text-danger">Failed to load feeds.</p>}
@@ -102,7 +134,7 @@ export default function FeedManager() {
       {feeds.length > 0 && (
         <ul className="mt-3 space-y-2">
           {feeds.map((feed) => (
-            <FeedRow key={feed.id} feed={feed} />
+            <FeedRow key={feed.id} feed={feed} folders={orderedFolders} />
           ))}
         </ul>
       )}
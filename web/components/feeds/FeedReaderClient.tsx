@@ -1,7 +1,8 @@
 'use client'
 
 import { useCallback, useMemo, useState } from 'react'
-import { useFeeds, useFeedItems, useFetchFeedItemsPage } from '@/hooks/useFeeds'
+import { useRouter, useSearchParams } from 'next/navigation'
+import { useFeedItem, useFeeds, useFeedItems, useFetchFeedItemsPage } from '@/hooks/useFeeds'
 import { usePaginatedList } from '@/hooks/usePaginatedList'
 import ArticleReaderDialog from '@/components/feeds/ArticleReaderDialog'
 import FeedBookmarkButton from '@/components/feeds/FeedBookmarkButton'
@@ -11,10 +12,14 @@ import { LoadMoreButton } from '@/components/ui/LoadMoreButton'
 import { Select } from '@/components/ui/select'
 import { cn } from '@/lib/cn'
 import { formatDate } from '@/lib/dates'
+import { orderFolders, withUnread } from '@/lib/feeds/folders'
 import type { Item } from '@/lib/gen/feeds/v1/feeds_pb'
 
 const LAST_VISIT_KEY = 'feeds:lastVisit'
 
+// The view selector holds a feed ID as is and a folder ID behind this prefix.
+const FOLDER_PREFIX = 'folder:'
+
 // Items ingested after the visitor's previous visit are "new"; older unread
 // items have just been sitting there already seen. Tracked client-side via
 // localStorage since the backend has no per-visit read receipt.
@@ -31,15 +36,25 @@ export default function FeedReaderClient() {
   // Bookmarks are a keep-list, not an inbox: a bookmarked item stays relevant
   // after it's read, so the bookmarked view ignores the unread filter.
   const unreadOnly = !showRead && !bookmarkedOnly
-  const [selectedFeedId, setSelectedFeedId] = useState<string | undefined>(undefined)
+  const [selection, setSelection] = useState('')
+  const selectedFolderId = selection.startsWith(FOLDER_PREFIX)
+    ? selection.slice(FOLDER_PREFIX.length)
+    : undefined
+  const selectedFeedId = selection && !selectedFolderId ? selection : undefined
+  const linkedItemId = useSearchParams().get('item')
 
   const { data: feedsData } = useFeeds()
   const {
     data: itemsData,
     error,
     isLoading
-  } = useFeedItems(unreadOnly, selectedFeedId, bookmarkedOnly)
-  const fetchPage = useFetchFeedItemsPage(unreadOnly, selectedFeedId, bookmarkedOnly)
+  } = useFeedItems(unreadOnly, selectedFeedId, bookmarkedOnly, selectedFolderId)
+  const fetchPage = useFetchFeedItemsPage(
+    unreadOnly,
+    selectedFeedId,
+    bookmarkedOnly,
+    selectedFolderId
+  )
   const initialPage = useMemo(
     () => ({ items: itemsData?.items ?? [], hasMore: itemsData?.hasMore ?? false }),
     [itemsData]
@@ -65,6 +80,8 @@ export default function FeedReaderClient() {
 
   const [lastVisit] = useState(readAndBumpLastVisit)
 
+  const folders = useMemo(() => orderFolders(feedsData?.folders ?? []), [feedsData])
+
   const feedTitleById = useMemo(() => {
     const map = new Map<string, string>()
     for (const feed of feedsData?.feeds ?? []) {
@@ -92,17 +109,25 @@ export default function FeedReaderClient() {
   return (
     <div>
       <div className="mb-4 flex justify-end gap-2">
-        <Select
-          value={selectedFeedId ?? ''}
-          onChange={(e) => setSelectedFeedId(e.target.value || undefined)}
-          className="w-auto"
-        >
+        <Select value={selection} onChange={(e) => setSelection(e.target.value)} className="w-auto">
           <option value="">All feeds</option>
-          {(feedsData?.feeds ?? []).map((feed) => (
-            <option key={feed.id} value={feed.id}>
-              {feed.title || feed.url}
-            </option>
-          ))}
+          {folders.length > 0 && (
+            <optgroup label="Folders">
+              {folders.map(({ folder, depth }) => (
+                <option key={folder.id} value={FOLDER_PREFIX + folder.id}>
+                  {(depth > 0 ? '\u00a0\u00a0' : '') +
+                    withUnread(folder.name, folder.unreadCount)}
+                </option>
+              ))}
+            </optgroup>
+          )}
+          <optgroup label="Feeds">
+            {(feedsData?.feeds ?? []).map((feed) => (
+              <option key={feed.id} value={feed.id}>
+                {withUnread(feed.title || feed.url, feed.unreadCount)}
+              </option>
+            ))}
+          </optgroup>
         </Select>
         {!bookmarkedOnly && (
           <Button variant="secondary" size="sm" onClick={() => setShowRead((v) => !v)}>
@@ -144,10 +169,39 @@ export default function FeedReaderClient() {
           {hasMore && <LoadMoreButton onClick={loadMore} loading={loadingMore} />}
         </>
       )}
+
+      {linkedItemId && <LinkedItemReader itemId={linkedItemId} />}
     </div>
   )
 }
 
+const noop = () => {}
+
+// LinkedItemReader opens the item a books-library entry links back to
+// (/feeds?item=<id>). It may be on no loaded page — already read, or just
+// old — so it is fetched on its own; closing the reader drops the link.
+function LinkedItemReader({ itemId }: { itemId: string }) {
+  const router = useRouter()
+  const { data } = useFeedItem(itemId)
+  const handleOpenChange = useCallback(
+    (next: boolean) => {
+      if (!next) router.replace('/feeds')
+    },
+    [router]
+  )
+
+  if (!data?.item) return null
+  return (
+    <ArticleReaderDialog
+      item={data.item}
+      open
+      onOpenChange={handleOpenChange}
+      onMarkRead={noop}
+      onSettled={noop}
+    />
+  )
+}
+
 interface FeedReaderCardProps {
   item: Item
   feedTitle?: string
@@ -215,9 +269,22 @@ function FeedReaderCard({
               <FeedBookmarkButton itemId={item.id} bookmarked={item.bookmarked} />
             </div>
           </div>
-          {feedTitle && <p className="text-xs text-muted">{feedTitle}</p>}
+          {(feedTitle || item.author) && (
+            <p className="text-xs text-muted">
+              {[feedTitle, item.author].filter(Boolean).join(' · ')}
+            </p>
+          )}
           <p className="text-xs text-muted">{formatDate(item.publishedAt)}</p>
           {noContent && <p className="text-xs text-subtle">No in-app content</p>}
+          {item.tags.length > 0 && (
+            <div className="mt-1 flex flex-wrap gap-1">
+              {item.tags.map((tag) => (
+                <Badge key={tag} variant="secondary">
+                  {tag}
+                </Badge>
+              ))}
+            </div>
+          )}
         </div>
       </div>
 
@@ -0,0 +1,59 @@
+'use client'
+
+import { useState } from 'react'
+import Link from 'next/link'
+import { useSendItemToLibrary } from '@/hooks/useFeeds'
+
+interface FeedSendToLibraryButtonProps {
+  itemId: string
+  /** The books-library entry the item was already sent to, if any. */
+  libraryBookId: string
+}
+
+// FeedSendToLibraryButton adds an item to the books library from the body
+// feeds already stored, for long reads better suited to an e-reader. Once
+// sent it links to the entry instead; reading progress flows both ways.
+export default function FeedSendToLibraryButton({
+  itemId,
+  libraryBookId
+}: FeedSendToLibraryButtonProps) {
+  const [bookId, setBookId] = useState(libraryBookId)
+  const [sending, setSending] = useState(false)
+  const [failed, setFailed] = useState(false)
+  const sendItemToLibrary = useSendItemToLibrary()
+
+  if (bookId) {
+    return (
+      <Link
+        href={`/books/${bookId}`}
+        className="inline-block py-1 text-xs text-accent underline-offset-4 hover:underline"
+      >
+        In library
+      </Link>
+    )
+  }
+
+  const handleClick = async () => {
+    setSending(true)
+    setFailed(false)
+    try {
+      const resp = await sendItemToLibrary(itemId)
+      setBookId(resp.item?.libraryBookId ?? '')
+    } catch {
+      setFailed(true)
+    } finally {
+      setSending(false)
+    }
+  }
+
+  return (
+    <button
+      type="button"
+      onClick={handleClick}
+      disabled={sending}
+      className="inline-block py-1 text-xs text-accent underline-offset-4 hover:underline disabled:opacity-60"
+    >
+      {sending ? 'Sending…' : failed ? 'Send failed — retry' : 'Send to library'}
+    </button>
+  )
+}
@@ -0,0 +1,292 @@
+'use client'
+
+import { useState } from 'react'
+import { ConnectError, Code } from '@connectrpc/connect'
+import { Button } from '@/components/ui/button'
+import { Checkbox } from '@/components/ui/checkbox'
+import { Input } from '@/components/ui/input'
+import { Select } from '@/components/ui/select'
+import { cn } from '@/lib/cn'
+import {
+  useCreateFilterRule,
+  useDeleteFilterRule,
+  useFilterRules,
+  useTestFilterRule,
+  useUpdateFilterRule,
+  type FilterRuleInput
+} from '@/hooks/useFeeds'
+import type { Feed, FilterRule, Item } from '@/lib/gen/feeds/v1/feeds_pb'
+
+const FIELD_LABELS: Record<string, string> = {
+  title: 'Title',
+  author: 'Author',
+  url: 'URL',
+  content: 'Content'
+}
+
+const ACTION_LABELS: Record<string, string> = {
+  dismiss: 'Dismiss',
+  bookmark: 'Bookmark',
+  mark_read: 'Mark read',
+  tag: 'Tag'
+}
+
+const EMPTY_RULE: FilterRuleInput = {
+  feedId: '',
+  field: 'title',
+  matchType: 'keyword',
+  pattern: '',
+  action: 'dismiss',
+  tag: '',
+  enabled: true
+}
+
+function ruleErrorMessage(err: unknown): string {
+  if (err instanceof ConnectError && err.code === Code.InvalidArgument) {
+    return err.rawMessage
+  }
+  return 'Action failed.'
+}
+
+function feedName(feeds: Feed[], feedId: string): string {
+  if (!feedId) return 'All feeds'
+  const feed = feeds.find((f) => f.id === feedId)
+  return feed ? feed.title || feed.url || 'Email newsletter' : 'Unknown feed'
+}
+
+// describeRule renders a rule as one line, e.g.
+// `All feeds: title contains "sponsored" → Dismiss`.
+export function describeRule(rule: FilterRuleInput, feeds: Feed[]): string {
+  const field = (FIELD_LABELS[rule.field] ?? rule.field).toLowerCase()
+  const match =
+    rule.matchType === 'regex' ? `matches /${rule.pattern}/` : `contains "${rule.pattern}"`
+  const action =
+    rule.action === 'tag' ? `Tag "${rule.tag}"` : (ACTION_LABELS[rule.action] ?? rule.action)
+  return `${feedName(feeds, rule.feedId)}: ${field} ${match} → ${action}`
+}
+
+function toInput(rule: FilterRule): FilterRuleInput {
+  return {
+    feedId: rule.feedId,
+    field: rule.field,
+    matchType: rule.matchType,
+    pattern: rule.pattern,
+    action: rule.action,
+    tag: rule.tag,
+    enabled: rule.enabled
+  }
+}
+
+function RuleRow({ rule, feeds }: { rule: FilterRule; feeds: Feed[] }) {
+  const updateRule = useUpdateFilterRule()
+  const deleteRule = useDeleteFilterRule()
+  const [busy, setBusy] = useState(false)
+  const [status, setStatus] = useState('')
+
+  const run = async (action: () => Promise<unknown>) => {
+    setBusy(true)
+    setStatus('')
+    try {
+      await action()
+    } catch (err) {
+      setStatus(ruleErrorMessage(err))
+    } finally {
+      setBusy(false)
+    }
+  }
+
+  return (
+    <li data-testid={`rule-${rule.id}`}>
+      <div className="flex flex-wrap items-center gap-2">
+        <Checkbox
+          id={`rule-enabled-${rule.id}`}
+          checked={rule.enabled}
+          disabled={busy}
+          aria-label="Enabled"
+          onChange={(e) =>
+            void run(() => updateRule(rule.id, { ...toInput(rule), enabled: e.target.checked }))
+          }
+        />
+        <span
+          className={cn(
+            'min-w-0 flex-1 break-words text-sm',
+            !rule.enabled && 'text-muted line-through'
+          )}
+        >
+          {describeRule(rule, feeds)}
+        </span>
+        <Button
+          size="sm"
+          variant="destructive"
+          disabled={busy}
+          onClick={() => void run(() => deleteRule(rule.id))}
+        >
+          Delete
+        </Button>
+      </div>
+      {status && <p className="mt-1 text-xs text-danger">{status}</p>}
+    </li>
+  )
+}
+
+// FilterRulesManager edits the rules applied to items as they arrive: each
+// matches a field of new items by keyword or regex and dismisses,
+// bookmarks, marks read or tags them. Preview runs the draft rule against
+// recent items before it is saved; saved rules never touch items already
+// ingested.
+export default function FilterRulesManager({ feeds }: { feeds: Feed[] }) {
+  const { data, error } = useFilterRules()
+  const createRule = useCreateFilterRule()
+  const testRule = useTestFilterRule()
+
+  const [draft, setDraft] = useState<FilterRuleInput>(EMPTY_RULE)
+  const [busy, setBusy] = useState(false)
+  const [status, setStatus] = useState('')
+  const [preview, setPreview] = useState<{ matches: Item[]; checked: number } | null>(null)
+
+  const set = (patch: Partial<FilterRuleInput>) => {
+    setDraft((d) => ({ ...d, ...patch }))
+    setPreview(null)
+  }
+
+  const ready = draft.pattern.trim() !== '' && (draft.action !== 'tag' || draft.tag.trim() !== '')
+
+  const run = async (action: () => Promise<void>) => {
+    if (!ready || busy) return
+    setBusy(true)
+    setStatus('')
+    try {
+      await action()
+    } catch (err) {
+      setStatus(ruleErrorMessage(err))
+    } finally {
+      setBusy(false)
+    }
+  }
+
+  const handlePreview = () =>
+    run(async () => {
+      const resp = await testRule(draft)
+      setPreview({ matches: resp.matches, checked: resp.checkedCount })
+    })
+
+  const handleSave = () =>
+    run(async () => {
+      await createRule(draft)
+      setDraft(EMPTY_RULE)
+      setPreview(null)
+    })
+
+  const rules = data?.rules ?? []
+
+  return (
+    <div className="mt-3 space-y-2">
+      <form
+        className="flex flex-wrap items-center gap-2"
+        onSubmit={(e) => {
+          e.preventDefault()
+          void handleSave()
+        }}
+      >
+        <Select
+          value={draft.feedId}
+          onChange={(e) => set({ feedId: e.target.value })}
+          aria-label="Rule feed"
+          className="w-auto"
+        >
+          <option value="">All feeds</option>
+          {feeds.map((f) => (
+            <option key={f.id} value={f.id}>
+              {f.title || f.url || 'Email newsletter'}
+            </option>
+          ))}
+        </Select>
+        <Select
+          value={draft.field}
+          onChange={(e) => set({ field: e.target.value })}
+          aria-label="Rule field"
+          className="w-auto"
+        >
+          {Object.entries(FIELD_LABELS).map(([value, label]) => (
+            <option key={value} value={value}>
+              {label}
+            </option>
+          ))}
+        </Select>
+        <Select
+          value={draft.matchType}
+          onChange={(e) => set({ matchType: e.target.value })}
+          aria-label="Rule match"
+          className="w-auto"
+        >
+          <option value="keyword">contains</option>
+          <option value="regex">matches regex</option>
+        </Select>
+        <Input
+          placeholder={draft.matchType === 'regex' ? 'Regular expression' : 'Keyword'}
+          value={draft.pattern}
+          onChange={(e) => set({ pattern: e.target.value })}
+          aria-label="Rule pattern"
+          className="w-auto min-w-0 flex-1"
+        />
+        <Select
+          value={draft.action}
+          onChange={(e) => set({ action: e.target.value })}
+          aria-label="Rule action"
+          className="w-auto"
+        >
+          {Object.entries(ACTION_LABELS).map(([value, label]) => (
+            <option key={value} value={value}>
+              {label}
+            </option>
+          ))}
+        </Select>
+        {draft.action === 'tag' && (
+          <Input
+            placeholder="Tag"
+            value={draft.tag}
+            onChange={(e) => set({ tag: e.target.value })}
+            aria-label="Rule tag"
+            className="w-32"
+          />
+        )}
+        <Button
+          type="button"
+          variant="secondary"
+          disabled={busy || !ready}
+          onClick={() => void handlePreview()}
+        >
+          Preview
+        </Button>
+        <Button type="submit" disabled={busy || !ready}>
+          Save rule
+        </Button>
+      </form>
+      {status && <p className="text-xs text-danger">{status}</p>}
+      {preview && (
+        <div className="text-xs" data-testid="rule-preview">
+          <p className="text-muted">
+            Matches {preview.matches.length} of the last {preview.checked} item(s).
+          </p>
+          {preview.matches.length > 0 && (
+            <ul className="mt-1 space-y-0.5">
+              {preview.matches.map((item) => (
+                <li key={item.id} className="truncate">
+                  {item.title}
+                </li>
+              ))}
+            </ul>
+          )}
+        </div>
+      )}
+      {error && <p className="text-xs text-danger">Failed to load filter rules.</p>}
+      {rules.length > 0 && (
+        <ul className="space-y-1">
+          {rules.map((rule) => (
+            <RuleRow key={rule.id} rule={rule} feeds={feeds} />
+          ))}
+        </ul>
+      )}
+    </div>
+  )
+}
@@ -0,0 +1,215 @@
+'use client'
+
+import { useState } from 'react'
+import { ConnectError, Code } from '@connectrpc/connect'
+import { Button } from '@/components/ui/button'
+import { Input } from '@/components/ui/input'
+import { Select } from '@/components/ui/select'
+import {
+  useCreateFolder,
+  useDeleteFolder,
+  useRenameFolder,
+  useReorderFolders
+} from '@/hooks/useFeeds'
+import { orderFolders, withUnread } from '@/lib/feeds/folders'
+import type { Folder } from '@/lib/gen/feeds/v1/feeds_pb'
+
+function folderErrorMessage(err: unknown): string {
+  if (err instanceof ConnectError && err.code === Code.AlreadyExists) {
+    return 'A folder with that name already exists there.'
+  }
+  return 'Action failed.'
+}
+
+interface FolderRowProps {
+  folder: Folder
+  depth: number
+  // The folder's siblings in order, itself included; moving it sends the
+  // reordered list back.
+  siblings: Folder[]
+}
+
+function FolderRow({ folder, depth, siblings }: FolderRowProps) {
+  const renameFolder = useRenameFolder()
+  const deleteFolder = useDeleteFolder()
+  const reorderFolders = useReorderFolders()
+  const [busy, setBusy] = useState(false)
+  const [status, setStatus] = useState('')
+  const [editing, setEditing] = useState(false)
+  const [name, setName] = useState(folder.name)
+
+  const ids = siblings.map((f) => f.id)
+  const index = ids.indexOf(folder.id)
+
+  const run = async (action: () => Promise<void>) => {
+    setBusy(true)
+    setStatus('')
+    try {
+      await action()
+    } catch (err) {
+      setStatus(folderErrorMessage(err))
+    } finally {
+      setBusy(false)
+    }
+  }
+
+  const move = (by: number) => {
+    const next = [...ids]
+    next.splice(index, 1)
+    next.splice(index + by, 0, folder.id)
+    return run(() => reorderFolders(next))
+  }
+
+  return (
+    <li className={depth > 0 ? 'ml-6' : undefined} data-testid={`folder-${folder.id}`}>
+      <div className="flex flex-wrap items-center gap-2">
+        {editing ? (
+          <form
+            className="flex min-w-0 flex-1 items-center gap-2"
+            onSubmit={(e) => {
+              e.preventDefault()
+              if (!name.trim()) return
+              void run(async () => {
+                await renameFolder(folder.id, name.trim())
+                setEditing(false)
+              })
+            }}
+          >
+            <Input
+              value={name}
+              onChange={(e) => setName(e.target.value)}
+              aria-label="Folder name"
+              className="w-auto min-w-0 flex-1"
+            />
+            <Button type="submit" size="sm" disabled={busy || !name.trim()}>
+              Save
+            </Button>
+          </form>
+        ) : (
+          <span className="min-w-0 flex-1 truncate text-sm font-medium">
+            {withUnread(folder.name, folder.unreadCount)}
+          </span>
+        )}
+        {!editing && (
+          <Button
+            size="sm"
+            variant="secondary"
+            disabled={busy}
+            onClick={() => {
+              setName(folder.name)
+              setEditing(true)
+            }}
+          >
+            Rename
+          </Button>
+        )}
+        <Button
+          size="sm"
+          variant="secondary"
+          disabled={busy || index === 0}
+          onClick={() => void move(-1)}
+        >
+          Move up
+        </Button>
+        <Button
+          size="sm"
+          variant="secondary"
+          disabled={busy || index === ids.length - 1}
+          onClick={() => void move(1)}
+        >
+          Move down
+        </Button>
+        <Button
+          size="sm"
+          variant="destructive"
+          disabled={busy}
+          onClick={() => void run(() => deleteFolder(folder.id))}
+        >
+          Delete
+        </Button>
+      </div>
+      {status && <p className="mt-1 text-xs text-danger">{status}</p>}
+    </li>
+  )
+}
+
+// FolderManager creates, renames, reorders and deletes the folders feeds are
+// filed under. Folders nest one level, so only top-level folders are offered
+// as parents; deleting a folder leaves its feeds unfiled.
+export default function FolderManager({ folders }: { folders: Folder[] }) {
+  const createFolder = useCreateFolder()
+  const [name, setName] = useState('')
+  const [parentId, setParentId] = useState('')
+  const [busy, setBusy] = useState(false)
+  const [status, setStatus] = useState('')
+
+  const ordered = orderFolders(folders)
+  const topLevel = ordered.filter((e) => e.depth === 0).map((e) => e.folder)
+
+  const submit = async () => {
+    if (!name.trim() || busy) return
+    setBusy(true)
+    setStatus('')
+    try {
+      await createFolder(name.trim(), parentId)
+      setName('')
+    } catch (err) {
+      setStatus(folderErrorMessage(err))
+    } finally {
+      setBusy(false)
+    }
+  }
+
+  return (
+    <div className="mt-3 space-y-2">
+      <form
+        className="flex flex-wrap items-center gap-2"
+        onSubmit={(e) => {
+          e.preventDefault()
+          void submit()
+        }}
+      >
+        <Input
+          placeholder="New folder"
+          value={name}
+          onChange={(e) => setName(e.target.value)}
+          aria-label="New folder name"
+          className="w-auto min-w-0 flex-1"
+        />
+        {topLevel.length > 0 && (
+          <Select
+            value={parentId}
+            onChange={(e) => setParentId(e.target.value)}
+            aria-label="Parent folder"
+            className="w-auto"
+          >
+            <option value="">Top level</option>
+            {topLevel.map((f) => (
+              <option key={f.id} value={f.id}>
+                In {f.name}
+              </option>
+            ))}
+          </Select>
+        )}
+        <Button type="submit" variant="secondary" disabled={busy || !name.trim()}>
+          Add folder
+        </Button>
+      </form>
+      {status && <p className="text-xs text-danger">{status}</p>}
+      {ordered.length > 0 && (
+        <ul className="space-y-1">
+          {ordered.map(({ folder, depth }) => (
+            <FolderRow
+              key={folder.id}
+              folder={folder}
+              depth={depth}
+              siblings={ordered
+                .filter((e) => e.folder.parentId === folder.parentId)
+                .map((e) => e.folder)}
+            />
+          ))}
+        </ul>
+      )}
+    </div>
+  )
+}
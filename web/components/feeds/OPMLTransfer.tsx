@@ -0,0 +1,98 @@
+'use client'
+
+import { useState } from 'react'
+import { Button } from '@/components/ui/button'
+import { useExportOPML, useImportOPML } from '@/hooks/useFeeds'
+import { saveFile } from '@/lib/books/download'
+import type { OPMLImportResult } from '@/lib/gen/feeds/v1/feeds_pb'
+
+function readFile(file: File): Promise<Uint8Array> {
+  return new Promise((resolve, reject) => {
+    const reader = new FileReader()
+    reader.onload = () => {
+      if (reader.result instanceof ArrayBuffer) resolve(new Uint8Array(reader.result))
+      else reject(new Error('unreadable file'))
+    }
+    reader.onerror = () => reject(reader.error)
+    reader.readAsArrayBuffer(file)
+  })
+}
+
+// OPMLTransfer moves subscriptions in from another reader's OPML export and
+// back out as an OPML backup.
+export default function OPMLTransfer() {
+  const importOPML = useImportOPML()
+  const exportOPML = useExportOPML()
+
+  const [busy, setBusy] = useState(false)
+  const [status, setStatus] = useState('')
+  const [failed, setFailed] = useState<OPMLImportResult[]>([])
+
+  async function handleFile(e: React.ChangeEvent<HTMLInputElement>) {
+    const file = e.target.files?.[0]
+    e.target.value = ''
+    if (!file) return
+    setBusy(true)
+    setFailed([])
+    setStatus('Importing… each feed is checked, which can take a while.')
+    try {
+      const res = await importOPML(await readFile(file))
+      // Feeds past the server's per-import cap come back as deferred; the
+      // ones added this time count as duplicates on the next import.
+      const deferred = res.results.filter((r) => r.status === 'deferred').length
+      setStatus(
+        `Added ${res.createdCount} feed(s); ${res.duplicateCount} already subscribed, ` +
+          `${res.failedCount} failed.` +
+          (deferred > 0 ? ` ${deferred} more left: import the file again to add them.` : '')
+      )
+      setFailed(res.results.filter((r) => r.status === 'invalid' || r.status === 'unreachable'))
+    } catch {
+      setStatus('Could not import this file. Is it an OPML export?')
+    } finally {
+      setBusy(false)
+    }
+  }
+
+  async function handleExport() {
+    setStatus('')
+    try {
+      const res = await exportOPML()
+      saveFile(res.data, res.filename, res.contentType)
+    } catch {
+      setStatus('Export failed.')
+    }
+  }
+
+  return (
+    <div className="mt-3 space-y-2">
+      <div className="flex flex-wrap items-center gap-2">
+        <label className="inline-flex h-9 cursor-pointer items-center rounded-xl border border-border bg-surface px-3 text-sm text-fg transition-colors hover:bg-hover active:bg-hover">
+          Import OPML
+          <input
+            type="file"
+            accept=".opml,.xml,text/x-opml,text/xml,application/xml"
+            onChange={handleFile}
+            disabled={busy}
+            className="hidden"
+            data-testid="opml-file"
+          />
+        </label>
+        <Button variant="secondary" size="sm" disabled={busy} onClick={handleExport}>
+          Export OPML
+        </Button>
+        {status && <span className="text-sm text-muted">{status}</span>}
+      </div>
+      {failed.length > 0 && (
+        <ul className="space-y-1 text-xs" data-testid="opml-failed">
+          {failed.map((r) => (
+            <li key={r.url} className="text-danger">
+              <span className="font-medium">{r.title || r.url}</span>
+              {r.status === 'unreachable' ? ' could not be reached' : ' is not a feed'}
+              {r.error && <span className="text-muted"> — {r.error}</span>}
+            </li>
+          ))}
+        </ul>
+      )}
+    </div>
+  )
+}
@@ -0,0 +1,157 @@
+'use client'
+
+import { useState } from 'react'
+import { ConnectError, Code } from '@connectrpc/connect'
+import { Button } from '@/components/ui/button'
+import { Input } from '@/components/ui/input'
+import { useAppPasswords, useCreateAppPassword, useDeleteAppPassword } from '@/hooks/useFeeds'
+import { formatDate } from '@/lib/dates'
+import { getApiUrl } from '@/lib/env'
+import type { AppPassword } from '@/lib/gen/feeds/v1/feeds_pb'
+
+// The Google Reader API server reader apps should log in to.
+export function readerServerUrl(): string {
+  return `${getApiUrl()}/feeds/greader`
+}
+
+function formatLastUsed(lastUsedAt: string): string {
+  if (!lastUsedAt) return 'Never used'
+  return `Last used ${formatDate(lastUsedAt)}`
+}
+
+interface Credentials {
+  username: string
+  password: string
+}
+
+function AppPasswordRow({ appPassword }: { appPassword: AppPassword }) {
+  const deleteAppPassword = useDeleteAppPassword()
+  const [busy, setBusy] = useState(false)
+  const [status, setStatus] = useState('')
+
+  const revoke = async () => {
+    setBusy(true)
+    setStatus('')
+    try {
+      await deleteAppPassword(appPassword.id)
+    } catch {
+      setStatus('Action failed.')
+      setBusy(false)
+    }
+  }
+
+  return (
+    <li data-testid={`app-password-${appPassword.id}`}>
+      <div className="flex items-center gap-2">
+        <span className="min-w-0 flex-1 truncate text-sm">{appPassword.username}</span>
+        <span className="text-xs text-muted">{formatLastUsed(appPassword.lastUsedAt)}</span>
+        <Button size="sm" variant="destructive" disabled={busy} onClick={() => void revoke()}>
+          Revoke
+        </Button>
+      </div>
+      {status && <p className="mt-1 text-xs text-danger">{status}</p>}
+    </li>
+  )
+}
+
+// ReaderAppPasswords hands out the logins native reader apps (Reeder,
+// NetNewsWire, ReadKit) use with the Google Reader API, so items read or
+// bookmarked there are read or bookmarked here too. Each app gets its own
+// password, shown once and revocable on its own.
+export default function ReaderAppPasswords() {
+  const { data, error } = useAppPasswords()
+  const createAppPassword = useCreateAppPassword()
+
+  const [username, setUsername] = useState('')
+  const [busy, setBusy] = useState(false)
+  const [status, setStatus] = useState('')
+  const [credentials, setCredentials] = useState<Credentials | null>(null)
+
+  const submit = async () => {
+    if (!username.trim() || busy) return
+    setBusy(true)
+    setStatus('')
+    try {
+      const resp = await createAppPassword(username.trim())
+      setCredentials({
+        username: resp.appPassword?.username ?? username.trim(),
+        password: resp.password
+      })
+      setUsername('')
+    } catch (err) {
+      setStatus(
+        err instanceof ConnectError && err.code === Code.InvalidArgument
+          ? err.rawMessage
+          : 'Failed to create an app password.'
+      )
+    } finally {
+      setBusy(false)
+    }
+  }
+
+  const fields = credentials
+    ? [
+        { label: 'Server', value: readerServerUrl() },
+        { label: 'Username', value: credentials.username },
+        { label: 'Password', value: credentials.password }
+      ]
+    : []
+  const appPasswords = data?.appPasswords ?? []
+
+  return (
+    <div className="mt-3 space-y-2">
+      <form
+        className="flex flex-wrap items-center gap-2"
+        onSubmit={(e) => {
+          e.preventDefault()
+          void submit()
+        }}
+      >
+        <Input
+          placeholder="Reader app username, e.g. reeder"
+          value={username}
+          onChange={(e) => setUsername(e.target.value)}
+          aria-label="Reader app username"
+          className="w-auto min-w-0 flex-1"
+        />
+        <Button type="submit" variant="secondary" disabled={busy || !username.trim()}>
+          Create app password
+        </Button>
+      </form>
+      {status && <p className="text-xs text-danger">{status}</p>}
+      {credentials && (
+        <div
+          className="space-y-2 rounded-xl border border-border bg-card px-4 py-3"
+          data-testid="app-password-credentials"
+        >
+          <p className="text-xs text-muted">
+            In the app, add a Google Reader (or FreshRSS) account and enter these. The password is
+            only shown once.
+          </p>
+          {fields.map(({ label, value }) => (
+            <div key={label} className="flex items-center gap-2">
+              <span className="w-16 shrink-0 text-xs text-subtle">{label}</span>
+              <code className="min-w-0 flex-1 truncate text-xs">{value}</code>
+              <Button
+                type="button"
+                variant="ghost"
+                size="sm"
+                onClick={() => void navigator.clipboard.writeText(value)}
+              >
+                Copy
+              </Button>
+            </div>
+          ))}
+        </div>
+      )}
+      {error && <p className="text-xs text-danger">Failed to load app passwords.</p>}
+      {appPasswords.length > 0 && (
+        <ul className="space-y-1">
+          {appPasswords.map((p) => (
+            <AppPasswordRow key={p.id} appPassword={p} />
+          ))}
+        </ul>
+      )}
+    </div>
+  )
+}
@@ -22,23 +22,32 @@ import {
   formatCount
 } from '@/lib/observability'
 
+// CleanupNotice summarises the latest scan's garbage collection. The scan
+// deletes stale uploads and orphans past their grace period itself, so only
+// what it found but couldn't delete yet is flagged.
 function CleanupNotice({ latest }: { latest: StorageSnapshot }) {
-  const orphans = Number(latest.orphanCount)
-  const stale = Number(latest.staleUploadCount)
-  if (orphans === 0 && stale === 0) {
-    return <Badge variant="secondary">No cleanup needed</Badge>
-  }
+  const found = latest.orphanCount + latest.staleUploadCount
+  const remaining = found - latest.reclaimedCount
+  const remainingBytes =
+    latest.orphanSizeBytes + latest.staleUploadSizeBytes - latest.reclaimedSizeBytes
   return (
     <div className="flex flex-wrap gap-2">
-      {orphans > 0 && (
+      {remaining > 0n && (
         <Badge variant="danger">
-          {formatCount(latest.orphanCount)} orphaned ({formatBytes(latest.orphanSizeBytes)})
+          {formatCount(remaining)} orphaned or stale, awaiting cleanup (
+          {formatBytes(remainingBytes)})
         </Badge>
       )}
-      {stale > 0 && (
-        <Badge variant="danger">
-          {formatCount(latest.staleUploadCount)} stale uploads (
-          {formatBytes(latest.staleUploadSizeBytes)})
+      {latest.reclaimedCount > 0n && (
+        <Badge variant="secondary">
+          Reclaimed {formatCount(latest.reclaimedCount)} (
+          {formatBytes(latest.reclaimedSizeBytes)})
+        </Badge>
+      )}
+      {found === 0n && <Badge variant="secondary">No cleanup needed</Badge>}
+      {latest.dedupSavedBytes > 0n && (
+        <Badge variant="secondary">
+          Dedup saved {formatBytes(latest.dedupSavedBytes)}
         </Badge>
       )}
     </div>
@@ -16,17 +16,26 @@ import {
 import { BookFilesService } from '@/lib/gen/books/v1/files_pb'
 import { KoboService } from '@/lib/gen/books/v1/kobo_pb'
 import { CatalogService, UpdateBookRequestSchema } from '@/lib/gen/books/v1/catalog_pb'
+import { OPDSService } from '@/lib/gen/books/v1/opds_pb'
+import { KOReaderService } from '@/lib/gen/books/v1/koreader_pb'
+import { GoalsService, ReadingGoalSchema } from '@/lib/gen/books/v1/goals_pb'
 import type {
   GetLibraryResponse,
   GetBooksProgressResponse,
+  GetReadingStatsResponse,
   SearchLibraryResponse,
   SearchExternalResponse,
   GetExternalBookResponse,
   GetBookContentResponse,
+  ListAnnotationsResponse,
+  SearchBookTextResponse,
   Book
 } from '@/lib/gen/books/v1/library_pb'
 import type { GetKEPUBStatusResponse, GetBookFileResponse } from '@/lib/gen/books/v1/files_pb'
 import type { ListKoboDevicesResponse, GetKoboDeviceLogsResponse } from '@/lib/gen/books/v1/kobo_pb'
+import type { ListOPDSTokensResponse } from '@/lib/gen/books/v1/opds_pb'
+import type { ListKOReaderDevicesResponse } from '@/lib/gen/books/v1/koreader_pb'
+import type { GetReadingGoalsResponse } from '@/lib/gen/books/v1/goals_pb'
 import type {
   FindDuplicatesResponse,
   ListResyncProposalsResponse,
@@ -38,6 +47,7 @@ import type {
 export type CreateBookInput = MessageInitShape<typeof CreateBookRequestSchema>
 export type UpdateBookStatusInput = MessageInitShape<typeof UpdateBookStatusRequestSchema>
 export type UpdateProgressInput = MessageInitShape<typeof UpdateProgressRequestSchema>
+export type ReadingGoalInput = MessageInitShape<typeof ReadingGoalSchema>
 export type UpdateBookMetadataInput = NonNullable<
   MessageInitShape<typeof UpdateBookRequestSchema>['metadata']
 >
@@ -55,6 +65,31 @@ export function useBooksProgress(dateStart?: string, dateEnd?: string) {
   )
 }
 
+export function useReadingStats(dateStart?: string, dateEnd?: string) {
+  const client = createServiceClient(LibraryService)
+  return useSWR<GetReadingStatsResponse, Error>(swrKeys.readingStats(dateStart, dateEnd), () =>
+    client.getReadingStats({ dateStart, dateEnd })
+  )
+}
+
+// Omitting year asks for the current year's goals.
+export function useReadingGoals(year?: number) {
+  const client = createServiceClient(GoalsService)
+  return useSWR<GetReadingGoalsResponse, Error>(swrKeys.readingGoals(year), () =>
+    client.getReadingGoals({ year })
+  )
+}
+
+export function useSetReadingGoal() {
+  const client = createServiceClient(GoalsService)
+  return (goal: ReadingGoalInput) => client.setReadingGoal({ goal })
+}
+
+export function useDeleteReadingGoal() {
+  const client = createServiceClient(GoalsService)
+  return (year: number, kind: string) => client.deleteReadingGoal({ year, kind })
+}
+
 export function useSearchLibrary() {
   const client = useMemo(() => createServiceClient(LibraryService), [])
   return useCallback(
@@ -92,12 +127,21 @@ export function useCreateBook() {
   return (req: CreateBookInput) => client.createBook(req)
 }
 
+export interface ImportBooksOptions {
+  // Empty lets the server detect the format.
+  format?: string
+  dryRun?: boolean
+}
+
 export function useImportBooks() {
   const client = createServiceClient(CatalogService)
-  return (csvData: string) => {
-    const encoder = new TextEncoder()
-    return client.importBooks({ csvData: encoder.encode(csvData) })
-  }
+  return (data: Uint8Array, { format = '', dryRun = false }: ImportBooksOptions = {}) =>
+    client.importBooks({ data, format, dryRun })
+}
+
+export function useExportLibrary() {
+  const client = createServiceClient(CatalogService)
+  return (format: 'csv' | 'json') => client.exportLibrary({ format })
 }
 
 export function useUpdateBookStatus() {
@@ -125,6 +169,27 @@ export function useRemoveBook() {
   return (bookId: string) => client.removeBook({ bookId })
 }
 
+export function useQueueBook() {
+  const client = createServiceClient(LibraryService)
+  return (bookId: string) => client.queueBook({ bookId })
+}
+
+export function useDequeueBook() {
+  const client = createServiceClient(LibraryService)
+  return (bookId: string) => client.dequeueBook({ bookId })
+}
+
+export function useReorderQueue() {
+  const client = createServiceClient(LibraryService)
+  return (bookIds: string[]) => client.reorderQueue({ bookIds })
+}
+
+export function useUpdateQueueSettings() {
+  const client = createServiceClient(LibraryService)
+  return (autoAdvance: boolean, koboSyncCount: number) =>
+    client.updateQueueSettings({ settings: { autoAdvance, koboSyncCount } })
+}
+
 export type UploadBookFileResult = {
   matchedExisting: boolean
   recognizedTitle: string
@@ -277,6 +342,12 @@ export function useDisconnectKoboDevice() {
   return (id: string) => client.disconnectKoboDevice({ id })
 }
 
+export function useSetKoboDeviceFilter() {
+  const client = createServiceClient(KoboService)
+  return (id: string, syncShelves: string[], syncTags: string[]) =>
+    client.setKoboDeviceFilter({ id, syncShelves, syncTags })
+}
+
 export function useSetKoboDeviceLogging() {
   const client = createServiceClient(KoboService)
   return (id: string, enabled: boolean) => client.setKoboDeviceLogging({ id, enabled })
@@ -294,6 +365,38 @@ export function useClearKoboDeviceLogs() {
   return (id: string) => client.clearKoboDeviceLogs({ id })
 }
 
+export function useListOPDSTokens() {
+  const client = createServiceClient(OPDSService)
+  return useSWR<ListOPDSTokensResponse, Error>(swrKeys.opdsTokens, () => client.listOPDSTokens({}))
+}
+
+export function useCreateOPDSToken() {
+  const client = createServiceClient(OPDSService)
+  return (name: string) => client.createOPDSToken({ name })
+}
+
+export function useRevokeOPDSToken() {
+  const client = createServiceClient(OPDSService)
+  return (id: string) => client.revokeOPDSToken({ id })
+}
+
+export function useListKOReaderDevices() {
+  const client = createServiceClient(KOReaderService)
+  return useSWR<ListKOReaderDevicesResponse, Error>(swrKeys.koreaderDevices, () =>
+    client.listKOReaderDevices({})
+  )
+}
+
+export function useRegisterKOReaderDevice() {
+  const client = createServiceClient(KOReaderService)
+  return (username: string) => client.registerKOReaderDevice({ username })
+}
+
+export function useDisconnectKOReaderDevice() {
+  const client = createServiceClient(KOReaderService)
+  return (id: string) => client.disconnectKOReaderDevice({ id })
+}
+
 export function useStartResync() {
   const client = createServiceClient(CatalogService)
   return (force = false) => client.startResync({ force })
@@ -437,6 +540,33 @@ export function useGetBookContent(bookId: string | null) {
   )
 }
 
+// useBookAnnotations lists the highlights and notes synced from the user's
+// devices for one book.
+export function useBookAnnotations(bookId: string | null) {
+  const client = createServiceClient(LibraryService)
+  return useSWR<ListAnnotationsResponse, Error>(
+    bookId ? swrKeys.bookAnnotations(bookId) : null,
+    () => client.listAnnotations({ bookId: bookId! })
+  )
+}
+
+export function useExportAnnotations() {
+  const client = createServiceClient(LibraryService)
+  return (bookId: string, format: 'markdown' | 'json' = 'markdown') =>
+    client.exportAnnotations({ bookId, format })
+}
+
+// useSearchBookText searches inside the text of the user's books and
+// articles. An empty query disables the fetch. Only the first page is
+// fetched: the hits sit below the title matches as a preview, not a listing.
+export function useSearchBookText(query: string) {
+  const client = createServiceClient(LibraryService)
+  const q = query.trim()
+  return useSWR<SearchBookTextResponse, Error>(q ? swrKeys.bookTextSearch(q) : null, () =>
+    client.searchBookText({ query: q, limit: DEFAULT_PAGE_SIZE })
+  )
+}
+
 export function useCreateShelf() {
   const client = createServiceClient(LibraryService)
   return (name: string) => client.createShelf({ name })
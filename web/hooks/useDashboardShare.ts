@@ -7,7 +7,8 @@ import { PublicReadingDashboardService } from '@/lib/gen/dashboard/v1/reading_pb
 import type {
   GetSharedLibraryResponse,
   GetSharedBooksProgressResponse,
-  GetSharedFeedsSummaryResponse
+  GetSharedFeedsSummaryResponse,
+  GetSharedReadingGoalsResponse
 } from '@/lib/gen/dashboard/v1/reading_pb'
 import { PublicGamesDashboardService } from '@/lib/gen/dashboard/v1/games_pb'
 import type {
@@ -76,6 +77,18 @@ export function useSharedFeedsSummary(token: string, fallbackData?: GetSharedFee
   )
 }
 
+export function useSharedReadingGoals(
+  token: string,
+  fallbackData?: GetSharedReadingGoalsResponse
+) {
+  const client = createServiceClient(PublicReadingDashboardService)
+  return useSWR<GetSharedReadingGoalsResponse, Error>(
+    token ? swrKeys.dashboardReadingGoals(token) : null,
+    () => client.getSharedReadingGoals({ token }),
+    { fallbackData }
+  )
+}
+
 export function useSharedSteam(token: string, fallbackData?: GetSharedSteamResponse) {
   const client = createServiceClient(PublicGamesDashboardService)
   return useSWR<GetSharedSteamResponse, Error>(
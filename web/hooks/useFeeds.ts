@@ -10,7 +10,11 @@ import type {
   ListFeedItemsResponse,
   GetFeedItemResponse,
   UpdateItemResponse,
-  GetFeedStatsResponse
+  SendItemToLibraryResponse,
+  GetFeedStatsResponse,
+  ListFilterRulesResponse,
+  TestFilterRuleResponse,
+  ListAppPasswordsResponse
 } from '@/lib/gen/feeds/v1/feeds_pb'
 
 // FEEDS_SUMMARY_ITEM_LIMIT bounds the reading dashboard's feeds widget.
@@ -29,7 +33,8 @@ export interface FeedsSummary {
 
 // RSS/Atom and email-newsletter feed subscriptions, standalone from the
 // reading library (issue #734) — items are self-contained, so mutations
-// only ever invalidate feeds-scoped keys.
+// only ever invalidate feeds-scoped keys, apart from sending an item to the
+// library.
 
 // Items are paginated per unreadOnly variant (two independent SWR keys), so
 // a mutation invalidates both rather than tracking which one is on-screen.
@@ -78,11 +83,25 @@ export function useFeeds() {
   )
 }
 
-export function useFeedItems(unreadOnly: boolean, feedId?: string, bookmarkedOnly?: boolean) {
+// useFeedItems lists one page of items, optionally narrowed to one feed or
+// to one folder (including its subfolders).
+export function useFeedItems(
+  unreadOnly: boolean,
+  feedId?: string,
+  bookmarkedOnly?: boolean,
+  folderId?: string
+) {
   const client = createServiceClient(FeedService)
   return useSWR<ListFeedItemsResponse, Error>(
-    swrKeys.feedItems(unreadOnly, feedId, bookmarkedOnly),
-    () => client.listFeedItems({ limit: DEFAULT_PAGE_SIZE, unreadOnly, feedId, bookmarkedOnly }),
+    swrKeys.feedItems(unreadOnly, feedId, bookmarkedOnly, folderId),
+    () =>
+      client.listFeedItems({
+        limit: DEFAULT_PAGE_SIZE,
+        unreadOnly,
+        feedId,
+        bookmarkedOnly,
+        folderId
+      }),
     noAutoRevalidate
   )
 }
@@ -102,15 +121,23 @@ export function useFeedItem(itemId: string | null) {
 export function useFetchFeedItemsPage(
   unreadOnly: boolean,
   feedId?: string,
-  bookmarkedOnly?: boolean
+  bookmarkedOnly?: boolean,
+  folderId?: string
 ) {
   const client = useMemo(() => createServiceClient(FeedService), [])
   return useCallback(
     (offset: number) =>
       client
-        .listFeedItems({ limit: DEFAULT_PAGE_SIZE, offset, unreadOnly, feedId, bookmarkedOnly })
+        .listFeedItems({
+          limit: DEFAULT_PAGE_SIZE,
+          offset,
+          unreadOnly,
+          feedId,
+          bookmarkedOnly,
+          folderId
+        })
         .then((r) => ({ items: r.items, hasMore: r.hasMore })),
-    [client, unreadOnly, feedId, bookmarkedOnly]
+    [client, unreadOnly, feedId, bookmarkedOnly, folderId]
   )
 }
 
@@ -138,6 +165,189 @@ export function useDeleteFeed() {
   )
 }
 
+// useImportOPML subscribes to every feed in an OPML file. New feeds import
+// their items in the background, like CreateFeed, so only the feed list is
+// refreshed here.
+export function useImportOPML() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(
+    async (data: Uint8Array) => {
+      const resp = await client.importOPML({ data })
+      if (resp.createdCount > 0) await mutate(swrKeys.feeds)
+      return resp
+    },
+    [client]
+  )
+}
+
+export function useExportOPML() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(() => client.exportOPML({}), [client])
+}
+
+// Folder hooks. Moving feeds between folders changes what a folder's item
+// view holds, so deleting a folder or moving a feed invalidates the items
+// too; creating, renaming and reordering only touch the feed list.
+export function useCreateFolder() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(
+    async (name: string, parentId = '') => {
+      const resp = await client.createFolder({ name, parentId })
+      await mutate(swrKeys.feeds)
+      return resp
+    },
+    [client]
+  )
+}
+
+export function useRenameFolder() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(
+    async (folderId: string, name: string) => {
+      await client.renameFolder({ folderId, name })
+      await mutate(swrKeys.feeds)
+    },
+    [client]
+  )
+}
+
+export function useDeleteFolder() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(
+    async (folderId: string) => {
+      await client.deleteFolder({ folderId })
+      await mutate(swrKeys.feeds)
+      await mutateFeedItems()
+    },
+    [client]
+  )
+}
+
+export function useReorderFolders() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(
+    async (ids: string[]) => {
+      await client.reorderFolders({ ids })
+      await mutate(swrKeys.feeds)
+    },
+    [client]
+  )
+}
+
+// useMoveFeed files a feed under a folder; an empty folderId unfiles it.
+export function useMoveFeed() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(
+    async (feedId: string, folderId: string) => {
+      await client.moveFeed({ feedId, folderId })
+      await mutate(swrKeys.feeds)
+      await mutateFeedItems()
+    },
+    [client]
+  )
+}
+
+// FilterRuleInput is a rule as the editor builds it, before it has an id.
+export interface FilterRuleInput {
+  feedId: string
+  field: string
+  matchType: string
+  pattern: string
+  action: string
+  tag: string
+  enabled: boolean
+}
+
+// Filter rules only act on items ingested after they are saved, so rule
+// mutations never touch the cached items.
+export function useFilterRules() {
+  const client = createServiceClient(FeedService)
+  return useSWR<ListFilterRulesResponse, Error>(
+    swrKeys.feedFilterRules,
+    () => client.listFilterRules({}),
+    noAutoRevalidate
+  )
+}
+
+export function useCreateFilterRule() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(
+    async (rule: FilterRuleInput) => {
+      const resp = await client.createFilterRule({ rule })
+      await mutate(swrKeys.feedFilterRules)
+      return resp
+    },
+    [client]
+  )
+}
+
+export function useUpdateFilterRule() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(
+    async (id: string, rule: FilterRuleInput) => {
+      const resp = await client.updateFilterRule({ rule: { ...rule, id } })
+      await mutate(swrKeys.feedFilterRules)
+      return resp
+    },
+    [client]
+  )
+}
+
+export function useDeleteFilterRule() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(
+    async (ruleId: string) => {
+      await client.deleteFilterRule({ ruleId })
+      await mutate(swrKeys.feedFilterRules)
+    },
+    [client]
+  )
+}
+
+// useTestFilterRule previews an unsaved rule against recent items; nothing
+// is cached or changed.
+export function useTestFilterRule() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(
+    (rule: FilterRuleInput): Promise<TestFilterRuleResponse> =>
+      client.testFilterRule({ rule }),
+    [client]
+  )
+}
+
+// App passwords let native reader apps log in to the Google Reader API.
+export function useAppPasswords() {
+  const client = createServiceClient(FeedService)
+  return useSWR<ListAppPasswordsResponse, Error>(
+    swrKeys.feedAppPasswords,
+    () => client.listAppPasswords({}),
+    noAutoRevalidate
+  )
+}
+
+export function useCreateAppPassword() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(
+    async (username: string) => {
+      const resp = await client.createAppPassword({ username })
+      await mutate(swrKeys.feedAppPasswords)
+      return resp
+    },
+    [client]
+  )
+}
+
+export function useDeleteAppPassword() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(
+    async (appPasswordId: string) => {
+      await client.deleteAppPassword({ appPasswordId })
+      await mutate(swrKeys.feedAppPasswords)
+    },
+    [client]
+  )
+}
+
 export function useRefreshFeed() {
   const client = useMemo(() => createServiceClient(FeedService), [])
   return useCallback(
@@ -166,7 +376,30 @@ export function useUpdateItem() {
   return useCallback(
     async (itemId: string, updates: UpdateItemInput): Promise<UpdateItemResponse> => {
       const resp = await client.updateItem({ itemId, ...updates })
+      if (resp.item) {
+        await patchCachedItem(resp.item)
+        // Reading or dismissing changes the feed and folder unread counts
+        // ListFeeds carries; read progress alone doesn't.
+        if (updates.read !== undefined || updates.dismissed !== undefined) {
+          await mutate(swrKeys.feeds)
+        }
+      }
+      return resp
+    },
+    [client]
+  )
+}
+
+// useSendItemToLibrary adds an item to the books library from its stored
+// body. The returned item carries the new libraryBookId, patched into the
+// cached lists like an UpdateItem; the library itself is a books-scoped key.
+export function useSendItemToLibrary() {
+  const client = useMemo(() => createServiceClient(FeedService), [])
+  return useCallback(
+    async (itemId: string): Promise<SendItemToLibraryResponse> => {
+      const resp = await client.sendItemToLibrary({ itemId })
       if (resp.item) await patchCachedItem(resp.item)
+      await mutate(swrKeys.books)
       return resp
     },
     [client]
@@ -1,5 +1,6 @@
-import useSWR from 'swr'
-import { probeGateway, type GatewayStatus } from '@/lib/books/gatewayClient'
+import { useCallback } from 'react'
+import useSWR, { mutate } from 'swr'
+import { importGateway, probeGateway, type GatewayStatus } from '@/lib/books/gatewayClient'
 import { swrKeys } from '@/lib/swrKeys'
 
 const POLL_INTERVAL_MS = 2000
@@ -14,3 +15,16 @@ export function useGatewayStatus() {
     refreshInterval: POLL_INTERVAL_MS
   })
 }
+
+/**
+ * Has the gateway upload a Kobo's reading history to the endpoint it is
+ * already configured with. Imports can add books and mark them read, so the
+ * library is refreshed afterwards.
+ */
+export function useImportGateway() {
+  return useCallback(async (volumePath: string) => {
+    const result = await importGateway(undefined, volumePath)
+    await mutate(swrKeys.books)
+    return result
+  }, [])
+}
@@ -0,0 +1,14 @@
+/**
+ * Hands bytes returned by an RPC to the browser as a file download, for
+ * exports that come back in a response body rather than from a URL.
+ */
+export function saveFile(data: Uint8Array, filename: string, contentType: string) {
+  const url = URL.createObjectURL(new Blob([data], { type: contentType }))
+  const link = document.createElement('a')
+  link.href = url
+  link.download = filename
+  document.body.appendChild(link)
+  link.click()
+  link.remove()
+  URL.revokeObjectURL(url)
+}
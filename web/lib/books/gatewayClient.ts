@@ -23,7 +23,7 @@ export const GATEWAY_URL = `https://127.0.0.1:${GATEWAY_PORT}`
  * releases are instead caught by the release (build SHA) check in
  * gatewayNeedsUpdate below.
  */
-export const REQUIRED_GATEWAY_VERSION = 2
+export const REQUIRED_GATEWAY_VERSION = 3
 
 // The .dmg is what the download button offers (drag-to-Applications, menu
 // bar app). The self-updater (updateGateway below) fetches the raw binary
@@ -95,6 +95,35 @@ export function revertGateway(
   return gatewayPost('/revert', { targetEndpoint, ...(volumePath ? { volumePath } : {}) })
 }
 
+export interface GatewayImportResult {
+  serial: string
+  books: number
+  matched: number
+  created: number
+  skipped: number
+  markedRead: number
+  highlights: number
+  sessions: number
+  // Device highlights the server couldn't map (e.g. non-UUID bookmark IDs).
+  skippedHighlights: number
+}
+
+/**
+ * Asks the gateway to read highlights, reading sessions and finished books
+ * out of the Kobo's KoboReader.sqlite and upload them to this server. The
+ * upload goes to syncUrl, or to the endpoint the Kobo is already configured
+ * with when omitted.
+ */
+export function importGateway(
+  syncUrl?: string,
+  volumePath?: string
+): Promise<GatewayImportResult> {
+  return gatewayPost('/import', {
+    ...(syncUrl ? { syncUrl } : {}),
+    ...(volumePath ? { volumePath } : {})
+  })
+}
+
 /**
  * True when the installed gateway should self-update: either it's below the
  * required protocol version, or its release (build SHA) doesn't match the
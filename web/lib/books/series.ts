@@ -0,0 +1,22 @@
+import type { Book } from '@/lib/gen/books/v1/library_pb'
+
+// Formats a book's place in its series for display: "The Expanse #2",
+// "The Expanse #2 of 9", or just the series name when the position is unknown.
+// Returns '' for standalone books.
+export function seriesLabel(
+  book: Pick<Book, 'series' | 'seriesPosition' | 'seriesTotal'> | undefined
+): string {
+  if (!book?.series) return ''
+  if (book.seriesPosition === undefined) return book.series
+  const total = book.seriesTotal > 0 ? ` of ${book.seriesTotal}` : ''
+  return `${book.series} #${book.seriesPosition}${total}`
+}
+
+// Parses the "number in series" input; blank or non-numeric means unknown.
+// Accepts a decimal comma since editions often print "2,5".
+export function parseSeriesPosition(value: string): number | undefined {
+  const trimmed = value.trim().replace(',', '.')
+  if (trimmed === '') return undefined
+  const n = Number(trimmed)
+  return Number.isFinite(n) && n >= 0 ? n : undefined
+}
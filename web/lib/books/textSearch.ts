@@ -0,0 +1,83 @@
+import type { TextHit } from '@/lib/gen/books/v1/library_pb'
+
+export type ReaderFormat = 'pdf' | 'epub' | 'kepub'
+
+export interface SnippetPart {
+  text: string
+  marked: boolean
+}
+
+// Splits a SearchBookText snippet on the <mark></mark> markers the server
+// wraps matched words in, so they can be rendered as elements rather than
+// injected as HTML (the rest of the snippet is the book's own text).
+export function splitSnippet(snippet: string): SnippetPart[] {
+  const parts: SnippetPart[] = []
+  for (const [i, chunk] of snippet.split(/<\/?mark>/).entries()) {
+    if (chunk) parts.push({ text: chunk, marked: i % 2 === 1 })
+  }
+  return parts
+}
+
+// The word to look for once the reader has opened a hit's chapter: the
+// first plain term of the query, ignoring quotes, OR and -excluded words.
+export function findTerm(query: string): string {
+  const words = query
+    .replace(/"/g, ' ')
+    .split(/\s+/)
+    .filter((w) => w && !w.startsWith('-') && w.toLowerCase() !== 'or')
+  return words[0] ?? ''
+}
+
+// Links a hit to its book's detail page, which opens the reader at the
+// matched chapter (read/loc) and looks for the query there (find).
+export function textHitHref(
+  hit: Pick<TextHit, 'userBookId' | 'format' | 'location'>,
+  query: string
+): string {
+  const params = new URLSearchParams({ read: hit.format })
+  if (hit.location) params.set('loc', hit.location)
+  const term = findTerm(query)
+  if (term) params.set('find', term)
+  return `/books/${hit.userBookId}?${params.toString()}`
+}
+
+export interface ReaderLink {
+  // Which reader to open: a file format for BookPreviewDialog, 'article'
+  // for ArticleReaderDialog, null for neither.
+  read: ReaderFormat | 'article' | null
+  location: string | null
+  find: string
+}
+
+// Reads the read/loc/find parameters textHitHref adds. Unknown formats open
+// nothing rather than guessing.
+export function parseReaderLink(params: Pick<URLSearchParams, 'get'>): ReaderLink {
+  const read = params.get('read')
+  const valid = read === 'pdf' || read === 'epub' || read === 'kepub' || read === 'article'
+  return {
+    read: valid ? read : null,
+    location: params.get('loc') || null,
+    find: params.get('find') ?? ''
+  }
+}
+
+// Wraps the first case-insensitive occurrence of term under root in a
+// <mark> and returns it, or null when the text doesn't contain it. Only a
+// match within a single text node is found, which covers a single word.
+export function markFirstMatch(root: HTMLElement, term: string): HTMLElement | null {
+  const needle = term.trim().toLowerCase()
+  if (!needle) return null
+  const walker = root.ownerDocument.createTreeWalker(root, NodeFilter.SHOW_TEXT)
+  for (let node = walker.nextNode(); node; node = walker.nextNode()) {
+    const text = node.nodeValue ?? ''
+    const at = text.toLowerCase().indexOf(needle)
+    if (at < 0) continue
+    const range = root.ownerDocument.createRange()
+    range.setStart(node, at)
+    range.setEnd(node, at + needle.length)
+    const mark = root.ownerDocument.createElement('mark')
+    range.surroundContents(mark)
+    return mark
+  }
+  return null
+}
@@ -5,7 +5,7 @@
 export const MAX_UPLOAD_BYTES = 250 * 1024 * 1024
 
 /** Accepted book file extensions. */
-const BOOK_EXTENSIONS = ['.epub', '.pdf']
+const BOOK_EXTENSIONS = ['.epub', '.pdf', '.cbz', '.mobi', '.azw', '.azw3', '.fb2', '.fb2.zip']
 
 /** Returns true if the File has an accepted extension. */
 export function isBookFile(file: File): boolean {
@@ -0,0 +1,27 @@
+import type { Folder } from '@/lib/gen/feeds/v1/feeds_pb'
+
+export interface FolderEntry {
+  folder: Folder
+  // 0 for a top-level folder, 1 for a subfolder.
+  depth: number
+}
+
+// orderFolders lays folders out as a tree: each top-level folder in position
+// order, followed by its own subfolders in position order.
+export function orderFolders(folders: Folder[]): FolderEntry[] {
+  const byPosition = (a: Folder, b: Folder) =>
+    a.position - b.position || a.name.localeCompare(b.name)
+  const out: FolderEntry[] = []
+  for (const top of folders.filter((f) => !f.parentId).sort(byPosition)) {
+    out.push({ folder: top, depth: 0 })
+    for (const sub of folders.filter((f) => f.parentId === top.id).sort(byPosition)) {
+      out.push({ folder: sub, depth: 1 })
+    }
+  }
+  return out
+}
+
+// withUnread appends an unread count to a feed or folder label.
+export function withUnread(label: string, unreadCount: number): string {
+  return unreadCount > 0 ? `${label} (${unreadCount})` : label
+}
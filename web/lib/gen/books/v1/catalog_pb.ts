@@ -12,16 +12,32 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file books/v1/catalog.proto.
  */
 export const file_books_v1_catalog: GenFile = /*@__PURE__*/
-  fileDesc("ChZib29rcy92MS9jYXRhbG9nLnByb3RvEghib29rcy52MSImChJJbXBvcnRCb29rc1JlcXVlc3QSEAoIY3N2X2RhdGEYASABKAwiLQoTSW1wb3J0Qm9va3NSZXNwb25zZRIWCg5pbXBvcnRlZF9jb3VudBgBIAEoBSJFCg5EdXBsaWNhdGVHcm91cBIjCgdlbnRyaWVzGAEgAygLMhIuYm9va3MudjEuVXNlckJvb2sSDgoGcmVhc29uGAIgASgJIhcKFUZpbmREdXBsaWNhdGVzUmVxdWVzdCJCChZGaW5kRHVwbGljYXRlc1Jlc3BvbnNlEigKBmdyb3VwcxgBIAMoCzIYLmJvb2tzLnYxLkR1cGxpY2F0ZUdyb3VwIokCChFNZXJnZUJvb2tzUmVxdWVzdBIWCg53aW5uZXJfYm9va19pZBgBIAEoCRIWCg5sb3Nlcl9ib29rX2lkcxgCIAMoCRIuChFyZXNvbHZlZF9tZXRhZGF0YRgDIAEoCzIOLmJvb2tzLnYxLkJvb2tIAIgBARIqCh1yZXNvbHZlZF9jb3Zlcl9zb3VyY2VfYm9va19pZBgEIAEoCUgBiAEBEhwKD3Jlc29sdmVkX3N0YXR1cxgFIAEoCUgCiAEBQhQKEl9yZXNvbHZlZF9tZXRhZGF0YUIgCh5fcmVzb2x2ZWRfY292ZXJfc291cmNlX2Jvb2tfaWRCEgoQX3Jlc29sdmVkX3N0YXR1cyJCChJNZXJnZUJvb2tzUmVzcG9uc2USFQoNbWVyZ2VkX2dyb3VwcxgBIAEoDRIVCg1kZWxldGVkX2ZpbGVzGAIgASgNIiMKElN0YXJ0UmVzeW5jUmVxdWVzdBINCgVmb3JjZRgBIAEoCCIVChNTdGFydFJlc3luY1Jlc3BvbnNlIhUKE0NhbmNlbFJlc3luY1JlcXVlc3QiFgoUQ2FuY2VsUmVzeW5jUmVzcG9uc2UiqAEKClNvdXJjZUJvb2sSDgoGc291cmNlGAEgASgJEhEKCWNvdmVyX3VybBgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRISCgpwYWdlX2NvdW50GAQgASgFEg4KBmlzYm4xMxgFIAEoCRINCgV0aXRsZRgGIAEoCRIPCgdhdXRob3JzGAcgAygJEg8KB2RpZmZlcnMYCCADKAkSDQoFaW5kZXgYCSABKAUibwoOUmVzeW5jUHJvcG9zYWwSDwoHYm9va19pZBgBIAEoCRIlCgdsaWJyYXJ5GAIgASgLMhQuYm9va3MudjEuU291cmNlQm9vaxIlCgdzb3VyY2VzGAMgAygLMhQuYm9va3MudjEuU291cmNlQm9vayIcChpMaXN0UmVzeW5jUHJvcG9zYWxzUmVxdWVzdCJKChtMaXN0UmVzeW5jUHJvcG9zYWxzUmVzcG9uc2USKwoJcHJvcG9zYWxzGAEgAygLMhguYm9va3MudjEuUmVzeW5jUHJvcG9zYWwiOwoYQXBwbHlSZXN5bmNDaG9pY2VSZXF1ZXN0Eg8KB2Jvb2tfaWQYASABKAkSDgoGc291cmNlGAIgASgJIhsKGUFwcGx5UmVzeW5jQ2hvaWNlUmVzcG9uc2UiNQoSU2V0Qm9va0lTQk5SZXF1ZXN0Eg8KB2Jvb2tfaWQYASABKAkSDgoGaXNibjEzGAIgASgJIhUKE1NldEJvb2tJU0JOUmVzcG9uc2UiRgoRVXBkYXRlQm9va1JlcXVlc3QSDwoHYm9va19pZBgBIAEoCRIgCghtZXRhZGF0YRgCIAEoCzIOLmJvb2tzLnYxLkJvb2siMgoSVXBkYXRlQm9va1Jlc3BvbnNlEhwKBGJvb2sYASABKAsyDi5ib29rcy52MS5Cb29rIooBChVHZXRCb29rU291cmNlc1JlcXVlc3QSDwoHYm9va19pZBgBIAEoCRIbCg5vdmVycmlkZV90aXRsZRgCIAEoCUgAiAEBEhwKD292ZXJyaWRlX2F1dGhvchgDIAEoCUgBiAEBQhEKD19vdmVycmlkZV90aXRsZUISChBfb3ZlcnJpZGVfYXV0aG9yIkQKFkdldEJvb2tTb3VyY2VzUmVzcG9uc2USKgoIcHJvcG9zYWwYASABKAsyGC5ib29rcy52MS5SZXN5bmNQcm9wb3NhbCKqAQoWQXBwbHlCb29rU291cmNlUmVxdWVzdBIPCgdib29rX2lkGAEgASgJEg4KBnNvdXJjZRgCIAEoCRIbCg5vdmVycmlkZV90aXRsZRgDIAEoCUgAiAEBEhwKD292ZXJyaWRlX2F1dGhvchgEIAEoCUgBiAEBEg0KBWluZGV4GAUgASgFQhEKD19vdmVycmlkZV90aXRsZUISChBfb3ZlcnJpZGVfYXV0aG9yIhkKF0FwcGx5Qm9va1NvdXJjZVJlc3BvbnNlIhcKFUdldFNvdXJjZVN0YXRzUmVxdWVzdCJdCgpTb3VyY2VTdGF0Eg4KBnNvdXJjZRgBIAEoCRITCgtmb3VuZF9jb3VudBgCIAEoBRIUCgx1bmlxdWVfY291bnQYAyABKAUSFAoMbWlzc2VkX2NvdW50GAQgASgFIjEKD1NvdXJjZUNvbWJvU3RhdBIPCgdzb3VyY2VzGAEgAygJEg0KBWNvdW50GAIgASgFIugBChZHZXRTb3VyY2VTdGF0c1Jlc3BvbnNlEiUKB3NvdXJjZXMYASADKAsyFC5ib29rcy52MS5Tb3VyY2VTdGF0EhMKC3RvdGFsX2Jvb2tzGAIgASgFEhoKEm5vdF9mb3VuZF9hbnl3aGVyZRgDIAEoBRIVCg1uZXZlcl9zY2FubmVkGAQgASgFEisKCG92ZXJsYXBzGAUgAygLMhkuYm9va3MudjEuU291cmNlQ29tYm9TdGF0EjIKD21pc3NlZF9vdmVybGFwcxgGIAMoCzIZLmJvb2tzLnYxLlNvdXJjZUNvbWJvU3RhdCIxCh5MaXN0Qm9va3NJbkV4YWN0U291cmNlc1JlcXVlc3QSDwoHc291cmNlcxgBIAMoCSJACh9MaXN0Qm9va3NJbkV4YWN0U291cmNlc1Jlc3BvbnNlEh0KBWJvb2tzGAEgAygLMg4uYm9va3MudjEuQm9vazLeCAoOQ2F0YWxvZ1NlcnZpY2USSgoLSW1wb3J0Qm9va3MSHC5ib29rcy52MS5JbXBvcnRCb29rc1JlcXVlc3QaHS5ib29rcy52MS5JbXBvcnRCb29rc1Jlc3BvbnNlElMKDkZpbmREdXBsaWNhdGVzEh8uYm9va3MudjEuRmluZER1cGxpY2F0ZXNSZXF1ZXN0GiAuYm9va3MudjEuRmluZER1cGxpY2F0ZXNSZXNwb25zZRJHCgpNZXJnZUJvb2tzEhsuYm9va3MudjEuTWVyZ2VCb29rc1JlcXVlc3QaHC5ib29rcy52MS5NZXJnZUJvb2tzUmVzcG9uc2USSgoLU3RhcnRSZXN5bmMSHC5ib29rcy52MS5TdGFydFJlc3luY1JlcXVlc3QaHS5ib29rcy52MS5TdGFydFJlc3luY1Jlc3BvbnNlEk0KDENhbmNlbFJlc3luYxIdLmJvb2tzLnYxLkNhbmNlbFJlc3luY1JlcXVlc3QaHi5ib29rcy52MS5DYW5jZWxSZXN5bmNSZXNwb25zZRJiChNMaXN0UmVzeW5jUHJvcG9zYWxzEiQuYm9va3MudjEuTGlzdFJlc3luY1Byb3Bvc2Fsc1JlcXVlc3QaJS5ib29rcy52MS5MaXN0UmVzeW5jUHJvcG9zYWxzUmVzcG9uc2USXAoRQXBwbHlSZXN5bmNDaG9pY2USIi5ib29rcy52MS5BcHBseVJlc3luY0Nob2ljZVJlcXVlc3QaIy5ib29rcy52MS5BcHBseVJlc3luY0Nob2ljZVJlc3BvbnNlEkoKC1NldEJvb2tJU0JOEhwuYm9va3MudjEuU2V0Qm9va0lTQk5SZXF1ZXN0Gh0uYm9va3MudjEuU2V0Qm9va0lTQk5SZXNwb25zZRJHCgpVcGRhdGVCb29rEhsuYm9va3MudjEuVXBkYXRlQm9va1JlcXVlc3QaHC5ib29rcy52MS5VcGRhdGVCb29rUmVzcG9uc2USUwoOR2V0Qm9va1NvdXJjZXMSHy5ib29rcy52MS5HZXRCb29rU291cmNlc1JlcXVlc3QaIC5ib29rcy52MS5HZXRCb29rU291cmNlc1Jlc3BvbnNlElYKD0FwcGx5Qm9va1NvdXJjZRIgLmJvb2tzLnYxLkFwcGx5Qm9va1NvdXJjZVJlcXVlc3QaIS5ib29rcy52MS5BcHBseUJvb2tTb3VyY2VSZXNwb25zZRJTCg5HZXRTb3VyY2VTdGF0cxIfLmJvb2tzLnYxLkdldFNvdXJjZVN0YXRzUmVxdWVzdBogLmJvb2tzLnYxLkdldFNvdXJjZVN0YXRzUmVzcG9uc2USbgoXTGlzdEJvb2tzSW5FeGFjdFNvdXJjZXMSKC5ib29rcy52MS5MaXN0Qm9va3NJbkV4YWN0U291cmNlc1JlcXVlc3QaKS5ib29rcy52MS5MaXN0Qm9va3NJbkV4YWN0U291cmNlc1Jlc3BvbnNlQilaJ3Rvb2xzLnhkb3VibGV1LmNvbS9nZW4vYm9va3MvdjE7Ym9va3N2MWIGcHJvdG8z", [file_books_v1_library]);
+  fileDesc("ChZib29rcy92MS9jYXRhbG9nLnByb3RvEghib29rcy52MSJDChJJbXBvcnRCb29rc1JlcXVlc3QSDAoEZGF0YRgBIAEoDBIOCgZmb3JtYXQYAiABKAkSDwoHZHJ5X3J1bhgDIAEoCCJqCg5JbXBvcnRQbGFuSXRlbRINCgV0aXRsZRgBIAEoCRIPCgdhdXRob3JzGAIgAygJEg4KBmFjdGlvbhgDIAEoCRIOCgZyZWFzb24YBCABKAkSGAoQZXhpc3RpbmdfYm9va19pZBgFIAEoCSKqAQoTSW1wb3J0Qm9va3NSZXNwb25zZRIWCg5pbXBvcnRlZF9jb3VudBgBIAEoBRIOCgZmb3JtYXQYAiABKAkSFQoNY3JlYXRlZF9jb3VudBgDIAEoBRIUCgxtZXJnZWRfY291bnQYBCABKAUSFQoNc2tpcHBlZF9jb3VudBgFIAEoBRInCgVpdGVtcxgGIAMoCzIYLmJvb2tzLnYxLkltcG9ydFBsYW5JdGVtIiYKFEV4cG9ydExpYnJhcnlSZXF1ZXN0Eg4KBmZvcm1hdBgBIAEoCSJNChVFeHBvcnRMaWJyYXJ5UmVzcG9uc2USDAoEZGF0YRgBIAEoDBIUCgxjb250ZW50X3R5cGUYAiABKAkSEAoIZmlsZW5hbWUYAyABKAkiRQoORHVwbGljYXRlR3JvdXASIwoHZW50cmllcxgBIAMoCzISLmJvb2tzLnYxLlVzZXJCb29rEg4KBnJlYXNvbhgCIAEoCSIXChVGaW5kRHVwbGljYXRlc1JlcXVlc3QiQgoWRmluZER1cGxpY2F0ZXNSZXNwb25zZRIoCgZncm91cHMYASADKAsyGC5ib29rcy52MS5EdXBsaWNhdGVHcm91cCKJAgoRTWVyZ2VCb29rc1JlcXVlc3QSFgoOd2lubmVyX2Jvb2tfaWQYASABKAkSFgoObG9zZXJfYm9va19pZHMYAiADKAkSLgoRcmVzb2x2ZWRfbWV0YWRhdGEYAyABKAsyDi5ib29rcy52MS5Cb29rSACIAQESKgodcmVzb2x2ZWRfY292ZXJfc291cmNlX2Jvb2tfaWQYBCABKAlIAYgBARIcCg9yZXNvbHZlZF9zdGF0dXMYBSABKAlIAogBAUIUChJfcmVzb2x2ZWRfbWV0YWRhdGFCIAoeX3Jlc29sdmVkX2NvdmVyX3NvdXJjZV9ib29rX2lkQhIKEF9yZXNvbHZlZF9zdGF0dXMiQgoSTWVyZ2VCb29rc1Jlc3BvbnNlEhUKDW1lcmdlZF9ncm91cHMYASABKA0SFQoNZGVsZXRlZF9maWxlcxgCIAEoDSIjChJTdGFydFJlc3luY1JlcXVlc3QSDQoFZm9yY2UYASABKAgiFQoTU3RhcnRSZXN5bmNSZXNwb25zZSIVChNDYW5jZWxSZXN5bmNSZXF1ZXN0IhYKFENhbmNlbFJlc3luY1Jlc3BvbnNlIoACCgpTb3VyY2VCb29rEg4KBnNvdXJjZRgBIAEoCRIRCgljb3Zlcl91cmwYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSEgoKcGFnZV9jb3VudBgEIAEoBRIOCgZpc2JuMTMYBSABKAkSDQoFdGl0bGUYBiABKAkSDwoHYXV0aG9ycxgHIAMoCRIPCgdkaWZmZXJzGAggAygJEg0KBWluZGV4GAkgASgFEg4KBnNlcmllcxgKIAEoCRIcCg9zZXJpZXNfcG9zaXRpb24YCyABKAFIAIgBARIUCgxzZXJpZXNfdG90YWwYDCABKAVCEgoQX3Nlcmllc19wb3NpdGlvbiJvCg5SZXN5bmNQcm9wb3NhbBIPCgdib29rX2lkGAEgASgJEiUKB2xpYnJhcnkYAiABKAsyFC5ib29rcy52MS5Tb3VyY2VCb29rEiUKB3NvdXJjZXMYAyADKAsyFC5ib29rcy52MS5Tb3VyY2VCb29rIhwKGkxpc3RSZXN5bmNQcm9wb3NhbHNSZXF1ZXN0IkoKG0xpc3RSZXN5bmNQcm9wb3NhbHNSZXNwb25zZRIrCglwcm9wb3NhbHMYASADKAsyGC5ib29rcy52MS5SZXN5bmNQcm9wb3NhbCI7ChhBcHBseVJlc3luY0Nob2ljZVJlcXVlc3QSDwoHYm9va19pZBgBIAEoCRIOCgZzb3VyY2UYAiABKAkiGwoZQXBwbHlSZXN5bmNDaG9pY2VSZXNwb25zZSI1ChJTZXRCb29rSVNCTlJlcXVlc3QSDwoHYm9va19pZBgBIAEoCRIOCgZpc2JuMTMYAiABKAkiFQoTU2V0Qm9va0lTQk5SZXNwb25zZSJGChFVcGRhdGVCb29rUmVxdWVzdBIPCgdib29rX2lkGAEgASgJEiAKCG1ldGFkYXRhGAIgASgLMg4uYm9va3MudjEuQm9vayIyChJVcGRhdGVCb29rUmVzcG9uc2USHAoEYm9vaxgBIAEoCzIOLmJvb2tzLnYxLkJvb2siigEKFUdldEJvb2tTb3VyY2VzUmVxdWVzdBIPCgdib29rX2lkGAEgASgJEhsKDm92ZXJyaWRlX3RpdGxlGAIgASgJSACIAQESHAoPb3ZlcnJpZGVfYXV0aG9yGAMgASgJSAGIAQFCEQoPX292ZXJyaWRlX3RpdGxlQhIKEF9vdmVycmlkZV9hdXRob3IiRAoWR2V0Qm9va1NvdXJjZXNSZXNwb25zZRIqCghwcm9wb3NhbBgBIAEoCzIYLmJvb2tzLnYxLlJlc3luY1Byb3Bvc2FsIqoBChZBcHBseUJvb2tTb3VyY2VSZXF1ZXN0Eg8KB2Jvb2tfaWQYASABKAkSDgoGc291cmNlGAIgASgJEhsKDm92ZXJyaWRlX3RpdGxlGAMgASgJSACIAQESHAoPb3ZlcnJpZGVfYXV0aG9yGAQgASgJSAGIAQESDQoFaW5kZXgYBSABKAVCEQoPX292ZXJyaWRlX3RpdGxlQhIKEF9vdmVycmlkZV9hdXRob3IiGQoXQXBwbHlCb29rU291cmNlUmVzcG9uc2UiFwoVR2V0U291cmNlU3RhdHNSZXF1ZXN0Il0KClNvdXJjZVN0YXQSDgoGc291cmNlGAEgASgJEhMKC2ZvdW5kX2NvdW50GAIgASgFEhQKDHVuaXF1ZV9jb3VudBgDIAEoBRIUCgxtaXNzZWRfY291bnQYBCABKAUiMQoPU291cmNlQ29tYm9TdGF0Eg8KB3NvdXJjZXMYASADKAkSDQoFY291bnQYAiABKAUi6AEKFkdldFNvdXJjZVN0YXRzUmVzcG9uc2USJQoHc291cmNlcxgBIAMoCzIULmJvb2tzLnYxLlNvdXJjZVN0YXQSEwoLdG90YWxfYm9va3MYAiABKAUSGgoSbm90X2ZvdW5kX2FueXdoZXJlGAMgASgFEhUKDW5ldmVyX3NjYW5uZWQYBCABKAUSKwoIb3ZlcmxhcHMYBSADKAsyGS5ib29rcy52MS5Tb3VyY2VDb21ib1N0YXQSMgoPbWlzc2VkX292ZXJsYXBzGAYgAygLMhkuYm9va3MudjEuU291cmNlQ29tYm9TdGF0IjEKHkxpc3RCb29rc0luRXhhY3RTb3VyY2VzUmVxdWVzdBIPCgdzb3VyY2VzGAEgAygJIkAKH0xpc3RCb29rc0luRXhhY3RTb3VyY2VzUmVzcG9uc2USHQoFYm9va3MYASADKAsyDi5ib29rcy52MS5Cb29rMrAJCg5DYXRhbG9nU2VydmljZRJKCgtJbXBvcnRCb29rcxIcLmJvb2tzLnYxLkltcG9ydEJvb2tzUmVxdWVzdBodLmJvb2tzLnYxLkltcG9ydEJvb2tzUmVzcG9uc2USUAoNRXhwb3J0TGlicmFyeRIeLmJvb2tzLnYxLkV4cG9ydExpYnJhcnlSZXF1ZXN0Gh8uYm9va3MudjEuRXhwb3J0TGlicmFyeVJlc3BvbnNlElMKDkZpbmREdXBsaWNhdGVzEh8uYm9va3MudjEuRmluZER1cGxpY2F0ZXNSZXF1ZXN0GiAuYm9va3MudjEuRmluZER1cGxpY2F0ZXNSZXNwb25zZRJHCgpNZXJnZUJvb2tzEhsuYm9va3MudjEuTWVyZ2VCb29rc1JlcXVlc3QaHC5ib29rcy52MS5NZXJnZUJvb2tzUmVzcG9uc2USSgoLU3RhcnRSZXN5bmMSHC5ib29rcy52MS5TdGFydFJlc3luY1JlcXVlc3QaHS5ib29rcy52MS5TdGFydFJlc3luY1Jlc3BvbnNlEk0KDENhbmNlbFJlc3luYxIdLmJvb2tzLnYxLkNhbmNlbFJlc3luY1JlcXVlc3QaHi5ib29rcy52MS5DYW5jZWxSZXN5bmNSZXNwb25zZRJiChNMaXN0UmVzeW5jUHJvcG9zYWxzEiQuYm9va3MudjEuTGlzdFJlc3luY1Byb3Bvc2Fsc1JlcXVlc3QaJS5ib29rcy52MS5MaXN0UmVzeW5jUHJvcG9zYWxzUmVzcG9uc2USXAoRQXBwbHlSZXN5bmNDaG9pY2USIi5ib29rcy52MS5BcHBseVJlc3luY0Nob2ljZVJlcXVlc3QaIy5ib29rcy52MS5BcHBseVJlc3luY0Nob2ljZVJlc3BvbnNlEkoKC1NldEJvb2tJU0JOEhwuYm9va3MudjEuU2V0Qm9va0lTQk5SZXF1ZXN0Gh0uYm9va3MudjEuU2V0Qm9va0lTQk5SZXNwb25zZRJHCgpVcGRhdGVCb29rEhsuYm9va3MudjEuVXBkYXRlQm9va1JlcXVlc3QaHC5ib29rcy52MS5VcGRhdGVCb29rUmVzcG9uc2USUwoOR2V0Qm9va1NvdXJjZXMSHy5ib29rcy52MS5HZXRCb29rU291cmNlc1JlcXVlc3QaIC5ib29rcy52MS5HZXRCb29rU291cmNlc1Jlc3BvbnNlElYKD0FwcGx5Qm9va1NvdXJjZRIgLmJvb2tzLnYxLkFwcGx5Qm9va1NvdXJjZVJlcXVlc3QaIS5ib29rcy52MS5BcHBseUJvb2tTb3VyY2VSZXNwb25zZRJTCg5HZXRTb3VyY2VTdGF0cxIfLmJvb2tzLnYxLkdldFNvdXJjZVN0YXRzUmVxdWVzdBogLmJvb2tzLnYxLkdldFNvdXJjZVN0YXRzUmVzcG9uc2USbgoXTGlzdEJvb2tzSW5FeGFjdFNvdXJjZXMSKC5ib29rcy52MS5MaXN0Qm9va3NJbkV4YWN0U291cmNlc1JlcXVlc3QaKS5ib29rcy52MS5MaXN0Qm9va3NJbkV4YWN0U291cmNlc1Jlc3BvbnNlQilaJ3Rvb2xzLnhkb3VibGV1LmNvbS9nZW4vYm9va3MvdjE7Ym9va3N2MWIGcHJvdG8z", [file_books_v1_library]);
 
 /**
+ * ImportBooks reads another service's library export. format is one of
+ * "goodreads", "storygraph", "librarything" (TSV or JSON), "calibre" (a
+ * metadata.db) or "backup" (an ExportLibrary JSON file); empty detects it
+ * from the data. With dry_run set nothing is written and the response only
+ * previews what an import would do.
+ *
  * @generated from message books.v1.ImportBooksRequest
  */
 export type ImportBooksRequest = Message<"books.v1.ImportBooksRequest"> & {
   /**
-   * @generated from field: bytes csv_data = 1;
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: string format = 2;
+   */
+  format: string;
+
+  /**
+   * @generated from field: bool dry_run = 3;
    */
-  csvData: Uint8Array;
+  dryRun: boolean;
 };
 
 /**
@@ -31,14 +47,90 @@ export type ImportBooksRequest = Message<"books.v1.ImportBooksRequest"> & {
 export const ImportBooksRequestSchema: GenMessage<ImportBooksRequest> = /*@__PURE__*/
   messageDesc(file_books_v1_catalog, 0);
 
+/**
+ * ImportPlanItem is what happens to one entry of an import file.
+ *
+ * @generated from message books.v1.ImportPlanItem
+ */
+export type ImportPlanItem = Message<"books.v1.ImportPlanItem"> & {
+  /**
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * @generated from field: repeated string authors = 2;
+   */
+  authors: string[];
+
+  /**
+   * "create" | "merge" | "skip"
+   *
+   * @generated from field: string action = 3;
+   */
+  action: string;
+
+  /**
+   * For merges the matching signal ("isbn13" | "title+author"); for skips
+   * why the entry is left out ("no title" | "duplicate in file" |
+   * "unchanged").
+   *
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+
+  /**
+   * The library book a merge or an unchanged skip refers to.
+   *
+   * @generated from field: string existing_book_id = 5;
+   */
+  existingBookId: string;
+};
+
+/**
+ * Describes the message books.v1.ImportPlanItem.
+ * Use `create(ImportPlanItemSchema)` to create a new message.
+ */
+export const ImportPlanItemSchema: GenMessage<ImportPlanItem> = /*@__PURE__*/
+  messageDesc(file_books_v1_catalog, 1);
+
 /**
  * @generated from message books.v1.ImportBooksResponse
  */
 export type ImportBooksResponse = Message<"books.v1.ImportBooksResponse"> & {
   /**
+   * Books created or merged; always 0 on a dry run.
+   *
    * @generated from field: int32 imported_count = 1;
    */
   importedCount: number;
+
+  /**
+   * The format that was used, as detected or requested.
+   *
+   * @generated from field: string format = 2;
+   */
+  format: string;
+
+  /**
+   * @generated from field: int32 created_count = 3;
+   */
+  createdCount: number;
+
+  /**
+   * @generated from field: int32 merged_count = 4;
+   */
+  mergedCount: number;
+
+  /**
+   * @generated from field: int32 skipped_count = 5;
+   */
+  skippedCount: number;
+
+  /**
+   * @generated from field: repeated books.v1.ImportPlanItem items = 6;
+   */
+  items: ImportPlanItem[];
 };
 
 /**
@@ -46,7 +138,55 @@ export type ImportBooksResponse = Message<"books.v1.ImportBooksResponse"> & {
  * Use `create(ImportBooksResponseSchema)` to create a new message.
  */
 export const ImportBooksResponseSchema: GenMessage<ImportBooksResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 1);
+  messageDesc(file_books_v1_catalog, 2);
+
+/**
+ * ExportLibrary renders the library as a downloadable file. format is "csv"
+ * (default), a Goodreads export ImportBooks reads back, or "json", a
+ * lossless backup that also carries reading state, sessions and annotations.
+ *
+ * @generated from message books.v1.ExportLibraryRequest
+ */
+export type ExportLibraryRequest = Message<"books.v1.ExportLibraryRequest"> & {
+  /**
+   * @generated from field: string format = 1;
+   */
+  format: string;
+};
+
+/**
+ * Describes the message books.v1.ExportLibraryRequest.
+ * Use `create(ExportLibraryRequestSchema)` to create a new message.
+ */
+export const ExportLibraryRequestSchema: GenMessage<ExportLibraryRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_catalog, 3);
+
+/**
+ * @generated from message books.v1.ExportLibraryResponse
+ */
+export type ExportLibraryResponse = Message<"books.v1.ExportLibraryResponse"> & {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: string content_type = 2;
+   */
+  contentType: string;
+
+  /**
+   * @generated from field: string filename = 3;
+   */
+  filename: string;
+};
+
+/**
+ * Describes the message books.v1.ExportLibraryResponse.
+ * Use `create(ExportLibraryResponseSchema)` to create a new message.
+ */
+export const ExportLibraryResponseSchema: GenMessage<ExportLibraryResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_catalog, 4);
 
 /**
  * @generated from message books.v1.DuplicateGroup
@@ -73,7 +213,7 @@ export type DuplicateGroup = Message<"books.v1.DuplicateGroup"> & {
  * Use `create(DuplicateGroupSchema)` to create a new message.
  */
 export const DuplicateGroupSchema: GenMessage<DuplicateGroup> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 2);
+  messageDesc(file_books_v1_catalog, 5);
 
 /**
  * @generated from message books.v1.FindDuplicatesRequest
@@ -86,7 +226,7 @@ export type FindDuplicatesRequest = Message<"books.v1.FindDuplicatesRequest"> &
  * Use `create(FindDuplicatesRequestSchema)` to create a new message.
  */
 export const FindDuplicatesRequestSchema: GenMessage<FindDuplicatesRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 3);
+  messageDesc(file_books_v1_catalog, 6);
 
 /**
  * @generated from message books.v1.FindDuplicatesResponse
@@ -103,7 +243,7 @@ export type FindDuplicatesResponse = Message<"books.v1.FindDuplicatesResponse">
  * Use `create(FindDuplicatesResponseSchema)` to create a new message.
  */
 export const FindDuplicatesResponseSchema: GenMessage<FindDuplicatesResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 4);
+  messageDesc(file_books_v1_catalog, 7);
 
 /**
  * @generated from message books.v1.MergeBooksRequest
@@ -157,7 +297,7 @@ export type MergeBooksRequest = Message<"books.v1.MergeBooksRequest"> & {
  * Use `create(MergeBooksRequestSchema)` to create a new message.
  */
 export const MergeBooksRequestSchema: GenMessage<MergeBooksRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 5);
+  messageDesc(file_books_v1_catalog, 8);
 
 /**
  * @generated from message books.v1.MergeBooksResponse
@@ -183,7 +323,7 @@ export type MergeBooksResponse = Message<"books.v1.MergeBooksResponse"> & {
  * Use `create(MergeBooksResponseSchema)` to create a new message.
  */
 export const MergeBooksResponseSchema: GenMessage<MergeBooksResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 6);
+  messageDesc(file_books_v1_catalog, 9);
 
 /**
  * @generated from message books.v1.StartResyncRequest
@@ -206,7 +346,7 @@ export type StartResyncRequest = Message<"books.v1.StartResyncRequest"> & {
  * Use `create(StartResyncRequestSchema)` to create a new message.
  */
 export const StartResyncRequestSchema: GenMessage<StartResyncRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 7);
+  messageDesc(file_books_v1_catalog, 10);
 
 /**
  * @generated from message books.v1.StartResyncResponse
@@ -219,7 +359,7 @@ export type StartResyncResponse = Message<"books.v1.StartResyncResponse"> & {
  * Use `create(StartResyncResponseSchema)` to create a new message.
  */
 export const StartResyncResponseSchema: GenMessage<StartResyncResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 8);
+  messageDesc(file_books_v1_catalog, 11);
 
 /**
  * CancelResync stops an in-progress resync scan started by StartResync. A
@@ -236,7 +376,7 @@ export type CancelResyncRequest = Message<"books.v1.CancelResyncRequest"> & {
  * Use `create(CancelResyncRequestSchema)` to create a new message.
  */
 export const CancelResyncRequestSchema: GenMessage<CancelResyncRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 9);
+  messageDesc(file_books_v1_catalog, 12);
 
 /**
  * @generated from message books.v1.CancelResyncResponse
@@ -249,7 +389,7 @@ export type CancelResyncResponse = Message<"books.v1.CancelResyncResponse"> & {
  * Use `create(CancelResyncResponseSchema)` to create a new message.
  */
 export const CancelResyncResponseSchema: GenMessage<CancelResyncResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 10);
+  messageDesc(file_books_v1_catalog, 13);
 
 /**
  * SourceBook is one candidate set of metadata for a catalog book — either the
@@ -312,6 +452,21 @@ export type SourceBook = Message<"books.v1.SourceBook"> & {
    * @generated from field: int32 index = 9;
    */
   index: number;
+
+  /**
+   * @generated from field: string series = 10;
+   */
+  series: string;
+
+  /**
+   * @generated from field: optional double series_position = 11;
+   */
+  seriesPosition?: number | undefined;
+
+  /**
+   * @generated from field: int32 series_total = 12;
+   */
+  seriesTotal: number;
 };
 
 /**
@@ -319,7 +474,7 @@ export type SourceBook = Message<"books.v1.SourceBook"> & {
  * Use `create(SourceBookSchema)` to create a new message.
  */
 export const SourceBookSchema: GenMessage<SourceBook> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 11);
+  messageDesc(file_books_v1_catalog, 14);
 
 /**
  * ResyncProposal describes one catalog book that differs from at least one
@@ -349,7 +504,7 @@ export type ResyncProposal = Message<"books.v1.ResyncProposal"> & {
  * Use `create(ResyncProposalSchema)` to create a new message.
  */
 export const ResyncProposalSchema: GenMessage<ResyncProposal> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 12);
+  messageDesc(file_books_v1_catalog, 15);
 
 /**
  * @generated from message books.v1.ListResyncProposalsRequest
@@ -362,7 +517,7 @@ export type ListResyncProposalsRequest = Message<"books.v1.ListResyncProposalsRe
  * Use `create(ListResyncProposalsRequestSchema)` to create a new message.
  */
 export const ListResyncProposalsRequestSchema: GenMessage<ListResyncProposalsRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 13);
+  messageDesc(file_books_v1_catalog, 16);
 
 /**
  * @generated from message books.v1.ListResyncProposalsResponse
@@ -379,7 +534,7 @@ export type ListResyncProposalsResponse = Message<"books.v1.ListResyncProposalsR
  * Use `create(ListResyncProposalsResponseSchema)` to create a new message.
  */
 export const ListResyncProposalsResponseSchema: GenMessage<ListResyncProposalsResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 14);
+  messageDesc(file_books_v1_catalog, 17);
 
 /**
  * @generated from message books.v1.ApplyResyncChoiceRequest
@@ -405,7 +560,7 @@ export type ApplyResyncChoiceRequest = Message<"books.v1.ApplyResyncChoiceReques
  * Use `create(ApplyResyncChoiceRequestSchema)` to create a new message.
  */
 export const ApplyResyncChoiceRequestSchema: GenMessage<ApplyResyncChoiceRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 15);
+  messageDesc(file_books_v1_catalog, 18);
 
 /**
  * @generated from message books.v1.ApplyResyncChoiceResponse
@@ -418,7 +573,7 @@ export type ApplyResyncChoiceResponse = Message<"books.v1.ApplyResyncChoiceRespo
  * Use `create(ApplyResyncChoiceResponseSchema)` to create a new message.
  */
 export const ApplyResyncChoiceResponseSchema: GenMessage<ApplyResyncChoiceResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 16);
+  messageDesc(file_books_v1_catalog, 19);
 
 /**
  * @generated from message books.v1.SetBookISBNRequest
@@ -440,7 +595,7 @@ export type SetBookISBNRequest = Message<"books.v1.SetBookISBNRequest"> & {
  * Use `create(SetBookISBNRequestSchema)` to create a new message.
  */
 export const SetBookISBNRequestSchema: GenMessage<SetBookISBNRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 17);
+  messageDesc(file_books_v1_catalog, 20);
 
 /**
  * @generated from message books.v1.SetBookISBNResponse
@@ -453,7 +608,7 @@ export type SetBookISBNResponse = Message<"books.v1.SetBookISBNResponse"> & {
  * Use `create(SetBookISBNResponseSchema)` to create a new message.
  */
 export const SetBookISBNResponseSchema: GenMessage<SetBookISBNResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 18);
+  messageDesc(file_books_v1_catalog, 21);
 
 /**
  * UpdateBook lets an admin hand-correct a catalog book's metadata directly,
@@ -482,7 +637,7 @@ export type UpdateBookRequest = Message<"books.v1.UpdateBookRequest"> & {
  * Use `create(UpdateBookRequestSchema)` to create a new message.
  */
 export const UpdateBookRequestSchema: GenMessage<UpdateBookRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 19);
+  messageDesc(file_books_v1_catalog, 22);
 
 /**
  * @generated from message books.v1.UpdateBookResponse
@@ -499,7 +654,7 @@ export type UpdateBookResponse = Message<"books.v1.UpdateBookResponse"> & {
  * Use `create(UpdateBookResponseSchema)` to create a new message.
  */
 export const UpdateBookResponseSchema: GenMessage<UpdateBookResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 20);
+  messageDesc(file_books_v1_catalog, 23);
 
 /**
  * GetBookSources live-fetches one book's candidates from every configured
@@ -535,7 +690,7 @@ export type GetBookSourcesRequest = Message<"books.v1.GetBookSourcesRequest"> &
  * Use `create(GetBookSourcesRequestSchema)` to create a new message.
  */
 export const GetBookSourcesRequestSchema: GenMessage<GetBookSourcesRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 21);
+  messageDesc(file_books_v1_catalog, 24);
 
 /**
  * @generated from message books.v1.GetBookSourcesResponse
@@ -552,7 +707,7 @@ export type GetBookSourcesResponse = Message<"books.v1.GetBookSourcesResponse">
  * Use `create(GetBookSourcesResponseSchema)` to create a new message.
  */
 export const GetBookSourcesResponseSchema: GenMessage<GetBookSourcesResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 22);
+  messageDesc(file_books_v1_catalog, 25);
 
 /**
  * ApplyBookSource live-fetches the book's sources and applies the chosen one
@@ -602,7 +757,7 @@ export type ApplyBookSourceRequest = Message<"books.v1.ApplyBookSourceRequest">
  * Use `create(ApplyBookSourceRequestSchema)` to create a new message.
  */
 export const ApplyBookSourceRequestSchema: GenMessage<ApplyBookSourceRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 23);
+  messageDesc(file_books_v1_catalog, 26);
 
 /**
  * @generated from message books.v1.ApplyBookSourceResponse
@@ -615,7 +770,7 @@ export type ApplyBookSourceResponse = Message<"books.v1.ApplyBookSourceResponse"
  * Use `create(ApplyBookSourceResponseSchema)` to create a new message.
  */
 export const ApplyBookSourceResponseSchema: GenMessage<ApplyBookSourceResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 24);
+  messageDesc(file_books_v1_catalog, 27);
 
 /**
  * GetSourceStats reports per-source coverage over the whole catalog, for
@@ -631,7 +786,7 @@ export type GetSourceStatsRequest = Message<"books.v1.GetSourceStatsRequest"> &
  * Use `create(GetSourceStatsRequestSchema)` to create a new message.
  */
 export const GetSourceStatsRequestSchema: GenMessage<GetSourceStatsRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 25);
+  messageDesc(file_books_v1_catalog, 28);
 
 /**
  * @generated from message books.v1.SourceStat
@@ -672,7 +827,7 @@ export type SourceStat = Message<"books.v1.SourceStat"> & {
  * Use `create(SourceStatSchema)` to create a new message.
  */
 export const SourceStatSchema: GenMessage<SourceStat> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 26);
+  messageDesc(file_books_v1_catalog, 29);
 
 /**
  * SourceComboStat reports how many books were found by exactly this set of
@@ -701,7 +856,7 @@ export type SourceComboStat = Message<"books.v1.SourceComboStat"> & {
  * Use `create(SourceComboStatSchema)` to create a new message.
  */
 export const SourceComboStatSchema: GenMessage<SourceComboStat> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 27);
+  messageDesc(file_books_v1_catalog, 30);
 
 /**
  * @generated from message books.v1.GetSourceStatsResponse
@@ -753,7 +908,7 @@ export type GetSourceStatsResponse = Message<"books.v1.GetSourceStatsResponse">
  * Use `create(GetSourceStatsResponseSchema)` to create a new message.
  */
 export const GetSourceStatsResponseSchema: GenMessage<GetSourceStatsResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 28);
+  messageDesc(file_books_v1_catalog, 31);
 
 /**
  * ListBooksInExactSources lists the catalog books found by exactly the given
@@ -777,7 +932,7 @@ export type ListBooksInExactSourcesRequest = Message<"books.v1.ListBooksInExactS
  * Use `create(ListBooksInExactSourcesRequestSchema)` to create a new message.
  */
 export const ListBooksInExactSourcesRequestSchema: GenMessage<ListBooksInExactSourcesRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 29);
+  messageDesc(file_books_v1_catalog, 32);
 
 /**
  * @generated from message books.v1.ListBooksInExactSourcesResponse
@@ -794,7 +949,7 @@ export type ListBooksInExactSourcesResponse = Message<"books.v1.ListBooksInExact
  * Use `create(ListBooksInExactSourcesResponseSchema)` to create a new message.
  */
 export const ListBooksInExactSourcesResponseSchema: GenMessage<ListBooksInExactSourcesResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_catalog, 30);
+  messageDesc(file_books_v1_catalog, 33);
 
 /**
  * @generated from service books.v1.CatalogService
@@ -808,6 +963,14 @@ export const CatalogService: GenService<{
     input: typeof ImportBooksRequestSchema;
     output: typeof ImportBooksResponseSchema;
   },
+  /**
+   * @generated from rpc books.v1.CatalogService.ExportLibrary
+   */
+  exportLibrary: {
+    methodKind: "unary";
+    input: typeof ExportLibraryRequestSchema;
+    output: typeof ExportLibraryResponseSchema;
+  },
   /**
    * @generated from rpc books.v1.CatalogService.FindDuplicates
    */
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file books/v1/files.proto.
  */
 export const file_books_v1_files: GenFile = /*@__PURE__*/
-  fileDesc("ChRib29rcy92MS9maWxlcy5wcm90bxIIYm9va3MudjEiYQoXQ3JlYXRlQm9va1VwbG9hZFJlcXVlc3QSEAoIZmlsZW5hbWUYASABKAkSFAoMY29udGVudF90eXBlGAIgASgJEgwKBHNpemUYAyABKAMSEAoIY2hlY2tzdW0YBCABKAkiUgoYQ3JlYXRlQm9va1VwbG9hZFJlc3BvbnNlEhEKCXVwbG9hZF9pZBgBIAEoCRILCgN1cmwYAiABKAkSFgoOYWxyZWFkeV9leGlzdHMYAyABKAgimQEKGUZpbmFsaXplQm9va1VwbG9hZFJlcXVlc3QSEQoJdXBsb2FkX2lkGAEgASgJEhAKCGZpbGVuYW1lGAIgASgJEhQKDGNvbnRlbnRfdHlwZRgDIAEoCRIQCghjaGVja3N1bRgEIAEoCRIWCg50aXRsZV9vdmVycmlkZRgFIAEoCRIXCg9hdXRob3Jfb3ZlcnJpZGUYBiABKAkiggEKGkZpbmFsaXplQm9va1VwbG9hZFJlc3BvbnNlEg8KB2Jvb2tfaWQYASABKAkSDwoHZmlsZV9pZBgCIAEoCRIYChByZWNvZ25pemVkX3RpdGxlGAMgASgJEhgKEG1hdGNoZWRfZXhpc3RpbmcYBCABKAgSDgoGZm9ybWF0GAUgASgJIjUKEkdldEJvb2tGaWxlUmVxdWVzdBIPCgdib29rX2lkGAEgASgJEg4KBmZvcm1hdBgCIAEoCSJGChNHZXRCb29rRmlsZVJlc3BvbnNlEgsKA3VybBgBIAEoCRISCgpleHBpcmVzX2F0GAIgASgJEg4KBmZvcm1hdBgDIAEoCSIwCh1SZXF1ZXN0S0VQVUJDb252ZXJzaW9uUmVxdWVzdBIPCgdib29rX2lkGAEgASgJIjYKHlJlcXVlc3RLRVBVQkNvbnZlcnNpb25SZXNwb25zZRIUCgxrZXB1Yl9zdGF0dXMYASABKAkiKAoVR2V0S0VQVUJTdGF0dXNSZXF1ZXN0Eg8KB2Jvb2tfaWQYASABKAkiUQoWR2V0S0VQVUJTdGF0dXNSZXNwb25zZRIQCghoYXNfZXB1YhgBIAEoCBIUCgxrZXB1Yl9zdGF0dXMYAiABKAkSDwoHaGFzX3BkZhgDIAEoCDLcAwoQQm9va0ZpbGVzU2VydmljZRJZChBDcmVhdGVCb29rVXBsb2FkEiEuYm9va3MudjEuQ3JlYXRlQm9va1VwbG9hZFJlcXVlc3QaIi5ib29rcy52MS5DcmVhdGVCb29rVXBsb2FkUmVzcG9uc2USXwoSRmluYWxpemVCb29rVXBsb2FkEiMuYm9va3MudjEuRmluYWxpemVCb29rVXBsb2FkUmVxdWVzdBokLmJvb2tzLnYxLkZpbmFsaXplQm9va1VwbG9hZFJlc3BvbnNlEkoKC0dldEJvb2tGaWxlEhwuYm9va3MudjEuR2V0Qm9va0ZpbGVSZXF1ZXN0Gh0uYm9va3MudjEuR2V0Qm9va0ZpbGVSZXNwb25zZRJrChZSZXF1ZXN0S0VQVUJDb252ZXJzaW9uEicuYm9va3MudjEuUmVxdWVzdEtFUFVCQ29udmVyc2lvblJlcXVlc3QaKC5ib29rcy52MS5SZXF1ZXN0S0VQVUJDb252ZXJzaW9uUmVzcG9uc2USUwoOR2V0S0VQVUJTdGF0dXMSHy5ib29rcy52MS5HZXRLRVBVQlN0YXR1c1JlcXVlc3QaIC5ib29rcy52MS5HZXRLRVBVQlN0YXR1c1Jlc3BvbnNlQilaJ3Rvb2xzLnhkb3VibGV1LmNvbS9nZW4vYm9va3MvdjE7Ym9va3N2MWIGcHJvdG8z");
+  fileDesc("ChRib29rcy92MS9maWxlcy5wcm90bxIIYm9va3MudjEiYQoXQ3JlYXRlQm9va1VwbG9hZFJlcXVlc3QSEAoIZmlsZW5hbWUYASABKAkSFAoMY29udGVudF90eXBlGAIgASgJEgwKBHNpemUYAyABKAMSEAoIY2hlY2tzdW0YBCABKAkiUgoYQ3JlYXRlQm9va1VwbG9hZFJlc3BvbnNlEhEKCXVwbG9hZF9pZBgBIAEoCRILCgN1cmwYAiABKAkSFgoOYWxyZWFkeV9leGlzdHMYAyABKAgimQEKGUZpbmFsaXplQm9va1VwbG9hZFJlcXVlc3QSEQoJdXBsb2FkX2lkGAEgASgJEhAKCGZpbGVuYW1lGAIgASgJEhQKDGNvbnRlbnRfdHlwZRgDIAEoCRIQCghjaGVja3N1bRgEIAEoCRIWCg50aXRsZV9vdmVycmlkZRgFIAEoCRIXCg9hdXRob3Jfb3ZlcnJpZGUYBiABKAkiggEKGkZpbmFsaXplQm9va1VwbG9hZFJlc3BvbnNlEg8KB2Jvb2tfaWQYASABKAkSDwoHZmlsZV9pZBgCIAEoCRIYChByZWNvZ25pemVkX3RpdGxlGAMgASgJEhgKEG1hdGNoZWRfZXhpc3RpbmcYBCABKAgSDgoGZm9ybWF0GAUgASgJIjUKEkdldEJvb2tGaWxlUmVxdWVzdBIPCgdib29rX2lkGAEgASgJEg4KBmZvcm1hdBgCIAEoCSJGChNHZXRCb29rRmlsZVJlc3BvbnNlEgsKA3VybBgBIAEoCRISCgpleHBpcmVzX2F0GAIgASgJEg4KBmZvcm1hdBgDIAEoCSIwCh1SZXF1ZXN0S0VQVUJDb252ZXJzaW9uUmVxdWVzdBIPCgdib29rX2lkGAEgASgJIjYKHlJlcXVlc3RLRVBVQkNvbnZlcnNpb25SZXNwb25zZRIUCgxrZXB1Yl9zdGF0dXMYASABKAkiKAoVR2V0S0VQVUJTdGF0dXNSZXF1ZXN0Eg8KB2Jvb2tfaWQYASABKAkiYgoWR2V0S0VQVUJTdGF0dXNSZXNwb25zZRIQCghoYXNfZXB1YhgBIAEoCBIUCgxrZXB1Yl9zdGF0dXMYAiABKAkSDwoHaGFzX3BkZhgDIAEoCBIPCgdoYXNfY2J6GAQgASgIMtwDChBCb29rRmlsZXNTZXJ2aWNlElkKEENyZWF0ZUJvb2tVcGxvYWQSIS5ib29rcy52MS5DcmVhdGVCb29rVXBsb2FkUmVxdWVzdBoiLmJvb2tzLnYxLkNyZWF0ZUJvb2tVcGxvYWRSZXNwb25zZRJfChJGaW5hbGl6ZUJvb2tVcGxvYWQSIy5ib29rcy52MS5GaW5hbGl6ZUJvb2tVcGxvYWRSZXF1ZXN0GiQuYm9va3MudjEuRmluYWxpemVCb29rVXBsb2FkUmVzcG9uc2USSgoLR2V0Qm9va0ZpbGUSHC5ib29rcy52MS5HZXRCb29rRmlsZVJlcXVlc3QaHS5ib29rcy52MS5HZXRCb29rRmlsZVJlc3BvbnNlEmsKFlJlcXVlc3RLRVBVQkNvbnZlcnNpb24SJy5ib29rcy52MS5SZXF1ZXN0S0VQVUJDb252ZXJzaW9uUmVxdWVzdBooLmJvb2tzLnYxLlJlcXVlc3RLRVBVQkNvbnZlcnNpb25SZXNwb25zZRJTCg5HZXRLRVBVQlN0YXR1cxIfLmJvb2tzLnYxLkdldEtFUFVCU3RhdHVzUmVxdWVzdBogLmJvb2tzLnYxLkdldEtFUFVCU3RhdHVzUmVzcG9uc2VCKVondG9vbHMueGRvdWJsZXUuY29tL2dlbi9ib29rcy92MTtib29rc3YxYgZwcm90bzM");
 
 /**
  * @generated from message books.v1.CreateBookUploadRequest
@@ -282,6 +282,11 @@ export type GetKEPUBStatusResponse = Message<"books.v1.GetKEPUBStatusResponse">
    * @generated from field: bool has_pdf = 3;
    */
   hasPdf: boolean;
+
+  /**
+   * @generated from field: bool has_cbz = 4;
+   */
+  hasCbz: boolean;
 };
 
 /**
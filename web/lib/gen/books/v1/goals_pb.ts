@@ -0,0 +1,236 @@
+// @generated by protoc-gen-es v2.14.0 with parameter "target=ts,import_extension=none"
+// @generated from file books/v1/goals.proto (package books.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file books/v1/goals.proto.
+ */
+export const file_books_v1_goals: GenFile = /*@__PURE__*/
+  fileDesc("ChRib29rcy92MS9nb2Fscy5wcm90bxIIYm9va3MudjEiSQoLUmVhZGluZ0dvYWwSDAoEeWVhchgBIAEoBRIMCgRraW5kGAIgASgJEg4KBnRhcmdldBgDIAEoBRIOCgZwdWJsaWMYBCABKAgifgoTUmVhZGluZ0dvYWxQcm9ncmVzcxIjCgRnb2FsGAEgASgLMhUuYm9va3MudjEuUmVhZGluZ0dvYWwSDwoHY3VycmVudBgCIAEoBRIQCghleHBlY3RlZBgDIAEoARIRCglwcm9qZWN0ZWQYBCABKAESDAoEcGFjZRgFIAEoCSI8ChVTZXRSZWFkaW5nR29hbFJlcXVlc3QSIwoEZ29hbBgBIAEoCzIVLmJvb2tzLnYxLlJlYWRpbmdHb2FsIj0KFlNldFJlYWRpbmdHb2FsUmVzcG9uc2USIwoEZ29hbBgBIAEoCzIVLmJvb2tzLnYxLlJlYWRpbmdHb2FsIjYKGERlbGV0ZVJlYWRpbmdHb2FsUmVxdWVzdBIMCgR5ZWFyGAEgASgFEgwKBGtpbmQYAiABKAkiGwoZRGVsZXRlUmVhZGluZ0dvYWxSZXNwb25zZSImChZHZXRSZWFkaW5nR29hbHNSZXF1ZXN0EgwKBHllYXIYASABKAUiVQoXR2V0UmVhZGluZ0dvYWxzUmVzcG9uc2USDAoEeWVhchgBIAEoBRIsCgVnb2FscxgCIAMoCzIdLmJvb2tzLnYxLlJlYWRpbmdHb2FsUHJvZ3Jlc3MymQIKDEdvYWxzU2VydmljZRJTCg5TZXRSZWFkaW5nR29hbBIfLmJvb2tzLnYxLlNldFJlYWRpbmdHb2FsUmVxdWVzdBogLmJvb2tzLnYxLlNldFJlYWRpbmdHb2FsUmVzcG9uc2USXAoRRGVsZXRlUmVhZGluZ0dvYWwSIi5ib29rcy52MS5EZWxldGVSZWFkaW5nR29hbFJlcXVlc3QaIy5ib29rcy52MS5EZWxldGVSZWFkaW5nR29hbFJlc3BvbnNlElYKD0dldFJlYWRpbmdHb2FscxIgLmJvb2tzLnYxLkdldFJlYWRpbmdHb2Fsc1JlcXVlc3QaIS5ib29rcy52MS5HZXRSZWFkaW5nR29hbHNSZXNwb25zZUIpWid0b29scy54ZG91YmxldS5jb20vZ2VuL2Jvb2tzL3YxO2Jvb2tzdjFiBnByb3RvMw");
+
+/**
+ * ReadingGoal is a target of books or pages to finish in a calendar year,
+ * one of each kind per year. Public goals show on the shared reading
+ * dashboard.
+ *
+ * @generated from message books.v1.ReadingGoal
+ */
+export type ReadingGoal = Message<"books.v1.ReadingGoal"> & {
+  /**
+   * @generated from field: int32 year = 1;
+   */
+  year: number;
+
+  /**
+   * "books" or "pages".
+   *
+   * @generated from field: string kind = 2;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: int32 target = 3;
+   */
+  target: number;
+
+  /**
+   * @generated from field: bool public = 4;
+   */
+  public: boolean;
+};
+
+/**
+ * Describes the message books.v1.ReadingGoal.
+ * Use `create(ReadingGoalSchema)` to create a new message.
+ */
+export const ReadingGoalSchema: GenMessage<ReadingGoal> = /*@__PURE__*/
+  messageDesc(file_books_v1_goals, 0);
+
+/**
+ * ReadingGoalProgress is a goal with the books (or pages of books) finished
+ * so far that year. expected is where an even pace through the year would
+ * be today and projected the year-end total at the pace so far; pace is
+ * "ahead", "on-track" or "behind".
+ *
+ * @generated from message books.v1.ReadingGoalProgress
+ */
+export type ReadingGoalProgress = Message<"books.v1.ReadingGoalProgress"> & {
+  /**
+   * @generated from field: books.v1.ReadingGoal goal = 1;
+   */
+  goal?: ReadingGoal | undefined;
+
+  /**
+   * @generated from field: int32 current = 2;
+   */
+  current: number;
+
+  /**
+   * @generated from field: double expected = 3;
+   */
+  expected: number;
+
+  /**
+   * @generated from field: double projected = 4;
+   */
+  projected: number;
+
+  /**
+   * @generated from field: string pace = 5;
+   */
+  pace: string;
+};
+
+/**
+ * Describes the message books.v1.ReadingGoalProgress.
+ * Use `create(ReadingGoalProgressSchema)` to create a new message.
+ */
+export const ReadingGoalProgressSchema: GenMessage<ReadingGoalProgress> = /*@__PURE__*/
+  messageDesc(file_books_v1_goals, 1);
+
+/**
+ * @generated from message books.v1.SetReadingGoalRequest
+ */
+export type SetReadingGoalRequest = Message<"books.v1.SetReadingGoalRequest"> & {
+  /**
+   * @generated from field: books.v1.ReadingGoal goal = 1;
+   */
+  goal?: ReadingGoal | undefined;
+};
+
+/**
+ * Describes the message books.v1.SetReadingGoalRequest.
+ * Use `create(SetReadingGoalRequestSchema)` to create a new message.
+ */
+export const SetReadingGoalRequestSchema: GenMessage<SetReadingGoalRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_goals, 2);
+
+/**
+ * @generated from message books.v1.SetReadingGoalResponse
+ */
+export type SetReadingGoalResponse = Message<"books.v1.SetReadingGoalResponse"> & {
+  /**
+   * @generated from field: books.v1.ReadingGoal goal = 1;
+   */
+  goal?: ReadingGoal | undefined;
+};
+
+/**
+ * Describes the message books.v1.SetReadingGoalResponse.
+ * Use `create(SetReadingGoalResponseSchema)` to create a new message.
+ */
+export const SetReadingGoalResponseSchema: GenMessage<SetReadingGoalResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_goals, 3);
+
+/**
+ * @generated from message books.v1.DeleteReadingGoalRequest
+ */
+export type DeleteReadingGoalRequest = Message<"books.v1.DeleteReadingGoalRequest"> & {
+  /**
+   * @generated from field: int32 year = 1;
+   */
+  year: number;
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind: string;
+};
+
+/**
+ * Describes the message books.v1.DeleteReadingGoalRequest.
+ * Use `create(DeleteReadingGoalRequestSchema)` to create a new message.
+ */
+export const DeleteReadingGoalRequestSchema: GenMessage<DeleteReadingGoalRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_goals, 4);
+
+/**
+ * @generated from message books.v1.DeleteReadingGoalResponse
+ */
+export type DeleteReadingGoalResponse = Message<"books.v1.DeleteReadingGoalResponse"> & {
+};
+
+/**
+ * Describes the message books.v1.DeleteReadingGoalResponse.
+ * Use `create(DeleteReadingGoalResponseSchema)` to create a new message.
+ */
+export const DeleteReadingGoalResponseSchema: GenMessage<DeleteReadingGoalResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_goals, 5);
+
+/**
+ * GetReadingGoals returns the goals for a year (default: the current one)
+ * with their progress.
+ *
+ * @generated from message books.v1.GetReadingGoalsRequest
+ */
+export type GetReadingGoalsRequest = Message<"books.v1.GetReadingGoalsRequest"> & {
+  /**
+   * @generated from field: int32 year = 1;
+   */
+  year: number;
+};
+
+/**
+ * Describes the message books.v1.GetReadingGoalsRequest.
+ * Use `create(GetReadingGoalsRequestSchema)` to create a new message.
+ */
+export const GetReadingGoalsRequestSchema: GenMessage<GetReadingGoalsRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_goals, 6);
+
+/**
+ * @generated from message books.v1.GetReadingGoalsResponse
+ */
+export type GetReadingGoalsResponse = Message<"books.v1.GetReadingGoalsResponse"> & {
+  /**
+   * @generated from field: int32 year = 1;
+   */
+  year: number;
+
+  /**
+   * @generated from field: repeated books.v1.ReadingGoalProgress goals = 2;
+   */
+  goals: ReadingGoalProgress[];
+};
+
+/**
+ * Describes the message books.v1.GetReadingGoalsResponse.
+ * Use `create(GetReadingGoalsResponseSchema)` to create a new message.
+ */
+export const GetReadingGoalsResponseSchema: GenMessage<GetReadingGoalsResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_goals, 7);
+
+/**
+ * @generated from service books.v1.GoalsService
+ */
+export const GoalsService: GenService<{
+  /**
+   * @generated from rpc books.v1.GoalsService.SetReadingGoal
+   */
+  setReadingGoal: {
+    methodKind: "unary";
+    input: typeof SetReadingGoalRequestSchema;
+    output: typeof SetReadingGoalResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.GoalsService.DeleteReadingGoal
+   */
+  deleteReadingGoal: {
+    methodKind: "unary";
+    input: typeof DeleteReadingGoalRequestSchema;
+    output: typeof DeleteReadingGoalResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.GoalsService.GetReadingGoals
+   */
+  getReadingGoals: {
+    methodKind: "unary";
+    input: typeof GetReadingGoalsRequestSchema;
+    output: typeof GetReadingGoalsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_books_v1_goals, 0);
+
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file books/v1/kobo.proto.
  */
 export const file_books_v1_kobo: GenFile = /*@__PURE__*/
-  fileDesc("ChNib29rcy92MS9rb2JvLnByb3RvEghib29rcy52MSJ5CgpLb2JvRGV2aWNlEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSDgoGc2VyaWFsGAMgASgJEhIKCmNyZWF0ZWRfYXQYBCABKAkSFAoMbGFzdF9zZWVuX2F0GAUgASgJEhcKD2xvZ2dpbmdfZW5hYmxlZBgGIAEoCCKGAQoMS29ib0xvZ0VudHJ5EgwKBHRpbWUYASABKAkSDgoGbWV0aG9kGAIgASgJEgwKBHBhdGgYAyABKAkSDQoFcXVlcnkYBCABKAkSFAoMcmVxdWVzdF9ib2R5GAUgASgJEg4KBnN0YXR1cxgGIAEoBRIVCg1yZXNwb25zZV9ib2R5GAcgASgJIigKFUVuYWJsZUtvYm9TeW5jUmVxdWVzdBIPCgdib29rX2lkGAEgASgJIi4KFkVuYWJsZUtvYm9TeW5jUmVzcG9uc2USFAoMa2VwdWJfc3RhdHVzGAEgASgJIjkKGVJlZ2lzdGVyS29ib0RldmljZVJlcXVlc3QSDAoEbmFtZRgBIAEoCRIOCgZzZXJpYWwYAiABKAkiVQoaUmVnaXN0ZXJLb2JvRGV2aWNlUmVzcG9uc2USJAoGZGV2aWNlGAEgASgLMhQuYm9va3MudjEuS29ib0RldmljZRIRCglyYXdfdG9rZW4YAiABKAkiGAoWTGlzdEtvYm9EZXZpY2VzUmVxdWVzdCJAChdMaXN0S29ib0RldmljZXNSZXNwb25zZRIlCgdkZXZpY2VzGAEgAygLMhQuYm9va3MudjEuS29ib0RldmljZSIpChtEaXNjb25uZWN0S29ib0RldmljZVJlcXVlc3QSCgoCaWQYASABKAkiHgocRGlzY29ubmVjdEtvYm9EZXZpY2VSZXNwb25zZSI6ChtTZXRLb2JvRGV2aWNlTG9nZ2luZ1JlcXVlc3QSCgoCaWQYASABKAkSDwoHZW5hYmxlZBgCIAEoCCIeChxTZXRLb2JvRGV2aWNlTG9nZ2luZ1Jlc3BvbnNlIiYKGEdldEtvYm9EZXZpY2VMb2dzUmVxdWVzdBIKCgJpZBgBIAEoCSJEChlHZXRLb2JvRGV2aWNlTG9nc1Jlc3BvbnNlEicKB2VudHJpZXMYASADKAsyFi5ib29rcy52MS5Lb2JvTG9nRW50cnkiKAoaQ2xlYXJLb2JvRGV2aWNlTG9nc1JlcXVlc3QSCgoCaWQYASABKAkiHQobQ2xlYXJLb2JvRGV2aWNlTG9nc1Jlc3BvbnNlMqsFCgtLb2JvU2VydmljZRJTCg5FbmFibGVLb2JvU3luYxIfLmJvb2tzLnYxLkVuYWJsZUtvYm9TeW5jUmVxdWVzdBogLmJvb2tzLnYxLkVuYWJsZUtvYm9TeW5jUmVzcG9uc2USXwoSUmVnaXN0ZXJLb2JvRGV2aWNlEiMuYm9va3MudjEuUmVnaXN0ZXJLb2JvRGV2aWNlUmVxdWVzdBokLmJvb2tzLnYxLlJlZ2lzdGVyS29ib0RldmljZVJlc3BvbnNlElYKD0xpc3RLb2JvRGV2aWNlcxIgLmJvb2tzLnYxLkxpc3RLb2JvRGV2aWNlc1JlcXVlc3QaIS5ib29rcy52MS5MaXN0S29ib0RldmljZXNSZXNwb25zZRJlChREaXNjb25uZWN0S29ib0RldmljZRIlLmJvb2tzLnYxLkRpc2Nvbm5lY3RLb2JvRGV2aWNlUmVxdWVzdBomLmJvb2tzLnYxLkRpc2Nvbm5lY3RLb2JvRGV2aWNlUmVzcG9uc2USZQoUU2V0S29ib0RldmljZUxvZ2dpbmcSJS5ib29rcy52MS5TZXRLb2JvRGV2aWNlTG9nZ2luZ1JlcXVlc3QaJi5ib29rcy52MS5TZXRLb2JvRGV2aWNlTG9nZ2luZ1Jlc3BvbnNlElwKEUdldEtvYm9EZXZpY2VMb2dzEiIuYm9va3MudjEuR2V0S29ib0RldmljZUxvZ3NSZXF1ZXN0GiMuYm9va3MudjEuR2V0S29ib0RldmljZUxvZ3NSZXNwb25zZRJiChNDbGVhcktvYm9EZXZpY2VMb2dzEiQuYm9va3MudjEuQ2xlYXJLb2JvRGV2aWNlTG9nc1JlcXVlc3QaJS5ib29rcy52MS5DbGVhcktvYm9EZXZpY2VMb2dzUmVzcG9uc2VCKVondG9vbHMueGRvdWJsZXUuY29tL2dlbi9ib29rcy92MTtib29rc3YxYgZwcm90bzM");
+  fileDesc("ChNib29rcy92MS9rb2JvLnByb3RvEghib29rcy52MSKiAQoKS29ib0RldmljZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg4KBnNlcmlhbBgDIAEoCRISCgpjcmVhdGVkX2F0GAQgASgJEhQKDGxhc3Rfc2Vlbl9hdBgFIAEoCRIXCg9sb2dnaW5nX2VuYWJsZWQYBiABKAgSFAoMc3luY19zaGVsdmVzGAcgAygJEhEKCXN5bmNfdGFncxgIIAMoCSKGAQoMS29ib0xvZ0VudHJ5EgwKBHRpbWUYASABKAkSDgoGbWV0aG9kGAIgASgJEgwKBHBhdGgYAyABKAkSDQoFcXVlcnkYBCABKAkSFAoMcmVxdWVzdF9ib2R5GAUgASgJEg4KBnN0YXR1cxgGIAEoBRIVCg1yZXNwb25zZV9ib2R5GAcgASgJIigKFUVuYWJsZUtvYm9TeW5jUmVxdWVzdBIPCgdib29rX2lkGAEgASgJIi4KFkVuYWJsZUtvYm9TeW5jUmVzcG9uc2USFAoMa2VwdWJfc3RhdHVzGAEgASgJImIKGVJlZ2lzdGVyS29ib0RldmljZVJlcXVlc3QSDAoEbmFtZRgBIAEoCRIOCgZzZXJpYWwYAiABKAkSFAoMc3luY19zaGVsdmVzGAMgAygJEhEKCXN5bmNfdGFncxgEIAMoCSJVChpSZWdpc3RlcktvYm9EZXZpY2VSZXNwb25zZRIkCgZkZXZpY2UYASABKAsyFC5ib29rcy52MS5Lb2JvRGV2aWNlEhEKCXJhd190b2tlbhgCIAEoCSIYChZMaXN0S29ib0RldmljZXNSZXF1ZXN0IkAKF0xpc3RLb2JvRGV2aWNlc1Jlc3BvbnNlEiUKB2RldmljZXMYASADKAsyFC5ib29rcy52MS5Lb2JvRGV2aWNlIikKG0Rpc2Nvbm5lY3RLb2JvRGV2aWNlUmVxdWVzdBIKCgJpZBgBIAEoCSIeChxEaXNjb25uZWN0S29ib0RldmljZVJlc3BvbnNlIlEKGlNldEtvYm9EZXZpY2VGaWx0ZXJSZXF1ZXN0EgoKAmlkGAEgASgJEhQKDHN5bmNfc2hlbHZlcxgCIAMoCRIRCglzeW5jX3RhZ3MYAyADKAkiQwobU2V0S29ib0RldmljZUZpbHRlclJlc3BvbnNlEiQKBmRldmljZRgBIAEoCzIULmJvb2tzLnYxLktvYm9EZXZpY2UiOgobU2V0S29ib0RldmljZUxvZ2dpbmdSZXF1ZXN0EgoKAmlkGAEgASgJEg8KB2VuYWJsZWQYAiABKAgiHgocU2V0S29ib0RldmljZUxvZ2dpbmdSZXNwb25zZSImChhHZXRLb2JvRGV2aWNlTG9nc1JlcXVlc3QSCgoCaWQYASABKAkiRAoZR2V0S29ib0RldmljZUxvZ3NSZXNwb25zZRInCgdlbnRyaWVzGAEgAygLMhYuYm9va3MudjEuS29ib0xvZ0VudHJ5IigKGkNsZWFyS29ib0RldmljZUxvZ3NSZXF1ZXN0EgoKAmlkGAEgASgJIh0KG0NsZWFyS29ib0RldmljZUxvZ3NSZXNwb25zZTKPBgoLS29ib1NlcnZpY2USUwoORW5hYmxlS29ib1N5bmMSHy5ib29rcy52MS5FbmFibGVLb2JvU3luY1JlcXVlc3QaIC5ib29rcy52MS5FbmFibGVLb2JvU3luY1Jlc3BvbnNlEl8KElJlZ2lzdGVyS29ib0RldmljZRIjLmJvb2tzLnYxLlJlZ2lzdGVyS29ib0RldmljZVJlcXVlc3QaJC5ib29rcy52MS5SZWdpc3RlcktvYm9EZXZpY2VSZXNwb25zZRJWCg9MaXN0S29ib0RldmljZXMSIC5ib29rcy52MS5MaXN0S29ib0RldmljZXNSZXF1ZXN0GiEuYm9va3MudjEuTGlzdEtvYm9EZXZpY2VzUmVzcG9uc2USZQoURGlzY29ubmVjdEtvYm9EZXZpY2USJS5ib29rcy52MS5EaXNjb25uZWN0S29ib0RldmljZVJlcXVlc3QaJi5ib29rcy52MS5EaXNjb25uZWN0S29ib0RldmljZVJlc3BvbnNlEmIKE1NldEtvYm9EZXZpY2VGaWx0ZXISJC5ib29rcy52MS5TZXRLb2JvRGV2aWNlRmlsdGVyUmVxdWVzdBolLmJvb2tzLnYxLlNldEtvYm9EZXZpY2VGaWx0ZXJSZXNwb25zZRJlChRTZXRLb2JvRGV2aWNlTG9nZ2luZxIlLmJvb2tzLnYxLlNldEtvYm9EZXZpY2VMb2dnaW5nUmVxdWVzdBomLmJvb2tzLnYxLlNldEtvYm9EZXZpY2VMb2dnaW5nUmVzcG9uc2USXAoRR2V0S29ib0RldmljZUxvZ3MSIi5ib29rcy52MS5HZXRLb2JvRGV2aWNlTG9nc1JlcXVlc3QaIy5ib29rcy52MS5HZXRLb2JvRGV2aWNlTG9nc1Jlc3BvbnNlEmIKE0NsZWFyS29ib0RldmljZUxvZ3MSJC5ib29rcy52MS5DbGVhcktvYm9EZXZpY2VMb2dzUmVxdWVzdBolLmJvb2tzLnYxLkNsZWFyS29ib0RldmljZUxvZ3NSZXNwb25zZUIpWid0b29scy54ZG91YmxldS5jb20vZ2VuL2Jvb2tzL3YxO2Jvb2tzdjFiBnByb3RvMw");
 
 /**
  * @generated from message books.v1.KoboDevice
@@ -45,6 +45,19 @@ export type KoboDevice = Message<"books.v1.KoboDevice"> & {
    * @generated from field: bool logging_enabled = 6;
    */
   loggingEnabled: boolean;
+
+  /**
+   * Shelves (including the reading statuses) and tags whose books sync to
+   * this device. Both empty means every kobo-sync book.
+   *
+   * @generated from field: repeated string sync_shelves = 7;
+   */
+  syncShelves: string[];
+
+  /**
+   * @generated from field: repeated string sync_tags = 8;
+   */
+  syncTags: string[];
 };
 
 /**
@@ -151,6 +164,16 @@ export type RegisterKoboDeviceRequest = Message<"books.v1.RegisterKoboDeviceRequ
    * @generated from field: string serial = 2;
    */
   serial: string;
+
+  /**
+   * @generated from field: repeated string sync_shelves = 3;
+   */
+  syncShelves: string[];
+
+  /**
+   * @generated from field: repeated string sync_tags = 4;
+   */
+  syncTags: string[];
 };
 
 /**
@@ -242,6 +265,50 @@ export type DisconnectKoboDeviceResponse = Message<"books.v1.DisconnectKoboDevic
 export const DisconnectKoboDeviceResponseSchema: GenMessage<DisconnectKoboDeviceResponse> = /*@__PURE__*/
   messageDesc(file_books_v1_kobo, 9);
 
+/**
+ * @generated from message books.v1.SetKoboDeviceFilterRequest
+ */
+export type SetKoboDeviceFilterRequest = Message<"books.v1.SetKoboDeviceFilterRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: repeated string sync_shelves = 2;
+   */
+  syncShelves: string[];
+
+  /**
+   * @generated from field: repeated string sync_tags = 3;
+   */
+  syncTags: string[];
+};
+
+/**
+ * Describes the message books.v1.SetKoboDeviceFilterRequest.
+ * Use `create(SetKoboDeviceFilterRequestSchema)` to create a new message.
+ */
+export const SetKoboDeviceFilterRequestSchema: GenMessage<SetKoboDeviceFilterRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_kobo, 10);
+
+/**
+ * @generated from message books.v1.SetKoboDeviceFilterResponse
+ */
+export type SetKoboDeviceFilterResponse = Message<"books.v1.SetKoboDeviceFilterResponse"> & {
+  /**
+   * @generated from field: books.v1.KoboDevice device = 1;
+   */
+  device?: KoboDevice | undefined;
+};
+
+/**
+ * Describes the message books.v1.SetKoboDeviceFilterResponse.
+ * Use `create(SetKoboDeviceFilterResponseSchema)` to create a new message.
+ */
+export const SetKoboDeviceFilterResponseSchema: GenMessage<SetKoboDeviceFilterResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_kobo, 11);
+
 /**
  * @generated from message books.v1.SetKoboDeviceLoggingRequest
  */
@@ -262,7 +329,7 @@ export type SetKoboDeviceLoggingRequest = Message<"books.v1.SetKoboDeviceLogging
  * Use `create(SetKoboDeviceLoggingRequestSchema)` to create a new message.
  */
 export const SetKoboDeviceLoggingRequestSchema: GenMessage<SetKoboDeviceLoggingRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_kobo, 10);
+  messageDesc(file_books_v1_kobo, 12);
 
 /**
  * @generated from message books.v1.SetKoboDeviceLoggingResponse
@@ -275,7 +342,7 @@ export type SetKoboDeviceLoggingResponse = Message<"books.v1.SetKoboDeviceLoggin
  * Use `create(SetKoboDeviceLoggingResponseSchema)` to create a new message.
  */
 export const SetKoboDeviceLoggingResponseSchema: GenMessage<SetKoboDeviceLoggingResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_kobo, 11);
+  messageDesc(file_books_v1_kobo, 13);
 
 /**
  * @generated from message books.v1.GetKoboDeviceLogsRequest
@@ -292,7 +359,7 @@ export type GetKoboDeviceLogsRequest = Message<"books.v1.GetKoboDeviceLogsReques
  * Use `create(GetKoboDeviceLogsRequestSchema)` to create a new message.
  */
 export const GetKoboDeviceLogsRequestSchema: GenMessage<GetKoboDeviceLogsRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_kobo, 12);
+  messageDesc(file_books_v1_kobo, 14);
 
 /**
  * @generated from message books.v1.GetKoboDeviceLogsResponse
@@ -309,7 +376,7 @@ export type GetKoboDeviceLogsResponse = Message<"books.v1.GetKoboDeviceLogsRespo
  * Use `create(GetKoboDeviceLogsResponseSchema)` to create a new message.
  */
 export const GetKoboDeviceLogsResponseSchema: GenMessage<GetKoboDeviceLogsResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_kobo, 13);
+  messageDesc(file_books_v1_kobo, 15);
 
 /**
  * @generated from message books.v1.ClearKoboDeviceLogsRequest
@@ -326,7 +393,7 @@ export type ClearKoboDeviceLogsRequest = Message<"books.v1.ClearKoboDeviceLogsRe
  * Use `create(ClearKoboDeviceLogsRequestSchema)` to create a new message.
  */
 export const ClearKoboDeviceLogsRequestSchema: GenMessage<ClearKoboDeviceLogsRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_kobo, 14);
+  messageDesc(file_books_v1_kobo, 16);
 
 /**
  * @generated from message books.v1.ClearKoboDeviceLogsResponse
@@ -339,7 +406,7 @@ export type ClearKoboDeviceLogsResponse = Message<"books.v1.ClearKoboDeviceLogsR
  * Use `create(ClearKoboDeviceLogsResponseSchema)` to create a new message.
  */
 export const ClearKoboDeviceLogsResponseSchema: GenMessage<ClearKoboDeviceLogsResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_kobo, 15);
+  messageDesc(file_books_v1_kobo, 17);
 
 /**
  * @generated from service books.v1.KoboService
@@ -377,6 +444,14 @@ export const KoboService: GenService<{
     input: typeof DisconnectKoboDeviceRequestSchema;
     output: typeof DisconnectKoboDeviceResponseSchema;
   },
+  /**
+   * @generated from rpc books.v1.KoboService.SetKoboDeviceFilter
+   */
+  setKoboDeviceFilter: {
+    methodKind: "unary";
+    input: typeof SetKoboDeviceFilterRequestSchema;
+    output: typeof SetKoboDeviceFilterResponseSchema;
+  },
   /**
    * @generated from rpc books.v1.KoboService.SetKoboDeviceLogging
    */
@@ -0,0 +1,179 @@
+// @generated by protoc-gen-es v2.14.0 with parameter "target=ts,import_extension=none"
+// @generated from file books/v1/koreader.proto (package books.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file books/v1/koreader.proto.
+ */
+export const file_books_v1_koreader: GenFile = /*@__PURE__*/
+  fileDesc("Chdib29rcy92MS9rb3JlYWRlci5wcm90bxIIYm9va3MudjEiWAoOS09SZWFkZXJEZXZpY2USCgoCaWQYASABKAkSEAoIdXNlcm5hbWUYAiABKAkSEgoKY3JlYXRlZF9hdBgDIAEoCRIUCgxsYXN0X3VzZWRfYXQYBCABKAkiMQodUmVnaXN0ZXJLT1JlYWRlckRldmljZVJlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkiXAoeUmVnaXN0ZXJLT1JlYWRlckRldmljZVJlc3BvbnNlEigKBmRldmljZRgBIAEoCzIYLmJvb2tzLnYxLktPUmVhZGVyRGV2aWNlEhAKCHBhc3N3b3JkGAIgASgJIhwKGkxpc3RLT1JlYWRlckRldmljZXNSZXF1ZXN0IkgKG0xpc3RLT1JlYWRlckRldmljZXNSZXNwb25zZRIpCgdkZXZpY2VzGAEgAygLMhguYm9va3MudjEuS09SZWFkZXJEZXZpY2UiLQofRGlzY29ubmVjdEtPUmVhZGVyRGV2aWNlUmVxdWVzdBIKCgJpZBgBIAEoCSIiCiBEaXNjb25uZWN0S09SZWFkZXJEZXZpY2VSZXNwb25zZTLVAgoPS09SZWFkZXJTZXJ2aWNlEmsKFlJlZ2lzdGVyS09SZWFkZXJEZXZpY2USJy5ib29rcy52MS5SZWdpc3RlcktPUmVhZGVyRGV2aWNlUmVxdWVzdBooLmJvb2tzLnYxLlJlZ2lzdGVyS09SZWFkZXJEZXZpY2VSZXNwb25zZRJiChNMaXN0S09SZWFkZXJEZXZpY2VzEiQuYm9va3MudjEuTGlzdEtPUmVhZGVyRGV2aWNlc1JlcXVlc3QaJS5ib29rcy52MS5MaXN0S09SZWFkZXJEZXZpY2VzUmVzcG9uc2UScQoYRGlzY29ubmVjdEtPUmVhZGVyRGV2aWNlEikuYm9va3MudjEuRGlzY29ubmVjdEtPUmVhZGVyRGV2aWNlUmVxdWVzdBoqLmJvb2tzLnYxLkRpc2Nvbm5lY3RLT1JlYWRlckRldmljZVJlc3BvbnNlQilaJ3Rvb2xzLnhkb3VibGV1LmNvbS9nZW4vYm9va3MvdjE7Ym9va3N2MWIGcHJvdG8z");
+
+/**
+ * KOReaderDevice is a set of credentials for KOReader's progress sync. The
+ * password is generated by the server and only returned once, on creation.
+ *
+ * @generated from message books.v1.KOReaderDevice
+ */
+export type KOReaderDevice = Message<"books.v1.KOReaderDevice"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string created_at = 3;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: string last_used_at = 4;
+   */
+  lastUsedAt: string;
+};
+
+/**
+ * Describes the message books.v1.KOReaderDevice.
+ * Use `create(KOReaderDeviceSchema)` to create a new message.
+ */
+export const KOReaderDeviceSchema: GenMessage<KOReaderDevice> = /*@__PURE__*/
+  messageDesc(file_books_v1_koreader, 0);
+
+/**
+ * @generated from message books.v1.RegisterKOReaderDeviceRequest
+ */
+export type RegisterKOReaderDeviceRequest = Message<"books.v1.RegisterKOReaderDeviceRequest"> & {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username: string;
+};
+
+/**
+ * Describes the message books.v1.RegisterKOReaderDeviceRequest.
+ * Use `create(RegisterKOReaderDeviceRequestSchema)` to create a new message.
+ */
+export const RegisterKOReaderDeviceRequestSchema: GenMessage<RegisterKOReaderDeviceRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_koreader, 1);
+
+/**
+ * @generated from message books.v1.RegisterKOReaderDeviceResponse
+ */
+export type RegisterKOReaderDeviceResponse = Message<"books.v1.RegisterKOReaderDeviceResponse"> & {
+  /**
+   * @generated from field: books.v1.KOReaderDevice device = 1;
+   */
+  device?: KOReaderDevice | undefined;
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message books.v1.RegisterKOReaderDeviceResponse.
+ * Use `create(RegisterKOReaderDeviceResponseSchema)` to create a new message.
+ */
+export const RegisterKOReaderDeviceResponseSchema: GenMessage<RegisterKOReaderDeviceResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_koreader, 2);
+
+/**
+ * @generated from message books.v1.ListKOReaderDevicesRequest
+ */
+export type ListKOReaderDevicesRequest = Message<"books.v1.ListKOReaderDevicesRequest"> & {
+};
+
+/**
+ * Describes the message books.v1.ListKOReaderDevicesRequest.
+ * Use `create(ListKOReaderDevicesRequestSchema)` to create a new message.
+ */
+export const ListKOReaderDevicesRequestSchema: GenMessage<ListKOReaderDevicesRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_koreader, 3);
+
+/**
+ * @generated from message books.v1.ListKOReaderDevicesResponse
+ */
+export type ListKOReaderDevicesResponse = Message<"books.v1.ListKOReaderDevicesResponse"> & {
+  /**
+   * @generated from field: repeated books.v1.KOReaderDevice devices = 1;
+   */
+  devices: KOReaderDevice[];
+};
+
+/**
+ * Describes the message books.v1.ListKOReaderDevicesResponse.
+ * Use `create(ListKOReaderDevicesResponseSchema)` to create a new message.
+ */
+export const ListKOReaderDevicesResponseSchema: GenMessage<ListKOReaderDevicesResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_koreader, 4);
+
+/**
+ * @generated from message books.v1.DisconnectKOReaderDeviceRequest
+ */
+export type DisconnectKOReaderDeviceRequest = Message<"books.v1.DisconnectKOReaderDeviceRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message books.v1.DisconnectKOReaderDeviceRequest.
+ * Use `create(DisconnectKOReaderDeviceRequestSchema)` to create a new message.
+ */
+export const DisconnectKOReaderDeviceRequestSchema: GenMessage<DisconnectKOReaderDeviceRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_koreader, 5);
+
+/**
+ * @generated from message books.v1.DisconnectKOReaderDeviceResponse
+ */
+export type DisconnectKOReaderDeviceResponse = Message<"books.v1.DisconnectKOReaderDeviceResponse"> & {
+};
+
+/**
+ * Describes the message books.v1.DisconnectKOReaderDeviceResponse.
+ * Use `create(DisconnectKOReaderDeviceResponseSchema)` to create a new message.
+ */
+export const DisconnectKOReaderDeviceResponseSchema: GenMessage<DisconnectKOReaderDeviceResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_koreader, 6);
+
+/**
+ * @generated from service books.v1.KOReaderService
+ */
+export const KOReaderService: GenService<{
+  /**
+   * @generated from rpc books.v1.KOReaderService.RegisterKOReaderDevice
+   */
+  registerKOReaderDevice: {
+    methodKind: "unary";
+    input: typeof RegisterKOReaderDeviceRequestSchema;
+    output: typeof RegisterKOReaderDeviceResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.KOReaderService.ListKOReaderDevices
+   */
+  listKOReaderDevices: {
+    methodKind: "unary";
+    input: typeof ListKOReaderDevicesRequestSchema;
+    output: typeof ListKOReaderDevicesResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.KOReaderService.DisconnectKOReaderDevice
+   */
+  disconnectKOReaderDevice: {
+    methodKind: "unary";
+    input: typeof DisconnectKOReaderDeviceRequestSchema;
+    output: typeof DisconnectKOReaderDeviceResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_books_v1_koreader, 0);
+
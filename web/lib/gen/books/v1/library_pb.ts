@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file books/v1/library.proto.
  */
 export const file_books_v1_library: GenFile = /*@__PURE__*/
-  fileDesc("ChZib29rcy92MS9saWJyYXJ5LnByb3RvEghib29rcy52MSLaAQoEQm9vaxIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRIPCgdhdXRob3JzGAMgAygJEg4KBmlzYm4xMxgEIAEoCRIRCgljb3Zlcl91cmwYBSABKAkSEwoLZGVzY3JpcHRpb24YBiABKAkSEgoKcGFnZV9jb3VudBgHIAEoBRISCgpzb3VyY2VfdXJsGAsgASgJEhMKC2hhc19jb250ZW50GAwgASgISgQICBAJSgQICRAKSgQIChALUgZpc2JuMTBSDWV4dGVybmFsX3JlZnNSCGNhdGVnb3J5Ip0CCghVc2VyQm9vaxIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEg8KB2Jvb2tfaWQYAyABKAkSHAoEYm9vaxgEIAEoCzIOLmJvb2tzLnYxLkJvb2sSDgoGc3RhdHVzGAUgASgJEgwKBHRhZ3MYBiADKAkSDgoGcmF0aW5nGAcgASgFEhMKC2ZpbmlzaGVkX2F0GAkgAygJEhAKCGFkZGVkX2F0GAogASgJEhIKCnVwZGF0ZWRfYXQYCyABKAkSFQoNcHJvZ3Jlc3NfbW9kZRgMIAEoCRIUCgxjdXJyZW50X3BhZ2UYDSABKAUSGAoQcHJvZ3Jlc3NfcGVyY2VudBgOIAEoBRIPCgdmb3JtYXRzGA8gAygJSgQICBAJIjwKCUJvb2tTaGVsZhIMCgRuYW1lGAEgASgJEiEKBWJvb2tzGAIgAygLMhIuYm9va3MudjEuVXNlckJvb2siswEKD0xpYnJhcnlSZXNwb25zZRIjCgdyZWFkaW5nGAEgAygLMhIuYm9va3MudjEuVXNlckJvb2sSJAoId2lzaGxpc3QYAiADKAsyEi5ib29rcy52MS5Vc2VyQm9vaxIkCghmaW5pc2hlZBgDIAMoCzISLmJvb2tzLnYxLlVzZXJCb29rEiQKB3NoZWx2ZXMYBCADKAsyEy5ib29rcy52MS5Cb29rU2hlbGZKBAgFEAZSA3JzcyJdChVCb29rc1Byb2dyZXNzUmVzcG9uc2USDgoGbGFiZWxzGAEgAygJEg4KBnZhbHVlcxgCIAMoCRISCgpkYXRlX3N0YXJ0GAMgASgJEhAKCGRhdGVfZW5kGAQgASgJIpMBChJFeHRlcm5hbEJvb2tSZXN1bHQSEAoIcHJvdmlkZXIYASABKAkSEwoLcHJvdmlkZXJfaWQYAiABKAkSDQoFdGl0bGUYAyABKAkSDwoHYXV0aG9ycxgEIAMoCRIOCgZpc2JuMTMYBSABKAkSEQoJY292ZXJfdXJsGAYgASgJEhMKC2Rlc2NyaXB0aW9uGAcgASgJIl0KFEJvb2tSZWFkaW5nU3RhdGVEYXRhEg4KBnNvdXJjZRgBIAEoCRIPCgdwZXJjZW50GAIgASgFEhAKCGxvY2F0aW9uGAMgASgJEhIKCnVwZGF0ZWRfYXQYBCABKAkiEwoRR2V0TGlicmFyeVJlcXVlc3QiQAoSR2V0TGlicmFyeVJlc3BvbnNlEioKB2xpYnJhcnkYASABKAsyGS5ib29rcy52MS5MaWJyYXJ5UmVzcG9uc2UiPwoXR2V0Qm9va3NQcm9ncmVzc1JlcXVlc3QSEgoKZGF0ZV9zdGFydBgBIAEoCRIQCghkYXRlX2VuZBgCIAEoCSJNChhHZXRCb29rc1Byb2dyZXNzUmVzcG9uc2USMQoIcHJvZ3Jlc3MYASABKAsyHy5ib29rcy52MS5Cb29rc1Byb2dyZXNzUmVzcG9uc2UiRAoUU2VhcmNoTGlicmFyeVJlcXVlc3QSDQoFcXVlcnkYASABKAkSDQoFbGltaXQYAiABKAUSDgoGb2Zmc2V0GAMgASgFIkwKFVNlYXJjaExpYnJhcnlSZXNwb25zZRIhCgVib29rcxgBIAMoCzISLmJvb2tzLnYxLlVzZXJCb29rEhAKCGhhc19tb3JlGAIgASgIIiYKFVNlYXJjaEV4dGVybmFsUmVxdWVzdBINCgVxdWVyeRgBIAEoCSJHChZTZWFyY2hFeHRlcm5hbFJlc3BvbnNlEi0KB3Jlc3VsdHMYASADKAsyHC5ib29rcy52MS5FeHRlcm5hbEJvb2tSZXN1bHQiPwoWR2V0RXh0ZXJuYWxCb29rUmVxdWVzdBIQCghwcm92aWRlchgBIAEoCRITCgtwcm92aWRlcl9pZBgCIAEoCSJHChdHZXRFeHRlcm5hbEJvb2tSZXNwb25zZRIsCgZyZXN1bHQYASABKAsyHC5ib29rcy52MS5FeHRlcm5hbEJvb2tSZXN1bHQizAEKEUNyZWF0ZUJvb2tSZXF1ZXN0EhAKCHByb3ZpZGVyGAEgASgJEhMKC3Byb3ZpZGVyX2lkGAIgASgJEg0KBXRpdGxlGAMgASgJEg4KBmF1dGhvchgEIAEoCRIOCgZzdGF0dXMYBSABKAkSDgoGaXNibjEzGAYgASgJEhEKCWNvdmVyX3VybBgHIAEoCRITCgtkZXNjcmlwdGlvbhgIIAEoCRIUCgxvd25fcGh5c2ljYWwYCSABKAgSEwoLb3duX2RpZ2l0YWwYCiABKAgiFAoSQ3JlYXRlQm9va1Jlc3BvbnNlImMKF1VwZGF0ZUJvb2tTdGF0dXNSZXF1ZXN0Eg8KB2Jvb2tfaWQYASABKAkSDgoGc3RhdHVzGAIgASgJEhEKCWZhdm91cml0ZRgDIAEoCBIOCgZyYXRpbmcYBCABKAlKBAgFEAYiGgoYVXBkYXRlQm9va1N0YXR1c1Jlc3BvbnNlIjAKEFRvZ2dsZVRhZ1JlcXVlc3QSDwoHYm9va19pZBgBIAEoCRILCgN0YWcYAiABKAkiEwoRVG9nZ2xlVGFnUmVzcG9uc2UiJAoRUmVtb3ZlQm9va1JlcXVlc3QSDwoHYm9va19pZBgBIAEoCSIUChJSZW1vdmVCb29rUmVzcG9uc2UiPwoXVXBkYXRlRmluaXNoZWRBdFJlcXVlc3QSDwoHYm9va19pZBgBIAEoCRITCgtmaW5pc2hlZF9hdBgCIAMoCSIaChhVcGRhdGVGaW5pc2hlZEF0UmVzcG9uc2UibwoVVXBkYXRlUHJvZ3Jlc3NSZXF1ZXN0Eg8KB2Jvb2tfaWQYASABKAkSFQoNcHJvZ3Jlc3NfbW9kZRgCIAEoCRIUCgxjdXJyZW50X3BhZ2UYAyABKAUSGAoQcHJvZ3Jlc3NfcGVyY2VudBgEIAEoBSIYChZVcGRhdGVQcm9ncmVzc1Jlc3BvbnNlImIKHFVwZGF0ZVJlYWRpbmdQcm9ncmVzc1JlcXVlc3QSDwoHYm9va19pZBgBIAEoCRIOCgZzb3VyY2UYAiABKAkSDwoHcGVyY2VudBgDIAEoBRIQCghsb2NhdGlvbhgEIAEoCSIfCh1VcGRhdGVSZWFkaW5nUHJvZ3Jlc3NSZXNwb25zZSIpChZHZXRSZWFkaW5nU3RhdGVSZXF1ZXN0Eg8KB2Jvb2tfaWQYASABKAkiSAoXR2V0UmVhZGluZ1N0YXRlUmVzcG9uc2USLQoFc3RhdGUYASABKAsyHi5ib29rcy52MS5Cb29rUmVhZGluZ1N0YXRlRGF0YSIoChVHZXRCb29rQ29udGVudFJlcXVlc3QSDwoHYm9va19pZBgBIAEoCSImChZHZXRCb29rQ29udGVudFJlc3BvbnNlEgwKBGh0bWwYASABKAkiIgoSQ3JlYXRlU2hlbGZSZXF1ZXN0EgwKBG5hbWUYASABKAkiFQoTQ3JlYXRlU2hlbGZSZXNwb25zZSI4ChJSZW5hbWVTaGVsZlJlcXVlc3QSEAoIb2xkX25hbWUYASABKAkSEAoIbmV3X25hbWUYAiABKAkiJAoTUmVuYW1lU2hlbGZSZXNwb25zZRINCgVtb3ZlZBgBIAEoDSI3ChJEZWxldGVTaGVsZlJlcXVlc3QSDAoEbmFtZRgBIAEoCRITCgt0YXJnZXRfbmFtZRgCIAEoCSIkChNEZWxldGVTaGVsZlJlc3BvbnNlEg0KBW1vdmVkGAEgASgNIjYKEFJlbmFtZVRhZ1JlcXVlc3QSEAoIb2xkX25hbWUYASABKAkSEAoIbmV3X25hbWUYAiABKAkiJQoRUmVuYW1lVGFnUmVzcG9uc2USEAoIYWZmZWN0ZWQYASABKA0iIAoQRGVsZXRlVGFnUmVxdWVzdBIMCgRuYW1lGAEgASgJIiUKEURlbGV0ZVRhZ1Jlc3BvbnNlEhAKCGFmZmVjdGVkGAEgASgNMp0MCg5MaWJyYXJ5U2VydmljZRJHCgpHZXRMaWJyYXJ5EhsuYm9va3MudjEuR2V0TGlicmFyeVJlcXVlc3QaHC5ib29rcy52MS5HZXRMaWJyYXJ5UmVzcG9uc2USWQoQR2V0Qm9va3NQcm9ncmVzcxIhLmJvb2tzLnYxLkdldEJvb2tzUHJvZ3Jlc3NSZXF1ZXN0GiIuYm9va3MudjEuR2V0Qm9va3NQcm9ncmVzc1Jlc3BvbnNlElAKDVNlYXJjaExpYnJhcnkSHi5ib29rcy52MS5TZWFyY2hMaWJyYXJ5UmVxdWVzdBofLmJvb2tzLnYxLlNlYXJjaExpYnJhcnlSZXNwb25zZRJTCg5TZWFyY2hFeHRlcm5hbBIfLmJvb2tzLnYxLlNlYXJjaEV4dGVybmFsUmVxdWVzdBogLmJvb2tzLnYxLlNlYXJjaEV4dGVybmFsUmVzcG9uc2USVgoPR2V0RXh0ZXJuYWxCb29rEiAuYm9va3MudjEuR2V0RXh0ZXJuYWxCb29rUmVxdWVzdBohLmJvb2tzLnYxLkdldEV4dGVybmFsQm9va1Jlc3BvbnNlEkcKCkNyZWF0ZUJvb2sSGy5ib29rcy52MS5DcmVhdGVCb29rUmVxdWVzdBocLmJvb2tzLnYxLkNyZWF0ZUJvb2tSZXNwb25zZRJZChBVcGRhdGVCb29rU3RhdHVzEiEuYm9va3MudjEuVXBkYXRlQm9va1N0YXR1c1JlcXVlc3QaIi5ib29rcy52MS5VcGRhdGVCb29rU3RhdHVzUmVzcG9uc2USWQoQVXBkYXRlRmluaXNoZWRBdBIhLmJvb2tzLnYxLlVwZGF0ZUZpbmlzaGVkQXRSZXF1ZXN0GiIuYm9va3MudjEuVXBkYXRlRmluaXNoZWRBdFJlc3BvbnNlElMKDlVwZGF0ZVByb2dyZXNzEh8uYm9va3MudjEuVXBkYXRlUHJvZ3Jlc3NSZXF1ZXN0GiAuYm9va3MudjEuVXBkYXRlUHJvZ3Jlc3NSZXNwb25zZRJECglUb2dnbGVUYWcSGi5ib29rcy52MS5Ub2dnbGVUYWdSZXF1ZXN0GhsuYm9va3MudjEuVG9nZ2xlVGFnUmVzcG9uc2USRwoKUmVtb3ZlQm9vaxIbLmJvb2tzLnYxLlJlbW92ZUJvb2tSZXF1ZXN0GhwuYm9va3MudjEuUmVtb3ZlQm9va1Jlc3BvbnNlEmgKFVVwZGF0ZVJlYWRpbmdQcm9ncmVzcxImLmJvb2tzLnYxLlVwZGF0ZVJlYWRpbmdQcm9ncmVzc1JlcXVlc3QaJy5ib29rcy52MS5VcGRhdGVSZWFkaW5nUHJvZ3Jlc3NSZXNwb25zZRJWCg9HZXRSZWFkaW5nU3RhdGUSIC5ib29rcy52MS5HZXRSZWFkaW5nU3RhdGVSZXF1ZXN0GiEuYm9va3MudjEuR2V0UmVhZGluZ1N0YXRlUmVzcG9uc2USUwoOR2V0Qm9va0NvbnRlbnQSHy5ib29rcy52MS5HZXRCb29rQ29udGVudFJlcXVlc3QaIC5ib29rcy52MS5HZXRCb29rQ29udGVudFJlc3BvbnNlEkoKC0NyZWF0ZVNoZWxmEhwuYm9va3MudjEuQ3JlYXRlU2hlbGZSZXF1ZXN0Gh0uYm9va3MudjEuQ3JlYXRlU2hlbGZSZXNwb25zZRJKCgtSZW5hbWVTaGVsZhIcLmJvb2tzLnYxLlJlbmFtZVNoZWxmUmVxdWVzdBodLmJvb2tzLnYxLlJlbmFtZVNoZWxmUmVzcG9uc2USSgoLRGVsZXRlU2hlbGYSHC5ib29rcy52MS5EZWxldGVTaGVsZlJlcXVlc3QaHS5ib29rcy52MS5EZWxldGVTaGVsZlJlc3BvbnNlEkQKCVJlbmFtZVRhZxIaLmJvb2tzLnYxLlJlbmFtZVRhZ1JlcXVlc3QaGy5ib29rcy52MS5SZW5hbWVUYWdSZXNwb25zZRJECglEZWxldGVUYWcSGi5ib29rcy52MS5EZWxldGVUYWdSZXF1ZXN0GhsuYm9va3MudjEuRGVsZXRlVGFnUmVzcG9uc2VCKVondG9vbHMueGRvdWJsZXUuY29tL2dlbi9ib29rcy92MTtib29rc3YxYgZwcm90bzM");
+  fileDesc("ChZib29rcy92MS9saWJyYXJ5LnByb3RvEghib29rcy52MSKyAgoEQm9vaxIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRIPCgdhdXRob3JzGAMgAygJEg4KBmlzYm4xMxgEIAEoCRIRCgljb3Zlcl91cmwYBSABKAkSEwoLZGVzY3JpcHRpb24YBiABKAkSEgoKcGFnZV9jb3VudBgHIAEoBRISCgpzb3VyY2VfdXJsGAsgASgJEhMKC2hhc19jb250ZW50GAwgASgIEg4KBnNlcmllcxgNIAEoCRIcCg9zZXJpZXNfcG9zaXRpb24YDiABKAFIAIgBARIUCgxzZXJpZXNfdG90YWwYDyABKAVCEgoQX3Nlcmllc19wb3NpdGlvbkoECAgQCUoECAkQCkoECAoQC1IGaXNibjEwUg1leHRlcm5hbF9yZWZzUghjYXRlZ29yeSLjAgoIVXNlckJvb2sSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIPCgdib29rX2lkGAMgASgJEhwKBGJvb2sYBCABKAsyDi5ib29rcy52MS5Cb29rEg4KBnN0YXR1cxgFIAEoCRIMCgR0YWdzGAYgAygJEg4KBnJhdGluZxgHIAEoBRITCgtmaW5pc2hlZF9hdBgJIAMoCRIQCghhZGRlZF9hdBgKIAEoCRISCgp1cGRhdGVkX2F0GAsgASgJEhUKDXByb2dyZXNzX21vZGUYDCABKAkSFAoMY3VycmVudF9wYWdlGA0gASgFEhgKEHByb2dyZXNzX3BlcmNlbnQYDiABKAUSDwoHZm9ybWF0cxgPIAMoCRIUCgxmZWVkX2l0ZW1faWQYECABKAkSGwoOcXVldWVfcG9zaXRpb24YESABKAVIAIgBAUIRCg9fcXVldWVfcG9zaXRpb25KBAgIEAkiPAoJQm9va1NoZWxmEgwKBG5hbWUYASABKAkSIQoFYm9va3MYAiADKAsyEi5ib29rcy52MS5Vc2VyQm9vayK1AgoPTGlicmFyeVJlc3BvbnNlEiMKB3JlYWRpbmcYASADKAsyEi5ib29rcy52MS5Vc2VyQm9vaxIkCgh3aXNobGlzdBgCIAMoCzISLmJvb2tzLnYxLlVzZXJCb29rEiQKCGZpbmlzaGVkGAMgAygLMhIuYm9va3MudjEuVXNlckJvb2sSJAoHc2hlbHZlcxgEIAMoCzITLmJvb2tzLnYxLkJvb2tTaGVsZhIqCg5uZXh0X2luX3NlcmllcxgGIAMoCzISLmJvb2tzLnYxLlVzZXJCb29rEiMKB3VwX25leHQYByADKAsyEi5ib29rcy52MS5Vc2VyQm9vaxIvCg5xdWV1ZV9zZXR0aW5ncxgIIAEoCzIXLmJvb2tzLnYxLlF1ZXVlU2V0dGluZ3NKBAgFEAZSA3JzcyI+Cg1RdWV1ZVNldHRpbmdzEhQKDGF1dG9fYWR2YW5jZRgBIAEoCBIXCg9rb2JvX3N5bmNfY291bnQYAiABKAUiXQoVQm9va3NQcm9ncmVzc1Jlc3BvbnNlEg4KBmxhYmVscxgBIAMoCRIOCgZ2YWx1ZXMYAiADKAkSEgoKZGF0ZV9zdGFydBgDIAEoCRIQCghkYXRlX2VuZBgEIAEoCSKTAQoSRXh0ZXJuYWxCb29rUmVzdWx0EhAKCHByb3ZpZGVyGAEgASgJEhMKC3Byb3ZpZGVyX2lkGAIgASgJEg0KBXRpdGxlGAMgASgJEg8KB2F1dGhvcnMYBCADKAkSDgoGaXNibjEzGAUgASgJEhEKCWNvdmVyX3VybBgGIAEoCRITCgtkZXNjcmlwdGlvbhgHIAEoCSJdChRCb29rUmVhZGluZ1N0YXRlRGF0YRIOCgZzb3VyY2UYASABKAkSDwoHcGVyY2VudBgCIAEoBRIQCghsb2NhdGlvbhgDIAEoCRISCgp1cGRhdGVkX2F0GAQgASgJIhMKEUdldExpYnJhcnlSZXF1ZXN0IkAKEkdldExpYnJhcnlSZXNwb25zZRIqCgdsaWJyYXJ5GAEgASgLMhkuYm9va3MudjEuTGlicmFyeVJlc3BvbnNlIj8KF0dldEJvb2tzUHJvZ3Jlc3NSZXF1ZXN0EhIKCmRhdGVfc3RhcnQYASABKAkSEAoIZGF0ZV9lbmQYAiABKAkiTQoYR2V0Qm9va3NQcm9ncmVzc1Jlc3BvbnNlEjEKCHByb2dyZXNzGAEgASgLMh8uYm9va3MudjEuQm9va3NQcm9ncmVzc1Jlc3BvbnNlIkQKFFNlYXJjaExpYnJhcnlSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEg0KBWxpbWl0GAIgASgFEg4KBm9mZnNldBgDIAEoBSJMChVTZWFyY2hMaWJyYXJ5UmVzcG9uc2USIQoFYm9va3MYASADKAsyEi5ib29rcy52MS5Vc2VyQm9vaxIQCghoYXNfbW9yZRgCIAEoCCImChVTZWFyY2hFeHRlcm5hbFJlcXVlc3QSDQoFcXVlcnkYASABKAkiRwoWU2VhcmNoRXh0ZXJuYWxSZXNwb25zZRItCgdyZXN1bHRzGAEgAygLMhwuYm9va3MudjEuRXh0ZXJuYWxCb29rUmVzdWx0Ij8KFkdldEV4dGVybmFsQm9va1JlcXVlc3QSEAoIcHJvdmlkZXIYASABKAkSEwoLcHJvdmlkZXJfaWQYAiABKAkiRwoXR2V0RXh0ZXJuYWxCb29rUmVzcG9uc2USLAoGcmVzdWx0GAEgASgLMhwuYm9va3MudjEuRXh0ZXJuYWxCb29rUmVzdWx0IswBChFDcmVhdGVCb29rUmVxdWVzdBIQCghwcm92aWRlchgBIAEoCRITCgtwcm92aWRlcl9pZBgCIAEoCRINCgV0aXRsZRgDIAEoCRIOCgZhdXRob3IYBCABKAkSDgoGc3RhdHVzGAUgASgJEg4KBmlzYm4xMxgGIAEoCRIRCgljb3Zlcl91cmwYByABKAkSEwoLZGVzY3JpcHRpb24YCCABKAkSFAoMb3duX3BoeXNpY2FsGAkgASgIEhMKC293bl9kaWdpdGFsGAogASgIIhQKEkNyZWF0ZUJvb2tSZXNwb25zZSJjChdVcGRhdGVCb29rU3RhdHVzUmVxdWVzdBIPCgdib29rX2lkGAEgASgJEg4KBnN0YXR1cxgCIAEoCRIRCglmYXZvdXJpdGUYAyABKAgSDgoGcmF0aW5nGAQgASgJSgQIBRAGIhoKGFVwZGF0ZUJvb2tTdGF0dXNSZXNwb25zZSIwChBUb2dnbGVUYWdSZXF1ZXN0Eg8KB2Jvb2tfaWQYASABKAkSCwoDdGFnGAIgASgJIhMKEVRvZ2dsZVRhZ1Jlc3BvbnNlIiQKEVJlbW92ZUJvb2tSZXF1ZXN0Eg8KB2Jvb2tfaWQYASABKAkiFAoSUmVtb3ZlQm9va1Jlc3BvbnNlIj8KF1VwZGF0ZUZpbmlzaGVkQXRSZXF1ZXN0Eg8KB2Jvb2tfaWQYASABKAkSEwoLZmluaXNoZWRfYXQYAiADKAkiGgoYVXBkYXRlRmluaXNoZWRBdFJlc3BvbnNlIm8KFVVwZGF0ZVByb2dyZXNzUmVxdWVzdBIPCgdib29rX2lkGAEgASgJEhUKDXByb2dyZXNzX21vZGUYAiABKAkSFAoMY3VycmVudF9wYWdlGAMgASgFEhgKEHByb2dyZXNzX3BlcmNlbnQYBCABKAUiGAoWVXBkYXRlUHJvZ3Jlc3NSZXNwb25zZSJiChxVcGRhdGVSZWFkaW5nUHJvZ3Jlc3NSZXF1ZXN0Eg8KB2Jvb2tfaWQYASABKAkSDgoGc291cmNlGAIgASgJEg8KB3BlcmNlbnQYAyABKAUSEAoIbG9jYXRpb24YBCABKAkiHwodVXBkYXRlUmVhZGluZ1Byb2dyZXNzUmVzcG9uc2UiIwoQUXVldWVCb29rUmVxdWVzdBIPCgdib29rX2lkGAEgASgJIhMKEVF1ZXVlQm9va1Jlc3BvbnNlIiUKEkRlcXVldWVCb29rUmVxdWVzdBIPCgdib29rX2lkGAEgASgJIhUKE0RlcXVldWVCb29rUmVzcG9uc2UiJwoTUmVvcmRlclF1ZXVlUmVxdWVzdBIQCghib29rX2lkcxgBIAMoCSIWChRSZW9yZGVyUXVldWVSZXNwb25zZSJHChpVcGRhdGVRdWV1ZVNldHRpbmdzUmVxdWVzdBIpCghzZXR0aW5ncxgBIAEoCzIXLmJvb2tzLnYxLlF1ZXVlU2V0dGluZ3MiHQobVXBkYXRlUXVldWVTZXR0aW5nc1Jlc3BvbnNlIikKFkdldFJlYWRpbmdTdGF0ZVJlcXVlc3QSDwoHYm9va19pZBgBIAEoCSJIChdHZXRSZWFkaW5nU3RhdGVSZXNwb25zZRItCgVzdGF0ZRgBIAEoCzIeLmJvb2tzLnYxLkJvb2tSZWFkaW5nU3RhdGVEYXRhIigKFUdldEJvb2tDb250ZW50UmVxdWVzdBIPCgdib29rX2lkGAEgASgJIiYKFkdldEJvb2tDb250ZW50UmVzcG9uc2USDAoEaHRtbBgBIAEoCSIiChJDcmVhdGVTaGVsZlJlcXVlc3QSDAoEbmFtZRgBIAEoCSIVChNDcmVhdGVTaGVsZlJlc3BvbnNlIjgKElJlbmFtZVNoZWxmUmVxdWVzdBIQCghvbGRfbmFtZRgBIAEoCRIQCghuZXdfbmFtZRgCIAEoCSIkChNSZW5hbWVTaGVsZlJlc3BvbnNlEg0KBW1vdmVkGAEgASgNIjcKEkRlbGV0ZVNoZWxmUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC3RhcmdldF9uYW1lGAIgASgJIiQKE0RlbGV0ZVNoZWxmUmVzcG9uc2USDQoFbW92ZWQYASABKA0iNgoQUmVuYW1lVGFnUmVxdWVzdBIQCghvbGRfbmFtZRgBIAEoCRIQCghuZXdfbmFtZRgCIAEoCSIlChFSZW5hbWVUYWdSZXNwb25zZRIQCghhZmZlY3RlZBgBIAEoDSIgChBEZWxldGVUYWdSZXF1ZXN0EgwKBG5hbWUYASABKAkiJQoRRGVsZXRlVGFnUmVzcG9uc2USEAoIYWZmZWN0ZWQYASABKA0i8AEKCkFubm90YXRpb24SCgoCaWQYASABKAkSDwoHYm9va19pZBgCIAEoCRISCgpib29rX3RpdGxlGAMgASgJEgwKBHR5cGUYBCABKAkSGAoQaGlnaGxpZ2h0ZWRfdGV4dBgFIAEoCRIRCglub3RlX3RleHQYBiABKAkSDQoFY29sb3IYByABKAkSFQoNY2hhcHRlcl90aXRsZRgIIAEoCRIYChBjaGFwdGVyX3Byb2dyZXNzGAkgASgBEg4KBnNvdXJjZRgKIAEoCRISCgpjcmVhdGVkX2F0GAsgASgJEhIKCnVwZGF0ZWRfYXQYDCABKAkiKQoWTGlzdEFubm90YXRpb25zUmVxdWVzdBIPCgdib29rX2lkGAEgASgJIkQKF0xpc3RBbm5vdGF0aW9uc1Jlc3BvbnNlEikKC2Fubm90YXRpb25zGAEgAygLMhQuYm9va3MudjEuQW5ub3RhdGlvbiJIChhTZWFyY2hBbm5vdGF0aW9uc1JlcXVlc3QSDQoFcXVlcnkYASABKAkSDQoFbGltaXQYAiABKAUSDgoGb2Zmc2V0GAMgASgFIlgKGVNlYXJjaEFubm90YXRpb25zUmVzcG9uc2USKQoLYW5ub3RhdGlvbnMYASADKAsyFC5ib29rcy52MS5Bbm5vdGF0aW9uEhAKCGhhc19tb3JlGAIgASgIIjsKGEV4cG9ydEFubm90YXRpb25zUmVxdWVzdBIPCgdib29rX2lkGAEgASgJEg4KBmZvcm1hdBgCIAEoCSJRChlFeHBvcnRBbm5vdGF0aW9uc1Jlc3BvbnNlEgwKBGRhdGEYASABKAwSFAoMY29udGVudF90eXBlGAIgASgJEhAKCGZpbGVuYW1lGAMgASgJIpQBCgdUZXh0SGl0EhQKDHVzZXJfYm9va19pZBgBIAEoCRIPCgdib29rX2lkGAIgASgJEg0KBXRpdGxlGAMgASgJEg8KB2F1dGhvcnMYBCADKAkSDwoHY2hhcHRlchgFIAEoCRIQCghsb2NhdGlvbhgGIAEoCRIOCgZmb3JtYXQYByABKAkSDwoHc25pcHBldBgIIAEoCSJFChVTZWFyY2hCb29rVGV4dFJlcXVlc3QSDQoFcXVlcnkYASABKAkSDQoFbGltaXQYAiABKAUSDgoGb2Zmc2V0GAMgASgFIksKFlNlYXJjaEJvb2tUZXh0UmVzcG9uc2USHwoEaGl0cxgBIAMoCzIRLmJvb2tzLnYxLlRleHRIaXQSEAoIaGFzX21vcmUYAiABKAgiZAoKUmVhZGluZ0RheRIMCgRkYXRlGAEgASgJEg8KB2Jvb2tfaWQYAiABKAkSDwoHc2Vjb25kcxgDIAEoBRIQCghzZXNzaW9ucxgEIAEoBRIUCgxwYWdlc190dXJuZWQYBSABKAUimgIKEEJvb2tSZWFkaW5nU3RhdHMSDwoHYm9va19pZBgBIAEoCRINCgV0aXRsZRgCIAEoCRIPCgdhdXRob3JzGAMgAygJEg4KBnN0YXR1cxgEIAEoCRIPCgdwZXJjZW50GAUgASgFEg8KB3NlY29uZHMYBiABKAUSEAoIc2Vzc2lvbnMYByABKAUSFAoMcGFnZXNfdHVybmVkGAggASgFEhgKEHBlcmNlbnRfcGVyX2hvdXIYCSABKAESFgoOcGFnZXNfcGVyX2hvdXIYCiABKAESGQoRcmVtYWluaW5nX3NlY29uZHMYCyABKAUSGAoQZXN0aW1hdGVkX2ZpbmlzaBgMIAEoCRIUCgxsYXN0X3JlYWRfYXQYDSABKAkiPgoWR2V0UmVhZGluZ1N0YXRzUmVxdWVzdBISCgpkYXRlX3N0YXJ0GAEgASgJEhAKCGRhdGVfZW5kGAIgASgJIo4BChdHZXRSZWFkaW5nU3RhdHNSZXNwb25zZRIiCgRkYXlzGAEgAygLMhQuYm9va3MudjEuUmVhZGluZ0RheRIpCgVib29rcxgCIAMoCzIaLmJvb2tzLnYxLkJvb2tSZWFkaW5nU3RhdHMSEgoKZGF0ZV9zdGFydBgDIAEoCRIQCghkYXRlX2VuZBgEIAEoCTKjEgoOTGlicmFyeVNlcnZpY2USRwoKR2V0TGlicmFyeRIbLmJvb2tzLnYxLkdldExpYnJhcnlSZXF1ZXN0GhwuYm9va3MudjEuR2V0TGlicmFyeVJlc3BvbnNlElkKEEdldEJvb2tzUHJvZ3Jlc3MSIS5ib29rcy52MS5HZXRCb29rc1Byb2dyZXNzUmVxdWVzdBoiLmJvb2tzLnYxLkdldEJvb2tzUHJvZ3Jlc3NSZXNwb25zZRJWCg9HZXRSZWFkaW5nU3RhdHMSIC5ib29rcy52MS5HZXRSZWFkaW5nU3RhdHNSZXF1ZXN0GiEuYm9va3MudjEuR2V0UmVhZGluZ1N0YXRzUmVzcG9uc2USUAoNU2VhcmNoTGlicmFyeRIeLmJvb2tzLnYxLlNlYXJjaExpYnJhcnlSZXF1ZXN0Gh8uYm9va3MudjEuU2VhcmNoTGlicmFyeVJlc3BvbnNlElMKDlNlYXJjaEV4dGVybmFsEh8uYm9va3MudjEuU2VhcmNoRXh0ZXJuYWxSZXF1ZXN0GiAuYm9va3MudjEuU2VhcmNoRXh0ZXJuYWxSZXNwb25zZRJWCg9HZXRFeHRlcm5hbEJvb2sSIC5ib29rcy52MS5HZXRFeHRlcm5hbEJvb2tSZXF1ZXN0GiEuYm9va3MudjEuR2V0RXh0ZXJuYWxCb29rUmVzcG9uc2USRwoKQ3JlYXRlQm9vaxIbLmJvb2tzLnYxLkNyZWF0ZUJvb2tSZXF1ZXN0GhwuYm9va3MudjEuQ3JlYXRlQm9va1Jlc3BvbnNlElkKEFVwZGF0ZUJvb2tTdGF0dXMSIS5ib29rcy52MS5VcGRhdGVCb29rU3RhdHVzUmVxdWVzdBoiLmJvb2tzLnYxLlVwZGF0ZUJvb2tTdGF0dXNSZXNwb25zZRJZChBVcGRhdGVGaW5pc2hlZEF0EiEuYm9va3MudjEuVXBkYXRlRmluaXNoZWRBdFJlcXVlc3QaIi5ib29rcy52MS5VcGRhdGVGaW5pc2hlZEF0UmVzcG9uc2USUwoOVXBkYXRlUHJvZ3Jlc3MSHy5ib29rcy52MS5VcGRhdGVQcm9ncmVzc1JlcXVlc3QaIC5ib29rcy52MS5VcGRhdGVQcm9ncmVzc1Jlc3BvbnNlEkQKCVRvZ2dsZVRhZxIaLmJvb2tzLnYxLlRvZ2dsZVRhZ1JlcXVlc3QaGy5ib29rcy52MS5Ub2dnbGVUYWdSZXNwb25zZRJHCgpSZW1vdmVCb29rEhsuYm9va3MudjEuUmVtb3ZlQm9va1JlcXVlc3QaHC5ib29rcy52MS5SZW1vdmVCb29rUmVzcG9uc2USRAoJUXVldWVCb29rEhouYm9va3MudjEuUXVldWVCb29rUmVxdWVzdBobLmJvb2tzLnYxLlF1ZXVlQm9va1Jlc3BvbnNlEkoKC0RlcXVldWVCb29rEhwuYm9va3MudjEuRGVxdWV1ZUJvb2tSZXF1ZXN0Gh0uYm9va3MudjEuRGVxdWV1ZUJvb2tSZXNwb25zZRJNCgxSZW9yZGVyUXVldWUSHS5ib29rcy52MS5SZW9yZGVyUXVldWVSZXF1ZXN0Gh4uYm9va3MudjEuUmVvcmRlclF1ZXVlUmVzcG9uc2USYgoTVXBkYXRlUXVldWVTZXR0aW5ncxIkLmJvb2tzLnYxLlVwZGF0ZVF1ZXVlU2V0dGluZ3NSZXF1ZXN0GiUuYm9va3MudjEuVXBkYXRlUXVldWVTZXR0aW5nc1Jlc3BvbnNlEmgKFVVwZGF0ZVJlYWRpbmdQcm9ncmVzcxImLmJvb2tzLnYxLlVwZGF0ZVJlYWRpbmdQcm9ncmVzc1JlcXVlc3QaJy5ib29rcy52MS5VcGRhdGVSZWFkaW5nUHJvZ3Jlc3NSZXNwb25zZRJWCg9HZXRSZWFkaW5nU3RhdGUSIC5ib29rcy52MS5HZXRSZWFkaW5nU3RhdGVSZXF1ZXN0GiEuYm9va3MudjEuR2V0UmVhZGluZ1N0YXRlUmVzcG9uc2USUwoOR2V0Qm9va0NvbnRlbnQSHy5ib29rcy52MS5HZXRCb29rQ29udGVudFJlcXVlc3QaIC5ib29rcy52MS5HZXRCb29rQ29udGVudFJlc3BvbnNlEkoKC0NyZWF0ZVNoZWxmEhwuYm9va3MudjEuQ3JlYXRlU2hlbGZSZXF1ZXN0Gh0uYm9va3MudjEuQ3JlYXRlU2hlbGZSZXNwb25zZRJKCgtSZW5hbWVTaGVsZhIcLmJvb2tzLnYxLlJlbmFtZVNoZWxmUmVxdWVzdBodLmJvb2tzLnYxLlJlbmFtZVNoZWxmUmVzcG9uc2USSgoLRGVsZXRlU2hlbGYSHC5ib29rcy52MS5EZWxldGVTaGVsZlJlcXVlc3QaHS5ib29rcy52MS5EZWxldGVTaGVsZlJlc3BvbnNlEkQKCVJlbmFtZVRhZxIaLmJvb2tzLnYxLlJlbmFtZVRhZ1JlcXVlc3QaGy5ib29rcy52MS5SZW5hbWVUYWdSZXNwb25zZRJECglEZWxldGVUYWcSGi5ib29rcy52MS5EZWxldGVUYWdSZXF1ZXN0GhsuYm9va3MudjEuRGVsZXRlVGFnUmVzcG9uc2USVgoPTGlzdEFubm90YXRpb25zEiAuYm9va3MudjEuTGlzdEFubm90YXRpb25zUmVxdWVzdBohLmJvb2tzLnYxLkxpc3RBbm5vdGF0aW9uc1Jlc3BvbnNlElwKEVNlYXJjaEFubm90YXRpb25zEiIuYm9va3MudjEuU2VhcmNoQW5ub3RhdGlvbnNSZXF1ZXN0GiMuYm9va3MudjEuU2VhcmNoQW5ub3RhdGlvbnNSZXNwb25zZRJcChFFeHBvcnRBbm5vdGF0aW9ucxIiLmJvb2tzLnYxLkV4cG9ydEFubm90YXRpb25zUmVxdWVzdBojLmJvb2tzLnYxLkV4cG9ydEFubm90YXRpb25zUmVzcG9uc2USUwoOU2VhcmNoQm9va1RleHQSHy5ib29rcy52MS5TZWFyY2hCb29rVGV4dFJlcXVlc3QaIC5ib29rcy52MS5TZWFyY2hCb29rVGV4dFJlc3BvbnNlQilaJ3Rvb2xzLnhkb3VibGV1LmNvbS9nZW4vYm9va3MvdjE7Ym9va3N2MWIGcHJvdG8z");
 
 /**
  * @generated from message books.v1.Book
@@ -65,6 +65,28 @@ export type Book = Message<"books.v1.Book"> & {
    * @generated from field: bool has_content = 12;
    */
   hasContent: boolean;
+
+  /**
+   * Series the book belongs to; empty when it is in none.
+   *
+   * @generated from field: string series = 13;
+   */
+  series: string;
+
+  /**
+   * Position in the series, e.g. 2 or 2.5; unset when unknown.
+   *
+   * @generated from field: optional double series_position = 14;
+   */
+  seriesPosition?: number | undefined;
+
+  /**
+   * Number of books in the series as a metadata source reports it; 0 when
+   * unknown.
+   *
+   * @generated from field: int32 series_total = 15;
+   */
+  seriesTotal: number;
 };
 
 /**
@@ -147,6 +169,22 @@ export type UserBook = Message<"books.v1.UserBook"> & {
    * @generated from field: repeated string formats = 15;
    */
   formats: string[];
+
+  /**
+   * The feeds item the entry was sent from as an article; empty for
+   * anything else.
+   *
+   * @generated from field: string feed_item_id = 16;
+   */
+  feedItemId: string;
+
+  /**
+   * Place in the "up next" reading queue, from 0; unset when the book isn't
+   * queued.
+   *
+   * @generated from field: optional int32 queue_position = 17;
+   */
+  queuePosition?: number | undefined;
 };
 
 /**
@@ -201,6 +239,29 @@ export type LibraryResponse = Message<"books.v1.LibraryResponse"> & {
    * @generated from field: repeated books.v1.BookShelf shelves = 4;
    */
   shelves: BookShelf[];
+
+  /**
+   * The next unread book of every series the user has started, in series
+   * name order.
+   *
+   * @generated from field: repeated books.v1.UserBook next_in_series = 6;
+   */
+  nextInSeries: UserBook[];
+
+  /**
+   * The "up next" reading queue, first to last. Its books are also in
+   * wishlist, which lists them first, in the same order.
+   *
+   * @generated from field: repeated books.v1.UserBook up_next = 7;
+   */
+  upNext: UserBook[];
+
+  /**
+   * Unset on a shared reading dashboard.
+   *
+   * @generated from field: books.v1.QueueSettings queue_settings = 8;
+   */
+  queueSettings?: QueueSettings;
 };
 
 /**
@@ -210,6 +271,33 @@ export type LibraryResponse = Message<"books.v1.LibraryResponse"> & {
 export const LibraryResponseSchema: GenMessage<LibraryResponse> = /*@__PURE__*/
   messageDesc(file_books_v1_library, 3);
 
+/**
+ * QueueSettings are the reading queue rules. auto_advance moves the first
+ * queued book to currently-reading when a book being read is finished;
+ * kobo_sync_count is how many books from the top of the queue sync to Kobo
+ * without the kobo-sync tag.
+ *
+ * @generated from message books.v1.QueueSettings
+ */
+export type QueueSettings = Message<"books.v1.QueueSettings"> & {
+  /**
+   * @generated from field: bool auto_advance = 1;
+   */
+  autoAdvance: boolean;
+
+  /**
+   * @generated from field: int32 kobo_sync_count = 2;
+   */
+  koboSyncCount: number;
+};
+
+/**
+ * Describes the message books.v1.QueueSettings.
+ * Use `create(QueueSettingsSchema)` to create a new message.
+ */
+export const QueueSettingsSchema: GenMessage<QueueSettings> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 4);
+
 /**
  * @generated from message books.v1.BooksProgressResponse
  */
@@ -240,7 +328,7 @@ export type BooksProgressResponse = Message<"books.v1.BooksProgressResponse"> &
  * Use `create(BooksProgressResponseSchema)` to create a new message.
  */
 export const BooksProgressResponseSchema: GenMessage<BooksProgressResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 4);
+  messageDesc(file_books_v1_library, 5);
 
 /**
  * @generated from message books.v1.ExternalBookResult
@@ -287,7 +375,7 @@ export type ExternalBookResult = Message<"books.v1.ExternalBookResult"> & {
  * Use `create(ExternalBookResultSchema)` to create a new message.
  */
 export const ExternalBookResultSchema: GenMessage<ExternalBookResult> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 5);
+  messageDesc(file_books_v1_library, 6);
 
 /**
  * @generated from message books.v1.BookReadingStateData
@@ -319,7 +407,7 @@ export type BookReadingStateData = Message<"books.v1.BookReadingStateData"> & {
  * Use `create(BookReadingStateDataSchema)` to create a new message.
  */
 export const BookReadingStateDataSchema: GenMessage<BookReadingStateData> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 6);
+  messageDesc(file_books_v1_library, 7);
 
 /**
  * @generated from message books.v1.GetLibraryRequest
@@ -332,7 +420,7 @@ export type GetLibraryRequest = Message<"books.v1.GetLibraryRequest"> & {
  * Use `create(GetLibraryRequestSchema)` to create a new message.
  */
 export const GetLibraryRequestSchema: GenMessage<GetLibraryRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 7);
+  messageDesc(file_books_v1_library, 8);
 
 /**
  * @generated from message books.v1.GetLibraryResponse
@@ -349,7 +437,7 @@ export type GetLibraryResponse = Message<"books.v1.GetLibraryResponse"> & {
  * Use `create(GetLibraryResponseSchema)` to create a new message.
  */
 export const GetLibraryResponseSchema: GenMessage<GetLibraryResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 8);
+  messageDesc(file_books_v1_library, 9);
 
 /**
  * @generated from message books.v1.GetBooksProgressRequest
@@ -371,7 +459,7 @@ export type GetBooksProgressRequest = Message<"books.v1.GetBooksProgressRequest"
  * Use `create(GetBooksProgressRequestSchema)` to create a new message.
  */
 export const GetBooksProgressRequestSchema: GenMessage<GetBooksProgressRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 9);
+  messageDesc(file_books_v1_library, 10);
 
 /**
  * @generated from message books.v1.GetBooksProgressResponse
@@ -388,7 +476,7 @@ export type GetBooksProgressResponse = Message<"books.v1.GetBooksProgressRespons
  * Use `create(GetBooksProgressResponseSchema)` to create a new message.
  */
 export const GetBooksProgressResponseSchema: GenMessage<GetBooksProgressResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 10);
+  messageDesc(file_books_v1_library, 11);
 
 /**
  * @generated from message books.v1.SearchLibraryRequest
@@ -415,7 +503,7 @@ export type SearchLibraryRequest = Message<"books.v1.SearchLibraryRequest"> & {
  * Use `create(SearchLibraryRequestSchema)` to create a new message.
  */
 export const SearchLibraryRequestSchema: GenMessage<SearchLibraryRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 11);
+  messageDesc(file_books_v1_library, 12);
 
 /**
  * @generated from message books.v1.SearchLibraryResponse
@@ -437,7 +525,7 @@ export type SearchLibraryResponse = Message<"books.v1.SearchLibraryResponse"> &
  * Use `create(SearchLibraryResponseSchema)` to create a new message.
  */
 export const SearchLibraryResponseSchema: GenMessage<SearchLibraryResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 12);
+  messageDesc(file_books_v1_library, 13);
 
 /**
  * @generated from message books.v1.SearchExternalRequest
@@ -454,7 +542,7 @@ export type SearchExternalRequest = Message<"books.v1.SearchExternalRequest"> &
  * Use `create(SearchExternalRequestSchema)` to create a new message.
  */
 export const SearchExternalRequestSchema: GenMessage<SearchExternalRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 13);
+  messageDesc(file_books_v1_library, 14);
 
 /**
  * @generated from message books.v1.SearchExternalResponse
@@ -471,7 +559,7 @@ export type SearchExternalResponse = Message<"books.v1.SearchExternalResponse">
  * Use `create(SearchExternalResponseSchema)` to create a new message.
  */
 export const SearchExternalResponseSchema: GenMessage<SearchExternalResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 14);
+  messageDesc(file_books_v1_library, 15);
 
 /**
  * @generated from message books.v1.GetExternalBookRequest
@@ -493,7 +581,7 @@ export type GetExternalBookRequest = Message<"books.v1.GetExternalBookRequest">
  * Use `create(GetExternalBookRequestSchema)` to create a new message.
  */
 export const GetExternalBookRequestSchema: GenMessage<GetExternalBookRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 15);
+  messageDesc(file_books_v1_library, 16);
 
 /**
  * @generated from message books.v1.GetExternalBookResponse
@@ -510,7 +598,7 @@ export type GetExternalBookResponse = Message<"books.v1.GetExternalBookResponse"
  * Use `create(GetExternalBookResponseSchema)` to create a new message.
  */
 export const GetExternalBookResponseSchema: GenMessage<GetExternalBookResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 16);
+  messageDesc(file_books_v1_library, 17);
 
 /**
  * @generated from message books.v1.CreateBookRequest
@@ -572,7 +660,7 @@ export type CreateBookRequest = Message<"books.v1.CreateBookRequest"> & {
  * Use `create(CreateBookRequestSchema)` to create a new message.
  */
 export const CreateBookRequestSchema: GenMessage<CreateBookRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 17);
+  messageDesc(file_books_v1_library, 18);
 
 /**
  * @generated from message books.v1.CreateBookResponse
@@ -585,7 +673,7 @@ export type CreateBookResponse = Message<"books.v1.CreateBookResponse"> & {
  * Use `create(CreateBookResponseSchema)` to create a new message.
  */
 export const CreateBookResponseSchema: GenMessage<CreateBookResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 18);
+  messageDesc(file_books_v1_library, 19);
 
 /**
  * @generated from message books.v1.UpdateBookStatusRequest
@@ -617,7 +705,7 @@ export type UpdateBookStatusRequest = Message<"books.v1.UpdateBookStatusRequest"
  * Use `create(UpdateBookStatusRequestSchema)` to create a new message.
  */
 export const UpdateBookStatusRequestSchema: GenMessage<UpdateBookStatusRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 19);
+  messageDesc(file_books_v1_library, 20);
 
 /**
  * @generated from message books.v1.UpdateBookStatusResponse
@@ -630,7 +718,7 @@ export type UpdateBookStatusResponse = Message<"books.v1.UpdateBookStatusRespons
  * Use `create(UpdateBookStatusResponseSchema)` to create a new message.
  */
 export const UpdateBookStatusResponseSchema: GenMessage<UpdateBookStatusResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 20);
+  messageDesc(file_books_v1_library, 21);
 
 /**
  * @generated from message books.v1.ToggleTagRequest
@@ -652,7 +740,7 @@ export type ToggleTagRequest = Message<"books.v1.ToggleTagRequest"> & {
  * Use `create(ToggleTagRequestSchema)` to create a new message.
  */
 export const ToggleTagRequestSchema: GenMessage<ToggleTagRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 21);
+  messageDesc(file_books_v1_library, 22);
 
 /**
  * @generated from message books.v1.ToggleTagResponse
@@ -665,7 +753,7 @@ export type ToggleTagResponse = Message<"books.v1.ToggleTagResponse"> & {
  * Use `create(ToggleTagResponseSchema)` to create a new message.
  */
 export const ToggleTagResponseSchema: GenMessage<ToggleTagResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 22);
+  messageDesc(file_books_v1_library, 23);
 
 /**
  * RemoveBook removes the book from the caller's own library only. If no other
@@ -686,7 +774,7 @@ export type RemoveBookRequest = Message<"books.v1.RemoveBookRequest"> & {
  * Use `create(RemoveBookRequestSchema)` to create a new message.
  */
 export const RemoveBookRequestSchema: GenMessage<RemoveBookRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 23);
+  messageDesc(file_books_v1_library, 24);
 
 /**
  * @generated from message books.v1.RemoveBookResponse
@@ -699,7 +787,7 @@ export type RemoveBookResponse = Message<"books.v1.RemoveBookResponse"> & {
  * Use `create(RemoveBookResponseSchema)` to create a new message.
  */
 export const RemoveBookResponseSchema: GenMessage<RemoveBookResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 24);
+  messageDesc(file_books_v1_library, 25);
 
 /**
  * @generated from message books.v1.UpdateFinishedAtRequest
@@ -721,7 +809,7 @@ export type UpdateFinishedAtRequest = Message<"books.v1.UpdateFinishedAtRequest"
  * Use `create(UpdateFinishedAtRequestSchema)` to create a new message.
  */
 export const UpdateFinishedAtRequestSchema: GenMessage<UpdateFinishedAtRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 25);
+  messageDesc(file_books_v1_library, 26);
 
 /**
  * @generated from message books.v1.UpdateFinishedAtResponse
@@ -734,7 +822,7 @@ export type UpdateFinishedAtResponse = Message<"books.v1.UpdateFinishedAtRespons
  * Use `create(UpdateFinishedAtResponseSchema)` to create a new message.
  */
 export const UpdateFinishedAtResponseSchema: GenMessage<UpdateFinishedAtResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 26);
+  messageDesc(file_books_v1_library, 27);
 
 /**
  * @generated from message books.v1.UpdateProgressRequest
@@ -766,7 +854,7 @@ export type UpdateProgressRequest = Message<"books.v1.UpdateProgressRequest"> &
  * Use `create(UpdateProgressRequestSchema)` to create a new message.
  */
 export const UpdateProgressRequestSchema: GenMessage<UpdateProgressRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 27);
+  messageDesc(file_books_v1_library, 28);
 
 /**
  * @generated from message books.v1.UpdateProgressResponse
@@ -779,7 +867,7 @@ export type UpdateProgressResponse = Message<"books.v1.UpdateProgressResponse">
  * Use `create(UpdateProgressResponseSchema)` to create a new message.
  */
 export const UpdateProgressResponseSchema: GenMessage<UpdateProgressResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 28);
+  messageDesc(file_books_v1_library, 29);
 
 /**
  * @generated from message books.v1.UpdateReadingProgressRequest
@@ -811,7 +899,7 @@ export type UpdateReadingProgressRequest = Message<"books.v1.UpdateReadingProgre
  * Use `create(UpdateReadingProgressRequestSchema)` to create a new message.
  */
 export const UpdateReadingProgressRequestSchema: GenMessage<UpdateReadingProgressRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 29);
+  messageDesc(file_books_v1_library, 30);
 
 /**
  * @generated from message books.v1.UpdateReadingProgressResponse
@@ -824,7 +912,132 @@ export type UpdateReadingProgressResponse = Message<"books.v1.UpdateReadingProgr
  * Use `create(UpdateReadingProgressResponseSchema)` to create a new message.
  */
 export const UpdateReadingProgressResponseSchema: GenMessage<UpdateReadingProgressResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 30);
+  messageDesc(file_books_v1_library, 31);
+
+/**
+ * QueueBook appends a to-read book to the end of the "up next" queue.
+ *
+ * @generated from message books.v1.QueueBookRequest
+ */
+export type QueueBookRequest = Message<"books.v1.QueueBookRequest"> & {
+  /**
+   * @generated from field: string book_id = 1;
+   */
+  bookId: string;
+};
+
+/**
+ * Describes the message books.v1.QueueBookRequest.
+ * Use `create(QueueBookRequestSchema)` to create a new message.
+ */
+export const QueueBookRequestSchema: GenMessage<QueueBookRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 32);
+
+/**
+ * @generated from message books.v1.QueueBookResponse
+ */
+export type QueueBookResponse = Message<"books.v1.QueueBookResponse"> & {
+};
+
+/**
+ * Describes the message books.v1.QueueBookResponse.
+ * Use `create(QueueBookResponseSchema)` to create a new message.
+ */
+export const QueueBookResponseSchema: GenMessage<QueueBookResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 33);
+
+/**
+ * @generated from message books.v1.DequeueBookRequest
+ */
+export type DequeueBookRequest = Message<"books.v1.DequeueBookRequest"> & {
+  /**
+   * @generated from field: string book_id = 1;
+   */
+  bookId: string;
+};
+
+/**
+ * Describes the message books.v1.DequeueBookRequest.
+ * Use `create(DequeueBookRequestSchema)` to create a new message.
+ */
+export const DequeueBookRequestSchema: GenMessage<DequeueBookRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 34);
+
+/**
+ * @generated from message books.v1.DequeueBookResponse
+ */
+export type DequeueBookResponse = Message<"books.v1.DequeueBookResponse"> & {
+};
+
+/**
+ * Describes the message books.v1.DequeueBookResponse.
+ * Use `create(DequeueBookResponseSchema)` to create a new message.
+ */
+export const DequeueBookResponseSchema: GenMessage<DequeueBookResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 35);
+
+/**
+ * ReorderQueue puts the listed books at the front of the queue in that
+ * order, queueing any to-read book among them that wasn't yet.
+ *
+ * @generated from message books.v1.ReorderQueueRequest
+ */
+export type ReorderQueueRequest = Message<"books.v1.ReorderQueueRequest"> & {
+  /**
+   * @generated from field: repeated string book_ids = 1;
+   */
+  bookIds: string[];
+};
+
+/**
+ * Describes the message books.v1.ReorderQueueRequest.
+ * Use `create(ReorderQueueRequestSchema)` to create a new message.
+ */
+export const ReorderQueueRequestSchema: GenMessage<ReorderQueueRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 36);
+
+/**
+ * @generated from message books.v1.ReorderQueueResponse
+ */
+export type ReorderQueueResponse = Message<"books.v1.ReorderQueueResponse"> & {
+};
+
+/**
+ * Describes the message books.v1.ReorderQueueResponse.
+ * Use `create(ReorderQueueResponseSchema)` to create a new message.
+ */
+export const ReorderQueueResponseSchema: GenMessage<ReorderQueueResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 37);
+
+/**
+ * @generated from message books.v1.UpdateQueueSettingsRequest
+ */
+export type UpdateQueueSettingsRequest = Message<"books.v1.UpdateQueueSettingsRequest"> & {
+  /**
+   * @generated from field: books.v1.QueueSettings settings = 1;
+   */
+  settings?: QueueSettings;
+};
+
+/**
+ * Describes the message books.v1.UpdateQueueSettingsRequest.
+ * Use `create(UpdateQueueSettingsRequestSchema)` to create a new message.
+ */
+export const UpdateQueueSettingsRequestSchema: GenMessage<UpdateQueueSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 38);
+
+/**
+ * @generated from message books.v1.UpdateQueueSettingsResponse
+ */
+export type UpdateQueueSettingsResponse = Message<"books.v1.UpdateQueueSettingsResponse"> & {
+};
+
+/**
+ * Describes the message books.v1.UpdateQueueSettingsResponse.
+ * Use `create(UpdateQueueSettingsResponseSchema)` to create a new message.
+ */
+export const UpdateQueueSettingsResponseSchema: GenMessage<UpdateQueueSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 39);
 
 /**
  * @generated from message books.v1.GetReadingStateRequest
@@ -841,7 +1054,7 @@ export type GetReadingStateRequest = Message<"books.v1.GetReadingStateRequest">
  * Use `create(GetReadingStateRequestSchema)` to create a new message.
  */
 export const GetReadingStateRequestSchema: GenMessage<GetReadingStateRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 31);
+  messageDesc(file_books_v1_library, 40);
 
 /**
  * @generated from message books.v1.GetReadingStateResponse
@@ -858,7 +1071,7 @@ export type GetReadingStateResponse = Message<"books.v1.GetReadingStateResponse"
  * Use `create(GetReadingStateResponseSchema)` to create a new message.
  */
 export const GetReadingStateResponseSchema: GenMessage<GetReadingStateResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 32);
+  messageDesc(file_books_v1_library, 41);
 
 /**
  * GetBookContent returns the readability-extracted article body stored for a
@@ -879,7 +1092,7 @@ export type GetBookContentRequest = Message<"books.v1.GetBookContentRequest"> &
  * Use `create(GetBookContentRequestSchema)` to create a new message.
  */
 export const GetBookContentRequestSchema: GenMessage<GetBookContentRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 33);
+  messageDesc(file_books_v1_library, 42);
 
 /**
  * @generated from message books.v1.GetBookContentResponse
@@ -896,7 +1109,7 @@ export type GetBookContentResponse = Message<"books.v1.GetBookContentResponse">
  * Use `create(GetBookContentResponseSchema)` to create a new message.
  */
 export const GetBookContentResponseSchema: GenMessage<GetBookContentResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 34);
+  messageDesc(file_books_v1_library, 43);
 
 /**
  * @generated from message books.v1.CreateShelfRequest
@@ -913,7 +1126,7 @@ export type CreateShelfRequest = Message<"books.v1.CreateShelfRequest"> & {
  * Use `create(CreateShelfRequestSchema)` to create a new message.
  */
 export const CreateShelfRequestSchema: GenMessage<CreateShelfRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 35);
+  messageDesc(file_books_v1_library, 44);
 
 /**
  * @generated from message books.v1.CreateShelfResponse
@@ -926,7 +1139,7 @@ export type CreateShelfResponse = Message<"books.v1.CreateShelfResponse"> & {
  * Use `create(CreateShelfResponseSchema)` to create a new message.
  */
 export const CreateShelfResponseSchema: GenMessage<CreateShelfResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 36);
+  messageDesc(file_books_v1_library, 45);
 
 /**
  * @generated from message books.v1.RenameShelfRequest
@@ -948,7 +1161,7 @@ export type RenameShelfRequest = Message<"books.v1.RenameShelfRequest"> & {
  * Use `create(RenameShelfRequestSchema)` to create a new message.
  */
 export const RenameShelfRequestSchema: GenMessage<RenameShelfRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 37);
+  messageDesc(file_books_v1_library, 46);
 
 /**
  * @generated from message books.v1.RenameShelfResponse
@@ -965,7 +1178,7 @@ export type RenameShelfResponse = Message<"books.v1.RenameShelfResponse"> & {
  * Use `create(RenameShelfResponseSchema)` to create a new message.
  */
 export const RenameShelfResponseSchema: GenMessage<RenameShelfResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 38);
+  messageDesc(file_books_v1_library, 47);
 
 /**
  * Reassign every book on `name` to `target_name`, after which `name` no longer exists.
@@ -989,7 +1202,7 @@ export type DeleteShelfRequest = Message<"books.v1.DeleteShelfRequest"> & {
  * Use `create(DeleteShelfRequestSchema)` to create a new message.
  */
 export const DeleteShelfRequestSchema: GenMessage<DeleteShelfRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 39);
+  messageDesc(file_books_v1_library, 48);
 
 /**
  * @generated from message books.v1.DeleteShelfResponse
@@ -1006,7 +1219,7 @@ export type DeleteShelfResponse = Message<"books.v1.DeleteShelfResponse"> & {
  * Use `create(DeleteShelfResponseSchema)` to create a new message.
  */
 export const DeleteShelfResponseSchema: GenMessage<DeleteShelfResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 40);
+  messageDesc(file_books_v1_library, 49);
 
 /**
  * @generated from message books.v1.RenameTagRequest
@@ -1028,7 +1241,7 @@ export type RenameTagRequest = Message<"books.v1.RenameTagRequest"> & {
  * Use `create(RenameTagRequestSchema)` to create a new message.
  */
 export const RenameTagRequestSchema: GenMessage<RenameTagRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 41);
+  messageDesc(file_books_v1_library, 50);
 
 /**
  * @generated from message books.v1.RenameTagResponse
@@ -1045,7 +1258,7 @@ export type RenameTagResponse = Message<"books.v1.RenameTagResponse"> & {
  * Use `create(RenameTagResponseSchema)` to create a new message.
  */
 export const RenameTagResponseSchema: GenMessage<RenameTagResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 42);
+  messageDesc(file_books_v1_library, 51);
 
 /**
  * @generated from message books.v1.DeleteTagRequest
@@ -1062,7 +1275,7 @@ export type DeleteTagRequest = Message<"books.v1.DeleteTagRequest"> & {
  * Use `create(DeleteTagRequestSchema)` to create a new message.
  */
 export const DeleteTagRequestSchema: GenMessage<DeleteTagRequest> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 43);
+  messageDesc(file_books_v1_library, 52);
 
 /**
  * @generated from message books.v1.DeleteTagResponse
@@ -1079,74 +1292,580 @@ export type DeleteTagResponse = Message<"books.v1.DeleteTagResponse"> & {
  * Use `create(DeleteTagResponseSchema)` to create a new message.
  */
 export const DeleteTagResponseSchema: GenMessage<DeleteTagResponse> = /*@__PURE__*/
-  messageDesc(file_books_v1_library, 44);
+  messageDesc(file_books_v1_library, 53);
 
 /**
- * @generated from service books.v1.LibraryService
+ * Annotation is a highlight, note or bookmark made on a device (currently
+ * Kobo) and synced back through the reading services endpoints.
+ *
+ * @generated from message books.v1.Annotation
  */
-export const LibraryService: GenService<{
+export type Annotation = Message<"books.v1.Annotation"> & {
   /**
-   * @generated from rpc books.v1.LibraryService.GetLibrary
+   * @generated from field: string id = 1;
    */
-  getLibrary: {
-    methodKind: "unary";
-    input: typeof GetLibraryRequestSchema;
-    output: typeof GetLibraryResponseSchema;
-  },
+  id: string;
+
   /**
-   * @generated from rpc books.v1.LibraryService.GetBooksProgress
+   * @generated from field: string book_id = 2;
    */
-  getBooksProgress: {
-    methodKind: "unary";
-    input: typeof GetBooksProgressRequestSchema;
-    output: typeof GetBooksProgressResponseSchema;
-  },
+  bookId: string;
+
   /**
-   * @generated from rpc books.v1.LibraryService.SearchLibrary
+   * @generated from field: string book_title = 3;
    */
-  searchLibrary: {
-    methodKind: "unary";
-    input: typeof SearchLibraryRequestSchema;
-    output: typeof SearchLibraryResponseSchema;
-  },
+  bookTitle: string;
+
   /**
-   * @generated from rpc books.v1.LibraryService.SearchExternal
+   * @generated from field: string type = 4;
    */
-  searchExternal: {
-    methodKind: "unary";
-    input: typeof SearchExternalRequestSchema;
-    output: typeof SearchExternalResponseSchema;
-  },
+  type: string;
+
   /**
-   * @generated from rpc books.v1.LibraryService.GetExternalBook
+   * @generated from field: string highlighted_text = 5;
    */
-  getExternalBook: {
-    methodKind: "unary";
-    input: typeof GetExternalBookRequestSchema;
-    output: typeof GetExternalBookResponseSchema;
-  },
+  highlightedText: string;
+
   /**
-   * @generated from rpc books.v1.LibraryService.CreateBook
+   * @generated from field: string note_text = 6;
    */
-  createBook: {
-    methodKind: "unary";
-    input: typeof CreateBookRequestSchema;
-    output: typeof CreateBookResponseSchema;
-  },
+  noteText: string;
+
   /**
-   * @generated from rpc books.v1.LibraryService.UpdateBookStatus
+   * @generated from field: string color = 7;
    */
-  updateBookStatus: {
-    methodKind: "unary";
-    input: typeof UpdateBookStatusRequestSchema;
-    output: typeof UpdateBookStatusResponseSchema;
-  },
+  color: string;
+
   /**
-   * @generated from rpc books.v1.LibraryService.UpdateFinishedAt
+   * @generated from field: string chapter_title = 8;
    */
-  updateFinishedAt: {
-    methodKind: "unary";
-    input: typeof UpdateFinishedAtRequestSchema;
+  chapterTitle: string;
+
+  /**
+   * @generated from field: double chapter_progress = 9;
+   */
+  chapterProgress: number;
+
+  /**
+   * @generated from field: string source = 10;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string created_at = 11;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: string updated_at = 12;
+   */
+  updatedAt: string;
+};
+
+/**
+ * Describes the message books.v1.Annotation.
+ * Use `create(AnnotationSchema)` to create a new message.
+ */
+export const AnnotationSchema: GenMessage<Annotation> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 54);
+
+/**
+ * @generated from message books.v1.ListAnnotationsRequest
+ */
+export type ListAnnotationsRequest = Message<"books.v1.ListAnnotationsRequest"> & {
+  /**
+   * @generated from field: string book_id = 1;
+   */
+  bookId: string;
+};
+
+/**
+ * Describes the message books.v1.ListAnnotationsRequest.
+ * Use `create(ListAnnotationsRequestSchema)` to create a new message.
+ */
+export const ListAnnotationsRequestSchema: GenMessage<ListAnnotationsRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 55);
+
+/**
+ * @generated from message books.v1.ListAnnotationsResponse
+ */
+export type ListAnnotationsResponse = Message<"books.v1.ListAnnotationsResponse"> & {
+  /**
+   * @generated from field: repeated books.v1.Annotation annotations = 1;
+   */
+  annotations: Annotation[];
+};
+
+/**
+ * Describes the message books.v1.ListAnnotationsResponse.
+ * Use `create(ListAnnotationsResponseSchema)` to create a new message.
+ */
+export const ListAnnotationsResponseSchema: GenMessage<ListAnnotationsResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 56);
+
+/**
+ * @generated from message books.v1.SearchAnnotationsRequest
+ */
+export type SearchAnnotationsRequest = Message<"books.v1.SearchAnnotationsRequest"> & {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: int32 offset = 3;
+   */
+  offset: number;
+};
+
+/**
+ * Describes the message books.v1.SearchAnnotationsRequest.
+ * Use `create(SearchAnnotationsRequestSchema)` to create a new message.
+ */
+export const SearchAnnotationsRequestSchema: GenMessage<SearchAnnotationsRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 57);
+
+/**
+ * @generated from message books.v1.SearchAnnotationsResponse
+ */
+export type SearchAnnotationsResponse = Message<"books.v1.SearchAnnotationsResponse"> & {
+  /**
+   * @generated from field: repeated books.v1.Annotation annotations = 1;
+   */
+  annotations: Annotation[];
+
+  /**
+   * @generated from field: bool has_more = 2;
+   */
+  hasMore: boolean;
+};
+
+/**
+ * Describes the message books.v1.SearchAnnotationsResponse.
+ * Use `create(SearchAnnotationsResponseSchema)` to create a new message.
+ */
+export const SearchAnnotationsResponseSchema: GenMessage<SearchAnnotationsResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 58);
+
+/**
+ * ExportAnnotations renders highlights as a downloadable file. An empty
+ * book_id exports every book; format is "markdown" (default) or "json".
+ *
+ * @generated from message books.v1.ExportAnnotationsRequest
+ */
+export type ExportAnnotationsRequest = Message<"books.v1.ExportAnnotationsRequest"> & {
+  /**
+   * @generated from field: string book_id = 1;
+   */
+  bookId: string;
+
+  /**
+   * @generated from field: string format = 2;
+   */
+  format: string;
+};
+
+/**
+ * Describes the message books.v1.ExportAnnotationsRequest.
+ * Use `create(ExportAnnotationsRequestSchema)` to create a new message.
+ */
+export const ExportAnnotationsRequestSchema: GenMessage<ExportAnnotationsRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 59);
+
+/**
+ * @generated from message books.v1.ExportAnnotationsResponse
+ */
+export type ExportAnnotationsResponse = Message<"books.v1.ExportAnnotationsResponse"> & {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: string content_type = 2;
+   */
+  contentType: string;
+
+  /**
+   * @generated from field: string filename = 3;
+   */
+  filename: string;
+};
+
+/**
+ * Describes the message books.v1.ExportAnnotationsResponse.
+ * Use `create(ExportAnnotationsResponseSchema)` to create a new message.
+ */
+export const ExportAnnotationsResponseSchema: GenMessage<ExportAnnotationsResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 60);
+
+/**
+ * TextHit is a passage in the text of a book file or article that matched a
+ * SearchBookText query. location is where the reader opens it: the content
+ * document of the file named by format ("epub" or "kepub"); it is empty
+ * when format is "article". snippet is plain text with the matched words wrapped in
+ * <mark></mark>.
+ *
+ * @generated from message books.v1.TextHit
+ */
+export type TextHit = Message<"books.v1.TextHit"> & {
+  /**
+   * @generated from field: string user_book_id = 1;
+   */
+  userBookId: string;
+
+  /**
+   * @generated from field: string book_id = 2;
+   */
+  bookId: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: repeated string authors = 4;
+   */
+  authors: string[];
+
+  /**
+   * @generated from field: string chapter = 5;
+   */
+  chapter: string;
+
+  /**
+   * @generated from field: string location = 6;
+   */
+  location: string;
+
+  /**
+   * @generated from field: string format = 7;
+   */
+  format: string;
+
+  /**
+   * @generated from field: string snippet = 8;
+   */
+  snippet: string;
+};
+
+/**
+ * Describes the message books.v1.TextHit.
+ * Use `create(TextHitSchema)` to create a new message.
+ */
+export const TextHitSchema: GenMessage<TextHit> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 61);
+
+/**
+ * SearchBookText searches inside the user's uploaded books and ingested
+ * articles. query accepts web search syntax: "quoted phrases", or, -word.
+ *
+ * @generated from message books.v1.SearchBookTextRequest
+ */
+export type SearchBookTextRequest = Message<"books.v1.SearchBookTextRequest"> & {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: int32 offset = 3;
+   */
+  offset: number;
+};
+
+/**
+ * Describes the message books.v1.SearchBookTextRequest.
+ * Use `create(SearchBookTextRequestSchema)` to create a new message.
+ */
+export const SearchBookTextRequestSchema: GenMessage<SearchBookTextRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 62);
+
+/**
+ * @generated from message books.v1.SearchBookTextResponse
+ */
+export type SearchBookTextResponse = Message<"books.v1.SearchBookTextResponse"> & {
+  /**
+   * @generated from field: repeated books.v1.TextHit hits = 1;
+   */
+  hits: TextHit[];
+
+  /**
+   * @generated from field: bool has_more = 2;
+   */
+  hasMore: boolean;
+};
+
+/**
+ * Describes the message books.v1.SearchBookTextResponse.
+ * Use `create(SearchBookTextResponseSchema)` to create a new message.
+ */
+export const SearchBookTextResponseSchema: GenMessage<SearchBookTextResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 63);
+
+/**
+ * ReadingDay is the time spent reading one book on one UTC day.
+ *
+ * @generated from message books.v1.ReadingDay
+ */
+export type ReadingDay = Message<"books.v1.ReadingDay"> & {
+  /**
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: string book_id = 2;
+   */
+  bookId: string;
+
+  /**
+   * @generated from field: int32 seconds = 3;
+   */
+  seconds: number;
+
+  /**
+   * @generated from field: int32 sessions = 4;
+   */
+  sessions: number;
+
+  /**
+   * @generated from field: int32 pages_turned = 5;
+   */
+  pagesTurned: number;
+};
+
+/**
+ * Describes the message books.v1.ReadingDay.
+ * Use `create(ReadingDaySchema)` to create a new message.
+ */
+export const ReadingDaySchema: GenMessage<ReadingDay> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 64);
+
+/**
+ * BookReadingStats summarises every reading session of a book. Speeds are 0
+ * when the sessions don't say how far they got; remaining_seconds and
+ * estimated_finish are only set for books still being read, the latter from
+ * the reading pace of the last two weeks.
+ *
+ * @generated from message books.v1.BookReadingStats
+ */
+export type BookReadingStats = Message<"books.v1.BookReadingStats"> & {
+  /**
+   * @generated from field: string book_id = 1;
+   */
+  bookId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: repeated string authors = 3;
+   */
+  authors: string[];
+
+  /**
+   * @generated from field: string status = 4;
+   */
+  status: string;
+
+  /**
+   * @generated from field: int32 percent = 5;
+   */
+  percent: number;
+
+  /**
+   * @generated from field: int32 seconds = 6;
+   */
+  seconds: number;
+
+  /**
+   * @generated from field: int32 sessions = 7;
+   */
+  sessions: number;
+
+  /**
+   * @generated from field: int32 pages_turned = 8;
+   */
+  pagesTurned: number;
+
+  /**
+   * @generated from field: double percent_per_hour = 9;
+   */
+  percentPerHour: number;
+
+  /**
+   * @generated from field: double pages_per_hour = 10;
+   */
+  pagesPerHour: number;
+
+  /**
+   * @generated from field: int32 remaining_seconds = 11;
+   */
+  remainingSeconds: number;
+
+  /**
+   * @generated from field: string estimated_finish = 12;
+   */
+  estimatedFinish: string;
+
+  /**
+   * @generated from field: string last_read_at = 13;
+   */
+  lastReadAt: string;
+};
+
+/**
+ * Describes the message books.v1.BookReadingStats.
+ * Use `create(BookReadingStatsSchema)` to create a new message.
+ */
+export const BookReadingStatsSchema: GenMessage<BookReadingStats> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 65);
+
+/**
+ * GetReadingStats reports reading sessions (Kobo, KOReader, web reader)
+ * that started in the date range, which defaults to the last year.
+ *
+ * @generated from message books.v1.GetReadingStatsRequest
+ */
+export type GetReadingStatsRequest = Message<"books.v1.GetReadingStatsRequest"> & {
+  /**
+   * @generated from field: string date_start = 1;
+   */
+  dateStart: string;
+
+  /**
+   * @generated from field: string date_end = 2;
+   */
+  dateEnd: string;
+};
+
+/**
+ * Describes the message books.v1.GetReadingStatsRequest.
+ * Use `create(GetReadingStatsRequestSchema)` to create a new message.
+ */
+export const GetReadingStatsRequestSchema: GenMessage<GetReadingStatsRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 66);
+
+/**
+ * @generated from message books.v1.GetReadingStatsResponse
+ */
+export type GetReadingStatsResponse = Message<"books.v1.GetReadingStatsResponse"> & {
+  /**
+   * @generated from field: repeated books.v1.ReadingDay days = 1;
+   */
+  days: ReadingDay[];
+
+  /**
+   * @generated from field: repeated books.v1.BookReadingStats books = 2;
+   */
+  books: BookReadingStats[];
+
+  /**
+   * @generated from field: string date_start = 3;
+   */
+  dateStart: string;
+
+  /**
+   * @generated from field: string date_end = 4;
+   */
+  dateEnd: string;
+};
+
+/**
+ * Describes the message books.v1.GetReadingStatsResponse.
+ * Use `create(GetReadingStatsResponseSchema)` to create a new message.
+ */
+export const GetReadingStatsResponseSchema: GenMessage<GetReadingStatsResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_library, 67);
+
+/**
+ * @generated from service books.v1.LibraryService
+ */
+export const LibraryService: GenService<{
+  /**
+   * @generated from rpc books.v1.LibraryService.GetLibrary
+   */
+  getLibrary: {
+    methodKind: "unary";
+    input: typeof GetLibraryRequestSchema;
+    output: typeof GetLibraryResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.GetBooksProgress
+   */
+  getBooksProgress: {
+    methodKind: "unary";
+    input: typeof GetBooksProgressRequestSchema;
+    output: typeof GetBooksProgressResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.GetReadingStats
+   */
+  getReadingStats: {
+    methodKind: "unary";
+    input: typeof GetReadingStatsRequestSchema;
+    output: typeof GetReadingStatsResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.SearchLibrary
+   */
+  searchLibrary: {
+    methodKind: "unary";
+    input: typeof SearchLibraryRequestSchema;
+    output: typeof SearchLibraryResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.SearchExternal
+   */
+  searchExternal: {
+    methodKind: "unary";
+    input: typeof SearchExternalRequestSchema;
+    output: typeof SearchExternalResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.GetExternalBook
+   */
+  getExternalBook: {
+    methodKind: "unary";
+    input: typeof GetExternalBookRequestSchema;
+    output: typeof GetExternalBookResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.CreateBook
+   */
+  createBook: {
+    methodKind: "unary";
+    input: typeof CreateBookRequestSchema;
+    output: typeof CreateBookResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.UpdateBookStatus
+   */
+  updateBookStatus: {
+    methodKind: "unary";
+    input: typeof UpdateBookStatusRequestSchema;
+    output: typeof UpdateBookStatusResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.UpdateFinishedAt
+   */
+  updateFinishedAt: {
+    methodKind: "unary";
+    input: typeof UpdateFinishedAtRequestSchema;
     output: typeof UpdateFinishedAtResponseSchema;
   },
   /**
@@ -1173,6 +1892,38 @@ export const LibraryService: GenService<{
     input: typeof RemoveBookRequestSchema;
     output: typeof RemoveBookResponseSchema;
   },
+  /**
+   * @generated from rpc books.v1.LibraryService.QueueBook
+   */
+  queueBook: {
+    methodKind: "unary";
+    input: typeof QueueBookRequestSchema;
+    output: typeof QueueBookResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.DequeueBook
+   */
+  dequeueBook: {
+    methodKind: "unary";
+    input: typeof DequeueBookRequestSchema;
+    output: typeof DequeueBookResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.ReorderQueue
+   */
+  reorderQueue: {
+    methodKind: "unary";
+    input: typeof ReorderQueueRequestSchema;
+    output: typeof ReorderQueueResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.UpdateQueueSettings
+   */
+  updateQueueSettings: {
+    methodKind: "unary";
+    input: typeof UpdateQueueSettingsRequestSchema;
+    output: typeof UpdateQueueSettingsResponseSchema;
+  },
   /**
    * @generated from rpc books.v1.LibraryService.UpdateReadingProgress
    */
@@ -1237,6 +1988,38 @@ export const LibraryService: GenService<{
     input: typeof DeleteTagRequestSchema;
     output: typeof DeleteTagResponseSchema;
   },
+  /**
+   * @generated from rpc books.v1.LibraryService.ListAnnotations
+   */
+  listAnnotations: {
+    methodKind: "unary";
+    input: typeof ListAnnotationsRequestSchema;
+    output: typeof ListAnnotationsResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.SearchAnnotations
+   */
+  searchAnnotations: {
+    methodKind: "unary";
+    input: typeof SearchAnnotationsRequestSchema;
+    output: typeof SearchAnnotationsResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.ExportAnnotations
+   */
+  exportAnnotations: {
+    methodKind: "unary";
+    input: typeof ExportAnnotationsRequestSchema;
+    output: typeof ExportAnnotationsResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.LibraryService.SearchBookText
+   */
+  searchBookText: {
+    methodKind: "unary";
+    input: typeof SearchBookTextRequestSchema;
+    output: typeof SearchBookTextResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_books_v1_library, 0);
 
@@ -0,0 +1,179 @@
+// @generated by protoc-gen-es v2.14.0 with parameter "target=ts,import_extension=none"
+// @generated from file books/v1/opds.proto (package books.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file books/v1/opds.proto.
+ */
+export const file_books_v1_opds: GenFile = /*@__PURE__*/
+  fileDesc("ChNib29rcy92MS9vcGRzLnByb3RvEghib29rcy52MSJPCglPUERTVG9rZW4SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRISCgpjcmVhdGVkX2F0GAMgASgJEhQKDGxhc3RfdXNlZF9hdBgEIAEoCSImChZDcmVhdGVPUERTVG9rZW5SZXF1ZXN0EgwKBG5hbWUYASABKAkiUAoXQ3JlYXRlT1BEU1Rva2VuUmVzcG9uc2USIgoFdG9rZW4YASABKAsyEy5ib29rcy52MS5PUERTVG9rZW4SEQoJcmF3X3Rva2VuGAIgASgJIhcKFUxpc3RPUERTVG9rZW5zUmVxdWVzdCI9ChZMaXN0T1BEU1Rva2Vuc1Jlc3BvbnNlEiMKBnRva2VucxgBIAMoCzITLmJvb2tzLnYxLk9QRFNUb2tlbiIkChZSZXZva2VPUERTVG9rZW5SZXF1ZXN0EgoKAmlkGAEgASgJIhkKF1Jldm9rZU9QRFNUb2tlblJlc3BvbnNlMpICCgtPUERTU2VydmljZRJWCg9DcmVhdGVPUERTVG9rZW4SIC5ib29rcy52MS5DcmVhdGVPUERTVG9rZW5SZXF1ZXN0GiEuYm9va3MudjEuQ3JlYXRlT1BEU1Rva2VuUmVzcG9uc2USUwoOTGlzdE9QRFNUb2tlbnMSHy5ib29rcy52MS5MaXN0T1BEU1Rva2Vuc1JlcXVlc3QaIC5ib29rcy52MS5MaXN0T1BEU1Rva2Vuc1Jlc3BvbnNlElYKD1Jldm9rZU9QRFNUb2tlbhIgLmJvb2tzLnYxLlJldm9rZU9QRFNUb2tlblJlcXVlc3QaIS5ib29rcy52MS5SZXZva2VPUERTVG9rZW5SZXNwb25zZUIpWid0b29scy54ZG91YmxldS5jb20vZ2VuL2Jvb2tzL3YxO2Jvb2tzdjFiBnByb3RvMw");
+
+/**
+ * OPDSToken grants a reader app access to the OPDS catalog. The raw token is
+ * part of the catalog URL and is only returned once, on creation.
+ *
+ * @generated from message books.v1.OPDSToken
+ */
+export type OPDSToken = Message<"books.v1.OPDSToken"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string created_at = 3;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: string last_used_at = 4;
+   */
+  lastUsedAt: string;
+};
+
+/**
+ * Describes the message books.v1.OPDSToken.
+ * Use `create(OPDSTokenSchema)` to create a new message.
+ */
+export const OPDSTokenSchema: GenMessage<OPDSToken> = /*@__PURE__*/
+  messageDesc(file_books_v1_opds, 0);
+
+/**
+ * @generated from message books.v1.CreateOPDSTokenRequest
+ */
+export type CreateOPDSTokenRequest = Message<"books.v1.CreateOPDSTokenRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message books.v1.CreateOPDSTokenRequest.
+ * Use `create(CreateOPDSTokenRequestSchema)` to create a new message.
+ */
+export const CreateOPDSTokenRequestSchema: GenMessage<CreateOPDSTokenRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_opds, 1);
+
+/**
+ * @generated from message books.v1.CreateOPDSTokenResponse
+ */
+export type CreateOPDSTokenResponse = Message<"books.v1.CreateOPDSTokenResponse"> & {
+  /**
+   * @generated from field: books.v1.OPDSToken token = 1;
+   */
+  token?: OPDSToken | undefined;
+
+  /**
+   * @generated from field: string raw_token = 2;
+   */
+  rawToken: string;
+};
+
+/**
+ * Describes the message books.v1.CreateOPDSTokenResponse.
+ * Use `create(CreateOPDSTokenResponseSchema)` to create a new message.
+ */
+export const CreateOPDSTokenResponseSchema: GenMessage<CreateOPDSTokenResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_opds, 2);
+
+/**
+ * @generated from message books.v1.ListOPDSTokensRequest
+ */
+export type ListOPDSTokensRequest = Message<"books.v1.ListOPDSTokensRequest"> & {
+};
+
+/**
+ * Describes the message books.v1.ListOPDSTokensRequest.
+ * Use `create(ListOPDSTokensRequestSchema)` to create a new message.
+ */
+export const ListOPDSTokensRequestSchema: GenMessage<ListOPDSTokensRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_opds, 3);
+
+/**
+ * @generated from message books.v1.ListOPDSTokensResponse
+ */
+export type ListOPDSTokensResponse = Message<"books.v1.ListOPDSTokensResponse"> & {
+  /**
+   * @generated from field: repeated books.v1.OPDSToken tokens = 1;
+   */
+  tokens: OPDSToken[];
+};
+
+/**
+ * Describes the message books.v1.ListOPDSTokensResponse.
+ * Use `create(ListOPDSTokensResponseSchema)` to create a new message.
+ */
+export const ListOPDSTokensResponseSchema: GenMessage<ListOPDSTokensResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_opds, 4);
+
+/**
+ * @generated from message books.v1.RevokeOPDSTokenRequest
+ */
+export type RevokeOPDSTokenRequest = Message<"books.v1.RevokeOPDSTokenRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message books.v1.RevokeOPDSTokenRequest.
+ * Use `create(RevokeOPDSTokenRequestSchema)` to create a new message.
+ */
+export const RevokeOPDSTokenRequestSchema: GenMessage<RevokeOPDSTokenRequest> = /*@__PURE__*/
+  messageDesc(file_books_v1_opds, 5);
+
+/**
+ * @generated from message books.v1.RevokeOPDSTokenResponse
+ */
+export type RevokeOPDSTokenResponse = Message<"books.v1.RevokeOPDSTokenResponse"> & {
+};
+
+/**
+ * Describes the message books.v1.RevokeOPDSTokenResponse.
+ * Use `create(RevokeOPDSTokenResponseSchema)` to create a new message.
+ */
+export const RevokeOPDSTokenResponseSchema: GenMessage<RevokeOPDSTokenResponse> = /*@__PURE__*/
+  messageDesc(file_books_v1_opds, 6);
+
+/**
+ * @generated from service books.v1.OPDSService
+ */
+export const OPDSService: GenService<{
+  /**
+   * @generated from rpc books.v1.OPDSService.CreateOPDSToken
+   */
+  createOPDSToken: {
+    methodKind: "unary";
+    input: typeof CreateOPDSTokenRequestSchema;
+    output: typeof CreateOPDSTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.OPDSService.ListOPDSTokens
+   */
+  listOPDSTokens: {
+    methodKind: "unary";
+    input: typeof ListOPDSTokensRequestSchema;
+    output: typeof ListOPDSTokensResponseSchema;
+  },
+  /**
+   * @generated from rpc books.v1.OPDSService.RevokeOPDSToken
+   */
+  revokeOPDSToken: {
+    methodKind: "unary";
+    input: typeof RevokeOPDSTokenRequestSchema;
+    output: typeof RevokeOPDSTokenResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_books_v1_opds, 0);
+
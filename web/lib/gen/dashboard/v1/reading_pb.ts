@@ -4,6 +4,8 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { ReadingGoalProgress } from "../../books/v1/goals_pb";
+import { file_books_v1_goals } from "../../books/v1/goals_pb";
 import type { BooksProgressResponse, LibraryResponse } from "../../books/v1/library_pb";
 import { file_books_v1_library } from "../../books/v1/library_pb";
 import type { Message } from "@bufbuild/protobuf";
@@ -12,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file dashboard/v1/reading.proto.
  */
 export const file_dashboard_v1_reading: GenFile = /*@__PURE__*/
-  fileDesc("ChpkYXNoYm9hcmQvdjEvcmVhZGluZy5wcm90bxIMZGFzaGJvYXJkLnYxIigKF0dldFNoYXJlZExpYnJhcnlSZXF1ZXN0Eg0KBXRva2VuGAEgASgJInQKGEdldFNoYXJlZExpYnJhcnlSZXNwb25zZRIqCgdsaWJyYXJ5GAEgASgLMhkuYm9va3MudjEuTGlicmFyeVJlc3BvbnNlEhYKDmxhc3Rfc3luY2VkX2F0GAIgASgJEhQKDGRpc3BsYXlfbmFtZRgDIAEoCSJUCh1HZXRTaGFyZWRCb29rc1Byb2dyZXNzUmVxdWVzdBINCgV0b2tlbhgBIAEoCRISCgpkYXRlX3N0YXJ0GAIgASgJEhAKCGRhdGVfZW5kGAMgASgJIlMKHkdldFNoYXJlZEJvb2tzUHJvZ3Jlc3NSZXNwb25zZRIxCghwcm9ncmVzcxgBIAEoCzIfLmJvb2tzLnYxLkJvb2tzUHJvZ3Jlc3NSZXNwb25zZSItChxHZXRTaGFyZWRGZWVkc1N1bW1hcnlSZXF1ZXN0Eg0KBXRva2VuGAEgASgJIigKClNoYXJlZEZlZWQSDQoFdGl0bGUYASABKAkSCwoDdXJsGAIgASgJIkgKHUdldFNoYXJlZEZlZWRzU3VtbWFyeVJlc3BvbnNlEicKBWZlZWRzGAEgAygLMhguZGFzaGJvYXJkLnYxLlNoYXJlZEZlZWQy6QIKHVB1YmxpY1JlYWRpbmdEYXNoYm9hcmRTZXJ2aWNlEmEKEEdldFNoYXJlZExpYnJhcnkSJS5kYXNoYm9hcmQudjEuR2V0U2hhcmVkTGlicmFyeVJlcXVlc3QaJi5kYXNoYm9hcmQudjEuR2V0U2hhcmVkTGlicmFyeVJlc3BvbnNlEnMKFkdldFNoYXJlZEJvb2tzUHJvZ3Jlc3MSKy5kYXNoYm9hcmQudjEuR2V0U2hhcmVkQm9va3NQcm9ncmVzc1JlcXVlc3QaLC5kYXNoYm9hcmQudjEuR2V0U2hhcmVkQm9va3NQcm9ncmVzc1Jlc3BvbnNlEnAKFUdldFNoYXJlZEZlZWRzU3VtbWFyeRIqLmRhc2hib2FyZC52MS5HZXRTaGFyZWRGZWVkc1N1bW1hcnlSZXF1ZXN0GisuZGFzaGJvYXJkLnYxLkdldFNoYXJlZEZlZWRzU3VtbWFyeVJlc3BvbnNlQjFaL3Rvb2xzLnhkb3VibGV1LmNvbS9nZW4vZGFzaGJvYXJkL3YxO2Rhc2hib2FyZHYxYgZwcm90bzM", [file_books_v1_library]);
+  fileDesc("ChpkYXNoYm9hcmQvdjEvcmVhZGluZy5wcm90bxIMZGFzaGJvYXJkLnYxIigKF0dldFNoYXJlZExpYnJhcnlSZXF1ZXN0Eg0KBXRva2VuGAEgASgJInQKGEdldFNoYXJlZExpYnJhcnlSZXNwb25zZRIqCgdsaWJyYXJ5GAEgASgLMhkuYm9va3MudjEuTGlicmFyeVJlc3BvbnNlEhYKDmxhc3Rfc3luY2VkX2F0GAIgASgJEhQKDGRpc3BsYXlfbmFtZRgDIAEoCSJUCh1HZXRTaGFyZWRCb29rc1Byb2dyZXNzUmVxdWVzdBINCgV0b2tlbhgBIAEoCRISCgpkYXRlX3N0YXJ0GAIgASgJEhAKCGRhdGVfZW5kGAMgASgJIlMKHkdldFNoYXJlZEJvb2tzUHJvZ3Jlc3NSZXNwb25zZRIxCghwcm9ncmVzcxgBIAEoCzIfLmJvb2tzLnYxLkJvb2tzUHJvZ3Jlc3NSZXNwb25zZSItChxHZXRTaGFyZWRGZWVkc1N1bW1hcnlSZXF1ZXN0Eg0KBXRva2VuGAEgASgJIigKClNoYXJlZEZlZWQSDQoFdGl0bGUYASABKAkSCwoDdXJsGAIgASgJIkgKHUdldFNoYXJlZEZlZWRzU3VtbWFyeVJlc3BvbnNlEicKBWZlZWRzGAEgAygLMhguZGFzaGJvYXJkLnYxLlNoYXJlZEZlZWQiOwocR2V0U2hhcmVkUmVhZGluZ0dvYWxzUmVxdWVzdBINCgV0b2tlbhgBIAEoCRIMCgR5ZWFyGAIgASgFIk0KHUdldFNoYXJlZFJlYWRpbmdHb2Fsc1Jlc3BvbnNlEiwKBWdvYWxzGAEgAygLMh0uYm9va3MudjEuUmVhZGluZ0dvYWxQcm9ncmVzczLbAwodUHVibGljUmVhZGluZ0Rhc2hib2FyZFNlcnZpY2USYQoQR2V0U2hhcmVkTGlicmFyeRIlLmRhc2hib2FyZC52MS5HZXRTaGFyZWRMaWJyYXJ5UmVxdWVzdBomLmRhc2hib2FyZC52MS5HZXRTaGFyZWRMaWJyYXJ5UmVzcG9uc2UScwoWR2V0U2hhcmVkQm9va3NQcm9ncmVzcxIrLmRhc2hib2FyZC52MS5HZXRTaGFyZWRCb29rc1Byb2dyZXNzUmVxdWVzdBosLmRhc2hib2FyZC52MS5HZXRTaGFyZWRCb29rc1Byb2dyZXNzUmVzcG9uc2UScAoVR2V0U2hhcmVkRmVlZHNTdW1tYXJ5EiouZGFzaGJvYXJkLnYxLkdldFNoYXJlZEZlZWRzU3VtbWFyeVJlcXVlc3QaKy5kYXNoYm9hcmQudjEuR2V0U2hhcmVkRmVlZHNTdW1tYXJ5UmVzcG9uc2UScAoVR2V0U2hhcmVkUmVhZGluZ0dvYWxzEiouZGFzaGJvYXJkLnYxLkdldFNoYXJlZFJlYWRpbmdHb2Fsc1JlcXVlc3QaKy5kYXNoYm9hcmQudjEuR2V0U2hhcmVkUmVhZGluZ0dvYWxzUmVzcG9uc2VCMVovdG9vbHMueGRvdWJsZXUuY29tL2dlbi9kYXNoYm9hcmQvdjE7ZGFzaGJvYXJkdjFiBnByb3RvMw", [file_books_v1_goals, file_books_v1_library]);
 
 /**
  * @generated from message dashboard.v1.GetSharedLibraryRequest
@@ -171,6 +173,49 @@ export type GetSharedFeedsSummaryResponse = Message<"dashboard.v1.GetSharedFeeds
 export const GetSharedFeedsSummaryResponseSchema: GenMessage<GetSharedFeedsSummaryResponse> = /*@__PURE__*/
   messageDesc(file_dashboard_v1_reading, 6);
 
+/**
+ * GetSharedReadingGoals returns progress on the goals the owner opted into
+ * showing publicly, for a year (default: the current one). Empty when none
+ * are shared.
+ *
+ * @generated from message dashboard.v1.GetSharedReadingGoalsRequest
+ */
+export type GetSharedReadingGoalsRequest = Message<"dashboard.v1.GetSharedReadingGoalsRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * @generated from field: int32 year = 2;
+   */
+  year: number;
+};
+
+/**
+ * Describes the message dashboard.v1.GetSharedReadingGoalsRequest.
+ * Use `create(GetSharedReadingGoalsRequestSchema)` to create a new message.
+ */
+export const GetSharedReadingGoalsRequestSchema: GenMessage<GetSharedReadingGoalsRequest> = /*@__PURE__*/
+  messageDesc(file_dashboard_v1_reading, 7);
+
+/**
+ * @generated from message dashboard.v1.GetSharedReadingGoalsResponse
+ */
+export type GetSharedReadingGoalsResponse = Message<"dashboard.v1.GetSharedReadingGoalsResponse"> & {
+  /**
+   * @generated from field: repeated books.v1.ReadingGoalProgress goals = 1;
+   */
+  goals: ReadingGoalProgress[];
+};
+
+/**
+ * Describes the message dashboard.v1.GetSharedReadingGoalsResponse.
+ * Use `create(GetSharedReadingGoalsResponseSchema)` to create a new message.
+ */
+export const GetSharedReadingGoalsResponseSchema: GenMessage<GetSharedReadingGoalsResponse> = /*@__PURE__*/
+  messageDesc(file_dashboard_v1_reading, 8);
+
 /**
  * PublicReadingDashboardService serves the read-only shareable reading
  * dashboard — the books library plus a feeds summary (issue #737). It is
@@ -205,6 +250,14 @@ export const PublicReadingDashboardService: GenService<{
     input: typeof GetSharedFeedsSummaryRequestSchema;
     output: typeof GetSharedFeedsSummaryResponseSchema;
   },
+  /**
+   * @generated from rpc dashboard.v1.PublicReadingDashboardService.GetSharedReadingGoals
+   */
+  getSharedReadingGoals: {
+    methodKind: "unary";
+    input: typeof GetSharedReadingGoalsRequestSchema;
+    output: typeof GetSharedReadingGoalsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_dashboard_v1_reading, 0);
 
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file feeds/v1/feeds.proto.
  */
 export const file_feeds_v1_feeds: GenFile = /*@__PURE__*/
-  fileDesc("ChRmZWVkcy92MS9mZWVkcy5wcm90bxIIZmVlZHMudjEi9QEKBEZlZWQSCgoCaWQYASABKAkSCwoDdXJsGAIgASgJEg0KBXRpdGxlGAMgASgJEhcKD2xhc3RfZmV0Y2hlZF9hdBgEIAEoCRISCgpsYXN0X2Vycm9yGAUgASgJEhIKCmNyZWF0ZWRfYXQYBiABKAkSEwoLc291cmNlX3R5cGUYByABKAkSFwoPaW5ib3VuZF9hZGRyZXNzGAggASgJEgwKBGV0YWcYCSABKAkSFQoNbGFzdF9tb2RpZmllZBgKIAEoCRIcChRjb25zZWN1dGl2ZV9mYWlsdXJlcxgLIAEoBRITCgtub3RpZmllZF9hdBgMIAEoCSISChBMaXN0RmVlZHNSZXF1ZXN0IjIKEUxpc3RGZWVkc1Jlc3BvbnNlEh0KBWZlZWRzGAEgAygLMg4uZmVlZHMudjEuRmVlZCJRChFDcmVhdGVGZWVkUmVxdWVzdBILCgN1cmwYASABKAkSIAoEa2luZBgCIAEoDjISLmZlZWRzLnYxLkZlZWRLaW5kEg0KBXRpdGxlGAMgASgJIjIKEkNyZWF0ZUZlZWRSZXNwb25zZRIcCgRmZWVkGAEgASgLMg4uZmVlZHMudjEuRmVlZCIzChFVcGRhdGVGZWVkUmVxdWVzdBIPCgdmZWVkX2lkGAEgASgJEg0KBXRpdGxlGAIgASgJIhQKElVwZGF0ZUZlZWRSZXNwb25zZSIkChFEZWxldGVGZWVkUmVxdWVzdBIPCgdmZWVkX2lkGAEgASgJIhQKEkRlbGV0ZUZlZWRSZXNwb25zZSIlChJSZWZyZXNoRmVlZFJlcXVlc3QSDwoHZmVlZF9pZBgBIAEoCSInChNSZWZyZXNoRmVlZFJlc3BvbnNlEhAKCGluZ2VzdGVkGAEgASgFIoQCCgRJdGVtEgoKAmlkGAEgASgJEg8KB2ZlZWRfaWQYAiABKAkSDQoFdGl0bGUYAyABKAkSEgoKc291cmNlX3VybBgEIAEoCRIUCgxjb250ZW50X2h0bWwYBSABKAkSFAoMcHVibGlzaGVkX2F0GAYgASgJEg8KB3JlYWRfYXQYByABKAkSEQoJZGlzbWlzc2VkGAggASgIEhIKCmJvb2ttYXJrZWQYCSABKAgSFAoMaW5nZXN0X2Vycm9yGAogASgJEhIKCmNyZWF0ZWRfYXQYCyABKAkSGQoRcmVhZF9wcm9ncmVzc19wY3QYDCABKAUSEwoLaGFzX2NvbnRlbnQYDSABKAgiswEKFExpc3RGZWVkSXRlbXNSZXF1ZXN0Eg0KBWxpbWl0GAEgASgFEg4KBm9mZnNldBgCIAEoBRIYCgt1bnJlYWRfb25seRgDIAEoCEgAiAEBEhQKB2ZlZWRfaWQYBCABKAlIAYgBARIcCg9ib29rbWFya2VkX29ubHkYBSABKAhIAogBAUIOCgxfdW5yZWFkX29ubHlCCgoIX2ZlZWRfaWRCEgoQX2Jvb2ttYXJrZWRfb25seSJIChVMaXN0RmVlZEl0ZW1zUmVzcG9uc2USHQoFaXRlbXMYASADKAsyDi5mZWVkcy52MS5JdGVtEhAKCGhhc19tb3JlGAIgASgIIiUKEkdldEZlZWRJdGVtUmVxdWVzdBIPCgdpdGVtX2lkGAEgASgJIjMKE0dldEZlZWRJdGVtUmVzcG9uc2USHAoEaXRlbRgBIAEoCzIOLmZlZWRzLnYxLkl0ZW0ixAEKEVVwZGF0ZUl0ZW1SZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkSEQoEcmVhZBgCIAEoCEgAiAEBEhYKCWRpc21pc3NlZBgDIAEoCEgBiAEBEhcKCmJvb2ttYXJrZWQYBCABKAhIAogBARIeChFyZWFkX3Byb2dyZXNzX3BjdBgFIAEoBUgDiAEBQgcKBV9yZWFkQgwKCl9kaXNtaXNzZWRCDQoLX2Jvb2ttYXJrZWRCFAoSX3JlYWRfcHJvZ3Jlc3NfcGN0IjIKElVwZGF0ZUl0ZW1SZXNwb25zZRIcCgRpdGVtGAEgASgLMg4uZmVlZHMudjEuSXRlbSKSAQoJRmVlZFN0YXRzEg8KB2ZlZWRfaWQYASABKAkSEgoKZmVlZF90aXRsZRgCIAEoCRISCgppdGVtX2NvdW50GAMgASgFEhoKEmF2Z19pbnRlcnZhbF9ob3VycxgEIAEoARIRCglyZWFkX3JhdGUYBSABKAESHQoVYXZnX3JlYWRfcHJvZ3Jlc3NfcGN0GAYgASgBIiYKCERheUNvdW50EgsKA2RheRgBIAEoCRINCgVjb3VudBgCIAEoBSIVChNHZXRGZWVkU3RhdHNSZXF1ZXN0ImUKFEdldEZlZWRTdGF0c1Jlc3BvbnNlEiIKBXN0YXRzGAEgAygLMhMuZmVlZHMudjEuRmVlZFN0YXRzEikKDWl0ZW1zX3Blcl9kYXkYAiADKAsyEi5mZWVkcy52MS5EYXlDb3VudCpjCghGZWVkS2luZBIZChVGRUVEX0tJTkRfVU5TUEVDSUZJRUQQABIRCg1GRUVEX0tJTkRfUlNTEAESEwoPRkVFRF9LSU5EX0VNQUlMEAISFAoQRkVFRF9LSU5EX1NDUkFQRRADMrAFCgtGZWVkU2VydmljZRJECglMaXN0RmVlZHMSGi5mZWVkcy52MS5MaXN0RmVlZHNSZXF1ZXN0GhsuZmVlZHMudjEuTGlzdEZlZWRzUmVzcG9uc2USRwoKQ3JlYXRlRmVlZBIbLmZlZWRzLnYxLkNyZWF0ZUZlZWRSZXF1ZXN0GhwuZmVlZHMudjEuQ3JlYXRlRmVlZFJlc3BvbnNlEkcKClVwZGF0ZUZlZWQSGy5mZWVkcy52MS5VcGRhdGVGZWVkUmVxdWVzdBocLmZlZWRzLnYxLlVwZGF0ZUZlZWRSZXNwb25zZRJHCgpEZWxldGVGZWVkEhsuZmVlZHMudjEuRGVsZXRlRmVlZFJlcXVlc3QaHC5mZWVkcy52MS5EZWxldGVGZWVkUmVzcG9uc2USSgoLUmVmcmVzaEZlZWQSHC5mZWVkcy52MS5SZWZyZXNoRmVlZFJlcXVlc3QaHS5mZWVkcy52MS5SZWZyZXNoRmVlZFJlc3BvbnNlElAKDUxpc3RGZWVkSXRlbXMSHi5mZWVkcy52MS5MaXN0RmVlZEl0ZW1zUmVxdWVzdBofLmZlZWRzLnYxLkxpc3RGZWVkSXRlbXNSZXNwb25zZRJKCgtHZXRGZWVkSXRlbRIcLmZlZWRzLnYxLkdldEZlZWRJdGVtUmVxdWVzdBodLmZlZWRzLnYxLkdldEZlZWRJdGVtUmVzcG9uc2USRwoKVXBkYXRlSXRlbRIbLmZlZWRzLnYxLlVwZGF0ZUl0ZW1SZXF1ZXN0GhwuZmVlZHMudjEuVXBkYXRlSXRlbVJlc3BvbnNlEk0KDEdldEZlZWRTdGF0cxIdLmZlZWRzLnYxLkdldEZlZWRTdGF0c1JlcXVlc3QaHi5mZWVkcy52MS5HZXRGZWVkU3RhdHNSZXNwb25zZUIpWid0b29scy54ZG91YmxldS5jb20vZ2VuL2ZlZWRzL3YxO2ZlZWRzdjFiBnByb3RvMw");
+  fileDesc("ChRmZWVkcy92MS9mZWVkcy5wcm90bxIIZmVlZHMudjEirAIKBEZlZWQSCgoCaWQYASABKAkSCwoDdXJsGAIgASgJEg0KBXRpdGxlGAMgASgJEhcKD2xhc3RfZmV0Y2hlZF9hdBgEIAEoCRISCgpsYXN0X2Vycm9yGAUgASgJEhIKCmNyZWF0ZWRfYXQYBiABKAkSEwoLc291cmNlX3R5cGUYByABKAkSFwoPaW5ib3VuZF9hZGRyZXNzGAggASgJEgwKBGV0YWcYCSABKAkSFQoNbGFzdF9tb2RpZmllZBgKIAEoCRIcChRjb25zZWN1dGl2ZV9mYWlsdXJlcxgLIAEoBRITCgtub3RpZmllZF9hdBgMIAEoCRIRCglmb2xkZXJfaWQYDiABKAkSFAoMdW5yZWFkX2NvdW50GA8gASgFSgQIDRAOUgZmb2xkZXIiXQoGRm9sZGVyEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEQoJcGFyZW50X2lkGAMgASgJEhAKCHBvc2l0aW9uGAQgASgFEhQKDHVucmVhZF9jb3VudBgFIAEoBSISChBMaXN0RmVlZHNSZXF1ZXN0IlUKEUxpc3RGZWVkc1Jlc3BvbnNlEh0KBWZlZWRzGAEgAygLMg4uZmVlZHMudjEuRmVlZBIhCgdmb2xkZXJzGAIgAygLMhAuZmVlZHMudjEuRm9sZGVyIlEKEUNyZWF0ZUZlZWRSZXF1ZXN0EgsKA3VybBgBIAEoCRIgCgRraW5kGAIgASgOMhIuZmVlZHMudjEuRmVlZEtpbmQSDQoFdGl0bGUYAyABKAkiMgoSQ3JlYXRlRmVlZFJlc3BvbnNlEhwKBGZlZWQYASABKAsyDi5mZWVkcy52MS5GZWVkIjMKEVVwZGF0ZUZlZWRSZXF1ZXN0Eg8KB2ZlZWRfaWQYASABKAkSDQoFdGl0bGUYAiABKAkiFAoSVXBkYXRlRmVlZFJlc3BvbnNlIiQKEURlbGV0ZUZlZWRSZXF1ZXN0Eg8KB2ZlZWRfaWQYASABKAkiFAoSRGVsZXRlRmVlZFJlc3BvbnNlIiUKElJlZnJlc2hGZWVkUmVxdWVzdBIPCgdmZWVkX2lkGAEgASgJIicKE1JlZnJlc2hGZWVkUmVzcG9uc2USEAoIaW5nZXN0ZWQYASABKAUiuwIKBEl0ZW0SCgoCaWQYASABKAkSDwoHZmVlZF9pZBgCIAEoCRINCgV0aXRsZRgDIAEoCRISCgpzb3VyY2VfdXJsGAQgASgJEhQKDGNvbnRlbnRfaHRtbBgFIAEoCRIUCgxwdWJsaXNoZWRfYXQYBiABKAkSDwoHcmVhZF9hdBgHIAEoCRIRCglkaXNtaXNzZWQYCCABKAgSEgoKYm9va21hcmtlZBgJIAEoCBIUCgxpbmdlc3RfZXJyb3IYCiABKAkSEgoKY3JlYXRlZF9hdBgLIAEoCRIZChFyZWFkX3Byb2dyZXNzX3BjdBgMIAEoBRITCgtoYXNfY29udGVudBgNIAEoCBIXCg9saWJyYXJ5X2Jvb2tfaWQYDiABKAkSDgoGYXV0aG9yGA8gASgJEgwKBHRhZ3MYECADKAki2QEKFExpc3RGZWVkSXRlbXNSZXF1ZXN0Eg0KBWxpbWl0GAEgASgFEg4KBm9mZnNldBgCIAEoBRIYCgt1bnJlYWRfb25seRgDIAEoCEgAiAEBEhQKB2ZlZWRfaWQYBCABKAlIAYgBARIcCg9ib29rbWFya2VkX29ubHkYBSABKAhIAogBARIWCglmb2xkZXJfaWQYBiABKAlIA4gBAUIOCgxfdW5yZWFkX29ubHlCCgoIX2ZlZWRfaWRCEgoQX2Jvb2ttYXJrZWRfb25seUIMCgpfZm9sZGVyX2lkIkgKFUxpc3RGZWVkSXRlbXNSZXNwb25zZRIdCgVpdGVtcxgBIAMoCzIOLmZlZWRzLnYxLkl0ZW0SEAoIaGFzX21vcmUYAiABKAgiJQoSR2V0RmVlZEl0ZW1SZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkiMwoTR2V0RmVlZEl0ZW1SZXNwb25zZRIcCgRpdGVtGAEgASgLMg4uZmVlZHMudjEuSXRlbSLEAQoRVXBkYXRlSXRlbVJlcXVlc3QSDwoHaXRlbV9pZBgBIAEoCRIRCgRyZWFkGAIgASgISACIAQESFgoJZGlzbWlzc2VkGAMgASgISAGIAQESFwoKYm9va21hcmtlZBgEIAEoCEgCiAEBEh4KEXJlYWRfcHJvZ3Jlc3NfcGN0GAUgASgFSAOIAQFCBwoFX3JlYWRCDAoKX2Rpc21pc3NlZEINCgtfYm9va21hcmtlZEIUChJfcmVhZF9wcm9ncmVzc19wY3QiMgoSVXBkYXRlSXRlbVJlc3BvbnNlEhwKBGl0ZW0YASABKAsyDi5mZWVkcy52MS5JdGVtIisKGFNlbmRJdGVtVG9MaWJyYXJ5UmVxdWVzdBIPCgdpdGVtX2lkGAEgASgJIjkKGVNlbmRJdGVtVG9MaWJyYXJ5UmVzcG9uc2USHAoEaXRlbRgBIAEoCzIOLmZlZWRzLnYxLkl0ZW0ikgEKCUZlZWRTdGF0cxIPCgdmZWVkX2lkGAEgASgJEhIKCmZlZWRfdGl0bGUYAiABKAkSEgoKaXRlbV9jb3VudBgDIAEoBRIaChJhdmdfaW50ZXJ2YWxfaG91cnMYBCABKAESEQoJcmVhZF9yYXRlGAUgASgBEh0KFWF2Z19yZWFkX3Byb2dyZXNzX3BjdBgGIAEoASImCghEYXlDb3VudBILCgNkYXkYASABKAkSDQoFY291bnQYAiABKAUiFQoTR2V0RmVlZFN0YXRzUmVxdWVzdCJlChRHZXRGZWVkU3RhdHNSZXNwb25zZRIiCgVzdGF0cxgBIAMoCzITLmZlZWRzLnYxLkZlZWRTdGF0cxIpCg1pdGVtc19wZXJfZGF5GAIgAygLMhIuZmVlZHMudjEuRGF5Q291bnQiIQoRSW1wb3J0T1BNTFJlcXVlc3QSDAoEZGF0YRgBIAEoDCJ7ChBPUE1MSW1wb3J0UmVzdWx0EgsKA3VybBgBIAEoCRINCgV0aXRsZRgCIAEoCRIOCgZmb2xkZXIYAyABKAkSDgoGc3RhdHVzGAQgASgJEg0KBWVycm9yGAUgASgJEhwKBGZlZWQYBiABKAsyDi5mZWVkcy52MS5GZWVkIocBChJJbXBvcnRPUE1MUmVzcG9uc2USKwoHcmVzdWx0cxgBIAMoCzIaLmZlZWRzLnYxLk9QTUxJbXBvcnRSZXN1bHQSFQoNY3JlYXRlZF9jb3VudBgCIAEoBRIXCg9kdXBsaWNhdGVfY291bnQYAyABKAUSFAoMZmFpbGVkX2NvdW50GAQgASgFIhMKEUV4cG9ydE9QTUxSZXF1ZXN0IkoKEkV4cG9ydE9QTUxSZXNwb25zZRIMCgRkYXRhGAEgASgMEhQKDGNvbnRlbnRfdHlwZRgCIAEoCRIQCghmaWxlbmFtZRgDIAEoCSI2ChNDcmVhdGVGb2xkZXJSZXF1ZXN0EgwKBG5hbWUYASABKAkSEQoJcGFyZW50X2lkGAIgASgJIjgKFENyZWF0ZUZvbGRlclJlc3BvbnNlEiAKBmZvbGRlchgBIAEoCzIQLmZlZWRzLnYxLkZvbGRlciI2ChNSZW5hbWVGb2xkZXJSZXF1ZXN0EhEKCWZvbGRlcl9pZBgBIAEoCRIMCgRuYW1lGAIgASgJIhYKFFJlbmFtZUZvbGRlclJlc3BvbnNlIigKE0RlbGV0ZUZvbGRlclJlcXVlc3QSEQoJZm9sZGVyX2lkGAEgASgJIhYKFERlbGV0ZUZvbGRlclJlc3BvbnNlIiQKFVJlb3JkZXJGb2xkZXJzUmVxdWVzdBILCgNpZHMYASADKAkiGAoWUmVvcmRlckZvbGRlcnNSZXNwb25zZSI1Cg9Nb3ZlRmVlZFJlcXVlc3QSDwoHZmVlZF9pZBgBIAEoCRIRCglmb2xkZXJfaWQYAiABKAkiEgoQTW92ZUZlZWRSZXNwb25zZSKfAQoKRmlsdGVyUnVsZRIKCgJpZBgBIAEoCRIPCgdmZWVkX2lkGAIgASgJEg0KBWZpZWxkGAMgASgJEhIKCm1hdGNoX3R5cGUYBCABKAkSDwoHcGF0dGVybhgFIAEoCRIOCgZhY3Rpb24YBiABKAkSCwoDdGFnGAcgASgJEg8KB2VuYWJsZWQYCCABKAgSEgoKY3JlYXRlZF9hdBgJIAEoCSIYChZMaXN0RmlsdGVyUnVsZXNSZXF1ZXN0Ij4KF0xpc3RGaWx0ZXJSdWxlc1Jlc3BvbnNlEiMKBXJ1bGVzGAEgAygLMhQuZmVlZHMudjEuRmlsdGVyUnVsZSI9ChdDcmVhdGVGaWx0ZXJSdWxlUmVxdWVzdBIiCgRydWxlGAEgASgLMhQuZmVlZHMudjEuRmlsdGVyUnVsZSI+ChhDcmVhdGVGaWx0ZXJSdWxlUmVzcG9uc2USIgoEcnVsZRgBIAEoCzIULmZlZWRzLnYxLkZpbHRlclJ1bGUiPQoXVXBkYXRlRmlsdGVyUnVsZVJlcXVlc3QSIgoEcnVsZRgBIAEoCzIULmZlZWRzLnYxLkZpbHRlclJ1bGUiPgoYVXBkYXRlRmlsdGVyUnVsZVJlc3BvbnNlEiIKBHJ1bGUYASABKAsyFC5mZWVkcy52MS5GaWx0ZXJSdWxlIioKF0RlbGV0ZUZpbHRlclJ1bGVSZXF1ZXN0Eg8KB3J1bGVfaWQYASABKAkiGgoYRGVsZXRlRmlsdGVyUnVsZVJlc3BvbnNlIkoKFVRlc3RGaWx0ZXJSdWxlUmVxdWVzdBIiCgRydWxlGAEgASgLMhQuZmVlZHMudjEuRmlsdGVyUnVsZRINCgVsaW1pdBgCIAEoBSJQChZUZXN0RmlsdGVyUnVsZVJlc3BvbnNlEh8KB21hdGNoZXMYASADKAsyDi5mZWVkcy52MS5JdGVtEhUKDWNoZWNrZWRfY291bnQYAiABKAUiVQoLQXBwUGFzc3dvcmQSCgoCaWQYASABKAkSEAoIdXNlcm5hbWUYAiABKAkSEgoKY3JlYXRlZF9hdBgDIAEoCRIUCgxsYXN0X3VzZWRfYXQYBCABKAkiGQoXTGlzdEFwcFBhc3N3b3Jkc1JlcXVlc3QiSAoYTGlzdEFwcFBhc3N3b3Jkc1Jlc3BvbnNlEiwKDWFwcF9wYXNzd29yZHMYASADKAsyFS5mZWVkcy52MS5BcHBQYXNzd29yZCIsChhDcmVhdGVBcHBQYXNzd29yZFJlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkiWgoZQ3JlYXRlQXBwUGFzc3dvcmRSZXNwb25zZRIrCgxhcHBfcGFzc3dvcmQYASABKAsyFS5mZWVkcy52MS5BcHBQYXNzd29yZBIQCghwYXNzd29yZBgCIAEoCSIzChhEZWxldGVBcHBQYXNzd29yZFJlcXVlc3QSFwoPYXBwX3Bhc3N3b3JkX2lkGAEgASgJIhsKGURlbGV0ZUFwcFBhc3N3b3JkUmVzcG9uc2UqYwoIRmVlZEtpbmQSGQoVRkVFRF9LSU5EX1VOU1BFQ0lGSUVEEAASEQoNRkVFRF9LSU5EX1JTUxABEhMKD0ZFRURfS0lORF9FTUFJTBACEhQKEEZFRURfS0lORF9TQ1JBUEUQAzL6DwoLRmVlZFNlcnZpY2USRAoJTGlzdEZlZWRzEhouZmVlZHMudjEuTGlzdEZlZWRzUmVxdWVzdBobLmZlZWRzLnYxLkxpc3RGZWVkc1Jlc3BvbnNlEkcKCkNyZWF0ZUZlZWQSGy5mZWVkcy52MS5DcmVhdGVGZWVkUmVxdWVzdBocLmZlZWRzLnYxLkNyZWF0ZUZlZWRSZXNwb25zZRJHCgpVcGRhdGVGZWVkEhsuZmVlZHMudjEuVXBkYXRlRmVlZFJlcXVlc3QaHC5mZWVkcy52MS5VcGRhdGVGZWVkUmVzcG9uc2USRwoKRGVsZXRlRmVlZBIbLmZlZWRzLnYxLkRlbGV0ZUZlZWRSZXF1ZXN0GhwuZmVlZHMudjEuRGVsZXRlRmVlZFJlc3BvbnNlEkoKC1JlZnJlc2hGZWVkEhwuZmVlZHMudjEuUmVmcmVzaEZlZWRSZXF1ZXN0Gh0uZmVlZHMudjEuUmVmcmVzaEZlZWRSZXNwb25zZRJQCg1MaXN0RmVlZEl0ZW1zEh4uZmVlZHMudjEuTGlzdEZlZWRJdGVtc1JlcXVlc3QaHy5mZWVkcy52MS5MaXN0RmVlZEl0ZW1zUmVzcG9uc2USSgoLR2V0RmVlZEl0ZW0SHC5mZWVkcy52MS5HZXRGZWVkSXRlbVJlcXVlc3QaHS5mZWVkcy52MS5HZXRGZWVkSXRlbVJlc3BvbnNlEkcKClVwZGF0ZUl0ZW0SGy5mZWVkcy52MS5VcGRhdGVJdGVtUmVxdWVzdBocLmZlZWRzLnYxLlVwZGF0ZUl0ZW1SZXNwb25zZRJcChFTZW5kSXRlbVRvTGlicmFyeRIiLmZlZWRzLnYxLlNlbmRJdGVtVG9MaWJyYXJ5UmVxdWVzdBojLmZlZWRzLnYxLlNlbmRJdGVtVG9MaWJyYXJ5UmVzcG9uc2USTQoMR2V0RmVlZFN0YXRzEh0uZmVlZHMudjEuR2V0RmVlZFN0YXRzUmVxdWVzdBoeLmZlZWRzLnYxLkdldEZlZWRTdGF0c1Jlc3BvbnNlEkcKCkltcG9ydE9QTUwSGy5mZWVkcy52MS5JbXBvcnRPUE1MUmVxdWVzdBocLmZlZWRzLnYxLkltcG9ydE9QTUxSZXNwb25zZRJHCgpFeHBvcnRPUE1MEhsuZmVlZHMudjEuRXhwb3J0T1BNTFJlcXVlc3QaHC5mZWVkcy52MS5FeHBvcnRPUE1MUmVzcG9uc2USTQoMQ3JlYXRlRm9sZGVyEh0uZmVlZHMudjEuQ3JlYXRlRm9sZGVyUmVxdWVzdBoeLmZlZWRzLnYxLkNyZWF0ZUZvbGRlclJlc3BvbnNlEk0KDFJlbmFtZUZvbGRlchIdLmZlZWRzLnYxLlJlbmFtZUZvbGRlclJlcXVlc3QaHi5mZWVkcy52MS5SZW5hbWVGb2xkZXJSZXNwb25zZRJNCgxEZWxldGVGb2xkZXISHS5mZWVkcy52MS5EZWxldGVGb2xkZXJSZXF1ZXN0Gh4uZmVlZHMudjEuRGVsZXRlRm9sZGVyUmVzcG9uc2USUwoOUmVvcmRlckZvbGRlcnMSHy5mZWVkcy52MS5SZW9yZGVyRm9sZGVyc1JlcXVlc3QaIC5mZWVkcy52MS5SZW9yZGVyRm9sZGVyc1Jlc3BvbnNlEkEKCE1vdmVGZWVkEhkuZmVlZHMudjEuTW92ZUZlZWRSZXF1ZXN0GhouZmVlZHMudjEuTW92ZUZlZWRSZXNwb25zZRJWCg9MaXN0RmlsdGVyUnVsZXMSIC5mZWVkcy52MS5MaXN0RmlsdGVyUnVsZXNSZXF1ZXN0GiEuZmVlZHMudjEuTGlzdEZpbHRlclJ1bGVzUmVzcG9uc2USWQoQQ3JlYXRlRmlsdGVyUnVsZRIhLmZlZWRzLnYxLkNyZWF0ZUZpbHRlclJ1bGVSZXF1ZXN0GiIuZmVlZHMudjEuQ3JlYXRlRmlsdGVyUnVsZVJlc3BvbnNlElkKEFVwZGF0ZUZpbHRlclJ1bGUSIS5mZWVkcy52MS5VcGRhdGVGaWx0ZXJSdWxlUmVxdWVzdBoiLmZlZWRzLnYxLlVwZGF0ZUZpbHRlclJ1bGVSZXNwb25zZRJZChBEZWxldGVGaWx0ZXJSdWxlEiEuZmVlZHMudjEuRGVsZXRlRmlsdGVyUnVsZVJlcXVlc3QaIi5mZWVkcy52MS5EZWxldGVGaWx0ZXJSdWxlUmVzcG9uc2USUwoOVGVzdEZpbHRlclJ1bGUSHy5mZWVkcy52MS5UZXN0RmlsdGVyUnVsZVJlcXVlc3QaIC5mZWVkcy52MS5UZXN0RmlsdGVyUnVsZVJlc3BvbnNlElkKEExpc3RBcHBQYXNzd29yZHMSIS5mZWVkcy52MS5MaXN0QXBwUGFzc3dvcmRzUmVxdWVzdBoiLmZlZWRzLnYxLkxpc3RBcHBQYXNzd29yZHNSZXNwb25zZRJcChFDcmVhdGVBcHBQYXNzd29yZBIiLmZlZWRzLnYxLkNyZWF0ZUFwcFBhc3N3b3JkUmVxdWVzdBojLmZlZWRzLnYxLkNyZWF0ZUFwcFBhc3N3b3JkUmVzcG9uc2USXAoRRGVsZXRlQXBwUGFzc3dvcmQSIi5mZWVkcy52MS5EZWxldGVBcHBQYXNzd29yZFJlcXVlc3QaIy5mZWVkcy52MS5EZWxldGVBcHBQYXNzd29yZFJlc3BvbnNlQilaJ3Rvb2xzLnhkb3VibGV1LmNvbS9nZW4vZmVlZHMvdjE7ZmVlZHN2MWIGcHJvdG8z");
 
 /**
  * Feed is an RSS/Atom subscription or an email-relay newsletter subscription.
@@ -99,6 +99,20 @@ export type Feed = Message<"feeds.v1.Feed"> & {
    * @generated from field: string notified_at = 12;
    */
   notifiedAt: string;
+
+  /**
+   * The folder the feed is filed under; empty when unfiled.
+   *
+   * @generated from field: string folder_id = 14;
+   */
+  folderId: string;
+
+  /**
+   * Unread, non-dismissed items; only set by ListFeeds.
+   *
+   * @generated from field: int32 unread_count = 15;
+   */
+  unreadCount: number;
 };
 
 /**
@@ -108,6 +122,52 @@ export type Feed = Message<"feeds.v1.Feed"> & {
 export const FeedSchema: GenMessage<Feed> = /*@__PURE__*/
   messageDesc(file_feeds_v1_feeds, 0);
 
+/**
+ * Folder groups feeds. Folders nest one level: a subfolder's parent is
+ * always a top-level folder.
+ *
+ * @generated from message feeds.v1.Folder
+ */
+export type Folder = Message<"feeds.v1.Folder"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Empty for a top-level folder.
+   *
+   * @generated from field: string parent_id = 3;
+   */
+  parentId: string;
+
+  /**
+   * Orders the folder among its siblings.
+   *
+   * @generated from field: int32 position = 4;
+   */
+  position: number;
+
+  /**
+   * Unread items across the folder's feeds, including its subfolders'.
+   *
+   * @generated from field: int32 unread_count = 5;
+   */
+  unreadCount: number;
+};
+
+/**
+ * Describes the message feeds.v1.Folder.
+ * Use `create(FolderSchema)` to create a new message.
+ */
+export const FolderSchema: GenMessage<Folder> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 1);
+
 /**
  * @generated from message feeds.v1.ListFeedsRequest
  */
@@ -119,7 +179,7 @@ export type ListFeedsRequest = Message<"feeds.v1.ListFeedsRequest"> & {
  * Use `create(ListFeedsRequestSchema)` to create a new message.
  */
 export const ListFeedsRequestSchema: GenMessage<ListFeedsRequest> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 1);
+  messageDesc(file_feeds_v1_feeds, 2);
 
 /**
  * @generated from message feeds.v1.ListFeedsResponse
@@ -129,6 +189,13 @@ export type ListFeedsResponse = Message<"feeds.v1.ListFeedsResponse"> & {
    * @generated from field: repeated feeds.v1.Feed feeds = 1;
    */
   feeds: Feed[];
+
+  /**
+   * Every folder, top-level and subfolders alike, ordered by position.
+   *
+   * @generated from field: repeated feeds.v1.Folder folders = 2;
+   */
+  folders: Folder[];
 };
 
 /**
@@ -136,7 +203,7 @@ export type ListFeedsResponse = Message<"feeds.v1.ListFeedsResponse"> & {
  * Use `create(ListFeedsResponseSchema)` to create a new message.
  */
 export const ListFeedsResponseSchema: GenMessage<ListFeedsResponse> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 2);
+  messageDesc(file_feeds_v1_feeds, 3);
 
 /**
  * CreateFeed with kind RSS (the default) validates the URL by fetching and
@@ -179,7 +246,7 @@ export type CreateFeedRequest = Message<"feeds.v1.CreateFeedRequest"> & {
  * Use `create(CreateFeedRequestSchema)` to create a new message.
  */
 export const CreateFeedRequestSchema: GenMessage<CreateFeedRequest> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 3);
+  messageDesc(file_feeds_v1_feeds, 4);
 
 /**
  * @generated from message feeds.v1.CreateFeedResponse
@@ -196,7 +263,7 @@ export type CreateFeedResponse = Message<"feeds.v1.CreateFeedResponse"> & {
  * Use `create(CreateFeedResponseSchema)` to create a new message.
  */
 export const CreateFeedResponseSchema: GenMessage<CreateFeedResponse> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 4);
+  messageDesc(file_feeds_v1_feeds, 5);
 
 /**
  * @generated from message feeds.v1.UpdateFeedRequest
@@ -218,7 +285,7 @@ export type UpdateFeedRequest = Message<"feeds.v1.UpdateFeedRequest"> & {
  * Use `create(UpdateFeedRequestSchema)` to create a new message.
  */
 export const UpdateFeedRequestSchema: GenMessage<UpdateFeedRequest> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 5);
+  messageDesc(file_feeds_v1_feeds, 6);
 
 /**
  * @generated from message feeds.v1.UpdateFeedResponse
@@ -231,7 +298,7 @@ export type UpdateFeedResponse = Message<"feeds.v1.UpdateFeedResponse"> & {
  * Use `create(UpdateFeedResponseSchema)` to create a new message.
  */
 export const UpdateFeedResponseSchema: GenMessage<UpdateFeedResponse> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 6);
+  messageDesc(file_feeds_v1_feeds, 7);
 
 /**
  * DeleteFeed removes the subscription and every item it ingested.
@@ -250,7 +317,7 @@ export type DeleteFeedRequest = Message<"feeds.v1.DeleteFeedRequest"> & {
  * Use `create(DeleteFeedRequestSchema)` to create a new message.
  */
 export const DeleteFeedRequestSchema: GenMessage<DeleteFeedRequest> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 7);
+  messageDesc(file_feeds_v1_feeds, 8);
 
 /**
  * @generated from message feeds.v1.DeleteFeedResponse
@@ -263,7 +330,7 @@ export type DeleteFeedResponse = Message<"feeds.v1.DeleteFeedResponse"> & {
  * Use `create(DeleteFeedResponseSchema)` to create a new message.
  */
 export const DeleteFeedResponseSchema: GenMessage<DeleteFeedResponse> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 8);
+  messageDesc(file_feeds_v1_feeds, 9);
 
 /**
  * RefreshFeed polls the feed synchronously and ingests new items.
@@ -282,7 +349,7 @@ export type RefreshFeedRequest = Message<"feeds.v1.RefreshFeedRequest"> & {
  * Use `create(RefreshFeedRequestSchema)` to create a new message.
  */
 export const RefreshFeedRequestSchema: GenMessage<RefreshFeedRequest> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 9);
+  messageDesc(file_feeds_v1_feeds, 10);
 
 /**
  * @generated from message feeds.v1.RefreshFeedResponse
@@ -299,11 +366,12 @@ export type RefreshFeedResponse = Message<"feeds.v1.RefreshFeedResponse"> & {
  * Use `create(RefreshFeedResponseSchema)` to create a new message.
  */
 export const RefreshFeedResponseSchema: GenMessage<RefreshFeedResponse> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 10);
+  messageDesc(file_feeds_v1_feeds, 11);
 
 /**
- * Item is one ingested feed entry, self-contained (no library/book
- * linkage) — a feed and its items only ever belong to one user.
+ * Item is one ingested feed entry, self-contained apart from an optional
+ * link to the books-library entry it was sent to — a feed and its items
+ * only ever belong to one user.
  *
  * @generated from message feeds.v1.Item
  */
@@ -390,6 +458,28 @@ export type Item = Message<"feeds.v1.Item"> & {
    * @generated from field: bool has_content = 13;
    */
   hasContent: boolean;
+
+  /**
+   * The books-library entry the item was sent to by SendItemToLibrary;
+   * empty if it never was.
+   *
+   * @generated from field: string library_book_id = 14;
+   */
+  libraryBookId: string;
+
+  /**
+   * The byline the feed reported (an email's sender); empty when none.
+   *
+   * @generated from field: string author = 15;
+   */
+  author: string;
+
+  /**
+   * Labels filter rules gave the item when it was ingested.
+   *
+   * @generated from field: repeated string tags = 16;
+   */
+  tags: string[];
 };
 
 /**
@@ -397,7 +487,7 @@ export type Item = Message<"feeds.v1.Item"> & {
  * Use `create(ItemSchema)` to create a new message.
  */
 export const ItemSchema: GenMessage<Item> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 11);
+  messageDesc(file_feeds_v1_feeds, 12);
 
 /**
  * @generated from message feeds.v1.ListFeedItemsRequest
@@ -436,6 +526,14 @@ export type ListFeedItemsRequest = Message<"feeds.v1.ListFeedItemsRequest"> & {
    * @generated from field: optional bool bookmarked_only = 5;
    */
   bookmarkedOnly?: boolean | undefined;
+
+  /**
+   * Restricts results to the feeds filed under one folder or its
+   * subfolders. Unset returns items from any of the caller's feeds.
+   *
+   * @generated from field: optional string folder_id = 6;
+   */
+  folderId?: string | undefined;
 };
 
 /**
@@ -443,7 +541,7 @@ export type ListFeedItemsRequest = Message<"feeds.v1.ListFeedItemsRequest"> & {
  * Use `create(ListFeedItemsRequestSchema)` to create a new message.
  */
 export const ListFeedItemsRequestSchema: GenMessage<ListFeedItemsRequest> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 12);
+  messageDesc(file_feeds_v1_feeds, 13);
 
 /**
  * @generated from message feeds.v1.ListFeedItemsResponse
@@ -465,7 +563,7 @@ export type ListFeedItemsResponse = Message<"feeds.v1.ListFeedItemsResponse"> &
  * Use `create(ListFeedItemsResponseSchema)` to create a new message.
  */
 export const ListFeedItemsResponseSchema: GenMessage<ListFeedItemsResponse> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 13);
+  messageDesc(file_feeds_v1_feeds, 14);
 
 /**
  * GetFeedItem returns one item with its content_html populated — the only
@@ -486,7 +584,7 @@ export type GetFeedItemRequest = Message<"feeds.v1.GetFeedItemRequest"> & {
  * Use `create(GetFeedItemRequestSchema)` to create a new message.
  */
 export const GetFeedItemRequestSchema: GenMessage<GetFeedItemRequest> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 14);
+  messageDesc(file_feeds_v1_feeds, 15);
 
 /**
  * @generated from message feeds.v1.GetFeedItemResponse
@@ -503,7 +601,7 @@ export type GetFeedItemResponse = Message<"feeds.v1.GetFeedItemResponse"> & {
  * Use `create(GetFeedItemResponseSchema)` to create a new message.
  */
 export const GetFeedItemResponseSchema: GenMessage<GetFeedItemResponse> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 15);
+  messageDesc(file_feeds_v1_feeds, 16);
 
 /**
  * UpdateItem partially updates an item's read/dismissed/bookmarked/
@@ -546,7 +644,7 @@ export type UpdateItemRequest = Message<"feeds.v1.UpdateItemRequest"> & {
  * Use `create(UpdateItemRequestSchema)` to create a new message.
  */
 export const UpdateItemRequestSchema: GenMessage<UpdateItemRequest> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 16);
+  messageDesc(file_feeds_v1_feeds, 17);
 
 /**
  * @generated from message feeds.v1.UpdateItemResponse
@@ -563,7 +661,47 @@ export type UpdateItemResponse = Message<"feeds.v1.UpdateItemResponse"> & {
  * Use `create(UpdateItemResponseSchema)` to create a new message.
  */
 export const UpdateItemResponseSchema: GenMessage<UpdateItemResponse> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 17);
+  messageDesc(file_feeds_v1_feeds, 18);
+
+/**
+ * SendItemToLibrary adds the item to the books library as an article made
+ * from its stored content_html — the page is not fetched again — and links
+ * the two, so reading progress in either app shows up in the other. Sending
+ * an item again returns the same entry. FAILED_PRECONDITION when the item
+ * has no content.
+ *
+ * @generated from message feeds.v1.SendItemToLibraryRequest
+ */
+export type SendItemToLibraryRequest = Message<"feeds.v1.SendItemToLibraryRequest"> & {
+  /**
+   * @generated from field: string item_id = 1;
+   */
+  itemId: string;
+};
+
+/**
+ * Describes the message feeds.v1.SendItemToLibraryRequest.
+ * Use `create(SendItemToLibraryRequestSchema)` to create a new message.
+ */
+export const SendItemToLibraryRequestSchema: GenMessage<SendItemToLibraryRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 19);
+
+/**
+ * @generated from message feeds.v1.SendItemToLibraryResponse
+ */
+export type SendItemToLibraryResponse = Message<"feeds.v1.SendItemToLibraryResponse"> & {
+  /**
+   * @generated from field: feeds.v1.Item item = 1;
+   */
+  item?: Item | undefined;
+};
+
+/**
+ * Describes the message feeds.v1.SendItemToLibraryResponse.
+ * Use `create(SendItemToLibraryResponseSchema)` to create a new message.
+ */
+export const SendItemToLibraryResponseSchema: GenMessage<SendItemToLibraryResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 20);
 
 /**
  * FeedStats aggregates one feed's posting cadence and read/completion
@@ -615,7 +753,7 @@ export type FeedStats = Message<"feeds.v1.FeedStats"> & {
  * Use `create(FeedStatsSchema)` to create a new message.
  */
 export const FeedStatsSchema: GenMessage<FeedStats> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 18);
+  messageDesc(file_feeds_v1_feeds, 21);
 
 /**
  * One day's ingested-item count, for the "when do new items appear"
@@ -642,7 +780,7 @@ export type DayCount = Message<"feeds.v1.DayCount"> & {
  * Use `create(DayCountSchema)` to create a new message.
  */
 export const DayCountSchema: GenMessage<DayCount> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 19);
+  messageDesc(file_feeds_v1_feeds, 22);
 
 /**
  * @generated from message feeds.v1.GetFeedStatsRequest
@@ -655,7 +793,7 @@ export type GetFeedStatsRequest = Message<"feeds.v1.GetFeedStatsRequest"> & {
  * Use `create(GetFeedStatsRequestSchema)` to create a new message.
  */
 export const GetFeedStatsRequestSchema: GenMessage<GetFeedStatsRequest> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 20);
+  messageDesc(file_feeds_v1_feeds, 23);
 
 /**
  * @generated from message feeds.v1.GetFeedStatsResponse
@@ -679,116 +817,987 @@ export type GetFeedStatsResponse = Message<"feeds.v1.GetFeedStatsResponse"> & {
  * Use `create(GetFeedStatsResponseSchema)` to create a new message.
  */
 export const GetFeedStatsResponseSchema: GenMessage<GetFeedStatsResponse> = /*@__PURE__*/
-  messageDesc(file_feeds_v1_feeds, 21);
+  messageDesc(file_feeds_v1_feeds, 24);
 
 /**
- * @generated from enum feeds.v1.FeedKind
+ * ImportOPML subscribes to every feed in an OPML file, filing each under
+ * its folder outline (created as needed; outlines nested deeper than one
+ * level collapse into one subfolder named by the rest of the path). RSS/Atom
+ * outlines (xmlUrl) become RSS feeds and type="link" outlines scrape feeds; each is validated like CreateFeed. Feeds already
+ * subscribed to, or listed twice, are skipped as duplicates. Only the
+ * first 100 new feeds of a file are fetched; the rest are reported as
+ * "deferred" and picked up by importing the file again. INVALID_ARGUMENT
+ * for a file that isn't OPML.
+ *
+ * @generated from message feeds.v1.ImportOPMLRequest
  */
-export enum FeedKind {
+export type ImportOPMLRequest = Message<"feeds.v1.ImportOPMLRequest"> & {
   /**
-   * treated as RSS
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+};
+
+/**
+ * Describes the message feeds.v1.ImportOPMLRequest.
+ * Use `create(ImportOPMLRequestSchema)` to create a new message.
+ */
+export const ImportOPMLRequestSchema: GenMessage<ImportOPMLRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 25);
+
+/**
+ * OPMLImportResult is what happened to one feed outline of the file.
+ *
+ * @generated from message feeds.v1.OPMLImportResult
+ */
+export type OPMLImportResult = Message<"feeds.v1.OPMLImportResult"> & {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * The outline path the feed was filed under, nested outlines joined with
+   * " / "; empty outside any folder.
    *
-   * @generated from enum value: FEED_KIND_UNSPECIFIED = 0;
+   * @generated from field: string folder = 3;
    */
-  UNSPECIFIED = 0,
+  folder: string;
 
   /**
-   * @generated from enum value: FEED_KIND_RSS = 1;
+   * "created" | "duplicate" | "invalid" (not a feed, or not an http(s)
+   * URL) | "unreachable" (could not be fetched) | "deferred" (past the
+   * per-import limit; import the file again)
+   *
+   * @generated from field: string status = 4;
    */
-  RSS = 1,
+  status: string;
 
   /**
-   * @generated from enum value: FEED_KIND_EMAIL = 2;
+   * Why an invalid, unreachable or deferred outline was skipped.
+   *
+   * @generated from field: string error = 5;
    */
-  EMAIL = 2,
+  error: string;
 
   /**
-   * @generated from enum value: FEED_KIND_SCRAPE = 3;
+   * The new subscription; only set when status is "created".
+   *
+   * @generated from field: feeds.v1.Feed feed = 6;
    */
-  SCRAPE = 3,
-}
+  feed?: Feed;
+};
 
 /**
- * Describes the enum feeds.v1.FeedKind.
+ * Describes the message feeds.v1.OPMLImportResult.
+ * Use `create(OPMLImportResultSchema)` to create a new message.
  */
-export const FeedKindSchema: GenEnum<FeedKind> = /*@__PURE__*/
-  enumDesc(file_feeds_v1_feeds, 0);
+export const OPMLImportResultSchema: GenMessage<OPMLImportResult> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 26);
 
 /**
- * @generated from service feeds.v1.FeedService
+ * @generated from message feeds.v1.ImportOPMLResponse
  */
-export const FeedService: GenService<{
+export type ImportOPMLResponse = Message<"feeds.v1.ImportOPMLResponse"> & {
   /**
-   * @generated from rpc feeds.v1.FeedService.ListFeeds
+   * In file order.
+   *
+   * @generated from field: repeated feeds.v1.OPMLImportResult results = 1;
    */
-  listFeeds: {
-    methodKind: "unary";
-    input: typeof ListFeedsRequestSchema;
-    output: typeof ListFeedsResponseSchema;
-  },
+  results: OPMLImportResult[];
+
   /**
-   * @generated from rpc feeds.v1.FeedService.CreateFeed
+   * @generated from field: int32 created_count = 2;
    */
-  createFeed: {
-    methodKind: "unary";
-    input: typeof CreateFeedRequestSchema;
-    output: typeof CreateFeedResponseSchema;
-  },
+  createdCount: number;
+
   /**
-   * @generated from rpc feeds.v1.FeedService.UpdateFeed
+   * @generated from field: int32 duplicate_count = 3;
    */
-  updateFeed: {
-    methodKind: "unary";
-    input: typeof UpdateFeedRequestSchema;
-    output: typeof UpdateFeedResponseSchema;
-  },
+  duplicateCount: number;
+
   /**
-   * @generated from rpc feeds.v1.FeedService.DeleteFeed
+   * Invalid and unreachable outlines; deferred ones are not counted.
+   *
+   * @generated from field: int32 failed_count = 4;
    */
-  deleteFeed: {
-    methodKind: "unary";
-    input: typeof DeleteFeedRequestSchema;
-    output: typeof DeleteFeedResponseSchema;
-  },
+  failedCount: number;
+};
+
+/**
+ * Describes the message feeds.v1.ImportOPMLResponse.
+ * Use `create(ImportOPMLResponseSchema)` to create a new message.
+ */
+export const ImportOPMLResponseSchema: GenMessage<ImportOPMLResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 27);
+
+/**
+ * ExportOPML renders the caller's feeds as an OPML 2.0 file, in their
+ * folders. Scrape feeds are type="link" outlines, which ImportOPML reads
+ * back as scrape feeds; email feeds can't be subscribed to elsewhere, so
+ * they are only listed as comments.
+ *
+ * @generated from message feeds.v1.ExportOPMLRequest
+ */
+export type ExportOPMLRequest = Message<"feeds.v1.ExportOPMLRequest"> & {
+};
+
+/**
+ * Describes the message feeds.v1.ExportOPMLRequest.
+ * Use `create(ExportOPMLRequestSchema)` to create a new message.
+ */
+export const ExportOPMLRequestSchema: GenMessage<ExportOPMLRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 28);
+
+/**
+ * @generated from message feeds.v1.ExportOPMLResponse
+ */
+export type ExportOPMLResponse = Message<"feeds.v1.ExportOPMLResponse"> & {
   /**
-   * @generated from rpc feeds.v1.FeedService.RefreshFeed
+   * @generated from field: bytes data = 1;
    */
-  refreshFeed: {
-    methodKind: "unary";
-    input: typeof RefreshFeedRequestSchema;
-    output: typeof RefreshFeedResponseSchema;
-  },
+  data: Uint8Array;
+
   /**
-   * @generated from rpc feeds.v1.FeedService.ListFeedItems
+   * @generated from field: string content_type = 2;
    */
-  listFeedItems: {
-    methodKind: "unary";
-    input: typeof ListFeedItemsRequestSchema;
-    output: typeof ListFeedItemsResponseSchema;
-  },
+  contentType: string;
+
   /**
-   * @generated from rpc feeds.v1.FeedService.GetFeedItem
+   * @generated from field: string filename = 3;
    */
-  getFeedItem: {
-    methodKind: "unary";
-    input: typeof GetFeedItemRequestSchema;
-    output: typeof GetFeedItemResponseSchema;
-  },
+  filename: string;
+};
+
+/**
+ * Describes the message feeds.v1.ExportOPMLResponse.
+ * Use `create(ExportOPMLResponseSchema)` to create a new message.
+ */
+export const ExportOPMLResponseSchema: GenMessage<ExportOPMLResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 29);
+
+/**
+ * CreateFolder adds a folder after its siblings, top-level unless parent_id
+ * names a top-level folder. INVALID_ARGUMENT for an empty name or a parent
+ * that is itself a subfolder; ALREADY_EXISTS for a sibling of the same name.
+ *
+ * @generated from message feeds.v1.CreateFolderRequest
+ */
+export type CreateFolderRequest = Message<"feeds.v1.CreateFolderRequest"> & {
   /**
-   * @generated from rpc feeds.v1.FeedService.UpdateItem
+   * @generated from field: string name = 1;
    */
-  updateItem: {
-    methodKind: "unary";
-    input: typeof UpdateItemRequestSchema;
-    output: typeof UpdateItemResponseSchema;
-  },
+  name: string;
+
   /**
-   * @generated from rpc feeds.v1.FeedService.GetFeedStats
+   * @generated from field: string parent_id = 2;
    */
-  getFeedStats: {
-    methodKind: "unary";
-    input: typeof GetFeedStatsRequestSchema;
-    output: typeof GetFeedStatsResponseSchema;
+  parentId: string;
+};
+
+/**
+ * Describes the message feeds.v1.CreateFolderRequest.
+ * Use `create(CreateFolderRequestSchema)` to create a new message.
+ */
+export const CreateFolderRequestSchema: GenMessage<CreateFolderRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 30);
+
+/**
+ * @generated from message feeds.v1.CreateFolderResponse
+ */
+export type CreateFolderResponse = Message<"feeds.v1.CreateFolderResponse"> & {
+  /**
+   * @generated from field: feeds.v1.Folder folder = 1;
+   */
+  folder?: Folder;
+};
+
+/**
+ * Describes the message feeds.v1.CreateFolderResponse.
+ * Use `create(CreateFolderResponseSchema)` to create a new message.
+ */
+export const CreateFolderResponseSchema: GenMessage<CreateFolderResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 31);
+
+/**
+ * @generated from message feeds.v1.RenameFolderRequest
+ */
+export type RenameFolderRequest = Message<"feeds.v1.RenameFolderRequest"> & {
+  /**
+   * @generated from field: string folder_id = 1;
+   */
+  folderId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message feeds.v1.RenameFolderRequest.
+ * Use `create(RenameFolderRequestSchema)` to create a new message.
+ */
+export const RenameFolderRequestSchema: GenMessage<RenameFolderRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 32);
+
+/**
+ * @generated from message feeds.v1.RenameFolderResponse
+ */
+export type RenameFolderResponse = Message<"feeds.v1.RenameFolderResponse"> & {
+};
+
+/**
+ * Describes the message feeds.v1.RenameFolderResponse.
+ * Use `create(RenameFolderResponseSchema)` to create a new message.
+ */
+export const RenameFolderResponseSchema: GenMessage<RenameFolderResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 33);
+
+/**
+ * DeleteFolder removes the folder and its subfolders; their feeds become
+ * unfiled, not deleted.
+ *
+ * @generated from message feeds.v1.DeleteFolderRequest
+ */
+export type DeleteFolderRequest = Message<"feeds.v1.DeleteFolderRequest"> & {
+  /**
+   * @generated from field: string folder_id = 1;
+   */
+  folderId: string;
+};
+
+/**
+ * Describes the message feeds.v1.DeleteFolderRequest.
+ * Use `create(DeleteFolderRequestSchema)` to create a new message.
+ */
+export const DeleteFolderRequestSchema: GenMessage<DeleteFolderRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 34);
+
+/**
+ * @generated from message feeds.v1.DeleteFolderResponse
+ */
+export type DeleteFolderResponse = Message<"feeds.v1.DeleteFolderResponse"> & {
+};
+
+/**
+ * Describes the message feeds.v1.DeleteFolderResponse.
+ * Use `create(DeleteFolderResponseSchema)` to create a new message.
+ */
+export const DeleteFolderResponseSchema: GenMessage<DeleteFolderResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 35);
+
+/**
+ * ReorderFolders sets each listed folder's position to its index in ids.
+ *
+ * @generated from message feeds.v1.ReorderFoldersRequest
+ */
+export type ReorderFoldersRequest = Message<"feeds.v1.ReorderFoldersRequest"> & {
+  /**
+   * @generated from field: repeated string ids = 1;
+   */
+  ids: string[];
+};
+
+/**
+ * Describes the message feeds.v1.ReorderFoldersRequest.
+ * Use `create(ReorderFoldersRequestSchema)` to create a new message.
+ */
+export const ReorderFoldersRequestSchema: GenMessage<ReorderFoldersRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 36);
+
+/**
+ * @generated from message feeds.v1.ReorderFoldersResponse
+ */
+export type ReorderFoldersResponse = Message<"feeds.v1.ReorderFoldersResponse"> & {
+};
+
+/**
+ * Describes the message feeds.v1.ReorderFoldersResponse.
+ * Use `create(ReorderFoldersResponseSchema)` to create a new message.
+ */
+export const ReorderFoldersResponseSchema: GenMessage<ReorderFoldersResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 37);
+
+/**
+ * MoveFeed files the feed under folder_id, or unfiles it when empty.
+ *
+ * @generated from message feeds.v1.MoveFeedRequest
+ */
+export type MoveFeedRequest = Message<"feeds.v1.MoveFeedRequest"> & {
+  /**
+   * @generated from field: string feed_id = 1;
+   */
+  feedId: string;
+
+  /**
+   * @generated from field: string folder_id = 2;
+   */
+  folderId: string;
+};
+
+/**
+ * Describes the message feeds.v1.MoveFeedRequest.
+ * Use `create(MoveFeedRequestSchema)` to create a new message.
+ */
+export const MoveFeedRequestSchema: GenMessage<MoveFeedRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 38);
+
+/**
+ * @generated from message feeds.v1.MoveFeedResponse
+ */
+export type MoveFeedResponse = Message<"feeds.v1.MoveFeedResponse"> & {
+};
+
+/**
+ * Describes the message feeds.v1.MoveFeedResponse.
+ * Use `create(MoveFeedResponseSchema)` to create a new message.
+ */
+export const MoveFeedResponseSchema: GenMessage<MoveFeedResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 39);
+
+/**
+ * FilterRule acts on each new item whose field matches pattern as it is
+ * ingested. Rules never change items already stored.
+ *
+ * @generated from message feeds.v1.FilterRule
+ */
+export type FilterRule = Message<"feeds.v1.FilterRule"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Limits the rule to one feed; empty applies it to all the caller's feeds.
+   *
+   * @generated from field: string feed_id = 2;
+   */
+  feedId: string;
+
+  /**
+   * "title", "author", "url" or "content" (the article's text, not its
+   * HTML).
+   *
+   * @generated from field: string field = 3;
+   */
+  field: string;
+
+  /**
+   * "keyword" (case-insensitive substring) or "regex" (RE2 syntax, case-
+   * sensitive unless the pattern starts with (?i)).
+   *
+   * @generated from field: string match_type = 4;
+   */
+  matchType: string;
+
+  /**
+   * @generated from field: string pattern = 5;
+   */
+  pattern: string;
+
+  /**
+   * "dismiss", "bookmark", "mark_read" or "tag".
+   *
+   * @generated from field: string action = 6;
+   */
+  action: string;
+
+  /**
+   * The label the tag action adds; ignored for other actions.
+   *
+   * @generated from field: string tag = 7;
+   */
+  tag: string;
+
+  /**
+   * @generated from field: bool enabled = 8;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: string created_at = 9;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message feeds.v1.FilterRule.
+ * Use `create(FilterRuleSchema)` to create a new message.
+ */
+export const FilterRuleSchema: GenMessage<FilterRule> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 40);
+
+/**
+ * ListFilterRules returns the caller's rules, oldest first — the order they
+ * are applied in.
+ *
+ * @generated from message feeds.v1.ListFilterRulesRequest
+ */
+export type ListFilterRulesRequest = Message<"feeds.v1.ListFilterRulesRequest"> & {
+};
+
+/**
+ * Describes the message feeds.v1.ListFilterRulesRequest.
+ * Use `create(ListFilterRulesRequestSchema)` to create a new message.
+ */
+export const ListFilterRulesRequestSchema: GenMessage<ListFilterRulesRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 41);
+
+/**
+ * @generated from message feeds.v1.ListFilterRulesResponse
+ */
+export type ListFilterRulesResponse = Message<"feeds.v1.ListFilterRulesResponse"> & {
+  /**
+   * @generated from field: repeated feeds.v1.FilterRule rules = 1;
+   */
+  rules: FilterRule[];
+};
+
+/**
+ * Describes the message feeds.v1.ListFilterRulesResponse.
+ * Use `create(ListFilterRulesResponseSchema)` to create a new message.
+ */
+export const ListFilterRulesResponseSchema: GenMessage<ListFilterRulesResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 42);
+
+/**
+ * CreateFilterRule ignores rule.id. INVALID_ARGUMENT for an unknown field,
+ * match type or action, an empty pattern, a regex that does not compile, or
+ * a tag action without a tag.
+ *
+ * @generated from message feeds.v1.CreateFilterRuleRequest
+ */
+export type CreateFilterRuleRequest = Message<"feeds.v1.CreateFilterRuleRequest"> & {
+  /**
+   * @generated from field: feeds.v1.FilterRule rule = 1;
+   */
+  rule?: FilterRule;
+};
+
+/**
+ * Describes the message feeds.v1.CreateFilterRuleRequest.
+ * Use `create(CreateFilterRuleRequestSchema)` to create a new message.
+ */
+export const CreateFilterRuleRequestSchema: GenMessage<CreateFilterRuleRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 43);
+
+/**
+ * @generated from message feeds.v1.CreateFilterRuleResponse
+ */
+export type CreateFilterRuleResponse = Message<"feeds.v1.CreateFilterRuleResponse"> & {
+  /**
+   * @generated from field: feeds.v1.FilterRule rule = 1;
+   */
+  rule?: FilterRule;
+};
+
+/**
+ * Describes the message feeds.v1.CreateFilterRuleResponse.
+ * Use `create(CreateFilterRuleResponseSchema)` to create a new message.
+ */
+export const CreateFilterRuleResponseSchema: GenMessage<CreateFilterRuleResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 44);
+
+/**
+ * UpdateFilterRule replaces every field of the rule named by rule.id.
+ *
+ * @generated from message feeds.v1.UpdateFilterRuleRequest
+ */
+export type UpdateFilterRuleRequest = Message<"feeds.v1.UpdateFilterRuleRequest"> & {
+  /**
+   * @generated from field: feeds.v1.FilterRule rule = 1;
+   */
+  rule?: FilterRule;
+};
+
+/**
+ * Describes the message feeds.v1.UpdateFilterRuleRequest.
+ * Use `create(UpdateFilterRuleRequestSchema)` to create a new message.
+ */
+export const UpdateFilterRuleRequestSchema: GenMessage<UpdateFilterRuleRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 45);
+
+/**
+ * @generated from message feeds.v1.UpdateFilterRuleResponse
+ */
+export type UpdateFilterRuleResponse = Message<"feeds.v1.UpdateFilterRuleResponse"> & {
+  /**
+   * @generated from field: feeds.v1.FilterRule rule = 1;
+   */
+  rule?: FilterRule;
+};
+
+/**
+ * Describes the message feeds.v1.UpdateFilterRuleResponse.
+ * Use `create(UpdateFilterRuleResponseSchema)` to create a new message.
+ */
+export const UpdateFilterRuleResponseSchema: GenMessage<UpdateFilterRuleResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 46);
+
+/**
+ * @generated from message feeds.v1.DeleteFilterRuleRequest
+ */
+export type DeleteFilterRuleRequest = Message<"feeds.v1.DeleteFilterRuleRequest"> & {
+  /**
+   * @generated from field: string rule_id = 1;
+   */
+  ruleId: string;
+};
+
+/**
+ * Describes the message feeds.v1.DeleteFilterRuleRequest.
+ * Use `create(DeleteFilterRuleRequestSchema)` to create a new message.
+ */
+export const DeleteFilterRuleRequestSchema: GenMessage<DeleteFilterRuleRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 47);
+
+/**
+ * @generated from message feeds.v1.DeleteFilterRuleResponse
+ */
+export type DeleteFilterRuleResponse = Message<"feeds.v1.DeleteFilterRuleResponse"> & {
+};
+
+/**
+ * Describes the message feeds.v1.DeleteFilterRuleResponse.
+ * Use `create(DeleteFilterRuleResponseSchema)` to create a new message.
+ */
+export const DeleteFilterRuleResponseSchema: GenMessage<DeleteFilterRuleResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 48);
+
+/**
+ * TestFilterRule previews an unsaved rule against the caller's limit most
+ * recent items (its feed's, when feed_id is set), read and dismissed ones
+ * included; limit defaults to 50 and is capped at 200. Nothing is changed.
+ *
+ * @generated from message feeds.v1.TestFilterRuleRequest
+ */
+export type TestFilterRuleRequest = Message<"feeds.v1.TestFilterRuleRequest"> & {
+  /**
+   * @generated from field: feeds.v1.FilterRule rule = 1;
+   */
+  rule?: FilterRule;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message feeds.v1.TestFilterRuleRequest.
+ * Use `create(TestFilterRuleRequestSchema)` to create a new message.
+ */
+export const TestFilterRuleRequestSchema: GenMessage<TestFilterRuleRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 49);
+
+/**
+ * @generated from message feeds.v1.TestFilterRuleResponse
+ */
+export type TestFilterRuleResponse = Message<"feeds.v1.TestFilterRuleResponse"> & {
+  /**
+   * The checked items the rule matches, newest first.
+   *
+   * @generated from field: repeated feeds.v1.Item matches = 1;
+   */
+  matches: Item[];
+
+  /**
+   * @generated from field: int32 checked_count = 2;
+   */
+  checkedCount: number;
+};
+
+/**
+ * Describes the message feeds.v1.TestFilterRuleResponse.
+ * Use `create(TestFilterRuleResponseSchema)` to create a new message.
+ */
+export const TestFilterRuleResponseSchema: GenMessage<TestFilterRuleResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 50);
+
+/**
+ * AppPassword is a set of credentials a native reader app logs in to the
+ * Google Reader API (served under /feeds/greader) with. The password itself
+ * is only ever returned by CreateAppPassword.
+ *
+ * @generated from message feeds.v1.AppPassword
+ */
+export type AppPassword = Message<"feeds.v1.AppPassword"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string created_at = 3;
+   */
+  createdAt: string;
+
+  /**
+   * Empty until the app has logged in.
+   *
+   * @generated from field: string last_used_at = 4;
+   */
+  lastUsedAt: string;
+};
+
+/**
+ * Describes the message feeds.v1.AppPassword.
+ * Use `create(AppPasswordSchema)` to create a new message.
+ */
+export const AppPasswordSchema: GenMessage<AppPassword> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 51);
+
+/**
+ * @generated from message feeds.v1.ListAppPasswordsRequest
+ */
+export type ListAppPasswordsRequest = Message<"feeds.v1.ListAppPasswordsRequest"> & {
+};
+
+/**
+ * Describes the message feeds.v1.ListAppPasswordsRequest.
+ * Use `create(ListAppPasswordsRequestSchema)` to create a new message.
+ */
+export const ListAppPasswordsRequestSchema: GenMessage<ListAppPasswordsRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 52);
+
+/**
+ * @generated from message feeds.v1.ListAppPasswordsResponse
+ */
+export type ListAppPasswordsResponse = Message<"feeds.v1.ListAppPasswordsResponse"> & {
+  /**
+   * @generated from field: repeated feeds.v1.AppPassword app_passwords = 1;
+   */
+  appPasswords: AppPassword[];
+};
+
+/**
+ * Describes the message feeds.v1.ListAppPasswordsResponse.
+ * Use `create(ListAppPasswordsResponseSchema)` to create a new message.
+ */
+export const ListAppPasswordsResponseSchema: GenMessage<ListAppPasswordsResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 53);
+
+/**
+ * CreateAppPassword generates a password for the username the app will log
+ * in with. INVALID_ARGUMENT for an empty username.
+ *
+ * @generated from message feeds.v1.CreateAppPasswordRequest
+ */
+export type CreateAppPasswordRequest = Message<"feeds.v1.CreateAppPasswordRequest"> & {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username: string;
+};
+
+/**
+ * Describes the message feeds.v1.CreateAppPasswordRequest.
+ * Use `create(CreateAppPasswordRequestSchema)` to create a new message.
+ */
+export const CreateAppPasswordRequestSchema: GenMessage<CreateAppPasswordRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 54);
+
+/**
+ * @generated from message feeds.v1.CreateAppPasswordResponse
+ */
+export type CreateAppPasswordResponse = Message<"feeds.v1.CreateAppPasswordResponse"> & {
+  /**
+   * @generated from field: feeds.v1.AppPassword app_password = 1;
+   */
+  appPassword?: AppPassword;
+
+  /**
+   * Shown once; only a hash is stored.
+   *
+   * @generated from field: string password = 2;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message feeds.v1.CreateAppPasswordResponse.
+ * Use `create(CreateAppPasswordResponseSchema)` to create a new message.
+ */
+export const CreateAppPasswordResponseSchema: GenMessage<CreateAppPasswordResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 55);
+
+/**
+ * @generated from message feeds.v1.DeleteAppPasswordRequest
+ */
+export type DeleteAppPasswordRequest = Message<"feeds.v1.DeleteAppPasswordRequest"> & {
+  /**
+   * @generated from field: string app_password_id = 1;
+   */
+  appPasswordId: string;
+};
+
+/**
+ * Describes the message feeds.v1.DeleteAppPasswordRequest.
+ * Use `create(DeleteAppPasswordRequestSchema)` to create a new message.
+ */
+export const DeleteAppPasswordRequestSchema: GenMessage<DeleteAppPasswordRequest> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 56);
+
+/**
+ * @generated from message feeds.v1.DeleteAppPasswordResponse
+ */
+export type DeleteAppPasswordResponse = Message<"feeds.v1.DeleteAppPasswordResponse"> & {
+};
+
+/**
+ * Describes the message feeds.v1.DeleteAppPasswordResponse.
+ * Use `create(DeleteAppPasswordResponseSchema)` to create a new message.
+ */
+export const DeleteAppPasswordResponseSchema: GenMessage<DeleteAppPasswordResponse> = /*@__PURE__*/
+  messageDesc(file_feeds_v1_feeds, 57);
+
+/**
+ * @generated from enum feeds.v1.FeedKind
+ */
+export enum FeedKind {
+  /**
+   * treated as RSS
+   *
+   * @generated from enum value: FEED_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: FEED_KIND_RSS = 1;
+   */
+  RSS = 1,
+
+  /**
+   * @generated from enum value: FEED_KIND_EMAIL = 2;
+   */
+  EMAIL = 2,
+
+  /**
+   * @generated from enum value: FEED_KIND_SCRAPE = 3;
+   */
+  SCRAPE = 3,
+}
+
+/**
+ * Describes the enum feeds.v1.FeedKind.
+ */
+export const FeedKindSchema: GenEnum<FeedKind> = /*@__PURE__*/
+  enumDesc(file_feeds_v1_feeds, 0);
+
+/**
+ * @generated from service feeds.v1.FeedService
+ */
+export const FeedService: GenService<{
+  /**
+   * @generated from rpc feeds.v1.FeedService.ListFeeds
+   */
+  listFeeds: {
+    methodKind: "unary";
+    input: typeof ListFeedsRequestSchema;
+    output: typeof ListFeedsResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.CreateFeed
+   */
+  createFeed: {
+    methodKind: "unary";
+    input: typeof CreateFeedRequestSchema;
+    output: typeof CreateFeedResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.UpdateFeed
+   */
+  updateFeed: {
+    methodKind: "unary";
+    input: typeof UpdateFeedRequestSchema;
+    output: typeof UpdateFeedResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.DeleteFeed
+   */
+  deleteFeed: {
+    methodKind: "unary";
+    input: typeof DeleteFeedRequestSchema;
+    output: typeof DeleteFeedResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.RefreshFeed
+   */
+  refreshFeed: {
+    methodKind: "unary";
+    input: typeof RefreshFeedRequestSchema;
+    output: typeof RefreshFeedResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.ListFeedItems
+   */
+  listFeedItems: {
+    methodKind: "unary";
+    input: typeof ListFeedItemsRequestSchema;
+    output: typeof ListFeedItemsResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.GetFeedItem
+   */
+  getFeedItem: {
+    methodKind: "unary";
+    input: typeof GetFeedItemRequestSchema;
+    output: typeof GetFeedItemResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.UpdateItem
+   */
+  updateItem: {
+    methodKind: "unary";
+    input: typeof UpdateItemRequestSchema;
+    output: typeof UpdateItemResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.SendItemToLibrary
+   */
+  sendItemToLibrary: {
+    methodKind: "unary";
+    input: typeof SendItemToLibraryRequestSchema;
+    output: typeof SendItemToLibraryResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.GetFeedStats
+   */
+  getFeedStats: {
+    methodKind: "unary";
+    input: typeof GetFeedStatsRequestSchema;
+    output: typeof GetFeedStatsResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.ImportOPML
+   */
+  importOPML: {
+    methodKind: "unary";
+    input: typeof ImportOPMLRequestSchema;
+    output: typeof ImportOPMLResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.ExportOPML
+   */
+  exportOPML: {
+    methodKind: "unary";
+    input: typeof ExportOPMLRequestSchema;
+    output: typeof ExportOPMLResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.CreateFolder
+   */
+  createFolder: {
+    methodKind: "unary";
+    input: typeof CreateFolderRequestSchema;
+    output: typeof CreateFolderResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.RenameFolder
+   */
+  renameFolder: {
+    methodKind: "unary";
+    input: typeof RenameFolderRequestSchema;
+    output: typeof RenameFolderResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.DeleteFolder
+   */
+  deleteFolder: {
+    methodKind: "unary";
+    input: typeof DeleteFolderRequestSchema;
+    output: typeof DeleteFolderResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.ReorderFolders
+   */
+  reorderFolders: {
+    methodKind: "unary";
+    input: typeof ReorderFoldersRequestSchema;
+    output: typeof ReorderFoldersResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.MoveFeed
+   */
+  moveFeed: {
+    methodKind: "unary";
+    input: typeof MoveFeedRequestSchema;
+    output: typeof MoveFeedResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.ListFilterRules
+   */
+  listFilterRules: {
+    methodKind: "unary";
+    input: typeof ListFilterRulesRequestSchema;
+    output: typeof ListFilterRulesResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.CreateFilterRule
+   */
+  createFilterRule: {
+    methodKind: "unary";
+    input: typeof CreateFilterRuleRequestSchema;
+    output: typeof CreateFilterRuleResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.UpdateFilterRule
+   */
+  updateFilterRule: {
+    methodKind: "unary";
+    input: typeof UpdateFilterRuleRequestSchema;
+    output: typeof UpdateFilterRuleResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.DeleteFilterRule
+   */
+  deleteFilterRule: {
+    methodKind: "unary";
+    input: typeof DeleteFilterRuleRequestSchema;
+    output: typeof DeleteFilterRuleResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.TestFilterRule
+   */
+  testFilterRule: {
+    methodKind: "unary";
+    input: typeof TestFilterRuleRequestSchema;
+    output: typeof TestFilterRuleResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.ListAppPasswords
+   */
+  listAppPasswords: {
+    methodKind: "unary";
+    input: typeof ListAppPasswordsRequestSchema;
+    output: typeof ListAppPasswordsResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.CreateAppPassword
+   */
+  createAppPassword: {
+    methodKind: "unary";
+    input: typeof CreateAppPasswordRequestSchema;
+    output: typeof CreateAppPasswordResponseSchema;
+  },
+  /**
+   * @generated from rpc feeds.v1.FeedService.DeleteAppPassword
+   */
+  deleteAppPassword: {
+    methodKind: "unary";
+    input: typeof DeleteAppPasswordRequestSchema;
+    output: typeof DeleteAppPasswordResponseSchema;
   },
 }> = /*@__PURE__*/
   serviceDesc(file_feeds_v1_feeds, 0);
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file observability/v1/observability.proto.
  */
 export const file_observability_v1_observability: GenFile = /*@__PURE__*/
-  fileDesc("CiRvYnNlcnZhYmlsaXR5L3YxL29ic2VydmFiaWxpdHkucHJvdG8SEG9ic2VydmFiaWxpdHkudjEicAoHSm9iU3RhdBIOCgZqb2JfaWQYASABKAkSEgoKdG90YWxfcnVucxgCIAEoAxITCgtmYWlsZWRfcnVucxgDIAEoAxIXCg9hdmdfZHVyYXRpb25fbXMYBCABKAMSEwoLbGFzdF9ydW5fYXQYBSABKAkiYQoGSm9iUnVuEg4KBmpvYl9pZBgBIAEoCRISCgpzdGFydGVkX2F0GAIgASgJEhMKC2R1cmF0aW9uX21zGAMgASgDEg8KB3N1Y2Nlc3MYBCABKAgSDQoFZXJyb3IYBSABKAkiKQoSR2V0Sm9iU3RhdHNSZXF1ZXN0EhMKC3dpbmRvd19kYXlzGAEgASgFIm4KE0dldEpvYlN0YXRzUmVzcG9uc2USKAoFc3RhdHMYASADKAsyGS5vYnNlcnZhYmlsaXR5LnYxLkpvYlN0YXQSLQoLcmVjZW50X3J1bnMYAiADKAsyGC5vYnNlcnZhYmlsaXR5LnYxLkpvYlJ1biJUCghVc2FnZURheRILCgNkYXkYASABKAkSCwoDYXBwGAIgASgJEhAKCGVuZHBvaW50GAMgASgJEg0KBWNvdW50GAQgASgDEg0KBWJ5dGVzGAUgASgDIisKFEdldFVzYWdlU3RhdHNSZXF1ZXN0EhMKC3dpbmRvd19kYXlzGAEgASgFIlkKFUdldFVzYWdlU3RhdHNSZXNwb25zZRIrCgdlbnRyaWVzGAEgAygLMhoub2JzZXJ2YWJpbGl0eS52MS5Vc2FnZURheRITCgt1bnVzZWRfYXBwcxgCIAMoCSI/CgpQcmVmaXhTdGF0Eg4KBnByZWZpeBgBIAEoCRISCgpzaXplX2J5dGVzGAIgASgDEg0KBWNvdW50GAMgASgDIvsBCg9TdG9yYWdlU25hcHNob3QSEgoKc2Nhbm5lZF9hdBgBIAEoCRIYChB0b3RhbF9zaXplX2J5dGVzGAIgASgDEhQKDG9iamVjdF9jb3VudBgDIAEoAxIZChFvcnBoYW5fc2l6ZV9ieXRlcxgEIAEoAxIUCgxvcnBoYW5fY291bnQYBSABKAMSHwoXc3RhbGVfdXBsb2FkX3NpemVfYnl0ZXMYBiABKAMSGgoSc3RhbGVfdXBsb2FkX2NvdW50GAcgASgDEjYKEHByZWZpeF9icmVha2Rvd24YCCADKAsyHC5vYnNlcnZhYmlsaXR5LnYxLlByZWZpeFN0YXQiGAoWR2V0U3RvcmFnZVN0YXRzUmVxdWVzdCKAAQoXR2V0U3RvcmFnZVN0YXRzUmVzcG9uc2USMQoGbGF0ZXN0GAEgASgLMiEub2JzZXJ2YWJpbGl0eS52MS5TdG9yYWdlU25hcHNob3QSMgoHaGlzdG9yeRgCIAMoCzIhLm9ic2VydmFiaWxpdHkudjEuU3RvcmFnZVNuYXBzaG90IhsKGVRyaWdnZXJTdG9yYWdlU2NhblJlcXVlc3QiHAoaVHJpZ2dlclN0b3JhZ2VTY2FuUmVzcG9uc2UiQwoKU2NoZW1hU3RhdBIMCgRuYW1lGAEgASgJEhIKCnNpemVfYnl0ZXMYAiABKAMSEwoLdGFibGVfY291bnQYAyABKAMiGQoXR2V0RGF0YWJhc2VTdGF0c1JlcXVlc3QiYwoYR2V0RGF0YWJhc2VTdGF0c1Jlc3BvbnNlEhgKEHRvdGFsX3NpemVfYnl0ZXMYASABKAMSLQoHc2NoZW1hcxgCIAMoCzIcLm9ic2VydmFiaWxpdHkudjEuU2NoZW1hU3RhdCI9CgxGYWlsaW5nQ2hlY2sSDAoEbmFtZRgBIAEoCRISCgpjb25jbHVzaW9uGAIgASgJEgsKA3VybBgDIAEoCSKcAQoSRmFpbGluZ1B1bGxSZXF1ZXN0Eg4KBm51bWJlchgBIAEoAxINCgV0aXRsZRgCIAEoCRILCgN1cmwYAyABKAkSDgoGYXV0aG9yGAQgASgJEhIKCnVwZGF0ZWRfYXQYBSABKAkSNgoOZmFpbGluZ19jaGVja3MYBiADKAsyHi5vYnNlcnZhYmlsaXR5LnYxLkZhaWxpbmdDaGVjayIfCh1HZXRGYWlsaW5nUHVsbFJlcXVlc3RzUmVxdWVzdCKIAQoeR2V0RmFpbGluZ1B1bGxSZXF1ZXN0c1Jlc3BvbnNlEjsKDXB1bGxfcmVxdWVzdHMYASADKAsyJC5vYnNlcnZhYmlsaXR5LnYxLkZhaWxpbmdQdWxsUmVxdWVzdBISCgpjb25maWd1cmVkGAIgASgIEhUKDWZhaWxpbmdfY291bnQYAyABKAUijAIKDVNlY3VyaXR5QWxlcnQSDgoGbnVtYmVyGAEgASgDEhQKDHBhY2thZ2VfbmFtZRgCIAEoCRIRCgllY29zeXN0ZW0YAyABKAkSEAoIc2V2ZXJpdHkYBCABKAkSDwoHc3VtbWFyeRgFIAEoCRILCgN1cmwYBiABKAkSEgoKY3JlYXRlZF9hdBgHIAEoCRI3CgphbGVydF90eXBlGAggASgOMiMub2JzZXJ2YWJpbGl0eS52MS5TZWN1cml0eUFsZXJ0VHlwZRIPCgdydWxlX2lkGAkgASgJEhEKCWZpbGVfcGF0aBgKIAEoCRIMCgRsaW5lGAsgASgFEhMKC3NlY3JldF90eXBlGAwgASgJIhoKGEdldFNlY3VyaXR5QWxlcnRzUmVxdWVzdCJ1ChlHZXRTZWN1cml0eUFsZXJ0c1Jlc3BvbnNlEi8KBmFsZXJ0cxgBIAMoCzIfLm9ic2VydmFiaWxpdHkudjEuU2VjdXJpdHlBbGVydBISCgpjb25maWd1cmVkGAIgASgIEhMKC2FsZXJ0X2NvdW50GAMgASgFIo4BCgtTZW50cnlJc3N1ZRIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRIPCgdjdWxwcml0GAMgASgJEhEKCXBlcm1hbGluaxgEIAEoCRINCgVjb3VudBgFIAEoAxIRCglsYXN0X3NlZW4YBiABKAkSDQoFbGV2ZWwYByABKAkSDwoHcHJvamVjdBgIIAEoCSIYChZHZXRTZW50cnlJc3N1ZXNSZXF1ZXN0InYKF0dldFNlbnRyeUlzc3Vlc1Jlc3BvbnNlEi0KBmlzc3VlcxgBIAMoCzIdLm9ic2VydmFiaWxpdHkudjEuU2VudHJ5SXNzdWUSEgoKY29uZmlndXJlZBgCIAEoCBIYChB1bnJlc29sdmVkX2NvdW50GAMgASgFIi0KGVJlc29sdmVTZW50cnlJc3N1ZVJlcXVlc3QSEAoIaXNzdWVfaWQYASABKAkiHAoaUmVzb2x2ZVNlbnRyeUlzc3VlUmVzcG9uc2UiZwoPU2xvd1RyYW5zYWN0aW9uEhMKC3RyYW5zYWN0aW9uGAEgASgJEg8KB3Byb2plY3QYAiABKAkSFwoPcDk1X2R1cmF0aW9uX21zGAMgASgBEhUKDXJlcXVlc3RfY291bnQYBCABKAMigQEKEFRyYW5zYWN0aW9uVHJlbmQSEwoLdHJhbnNhY3Rpb24YASABKAkSDwoHcHJvamVjdBgCIAEoCRIYChBwcmlvcl9hdmdfcDk1X21zGAMgASgBEhkKEXJlY2VudF9hdmdfcDk1X21zGAQgASgBEhIKCnBjdF9jaGFuZ2UYBSABKAEiHAoaR2V0U2xvd1RyYW5zYWN0aW9uc1JlcXVlc3QimwEKG0dldFNsb3dUcmFuc2FjdGlvbnNSZXNwb25zZRIyCgdjdXJyZW50GAEgAygLMiEub2JzZXJ2YWJpbGl0eS52MS5TbG93VHJhbnNhY3Rpb24SEgoKY29uZmlndXJlZBgCIAEoCBI0Cgh0cmVuZGluZxgDIAMoCzIiLm9ic2VydmFiaWxpdHkudjEuVHJhbnNhY3Rpb25UcmVuZCIYChZHZXREZXBsb3lTdGF0dXNSZXF1ZXN0IooBChdHZXREZXBsb3lTdGF0dXNSZXNwb25zZRISCgpjb25maWd1cmVkGAEgASgIEg0KBXBoYXNlGAIgASgJEg0KBWNhdXNlGAMgASgJEhIKCmNyZWF0ZWRfYXQYBCABKAkSEgoKdXBkYXRlZF9hdBgFIAEoCRIVCg1kZXBsb3ltZW50X2lkGAYgASgJIkEKFEdldERlcGxveUxvZ3NSZXF1ZXN0EhUKDWRlcGxveW1lbnRfaWQYASABKAkSEgoKdGFpbF9saW5lcxgCIAEoBSJ0ChJEZXBsb3lDb21wb25lbnRMb2cSEQoJY29tcG9uZW50GAEgASgJEhAKCGxvZ190eXBlGAIgASgJEg8KB2NvbnRlbnQYAyABKAkSEQoJdHJ1bmNhdGVkGAQgASgIEhUKDWRlcGxveW1lbnRfaWQYBSABKAkiXgopT2JzZXJ2YWJpbGl0eVNlcnZpY2VHZXREZXBsb3lMb2dzUmVzcG9uc2USMQoDbG9nGAEgASgLMiQub2JzZXJ2YWJpbGl0eS52MS5EZXBsb3lDb21wb25lbnRMb2cidgoVR2V0RGVwbG95TG9nc1Jlc3BvbnNlEhIKCmNvbmZpZ3VyZWQYASABKAgSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIyCgRsb2dzGAMgAygLMiQub2JzZXJ2YWJpbGl0eS52MS5EZXBsb3lDb21wb25lbnRMb2ciGgoYR2V0SGVhbHRoT3ZlcnZpZXdSZXF1ZXN0IpEBChlHZXRIZWFsdGhPdmVydmlld1Jlc3BvbnNlEjkKBnNlbnRyeRgCIAEoCzIpLm9ic2VydmFiaWxpdHkudjEuR2V0U2VudHJ5SXNzdWVzUmVzcG9uc2USOQoGZGVwbG95GAMgASgLMikub2JzZXJ2YWJpbGl0eS52MS5HZXREZXBsb3lTdGF0dXNSZXNwb25zZSIcCgxHaXRodWJDb25maWcSDAoEcmVwbxgBIAEoCSItCgxTZW50cnlDb25maWcSCwoDb3JnGAEgASgJEhAKCHByb2plY3RzGAIgAygJIiQKEkRpZ2l0YWxPY2VhbkNvbmZpZxIOCgZhcHBfaWQYASABKAkivAEKDlByb3ZpZGVyQ29uZmlnEjAKBmdpdGh1YhgBIAEoCzIeLm9ic2VydmFiaWxpdHkudjEuR2l0aHViQ29uZmlnSAASMAoGc2VudHJ5GAIgASgLMh4ub2JzZXJ2YWJpbGl0eS52MS5TZW50cnlDb25maWdIABI8CgxkaWdpdGFsb2NlYW4YAyABKAsyJC5vYnNlcnZhYmlsaXR5LnYxLkRpZ2l0YWxPY2VhbkNvbmZpZ0gAQggKBmNvbmZpZyKuAQoVT0F1dGhDb25uZWN0aW9uU3RhdHVzEhAKCHByb3ZpZGVyGAEgASgJEhEKCWNvbm5lY3RlZBgCIAEoCBIUCgxjb25uZWN0ZWRfYnkYAyABKAkSFAoMY29ubmVjdGVkX2F0GAQgASgJEhIKCmV4cGlyZXNfYXQYBSABKAkSMAoGY29uZmlnGAYgASgLMiAub2JzZXJ2YWJpbGl0eS52MS5Qcm92aWRlckNvbmZpZyIdChtMaXN0T0F1dGhDb25uZWN0aW9uc1JlcXVlc3QiXAocTGlzdE9BdXRoQ29ubmVjdGlvbnNSZXNwb25zZRI8Cgtjb25uZWN0aW9ucxgBIAMoCzInLm9ic2VydmFiaWxpdHkudjEuT0F1dGhDb25uZWN0aW9uU3RhdHVzIjQKIERpc2Nvbm5lY3RPQXV0aENvbm5lY3Rpb25SZXF1ZXN0EhAKCHByb3ZpZGVyGAEgASgJIiMKIURpc2Nvbm5lY3RPQXV0aENvbm5lY3Rpb25SZXNwb25zZSJBChlHZXRQcm92aWRlck9wdGlvbnNSZXF1ZXN0EhAKCHByb3ZpZGVyGAEgASgJEhIKCnNlbnRyeV9vcmcYAiABKAkiZwoaR2V0UHJvdmlkZXJPcHRpb25zUmVzcG9uc2USDQoFcmVwb3MYASADKAkSEwoLc2VudHJ5X29yZ3MYAiADKAkSFwoPc2VudHJ5X3Byb2plY3RzGAMgAygJEgwKBGFwcHMYBCADKAkiXgoYU2V0UHJvdmlkZXJDb25maWdSZXF1ZXN0EhAKCHByb3ZpZGVyGAEgASgJEjAKBmNvbmZpZxgCIAEoCzIgLm9ic2VydmFiaWxpdHkudjEuUHJvdmlkZXJDb25maWciGwoZU2V0UHJvdmlkZXJDb25maWdSZXNwb25zZSqsAQoRU2VjdXJpdHlBbGVydFR5cGUSIwofU0VDVVJJVFlfQUxFUlRfVFlQRV9VTlNQRUNJRklFRBAAEiIKHlNFQ1VSSVRZX0FMRVJUX1RZUEVfREVQRU5EQUJPVBABEiUKIVNFQ1VSSVRZX0FMRVJUX1RZUEVfQ09ERV9TQ0FOTklORxACEicKI1NFQ1VSSVRZX0FMRVJUX1RZUEVfU0VDUkVUX1NDQU5OSU5HEAMy+w4KFE9ic2VydmFiaWxpdHlTZXJ2aWNlEloKC0dldEpvYlN0YXRzEiQub2JzZXJ2YWJpbGl0eS52MS5HZXRKb2JTdGF0c1JlcXVlc3QaJS5vYnNlcnZhYmlsaXR5LnYxLkdldEpvYlN0YXRzUmVzcG9uc2USYAoNR2V0VXNhZ2VTdGF0cxImLm9ic2VydmFiaWxpdHkudjEuR2V0VXNhZ2VTdGF0c1JlcXVlc3QaJy5vYnNlcnZhYmlsaXR5LnYxLkdldFVzYWdlU3RhdHNSZXNwb25zZRJmCg9HZXRTdG9yYWdlU3RhdHMSKC5vYnNlcnZhYmlsaXR5LnYxLkdldFN0b3JhZ2VTdGF0c1JlcXVlc3QaKS5vYnNlcnZhYmlsaXR5LnYxLkdldFN0b3JhZ2VTdGF0c1Jlc3BvbnNlEm8KElRyaWdnZXJTdG9yYWdlU2NhbhIrLm9ic2VydmFiaWxpdHkudjEuVHJpZ2dlclN0b3JhZ2VTY2FuUmVxdWVzdBosLm9ic2VydmFiaWxpdHkudjEuVHJpZ2dlclN0b3JhZ2VTY2FuUmVzcG9uc2USaQoQR2V0RGF0YWJhc2VTdGF0cxIpLm9ic2VydmFiaWxpdHkudjEuR2V0RGF0YWJhc2VTdGF0c1JlcXVlc3QaKi5vYnNlcnZhYmlsaXR5LnYxLkdldERhdGFiYXNlU3RhdHNSZXNwb25zZRJ7ChZHZXRGYWlsaW5nUHVsbFJlcXVlc3RzEi8ub2JzZXJ2YWJpbGl0eS52MS5HZXRGYWlsaW5nUHVsbFJlcXVlc3RzUmVxdWVzdBowLm9ic2VydmFiaWxpdHkudjEuR2V0RmFpbGluZ1B1bGxSZXF1ZXN0c1Jlc3BvbnNlEmwKEUdldFNlY3VyaXR5QWxlcnRzEioub2JzZXJ2YWJpbGl0eS52MS5HZXRTZWN1cml0eUFsZXJ0c1JlcXVlc3QaKy5vYnNlcnZhYmlsaXR5LnYxLkdldFNlY3VyaXR5QWxlcnRzUmVzcG9uc2USZgoPR2V0U2VudHJ5SXNzdWVzEigub2JzZXJ2YWJpbGl0eS52MS5HZXRTZW50cnlJc3N1ZXNSZXF1ZXN0Gikub2JzZXJ2YWJpbGl0eS52MS5HZXRTZW50cnlJc3N1ZXNSZXNwb25zZRJvChJSZXNvbHZlU2VudHJ5SXNzdWUSKy5vYnNlcnZhYmlsaXR5LnYxLlJlc29sdmVTZW50cnlJc3N1ZVJlcXVlc3QaLC5vYnNlcnZhYmlsaXR5LnYxLlJlc29sdmVTZW50cnlJc3N1ZVJlc3BvbnNlEnIKE0dldFNsb3dUcmFuc2FjdGlvbnMSLC5vYnNlcnZhYmlsaXR5LnYxLkdldFNsb3dUcmFuc2FjdGlvbnNSZXF1ZXN0Gi0ub2JzZXJ2YWJpbGl0eS52MS5HZXRTbG93VHJhbnNhY3Rpb25zUmVzcG9uc2USZgoPR2V0RGVwbG95U3RhdHVzEigub2JzZXJ2YWJpbGl0eS52MS5HZXREZXBsb3lTdGF0dXNSZXF1ZXN0Gikub2JzZXJ2YWJpbGl0eS52MS5HZXREZXBsb3lTdGF0dXNSZXNwb25zZRJ2Cg1HZXREZXBsb3lMb2dzEiYub2JzZXJ2YWJpbGl0eS52MS5HZXREZXBsb3lMb2dzUmVxdWVzdBo7Lm9ic2VydmFiaWxpdHkudjEuT2JzZXJ2YWJpbGl0eVNlcnZpY2VHZXREZXBsb3lMb2dzUmVzcG9uc2UwARJsChFHZXRIZWFsdGhPdmVydmlldxIqLm9ic2VydmFiaWxpdHkudjEuR2V0SGVhbHRoT3ZlcnZpZXdSZXF1ZXN0Gisub2JzZXJ2YWJpbGl0eS52MS5HZXRIZWFsdGhPdmVydmlld1Jlc3BvbnNlEnUKFExpc3RPQXV0aENvbm5lY3Rpb25zEi0ub2JzZXJ2YWJpbGl0eS52MS5MaXN0T0F1dGhDb25uZWN0aW9uc1JlcXVlc3QaLi5vYnNlcnZhYmlsaXR5LnYxLkxpc3RPQXV0aENvbm5lY3Rpb25zUmVzcG9uc2UShAEKGURpc2Nvbm5lY3RPQXV0aENvbm5lY3Rpb24SMi5vYnNlcnZhYmlsaXR5LnYxLkRpc2Nvbm5lY3RPQXV0aENvbm5lY3Rpb25SZXF1ZXN0GjMub2JzZXJ2YWJpbGl0eS52MS5EaXNjb25uZWN0T0F1dGhDb25uZWN0aW9uUmVzcG9uc2USbwoSR2V0UHJvdmlkZXJPcHRpb25zEisub2JzZXJ2YWJpbGl0eS52MS5HZXRQcm92aWRlck9wdGlvbnNSZXF1ZXN0Giwub2JzZXJ2YWJpbGl0eS52MS5HZXRQcm92aWRlck9wdGlvbnNSZXNwb25zZRJsChFTZXRQcm92aWRlckNvbmZpZxIqLm9ic2VydmFiaWxpdHkudjEuU2V0UHJvdmlkZXJDb25maWdSZXF1ZXN0Gisub2JzZXJ2YWJpbGl0eS52MS5TZXRQcm92aWRlckNvbmZpZ1Jlc3BvbnNlQjlaN3Rvb2xzLnhkb3VibGV1LmNvbS9nZW4vb2JzZXJ2YWJpbGl0eS92MTtvYnNlcnZhYmlsaXR5djFiBnByb3RvMw");
+  fileDesc("CiRvYnNlcnZhYmlsaXR5L3YxL29ic2VydmFiaWxpdHkucHJvdG8SEG9ic2VydmFiaWxpdHkudjEicAoHSm9iU3RhdBIOCgZqb2JfaWQYASABKAkSEgoKdG90YWxfcnVucxgCIAEoAxITCgtmYWlsZWRfcnVucxgDIAEoAxIXCg9hdmdfZHVyYXRpb25fbXMYBCABKAMSEwoLbGFzdF9ydW5fYXQYBSABKAkiYQoGSm9iUnVuEg4KBmpvYl9pZBgBIAEoCRISCgpzdGFydGVkX2F0GAIgASgJEhMKC2R1cmF0aW9uX21zGAMgASgDEg8KB3N1Y2Nlc3MYBCABKAgSDQoFZXJyb3IYBSABKAkiKQoSR2V0Sm9iU3RhdHNSZXF1ZXN0EhMKC3dpbmRvd19kYXlzGAEgASgFIm4KE0dldEpvYlN0YXRzUmVzcG9uc2USKAoFc3RhdHMYASADKAsyGS5vYnNlcnZhYmlsaXR5LnYxLkpvYlN0YXQSLQoLcmVjZW50X3J1bnMYAiADKAsyGC5vYnNlcnZhYmlsaXR5LnYxLkpvYlJ1biJUCghVc2FnZURheRILCgNkYXkYASABKAkSCwoDYXBwGAIgASgJEhAKCGVuZHBvaW50GAMgASgJEg0KBWNvdW50GAQgASgDEg0KBWJ5dGVzGAUgASgDIisKFEdldFVzYWdlU3RhdHNSZXF1ZXN0EhMKC3dpbmRvd19kYXlzGAEgASgFIlkKFUdldFVzYWdlU3RhdHNSZXNwb25zZRIrCgdlbnRyaWVzGAEgAygLMhoub2JzZXJ2YWJpbGl0eS52MS5Vc2FnZURheRITCgt1bnVzZWRfYXBwcxgCIAMoCSI/CgpQcmVmaXhTdGF0Eg4KBnByZWZpeBgBIAEoCRISCgpzaXplX2J5dGVzGAIgASgDEg0KBWNvdW50GAMgASgDIs0CCg9TdG9yYWdlU25hcHNob3QSEgoKc2Nhbm5lZF9hdBgBIAEoCRIYChB0b3RhbF9zaXplX2J5dGVzGAIgASgDEhQKDG9iamVjdF9jb3VudBgDIAEoAxIZChFvcnBoYW5fc2l6ZV9ieXRlcxgEIAEoAxIUCgxvcnBoYW5fY291bnQYBSABKAMSHwoXc3RhbGVfdXBsb2FkX3NpemVfYnl0ZXMYBiABKAMSGgoSc3RhbGVfdXBsb2FkX2NvdW50GAcgASgDEjYKEHByZWZpeF9icmVha2Rvd24YCCADKAsyHC5vYnNlcnZhYmlsaXR5LnYxLlByZWZpeFN0YXQSGQoRZGVkdXBfc2F2ZWRfYnl0ZXMYCSABKAMSHAoUcmVjbGFpbWVkX3NpemVfYnl0ZXMYCiABKAMSFwoPcmVjbGFpbWVkX2NvdW50GAsgASgDIhgKFkdldFN0b3JhZ2VTdGF0c1JlcXVlc3QigAEKF0dldFN0b3JhZ2VTdGF0c1Jlc3BvbnNlEjEKBmxhdGVzdBgBIAEoCzIhLm9ic2VydmFiaWxpdHkudjEuU3RvcmFnZVNuYXBzaG90EjIKB2hpc3RvcnkYAiADKAsyIS5vYnNlcnZhYmlsaXR5LnYxLlN0b3JhZ2VTbmFwc2hvdCIbChlUcmlnZ2VyU3RvcmFnZVNjYW5SZXF1ZXN0IhwKGlRyaWdnZXJTdG9yYWdlU2NhblJlc3BvbnNlIkMKClNjaGVtYVN0YXQSDAoEbmFtZRgBIAEoCRISCgpzaXplX2J5dGVzGAIgASgDEhMKC3RhYmxlX2NvdW50GAMgASgDIhkKF0dldERhdGFiYXNlU3RhdHNSZXF1ZXN0ImMKGEdldERhdGFiYXNlU3RhdHNSZXNwb25zZRIYChB0b3RhbF9zaXplX2J5dGVzGAEgASgDEi0KB3NjaGVtYXMYAiADKAsyHC5vYnNlcnZhYmlsaXR5LnYxLlNjaGVtYVN0YXQiPQoMRmFpbGluZ0NoZWNrEgwKBG5hbWUYASABKAkSEgoKY29uY2x1c2lvbhgCIAEoCRILCgN1cmwYAyABKAkinAEKEkZhaWxpbmdQdWxsUmVxdWVzdBIOCgZudW1iZXIYASABKAMSDQoFdGl0bGUYAiABKAkSCwoDdXJsGAMgASgJEg4KBmF1dGhvchgEIAEoCRISCgp1cGRhdGVkX2F0GAUgASgJEjYKDmZhaWxpbmdfY2hlY2tzGAYgAygLMh4ub2JzZXJ2YWJpbGl0eS52MS5GYWlsaW5nQ2hlY2siHwodR2V0RmFpbGluZ1B1bGxSZXF1ZXN0c1JlcXVlc3QiiAEKHkdldEZhaWxpbmdQdWxsUmVxdWVzdHNSZXNwb25zZRI7Cg1wdWxsX3JlcXVlc3RzGAEgAygLMiQub2JzZXJ2YWJpbGl0eS52MS5GYWlsaW5nUHVsbFJlcXVlc3QSEgoKY29uZmlndXJlZBgCIAEoCBIVCg1mYWlsaW5nX2NvdW50GAMgASgFIowCCg1TZWN1cml0eUFsZXJ0Eg4KBm51bWJlchgBIAEoAxIUCgxwYWNrYWdlX25hbWUYAiABKAkSEQoJZWNvc3lzdGVtGAMgASgJEhAKCHNldmVyaXR5GAQgASgJEg8KB3N1bW1hcnkYBSABKAkSCwoDdXJsGAYgASgJEhIKCmNyZWF0ZWRfYXQYByABKAkSNwoKYWxlcnRfdHlwZRgIIAEoDjIjLm9ic2VydmFiaWxpdHkudjEuU2VjdXJpdHlBbGVydFR5cGUSDwoHcnVsZV9pZBgJIAEoCRIRCglmaWxlX3BhdGgYCiABKAkSDAoEbGluZRgLIAEoBRITCgtzZWNyZXRfdHlwZRgMIAEoCSIaChhHZXRTZWN1cml0eUFsZXJ0c1JlcXVlc3QidQoZR2V0U2VjdXJpdHlBbGVydHNSZXNwb25zZRIvCgZhbGVydHMYASADKAsyHy5vYnNlcnZhYmlsaXR5LnYxLlNlY3VyaXR5QWxlcnQSEgoKY29uZmlndXJlZBgCIAEoCBITCgthbGVydF9jb3VudBgDIAEoBSKOAQoLU2VudHJ5SXNzdWUSCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSDwoHY3VscHJpdBgDIAEoCRIRCglwZXJtYWxpbmsYBCABKAkSDQoFY291bnQYBSABKAMSEQoJbGFzdF9zZWVuGAYgASgJEg0KBWxldmVsGAcgASgJEg8KB3Byb2plY3QYCCABKAkiGAoWR2V0U2VudHJ5SXNzdWVzUmVxdWVzdCJ2ChdHZXRTZW50cnlJc3N1ZXNSZXNwb25zZRItCgZpc3N1ZXMYASADKAsyHS5vYnNlcnZhYmlsaXR5LnYxLlNlbnRyeUlzc3VlEhIKCmNvbmZpZ3VyZWQYAiABKAgSGAoQdW5yZXNvbHZlZF9jb3VudBgDIAEoBSItChlSZXNvbHZlU2VudHJ5SXNzdWVSZXF1ZXN0EhAKCGlzc3VlX2lkGAEgASgJIhwKGlJlc29sdmVTZW50cnlJc3N1ZVJlc3BvbnNlImcKD1Nsb3dUcmFuc2FjdGlvbhITCgt0cmFuc2FjdGlvbhgBIAEoCRIPCgdwcm9qZWN0GAIgASgJEhcKD3A5NV9kdXJhdGlvbl9tcxgDIAEoARIVCg1yZXF1ZXN0X2NvdW50GAQgASgDIoEBChBUcmFuc2FjdGlvblRyZW5kEhMKC3RyYW5zYWN0aW9uGAEgASgJEg8KB3Byb2plY3QYAiABKAkSGAoQcHJpb3JfYXZnX3A5NV9tcxgDIAEoARIZChFyZWNlbnRfYXZnX3A5NV9tcxgEIAEoARISCgpwY3RfY2hhbmdlGAUgASgBIhwKGkdldFNsb3dUcmFuc2FjdGlvbnNSZXF1ZXN0IpsBChtHZXRTbG93VHJhbnNhY3Rpb25zUmVzcG9uc2USMgoHY3VycmVudBgBIAMoCzIhLm9ic2VydmFiaWxpdHkudjEuU2xvd1RyYW5zYWN0aW9uEhIKCmNvbmZpZ3VyZWQYAiABKAgSNAoIdHJlbmRpbmcYAyADKAsyIi5vYnNlcnZhYmlsaXR5LnYxLlRyYW5zYWN0aW9uVHJlbmQiGAoWR2V0RGVwbG95U3RhdHVzUmVxdWVzdCKKAQoXR2V0RGVwbG95U3RhdHVzUmVzcG9uc2USEgoKY29uZmlndXJlZBgBIAEoCBINCgVwaGFzZRgCIAEoCRINCgVjYXVzZRgDIAEoCRISCgpjcmVhdGVkX2F0GAQgASgJEhIKCnVwZGF0ZWRfYXQYBSABKAkSFQoNZGVwbG95bWVudF9pZBgGIAEoCSJBChRHZXREZXBsb3lMb2dzUmVxdWVzdBIVCg1kZXBsb3ltZW50X2lkGAEgASgJEhIKCnRhaWxfbGluZXMYAiABKAUidAoSRGVwbG95Q29tcG9uZW50TG9nEhEKCWNvbXBvbmVudBgBIAEoCRIQCghsb2dfdHlwZRgCIAEoCRIPCgdjb250ZW50GAMgASgJEhEKCXRydW5jYXRlZBgEIAEoCBIVCg1kZXBsb3ltZW50X2lkGAUgASgJIl4KKU9ic2VydmFiaWxpdHlTZXJ2aWNlR2V0RGVwbG95TG9nc1Jlc3BvbnNlEjEKA2xvZxgBIAEoCzIkLm9ic2VydmFiaWxpdHkudjEuRGVwbG95Q29tcG9uZW50TG9nInYKFUdldERlcGxveUxvZ3NSZXNwb25zZRISCgpjb25maWd1cmVkGAEgASgIEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSMgoEbG9ncxgDIAMoCzIkLm9ic2VydmFiaWxpdHkudjEuRGVwbG95Q29tcG9uZW50TG9nIhoKGEdldEhlYWx0aE92ZXJ2aWV3UmVxdWVzdCKRAQoZR2V0SGVhbHRoT3ZlcnZpZXdSZXNwb25zZRI5CgZzZW50cnkYAiABKAsyKS5vYnNlcnZhYmlsaXR5LnYxLkdldFNlbnRyeUlzc3Vlc1Jlc3BvbnNlEjkKBmRlcGxveRgDIAEoCzIpLm9ic2VydmFiaWxpdHkudjEuR2V0RGVwbG95U3RhdHVzUmVzcG9uc2UiHAoMR2l0aHViQ29uZmlnEgwKBHJlcG8YASABKAkiLQoMU2VudHJ5Q29uZmlnEgsKA29yZxgBIAEoCRIQCghwcm9qZWN0cxgCIAMoCSIkChJEaWdpdGFsT2NlYW5Db25maWcSDgoGYXBwX2lkGAEgASgJIrwBCg5Qcm92aWRlckNvbmZpZxIwCgZnaXRodWIYASABKAsyHi5vYnNlcnZhYmlsaXR5LnYxLkdpdGh1YkNvbmZpZ0gAEjAKBnNlbnRyeRgCIAEoCzIeLm9ic2VydmFiaWxpdHkudjEuU2VudHJ5Q29uZmlnSAASPAoMZGlnaXRhbG9jZWFuGAMgASgLMiQub2JzZXJ2YWJpbGl0eS52MS5EaWdpdGFsT2NlYW5Db25maWdIAEIICgZjb25maWcirgEKFU9BdXRoQ29ubmVjdGlvblN0YXR1cxIQCghwcm92aWRlchgBIAEoCRIRCgljb25uZWN0ZWQYAiABKAgSFAoMY29ubmVjdGVkX2J5GAMgASgJEhQKDGNvbm5lY3RlZF9hdBgEIAEoCRISCgpleHBpcmVzX2F0GAUgASgJEjAKBmNvbmZpZxgGIAEoCzIgLm9ic2VydmFiaWxpdHkudjEuUHJvdmlkZXJDb25maWciHQobTGlzdE9BdXRoQ29ubmVjdGlvbnNSZXF1ZXN0IlwKHExpc3RPQXV0aENvbm5lY3Rpb25zUmVzcG9uc2USPAoLY29ubmVjdGlvbnMYASADKAsyJy5vYnNlcnZhYmlsaXR5LnYxLk9BdXRoQ29ubmVjdGlvblN0YXR1cyI0CiBEaXNjb25uZWN0T0F1dGhDb25uZWN0aW9uUmVxdWVzdBIQCghwcm92aWRlchgBIAEoCSIjCiFEaXNjb25uZWN0T0F1dGhDb25uZWN0aW9uUmVzcG9uc2UiQQoZR2V0UHJvdmlkZXJPcHRpb25zUmVxdWVzdBIQCghwcm92aWRlchgBIAEoCRISCgpzZW50cnlfb3JnGAIgASgJImcKGkdldFByb3ZpZGVyT3B0aW9uc1Jlc3BvbnNlEg0KBXJlcG9zGAEgAygJEhMKC3NlbnRyeV9vcmdzGAIgAygJEhcKD3NlbnRyeV9wcm9qZWN0cxgDIAMoCRIMCgRhcHBzGAQgAygJIl4KGFNldFByb3ZpZGVyQ29uZmlnUmVxdWVzdBIQCghwcm92aWRlchgBIAEoCRIwCgZjb25maWcYAiABKAsyIC5vYnNlcnZhYmlsaXR5LnYxLlByb3ZpZGVyQ29uZmlnIhsKGVNldFByb3ZpZGVyQ29uZmlnUmVzcG9uc2UqrAEKEVNlY3VyaXR5QWxlcnRUeXBlEiMKH1NFQ1VSSVRZX0FMRVJUX1RZUEVfVU5TUEVDSUZJRUQQABIiCh5TRUNVUklUWV9BTEVSVF9UWVBFX0RFUEVOREFCT1QQARIlCiFTRUNVUklUWV9BTEVSVF9UWVBFX0NPREVfU0NBTk5JTkcQAhInCiNTRUNVUklUWV9BTEVSVF9UWVBFX1NFQ1JFVF9TQ0FOTklORxADMvsOChRPYnNlcnZhYmlsaXR5U2VydmljZRJaCgtHZXRKb2JTdGF0cxIkLm9ic2VydmFiaWxpdHkudjEuR2V0Sm9iU3RhdHNSZXF1ZXN0GiUub2JzZXJ2YWJpbGl0eS52MS5HZXRKb2JTdGF0c1Jlc3BvbnNlEmAKDUdldFVzYWdlU3RhdHMSJi5vYnNlcnZhYmlsaXR5LnYxLkdldFVzYWdlU3RhdHNSZXF1ZXN0Gicub2JzZXJ2YWJpbGl0eS52MS5HZXRVc2FnZVN0YXRzUmVzcG9uc2USZgoPR2V0U3RvcmFnZVN0YXRzEigub2JzZXJ2YWJpbGl0eS52MS5HZXRTdG9yYWdlU3RhdHNSZXF1ZXN0Gikub2JzZXJ2YWJpbGl0eS52MS5HZXRTdG9yYWdlU3RhdHNSZXNwb25zZRJvChJUcmlnZ2VyU3RvcmFnZVNjYW4SKy5vYnNlcnZhYmlsaXR5LnYxLlRyaWdnZXJTdG9yYWdlU2NhblJlcXVlc3QaLC5vYnNlcnZhYmlsaXR5LnYxLlRyaWdnZXJTdG9yYWdlU2NhblJlc3BvbnNlEmkKEEdldERhdGFiYXNlU3RhdHMSKS5vYnNlcnZhYmlsaXR5LnYxLkdldERhdGFiYXNlU3RhdHNSZXF1ZXN0Gioub2JzZXJ2YWJpbGl0eS52MS5HZXREYXRhYmFzZVN0YXRzUmVzcG9uc2USewoWR2V0RmFpbGluZ1B1bGxSZXF1ZXN0cxIvLm9ic2VydmFiaWxpdHkudjEuR2V0RmFpbGluZ1B1bGxSZXF1ZXN0c1JlcXVlc3QaMC5vYnNlcnZhYmlsaXR5LnYxLkdldEZhaWxpbmdQdWxsUmVxdWVzdHNSZXNwb25zZRJsChFHZXRTZWN1cml0eUFsZXJ0cxIqLm9ic2VydmFiaWxpdHkudjEuR2V0U2VjdXJpdHlBbGVydHNSZXF1ZXN0Gisub2JzZXJ2YWJpbGl0eS52MS5HZXRTZWN1cml0eUFsZXJ0c1Jlc3BvbnNlEmYKD0dldFNlbnRyeUlzc3VlcxIoLm9ic2VydmFiaWxpdHkudjEuR2V0U2VudHJ5SXNzdWVzUmVxdWVzdBopLm9ic2VydmFiaWxpdHkudjEuR2V0U2VudHJ5SXNzdWVzUmVzcG9uc2USbwoSUmVzb2x2ZVNlbnRyeUlzc3VlEisub2JzZXJ2YWJpbGl0eS52MS5SZXNvbHZlU2VudHJ5SXNzdWVSZXF1ZXN0Giwub2JzZXJ2YWJpbGl0eS52MS5SZXNvbHZlU2VudHJ5SXNzdWVSZXNwb25zZRJyChNHZXRTbG93VHJhbnNhY3Rpb25zEiwub2JzZXJ2YWJpbGl0eS52MS5HZXRTbG93VHJhbnNhY3Rpb25zUmVxdWVzdBotLm9ic2VydmFiaWxpdHkudjEuR2V0U2xvd1RyYW5zYWN0aW9uc1Jlc3BvbnNlEmYKD0dldERlcGxveVN0YXR1cxIoLm9ic2VydmFiaWxpdHkudjEuR2V0RGVwbG95U3RhdHVzUmVxdWVzdBopLm9ic2VydmFiaWxpdHkudjEuR2V0RGVwbG95U3RhdHVzUmVzcG9uc2USdgoNR2V0RGVwbG95TG9ncxImLm9ic2VydmFiaWxpdHkudjEuR2V0RGVwbG95TG9nc1JlcXVlc3QaOy5vYnNlcnZhYmlsaXR5LnYxLk9ic2VydmFiaWxpdHlTZXJ2aWNlR2V0RGVwbG95TG9nc1Jlc3BvbnNlMAESbAoRR2V0SGVhbHRoT3ZlcnZpZXcSKi5vYnNlcnZhYmlsaXR5LnYxLkdldEhlYWx0aE92ZXJ2aWV3UmVxdWVzdBorLm9ic2VydmFiaWxpdHkudjEuR2V0SGVhbHRoT3ZlcnZpZXdSZXNwb25zZRJ1ChRMaXN0T0F1dGhDb25uZWN0aW9ucxItLm9ic2VydmFiaWxpdHkudjEuTGlzdE9BdXRoQ29ubmVjdGlvbnNSZXF1ZXN0Gi4ub2JzZXJ2YWJpbGl0eS52MS5MaXN0T0F1dGhDb25uZWN0aW9uc1Jlc3BvbnNlEoQBChlEaXNjb25uZWN0T0F1dGhDb25uZWN0aW9uEjIub2JzZXJ2YWJpbGl0eS52MS5EaXNjb25uZWN0T0F1dGhDb25uZWN0aW9uUmVxdWVzdBozLm9ic2VydmFiaWxpdHkudjEuRGlzY29ubmVjdE9BdXRoQ29ubmVjdGlvblJlc3BvbnNlEm8KEkdldFByb3ZpZGVyT3B0aW9ucxIrLm9ic2VydmFiaWxpdHkudjEuR2V0UHJvdmlkZXJPcHRpb25zUmVxdWVzdBosLm9ic2VydmFiaWxpdHkudjEuR2V0UHJvdmlkZXJPcHRpb25zUmVzcG9uc2USbAoRU2V0UHJvdmlkZXJDb25maWcSKi5vYnNlcnZhYmlsaXR5LnYxLlNldFByb3ZpZGVyQ29uZmlnUmVxdWVzdBorLm9ic2VydmFiaWxpdHkudjEuU2V0UHJvdmlkZXJDb25maWdSZXNwb25zZUI5Wjd0b29scy54ZG91YmxldS5jb20vZ2VuL29ic2VydmFiaWxpdHkvdjE7b2JzZXJ2YWJpbGl0eXYxYgZwcm90bzM");
 
 /**
  * @generated from message observability.v1.JobStat
@@ -285,6 +285,25 @@ export type StorageSnapshot = Message<"observability.v1.StorageSnapshot"> & {
    * @generated from field: repeated observability.v1.PrefixStat prefix_breakdown = 8;
    */
   prefixBreakdown: PrefixStat[];
+
+  /**
+   * Space saved by blobs shared across book files (size × extra references).
+   *
+   * @generated from field: int64 dedup_saved_bytes = 9;
+   */
+  dedupSavedBytes: bigint;
+
+  /**
+   * What the scan's garbage collection deleted: orphans and stale uploads.
+   *
+   * @generated from field: int64 reclaimed_size_bytes = 10;
+   */
+  reclaimedSizeBytes: bigint;
+
+  /**
+   * @generated from field: int64 reclaimed_count = 11;
+   */
+  reclaimedCount: bigint;
 };
 
 /**
@@ -29,6 +29,7 @@ export const swrKeys = {
   dashboardReadingProgress: (token: string, dateStart?: string, dateEnd?: string) =>
     ['/dashboard/reading-progress', token, dateStart, dateEnd] as const,
   dashboardFeedsSummary: (token: string) => `/dashboard/reading/${token}/feeds-summary`,
+  dashboardReadingGoals: (token: string) => `/dashboard/reading/${token}/goals`,
   dashboardGames: (token: string) => `/dashboard/games/${token}`,
   dashboardGamesProgress: (token: string, dateStart?: string, dateEnd?: string) =>
     ['/dashboard/games-progress', token, dateStart, dateEnd] as const,
@@ -38,11 +39,16 @@ export const swrKeys = {
   books: '/books',
   booksProgress: (dateStart?: string, dateEnd?: string) =>
     ['/books/progress', dateStart, dateEnd] as const,
+  readingStats: (dateStart?: string, dateEnd?: string) =>
+    ['/books/reading-stats', dateStart, dateEnd] as const,
+  readingGoals: (year?: number) => ['/books/reading-goals', year] as const,
   koboDevices: '/books/kobo/devices',
   // Local-only key (no server round-trip) for polling the kobo-gateway
   // helper's /status — see lib/books/gatewayClient.ts.
   gatewayStatus: '/books/kobo/gateway-status',
   koboDeviceLogs: (id: string) => ['/books/kobo/logs', id] as const,
+  opdsTokens: '/books/opds/tokens',
+  koreaderDevices: '/books/koreader/devices',
   bookDuplicates: '/books/duplicates',
   resyncProposals: '/books/resync-proposals',
   bookSources: (bookId: string, overrideTitle = '', overrideAuthor = '') =>
@@ -53,6 +59,8 @@ export const swrKeys = {
   kepubStatus: (bookId: string) => ['/books/kepub-status', bookId] as const,
   bookFile: (bookId: string, format: string) => ['/books/file', bookId, format] as const,
   bookContent: (bookId: string) => ['/books/content', bookId] as const,
+  bookAnnotations: (bookId: string) => ['/books/annotations', bookId] as const,
+  bookTextSearch: (query: string) => ['/books/text-search', query] as const,
   externalBook: (provider: string, providerId: string) =>
     ['/books/external', provider, providerId] as const,
 
@@ -65,14 +73,22 @@ export const swrKeys = {
   gamesIntegrations: '/games/integrations',
 
   feeds: '/feeds',
-  feedItems: (unreadOnly: boolean, feedId?: string, bookmarkedOnly?: boolean) =>
-    `/feeds/items?unread=${unreadOnly}&feed=${feedId ?? ''}&bookmarked=${bookmarkedOnly ?? false}`,
+  feedItems: (
+    unreadOnly: boolean,
+    feedId?: string,
+    bookmarkedOnly?: boolean,
+    folderId?: string
+  ) =>
+    `/feeds/items?unread=${unreadOnly}&feed=${feedId ?? ''}&bookmarked=${bookmarkedOnly ?? false}` +
+    `&folder=${folderId ?? ''}`,
   // One item's article body, fetched only when the reader opens it — list
   // responses no longer carry content_html (issue #1027). Deliberately
   // singular so mutateFeedItems' '/feeds/items' prefix sweep doesn't also
   // drop every cached body.
   feedItem: (id: string) => `/feeds/item/${id}`,
   feedStats: '/feeds/stats',
+  feedFilterRules: '/feeds/rules',
+  feedAppPasswords: '/feeds/app-passwords',
   // Owner's own reading dashboard feeds widget — a handful of unread items
   // via the authenticated FeedService, separate from the public
   // dashboardFeedsSummary above.